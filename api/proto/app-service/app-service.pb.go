@@ -26,10 +26,14 @@ type GenApiKeyReq struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	AppId   string `protobuf:"bytes,1,opt,name=appId,proto3" json:"appId,omitempty"`
-	AppType string `protobuf:"bytes,2,opt,name=appType,proto3" json:"appType,omitempty"`
-	UserId  string `protobuf:"bytes,3,opt,name=userId,proto3" json:"userId,omitempty"`
-	OrgId   string `protobuf:"bytes,4,opt,name=orgId,proto3" json:"orgId,omitempty"`
+	AppId          string   `protobuf:"bytes,1,opt,name=appId,proto3" json:"appId,omitempty"`
+	AppType        string   `protobuf:"bytes,2,opt,name=appType,proto3" json:"appType,omitempty"`
+	UserId         string   `protobuf:"bytes,3,opt,name=userId,proto3" json:"userId,omitempty"`
+	OrgId          string   `protobuf:"bytes,4,opt,name=orgId,proto3" json:"orgId,omitempty"`
+	Scopes         []string `protobuf:"bytes,5,rep,name=scopes,proto3" json:"scopes,omitempty"`                 // 授权范围，为空表示不限制
+	AllowedOrigins []string `protobuf:"bytes,6,rep,name=allowedOrigins,proto3" json:"allowedOrigins,omitempty"` // 允许的请求来源，为空表示不限制
+	AllowedIps     []string `protobuf:"bytes,7,rep,name=allowedIps,proto3" json:"allowedIps,omitempty"`         // 允许的客户端IP或CIDR，为空表示不限制
+	ExpiresAt      int64    `protobuf:"varint,8,opt,name=expiresAt,proto3" json:"expiresAt,omitempty"`          // 过期时间（毫秒），0表示永不过期
 }
 
 func (x *GenApiKeyReq) Reset() {
@@ -92,6 +96,34 @@ func (x *GenApiKeyReq) GetOrgId() string {
 	return ""
 }
 
+func (x *GenApiKeyReq) GetScopes() []string {
+	if x != nil {
+		return x.Scopes
+	}
+	return nil
+}
+
+func (x *GenApiKeyReq) GetAllowedOrigins() []string {
+	if x != nil {
+		return x.AllowedOrigins
+	}
+	return nil
+}
+
+func (x *GenApiKeyReq) GetAllowedIps() []string {
+	if x != nil {
+		return x.AllowedIps
+	}
+	return nil
+}
+
+func (x *GenApiKeyReq) GetExpiresAt() int64 {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return 0
+}
+
 type GetApiKeyListReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -223,13 +255,19 @@ type ApiKeyInfo struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ApiId     string `protobuf:"bytes,1,opt,name=apiId,proto3" json:"apiId,omitempty"`
-	ApiKey    string `protobuf:"bytes,2,opt,name=apiKey,proto3" json:"apiKey,omitempty"`
-	UserId    string `protobuf:"bytes,3,opt,name=userId,proto3" json:"userId,omitempty"`
-	OrgId     string `protobuf:"bytes,4,opt,name=orgId,proto3" json:"orgId,omitempty"`
-	AppId     string `protobuf:"bytes,5,opt,name=appId,proto3" json:"appId,omitempty"`
-	AppType   string `protobuf:"bytes,6,opt,name=appType,proto3" json:"appType,omitempty"`
-	CreatedAt int64  `protobuf:"varint,7,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
+	ApiId          string   `protobuf:"bytes,1,opt,name=apiId,proto3" json:"apiId,omitempty"`
+	ApiKey         string   `protobuf:"bytes,2,opt,name=apiKey,proto3" json:"apiKey,omitempty"` // 明文，仅在生成或轮换时返回
+	UserId         string   `protobuf:"bytes,3,opt,name=userId,proto3" json:"userId,omitempty"`
+	OrgId          string   `protobuf:"bytes,4,opt,name=orgId,proto3" json:"orgId,omitempty"`
+	AppId          string   `protobuf:"bytes,5,opt,name=appId,proto3" json:"appId,omitempty"`
+	AppType        string   `protobuf:"bytes,6,opt,name=appType,proto3" json:"appType,omitempty"`
+	CreatedAt      int64    `protobuf:"varint,7,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
+	KeyPrefix      string   `protobuf:"bytes,8,opt,name=keyPrefix,proto3" json:"keyPrefix,omitempty"` // 可见前缀
+	Scopes         []string `protobuf:"bytes,9,rep,name=scopes,proto3" json:"scopes,omitempty"`
+	AllowedOrigins []string `protobuf:"bytes,10,rep,name=allowedOrigins,proto3" json:"allowedOrigins,omitempty"`
+	AllowedIps     []string `protobuf:"bytes,11,rep,name=allowedIps,proto3" json:"allowedIps,omitempty"`
+	ExpiresAt      int64    `protobuf:"varint,12,opt,name=expiresAt,proto3" json:"expiresAt,omitempty"`
+	LastUsedAt     int64    `protobuf:"varint,13,opt,name=lastUsedAt,proto3" json:"lastUsedAt,omitempty"`
 }
 
 func (x *ApiKeyInfo) Reset() {
@@ -313,6 +351,48 @@ func (x *ApiKeyInfo) GetCreatedAt() int64 {
 	return 0
 }
 
+func (x *ApiKeyInfo) GetKeyPrefix() string {
+	if x != nil {
+		return x.KeyPrefix
+	}
+	return ""
+}
+
+func (x *ApiKeyInfo) GetScopes() []string {
+	if x != nil {
+		return x.Scopes
+	}
+	return nil
+}
+
+func (x *ApiKeyInfo) GetAllowedOrigins() []string {
+	if x != nil {
+		return x.AllowedOrigins
+	}
+	return nil
+}
+
+func (x *ApiKeyInfo) GetAllowedIps() []string {
+	if x != nil {
+		return x.AllowedIps
+	}
+	return nil
+}
+
+func (x *ApiKeyInfo) GetExpiresAt() int64 {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return 0
+}
+
+func (x *ApiKeyInfo) GetLastUsedAt() int64 {
+	if x != nil {
+		return x.LastUsedAt
+	}
+	return 0
+}
+
 type DelApiKeyReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -407,6 +487,69 @@ func (x *GetApiKeyByKeyReq) GetApiKey() string {
 	return ""
 }
 
+type RotateApiKeyReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ApiId          string `protobuf:"bytes,1,opt,name=apiId,proto3" json:"apiId,omitempty"`
+	UserId         string `protobuf:"bytes,2,opt,name=userId,proto3" json:"userId,omitempty"`
+	OverlapSeconds int64  `protobuf:"varint,3,opt,name=overlapSeconds,proto3" json:"overlapSeconds,omitempty"` // 原Key继续可用的时长（秒）
+}
+
+func (x *RotateApiKeyReq) Reset() {
+	*x = RotateApiKeyReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_app_service_app_service_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RotateApiKeyReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RotateApiKeyReq) ProtoMessage() {}
+
+func (x *RotateApiKeyReq) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_app_service_app_service_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RotateApiKeyReq.ProtoReflect.Descriptor instead.
+func (*RotateApiKeyReq) Descriptor() ([]byte, []int) {
+	return file_proto_app_service_app_service_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *RotateApiKeyReq) GetApiId() string {
+	if x != nil {
+		return x.ApiId
+	}
+	return ""
+}
+
+func (x *RotateApiKeyReq) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *RotateApiKeyReq) GetOverlapSeconds() int64 {
+	if x != nil {
+		return x.OverlapSeconds
+	}
+	return 0
+}
+
 type GetExplorationAppListReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -421,7 +564,7 @@ type GetExplorationAppListReq struct {
 func (x *GetExplorationAppListReq) Reset() {
 	*x = GetExplorationAppListReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_app_service_app_service_proto_msgTypes[6]
+		mi := &file_proto_app_service_app_service_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -434,7 +577,7 @@ func (x *GetExplorationAppListReq) String() string {
 func (*GetExplorationAppListReq) ProtoMessage() {}
 
 func (x *GetExplorationAppListReq) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_app_service_app_service_proto_msgTypes[6]
+	mi := &file_proto_app_service_app_service_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -447,7 +590,7 @@ func (x *GetExplorationAppListReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetExplorationAppListReq.ProtoReflect.Descriptor instead.
 func (*GetExplorationAppListReq) Descriptor() ([]byte, []int) {
-	return file_proto_app_service_app_service_proto_rawDescGZIP(), []int{6}
+	return file_proto_app_service_app_service_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *GetExplorationAppListReq) GetName() string {
@@ -490,7 +633,7 @@ type ExplorationAppList struct {
 func (x *ExplorationAppList) Reset() {
 	*x = ExplorationAppList{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_app_service_app_service_proto_msgTypes[7]
+		mi := &file_proto_app_service_app_service_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -503,7 +646,7 @@ func (x *ExplorationAppList) String() string {
 func (*ExplorationAppList) ProtoMessage() {}
 
 func (x *ExplorationAppList) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_app_service_app_service_proto_msgTypes[7]
+	mi := &file_proto_app_service_app_service_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -516,7 +659,7 @@ func (x *ExplorationAppList) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ExplorationAppList.ProtoReflect.Descriptor instead.
 func (*ExplorationAppList) Descriptor() ([]byte, []int) {
-	return file_proto_app_service_app_service_proto_rawDescGZIP(), []int{7}
+	return file_proto_app_service_app_service_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *ExplorationAppList) GetInfos() []*ExplorationAppInfo {
@@ -550,7 +693,7 @@ type ExplorationAppInfo struct {
 func (x *ExplorationAppInfo) Reset() {
 	*x = ExplorationAppInfo{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_app_service_app_service_proto_msgTypes[8]
+		mi := &file_proto_app_service_app_service_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -563,7 +706,7 @@ func (x *ExplorationAppInfo) String() string {
 func (*ExplorationAppInfo) ProtoMessage() {}
 
 func (x *ExplorationAppInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_app_service_app_service_proto_msgTypes[8]
+	mi := &file_proto_app_service_app_service_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -576,7 +719,7 @@ func (x *ExplorationAppInfo) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ExplorationAppInfo.ProtoReflect.Descriptor instead.
 func (*ExplorationAppInfo) Descriptor() ([]byte, []int) {
-	return file_proto_app_service_app_service_proto_rawDescGZIP(), []int{8}
+	return file_proto_app_service_app_service_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *ExplorationAppInfo) GetAppId() string {
@@ -642,7 +785,7 @@ type AppInfo struct {
 func (x *AppInfo) Reset() {
 	*x = AppInfo{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_app_service_app_service_proto_msgTypes[9]
+		mi := &file_proto_app_service_app_service_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -655,7 +798,7 @@ func (x *AppInfo) String() string {
 func (*AppInfo) ProtoMessage() {}
 
 func (x *AppInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_app_service_app_service_proto_msgTypes[9]
+	mi := &file_proto_app_service_app_service_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -668,7 +811,7 @@ func (x *AppInfo) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AppInfo.ProtoReflect.Descriptor instead.
 func (*AppInfo) Descriptor() ([]byte, []int) {
-	return file_proto_app_service_app_service_proto_rawDescGZIP(), []int{9}
+	return file_proto_app_service_app_service_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *AppInfo) GetAppId() string {
@@ -714,7 +857,7 @@ type ChangeExplorationAppFavoriteReq struct {
 func (x *ChangeExplorationAppFavoriteReq) Reset() {
 	*x = ChangeExplorationAppFavoriteReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_app_service_app_service_proto_msgTypes[10]
+		mi := &file_proto_app_service_app_service_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -727,7 +870,7 @@ func (x *ChangeExplorationAppFavoriteReq) String() string {
 func (*ChangeExplorationAppFavoriteReq) ProtoMessage() {}
 
 func (x *ChangeExplorationAppFavoriteReq) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_app_service_app_service_proto_msgTypes[10]
+	mi := &file_proto_app_service_app_service_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -740,7 +883,7 @@ func (x *ChangeExplorationAppFavoriteReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ChangeExplorationAppFavoriteReq.ProtoReflect.Descriptor instead.
 func (*ChangeExplorationAppFavoriteReq) Descriptor() ([]byte, []int) {
-	return file_proto_app_service_app_service_proto_rawDescGZIP(), []int{10}
+	return file_proto_app_service_app_service_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *ChangeExplorationAppFavoriteReq) GetAppId() string {
@@ -791,7 +934,7 @@ type RecordAppHistoryReq struct {
 func (x *RecordAppHistoryReq) Reset() {
 	*x = RecordAppHistoryReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_app_service_app_service_proto_msgTypes[11]
+		mi := &file_proto_app_service_app_service_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -804,7 +947,7 @@ func (x *RecordAppHistoryReq) String() string {
 func (*RecordAppHistoryReq) ProtoMessage() {}
 
 func (x *RecordAppHistoryReq) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_app_service_app_service_proto_msgTypes[11]
+	mi := &file_proto_app_service_app_service_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -817,7 +960,7 @@ func (x *RecordAppHistoryReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RecordAppHistoryReq.ProtoReflect.Descriptor instead.
 func (*RecordAppHistoryReq) Descriptor() ([]byte, []int) {
-	return file_proto_app_service_app_service_proto_rawDescGZIP(), []int{11}
+	return file_proto_app_service_app_service_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *RecordAppHistoryReq) GetUserId() string {
@@ -856,7 +999,7 @@ type PublishAppReq struct {
 func (x *PublishAppReq) Reset() {
 	*x = PublishAppReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_app_service_app_service_proto_msgTypes[12]
+		mi := &file_proto_app_service_app_service_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -869,7 +1012,7 @@ func (x *PublishAppReq) String() string {
 func (*PublishAppReq) ProtoMessage() {}
 
 func (x *PublishAppReq) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_app_service_app_service_proto_msgTypes[12]
+	mi := &file_proto_app_service_app_service_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -882,7 +1025,7 @@ func (x *PublishAppReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PublishAppReq.ProtoReflect.Descriptor instead.
 func (*PublishAppReq) Descriptor() ([]byte, []int) {
-	return file_proto_app_service_app_service_proto_rawDescGZIP(), []int{12}
+	return file_proto_app_service_app_service_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *PublishAppReq) GetAppId() string {
@@ -928,12 +1071,13 @@ type UnPublishAppReq struct {
 	AppId   string `protobuf:"bytes,1,opt,name=appId,proto3" json:"appId,omitempty"`
 	AppType string `protobuf:"bytes,2,opt,name=appType,proto3" json:"appType,omitempty"`
 	UserId  string `protobuf:"bytes,3,opt,name=userId,proto3" json:"userId,omitempty"`
+	OrgId   string `protobuf:"bytes,4,opt,name=orgId,proto3" json:"orgId,omitempty"`
 }
 
 func (x *UnPublishAppReq) Reset() {
 	*x = UnPublishAppReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_app_service_app_service_proto_msgTypes[13]
+		mi := &file_proto_app_service_app_service_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -946,7 +1090,7 @@ func (x *UnPublishAppReq) String() string {
 func (*UnPublishAppReq) ProtoMessage() {}
 
 func (x *UnPublishAppReq) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_app_service_app_service_proto_msgTypes[13]
+	mi := &file_proto_app_service_app_service_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -959,7 +1103,7 @@ func (x *UnPublishAppReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UnPublishAppReq.ProtoReflect.Descriptor instead.
 func (*UnPublishAppReq) Descriptor() ([]byte, []int) {
-	return file_proto_app_service_app_service_proto_rawDescGZIP(), []int{13}
+	return file_proto_app_service_app_service_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *UnPublishAppReq) GetAppId() string {
@@ -983,6 +1127,13 @@ func (x *UnPublishAppReq) GetUserId() string {
 	return ""
 }
 
+func (x *UnPublishAppReq) GetOrgId() string {
+	if x != nil {
+		return x.OrgId
+	}
+	return ""
+}
+
 type GetAppListReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -996,7 +1147,7 @@ type GetAppListReq struct {
 func (x *GetAppListReq) Reset() {
 	*x = GetAppListReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_app_service_app_service_proto_msgTypes[14]
+		mi := &file_proto_app_service_app_service_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1009,7 +1160,7 @@ func (x *GetAppListReq) String() string {
 func (*GetAppListReq) ProtoMessage() {}
 
 func (x *GetAppListReq) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_app_service_app_service_proto_msgTypes[14]
+	mi := &file_proto_app_service_app_service_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1022,7 +1173,7 @@ func (x *GetAppListReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetAppListReq.ProtoReflect.Descriptor instead.
 func (*GetAppListReq) Descriptor() ([]byte, []int) {
-	return file_proto_app_service_app_service_proto_rawDescGZIP(), []int{14}
+	return file_proto_app_service_app_service_proto_rawDescGZIP(), []int{15}
 }
 
 func (x *GetAppListReq) GetAppType() string {
@@ -1057,7 +1208,7 @@ type GetAppListByIdsReq struct {
 func (x *GetAppListByIdsReq) Reset() {
 	*x = GetAppListByIdsReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_app_service_app_service_proto_msgTypes[15]
+		mi := &file_proto_app_service_app_service_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1070,7 +1221,7 @@ func (x *GetAppListByIdsReq) String() string {
 func (*GetAppListByIdsReq) ProtoMessage() {}
 
 func (x *GetAppListByIdsReq) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_app_service_app_service_proto_msgTypes[15]
+	mi := &file_proto_app_service_app_service_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1083,7 +1234,7 @@ func (x *GetAppListByIdsReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetAppListByIdsReq.ProtoReflect.Descriptor instead.
 func (*GetAppListByIdsReq) Descriptor() ([]byte, []int) {
-	return file_proto_app_service_app_service_proto_rawDescGZIP(), []int{15}
+	return file_proto_app_service_app_service_proto_rawDescGZIP(), []int{16}
 }
 
 func (x *GetAppListByIdsReq) GetAppIdsList() []string {
@@ -1105,7 +1256,7 @@ type AppList struct {
 func (x *AppList) Reset() {
 	*x = AppList{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_app_service_app_service_proto_msgTypes[16]
+		mi := &file_proto_app_service_app_service_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1118,7 +1269,7 @@ func (x *AppList) String() string {
 func (*AppList) ProtoMessage() {}
 
 func (x *AppList) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_app_service_app_service_proto_msgTypes[16]
+	mi := &file_proto_app_service_app_service_proto_msgTypes[17]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1131,7 +1282,7 @@ func (x *AppList) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AppList.ProtoReflect.Descriptor instead.
 func (*AppList) Descriptor() ([]byte, []int) {
-	return file_proto_app_service_app_service_proto_rawDescGZIP(), []int{16}
+	return file_proto_app_service_app_service_proto_rawDescGZIP(), []int{17}
 }
 
 func (x *AppList) GetInfos() []*AppInfo {
@@ -1160,7 +1311,7 @@ type DeleteAppReq struct {
 func (x *DeleteAppReq) Reset() {
 	*x = DeleteAppReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_app_service_app_service_proto_msgTypes[17]
+		mi := &file_proto_app_service_app_service_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1173,7 +1324,7 @@ func (x *DeleteAppReq) String() string {
 func (*DeleteAppReq) ProtoMessage() {}
 
 func (x *DeleteAppReq) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_app_service_app_service_proto_msgTypes[17]
+	mi := &file_proto_app_service_app_service_proto_msgTypes[18]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1186,7 +1337,7 @@ func (x *DeleteAppReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteAppReq.ProtoReflect.Descriptor instead.
 func (*DeleteAppReq) Descriptor() ([]byte, []int) {
-	return file_proto_app_service_app_service_proto_rawDescGZIP(), []int{17}
+	return file_proto_app_service_app_service_proto_rawDescGZIP(), []int{18}
 }
 
 func (x *DeleteAppReq) GetAppId() string {
@@ -1229,7 +1380,7 @@ type AppUrlInfo struct {
 func (x *AppUrlInfo) Reset() {
 	*x = AppUrlInfo{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_app_service_app_service_proto_msgTypes[18]
+		mi := &file_proto_app_service_app_service_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1242,7 +1393,7 @@ func (x *AppUrlInfo) String() string {
 func (*AppUrlInfo) ProtoMessage() {}
 
 func (x *AppUrlInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_app_service_app_service_proto_msgTypes[18]
+	mi := &file_proto_app_service_app_service_proto_msgTypes[19]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1255,7 +1406,7 @@ func (x *AppUrlInfo) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AppUrlInfo.ProtoReflect.Descriptor instead.
 func (*AppUrlInfo) Descriptor() ([]byte, []int) {
-	return file_proto_app_service_app_service_proto_rawDescGZIP(), []int{18}
+	return file_proto_app_service_app_service_proto_rawDescGZIP(), []int{19}
 }
 
 func (x *AppUrlInfo) GetUrlId() string {
@@ -1381,7 +1532,7 @@ type AppUrlCreateReq struct {
 func (x *AppUrlCreateReq) Reset() {
 	*x = AppUrlCreateReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_app_service_app_service_proto_msgTypes[19]
+		mi := &file_proto_app_service_app_service_proto_msgTypes[20]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1394,7 +1545,7 @@ func (x *AppUrlCreateReq) String() string {
 func (*AppUrlCreateReq) ProtoMessage() {}
 
 func (x *AppUrlCreateReq) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_app_service_app_service_proto_msgTypes[19]
+	mi := &file_proto_app_service_app_service_proto_msgTypes[20]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1407,7 +1558,7 @@ func (x *AppUrlCreateReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AppUrlCreateReq.ProtoReflect.Descriptor instead.
 func (*AppUrlCreateReq) Descriptor() ([]byte, []int) {
-	return file_proto_app_service_app_service_proto_rawDescGZIP(), []int{19}
+	return file_proto_app_service_app_service_proto_rawDescGZIP(), []int{20}
 }
 
 func (x *AppUrlCreateReq) GetAppUrlInfo() *AppUrlInfo {
@@ -1428,7 +1579,7 @@ type AppUrlUpdateReq struct {
 func (x *AppUrlUpdateReq) Reset() {
 	*x = AppUrlUpdateReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_app_service_app_service_proto_msgTypes[20]
+		mi := &file_proto_app_service_app_service_proto_msgTypes[21]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1441,7 +1592,7 @@ func (x *AppUrlUpdateReq) String() string {
 func (*AppUrlUpdateReq) ProtoMessage() {}
 
 func (x *AppUrlUpdateReq) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_app_service_app_service_proto_msgTypes[20]
+	mi := &file_proto_app_service_app_service_proto_msgTypes[21]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1454,7 +1605,7 @@ func (x *AppUrlUpdateReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AppUrlUpdateReq.ProtoReflect.Descriptor instead.
 func (*AppUrlUpdateReq) Descriptor() ([]byte, []int) {
-	return file_proto_app_service_app_service_proto_rawDescGZIP(), []int{20}
+	return file_proto_app_service_app_service_proto_rawDescGZIP(), []int{21}
 }
 
 func (x *AppUrlUpdateReq) GetAppUrlInfo() *AppUrlInfo {
@@ -1475,7 +1626,7 @@ type AppUrlDeleteReq struct {
 func (x *AppUrlDeleteReq) Reset() {
 	*x = AppUrlDeleteReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_app_service_app_service_proto_msgTypes[21]
+		mi := &file_proto_app_service_app_service_proto_msgTypes[22]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1488,7 +1639,7 @@ func (x *AppUrlDeleteReq) String() string {
 func (*AppUrlDeleteReq) ProtoMessage() {}
 
 func (x *AppUrlDeleteReq) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_app_service_app_service_proto_msgTypes[21]
+	mi := &file_proto_app_service_app_service_proto_msgTypes[22]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1501,7 +1652,7 @@ func (x *AppUrlDeleteReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AppUrlDeleteReq.ProtoReflect.Descriptor instead.
 func (*AppUrlDeleteReq) Descriptor() ([]byte, []int) {
-	return file_proto_app_service_app_service_proto_rawDescGZIP(), []int{21}
+	return file_proto_app_service_app_service_proto_rawDescGZIP(), []int{22}
 }
 
 func (x *AppUrlDeleteReq) GetUrlId() string {
@@ -1523,7 +1674,7 @@ type GetAppUrlListReq struct {
 func (x *GetAppUrlListReq) Reset() {
 	*x = GetAppUrlListReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_app_service_app_service_proto_msgTypes[22]
+		mi := &file_proto_app_service_app_service_proto_msgTypes[23]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1536,7 +1687,7 @@ func (x *GetAppUrlListReq) String() string {
 func (*GetAppUrlListReq) ProtoMessage() {}
 
 func (x *GetAppUrlListReq) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_app_service_app_service_proto_msgTypes[22]
+	mi := &file_proto_app_service_app_service_proto_msgTypes[23]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1549,7 +1700,7 @@ func (x *GetAppUrlListReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetAppUrlListReq.ProtoReflect.Descriptor instead.
 func (*GetAppUrlListReq) Descriptor() ([]byte, []int) {
-	return file_proto_app_service_app_service_proto_rawDescGZIP(), []int{22}
+	return file_proto_app_service_app_service_proto_rawDescGZIP(), []int{23}
 }
 
 func (x *GetAppUrlListReq) GetAppId() string {
@@ -1578,7 +1729,7 @@ type GetAppUrlListResp struct {
 func (x *GetAppUrlListResp) Reset() {
 	*x = GetAppUrlListResp{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_app_service_app_service_proto_msgTypes[23]
+		mi := &file_proto_app_service_app_service_proto_msgTypes[24]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1591,7 +1742,7 @@ func (x *GetAppUrlListResp) String() string {
 func (*GetAppUrlListResp) ProtoMessage() {}
 
 func (x *GetAppUrlListResp) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_app_service_app_service_proto_msgTypes[23]
+	mi := &file_proto_app_service_app_service_proto_msgTypes[24]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1604,7 +1755,7 @@ func (x *GetAppUrlListResp) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetAppUrlListResp.ProtoReflect.Descriptor instead.
 func (*GetAppUrlListResp) Descriptor() ([]byte, []int) {
-	return file_proto_app_service_app_service_proto_rawDescGZIP(), []int{23}
+	return file_proto_app_service_app_service_proto_rawDescGZIP(), []int{24}
 }
 
 func (x *GetAppUrlListResp) GetAppUrlInfos() []*AppUrlInfo {
@@ -1632,7 +1783,7 @@ type GetAppUrlInfoBySuffixReq struct {
 func (x *GetAppUrlInfoBySuffixReq) Reset() {
 	*x = GetAppUrlInfoBySuffixReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_app_service_app_service_proto_msgTypes[24]
+		mi := &file_proto_app_service_app_service_proto_msgTypes[25]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1645,7 +1796,7 @@ func (x *GetAppUrlInfoBySuffixReq) String() string {
 func (*GetAppUrlInfoBySuffixReq) ProtoMessage() {}
 
 func (x *GetAppUrlInfoBySuffixReq) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_app_service_app_service_proto_msgTypes[24]
+	mi := &file_proto_app_service_app_service_proto_msgTypes[25]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1658,7 +1809,7 @@ func (x *GetAppUrlInfoBySuffixReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetAppUrlInfoBySuffixReq.ProtoReflect.Descriptor instead.
 func (*GetAppUrlInfoBySuffixReq) Descriptor() ([]byte, []int) {
-	return file_proto_app_service_app_service_proto_rawDescGZIP(), []int{24}
+	return file_proto_app_service_app_service_proto_rawDescGZIP(), []int{25}
 }
 
 func (x *GetAppUrlInfoBySuffixReq) GetSuffix() string {
@@ -1680,7 +1831,7 @@ type AppUrlStatusSwitchReq struct {
 func (x *AppUrlStatusSwitchReq) Reset() {
 	*x = AppUrlStatusSwitchReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_app_service_app_service_proto_msgTypes[25]
+		mi := &file_proto_app_service_app_service_proto_msgTypes[26]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1693,7 +1844,7 @@ func (x *AppUrlStatusSwitchReq) String() string {
 func (*AppUrlStatusSwitchReq) ProtoMessage() {}
 
 func (x *AppUrlStatusSwitchReq) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_app_service_app_service_proto_msgTypes[25]
+	mi := &file_proto_app_service_app_service_proto_msgTypes[26]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1706,7 +1857,7 @@ func (x *AppUrlStatusSwitchReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AppUrlStatusSwitchReq.ProtoReflect.Descriptor instead.
 func (*AppUrlStatusSwitchReq) Descriptor() ([]byte, []int) {
-	return file_proto_app_service_app_service_proto_rawDescGZIP(), []int{25}
+	return file_proto_app_service_app_service_proto_rawDescGZIP(), []int{26}
 }
 
 func (x *AppUrlStatusSwitchReq) GetUrlId() string {
@@ -1723,213 +1874,1888 @@ func (x *AppUrlStatusSwitchReq) GetStatus() bool {
 	return false
 }
 
-var File_proto_app_service_app_service_proto protoreflect.FileDescriptor
+type AppEvalQuestion struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 
-var file_proto_app_service_app_service_proto_rawDesc = []byte{
-	0x0a, 0x23, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x61, 0x70, 0x70, 0x2d, 0x73, 0x65, 0x72, 0x76,
-	0x69, 0x63, 0x65, 0x2f, 0x61, 0x70, 0x70, 0x2d, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0b, 0x61, 0x70, 0x70, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69,
-	0x63, 0x65, 0x1a, 0x1b, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x62, 0x75, 0x66, 0x2f, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22,
-	0x6c, 0x0a, 0x0c, 0x47, 0x65, 0x6e, 0x41, 0x70, 0x69, 0x4b, 0x65, 0x79, 0x52, 0x65, 0x71, 0x12,
-	0x14, 0x0a, 0x05, 0x61, 0x70, 0x70, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
-	0x61, 0x70, 0x70, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x70, 0x70, 0x54, 0x79, 0x70, 0x65,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x70, 0x70, 0x54, 0x79, 0x70, 0x65, 0x12,
-	0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x6f, 0x72, 0x67, 0x49, 0x64,
-	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6f, 0x72, 0x67, 0x49, 0x64, 0x22, 0x70, 0x0a,
-	0x10, 0x47, 0x65, 0x74, 0x41, 0x70, 0x69, 0x4b, 0x65, 0x79, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65,
-	0x71, 0x12, 0x14, 0x0a, 0x05, 0x61, 0x70, 0x70, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x05, 0x61, 0x70, 0x70, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x70, 0x70, 0x54, 0x79,
-	0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x70, 0x70, 0x54, 0x79, 0x70,
-	0x65, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x6f, 0x72, 0x67,
-	0x49, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6f, 0x72, 0x67, 0x49, 0x64, 0x22,
-	0x53, 0x0a, 0x0e, 0x41, 0x70, 0x69, 0x4b, 0x65, 0x79, 0x49, 0x6e, 0x66, 0x6f, 0x4c, 0x69, 0x73,
-	0x74, 0x12, 0x2b, 0x0a, 0x04, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
-	0x17, 0x2e, 0x61, 0x70, 0x70, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x41, 0x70,
-	0x69, 0x4b, 0x65, 0x79, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x04, 0x69, 0x6e, 0x66, 0x6f, 0x12, 0x14,
-	0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x74,
-	0x6f, 0x74, 0x61, 0x6c, 0x22, 0xb6, 0x01, 0x0a, 0x0a, 0x41, 0x70, 0x69, 0x4b, 0x65, 0x79, 0x49,
-	0x6e, 0x66, 0x6f, 0x12, 0x14, 0x0a, 0x05, 0x61, 0x70, 0x69, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x05, 0x61, 0x70, 0x69, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x70, 0x69,
-	0x4b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x70, 0x69, 0x4b, 0x65,
-	0x79, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x6f, 0x72, 0x67,
-	0x49, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6f, 0x72, 0x67, 0x49, 0x64, 0x12,
-	0x14, 0x0a, 0x05, 0x61, 0x70, 0x70, 0x49, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
-	0x61, 0x70, 0x70, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x70, 0x70, 0x54, 0x79, 0x70, 0x65,
-	0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x70, 0x70, 0x54, 0x79, 0x70, 0x65, 0x12,
-	0x1c, 0x0a, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x07, 0x20, 0x01,
-	0x28, 0x03, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0x24, 0x0a,
-	0x0c, 0x44, 0x65, 0x6c, 0x41, 0x70, 0x69, 0x4b, 0x65, 0x79, 0x52, 0x65, 0x71, 0x12, 0x14, 0x0a,
-	0x05, 0x61, 0x70, 0x69, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x61, 0x70,
-	0x69, 0x49, 0x64, 0x22, 0x2b, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x41, 0x70, 0x69, 0x4b, 0x65, 0x79,
-	0x42, 0x79, 0x4b, 0x65, 0x79, 0x52, 0x65, 0x71, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x70, 0x69, 0x4b,
-	0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x70, 0x69, 0x4b, 0x65, 0x79,
-	0x22, 0x80, 0x01, 0x0a, 0x18, 0x47, 0x65, 0x74, 0x45, 0x78, 0x70, 0x6c, 0x6f, 0x72, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x41, 0x70, 0x70, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x12, 0x12, 0x0a,
-	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d,
-	0x65, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x70, 0x70, 0x54, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x07, 0x61, 0x70, 0x70, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x73,
-	0x65, 0x61, 0x72, 0x63, 0x68, 0x54, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x0a, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x54, 0x79, 0x70, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x75,
-	0x73, 0x65, 0x72, 0x49, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65,
-	0x72, 0x49, 0x64, 0x22, 0x61, 0x0a, 0x12, 0x45, 0x78, 0x70, 0x6c, 0x6f, 0x72, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x41, 0x70, 0x70, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x35, 0x0a, 0x05, 0x69, 0x6e, 0x66,
-	0x6f, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x61, 0x70, 0x70, 0x5f, 0x73,
-	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x45, 0x78, 0x70, 0x6c, 0x6f, 0x72, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x41, 0x70, 0x70, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x05, 0x69, 0x6e, 0x66, 0x6f, 0x73,
-	0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52,
-	0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x22, 0xda, 0x01, 0x0a, 0x12, 0x45, 0x78, 0x70, 0x6c, 0x6f,
-	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x70, 0x70, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x14, 0x0a,
-	0x05, 0x61, 0x70, 0x70, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x61, 0x70,
-	0x70, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x70, 0x70, 0x54, 0x79, 0x70, 0x65, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x70, 0x70, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1c, 0x0a,
-	0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03,
-	0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x75,
-	0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09,
-	0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x69, 0x73, 0x46,
-	0x61, 0x76, 0x6f, 0x72, 0x69, 0x74, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x69,
-	0x73, 0x46, 0x61, 0x76, 0x6f, 0x72, 0x69, 0x74, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x70, 0x75, 0x62,
-	0x6c, 0x69, 0x73, 0x68, 0x54, 0x79, 0x70, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b,
-	0x70, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x54, 0x79, 0x70, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x75,
-	0x73, 0x65, 0x72, 0x49, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65,
-	0x72, 0x49, 0x64, 0x22, 0x79, 0x0a, 0x07, 0x41, 0x70, 0x70, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x14,
-	0x0a, 0x05, 0x61, 0x70, 0x70, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x61,
-	0x70, 0x70, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x70, 0x70, 0x54, 0x79, 0x70, 0x65, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x70, 0x70, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1c,
-	0x0a, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x03, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x20, 0x0a, 0x0b,
-	0x70, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x54, 0x79, 0x70, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x0b, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x54, 0x79, 0x70, 0x65, 0x22, 0x9f,
-	0x01, 0x0a, 0x1f, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x45, 0x78, 0x70, 0x6c, 0x6f, 0x72, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x41, 0x70, 0x70, 0x46, 0x61, 0x76, 0x6f, 0x72, 0x69, 0x74, 0x65, 0x52,
-	0x65, 0x71, 0x12, 0x14, 0x0a, 0x05, 0x61, 0x70, 0x70, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x05, 0x61, 0x70, 0x70, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x70, 0x70, 0x54,
-	0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x70, 0x70, 0x54, 0x79,
-	0x70, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x69, 0x73, 0x46, 0x61, 0x76, 0x6f, 0x72, 0x69, 0x74, 0x65,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x69, 0x73, 0x46, 0x61, 0x76, 0x6f, 0x72, 0x69,
-	0x74, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x18, 0x04, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x6f, 0x72,
-	0x67, 0x49, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6f, 0x72, 0x67, 0x49, 0x64,
-	0x22, 0x5d, 0x0a, 0x13, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x41, 0x70, 0x70, 0x48, 0x69, 0x73,
-	0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49,
-	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12,
-	0x14, 0x0a, 0x05, 0x61, 0x70, 0x70, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
-	0x61, 0x70, 0x70, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x70, 0x70, 0x54, 0x79, 0x70, 0x65,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x70, 0x70, 0x54, 0x79, 0x70, 0x65, 0x22,
-	0x8f, 0x01, 0x0a, 0x0d, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x41, 0x70, 0x70, 0x52, 0x65,
-	0x71, 0x12, 0x14, 0x0a, 0x05, 0x61, 0x70, 0x70, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x05, 0x61, 0x70, 0x70, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x70, 0x70, 0x54, 0x79,
-	0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x70, 0x70, 0x54, 0x79, 0x70,
-	0x65, 0x12, 0x20, 0x0a, 0x0b, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x54, 0x79, 0x70, 0x65,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x54,
-	0x79, 0x70, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x18, 0x04, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x6f,
-	0x72, 0x67, 0x49, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6f, 0x72, 0x67, 0x49,
-	0x64, 0x22, 0x59, 0x0a, 0x0f, 0x55, 0x6e, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x41, 0x70,
-	0x70, 0x52, 0x65, 0x71, 0x12, 0x14, 0x0a, 0x05, 0x61, 0x70, 0x70, 0x49, 0x64, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x05, 0x61, 0x70, 0x70, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x70,
-	0x70, 0x54, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x70, 0x70,
-	0x54, 0x79, 0x70, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x22, 0x57, 0x0a, 0x0d,
-	0x47, 0x65, 0x74, 0x41, 0x70, 0x70, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x12, 0x18, 0x0a,
-	0x07, 0x61, 0x70, 0x70, 0x54, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
-	0x61, 0x70, 0x70, 0x54, 0x79, 0x70, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49,
-	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12,
-	0x14, 0x0a, 0x05, 0x6f, 0x72, 0x67, 0x49, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
-	0x6f, 0x72, 0x67, 0x49, 0x64, 0x22, 0x34, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x41, 0x70, 0x70, 0x4c,
-	0x69, 0x73, 0x74, 0x42, 0x79, 0x49, 0x64, 0x73, 0x52, 0x65, 0x71, 0x12, 0x1e, 0x0a, 0x0a, 0x61,
-	0x70, 0x70, 0x49, 0x64, 0x73, 0x4c, 0x69, 0x73, 0x74, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52,
-	0x0a, 0x61, 0x70, 0x70, 0x49, 0x64, 0x73, 0x4c, 0x69, 0x73, 0x74, 0x22, 0x4b, 0x0a, 0x07, 0x41,
-	0x70, 0x70, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x2a, 0x0a, 0x05, 0x69, 0x6e, 0x66, 0x6f, 0x73, 0x18,
-	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x61, 0x70, 0x70, 0x5f, 0x73, 0x65, 0x72, 0x76,
-	0x69, 0x63, 0x65, 0x2e, 0x41, 0x70, 0x70, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x05, 0x69, 0x6e, 0x66,
-	0x6f, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x03, 0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x22, 0x3e, 0x0a, 0x0c, 0x44, 0x65, 0x6c, 0x65,
-	0x74, 0x65, 0x41, 0x70, 0x70, 0x52, 0x65, 0x71, 0x12, 0x14, 0x0a, 0x05, 0x61, 0x70, 0x70, 0x49,
-	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x61, 0x70, 0x70, 0x49, 0x64, 0x12, 0x18,
-	0x0a, 0x07, 0x61, 0x70, 0x70, 0x54, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x07, 0x61, 0x70, 0x70, 0x54, 0x79, 0x70, 0x65, 0x22, 0xec, 0x03, 0x0a, 0x0a, 0x41, 0x70, 0x70,
-	0x55, 0x72, 0x6c, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x14, 0x0a, 0x05, 0x75, 0x72, 0x6c, 0x49, 0x64,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x75, 0x72, 0x6c, 0x49, 0x64, 0x12, 0x14, 0x0a,
-	0x05, 0x61, 0x70, 0x70, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x61, 0x70,
-	0x70, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x70, 0x70, 0x54, 0x79, 0x70, 0x65, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x70, 0x70, 0x54, 0x79, 0x70, 0x65, 0x12, 0x12, 0x0a,
-	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d,
-	0x65, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x05,
-	0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12,
-	0x1c, 0x0a, 0x09, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x64, 0x41, 0x74, 0x18, 0x06, 0x20, 0x01,
-	0x28, 0x03, 0x52, 0x09, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x64, 0x41, 0x74, 0x12, 0x1c, 0x0a,
-	0x09, 0x63, 0x6f, 0x70, 0x79, 0x72, 0x69, 0x67, 0x68, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x09, 0x63, 0x6f, 0x70, 0x79, 0x72, 0x69, 0x67, 0x68, 0x74, 0x12, 0x28, 0x0a, 0x0f, 0x63,
-	0x6f, 0x70, 0x79, 0x72, 0x69, 0x67, 0x68, 0x74, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x08,
-	0x20, 0x01, 0x28, 0x08, 0x52, 0x0f, 0x63, 0x6f, 0x70, 0x79, 0x72, 0x69, 0x67, 0x68, 0x74, 0x45,
-	0x6e, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x24, 0x0a, 0x0d, 0x70, 0x72, 0x69, 0x76, 0x61, 0x63, 0x79,
-	0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x70, 0x72,
-	0x69, 0x76, 0x61, 0x63, 0x79, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x30, 0x0a, 0x13, 0x70,
-	0x72, 0x69, 0x76, 0x61, 0x63, 0x79, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x45, 0x6e, 0x61, 0x62,
-	0x6c, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x08, 0x52, 0x13, 0x70, 0x72, 0x69, 0x76, 0x61, 0x63,
-	0x79, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x1e, 0x0a,
-	0x0a, 0x64, 0x69, 0x73, 0x63, 0x6c, 0x61, 0x69, 0x6d, 0x65, 0x72, 0x18, 0x0b, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x0a, 0x64, 0x69, 0x73, 0x63, 0x6c, 0x61, 0x69, 0x6d, 0x65, 0x72, 0x12, 0x2a, 0x0a,
-	0x10, 0x64, 0x69, 0x73, 0x63, 0x6c, 0x61, 0x69, 0x6d, 0x65, 0x72, 0x45, 0x6e, 0x61, 0x62, 0x6c,
-	0x65, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x08, 0x52, 0x10, 0x64, 0x69, 0x73, 0x63, 0x6c, 0x61, 0x69,
-	0x6d, 0x65, 0x72, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x75, 0x66,
-	0x66, 0x69, 0x78, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x75, 0x66, 0x66, 0x69,
-	0x78, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x0e, 0x20, 0x01, 0x28,
-	0x08, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65,
-	0x72, 0x49, 0x64, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49,
-	0x64, 0x12, 0x14, 0x0a, 0x05, 0x6f, 0x72, 0x67, 0x49, 0x64, 0x18, 0x10, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x05, 0x6f, 0x72, 0x67, 0x49, 0x64, 0x22, 0x4a, 0x0a, 0x0f, 0x41, 0x70, 0x70, 0x55, 0x72,
-	0x6c, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x12, 0x37, 0x0a, 0x0a, 0x61, 0x70,
-	0x70, 0x55, 0x72, 0x6c, 0x49, 0x6e, 0x66, 0x6f, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17,
-	0x2e, 0x61, 0x70, 0x70, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x41, 0x70, 0x70,
-	0x55, 0x72, 0x6c, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0a, 0x61, 0x70, 0x70, 0x55, 0x72, 0x6c, 0x49,
-	0x6e, 0x66, 0x6f, 0x22, 0x4a, 0x0a, 0x0f, 0x41, 0x70, 0x70, 0x55, 0x72, 0x6c, 0x55, 0x70, 0x64,
-	0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x12, 0x37, 0x0a, 0x0a, 0x61, 0x70, 0x70, 0x55, 0x72, 0x6c,
-	0x49, 0x6e, 0x66, 0x6f, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x61, 0x70, 0x70,
-	0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x41, 0x70, 0x70, 0x55, 0x72, 0x6c, 0x49,
-	0x6e, 0x66, 0x6f, 0x52, 0x0a, 0x61, 0x70, 0x70, 0x55, 0x72, 0x6c, 0x49, 0x6e, 0x66, 0x6f, 0x22,
-	0x27, 0x0a, 0x0f, 0x41, 0x70, 0x70, 0x55, 0x72, 0x6c, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52,
-	0x65, 0x71, 0x12, 0x14, 0x0a, 0x05, 0x75, 0x72, 0x6c, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x05, 0x75, 0x72, 0x6c, 0x49, 0x64, 0x22, 0x42, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x41,
-	0x70, 0x70, 0x55, 0x72, 0x6c, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x12, 0x14, 0x0a, 0x05,
-	0x61, 0x70, 0x70, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x61, 0x70, 0x70,
-	0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x70, 0x70, 0x54, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x70, 0x70, 0x54, 0x79, 0x70, 0x65, 0x22, 0x64, 0x0a, 0x11,
-	0x47, 0x65, 0x74, 0x41, 0x70, 0x70, 0x55, 0x72, 0x6c, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73,
-	0x70, 0x12, 0x39, 0x0a, 0x0b, 0x61, 0x70, 0x70, 0x55, 0x72, 0x6c, 0x49, 0x6e, 0x66, 0x6f, 0x73,
-	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x61, 0x70, 0x70, 0x5f, 0x73, 0x65, 0x72,
-	0x76, 0x69, 0x63, 0x65, 0x2e, 0x41, 0x70, 0x70, 0x55, 0x72, 0x6c, 0x49, 0x6e, 0x66, 0x6f, 0x52,
-	0x0b, 0x61, 0x70, 0x70, 0x55, 0x72, 0x6c, 0x49, 0x6e, 0x66, 0x6f, 0x73, 0x12, 0x14, 0x0a, 0x05,
+	QuestionId      string `protobuf:"bytes,1,opt,name=questionId,proto3" json:"questionId,omitempty"`
+	Question        string `protobuf:"bytes,2,opt,name=question,proto3" json:"question,omitempty"`
+	ReferenceAnswer string `protobuf:"bytes,3,opt,name=referenceAnswer,proto3" json:"referenceAnswer,omitempty"` // 参考答案，用于评估回答正确性
+}
+
+func (x *AppEvalQuestion) Reset() {
+	*x = AppEvalQuestion{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_app_service_app_service_proto_msgTypes[27]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AppEvalQuestion) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AppEvalQuestion) ProtoMessage() {}
+
+func (x *AppEvalQuestion) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_app_service_app_service_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AppEvalQuestion.ProtoReflect.Descriptor instead.
+func (*AppEvalQuestion) Descriptor() ([]byte, []int) {
+	return file_proto_app_service_app_service_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *AppEvalQuestion) GetQuestionId() string {
+	if x != nil {
+		return x.QuestionId
+	}
+	return ""
+}
+
+func (x *AppEvalQuestion) GetQuestion() string {
+	if x != nil {
+		return x.Question
+	}
+	return ""
+}
+
+func (x *AppEvalQuestion) GetReferenceAnswer() string {
+	if x != nil {
+		return x.ReferenceAnswer
+	}
+	return ""
+}
+
+type CreateAppEvalDatasetReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name        string             `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Description string             `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	Questions   []*AppEvalQuestion `protobuf:"bytes,3,rep,name=questions,proto3" json:"questions,omitempty"`
+	UserId      string             `protobuf:"bytes,4,opt,name=userId,proto3" json:"userId,omitempty"`
+	OrgId       string             `protobuf:"bytes,5,opt,name=orgId,proto3" json:"orgId,omitempty"`
+}
+
+func (x *CreateAppEvalDatasetReq) Reset() {
+	*x = CreateAppEvalDatasetReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_app_service_app_service_proto_msgTypes[28]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateAppEvalDatasetReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateAppEvalDatasetReq) ProtoMessage() {}
+
+func (x *CreateAppEvalDatasetReq) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_app_service_app_service_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateAppEvalDatasetReq.ProtoReflect.Descriptor instead.
+func (*CreateAppEvalDatasetReq) Descriptor() ([]byte, []int) {
+	return file_proto_app_service_app_service_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *CreateAppEvalDatasetReq) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateAppEvalDatasetReq) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *CreateAppEvalDatasetReq) GetQuestions() []*AppEvalQuestion {
+	if x != nil {
+		return x.Questions
+	}
+	return nil
+}
+
+func (x *CreateAppEvalDatasetReq) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *CreateAppEvalDatasetReq) GetOrgId() string {
+	if x != nil {
+		return x.OrgId
+	}
+	return ""
+}
+
+type AppEvalDatasetReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	DatasetId string `protobuf:"bytes,1,opt,name=datasetId,proto3" json:"datasetId,omitempty"`
+	UserId    string `protobuf:"bytes,2,opt,name=userId,proto3" json:"userId,omitempty"`
+	OrgId     string `protobuf:"bytes,3,opt,name=orgId,proto3" json:"orgId,omitempty"`
+}
+
+func (x *AppEvalDatasetReq) Reset() {
+	*x = AppEvalDatasetReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_app_service_app_service_proto_msgTypes[29]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AppEvalDatasetReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AppEvalDatasetReq) ProtoMessage() {}
+
+func (x *AppEvalDatasetReq) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_app_service_app_service_proto_msgTypes[29]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AppEvalDatasetReq.ProtoReflect.Descriptor instead.
+func (*AppEvalDatasetReq) Descriptor() ([]byte, []int) {
+	return file_proto_app_service_app_service_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *AppEvalDatasetReq) GetDatasetId() string {
+	if x != nil {
+		return x.DatasetId
+	}
+	return ""
+}
+
+func (x *AppEvalDatasetReq) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *AppEvalDatasetReq) GetOrgId() string {
+	if x != nil {
+		return x.OrgId
+	}
+	return ""
+}
+
+type GetAppEvalDatasetListReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId string `protobuf:"bytes,1,opt,name=userId,proto3" json:"userId,omitempty"`
+	OrgId  string `protobuf:"bytes,2,opt,name=orgId,proto3" json:"orgId,omitempty"`
+}
+
+func (x *GetAppEvalDatasetListReq) Reset() {
+	*x = GetAppEvalDatasetListReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_app_service_app_service_proto_msgTypes[30]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetAppEvalDatasetListReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAppEvalDatasetListReq) ProtoMessage() {}
+
+func (x *GetAppEvalDatasetListReq) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_app_service_app_service_proto_msgTypes[30]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAppEvalDatasetListReq.ProtoReflect.Descriptor instead.
+func (*GetAppEvalDatasetListReq) Descriptor() ([]byte, []int) {
+	return file_proto_app_service_app_service_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *GetAppEvalDatasetListReq) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *GetAppEvalDatasetListReq) GetOrgId() string {
+	if x != nil {
+		return x.OrgId
+	}
+	return ""
+}
+
+type AppEvalDatasetInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	DatasetId     string             `protobuf:"bytes,1,opt,name=datasetId,proto3" json:"datasetId,omitempty"`
+	Name          string             `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Description   string             `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	QuestionCount int32              `protobuf:"varint,4,opt,name=questionCount,proto3" json:"questionCount,omitempty"`
+	Questions     []*AppEvalQuestion `protobuf:"bytes,5,rep,name=questions,proto3" json:"questions,omitempty"` // 仅详情返回
+	CreatedAt     int64              `protobuf:"varint,6,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
+	UserId        string             `protobuf:"bytes,7,opt,name=userId,proto3" json:"userId,omitempty"`
+	OrgId         string             `protobuf:"bytes,8,opt,name=orgId,proto3" json:"orgId,omitempty"`
+}
+
+func (x *AppEvalDatasetInfo) Reset() {
+	*x = AppEvalDatasetInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_app_service_app_service_proto_msgTypes[31]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AppEvalDatasetInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AppEvalDatasetInfo) ProtoMessage() {}
+
+func (x *AppEvalDatasetInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_app_service_app_service_proto_msgTypes[31]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AppEvalDatasetInfo.ProtoReflect.Descriptor instead.
+func (*AppEvalDatasetInfo) Descriptor() ([]byte, []int) {
+	return file_proto_app_service_app_service_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *AppEvalDatasetInfo) GetDatasetId() string {
+	if x != nil {
+		return x.DatasetId
+	}
+	return ""
+}
+
+func (x *AppEvalDatasetInfo) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *AppEvalDatasetInfo) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *AppEvalDatasetInfo) GetQuestionCount() int32 {
+	if x != nil {
+		return x.QuestionCount
+	}
+	return 0
+}
+
+func (x *AppEvalDatasetInfo) GetQuestions() []*AppEvalQuestion {
+	if x != nil {
+		return x.Questions
+	}
+	return nil
+}
+
+func (x *AppEvalDatasetInfo) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+func (x *AppEvalDatasetInfo) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *AppEvalDatasetInfo) GetOrgId() string {
+	if x != nil {
+		return x.OrgId
+	}
+	return ""
+}
+
+type AppEvalDatasetList struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Datasets []*AppEvalDatasetInfo `protobuf:"bytes,1,rep,name=datasets,proto3" json:"datasets,omitempty"`
+	Total    int64                 `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+}
+
+func (x *AppEvalDatasetList) Reset() {
+	*x = AppEvalDatasetList{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_app_service_app_service_proto_msgTypes[32]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AppEvalDatasetList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AppEvalDatasetList) ProtoMessage() {}
+
+func (x *AppEvalDatasetList) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_app_service_app_service_proto_msgTypes[32]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AppEvalDatasetList.ProtoReflect.Descriptor instead.
+func (*AppEvalDatasetList) Descriptor() ([]byte, []int) {
+	return file_proto_app_service_app_service_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *AppEvalDatasetList) GetDatasets() []*AppEvalDatasetInfo {
+	if x != nil {
+		return x.Datasets
+	}
+	return nil
+}
+
+func (x *AppEvalDatasetList) GetTotal() int64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+type AppEvalScores struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Faithfulness float64 `protobuf:"fixed64,1,opt,name=faithfulness,proto3" json:"faithfulness,omitempty"` // 忠实度：回答是否基于检索内容，1-5分
+	Relevance    float64 `protobuf:"fixed64,2,opt,name=relevance,proto3" json:"relevance,omitempty"`       // 相关性：回答是否切题，1-5分
+	Correctness  float64 `protobuf:"fixed64,3,opt,name=correctness,proto3" json:"correctness,omitempty"`   // 正确性：回答与参考答案是否一致，1-5分
+}
+
+func (x *AppEvalScores) Reset() {
+	*x = AppEvalScores{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_app_service_app_service_proto_msgTypes[33]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AppEvalScores) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AppEvalScores) ProtoMessage() {}
+
+func (x *AppEvalScores) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_app_service_app_service_proto_msgTypes[33]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AppEvalScores.ProtoReflect.Descriptor instead.
+func (*AppEvalScores) Descriptor() ([]byte, []int) {
+	return file_proto_app_service_app_service_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *AppEvalScores) GetFaithfulness() float64 {
+	if x != nil {
+		return x.Faithfulness
+	}
+	return 0
+}
+
+func (x *AppEvalScores) GetRelevance() float64 {
+	if x != nil {
+		return x.Relevance
+	}
+	return 0
+}
+
+func (x *AppEvalScores) GetCorrectness() float64 {
+	if x != nil {
+		return x.Correctness
+	}
+	return 0
+}
+
+type CreateAppEvalJobReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	DatasetId     string  `protobuf:"bytes,1,opt,name=datasetId,proto3" json:"datasetId,omitempty"`
+	AppId         string  `protobuf:"bytes,2,opt,name=appId,proto3" json:"appId,omitempty"`
+	AppType       string  `protobuf:"bytes,3,opt,name=appType,proto3" json:"appType,omitempty"`             // rag 或 agent
+	JudgeModelId  string  `protobuf:"bytes,4,opt,name=judgeModelId,proto3" json:"judgeModelId,omitempty"`   // 评分模型id
+	BaselineJobId string  `protobuf:"bytes,5,opt,name=baselineJobId,proto3" json:"baselineJobId,omitempty"` // 基线评测任务id，为空时不与基线对比
+	Tolerance     float64 `protobuf:"fixed64,6,opt,name=tolerance,proto3" json:"tolerance,omitempty"`       // 相对基线允许下降的分值
+	MinScore      float64 `protobuf:"fixed64,7,opt,name=minScore,proto3" json:"minScore,omitempty"`         // 各项平均分的最低要求，为0时不校验
+	UserId        string  `protobuf:"bytes,8,opt,name=userId,proto3" json:"userId,omitempty"`
+	OrgId         string  `protobuf:"bytes,9,opt,name=orgId,proto3" json:"orgId,omitempty"`
+}
+
+func (x *CreateAppEvalJobReq) Reset() {
+	*x = CreateAppEvalJobReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_app_service_app_service_proto_msgTypes[34]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateAppEvalJobReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateAppEvalJobReq) ProtoMessage() {}
+
+func (x *CreateAppEvalJobReq) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_app_service_app_service_proto_msgTypes[34]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateAppEvalJobReq.ProtoReflect.Descriptor instead.
+func (*CreateAppEvalJobReq) Descriptor() ([]byte, []int) {
+	return file_proto_app_service_app_service_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *CreateAppEvalJobReq) GetDatasetId() string {
+	if x != nil {
+		return x.DatasetId
+	}
+	return ""
+}
+
+func (x *CreateAppEvalJobReq) GetAppId() string {
+	if x != nil {
+		return x.AppId
+	}
+	return ""
+}
+
+func (x *CreateAppEvalJobReq) GetAppType() string {
+	if x != nil {
+		return x.AppType
+	}
+	return ""
+}
+
+func (x *CreateAppEvalJobReq) GetJudgeModelId() string {
+	if x != nil {
+		return x.JudgeModelId
+	}
+	return ""
+}
+
+func (x *CreateAppEvalJobReq) GetBaselineJobId() string {
+	if x != nil {
+		return x.BaselineJobId
+	}
+	return ""
+}
+
+func (x *CreateAppEvalJobReq) GetTolerance() float64 {
+	if x != nil {
+		return x.Tolerance
+	}
+	return 0
+}
+
+func (x *CreateAppEvalJobReq) GetMinScore() float64 {
+	if x != nil {
+		return x.MinScore
+	}
+	return 0
+}
+
+func (x *CreateAppEvalJobReq) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *CreateAppEvalJobReq) GetOrgId() string {
+	if x != nil {
+		return x.OrgId
+	}
+	return ""
+}
+
+type AppEvalJobReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	JobId  string `protobuf:"bytes,1,opt,name=jobId,proto3" json:"jobId,omitempty"`
+	UserId string `protobuf:"bytes,2,opt,name=userId,proto3" json:"userId,omitempty"`
+	OrgId  string `protobuf:"bytes,3,opt,name=orgId,proto3" json:"orgId,omitempty"`
+}
+
+func (x *AppEvalJobReq) Reset() {
+	*x = AppEvalJobReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_app_service_app_service_proto_msgTypes[35]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AppEvalJobReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AppEvalJobReq) ProtoMessage() {}
+
+func (x *AppEvalJobReq) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_app_service_app_service_proto_msgTypes[35]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AppEvalJobReq.ProtoReflect.Descriptor instead.
+func (*AppEvalJobReq) Descriptor() ([]byte, []int) {
+	return file_proto_app_service_app_service_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *AppEvalJobReq) GetJobId() string {
+	if x != nil {
+		return x.JobId
+	}
+	return ""
+}
+
+func (x *AppEvalJobReq) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *AppEvalJobReq) GetOrgId() string {
+	if x != nil {
+		return x.OrgId
+	}
+	return ""
+}
+
+type AppEvalJobInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	JobId         string         `protobuf:"bytes,1,opt,name=jobId,proto3" json:"jobId,omitempty"`
+	DatasetId     string         `protobuf:"bytes,2,opt,name=datasetId,proto3" json:"datasetId,omitempty"`
+	AppId         string         `protobuf:"bytes,3,opt,name=appId,proto3" json:"appId,omitempty"`
+	AppType       string         `protobuf:"bytes,4,opt,name=appType,proto3" json:"appType,omitempty"`
+	JudgeModelId  string         `protobuf:"bytes,5,opt,name=judgeModelId,proto3" json:"judgeModelId,omitempty"`
+	BaselineJobId string         `protobuf:"bytes,6,opt,name=baselineJobId,proto3" json:"baselineJobId,omitempty"`
+	Tolerance     float64        `protobuf:"fixed64,7,opt,name=tolerance,proto3" json:"tolerance,omitempty"`
+	MinScore      float64        `protobuf:"fixed64,8,opt,name=minScore,proto3" json:"minScore,omitempty"`
+	Status        int32          `protobuf:"varint,9,opt,name=status,proto3" json:"status,omitempty"` // 0.评测中 1.成功 2.失败
+	TotalCount    int32          `protobuf:"varint,10,opt,name=totalCount,proto3" json:"totalCount,omitempty"`
+	FinishCount   int32          `protobuf:"varint,11,opt,name=finishCount,proto3" json:"finishCount,omitempty"`
+	ErrorCount    int32          `protobuf:"varint,12,opt,name=errorCount,proto3" json:"errorCount,omitempty"` // 回答或评分失败的问题数
+	Scores        *AppEvalScores `protobuf:"bytes,13,opt,name=scores,proto3" json:"scores,omitempty"`          // 评分成功问题的平均分
+	GateStatus    int32          `protobuf:"varint,14,opt,name=gateStatus,proto3" json:"gateStatus,omitempty"` // 发布门禁：0.未设置 1.通过 2.未通过
+	GateReason    string         `protobuf:"bytes,15,opt,name=gateReason,proto3" json:"gateReason,omitempty"`  // 门禁未通过原因
+	ErrorMsg      string         `protobuf:"bytes,16,opt,name=errorMsg,proto3" json:"errorMsg,omitempty"`
+	CreatedAt     int64          `protobuf:"varint,17,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
+	UpdatedAt     int64          `protobuf:"varint,18,opt,name=updatedAt,proto3" json:"updatedAt,omitempty"`
+	UserId        string         `protobuf:"bytes,19,opt,name=userId,proto3" json:"userId,omitempty"`
+	OrgId         string         `protobuf:"bytes,20,opt,name=orgId,proto3" json:"orgId,omitempty"`
+}
+
+func (x *AppEvalJobInfo) Reset() {
+	*x = AppEvalJobInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_app_service_app_service_proto_msgTypes[36]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AppEvalJobInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AppEvalJobInfo) ProtoMessage() {}
+
+func (x *AppEvalJobInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_app_service_app_service_proto_msgTypes[36]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AppEvalJobInfo.ProtoReflect.Descriptor instead.
+func (*AppEvalJobInfo) Descriptor() ([]byte, []int) {
+	return file_proto_app_service_app_service_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *AppEvalJobInfo) GetJobId() string {
+	if x != nil {
+		return x.JobId
+	}
+	return ""
+}
+
+func (x *AppEvalJobInfo) GetDatasetId() string {
+	if x != nil {
+		return x.DatasetId
+	}
+	return ""
+}
+
+func (x *AppEvalJobInfo) GetAppId() string {
+	if x != nil {
+		return x.AppId
+	}
+	return ""
+}
+
+func (x *AppEvalJobInfo) GetAppType() string {
+	if x != nil {
+		return x.AppType
+	}
+	return ""
+}
+
+func (x *AppEvalJobInfo) GetJudgeModelId() string {
+	if x != nil {
+		return x.JudgeModelId
+	}
+	return ""
+}
+
+func (x *AppEvalJobInfo) GetBaselineJobId() string {
+	if x != nil {
+		return x.BaselineJobId
+	}
+	return ""
+}
+
+func (x *AppEvalJobInfo) GetTolerance() float64 {
+	if x != nil {
+		return x.Tolerance
+	}
+	return 0
+}
+
+func (x *AppEvalJobInfo) GetMinScore() float64 {
+	if x != nil {
+		return x.MinScore
+	}
+	return 0
+}
+
+func (x *AppEvalJobInfo) GetStatus() int32 {
+	if x != nil {
+		return x.Status
+	}
+	return 0
+}
+
+func (x *AppEvalJobInfo) GetTotalCount() int32 {
+	if x != nil {
+		return x.TotalCount
+	}
+	return 0
+}
+
+func (x *AppEvalJobInfo) GetFinishCount() int32 {
+	if x != nil {
+		return x.FinishCount
+	}
+	return 0
+}
+
+func (x *AppEvalJobInfo) GetErrorCount() int32 {
+	if x != nil {
+		return x.ErrorCount
+	}
+	return 0
+}
+
+func (x *AppEvalJobInfo) GetScores() *AppEvalScores {
+	if x != nil {
+		return x.Scores
+	}
+	return nil
+}
+
+func (x *AppEvalJobInfo) GetGateStatus() int32 {
+	if x != nil {
+		return x.GateStatus
+	}
+	return 0
+}
+
+func (x *AppEvalJobInfo) GetGateReason() string {
+	if x != nil {
+		return x.GateReason
+	}
+	return ""
+}
+
+func (x *AppEvalJobInfo) GetErrorMsg() string {
+	if x != nil {
+		return x.ErrorMsg
+	}
+	return ""
+}
+
+func (x *AppEvalJobInfo) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+func (x *AppEvalJobInfo) GetUpdatedAt() int64 {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return 0
+}
+
+func (x *AppEvalJobInfo) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *AppEvalJobInfo) GetOrgId() string {
+	if x != nil {
+		return x.OrgId
+	}
+	return ""
+}
+
+type AppEvalResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	QuestionId      string         `protobuf:"bytes,1,opt,name=questionId,proto3" json:"questionId,omitempty"`
+	Question        string         `protobuf:"bytes,2,opt,name=question,proto3" json:"question,omitempty"`
+	ReferenceAnswer string         `protobuf:"bytes,3,opt,name=referenceAnswer,proto3" json:"referenceAnswer,omitempty"`
+	Answer          string         `protobuf:"bytes,4,opt,name=answer,proto3" json:"answer,omitempty"`
+	SearchList      string         `protobuf:"bytes,5,opt,name=searchList,proto3" json:"searchList,omitempty"` // 回答引用的检索结果，json格式
+	Scores          *AppEvalScores `protobuf:"bytes,6,opt,name=scores,proto3" json:"scores,omitempty"`
+	Reason          string         `protobuf:"bytes,7,opt,name=reason,proto3" json:"reason,omitempty"`        // 评分理由
+	ErrorMsg        string         `protobuf:"bytes,8,opt,name=errorMsg,proto3" json:"errorMsg,omitempty"`    // 回答或评分失败原因
+	LatencyMs       int64          `protobuf:"varint,9,opt,name=latencyMs,proto3" json:"latencyMs,omitempty"` // 回答耗时
+}
+
+func (x *AppEvalResult) Reset() {
+	*x = AppEvalResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_app_service_app_service_proto_msgTypes[37]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AppEvalResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AppEvalResult) ProtoMessage() {}
+
+func (x *AppEvalResult) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_app_service_app_service_proto_msgTypes[37]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AppEvalResult.ProtoReflect.Descriptor instead.
+func (*AppEvalResult) Descriptor() ([]byte, []int) {
+	return file_proto_app_service_app_service_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *AppEvalResult) GetQuestionId() string {
+	if x != nil {
+		return x.QuestionId
+	}
+	return ""
+}
+
+func (x *AppEvalResult) GetQuestion() string {
+	if x != nil {
+		return x.Question
+	}
+	return ""
+}
+
+func (x *AppEvalResult) GetReferenceAnswer() string {
+	if x != nil {
+		return x.ReferenceAnswer
+	}
+	return ""
+}
+
+func (x *AppEvalResult) GetAnswer() string {
+	if x != nil {
+		return x.Answer
+	}
+	return ""
+}
+
+func (x *AppEvalResult) GetSearchList() string {
+	if x != nil {
+		return x.SearchList
+	}
+	return ""
+}
+
+func (x *AppEvalResult) GetScores() *AppEvalScores {
+	if x != nil {
+		return x.Scores
+	}
+	return nil
+}
+
+func (x *AppEvalResult) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *AppEvalResult) GetErrorMsg() string {
+	if x != nil {
+		return x.ErrorMsg
+	}
+	return ""
+}
+
+func (x *AppEvalResult) GetLatencyMs() int64 {
+	if x != nil {
+		return x.LatencyMs
+	}
+	return 0
+}
+
+type ClaimAppEvalJobReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RunnerId     string `protobuf:"bytes,1,opt,name=runnerId,proto3" json:"runnerId,omitempty"`          // 执行者id
+	LeaseSeconds int64  `protobuf:"varint,2,opt,name=leaseSeconds,proto3" json:"leaseSeconds,omitempty"` // 租约时长，执行者须在租约过期前续约
+}
+
+func (x *ClaimAppEvalJobReq) Reset() {
+	*x = ClaimAppEvalJobReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_app_service_app_service_proto_msgTypes[38]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ClaimAppEvalJobReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClaimAppEvalJobReq) ProtoMessage() {}
+
+func (x *ClaimAppEvalJobReq) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_app_service_app_service_proto_msgTypes[38]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClaimAppEvalJobReq.ProtoReflect.Descriptor instead.
+func (*ClaimAppEvalJobReq) Descriptor() ([]byte, []int) {
+	return file_proto_app_service_app_service_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *ClaimAppEvalJobReq) GetRunnerId() string {
+	if x != nil {
+		return x.RunnerId
+	}
+	return ""
+}
+
+func (x *ClaimAppEvalJobReq) GetLeaseSeconds() int64 {
+	if x != nil {
+		return x.LeaseSeconds
+	}
+	return 0
+}
+
+type ClaimAppEvalJobResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Job       *AppEvalJobInfo    `protobuf:"bytes,1,opt,name=job,proto3" json:"job,omitempty"`             // 为空表示没有可领取的任务
+	Questions []*AppEvalQuestion `protobuf:"bytes,2,rep,name=questions,proto3" json:"questions,omitempty"` // 尚未保存评测结果的问题
+}
+
+func (x *ClaimAppEvalJobResp) Reset() {
+	*x = ClaimAppEvalJobResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_app_service_app_service_proto_msgTypes[39]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ClaimAppEvalJobResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClaimAppEvalJobResp) ProtoMessage() {}
+
+func (x *ClaimAppEvalJobResp) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_app_service_app_service_proto_msgTypes[39]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClaimAppEvalJobResp.ProtoReflect.Descriptor instead.
+func (*ClaimAppEvalJobResp) Descriptor() ([]byte, []int) {
+	return file_proto_app_service_app_service_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *ClaimAppEvalJobResp) GetJob() *AppEvalJobInfo {
+	if x != nil {
+		return x.Job
+	}
+	return nil
+}
+
+func (x *ClaimAppEvalJobResp) GetQuestions() []*AppEvalQuestion {
+	if x != nil {
+		return x.Questions
+	}
+	return nil
+}
+
+type RenewAppEvalJobReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	JobId        string `protobuf:"bytes,1,opt,name=jobId,proto3" json:"jobId,omitempty"`
+	RunnerId     string `protobuf:"bytes,2,opt,name=runnerId,proto3" json:"runnerId,omitempty"`
+	LeaseSeconds int64  `protobuf:"varint,3,opt,name=leaseSeconds,proto3" json:"leaseSeconds,omitempty"`
+}
+
+func (x *RenewAppEvalJobReq) Reset() {
+	*x = RenewAppEvalJobReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_app_service_app_service_proto_msgTypes[40]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RenewAppEvalJobReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RenewAppEvalJobReq) ProtoMessage() {}
+
+func (x *RenewAppEvalJobReq) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_app_service_app_service_proto_msgTypes[40]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RenewAppEvalJobReq.ProtoReflect.Descriptor instead.
+func (*RenewAppEvalJobReq) Descriptor() ([]byte, []int) {
+	return file_proto_app_service_app_service_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *RenewAppEvalJobReq) GetJobId() string {
+	if x != nil {
+		return x.JobId
+	}
+	return ""
+}
+
+func (x *RenewAppEvalJobReq) GetRunnerId() string {
+	if x != nil {
+		return x.RunnerId
+	}
+	return ""
+}
+
+func (x *RenewAppEvalJobReq) GetLeaseSeconds() int64 {
+	if x != nil {
+		return x.LeaseSeconds
+	}
+	return 0
+}
+
+type SaveAppEvalResultReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	JobId    string         `protobuf:"bytes,1,opt,name=jobId,proto3" json:"jobId,omitempty"`
+	Result   *AppEvalResult `protobuf:"bytes,2,opt,name=result,proto3" json:"result,omitempty"`
+	RunnerId string         `protobuf:"bytes,3,opt,name=runnerId,proto3" json:"runnerId,omitempty"` // 须为当前持有租约的执行者
+}
+
+func (x *SaveAppEvalResultReq) Reset() {
+	*x = SaveAppEvalResultReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_app_service_app_service_proto_msgTypes[41]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SaveAppEvalResultReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SaveAppEvalResultReq) ProtoMessage() {}
+
+func (x *SaveAppEvalResultReq) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_app_service_app_service_proto_msgTypes[41]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SaveAppEvalResultReq.ProtoReflect.Descriptor instead.
+func (*SaveAppEvalResultReq) Descriptor() ([]byte, []int) {
+	return file_proto_app_service_app_service_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *SaveAppEvalResultReq) GetJobId() string {
+	if x != nil {
+		return x.JobId
+	}
+	return ""
+}
+
+func (x *SaveAppEvalResultReq) GetResult() *AppEvalResult {
+	if x != nil {
+		return x.Result
+	}
+	return nil
+}
+
+func (x *SaveAppEvalResultReq) GetRunnerId() string {
+	if x != nil {
+		return x.RunnerId
+	}
+	return ""
+}
+
+type FinishAppEvalJobReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	JobId    string `protobuf:"bytes,1,opt,name=jobId,proto3" json:"jobId,omitempty"`
+	ErrorMsg string `protobuf:"bytes,2,opt,name=errorMsg,proto3" json:"errorMsg,omitempty"` // 不为空时评测任务失败
+	RunnerId string `protobuf:"bytes,3,opt,name=runnerId,proto3" json:"runnerId,omitempty"` // 须为当前持有租约的执行者
+}
+
+func (x *FinishAppEvalJobReq) Reset() {
+	*x = FinishAppEvalJobReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_app_service_app_service_proto_msgTypes[42]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FinishAppEvalJobReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FinishAppEvalJobReq) ProtoMessage() {}
+
+func (x *FinishAppEvalJobReq) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_app_service_app_service_proto_msgTypes[42]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FinishAppEvalJobReq.ProtoReflect.Descriptor instead.
+func (*FinishAppEvalJobReq) Descriptor() ([]byte, []int) {
+	return file_proto_app_service_app_service_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *FinishAppEvalJobReq) GetJobId() string {
+	if x != nil {
+		return x.JobId
+	}
+	return ""
+}
+
+func (x *FinishAppEvalJobReq) GetErrorMsg() string {
+	if x != nil {
+		return x.ErrorMsg
+	}
+	return ""
+}
+
+func (x *FinishAppEvalJobReq) GetRunnerId() string {
+	if x != nil {
+		return x.RunnerId
+	}
+	return ""
+}
+
+type GetAppEvalJobListReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	DatasetId string `protobuf:"bytes,1,opt,name=datasetId,proto3" json:"datasetId,omitempty"`
+	AppId     string `protobuf:"bytes,2,opt,name=appId,proto3" json:"appId,omitempty"`
+	UserId    string `protobuf:"bytes,3,opt,name=userId,proto3" json:"userId,omitempty"`
+	OrgId     string `protobuf:"bytes,4,opt,name=orgId,proto3" json:"orgId,omitempty"`
+}
+
+func (x *GetAppEvalJobListReq) Reset() {
+	*x = GetAppEvalJobListReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_app_service_app_service_proto_msgTypes[43]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetAppEvalJobListReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAppEvalJobListReq) ProtoMessage() {}
+
+func (x *GetAppEvalJobListReq) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_app_service_app_service_proto_msgTypes[43]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAppEvalJobListReq.ProtoReflect.Descriptor instead.
+func (*GetAppEvalJobListReq) Descriptor() ([]byte, []int) {
+	return file_proto_app_service_app_service_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *GetAppEvalJobListReq) GetDatasetId() string {
+	if x != nil {
+		return x.DatasetId
+	}
+	return ""
+}
+
+func (x *GetAppEvalJobListReq) GetAppId() string {
+	if x != nil {
+		return x.AppId
+	}
+	return ""
+}
+
+func (x *GetAppEvalJobListReq) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *GetAppEvalJobListReq) GetOrgId() string {
+	if x != nil {
+		return x.OrgId
+	}
+	return ""
+}
+
+type AppEvalJobList struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Jobs  []*AppEvalJobInfo `protobuf:"bytes,1,rep,name=jobs,proto3" json:"jobs,omitempty"`
+	Total int64             `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+}
+
+func (x *AppEvalJobList) Reset() {
+	*x = AppEvalJobList{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_app_service_app_service_proto_msgTypes[44]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AppEvalJobList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AppEvalJobList) ProtoMessage() {}
+
+func (x *AppEvalJobList) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_app_service_app_service_proto_msgTypes[44]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AppEvalJobList.ProtoReflect.Descriptor instead.
+func (*AppEvalJobList) Descriptor() ([]byte, []int) {
+	return file_proto_app_service_app_service_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *AppEvalJobList) GetJobs() []*AppEvalJobInfo {
+	if x != nil {
+		return x.Jobs
+	}
+	return nil
+}
+
+func (x *AppEvalJobList) GetTotal() int64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+type AppEvalJobDetail struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Job     *AppEvalJobInfo  `protobuf:"bytes,1,opt,name=job,proto3" json:"job,omitempty"`
+	Results []*AppEvalResult `protobuf:"bytes,2,rep,name=results,proto3" json:"results,omitempty"`
+}
+
+func (x *AppEvalJobDetail) Reset() {
+	*x = AppEvalJobDetail{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_app_service_app_service_proto_msgTypes[45]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AppEvalJobDetail) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AppEvalJobDetail) ProtoMessage() {}
+
+func (x *AppEvalJobDetail) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_app_service_app_service_proto_msgTypes[45]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AppEvalJobDetail.ProtoReflect.Descriptor instead.
+func (*AppEvalJobDetail) Descriptor() ([]byte, []int) {
+	return file_proto_app_service_app_service_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *AppEvalJobDetail) GetJob() *AppEvalJobInfo {
+	if x != nil {
+		return x.Job
+	}
+	return nil
+}
+
+func (x *AppEvalJobDetail) GetResults() []*AppEvalResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+var File_proto_app_service_app_service_proto protoreflect.FileDescriptor
+
+var file_proto_app_service_app_service_proto_rawDesc = []byte{
+	0x0a, 0x23, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x61, 0x70, 0x70, 0x2d, 0x73, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x2f, 0x61, 0x70, 0x70, 0x2d, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0b, 0x61, 0x70, 0x70, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x1a, 0x1b, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2f, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22,
+	0xea, 0x01, 0x0a, 0x0c, 0x47, 0x65, 0x6e, 0x41, 0x70, 0x69, 0x4b, 0x65, 0x79, 0x52, 0x65, 0x71,
+	0x12, 0x14, 0x0a, 0x05, 0x61, 0x70, 0x70, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x61, 0x70, 0x70, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x70, 0x70, 0x54, 0x79, 0x70,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x70, 0x70, 0x54, 0x79, 0x70, 0x65,
+	0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x6f, 0x72, 0x67, 0x49,
+	0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6f, 0x72, 0x67, 0x49, 0x64, 0x12, 0x16,
+	0x0a, 0x06, 0x73, 0x63, 0x6f, 0x70, 0x65, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06,
+	0x73, 0x63, 0x6f, 0x70, 0x65, 0x73, 0x12, 0x26, 0x0a, 0x0e, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x65,
+	0x64, 0x4f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0e,
+	0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x4f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x73, 0x12, 0x1e,
+	0x0a, 0x0a, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x49, 0x70, 0x73, 0x18, 0x07, 0x20, 0x03,
+	0x28, 0x09, 0x52, 0x0a, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x49, 0x70, 0x73, 0x12, 0x1c,
+	0x0a, 0x09, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x41, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x09, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x41, 0x74, 0x22, 0x70, 0x0a, 0x10,
+	0x47, 0x65, 0x74, 0x41, 0x70, 0x69, 0x4b, 0x65, 0x79, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71,
+	0x12, 0x14, 0x0a, 0x05, 0x61, 0x70, 0x70, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x61, 0x70, 0x70, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x70, 0x70, 0x54, 0x79, 0x70,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x70, 0x70, 0x54, 0x79, 0x70, 0x65,
+	0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x6f, 0x72, 0x67, 0x49,
+	0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6f, 0x72, 0x67, 0x49, 0x64, 0x22, 0x53,
+	0x0a, 0x0e, 0x41, 0x70, 0x69, 0x4b, 0x65, 0x79, 0x49, 0x6e, 0x66, 0x6f, 0x4c, 0x69, 0x73, 0x74,
+	0x12, 0x2b, 0x0a, 0x04, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17,
+	0x2e, 0x61, 0x70, 0x70, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x41, 0x70, 0x69,
+	0x4b, 0x65, 0x79, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x04, 0x69, 0x6e, 0x66, 0x6f, 0x12, 0x14, 0x0a,
+	0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x74, 0x6f,
+	0x74, 0x61, 0x6c, 0x22, 0xf2, 0x02, 0x0a, 0x0a, 0x41, 0x70, 0x69, 0x4b, 0x65, 0x79, 0x49, 0x6e,
+	0x66, 0x6f, 0x12, 0x14, 0x0a, 0x05, 0x61, 0x70, 0x69, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x61, 0x70, 0x69, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x70, 0x69, 0x4b,
+	0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x70, 0x69, 0x4b, 0x65, 0x79,
+	0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x6f, 0x72, 0x67, 0x49,
+	0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6f, 0x72, 0x67, 0x49, 0x64, 0x12, 0x14,
+	0x0a, 0x05, 0x61, 0x70, 0x70, 0x49, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x61,
+	0x70, 0x70, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x70, 0x70, 0x54, 0x79, 0x70, 0x65, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x70, 0x70, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1c,
+	0x0a, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x1c, 0x0a, 0x09,
+	0x6b, 0x65, 0x79, 0x50, 0x72, 0x65, 0x66, 0x69, 0x78, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x09, 0x6b, 0x65, 0x79, 0x50, 0x72, 0x65, 0x66, 0x69, 0x78, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x63,
+	0x6f, 0x70, 0x65, 0x73, 0x18, 0x09, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x73, 0x63, 0x6f, 0x70,
+	0x65, 0x73, 0x12, 0x26, 0x0a, 0x0e, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x4f, 0x72, 0x69,
+	0x67, 0x69, 0x6e, 0x73, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0e, 0x61, 0x6c, 0x6c, 0x6f,
+	0x77, 0x65, 0x64, 0x4f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x61, 0x6c,
+	0x6c, 0x6f, 0x77, 0x65, 0x64, 0x49, 0x70, 0x73, 0x18, 0x0b, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0a,
+	0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x49, 0x70, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x65, 0x78,
+	0x70, 0x69, 0x72, 0x65, 0x73, 0x41, 0x74, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x65,
+	0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x41, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x6c, 0x61, 0x73, 0x74,
+	0x55, 0x73, 0x65, 0x64, 0x41, 0x74, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x6c, 0x61,
+	0x73, 0x74, 0x55, 0x73, 0x65, 0x64, 0x41, 0x74, 0x22, 0x24, 0x0a, 0x0c, 0x44, 0x65, 0x6c, 0x41,
+	0x70, 0x69, 0x4b, 0x65, 0x79, 0x52, 0x65, 0x71, 0x12, 0x14, 0x0a, 0x05, 0x61, 0x70, 0x69, 0x49,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x61, 0x70, 0x69, 0x49, 0x64, 0x22, 0x2b,
+	0x0a, 0x11, 0x47, 0x65, 0x74, 0x41, 0x70, 0x69, 0x4b, 0x65, 0x79, 0x42, 0x79, 0x4b, 0x65, 0x79,
+	0x52, 0x65, 0x71, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x70, 0x69, 0x4b, 0x65, 0x79, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x70, 0x69, 0x4b, 0x65, 0x79, 0x22, 0x67, 0x0a, 0x0f, 0x52,
+	0x6f, 0x74, 0x61, 0x74, 0x65, 0x41, 0x70, 0x69, 0x4b, 0x65, 0x79, 0x52, 0x65, 0x71, 0x12, 0x14,
+	0x0a, 0x05, 0x61, 0x70, 0x69, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x61,
+	0x70, 0x69, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x26, 0x0a, 0x0e,
+	0x6f, 0x76, 0x65, 0x72, 0x6c, 0x61, 0x70, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x0e, 0x6f, 0x76, 0x65, 0x72, 0x6c, 0x61, 0x70, 0x53, 0x65, 0x63,
+	0x6f, 0x6e, 0x64, 0x73, 0x22, 0x80, 0x01, 0x0a, 0x18, 0x47, 0x65, 0x74, 0x45, 0x78, 0x70, 0x6c,
+	0x6f, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x70, 0x70, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65,
+	0x71, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x70, 0x70, 0x54, 0x79, 0x70, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x70, 0x70, 0x54, 0x79, 0x70, 0x65, 0x12,
+	0x1e, 0x0a, 0x0a, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x54, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0a, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x54, 0x79, 0x70, 0x65, 0x12,
+	0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x22, 0x61, 0x0a, 0x12, 0x45, 0x78, 0x70, 0x6c, 0x6f,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x70, 0x70, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x35, 0x0a,
+	0x05, 0x69, 0x6e, 0x66, 0x6f, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x61,
+	0x70, 0x70, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x45, 0x78, 0x70, 0x6c, 0x6f,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x70, 0x70, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x05, 0x69,
+	0x6e, 0x66, 0x6f, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x22, 0xda, 0x01, 0x0a, 0x12, 0x45,
+	0x78, 0x70, 0x6c, 0x6f, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x70, 0x70, 0x49, 0x6e, 0x66,
+	0x6f, 0x12, 0x14, 0x0a, 0x05, 0x61, 0x70, 0x70, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x61, 0x70, 0x70, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x70, 0x70, 0x54, 0x79,
+	0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x70, 0x70, 0x54, 0x79, 0x70,
+	0x65, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12,
+	0x1c, 0x0a, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x1e, 0x0a,
+	0x0a, 0x69, 0x73, 0x46, 0x61, 0x76, 0x6f, 0x72, 0x69, 0x74, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x0a, 0x69, 0x73, 0x46, 0x61, 0x76, 0x6f, 0x72, 0x69, 0x74, 0x65, 0x12, 0x20, 0x0a,
+	0x0b, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x54, 0x79, 0x70, 0x65, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0b, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x54, 0x79, 0x70, 0x65, 0x12,
+	0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x22, 0x79, 0x0a, 0x07, 0x41, 0x70, 0x70, 0x49, 0x6e,
+	0x66, 0x6f, 0x12, 0x14, 0x0a, 0x05, 0x61, 0x70, 0x70, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x61, 0x70, 0x70, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x70, 0x70, 0x54,
+	0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x70, 0x70, 0x54, 0x79,
+	0x70, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74,
+	0x12, 0x20, 0x0a, 0x0b, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x54, 0x79, 0x70, 0x65, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x54, 0x79,
+	0x70, 0x65, 0x22, 0x9f, 0x01, 0x0a, 0x1f, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x45, 0x78, 0x70,
+	0x6c, 0x6f, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x70, 0x70, 0x46, 0x61, 0x76, 0x6f, 0x72,
+	0x69, 0x74, 0x65, 0x52, 0x65, 0x71, 0x12, 0x14, 0x0a, 0x05, 0x61, 0x70, 0x70, 0x49, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x61, 0x70, 0x70, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07,
+	0x61, 0x70, 0x70, 0x54, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61,
+	0x70, 0x70, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x69, 0x73, 0x46, 0x61, 0x76, 0x6f,
+	0x72, 0x69, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x69, 0x73, 0x46, 0x61,
+	0x76, 0x6f, 0x72, 0x69, 0x74, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x14,
+	0x0a, 0x05, 0x6f, 0x72, 0x67, 0x49, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6f,
+	0x72, 0x67, 0x49, 0x64, 0x22, 0x5d, 0x0a, 0x13, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x41, 0x70,
+	0x70, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x12, 0x16, 0x0a, 0x06, 0x75,
+	0x73, 0x65, 0x72, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65,
+	0x72, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x61, 0x70, 0x70, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x61, 0x70, 0x70, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x70, 0x70,
+	0x54, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x70, 0x70, 0x54,
+	0x79, 0x70, 0x65, 0x22, 0x8f, 0x01, 0x0a, 0x0d, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x41,
+	0x70, 0x70, 0x52, 0x65, 0x71, 0x12, 0x14, 0x0a, 0x05, 0x61, 0x70, 0x70, 0x49, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x61, 0x70, 0x70, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x61,
+	0x70, 0x70, 0x54, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x70,
+	0x70, 0x54, 0x79, 0x70, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68,
+	0x54, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x70, 0x75, 0x62, 0x6c,
+	0x69, 0x73, 0x68, 0x54, 0x79, 0x70, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49,
+	0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12,
+	0x14, 0x0a, 0x05, 0x6f, 0x72, 0x67, 0x49, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x6f, 0x72, 0x67, 0x49, 0x64, 0x22, 0x6f, 0x0a, 0x0f, 0x55, 0x6e, 0x50, 0x75, 0x62, 0x6c, 0x69,
+	0x73, 0x68, 0x41, 0x70, 0x70, 0x52, 0x65, 0x71, 0x12, 0x14, 0x0a, 0x05, 0x61, 0x70, 0x70, 0x49,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x61, 0x70, 0x70, 0x49, 0x64, 0x12, 0x18,
+	0x0a, 0x07, 0x61, 0x70, 0x70, 0x54, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x07, 0x61, 0x70, 0x70, 0x54, 0x79, 0x70, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72,
+	0x49, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64,
+	0x12, 0x14, 0x0a, 0x05, 0x6f, 0x72, 0x67, 0x49, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x6f, 0x72, 0x67, 0x49, 0x64, 0x22, 0x57, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x41, 0x70, 0x70,
+	0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x70, 0x70, 0x54, 0x79,
+	0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x70, 0x70, 0x54, 0x79, 0x70,
+	0x65, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x6f, 0x72, 0x67,
+	0x49, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6f, 0x72, 0x67, 0x49, 0x64, 0x22,
+	0x34, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x41, 0x70, 0x70, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x79, 0x49,
+	0x64, 0x73, 0x52, 0x65, 0x71, 0x12, 0x1e, 0x0a, 0x0a, 0x61, 0x70, 0x70, 0x49, 0x64, 0x73, 0x4c,
+	0x69, 0x73, 0x74, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0a, 0x61, 0x70, 0x70, 0x49, 0x64,
+	0x73, 0x4c, 0x69, 0x73, 0x74, 0x22, 0x4b, 0x0a, 0x07, 0x41, 0x70, 0x70, 0x4c, 0x69, 0x73, 0x74,
+	0x12, 0x2a, 0x0a, 0x05, 0x69, 0x6e, 0x66, 0x6f, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x14, 0x2e, 0x61, 0x70, 0x70, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x41, 0x70,
+	0x70, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x05, 0x69, 0x6e, 0x66, 0x6f, 0x73, 0x12, 0x14, 0x0a, 0x05,
 	0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x74, 0x6f, 0x74,
-	0x61, 0x6c, 0x22, 0x32, 0x0a, 0x18, 0x47, 0x65, 0x74, 0x41, 0x70, 0x70, 0x55, 0x72, 0x6c, 0x49,
-	0x6e, 0x66, 0x6f, 0x42, 0x79, 0x53, 0x75, 0x66, 0x66, 0x69, 0x78, 0x52, 0x65, 0x71, 0x12, 0x16,
-	0x0a, 0x06, 0x73, 0x75, 0x66, 0x66, 0x69, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
-	0x73, 0x75, 0x66, 0x66, 0x69, 0x78, 0x22, 0x45, 0x0a, 0x15, 0x41, 0x70, 0x70, 0x55, 0x72, 0x6c,
-	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x53, 0x77, 0x69, 0x74, 0x63, 0x68, 0x52, 0x65, 0x71, 0x12,
-	0x14, 0x0a, 0x05, 0x75, 0x72, 0x6c, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
-	0x75, 0x72, 0x6c, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x32, 0xfd, 0x0a,
-	0x0a, 0x0a, 0x41, 0x70, 0x70, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x41, 0x0a, 0x09,
-	0x47, 0x65, 0x6e, 0x41, 0x70, 0x69, 0x4b, 0x65, 0x79, 0x12, 0x19, 0x2e, 0x61, 0x70, 0x70, 0x5f,
-	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x47, 0x65, 0x6e, 0x41, 0x70, 0x69, 0x4b, 0x65,
-	0x79, 0x52, 0x65, 0x71, 0x1a, 0x17, 0x2e, 0x61, 0x70, 0x70, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69,
-	0x63, 0x65, 0x2e, 0x41, 0x70, 0x69, 0x4b, 0x65, 0x79, 0x49, 0x6e, 0x66, 0x6f, 0x22, 0x00, 0x12,
-	0x4d, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x41, 0x70, 0x69, 0x4b, 0x65, 0x79, 0x4c, 0x69, 0x73, 0x74,
-	0x12, 0x1d, 0x2e, 0x61, 0x70, 0x70, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x47,
-	0x65, 0x74, 0x41, 0x70, 0x69, 0x4b, 0x65, 0x79, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x1a,
-	0x1b, 0x2e, 0x61, 0x70, 0x70, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x41, 0x70,
-	0x69, 0x4b, 0x65, 0x79, 0x49, 0x6e, 0x66, 0x6f, 0x4c, 0x69, 0x73, 0x74, 0x22, 0x00, 0x12, 0x40,
-	0x0a, 0x09, 0x44, 0x65, 0x6c, 0x41, 0x70, 0x69, 0x4b, 0x65, 0x79, 0x12, 0x19, 0x2e, 0x61, 0x70,
-	0x70, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x44, 0x65, 0x6c, 0x41, 0x70, 0x69,
-	0x4b, 0x65, 0x79, 0x52, 0x65, 0x71, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00,
-	0x12, 0x4b, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x41, 0x70, 0x69, 0x4b, 0x65, 0x79, 0x42, 0x79, 0x4b,
-	0x65, 0x79, 0x12, 0x1e, 0x2e, 0x61, 0x70, 0x70, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
-	0x2e, 0x47, 0x65, 0x74, 0x41, 0x70, 0x69, 0x4b, 0x65, 0x79, 0x42, 0x79, 0x4b, 0x65, 0x79, 0x52,
+	0x61, 0x6c, 0x22, 0x3e, 0x0a, 0x0c, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x41, 0x70, 0x70, 0x52,
+	0x65, 0x71, 0x12, 0x14, 0x0a, 0x05, 0x61, 0x70, 0x70, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x61, 0x70, 0x70, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x70, 0x70, 0x54,
+	0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x70, 0x70, 0x54, 0x79,
+	0x70, 0x65, 0x22, 0xec, 0x03, 0x0a, 0x0a, 0x41, 0x70, 0x70, 0x55, 0x72, 0x6c, 0x49, 0x6e, 0x66,
+	0x6f, 0x12, 0x14, 0x0a, 0x05, 0x75, 0x72, 0x6c, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x75, 0x72, 0x6c, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x61, 0x70, 0x70, 0x49, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x61, 0x70, 0x70, 0x49, 0x64, 0x12, 0x18, 0x0a,
+	0x07, 0x61, 0x70, 0x70, 0x54, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x61, 0x70, 0x70, 0x54, 0x79, 0x70, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x63,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09,
+	0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x65, 0x78, 0x70,
+	0x69, 0x72, 0x65, 0x64, 0x41, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x65, 0x78,
+	0x70, 0x69, 0x72, 0x65, 0x64, 0x41, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x6f, 0x70, 0x79, 0x72,
+	0x69, 0x67, 0x68, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x6f, 0x70, 0x79,
+	0x72, 0x69, 0x67, 0x68, 0x74, 0x12, 0x28, 0x0a, 0x0f, 0x63, 0x6f, 0x70, 0x79, 0x72, 0x69, 0x67,
+	0x68, 0x74, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0f,
+	0x63, 0x6f, 0x70, 0x79, 0x72, 0x69, 0x67, 0x68, 0x74, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x12,
+	0x24, 0x0a, 0x0d, 0x70, 0x72, 0x69, 0x76, 0x61, 0x63, 0x79, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79,
+	0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x70, 0x72, 0x69, 0x76, 0x61, 0x63, 0x79, 0x50,
+	0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x30, 0x0a, 0x13, 0x70, 0x72, 0x69, 0x76, 0x61, 0x63, 0x79,
+	0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x0a, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x13, 0x70, 0x72, 0x69, 0x76, 0x61, 0x63, 0x79, 0x50, 0x6f, 0x6c, 0x69, 0x63,
+	0x79, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x64, 0x69, 0x73, 0x63, 0x6c,
+	0x61, 0x69, 0x6d, 0x65, 0x72, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x64, 0x69, 0x73,
+	0x63, 0x6c, 0x61, 0x69, 0x6d, 0x65, 0x72, 0x12, 0x2a, 0x0a, 0x10, 0x64, 0x69, 0x73, 0x63, 0x6c,
+	0x61, 0x69, 0x6d, 0x65, 0x72, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x0c, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x10, 0x64, 0x69, 0x73, 0x63, 0x6c, 0x61, 0x69, 0x6d, 0x65, 0x72, 0x45, 0x6e, 0x61,
+	0x62, 0x6c, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x75, 0x66, 0x66, 0x69, 0x78, 0x18, 0x0d, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x75, 0x66, 0x66, 0x69, 0x78, 0x12, 0x16, 0x0a, 0x06, 0x73,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x73, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x18, 0x0f, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x6f,
+	0x72, 0x67, 0x49, 0x64, 0x18, 0x10, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6f, 0x72, 0x67, 0x49,
+	0x64, 0x22, 0x4a, 0x0a, 0x0f, 0x41, 0x70, 0x70, 0x55, 0x72, 0x6c, 0x43, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x52, 0x65, 0x71, 0x12, 0x37, 0x0a, 0x0a, 0x61, 0x70, 0x70, 0x55, 0x72, 0x6c, 0x49, 0x6e,
+	0x66, 0x6f, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x61, 0x70, 0x70, 0x5f, 0x73,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x41, 0x70, 0x70, 0x55, 0x72, 0x6c, 0x49, 0x6e, 0x66,
+	0x6f, 0x52, 0x0a, 0x61, 0x70, 0x70, 0x55, 0x72, 0x6c, 0x49, 0x6e, 0x66, 0x6f, 0x22, 0x4a, 0x0a,
+	0x0f, 0x41, 0x70, 0x70, 0x55, 0x72, 0x6c, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71,
+	0x12, 0x37, 0x0a, 0x0a, 0x61, 0x70, 0x70, 0x55, 0x72, 0x6c, 0x49, 0x6e, 0x66, 0x6f, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x61, 0x70, 0x70, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x2e, 0x41, 0x70, 0x70, 0x55, 0x72, 0x6c, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0a, 0x61,
+	0x70, 0x70, 0x55, 0x72, 0x6c, 0x49, 0x6e, 0x66, 0x6f, 0x22, 0x27, 0x0a, 0x0f, 0x41, 0x70, 0x70,
+	0x55, 0x72, 0x6c, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x71, 0x12, 0x14, 0x0a, 0x05,
+	0x75, 0x72, 0x6c, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x75, 0x72, 0x6c,
+	0x49, 0x64, 0x22, 0x42, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x41, 0x70, 0x70, 0x55, 0x72, 0x6c, 0x4c,
+	0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x12, 0x14, 0x0a, 0x05, 0x61, 0x70, 0x70, 0x49, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x61, 0x70, 0x70, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07,
+	0x61, 0x70, 0x70, 0x54, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61,
+	0x70, 0x70, 0x54, 0x79, 0x70, 0x65, 0x22, 0x64, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x41, 0x70, 0x70,
+	0x55, 0x72, 0x6c, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x12, 0x39, 0x0a, 0x0b, 0x61,
+	0x70, 0x70, 0x55, 0x72, 0x6c, 0x49, 0x6e, 0x66, 0x6f, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x17, 0x2e, 0x61, 0x70, 0x70, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x41,
+	0x70, 0x70, 0x55, 0x72, 0x6c, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0b, 0x61, 0x70, 0x70, 0x55, 0x72,
+	0x6c, 0x49, 0x6e, 0x66, 0x6f, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x22, 0x32, 0x0a, 0x18,
+	0x47, 0x65, 0x74, 0x41, 0x70, 0x70, 0x55, 0x72, 0x6c, 0x49, 0x6e, 0x66, 0x6f, 0x42, 0x79, 0x53,
+	0x75, 0x66, 0x66, 0x69, 0x78, 0x52, 0x65, 0x71, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x75, 0x66, 0x66,
+	0x69, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x75, 0x66, 0x66, 0x69, 0x78,
+	0x22, 0x45, 0x0a, 0x15, 0x41, 0x70, 0x70, 0x55, 0x72, 0x6c, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x53, 0x77, 0x69, 0x74, 0x63, 0x68, 0x52, 0x65, 0x71, 0x12, 0x14, 0x0a, 0x05, 0x75, 0x72, 0x6c,
+	0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x75, 0x72, 0x6c, 0x49, 0x64, 0x12,
+	0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x77, 0x0a, 0x0f, 0x41, 0x70, 0x70, 0x45, 0x76,
+	0x61, 0x6c, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1e, 0x0a, 0x0a, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x28, 0x0a, 0x0f, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65,
+	0x6e, 0x63, 0x65, 0x41, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0f, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x41, 0x6e, 0x73, 0x77, 0x65, 0x72,
+	0x22, 0xb9, 0x01, 0x0a, 0x17, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x70, 0x70, 0x45, 0x76,
+	0x61, 0x6c, 0x44, 0x61, 0x74, 0x61, 0x73, 0x65, 0x74, 0x52, 0x65, 0x71, 0x12, 0x12, 0x0a, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x3a, 0x0a, 0x09, 0x71, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18,
+	0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x61, 0x70, 0x70, 0x5f, 0x73, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x2e, 0x41, 0x70, 0x70, 0x45, 0x76, 0x61, 0x6c, 0x51, 0x75, 0x65, 0x73, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x09, 0x71, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x16,
+	0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x6f, 0x72, 0x67, 0x49, 0x64, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6f, 0x72, 0x67, 0x49, 0x64, 0x22, 0x5f, 0x0a, 0x11,
+	0x41, 0x70, 0x70, 0x45, 0x76, 0x61, 0x6c, 0x44, 0x61, 0x74, 0x61, 0x73, 0x65, 0x74, 0x52, 0x65,
+	0x71, 0x12, 0x1c, 0x0a, 0x09, 0x64, 0x61, 0x74, 0x61, 0x73, 0x65, 0x74, 0x49, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x64, 0x61, 0x74, 0x61, 0x73, 0x65, 0x74, 0x49, 0x64, 0x12,
+	0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x6f, 0x72, 0x67, 0x49, 0x64,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6f, 0x72, 0x67, 0x49, 0x64, 0x22, 0x48, 0x0a,
+	0x18, 0x47, 0x65, 0x74, 0x41, 0x70, 0x70, 0x45, 0x76, 0x61, 0x6c, 0x44, 0x61, 0x74, 0x61, 0x73,
+	0x65, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65,
+	0x72, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49,
+	0x64, 0x12, 0x14, 0x0a, 0x05, 0x6f, 0x72, 0x67, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x6f, 0x72, 0x67, 0x49, 0x64, 0x22, 0x96, 0x02, 0x0a, 0x12, 0x41, 0x70, 0x70, 0x45,
+	0x76, 0x61, 0x6c, 0x44, 0x61, 0x74, 0x61, 0x73, 0x65, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x1c,
+	0x0a, 0x09, 0x64, 0x61, 0x74, 0x61, 0x73, 0x65, 0x74, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x64, 0x61, 0x74, 0x61, 0x73, 0x65, 0x74, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x24, 0x0a, 0x0d, 0x71, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f,
+	0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0d, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x3a, 0x0a, 0x09, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x61, 0x70,
+	0x70, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x41, 0x70, 0x70, 0x45, 0x76, 0x61,
+	0x6c, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x09, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41,
+	0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64,
+	0x41, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x6f, 0x72,
+	0x67, 0x49, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6f, 0x72, 0x67, 0x49, 0x64,
+	0x22, 0x67, 0x0a, 0x12, 0x41, 0x70, 0x70, 0x45, 0x76, 0x61, 0x6c, 0x44, 0x61, 0x74, 0x61, 0x73,
+	0x65, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x3b, 0x0a, 0x08, 0x64, 0x61, 0x74, 0x61, 0x73, 0x65,
+	0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x61, 0x70, 0x70, 0x5f, 0x73,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x41, 0x70, 0x70, 0x45, 0x76, 0x61, 0x6c, 0x44, 0x61,
+	0x74, 0x61, 0x73, 0x65, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x08, 0x64, 0x61, 0x74, 0x61, 0x73,
+	0x65, 0x74, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x22, 0x73, 0x0a, 0x0d, 0x41, 0x70, 0x70,
+	0x45, 0x76, 0x61, 0x6c, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x73, 0x12, 0x22, 0x0a, 0x0c, 0x66, 0x61,
+	0x69, 0x74, 0x68, 0x66, 0x75, 0x6c, 0x6e, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x01,
+	0x52, 0x0c, 0x66, 0x61, 0x69, 0x74, 0x68, 0x66, 0x75, 0x6c, 0x6e, 0x65, 0x73, 0x73, 0x12, 0x1c,
+	0x0a, 0x09, 0x72, 0x65, 0x6c, 0x65, 0x76, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x01, 0x52, 0x09, 0x72, 0x65, 0x6c, 0x65, 0x76, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x20, 0x0a, 0x0b,
+	0x63, 0x6f, 0x72, 0x72, 0x65, 0x63, 0x74, 0x6e, 0x65, 0x73, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x01, 0x52, 0x0b, 0x63, 0x6f, 0x72, 0x72, 0x65, 0x63, 0x74, 0x6e, 0x65, 0x73, 0x73, 0x22, 0x95,
+	0x02, 0x0a, 0x13, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x70, 0x70, 0x45, 0x76, 0x61, 0x6c,
+	0x4a, 0x6f, 0x62, 0x52, 0x65, 0x71, 0x12, 0x1c, 0x0a, 0x09, 0x64, 0x61, 0x74, 0x61, 0x73, 0x65,
+	0x74, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x64, 0x61, 0x74, 0x61, 0x73,
+	0x65, 0x74, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x61, 0x70, 0x70, 0x49, 0x64, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x61, 0x70, 0x70, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x70,
+	0x70, 0x54, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x70, 0x70,
+	0x54, 0x79, 0x70, 0x65, 0x12, 0x22, 0x0a, 0x0c, 0x6a, 0x75, 0x64, 0x67, 0x65, 0x4d, 0x6f, 0x64,
+	0x65, 0x6c, 0x49, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x6a, 0x75, 0x64, 0x67,
+	0x65, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x49, 0x64, 0x12, 0x24, 0x0a, 0x0d, 0x62, 0x61, 0x73, 0x65,
+	0x6c, 0x69, 0x6e, 0x65, 0x4a, 0x6f, 0x62, 0x49, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0d, 0x62, 0x61, 0x73, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x4a, 0x6f, 0x62, 0x49, 0x64, 0x12, 0x1c,
+	0x0a, 0x09, 0x74, 0x6f, 0x6c, 0x65, 0x72, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x01, 0x52, 0x09, 0x74, 0x6f, 0x6c, 0x65, 0x72, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x1a, 0x0a, 0x08,
+	0x6d, 0x69, 0x6e, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x01, 0x52, 0x08,
+	0x6d, 0x69, 0x6e, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72,
+	0x49, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64,
+	0x12, 0x14, 0x0a, 0x05, 0x6f, 0x72, 0x67, 0x49, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x6f, 0x72, 0x67, 0x49, 0x64, 0x22, 0x53, 0x0a, 0x0d, 0x41, 0x70, 0x70, 0x45, 0x76, 0x61,
+	0x6c, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x71, 0x12, 0x14, 0x0a, 0x05, 0x6a, 0x6f, 0x62, 0x49, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6a, 0x6f, 0x62, 0x49, 0x64, 0x12, 0x16, 0x0a,
+	0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75,
+	0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x6f, 0x72, 0x67, 0x49, 0x64, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6f, 0x72, 0x67, 0x49, 0x64, 0x22, 0xec, 0x04, 0x0a, 0x0e,
+	0x41, 0x70, 0x70, 0x45, 0x76, 0x61, 0x6c, 0x4a, 0x6f, 0x62, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x14,
+	0x0a, 0x05, 0x6a, 0x6f, 0x62, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6a,
+	0x6f, 0x62, 0x49, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x64, 0x61, 0x74, 0x61, 0x73, 0x65, 0x74, 0x49,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x64, 0x61, 0x74, 0x61, 0x73, 0x65, 0x74,
+	0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x61, 0x70, 0x70, 0x49, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x61, 0x70, 0x70, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x70, 0x70, 0x54,
+	0x79, 0x70, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x70, 0x70, 0x54, 0x79,
+	0x70, 0x65, 0x12, 0x22, 0x0a, 0x0c, 0x6a, 0x75, 0x64, 0x67, 0x65, 0x4d, 0x6f, 0x64, 0x65, 0x6c,
+	0x49, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x6a, 0x75, 0x64, 0x67, 0x65, 0x4d,
+	0x6f, 0x64, 0x65, 0x6c, 0x49, 0x64, 0x12, 0x24, 0x0a, 0x0d, 0x62, 0x61, 0x73, 0x65, 0x6c, 0x69,
+	0x6e, 0x65, 0x4a, 0x6f, 0x62, 0x49, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x62,
+	0x61, 0x73, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x4a, 0x6f, 0x62, 0x49, 0x64, 0x12, 0x1c, 0x0a, 0x09,
+	0x74, 0x6f, 0x6c, 0x65, 0x72, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x01, 0x52,
+	0x09, 0x74, 0x6f, 0x6c, 0x65, 0x72, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x6d, 0x69,
+	0x6e, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x01, 0x52, 0x08, 0x6d, 0x69,
+	0x6e, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x18, 0x09, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1e,
+	0x0a, 0x0a, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x0a, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x0a, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x20,
+	0x0a, 0x0b, 0x66, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x0b, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x0b, 0x66, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x43, 0x6f, 0x75, 0x6e, 0x74,
+	0x12, 0x1e, 0x0a, 0x0a, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x0c,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x75, 0x6e, 0x74,
+	0x12, 0x32, 0x0a, 0x06, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x73, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x61, 0x70, 0x70, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x41,
+	0x70, 0x70, 0x45, 0x76, 0x61, 0x6c, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x73, 0x52, 0x06, 0x73, 0x63,
+	0x6f, 0x72, 0x65, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x67, 0x61, 0x74, 0x65, 0x53, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x67, 0x61, 0x74, 0x65, 0x53, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x67, 0x61, 0x74, 0x65, 0x52, 0x65, 0x61, 0x73,
+	0x6f, 0x6e, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x67, 0x61, 0x74, 0x65, 0x52, 0x65,
+	0x61, 0x73, 0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x4d, 0x73, 0x67,
+	0x18, 0x10, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x4d, 0x73, 0x67,
+	0x12, 0x1c, 0x0a, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x11, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x1c,
+	0x0a, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x12, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x16, 0x0a, 0x06,
+	0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x18, 0x13, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73,
+	0x65, 0x72, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x6f, 0x72, 0x67, 0x49, 0x64, 0x18, 0x14, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x6f, 0x72, 0x67, 0x49, 0x64, 0x22, 0xb3, 0x02, 0x0a, 0x0d, 0x41,
+	0x70, 0x70, 0x45, 0x76, 0x61, 0x6c, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x1e, 0x0a, 0x0a,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0a, 0x71, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x28, 0x0a, 0x0f, 0x72, 0x65, 0x66, 0x65,
+	0x72, 0x65, 0x6e, 0x63, 0x65, 0x41, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0f, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x41, 0x6e, 0x73, 0x77,
+	0x65, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x61, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x12, 0x1e, 0x0a, 0x0a, 0x73, 0x65,
+	0x61, 0x72, 0x63, 0x68, 0x4c, 0x69, 0x73, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a,
+	0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x32, 0x0a, 0x06, 0x73, 0x63,
+	0x6f, 0x72, 0x65, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x61, 0x70, 0x70,
+	0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x41, 0x70, 0x70, 0x45, 0x76, 0x61, 0x6c,
+	0x53, 0x63, 0x6f, 0x72, 0x65, 0x73, 0x52, 0x06, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x73, 0x12, 0x16,
+	0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x4d,
+	0x73, 0x67, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x4d,
+	0x73, 0x67, 0x12, 0x1c, 0x0a, 0x09, 0x6c, 0x61, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x4d, 0x73, 0x18,
+	0x09, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x6c, 0x61, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x4d, 0x73,
+	0x22, 0x54, 0x0a, 0x12, 0x43, 0x6c, 0x61, 0x69, 0x6d, 0x41, 0x70, 0x70, 0x45, 0x76, 0x61, 0x6c,
+	0x4a, 0x6f, 0x62, 0x52, 0x65, 0x71, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x75, 0x6e, 0x6e, 0x65, 0x72,
+	0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x75, 0x6e, 0x6e, 0x65, 0x72,
+	0x49, 0x64, 0x12, 0x22, 0x0a, 0x0c, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x53, 0x65, 0x63, 0x6f, 0x6e,
+	0x64, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x53,
+	0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x22, 0x80, 0x01, 0x0a, 0x13, 0x43, 0x6c, 0x61, 0x69, 0x6d,
+	0x41, 0x70, 0x70, 0x45, 0x76, 0x61, 0x6c, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x73, 0x70, 0x12, 0x2d,
+	0x0a, 0x03, 0x6a, 0x6f, 0x62, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x61, 0x70,
+	0x70, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x41, 0x70, 0x70, 0x45, 0x76, 0x61,
+	0x6c, 0x4a, 0x6f, 0x62, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x03, 0x6a, 0x6f, 0x62, 0x12, 0x3a, 0x0a,
+	0x09, 0x71, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x1c, 0x2e, 0x61, 0x70, 0x70, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x41,
+	0x70, 0x70, 0x45, 0x76, 0x61, 0x6c, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x09,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x6a, 0x0a, 0x12, 0x52, 0x65, 0x6e,
+	0x65, 0x77, 0x41, 0x70, 0x70, 0x45, 0x76, 0x61, 0x6c, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x71, 0x12,
+	0x14, 0x0a, 0x05, 0x6a, 0x6f, 0x62, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x6a, 0x6f, 0x62, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x75, 0x6e, 0x6e, 0x65, 0x72, 0x49,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x75, 0x6e, 0x6e, 0x65, 0x72, 0x49,
+	0x64, 0x12, 0x22, 0x0a, 0x0c, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64,
+	0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x53, 0x65,
+	0x63, 0x6f, 0x6e, 0x64, 0x73, 0x22, 0x7c, 0x0a, 0x14, 0x53, 0x61, 0x76, 0x65, 0x41, 0x70, 0x70,
+	0x45, 0x76, 0x61, 0x6c, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x65, 0x71, 0x12, 0x14, 0x0a,
+	0x05, 0x6a, 0x6f, 0x62, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6a, 0x6f,
+	0x62, 0x49, 0x64, 0x12, 0x32, 0x0a, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x61, 0x70, 0x70, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x2e, 0x41, 0x70, 0x70, 0x45, 0x76, 0x61, 0x6c, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52,
+	0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x75, 0x6e, 0x6e, 0x65,
+	0x72, 0x49, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x75, 0x6e, 0x6e, 0x65,
+	0x72, 0x49, 0x64, 0x22, 0x63, 0x0a, 0x13, 0x46, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x41, 0x70, 0x70,
+	0x45, 0x76, 0x61, 0x6c, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x71, 0x12, 0x14, 0x0a, 0x05, 0x6a, 0x6f,
+	0x62, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6a, 0x6f, 0x62, 0x49, 0x64,
+	0x12, 0x1a, 0x0a, 0x08, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x4d, 0x73, 0x67, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x08, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x4d, 0x73, 0x67, 0x12, 0x1a, 0x0a, 0x08,
+	0x72, 0x75, 0x6e, 0x6e, 0x65, 0x72, 0x49, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x72, 0x75, 0x6e, 0x6e, 0x65, 0x72, 0x49, 0x64, 0x22, 0x78, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x41,
+	0x70, 0x70, 0x45, 0x76, 0x61, 0x6c, 0x4a, 0x6f, 0x62, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71,
+	0x12, 0x1c, 0x0a, 0x09, 0x64, 0x61, 0x74, 0x61, 0x73, 0x65, 0x74, 0x49, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x64, 0x61, 0x74, 0x61, 0x73, 0x65, 0x74, 0x49, 0x64, 0x12, 0x14,
+	0x0a, 0x05, 0x61, 0x70, 0x70, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x61,
+	0x70, 0x70, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05,
+	0x6f, 0x72, 0x67, 0x49, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6f, 0x72, 0x67,
+	0x49, 0x64, 0x22, 0x57, 0x0a, 0x0e, 0x41, 0x70, 0x70, 0x45, 0x76, 0x61, 0x6c, 0x4a, 0x6f, 0x62,
+	0x4c, 0x69, 0x73, 0x74, 0x12, 0x2f, 0x0a, 0x04, 0x6a, 0x6f, 0x62, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x61, 0x70, 0x70, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x2e, 0x41, 0x70, 0x70, 0x45, 0x76, 0x61, 0x6c, 0x4a, 0x6f, 0x62, 0x49, 0x6e, 0x66, 0x6f, 0x52,
+	0x04, 0x6a, 0x6f, 0x62, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x22, 0x77, 0x0a, 0x10, 0x41,
+	0x70, 0x70, 0x45, 0x76, 0x61, 0x6c, 0x4a, 0x6f, 0x62, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x12,
+	0x2d, 0x0a, 0x03, 0x6a, 0x6f, 0x62, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x61,
+	0x70, 0x70, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x41, 0x70, 0x70, 0x45, 0x76,
+	0x61, 0x6c, 0x4a, 0x6f, 0x62, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x03, 0x6a, 0x6f, 0x62, 0x12, 0x34,
+	0x0a, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x61, 0x70, 0x70, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x41, 0x70,
+	0x70, 0x45, 0x76, 0x61, 0x6c, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x07, 0x72, 0x65, 0x73,
+	0x75, 0x6c, 0x74, 0x73, 0x32, 0xfb, 0x12, 0x0a, 0x0a, 0x41, 0x70, 0x70, 0x53, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x12, 0x41, 0x0a, 0x09, 0x47, 0x65, 0x6e, 0x41, 0x70, 0x69, 0x4b, 0x65, 0x79,
+	0x12, 0x19, 0x2e, 0x61, 0x70, 0x70, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x47,
+	0x65, 0x6e, 0x41, 0x70, 0x69, 0x4b, 0x65, 0x79, 0x52, 0x65, 0x71, 0x1a, 0x17, 0x2e, 0x61, 0x70,
+	0x70, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x41, 0x70, 0x69, 0x4b, 0x65, 0x79,
+	0x49, 0x6e, 0x66, 0x6f, 0x22, 0x00, 0x12, 0x4d, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x41, 0x70, 0x69,
+	0x4b, 0x65, 0x79, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x1d, 0x2e, 0x61, 0x70, 0x70, 0x5f, 0x73, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x70, 0x69, 0x4b, 0x65, 0x79, 0x4c,
+	0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x1a, 0x1b, 0x2e, 0x61, 0x70, 0x70, 0x5f, 0x73, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x2e, 0x41, 0x70, 0x69, 0x4b, 0x65, 0x79, 0x49, 0x6e, 0x66, 0x6f, 0x4c,
+	0x69, 0x73, 0x74, 0x22, 0x00, 0x12, 0x40, 0x0a, 0x09, 0x44, 0x65, 0x6c, 0x41, 0x70, 0x69, 0x4b,
+	0x65, 0x79, 0x12, 0x19, 0x2e, 0x61, 0x70, 0x70, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x2e, 0x44, 0x65, 0x6c, 0x41, 0x70, 0x69, 0x4b, 0x65, 0x79, 0x52, 0x65, 0x71, 0x1a, 0x16, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x4b, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x41, 0x70,
+	0x69, 0x4b, 0x65, 0x79, 0x42, 0x79, 0x4b, 0x65, 0x79, 0x12, 0x1e, 0x2e, 0x61, 0x70, 0x70, 0x5f,
+	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x70, 0x69, 0x4b, 0x65,
+	0x79, 0x42, 0x79, 0x4b, 0x65, 0x79, 0x52, 0x65, 0x71, 0x1a, 0x17, 0x2e, 0x61, 0x70, 0x70, 0x5f,
+	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x41, 0x70, 0x69, 0x4b, 0x65, 0x79, 0x49, 0x6e,
+	0x66, 0x6f, 0x22, 0x00, 0x12, 0x47, 0x0a, 0x0c, 0x52, 0x6f, 0x74, 0x61, 0x74, 0x65, 0x41, 0x70,
+	0x69, 0x4b, 0x65, 0x79, 0x12, 0x1c, 0x2e, 0x61, 0x70, 0x70, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x2e, 0x52, 0x6f, 0x74, 0x61, 0x74, 0x65, 0x41, 0x70, 0x69, 0x4b, 0x65, 0x79, 0x52,
 	0x65, 0x71, 0x1a, 0x17, 0x2e, 0x61, 0x70, 0x70, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
 	0x2e, 0x41, 0x70, 0x69, 0x4b, 0x65, 0x79, 0x49, 0x6e, 0x66, 0x6f, 0x22, 0x00, 0x12, 0x61, 0x0a,
 	0x15, 0x47, 0x65, 0x74, 0x45, 0x78, 0x70, 0x6c, 0x6f, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x41,
@@ -2000,11 +3826,70 @@ var file_proto_app_service_app_service_proto_rawDesc = []byte{
 	0x22, 0x2e, 0x61, 0x70, 0x70, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x41, 0x70,
 	0x70, 0x55, 0x72, 0x6c, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x53, 0x77, 0x69, 0x74, 0x63, 0x68,
 	0x52, 0x65, 0x71, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x42, 0x31, 0x5a,
-	0x2f, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x55, 0x6e, 0x69, 0x63,
-	0x6f, 0x6d, 0x41, 0x49, 0x2f, 0x77, 0x61, 0x6e, 0x77, 0x75, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x61, 0x70, 0x70, 0x2d, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
-	0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x5f, 0x0a,
+	0x14, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x70, 0x70, 0x45, 0x76, 0x61, 0x6c, 0x44, 0x61,
+	0x74, 0x61, 0x73, 0x65, 0x74, 0x12, 0x24, 0x2e, 0x61, 0x70, 0x70, 0x5f, 0x73, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x70, 0x70, 0x45, 0x76, 0x61,
+	0x6c, 0x44, 0x61, 0x74, 0x61, 0x73, 0x65, 0x74, 0x52, 0x65, 0x71, 0x1a, 0x1f, 0x2e, 0x61, 0x70,
+	0x70, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x41, 0x70, 0x70, 0x45, 0x76, 0x61,
+	0x6c, 0x44, 0x61, 0x74, 0x61, 0x73, 0x65, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x22, 0x00, 0x12, 0x50,
+	0x0a, 0x14, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x41, 0x70, 0x70, 0x45, 0x76, 0x61, 0x6c, 0x44,
+	0x61, 0x74, 0x61, 0x73, 0x65, 0x74, 0x12, 0x1e, 0x2e, 0x61, 0x70, 0x70, 0x5f, 0x73, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x2e, 0x41, 0x70, 0x70, 0x45, 0x76, 0x61, 0x6c, 0x44, 0x61, 0x74, 0x61,
+	0x73, 0x65, 0x74, 0x52, 0x65, 0x71, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00,
+	0x12, 0x61, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x41, 0x70, 0x70, 0x45, 0x76, 0x61, 0x6c, 0x44, 0x61,
+	0x74, 0x61, 0x73, 0x65, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x25, 0x2e, 0x61, 0x70, 0x70, 0x5f,
+	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x70, 0x70, 0x45, 0x76,
+	0x61, 0x6c, 0x44, 0x61, 0x74, 0x61, 0x73, 0x65, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71,
+	0x1a, 0x1f, 0x2e, 0x61, 0x70, 0x70, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x41,
+	0x70, 0x70, 0x45, 0x76, 0x61, 0x6c, 0x44, 0x61, 0x74, 0x61, 0x73, 0x65, 0x74, 0x4c, 0x69, 0x73,
+	0x74, 0x22, 0x00, 0x12, 0x56, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x41, 0x70, 0x70, 0x45, 0x76, 0x61,
+	0x6c, 0x44, 0x61, 0x74, 0x61, 0x73, 0x65, 0x74, 0x12, 0x1e, 0x2e, 0x61, 0x70, 0x70, 0x5f, 0x73,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x41, 0x70, 0x70, 0x45, 0x76, 0x61, 0x6c, 0x44, 0x61,
+	0x74, 0x61, 0x73, 0x65, 0x74, 0x52, 0x65, 0x71, 0x1a, 0x1f, 0x2e, 0x61, 0x70, 0x70, 0x5f, 0x73,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x41, 0x70, 0x70, 0x45, 0x76, 0x61, 0x6c, 0x44, 0x61,
+	0x74, 0x61, 0x73, 0x65, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x22, 0x00, 0x12, 0x53, 0x0a, 0x10, 0x43,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x70, 0x70, 0x45, 0x76, 0x61, 0x6c, 0x4a, 0x6f, 0x62, 0x12,
+	0x20, 0x2e, 0x61, 0x70, 0x70, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x43, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x41, 0x70, 0x70, 0x45, 0x76, 0x61, 0x6c, 0x4a, 0x6f, 0x62, 0x52, 0x65,
+	0x71, 0x1a, 0x1b, 0x2e, 0x61, 0x70, 0x70, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e,
+	0x41, 0x70, 0x70, 0x45, 0x76, 0x61, 0x6c, 0x4a, 0x6f, 0x62, 0x49, 0x6e, 0x66, 0x6f, 0x22, 0x00,
+	0x12, 0x56, 0x0a, 0x0f, 0x43, 0x6c, 0x61, 0x69, 0x6d, 0x41, 0x70, 0x70, 0x45, 0x76, 0x61, 0x6c,
+	0x4a, 0x6f, 0x62, 0x12, 0x1f, 0x2e, 0x61, 0x70, 0x70, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x2e, 0x43, 0x6c, 0x61, 0x69, 0x6d, 0x41, 0x70, 0x70, 0x45, 0x76, 0x61, 0x6c, 0x4a, 0x6f,
+	0x62, 0x52, 0x65, 0x71, 0x1a, 0x20, 0x2e, 0x61, 0x70, 0x70, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x2e, 0x43, 0x6c, 0x61, 0x69, 0x6d, 0x41, 0x70, 0x70, 0x45, 0x76, 0x61, 0x6c, 0x4a,
+	0x6f, 0x62, 0x52, 0x65, 0x73, 0x70, 0x22, 0x00, 0x12, 0x4c, 0x0a, 0x0f, 0x52, 0x65, 0x6e, 0x65,
+	0x77, 0x41, 0x70, 0x70, 0x45, 0x76, 0x61, 0x6c, 0x4a, 0x6f, 0x62, 0x12, 0x1f, 0x2e, 0x61, 0x70,
+	0x70, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x52, 0x65, 0x6e, 0x65, 0x77, 0x41,
+	0x70, 0x70, 0x45, 0x76, 0x61, 0x6c, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x71, 0x1a, 0x16, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45,
+	0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x50, 0x0a, 0x11, 0x53, 0x61, 0x76, 0x65, 0x41, 0x70,
+	0x70, 0x45, 0x76, 0x61, 0x6c, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x21, 0x2e, 0x61, 0x70,
+	0x70, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x53, 0x61, 0x76, 0x65, 0x41, 0x70,
+	0x70, 0x45, 0x76, 0x61, 0x6c, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x65, 0x71, 0x1a, 0x16,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x53, 0x0a, 0x10, 0x46, 0x69, 0x6e, 0x69,
+	0x73, 0x68, 0x41, 0x70, 0x70, 0x45, 0x76, 0x61, 0x6c, 0x4a, 0x6f, 0x62, 0x12, 0x20, 0x2e, 0x61,
+	0x70, 0x70, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x46, 0x69, 0x6e, 0x69, 0x73,
+	0x68, 0x41, 0x70, 0x70, 0x45, 0x76, 0x61, 0x6c, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x71, 0x1a, 0x1b,
+	0x2e, 0x61, 0x70, 0x70, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x41, 0x70, 0x70,
+	0x45, 0x76, 0x61, 0x6c, 0x4a, 0x6f, 0x62, 0x49, 0x6e, 0x66, 0x6f, 0x22, 0x00, 0x12, 0x55, 0x0a,
+	0x11, 0x47, 0x65, 0x74, 0x41, 0x70, 0x70, 0x45, 0x76, 0x61, 0x6c, 0x4a, 0x6f, 0x62, 0x4c, 0x69,
+	0x73, 0x74, 0x12, 0x21, 0x2e, 0x61, 0x70, 0x70, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x2e, 0x47, 0x65, 0x74, 0x41, 0x70, 0x70, 0x45, 0x76, 0x61, 0x6c, 0x4a, 0x6f, 0x62, 0x4c, 0x69,
+	0x73, 0x74, 0x52, 0x65, 0x71, 0x1a, 0x1b, 0x2e, 0x61, 0x70, 0x70, 0x5f, 0x73, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x2e, 0x41, 0x70, 0x70, 0x45, 0x76, 0x61, 0x6c, 0x4a, 0x6f, 0x62, 0x4c, 0x69,
+	0x73, 0x74, 0x22, 0x00, 0x12, 0x4c, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x41, 0x70, 0x70, 0x45, 0x76,
+	0x61, 0x6c, 0x4a, 0x6f, 0x62, 0x12, 0x1a, 0x2e, 0x61, 0x70, 0x70, 0x5f, 0x73, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x2e, 0x41, 0x70, 0x70, 0x45, 0x76, 0x61, 0x6c, 0x4a, 0x6f, 0x62, 0x52, 0x65,
+	0x71, 0x1a, 0x1d, 0x2e, 0x61, 0x70, 0x70, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e,
+	0x41, 0x70, 0x70, 0x45, 0x76, 0x61, 0x6c, 0x4a, 0x6f, 0x62, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c,
+	0x22, 0x00, 0x42, 0x31, 0x5a, 0x2f, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d,
+	0x2f, 0x55, 0x6e, 0x69, 0x63, 0x6f, 0x6d, 0x41, 0x49, 0x2f, 0x77, 0x61, 0x6e, 0x77, 0x75, 0x2f,
+	0x61, 0x70, 0x69, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x61, 0x70, 0x70, 0x2d, 0x73, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -2019,7 +3904,7 @@ func file_proto_app_service_app_service_proto_rawDescGZIP() []byte {
 	return file_proto_app_service_app_service_proto_rawDescData
 }
 
-var file_proto_app_service_app_service_proto_msgTypes = make([]protoimpl.MessageInfo, 26)
+var file_proto_app_service_app_service_proto_msgTypes = make([]protoimpl.MessageInfo, 46)
 var file_proto_app_service_app_service_proto_goTypes = []interface{}{
 	(*GenApiKeyReq)(nil),                    // 0: app_service.GenApiKeyReq
 	(*GetApiKeyListReq)(nil),                // 1: app_service.GetApiKeyListReq
@@ -2027,76 +3912,131 @@ var file_proto_app_service_app_service_proto_goTypes = []interface{}{
 	(*ApiKeyInfo)(nil),                      // 3: app_service.ApiKeyInfo
 	(*DelApiKeyReq)(nil),                    // 4: app_service.DelApiKeyReq
 	(*GetApiKeyByKeyReq)(nil),               // 5: app_service.GetApiKeyByKeyReq
-	(*GetExplorationAppListReq)(nil),        // 6: app_service.GetExplorationAppListReq
-	(*ExplorationAppList)(nil),              // 7: app_service.ExplorationAppList
-	(*ExplorationAppInfo)(nil),              // 8: app_service.ExplorationAppInfo
-	(*AppInfo)(nil),                         // 9: app_service.AppInfo
-	(*ChangeExplorationAppFavoriteReq)(nil), // 10: app_service.ChangeExplorationAppFavoriteReq
-	(*RecordAppHistoryReq)(nil),             // 11: app_service.RecordAppHistoryReq
-	(*PublishAppReq)(nil),                   // 12: app_service.PublishAppReq
-	(*UnPublishAppReq)(nil),                 // 13: app_service.UnPublishAppReq
-	(*GetAppListReq)(nil),                   // 14: app_service.GetAppListReq
-	(*GetAppListByIdsReq)(nil),              // 15: app_service.GetAppListByIdsReq
-	(*AppList)(nil),                         // 16: app_service.AppList
-	(*DeleteAppReq)(nil),                    // 17: app_service.DeleteAppReq
-	(*AppUrlInfo)(nil),                      // 18: app_service.AppUrlInfo
-	(*AppUrlCreateReq)(nil),                 // 19: app_service.AppUrlCreateReq
-	(*AppUrlUpdateReq)(nil),                 // 20: app_service.AppUrlUpdateReq
-	(*AppUrlDeleteReq)(nil),                 // 21: app_service.AppUrlDeleteReq
-	(*GetAppUrlListReq)(nil),                // 22: app_service.GetAppUrlListReq
-	(*GetAppUrlListResp)(nil),               // 23: app_service.GetAppUrlListResp
-	(*GetAppUrlInfoBySuffixReq)(nil),        // 24: app_service.GetAppUrlInfoBySuffixReq
-	(*AppUrlStatusSwitchReq)(nil),           // 25: app_service.AppUrlStatusSwitchReq
-	(*emptypb.Empty)(nil),                   // 26: google.protobuf.Empty
+	(*RotateApiKeyReq)(nil),                 // 6: app_service.RotateApiKeyReq
+	(*GetExplorationAppListReq)(nil),        // 7: app_service.GetExplorationAppListReq
+	(*ExplorationAppList)(nil),              // 8: app_service.ExplorationAppList
+	(*ExplorationAppInfo)(nil),              // 9: app_service.ExplorationAppInfo
+	(*AppInfo)(nil),                         // 10: app_service.AppInfo
+	(*ChangeExplorationAppFavoriteReq)(nil), // 11: app_service.ChangeExplorationAppFavoriteReq
+	(*RecordAppHistoryReq)(nil),             // 12: app_service.RecordAppHistoryReq
+	(*PublishAppReq)(nil),                   // 13: app_service.PublishAppReq
+	(*UnPublishAppReq)(nil),                 // 14: app_service.UnPublishAppReq
+	(*GetAppListReq)(nil),                   // 15: app_service.GetAppListReq
+	(*GetAppListByIdsReq)(nil),              // 16: app_service.GetAppListByIdsReq
+	(*AppList)(nil),                         // 17: app_service.AppList
+	(*DeleteAppReq)(nil),                    // 18: app_service.DeleteAppReq
+	(*AppUrlInfo)(nil),                      // 19: app_service.AppUrlInfo
+	(*AppUrlCreateReq)(nil),                 // 20: app_service.AppUrlCreateReq
+	(*AppUrlUpdateReq)(nil),                 // 21: app_service.AppUrlUpdateReq
+	(*AppUrlDeleteReq)(nil),                 // 22: app_service.AppUrlDeleteReq
+	(*GetAppUrlListReq)(nil),                // 23: app_service.GetAppUrlListReq
+	(*GetAppUrlListResp)(nil),               // 24: app_service.GetAppUrlListResp
+	(*GetAppUrlInfoBySuffixReq)(nil),        // 25: app_service.GetAppUrlInfoBySuffixReq
+	(*AppUrlStatusSwitchReq)(nil),           // 26: app_service.AppUrlStatusSwitchReq
+	(*AppEvalQuestion)(nil),                 // 27: app_service.AppEvalQuestion
+	(*CreateAppEvalDatasetReq)(nil),         // 28: app_service.CreateAppEvalDatasetReq
+	(*AppEvalDatasetReq)(nil),               // 29: app_service.AppEvalDatasetReq
+	(*GetAppEvalDatasetListReq)(nil),        // 30: app_service.GetAppEvalDatasetListReq
+	(*AppEvalDatasetInfo)(nil),              // 31: app_service.AppEvalDatasetInfo
+	(*AppEvalDatasetList)(nil),              // 32: app_service.AppEvalDatasetList
+	(*AppEvalScores)(nil),                   // 33: app_service.AppEvalScores
+	(*CreateAppEvalJobReq)(nil),             // 34: app_service.CreateAppEvalJobReq
+	(*AppEvalJobReq)(nil),                   // 35: app_service.AppEvalJobReq
+	(*AppEvalJobInfo)(nil),                  // 36: app_service.AppEvalJobInfo
+	(*AppEvalResult)(nil),                   // 37: app_service.AppEvalResult
+	(*ClaimAppEvalJobReq)(nil),              // 38: app_service.ClaimAppEvalJobReq
+	(*ClaimAppEvalJobResp)(nil),             // 39: app_service.ClaimAppEvalJobResp
+	(*RenewAppEvalJobReq)(nil),              // 40: app_service.RenewAppEvalJobReq
+	(*SaveAppEvalResultReq)(nil),            // 41: app_service.SaveAppEvalResultReq
+	(*FinishAppEvalJobReq)(nil),             // 42: app_service.FinishAppEvalJobReq
+	(*GetAppEvalJobListReq)(nil),            // 43: app_service.GetAppEvalJobListReq
+	(*AppEvalJobList)(nil),                  // 44: app_service.AppEvalJobList
+	(*AppEvalJobDetail)(nil),                // 45: app_service.AppEvalJobDetail
+	(*emptypb.Empty)(nil),                   // 46: google.protobuf.Empty
 }
 var file_proto_app_service_app_service_proto_depIdxs = []int32{
 	3,  // 0: app_service.ApiKeyInfoList.info:type_name -> app_service.ApiKeyInfo
-	8,  // 1: app_service.ExplorationAppList.infos:type_name -> app_service.ExplorationAppInfo
-	9,  // 2: app_service.AppList.infos:type_name -> app_service.AppInfo
-	18, // 3: app_service.AppUrlCreateReq.appUrlInfo:type_name -> app_service.AppUrlInfo
-	18, // 4: app_service.AppUrlUpdateReq.appUrlInfo:type_name -> app_service.AppUrlInfo
-	18, // 5: app_service.GetAppUrlListResp.appUrlInfos:type_name -> app_service.AppUrlInfo
-	0,  // 6: app_service.AppService.GenApiKey:input_type -> app_service.GenApiKeyReq
-	1,  // 7: app_service.AppService.GetApiKeyList:input_type -> app_service.GetApiKeyListReq
-	4,  // 8: app_service.AppService.DelApiKey:input_type -> app_service.DelApiKeyReq
-	5,  // 9: app_service.AppService.GetApiKeyByKey:input_type -> app_service.GetApiKeyByKeyReq
-	6,  // 10: app_service.AppService.GetExplorationAppList:input_type -> app_service.GetExplorationAppListReq
-	10, // 11: app_service.AppService.ChangeExplorationAppFavorite:input_type -> app_service.ChangeExplorationAppFavoriteReq
-	11, // 12: app_service.AppService.RecordAppHistory:input_type -> app_service.RecordAppHistoryReq
-	12, // 13: app_service.AppService.PublishApp:input_type -> app_service.PublishAppReq
-	13, // 14: app_service.AppService.UnPublishApp:input_type -> app_service.UnPublishAppReq
-	14, // 15: app_service.AppService.GetAppList:input_type -> app_service.GetAppListReq
-	15, // 16: app_service.AppService.GetAppListByIds:input_type -> app_service.GetAppListByIdsReq
-	17, // 17: app_service.AppService.DeleteApp:input_type -> app_service.DeleteAppReq
-	19, // 18: app_service.AppService.AppUrlCreate:input_type -> app_service.AppUrlCreateReq
-	21, // 19: app_service.AppService.AppUrlDelete:input_type -> app_service.AppUrlDeleteReq
-	20, // 20: app_service.AppService.AppUrlUpdate:input_type -> app_service.AppUrlUpdateReq
-	22, // 21: app_service.AppService.GetAppUrlList:input_type -> app_service.GetAppUrlListReq
-	24, // 22: app_service.AppService.GetAppUrlInfoBySuffix:input_type -> app_service.GetAppUrlInfoBySuffixReq
-	25, // 23: app_service.AppService.AppUrlStatusSwitch:input_type -> app_service.AppUrlStatusSwitchReq
-	3,  // 24: app_service.AppService.GenApiKey:output_type -> app_service.ApiKeyInfo
-	2,  // 25: app_service.AppService.GetApiKeyList:output_type -> app_service.ApiKeyInfoList
-	26, // 26: app_service.AppService.DelApiKey:output_type -> google.protobuf.Empty
-	3,  // 27: app_service.AppService.GetApiKeyByKey:output_type -> app_service.ApiKeyInfo
-	7,  // 28: app_service.AppService.GetExplorationAppList:output_type -> app_service.ExplorationAppList
-	26, // 29: app_service.AppService.ChangeExplorationAppFavorite:output_type -> google.protobuf.Empty
-	26, // 30: app_service.AppService.RecordAppHistory:output_type -> google.protobuf.Empty
-	26, // 31: app_service.AppService.PublishApp:output_type -> google.protobuf.Empty
-	26, // 32: app_service.AppService.UnPublishApp:output_type -> google.protobuf.Empty
-	16, // 33: app_service.AppService.GetAppList:output_type -> app_service.AppList
-	16, // 34: app_service.AppService.GetAppListByIds:output_type -> app_service.AppList
-	26, // 35: app_service.AppService.DeleteApp:output_type -> google.protobuf.Empty
-	26, // 36: app_service.AppService.AppUrlCreate:output_type -> google.protobuf.Empty
-	26, // 37: app_service.AppService.AppUrlDelete:output_type -> google.protobuf.Empty
-	26, // 38: app_service.AppService.AppUrlUpdate:output_type -> google.protobuf.Empty
-	23, // 39: app_service.AppService.GetAppUrlList:output_type -> app_service.GetAppUrlListResp
-	18, // 40: app_service.AppService.GetAppUrlInfoBySuffix:output_type -> app_service.AppUrlInfo
-	26, // 41: app_service.AppService.AppUrlStatusSwitch:output_type -> google.protobuf.Empty
-	24, // [24:42] is the sub-list for method output_type
-	6,  // [6:24] is the sub-list for method input_type
-	6,  // [6:6] is the sub-list for extension type_name
-	6,  // [6:6] is the sub-list for extension extendee
-	0,  // [0:6] is the sub-list for field type_name
+	9,  // 1: app_service.ExplorationAppList.infos:type_name -> app_service.ExplorationAppInfo
+	10, // 2: app_service.AppList.infos:type_name -> app_service.AppInfo
+	19, // 3: app_service.AppUrlCreateReq.appUrlInfo:type_name -> app_service.AppUrlInfo
+	19, // 4: app_service.AppUrlUpdateReq.appUrlInfo:type_name -> app_service.AppUrlInfo
+	19, // 5: app_service.GetAppUrlListResp.appUrlInfos:type_name -> app_service.AppUrlInfo
+	27, // 6: app_service.CreateAppEvalDatasetReq.questions:type_name -> app_service.AppEvalQuestion
+	27, // 7: app_service.AppEvalDatasetInfo.questions:type_name -> app_service.AppEvalQuestion
+	31, // 8: app_service.AppEvalDatasetList.datasets:type_name -> app_service.AppEvalDatasetInfo
+	33, // 9: app_service.AppEvalJobInfo.scores:type_name -> app_service.AppEvalScores
+	33, // 10: app_service.AppEvalResult.scores:type_name -> app_service.AppEvalScores
+	36, // 11: app_service.ClaimAppEvalJobResp.job:type_name -> app_service.AppEvalJobInfo
+	27, // 12: app_service.ClaimAppEvalJobResp.questions:type_name -> app_service.AppEvalQuestion
+	37, // 13: app_service.SaveAppEvalResultReq.result:type_name -> app_service.AppEvalResult
+	36, // 14: app_service.AppEvalJobList.jobs:type_name -> app_service.AppEvalJobInfo
+	36, // 15: app_service.AppEvalJobDetail.job:type_name -> app_service.AppEvalJobInfo
+	37, // 16: app_service.AppEvalJobDetail.results:type_name -> app_service.AppEvalResult
+	0,  // 17: app_service.AppService.GenApiKey:input_type -> app_service.GenApiKeyReq
+	1,  // 18: app_service.AppService.GetApiKeyList:input_type -> app_service.GetApiKeyListReq
+	4,  // 19: app_service.AppService.DelApiKey:input_type -> app_service.DelApiKeyReq
+	5,  // 20: app_service.AppService.GetApiKeyByKey:input_type -> app_service.GetApiKeyByKeyReq
+	6,  // 21: app_service.AppService.RotateApiKey:input_type -> app_service.RotateApiKeyReq
+	7,  // 22: app_service.AppService.GetExplorationAppList:input_type -> app_service.GetExplorationAppListReq
+	11, // 23: app_service.AppService.ChangeExplorationAppFavorite:input_type -> app_service.ChangeExplorationAppFavoriteReq
+	12, // 24: app_service.AppService.RecordAppHistory:input_type -> app_service.RecordAppHistoryReq
+	13, // 25: app_service.AppService.PublishApp:input_type -> app_service.PublishAppReq
+	14, // 26: app_service.AppService.UnPublishApp:input_type -> app_service.UnPublishAppReq
+	15, // 27: app_service.AppService.GetAppList:input_type -> app_service.GetAppListReq
+	16, // 28: app_service.AppService.GetAppListByIds:input_type -> app_service.GetAppListByIdsReq
+	18, // 29: app_service.AppService.DeleteApp:input_type -> app_service.DeleteAppReq
+	20, // 30: app_service.AppService.AppUrlCreate:input_type -> app_service.AppUrlCreateReq
+	22, // 31: app_service.AppService.AppUrlDelete:input_type -> app_service.AppUrlDeleteReq
+	21, // 32: app_service.AppService.AppUrlUpdate:input_type -> app_service.AppUrlUpdateReq
+	23, // 33: app_service.AppService.GetAppUrlList:input_type -> app_service.GetAppUrlListReq
+	25, // 34: app_service.AppService.GetAppUrlInfoBySuffix:input_type -> app_service.GetAppUrlInfoBySuffixReq
+	26, // 35: app_service.AppService.AppUrlStatusSwitch:input_type -> app_service.AppUrlStatusSwitchReq
+	28, // 36: app_service.AppService.CreateAppEvalDataset:input_type -> app_service.CreateAppEvalDatasetReq
+	29, // 37: app_service.AppService.DeleteAppEvalDataset:input_type -> app_service.AppEvalDatasetReq
+	30, // 38: app_service.AppService.GetAppEvalDatasetList:input_type -> app_service.GetAppEvalDatasetListReq
+	29, // 39: app_service.AppService.GetAppEvalDataset:input_type -> app_service.AppEvalDatasetReq
+	34, // 40: app_service.AppService.CreateAppEvalJob:input_type -> app_service.CreateAppEvalJobReq
+	38, // 41: app_service.AppService.ClaimAppEvalJob:input_type -> app_service.ClaimAppEvalJobReq
+	40, // 42: app_service.AppService.RenewAppEvalJob:input_type -> app_service.RenewAppEvalJobReq
+	41, // 43: app_service.AppService.SaveAppEvalResult:input_type -> app_service.SaveAppEvalResultReq
+	42, // 44: app_service.AppService.FinishAppEvalJob:input_type -> app_service.FinishAppEvalJobReq
+	43, // 45: app_service.AppService.GetAppEvalJobList:input_type -> app_service.GetAppEvalJobListReq
+	35, // 46: app_service.AppService.GetAppEvalJob:input_type -> app_service.AppEvalJobReq
+	3,  // 47: app_service.AppService.GenApiKey:output_type -> app_service.ApiKeyInfo
+	2,  // 48: app_service.AppService.GetApiKeyList:output_type -> app_service.ApiKeyInfoList
+	46, // 49: app_service.AppService.DelApiKey:output_type -> google.protobuf.Empty
+	3,  // 50: app_service.AppService.GetApiKeyByKey:output_type -> app_service.ApiKeyInfo
+	3,  // 51: app_service.AppService.RotateApiKey:output_type -> app_service.ApiKeyInfo
+	8,  // 52: app_service.AppService.GetExplorationAppList:output_type -> app_service.ExplorationAppList
+	46, // 53: app_service.AppService.ChangeExplorationAppFavorite:output_type -> google.protobuf.Empty
+	46, // 54: app_service.AppService.RecordAppHistory:output_type -> google.protobuf.Empty
+	46, // 55: app_service.AppService.PublishApp:output_type -> google.protobuf.Empty
+	46, // 56: app_service.AppService.UnPublishApp:output_type -> google.protobuf.Empty
+	17, // 57: app_service.AppService.GetAppList:output_type -> app_service.AppList
+	17, // 58: app_service.AppService.GetAppListByIds:output_type -> app_service.AppList
+	46, // 59: app_service.AppService.DeleteApp:output_type -> google.protobuf.Empty
+	46, // 60: app_service.AppService.AppUrlCreate:output_type -> google.protobuf.Empty
+	46, // 61: app_service.AppService.AppUrlDelete:output_type -> google.protobuf.Empty
+	46, // 62: app_service.AppService.AppUrlUpdate:output_type -> google.protobuf.Empty
+	24, // 63: app_service.AppService.GetAppUrlList:output_type -> app_service.GetAppUrlListResp
+	19, // 64: app_service.AppService.GetAppUrlInfoBySuffix:output_type -> app_service.AppUrlInfo
+	46, // 65: app_service.AppService.AppUrlStatusSwitch:output_type -> google.protobuf.Empty
+	31, // 66: app_service.AppService.CreateAppEvalDataset:output_type -> app_service.AppEvalDatasetInfo
+	46, // 67: app_service.AppService.DeleteAppEvalDataset:output_type -> google.protobuf.Empty
+	32, // 68: app_service.AppService.GetAppEvalDatasetList:output_type -> app_service.AppEvalDatasetList
+	31, // 69: app_service.AppService.GetAppEvalDataset:output_type -> app_service.AppEvalDatasetInfo
+	36, // 70: app_service.AppService.CreateAppEvalJob:output_type -> app_service.AppEvalJobInfo
+	39, // 71: app_service.AppService.ClaimAppEvalJob:output_type -> app_service.ClaimAppEvalJobResp
+	46, // 72: app_service.AppService.RenewAppEvalJob:output_type -> google.protobuf.Empty
+	46, // 73: app_service.AppService.SaveAppEvalResult:output_type -> google.protobuf.Empty
+	36, // 74: app_service.AppService.FinishAppEvalJob:output_type -> app_service.AppEvalJobInfo
+	44, // 75: app_service.AppService.GetAppEvalJobList:output_type -> app_service.AppEvalJobList
+	45, // 76: app_service.AppService.GetAppEvalJob:output_type -> app_service.AppEvalJobDetail
+	47, // [47:77] is the sub-list for method output_type
+	17, // [17:47] is the sub-list for method input_type
+	17, // [17:17] is the sub-list for extension type_name
+	17, // [17:17] is the sub-list for extension extendee
+	0,  // [0:17] is the sub-list for field type_name
 }
 
 func init() { file_proto_app_service_app_service_proto_init() }
@@ -2178,7 +4118,7 @@ func file_proto_app_service_app_service_proto_init() {
 			}
 		}
 		file_proto_app_service_app_service_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetExplorationAppListReq); i {
+			switch v := v.(*RotateApiKeyReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2190,7 +4130,7 @@ func file_proto_app_service_app_service_proto_init() {
 			}
 		}
 		file_proto_app_service_app_service_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ExplorationAppList); i {
+			switch v := v.(*GetExplorationAppListReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2202,7 +4142,7 @@ func file_proto_app_service_app_service_proto_init() {
 			}
 		}
 		file_proto_app_service_app_service_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ExplorationAppInfo); i {
+			switch v := v.(*ExplorationAppList); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2214,7 +4154,7 @@ func file_proto_app_service_app_service_proto_init() {
 			}
 		}
 		file_proto_app_service_app_service_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AppInfo); i {
+			switch v := v.(*ExplorationAppInfo); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2226,7 +4166,7 @@ func file_proto_app_service_app_service_proto_init() {
 			}
 		}
 		file_proto_app_service_app_service_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ChangeExplorationAppFavoriteReq); i {
+			switch v := v.(*AppInfo); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2238,7 +4178,7 @@ func file_proto_app_service_app_service_proto_init() {
 			}
 		}
 		file_proto_app_service_app_service_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RecordAppHistoryReq); i {
+			switch v := v.(*ChangeExplorationAppFavoriteReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2250,7 +4190,7 @@ func file_proto_app_service_app_service_proto_init() {
 			}
 		}
 		file_proto_app_service_app_service_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PublishAppReq); i {
+			switch v := v.(*RecordAppHistoryReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2262,7 +4202,7 @@ func file_proto_app_service_app_service_proto_init() {
 			}
 		}
 		file_proto_app_service_app_service_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UnPublishAppReq); i {
+			switch v := v.(*PublishAppReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2274,7 +4214,7 @@ func file_proto_app_service_app_service_proto_init() {
 			}
 		}
 		file_proto_app_service_app_service_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetAppListReq); i {
+			switch v := v.(*UnPublishAppReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2286,7 +4226,7 @@ func file_proto_app_service_app_service_proto_init() {
 			}
 		}
 		file_proto_app_service_app_service_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetAppListByIdsReq); i {
+			switch v := v.(*GetAppListReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2298,7 +4238,7 @@ func file_proto_app_service_app_service_proto_init() {
 			}
 		}
 		file_proto_app_service_app_service_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AppList); i {
+			switch v := v.(*GetAppListByIdsReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2310,7 +4250,7 @@ func file_proto_app_service_app_service_proto_init() {
 			}
 		}
 		file_proto_app_service_app_service_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DeleteAppReq); i {
+			switch v := v.(*AppList); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2322,7 +4262,7 @@ func file_proto_app_service_app_service_proto_init() {
 			}
 		}
 		file_proto_app_service_app_service_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AppUrlInfo); i {
+			switch v := v.(*DeleteAppReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2334,7 +4274,7 @@ func file_proto_app_service_app_service_proto_init() {
 			}
 		}
 		file_proto_app_service_app_service_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AppUrlCreateReq); i {
+			switch v := v.(*AppUrlInfo); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2346,7 +4286,7 @@ func file_proto_app_service_app_service_proto_init() {
 			}
 		}
 		file_proto_app_service_app_service_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AppUrlUpdateReq); i {
+			switch v := v.(*AppUrlCreateReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2358,7 +4298,7 @@ func file_proto_app_service_app_service_proto_init() {
 			}
 		}
 		file_proto_app_service_app_service_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AppUrlDeleteReq); i {
+			switch v := v.(*AppUrlUpdateReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2370,7 +4310,7 @@ func file_proto_app_service_app_service_proto_init() {
 			}
 		}
 		file_proto_app_service_app_service_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetAppUrlListReq); i {
+			switch v := v.(*AppUrlDeleteReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2382,7 +4322,7 @@ func file_proto_app_service_app_service_proto_init() {
 			}
 		}
 		file_proto_app_service_app_service_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetAppUrlListResp); i {
+			switch v := v.(*GetAppUrlListReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2394,7 +4334,7 @@ func file_proto_app_service_app_service_proto_init() {
 			}
 		}
 		file_proto_app_service_app_service_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetAppUrlInfoBySuffixReq); i {
+			switch v := v.(*GetAppUrlListResp); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2406,6 +4346,18 @@ func file_proto_app_service_app_service_proto_init() {
 			}
 		}
 		file_proto_app_service_app_service_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetAppUrlInfoBySuffixReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_app_service_app_service_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*AppUrlStatusSwitchReq); i {
 			case 0:
 				return &v.state
@@ -2417,6 +4369,234 @@ func file_proto_app_service_app_service_proto_init() {
 				return nil
 			}
 		}
+		file_proto_app_service_app_service_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AppEvalQuestion); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_app_service_app_service_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateAppEvalDatasetReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_app_service_app_service_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AppEvalDatasetReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_app_service_app_service_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetAppEvalDatasetListReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_app_service_app_service_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AppEvalDatasetInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_app_service_app_service_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AppEvalDatasetList); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_app_service_app_service_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AppEvalScores); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_app_service_app_service_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateAppEvalJobReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_app_service_app_service_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AppEvalJobReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_app_service_app_service_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AppEvalJobInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_app_service_app_service_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AppEvalResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_app_service_app_service_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ClaimAppEvalJobReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_app_service_app_service_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ClaimAppEvalJobResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_app_service_app_service_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RenewAppEvalJobReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_app_service_app_service_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SaveAppEvalResultReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_app_service_app_service_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FinishAppEvalJobReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_app_service_app_service_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetAppEvalJobListReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_app_service_app_service_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AppEvalJobList); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_app_service_app_service_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AppEvalJobDetail); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -2424,7 +4604,7 @@ func file_proto_app_service_app_service_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_proto_app_service_app_service_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   26,
+			NumMessages:   46,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
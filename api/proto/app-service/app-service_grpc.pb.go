@@ -24,6 +24,7 @@ const (
 	AppService_GetApiKeyList_FullMethodName                = "/app_service.AppService/GetApiKeyList"
 	AppService_DelApiKey_FullMethodName                    = "/app_service.AppService/DelApiKey"
 	AppService_GetApiKeyByKey_FullMethodName               = "/app_service.AppService/GetApiKeyByKey"
+	AppService_RotateApiKey_FullMethodName                 = "/app_service.AppService/RotateApiKey"
 	AppService_GetExplorationAppList_FullMethodName        = "/app_service.AppService/GetExplorationAppList"
 	AppService_ChangeExplorationAppFavorite_FullMethodName = "/app_service.AppService/ChangeExplorationAppFavorite"
 	AppService_RecordAppHistory_FullMethodName             = "/app_service.AppService/RecordAppHistory"
@@ -38,6 +39,17 @@ const (
 	AppService_GetAppUrlList_FullMethodName                = "/app_service.AppService/GetAppUrlList"
 	AppService_GetAppUrlInfoBySuffix_FullMethodName        = "/app_service.AppService/GetAppUrlInfoBySuffix"
 	AppService_AppUrlStatusSwitch_FullMethodName           = "/app_service.AppService/AppUrlStatusSwitch"
+	AppService_CreateAppEvalDataset_FullMethodName         = "/app_service.AppService/CreateAppEvalDataset"
+	AppService_DeleteAppEvalDataset_FullMethodName         = "/app_service.AppService/DeleteAppEvalDataset"
+	AppService_GetAppEvalDatasetList_FullMethodName        = "/app_service.AppService/GetAppEvalDatasetList"
+	AppService_GetAppEvalDataset_FullMethodName            = "/app_service.AppService/GetAppEvalDataset"
+	AppService_CreateAppEvalJob_FullMethodName             = "/app_service.AppService/CreateAppEvalJob"
+	AppService_ClaimAppEvalJob_FullMethodName              = "/app_service.AppService/ClaimAppEvalJob"
+	AppService_RenewAppEvalJob_FullMethodName              = "/app_service.AppService/RenewAppEvalJob"
+	AppService_SaveAppEvalResult_FullMethodName            = "/app_service.AppService/SaveAppEvalResult"
+	AppService_FinishAppEvalJob_FullMethodName             = "/app_service.AppService/FinishAppEvalJob"
+	AppService_GetAppEvalJobList_FullMethodName            = "/app_service.AppService/GetAppEvalJobList"
+	AppService_GetAppEvalJob_FullMethodName                = "/app_service.AppService/GetAppEvalJob"
 )
 
 // AppServiceClient is the client API for AppService service.
@@ -49,6 +61,7 @@ type AppServiceClient interface {
 	GetApiKeyList(ctx context.Context, in *GetApiKeyListReq, opts ...grpc.CallOption) (*ApiKeyInfoList, error)
 	DelApiKey(ctx context.Context, in *DelApiKeyReq, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	GetApiKeyByKey(ctx context.Context, in *GetApiKeyByKeyReq, opts ...grpc.CallOption) (*ApiKeyInfo, error)
+	RotateApiKey(ctx context.Context, in *RotateApiKeyReq, opts ...grpc.CallOption) (*ApiKeyInfo, error)
 	// --- exploration ---
 	GetExplorationAppList(ctx context.Context, in *GetExplorationAppListReq, opts ...grpc.CallOption) (*ExplorationAppList, error)
 	ChangeExplorationAppFavorite(ctx context.Context, in *ChangeExplorationAppFavoriteReq, opts ...grpc.CallOption) (*emptypb.Empty, error)
@@ -66,6 +79,18 @@ type AppServiceClient interface {
 	GetAppUrlList(ctx context.Context, in *GetAppUrlListReq, opts ...grpc.CallOption) (*GetAppUrlListResp, error)
 	GetAppUrlInfoBySuffix(ctx context.Context, in *GetAppUrlInfoBySuffixReq, opts ...grpc.CallOption) (*AppUrlInfo, error)
 	AppUrlStatusSwitch(ctx context.Context, in *AppUrlStatusSwitchReq, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// --- eval ---
+	CreateAppEvalDataset(ctx context.Context, in *CreateAppEvalDatasetReq, opts ...grpc.CallOption) (*AppEvalDatasetInfo, error)
+	DeleteAppEvalDataset(ctx context.Context, in *AppEvalDatasetReq, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	GetAppEvalDatasetList(ctx context.Context, in *GetAppEvalDatasetListReq, opts ...grpc.CallOption) (*AppEvalDatasetList, error)
+	GetAppEvalDataset(ctx context.Context, in *AppEvalDatasetReq, opts ...grpc.CallOption) (*AppEvalDatasetInfo, error)
+	CreateAppEvalJob(ctx context.Context, in *CreateAppEvalJobReq, opts ...grpc.CallOption) (*AppEvalJobInfo, error)
+	ClaimAppEvalJob(ctx context.Context, in *ClaimAppEvalJobReq, opts ...grpc.CallOption) (*ClaimAppEvalJobResp, error)
+	RenewAppEvalJob(ctx context.Context, in *RenewAppEvalJobReq, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	SaveAppEvalResult(ctx context.Context, in *SaveAppEvalResultReq, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	FinishAppEvalJob(ctx context.Context, in *FinishAppEvalJobReq, opts ...grpc.CallOption) (*AppEvalJobInfo, error)
+	GetAppEvalJobList(ctx context.Context, in *GetAppEvalJobListReq, opts ...grpc.CallOption) (*AppEvalJobList, error)
+	GetAppEvalJob(ctx context.Context, in *AppEvalJobReq, opts ...grpc.CallOption) (*AppEvalJobDetail, error)
 }
 
 type appServiceClient struct {
@@ -116,6 +141,16 @@ func (c *appServiceClient) GetApiKeyByKey(ctx context.Context, in *GetApiKeyByKe
 	return out, nil
 }
 
+func (c *appServiceClient) RotateApiKey(ctx context.Context, in *RotateApiKeyReq, opts ...grpc.CallOption) (*ApiKeyInfo, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ApiKeyInfo)
+	err := c.cc.Invoke(ctx, AppService_RotateApiKey_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *appServiceClient) GetExplorationAppList(ctx context.Context, in *GetExplorationAppListReq, opts ...grpc.CallOption) (*ExplorationAppList, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(ExplorationAppList)
@@ -256,6 +291,116 @@ func (c *appServiceClient) AppUrlStatusSwitch(ctx context.Context, in *AppUrlSta
 	return out, nil
 }
 
+func (c *appServiceClient) CreateAppEvalDataset(ctx context.Context, in *CreateAppEvalDatasetReq, opts ...grpc.CallOption) (*AppEvalDatasetInfo, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AppEvalDatasetInfo)
+	err := c.cc.Invoke(ctx, AppService_CreateAppEvalDataset_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *appServiceClient) DeleteAppEvalDataset(ctx context.Context, in *AppEvalDatasetReq, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, AppService_DeleteAppEvalDataset_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *appServiceClient) GetAppEvalDatasetList(ctx context.Context, in *GetAppEvalDatasetListReq, opts ...grpc.CallOption) (*AppEvalDatasetList, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AppEvalDatasetList)
+	err := c.cc.Invoke(ctx, AppService_GetAppEvalDatasetList_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *appServiceClient) GetAppEvalDataset(ctx context.Context, in *AppEvalDatasetReq, opts ...grpc.CallOption) (*AppEvalDatasetInfo, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AppEvalDatasetInfo)
+	err := c.cc.Invoke(ctx, AppService_GetAppEvalDataset_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *appServiceClient) CreateAppEvalJob(ctx context.Context, in *CreateAppEvalJobReq, opts ...grpc.CallOption) (*AppEvalJobInfo, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AppEvalJobInfo)
+	err := c.cc.Invoke(ctx, AppService_CreateAppEvalJob_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *appServiceClient) ClaimAppEvalJob(ctx context.Context, in *ClaimAppEvalJobReq, opts ...grpc.CallOption) (*ClaimAppEvalJobResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ClaimAppEvalJobResp)
+	err := c.cc.Invoke(ctx, AppService_ClaimAppEvalJob_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *appServiceClient) RenewAppEvalJob(ctx context.Context, in *RenewAppEvalJobReq, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, AppService_RenewAppEvalJob_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *appServiceClient) SaveAppEvalResult(ctx context.Context, in *SaveAppEvalResultReq, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, AppService_SaveAppEvalResult_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *appServiceClient) FinishAppEvalJob(ctx context.Context, in *FinishAppEvalJobReq, opts ...grpc.CallOption) (*AppEvalJobInfo, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AppEvalJobInfo)
+	err := c.cc.Invoke(ctx, AppService_FinishAppEvalJob_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *appServiceClient) GetAppEvalJobList(ctx context.Context, in *GetAppEvalJobListReq, opts ...grpc.CallOption) (*AppEvalJobList, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AppEvalJobList)
+	err := c.cc.Invoke(ctx, AppService_GetAppEvalJobList_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *appServiceClient) GetAppEvalJob(ctx context.Context, in *AppEvalJobReq, opts ...grpc.CallOption) (*AppEvalJobDetail, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AppEvalJobDetail)
+	err := c.cc.Invoke(ctx, AppService_GetAppEvalJob_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // AppServiceServer is the server API for AppService service.
 // All implementations must embed UnimplementedAppServiceServer
 // for forward compatibility.
@@ -265,6 +410,7 @@ type AppServiceServer interface {
 	GetApiKeyList(context.Context, *GetApiKeyListReq) (*ApiKeyInfoList, error)
 	DelApiKey(context.Context, *DelApiKeyReq) (*emptypb.Empty, error)
 	GetApiKeyByKey(context.Context, *GetApiKeyByKeyReq) (*ApiKeyInfo, error)
+	RotateApiKey(context.Context, *RotateApiKeyReq) (*ApiKeyInfo, error)
 	// --- exploration ---
 	GetExplorationAppList(context.Context, *GetExplorationAppListReq) (*ExplorationAppList, error)
 	ChangeExplorationAppFavorite(context.Context, *ChangeExplorationAppFavoriteReq) (*emptypb.Empty, error)
@@ -282,6 +428,18 @@ type AppServiceServer interface {
 	GetAppUrlList(context.Context, *GetAppUrlListReq) (*GetAppUrlListResp, error)
 	GetAppUrlInfoBySuffix(context.Context, *GetAppUrlInfoBySuffixReq) (*AppUrlInfo, error)
 	AppUrlStatusSwitch(context.Context, *AppUrlStatusSwitchReq) (*emptypb.Empty, error)
+	// --- eval ---
+	CreateAppEvalDataset(context.Context, *CreateAppEvalDatasetReq) (*AppEvalDatasetInfo, error)
+	DeleteAppEvalDataset(context.Context, *AppEvalDatasetReq) (*emptypb.Empty, error)
+	GetAppEvalDatasetList(context.Context, *GetAppEvalDatasetListReq) (*AppEvalDatasetList, error)
+	GetAppEvalDataset(context.Context, *AppEvalDatasetReq) (*AppEvalDatasetInfo, error)
+	CreateAppEvalJob(context.Context, *CreateAppEvalJobReq) (*AppEvalJobInfo, error)
+	ClaimAppEvalJob(context.Context, *ClaimAppEvalJobReq) (*ClaimAppEvalJobResp, error)
+	RenewAppEvalJob(context.Context, *RenewAppEvalJobReq) (*emptypb.Empty, error)
+	SaveAppEvalResult(context.Context, *SaveAppEvalResultReq) (*emptypb.Empty, error)
+	FinishAppEvalJob(context.Context, *FinishAppEvalJobReq) (*AppEvalJobInfo, error)
+	GetAppEvalJobList(context.Context, *GetAppEvalJobListReq) (*AppEvalJobList, error)
+	GetAppEvalJob(context.Context, *AppEvalJobReq) (*AppEvalJobDetail, error)
 	mustEmbedUnimplementedAppServiceServer()
 }
 
@@ -304,6 +462,9 @@ func (UnimplementedAppServiceServer) DelApiKey(context.Context, *DelApiKeyReq) (
 func (UnimplementedAppServiceServer) GetApiKeyByKey(context.Context, *GetApiKeyByKeyReq) (*ApiKeyInfo, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetApiKeyByKey not implemented")
 }
+func (UnimplementedAppServiceServer) RotateApiKey(context.Context, *RotateApiKeyReq) (*ApiKeyInfo, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RotateApiKey not implemented")
+}
 func (UnimplementedAppServiceServer) GetExplorationAppList(context.Context, *GetExplorationAppListReq) (*ExplorationAppList, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetExplorationAppList not implemented")
 }
@@ -346,6 +507,39 @@ func (UnimplementedAppServiceServer) GetAppUrlInfoBySuffix(context.Context, *Get
 func (UnimplementedAppServiceServer) AppUrlStatusSwitch(context.Context, *AppUrlStatusSwitchReq) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AppUrlStatusSwitch not implemented")
 }
+func (UnimplementedAppServiceServer) CreateAppEvalDataset(context.Context, *CreateAppEvalDatasetReq) (*AppEvalDatasetInfo, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateAppEvalDataset not implemented")
+}
+func (UnimplementedAppServiceServer) DeleteAppEvalDataset(context.Context, *AppEvalDatasetReq) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteAppEvalDataset not implemented")
+}
+func (UnimplementedAppServiceServer) GetAppEvalDatasetList(context.Context, *GetAppEvalDatasetListReq) (*AppEvalDatasetList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAppEvalDatasetList not implemented")
+}
+func (UnimplementedAppServiceServer) GetAppEvalDataset(context.Context, *AppEvalDatasetReq) (*AppEvalDatasetInfo, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAppEvalDataset not implemented")
+}
+func (UnimplementedAppServiceServer) CreateAppEvalJob(context.Context, *CreateAppEvalJobReq) (*AppEvalJobInfo, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateAppEvalJob not implemented")
+}
+func (UnimplementedAppServiceServer) ClaimAppEvalJob(context.Context, *ClaimAppEvalJobReq) (*ClaimAppEvalJobResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClaimAppEvalJob not implemented")
+}
+func (UnimplementedAppServiceServer) RenewAppEvalJob(context.Context, *RenewAppEvalJobReq) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RenewAppEvalJob not implemented")
+}
+func (UnimplementedAppServiceServer) SaveAppEvalResult(context.Context, *SaveAppEvalResultReq) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SaveAppEvalResult not implemented")
+}
+func (UnimplementedAppServiceServer) FinishAppEvalJob(context.Context, *FinishAppEvalJobReq) (*AppEvalJobInfo, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FinishAppEvalJob not implemented")
+}
+func (UnimplementedAppServiceServer) GetAppEvalJobList(context.Context, *GetAppEvalJobListReq) (*AppEvalJobList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAppEvalJobList not implemented")
+}
+func (UnimplementedAppServiceServer) GetAppEvalJob(context.Context, *AppEvalJobReq) (*AppEvalJobDetail, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAppEvalJob not implemented")
+}
 func (UnimplementedAppServiceServer) mustEmbedUnimplementedAppServiceServer() {}
 func (UnimplementedAppServiceServer) testEmbeddedByValue()                    {}
 
@@ -439,6 +633,24 @@ func _AppService_GetApiKeyByKey_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _AppService_RotateApiKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RotateApiKeyReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AppServiceServer).RotateApiKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AppService_RotateApiKey_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AppServiceServer).RotateApiKey(ctx, req.(*RotateApiKeyReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _AppService_GetExplorationAppList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetExplorationAppListReq)
 	if err := dec(in); err != nil {
@@ -691,6 +903,204 @@ func _AppService_AppUrlStatusSwitch_Handler(srv interface{}, ctx context.Context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _AppService_CreateAppEvalDataset_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateAppEvalDatasetReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AppServiceServer).CreateAppEvalDataset(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AppService_CreateAppEvalDataset_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AppServiceServer).CreateAppEvalDataset(ctx, req.(*CreateAppEvalDatasetReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AppService_DeleteAppEvalDataset_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AppEvalDatasetReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AppServiceServer).DeleteAppEvalDataset(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AppService_DeleteAppEvalDataset_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AppServiceServer).DeleteAppEvalDataset(ctx, req.(*AppEvalDatasetReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AppService_GetAppEvalDatasetList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAppEvalDatasetListReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AppServiceServer).GetAppEvalDatasetList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AppService_GetAppEvalDatasetList_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AppServiceServer).GetAppEvalDatasetList(ctx, req.(*GetAppEvalDatasetListReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AppService_GetAppEvalDataset_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AppEvalDatasetReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AppServiceServer).GetAppEvalDataset(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AppService_GetAppEvalDataset_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AppServiceServer).GetAppEvalDataset(ctx, req.(*AppEvalDatasetReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AppService_CreateAppEvalJob_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateAppEvalJobReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AppServiceServer).CreateAppEvalJob(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AppService_CreateAppEvalJob_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AppServiceServer).CreateAppEvalJob(ctx, req.(*CreateAppEvalJobReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AppService_ClaimAppEvalJob_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ClaimAppEvalJobReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AppServiceServer).ClaimAppEvalJob(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AppService_ClaimAppEvalJob_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AppServiceServer).ClaimAppEvalJob(ctx, req.(*ClaimAppEvalJobReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AppService_RenewAppEvalJob_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RenewAppEvalJobReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AppServiceServer).RenewAppEvalJob(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AppService_RenewAppEvalJob_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AppServiceServer).RenewAppEvalJob(ctx, req.(*RenewAppEvalJobReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AppService_SaveAppEvalResult_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SaveAppEvalResultReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AppServiceServer).SaveAppEvalResult(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AppService_SaveAppEvalResult_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AppServiceServer).SaveAppEvalResult(ctx, req.(*SaveAppEvalResultReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AppService_FinishAppEvalJob_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FinishAppEvalJobReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AppServiceServer).FinishAppEvalJob(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AppService_FinishAppEvalJob_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AppServiceServer).FinishAppEvalJob(ctx, req.(*FinishAppEvalJobReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AppService_GetAppEvalJobList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAppEvalJobListReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AppServiceServer).GetAppEvalJobList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AppService_GetAppEvalJobList_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AppServiceServer).GetAppEvalJobList(ctx, req.(*GetAppEvalJobListReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AppService_GetAppEvalJob_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AppEvalJobReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AppServiceServer).GetAppEvalJob(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AppService_GetAppEvalJob_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AppServiceServer).GetAppEvalJob(ctx, req.(*AppEvalJobReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // AppService_ServiceDesc is the grpc.ServiceDesc for AppService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -714,6 +1124,10 @@ var AppService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetApiKeyByKey",
 			Handler:    _AppService_GetApiKeyByKey_Handler,
 		},
+		{
+			MethodName: "RotateApiKey",
+			Handler:    _AppService_RotateApiKey_Handler,
+		},
 		{
 			MethodName: "GetExplorationAppList",
 			Handler:    _AppService_GetExplorationAppList_Handler,
@@ -770,6 +1184,50 @@ var AppService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "AppUrlStatusSwitch",
 			Handler:    _AppService_AppUrlStatusSwitch_Handler,
 		},
+		{
+			MethodName: "CreateAppEvalDataset",
+			Handler:    _AppService_CreateAppEvalDataset_Handler,
+		},
+		{
+			MethodName: "DeleteAppEvalDataset",
+			Handler:    _AppService_DeleteAppEvalDataset_Handler,
+		},
+		{
+			MethodName: "GetAppEvalDatasetList",
+			Handler:    _AppService_GetAppEvalDatasetList_Handler,
+		},
+		{
+			MethodName: "GetAppEvalDataset",
+			Handler:    _AppService_GetAppEvalDataset_Handler,
+		},
+		{
+			MethodName: "CreateAppEvalJob",
+			Handler:    _AppService_CreateAppEvalJob_Handler,
+		},
+		{
+			MethodName: "ClaimAppEvalJob",
+			Handler:    _AppService_ClaimAppEvalJob_Handler,
+		},
+		{
+			MethodName: "RenewAppEvalJob",
+			Handler:    _AppService_RenewAppEvalJob_Handler,
+		},
+		{
+			MethodName: "SaveAppEvalResult",
+			Handler:    _AppService_SaveAppEvalResult_Handler,
+		},
+		{
+			MethodName: "FinishAppEvalJob",
+			Handler:    _AppService_FinishAppEvalJob_Handler,
+		},
+		{
+			MethodName: "GetAppEvalJobList",
+			Handler:    _AppService_GetAppEvalJobList_Handler,
+		},
+		{
+			MethodName: "GetAppEvalJob",
+			Handler:    _AppService_GetAppEvalJob_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "proto/app-service/app-service.proto",
@@ -47,6 +47,14 @@ const (
 	AssistantService_GetConversationDetailList_FullMethodName               = "/assistant_service.AssistantService/GetConversationDetailList"
 	AssistantService_AssistantConversionStream_FullMethodName               = "/assistant_service.AssistantService/AssistantConversionStream"
 	AssistantService_ConversationDeleteByAssistantId_FullMethodName         = "/assistant_service.AssistantService/ConversationDeleteByAssistantId"
+	AssistantService_AssistantConversionRegenerate_FullMethodName           = "/assistant_service.AssistantService/AssistantConversionRegenerate"
+	AssistantService_AssistantConversionEdit_FullMethodName                 = "/assistant_service.AssistantService/AssistantConversionEdit"
+	AssistantService_GetConversationDetailSiblings_FullMethodName           = "/assistant_service.AssistantService/GetConversationDetailSiblings"
+	AssistantService_ConversationBranchSelect_FullMethodName                = "/assistant_service.AssistantService/ConversationBranchSelect"
+	AssistantService_ConversationFeedbackSubmit_FullMethodName              = "/assistant_service.AssistantService/ConversationFeedbackSubmit"
+	AssistantService_GetConversationFeedbackList_FullMethodName             = "/assistant_service.AssistantService/GetConversationFeedbackList"
+	AssistantService_ConversationExport_FullMethodName                      = "/assistant_service.AssistantService/ConversationExport"
+	AssistantService_ConversationImport_FullMethodName                      = "/assistant_service.AssistantService/ConversationImport"
 )
 
 // AssistantServiceClient is the client API for AssistantService service.
@@ -85,6 +93,14 @@ type AssistantServiceClient interface {
 	GetConversationDetailList(ctx context.Context, in *GetConversationDetailListReq, opts ...grpc.CallOption) (*GetConversationDetailListResp, error)
 	AssistantConversionStream(ctx context.Context, in *AssistantConversionStreamReq, opts ...grpc.CallOption) (grpc.ServerStreamingClient[AssistantConversionStreamResp], error)
 	ConversationDeleteByAssistantId(ctx context.Context, in *ConversationDeleteByAssistantIdReq, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	AssistantConversionRegenerate(ctx context.Context, in *AssistantConversionRegenerateReq, opts ...grpc.CallOption) (grpc.ServerStreamingClient[AssistantConversionStreamResp], error)
+	AssistantConversionEdit(ctx context.Context, in *AssistantConversionEditReq, opts ...grpc.CallOption) (grpc.ServerStreamingClient[AssistantConversionStreamResp], error)
+	GetConversationDetailSiblings(ctx context.Context, in *GetConversationDetailSiblingsReq, opts ...grpc.CallOption) (*GetConversationDetailSiblingsResp, error)
+	ConversationBranchSelect(ctx context.Context, in *ConversationBranchSelectReq, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	ConversationFeedbackSubmit(ctx context.Context, in *ConversationFeedbackSubmitReq, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	GetConversationFeedbackList(ctx context.Context, in *GetConversationFeedbackListReq, opts ...grpc.CallOption) (*ConversationFeedbackList, error)
+	ConversationExport(ctx context.Context, in *ConversationExportReq, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ConversationExportResp], error)
+	ConversationImport(ctx context.Context, in *ConversationImportReq, opts ...grpc.CallOption) (*ConversationImportResp, error)
 }
 
 type assistantServiceClient struct {
@@ -374,6 +390,113 @@ func (c *assistantServiceClient) ConversationDeleteByAssistantId(ctx context.Con
 	return out, nil
 }
 
+func (c *assistantServiceClient) AssistantConversionRegenerate(ctx context.Context, in *AssistantConversionRegenerateReq, opts ...grpc.CallOption) (grpc.ServerStreamingClient[AssistantConversionStreamResp], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &AssistantService_ServiceDesc.Streams[1], AssistantService_AssistantConversionRegenerate_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[AssistantConversionRegenerateReq, AssistantConversionStreamResp]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type AssistantService_AssistantConversionRegenerateClient = grpc.ServerStreamingClient[AssistantConversionStreamResp]
+
+func (c *assistantServiceClient) AssistantConversionEdit(ctx context.Context, in *AssistantConversionEditReq, opts ...grpc.CallOption) (grpc.ServerStreamingClient[AssistantConversionStreamResp], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &AssistantService_ServiceDesc.Streams[2], AssistantService_AssistantConversionEdit_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[AssistantConversionEditReq, AssistantConversionStreamResp]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type AssistantService_AssistantConversionEditClient = grpc.ServerStreamingClient[AssistantConversionStreamResp]
+
+func (c *assistantServiceClient) GetConversationDetailSiblings(ctx context.Context, in *GetConversationDetailSiblingsReq, opts ...grpc.CallOption) (*GetConversationDetailSiblingsResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetConversationDetailSiblingsResp)
+	err := c.cc.Invoke(ctx, AssistantService_GetConversationDetailSiblings_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *assistantServiceClient) ConversationBranchSelect(ctx context.Context, in *ConversationBranchSelectReq, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, AssistantService_ConversationBranchSelect_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *assistantServiceClient) ConversationFeedbackSubmit(ctx context.Context, in *ConversationFeedbackSubmitReq, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, AssistantService_ConversationFeedbackSubmit_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *assistantServiceClient) GetConversationFeedbackList(ctx context.Context, in *GetConversationFeedbackListReq, opts ...grpc.CallOption) (*ConversationFeedbackList, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ConversationFeedbackList)
+	err := c.cc.Invoke(ctx, AssistantService_GetConversationFeedbackList_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *assistantServiceClient) ConversationExport(ctx context.Context, in *ConversationExportReq, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ConversationExportResp], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &AssistantService_ServiceDesc.Streams[3], AssistantService_ConversationExport_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[ConversationExportReq, ConversationExportResp]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type AssistantService_ConversationExportClient = grpc.ServerStreamingClient[ConversationExportResp]
+
+func (c *assistantServiceClient) ConversationImport(ctx context.Context, in *ConversationImportReq, opts ...grpc.CallOption) (*ConversationImportResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ConversationImportResp)
+	err := c.cc.Invoke(ctx, AssistantService_ConversationImport_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // AssistantServiceServer is the server API for AssistantService service.
 // All implementations must embed UnimplementedAssistantServiceServer
 // for forward compatibility.
@@ -410,6 +533,14 @@ type AssistantServiceServer interface {
 	GetConversationDetailList(context.Context, *GetConversationDetailListReq) (*GetConversationDetailListResp, error)
 	AssistantConversionStream(*AssistantConversionStreamReq, grpc.ServerStreamingServer[AssistantConversionStreamResp]) error
 	ConversationDeleteByAssistantId(context.Context, *ConversationDeleteByAssistantIdReq) (*emptypb.Empty, error)
+	AssistantConversionRegenerate(*AssistantConversionRegenerateReq, grpc.ServerStreamingServer[AssistantConversionStreamResp]) error
+	AssistantConversionEdit(*AssistantConversionEditReq, grpc.ServerStreamingServer[AssistantConversionStreamResp]) error
+	GetConversationDetailSiblings(context.Context, *GetConversationDetailSiblingsReq) (*GetConversationDetailSiblingsResp, error)
+	ConversationBranchSelect(context.Context, *ConversationBranchSelectReq) (*emptypb.Empty, error)
+	ConversationFeedbackSubmit(context.Context, *ConversationFeedbackSubmitReq) (*emptypb.Empty, error)
+	GetConversationFeedbackList(context.Context, *GetConversationFeedbackListReq) (*ConversationFeedbackList, error)
+	ConversationExport(*ConversationExportReq, grpc.ServerStreamingServer[ConversationExportResp]) error
+	ConversationImport(context.Context, *ConversationImportReq) (*ConversationImportResp, error)
 	mustEmbedUnimplementedAssistantServiceServer()
 }
 
@@ -501,6 +632,30 @@ func (UnimplementedAssistantServiceServer) AssistantConversionStream(*AssistantC
 func (UnimplementedAssistantServiceServer) ConversationDeleteByAssistantId(context.Context, *ConversationDeleteByAssistantIdReq) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ConversationDeleteByAssistantId not implemented")
 }
+func (UnimplementedAssistantServiceServer) AssistantConversionRegenerate(*AssistantConversionRegenerateReq, grpc.ServerStreamingServer[AssistantConversionStreamResp]) error {
+	return status.Errorf(codes.Unimplemented, "method AssistantConversionRegenerate not implemented")
+}
+func (UnimplementedAssistantServiceServer) AssistantConversionEdit(*AssistantConversionEditReq, grpc.ServerStreamingServer[AssistantConversionStreamResp]) error {
+	return status.Errorf(codes.Unimplemented, "method AssistantConversionEdit not implemented")
+}
+func (UnimplementedAssistantServiceServer) GetConversationDetailSiblings(context.Context, *GetConversationDetailSiblingsReq) (*GetConversationDetailSiblingsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetConversationDetailSiblings not implemented")
+}
+func (UnimplementedAssistantServiceServer) ConversationBranchSelect(context.Context, *ConversationBranchSelectReq) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConversationBranchSelect not implemented")
+}
+func (UnimplementedAssistantServiceServer) ConversationFeedbackSubmit(context.Context, *ConversationFeedbackSubmitReq) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConversationFeedbackSubmit not implemented")
+}
+func (UnimplementedAssistantServiceServer) GetConversationFeedbackList(context.Context, *GetConversationFeedbackListReq) (*ConversationFeedbackList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetConversationFeedbackList not implemented")
+}
+func (UnimplementedAssistantServiceServer) ConversationExport(*ConversationExportReq, grpc.ServerStreamingServer[ConversationExportResp]) error {
+	return status.Errorf(codes.Unimplemented, "method ConversationExport not implemented")
+}
+func (UnimplementedAssistantServiceServer) ConversationImport(context.Context, *ConversationImportReq) (*ConversationImportResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConversationImport not implemented")
+}
 func (UnimplementedAssistantServiceServer) mustEmbedUnimplementedAssistantServiceServer() {}
 func (UnimplementedAssistantServiceServer) testEmbeddedByValue()                          {}
 
@@ -1001,6 +1156,129 @@ func _AssistantService_ConversationDeleteByAssistantId_Handler(srv interface{},
 	return interceptor(ctx, in, info, handler)
 }
 
+func _AssistantService_AssistantConversionRegenerate_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(AssistantConversionRegenerateReq)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(AssistantServiceServer).AssistantConversionRegenerate(m, &grpc.GenericServerStream[AssistantConversionRegenerateReq, AssistantConversionStreamResp]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type AssistantService_AssistantConversionRegenerateServer = grpc.ServerStreamingServer[AssistantConversionStreamResp]
+
+func _AssistantService_AssistantConversionEdit_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(AssistantConversionEditReq)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(AssistantServiceServer).AssistantConversionEdit(m, &grpc.GenericServerStream[AssistantConversionEditReq, AssistantConversionStreamResp]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type AssistantService_AssistantConversionEditServer = grpc.ServerStreamingServer[AssistantConversionStreamResp]
+
+func _AssistantService_GetConversationDetailSiblings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetConversationDetailSiblingsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AssistantServiceServer).GetConversationDetailSiblings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AssistantService_GetConversationDetailSiblings_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AssistantServiceServer).GetConversationDetailSiblings(ctx, req.(*GetConversationDetailSiblingsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AssistantService_ConversationBranchSelect_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ConversationBranchSelectReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AssistantServiceServer).ConversationBranchSelect(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AssistantService_ConversationBranchSelect_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AssistantServiceServer).ConversationBranchSelect(ctx, req.(*ConversationBranchSelectReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AssistantService_ConversationFeedbackSubmit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ConversationFeedbackSubmitReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AssistantServiceServer).ConversationFeedbackSubmit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AssistantService_ConversationFeedbackSubmit_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AssistantServiceServer).ConversationFeedbackSubmit(ctx, req.(*ConversationFeedbackSubmitReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AssistantService_GetConversationFeedbackList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetConversationFeedbackListReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AssistantServiceServer).GetConversationFeedbackList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AssistantService_GetConversationFeedbackList_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AssistantServiceServer).GetConversationFeedbackList(ctx, req.(*GetConversationFeedbackListReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AssistantService_ConversationExport_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ConversationExportReq)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(AssistantServiceServer).ConversationExport(m, &grpc.GenericServerStream[ConversationExportReq, ConversationExportResp]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type AssistantService_ConversationExportServer = grpc.ServerStreamingServer[ConversationExportResp]
+
+func _AssistantService_ConversationImport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ConversationImportReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AssistantServiceServer).ConversationImport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AssistantService_ConversationImport_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AssistantServiceServer).ConversationImport(ctx, req.(*ConversationImportReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // AssistantService_ServiceDesc is the grpc.ServiceDesc for AssistantService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -1112,6 +1390,26 @@ var AssistantService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ConversationDeleteByAssistantId",
 			Handler:    _AssistantService_ConversationDeleteByAssistantId_Handler,
 		},
+		{
+			MethodName: "GetConversationDetailSiblings",
+			Handler:    _AssistantService_GetConversationDetailSiblings_Handler,
+		},
+		{
+			MethodName: "ConversationBranchSelect",
+			Handler:    _AssistantService_ConversationBranchSelect_Handler,
+		},
+		{
+			MethodName: "ConversationFeedbackSubmit",
+			Handler:    _AssistantService_ConversationFeedbackSubmit_Handler,
+		},
+		{
+			MethodName: "GetConversationFeedbackList",
+			Handler:    _AssistantService_GetConversationFeedbackList_Handler,
+		},
+		{
+			MethodName: "ConversationImport",
+			Handler:    _AssistantService_ConversationImport_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -1119,6 +1417,21 @@ var AssistantService_ServiceDesc = grpc.ServiceDesc{
 			Handler:       _AssistantService_AssistantConversionStream_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "AssistantConversionRegenerate",
+			Handler:       _AssistantService_AssistantConversionRegenerate_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "AssistantConversionEdit",
+			Handler:       _AssistantService_AssistantConversionEdit_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "ConversationExport",
+			Handler:       _AssistantService_ConversationExport_Handler,
+			ServerStreams: true,
+		},
 	},
 	Metadata: "proto/assistant-service/assistant-service.proto",
 }
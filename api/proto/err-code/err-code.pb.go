@@ -35,6 +35,7 @@ const (
 	Code_BFFResetPasswordDisable Code = 110007 // 用户忘记密码禁用
 	Code_BFFLoginDisable         Code = 110008 // 邮箱登录禁用
 	Code_BFFSingleLoginDisable   Code = 110009 // 单因子登录禁用
+	Code_BFFModelQuotaExceeded   Code = 110010 // 模型token配额超限
 	// --- iam-service ---
 	// [120000, 129999]
 	Code_IAMGeneral  Code = 120000 // 通用错误
@@ -63,6 +64,7 @@ const (
 	Code_KnowledgeBaseDuplicateName            Code = 141008 // 已存在同名知识库
 	Code_KnowledgeBaseSelectFailed             Code = 141009 // 查询知识库列表失败，请稍后重试
 	Code_KnowledgeBaseHitFailed                Code = 141010 // 命中测试失败，请稍后重试
+	Code_KnowledgeBaseMultiOwner               Code = 141011 // 不支持同时使用不同创建人的知识库
 	Code_KnowledgeDocDeleteDuringParse         Code = 142001 // 文档正在解析文档，不可删除
 	Code_KnowledgeDocDeleteFailed              Code = 142002 // 文档删除失败，请稍后重试
 	Code_KnowledgeDocSegmentStatusUpdateFail   Code = 142003 // 修改文档分段状态失败，请稍后重试
@@ -72,8 +74,24 @@ const (
 	Code_KnowledgeDocImportUrlFailed           Code = 142007 // 获取上传文档失败，请稍后重试
 	Code_KnowledgeDocUpdateMetaFailed          Code = 142008 // 更新文档元数据失败，请稍后重试
 	Code_KnowledgeDocUpdateMetaStatusFailed    Code = 142009 // 非处理完成文档无法更新元数据，请稍后重试
-	Code_KnowledgeDocSegmentFileCSVTypeFail    Code = 142010 // 上传文档必须是csv，请修改后重试
+	Code_KnowledgeDocSegmentFileCSVTypeFail    Code = 142010 // 上传文档必须是csv、jsonl或xlsx，请修改后重试
 	Code_KnowledgeDocUpdateMetaSameKeyFailed   Code = 142011 // 更新文档元数据失败，已存在重复key，请稍后重试
+	Code_KnowledgeDocSyncSaveFailed            Code = 142012 // 设置文档同步失败，请稍后重试
+	Code_KnowledgeDocSyncDeleteFailed          Code = 142013 // 删除文档同步失败，请稍后重试
+	Code_KnowledgeDocSyncSelectFailed          Code = 142014 // 查询文档同步失败，请稍后重试
+	Code_KnowledgeDocSyncRunFailed             Code = 142015 // 执行文档同步失败，请稍后重试
+	Code_KnowledgeDocSyncParamsInvalid         Code = 142016 // 同步周期或同步文档不合法，仅支持url文档
+	Code_KnowledgeConnectorSaveFailed          Code = 142017 // 保存知识库连接器失败，请稍后重试
+	Code_KnowledgeConnectorDeleteFailed        Code = 142018 // 删除知识库连接器失败，请稍后重试
+	Code_KnowledgeConnectorSelectFailed        Code = 142019 // 查询知识库连接器失败，请稍后重试
+	Code_KnowledgeConnectorRunFailed           Code = 142020 // 执行知识库连接器失败，请稍后重试
+	Code_KnowledgeConnectorParamsInvalid       Code = 142021 // 知识库连接器配置不合法，请检查后重试
+	Code_KnowledgeDocVersionUploadFailed       Code = 142022 // 上传文档新版本失败，请稍后重试
+	Code_KnowledgeDocVersionSelectFailed       Code = 142023 // 查询文档版本失败，请稍后重试
+	Code_KnowledgeDocVersionDiffFailed         Code = 142024 // 对比文档版本失败，请稍后重试
+	Code_KnowledgeDocVersionRollbackFailed     Code = 142025 // 回滚文档版本失败，请稍后重试
+	Code_KnowledgeDocVersionStatusInvalid      Code = 142026 // 文档正在处理中，请处理完成后重试
+	Code_KnowledgeConnectorDisabled            Code = 142027 // 知识库连接器未启用，请联系管理员配置连接器密钥
 	Code_KnowledgeTagCreateFailed              Code = 143001 // 新建知识库标签失败，请稍后重试
 	Code_KnowledgeTagDeleteFailed              Code = 143002 // 删除知识库标签失败，请稍后重试
 	Code_KnowledgeTagUpdateFailed              Code = 143003 // 修改知识库标签失败，请稍后重试
@@ -107,6 +125,21 @@ const (
 	Code_KnowledgeInvalidArguments             Code = 145019 // 非法参数
 	Code_KnowledgeDocSegmentEmpty              Code = 145020 // 文档分段内容为空
 	Code_KnowledgeMetaUpdatePartialSuccess     Code = 145021 // 部分元数据更新成功
+	Code_KnowledgeDocSegmentExportFailed       Code = 145022 // 导出文档分段失败，请稍后重试
+	Code_KnowledgeDocSegmentImportSelectFailed Code = 145023 // 查询文档分段导入结果失败，请稍后重试
+	Code_KnowledgePermissionGrantFailed        Code = 146001 // 分享知识库失败，请稍后重试
+	Code_KnowledgePermissionRevokeFailed       Code = 146002 // 取消分享知识库失败，请稍后重试
+	Code_KnowledgePermissionSelectFailed       Code = 146003 // 查询知识库分享列表失败，请稍后重试
+	Code_KnowledgeBundleExportFailed           Code = 147001 // 导出知识库失败，请稍后重试
+	Code_KnowledgeBundleImportFailed           Code = 147002 // 导入知识库失败，请稍后重试
+	Code_KnowledgeBundleSelectFailed           Code = 147003 // 查询知识库导入导出任务失败，请稍后重试
+	Code_KnowledgeBundleFileInvalid            Code = 147004 // 知识库导入文件不合法，请检查后重试
+	Code_KnowledgeEvalDatasetCreateFailed      Code = 148001 // 创建检索评测数据集失败，请稍后重试
+	Code_KnowledgeEvalDatasetSelectFailed      Code = 148002 // 查询检索评测数据集失败，请稍后重试
+	Code_KnowledgeEvalDatasetDeleteFailed      Code = 148003 // 删除检索评测数据集失败，请稍后重试
+	Code_KnowledgeEvalRunCreateFailed          Code = 148004 // 创建检索评测失败，请稍后重试
+	Code_KnowledgeEvalRunSelectFailed          Code = 148005 // 查询检索评测结果失败，请稍后重试
+	Code_KnowledgeEvalRunCompareFailed         Code = 148006 // 只能对比同一数据集下已完成的检索评测
 	// --- rag-service ---
 	// [150000, 159999]
 	Code_RagGeneral      Code = 150000 // 通用错误
@@ -118,6 +151,7 @@ const (
 	Code_RagCreateErr    Code = 150006 // rag创建错误
 	Code_RagUpdateErr    Code = 150007 // rag更新错误
 	Code_RagChatErr      Code = 150008 // rag流式传输问答错误
+	Code_RagFeedbackErr  Code = 150010 // rag问答评价错误
 	// --- assistant-service ---
 	// [160000, 169999]
 	Code_AssistantGeneral         Code = 160000 // 通用错误
@@ -127,6 +161,7 @@ const (
 	Code_AssistantConversationErr Code = 160004 // 智能体对话相关错误
 	Code_AssistantMCPErr          Code = 160005 // 智能体MCP相关错误
 	Code_AssistantCustomErr       Code = 160006 // 智能体自定义工具相关错误
+	Code_AssistantFeedbackErr     Code = 160007 // 智能体回答评价相关错误
 	// --- workflow-service ---
 	// [210000, 219999]
 	Code_WorkflowGeneral Code = 210000 // 通用错误
@@ -142,6 +177,8 @@ const (
 	Code_ModelChangeModelStatus Code = 250007 // 模型启用/关停错误
 	Code_ModelListTypeModels    Code = 250008 // 模型llm/rerank/embedding列表错误
 	Code_ModelGetModelByIds     Code = 250009 // 根据模型ID列表查询错误
+	Code_ModelUsage             Code = 250010 // 模型用量错误
+	Code_ModelQuota             Code = 250011 // 模型配额错误
 	// --- app-service ---
 	// [300000, 309999]
 	Code_AppGeneral                      Code = 300000 // 通用错误
@@ -156,6 +193,8 @@ const (
 	Code_AppUrl                          Code = 300009 // App WebUrl错误
 	Code_AppUrlStatus                    Code = 300010 // App WebUrl状态错误
 	Code_AppUrlExpired                   Code = 300011 // App WebUrl过期错误
+	Code_AppEval                         Code = 300012 // 应用回答质量评测相关错误
+	Code_AppSafetyGuardrail              Code = 300013 // 安全护栏策略相关错误
 	// --- mcp-service ---
 	// [310000, 319999]
 	Code_MCPGeneral              Code = 310000 // 通用错误
@@ -175,6 +214,8 @@ const (
 	// [320000, 329999]
 	Code_OperateGeneral Code = 320000 // 通用错误
 	Code_OperateCustom  Code = 320001 // 自定义配置相关错误
+	Code_OperateAudit   Code = 320002 // 审计日志相关错误
+	Code_OperateWebhook Code = 320003 // webhook相关错误
 )
 
 // Enum value maps for Code.
@@ -190,6 +231,7 @@ var (
 		110007: "BFFResetPasswordDisable",
 		110008: "BFFLoginDisable",
 		110009: "BFFSingleLoginDisable",
+		110010: "BFFModelQuotaExceeded",
 		120000: "IAMGeneral",
 		120001: "IAMInternal",
 		120002: "IAMCaptcha",
@@ -212,6 +254,7 @@ var (
 		141008: "KnowledgeBaseDuplicateName",
 		141009: "KnowledgeBaseSelectFailed",
 		141010: "KnowledgeBaseHitFailed",
+		141011: "KnowledgeBaseMultiOwner",
 		142001: "KnowledgeDocDeleteDuringParse",
 		142002: "KnowledgeDocDeleteFailed",
 		142003: "KnowledgeDocSegmentStatusUpdateFail",
@@ -223,6 +266,22 @@ var (
 		142009: "KnowledgeDocUpdateMetaStatusFailed",
 		142010: "KnowledgeDocSegmentFileCSVTypeFail",
 		142011: "KnowledgeDocUpdateMetaSameKeyFailed",
+		142012: "KnowledgeDocSyncSaveFailed",
+		142013: "KnowledgeDocSyncDeleteFailed",
+		142014: "KnowledgeDocSyncSelectFailed",
+		142015: "KnowledgeDocSyncRunFailed",
+		142016: "KnowledgeDocSyncParamsInvalid",
+		142017: "KnowledgeConnectorSaveFailed",
+		142018: "KnowledgeConnectorDeleteFailed",
+		142019: "KnowledgeConnectorSelectFailed",
+		142020: "KnowledgeConnectorRunFailed",
+		142021: "KnowledgeConnectorParamsInvalid",
+		142022: "KnowledgeDocVersionUploadFailed",
+		142023: "KnowledgeDocVersionSelectFailed",
+		142024: "KnowledgeDocVersionDiffFailed",
+		142025: "KnowledgeDocVersionRollbackFailed",
+		142026: "KnowledgeDocVersionStatusInvalid",
+		142027: "KnowledgeConnectorDisabled",
 		143001: "KnowledgeTagCreateFailed",
 		143002: "KnowledgeTagDeleteFailed",
 		143003: "KnowledgeTagUpdateFailed",
@@ -256,6 +315,21 @@ var (
 		145019: "KnowledgeInvalidArguments",
 		145020: "KnowledgeDocSegmentEmpty",
 		145021: "KnowledgeMetaUpdatePartialSuccess",
+		145022: "KnowledgeDocSegmentExportFailed",
+		145023: "KnowledgeDocSegmentImportSelectFailed",
+		146001: "KnowledgePermissionGrantFailed",
+		146002: "KnowledgePermissionRevokeFailed",
+		146003: "KnowledgePermissionSelectFailed",
+		147001: "KnowledgeBundleExportFailed",
+		147002: "KnowledgeBundleImportFailed",
+		147003: "KnowledgeBundleSelectFailed",
+		147004: "KnowledgeBundleFileInvalid",
+		148001: "KnowledgeEvalDatasetCreateFailed",
+		148002: "KnowledgeEvalDatasetSelectFailed",
+		148003: "KnowledgeEvalDatasetDeleteFailed",
+		148004: "KnowledgeEvalRunCreateFailed",
+		148005: "KnowledgeEvalRunSelectFailed",
+		148006: "KnowledgeEvalRunCompareFailed",
 		150000: "RagGeneral",
 		150001: "RagRole",
 		150002: "RagInfoNotExist",
@@ -265,6 +339,7 @@ var (
 		150006: "RagCreateErr",
 		150007: "RagUpdateErr",
 		150008: "RagChatErr",
+		150010: "RagFeedbackErr",
 		160000: "AssistantGeneral",
 		160001: "AssistantErr",
 		160002: "AssistantActionErr",
@@ -272,6 +347,7 @@ var (
 		160004: "AssistantConversationErr",
 		160005: "AssistantMCPErr",
 		160006: "AssistantCustomErr",
+		160007: "AssistantFeedbackErr",
 		210000: "WorkflowGeneral",
 		250000: "ModelGeneral",
 		250001: "ModelImportedModel",
@@ -283,6 +359,8 @@ var (
 		250007: "ModelChangeModelStatus",
 		250008: "ModelListTypeModels",
 		250009: "ModelGetModelByIds",
+		250010: "ModelUsage",
+		250011: "ModelQuota",
 		300000: "AppGeneral",
 		300001: "AppApikey",
 		300002: "AppExploration",
@@ -295,6 +373,8 @@ var (
 		300009: "AppUrl",
 		300010: "AppUrlStatus",
 		300011: "AppUrlExpired",
+		300012: "AppEval",
+		300013: "AppSafetyGuardrail",
 		310000: "MCPGeneral",
 		310001: "MCPGetSquareMCPErr",
 		310002: "MCPCreateCustomMCPErr",
@@ -310,6 +390,8 @@ var (
 		310012: "MCPGetSquareToolErr",
 		320000: "OperateGeneral",
 		320001: "OperateCustom",
+		320002: "OperateAudit",
+		320003: "OperateWebhook",
 	}
 	Code_value = map[string]int32{
 		"OK":                                    0,
@@ -322,6 +404,7 @@ var (
 		"BFFResetPasswordDisable":               110007,
 		"BFFLoginDisable":                       110008,
 		"BFFSingleLoginDisable":                 110009,
+		"BFFModelQuotaExceeded":                 110010,
 		"IAMGeneral":                            120000,
 		"IAMInternal":                           120001,
 		"IAMCaptcha":                            120002,
@@ -344,6 +427,7 @@ var (
 		"KnowledgeBaseDuplicateName":            141008,
 		"KnowledgeBaseSelectFailed":             141009,
 		"KnowledgeBaseHitFailed":                141010,
+		"KnowledgeBaseMultiOwner":               141011,
 		"KnowledgeDocDeleteDuringParse":         142001,
 		"KnowledgeDocDeleteFailed":              142002,
 		"KnowledgeDocSegmentStatusUpdateFail":   142003,
@@ -355,6 +439,22 @@ var (
 		"KnowledgeDocUpdateMetaStatusFailed":    142009,
 		"KnowledgeDocSegmentFileCSVTypeFail":    142010,
 		"KnowledgeDocUpdateMetaSameKeyFailed":   142011,
+		"KnowledgeDocSyncSaveFailed":            142012,
+		"KnowledgeDocSyncDeleteFailed":          142013,
+		"KnowledgeDocSyncSelectFailed":          142014,
+		"KnowledgeDocSyncRunFailed":             142015,
+		"KnowledgeDocSyncParamsInvalid":         142016,
+		"KnowledgeConnectorSaveFailed":          142017,
+		"KnowledgeConnectorDeleteFailed":        142018,
+		"KnowledgeConnectorSelectFailed":        142019,
+		"KnowledgeConnectorRunFailed":           142020,
+		"KnowledgeConnectorParamsInvalid":       142021,
+		"KnowledgeDocVersionUploadFailed":       142022,
+		"KnowledgeDocVersionSelectFailed":       142023,
+		"KnowledgeDocVersionDiffFailed":         142024,
+		"KnowledgeDocVersionRollbackFailed":     142025,
+		"KnowledgeDocVersionStatusInvalid":      142026,
+		"KnowledgeConnectorDisabled":            142027,
 		"KnowledgeTagCreateFailed":              143001,
 		"KnowledgeTagDeleteFailed":              143002,
 		"KnowledgeTagUpdateFailed":              143003,
@@ -388,6 +488,21 @@ var (
 		"KnowledgeInvalidArguments":             145019,
 		"KnowledgeDocSegmentEmpty":              145020,
 		"KnowledgeMetaUpdatePartialSuccess":     145021,
+		"KnowledgeDocSegmentExportFailed":       145022,
+		"KnowledgeDocSegmentImportSelectFailed": 145023,
+		"KnowledgePermissionGrantFailed":        146001,
+		"KnowledgePermissionRevokeFailed":       146002,
+		"KnowledgePermissionSelectFailed":       146003,
+		"KnowledgeBundleExportFailed":           147001,
+		"KnowledgeBundleImportFailed":           147002,
+		"KnowledgeBundleSelectFailed":           147003,
+		"KnowledgeBundleFileInvalid":            147004,
+		"KnowledgeEvalDatasetCreateFailed":      148001,
+		"KnowledgeEvalDatasetSelectFailed":      148002,
+		"KnowledgeEvalDatasetDeleteFailed":      148003,
+		"KnowledgeEvalRunCreateFailed":          148004,
+		"KnowledgeEvalRunSelectFailed":          148005,
+		"KnowledgeEvalRunCompareFailed":         148006,
 		"RagGeneral":                            150000,
 		"RagRole":                               150001,
 		"RagInfoNotExist":                       150002,
@@ -397,6 +512,7 @@ var (
 		"RagCreateErr":                          150006,
 		"RagUpdateErr":                          150007,
 		"RagChatErr":                            150008,
+		"RagFeedbackErr":                        150010,
 		"AssistantGeneral":                      160000,
 		"AssistantErr":                          160001,
 		"AssistantActionErr":                    160002,
@@ -404,6 +520,7 @@ var (
 		"AssistantConversationErr":              160004,
 		"AssistantMCPErr":                       160005,
 		"AssistantCustomErr":                    160006,
+		"AssistantFeedbackErr":                  160007,
 		"WorkflowGeneral":                       210000,
 		"ModelGeneral":                          250000,
 		"ModelImportedModel":                    250001,
@@ -415,6 +532,8 @@ var (
 		"ModelChangeModelStatus":                250007,
 		"ModelListTypeModels":                   250008,
 		"ModelGetModelByIds":                    250009,
+		"ModelUsage":                            250010,
+		"ModelQuota":                            250011,
 		"AppGeneral":                            300000,
 		"AppApikey":                             300001,
 		"AppExploration":                        300002,
@@ -427,6 +546,8 @@ var (
 		"AppUrl":                                300009,
 		"AppUrlStatus":                          300010,
 		"AppUrlExpired":                         300011,
+		"AppEval":                               300012,
+		"AppSafetyGuardrail":                    300013,
 		"MCPGeneral":                            310000,
 		"MCPGetSquareMCPErr":                    310001,
 		"MCPCreateCustomMCPErr":                 310002,
@@ -442,6 +563,8 @@ var (
 		"MCPGetSquareToolErr":                   310012,
 		"OperateGeneral":                        320000,
 		"OperateCustom":                         320001,
+		"OperateAudit":                          320002,
+		"OperateWebhook":                        320003,
 	}
 )
 
@@ -477,7 +600,7 @@ var File_proto_err_code_err_code_proto protoreflect.FileDescriptor
 var file_proto_err_code_err_code_proto_rawDesc = []byte{
 	0x0a, 0x1d, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x65, 0x72, 0x72, 0x2d, 0x63, 0x6f, 0x64, 0x65,
 	0x2f, 0x65, 0x72, 0x72, 0x2d, 0x63, 0x6f, 0x64, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12,
-	0x08, 0x65, 0x72, 0x72, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x2a, 0xda, 0x1c, 0x0a, 0x04, 0x43, 0x6f,
+	0x08, 0x65, 0x72, 0x72, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x2a, 0xc7, 0x27, 0x0a, 0x04, 0x43, 0x6f,
 	0x64, 0x65, 0x12, 0x06, 0x0a, 0x02, 0x4f, 0x4b, 0x10, 0x00, 0x12, 0x10, 0x0a, 0x0a, 0x42, 0x46,
 	0x46, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x6c, 0x10, 0xb0, 0xdb, 0x06, 0x12, 0x13, 0x0a, 0x0d,
 	0x42, 0x46, 0x46, 0x49, 0x6e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x41, 0x72, 0x67, 0x10, 0xb1, 0xdb,
@@ -491,226 +614,313 @@ var file_proto_err_code_err_code_proto_rawDesc = []byte{
 	0x10, 0xb7, 0xdb, 0x06, 0x12, 0x15, 0x0a, 0x0f, 0x42, 0x46, 0x46, 0x4c, 0x6f, 0x67, 0x69, 0x6e,
 	0x44, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x10, 0xb8, 0xdb, 0x06, 0x12, 0x1b, 0x0a, 0x15, 0x42,
 	0x46, 0x46, 0x53, 0x69, 0x6e, 0x67, 0x6c, 0x65, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x44, 0x69, 0x73,
-	0x61, 0x62, 0x6c, 0x65, 0x10, 0xb9, 0xdb, 0x06, 0x12, 0x10, 0x0a, 0x0a, 0x49, 0x41, 0x4d, 0x47,
-	0x65, 0x6e, 0x65, 0x72, 0x61, 0x6c, 0x10, 0xc0, 0xa9, 0x07, 0x12, 0x11, 0x0a, 0x0b, 0x49, 0x41,
-	0x4d, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x10, 0xc1, 0xa9, 0x07, 0x12, 0x10, 0x0a,
-	0x0a, 0x49, 0x41, 0x4d, 0x43, 0x61, 0x70, 0x74, 0x63, 0x68, 0x61, 0x10, 0xc2, 0xa9, 0x07, 0x12,
-	0x0e, 0x0a, 0x08, 0x49, 0x41, 0x4d, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x10, 0xc3, 0xa9, 0x07, 0x12,
-	0x11, 0x0a, 0x0b, 0x49, 0x41, 0x4d, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x10, 0xc4,
-	0xa9, 0x07, 0x12, 0x0c, 0x0a, 0x06, 0x49, 0x41, 0x4d, 0x4f, 0x72, 0x67, 0x10, 0xcb, 0xa9, 0x07,
-	0x12, 0x0d, 0x0a, 0x07, 0x49, 0x41, 0x4d, 0x52, 0x6f, 0x6c, 0x65, 0x10, 0xd5, 0xa9, 0x07, 0x12,
-	0x0d, 0x0a, 0x07, 0x49, 0x41, 0x4d, 0x55, 0x73, 0x65, 0x72, 0x10, 0xdf, 0xa9, 0x07, 0x12, 0x11,
-	0x0a, 0x0b, 0x50, 0x65, 0x72, 0x6d, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x6c, 0x10, 0xd0, 0xf7,
-	0x07, 0x12, 0x0e, 0x0a, 0x08, 0x50, 0x65, 0x72, 0x6d, 0x52, 0x42, 0x41, 0x43, 0x10, 0xd1, 0xf7,
-	0x07, 0x12, 0x15, 0x0a, 0x0f, 0x50, 0x65, 0x72, 0x6d, 0x52, 0x42, 0x41, 0x43, 0x52, 0x65, 0x4c,
-	0x6f, 0x67, 0x69, 0x6e, 0x10, 0xd2, 0xf7, 0x07, 0x12, 0x16, 0x0a, 0x10, 0x4b, 0x6e, 0x6f, 0x77,
-	0x6c, 0x65, 0x64, 0x67, 0x65, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x6c, 0x10, 0xe0, 0xc5, 0x08,
+	0x61, 0x62, 0x6c, 0x65, 0x10, 0xb9, 0xdb, 0x06, 0x12, 0x1b, 0x0a, 0x15, 0x42, 0x46, 0x46, 0x4d,
+	0x6f, 0x64, 0x65, 0x6c, 0x51, 0x75, 0x6f, 0x74, 0x61, 0x45, 0x78, 0x63, 0x65, 0x65, 0x64, 0x65,
+	0x64, 0x10, 0xba, 0xdb, 0x06, 0x12, 0x10, 0x0a, 0x0a, 0x49, 0x41, 0x4d, 0x47, 0x65, 0x6e, 0x65,
+	0x72, 0x61, 0x6c, 0x10, 0xc0, 0xa9, 0x07, 0x12, 0x11, 0x0a, 0x0b, 0x49, 0x41, 0x4d, 0x49, 0x6e,
+	0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x10, 0xc1, 0xa9, 0x07, 0x12, 0x10, 0x0a, 0x0a, 0x49, 0x41,
+	0x4d, 0x43, 0x61, 0x70, 0x74, 0x63, 0x68, 0x61, 0x10, 0xc2, 0xa9, 0x07, 0x12, 0x0e, 0x0a, 0x08,
+	0x49, 0x41, 0x4d, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x10, 0xc3, 0xa9, 0x07, 0x12, 0x11, 0x0a, 0x0b,
+	0x49, 0x41, 0x4d, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x10, 0xc4, 0xa9, 0x07, 0x12,
+	0x0c, 0x0a, 0x06, 0x49, 0x41, 0x4d, 0x4f, 0x72, 0x67, 0x10, 0xcb, 0xa9, 0x07, 0x12, 0x0d, 0x0a,
+	0x07, 0x49, 0x41, 0x4d, 0x52, 0x6f, 0x6c, 0x65, 0x10, 0xd5, 0xa9, 0x07, 0x12, 0x0d, 0x0a, 0x07,
+	0x49, 0x41, 0x4d, 0x55, 0x73, 0x65, 0x72, 0x10, 0xdf, 0xa9, 0x07, 0x12, 0x11, 0x0a, 0x0b, 0x50,
+	0x65, 0x72, 0x6d, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x6c, 0x10, 0xd0, 0xf7, 0x07, 0x12, 0x0e,
+	0x0a, 0x08, 0x50, 0x65, 0x72, 0x6d, 0x52, 0x42, 0x41, 0x43, 0x10, 0xd1, 0xf7, 0x07, 0x12, 0x15,
+	0x0a, 0x0f, 0x50, 0x65, 0x72, 0x6d, 0x52, 0x42, 0x41, 0x43, 0x52, 0x65, 0x4c, 0x6f, 0x67, 0x69,
+	0x6e, 0x10, 0xd2, 0xf7, 0x07, 0x12, 0x16, 0x0a, 0x10, 0x4b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64,
+	0x67, 0x65, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x6c, 0x10, 0xe0, 0xc5, 0x08, 0x12, 0x1f, 0x0a,
+	0x19, 0x4b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x42, 0x61, 0x73, 0x65, 0x43, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x46, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x10, 0xc9, 0xcd, 0x08, 0x12, 0x1f,
+	0x0a, 0x19, 0x4b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x42, 0x61, 0x73, 0x65, 0x44,
+	0x65, 0x6c, 0x65, 0x74, 0x65, 0x46, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x10, 0xca, 0xcd, 0x08, 0x12,
+	0x1f, 0x0a, 0x19, 0x4b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x42, 0x61, 0x73, 0x65,
+	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x46, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x10, 0xcb, 0xcd, 0x08,
+	0x12, 0x1e, 0x0a, 0x18, 0x4b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x42, 0x61, 0x73,
+	0x65, 0x46, 0x65, 0x74, 0x63, 0x68, 0x46, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x10, 0xcc, 0xcd, 0x08,
 	0x12, 0x1f, 0x0a, 0x19, 0x4b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x42, 0x61, 0x73,
-	0x65, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x46, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x10, 0xc9, 0xcd,
-	0x08, 0x12, 0x1f, 0x0a, 0x19, 0x4b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x42, 0x61,
-	0x73, 0x65, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x46, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x10, 0xca,
-	0xcd, 0x08, 0x12, 0x1f, 0x0a, 0x19, 0x4b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x42,
-	0x61, 0x73, 0x65, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x46, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x10,
-	0xcb, 0xcd, 0x08, 0x12, 0x1e, 0x0a, 0x18, 0x4b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65,
-	0x42, 0x61, 0x73, 0x65, 0x46, 0x65, 0x74, 0x63, 0x68, 0x46, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x10,
-	0xcc, 0xcd, 0x08, 0x12, 0x1f, 0x0a, 0x19, 0x4b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65,
-	0x42, 0x61, 0x73, 0x65, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x44, 0x65, 0x6e, 0x69, 0x65, 0x64,
-	0x10, 0xcd, 0xcd, 0x08, 0x12, 0x25, 0x0a, 0x1f, 0x4b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67,
-	0x65, 0x42, 0x61, 0x73, 0x65, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x44, 0x75, 0x72, 0x69, 0x6e,
-	0x67, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x10, 0xce, 0xcd, 0x08, 0x12, 0x24, 0x0a, 0x1e, 0x4b,
-	0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x42, 0x61, 0x73, 0x65, 0x44, 0x65, 0x6c, 0x65,
-	0x74, 0x65, 0x44, 0x75, 0x72, 0x69, 0x6e, 0x67, 0x50, 0x61, 0x72, 0x73, 0x65, 0x10, 0xcf, 0xcd,
-	0x08, 0x12, 0x20, 0x0a, 0x1a, 0x4b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x42, 0x61,
-	0x73, 0x65, 0x44, 0x75, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x10,
-	0xd0, 0xcd, 0x08, 0x12, 0x1f, 0x0a, 0x19, 0x4b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65,
-	0x42, 0x61, 0x73, 0x65, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x46, 0x61, 0x69, 0x6c, 0x65, 0x64,
-	0x10, 0xd1, 0xcd, 0x08, 0x12, 0x1c, 0x0a, 0x16, 0x4b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67,
-	0x65, 0x42, 0x61, 0x73, 0x65, 0x48, 0x69, 0x74, 0x46, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x10, 0xd2,
-	0xcd, 0x08, 0x12, 0x23, 0x0a, 0x1d, 0x4b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x44,
-	0x6f, 0x63, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x44, 0x75, 0x72, 0x69, 0x6e, 0x67, 0x50, 0x61,
-	0x72, 0x73, 0x65, 0x10, 0xb1, 0xd5, 0x08, 0x12, 0x1e, 0x0a, 0x18, 0x4b, 0x6e, 0x6f, 0x77, 0x6c,
-	0x65, 0x64, 0x67, 0x65, 0x44, 0x6f, 0x63, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x46, 0x61, 0x69,
-	0x6c, 0x65, 0x64, 0x10, 0xb2, 0xd5, 0x08, 0x12, 0x29, 0x0a, 0x23, 0x4b, 0x6e, 0x6f, 0x77, 0x6c,
-	0x65, 0x64, 0x67, 0x65, 0x44, 0x6f, 0x63, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x74,
-	0x61, 0x74, 0x75, 0x73, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x46, 0x61, 0x69, 0x6c, 0x10, 0xb3,
-	0xd5, 0x08, 0x12, 0x1c, 0x0a, 0x16, 0x4b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x44,
-	0x6f, 0x63, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x46, 0x61, 0x69, 0x6c, 0x10, 0xb4, 0xd5, 0x08,
-	0x12, 0x1d, 0x0a, 0x17, 0x4b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x44, 0x6f, 0x63,
-	0x53, 0x70, 0x6c, 0x69, 0x74, 0x46, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x10, 0xb5, 0xd5, 0x08, 0x12,
-	0x24, 0x0a, 0x1e, 0x4b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x44, 0x6f, 0x63, 0x55,
-	0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x46, 0x61, 0x69, 0x6c, 0x65,
-	0x64, 0x10, 0xb6, 0xd5, 0x08, 0x12, 0x21, 0x0a, 0x1b, 0x4b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64,
-	0x67, 0x65, 0x44, 0x6f, 0x63, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x55, 0x72, 0x6c, 0x46, 0x61,
-	0x69, 0x6c, 0x65, 0x64, 0x10, 0xb7, 0xd5, 0x08, 0x12, 0x22, 0x0a, 0x1c, 0x4b, 0x6e, 0x6f, 0x77,
+	0x65, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x44, 0x65, 0x6e, 0x69, 0x65, 0x64, 0x10, 0xcd, 0xcd,
+	0x08, 0x12, 0x25, 0x0a, 0x1f, 0x4b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x42, 0x61,
+	0x73, 0x65, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x44, 0x75, 0x72, 0x69, 0x6e, 0x67, 0x55, 0x70,
+	0x6c, 0x6f, 0x61, 0x64, 0x10, 0xce, 0xcd, 0x08, 0x12, 0x24, 0x0a, 0x1e, 0x4b, 0x6e, 0x6f, 0x77,
+	0x6c, 0x65, 0x64, 0x67, 0x65, 0x42, 0x61, 0x73, 0x65, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x44,
+	0x75, 0x72, 0x69, 0x6e, 0x67, 0x50, 0x61, 0x72, 0x73, 0x65, 0x10, 0xcf, 0xcd, 0x08, 0x12, 0x20,
+	0x0a, 0x1a, 0x4b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x42, 0x61, 0x73, 0x65, 0x44,
+	0x75, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x10, 0xd0, 0xcd, 0x08,
+	0x12, 0x1f, 0x0a, 0x19, 0x4b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x42, 0x61, 0x73,
+	0x65, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x46, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x10, 0xd1, 0xcd,
+	0x08, 0x12, 0x1c, 0x0a, 0x16, 0x4b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x42, 0x61,
+	0x73, 0x65, 0x48, 0x69, 0x74, 0x46, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x10, 0xd2, 0xcd, 0x08, 0x12,
+	0x1d, 0x0a, 0x17, 0x4b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x42, 0x61, 0x73, 0x65,
+	0x4d, 0x75, 0x6c, 0x74, 0x69, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x10, 0xd3, 0xcd, 0x08, 0x12, 0x23,
+	0x0a, 0x1d, 0x4b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x44, 0x6f, 0x63, 0x44, 0x65,
+	0x6c, 0x65, 0x74, 0x65, 0x44, 0x75, 0x72, 0x69, 0x6e, 0x67, 0x50, 0x61, 0x72, 0x73, 0x65, 0x10,
+	0xb1, 0xd5, 0x08, 0x12, 0x1e, 0x0a, 0x18, 0x4b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65,
+	0x44, 0x6f, 0x63, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x46, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x10,
+	0xb2, 0xd5, 0x08, 0x12, 0x29, 0x0a, 0x23, 0x4b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65,
+	0x44, 0x6f, 0x63, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x46, 0x61, 0x69, 0x6c, 0x10, 0xb3, 0xd5, 0x08, 0x12, 0x1c,
+	0x0a, 0x16, 0x4b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x44, 0x6f, 0x63, 0x49, 0x6d,
+	0x70, 0x6f, 0x72, 0x74, 0x46, 0x61, 0x69, 0x6c, 0x10, 0xb4, 0xd5, 0x08, 0x12, 0x1d, 0x0a, 0x17,
+	0x4b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x44, 0x6f, 0x63, 0x53, 0x70, 0x6c, 0x69,
+	0x74, 0x46, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x10, 0xb5, 0xd5, 0x08, 0x12, 0x24, 0x0a, 0x1e, 0x4b,
+	0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x44, 0x6f, 0x63, 0x55, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x46, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x10, 0xb6, 0xd5,
+	0x08, 0x12, 0x21, 0x0a, 0x1b, 0x4b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x44, 0x6f,
+	0x63, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x55, 0x72, 0x6c, 0x46, 0x61, 0x69, 0x6c, 0x65, 0x64,
+	0x10, 0xb7, 0xd5, 0x08, 0x12, 0x22, 0x0a, 0x1c, 0x4b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67,
+	0x65, 0x44, 0x6f, 0x63, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4d, 0x65, 0x74, 0x61, 0x46, 0x61,
+	0x69, 0x6c, 0x65, 0x64, 0x10, 0xb8, 0xd5, 0x08, 0x12, 0x28, 0x0a, 0x22, 0x4b, 0x6e, 0x6f, 0x77,
 	0x6c, 0x65, 0x64, 0x67, 0x65, 0x44, 0x6f, 0x63, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4d, 0x65,
-	0x74, 0x61, 0x46, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x10, 0xb8, 0xd5, 0x08, 0x12, 0x28, 0x0a, 0x22,
+	0x74, 0x61, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x46, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x10, 0xb9,
+	0xd5, 0x08, 0x12, 0x28, 0x0a, 0x22, 0x4b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x44,
+	0x6f, 0x63, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x43, 0x53, 0x56,
+	0x54, 0x79, 0x70, 0x65, 0x46, 0x61, 0x69, 0x6c, 0x10, 0xba, 0xd5, 0x08, 0x12, 0x29, 0x0a, 0x23,
 	0x4b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x44, 0x6f, 0x63, 0x55, 0x70, 0x64, 0x61,
-	0x74, 0x65, 0x4d, 0x65, 0x74, 0x61, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x46, 0x61, 0x69, 0x6c,
-	0x65, 0x64, 0x10, 0xb9, 0xd5, 0x08, 0x12, 0x28, 0x0a, 0x22, 0x4b, 0x6e, 0x6f, 0x77, 0x6c, 0x65,
-	0x64, 0x67, 0x65, 0x44, 0x6f, 0x63, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x46, 0x69, 0x6c,
-	0x65, 0x43, 0x53, 0x56, 0x54, 0x79, 0x70, 0x65, 0x46, 0x61, 0x69, 0x6c, 0x10, 0xba, 0xd5, 0x08,
-	0x12, 0x29, 0x0a, 0x23, 0x4b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x44, 0x6f, 0x63,
-	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4d, 0x65, 0x74, 0x61, 0x53, 0x61, 0x6d, 0x65, 0x4b, 0x65,
-	0x79, 0x46, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x10, 0xbb, 0xd5, 0x08, 0x12, 0x1e, 0x0a, 0x18, 0x4b,
-	0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x54, 0x61, 0x67, 0x43, 0x72, 0x65, 0x61, 0x74,
-	0x65, 0x46, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x10, 0x99, 0xdd, 0x08, 0x12, 0x1e, 0x0a, 0x18, 0x4b,
-	0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x54, 0x61, 0x67, 0x44, 0x65, 0x6c, 0x65, 0x74,
-	0x65, 0x46, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x10, 0x9a, 0xdd, 0x08, 0x12, 0x1e, 0x0a, 0x18, 0x4b,
-	0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x54, 0x61, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74,
-	0x65, 0x46, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x10, 0x9b, 0xdd, 0x08, 0x12, 0x1f, 0x0a, 0x19, 0x4b,
-	0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x54, 0x61, 0x67, 0x44, 0x75, 0x70, 0x6c, 0x69,
-	0x63, 0x61, 0x74, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x10, 0x9c, 0xdd, 0x08, 0x12, 0x1e, 0x0a, 0x18,
-	0x4b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x54, 0x61, 0x67, 0x53, 0x65, 0x6c, 0x65,
-	0x63, 0x74, 0x46, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x10, 0x9d, 0xdd, 0x08, 0x12, 0x1c, 0x0a, 0x16,
-	0x4b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x54, 0x61, 0x67, 0x42, 0x69, 0x6e, 0x64,
-	0x46, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x10, 0x9e, 0xdd, 0x08, 0x12, 0x1e, 0x0a, 0x18, 0x4b, 0x6e,
-	0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x54, 0x61, 0x67, 0x55, 0x6e, 0x62, 0x69, 0x6e, 0x64,
-	0x46, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x10, 0x9f, 0xdd, 0x08, 0x12, 0x1e, 0x0a, 0x18, 0x4b, 0x6e,
-	0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x54, 0x61, 0x67, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73,
-	0x44, 0x65, 0x6e, 0x69, 0x65, 0x64, 0x10, 0xa0, 0xdd, 0x08, 0x12, 0x23, 0x0a, 0x1d, 0x4b, 0x6e,
-	0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x4b, 0x65, 0x79, 0x77, 0x6f, 0x72, 0x64, 0x73, 0x43,
-	0x72, 0x65, 0x61, 0x74, 0x65, 0x46, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x10, 0x81, 0xe5, 0x08, 0x12,
-	0x23, 0x0a, 0x1d, 0x4b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x4b, 0x65, 0x79, 0x77,
-	0x6f, 0x72, 0x64, 0x73, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x46, 0x61, 0x69, 0x6c, 0x65, 0x64,
-	0x10, 0x82, 0xe5, 0x08, 0x12, 0x23, 0x0a, 0x1d, 0x4b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67,
-	0x65, 0x4b, 0x65, 0x79, 0x77, 0x6f, 0x72, 0x64, 0x73, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x46,
-	0x61, 0x69, 0x6c, 0x65, 0x64, 0x10, 0x83, 0xe5, 0x08, 0x12, 0x21, 0x0a, 0x1b, 0x4b, 0x6e, 0x6f,
-	0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x4b, 0x65, 0x79, 0x77, 0x6f, 0x72, 0x64, 0x73, 0x4c, 0x69,
-	0x73, 0x74, 0x46, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x10, 0x84, 0xe5, 0x08, 0x12, 0x21, 0x0a, 0x1b,
-	0x4b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x4b, 0x65, 0x79, 0x77, 0x6f, 0x72, 0x64,
-	0x73, 0x49, 0x6e, 0x66, 0x6f, 0x46, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x10, 0x85, 0xe5, 0x08, 0x12,
-	0x1f, 0x0a, 0x19, 0x4b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x4b, 0x65, 0x79, 0x77,
-	0x6f, 0x72, 0x64, 0x73, 0x52, 0x65, 0x70, 0x65, 0x61, 0x74, 0x65, 0x64, 0x10, 0x86, 0xe5, 0x08,
-	0x12, 0x23, 0x0a, 0x1d, 0x4b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x53, 0x70, 0x6c,
-	0x69, 0x74, 0x74, 0x65, 0x72, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x46, 0x61, 0x69, 0x6c, 0x65,
-	0x64, 0x10, 0xe9, 0xec, 0x08, 0x12, 0x23, 0x0a, 0x1d, 0x4b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64,
-	0x67, 0x65, 0x53, 0x70, 0x6c, 0x69, 0x74, 0x74, 0x65, 0x72, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
-	0x46, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x10, 0xea, 0xec, 0x08, 0x12, 0x23, 0x0a, 0x1d, 0x4b, 0x6e,
-	0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x53, 0x70, 0x6c, 0x69, 0x74, 0x74, 0x65, 0x72, 0x55,
-	0x70, 0x64, 0x61, 0x74, 0x65, 0x46, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x10, 0xeb, 0xec, 0x08, 0x12,
-	0x24, 0x0a, 0x1e, 0x4b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x53, 0x70, 0x6c, 0x69,
-	0x74, 0x74, 0x65, 0x72, 0x44, 0x75, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x65, 0x4e, 0x61, 0x6d,
-	0x65, 0x10, 0xec, 0xec, 0x08, 0x12, 0x23, 0x0a, 0x1d, 0x4b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64,
-	0x67, 0x65, 0x53, 0x70, 0x6c, 0x69, 0x74, 0x74, 0x65, 0x72, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74,
-	0x46, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x10, 0xed, 0xec, 0x08, 0x12, 0x23, 0x0a, 0x1d, 0x4b, 0x6e,
-	0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x53, 0x70, 0x6c, 0x69, 0x74, 0x74, 0x65, 0x72, 0x41,
-	0x63, 0x63, 0x65, 0x73, 0x73, 0x44, 0x65, 0x6e, 0x69, 0x65, 0x64, 0x10, 0xf0, 0xec, 0x08, 0x12,
-	0x2b, 0x0a, 0x25, 0x4b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x44, 0x6f, 0x63, 0x53,
-	0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4c, 0x61, 0x62, 0x65,
-	0x6c, 0x73, 0x46, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x10, 0xf1, 0xec, 0x08, 0x12, 0x25, 0x0a, 0x1f,
-	0x4b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x44, 0x6f, 0x63, 0x53, 0x65, 0x67, 0x6d,
-	0x65, 0x6e, 0x74, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x46, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x10,
-	0xf2, 0xec, 0x08, 0x12, 0x26, 0x0a, 0x20, 0x4b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65,
-	0x44, 0x6f, 0x63, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x45, 0x78, 0x63, 0x65, 0x65, 0x64,
-	0x4d, 0x61, 0x78, 0x53, 0x69, 0x7a, 0x65, 0x10, 0xf3, 0xec, 0x08, 0x12, 0x25, 0x0a, 0x1f, 0x4b,
+	0x74, 0x65, 0x4d, 0x65, 0x74, 0x61, 0x53, 0x61, 0x6d, 0x65, 0x4b, 0x65, 0x79, 0x46, 0x61, 0x69,
+	0x6c, 0x65, 0x64, 0x10, 0xbb, 0xd5, 0x08, 0x12, 0x20, 0x0a, 0x1a, 0x4b, 0x6e, 0x6f, 0x77, 0x6c,
+	0x65, 0x64, 0x67, 0x65, 0x44, 0x6f, 0x63, 0x53, 0x79, 0x6e, 0x63, 0x53, 0x61, 0x76, 0x65, 0x46,
+	0x61, 0x69, 0x6c, 0x65, 0x64, 0x10, 0xbc, 0xd5, 0x08, 0x12, 0x22, 0x0a, 0x1c, 0x4b, 0x6e, 0x6f,
+	0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x44, 0x6f, 0x63, 0x53, 0x79, 0x6e, 0x63, 0x44, 0x65, 0x6c,
+	0x65, 0x74, 0x65, 0x46, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x10, 0xbd, 0xd5, 0x08, 0x12, 0x22, 0x0a,
+	0x1c, 0x4b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x44, 0x6f, 0x63, 0x53, 0x79, 0x6e,
+	0x63, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x46, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x10, 0xbe, 0xd5,
+	0x08, 0x12, 0x1f, 0x0a, 0x19, 0x4b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x44, 0x6f,
+	0x63, 0x53, 0x79, 0x6e, 0x63, 0x52, 0x75, 0x6e, 0x46, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x10, 0xbf,
+	0xd5, 0x08, 0x12, 0x23, 0x0a, 0x1d, 0x4b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x44,
+	0x6f, 0x63, 0x53, 0x79, 0x6e, 0x63, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x49, 0x6e, 0x76, 0x61,
+	0x6c, 0x69, 0x64, 0x10, 0xc0, 0xd5, 0x08, 0x12, 0x22, 0x0a, 0x1c, 0x4b, 0x6e, 0x6f, 0x77, 0x6c,
+	0x65, 0x64, 0x67, 0x65, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x53, 0x61, 0x76,
+	0x65, 0x46, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x10, 0xc1, 0xd5, 0x08, 0x12, 0x24, 0x0a, 0x1e, 0x4b,
+	0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x6f,
+	0x72, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x46, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x10, 0xc2, 0xd5,
+	0x08, 0x12, 0x24, 0x0a, 0x1e, 0x4b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x43, 0x6f,
+	0x6e, 0x6e, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x46, 0x61, 0x69,
+	0x6c, 0x65, 0x64, 0x10, 0xc3, 0xd5, 0x08, 0x12, 0x21, 0x0a, 0x1b, 0x4b, 0x6e, 0x6f, 0x77, 0x6c,
+	0x65, 0x64, 0x67, 0x65, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x52, 0x75, 0x6e,
+	0x46, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x10, 0xc4, 0xd5, 0x08, 0x12, 0x25, 0x0a, 0x1f, 0x4b, 0x6e,
+	0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x6f, 0x72,
+	0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x49, 0x6e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x10, 0xc5, 0xd5,
+	0x08, 0x12, 0x25, 0x0a, 0x1f, 0x4b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x44, 0x6f,
+	0x63, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x46, 0x61,
+	0x69, 0x6c, 0x65, 0x64, 0x10, 0xc6, 0xd5, 0x08, 0x12, 0x25, 0x0a, 0x1f, 0x4b, 0x6e, 0x6f, 0x77,
+	0x6c, 0x65, 0x64, 0x67, 0x65, 0x44, 0x6f, 0x63, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x53,
+	0x65, 0x6c, 0x65, 0x63, 0x74, 0x46, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x10, 0xc7, 0xd5, 0x08, 0x12,
+	0x23, 0x0a, 0x1d, 0x4b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x44, 0x6f, 0x63, 0x56,
+	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x44, 0x69, 0x66, 0x66, 0x46, 0x61, 0x69, 0x6c, 0x65, 0x64,
+	0x10, 0xc8, 0xd5, 0x08, 0x12, 0x27, 0x0a, 0x21, 0x4b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67,
+	0x65, 0x44, 0x6f, 0x63, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x6f, 0x6c, 0x6c, 0x62,
+	0x61, 0x63, 0x6b, 0x46, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x10, 0xc9, 0xd5, 0x08, 0x12, 0x26, 0x0a,
+	0x20, 0x4b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x44, 0x6f, 0x63, 0x56, 0x65, 0x72,
+	0x73, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x49, 0x6e, 0x76, 0x61, 0x6c, 0x69,
+	0x64, 0x10, 0xca, 0xd5, 0x08, 0x12, 0x20, 0x0a, 0x1a, 0x4b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64,
+	0x67, 0x65, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x44, 0x69, 0x73, 0x61, 0x62,
+	0x6c, 0x65, 0x64, 0x10, 0xcb, 0xd5, 0x08, 0x12, 0x1e, 0x0a, 0x18, 0x4b, 0x6e, 0x6f, 0x77, 0x6c,
+	0x65, 0x64, 0x67, 0x65, 0x54, 0x61, 0x67, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x46, 0x61, 0x69,
+	0x6c, 0x65, 0x64, 0x10, 0x99, 0xdd, 0x08, 0x12, 0x1e, 0x0a, 0x18, 0x4b, 0x6e, 0x6f, 0x77, 0x6c,
+	0x65, 0x64, 0x67, 0x65, 0x54, 0x61, 0x67, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x46, 0x61, 0x69,
+	0x6c, 0x65, 0x64, 0x10, 0x9a, 0xdd, 0x08, 0x12, 0x1e, 0x0a, 0x18, 0x4b, 0x6e, 0x6f, 0x77, 0x6c,
+	0x65, 0x64, 0x67, 0x65, 0x54, 0x61, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x46, 0x61, 0x69,
+	0x6c, 0x65, 0x64, 0x10, 0x9b, 0xdd, 0x08, 0x12, 0x1f, 0x0a, 0x19, 0x4b, 0x6e, 0x6f, 0x77, 0x6c,
+	0x65, 0x64, 0x67, 0x65, 0x54, 0x61, 0x67, 0x44, 0x75, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x65,
+	0x4e, 0x61, 0x6d, 0x65, 0x10, 0x9c, 0xdd, 0x08, 0x12, 0x1e, 0x0a, 0x18, 0x4b, 0x6e, 0x6f, 0x77,
+	0x6c, 0x65, 0x64, 0x67, 0x65, 0x54, 0x61, 0x67, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x46, 0x61,
+	0x69, 0x6c, 0x65, 0x64, 0x10, 0x9d, 0xdd, 0x08, 0x12, 0x1c, 0x0a, 0x16, 0x4b, 0x6e, 0x6f, 0x77,
+	0x6c, 0x65, 0x64, 0x67, 0x65, 0x54, 0x61, 0x67, 0x42, 0x69, 0x6e, 0x64, 0x46, 0x61, 0x69, 0x6c,
+	0x65, 0x64, 0x10, 0x9e, 0xdd, 0x08, 0x12, 0x1e, 0x0a, 0x18, 0x4b, 0x6e, 0x6f, 0x77, 0x6c, 0x65,
+	0x64, 0x67, 0x65, 0x54, 0x61, 0x67, 0x55, 0x6e, 0x62, 0x69, 0x6e, 0x64, 0x46, 0x61, 0x69, 0x6c,
+	0x65, 0x64, 0x10, 0x9f, 0xdd, 0x08, 0x12, 0x1e, 0x0a, 0x18, 0x4b, 0x6e, 0x6f, 0x77, 0x6c, 0x65,
+	0x64, 0x67, 0x65, 0x54, 0x61, 0x67, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x44, 0x65, 0x6e, 0x69,
+	0x65, 0x64, 0x10, 0xa0, 0xdd, 0x08, 0x12, 0x23, 0x0a, 0x1d, 0x4b, 0x6e, 0x6f, 0x77, 0x6c, 0x65,
+	0x64, 0x67, 0x65, 0x4b, 0x65, 0x79, 0x77, 0x6f, 0x72, 0x64, 0x73, 0x43, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x46, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x10, 0x81, 0xe5, 0x08, 0x12, 0x23, 0x0a, 0x1d, 0x4b,
+	0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x4b, 0x65, 0x79, 0x77, 0x6f, 0x72, 0x64, 0x73,
+	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x46, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x10, 0x82, 0xe5, 0x08,
+	0x12, 0x23, 0x0a, 0x1d, 0x4b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x4b, 0x65, 0x79,
+	0x77, 0x6f, 0x72, 0x64, 0x73, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x46, 0x61, 0x69, 0x6c, 0x65,
+	0x64, 0x10, 0x83, 0xe5, 0x08, 0x12, 0x21, 0x0a, 0x1b, 0x4b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64,
+	0x67, 0x65, 0x4b, 0x65, 0x79, 0x77, 0x6f, 0x72, 0x64, 0x73, 0x4c, 0x69, 0x73, 0x74, 0x46, 0x61,
+	0x69, 0x6c, 0x65, 0x64, 0x10, 0x84, 0xe5, 0x08, 0x12, 0x21, 0x0a, 0x1b, 0x4b, 0x6e, 0x6f, 0x77,
+	0x6c, 0x65, 0x64, 0x67, 0x65, 0x4b, 0x65, 0x79, 0x77, 0x6f, 0x72, 0x64, 0x73, 0x49, 0x6e, 0x66,
+	0x6f, 0x46, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x10, 0x85, 0xe5, 0x08, 0x12, 0x1f, 0x0a, 0x19, 0x4b,
+	0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x4b, 0x65, 0x79, 0x77, 0x6f, 0x72, 0x64, 0x73,
+	0x52, 0x65, 0x70, 0x65, 0x61, 0x74, 0x65, 0x64, 0x10, 0x86, 0xe5, 0x08, 0x12, 0x23, 0x0a, 0x1d,
+	0x4b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x53, 0x70, 0x6c, 0x69, 0x74, 0x74, 0x65,
+	0x72, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x46, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x10, 0xe9, 0xec,
+	0x08, 0x12, 0x23, 0x0a, 0x1d, 0x4b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x53, 0x70,
+	0x6c, 0x69, 0x74, 0x74, 0x65, 0x72, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x46, 0x61, 0x69, 0x6c,
+	0x65, 0x64, 0x10, 0xea, 0xec, 0x08, 0x12, 0x23, 0x0a, 0x1d, 0x4b, 0x6e, 0x6f, 0x77, 0x6c, 0x65,
+	0x64, 0x67, 0x65, 0x53, 0x70, 0x6c, 0x69, 0x74, 0x74, 0x65, 0x72, 0x55, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x46, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x10, 0xeb, 0xec, 0x08, 0x12, 0x24, 0x0a, 0x1e, 0x4b,
+	0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x53, 0x70, 0x6c, 0x69, 0x74, 0x74, 0x65, 0x72,
+	0x44, 0x75, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x10, 0xec, 0xec,
+	0x08, 0x12, 0x23, 0x0a, 0x1d, 0x4b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x53, 0x70,
+	0x6c, 0x69, 0x74, 0x74, 0x65, 0x72, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x46, 0x61, 0x69, 0x6c,
+	0x65, 0x64, 0x10, 0xed, 0xec, 0x08, 0x12, 0x23, 0x0a, 0x1d, 0x4b, 0x6e, 0x6f, 0x77, 0x6c, 0x65,
+	0x64, 0x67, 0x65, 0x53, 0x70, 0x6c, 0x69, 0x74, 0x74, 0x65, 0x72, 0x41, 0x63, 0x63, 0x65, 0x73,
+	0x73, 0x44, 0x65, 0x6e, 0x69, 0x65, 0x64, 0x10, 0xf0, 0xec, 0x08, 0x12, 0x2b, 0x0a, 0x25, 0x4b,
 	0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x44, 0x6f, 0x63, 0x53, 0x65, 0x67, 0x6d, 0x65,
-	0x6e, 0x74, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x46, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x10, 0xf4,
-	0xec, 0x08, 0x12, 0x25, 0x0a, 0x1f, 0x4b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x44,
-	0x6f, 0x63, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x46,
-	0x61, 0x69, 0x6c, 0x65, 0x64, 0x10, 0xf5, 0xec, 0x08, 0x12, 0x1e, 0x0a, 0x18, 0x4b, 0x6e, 0x6f,
-	0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x4d, 0x65, 0x74, 0x61, 0x46, 0x65, 0x74, 0x63, 0x68, 0x46,
-	0x61, 0x69, 0x6c, 0x65, 0x64, 0x10, 0xf6, 0xec, 0x08, 0x12, 0x1f, 0x0a, 0x19, 0x4b, 0x6e, 0x6f,
-	0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x4d, 0x65, 0x74, 0x61, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
-	0x46, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x10, 0xf7, 0xec, 0x08, 0x12, 0x1f, 0x0a, 0x19, 0x4b, 0x6e,
+	0x6e, 0x74, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x46, 0x61,
+	0x69, 0x6c, 0x65, 0x64, 0x10, 0xf1, 0xec, 0x08, 0x12, 0x25, 0x0a, 0x1f, 0x4b, 0x6e, 0x6f, 0x77,
+	0x6c, 0x65, 0x64, 0x67, 0x65, 0x44, 0x6f, 0x63, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x43,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x46, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x10, 0xf2, 0xec, 0x08, 0x12,
+	0x26, 0x0a, 0x20, 0x4b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x44, 0x6f, 0x63, 0x53,
+	0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x45, 0x78, 0x63, 0x65, 0x65, 0x64, 0x4d, 0x61, 0x78, 0x53,
+	0x69, 0x7a, 0x65, 0x10, 0xf3, 0xec, 0x08, 0x12, 0x25, 0x0a, 0x1f, 0x4b, 0x6e, 0x6f, 0x77, 0x6c,
+	0x65, 0x64, 0x67, 0x65, 0x44, 0x6f, 0x63, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x55, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x46, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x10, 0xf4, 0xec, 0x08, 0x12, 0x25,
+	0x0a, 0x1f, 0x4b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x44, 0x6f, 0x63, 0x53, 0x65,
+	0x67, 0x6d, 0x65, 0x6e, 0x74, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x46, 0x61, 0x69, 0x6c, 0x65,
+	0x64, 0x10, 0xf5, 0xec, 0x08, 0x12, 0x1e, 0x0a, 0x18, 0x4b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64,
+	0x67, 0x65, 0x4d, 0x65, 0x74, 0x61, 0x46, 0x65, 0x74, 0x63, 0x68, 0x46, 0x61, 0x69, 0x6c, 0x65,
+	0x64, 0x10, 0xf6, 0xec, 0x08, 0x12, 0x1f, 0x0a, 0x19, 0x4b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64,
+	0x67, 0x65, 0x4d, 0x65, 0x74, 0x61, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x46, 0x61, 0x69, 0x6c,
+	0x65, 0x64, 0x10, 0xf7, 0xec, 0x08, 0x12, 0x1f, 0x0a, 0x19, 0x4b, 0x6e, 0x6f, 0x77, 0x6c, 0x65,
+	0x64, 0x67, 0x65, 0x4d, 0x65, 0x74, 0x61, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x46, 0x61, 0x69,
+	0x6c, 0x65, 0x64, 0x10, 0xf8, 0xec, 0x08, 0x12, 0x1f, 0x0a, 0x19, 0x4b, 0x6e, 0x6f, 0x77, 0x6c,
+	0x65, 0x64, 0x67, 0x65, 0x4d, 0x65, 0x74, 0x61, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x46, 0x61,
+	0x69, 0x6c, 0x65, 0x64, 0x10, 0xf9, 0xec, 0x08, 0x12, 0x1f, 0x0a, 0x19, 0x4b, 0x6e, 0x6f, 0x77,
+	0x6c, 0x65, 0x64, 0x67, 0x65, 0x4d, 0x65, 0x74, 0x61, 0x44, 0x75, 0x70, 0x6c, 0x69, 0x63, 0x61,
+	0x74, 0x65, 0x4b, 0x65, 0x79, 0x10, 0xfa, 0xec, 0x08, 0x12, 0x1f, 0x0a, 0x19, 0x4b, 0x6e, 0x6f,
+	0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x49, 0x6e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x41, 0x72, 0x67,
+	0x75, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x10, 0xfb, 0xec, 0x08, 0x12, 0x1e, 0x0a, 0x18, 0x4b, 0x6e,
+	0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x44, 0x6f, 0x63, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e,
+	0x74, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x10, 0xfc, 0xec, 0x08, 0x12, 0x27, 0x0a, 0x21, 0x4b, 0x6e,
 	0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x4d, 0x65, 0x74, 0x61, 0x55, 0x70, 0x64, 0x61, 0x74,
-	0x65, 0x46, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x10, 0xf8, 0xec, 0x08, 0x12, 0x1f, 0x0a, 0x19, 0x4b,
-	0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x4d, 0x65, 0x74, 0x61, 0x44, 0x65, 0x6c, 0x65,
-	0x74, 0x65, 0x46, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x10, 0xf9, 0xec, 0x08, 0x12, 0x1f, 0x0a, 0x19,
-	0x4b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x4d, 0x65, 0x74, 0x61, 0x44, 0x75, 0x70,
-	0x6c, 0x69, 0x63, 0x61, 0x74, 0x65, 0x4b, 0x65, 0x79, 0x10, 0xfa, 0xec, 0x08, 0x12, 0x1f, 0x0a,
-	0x19, 0x4b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x49, 0x6e, 0x76, 0x61, 0x6c, 0x69,
-	0x64, 0x41, 0x72, 0x67, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x10, 0xfb, 0xec, 0x08, 0x12, 0x1e,
-	0x0a, 0x18, 0x4b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x44, 0x6f, 0x63, 0x53, 0x65,
-	0x67, 0x6d, 0x65, 0x6e, 0x74, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x10, 0xfc, 0xec, 0x08, 0x12, 0x27,
-	0x0a, 0x21, 0x4b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x4d, 0x65, 0x74, 0x61, 0x55,
-	0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x61, 0x72, 0x74, 0x69, 0x61, 0x6c, 0x53, 0x75, 0x63, 0x63,
-	0x65, 0x73, 0x73, 0x10, 0xfd, 0xec, 0x08, 0x12, 0x10, 0x0a, 0x0a, 0x52, 0x61, 0x67, 0x47, 0x65,
-	0x6e, 0x65, 0x72, 0x61, 0x6c, 0x10, 0xf0, 0x93, 0x09, 0x12, 0x0d, 0x0a, 0x07, 0x52, 0x61, 0x67,
-	0x52, 0x6f, 0x6c, 0x65, 0x10, 0xf1, 0x93, 0x09, 0x12, 0x15, 0x0a, 0x0f, 0x52, 0x61, 0x67, 0x49,
-	0x6e, 0x66, 0x6f, 0x4e, 0x6f, 0x74, 0x45, 0x78, 0x69, 0x73, 0x74, 0x10, 0xf2, 0x93, 0x09, 0x12,
-	0x12, 0x0a, 0x0c, 0x52, 0x61, 0x67, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x45, 0x72, 0x72, 0x10,
-	0xf3, 0x93, 0x09, 0x12, 0x0f, 0x0a, 0x09, 0x52, 0x61, 0x67, 0x47, 0x65, 0x74, 0x45, 0x72, 0x72,
-	0x10, 0xf4, 0x93, 0x09, 0x12, 0x10, 0x0a, 0x0a, 0x52, 0x61, 0x67, 0x4c, 0x69, 0x73, 0x74, 0x45,
-	0x72, 0x72, 0x10, 0xf5, 0x93, 0x09, 0x12, 0x12, 0x0a, 0x0c, 0x52, 0x61, 0x67, 0x43, 0x72, 0x65,
-	0x61, 0x74, 0x65, 0x45, 0x72, 0x72, 0x10, 0xf6, 0x93, 0x09, 0x12, 0x12, 0x0a, 0x0c, 0x52, 0x61,
-	0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x45, 0x72, 0x72, 0x10, 0xf7, 0x93, 0x09, 0x12, 0x10,
-	0x0a, 0x0a, 0x52, 0x61, 0x67, 0x43, 0x68, 0x61, 0x74, 0x45, 0x72, 0x72, 0x10, 0xf8, 0x93, 0x09,
-	0x12, 0x16, 0x0a, 0x10, 0x41, 0x73, 0x73, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x74, 0x47, 0x65, 0x6e,
-	0x65, 0x72, 0x61, 0x6c, 0x10, 0x80, 0xe2, 0x09, 0x12, 0x12, 0x0a, 0x0c, 0x41, 0x73, 0x73, 0x69,
-	0x73, 0x74, 0x61, 0x6e, 0x74, 0x45, 0x72, 0x72, 0x10, 0x81, 0xe2, 0x09, 0x12, 0x18, 0x0a, 0x12,
-	0x41, 0x73, 0x73, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x74, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x45,
-	0x72, 0x72, 0x10, 0x82, 0xe2, 0x09, 0x12, 0x1a, 0x0a, 0x14, 0x41, 0x73, 0x73, 0x69, 0x73, 0x74,
-	0x61, 0x6e, 0x74, 0x57, 0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x45, 0x72, 0x72, 0x10, 0x83,
-	0xe2, 0x09, 0x12, 0x1e, 0x0a, 0x18, 0x41, 0x73, 0x73, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x74, 0x43,
-	0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x72, 0x72, 0x10, 0x84,
-	0xe2, 0x09, 0x12, 0x15, 0x0a, 0x0f, 0x41, 0x73, 0x73, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x74, 0x4d,
-	0x43, 0x50, 0x45, 0x72, 0x72, 0x10, 0x85, 0xe2, 0x09, 0x12, 0x18, 0x0a, 0x12, 0x41, 0x73, 0x73,
-	0x69, 0x73, 0x74, 0x61, 0x6e, 0x74, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x45, 0x72, 0x72, 0x10,
-	0x86, 0xe2, 0x09, 0x12, 0x15, 0x0a, 0x0f, 0x57, 0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x47,
-	0x65, 0x6e, 0x65, 0x72, 0x61, 0x6c, 0x10, 0xd0, 0xe8, 0x0c, 0x12, 0x12, 0x0a, 0x0c, 0x4d, 0x6f,
-	0x64, 0x65, 0x6c, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x6c, 0x10, 0x90, 0xa1, 0x0f, 0x12, 0x18,
-	0x0a, 0x12, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x4d,
-	0x6f, 0x64, 0x65, 0x6c, 0x10, 0x91, 0xa1, 0x0f, 0x12, 0x17, 0x0a, 0x11, 0x4d, 0x6f, 0x64, 0x65,
-	0x6c, 0x47, 0x65, 0x74, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x42, 0x79, 0x49, 0x64, 0x10, 0x92, 0xa1,
-	0x0f, 0x12, 0x16, 0x0a, 0x10, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
-	0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x10, 0x93, 0xa1, 0x0f, 0x12, 0x16, 0x0a, 0x10, 0x4d, 0x6f, 0x64,
-	0x65, 0x6c, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x10, 0x94, 0xa1,
-	0x0f, 0x12, 0x13, 0x0a, 0x0d, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x47, 0x65, 0x74, 0x4d, 0x6f, 0x64,
-	0x65, 0x6c, 0x10, 0x95, 0xa1, 0x0f, 0x12, 0x15, 0x0a, 0x0f, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x4c,
-	0x69, 0x73, 0x74, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x10, 0x96, 0xa1, 0x0f, 0x12, 0x1c, 0x0a,
-	0x16, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x4d, 0x6f, 0x64, 0x65,
-	0x6c, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x10, 0x97, 0xa1, 0x0f, 0x12, 0x19, 0x0a, 0x13, 0x4d,
-	0x6f, 0x64, 0x65, 0x6c, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x79, 0x70, 0x65, 0x4d, 0x6f, 0x64, 0x65,
-	0x6c, 0x73, 0x10, 0x98, 0xa1, 0x0f, 0x12, 0x18, 0x0a, 0x12, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x47,
-	0x65, 0x74, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x42, 0x79, 0x49, 0x64, 0x73, 0x10, 0x99, 0xa1, 0x0f,
-	0x12, 0x10, 0x0a, 0x0a, 0x41, 0x70, 0x70, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x6c, 0x10, 0xe0,
-	0xa7, 0x12, 0x12, 0x0f, 0x0a, 0x09, 0x41, 0x70, 0x70, 0x41, 0x70, 0x69, 0x6b, 0x65, 0x79, 0x10,
-	0xe1, 0xa7, 0x12, 0x12, 0x14, 0x0a, 0x0e, 0x41, 0x70, 0x70, 0x45, 0x78, 0x70, 0x6c, 0x6f, 0x72,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x10, 0xe2, 0xa7, 0x12, 0x12, 0x0f, 0x0a, 0x09, 0x41, 0x70, 0x70,
-	0x53, 0x61, 0x66, 0x65, 0x74, 0x79, 0x10, 0xe3, 0xa7, 0x12, 0x12, 0x21, 0x0a, 0x1b, 0x41, 0x70,
-	0x70, 0x53, 0x61, 0x66, 0x65, 0x74, 0x79, 0x53, 0x65, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x76, 0x65,
-	0x57, 0x6f, 0x72, 0x64, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x10, 0xe4, 0xa7, 0x12, 0x12, 0x22, 0x0a,
-	0x1c, 0x41, 0x70, 0x70, 0x53, 0x61, 0x66, 0x65, 0x74, 0x79, 0x53, 0x65, 0x6e, 0x73, 0x69, 0x74,
-	0x69, 0x76, 0x65, 0x56, 0x6f, 0x63, 0x61, 0x62, 0x75, 0x6c, 0x61, 0x72, 0x79, 0x10, 0xe5, 0xa7,
-	0x12, 0x12, 0x1f, 0x0a, 0x19, 0x41, 0x70, 0x70, 0x53, 0x65, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x76,
-	0x65, 0x53, 0x61, 0x6d, 0x65, 0x57, 0x6f, 0x72, 0x64, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x10, 0xe6,
-	0xa7, 0x12, 0x12, 0x25, 0x0a, 0x1f, 0x41, 0x70, 0x70, 0x53, 0x65, 0x6e, 0x73, 0x69, 0x74, 0x69,
-	0x76, 0x65, 0x45, 0x78, 0x69, 0x73, 0x74, 0x53, 0x65, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x76, 0x65,
-	0x45, 0x72, 0x72, 0x6f, 0x72, 0x10, 0xe7, 0xa7, 0x12, 0x12, 0x1e, 0x0a, 0x18, 0x41, 0x70, 0x70,
-	0x53, 0x61, 0x66, 0x65, 0x74, 0x79, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x55, 0x72, 0x6c, 0x46,
-	0x61, 0x69, 0x6c, 0x65, 0x64, 0x10, 0xe8, 0xa7, 0x12, 0x12, 0x0c, 0x0a, 0x06, 0x41, 0x70, 0x70,
-	0x55, 0x72, 0x6c, 0x10, 0xe9, 0xa7, 0x12, 0x12, 0x12, 0x0a, 0x0c, 0x41, 0x70, 0x70, 0x55, 0x72,
-	0x6c, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x10, 0xea, 0xa7, 0x12, 0x12, 0x13, 0x0a, 0x0d, 0x41,
-	0x70, 0x70, 0x55, 0x72, 0x6c, 0x45, 0x78, 0x70, 0x69, 0x72, 0x65, 0x64, 0x10, 0xeb, 0xa7, 0x12,
-	0x12, 0x10, 0x0a, 0x0a, 0x4d, 0x43, 0x50, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x6c, 0x10, 0xf0,
-	0xf5, 0x12, 0x12, 0x18, 0x0a, 0x12, 0x4d, 0x43, 0x50, 0x47, 0x65, 0x74, 0x53, 0x71, 0x75, 0x61,
-	0x72, 0x65, 0x4d, 0x43, 0x50, 0x45, 0x72, 0x72, 0x10, 0xf1, 0xf5, 0x12, 0x12, 0x1b, 0x0a, 0x15,
-	0x4d, 0x43, 0x50, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x4d,
-	0x43, 0x50, 0x45, 0x72, 0x72, 0x10, 0xf2, 0xf5, 0x12, 0x12, 0x18, 0x0a, 0x12, 0x4d, 0x43, 0x50,
-	0x47, 0x65, 0x74, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x4d, 0x43, 0x50, 0x45, 0x72, 0x72, 0x10,
-	0xf3, 0xf5, 0x12, 0x12, 0x1b, 0x0a, 0x15, 0x4d, 0x43, 0x50, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
-	0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x4d, 0x43, 0x50, 0x45, 0x72, 0x72, 0x10, 0xf4, 0xf5, 0x12,
-	0x12, 0x1c, 0x0a, 0x16, 0x4d, 0x43, 0x50, 0x47, 0x65, 0x74, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d,
-	0x4d, 0x43, 0x50, 0x4c, 0x69, 0x73, 0x74, 0x45, 0x72, 0x72, 0x10, 0xf5, 0xf5, 0x12, 0x12, 0x18,
-	0x0a, 0x12, 0x4d, 0x43, 0x50, 0x47, 0x65, 0x74, 0x4d, 0x43, 0x50, 0x41, 0x76, 0x61, 0x74, 0x61,
-	0x72, 0x45, 0x72, 0x72, 0x10, 0xf6, 0xf5, 0x12, 0x12, 0x1c, 0x0a, 0x16, 0x4d, 0x43, 0x50, 0x43,
-	0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x54, 0x6f, 0x6f, 0x6c, 0x45,
-	0x72, 0x72, 0x10, 0xf7, 0xf5, 0x12, 0x12, 0x1d, 0x0a, 0x17, 0x4d, 0x43, 0x50, 0x47, 0x65, 0x74,
-	0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x54, 0x6f, 0x6f, 0x6c, 0x49, 0x6e, 0x66, 0x6f, 0x45, 0x72,
-	0x72, 0x10, 0xf8, 0xf5, 0x12, 0x12, 0x1d, 0x0a, 0x17, 0x4d, 0x43, 0x50, 0x47, 0x65, 0x74, 0x43,
-	0x75, 0x73, 0x74, 0x6f, 0x6d, 0x54, 0x6f, 0x6f, 0x6c, 0x4c, 0x69, 0x73, 0x74, 0x45, 0x72, 0x72,
-	0x10, 0xf9, 0xf5, 0x12, 0x12, 0x1c, 0x0a, 0x16, 0x4d, 0x43, 0x50, 0x55, 0x70, 0x64, 0x61, 0x74,
-	0x65, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x54, 0x6f, 0x6f, 0x6c, 0x45, 0x72, 0x72, 0x10, 0xfa,
-	0xf5, 0x12, 0x12, 0x1c, 0x0a, 0x16, 0x4d, 0x43, 0x50, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43,
-	0x75, 0x73, 0x74, 0x6f, 0x6d, 0x54, 0x6f, 0x6f, 0x6c, 0x45, 0x72, 0x72, 0x10, 0xfb, 0xf5, 0x12,
-	0x12, 0x19, 0x0a, 0x13, 0x4d, 0x43, 0x50, 0x47, 0x65, 0x74, 0x53, 0x71, 0x75, 0x61, 0x72, 0x65,
-	0x54, 0x6f, 0x6f, 0x6c, 0x45, 0x72, 0x72, 0x10, 0xfc, 0xf5, 0x12, 0x12, 0x14, 0x0a, 0x0e, 0x4f,
-	0x70, 0x65, 0x72, 0x61, 0x74, 0x65, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x6c, 0x10, 0x80, 0xc4,
-	0x13, 0x12, 0x13, 0x0a, 0x0d, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x65, 0x43, 0x75, 0x73, 0x74,
-	0x6f, 0x6d, 0x10, 0x81, 0xc4, 0x13, 0x42, 0x2e, 0x5a, 0x2c, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62,
-	0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x55, 0x6e, 0x69, 0x63, 0x6f, 0x6d, 0x41, 0x49, 0x2f, 0x77, 0x61,
-	0x6e, 0x77, 0x75, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x65, 0x72,
-	0x72, 0x2d, 0x63, 0x6f, 0x64, 0x65, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x65, 0x50, 0x61, 0x72, 0x74, 0x69, 0x61, 0x6c, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x10,
+	0xfd, 0xec, 0x08, 0x12, 0x25, 0x0a, 0x1f, 0x4b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65,
+	0x44, 0x6f, 0x63, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74,
+	0x46, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x10, 0xfe, 0xec, 0x08, 0x12, 0x2b, 0x0a, 0x25, 0x4b, 0x6e,
+	0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x44, 0x6f, 0x63, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e,
+	0x74, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x46, 0x61, 0x69,
+	0x6c, 0x65, 0x64, 0x10, 0xff, 0xec, 0x08, 0x12, 0x24, 0x0a, 0x1e, 0x4b, 0x6e, 0x6f, 0x77, 0x6c,
+	0x65, 0x64, 0x67, 0x65, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x47, 0x72,
+	0x61, 0x6e, 0x74, 0x46, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x10, 0xd1, 0xf4, 0x08, 0x12, 0x25, 0x0a,
+	0x1f, 0x4b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x46, 0x61, 0x69, 0x6c, 0x65, 0x64,
+	0x10, 0xd2, 0xf4, 0x08, 0x12, 0x25, 0x0a, 0x1f, 0x4b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67,
+	0x65, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x53, 0x65, 0x6c, 0x65, 0x63,
+	0x74, 0x46, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x10, 0xd3, 0xf4, 0x08, 0x12, 0x21, 0x0a, 0x1b, 0x4b,
+	0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x42, 0x75, 0x6e, 0x64, 0x6c, 0x65, 0x45, 0x78,
+	0x70, 0x6f, 0x72, 0x74, 0x46, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x10, 0xb9, 0xfc, 0x08, 0x12, 0x21,
+	0x0a, 0x1b, 0x4b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x42, 0x75, 0x6e, 0x64, 0x6c,
+	0x65, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x46, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x10, 0xba, 0xfc,
+	0x08, 0x12, 0x21, 0x0a, 0x1b, 0x4b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x42, 0x75,
+	0x6e, 0x64, 0x6c, 0x65, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x46, 0x61, 0x69, 0x6c, 0x65, 0x64,
+	0x10, 0xbb, 0xfc, 0x08, 0x12, 0x20, 0x0a, 0x1a, 0x4b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67,
+	0x65, 0x42, 0x75, 0x6e, 0x64, 0x6c, 0x65, 0x46, 0x69, 0x6c, 0x65, 0x49, 0x6e, 0x76, 0x61, 0x6c,
+	0x69, 0x64, 0x10, 0xbc, 0xfc, 0x08, 0x12, 0x26, 0x0a, 0x20, 0x4b, 0x6e, 0x6f, 0x77, 0x6c, 0x65,
+	0x64, 0x67, 0x65, 0x45, 0x76, 0x61, 0x6c, 0x44, 0x61, 0x74, 0x61, 0x73, 0x65, 0x74, 0x43, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x46, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x10, 0xa1, 0x84, 0x09, 0x12, 0x26,
+	0x0a, 0x20, 0x4b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x45, 0x76, 0x61, 0x6c, 0x44,
+	0x61, 0x74, 0x61, 0x73, 0x65, 0x74, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x46, 0x61, 0x69, 0x6c,
+	0x65, 0x64, 0x10, 0xa2, 0x84, 0x09, 0x12, 0x26, 0x0a, 0x20, 0x4b, 0x6e, 0x6f, 0x77, 0x6c, 0x65,
+	0x64, 0x67, 0x65, 0x45, 0x76, 0x61, 0x6c, 0x44, 0x61, 0x74, 0x61, 0x73, 0x65, 0x74, 0x44, 0x65,
+	0x6c, 0x65, 0x74, 0x65, 0x46, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x10, 0xa3, 0x84, 0x09, 0x12, 0x22,
+	0x0a, 0x1c, 0x4b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x45, 0x76, 0x61, 0x6c, 0x52,
+	0x75, 0x6e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x46, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x10, 0xa4,
+	0x84, 0x09, 0x12, 0x22, 0x0a, 0x1c, 0x4b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x45,
+	0x76, 0x61, 0x6c, 0x52, 0x75, 0x6e, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x46, 0x61, 0x69, 0x6c,
+	0x65, 0x64, 0x10, 0xa5, 0x84, 0x09, 0x12, 0x23, 0x0a, 0x1d, 0x4b, 0x6e, 0x6f, 0x77, 0x6c, 0x65,
+	0x64, 0x67, 0x65, 0x45, 0x76, 0x61, 0x6c, 0x52, 0x75, 0x6e, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x72,
+	0x65, 0x46, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x10, 0xa6, 0x84, 0x09, 0x12, 0x10, 0x0a, 0x0a, 0x52,
+	0x61, 0x67, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x6c, 0x10, 0xf0, 0x93, 0x09, 0x12, 0x0d, 0x0a,
+	0x07, 0x52, 0x61, 0x67, 0x52, 0x6f, 0x6c, 0x65, 0x10, 0xf1, 0x93, 0x09, 0x12, 0x15, 0x0a, 0x0f,
+	0x52, 0x61, 0x67, 0x49, 0x6e, 0x66, 0x6f, 0x4e, 0x6f, 0x74, 0x45, 0x78, 0x69, 0x73, 0x74, 0x10,
+	0xf2, 0x93, 0x09, 0x12, 0x12, 0x0a, 0x0c, 0x52, 0x61, 0x67, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
+	0x45, 0x72, 0x72, 0x10, 0xf3, 0x93, 0x09, 0x12, 0x0f, 0x0a, 0x09, 0x52, 0x61, 0x67, 0x47, 0x65,
+	0x74, 0x45, 0x72, 0x72, 0x10, 0xf4, 0x93, 0x09, 0x12, 0x10, 0x0a, 0x0a, 0x52, 0x61, 0x67, 0x4c,
+	0x69, 0x73, 0x74, 0x45, 0x72, 0x72, 0x10, 0xf5, 0x93, 0x09, 0x12, 0x12, 0x0a, 0x0c, 0x52, 0x61,
+	0x67, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x45, 0x72, 0x72, 0x10, 0xf6, 0x93, 0x09, 0x12, 0x12,
+	0x0a, 0x0c, 0x52, 0x61, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x45, 0x72, 0x72, 0x10, 0xf7,
+	0x93, 0x09, 0x12, 0x10, 0x0a, 0x0a, 0x52, 0x61, 0x67, 0x43, 0x68, 0x61, 0x74, 0x45, 0x72, 0x72,
+	0x10, 0xf8, 0x93, 0x09, 0x12, 0x14, 0x0a, 0x0e, 0x52, 0x61, 0x67, 0x46, 0x65, 0x65, 0x64, 0x62,
+	0x61, 0x63, 0x6b, 0x45, 0x72, 0x72, 0x10, 0xfa, 0x93, 0x09, 0x12, 0x16, 0x0a, 0x10, 0x41, 0x73,
+	0x73, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x74, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x6c, 0x10, 0x80,
+	0xe2, 0x09, 0x12, 0x12, 0x0a, 0x0c, 0x41, 0x73, 0x73, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x74, 0x45,
+	0x72, 0x72, 0x10, 0x81, 0xe2, 0x09, 0x12, 0x18, 0x0a, 0x12, 0x41, 0x73, 0x73, 0x69, 0x73, 0x74,
+	0x61, 0x6e, 0x74, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x72, 0x72, 0x10, 0x82, 0xe2, 0x09,
+	0x12, 0x1a, 0x0a, 0x14, 0x41, 0x73, 0x73, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x74, 0x57, 0x6f, 0x72,
+	0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x45, 0x72, 0x72, 0x10, 0x83, 0xe2, 0x09, 0x12, 0x1e, 0x0a, 0x18,
+	0x41, 0x73, 0x73, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x74, 0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x72, 0x72, 0x10, 0x84, 0xe2, 0x09, 0x12, 0x15, 0x0a, 0x0f,
+	0x41, 0x73, 0x73, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x74, 0x4d, 0x43, 0x50, 0x45, 0x72, 0x72, 0x10,
+	0x85, 0xe2, 0x09, 0x12, 0x18, 0x0a, 0x12, 0x41, 0x73, 0x73, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x74,
+	0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x45, 0x72, 0x72, 0x10, 0x86, 0xe2, 0x09, 0x12, 0x1a, 0x0a,
+	0x14, 0x41, 0x73, 0x73, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x74, 0x46, 0x65, 0x65, 0x64, 0x62, 0x61,
+	0x63, 0x6b, 0x45, 0x72, 0x72, 0x10, 0x87, 0xe2, 0x09, 0x12, 0x15, 0x0a, 0x0f, 0x57, 0x6f, 0x72,
+	0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x6c, 0x10, 0xd0, 0xe8, 0x0c,
+	0x12, 0x12, 0x0a, 0x0c, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x6c,
+	0x10, 0x90, 0xa1, 0x0f, 0x12, 0x18, 0x0a, 0x12, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x49, 0x6d, 0x70,
+	0x6f, 0x72, 0x74, 0x65, 0x64, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x10, 0x91, 0xa1, 0x0f, 0x12, 0x17,
+	0x0a, 0x11, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x47, 0x65, 0x74, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x42,
+	0x79, 0x49, 0x64, 0x10, 0x92, 0xa1, 0x0f, 0x12, 0x16, 0x0a, 0x10, 0x4d, 0x6f, 0x64, 0x65, 0x6c,
+	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x10, 0x93, 0xa1, 0x0f, 0x12,
+	0x16, 0x0a, 0x10, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4d, 0x6f,
+	0x64, 0x65, 0x6c, 0x10, 0x94, 0xa1, 0x0f, 0x12, 0x13, 0x0a, 0x0d, 0x4d, 0x6f, 0x64, 0x65, 0x6c,
+	0x47, 0x65, 0x74, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x10, 0x95, 0xa1, 0x0f, 0x12, 0x15, 0x0a, 0x0f,
+	0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x10,
+	0x96, 0xa1, 0x0f, 0x12, 0x1c, 0x0a, 0x16, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x43, 0x68, 0x61, 0x6e,
+	0x67, 0x65, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x10, 0x97, 0xa1,
+	0x0f, 0x12, 0x19, 0x0a, 0x13, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x79,
+	0x70, 0x65, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x10, 0x98, 0xa1, 0x0f, 0x12, 0x18, 0x0a, 0x12,
+	0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x47, 0x65, 0x74, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x42, 0x79, 0x49,
+	0x64, 0x73, 0x10, 0x99, 0xa1, 0x0f, 0x12, 0x10, 0x0a, 0x0a, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x55,
+	0x73, 0x61, 0x67, 0x65, 0x10, 0x9a, 0xa1, 0x0f, 0x12, 0x10, 0x0a, 0x0a, 0x4d, 0x6f, 0x64, 0x65,
+	0x6c, 0x51, 0x75, 0x6f, 0x74, 0x61, 0x10, 0x9b, 0xa1, 0x0f, 0x12, 0x10, 0x0a, 0x0a, 0x41, 0x70,
+	0x70, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x6c, 0x10, 0xe0, 0xa7, 0x12, 0x12, 0x0f, 0x0a, 0x09,
+	0x41, 0x70, 0x70, 0x41, 0x70, 0x69, 0x6b, 0x65, 0x79, 0x10, 0xe1, 0xa7, 0x12, 0x12, 0x14, 0x0a,
+	0x0e, 0x41, 0x70, 0x70, 0x45, 0x78, 0x70, 0x6c, 0x6f, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x10,
+	0xe2, 0xa7, 0x12, 0x12, 0x0f, 0x0a, 0x09, 0x41, 0x70, 0x70, 0x53, 0x61, 0x66, 0x65, 0x74, 0x79,
+	0x10, 0xe3, 0xa7, 0x12, 0x12, 0x21, 0x0a, 0x1b, 0x41, 0x70, 0x70, 0x53, 0x61, 0x66, 0x65, 0x74,
+	0x79, 0x53, 0x65, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x76, 0x65, 0x57, 0x6f, 0x72, 0x64, 0x54, 0x61,
+	0x62, 0x6c, 0x65, 0x10, 0xe4, 0xa7, 0x12, 0x12, 0x22, 0x0a, 0x1c, 0x41, 0x70, 0x70, 0x53, 0x61,
+	0x66, 0x65, 0x74, 0x79, 0x53, 0x65, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x76, 0x65, 0x56, 0x6f, 0x63,
+	0x61, 0x62, 0x75, 0x6c, 0x61, 0x72, 0x79, 0x10, 0xe5, 0xa7, 0x12, 0x12, 0x1f, 0x0a, 0x19, 0x41,
+	0x70, 0x70, 0x53, 0x65, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x76, 0x65, 0x53, 0x61, 0x6d, 0x65, 0x57,
+	0x6f, 0x72, 0x64, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x10, 0xe6, 0xa7, 0x12, 0x12, 0x25, 0x0a, 0x1f,
+	0x41, 0x70, 0x70, 0x53, 0x65, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x76, 0x65, 0x45, 0x78, 0x69, 0x73,
+	0x74, 0x53, 0x65, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x76, 0x65, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x10,
+	0xe7, 0xa7, 0x12, 0x12, 0x1e, 0x0a, 0x18, 0x41, 0x70, 0x70, 0x53, 0x61, 0x66, 0x65, 0x74, 0x79,
+	0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x55, 0x72, 0x6c, 0x46, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x10,
+	0xe8, 0xa7, 0x12, 0x12, 0x0c, 0x0a, 0x06, 0x41, 0x70, 0x70, 0x55, 0x72, 0x6c, 0x10, 0xe9, 0xa7,
+	0x12, 0x12, 0x12, 0x0a, 0x0c, 0x41, 0x70, 0x70, 0x55, 0x72, 0x6c, 0x53, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x10, 0xea, 0xa7, 0x12, 0x12, 0x13, 0x0a, 0x0d, 0x41, 0x70, 0x70, 0x55, 0x72, 0x6c, 0x45,
+	0x78, 0x70, 0x69, 0x72, 0x65, 0x64, 0x10, 0xeb, 0xa7, 0x12, 0x12, 0x0d, 0x0a, 0x07, 0x41, 0x70,
+	0x70, 0x45, 0x76, 0x61, 0x6c, 0x10, 0xec, 0xa7, 0x12, 0x12, 0x18, 0x0a, 0x12, 0x41, 0x70, 0x70,
+	0x53, 0x61, 0x66, 0x65, 0x74, 0x79, 0x47, 0x75, 0x61, 0x72, 0x64, 0x72, 0x61, 0x69, 0x6c, 0x10,
+	0xed, 0xa7, 0x12, 0x12, 0x10, 0x0a, 0x0a, 0x4d, 0x43, 0x50, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61,
+	0x6c, 0x10, 0xf0, 0xf5, 0x12, 0x12, 0x18, 0x0a, 0x12, 0x4d, 0x43, 0x50, 0x47, 0x65, 0x74, 0x53,
+	0x71, 0x75, 0x61, 0x72, 0x65, 0x4d, 0x43, 0x50, 0x45, 0x72, 0x72, 0x10, 0xf1, 0xf5, 0x12, 0x12,
+	0x1b, 0x0a, 0x15, 0x4d, 0x43, 0x50, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x75, 0x73, 0x74,
+	0x6f, 0x6d, 0x4d, 0x43, 0x50, 0x45, 0x72, 0x72, 0x10, 0xf2, 0xf5, 0x12, 0x12, 0x18, 0x0a, 0x12,
+	0x4d, 0x43, 0x50, 0x47, 0x65, 0x74, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x4d, 0x43, 0x50, 0x45,
+	0x72, 0x72, 0x10, 0xf3, 0xf5, 0x12, 0x12, 0x1b, 0x0a, 0x15, 0x4d, 0x43, 0x50, 0x44, 0x65, 0x6c,
+	0x65, 0x74, 0x65, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x4d, 0x43, 0x50, 0x45, 0x72, 0x72, 0x10,
+	0xf4, 0xf5, 0x12, 0x12, 0x1c, 0x0a, 0x16, 0x4d, 0x43, 0x50, 0x47, 0x65, 0x74, 0x43, 0x75, 0x73,
+	0x74, 0x6f, 0x6d, 0x4d, 0x43, 0x50, 0x4c, 0x69, 0x73, 0x74, 0x45, 0x72, 0x72, 0x10, 0xf5, 0xf5,
+	0x12, 0x12, 0x18, 0x0a, 0x12, 0x4d, 0x43, 0x50, 0x47, 0x65, 0x74, 0x4d, 0x43, 0x50, 0x41, 0x76,
+	0x61, 0x74, 0x61, 0x72, 0x45, 0x72, 0x72, 0x10, 0xf6, 0xf5, 0x12, 0x12, 0x1c, 0x0a, 0x16, 0x4d,
+	0x43, 0x50, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x54, 0x6f,
+	0x6f, 0x6c, 0x45, 0x72, 0x72, 0x10, 0xf7, 0xf5, 0x12, 0x12, 0x1d, 0x0a, 0x17, 0x4d, 0x43, 0x50,
+	0x47, 0x65, 0x74, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x54, 0x6f, 0x6f, 0x6c, 0x49, 0x6e, 0x66,
+	0x6f, 0x45, 0x72, 0x72, 0x10, 0xf8, 0xf5, 0x12, 0x12, 0x1d, 0x0a, 0x17, 0x4d, 0x43, 0x50, 0x47,
+	0x65, 0x74, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x54, 0x6f, 0x6f, 0x6c, 0x4c, 0x69, 0x73, 0x74,
+	0x45, 0x72, 0x72, 0x10, 0xf9, 0xf5, 0x12, 0x12, 0x1c, 0x0a, 0x16, 0x4d, 0x43, 0x50, 0x55, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x54, 0x6f, 0x6f, 0x6c, 0x45, 0x72,
+	0x72, 0x10, 0xfa, 0xf5, 0x12, 0x12, 0x1c, 0x0a, 0x16, 0x4d, 0x43, 0x50, 0x44, 0x65, 0x6c, 0x65,
+	0x74, 0x65, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x54, 0x6f, 0x6f, 0x6c, 0x45, 0x72, 0x72, 0x10,
+	0xfb, 0xf5, 0x12, 0x12, 0x19, 0x0a, 0x13, 0x4d, 0x43, 0x50, 0x47, 0x65, 0x74, 0x53, 0x71, 0x75,
+	0x61, 0x72, 0x65, 0x54, 0x6f, 0x6f, 0x6c, 0x45, 0x72, 0x72, 0x10, 0xfc, 0xf5, 0x12, 0x12, 0x14,
+	0x0a, 0x0e, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x65, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x6c,
+	0x10, 0x80, 0xc4, 0x13, 0x12, 0x13, 0x0a, 0x0d, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x65, 0x43,
+	0x75, 0x73, 0x74, 0x6f, 0x6d, 0x10, 0x81, 0xc4, 0x13, 0x12, 0x12, 0x0a, 0x0c, 0x4f, 0x70, 0x65,
+	0x72, 0x61, 0x74, 0x65, 0x41, 0x75, 0x64, 0x69, 0x74, 0x10, 0x82, 0xc4, 0x13, 0x12, 0x14, 0x0a,
+	0x0e, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x65, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x10,
+	0x83, 0xc4, 0x13, 0x42, 0x2e, 0x5a, 0x2c, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f,
+	0x6d, 0x2f, 0x55, 0x6e, 0x69, 0x63, 0x6f, 0x6d, 0x41, 0x49, 0x2f, 0x77, 0x61, 0x6e, 0x77, 0x75,
+	0x2f, 0x61, 0x70, 0x69, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x65, 0x72, 0x72, 0x2d, 0x63,
+	0x6f, 0x64, 0x65, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
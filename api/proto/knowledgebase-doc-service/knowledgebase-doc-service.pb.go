@@ -1981,7 +1981,8 @@ type BatchCreateDocSegmentReq struct {
 	UserId  string `protobuf:"bytes,1,opt,name=userId,proto3" json:"userId,omitempty"`
 	OrgId   string `protobuf:"bytes,2,opt,name=orgId,proto3" json:"orgId,omitempty"`
 	DocId   string `protobuf:"bytes,3,opt,name=docId,proto3" json:"docId,omitempty"`
-	FileUrl string `protobuf:"bytes,4,opt,name=fileUrl,proto3" json:"fileUrl,omitempty"`
+	FileUrl string `protobuf:"bytes,4,opt,name=fileUrl,proto3" json:"fileUrl,omitempty"` // 文件格式：csv、jsonl、xlsx
+	Mode    string `protobuf:"bytes,5,opt,name=mode,proto3" json:"mode,omitempty"`       // 导入方式：create（全部新增）、upsert（按分段id更新，分段id为空时新增），默认create
 }
 
 func (x *BatchCreateDocSegmentReq) Reset() {
@@ -2044,19 +2045,27 @@ func (x *BatchCreateDocSegmentReq) GetFileUrl() string {
 	return ""
 }
 
-type DeleteDocSegmentReq struct {
+func (x *BatchCreateDocSegmentReq) GetMode() string {
+	if x != nil {
+		return x.Mode
+	}
+	return ""
+}
+
+type ExportDocSegmentReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	UserId    string `protobuf:"bytes,1,opt,name=userId,proto3" json:"userId,omitempty"`
-	OrgId     string `protobuf:"bytes,2,opt,name=orgId,proto3" json:"orgId,omitempty"`
-	DocId     string `protobuf:"bytes,3,opt,name=docId,proto3" json:"docId,omitempty"`
-	ContentId string `protobuf:"bytes,4,opt,name=contentId,proto3" json:"contentId,omitempty"`
+	UserId      string `protobuf:"bytes,1,opt,name=userId,proto3" json:"userId,omitempty"`
+	OrgId       string `protobuf:"bytes,2,opt,name=orgId,proto3" json:"orgId,omitempty"`
+	KnowledgeId string `protobuf:"bytes,3,opt,name=knowledgeId,proto3" json:"knowledgeId,omitempty"` // 导出整个知识库
+	DocId       string `protobuf:"bytes,4,opt,name=docId,proto3" json:"docId,omitempty"`             // 导出单个文档，优先于knowledgeId
+	Format      string `protobuf:"bytes,5,opt,name=format,proto3" json:"format,omitempty"`           // 导出格式：csv、jsonl、xlsx
 }
 
-func (x *DeleteDocSegmentReq) Reset() {
-	*x = DeleteDocSegmentReq{}
+func (x *ExportDocSegmentReq) Reset() {
+	*x = ExportDocSegmentReq{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_proto_knowledgebase_doc_service_knowledgebase_doc_service_proto_msgTypes[25]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2064,13 +2073,13 @@ func (x *DeleteDocSegmentReq) Reset() {
 	}
 }
 
-func (x *DeleteDocSegmentReq) String() string {
+func (x *ExportDocSegmentReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteDocSegmentReq) ProtoMessage() {}
+func (*ExportDocSegmentReq) ProtoMessage() {}
 
-func (x *DeleteDocSegmentReq) ProtoReflect() protoreflect.Message {
+func (x *ExportDocSegmentReq) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_knowledgebase_doc_service_knowledgebase_doc_service_proto_msgTypes[25]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2082,53 +2091,56 @@ func (x *DeleteDocSegmentReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteDocSegmentReq.ProtoReflect.Descriptor instead.
-func (*DeleteDocSegmentReq) Descriptor() ([]byte, []int) {
+// Deprecated: Use ExportDocSegmentReq.ProtoReflect.Descriptor instead.
+func (*ExportDocSegmentReq) Descriptor() ([]byte, []int) {
 	return file_proto_knowledgebase_doc_service_knowledgebase_doc_service_proto_rawDescGZIP(), []int{25}
 }
 
-func (x *DeleteDocSegmentReq) GetUserId() string {
+func (x *ExportDocSegmentReq) GetUserId() string {
 	if x != nil {
 		return x.UserId
 	}
 	return ""
 }
 
-func (x *DeleteDocSegmentReq) GetOrgId() string {
+func (x *ExportDocSegmentReq) GetOrgId() string {
 	if x != nil {
 		return x.OrgId
 	}
 	return ""
 }
 
-func (x *DeleteDocSegmentReq) GetDocId() string {
+func (x *ExportDocSegmentReq) GetKnowledgeId() string {
+	if x != nil {
+		return x.KnowledgeId
+	}
+	return ""
+}
+
+func (x *ExportDocSegmentReq) GetDocId() string {
 	if x != nil {
 		return x.DocId
 	}
 	return ""
 }
 
-func (x *DeleteDocSegmentReq) GetContentId() string {
+func (x *ExportDocSegmentReq) GetFormat() string {
 	if x != nil {
-		return x.ContentId
+		return x.Format
 	}
 	return ""
 }
 
-type UpdateDocSegmentReq struct {
+type ExportDocSegmentResp struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	UserId    string `protobuf:"bytes,1,opt,name=userId,proto3" json:"userId,omitempty"`
-	OrgId     string `protobuf:"bytes,2,opt,name=orgId,proto3" json:"orgId,omitempty"`
-	DocId     string `protobuf:"bytes,3,opt,name=docId,proto3" json:"docId,omitempty"`
-	ContentId string `protobuf:"bytes,4,opt,name=contentId,proto3" json:"contentId,omitempty"`
-	Content   string `protobuf:"bytes,5,opt,name=content,proto3" json:"content,omitempty"`
+	Data []byte `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"` // 导出文件内容分块
 }
 
-func (x *UpdateDocSegmentReq) Reset() {
-	*x = UpdateDocSegmentReq{}
+func (x *ExportDocSegmentResp) Reset() {
+	*x = ExportDocSegmentResp{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_proto_knowledgebase_doc_service_knowledgebase_doc_service_proto_msgTypes[26]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2136,13 +2148,13 @@ func (x *UpdateDocSegmentReq) Reset() {
 	}
 }
 
-func (x *UpdateDocSegmentReq) String() string {
+func (x *ExportDocSegmentResp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateDocSegmentReq) ProtoMessage() {}
+func (*ExportDocSegmentResp) ProtoMessage() {}
 
-func (x *UpdateDocSegmentReq) ProtoReflect() protoreflect.Message {
+func (x *ExportDocSegmentResp) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_knowledgebase_doc_service_knowledgebase_doc_service_proto_msgTypes[26]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2154,59 +2166,30 @@ func (x *UpdateDocSegmentReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateDocSegmentReq.ProtoReflect.Descriptor instead.
-func (*UpdateDocSegmentReq) Descriptor() ([]byte, []int) {
+// Deprecated: Use ExportDocSegmentResp.ProtoReflect.Descriptor instead.
+func (*ExportDocSegmentResp) Descriptor() ([]byte, []int) {
 	return file_proto_knowledgebase_doc_service_knowledgebase_doc_service_proto_rawDescGZIP(), []int{26}
 }
 
-func (x *UpdateDocSegmentReq) GetUserId() string {
-	if x != nil {
-		return x.UserId
-	}
-	return ""
-}
-
-func (x *UpdateDocSegmentReq) GetOrgId() string {
-	if x != nil {
-		return x.OrgId
-	}
-	return ""
-}
-
-func (x *UpdateDocSegmentReq) GetDocId() string {
-	if x != nil {
-		return x.DocId
-	}
-	return ""
-}
-
-func (x *UpdateDocSegmentReq) GetContentId() string {
-	if x != nil {
-		return x.ContentId
-	}
-	return ""
-}
-
-func (x *UpdateDocSegmentReq) GetContent() string {
+func (x *ExportDocSegmentResp) GetData() []byte {
 	if x != nil {
-		return x.Content
+		return x.Data
 	}
-	return ""
+	return nil
 }
 
-type GetDocChildSegmentListReq struct {
+type GetDocSegmentImportReportReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	UserId    string `protobuf:"bytes,1,opt,name=userId,proto3" json:"userId,omitempty"`
-	OrgId     string `protobuf:"bytes,2,opt,name=orgId,proto3" json:"orgId,omitempty"`
-	ContentId string `protobuf:"bytes,3,opt,name=contentId,proto3" json:"contentId,omitempty"`
-	DocId     string `protobuf:"bytes,4,opt,name=docId,proto3" json:"docId,omitempty"`
+	UserId string `protobuf:"bytes,1,opt,name=userId,proto3" json:"userId,omitempty"`
+	OrgId  string `protobuf:"bytes,2,opt,name=orgId,proto3" json:"orgId,omitempty"`
+	DocId  string `protobuf:"bytes,3,opt,name=docId,proto3" json:"docId,omitempty"`
 }
 
-func (x *GetDocChildSegmentListReq) Reset() {
-	*x = GetDocChildSegmentListReq{}
+func (x *GetDocSegmentImportReportReq) Reset() {
+	*x = GetDocSegmentImportReportReq{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_proto_knowledgebase_doc_service_knowledgebase_doc_service_proto_msgTypes[27]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2214,13 +2197,13 @@ func (x *GetDocChildSegmentListReq) Reset() {
 	}
 }
 
-func (x *GetDocChildSegmentListReq) String() string {
+func (x *GetDocSegmentImportReportReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetDocChildSegmentListReq) ProtoMessage() {}
+func (*GetDocSegmentImportReportReq) ProtoMessage() {}
 
-func (x *GetDocChildSegmentListReq) ProtoReflect() protoreflect.Message {
+func (x *GetDocSegmentImportReportReq) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_knowledgebase_doc_service_knowledgebase_doc_service_proto_msgTypes[27]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2232,49 +2215,48 @@ func (x *GetDocChildSegmentListReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetDocChildSegmentListReq.ProtoReflect.Descriptor instead.
-func (*GetDocChildSegmentListReq) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetDocSegmentImportReportReq.ProtoReflect.Descriptor instead.
+func (*GetDocSegmentImportReportReq) Descriptor() ([]byte, []int) {
 	return file_proto_knowledgebase_doc_service_knowledgebase_doc_service_proto_rawDescGZIP(), []int{27}
 }
 
-func (x *GetDocChildSegmentListReq) GetUserId() string {
+func (x *GetDocSegmentImportReportReq) GetUserId() string {
 	if x != nil {
 		return x.UserId
 	}
 	return ""
 }
 
-func (x *GetDocChildSegmentListReq) GetOrgId() string {
+func (x *GetDocSegmentImportReportReq) GetOrgId() string {
 	if x != nil {
 		return x.OrgId
 	}
 	return ""
 }
 
-func (x *GetDocChildSegmentListReq) GetContentId() string {
-	if x != nil {
-		return x.ContentId
-	}
-	return ""
-}
-
-func (x *GetDocChildSegmentListReq) GetDocId() string {
+func (x *GetDocSegmentImportReportReq) GetDocId() string {
 	if x != nil {
 		return x.DocId
 	}
 	return ""
 }
 
-type GetDocChildSegmentListResp struct {
+type DocSegmentImportReport struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ContentList []*ChildSegmentInfo `protobuf:"bytes,1,rep,name=contentList,proto3" json:"contentList,omitempty"`
+	ImportId     string                      `protobuf:"bytes,1,opt,name=importId,proto3" json:"importId,omitempty"`
+	Status       int32                       `protobuf:"varint,2,opt,name=status,proto3" json:"status,omitempty"`             // 0.待处理 1.导入中 2.成功 3.失败
+	TotalCount   int32                       `protobuf:"varint,3,opt,name=totalCount,proto3" json:"totalCount,omitempty"`     // 文件总行数
+	SuccessCount int32                       `protobuf:"varint,4,opt,name=successCount,proto3" json:"successCount,omitempty"` // 成功行数
+	ErrorMsg     string                      `protobuf:"bytes,5,opt,name=errorMsg,proto3" json:"errorMsg,omitempty"`
+	RowErrorList []*DocSegmentImportRowError `protobuf:"bytes,6,rep,name=rowErrorList,proto3" json:"rowErrorList,omitempty"` // 失败行
+	CreatedAt    string                      `protobuf:"bytes,7,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
 }
 
-func (x *GetDocChildSegmentListResp) Reset() {
-	*x = GetDocChildSegmentListResp{}
+func (x *DocSegmentImportReport) Reset() {
+	*x = DocSegmentImportReport{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_proto_knowledgebase_doc_service_knowledgebase_doc_service_proto_msgTypes[28]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2282,13 +2264,13 @@ func (x *GetDocChildSegmentListResp) Reset() {
 	}
 }
 
-func (x *GetDocChildSegmentListResp) String() string {
+func (x *DocSegmentImportReport) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetDocChildSegmentListResp) ProtoMessage() {}
+func (*DocSegmentImportReport) ProtoMessage() {}
 
-func (x *GetDocChildSegmentListResp) ProtoReflect() protoreflect.Message {
+func (x *DocSegmentImportReport) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_knowledgebase_doc_service_knowledgebase_doc_service_proto_msgTypes[28]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2300,31 +2282,72 @@ func (x *GetDocChildSegmentListResp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetDocChildSegmentListResp.ProtoReflect.Descriptor instead.
-func (*GetDocChildSegmentListResp) Descriptor() ([]byte, []int) {
+// Deprecated: Use DocSegmentImportReport.ProtoReflect.Descriptor instead.
+func (*DocSegmentImportReport) Descriptor() ([]byte, []int) {
 	return file_proto_knowledgebase_doc_service_knowledgebase_doc_service_proto_rawDescGZIP(), []int{28}
 }
 
-func (x *GetDocChildSegmentListResp) GetContentList() []*ChildSegmentInfo {
+func (x *DocSegmentImportReport) GetImportId() string {
 	if x != nil {
-		return x.ContentList
+		return x.ImportId
+	}
+	return ""
+}
+
+func (x *DocSegmentImportReport) GetStatus() int32 {
+	if x != nil {
+		return x.Status
+	}
+	return 0
+}
+
+func (x *DocSegmentImportReport) GetTotalCount() int32 {
+	if x != nil {
+		return x.TotalCount
+	}
+	return 0
+}
+
+func (x *DocSegmentImportReport) GetSuccessCount() int32 {
+	if x != nil {
+		return x.SuccessCount
+	}
+	return 0
+}
+
+func (x *DocSegmentImportReport) GetErrorMsg() string {
+	if x != nil {
+		return x.ErrorMsg
+	}
+	return ""
+}
+
+func (x *DocSegmentImportReport) GetRowErrorList() []*DocSegmentImportRowError {
+	if x != nil {
+		return x.RowErrorList
 	}
 	return nil
 }
 
-type ChildSegmentInfo struct {
+func (x *DocSegmentImportReport) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+type DocSegmentImportRowError struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Content  string `protobuf:"bytes,1,opt,name=content,proto3" json:"content,omitempty"`
-	ChildId  string `protobuf:"bytes,2,opt,name=childId,proto3" json:"childId,omitempty"`
-	ChildNum int32  `protobuf:"varint,3,opt,name=childNum,proto3" json:"childNum,omitempty"`
-	ParentId string `protobuf:"bytes,4,opt,name=parentId,proto3" json:"parentId,omitempty"`
+	Line      int32  `protobuf:"varint,1,opt,name=line,proto3" json:"line,omitempty"` // 行号，从1开始，不含表头
+	ContentId string `protobuf:"bytes,2,opt,name=contentId,proto3" json:"contentId,omitempty"`
+	ErrorMsg  string `protobuf:"bytes,3,opt,name=errorMsg,proto3" json:"errorMsg,omitempty"`
 }
 
-func (x *ChildSegmentInfo) Reset() {
-	*x = ChildSegmentInfo{}
+func (x *DocSegmentImportRowError) Reset() {
+	*x = DocSegmentImportRowError{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_proto_knowledgebase_doc_service_knowledgebase_doc_service_proto_msgTypes[29]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2332,13 +2355,13 @@ func (x *ChildSegmentInfo) Reset() {
 	}
 }
 
-func (x *ChildSegmentInfo) String() string {
+func (x *DocSegmentImportRowError) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ChildSegmentInfo) ProtoMessage() {}
+func (*DocSegmentImportRowError) ProtoMessage() {}
 
-func (x *ChildSegmentInfo) ProtoReflect() protoreflect.Message {
+func (x *DocSegmentImportRowError) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_knowledgebase_doc_service_knowledgebase_doc_service_proto_msgTypes[29]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2350,53 +2373,45 @@ func (x *ChildSegmentInfo) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ChildSegmentInfo.ProtoReflect.Descriptor instead.
-func (*ChildSegmentInfo) Descriptor() ([]byte, []int) {
+// Deprecated: Use DocSegmentImportRowError.ProtoReflect.Descriptor instead.
+func (*DocSegmentImportRowError) Descriptor() ([]byte, []int) {
 	return file_proto_knowledgebase_doc_service_knowledgebase_doc_service_proto_rawDescGZIP(), []int{29}
 }
 
-func (x *ChildSegmentInfo) GetContent() string {
+func (x *DocSegmentImportRowError) GetLine() int32 {
 	if x != nil {
-		return x.Content
+		return x.Line
 	}
-	return ""
+	return 0
 }
 
-func (x *ChildSegmentInfo) GetChildId() string {
+func (x *DocSegmentImportRowError) GetContentId() string {
 	if x != nil {
-		return x.ChildId
+		return x.ContentId
 	}
 	return ""
 }
 
-func (x *ChildSegmentInfo) GetChildNum() int32 {
-	if x != nil {
-		return x.ChildNum
-	}
-	return 0
-}
-
-func (x *ChildSegmentInfo) GetParentId() string {
+func (x *DocSegmentImportRowError) GetErrorMsg() string {
 	if x != nil {
-		return x.ParentId
+		return x.ErrorMsg
 	}
 	return ""
 }
 
-type CreateDocChildSegmentReq struct {
+type DeleteDocSegmentReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	UserId        string   `protobuf:"bytes,1,opt,name=userId,proto3" json:"userId,omitempty"`
-	OrgId         string   `protobuf:"bytes,2,opt,name=orgId,proto3" json:"orgId,omitempty"`
-	DocId         string   `protobuf:"bytes,3,opt,name=docId,proto3" json:"docId,omitempty"`
-	ParentChunkId string   `protobuf:"bytes,4,opt,name=parentChunkId,proto3" json:"parentChunkId,omitempty"`
-	Content       []string `protobuf:"bytes,5,rep,name=content,proto3" json:"content,omitempty"`
+	UserId    string `protobuf:"bytes,1,opt,name=userId,proto3" json:"userId,omitempty"`
+	OrgId     string `protobuf:"bytes,2,opt,name=orgId,proto3" json:"orgId,omitempty"`
+	DocId     string `protobuf:"bytes,3,opt,name=docId,proto3" json:"docId,omitempty"`
+	ContentId string `protobuf:"bytes,4,opt,name=contentId,proto3" json:"contentId,omitempty"`
 }
 
-func (x *CreateDocChildSegmentReq) Reset() {
-	*x = CreateDocChildSegmentReq{}
+func (x *DeleteDocSegmentReq) Reset() {
+	*x = DeleteDocSegmentReq{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_proto_knowledgebase_doc_service_knowledgebase_doc_service_proto_msgTypes[30]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2404,13 +2419,13 @@ func (x *CreateDocChildSegmentReq) Reset() {
 	}
 }
 
-func (x *CreateDocChildSegmentReq) String() string {
+func (x *DeleteDocSegmentReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateDocChildSegmentReq) ProtoMessage() {}
+func (*DeleteDocSegmentReq) ProtoMessage() {}
 
-func (x *CreateDocChildSegmentReq) ProtoReflect() protoreflect.Message {
+func (x *DeleteDocSegmentReq) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_knowledgebase_doc_service_knowledgebase_doc_service_proto_msgTypes[30]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2422,61 +2437,53 @@ func (x *CreateDocChildSegmentReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateDocChildSegmentReq.ProtoReflect.Descriptor instead.
-func (*CreateDocChildSegmentReq) Descriptor() ([]byte, []int) {
+// Deprecated: Use DeleteDocSegmentReq.ProtoReflect.Descriptor instead.
+func (*DeleteDocSegmentReq) Descriptor() ([]byte, []int) {
 	return file_proto_knowledgebase_doc_service_knowledgebase_doc_service_proto_rawDescGZIP(), []int{30}
 }
 
-func (x *CreateDocChildSegmentReq) GetUserId() string {
+func (x *DeleteDocSegmentReq) GetUserId() string {
 	if x != nil {
 		return x.UserId
 	}
 	return ""
 }
 
-func (x *CreateDocChildSegmentReq) GetOrgId() string {
+func (x *DeleteDocSegmentReq) GetOrgId() string {
 	if x != nil {
 		return x.OrgId
 	}
 	return ""
 }
 
-func (x *CreateDocChildSegmentReq) GetDocId() string {
+func (x *DeleteDocSegmentReq) GetDocId() string {
 	if x != nil {
 		return x.DocId
 	}
 	return ""
 }
 
-func (x *CreateDocChildSegmentReq) GetParentChunkId() string {
+func (x *DeleteDocSegmentReq) GetContentId() string {
 	if x != nil {
-		return x.ParentChunkId
+		return x.ContentId
 	}
 	return ""
 }
 
-func (x *CreateDocChildSegmentReq) GetContent() []string {
-	if x != nil {
-		return x.Content
-	}
-	return nil
-}
-
-type DeleteDocChildSegmentReq struct {
+type UpdateDocSegmentReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	UserId        string  `protobuf:"bytes,1,opt,name=userId,proto3" json:"userId,omitempty"`
-	OrgId         string  `protobuf:"bytes,2,opt,name=orgId,proto3" json:"orgId,omitempty"`
-	DocId         string  `protobuf:"bytes,3,opt,name=docId,proto3" json:"docId,omitempty"`
-	ParentChunkId string  `protobuf:"bytes,4,opt,name=parentChunkId,proto3" json:"parentChunkId,omitempty"`       //父分段id
-	ParentChunkNo int32   `protobuf:"varint,5,opt,name=parentChunkNo,proto3" json:"parentChunkNo,omitempty"`      //父分段编号
-	ChildChunkNo  []int32 `protobuf:"varint,6,rep,packed,name=childChunkNo,proto3" json:"childChunkNo,omitempty"` //子分段编号列表
+	UserId    string `protobuf:"bytes,1,opt,name=userId,proto3" json:"userId,omitempty"`
+	OrgId     string `protobuf:"bytes,2,opt,name=orgId,proto3" json:"orgId,omitempty"`
+	DocId     string `protobuf:"bytes,3,opt,name=docId,proto3" json:"docId,omitempty"`
+	ContentId string `protobuf:"bytes,4,opt,name=contentId,proto3" json:"contentId,omitempty"`
+	Content   string `protobuf:"bytes,5,opt,name=content,proto3" json:"content,omitempty"`
 }
 
-func (x *DeleteDocChildSegmentReq) Reset() {
-	*x = DeleteDocChildSegmentReq{}
+func (x *UpdateDocSegmentReq) Reset() {
+	*x = UpdateDocSegmentReq{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_proto_knowledgebase_doc_service_knowledgebase_doc_service_proto_msgTypes[31]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2484,13 +2491,13 @@ func (x *DeleteDocChildSegmentReq) Reset() {
 	}
 }
 
-func (x *DeleteDocChildSegmentReq) String() string {
+func (x *UpdateDocSegmentReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteDocChildSegmentReq) ProtoMessage() {}
+func (*UpdateDocSegmentReq) ProtoMessage() {}
 
-func (x *DeleteDocChildSegmentReq) ProtoReflect() protoreflect.Message {
+func (x *UpdateDocSegmentReq) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_knowledgebase_doc_service_knowledgebase_doc_service_proto_msgTypes[31]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2502,68 +2509,59 @@ func (x *DeleteDocChildSegmentReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteDocChildSegmentReq.ProtoReflect.Descriptor instead.
-func (*DeleteDocChildSegmentReq) Descriptor() ([]byte, []int) {
+// Deprecated: Use UpdateDocSegmentReq.ProtoReflect.Descriptor instead.
+func (*UpdateDocSegmentReq) Descriptor() ([]byte, []int) {
 	return file_proto_knowledgebase_doc_service_knowledgebase_doc_service_proto_rawDescGZIP(), []int{31}
 }
 
-func (x *DeleteDocChildSegmentReq) GetUserId() string {
+func (x *UpdateDocSegmentReq) GetUserId() string {
 	if x != nil {
 		return x.UserId
 	}
 	return ""
 }
 
-func (x *DeleteDocChildSegmentReq) GetOrgId() string {
+func (x *UpdateDocSegmentReq) GetOrgId() string {
 	if x != nil {
 		return x.OrgId
 	}
 	return ""
 }
 
-func (x *DeleteDocChildSegmentReq) GetDocId() string {
+func (x *UpdateDocSegmentReq) GetDocId() string {
 	if x != nil {
 		return x.DocId
 	}
 	return ""
 }
 
-func (x *DeleteDocChildSegmentReq) GetParentChunkId() string {
+func (x *UpdateDocSegmentReq) GetContentId() string {
 	if x != nil {
-		return x.ParentChunkId
+		return x.ContentId
 	}
 	return ""
 }
 
-func (x *DeleteDocChildSegmentReq) GetParentChunkNo() int32 {
+func (x *UpdateDocSegmentReq) GetContent() string {
 	if x != nil {
-		return x.ParentChunkNo
+		return x.Content
 	}
-	return 0
-}
-
-func (x *DeleteDocChildSegmentReq) GetChildChunkNo() []int32 {
-	if x != nil {
-		return x.ChildChunkNo
-	}
-	return nil
+	return ""
 }
 
-type UpdateDocChildSegmentReq struct {
+type GetDocChildSegmentListReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	UserId        string      `protobuf:"bytes,1,opt,name=userId,proto3" json:"userId,omitempty"`
-	OrgId         string      `protobuf:"bytes,2,opt,name=orgId,proto3" json:"orgId,omitempty"`
-	DocId         string      `protobuf:"bytes,3,opt,name=docId,proto3" json:"docId,omitempty"`
-	ParentChunkId string      `protobuf:"bytes,4,opt,name=parentChunkId,proto3" json:"parentChunkId,omitempty"`  //父分段id
-	ParentChunkNo int32       `protobuf:"varint,5,opt,name=parentChunkNo,proto3" json:"parentChunkNo,omitempty"` //父分段编号
-	ChildChunk    *ChildChunk `protobuf:"bytes,6,opt,name=childChunk,proto3" json:"childChunk,omitempty"`        //子分段编号
+	UserId    string `protobuf:"bytes,1,opt,name=userId,proto3" json:"userId,omitempty"`
+	OrgId     string `protobuf:"bytes,2,opt,name=orgId,proto3" json:"orgId,omitempty"`
+	ContentId string `protobuf:"bytes,3,opt,name=contentId,proto3" json:"contentId,omitempty"`
+	DocId     string `protobuf:"bytes,4,opt,name=docId,proto3" json:"docId,omitempty"`
 }
 
-func (x *UpdateDocChildSegmentReq) Reset() {
-	*x = UpdateDocChildSegmentReq{}
+func (x *GetDocChildSegmentListReq) Reset() {
+	*x = GetDocChildSegmentListReq{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_proto_knowledgebase_doc_service_knowledgebase_doc_service_proto_msgTypes[32]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2571,13 +2569,13 @@ func (x *UpdateDocChildSegmentReq) Reset() {
 	}
 }
 
-func (x *UpdateDocChildSegmentReq) String() string {
+func (x *GetDocChildSegmentListReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateDocChildSegmentReq) ProtoMessage() {}
+func (*GetDocChildSegmentListReq) ProtoMessage() {}
 
-func (x *UpdateDocChildSegmentReq) ProtoReflect() protoreflect.Message {
+func (x *GetDocChildSegmentListReq) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_knowledgebase_doc_service_knowledgebase_doc_service_proto_msgTypes[32]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2589,79 +2587,114 @@ func (x *UpdateDocChildSegmentReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateDocChildSegmentReq.ProtoReflect.Descriptor instead.
-func (*UpdateDocChildSegmentReq) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetDocChildSegmentListReq.ProtoReflect.Descriptor instead.
+func (*GetDocChildSegmentListReq) Descriptor() ([]byte, []int) {
 	return file_proto_knowledgebase_doc_service_knowledgebase_doc_service_proto_rawDescGZIP(), []int{32}
 }
 
-func (x *UpdateDocChildSegmentReq) GetUserId() string {
+func (x *GetDocChildSegmentListReq) GetUserId() string {
 	if x != nil {
 		return x.UserId
 	}
 	return ""
 }
 
-func (x *UpdateDocChildSegmentReq) GetOrgId() string {
+func (x *GetDocChildSegmentListReq) GetOrgId() string {
 	if x != nil {
 		return x.OrgId
 	}
 	return ""
 }
 
-func (x *UpdateDocChildSegmentReq) GetDocId() string {
+func (x *GetDocChildSegmentListReq) GetContentId() string {
 	if x != nil {
-		return x.DocId
+		return x.ContentId
 	}
 	return ""
 }
 
-func (x *UpdateDocChildSegmentReq) GetParentChunkId() string {
+func (x *GetDocChildSegmentListReq) GetDocId() string {
 	if x != nil {
-		return x.ParentChunkId
+		return x.DocId
 	}
 	return ""
 }
 
-func (x *UpdateDocChildSegmentReq) GetParentChunkNo() int32 {
-	if x != nil {
-		return x.ParentChunkNo
+type GetDocChildSegmentListResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ContentList []*ChildSegmentInfo `protobuf:"bytes,1,rep,name=contentList,proto3" json:"contentList,omitempty"`
+}
+
+func (x *GetDocChildSegmentListResp) Reset() {
+	*x = GetDocChildSegmentListResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_knowledgebase_doc_service_knowledgebase_doc_service_proto_msgTypes[33]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return 0
 }
 
-func (x *UpdateDocChildSegmentReq) GetChildChunk() *ChildChunk {
+func (x *GetDocChildSegmentListResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDocChildSegmentListResp) ProtoMessage() {}
+
+func (x *GetDocChildSegmentListResp) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_knowledgebase_doc_service_knowledgebase_doc_service_proto_msgTypes[33]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDocChildSegmentListResp.ProtoReflect.Descriptor instead.
+func (*GetDocChildSegmentListResp) Descriptor() ([]byte, []int) {
+	return file_proto_knowledgebase_doc_service_knowledgebase_doc_service_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *GetDocChildSegmentListResp) GetContentList() []*ChildSegmentInfo {
 	if x != nil {
-		return x.ChildChunk
+		return x.ContentList
 	}
 	return nil
 }
 
-type ChildChunk struct {
+type ChildSegmentInfo struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Content string `protobuf:"bytes,1,opt,name=content,proto3" json:"content,omitempty"`
-	ChunkNo int32  `protobuf:"varint,2,opt,name=chunkNo,proto3" json:"chunkNo,omitempty"`
+	Content  string `protobuf:"bytes,1,opt,name=content,proto3" json:"content,omitempty"`
+	ChildId  string `protobuf:"bytes,2,opt,name=childId,proto3" json:"childId,omitempty"`
+	ChildNum int32  `protobuf:"varint,3,opt,name=childNum,proto3" json:"childNum,omitempty"`
+	ParentId string `protobuf:"bytes,4,opt,name=parentId,proto3" json:"parentId,omitempty"`
 }
 
-func (x *ChildChunk) Reset() {
-	*x = ChildChunk{}
+func (x *ChildSegmentInfo) Reset() {
+	*x = ChildSegmentInfo{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_knowledgebase_doc_service_knowledgebase_doc_service_proto_msgTypes[33]
+		mi := &file_proto_knowledgebase_doc_service_knowledgebase_doc_service_proto_msgTypes[34]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ChildChunk) String() string {
+func (x *ChildSegmentInfo) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ChildChunk) ProtoMessage() {}
+func (*ChildSegmentInfo) ProtoMessage() {}
 
-func (x *ChildChunk) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_knowledgebase_doc_service_knowledgebase_doc_service_proto_msgTypes[33]
+func (x *ChildSegmentInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_knowledgebase_doc_service_knowledgebase_doc_service_proto_msgTypes[34]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2672,147 +2705,2554 @@ func (x *ChildChunk) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ChildChunk.ProtoReflect.Descriptor instead.
-func (*ChildChunk) Descriptor() ([]byte, []int) {
-	return file_proto_knowledgebase_doc_service_knowledgebase_doc_service_proto_rawDescGZIP(), []int{33}
+// Deprecated: Use ChildSegmentInfo.ProtoReflect.Descriptor instead.
+func (*ChildSegmentInfo) Descriptor() ([]byte, []int) {
+	return file_proto_knowledgebase_doc_service_knowledgebase_doc_service_proto_rawDescGZIP(), []int{34}
 }
 
-func (x *ChildChunk) GetContent() string {
+func (x *ChildSegmentInfo) GetContent() string {
 	if x != nil {
 		return x.Content
 	}
 	return ""
 }
 
-func (x *ChildChunk) GetChunkNo() int32 {
+func (x *ChildSegmentInfo) GetChildId() string {
 	if x != nil {
-		return x.ChunkNo
+		return x.ChildId
+	}
+	return ""
+}
+
+func (x *ChildSegmentInfo) GetChildNum() int32 {
+	if x != nil {
+		return x.ChildNum
 	}
 	return 0
 }
 
-var File_proto_knowledgebase_doc_service_knowledgebase_doc_service_proto protoreflect.FileDescriptor
+func (x *ChildSegmentInfo) GetParentId() string {
+	if x != nil {
+		return x.ParentId
+	}
+	return ""
+}
 
-var file_proto_knowledgebase_doc_service_knowledgebase_doc_service_proto_rawDesc = []byte{
-	0x0a, 0x3f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x6b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67,
-	0x65, 0x62, 0x61, 0x73, 0x65, 0x2d, 0x64, 0x6f, 0x63, 0x2d, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
-	0x65, 0x2f, 0x6b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x62, 0x61, 0x73, 0x65, 0x2d,
-	0x64, 0x6f, 0x63, 0x2d, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x12, 0x19, 0x6b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x62, 0x61, 0x73, 0x65,
-	0x5f, 0x64, 0x6f, 0x63, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x1a, 0x1b, 0x67, 0x6f,
-	0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x65, 0x6d,
-	0x70, 0x74, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xdf, 0x01, 0x0a, 0x0d, 0x47, 0x65,
-	0x74, 0x44, 0x6f, 0x63, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x12, 0x20, 0x0a, 0x0b, 0x6b,
-	0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x0b, 0x6b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x49, 0x64, 0x12, 0x18, 0x0a,
-	0x07, 0x64, 0x6f, 0x63, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
-	0x64, 0x6f, 0x63, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75,
-	0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12,
-	0x1a, 0x0a, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28,
-	0x05, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x70,
-	0x61, 0x67, 0x65, 0x4e, 0x75, 0x6d, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x70, 0x61,
-	0x67, 0x65, 0x4e, 0x75, 0x6d, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x18,
-	0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x14, 0x0a,
-	0x05, 0x6f, 0x72, 0x67, 0x49, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6f, 0x72,
-	0x67, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x64, 0x6f, 0x63, 0x54, 0x61, 0x67, 0x18, 0x08, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x06, 0x64, 0x6f, 0x63, 0x54, 0x61, 0x67, 0x22, 0x94, 0x01, 0x0a, 0x0e,
-	0x47, 0x65, 0x74, 0x44, 0x6f, 0x63, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x12, 0x36,
-	0x0a, 0x04, 0x64, 0x6f, 0x63, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x6b,
-	0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x64, 0x6f, 0x63,
-	0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x44, 0x6f, 0x63, 0x49, 0x6e, 0x66, 0x6f,
-	0x52, 0x04, 0x64, 0x6f, 0x63, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x18, 0x0a, 0x07,
-	0x70, 0x61, 0x67, 0x65, 0x4e, 0x75, 0x6d, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x70,
-	0x61, 0x67, 0x65, 0x4e, 0x75, 0x6d, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69,
-	0x7a, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69,
-	0x7a, 0x65, 0x22, 0xcf, 0x03, 0x0a, 0x0c, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x44, 0x6f, 0x63,
-	0x52, 0x65, 0x71, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x6f,
-	0x72, 0x67, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6f, 0x72, 0x67, 0x49,
-	0x64, 0x12, 0x20, 0x0a, 0x0b, 0x6b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x49, 0x64,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x6b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67,
-	0x65, 0x49, 0x64, 0x12, 0x24, 0x0a, 0x0d, 0x64, 0x6f, 0x63, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74,
-	0x54, 0x79, 0x70, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0d, 0x64, 0x6f, 0x63, 0x49,
-	0x6d, 0x70, 0x6f, 0x72, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x48, 0x0a, 0x0b, 0x64, 0x6f, 0x63,
-	0x49, 0x6e, 0x66, 0x6f, 0x4c, 0x69, 0x73, 0x74, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x26,
-	0x2e, 0x6b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x64,
-	0x6f, 0x63, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x44, 0x6f, 0x63, 0x46, 0x69,
-	0x6c, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0b, 0x64, 0x6f, 0x63, 0x49, 0x6e, 0x66, 0x6f, 0x4c,
-	0x69, 0x73, 0x74, 0x12, 0x45, 0x0a, 0x0a, 0x64, 0x6f, 0x63, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e,
-	0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x6b, 0x6e, 0x6f, 0x77, 0x6c, 0x65,
-	0x64, 0x67, 0x65, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x64, 0x6f, 0x63, 0x5f, 0x73, 0x65, 0x72, 0x76,
-	0x69, 0x63, 0x65, 0x2e, 0x44, 0x6f, 0x63, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x0a,
-	0x64, 0x6f, 0x63, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x6f,
-	0x63, 0x41, 0x6e, 0x61, 0x6c, 0x79, 0x7a, 0x65, 0x72, 0x18, 0x07, 0x20, 0x03, 0x28, 0x09, 0x52,
-	0x0b, 0x64, 0x6f, 0x63, 0x41, 0x6e, 0x61, 0x6c, 0x79, 0x7a, 0x65, 0x72, 0x12, 0x1e, 0x0a, 0x0a,
-	0x6f, 0x63, 0x72, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x49, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x0a, 0x6f, 0x63, 0x72, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x49, 0x64, 0x12, 0x24, 0x0a, 0x0d,
-	0x64, 0x6f, 0x63, 0x50, 0x72, 0x65, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x18, 0x09, 0x20,
-	0x03, 0x28, 0x09, 0x52, 0x0d, 0x64, 0x6f, 0x63, 0x50, 0x72, 0x65, 0x70, 0x72, 0x6f, 0x63, 0x65,
-	0x73, 0x73, 0x12, 0x50, 0x0a, 0x0f, 0x64, 0x6f, 0x63, 0x4d, 0x65, 0x74, 0x61, 0x44, 0x61, 0x74,
-	0x61, 0x4c, 0x69, 0x73, 0x74, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x6b, 0x6e,
-	0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x64, 0x6f, 0x63, 0x5f,
-	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x44, 0x6f, 0x63, 0x4d, 0x65, 0x74, 0x61, 0x44,
-	0x61, 0x74, 0x61, 0x52, 0x0f, 0x64, 0x6f, 0x63, 0x4d, 0x65, 0x74, 0x61, 0x44, 0x61, 0x74, 0x61,
-	0x4c, 0x69, 0x73, 0x74, 0x22, 0x67, 0x0a, 0x0b, 0x44, 0x6f, 0x63, 0x4d, 0x65, 0x74, 0x61, 0x44,
-	0x61, 0x74, 0x61, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x76,
-	0x61, 0x6c, 0x75, 0x65, 0x54, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
-	0x76, 0x61, 0x6c, 0x75, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x75, 0x6c,
-	0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x72, 0x75, 0x6c, 0x65, 0x22, 0x8b, 0x01,
-	0x0a, 0x12, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x44, 0x6f, 0x63, 0x53, 0x74, 0x61, 0x74, 0x75,
-	0x73, 0x52, 0x65, 0x71, 0x12, 0x14, 0x0a, 0x05, 0x64, 0x6f, 0x63, 0x49, 0x64, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x05, 0x64, 0x6f, 0x63, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74,
-	0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74,
-	0x75, 0x73, 0x12, 0x47, 0x0a, 0x0c, 0x6d, 0x65, 0x74, 0x61, 0x44, 0x61, 0x74, 0x61, 0x4c, 0x69,
-	0x73, 0x74, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x6b, 0x6e, 0x6f, 0x77, 0x6c,
-	0x65, 0x64, 0x67, 0x65, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x64, 0x6f, 0x63, 0x5f, 0x73, 0x65, 0x72,
-	0x76, 0x69, 0x63, 0x65, 0x2e, 0x4d, 0x65, 0x74, 0x61, 0x44, 0x61, 0x74, 0x61, 0x52, 0x0c, 0x6d,
-	0x65, 0x74, 0x61, 0x44, 0x61, 0x74, 0x61, 0x4c, 0x69, 0x73, 0x74, 0x22, 0xc5, 0x01, 0x0a, 0x14,
-	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x44, 0x6f, 0x63, 0x4d, 0x65, 0x74, 0x61, 0x44, 0x61, 0x74,
-	0x61, 0x52, 0x65, 0x71, 0x12, 0x14, 0x0a, 0x05, 0x6f, 0x72, 0x67, 0x49, 0x64, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x05, 0x6f, 0x72, 0x67, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73,
-	0x65, 0x72, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72,
-	0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x64, 0x6f, 0x63, 0x49, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x05, 0x64, 0x6f, 0x63, 0x49, 0x64, 0x12, 0x47, 0x0a, 0x0c, 0x6d, 0x65, 0x74, 0x61,
-	0x44, 0x61, 0x74, 0x61, 0x4c, 0x69, 0x73, 0x74, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x23,
-	0x2e, 0x6b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x64,
-	0x6f, 0x63, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x4d, 0x65, 0x74, 0x61, 0x44,
-	0x61, 0x74, 0x61, 0x52, 0x0c, 0x6d, 0x65, 0x74, 0x61, 0x44, 0x61, 0x74, 0x61, 0x4c, 0x69, 0x73,
-	0x74, 0x12, 0x20, 0x0a, 0x0b, 0x6b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x49, 0x64,
-	0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x6b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67,
-	0x65, 0x49, 0x64, 0x22, 0xea, 0x01, 0x0a, 0x19, 0x42, 0x61, 0x74, 0x63, 0x68, 0x55, 0x70, 0x64,
-	0x61, 0x74, 0x65, 0x44, 0x6f, 0x63, 0x4d, 0x65, 0x74, 0x61, 0x44, 0x61, 0x74, 0x61, 0x52, 0x65,
-	0x71, 0x12, 0x14, 0x0a, 0x05, 0x6f, 0x72, 0x67, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x05, 0x6f, 0x72, 0x67, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49,
-	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12,
-	0x14, 0x0a, 0x05, 0x64, 0x6f, 0x63, 0x49, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
-	0x64, 0x6f, 0x63, 0x49, 0x64, 0x12, 0x47, 0x0a, 0x0c, 0x6d, 0x65, 0x74, 0x61, 0x44, 0x61, 0x74,
-	0x61, 0x4c, 0x69, 0x73, 0x74, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x6b, 0x6e,
-	0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x64, 0x6f, 0x63, 0x5f,
-	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x4d, 0x65, 0x74, 0x61, 0x44, 0x61, 0x74, 0x61,
-	0x52, 0x0c, 0x6d, 0x65, 0x74, 0x61, 0x44, 0x61, 0x74, 0x61, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x20,
-	0x0a, 0x0b, 0x6b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x49, 0x64, 0x18, 0x05, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x0b, 0x6b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x49, 0x64,
-	0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4d, 0x65, 0x74, 0x61, 0x18, 0x06,
-	0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4d, 0x65, 0x74, 0x61,
-	0x22, 0x94, 0x01, 0x0a, 0x08, 0x4d, 0x65, 0x74, 0x61, 0x44, 0x61, 0x74, 0x61, 0x12, 0x16, 0x0a,
-	0x06, 0x6d, 0x65, 0x74, 0x61, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6d,
-	0x65, 0x74, 0x61, 0x49, 0x64, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x1c, 0x0a,
-	0x09, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x54, 0x79, 0x70, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x09, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x6f,
-	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6f, 0x70, 0x74,
-	0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x75, 0x6c, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x04, 0x72, 0x75, 0x6c, 0x65, 0x22, 0x40, 0x0a, 0x10, 0x49, 0x6e, 0x69, 0x74, 0x44,
-	0x6f, 0x63, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x12, 0x16, 0x0a, 0x06, 0x75,
-	0x73, 0x65, 0x72, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65,
-	0x72, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x6f, 0x72, 0x67, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x05, 0x6f, 0x72, 0x67, 0x49, 0x64, 0x22, 0x4e, 0x0a, 0x0c, 0x44, 0x65, 0x6c,
-	0x65, 0x74, 0x65, 0x44, 0x6f, 0x63, 0x52, 0x65, 0x71, 0x12, 0x10, 0x0a, 0x03, 0x69, 0x64, 0x73,
-	0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x03, 0x69, 0x64, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x75,
+type CreateDocChildSegmentReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId        string   `protobuf:"bytes,1,opt,name=userId,proto3" json:"userId,omitempty"`
+	OrgId         string   `protobuf:"bytes,2,opt,name=orgId,proto3" json:"orgId,omitempty"`
+	DocId         string   `protobuf:"bytes,3,opt,name=docId,proto3" json:"docId,omitempty"`
+	ParentChunkId string   `protobuf:"bytes,4,opt,name=parentChunkId,proto3" json:"parentChunkId,omitempty"`
+	Content       []string `protobuf:"bytes,5,rep,name=content,proto3" json:"content,omitempty"`
+}
+
+func (x *CreateDocChildSegmentReq) Reset() {
+	*x = CreateDocChildSegmentReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_knowledgebase_doc_service_knowledgebase_doc_service_proto_msgTypes[35]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateDocChildSegmentReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateDocChildSegmentReq) ProtoMessage() {}
+
+func (x *CreateDocChildSegmentReq) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_knowledgebase_doc_service_knowledgebase_doc_service_proto_msgTypes[35]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateDocChildSegmentReq.ProtoReflect.Descriptor instead.
+func (*CreateDocChildSegmentReq) Descriptor() ([]byte, []int) {
+	return file_proto_knowledgebase_doc_service_knowledgebase_doc_service_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *CreateDocChildSegmentReq) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *CreateDocChildSegmentReq) GetOrgId() string {
+	if x != nil {
+		return x.OrgId
+	}
+	return ""
+}
+
+func (x *CreateDocChildSegmentReq) GetDocId() string {
+	if x != nil {
+		return x.DocId
+	}
+	return ""
+}
+
+func (x *CreateDocChildSegmentReq) GetParentChunkId() string {
+	if x != nil {
+		return x.ParentChunkId
+	}
+	return ""
+}
+
+func (x *CreateDocChildSegmentReq) GetContent() []string {
+	if x != nil {
+		return x.Content
+	}
+	return nil
+}
+
+type DeleteDocChildSegmentReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId        string  `protobuf:"bytes,1,opt,name=userId,proto3" json:"userId,omitempty"`
+	OrgId         string  `protobuf:"bytes,2,opt,name=orgId,proto3" json:"orgId,omitempty"`
+	DocId         string  `protobuf:"bytes,3,opt,name=docId,proto3" json:"docId,omitempty"`
+	ParentChunkId string  `protobuf:"bytes,4,opt,name=parentChunkId,proto3" json:"parentChunkId,omitempty"`       //父分段id
+	ParentChunkNo int32   `protobuf:"varint,5,opt,name=parentChunkNo,proto3" json:"parentChunkNo,omitempty"`      //父分段编号
+	ChildChunkNo  []int32 `protobuf:"varint,6,rep,packed,name=childChunkNo,proto3" json:"childChunkNo,omitempty"` //子分段编号列表
+}
+
+func (x *DeleteDocChildSegmentReq) Reset() {
+	*x = DeleteDocChildSegmentReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_knowledgebase_doc_service_knowledgebase_doc_service_proto_msgTypes[36]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteDocChildSegmentReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteDocChildSegmentReq) ProtoMessage() {}
+
+func (x *DeleteDocChildSegmentReq) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_knowledgebase_doc_service_knowledgebase_doc_service_proto_msgTypes[36]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteDocChildSegmentReq.ProtoReflect.Descriptor instead.
+func (*DeleteDocChildSegmentReq) Descriptor() ([]byte, []int) {
+	return file_proto_knowledgebase_doc_service_knowledgebase_doc_service_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *DeleteDocChildSegmentReq) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *DeleteDocChildSegmentReq) GetOrgId() string {
+	if x != nil {
+		return x.OrgId
+	}
+	return ""
+}
+
+func (x *DeleteDocChildSegmentReq) GetDocId() string {
+	if x != nil {
+		return x.DocId
+	}
+	return ""
+}
+
+func (x *DeleteDocChildSegmentReq) GetParentChunkId() string {
+	if x != nil {
+		return x.ParentChunkId
+	}
+	return ""
+}
+
+func (x *DeleteDocChildSegmentReq) GetParentChunkNo() int32 {
+	if x != nil {
+		return x.ParentChunkNo
+	}
+	return 0
+}
+
+func (x *DeleteDocChildSegmentReq) GetChildChunkNo() []int32 {
+	if x != nil {
+		return x.ChildChunkNo
+	}
+	return nil
+}
+
+type UpdateDocChildSegmentReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId        string      `protobuf:"bytes,1,opt,name=userId,proto3" json:"userId,omitempty"`
+	OrgId         string      `protobuf:"bytes,2,opt,name=orgId,proto3" json:"orgId,omitempty"`
+	DocId         string      `protobuf:"bytes,3,opt,name=docId,proto3" json:"docId,omitempty"`
+	ParentChunkId string      `protobuf:"bytes,4,opt,name=parentChunkId,proto3" json:"parentChunkId,omitempty"`  //父分段id
+	ParentChunkNo int32       `protobuf:"varint,5,opt,name=parentChunkNo,proto3" json:"parentChunkNo,omitempty"` //父分段编号
+	ChildChunk    *ChildChunk `protobuf:"bytes,6,opt,name=childChunk,proto3" json:"childChunk,omitempty"`        //子分段编号
+}
+
+func (x *UpdateDocChildSegmentReq) Reset() {
+	*x = UpdateDocChildSegmentReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_knowledgebase_doc_service_knowledgebase_doc_service_proto_msgTypes[37]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateDocChildSegmentReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateDocChildSegmentReq) ProtoMessage() {}
+
+func (x *UpdateDocChildSegmentReq) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_knowledgebase_doc_service_knowledgebase_doc_service_proto_msgTypes[37]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateDocChildSegmentReq.ProtoReflect.Descriptor instead.
+func (*UpdateDocChildSegmentReq) Descriptor() ([]byte, []int) {
+	return file_proto_knowledgebase_doc_service_knowledgebase_doc_service_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *UpdateDocChildSegmentReq) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *UpdateDocChildSegmentReq) GetOrgId() string {
+	if x != nil {
+		return x.OrgId
+	}
+	return ""
+}
+
+func (x *UpdateDocChildSegmentReq) GetDocId() string {
+	if x != nil {
+		return x.DocId
+	}
+	return ""
+}
+
+func (x *UpdateDocChildSegmentReq) GetParentChunkId() string {
+	if x != nil {
+		return x.ParentChunkId
+	}
+	return ""
+}
+
+func (x *UpdateDocChildSegmentReq) GetParentChunkNo() int32 {
+	if x != nil {
+		return x.ParentChunkNo
+	}
+	return 0
+}
+
+func (x *UpdateDocChildSegmentReq) GetChildChunk() *ChildChunk {
+	if x != nil {
+		return x.ChildChunk
+	}
+	return nil
+}
+
+type ChildChunk struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Content string `protobuf:"bytes,1,opt,name=content,proto3" json:"content,omitempty"`
+	ChunkNo int32  `protobuf:"varint,2,opt,name=chunkNo,proto3" json:"chunkNo,omitempty"`
+}
+
+func (x *ChildChunk) Reset() {
+	*x = ChildChunk{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_knowledgebase_doc_service_knowledgebase_doc_service_proto_msgTypes[38]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ChildChunk) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChildChunk) ProtoMessage() {}
+
+func (x *ChildChunk) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_knowledgebase_doc_service_knowledgebase_doc_service_proto_msgTypes[38]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChildChunk.ProtoReflect.Descriptor instead.
+func (*ChildChunk) Descriptor() ([]byte, []int) {
+	return file_proto_knowledgebase_doc_service_knowledgebase_doc_service_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *ChildChunk) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+func (x *ChildChunk) GetChunkNo() int32 {
+	if x != nil {
+		return x.ChunkNo
+	}
+	return 0
+}
+
+type SaveDocSyncReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId          string `protobuf:"bytes,1,opt,name=userId,proto3" json:"userId,omitempty"`
+	OrgId           string `protobuf:"bytes,2,opt,name=orgId,proto3" json:"orgId,omitempty"`
+	KnowledgeId     string `protobuf:"bytes,3,opt,name=knowledgeId,proto3" json:"knowledgeId,omitempty"`
+	DocId           string `protobuf:"bytes,4,opt,name=docId,proto3" json:"docId,omitempty"`                      //文档id，按文档同步时必填
+	ImportTaskId    string `protobuf:"bytes,5,opt,name=importTaskId,proto3" json:"importTaskId,omitempty"`        //导入任务id，按导入任务同步该任务导入的所有url文档，与docId二选一
+	CronExpr        string `protobuf:"bytes,6,opt,name=cronExpr,proto3" json:"cronExpr,omitempty"`                //cron表达式（分 时 日 月 周），与intervalMinutes二选一
+	IntervalMinutes int32  `protobuf:"varint,7,opt,name=intervalMinutes,proto3" json:"intervalMinutes,omitempty"` //同步间隔（分钟）
+	Enable          bool   `protobuf:"varint,8,opt,name=enable,proto3" json:"enable,omitempty"`                   //是否启用
+}
+
+func (x *SaveDocSyncReq) Reset() {
+	*x = SaveDocSyncReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_knowledgebase_doc_service_knowledgebase_doc_service_proto_msgTypes[39]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SaveDocSyncReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SaveDocSyncReq) ProtoMessage() {}
+
+func (x *SaveDocSyncReq) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_knowledgebase_doc_service_knowledgebase_doc_service_proto_msgTypes[39]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SaveDocSyncReq.ProtoReflect.Descriptor instead.
+func (*SaveDocSyncReq) Descriptor() ([]byte, []int) {
+	return file_proto_knowledgebase_doc_service_knowledgebase_doc_service_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *SaveDocSyncReq) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *SaveDocSyncReq) GetOrgId() string {
+	if x != nil {
+		return x.OrgId
+	}
+	return ""
+}
+
+func (x *SaveDocSyncReq) GetKnowledgeId() string {
+	if x != nil {
+		return x.KnowledgeId
+	}
+	return ""
+}
+
+func (x *SaveDocSyncReq) GetDocId() string {
+	if x != nil {
+		return x.DocId
+	}
+	return ""
+}
+
+func (x *SaveDocSyncReq) GetImportTaskId() string {
+	if x != nil {
+		return x.ImportTaskId
+	}
+	return ""
+}
+
+func (x *SaveDocSyncReq) GetCronExpr() string {
+	if x != nil {
+		return x.CronExpr
+	}
+	return ""
+}
+
+func (x *SaveDocSyncReq) GetIntervalMinutes() int32 {
+	if x != nil {
+		return x.IntervalMinutes
+	}
+	return 0
+}
+
+func (x *SaveDocSyncReq) GetEnable() bool {
+	if x != nil {
+		return x.Enable
+	}
+	return false
+}
+
+type SaveDocSyncResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SyncId string `protobuf:"bytes,1,opt,name=syncId,proto3" json:"syncId,omitempty"`
+}
+
+func (x *SaveDocSyncResp) Reset() {
+	*x = SaveDocSyncResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_knowledgebase_doc_service_knowledgebase_doc_service_proto_msgTypes[40]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SaveDocSyncResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SaveDocSyncResp) ProtoMessage() {}
+
+func (x *SaveDocSyncResp) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_knowledgebase_doc_service_knowledgebase_doc_service_proto_msgTypes[40]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SaveDocSyncResp.ProtoReflect.Descriptor instead.
+func (*SaveDocSyncResp) Descriptor() ([]byte, []int) {
+	return file_proto_knowledgebase_doc_service_knowledgebase_doc_service_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *SaveDocSyncResp) GetSyncId() string {
+	if x != nil {
+		return x.SyncId
+	}
+	return ""
+}
+
+type DeleteDocSyncReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId string `protobuf:"bytes,1,opt,name=userId,proto3" json:"userId,omitempty"`
+	OrgId  string `protobuf:"bytes,2,opt,name=orgId,proto3" json:"orgId,omitempty"`
+	SyncId string `protobuf:"bytes,3,opt,name=syncId,proto3" json:"syncId,omitempty"`
+}
+
+func (x *DeleteDocSyncReq) Reset() {
+	*x = DeleteDocSyncReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_knowledgebase_doc_service_knowledgebase_doc_service_proto_msgTypes[41]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteDocSyncReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteDocSyncReq) ProtoMessage() {}
+
+func (x *DeleteDocSyncReq) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_knowledgebase_doc_service_knowledgebase_doc_service_proto_msgTypes[41]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteDocSyncReq.ProtoReflect.Descriptor instead.
+func (*DeleteDocSyncReq) Descriptor() ([]byte, []int) {
+	return file_proto_knowledgebase_doc_service_knowledgebase_doc_service_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *DeleteDocSyncReq) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *DeleteDocSyncReq) GetOrgId() string {
+	if x != nil {
+		return x.OrgId
+	}
+	return ""
+}
+
+func (x *DeleteDocSyncReq) GetSyncId() string {
+	if x != nil {
+		return x.SyncId
+	}
+	return ""
+}
+
+type GetDocSyncListReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId      string `protobuf:"bytes,1,opt,name=userId,proto3" json:"userId,omitempty"`
+	OrgId       string `protobuf:"bytes,2,opt,name=orgId,proto3" json:"orgId,omitempty"`
+	KnowledgeId string `protobuf:"bytes,3,opt,name=knowledgeId,proto3" json:"knowledgeId,omitempty"`
+}
+
+func (x *GetDocSyncListReq) Reset() {
+	*x = GetDocSyncListReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_knowledgebase_doc_service_knowledgebase_doc_service_proto_msgTypes[42]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetDocSyncListReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDocSyncListReq) ProtoMessage() {}
+
+func (x *GetDocSyncListReq) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_knowledgebase_doc_service_knowledgebase_doc_service_proto_msgTypes[42]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDocSyncListReq.ProtoReflect.Descriptor instead.
+func (*GetDocSyncListReq) Descriptor() ([]byte, []int) {
+	return file_proto_knowledgebase_doc_service_knowledgebase_doc_service_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *GetDocSyncListReq) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *GetDocSyncListReq) GetOrgId() string {
+	if x != nil {
+		return x.OrgId
+	}
+	return ""
+}
+
+func (x *GetDocSyncListReq) GetKnowledgeId() string {
+	if x != nil {
+		return x.KnowledgeId
+	}
+	return ""
+}
+
+type GetDocSyncListResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	List []*DocSyncInfo `protobuf:"bytes,1,rep,name=list,proto3" json:"list,omitempty"`
+}
+
+func (x *GetDocSyncListResp) Reset() {
+	*x = GetDocSyncListResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_knowledgebase_doc_service_knowledgebase_doc_service_proto_msgTypes[43]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetDocSyncListResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDocSyncListResp) ProtoMessage() {}
+
+func (x *GetDocSyncListResp) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_knowledgebase_doc_service_knowledgebase_doc_service_proto_msgTypes[43]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDocSyncListResp.ProtoReflect.Descriptor instead.
+func (*GetDocSyncListResp) Descriptor() ([]byte, []int) {
+	return file_proto_knowledgebase_doc_service_knowledgebase_doc_service_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *GetDocSyncListResp) GetList() []*DocSyncInfo {
+	if x != nil {
+		return x.List
+	}
+	return nil
+}
+
+type DocSyncInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SyncId          string `protobuf:"bytes,1,opt,name=syncId,proto3" json:"syncId,omitempty"`
+	KnowledgeId     string `protobuf:"bytes,2,opt,name=knowledgeId,proto3" json:"knowledgeId,omitempty"`
+	DocId           string `protobuf:"bytes,3,opt,name=docId,proto3" json:"docId,omitempty"`
+	ImportTaskId    string `protobuf:"bytes,4,opt,name=importTaskId,proto3" json:"importTaskId,omitempty"`
+	CronExpr        string `protobuf:"bytes,5,opt,name=cronExpr,proto3" json:"cronExpr,omitempty"`
+	IntervalMinutes int32  `protobuf:"varint,6,opt,name=intervalMinutes,proto3" json:"intervalMinutes,omitempty"`
+	Enable          bool   `protobuf:"varint,7,opt,name=enable,proto3" json:"enable,omitempty"`
+	LastSyncAt      string `protobuf:"bytes,8,opt,name=lastSyncAt,proto3" json:"lastSyncAt,omitempty"` //上次同步时间
+	NextSyncAt      string `protobuf:"bytes,9,opt,name=nextSyncAt,proto3" json:"nextSyncAt,omitempty"` //下次同步时间
+	CreatedAt       string `protobuf:"bytes,10,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
+}
+
+func (x *DocSyncInfo) Reset() {
+	*x = DocSyncInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_knowledgebase_doc_service_knowledgebase_doc_service_proto_msgTypes[44]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DocSyncInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DocSyncInfo) ProtoMessage() {}
+
+func (x *DocSyncInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_knowledgebase_doc_service_knowledgebase_doc_service_proto_msgTypes[44]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DocSyncInfo.ProtoReflect.Descriptor instead.
+func (*DocSyncInfo) Descriptor() ([]byte, []int) {
+	return file_proto_knowledgebase_doc_service_knowledgebase_doc_service_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *DocSyncInfo) GetSyncId() string {
+	if x != nil {
+		return x.SyncId
+	}
+	return ""
+}
+
+func (x *DocSyncInfo) GetKnowledgeId() string {
+	if x != nil {
+		return x.KnowledgeId
+	}
+	return ""
+}
+
+func (x *DocSyncInfo) GetDocId() string {
+	if x != nil {
+		return x.DocId
+	}
+	return ""
+}
+
+func (x *DocSyncInfo) GetImportTaskId() string {
+	if x != nil {
+		return x.ImportTaskId
+	}
+	return ""
+}
+
+func (x *DocSyncInfo) GetCronExpr() string {
+	if x != nil {
+		return x.CronExpr
+	}
+	return ""
+}
+
+func (x *DocSyncInfo) GetIntervalMinutes() int32 {
+	if x != nil {
+		return x.IntervalMinutes
+	}
+	return 0
+}
+
+func (x *DocSyncInfo) GetEnable() bool {
+	if x != nil {
+		return x.Enable
+	}
+	return false
+}
+
+func (x *DocSyncInfo) GetLastSyncAt() string {
+	if x != nil {
+		return x.LastSyncAt
+	}
+	return ""
+}
+
+func (x *DocSyncInfo) GetNextSyncAt() string {
+	if x != nil {
+		return x.NextSyncAt
+	}
+	return ""
+}
+
+func (x *DocSyncInfo) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+type RunDocSyncReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId string `protobuf:"bytes,1,opt,name=userId,proto3" json:"userId,omitempty"`
+	OrgId  string `protobuf:"bytes,2,opt,name=orgId,proto3" json:"orgId,omitempty"`
+	SyncId string `protobuf:"bytes,3,opt,name=syncId,proto3" json:"syncId,omitempty"`
+}
+
+func (x *RunDocSyncReq) Reset() {
+	*x = RunDocSyncReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_knowledgebase_doc_service_knowledgebase_doc_service_proto_msgTypes[45]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RunDocSyncReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RunDocSyncReq) ProtoMessage() {}
+
+func (x *RunDocSyncReq) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_knowledgebase_doc_service_knowledgebase_doc_service_proto_msgTypes[45]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RunDocSyncReq.ProtoReflect.Descriptor instead.
+func (*RunDocSyncReq) Descriptor() ([]byte, []int) {
+	return file_proto_knowledgebase_doc_service_knowledgebase_doc_service_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *RunDocSyncReq) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *RunDocSyncReq) GetOrgId() string {
+	if x != nil {
+		return x.OrgId
+	}
+	return ""
+}
+
+func (x *RunDocSyncReq) GetSyncId() string {
+	if x != nil {
+		return x.SyncId
+	}
+	return ""
+}
+
+type GetDocSyncHistoryListReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId   string `protobuf:"bytes,1,opt,name=userId,proto3" json:"userId,omitempty"`
+	OrgId    string `protobuf:"bytes,2,opt,name=orgId,proto3" json:"orgId,omitempty"`
+	SyncId   string `protobuf:"bytes,3,opt,name=syncId,proto3" json:"syncId,omitempty"`
+	DocId    string `protobuf:"bytes,4,opt,name=docId,proto3" json:"docId,omitempty"` //按文档过滤，可选
+	PageSize int32  `protobuf:"varint,5,opt,name=pageSize,proto3" json:"pageSize,omitempty"`
+	PageNum  int32  `protobuf:"varint,6,opt,name=pageNum,proto3" json:"pageNum,omitempty"`
+}
+
+func (x *GetDocSyncHistoryListReq) Reset() {
+	*x = GetDocSyncHistoryListReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_knowledgebase_doc_service_knowledgebase_doc_service_proto_msgTypes[46]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetDocSyncHistoryListReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDocSyncHistoryListReq) ProtoMessage() {}
+
+func (x *GetDocSyncHistoryListReq) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_knowledgebase_doc_service_knowledgebase_doc_service_proto_msgTypes[46]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDocSyncHistoryListReq.ProtoReflect.Descriptor instead.
+func (*GetDocSyncHistoryListReq) Descriptor() ([]byte, []int) {
+	return file_proto_knowledgebase_doc_service_knowledgebase_doc_service_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *GetDocSyncHistoryListReq) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *GetDocSyncHistoryListReq) GetOrgId() string {
+	if x != nil {
+		return x.OrgId
+	}
+	return ""
+}
+
+func (x *GetDocSyncHistoryListReq) GetSyncId() string {
+	if x != nil {
+		return x.SyncId
+	}
+	return ""
+}
+
+func (x *GetDocSyncHistoryListReq) GetDocId() string {
+	if x != nil {
+		return x.DocId
+	}
+	return ""
+}
+
+func (x *GetDocSyncHistoryListReq) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *GetDocSyncHistoryListReq) GetPageNum() int32 {
+	if x != nil {
+		return x.PageNum
+	}
+	return 0
+}
+
+type GetDocSyncHistoryListResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	List     []*DocSyncHistoryInfo `protobuf:"bytes,1,rep,name=list,proto3" json:"list,omitempty"`
+	Total    int64                 `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	PageNum  int32                 `protobuf:"varint,3,opt,name=pageNum,proto3" json:"pageNum,omitempty"`
+	PageSize int32                 `protobuf:"varint,4,opt,name=pageSize,proto3" json:"pageSize,omitempty"`
+}
+
+func (x *GetDocSyncHistoryListResp) Reset() {
+	*x = GetDocSyncHistoryListResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_knowledgebase_doc_service_knowledgebase_doc_service_proto_msgTypes[47]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetDocSyncHistoryListResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDocSyncHistoryListResp) ProtoMessage() {}
+
+func (x *GetDocSyncHistoryListResp) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_knowledgebase_doc_service_knowledgebase_doc_service_proto_msgTypes[47]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDocSyncHistoryListResp.ProtoReflect.Descriptor instead.
+func (*GetDocSyncHistoryListResp) Descriptor() ([]byte, []int) {
+	return file_proto_knowledgebase_doc_service_knowledgebase_doc_service_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *GetDocSyncHistoryListResp) GetList() []*DocSyncHistoryInfo {
+	if x != nil {
+		return x.List
+	}
+	return nil
+}
+
+func (x *GetDocSyncHistoryListResp) GetTotal() int64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *GetDocSyncHistoryListResp) GetPageNum() int32 {
+	if x != nil {
+		return x.PageNum
+	}
+	return 0
+}
+
+func (x *GetDocSyncHistoryListResp) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+type DocSyncHistoryInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SyncId     string `protobuf:"bytes,1,opt,name=syncId,proto3" json:"syncId,omitempty"`
+	DocId      string `protobuf:"bytes,2,opt,name=docId,proto3" json:"docId,omitempty"`
+	DocName    string `protobuf:"bytes,3,opt,name=docName,proto3" json:"docName,omitempty"`
+	DocUrl     string `protobuf:"bytes,4,opt,name=docUrl,proto3" json:"docUrl,omitempty"`
+	Status     int32  `protobuf:"varint,5,opt,name=status,proto3" json:"status,omitempty"`        //同步结果：1.内容未变化 2.内容已变化并重新导入 3.同步失败
+	ContentMd5 string `protobuf:"bytes,6,opt,name=contentMd5,proto3" json:"contentMd5,omitempty"` //本次获取的内容摘要
+	ErrorMsg   string `protobuf:"bytes,7,opt,name=errorMsg,proto3" json:"errorMsg,omitempty"`
+	StartAt    string `protobuf:"bytes,8,opt,name=startAt,proto3" json:"startAt,omitempty"`
+	FinishAt   string `protobuf:"bytes,9,opt,name=finishAt,proto3" json:"finishAt,omitempty"`
+}
+
+func (x *DocSyncHistoryInfo) Reset() {
+	*x = DocSyncHistoryInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_knowledgebase_doc_service_knowledgebase_doc_service_proto_msgTypes[48]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DocSyncHistoryInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DocSyncHistoryInfo) ProtoMessage() {}
+
+func (x *DocSyncHistoryInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_knowledgebase_doc_service_knowledgebase_doc_service_proto_msgTypes[48]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DocSyncHistoryInfo.ProtoReflect.Descriptor instead.
+func (*DocSyncHistoryInfo) Descriptor() ([]byte, []int) {
+	return file_proto_knowledgebase_doc_service_knowledgebase_doc_service_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *DocSyncHistoryInfo) GetSyncId() string {
+	if x != nil {
+		return x.SyncId
+	}
+	return ""
+}
+
+func (x *DocSyncHistoryInfo) GetDocId() string {
+	if x != nil {
+		return x.DocId
+	}
+	return ""
+}
+
+func (x *DocSyncHistoryInfo) GetDocName() string {
+	if x != nil {
+		return x.DocName
+	}
+	return ""
+}
+
+func (x *DocSyncHistoryInfo) GetDocUrl() string {
+	if x != nil {
+		return x.DocUrl
+	}
+	return ""
+}
+
+func (x *DocSyncHistoryInfo) GetStatus() int32 {
+	if x != nil {
+		return x.Status
+	}
+	return 0
+}
+
+func (x *DocSyncHistoryInfo) GetContentMd5() string {
+	if x != nil {
+		return x.ContentMd5
+	}
+	return ""
+}
+
+func (x *DocSyncHistoryInfo) GetErrorMsg() string {
+	if x != nil {
+		return x.ErrorMsg
+	}
+	return ""
+}
+
+func (x *DocSyncHistoryInfo) GetStartAt() string {
+	if x != nil {
+		return x.StartAt
+	}
+	return ""
+}
+
+func (x *DocSyncHistoryInfo) GetFinishAt() string {
+	if x != nil {
+		return x.FinishAt
+	}
+	return ""
+}
+
+type ConnectorConfig struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Endpoint   string   `protobuf:"bytes,1,opt,name=endpoint,proto3" json:"endpoint,omitempty"`     //s3：服务地址；webdav：服务地址
+	Bucket     string   `protobuf:"bytes,2,opt,name=bucket,proto3" json:"bucket,omitempty"`         //s3：桶名称
+	UseSsl     bool     `protobuf:"varint,3,opt,name=useSsl,proto3" json:"useSsl,omitempty"`        //s3：是否使用https
+	RepoUrl    string   `protobuf:"bytes,4,opt,name=repoUrl,proto3" json:"repoUrl,omitempty"`       //git：仓库地址
+	Branch     string   `protobuf:"bytes,5,opt,name=branch,proto3" json:"branch,omitempty"`         //git：分支，为空使用默认分支
+	RootPath   string   `protobuf:"bytes,6,opt,name=rootPath,proto3" json:"rootPath,omitempty"`     //s3：对象前缀；git、webdav：目录；local：服务端挂载目录
+	PathFilter []string `protobuf:"bytes,7,rep,name=pathFilter,proto3" json:"pathFilter,omitempty"` //路径过滤规则（glob），为空导入全部文件
+}
+
+func (x *ConnectorConfig) Reset() {
+	*x = ConnectorConfig{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_knowledgebase_doc_service_knowledgebase_doc_service_proto_msgTypes[49]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ConnectorConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConnectorConfig) ProtoMessage() {}
+
+func (x *ConnectorConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_knowledgebase_doc_service_knowledgebase_doc_service_proto_msgTypes[49]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConnectorConfig.ProtoReflect.Descriptor instead.
+func (*ConnectorConfig) Descriptor() ([]byte, []int) {
+	return file_proto_knowledgebase_doc_service_knowledgebase_doc_service_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *ConnectorConfig) GetEndpoint() string {
+	if x != nil {
+		return x.Endpoint
+	}
+	return ""
+}
+
+func (x *ConnectorConfig) GetBucket() string {
+	if x != nil {
+		return x.Bucket
+	}
+	return ""
+}
+
+func (x *ConnectorConfig) GetUseSsl() bool {
+	if x != nil {
+		return x.UseSsl
+	}
+	return false
+}
+
+func (x *ConnectorConfig) GetRepoUrl() string {
+	if x != nil {
+		return x.RepoUrl
+	}
+	return ""
+}
+
+func (x *ConnectorConfig) GetBranch() string {
+	if x != nil {
+		return x.Branch
+	}
+	return ""
+}
+
+func (x *ConnectorConfig) GetRootPath() string {
+	if x != nil {
+		return x.RootPath
+	}
+	return ""
+}
+
+func (x *ConnectorConfig) GetPathFilter() []string {
+	if x != nil {
+		return x.PathFilter
+	}
+	return nil
+}
+
+type ConnectorCredential struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AccessKey string `protobuf:"bytes,1,opt,name=accessKey,proto3" json:"accessKey,omitempty"` //s3
+	SecretKey string `protobuf:"bytes,2,opt,name=secretKey,proto3" json:"secretKey,omitempty"` //s3
+	Username  string `protobuf:"bytes,3,opt,name=username,proto3" json:"username,omitempty"`   //git、webdav
+	Password  string `protobuf:"bytes,4,opt,name=password,proto3" json:"password,omitempty"`   //git（可填token）、webdav
+}
+
+func (x *ConnectorCredential) Reset() {
+	*x = ConnectorCredential{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_knowledgebase_doc_service_knowledgebase_doc_service_proto_msgTypes[50]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ConnectorCredential) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConnectorCredential) ProtoMessage() {}
+
+func (x *ConnectorCredential) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_knowledgebase_doc_service_knowledgebase_doc_service_proto_msgTypes[50]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConnectorCredential.ProtoReflect.Descriptor instead.
+func (*ConnectorCredential) Descriptor() ([]byte, []int) {
+	return file_proto_knowledgebase_doc_service_knowledgebase_doc_service_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *ConnectorCredential) GetAccessKey() string {
+	if x != nil {
+		return x.AccessKey
+	}
+	return ""
+}
+
+func (x *ConnectorCredential) GetSecretKey() string {
+	if x != nil {
+		return x.SecretKey
+	}
+	return ""
+}
+
+func (x *ConnectorCredential) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *ConnectorCredential) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+type SaveConnectorReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId        string               `protobuf:"bytes,1,opt,name=userId,proto3" json:"userId,omitempty"`
+	OrgId         string               `protobuf:"bytes,2,opt,name=orgId,proto3" json:"orgId,omitempty"`
+	KnowledgeId   string               `protobuf:"bytes,3,opt,name=knowledgeId,proto3" json:"knowledgeId,omitempty"`
+	ConnectorId   string               `protobuf:"bytes,4,opt,name=connectorId,proto3" json:"connectorId,omitempty"` //为空时创建
+	Name          string               `protobuf:"bytes,5,opt,name=name,proto3" json:"name,omitempty"`
+	ConnectorType string               `protobuf:"bytes,6,opt,name=connectorType,proto3" json:"connectorType,omitempty"` //连接器类型：s3、git、webdav、local
+	Config        *ConnectorConfig     `protobuf:"bytes,7,opt,name=config,proto3" json:"config,omitempty"`
+	Credential    *ConnectorCredential `protobuf:"bytes,8,opt,name=credential,proto3" json:"credential,omitempty"`        //更新时为空则保留原凭证
+	DocSegment    *DocSegment          `protobuf:"bytes,9,opt,name=docSegment,proto3" json:"docSegment,omitempty"`        //分段信息配置
+	DocAnalyzer   []string             `protobuf:"bytes,10,rep,name=docAnalyzer,proto3" json:"docAnalyzer,omitempty"`     //文档解析类型
+	OcrModelId    string               `protobuf:"bytes,11,opt,name=ocrModelId,proto3" json:"ocrModelId,omitempty"`       //ocr模型id
+	DocPreprocess []string             `protobuf:"bytes,12,rep,name=docPreprocess,proto3" json:"docPreprocess,omitempty"` //文本预处理规则
+}
+
+func (x *SaveConnectorReq) Reset() {
+	*x = SaveConnectorReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_knowledgebase_doc_service_knowledgebase_doc_service_proto_msgTypes[51]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SaveConnectorReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SaveConnectorReq) ProtoMessage() {}
+
+func (x *SaveConnectorReq) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_knowledgebase_doc_service_knowledgebase_doc_service_proto_msgTypes[51]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SaveConnectorReq.ProtoReflect.Descriptor instead.
+func (*SaveConnectorReq) Descriptor() ([]byte, []int) {
+	return file_proto_knowledgebase_doc_service_knowledgebase_doc_service_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *SaveConnectorReq) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *SaveConnectorReq) GetOrgId() string {
+	if x != nil {
+		return x.OrgId
+	}
+	return ""
+}
+
+func (x *SaveConnectorReq) GetKnowledgeId() string {
+	if x != nil {
+		return x.KnowledgeId
+	}
+	return ""
+}
+
+func (x *SaveConnectorReq) GetConnectorId() string {
+	if x != nil {
+		return x.ConnectorId
+	}
+	return ""
+}
+
+func (x *SaveConnectorReq) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *SaveConnectorReq) GetConnectorType() string {
+	if x != nil {
+		return x.ConnectorType
+	}
+	return ""
+}
+
+func (x *SaveConnectorReq) GetConfig() *ConnectorConfig {
+	if x != nil {
+		return x.Config
+	}
+	return nil
+}
+
+func (x *SaveConnectorReq) GetCredential() *ConnectorCredential {
+	if x != nil {
+		return x.Credential
+	}
+	return nil
+}
+
+func (x *SaveConnectorReq) GetDocSegment() *DocSegment {
+	if x != nil {
+		return x.DocSegment
+	}
+	return nil
+}
+
+func (x *SaveConnectorReq) GetDocAnalyzer() []string {
+	if x != nil {
+		return x.DocAnalyzer
+	}
+	return nil
+}
+
+func (x *SaveConnectorReq) GetOcrModelId() string {
+	if x != nil {
+		return x.OcrModelId
+	}
+	return ""
+}
+
+func (x *SaveConnectorReq) GetDocPreprocess() []string {
+	if x != nil {
+		return x.DocPreprocess
+	}
+	return nil
+}
+
+type SaveConnectorResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ConnectorId string `protobuf:"bytes,1,opt,name=connectorId,proto3" json:"connectorId,omitempty"`
+}
+
+func (x *SaveConnectorResp) Reset() {
+	*x = SaveConnectorResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_knowledgebase_doc_service_knowledgebase_doc_service_proto_msgTypes[52]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SaveConnectorResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SaveConnectorResp) ProtoMessage() {}
+
+func (x *SaveConnectorResp) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_knowledgebase_doc_service_knowledgebase_doc_service_proto_msgTypes[52]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SaveConnectorResp.ProtoReflect.Descriptor instead.
+func (*SaveConnectorResp) Descriptor() ([]byte, []int) {
+	return file_proto_knowledgebase_doc_service_knowledgebase_doc_service_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *SaveConnectorResp) GetConnectorId() string {
+	if x != nil {
+		return x.ConnectorId
+	}
+	return ""
+}
+
+type DeleteConnectorReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId      string `protobuf:"bytes,1,opt,name=userId,proto3" json:"userId,omitempty"`
+	OrgId       string `protobuf:"bytes,2,opt,name=orgId,proto3" json:"orgId,omitempty"`
+	ConnectorId string `protobuf:"bytes,3,opt,name=connectorId,proto3" json:"connectorId,omitempty"`
+}
+
+func (x *DeleteConnectorReq) Reset() {
+	*x = DeleteConnectorReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_knowledgebase_doc_service_knowledgebase_doc_service_proto_msgTypes[53]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteConnectorReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteConnectorReq) ProtoMessage() {}
+
+func (x *DeleteConnectorReq) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_knowledgebase_doc_service_knowledgebase_doc_service_proto_msgTypes[53]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteConnectorReq.ProtoReflect.Descriptor instead.
+func (*DeleteConnectorReq) Descriptor() ([]byte, []int) {
+	return file_proto_knowledgebase_doc_service_knowledgebase_doc_service_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *DeleteConnectorReq) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *DeleteConnectorReq) GetOrgId() string {
+	if x != nil {
+		return x.OrgId
+	}
+	return ""
+}
+
+func (x *DeleteConnectorReq) GetConnectorId() string {
+	if x != nil {
+		return x.ConnectorId
+	}
+	return ""
+}
+
+type GetConnectorListReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId      string `protobuf:"bytes,1,opt,name=userId,proto3" json:"userId,omitempty"`
+	OrgId       string `protobuf:"bytes,2,opt,name=orgId,proto3" json:"orgId,omitempty"`
+	KnowledgeId string `protobuf:"bytes,3,opt,name=knowledgeId,proto3" json:"knowledgeId,omitempty"`
+}
+
+func (x *GetConnectorListReq) Reset() {
+	*x = GetConnectorListReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_knowledgebase_doc_service_knowledgebase_doc_service_proto_msgTypes[54]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetConnectorListReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetConnectorListReq) ProtoMessage() {}
+
+func (x *GetConnectorListReq) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_knowledgebase_doc_service_knowledgebase_doc_service_proto_msgTypes[54]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetConnectorListReq.ProtoReflect.Descriptor instead.
+func (*GetConnectorListReq) Descriptor() ([]byte, []int) {
+	return file_proto_knowledgebase_doc_service_knowledgebase_doc_service_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *GetConnectorListReq) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *GetConnectorListReq) GetOrgId() string {
+	if x != nil {
+		return x.OrgId
+	}
+	return ""
+}
+
+func (x *GetConnectorListReq) GetKnowledgeId() string {
+	if x != nil {
+		return x.KnowledgeId
+	}
+	return ""
+}
+
+type GetConnectorListResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	List []*ConnectorInfo `protobuf:"bytes,1,rep,name=list,proto3" json:"list,omitempty"`
+}
+
+func (x *GetConnectorListResp) Reset() {
+	*x = GetConnectorListResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_knowledgebase_doc_service_knowledgebase_doc_service_proto_msgTypes[55]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetConnectorListResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetConnectorListResp) ProtoMessage() {}
+
+func (x *GetConnectorListResp) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_knowledgebase_doc_service_knowledgebase_doc_service_proto_msgTypes[55]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetConnectorListResp.ProtoReflect.Descriptor instead.
+func (*GetConnectorListResp) Descriptor() ([]byte, []int) {
+	return file_proto_knowledgebase_doc_service_knowledgebase_doc_service_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *GetConnectorListResp) GetList() []*ConnectorInfo {
+	if x != nil {
+		return x.List
+	}
+	return nil
+}
+
+type ConnectorInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ConnectorId      string           `protobuf:"bytes,1,opt,name=connectorId,proto3" json:"connectorId,omitempty"`
+	KnowledgeId      string           `protobuf:"bytes,2,opt,name=knowledgeId,proto3" json:"knowledgeId,omitempty"`
+	Name             string           `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	ConnectorType    string           `protobuf:"bytes,4,opt,name=connectorType,proto3" json:"connectorType,omitempty"`
+	Config           *ConnectorConfig `protobuf:"bytes,5,opt,name=config,proto3" json:"config,omitempty"`
+	HasCredential    bool             `protobuf:"varint,6,opt,name=hasCredential,proto3" json:"hasCredential,omitempty"` //是否已设置凭证，凭证不返回
+	Status           int32            `protobuf:"varint,7,opt,name=status,proto3" json:"status,omitempty"`               //0.未执行 1.执行中 2.执行成功 3.执行失败
+	ErrorMsg         string           `protobuf:"bytes,8,opt,name=errorMsg,proto3" json:"errorMsg,omitempty"`
+	Cursor           string           `protobuf:"bytes,9,opt,name=cursor,proto3" json:"cursor,omitempty"`                      //增量游标
+	LastImportTaskId string           `protobuf:"bytes,10,opt,name=lastImportTaskId,proto3" json:"lastImportTaskId,omitempty"` //最近一次创建的导入任务id
+	LastRunAt        string           `protobuf:"bytes,11,opt,name=lastRunAt,proto3" json:"lastRunAt,omitempty"`
+	CreatedAt        string           `protobuf:"bytes,12,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
+}
+
+func (x *ConnectorInfo) Reset() {
+	*x = ConnectorInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_knowledgebase_doc_service_knowledgebase_doc_service_proto_msgTypes[56]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ConnectorInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConnectorInfo) ProtoMessage() {}
+
+func (x *ConnectorInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_knowledgebase_doc_service_knowledgebase_doc_service_proto_msgTypes[56]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConnectorInfo.ProtoReflect.Descriptor instead.
+func (*ConnectorInfo) Descriptor() ([]byte, []int) {
+	return file_proto_knowledgebase_doc_service_knowledgebase_doc_service_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *ConnectorInfo) GetConnectorId() string {
+	if x != nil {
+		return x.ConnectorId
+	}
+	return ""
+}
+
+func (x *ConnectorInfo) GetKnowledgeId() string {
+	if x != nil {
+		return x.KnowledgeId
+	}
+	return ""
+}
+
+func (x *ConnectorInfo) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ConnectorInfo) GetConnectorType() string {
+	if x != nil {
+		return x.ConnectorType
+	}
+	return ""
+}
+
+func (x *ConnectorInfo) GetConfig() *ConnectorConfig {
+	if x != nil {
+		return x.Config
+	}
+	return nil
+}
+
+func (x *ConnectorInfo) GetHasCredential() bool {
+	if x != nil {
+		return x.HasCredential
+	}
+	return false
+}
+
+func (x *ConnectorInfo) GetStatus() int32 {
+	if x != nil {
+		return x.Status
+	}
+	return 0
+}
+
+func (x *ConnectorInfo) GetErrorMsg() string {
+	if x != nil {
+		return x.ErrorMsg
+	}
+	return ""
+}
+
+func (x *ConnectorInfo) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
+func (x *ConnectorInfo) GetLastImportTaskId() string {
+	if x != nil {
+		return x.LastImportTaskId
+	}
+	return ""
+}
+
+func (x *ConnectorInfo) GetLastRunAt() string {
+	if x != nil {
+		return x.LastRunAt
+	}
+	return ""
+}
+
+func (x *ConnectorInfo) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+type RunConnectorReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId      string `protobuf:"bytes,1,opt,name=userId,proto3" json:"userId,omitempty"`
+	OrgId       string `protobuf:"bytes,2,opt,name=orgId,proto3" json:"orgId,omitempty"`
+	ConnectorId string `protobuf:"bytes,3,opt,name=connectorId,proto3" json:"connectorId,omitempty"`
+}
+
+func (x *RunConnectorReq) Reset() {
+	*x = RunConnectorReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_knowledgebase_doc_service_knowledgebase_doc_service_proto_msgTypes[57]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RunConnectorReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RunConnectorReq) ProtoMessage() {}
+
+func (x *RunConnectorReq) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_knowledgebase_doc_service_knowledgebase_doc_service_proto_msgTypes[57]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RunConnectorReq.ProtoReflect.Descriptor instead.
+func (*RunConnectorReq) Descriptor() ([]byte, []int) {
+	return file_proto_knowledgebase_doc_service_knowledgebase_doc_service_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *RunConnectorReq) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *RunConnectorReq) GetOrgId() string {
+	if x != nil {
+		return x.OrgId
+	}
+	return ""
+}
+
+func (x *RunConnectorReq) GetConnectorId() string {
+	if x != nil {
+		return x.ConnectorId
+	}
+	return ""
+}
+
+type UploadDocVersionReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId  string       `protobuf:"bytes,1,opt,name=userId,proto3" json:"userId,omitempty"`
+	OrgId   string       `protobuf:"bytes,2,opt,name=orgId,proto3" json:"orgId,omitempty"`
+	DocId   string       `protobuf:"bytes,3,opt,name=docId,proto3" json:"docId,omitempty"`
+	DocInfo *DocFileInfo `protobuf:"bytes,4,opt,name=docInfo,proto3" json:"docInfo,omitempty"` //新版本文件，不支持压缩包
+}
+
+func (x *UploadDocVersionReq) Reset() {
+	*x = UploadDocVersionReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_knowledgebase_doc_service_knowledgebase_doc_service_proto_msgTypes[58]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UploadDocVersionReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UploadDocVersionReq) ProtoMessage() {}
+
+func (x *UploadDocVersionReq) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_knowledgebase_doc_service_knowledgebase_doc_service_proto_msgTypes[58]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UploadDocVersionReq.ProtoReflect.Descriptor instead.
+func (*UploadDocVersionReq) Descriptor() ([]byte, []int) {
+	return file_proto_knowledgebase_doc_service_knowledgebase_doc_service_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *UploadDocVersionReq) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *UploadDocVersionReq) GetOrgId() string {
+	if x != nil {
+		return x.OrgId
+	}
+	return ""
+}
+
+func (x *UploadDocVersionReq) GetDocId() string {
+	if x != nil {
+		return x.DocId
+	}
+	return ""
+}
+
+func (x *UploadDocVersionReq) GetDocInfo() *DocFileInfo {
+	if x != nil {
+		return x.DocInfo
+	}
+	return nil
+}
+
+type GetDocVersionListReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId string `protobuf:"bytes,1,opt,name=userId,proto3" json:"userId,omitempty"`
+	OrgId  string `protobuf:"bytes,2,opt,name=orgId,proto3" json:"orgId,omitempty"`
+	DocId  string `protobuf:"bytes,3,opt,name=docId,proto3" json:"docId,omitempty"`
+}
+
+func (x *GetDocVersionListReq) Reset() {
+	*x = GetDocVersionListReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_knowledgebase_doc_service_knowledgebase_doc_service_proto_msgTypes[59]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetDocVersionListReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDocVersionListReq) ProtoMessage() {}
+
+func (x *GetDocVersionListReq) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_knowledgebase_doc_service_knowledgebase_doc_service_proto_msgTypes[59]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDocVersionListReq.ProtoReflect.Descriptor instead.
+func (*GetDocVersionListReq) Descriptor() ([]byte, []int) {
+	return file_proto_knowledgebase_doc_service_knowledgebase_doc_service_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *GetDocVersionListReq) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *GetDocVersionListReq) GetOrgId() string {
+	if x != nil {
+		return x.OrgId
+	}
+	return ""
+}
+
+func (x *GetDocVersionListReq) GetDocId() string {
+	if x != nil {
+		return x.DocId
+	}
+	return ""
+}
+
+type GetDocVersionListResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	List []*DocVersionInfo `protobuf:"bytes,1,rep,name=list,proto3" json:"list,omitempty"` //按版本号倒序，第一条为当前生效版本
+}
+
+func (x *GetDocVersionListResp) Reset() {
+	*x = GetDocVersionListResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_knowledgebase_doc_service_knowledgebase_doc_service_proto_msgTypes[60]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetDocVersionListResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDocVersionListResp) ProtoMessage() {}
+
+func (x *GetDocVersionListResp) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_knowledgebase_doc_service_knowledgebase_doc_service_proto_msgTypes[60]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDocVersionListResp.ProtoReflect.Descriptor instead.
+func (*GetDocVersionListResp) Descriptor() ([]byte, []int) {
+	return file_proto_knowledgebase_doc_service_knowledgebase_doc_service_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *GetDocVersionListResp) GetList() []*DocVersionInfo {
+	if x != nil {
+		return x.List
+	}
+	return nil
+}
+
+type DocVersionInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	VersionId    string `protobuf:"bytes,1,opt,name=versionId,proto3" json:"versionId,omitempty"` //当前生效版本为空
+	VersionNo    int32  `protobuf:"varint,2,opt,name=versionNo,proto3" json:"versionNo,omitempty"`
+	DocName      string `protobuf:"bytes,3,opt,name=docName,proto3" json:"docName,omitempty"`
+	DocType      string `protobuf:"bytes,4,opt,name=docType,proto3" json:"docType,omitempty"`
+	DocSize      int64  `protobuf:"varint,5,opt,name=docSize,proto3" json:"docSize,omitempty"`
+	SegmentCount int32  `protobuf:"varint,6,opt,name=segmentCount,proto3" json:"segmentCount,omitempty"`
+	Active       bool   `protobuf:"varint,7,opt,name=active,proto3" json:"active,omitempty"`      //是否当前生效版本
+	CreatedAt    string `protobuf:"bytes,8,opt,name=createdAt,proto3" json:"createdAt,omitempty"` //历史版本为归档时间，当前版本为文档更新时间
+}
+
+func (x *DocVersionInfo) Reset() {
+	*x = DocVersionInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_knowledgebase_doc_service_knowledgebase_doc_service_proto_msgTypes[61]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DocVersionInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DocVersionInfo) ProtoMessage() {}
+
+func (x *DocVersionInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_knowledgebase_doc_service_knowledgebase_doc_service_proto_msgTypes[61]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DocVersionInfo.ProtoReflect.Descriptor instead.
+func (*DocVersionInfo) Descriptor() ([]byte, []int) {
+	return file_proto_knowledgebase_doc_service_knowledgebase_doc_service_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *DocVersionInfo) GetVersionId() string {
+	if x != nil {
+		return x.VersionId
+	}
+	return ""
+}
+
+func (x *DocVersionInfo) GetVersionNo() int32 {
+	if x != nil {
+		return x.VersionNo
+	}
+	return 0
+}
+
+func (x *DocVersionInfo) GetDocName() string {
+	if x != nil {
+		return x.DocName
+	}
+	return ""
+}
+
+func (x *DocVersionInfo) GetDocType() string {
+	if x != nil {
+		return x.DocType
+	}
+	return ""
+}
+
+func (x *DocVersionInfo) GetDocSize() int64 {
+	if x != nil {
+		return x.DocSize
+	}
+	return 0
+}
+
+func (x *DocVersionInfo) GetSegmentCount() int32 {
+	if x != nil {
+		return x.SegmentCount
+	}
+	return 0
+}
+
+func (x *DocVersionInfo) GetActive() bool {
+	if x != nil {
+		return x.Active
+	}
+	return false
+}
+
+func (x *DocVersionInfo) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+type DiffDocVersionReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId          string `protobuf:"bytes,1,opt,name=userId,proto3" json:"userId,omitempty"`
+	OrgId           string `protobuf:"bytes,2,opt,name=orgId,proto3" json:"orgId,omitempty"`
+	DocId           string `protobuf:"bytes,3,opt,name=docId,proto3" json:"docId,omitempty"`
+	BaseVersionId   string `protobuf:"bytes,4,opt,name=baseVersionId,proto3" json:"baseVersionId,omitempty"`     //为空表示当前生效版本
+	TargetVersionId string `protobuf:"bytes,5,opt,name=targetVersionId,proto3" json:"targetVersionId,omitempty"` //为空表示当前生效版本
+}
+
+func (x *DiffDocVersionReq) Reset() {
+	*x = DiffDocVersionReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_knowledgebase_doc_service_knowledgebase_doc_service_proto_msgTypes[62]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DiffDocVersionReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DiffDocVersionReq) ProtoMessage() {}
+
+func (x *DiffDocVersionReq) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_knowledgebase_doc_service_knowledgebase_doc_service_proto_msgTypes[62]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DiffDocVersionReq.ProtoReflect.Descriptor instead.
+func (*DiffDocVersionReq) Descriptor() ([]byte, []int) {
+	return file_proto_knowledgebase_doc_service_knowledgebase_doc_service_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *DiffDocVersionReq) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *DiffDocVersionReq) GetOrgId() string {
+	if x != nil {
+		return x.OrgId
+	}
+	return ""
+}
+
+func (x *DiffDocVersionReq) GetDocId() string {
+	if x != nil {
+		return x.DocId
+	}
+	return ""
+}
+
+func (x *DiffDocVersionReq) GetBaseVersionId() string {
+	if x != nil {
+		return x.BaseVersionId
+	}
+	return ""
+}
+
+func (x *DiffDocVersionReq) GetTargetVersionId() string {
+	if x != nil {
+		return x.TargetVersionId
+	}
+	return ""
+}
+
+type DiffDocVersionResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	BaseSegmentCount   int32             `protobuf:"varint,1,opt,name=baseSegmentCount,proto3" json:"baseSegmentCount,omitempty"`
+	TargetSegmentCount int32             `protobuf:"varint,2,opt,name=targetSegmentCount,proto3" json:"targetSegmentCount,omitempty"`
+	AddedCount         int32             `protobuf:"varint,3,opt,name=addedCount,proto3" json:"addedCount,omitempty"`
+	DeletedCount       int32             `protobuf:"varint,4,opt,name=deletedCount,proto3" json:"deletedCount,omitempty"`
+	ModifiedCount      int32             `protobuf:"varint,5,opt,name=modifiedCount,proto3" json:"modifiedCount,omitempty"`
+	List               []*DocSegmentDiff `protobuf:"bytes,6,rep,name=list,proto3" json:"list,omitempty"` //仅返回有差异的切片
+}
+
+func (x *DiffDocVersionResp) Reset() {
+	*x = DiffDocVersionResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_knowledgebase_doc_service_knowledgebase_doc_service_proto_msgTypes[63]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DiffDocVersionResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DiffDocVersionResp) ProtoMessage() {}
+
+func (x *DiffDocVersionResp) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_knowledgebase_doc_service_knowledgebase_doc_service_proto_msgTypes[63]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DiffDocVersionResp.ProtoReflect.Descriptor instead.
+func (*DiffDocVersionResp) Descriptor() ([]byte, []int) {
+	return file_proto_knowledgebase_doc_service_knowledgebase_doc_service_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *DiffDocVersionResp) GetBaseSegmentCount() int32 {
+	if x != nil {
+		return x.BaseSegmentCount
+	}
+	return 0
+}
+
+func (x *DiffDocVersionResp) GetTargetSegmentCount() int32 {
+	if x != nil {
+		return x.TargetSegmentCount
+	}
+	return 0
+}
+
+func (x *DiffDocVersionResp) GetAddedCount() int32 {
+	if x != nil {
+		return x.AddedCount
+	}
+	return 0
+}
+
+func (x *DiffDocVersionResp) GetDeletedCount() int32 {
+	if x != nil {
+		return x.DeletedCount
+	}
+	return 0
+}
+
+func (x *DiffDocVersionResp) GetModifiedCount() int32 {
+	if x != nil {
+		return x.ModifiedCount
+	}
+	return 0
+}
+
+func (x *DiffDocVersionResp) GetList() []*DocSegmentDiff {
+	if x != nil {
+		return x.List
+	}
+	return nil
+}
+
+type DocSegmentDiff struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ContentNum    int32  `protobuf:"varint,1,opt,name=contentNum,proto3" json:"contentNum,omitempty"` //切片序号
+	ChangeType    string `protobuf:"bytes,2,opt,name=changeType,proto3" json:"changeType,omitempty"`  //added、deleted、modified
+	BaseContent   string `protobuf:"bytes,3,opt,name=baseContent,proto3" json:"baseContent,omitempty"`
+	TargetContent string `protobuf:"bytes,4,opt,name=targetContent,proto3" json:"targetContent,omitempty"`
+}
+
+func (x *DocSegmentDiff) Reset() {
+	*x = DocSegmentDiff{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_knowledgebase_doc_service_knowledgebase_doc_service_proto_msgTypes[64]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DocSegmentDiff) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DocSegmentDiff) ProtoMessage() {}
+
+func (x *DocSegmentDiff) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_knowledgebase_doc_service_knowledgebase_doc_service_proto_msgTypes[64]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DocSegmentDiff.ProtoReflect.Descriptor instead.
+func (*DocSegmentDiff) Descriptor() ([]byte, []int) {
+	return file_proto_knowledgebase_doc_service_knowledgebase_doc_service_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *DocSegmentDiff) GetContentNum() int32 {
+	if x != nil {
+		return x.ContentNum
+	}
+	return 0
+}
+
+func (x *DocSegmentDiff) GetChangeType() string {
+	if x != nil {
+		return x.ChangeType
+	}
+	return ""
+}
+
+func (x *DocSegmentDiff) GetBaseContent() string {
+	if x != nil {
+		return x.BaseContent
+	}
+	return ""
+}
+
+func (x *DocSegmentDiff) GetTargetContent() string {
+	if x != nil {
+		return x.TargetContent
+	}
+	return ""
+}
+
+type RollbackDocVersionReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId    string `protobuf:"bytes,1,opt,name=userId,proto3" json:"userId,omitempty"`
+	OrgId     string `protobuf:"bytes,2,opt,name=orgId,proto3" json:"orgId,omitempty"`
+	DocId     string `protobuf:"bytes,3,opt,name=docId,proto3" json:"docId,omitempty"`
+	VersionId string `protobuf:"bytes,4,opt,name=versionId,proto3" json:"versionId,omitempty"`
+}
+
+func (x *RollbackDocVersionReq) Reset() {
+	*x = RollbackDocVersionReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_knowledgebase_doc_service_knowledgebase_doc_service_proto_msgTypes[65]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RollbackDocVersionReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RollbackDocVersionReq) ProtoMessage() {}
+
+func (x *RollbackDocVersionReq) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_knowledgebase_doc_service_knowledgebase_doc_service_proto_msgTypes[65]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RollbackDocVersionReq.ProtoReflect.Descriptor instead.
+func (*RollbackDocVersionReq) Descriptor() ([]byte, []int) {
+	return file_proto_knowledgebase_doc_service_knowledgebase_doc_service_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *RollbackDocVersionReq) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *RollbackDocVersionReq) GetOrgId() string {
+	if x != nil {
+		return x.OrgId
+	}
+	return ""
+}
+
+func (x *RollbackDocVersionReq) GetDocId() string {
+	if x != nil {
+		return x.DocId
+	}
+	return ""
+}
+
+func (x *RollbackDocVersionReq) GetVersionId() string {
+	if x != nil {
+		return x.VersionId
+	}
+	return ""
+}
+
+var File_proto_knowledgebase_doc_service_knowledgebase_doc_service_proto protoreflect.FileDescriptor
+
+var file_proto_knowledgebase_doc_service_knowledgebase_doc_service_proto_rawDesc = []byte{
+	0x0a, 0x3f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x6b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67,
+	0x65, 0x62, 0x61, 0x73, 0x65, 0x2d, 0x64, 0x6f, 0x63, 0x2d, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x2f, 0x6b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x62, 0x61, 0x73, 0x65, 0x2d,
+	0x64, 0x6f, 0x63, 0x2d, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x12, 0x19, 0x6b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x62, 0x61, 0x73, 0x65,
+	0x5f, 0x64, 0x6f, 0x63, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x1a, 0x1b, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x65, 0x6d,
+	0x70, 0x74, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xdf, 0x01, 0x0a, 0x0d, 0x47, 0x65,
+	0x74, 0x44, 0x6f, 0x63, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x12, 0x20, 0x0a, 0x0b, 0x6b,
+	0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0b, 0x6b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x49, 0x64, 0x12, 0x18, 0x0a,
+	0x07, 0x64, 0x6f, 0x63, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x64, 0x6f, 0x63, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12,
+	0x1a, 0x0a, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x70,
+	0x61, 0x67, 0x65, 0x4e, 0x75, 0x6d, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x70, 0x61,
+	0x67, 0x65, 0x4e, 0x75, 0x6d, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x14, 0x0a,
+	0x05, 0x6f, 0x72, 0x67, 0x49, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6f, 0x72,
+	0x67, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x64, 0x6f, 0x63, 0x54, 0x61, 0x67, 0x18, 0x08, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x64, 0x6f, 0x63, 0x54, 0x61, 0x67, 0x22, 0x94, 0x01, 0x0a, 0x0e,
+	0x47, 0x65, 0x74, 0x44, 0x6f, 0x63, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x12, 0x36,
+	0x0a, 0x04, 0x64, 0x6f, 0x63, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x6b,
+	0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x64, 0x6f, 0x63,
+	0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x44, 0x6f, 0x63, 0x49, 0x6e, 0x66, 0x6f,
+	0x52, 0x04, 0x64, 0x6f, 0x63, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x18, 0x0a, 0x07,
+	0x70, 0x61, 0x67, 0x65, 0x4e, 0x75, 0x6d, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x70,
+	0x61, 0x67, 0x65, 0x4e, 0x75, 0x6d, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69,
+	0x7a, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69,
+	0x7a, 0x65, 0x22, 0xcf, 0x03, 0x0a, 0x0c, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x44, 0x6f, 0x63,
+	0x52, 0x65, 0x71, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x6f,
+	0x72, 0x67, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6f, 0x72, 0x67, 0x49,
+	0x64, 0x12, 0x20, 0x0a, 0x0b, 0x6b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x49, 0x64,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x6b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67,
+	0x65, 0x49, 0x64, 0x12, 0x24, 0x0a, 0x0d, 0x64, 0x6f, 0x63, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74,
+	0x54, 0x79, 0x70, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0d, 0x64, 0x6f, 0x63, 0x49,
+	0x6d, 0x70, 0x6f, 0x72, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x48, 0x0a, 0x0b, 0x64, 0x6f, 0x63,
+	0x49, 0x6e, 0x66, 0x6f, 0x4c, 0x69, 0x73, 0x74, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x26,
+	0x2e, 0x6b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x64,
+	0x6f, 0x63, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x44, 0x6f, 0x63, 0x46, 0x69,
+	0x6c, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0b, 0x64, 0x6f, 0x63, 0x49, 0x6e, 0x66, 0x6f, 0x4c,
+	0x69, 0x73, 0x74, 0x12, 0x45, 0x0a, 0x0a, 0x64, 0x6f, 0x63, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e,
+	0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x6b, 0x6e, 0x6f, 0x77, 0x6c, 0x65,
+	0x64, 0x67, 0x65, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x64, 0x6f, 0x63, 0x5f, 0x73, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x2e, 0x44, 0x6f, 0x63, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x0a,
+	0x64, 0x6f, 0x63, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x6f,
+	0x63, 0x41, 0x6e, 0x61, 0x6c, 0x79, 0x7a, 0x65, 0x72, 0x18, 0x07, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x0b, 0x64, 0x6f, 0x63, 0x41, 0x6e, 0x61, 0x6c, 0x79, 0x7a, 0x65, 0x72, 0x12, 0x1e, 0x0a, 0x0a,
+	0x6f, 0x63, 0x72, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x49, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0a, 0x6f, 0x63, 0x72, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x49, 0x64, 0x12, 0x24, 0x0a, 0x0d,
+	0x64, 0x6f, 0x63, 0x50, 0x72, 0x65, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x18, 0x09, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x0d, 0x64, 0x6f, 0x63, 0x50, 0x72, 0x65, 0x70, 0x72, 0x6f, 0x63, 0x65,
+	0x73, 0x73, 0x12, 0x50, 0x0a, 0x0f, 0x64, 0x6f, 0x63, 0x4d, 0x65, 0x74, 0x61, 0x44, 0x61, 0x74,
+	0x61, 0x4c, 0x69, 0x73, 0x74, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x6b, 0x6e,
+	0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x64, 0x6f, 0x63, 0x5f,
+	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x44, 0x6f, 0x63, 0x4d, 0x65, 0x74, 0x61, 0x44,
+	0x61, 0x74, 0x61, 0x52, 0x0f, 0x64, 0x6f, 0x63, 0x4d, 0x65, 0x74, 0x61, 0x44, 0x61, 0x74, 0x61,
+	0x4c, 0x69, 0x73, 0x74, 0x22, 0x67, 0x0a, 0x0b, 0x44, 0x6f, 0x63, 0x4d, 0x65, 0x74, 0x61, 0x44,
+	0x61, 0x74, 0x61, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x54, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x75, 0x6c,
+	0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x72, 0x75, 0x6c, 0x65, 0x22, 0x8b, 0x01,
+	0x0a, 0x12, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x44, 0x6f, 0x63, 0x53, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x52, 0x65, 0x71, 0x12, 0x14, 0x0a, 0x05, 0x64, 0x6f, 0x63, 0x49, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x64, 0x6f, 0x63, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x12, 0x47, 0x0a, 0x0c, 0x6d, 0x65, 0x74, 0x61, 0x44, 0x61, 0x74, 0x61, 0x4c, 0x69,
+	0x73, 0x74, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x6b, 0x6e, 0x6f, 0x77, 0x6c,
+	0x65, 0x64, 0x67, 0x65, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x64, 0x6f, 0x63, 0x5f, 0x73, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x2e, 0x4d, 0x65, 0x74, 0x61, 0x44, 0x61, 0x74, 0x61, 0x52, 0x0c, 0x6d,
+	0x65, 0x74, 0x61, 0x44, 0x61, 0x74, 0x61, 0x4c, 0x69, 0x73, 0x74, 0x22, 0xc5, 0x01, 0x0a, 0x14,
+	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x44, 0x6f, 0x63, 0x4d, 0x65, 0x74, 0x61, 0x44, 0x61, 0x74,
+	0x61, 0x52, 0x65, 0x71, 0x12, 0x14, 0x0a, 0x05, 0x6f, 0x72, 0x67, 0x49, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x6f, 0x72, 0x67, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73,
+	0x65, 0x72, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72,
+	0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x64, 0x6f, 0x63, 0x49, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x64, 0x6f, 0x63, 0x49, 0x64, 0x12, 0x47, 0x0a, 0x0c, 0x6d, 0x65, 0x74, 0x61,
+	0x44, 0x61, 0x74, 0x61, 0x4c, 0x69, 0x73, 0x74, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x23,
+	0x2e, 0x6b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x64,
+	0x6f, 0x63, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x4d, 0x65, 0x74, 0x61, 0x44,
+	0x61, 0x74, 0x61, 0x52, 0x0c, 0x6d, 0x65, 0x74, 0x61, 0x44, 0x61, 0x74, 0x61, 0x4c, 0x69, 0x73,
+	0x74, 0x12, 0x20, 0x0a, 0x0b, 0x6b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x49, 0x64,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x6b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67,
+	0x65, 0x49, 0x64, 0x22, 0xea, 0x01, 0x0a, 0x19, 0x42, 0x61, 0x74, 0x63, 0x68, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x44, 0x6f, 0x63, 0x4d, 0x65, 0x74, 0x61, 0x44, 0x61, 0x74, 0x61, 0x52, 0x65,
+	0x71, 0x12, 0x14, 0x0a, 0x05, 0x6f, 0x72, 0x67, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x6f, 0x72, 0x67, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12,
+	0x14, 0x0a, 0x05, 0x64, 0x6f, 0x63, 0x49, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x64, 0x6f, 0x63, 0x49, 0x64, 0x12, 0x47, 0x0a, 0x0c, 0x6d, 0x65, 0x74, 0x61, 0x44, 0x61, 0x74,
+	0x61, 0x4c, 0x69, 0x73, 0x74, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x6b, 0x6e,
+	0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x64, 0x6f, 0x63, 0x5f,
+	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x4d, 0x65, 0x74, 0x61, 0x44, 0x61, 0x74, 0x61,
+	0x52, 0x0c, 0x6d, 0x65, 0x74, 0x61, 0x44, 0x61, 0x74, 0x61, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x20,
+	0x0a, 0x0b, 0x6b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x49, 0x64, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0b, 0x6b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x49, 0x64,
+	0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4d, 0x65, 0x74, 0x61, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4d, 0x65, 0x74, 0x61,
+	0x22, 0x94, 0x01, 0x0a, 0x08, 0x4d, 0x65, 0x74, 0x61, 0x44, 0x61, 0x74, 0x61, 0x12, 0x16, 0x0a,
+	0x06, 0x6d, 0x65, 0x74, 0x61, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6d,
+	0x65, 0x74, 0x61, 0x49, 0x64, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x1c, 0x0a,
+	0x09, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x54, 0x79, 0x70, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x09, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x6f,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6f, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x75, 0x6c, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x72, 0x75, 0x6c, 0x65, 0x22, 0x40, 0x0a, 0x10, 0x49, 0x6e, 0x69, 0x74, 0x44,
+	0x6f, 0x63, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x12, 0x16, 0x0a, 0x06, 0x75,
+	0x73, 0x65, 0x72, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65,
+	0x72, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x6f, 0x72, 0x67, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x6f, 0x72, 0x67, 0x49, 0x64, 0x22, 0x4e, 0x0a, 0x0c, 0x44, 0x65, 0x6c,
+	0x65, 0x74, 0x65, 0x44, 0x6f, 0x63, 0x52, 0x65, 0x71, 0x12, 0x10, 0x0a, 0x03, 0x69, 0x64, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x03, 0x69, 0x64, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x75,
 	0x73, 0x65, 0x72, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65,
 	0x72, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x6f, 0x72, 0x67, 0x49, 0x64, 0x18, 0x03, 0x20, 0x01,
 	0x28, 0x09, 0x52, 0x05, 0x6f, 0x72, 0x67, 0x49, 0x64, 0x22, 0x89, 0x01, 0x0a, 0x0b, 0x44, 0x6f,
@@ -2974,233 +5414,668 @@ var file_proto_knowledgebase_doc_service_knowledgebase_doc_service_proto_rawDesc
 	0x6f, 0x63, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18,
 	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x12, 0x16,
 	0x0a, 0x06, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06,
-	0x6c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x22, 0x78, 0x0a, 0x18, 0x42, 0x61, 0x74, 0x63, 0x68, 0x43,
-	0x72, 0x65, 0x61, 0x74, 0x65, 0x44, 0x6f, 0x63, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x52,
+	0x6c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x22, 0x8c, 0x01, 0x0a, 0x18, 0x42, 0x61, 0x74, 0x63, 0x68,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x44, 0x6f, 0x63, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74,
+	0x52, 0x65, 0x71, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x6f,
+	0x72, 0x67, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6f, 0x72, 0x67, 0x49,
+	0x64, 0x12, 0x14, 0x0a, 0x05, 0x64, 0x6f, 0x63, 0x49, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x64, 0x6f, 0x63, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x66, 0x69, 0x6c, 0x65, 0x55,
+	0x72, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x66, 0x69, 0x6c, 0x65, 0x55, 0x72,
+	0x6c, 0x12, 0x12, 0x0a, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x6d, 0x6f, 0x64, 0x65, 0x22, 0x93, 0x01, 0x0a, 0x13, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74,
+	0x44, 0x6f, 0x63, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x12, 0x16, 0x0a,
+	0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75,
+	0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x6f, 0x72, 0x67, 0x49, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6f, 0x72, 0x67, 0x49, 0x64, 0x12, 0x20, 0x0a, 0x0b, 0x6b,
+	0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x49, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0b, 0x6b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x49, 0x64, 0x12, 0x14, 0x0a,
+	0x05, 0x64, 0x6f, 0x63, 0x49, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x64, 0x6f,
+	0x63, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x22, 0x2a, 0x0a, 0x14, 0x45,
+	0x78, 0x70, 0x6f, 0x72, 0x74, 0x44, 0x6f, 0x63, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x52,
+	0x65, 0x73, 0x70, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0c, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0x62, 0x0a, 0x1c, 0x47, 0x65, 0x74, 0x44, 0x6f,
+	0x63, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65,
+	0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x71, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12,
+	0x14, 0x0a, 0x05, 0x6f, 0x72, 0x67, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x6f, 0x72, 0x67, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x64, 0x6f, 0x63, 0x49, 0x64, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x64, 0x6f, 0x63, 0x49, 0x64, 0x22, 0xa3, 0x02, 0x0a, 0x16,
+	0x44, 0x6f, 0x63, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74,
+	0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x69, 0x6d, 0x70, 0x6f, 0x72, 0x74,
+	0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x69, 0x6d, 0x70, 0x6f, 0x72, 0x74,
+	0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x74, 0x6f,
+	0x74, 0x61, 0x6c, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a,
+	0x74, 0x6f, 0x74, 0x61, 0x6c, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x22, 0x0a, 0x0c, 0x73, 0x75,
+	0x63, 0x63, 0x65, 0x73, 0x73, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x0c, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1a,
+	0x0a, 0x08, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x4d, 0x73, 0x67, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x4d, 0x73, 0x67, 0x12, 0x57, 0x0a, 0x0c, 0x72, 0x6f,
+	0x77, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x4c, 0x69, 0x73, 0x74, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x33, 0x2e, 0x6b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x62, 0x61, 0x73, 0x65,
+	0x5f, 0x64, 0x6f, 0x63, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x44, 0x6f, 0x63,
+	0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x6f, 0x77,
+	0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x0c, 0x72, 0x6f, 0x77, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x4c,
+	0x69, 0x73, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41,
+	0x74, 0x22, 0x68, 0x0a, 0x18, 0x44, 0x6f, 0x63, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x49,
+	0x6d, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x6f, 0x77, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x12, 0x0a,
+	0x04, 0x6c, 0x69, 0x6e, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x6c, 0x69, 0x6e,
+	0x65, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12,
+	0x1a, 0x0a, 0x08, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x4d, 0x73, 0x67, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x4d, 0x73, 0x67, 0x22, 0x77, 0x0a, 0x13, 0x44,
+	0x65, 0x6c, 0x65, 0x74, 0x65, 0x44, 0x6f, 0x63, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x52,
 	0x65, 0x71, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01,
 	0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x6f, 0x72,
 	0x67, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6f, 0x72, 0x67, 0x49, 0x64,
 	0x12, 0x14, 0x0a, 0x05, 0x64, 0x6f, 0x63, 0x49, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x05, 0x64, 0x6f, 0x63, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x66, 0x69, 0x6c, 0x65, 0x55, 0x72,
-	0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x66, 0x69, 0x6c, 0x65, 0x55, 0x72, 0x6c,
-	0x22, 0x77, 0x0a, 0x13, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x44, 0x6f, 0x63, 0x53, 0x65, 0x67,
-	0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49,
-	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12,
-	0x14, 0x0a, 0x05, 0x6f, 0x72, 0x67, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
-	0x6f, 0x72, 0x67, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x64, 0x6f, 0x63, 0x49, 0x64, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x64, 0x6f, 0x63, 0x49, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x63,
-	0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
-	0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x22, 0x91, 0x01, 0x0a, 0x13, 0x55, 0x70,
-	0x64, 0x61, 0x74, 0x65, 0x44, 0x6f, 0x63, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65,
+	0x05, 0x64, 0x6f, 0x63, 0x49, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e,
+	0x74, 0x49, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x6f, 0x6e, 0x74, 0x65,
+	0x6e, 0x74, 0x49, 0x64, 0x22, 0x91, 0x01, 0x0a, 0x13, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x44,
+	0x6f, 0x63, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x12, 0x16, 0x0a, 0x06,
+	0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73,
+	0x65, 0x72, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x6f, 0x72, 0x67, 0x49, 0x64, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x6f, 0x72, 0x67, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x64, 0x6f,
+	0x63, 0x49, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x64, 0x6f, 0x63, 0x49, 0x64,
+	0x12, 0x1c, 0x0a, 0x09, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x18,
+	0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x22, 0x7d, 0x0a, 0x19, 0x47, 0x65, 0x74, 0x44,
+	0x6f, 0x63, 0x43, 0x68, 0x69, 0x6c, 0x64, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x4c, 0x69,
+	0x73, 0x74, 0x52, 0x65, 0x71, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x14, 0x0a,
+	0x05, 0x6f, 0x72, 0x67, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6f, 0x72,
+	0x67, 0x49, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x49, 0x64,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x49,
+	0x64, 0x12, 0x14, 0x0a, 0x05, 0x64, 0x6f, 0x63, 0x49, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x64, 0x6f, 0x63, 0x49, 0x64, 0x22, 0x6b, 0x0a, 0x1a, 0x47, 0x65, 0x74, 0x44, 0x6f,
+	0x63, 0x43, 0x68, 0x69, 0x6c, 0x64, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x4c, 0x69, 0x73,
+	0x74, 0x52, 0x65, 0x73, 0x70, 0x12, 0x4d, 0x0a, 0x0b, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74,
+	0x4c, 0x69, 0x73, 0x74, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x6b, 0x6e, 0x6f,
+	0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x64, 0x6f, 0x63, 0x5f, 0x73,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x43, 0x68, 0x69, 0x6c, 0x64, 0x53, 0x65, 0x67, 0x6d,
+	0x65, 0x6e, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0b, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74,
+	0x4c, 0x69, 0x73, 0x74, 0x22, 0x7e, 0x0a, 0x10, 0x43, 0x68, 0x69, 0x6c, 0x64, 0x53, 0x65, 0x67,
+	0x6d, 0x65, 0x6e, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74,
+	0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65,
+	0x6e, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x68, 0x69, 0x6c, 0x64, 0x49, 0x64, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x68, 0x69, 0x6c, 0x64, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08,
+	0x63, 0x68, 0x69, 0x6c, 0x64, 0x4e, 0x75, 0x6d, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08,
+	0x63, 0x68, 0x69, 0x6c, 0x64, 0x4e, 0x75, 0x6d, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61, 0x72, 0x65,
+	0x6e, 0x74, 0x49, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x61, 0x72, 0x65,
+	0x6e, 0x74, 0x49, 0x64, 0x22, 0x9e, 0x01, 0x0a, 0x18, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x44,
+	0x6f, 0x63, 0x43, 0x68, 0x69, 0x6c, 0x64, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65,
 	0x71, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
 	0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x6f, 0x72, 0x67,
 	0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6f, 0x72, 0x67, 0x49, 0x64, 0x12,
 	0x14, 0x0a, 0x05, 0x64, 0x6f, 0x63, 0x49, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
-	0x64, 0x6f, 0x63, 0x49, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74,
-	0x49, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e,
-	0x74, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x05,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x22, 0x7d, 0x0a,
-	0x19, 0x47, 0x65, 0x74, 0x44, 0x6f, 0x63, 0x43, 0x68, 0x69, 0x6c, 0x64, 0x53, 0x65, 0x67, 0x6d,
-	0x65, 0x6e, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73,
-	0x65, 0x72, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72,
-	0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x6f, 0x72, 0x67, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x05, 0x6f, 0x72, 0x67, 0x49, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x6f, 0x6e, 0x74,
-	0x65, 0x6e, 0x74, 0x49, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x6f, 0x6e,
-	0x74, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x64, 0x6f, 0x63, 0x49, 0x64, 0x18,
-	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x64, 0x6f, 0x63, 0x49, 0x64, 0x22, 0x6b, 0x0a, 0x1a,
-	0x47, 0x65, 0x74, 0x44, 0x6f, 0x63, 0x43, 0x68, 0x69, 0x6c, 0x64, 0x53, 0x65, 0x67, 0x6d, 0x65,
-	0x6e, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x12, 0x4d, 0x0a, 0x0b, 0x63, 0x6f,
-	0x6e, 0x74, 0x65, 0x6e, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
-	0x2b, 0x2e, 0x6b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x62, 0x61, 0x73, 0x65, 0x5f,
-	0x64, 0x6f, 0x63, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x43, 0x68, 0x69, 0x6c,
-	0x64, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0b, 0x63, 0x6f,
-	0x6e, 0x74, 0x65, 0x6e, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x22, 0x7e, 0x0a, 0x10, 0x43, 0x68, 0x69,
-	0x6c, 0x64, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x18, 0x0a,
-	0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
-	0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x68, 0x69, 0x6c, 0x64,
-	0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x68, 0x69, 0x6c, 0x64, 0x49,
-	0x64, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x68, 0x69, 0x6c, 0x64, 0x4e, 0x75, 0x6d, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x05, 0x52, 0x08, 0x63, 0x68, 0x69, 0x6c, 0x64, 0x4e, 0x75, 0x6d, 0x12, 0x1a, 0x0a,
-	0x08, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x08, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x22, 0x9e, 0x01, 0x0a, 0x18, 0x43, 0x72,
-	0x65, 0x61, 0x74, 0x65, 0x44, 0x6f, 0x63, 0x43, 0x68, 0x69, 0x6c, 0x64, 0x53, 0x65, 0x67, 0x6d,
-	0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64,
+	0x64, 0x6f, 0x63, 0x49, 0x64, 0x12, 0x24, 0x0a, 0x0d, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x43,
+	0x68, 0x75, 0x6e, 0x6b, 0x49, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x70, 0x61,
+	0x72, 0x65, 0x6e, 0x74, 0x43, 0x68, 0x75, 0x6e, 0x6b, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x63,
+	0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f,
+	0x6e, 0x74, 0x65, 0x6e, 0x74, 0x22, 0xce, 0x01, 0x0a, 0x18, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
+	0x44, 0x6f, 0x63, 0x43, 0x68, 0x69, 0x6c, 0x64, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x52,
+	0x65, 0x71, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x6f, 0x72,
+	0x67, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6f, 0x72, 0x67, 0x49, 0x64,
+	0x12, 0x14, 0x0a, 0x05, 0x64, 0x6f, 0x63, 0x49, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x64, 0x6f, 0x63, 0x49, 0x64, 0x12, 0x24, 0x0a, 0x0d, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74,
+	0x43, 0x68, 0x75, 0x6e, 0x6b, 0x49, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x70,
+	0x61, 0x72, 0x65, 0x6e, 0x74, 0x43, 0x68, 0x75, 0x6e, 0x6b, 0x49, 0x64, 0x12, 0x24, 0x0a, 0x0d,
+	0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x43, 0x68, 0x75, 0x6e, 0x6b, 0x4e, 0x6f, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x0d, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x43, 0x68, 0x75, 0x6e, 0x6b,
+	0x4e, 0x6f, 0x12, 0x22, 0x0a, 0x0c, 0x63, 0x68, 0x69, 0x6c, 0x64, 0x43, 0x68, 0x75, 0x6e, 0x6b,
+	0x4e, 0x6f, 0x18, 0x06, 0x20, 0x03, 0x28, 0x05, 0x52, 0x0c, 0x63, 0x68, 0x69, 0x6c, 0x64, 0x43,
+	0x68, 0x75, 0x6e, 0x6b, 0x4e, 0x6f, 0x22, 0xf1, 0x01, 0x0a, 0x18, 0x55, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x44, 0x6f, 0x63, 0x43, 0x68, 0x69, 0x6c, 0x64, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74,
+	0x52, 0x65, 0x71, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x6f,
+	0x72, 0x67, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6f, 0x72, 0x67, 0x49,
+	0x64, 0x12, 0x14, 0x0a, 0x05, 0x64, 0x6f, 0x63, 0x49, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x64, 0x6f, 0x63, 0x49, 0x64, 0x12, 0x24, 0x0a, 0x0d, 0x70, 0x61, 0x72, 0x65, 0x6e,
+	0x74, 0x43, 0x68, 0x75, 0x6e, 0x6b, 0x49, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d,
+	0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x43, 0x68, 0x75, 0x6e, 0x6b, 0x49, 0x64, 0x12, 0x24, 0x0a,
+	0x0d, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x43, 0x68, 0x75, 0x6e, 0x6b, 0x4e, 0x6f, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x0d, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x43, 0x68, 0x75, 0x6e,
+	0x6b, 0x4e, 0x6f, 0x12, 0x45, 0x0a, 0x0a, 0x63, 0x68, 0x69, 0x6c, 0x64, 0x43, 0x68, 0x75, 0x6e,
+	0x6b, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x6b, 0x6e, 0x6f, 0x77, 0x6c, 0x65,
+	0x64, 0x67, 0x65, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x64, 0x6f, 0x63, 0x5f, 0x73, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x2e, 0x43, 0x68, 0x69, 0x6c, 0x64, 0x43, 0x68, 0x75, 0x6e, 0x6b, 0x52, 0x0a,
+	0x63, 0x68, 0x69, 0x6c, 0x64, 0x43, 0x68, 0x75, 0x6e, 0x6b, 0x22, 0x40, 0x0a, 0x0a, 0x43, 0x68,
+	0x69, 0x6c, 0x64, 0x43, 0x68, 0x75, 0x6e, 0x6b, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74,
+	0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65,
+	0x6e, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x4e, 0x6f, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x07, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x4e, 0x6f, 0x22, 0xf8, 0x01, 0x0a,
+	0x0e, 0x53, 0x61, 0x76, 0x65, 0x44, 0x6f, 0x63, 0x53, 0x79, 0x6e, 0x63, 0x52, 0x65, 0x71, 0x12,
+	0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x6f, 0x72, 0x67, 0x49, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6f, 0x72, 0x67, 0x49, 0x64, 0x12, 0x20, 0x0a,
+	0x0b, 0x6b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x49, 0x64, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0b, 0x6b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x49, 0x64, 0x12,
+	0x14, 0x0a, 0x05, 0x64, 0x6f, 0x63, 0x49, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x64, 0x6f, 0x63, 0x49, 0x64, 0x12, 0x22, 0x0a, 0x0c, 0x69, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x54,
+	0x61, 0x73, 0x6b, 0x49, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x69, 0x6d, 0x70,
+	0x6f, 0x72, 0x74, 0x54, 0x61, 0x73, 0x6b, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x72, 0x6f,
+	0x6e, 0x45, 0x78, 0x70, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x72, 0x6f,
+	0x6e, 0x45, 0x78, 0x70, 0x72, 0x12, 0x28, 0x0a, 0x0f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61,
+	0x6c, 0x4d, 0x69, 0x6e, 0x75, 0x74, 0x65, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0f,
+	0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x4d, 0x69, 0x6e, 0x75, 0x74, 0x65, 0x73, 0x12,
+	0x16, 0x0a, 0x06, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x06, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x22, 0x29, 0x0a, 0x0f, 0x53, 0x61, 0x76, 0x65, 0x44,
+	0x6f, 0x63, 0x53, 0x79, 0x6e, 0x63, 0x52, 0x65, 0x73, 0x70, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x79,
+	0x6e, 0x63, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x79, 0x6e, 0x63,
+	0x49, 0x64, 0x22, 0x58, 0x0a, 0x10, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x44, 0x6f, 0x63, 0x53,
+	0x79, 0x6e, 0x63, 0x52, 0x65, 0x71, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64,
 	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x14,
 	0x0a, 0x05, 0x6f, 0x72, 0x67, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6f,
-	0x72, 0x67, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x64, 0x6f, 0x63, 0x49, 0x64, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x05, 0x64, 0x6f, 0x63, 0x49, 0x64, 0x12, 0x24, 0x0a, 0x0d, 0x70, 0x61,
-	0x72, 0x65, 0x6e, 0x74, 0x43, 0x68, 0x75, 0x6e, 0x6b, 0x49, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x0d, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x43, 0x68, 0x75, 0x6e, 0x6b, 0x49, 0x64,
-	0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x03, 0x28,
-	0x09, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x22, 0xce, 0x01, 0x0a, 0x18, 0x44,
-	0x65, 0x6c, 0x65, 0x74, 0x65, 0x44, 0x6f, 0x63, 0x43, 0x68, 0x69, 0x6c, 0x64, 0x53, 0x65, 0x67,
-	0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49,
+	0x72, 0x67, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x79, 0x6e, 0x63, 0x49, 0x64, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x79, 0x6e, 0x63, 0x49, 0x64, 0x22, 0x63, 0x0a, 0x11,
+	0x47, 0x65, 0x74, 0x44, 0x6f, 0x63, 0x53, 0x79, 0x6e, 0x63, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65,
+	0x71, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x6f, 0x72, 0x67,
+	0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6f, 0x72, 0x67, 0x49, 0x64, 0x12,
+	0x20, 0x0a, 0x0b, 0x6b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x49, 0x64, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x6b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x49,
+	0x64, 0x22, 0x50, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x44, 0x6f, 0x63, 0x53, 0x79, 0x6e, 0x63, 0x4c,
+	0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x12, 0x3a, 0x0a, 0x04, 0x6c, 0x69, 0x73, 0x74, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x6b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67,
+	0x65, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x64, 0x6f, 0x63, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x2e, 0x44, 0x6f, 0x63, 0x53, 0x79, 0x6e, 0x63, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x04, 0x6c,
+	0x69, 0x73, 0x74, 0x22, 0xbd, 0x02, 0x0a, 0x0b, 0x44, 0x6f, 0x63, 0x53, 0x79, 0x6e, 0x63, 0x49,
+	0x6e, 0x66, 0x6f, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x79, 0x6e, 0x63, 0x49, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x79, 0x6e, 0x63, 0x49, 0x64, 0x12, 0x20, 0x0a, 0x0b, 0x6b,
+	0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0b, 0x6b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x49, 0x64, 0x12, 0x14, 0x0a,
+	0x05, 0x64, 0x6f, 0x63, 0x49, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x64, 0x6f,
+	0x63, 0x49, 0x64, 0x12, 0x22, 0x0a, 0x0c, 0x69, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x54, 0x61, 0x73,
+	0x6b, 0x49, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x69, 0x6d, 0x70, 0x6f, 0x72,
+	0x74, 0x54, 0x61, 0x73, 0x6b, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x72, 0x6f, 0x6e, 0x45,
+	0x78, 0x70, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x72, 0x6f, 0x6e, 0x45,
+	0x78, 0x70, 0x72, 0x12, 0x28, 0x0a, 0x0f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x4d,
+	0x69, 0x6e, 0x75, 0x74, 0x65, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0f, 0x69, 0x6e,
+	0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x4d, 0x69, 0x6e, 0x75, 0x74, 0x65, 0x73, 0x12, 0x16, 0x0a,
+	0x06, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x65,
+	0x6e, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x6c, 0x61, 0x73, 0x74, 0x53, 0x79, 0x6e,
+	0x63, 0x41, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6c, 0x61, 0x73, 0x74, 0x53,
+	0x79, 0x6e, 0x63, 0x41, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x6e, 0x65, 0x78, 0x74, 0x53, 0x79, 0x6e,
+	0x63, 0x41, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6e, 0x65, 0x78, 0x74, 0x53,
+	0x79, 0x6e, 0x63, 0x41, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64,
+	0x41, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x64, 0x41, 0x74, 0x22, 0x55, 0x0a, 0x0d, 0x52, 0x75, 0x6e, 0x44, 0x6f, 0x63, 0x53, 0x79, 0x6e,
+	0x63, 0x52, 0x65, 0x71, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05,
+	0x6f, 0x72, 0x67, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6f, 0x72, 0x67,
+	0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x79, 0x6e, 0x63, 0x49, 0x64, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x73, 0x79, 0x6e, 0x63, 0x49, 0x64, 0x22, 0xac, 0x01, 0x0a, 0x18, 0x47,
+	0x65, 0x74, 0x44, 0x6f, 0x63, 0x53, 0x79, 0x6e, 0x63, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79,
+	0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49,
 	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12,
 	0x14, 0x0a, 0x05, 0x6f, 0x72, 0x67, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
-	0x6f, 0x72, 0x67, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x64, 0x6f, 0x63, 0x49, 0x64, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x64, 0x6f, 0x63, 0x49, 0x64, 0x12, 0x24, 0x0a, 0x0d, 0x70,
-	0x61, 0x72, 0x65, 0x6e, 0x74, 0x43, 0x68, 0x75, 0x6e, 0x6b, 0x49, 0x64, 0x18, 0x04, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x0d, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x43, 0x68, 0x75, 0x6e, 0x6b, 0x49,
-	0x64, 0x12, 0x24, 0x0a, 0x0d, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x43, 0x68, 0x75, 0x6e, 0x6b,
-	0x4e, 0x6f, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0d, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74,
-	0x43, 0x68, 0x75, 0x6e, 0x6b, 0x4e, 0x6f, 0x12, 0x22, 0x0a, 0x0c, 0x63, 0x68, 0x69, 0x6c, 0x64,
-	0x43, 0x68, 0x75, 0x6e, 0x6b, 0x4e, 0x6f, 0x18, 0x06, 0x20, 0x03, 0x28, 0x05, 0x52, 0x0c, 0x63,
-	0x68, 0x69, 0x6c, 0x64, 0x43, 0x68, 0x75, 0x6e, 0x6b, 0x4e, 0x6f, 0x22, 0xf1, 0x01, 0x0a, 0x18,
-	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x44, 0x6f, 0x63, 0x43, 0x68, 0x69, 0x6c, 0x64, 0x53, 0x65,
-	0x67, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72,
+	0x6f, 0x72, 0x67, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x79, 0x6e, 0x63, 0x49, 0x64, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x79, 0x6e, 0x63, 0x49, 0x64, 0x12, 0x14, 0x0a,
+	0x05, 0x64, 0x6f, 0x63, 0x49, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x64, 0x6f,
+	0x63, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12,
+	0x18, 0x0a, 0x07, 0x70, 0x61, 0x67, 0x65, 0x4e, 0x75, 0x6d, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x07, 0x70, 0x61, 0x67, 0x65, 0x4e, 0x75, 0x6d, 0x22, 0xaa, 0x01, 0x0a, 0x19, 0x47, 0x65,
+	0x74, 0x44, 0x6f, 0x63, 0x53, 0x79, 0x6e, 0x63, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x4c,
+	0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x12, 0x41, 0x0a, 0x04, 0x6c, 0x69, 0x73, 0x74, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x6b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67,
+	0x65, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x64, 0x6f, 0x63, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x2e, 0x44, 0x6f, 0x63, 0x53, 0x79, 0x6e, 0x63, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79,
+	0x49, 0x6e, 0x66, 0x6f, 0x52, 0x04, 0x6c, 0x69, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f,
+	0x74, 0x61, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c,
+	0x12, 0x18, 0x0a, 0x07, 0x70, 0x61, 0x67, 0x65, 0x4e, 0x75, 0x6d, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x07, 0x70, 0x61, 0x67, 0x65, 0x4e, 0x75, 0x6d, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61,
+	0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x61,
+	0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x22, 0xfe, 0x01, 0x0a, 0x12, 0x44, 0x6f, 0x63, 0x53, 0x79,
+	0x6e, 0x63, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x16, 0x0a,
+	0x06, 0x73, 0x79, 0x6e, 0x63, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73,
+	0x79, 0x6e, 0x63, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x64, 0x6f, 0x63, 0x49, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x64, 0x6f, 0x63, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x64,
+	0x6f, 0x63, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x64, 0x6f,
+	0x63, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x64, 0x6f, 0x63, 0x55, 0x72, 0x6c, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x64, 0x6f, 0x63, 0x55, 0x72, 0x6c, 0x12, 0x16, 0x0a,
+	0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x73,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74,
+	0x4d, 0x64, 0x35, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x6f, 0x6e, 0x74, 0x65,
+	0x6e, 0x74, 0x4d, 0x64, 0x35, 0x12, 0x1a, 0x0a, 0x08, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x4d, 0x73,
+	0x67, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x4d, 0x73,
+	0x67, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x74, 0x61, 0x72, 0x74, 0x41, 0x74, 0x18, 0x08, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x73, 0x74, 0x61, 0x72, 0x74, 0x41, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x66,
+	0x69, 0x6e, 0x69, 0x73, 0x68, 0x41, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x66,
+	0x69, 0x6e, 0x69, 0x73, 0x68, 0x41, 0x74, 0x22, 0xcb, 0x01, 0x0a, 0x0f, 0x43, 0x6f, 0x6e, 0x6e,
+	0x65, 0x63, 0x74, 0x6f, 0x72, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x1a, 0x0a, 0x08, 0x65,
+	0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x65,
+	0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x62, 0x75, 0x63, 0x6b, 0x65,
+	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x12,
+	0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x53, 0x73, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x06, 0x75, 0x73, 0x65, 0x53, 0x73, 0x6c, 0x12, 0x18, 0x0a, 0x07, 0x72, 0x65, 0x70, 0x6f, 0x55,
+	0x72, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x72, 0x65, 0x70, 0x6f, 0x55, 0x72,
+	0x6c, 0x12, 0x16, 0x0a, 0x06, 0x62, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x62, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x6f, 0x6f,
+	0x74, 0x50, 0x61, 0x74, 0x68, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x6f, 0x6f,
+	0x74, 0x50, 0x61, 0x74, 0x68, 0x12, 0x1e, 0x0a, 0x0a, 0x70, 0x61, 0x74, 0x68, 0x46, 0x69, 0x6c,
+	0x74, 0x65, 0x72, 0x18, 0x07, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0a, 0x70, 0x61, 0x74, 0x68, 0x46,
+	0x69, 0x6c, 0x74, 0x65, 0x72, 0x22, 0x89, 0x01, 0x0a, 0x13, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63,
+	0x74, 0x6f, 0x72, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x12, 0x1c, 0x0a,
+	0x09, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x4b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x09, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x4b, 0x65, 0x79, 0x12, 0x1c, 0x0a, 0x09, 0x73,
+	0x65, 0x63, 0x72, 0x65, 0x74, 0x4b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
+	0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x4b, 0x65, 0x79, 0x12, 0x1a, 0x0a, 0x08, 0x75, 0x73, 0x65,
+	0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75, 0x73, 0x65,
+	0x72, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72,
+	0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72,
+	0x64, 0x22, 0x81, 0x04, 0x0a, 0x10, 0x53, 0x61, 0x76, 0x65, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63,
+	0x74, 0x6f, 0x72, 0x52, 0x65, 0x71, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x14,
+	0x0a, 0x05, 0x6f, 0x72, 0x67, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6f,
+	0x72, 0x67, 0x49, 0x64, 0x12, 0x20, 0x0a, 0x0b, 0x6b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67,
+	0x65, 0x49, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x6b, 0x6e, 0x6f, 0x77, 0x6c,
+	0x65, 0x64, 0x67, 0x65, 0x49, 0x64, 0x12, 0x20, 0x0a, 0x0b, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63,
+	0x74, 0x6f, 0x72, 0x49, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x6f, 0x6e,
+	0x6e, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x24, 0x0a, 0x0d,
+	0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x54, 0x79, 0x70, 0x65, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0d, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x54, 0x79,
+	0x70, 0x65, 0x12, 0x42, 0x0a, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x6b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x62, 0x61,
+	0x73, 0x65, 0x5f, 0x64, 0x6f, 0x63, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x43,
+	0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x06,
+	0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x4e, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x64, 0x65, 0x6e,
+	0x74, 0x69, 0x61, 0x6c, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2e, 0x2e, 0x6b, 0x6e, 0x6f,
+	0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x64, 0x6f, 0x63, 0x5f, 0x73,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x6f, 0x72,
+	0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x52, 0x0a, 0x63, 0x72, 0x65, 0x64,
+	0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x12, 0x45, 0x0a, 0x0a, 0x64, 0x6f, 0x63, 0x53, 0x65, 0x67,
+	0x6d, 0x65, 0x6e, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x6b, 0x6e, 0x6f,
+	0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x64, 0x6f, 0x63, 0x5f, 0x73,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x44, 0x6f, 0x63, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e,
+	0x74, 0x52, 0x0a, 0x64, 0x6f, 0x63, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x20, 0x0a,
+	0x0b, 0x64, 0x6f, 0x63, 0x41, 0x6e, 0x61, 0x6c, 0x79, 0x7a, 0x65, 0x72, 0x18, 0x0a, 0x20, 0x03,
+	0x28, 0x09, 0x52, 0x0b, 0x64, 0x6f, 0x63, 0x41, 0x6e, 0x61, 0x6c, 0x79, 0x7a, 0x65, 0x72, 0x12,
+	0x1e, 0x0a, 0x0a, 0x6f, 0x63, 0x72, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x49, 0x64, 0x18, 0x0b, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0a, 0x6f, 0x63, 0x72, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x49, 0x64, 0x12,
+	0x24, 0x0a, 0x0d, 0x64, 0x6f, 0x63, 0x50, 0x72, 0x65, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73,
+	0x18, 0x0c, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0d, 0x64, 0x6f, 0x63, 0x50, 0x72, 0x65, 0x70, 0x72,
+	0x6f, 0x63, 0x65, 0x73, 0x73, 0x22, 0x35, 0x0a, 0x11, 0x53, 0x61, 0x76, 0x65, 0x43, 0x6f, 0x6e,
+	0x6e, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x73, 0x70, 0x12, 0x20, 0x0a, 0x0b, 0x63, 0x6f,
+	0x6e, 0x6e, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0b, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x49, 0x64, 0x22, 0x64, 0x0a, 0x12,
+	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x52,
+	0x65, 0x71, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x6f, 0x72,
+	0x67, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6f, 0x72, 0x67, 0x49, 0x64,
+	0x12, 0x20, 0x0a, 0x0b, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x49, 0x64, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x6f, 0x72,
+	0x49, 0x64, 0x22, 0x65, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74,
+	0x6f, 0x72, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65,
+	0x72, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49,
+	0x64, 0x12, 0x14, 0x0a, 0x05, 0x6f, 0x72, 0x67, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x6f, 0x72, 0x67, 0x49, 0x64, 0x12, 0x20, 0x0a, 0x0b, 0x6b, 0x6e, 0x6f, 0x77, 0x6c,
+	0x65, 0x64, 0x67, 0x65, 0x49, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x6b, 0x6e,
+	0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x49, 0x64, 0x22, 0x54, 0x0a, 0x14, 0x47, 0x65, 0x74,
+	0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73,
+	0x70, 0x12, 0x3c, 0x0a, 0x04, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x28, 0x2e, 0x6b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x62, 0x61, 0x73, 0x65, 0x5f,
+	0x64, 0x6f, 0x63, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x43, 0x6f, 0x6e, 0x6e,
+	0x65, 0x63, 0x74, 0x6f, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x04, 0x6c, 0x69, 0x73, 0x74, 0x22,
+	0xab, 0x03, 0x0a, 0x0d, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x49, 0x6e, 0x66,
+	0x6f, 0x12, 0x20, 0x0a, 0x0b, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x49, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x6f,
+	0x72, 0x49, 0x64, 0x12, 0x20, 0x0a, 0x0b, 0x6b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65,
+	0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x6b, 0x6e, 0x6f, 0x77, 0x6c, 0x65,
+	0x64, 0x67, 0x65, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x24, 0x0a, 0x0d, 0x63, 0x6f, 0x6e,
+	0x6e, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x54, 0x79, 0x70, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0d, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x54, 0x79, 0x70, 0x65, 0x12,
+	0x42, 0x0a, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x2a, 0x2e, 0x6b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x62, 0x61, 0x73, 0x65, 0x5f,
+	0x64, 0x6f, 0x63, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x43, 0x6f, 0x6e, 0x6e,
+	0x65, 0x63, 0x74, 0x6f, 0x72, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x06, 0x63, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x12, 0x24, 0x0a, 0x0d, 0x68, 0x61, 0x73, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e,
+	0x74, 0x69, 0x61, 0x6c, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0d, 0x68, 0x61, 0x73, 0x43,
+	0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x12, 0x1a, 0x0a, 0x08, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x4d, 0x73, 0x67, 0x18, 0x08, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x4d, 0x73, 0x67, 0x12, 0x16, 0x0a,
+	0x06, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x63,
+	0x75, 0x72, 0x73, 0x6f, 0x72, 0x12, 0x2a, 0x0a, 0x10, 0x6c, 0x61, 0x73, 0x74, 0x49, 0x6d, 0x70,
+	0x6f, 0x72, 0x74, 0x54, 0x61, 0x73, 0x6b, 0x49, 0x64, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x10, 0x6c, 0x61, 0x73, 0x74, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x54, 0x61, 0x73, 0x6b, 0x49,
+	0x64, 0x12, 0x1c, 0x0a, 0x09, 0x6c, 0x61, 0x73, 0x74, 0x52, 0x75, 0x6e, 0x41, 0x74, 0x18, 0x0b,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6c, 0x61, 0x73, 0x74, 0x52, 0x75, 0x6e, 0x41, 0x74, 0x12,
+	0x1c, 0x0a, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x0c, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0x61, 0x0a,
+	0x0f, 0x52, 0x75, 0x6e, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x71,
+	0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x6f, 0x72, 0x67, 0x49,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6f, 0x72, 0x67, 0x49, 0x64, 0x12, 0x20,
+	0x0a, 0x0b, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x49, 0x64, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x49, 0x64,
+	0x22, 0x9b, 0x01, 0x0a, 0x13, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x44, 0x6f, 0x63, 0x56, 0x65,
+	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72,
 	0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64,
 	0x12, 0x14, 0x0a, 0x05, 0x6f, 0x72, 0x67, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
 	0x05, 0x6f, 0x72, 0x67, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x64, 0x6f, 0x63, 0x49, 0x64, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x64, 0x6f, 0x63, 0x49, 0x64, 0x12, 0x24, 0x0a, 0x0d,
-	0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x43, 0x68, 0x75, 0x6e, 0x6b, 0x49, 0x64, 0x18, 0x04, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x0d, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x43, 0x68, 0x75, 0x6e, 0x6b,
-	0x49, 0x64, 0x12, 0x24, 0x0a, 0x0d, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x43, 0x68, 0x75, 0x6e,
-	0x6b, 0x4e, 0x6f, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0d, 0x70, 0x61, 0x72, 0x65, 0x6e,
-	0x74, 0x43, 0x68, 0x75, 0x6e, 0x6b, 0x4e, 0x6f, 0x12, 0x45, 0x0a, 0x0a, 0x63, 0x68, 0x69, 0x6c,
-	0x64, 0x43, 0x68, 0x75, 0x6e, 0x6b, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x6b,
-	0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x64, 0x6f, 0x63,
-	0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x43, 0x68, 0x69, 0x6c, 0x64, 0x43, 0x68,
-	0x75, 0x6e, 0x6b, 0x52, 0x0a, 0x63, 0x68, 0x69, 0x6c, 0x64, 0x43, 0x68, 0x75, 0x6e, 0x6b, 0x22,
-	0x40, 0x0a, 0x0a, 0x43, 0x68, 0x69, 0x6c, 0x64, 0x43, 0x68, 0x75, 0x6e, 0x6b, 0x12, 0x18, 0x0a,
-	0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
-	0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x68, 0x75, 0x6e, 0x6b,
-	0x4e, 0x6f, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x4e,
-	0x6f, 0x32, 0x89, 0x10, 0x0a, 0x17, 0x4b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x42,
-	0x61, 0x73, 0x65, 0x44, 0x6f, 0x63, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x63, 0x0a,
-	0x0a, 0x47, 0x65, 0x74, 0x44, 0x6f, 0x63, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x28, 0x2e, 0x6b, 0x6e,
-	0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x64, 0x6f, 0x63, 0x5f,
-	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x44, 0x6f, 0x63, 0x4c, 0x69,
-	0x73, 0x74, 0x52, 0x65, 0x71, 0x1a, 0x29, 0x2e, 0x6b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67,
-	0x65, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x64, 0x6f, 0x63, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
-	0x65, 0x2e, 0x47, 0x65, 0x74, 0x44, 0x6f, 0x63, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70,
-	0x22, 0x00, 0x12, 0x4e, 0x0a, 0x09, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x44, 0x6f, 0x63, 0x12,
-	0x27, 0x2e, 0x6b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x62, 0x61, 0x73, 0x65, 0x5f,
-	0x64, 0x6f, 0x63, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x49, 0x6d, 0x70, 0x6f,
-	0x72, 0x74, 0x44, 0x6f, 0x63, 0x52, 0x65, 0x71, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
-	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79,
-	0x22, 0x00, 0x12, 0x5a, 0x0a, 0x0f, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x44, 0x6f, 0x63, 0x53,
-	0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x2d, 0x2e, 0x6b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67,
-	0x65, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x64, 0x6f, 0x63, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
-	0x65, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x44, 0x6f, 0x63, 0x53, 0x74, 0x61, 0x74, 0x75,
-	0x73, 0x52, 0x65, 0x71, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x5e,
-	0x0a, 0x11, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x44, 0x6f, 0x63, 0x4d, 0x65, 0x74, 0x61, 0x44,
-	0x61, 0x74, 0x61, 0x12, 0x2f, 0x2e, 0x6b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x62,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x64, 0x6f, 0x63, 0x49, 0x64, 0x12, 0x40, 0x0a, 0x07,
+	0x64, 0x6f, 0x63, 0x49, 0x6e, 0x66, 0x6f, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e,
+	0x6b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x64, 0x6f,
+	0x63, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x44, 0x6f, 0x63, 0x46, 0x69, 0x6c,
+	0x65, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x07, 0x64, 0x6f, 0x63, 0x49, 0x6e, 0x66, 0x6f, 0x22, 0x5a,
+	0x0a, 0x14, 0x47, 0x65, 0x74, 0x44, 0x6f, 0x63, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x4c,
+	0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x14,
+	0x0a, 0x05, 0x6f, 0x72, 0x67, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6f,
+	0x72, 0x67, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x64, 0x6f, 0x63, 0x49, 0x64, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x64, 0x6f, 0x63, 0x49, 0x64, 0x22, 0x56, 0x0a, 0x15, 0x47, 0x65,
+	0x74, 0x44, 0x6f, 0x63, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x4c, 0x69, 0x73, 0x74, 0x52,
+	0x65, 0x73, 0x70, 0x12, 0x3d, 0x0a, 0x04, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x29, 0x2e, 0x6b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x62, 0x61, 0x73,
+	0x65, 0x5f, 0x64, 0x6f, 0x63, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x44, 0x6f,
+	0x63, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x04, 0x6c, 0x69,
+	0x73, 0x74, 0x22, 0xf4, 0x01, 0x0a, 0x0e, 0x44, 0x6f, 0x63, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f,
+	0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x1c, 0x0a, 0x09, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
+	0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f,
+	0x6e, 0x49, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x4e, 0x6f,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x4e,
+	0x6f, 0x12, 0x18, 0x0a, 0x07, 0x64, 0x6f, 0x63, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x64, 0x6f, 0x63, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x64,
+	0x6f, 0x63, 0x54, 0x79, 0x70, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x64, 0x6f,
+	0x63, 0x54, 0x79, 0x70, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x64, 0x6f, 0x63, 0x53, 0x69, 0x7a, 0x65,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x64, 0x6f, 0x63, 0x53, 0x69, 0x7a, 0x65, 0x12,
+	0x22, 0x0a, 0x0c, 0x73, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x73, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x43, 0x6f,
+	0x75, 0x6e, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x06, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x63,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
+	0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0xa7, 0x01, 0x0a, 0x11, 0x44, 0x69,
+	0x66, 0x66, 0x44, 0x6f, 0x63, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x12,
+	0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x6f, 0x72, 0x67, 0x49, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6f, 0x72, 0x67, 0x49, 0x64, 0x12, 0x14, 0x0a,
+	0x05, 0x64, 0x6f, 0x63, 0x49, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x64, 0x6f,
+	0x63, 0x49, 0x64, 0x12, 0x24, 0x0a, 0x0d, 0x62, 0x61, 0x73, 0x65, 0x56, 0x65, 0x72, 0x73, 0x69,
+	0x6f, 0x6e, 0x49, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x62, 0x61, 0x73, 0x65,
+	0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x28, 0x0a, 0x0f, 0x74, 0x61, 0x72,
+	0x67, 0x65, 0x74, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0f, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f,
+	0x6e, 0x49, 0x64, 0x22, 0x99, 0x02, 0x0a, 0x12, 0x44, 0x69, 0x66, 0x66, 0x44, 0x6f, 0x63, 0x56,
+	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x12, 0x2a, 0x0a, 0x10, 0x62, 0x61,
+	0x73, 0x65, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x10, 0x62, 0x61, 0x73, 0x65, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e,
+	0x74, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x2e, 0x0a, 0x12, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74,
+	0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x12, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e,
+	0x74, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x61, 0x64, 0x64, 0x65, 0x64, 0x43,
+	0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x61, 0x64, 0x64, 0x65,
+	0x64, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x22, 0x0a, 0x0c, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65,
+	0x64, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x64, 0x65,
+	0x6c, 0x65, 0x74, 0x65, 0x64, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x24, 0x0a, 0x0d, 0x6d, 0x6f,
+	0x64, 0x69, 0x66, 0x69, 0x65, 0x64, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x0d, 0x6d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x64, 0x43, 0x6f, 0x75, 0x6e, 0x74,
+	0x12, 0x3d, 0x0a, 0x04, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x29,
+	0x2e, 0x6b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x64,
+	0x6f, 0x63, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x44, 0x6f, 0x63, 0x53, 0x65,
+	0x67, 0x6d, 0x65, 0x6e, 0x74, 0x44, 0x69, 0x66, 0x66, 0x52, 0x04, 0x6c, 0x69, 0x73, 0x74, 0x22,
+	0x98, 0x01, 0x0a, 0x0e, 0x44, 0x6f, 0x63, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x44, 0x69,
+	0x66, 0x66, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x4e, 0x75, 0x6d,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x4e,
+	0x75, 0x6d, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x54, 0x79, 0x70, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x54, 0x79,
+	0x70, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x62, 0x61, 0x73, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e,
+	0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x62, 0x61, 0x73, 0x65, 0x43, 0x6f, 0x6e,
+	0x74, 0x65, 0x6e, 0x74, 0x12, 0x24, 0x0a, 0x0d, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x43, 0x6f,
+	0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x74, 0x61, 0x72,
+	0x67, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x22, 0x79, 0x0a, 0x15, 0x52, 0x6f,
+	0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x44, 0x6f, 0x63, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
+	0x52, 0x65, 0x71, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x6f,
+	0x72, 0x67, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6f, 0x72, 0x67, 0x49,
+	0x64, 0x12, 0x14, 0x0a, 0x05, 0x64, 0x6f, 0x63, 0x49, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x64, 0x6f, 0x63, 0x49, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x76, 0x65, 0x72, 0x73, 0x69,
+	0x6f, 0x6e, 0x49, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x76, 0x65, 0x72, 0x73,
+	0x69, 0x6f, 0x6e, 0x49, 0x64, 0x32, 0xda, 0x1c, 0x0a, 0x17, 0x4b, 0x6e, 0x6f, 0x77, 0x6c, 0x65,
+	0x64, 0x67, 0x65, 0x42, 0x61, 0x73, 0x65, 0x44, 0x6f, 0x63, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x12, 0x63, 0x0a, 0x0a, 0x47, 0x65, 0x74, 0x44, 0x6f, 0x63, 0x4c, 0x69, 0x73, 0x74, 0x12,
+	0x28, 0x2e, 0x6b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x62, 0x61, 0x73, 0x65, 0x5f,
+	0x64, 0x6f, 0x63, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x44,
+	0x6f, 0x63, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x1a, 0x29, 0x2e, 0x6b, 0x6e, 0x6f, 0x77,
+	0x6c, 0x65, 0x64, 0x67, 0x65, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x64, 0x6f, 0x63, 0x5f, 0x73, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x44, 0x6f, 0x63, 0x4c, 0x69, 0x73, 0x74,
+	0x52, 0x65, 0x73, 0x70, 0x22, 0x00, 0x12, 0x4e, 0x0a, 0x09, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74,
+	0x44, 0x6f, 0x63, 0x12, 0x27, 0x2e, 0x6b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x62,
 	0x61, 0x73, 0x65, 0x5f, 0x64, 0x6f, 0x63, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e,
-	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x44, 0x6f, 0x63, 0x4d, 0x65, 0x74, 0x61, 0x44, 0x61, 0x74,
-	0x61, 0x52, 0x65, 0x71, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x68,
-	0x0a, 0x16, 0x42, 0x61, 0x74, 0x63, 0x68, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x44, 0x6f, 0x63,
-	0x4d, 0x65, 0x74, 0x61, 0x44, 0x61, 0x74, 0x61, 0x12, 0x34, 0x2e, 0x6b, 0x6e, 0x6f, 0x77, 0x6c,
-	0x65, 0x64, 0x67, 0x65, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x64, 0x6f, 0x63, 0x5f, 0x73, 0x65, 0x72,
-	0x76, 0x69, 0x63, 0x65, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
-	0x44, 0x6f, 0x63, 0x4d, 0x65, 0x74, 0x61, 0x44, 0x61, 0x74, 0x61, 0x52, 0x65, 0x71, 0x1a, 0x16,
-	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
-	0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x56, 0x0a, 0x0d, 0x49, 0x6e, 0x69, 0x74,
-	0x44, 0x6f, 0x63, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x2b, 0x2e, 0x6b, 0x6e, 0x6f, 0x77,
+	0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x44, 0x6f, 0x63, 0x52, 0x65, 0x71, 0x1a, 0x16, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45,
+	0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x5a, 0x0a, 0x0f, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x44, 0x6f, 0x63, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x2d, 0x2e, 0x6b, 0x6e, 0x6f, 0x77,
 	0x6c, 0x65, 0x64, 0x67, 0x65, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x64, 0x6f, 0x63, 0x5f, 0x73, 0x65,
-	0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x49, 0x6e, 0x69, 0x74, 0x44, 0x6f, 0x63, 0x53, 0x74, 0x61,
-	0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00,
-	0x12, 0x4e, 0x0a, 0x09, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x44, 0x6f, 0x63, 0x12, 0x27, 0x2e,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x44, 0x6f, 0x63, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79,
+	0x22, 0x00, 0x12, 0x5e, 0x0a, 0x11, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x44, 0x6f, 0x63, 0x4d,
+	0x65, 0x74, 0x61, 0x44, 0x61, 0x74, 0x61, 0x12, 0x2f, 0x2e, 0x6b, 0x6e, 0x6f, 0x77, 0x6c, 0x65,
+	0x64, 0x67, 0x65, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x64, 0x6f, 0x63, 0x5f, 0x73, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x44, 0x6f, 0x63, 0x4d, 0x65, 0x74,
+	0x61, 0x44, 0x61, 0x74, 0x61, 0x52, 0x65, 0x71, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79,
+	0x22, 0x00, 0x12, 0x68, 0x0a, 0x16, 0x42, 0x61, 0x74, 0x63, 0x68, 0x55, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x44, 0x6f, 0x63, 0x4d, 0x65, 0x74, 0x61, 0x44, 0x61, 0x74, 0x61, 0x12, 0x34, 0x2e, 0x6b,
+	0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x64, 0x6f, 0x63,
+	0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x55, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x44, 0x6f, 0x63, 0x4d, 0x65, 0x74, 0x61, 0x44, 0x61, 0x74, 0x61, 0x52,
+	0x65, 0x71, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x56, 0x0a, 0x0d,
+	0x49, 0x6e, 0x69, 0x74, 0x44, 0x6f, 0x63, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x2b, 0x2e,
 	0x6b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x64, 0x6f,
-	0x63, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
-	0x44, 0x6f, 0x63, 0x52, 0x65, 0x71, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00,
-	0x12, 0x74, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x44, 0x6f, 0x63, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f,
-	0x72, 0x79, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x54, 0x69, 0x70, 0x12, 0x2a, 0x2e, 0x6b, 0x6e,
+	0x63, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x49, 0x6e, 0x69, 0x74, 0x44, 0x6f,
+	0x63, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70,
+	0x74, 0x79, 0x22, 0x00, 0x12, 0x4e, 0x0a, 0x09, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x44, 0x6f,
+	0x63, 0x12, 0x27, 0x2e, 0x6b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x62, 0x61, 0x73,
+	0x65, 0x5f, 0x64, 0x6f, 0x63, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x44, 0x65,
+	0x6c, 0x65, 0x74, 0x65, 0x44, 0x6f, 0x63, 0x52, 0x65, 0x71, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70,
+	0x74, 0x79, 0x22, 0x00, 0x12, 0x74, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x44, 0x6f, 0x63, 0x43, 0x61,
+	0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x54, 0x69, 0x70, 0x12,
+	0x2a, 0x2e, 0x6b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x62, 0x61, 0x73, 0x65, 0x5f,
+	0x64, 0x6f, 0x63, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x44, 0x6f, 0x63, 0x49,
+	0x6d, 0x70, 0x6f, 0x72, 0x74, 0x54, 0x69, 0x70, 0x52, 0x65, 0x71, 0x1a, 0x2b, 0x2e, 0x6b, 0x6e,
 	0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x64, 0x6f, 0x63, 0x5f,
 	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x44, 0x6f, 0x63, 0x49, 0x6d, 0x70, 0x6f, 0x72,
-	0x74, 0x54, 0x69, 0x70, 0x52, 0x65, 0x71, 0x1a, 0x2b, 0x2e, 0x6b, 0x6e, 0x6f, 0x77, 0x6c, 0x65,
-	0x64, 0x67, 0x65, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x64, 0x6f, 0x63, 0x5f, 0x73, 0x65, 0x72, 0x76,
-	0x69, 0x63, 0x65, 0x2e, 0x44, 0x6f, 0x63, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x54, 0x69, 0x70,
-	0x52, 0x65, 0x73, 0x70, 0x22, 0x00, 0x12, 0x72, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x44, 0x6f, 0x63,
-	0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x2c, 0x2e, 0x6b, 0x6e,
-	0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x64, 0x6f, 0x63, 0x5f,
-	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x44, 0x6f, 0x63, 0x53, 0x65, 0x67, 0x6d, 0x65,
-	0x6e, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x1a, 0x2d, 0x2e, 0x6b, 0x6e, 0x6f, 0x77,
-	0x6c, 0x65, 0x64, 0x67, 0x65, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x64, 0x6f, 0x63, 0x5f, 0x73, 0x65,
-	0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x44, 0x6f, 0x63, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74,
-	0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x22, 0x00, 0x12, 0x68, 0x0a, 0x16, 0x55, 0x70,
-	0x64, 0x61, 0x74, 0x65, 0x44, 0x6f, 0x63, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x74,
-	0x61, 0x74, 0x75, 0x73, 0x12, 0x34, 0x2e, 0x6b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65,
-	0x62, 0x61, 0x73, 0x65, 0x5f, 0x64, 0x6f, 0x63, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
-	0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x44, 0x6f, 0x63, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e,
-	0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f,
-	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70,
-	0x74, 0x79, 0x22, 0x00, 0x12, 0x6f, 0x0a, 0x0e, 0x41, 0x6e, 0x61, 0x6c, 0x79, 0x73, 0x69, 0x73,
-	0x44, 0x6f, 0x63, 0x55, 0x72, 0x6c, 0x12, 0x2c, 0x2e, 0x6b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64,
-	0x67, 0x65, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x64, 0x6f, 0x63, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69,
-	0x63, 0x65, 0x2e, 0x41, 0x6e, 0x61, 0x6c, 0x79, 0x73, 0x69, 0x73, 0x55, 0x72, 0x6c, 0x44, 0x6f,
-	0x63, 0x52, 0x65, 0x71, 0x1a, 0x2d, 0x2e, 0x6b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65,
-	0x62, 0x61, 0x73, 0x65, 0x5f, 0x64, 0x6f, 0x63, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
-	0x2e, 0x41, 0x6e, 0x61, 0x6c, 0x79, 0x73, 0x69, 0x73, 0x55, 0x72, 0x6c, 0x44, 0x6f, 0x63, 0x52,
-	0x65, 0x73, 0x70, 0x22, 0x00, 0x12, 0x62, 0x0a, 0x16, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x44,
-	0x6f, 0x63, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x12,
-	0x2e, 0x2e, 0x6b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x62, 0x61, 0x73, 0x65, 0x5f,
+	0x74, 0x54, 0x69, 0x70, 0x52, 0x65, 0x73, 0x70, 0x22, 0x00, 0x12, 0x72, 0x0a, 0x11, 0x47, 0x65,
+	0x74, 0x44, 0x6f, 0x63, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x12,
+	0x2c, 0x2e, 0x6b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x62, 0x61, 0x73, 0x65, 0x5f,
 	0x64, 0x6f, 0x63, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x44, 0x6f, 0x63, 0x53,
-	0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x52, 0x65, 0x71, 0x1a,
-	0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
-	0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x5c, 0x0a, 0x10, 0x43, 0x72, 0x65,
-	0x61, 0x74, 0x65, 0x44, 0x6f, 0x63, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x2e, 0x2e,
+	0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x1a, 0x2d, 0x2e,
 	0x6b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x64, 0x6f,
-	0x63, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
-	0x44, 0x6f, 0x63, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x1a, 0x16, 0x2e,
-	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
-	0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x66, 0x0a, 0x15, 0x42, 0x61, 0x74, 0x63, 0x68,
-	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x44, 0x6f, 0x63, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74,
-	0x12, 0x33, 0x2e, 0x6b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x62, 0x61, 0x73, 0x65,
-	0x5f, 0x64, 0x6f, 0x63, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x42, 0x61, 0x74,
-	0x63, 0x68, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x44, 0x6f, 0x63, 0x53, 0x65, 0x67, 0x6d, 0x65,
-	0x6e, 0x74, 0x52, 0x65, 0x71, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12,
-	0x5c, 0x0a, 0x10, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x44, 0x6f, 0x63, 0x53, 0x65, 0x67, 0x6d,
-	0x65, 0x6e, 0x74, 0x12, 0x2e, 0x2e, 0x6b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x62,
+	0x63, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x44, 0x6f, 0x63, 0x53, 0x65, 0x67,
+	0x6d, 0x65, 0x6e, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x22, 0x00, 0x12, 0x68,
+	0x0a, 0x16, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x44, 0x6f, 0x63, 0x53, 0x65, 0x67, 0x6d, 0x65,
+	0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x34, 0x2e, 0x6b, 0x6e, 0x6f, 0x77, 0x6c,
+	0x65, 0x64, 0x67, 0x65, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x64, 0x6f, 0x63, 0x5f, 0x73, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x44, 0x6f, 0x63, 0x53, 0x65,
+	0x67, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x16,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x6f, 0x0a, 0x0e, 0x41, 0x6e, 0x61, 0x6c,
+	0x79, 0x73, 0x69, 0x73, 0x44, 0x6f, 0x63, 0x55, 0x72, 0x6c, 0x12, 0x2c, 0x2e, 0x6b, 0x6e, 0x6f,
+	0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x64, 0x6f, 0x63, 0x5f, 0x73,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x41, 0x6e, 0x61, 0x6c, 0x79, 0x73, 0x69, 0x73, 0x55,
+	0x72, 0x6c, 0x44, 0x6f, 0x63, 0x52, 0x65, 0x71, 0x1a, 0x2d, 0x2e, 0x6b, 0x6e, 0x6f, 0x77, 0x6c,
+	0x65, 0x64, 0x67, 0x65, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x64, 0x6f, 0x63, 0x5f, 0x73, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x2e, 0x41, 0x6e, 0x61, 0x6c, 0x79, 0x73, 0x69, 0x73, 0x55, 0x72, 0x6c,
+	0x44, 0x6f, 0x63, 0x52, 0x65, 0x73, 0x70, 0x22, 0x00, 0x12, 0x62, 0x0a, 0x16, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x44, 0x6f, 0x63, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x4c, 0x61, 0x62,
+	0x65, 0x6c, 0x73, 0x12, 0x2e, 0x2e, 0x6b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x62,
 	0x61, 0x73, 0x65, 0x5f, 0x64, 0x6f, 0x63, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e,
-	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x44, 0x6f, 0x63, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74,
+	0x44, 0x6f, 0x63, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73,
 	0x52, 0x65, 0x71, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
 	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x5c, 0x0a,
-	0x10, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x44, 0x6f, 0x63, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e,
+	0x10, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x44, 0x6f, 0x63, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e,
 	0x74, 0x12, 0x2e, 0x2e, 0x6b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x62, 0x61, 0x73,
-	0x65, 0x5f, 0x64, 0x6f, 0x63, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x55, 0x70,
-	0x64, 0x61, 0x74, 0x65, 0x44, 0x6f, 0x63, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65,
+	0x65, 0x5f, 0x64, 0x6f, 0x63, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x43, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x44, 0x6f, 0x63, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65,
 	0x71, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x87, 0x01, 0x0a, 0x16,
-	0x47, 0x65, 0x74, 0x44, 0x6f, 0x63, 0x43, 0x68, 0x69, 0x6c, 0x64, 0x53, 0x65, 0x67, 0x6d, 0x65,
-	0x6e, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x34, 0x2e, 0x6b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64,
+	0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x66, 0x0a, 0x15, 0x42,
+	0x61, 0x74, 0x63, 0x68, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x44, 0x6f, 0x63, 0x53, 0x65, 0x67,
+	0x6d, 0x65, 0x6e, 0x74, 0x12, 0x33, 0x2e, 0x6b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65,
+	0x62, 0x61, 0x73, 0x65, 0x5f, 0x64, 0x6f, 0x63, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x44, 0x6f, 0x63, 0x53,
+	0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74,
+	0x79, 0x22, 0x00, 0x12, 0x5c, 0x0a, 0x10, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x44, 0x6f, 0x63,
+	0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x2e, 0x2e, 0x6b, 0x6e, 0x6f, 0x77, 0x6c, 0x65,
+	0x64, 0x67, 0x65, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x64, 0x6f, 0x63, 0x5f, 0x73, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x44, 0x6f, 0x63, 0x53, 0x65, 0x67,
+	0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22,
+	0x00, 0x12, 0x5c, 0x0a, 0x10, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x44, 0x6f, 0x63, 0x53, 0x65,
+	0x67, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x2e, 0x2e, 0x6b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67,
+	0x65, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x64, 0x6f, 0x63, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x44, 0x6f, 0x63, 0x53, 0x65, 0x67, 0x6d, 0x65,
+	0x6e, 0x74, 0x52, 0x65, 0x71, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12,
+	0x87, 0x01, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x44, 0x6f, 0x63, 0x43, 0x68, 0x69, 0x6c, 0x64, 0x53,
+	0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x34, 0x2e, 0x6b, 0x6e, 0x6f,
+	0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x64, 0x6f, 0x63, 0x5f, 0x73,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x44, 0x6f, 0x63, 0x43, 0x68, 0x69,
+	0x6c, 0x64, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71,
+	0x1a, 0x35, 0x2e, 0x6b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x62, 0x61, 0x73, 0x65,
+	0x5f, 0x64, 0x6f, 0x63, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x47, 0x65, 0x74,
+	0x44, 0x6f, 0x63, 0x43, 0x68, 0x69, 0x6c, 0x64, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x4c,
+	0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x22, 0x00, 0x12, 0x66, 0x0a, 0x15, 0x43, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x44, 0x6f, 0x63, 0x43, 0x68, 0x69, 0x6c, 0x64, 0x53, 0x65, 0x67, 0x6d, 0x65,
+	0x6e, 0x74, 0x12, 0x33, 0x2e, 0x6b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x62, 0x61,
+	0x73, 0x65, 0x5f, 0x64, 0x6f, 0x63, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x43,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x44, 0x6f, 0x63, 0x43, 0x68, 0x69, 0x6c, 0x64, 0x53, 0x65, 0x67,
+	0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22,
+	0x00, 0x12, 0x66, 0x0a, 0x15, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x44, 0x6f, 0x63, 0x43, 0x68,
+	0x69, 0x6c, 0x64, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x33, 0x2e, 0x6b, 0x6e, 0x6f,
+	0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x64, 0x6f, 0x63, 0x5f, 0x73,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x44, 0x6f, 0x63,
+	0x43, 0x68, 0x69, 0x6c, 0x64, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x1a,
+	0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x66, 0x0a, 0x15, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x44, 0x6f, 0x63, 0x43, 0x68, 0x69, 0x6c, 0x64, 0x53, 0x65, 0x67, 0x6d, 0x65,
+	0x6e, 0x74, 0x12, 0x33, 0x2e, 0x6b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x62, 0x61,
+	0x73, 0x65, 0x5f, 0x64, 0x6f, 0x63, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x55,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x44, 0x6f, 0x63, 0x43, 0x68, 0x69, 0x6c, 0x64, 0x53, 0x65, 0x67,
+	0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22,
+	0x00, 0x12, 0x77, 0x0a, 0x10, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x44, 0x6f, 0x63, 0x53, 0x65,
+	0x67, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x2e, 0x2e, 0x6b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67,
+	0x65, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x64, 0x6f, 0x63, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x2e, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x44, 0x6f, 0x63, 0x53, 0x65, 0x67, 0x6d, 0x65,
+	0x6e, 0x74, 0x52, 0x65, 0x71, 0x1a, 0x2f, 0x2e, 0x6b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67,
+	0x65, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x64, 0x6f, 0x63, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x2e, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x44, 0x6f, 0x63, 0x53, 0x65, 0x67, 0x6d, 0x65,
+	0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x22, 0x00, 0x30, 0x01, 0x12, 0x89, 0x01, 0x0a, 0x19, 0x47,
+	0x65, 0x74, 0x44, 0x6f, 0x63, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x6d, 0x70, 0x6f,
+	0x72, 0x74, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x37, 0x2e, 0x6b, 0x6e, 0x6f, 0x77, 0x6c,
+	0x65, 0x64, 0x67, 0x65, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x64, 0x6f, 0x63, 0x5f, 0x73, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x44, 0x6f, 0x63, 0x53, 0x65, 0x67, 0x6d, 0x65,
+	0x6e, 0x74, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65,
+	0x71, 0x1a, 0x31, 0x2e, 0x6b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x62, 0x61, 0x73,
+	0x65, 0x5f, 0x64, 0x6f, 0x63, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x44, 0x6f,
+	0x63, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65,
+	0x70, 0x6f, 0x72, 0x74, 0x22, 0x00, 0x12, 0x66, 0x0a, 0x0b, 0x53, 0x61, 0x76, 0x65, 0x44, 0x6f,
+	0x63, 0x53, 0x79, 0x6e, 0x63, 0x12, 0x29, 0x2e, 0x6b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67,
+	0x65, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x64, 0x6f, 0x63, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x2e, 0x53, 0x61, 0x76, 0x65, 0x44, 0x6f, 0x63, 0x53, 0x79, 0x6e, 0x63, 0x52, 0x65, 0x71,
+	0x1a, 0x2a, 0x2e, 0x6b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x62, 0x61, 0x73, 0x65,
+	0x5f, 0x64, 0x6f, 0x63, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x53, 0x61, 0x76,
+	0x65, 0x44, 0x6f, 0x63, 0x53, 0x79, 0x6e, 0x63, 0x52, 0x65, 0x73, 0x70, 0x22, 0x00, 0x12, 0x56,
+	0x0a, 0x0d, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x44, 0x6f, 0x63, 0x53, 0x79, 0x6e, 0x63, 0x12,
+	0x2b, 0x2e, 0x6b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x62, 0x61, 0x73, 0x65, 0x5f,
+	0x64, 0x6f, 0x63, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x44, 0x65, 0x6c, 0x65,
+	0x74, 0x65, 0x44, 0x6f, 0x63, 0x53, 0x79, 0x6e, 0x63, 0x52, 0x65, 0x71, 0x1a, 0x16, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45,
+	0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x6f, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x44, 0x6f, 0x63,
+	0x53, 0x79, 0x6e, 0x63, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x2c, 0x2e, 0x6b, 0x6e, 0x6f, 0x77, 0x6c,
+	0x65, 0x64, 0x67, 0x65, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x64, 0x6f, 0x63, 0x5f, 0x73, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x44, 0x6f, 0x63, 0x53, 0x79, 0x6e, 0x63, 0x4c,
+	0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x1a, 0x2d, 0x2e, 0x6b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64,
 	0x67, 0x65, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x64, 0x6f, 0x63, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69,
-	0x63, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x44, 0x6f, 0x63, 0x43, 0x68, 0x69, 0x6c, 0x64, 0x53, 0x65,
-	0x67, 0x6d, 0x65, 0x6e, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x1a, 0x35, 0x2e, 0x6b,
-	0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x64, 0x6f, 0x63,
-	0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x44, 0x6f, 0x63, 0x43,
-	0x68, 0x69, 0x6c, 0x64, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x52,
-	0x65, 0x73, 0x70, 0x22, 0x00, 0x12, 0x66, 0x0a, 0x15, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x44,
-	0x6f, 0x63, 0x43, 0x68, 0x69, 0x6c, 0x64, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x33,
+	0x63, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x44, 0x6f, 0x63, 0x53, 0x79, 0x6e, 0x63, 0x4c, 0x69, 0x73,
+	0x74, 0x52, 0x65, 0x73, 0x70, 0x22, 0x00, 0x12, 0x50, 0x0a, 0x0a, 0x52, 0x75, 0x6e, 0x44, 0x6f,
+	0x63, 0x53, 0x79, 0x6e, 0x63, 0x12, 0x28, 0x2e, 0x6b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67,
+	0x65, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x64, 0x6f, 0x63, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x2e, 0x52, 0x75, 0x6e, 0x44, 0x6f, 0x63, 0x53, 0x79, 0x6e, 0x63, 0x52, 0x65, 0x71, 0x1a,
+	0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x84, 0x01, 0x0a, 0x15, 0x47, 0x65,
+	0x74, 0x44, 0x6f, 0x63, 0x53, 0x79, 0x6e, 0x63, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x4c,
+	0x69, 0x73, 0x74, 0x12, 0x33, 0x2e, 0x6b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x62,
+	0x61, 0x73, 0x65, 0x5f, 0x64, 0x6f, 0x63, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e,
+	0x47, 0x65, 0x74, 0x44, 0x6f, 0x63, 0x53, 0x79, 0x6e, 0x63, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72,
+	0x79, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x1a, 0x34, 0x2e, 0x6b, 0x6e, 0x6f, 0x77, 0x6c,
+	0x65, 0x64, 0x67, 0x65, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x64, 0x6f, 0x63, 0x5f, 0x73, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x44, 0x6f, 0x63, 0x53, 0x79, 0x6e, 0x63, 0x48,
+	0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x22, 0x00,
+	0x12, 0x6c, 0x0a, 0x0d, 0x53, 0x61, 0x76, 0x65, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x6f,
+	0x72, 0x12, 0x2b, 0x2e, 0x6b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x62, 0x61, 0x73,
+	0x65, 0x5f, 0x64, 0x6f, 0x63, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x53, 0x61,
+	0x76, 0x65, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x71, 0x1a, 0x2c,
 	0x2e, 0x6b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x64,
-	0x6f, 0x63, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74,
-	0x65, 0x44, 0x6f, 0x63, 0x43, 0x68, 0x69, 0x6c, 0x64, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74,
-	0x52, 0x65, 0x71, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x66, 0x0a,
-	0x15, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x44, 0x6f, 0x63, 0x43, 0x68, 0x69, 0x6c, 0x64, 0x53,
-	0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x33, 0x2e, 0x6b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64,
-	0x67, 0x65, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x64, 0x6f, 0x63, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69,
-	0x63, 0x65, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x44, 0x6f, 0x63, 0x43, 0x68, 0x69, 0x6c,
-	0x64, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x1a, 0x16, 0x2e, 0x67, 0x6f,
-	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d,
-	0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x66, 0x0a, 0x15, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x44,
-	0x6f, 0x63, 0x43, 0x68, 0x69, 0x6c, 0x64, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x33,
+	0x6f, 0x63, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x53, 0x61, 0x76, 0x65, 0x43,
+	0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x73, 0x70, 0x22, 0x00, 0x12, 0x5a,
+	0x0a, 0x0f, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x6f,
+	0x72, 0x12, 0x2d, 0x2e, 0x6b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x62, 0x61, 0x73,
+	0x65, 0x5f, 0x64, 0x6f, 0x63, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x44, 0x65,
+	0x6c, 0x65, 0x74, 0x65, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x71,
+	0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x75, 0x0a, 0x10, 0x47, 0x65,
+	0x74, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x2e,
 	0x2e, 0x6b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x64,
-	0x6f, 0x63, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74,
-	0x65, 0x44, 0x6f, 0x63, 0x43, 0x68, 0x69, 0x6c, 0x64, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74,
-	0x52, 0x65, 0x71, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x42, 0x6b, 0x5a,
-	0x69, 0x67, 0x69, 0x74, 0x6c, 0x61, 0x62, 0x2e, 0x61, 0x69, 0x2d, 0x79, 0x75, 0x61, 0x6e, 0x6a,
-	0x69, 0x6e, 0x67, 0x2e, 0x63, 0x6e, 0x2f, 0x61, 0x69, 0x2d, 0x70, 0x6c, 0x61, 0x74, 0x66, 0x6f,
-	0x72, 0x6d, 0x2d, 0x70, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x2f, 0x62, 0x61, 0x63, 0x6b, 0x65,
-	0x6e, 0x64, 0x2d, 0x75, 0x73, 0x65, 0x64, 0x2d, 0x62, 0x66, 0x66, 0x2f, 0x63, 0x6f, 0x6d, 0x6d,
-	0x6f, 0x6e, 0x2d, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x2f, 0x6b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x62, 0x61, 0x73, 0x65, 0x2d, 0x64,
-	0x6f, 0x63, 0x2d, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x33,
+	0x6f, 0x63, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f,
+	0x6e, 0x6e, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x1a, 0x2f,
+	0x2e, 0x6b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x64,
+	0x6f, 0x63, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f,
+	0x6e, 0x6e, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x22,
+	0x00, 0x12, 0x54, 0x0a, 0x0c, 0x52, 0x75, 0x6e, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x6f,
+	0x72, 0x12, 0x2a, 0x2e, 0x6b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x62, 0x61, 0x73,
+	0x65, 0x5f, 0x64, 0x6f, 0x63, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x52, 0x75,
+	0x6e, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x71, 0x1a, 0x16, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x5c, 0x0a, 0x10, 0x55, 0x70, 0x6c, 0x6f, 0x61,
+	0x64, 0x44, 0x6f, 0x63, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x2e, 0x2e, 0x6b, 0x6e,
+	0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x64, 0x6f, 0x63, 0x5f,
+	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x44, 0x6f,
+	0x63, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x1a, 0x16, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d,
+	0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x78, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x44, 0x6f, 0x63, 0x56,
+	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x2f, 0x2e, 0x6b, 0x6e, 0x6f,
+	0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x64, 0x6f, 0x63, 0x5f, 0x73,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x44, 0x6f, 0x63, 0x56, 0x65, 0x72,
+	0x73, 0x69, 0x6f, 0x6e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x1a, 0x30, 0x2e, 0x6b, 0x6e,
+	0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x64, 0x6f, 0x63, 0x5f,
+	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x44, 0x6f, 0x63, 0x56, 0x65,
+	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x22, 0x00, 0x12,
+	0x6f, 0x0a, 0x0e, 0x44, 0x69, 0x66, 0x66, 0x44, 0x6f, 0x63, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f,
+	0x6e, 0x12, 0x2c, 0x2e, 0x6b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x62, 0x61, 0x73,
+	0x65, 0x5f, 0x64, 0x6f, 0x63, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x44, 0x69,
+	0x66, 0x66, 0x44, 0x6f, 0x63, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x1a,
+	0x2d, 0x2e, 0x6b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x62, 0x61, 0x73, 0x65, 0x5f,
+	0x64, 0x6f, 0x63, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x44, 0x69, 0x66, 0x66,
+	0x44, 0x6f, 0x63, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x22, 0x00,
+	0x12, 0x60, 0x0a, 0x12, 0x52, 0x6f, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x44, 0x6f, 0x63, 0x56,
+	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x30, 0x2e, 0x6b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64,
+	0x67, 0x65, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x64, 0x6f, 0x63, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x2e, 0x52, 0x6f, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x44, 0x6f, 0x63, 0x56, 0x65,
+	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79,
+	0x22, 0x00, 0x42, 0x6b, 0x5a, 0x69, 0x67, 0x69, 0x74, 0x6c, 0x61, 0x62, 0x2e, 0x61, 0x69, 0x2d,
+	0x79, 0x75, 0x61, 0x6e, 0x6a, 0x69, 0x6e, 0x67, 0x2e, 0x63, 0x6e, 0x2f, 0x61, 0x69, 0x2d, 0x70,
+	0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x2d, 0x70, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x2f,
+	0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x2d, 0x75, 0x73, 0x65, 0x64, 0x2d, 0x62, 0x66, 0x66,
+	0x2f, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2d, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x70, 0x69, 0x2f,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x6b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x62,
+	0x61, 0x73, 0x65, 0x2d, 0x64, 0x6f, 0x63, 0x2d, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x62,
+	0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -3215,43 +6090,75 @@ func file_proto_knowledgebase_doc_service_knowledgebase_doc_service_proto_rawDes
 	return file_proto_knowledgebase_doc_service_knowledgebase_doc_service_proto_rawDescData
 }
 
-var file_proto_knowledgebase_doc_service_knowledgebase_doc_service_proto_msgTypes = make([]protoimpl.MessageInfo, 34)
+var file_proto_knowledgebase_doc_service_knowledgebase_doc_service_proto_msgTypes = make([]protoimpl.MessageInfo, 66)
 var file_proto_knowledgebase_doc_service_knowledgebase_doc_service_proto_goTypes = []interface{}{
-	(*GetDocListReq)(nil),              // 0: knowledgebase_doc_service.GetDocListReq
-	(*GetDocListResp)(nil),             // 1: knowledgebase_doc_service.GetDocListResp
-	(*ImportDocReq)(nil),               // 2: knowledgebase_doc_service.ImportDocReq
-	(*DocMetaData)(nil),                // 3: knowledgebase_doc_service.DocMetaData
-	(*UpdateDocStatusReq)(nil),         // 4: knowledgebase_doc_service.UpdateDocStatusReq
-	(*UpdateDocMetaDataReq)(nil),       // 5: knowledgebase_doc_service.UpdateDocMetaDataReq
-	(*BatchUpdateDocMetaDataReq)(nil),  // 6: knowledgebase_doc_service.BatchUpdateDocMetaDataReq
-	(*MetaData)(nil),                   // 7: knowledgebase_doc_service.MetaData
-	(*InitDocStatusReq)(nil),           // 8: knowledgebase_doc_service.InitDocStatusReq
-	(*DeleteDocReq)(nil),               // 9: knowledgebase_doc_service.DeleteDocReq
-	(*DocFileInfo)(nil),                // 10: knowledgebase_doc_service.DocFileInfo
-	(*DocSegment)(nil),                 // 11: knowledgebase_doc_service.DocSegment
-	(*DocImportTipReq)(nil),            // 12: knowledgebase_doc_service.DocImportTipReq
-	(*DocImportTipResp)(nil),           // 13: knowledgebase_doc_service.DocImportTipResp
-	(*DocSegmentListResp)(nil),         // 14: knowledgebase_doc_service.DocSegmentListResp
-	(*SegmentContent)(nil),             // 15: knowledgebase_doc_service.SegmentContent
-	(*DocInfo)(nil),                    // 16: knowledgebase_doc_service.DocInfo
-	(*DocSegmentListReq)(nil),          // 17: knowledgebase_doc_service.DocSegmentListReq
-	(*UpdateDocSegmentStatusReq)(nil),  // 18: knowledgebase_doc_service.UpdateDocSegmentStatusReq
-	(*AnalysisUrlDocReq)(nil),          // 19: knowledgebase_doc_service.AnalysisUrlDocReq
-	(*AnalysisUrlDocResp)(nil),         // 20: knowledgebase_doc_service.AnalysisUrlDocResp
-	(*UrlInfo)(nil),                    // 21: knowledgebase_doc_service.UrlInfo
-	(*DocSegmentLabelsReq)(nil),        // 22: knowledgebase_doc_service.DocSegmentLabelsReq
-	(*CreateDocSegmentReq)(nil),        // 23: knowledgebase_doc_service.CreateDocSegmentReq
-	(*BatchCreateDocSegmentReq)(nil),   // 24: knowledgebase_doc_service.BatchCreateDocSegmentReq
-	(*DeleteDocSegmentReq)(nil),        // 25: knowledgebase_doc_service.DeleteDocSegmentReq
-	(*UpdateDocSegmentReq)(nil),        // 26: knowledgebase_doc_service.UpdateDocSegmentReq
-	(*GetDocChildSegmentListReq)(nil),  // 27: knowledgebase_doc_service.GetDocChildSegmentListReq
-	(*GetDocChildSegmentListResp)(nil), // 28: knowledgebase_doc_service.GetDocChildSegmentListResp
-	(*ChildSegmentInfo)(nil),           // 29: knowledgebase_doc_service.ChildSegmentInfo
-	(*CreateDocChildSegmentReq)(nil),   // 30: knowledgebase_doc_service.CreateDocChildSegmentReq
-	(*DeleteDocChildSegmentReq)(nil),   // 31: knowledgebase_doc_service.DeleteDocChildSegmentReq
-	(*UpdateDocChildSegmentReq)(nil),   // 32: knowledgebase_doc_service.UpdateDocChildSegmentReq
-	(*ChildChunk)(nil),                 // 33: knowledgebase_doc_service.ChildChunk
-	(*emptypb.Empty)(nil),              // 34: google.protobuf.Empty
+	(*GetDocListReq)(nil),                // 0: knowledgebase_doc_service.GetDocListReq
+	(*GetDocListResp)(nil),               // 1: knowledgebase_doc_service.GetDocListResp
+	(*ImportDocReq)(nil),                 // 2: knowledgebase_doc_service.ImportDocReq
+	(*DocMetaData)(nil),                  // 3: knowledgebase_doc_service.DocMetaData
+	(*UpdateDocStatusReq)(nil),           // 4: knowledgebase_doc_service.UpdateDocStatusReq
+	(*UpdateDocMetaDataReq)(nil),         // 5: knowledgebase_doc_service.UpdateDocMetaDataReq
+	(*BatchUpdateDocMetaDataReq)(nil),    // 6: knowledgebase_doc_service.BatchUpdateDocMetaDataReq
+	(*MetaData)(nil),                     // 7: knowledgebase_doc_service.MetaData
+	(*InitDocStatusReq)(nil),             // 8: knowledgebase_doc_service.InitDocStatusReq
+	(*DeleteDocReq)(nil),                 // 9: knowledgebase_doc_service.DeleteDocReq
+	(*DocFileInfo)(nil),                  // 10: knowledgebase_doc_service.DocFileInfo
+	(*DocSegment)(nil),                   // 11: knowledgebase_doc_service.DocSegment
+	(*DocImportTipReq)(nil),              // 12: knowledgebase_doc_service.DocImportTipReq
+	(*DocImportTipResp)(nil),             // 13: knowledgebase_doc_service.DocImportTipResp
+	(*DocSegmentListResp)(nil),           // 14: knowledgebase_doc_service.DocSegmentListResp
+	(*SegmentContent)(nil),               // 15: knowledgebase_doc_service.SegmentContent
+	(*DocInfo)(nil),                      // 16: knowledgebase_doc_service.DocInfo
+	(*DocSegmentListReq)(nil),            // 17: knowledgebase_doc_service.DocSegmentListReq
+	(*UpdateDocSegmentStatusReq)(nil),    // 18: knowledgebase_doc_service.UpdateDocSegmentStatusReq
+	(*AnalysisUrlDocReq)(nil),            // 19: knowledgebase_doc_service.AnalysisUrlDocReq
+	(*AnalysisUrlDocResp)(nil),           // 20: knowledgebase_doc_service.AnalysisUrlDocResp
+	(*UrlInfo)(nil),                      // 21: knowledgebase_doc_service.UrlInfo
+	(*DocSegmentLabelsReq)(nil),          // 22: knowledgebase_doc_service.DocSegmentLabelsReq
+	(*CreateDocSegmentReq)(nil),          // 23: knowledgebase_doc_service.CreateDocSegmentReq
+	(*BatchCreateDocSegmentReq)(nil),     // 24: knowledgebase_doc_service.BatchCreateDocSegmentReq
+	(*ExportDocSegmentReq)(nil),          // 25: knowledgebase_doc_service.ExportDocSegmentReq
+	(*ExportDocSegmentResp)(nil),         // 26: knowledgebase_doc_service.ExportDocSegmentResp
+	(*GetDocSegmentImportReportReq)(nil), // 27: knowledgebase_doc_service.GetDocSegmentImportReportReq
+	(*DocSegmentImportReport)(nil),       // 28: knowledgebase_doc_service.DocSegmentImportReport
+	(*DocSegmentImportRowError)(nil),     // 29: knowledgebase_doc_service.DocSegmentImportRowError
+	(*DeleteDocSegmentReq)(nil),          // 30: knowledgebase_doc_service.DeleteDocSegmentReq
+	(*UpdateDocSegmentReq)(nil),          // 31: knowledgebase_doc_service.UpdateDocSegmentReq
+	(*GetDocChildSegmentListReq)(nil),    // 32: knowledgebase_doc_service.GetDocChildSegmentListReq
+	(*GetDocChildSegmentListResp)(nil),   // 33: knowledgebase_doc_service.GetDocChildSegmentListResp
+	(*ChildSegmentInfo)(nil),             // 34: knowledgebase_doc_service.ChildSegmentInfo
+	(*CreateDocChildSegmentReq)(nil),     // 35: knowledgebase_doc_service.CreateDocChildSegmentReq
+	(*DeleteDocChildSegmentReq)(nil),     // 36: knowledgebase_doc_service.DeleteDocChildSegmentReq
+	(*UpdateDocChildSegmentReq)(nil),     // 37: knowledgebase_doc_service.UpdateDocChildSegmentReq
+	(*ChildChunk)(nil),                   // 38: knowledgebase_doc_service.ChildChunk
+	(*SaveDocSyncReq)(nil),               // 39: knowledgebase_doc_service.SaveDocSyncReq
+	(*SaveDocSyncResp)(nil),              // 40: knowledgebase_doc_service.SaveDocSyncResp
+	(*DeleteDocSyncReq)(nil),             // 41: knowledgebase_doc_service.DeleteDocSyncReq
+	(*GetDocSyncListReq)(nil),            // 42: knowledgebase_doc_service.GetDocSyncListReq
+	(*GetDocSyncListResp)(nil),           // 43: knowledgebase_doc_service.GetDocSyncListResp
+	(*DocSyncInfo)(nil),                  // 44: knowledgebase_doc_service.DocSyncInfo
+	(*RunDocSyncReq)(nil),                // 45: knowledgebase_doc_service.RunDocSyncReq
+	(*GetDocSyncHistoryListReq)(nil),     // 46: knowledgebase_doc_service.GetDocSyncHistoryListReq
+	(*GetDocSyncHistoryListResp)(nil),    // 47: knowledgebase_doc_service.GetDocSyncHistoryListResp
+	(*DocSyncHistoryInfo)(nil),           // 48: knowledgebase_doc_service.DocSyncHistoryInfo
+	(*ConnectorConfig)(nil),              // 49: knowledgebase_doc_service.ConnectorConfig
+	(*ConnectorCredential)(nil),          // 50: knowledgebase_doc_service.ConnectorCredential
+	(*SaveConnectorReq)(nil),             // 51: knowledgebase_doc_service.SaveConnectorReq
+	(*SaveConnectorResp)(nil),            // 52: knowledgebase_doc_service.SaveConnectorResp
+	(*DeleteConnectorReq)(nil),           // 53: knowledgebase_doc_service.DeleteConnectorReq
+	(*GetConnectorListReq)(nil),          // 54: knowledgebase_doc_service.GetConnectorListReq
+	(*GetConnectorListResp)(nil),         // 55: knowledgebase_doc_service.GetConnectorListResp
+	(*ConnectorInfo)(nil),                // 56: knowledgebase_doc_service.ConnectorInfo
+	(*RunConnectorReq)(nil),              // 57: knowledgebase_doc_service.RunConnectorReq
+	(*UploadDocVersionReq)(nil),          // 58: knowledgebase_doc_service.UploadDocVersionReq
+	(*GetDocVersionListReq)(nil),         // 59: knowledgebase_doc_service.GetDocVersionListReq
+	(*GetDocVersionListResp)(nil),        // 60: knowledgebase_doc_service.GetDocVersionListResp
+	(*DocVersionInfo)(nil),               // 61: knowledgebase_doc_service.DocVersionInfo
+	(*DiffDocVersionReq)(nil),            // 62: knowledgebase_doc_service.DiffDocVersionReq
+	(*DiffDocVersionResp)(nil),           // 63: knowledgebase_doc_service.DiffDocVersionResp
+	(*DocSegmentDiff)(nil),               // 64: knowledgebase_doc_service.DocSegmentDiff
+	(*RollbackDocVersionReq)(nil),        // 65: knowledgebase_doc_service.RollbackDocVersionReq
+	(*emptypb.Empty)(nil),                // 66: google.protobuf.Empty
 }
 var file_proto_knowledgebase_doc_service_knowledgebase_doc_service_proto_depIdxs = []int32{
 	16, // 0: knowledgebase_doc_service.GetDocListResp.docs:type_name -> knowledgebase_doc_service.DocInfo
@@ -3264,53 +6171,94 @@ var file_proto_knowledgebase_doc_service_knowledgebase_doc_service_proto_depIdxs
 	15, // 7: knowledgebase_doc_service.DocSegmentListResp.contentList:type_name -> knowledgebase_doc_service.SegmentContent
 	7,  // 8: knowledgebase_doc_service.DocSegmentListResp.metaDataList:type_name -> knowledgebase_doc_service.MetaData
 	21, // 9: knowledgebase_doc_service.AnalysisUrlDocResp.urlList:type_name -> knowledgebase_doc_service.UrlInfo
-	29, // 10: knowledgebase_doc_service.GetDocChildSegmentListResp.contentList:type_name -> knowledgebase_doc_service.ChildSegmentInfo
-	33, // 11: knowledgebase_doc_service.UpdateDocChildSegmentReq.childChunk:type_name -> knowledgebase_doc_service.ChildChunk
-	0,  // 12: knowledgebase_doc_service.KnowledgeBaseDocService.GetDocList:input_type -> knowledgebase_doc_service.GetDocListReq
-	2,  // 13: knowledgebase_doc_service.KnowledgeBaseDocService.ImportDoc:input_type -> knowledgebase_doc_service.ImportDocReq
-	4,  // 14: knowledgebase_doc_service.KnowledgeBaseDocService.UpdateDocStatus:input_type -> knowledgebase_doc_service.UpdateDocStatusReq
-	5,  // 15: knowledgebase_doc_service.KnowledgeBaseDocService.UpdateDocMetaData:input_type -> knowledgebase_doc_service.UpdateDocMetaDataReq
-	6,  // 16: knowledgebase_doc_service.KnowledgeBaseDocService.BatchUpdateDocMetaData:input_type -> knowledgebase_doc_service.BatchUpdateDocMetaDataReq
-	8,  // 17: knowledgebase_doc_service.KnowledgeBaseDocService.InitDocStatus:input_type -> knowledgebase_doc_service.InitDocStatusReq
-	9,  // 18: knowledgebase_doc_service.KnowledgeBaseDocService.DeleteDoc:input_type -> knowledgebase_doc_service.DeleteDocReq
-	12, // 19: knowledgebase_doc_service.KnowledgeBaseDocService.GetDocCategoryUploadTip:input_type -> knowledgebase_doc_service.DocImportTipReq
-	17, // 20: knowledgebase_doc_service.KnowledgeBaseDocService.GetDocSegmentList:input_type -> knowledgebase_doc_service.DocSegmentListReq
-	18, // 21: knowledgebase_doc_service.KnowledgeBaseDocService.UpdateDocSegmentStatus:input_type -> knowledgebase_doc_service.UpdateDocSegmentStatusReq
-	19, // 22: knowledgebase_doc_service.KnowledgeBaseDocService.AnalysisDocUrl:input_type -> knowledgebase_doc_service.AnalysisUrlDocReq
-	22, // 23: knowledgebase_doc_service.KnowledgeBaseDocService.UpdateDocSegmentLabels:input_type -> knowledgebase_doc_service.DocSegmentLabelsReq
-	23, // 24: knowledgebase_doc_service.KnowledgeBaseDocService.CreateDocSegment:input_type -> knowledgebase_doc_service.CreateDocSegmentReq
-	24, // 25: knowledgebase_doc_service.KnowledgeBaseDocService.BatchCreateDocSegment:input_type -> knowledgebase_doc_service.BatchCreateDocSegmentReq
-	25, // 26: knowledgebase_doc_service.KnowledgeBaseDocService.DeleteDocSegment:input_type -> knowledgebase_doc_service.DeleteDocSegmentReq
-	26, // 27: knowledgebase_doc_service.KnowledgeBaseDocService.UpdateDocSegment:input_type -> knowledgebase_doc_service.UpdateDocSegmentReq
-	27, // 28: knowledgebase_doc_service.KnowledgeBaseDocService.GetDocChildSegmentList:input_type -> knowledgebase_doc_service.GetDocChildSegmentListReq
-	30, // 29: knowledgebase_doc_service.KnowledgeBaseDocService.CreateDocChildSegment:input_type -> knowledgebase_doc_service.CreateDocChildSegmentReq
-	31, // 30: knowledgebase_doc_service.KnowledgeBaseDocService.DeleteDocChildSegment:input_type -> knowledgebase_doc_service.DeleteDocChildSegmentReq
-	32, // 31: knowledgebase_doc_service.KnowledgeBaseDocService.UpdateDocChildSegment:input_type -> knowledgebase_doc_service.UpdateDocChildSegmentReq
-	1,  // 32: knowledgebase_doc_service.KnowledgeBaseDocService.GetDocList:output_type -> knowledgebase_doc_service.GetDocListResp
-	34, // 33: knowledgebase_doc_service.KnowledgeBaseDocService.ImportDoc:output_type -> google.protobuf.Empty
-	34, // 34: knowledgebase_doc_service.KnowledgeBaseDocService.UpdateDocStatus:output_type -> google.protobuf.Empty
-	34, // 35: knowledgebase_doc_service.KnowledgeBaseDocService.UpdateDocMetaData:output_type -> google.protobuf.Empty
-	34, // 36: knowledgebase_doc_service.KnowledgeBaseDocService.BatchUpdateDocMetaData:output_type -> google.protobuf.Empty
-	34, // 37: knowledgebase_doc_service.KnowledgeBaseDocService.InitDocStatus:output_type -> google.protobuf.Empty
-	34, // 38: knowledgebase_doc_service.KnowledgeBaseDocService.DeleteDoc:output_type -> google.protobuf.Empty
-	13, // 39: knowledgebase_doc_service.KnowledgeBaseDocService.GetDocCategoryUploadTip:output_type -> knowledgebase_doc_service.DocImportTipResp
-	14, // 40: knowledgebase_doc_service.KnowledgeBaseDocService.GetDocSegmentList:output_type -> knowledgebase_doc_service.DocSegmentListResp
-	34, // 41: knowledgebase_doc_service.KnowledgeBaseDocService.UpdateDocSegmentStatus:output_type -> google.protobuf.Empty
-	20, // 42: knowledgebase_doc_service.KnowledgeBaseDocService.AnalysisDocUrl:output_type -> knowledgebase_doc_service.AnalysisUrlDocResp
-	34, // 43: knowledgebase_doc_service.KnowledgeBaseDocService.UpdateDocSegmentLabels:output_type -> google.protobuf.Empty
-	34, // 44: knowledgebase_doc_service.KnowledgeBaseDocService.CreateDocSegment:output_type -> google.protobuf.Empty
-	34, // 45: knowledgebase_doc_service.KnowledgeBaseDocService.BatchCreateDocSegment:output_type -> google.protobuf.Empty
-	34, // 46: knowledgebase_doc_service.KnowledgeBaseDocService.DeleteDocSegment:output_type -> google.protobuf.Empty
-	34, // 47: knowledgebase_doc_service.KnowledgeBaseDocService.UpdateDocSegment:output_type -> google.protobuf.Empty
-	28, // 48: knowledgebase_doc_service.KnowledgeBaseDocService.GetDocChildSegmentList:output_type -> knowledgebase_doc_service.GetDocChildSegmentListResp
-	34, // 49: knowledgebase_doc_service.KnowledgeBaseDocService.CreateDocChildSegment:output_type -> google.protobuf.Empty
-	34, // 50: knowledgebase_doc_service.KnowledgeBaseDocService.DeleteDocChildSegment:output_type -> google.protobuf.Empty
-	34, // 51: knowledgebase_doc_service.KnowledgeBaseDocService.UpdateDocChildSegment:output_type -> google.protobuf.Empty
-	32, // [32:52] is the sub-list for method output_type
-	12, // [12:32] is the sub-list for method input_type
-	12, // [12:12] is the sub-list for extension type_name
-	12, // [12:12] is the sub-list for extension extendee
-	0,  // [0:12] is the sub-list for field type_name
+	29, // 10: knowledgebase_doc_service.DocSegmentImportReport.rowErrorList:type_name -> knowledgebase_doc_service.DocSegmentImportRowError
+	34, // 11: knowledgebase_doc_service.GetDocChildSegmentListResp.contentList:type_name -> knowledgebase_doc_service.ChildSegmentInfo
+	38, // 12: knowledgebase_doc_service.UpdateDocChildSegmentReq.childChunk:type_name -> knowledgebase_doc_service.ChildChunk
+	44, // 13: knowledgebase_doc_service.GetDocSyncListResp.list:type_name -> knowledgebase_doc_service.DocSyncInfo
+	48, // 14: knowledgebase_doc_service.GetDocSyncHistoryListResp.list:type_name -> knowledgebase_doc_service.DocSyncHistoryInfo
+	49, // 15: knowledgebase_doc_service.SaveConnectorReq.config:type_name -> knowledgebase_doc_service.ConnectorConfig
+	50, // 16: knowledgebase_doc_service.SaveConnectorReq.credential:type_name -> knowledgebase_doc_service.ConnectorCredential
+	11, // 17: knowledgebase_doc_service.SaveConnectorReq.docSegment:type_name -> knowledgebase_doc_service.DocSegment
+	56, // 18: knowledgebase_doc_service.GetConnectorListResp.list:type_name -> knowledgebase_doc_service.ConnectorInfo
+	49, // 19: knowledgebase_doc_service.ConnectorInfo.config:type_name -> knowledgebase_doc_service.ConnectorConfig
+	10, // 20: knowledgebase_doc_service.UploadDocVersionReq.docInfo:type_name -> knowledgebase_doc_service.DocFileInfo
+	61, // 21: knowledgebase_doc_service.GetDocVersionListResp.list:type_name -> knowledgebase_doc_service.DocVersionInfo
+	64, // 22: knowledgebase_doc_service.DiffDocVersionResp.list:type_name -> knowledgebase_doc_service.DocSegmentDiff
+	0,  // 23: knowledgebase_doc_service.KnowledgeBaseDocService.GetDocList:input_type -> knowledgebase_doc_service.GetDocListReq
+	2,  // 24: knowledgebase_doc_service.KnowledgeBaseDocService.ImportDoc:input_type -> knowledgebase_doc_service.ImportDocReq
+	4,  // 25: knowledgebase_doc_service.KnowledgeBaseDocService.UpdateDocStatus:input_type -> knowledgebase_doc_service.UpdateDocStatusReq
+	5,  // 26: knowledgebase_doc_service.KnowledgeBaseDocService.UpdateDocMetaData:input_type -> knowledgebase_doc_service.UpdateDocMetaDataReq
+	6,  // 27: knowledgebase_doc_service.KnowledgeBaseDocService.BatchUpdateDocMetaData:input_type -> knowledgebase_doc_service.BatchUpdateDocMetaDataReq
+	8,  // 28: knowledgebase_doc_service.KnowledgeBaseDocService.InitDocStatus:input_type -> knowledgebase_doc_service.InitDocStatusReq
+	9,  // 29: knowledgebase_doc_service.KnowledgeBaseDocService.DeleteDoc:input_type -> knowledgebase_doc_service.DeleteDocReq
+	12, // 30: knowledgebase_doc_service.KnowledgeBaseDocService.GetDocCategoryUploadTip:input_type -> knowledgebase_doc_service.DocImportTipReq
+	17, // 31: knowledgebase_doc_service.KnowledgeBaseDocService.GetDocSegmentList:input_type -> knowledgebase_doc_service.DocSegmentListReq
+	18, // 32: knowledgebase_doc_service.KnowledgeBaseDocService.UpdateDocSegmentStatus:input_type -> knowledgebase_doc_service.UpdateDocSegmentStatusReq
+	19, // 33: knowledgebase_doc_service.KnowledgeBaseDocService.AnalysisDocUrl:input_type -> knowledgebase_doc_service.AnalysisUrlDocReq
+	22, // 34: knowledgebase_doc_service.KnowledgeBaseDocService.UpdateDocSegmentLabels:input_type -> knowledgebase_doc_service.DocSegmentLabelsReq
+	23, // 35: knowledgebase_doc_service.KnowledgeBaseDocService.CreateDocSegment:input_type -> knowledgebase_doc_service.CreateDocSegmentReq
+	24, // 36: knowledgebase_doc_service.KnowledgeBaseDocService.BatchCreateDocSegment:input_type -> knowledgebase_doc_service.BatchCreateDocSegmentReq
+	30, // 37: knowledgebase_doc_service.KnowledgeBaseDocService.DeleteDocSegment:input_type -> knowledgebase_doc_service.DeleteDocSegmentReq
+	31, // 38: knowledgebase_doc_service.KnowledgeBaseDocService.UpdateDocSegment:input_type -> knowledgebase_doc_service.UpdateDocSegmentReq
+	32, // 39: knowledgebase_doc_service.KnowledgeBaseDocService.GetDocChildSegmentList:input_type -> knowledgebase_doc_service.GetDocChildSegmentListReq
+	35, // 40: knowledgebase_doc_service.KnowledgeBaseDocService.CreateDocChildSegment:input_type -> knowledgebase_doc_service.CreateDocChildSegmentReq
+	36, // 41: knowledgebase_doc_service.KnowledgeBaseDocService.DeleteDocChildSegment:input_type -> knowledgebase_doc_service.DeleteDocChildSegmentReq
+	37, // 42: knowledgebase_doc_service.KnowledgeBaseDocService.UpdateDocChildSegment:input_type -> knowledgebase_doc_service.UpdateDocChildSegmentReq
+	25, // 43: knowledgebase_doc_service.KnowledgeBaseDocService.ExportDocSegment:input_type -> knowledgebase_doc_service.ExportDocSegmentReq
+	27, // 44: knowledgebase_doc_service.KnowledgeBaseDocService.GetDocSegmentImportReport:input_type -> knowledgebase_doc_service.GetDocSegmentImportReportReq
+	39, // 45: knowledgebase_doc_service.KnowledgeBaseDocService.SaveDocSync:input_type -> knowledgebase_doc_service.SaveDocSyncReq
+	41, // 46: knowledgebase_doc_service.KnowledgeBaseDocService.DeleteDocSync:input_type -> knowledgebase_doc_service.DeleteDocSyncReq
+	42, // 47: knowledgebase_doc_service.KnowledgeBaseDocService.GetDocSyncList:input_type -> knowledgebase_doc_service.GetDocSyncListReq
+	45, // 48: knowledgebase_doc_service.KnowledgeBaseDocService.RunDocSync:input_type -> knowledgebase_doc_service.RunDocSyncReq
+	46, // 49: knowledgebase_doc_service.KnowledgeBaseDocService.GetDocSyncHistoryList:input_type -> knowledgebase_doc_service.GetDocSyncHistoryListReq
+	51, // 50: knowledgebase_doc_service.KnowledgeBaseDocService.SaveConnector:input_type -> knowledgebase_doc_service.SaveConnectorReq
+	53, // 51: knowledgebase_doc_service.KnowledgeBaseDocService.DeleteConnector:input_type -> knowledgebase_doc_service.DeleteConnectorReq
+	54, // 52: knowledgebase_doc_service.KnowledgeBaseDocService.GetConnectorList:input_type -> knowledgebase_doc_service.GetConnectorListReq
+	57, // 53: knowledgebase_doc_service.KnowledgeBaseDocService.RunConnector:input_type -> knowledgebase_doc_service.RunConnectorReq
+	58, // 54: knowledgebase_doc_service.KnowledgeBaseDocService.UploadDocVersion:input_type -> knowledgebase_doc_service.UploadDocVersionReq
+	59, // 55: knowledgebase_doc_service.KnowledgeBaseDocService.GetDocVersionList:input_type -> knowledgebase_doc_service.GetDocVersionListReq
+	62, // 56: knowledgebase_doc_service.KnowledgeBaseDocService.DiffDocVersion:input_type -> knowledgebase_doc_service.DiffDocVersionReq
+	65, // 57: knowledgebase_doc_service.KnowledgeBaseDocService.RollbackDocVersion:input_type -> knowledgebase_doc_service.RollbackDocVersionReq
+	1,  // 58: knowledgebase_doc_service.KnowledgeBaseDocService.GetDocList:output_type -> knowledgebase_doc_service.GetDocListResp
+	66, // 59: knowledgebase_doc_service.KnowledgeBaseDocService.ImportDoc:output_type -> google.protobuf.Empty
+	66, // 60: knowledgebase_doc_service.KnowledgeBaseDocService.UpdateDocStatus:output_type -> google.protobuf.Empty
+	66, // 61: knowledgebase_doc_service.KnowledgeBaseDocService.UpdateDocMetaData:output_type -> google.protobuf.Empty
+	66, // 62: knowledgebase_doc_service.KnowledgeBaseDocService.BatchUpdateDocMetaData:output_type -> google.protobuf.Empty
+	66, // 63: knowledgebase_doc_service.KnowledgeBaseDocService.InitDocStatus:output_type -> google.protobuf.Empty
+	66, // 64: knowledgebase_doc_service.KnowledgeBaseDocService.DeleteDoc:output_type -> google.protobuf.Empty
+	13, // 65: knowledgebase_doc_service.KnowledgeBaseDocService.GetDocCategoryUploadTip:output_type -> knowledgebase_doc_service.DocImportTipResp
+	14, // 66: knowledgebase_doc_service.KnowledgeBaseDocService.GetDocSegmentList:output_type -> knowledgebase_doc_service.DocSegmentListResp
+	66, // 67: knowledgebase_doc_service.KnowledgeBaseDocService.UpdateDocSegmentStatus:output_type -> google.protobuf.Empty
+	20, // 68: knowledgebase_doc_service.KnowledgeBaseDocService.AnalysisDocUrl:output_type -> knowledgebase_doc_service.AnalysisUrlDocResp
+	66, // 69: knowledgebase_doc_service.KnowledgeBaseDocService.UpdateDocSegmentLabels:output_type -> google.protobuf.Empty
+	66, // 70: knowledgebase_doc_service.KnowledgeBaseDocService.CreateDocSegment:output_type -> google.protobuf.Empty
+	66, // 71: knowledgebase_doc_service.KnowledgeBaseDocService.BatchCreateDocSegment:output_type -> google.protobuf.Empty
+	66, // 72: knowledgebase_doc_service.KnowledgeBaseDocService.DeleteDocSegment:output_type -> google.protobuf.Empty
+	66, // 73: knowledgebase_doc_service.KnowledgeBaseDocService.UpdateDocSegment:output_type -> google.protobuf.Empty
+	33, // 74: knowledgebase_doc_service.KnowledgeBaseDocService.GetDocChildSegmentList:output_type -> knowledgebase_doc_service.GetDocChildSegmentListResp
+	66, // 75: knowledgebase_doc_service.KnowledgeBaseDocService.CreateDocChildSegment:output_type -> google.protobuf.Empty
+	66, // 76: knowledgebase_doc_service.KnowledgeBaseDocService.DeleteDocChildSegment:output_type -> google.protobuf.Empty
+	66, // 77: knowledgebase_doc_service.KnowledgeBaseDocService.UpdateDocChildSegment:output_type -> google.protobuf.Empty
+	26, // 78: knowledgebase_doc_service.KnowledgeBaseDocService.ExportDocSegment:output_type -> knowledgebase_doc_service.ExportDocSegmentResp
+	28, // 79: knowledgebase_doc_service.KnowledgeBaseDocService.GetDocSegmentImportReport:output_type -> knowledgebase_doc_service.DocSegmentImportReport
+	40, // 80: knowledgebase_doc_service.KnowledgeBaseDocService.SaveDocSync:output_type -> knowledgebase_doc_service.SaveDocSyncResp
+	66, // 81: knowledgebase_doc_service.KnowledgeBaseDocService.DeleteDocSync:output_type -> google.protobuf.Empty
+	43, // 82: knowledgebase_doc_service.KnowledgeBaseDocService.GetDocSyncList:output_type -> knowledgebase_doc_service.GetDocSyncListResp
+	66, // 83: knowledgebase_doc_service.KnowledgeBaseDocService.RunDocSync:output_type -> google.protobuf.Empty
+	47, // 84: knowledgebase_doc_service.KnowledgeBaseDocService.GetDocSyncHistoryList:output_type -> knowledgebase_doc_service.GetDocSyncHistoryListResp
+	52, // 85: knowledgebase_doc_service.KnowledgeBaseDocService.SaveConnector:output_type -> knowledgebase_doc_service.SaveConnectorResp
+	66, // 86: knowledgebase_doc_service.KnowledgeBaseDocService.DeleteConnector:output_type -> google.protobuf.Empty
+	55, // 87: knowledgebase_doc_service.KnowledgeBaseDocService.GetConnectorList:output_type -> knowledgebase_doc_service.GetConnectorListResp
+	66, // 88: knowledgebase_doc_service.KnowledgeBaseDocService.RunConnector:output_type -> google.protobuf.Empty
+	66, // 89: knowledgebase_doc_service.KnowledgeBaseDocService.UploadDocVersion:output_type -> google.protobuf.Empty
+	60, // 90: knowledgebase_doc_service.KnowledgeBaseDocService.GetDocVersionList:output_type -> knowledgebase_doc_service.GetDocVersionListResp
+	63, // 91: knowledgebase_doc_service.KnowledgeBaseDocService.DiffDocVersion:output_type -> knowledgebase_doc_service.DiffDocVersionResp
+	66, // 92: knowledgebase_doc_service.KnowledgeBaseDocService.RollbackDocVersion:output_type -> google.protobuf.Empty
+	58, // [58:93] is the sub-list for method output_type
+	23, // [23:58] is the sub-list for method input_type
+	23, // [23:23] is the sub-list for extension type_name
+	23, // [23:23] is the sub-list for extension extendee
+	0,  // [0:23] is the sub-list for field type_name
 }
 
 func init() { file_proto_knowledgebase_doc_service_knowledgebase_doc_service_proto_init() }
@@ -3331,8 +6279,212 @@ func file_proto_knowledgebase_doc_service_knowledgebase_doc_service_proto_init()
 				return nil
 			}
 		}
-		file_proto_knowledgebase_doc_service_knowledgebase_doc_service_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetDocListResp); i {
+		file_proto_knowledgebase_doc_service_knowledgebase_doc_service_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetDocListResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_knowledgebase_doc_service_knowledgebase_doc_service_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ImportDocReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_knowledgebase_doc_service_knowledgebase_doc_service_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DocMetaData); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_knowledgebase_doc_service_knowledgebase_doc_service_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateDocStatusReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_knowledgebase_doc_service_knowledgebase_doc_service_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateDocMetaDataReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_knowledgebase_doc_service_knowledgebase_doc_service_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BatchUpdateDocMetaDataReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_knowledgebase_doc_service_knowledgebase_doc_service_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MetaData); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_knowledgebase_doc_service_knowledgebase_doc_service_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*InitDocStatusReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_knowledgebase_doc_service_knowledgebase_doc_service_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteDocReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_knowledgebase_doc_service_knowledgebase_doc_service_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DocFileInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_knowledgebase_doc_service_knowledgebase_doc_service_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DocSegment); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_knowledgebase_doc_service_knowledgebase_doc_service_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DocImportTipReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_knowledgebase_doc_service_knowledgebase_doc_service_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DocImportTipResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_knowledgebase_doc_service_knowledgebase_doc_service_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DocSegmentListResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_knowledgebase_doc_service_knowledgebase_doc_service_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SegmentContent); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_knowledgebase_doc_service_knowledgebase_doc_service_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DocInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_knowledgebase_doc_service_knowledgebase_doc_service_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DocSegmentListReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_knowledgebase_doc_service_knowledgebase_doc_service_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateDocSegmentStatusReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3343,8 +6495,8 @@ func file_proto_knowledgebase_doc_service_knowledgebase_doc_service_proto_init()
 				return nil
 			}
 		}
-		file_proto_knowledgebase_doc_service_knowledgebase_doc_service_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ImportDocReq); i {
+		file_proto_knowledgebase_doc_service_knowledgebase_doc_service_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AnalysisUrlDocReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3355,8 +6507,8 @@ func file_proto_knowledgebase_doc_service_knowledgebase_doc_service_proto_init()
 				return nil
 			}
 		}
-		file_proto_knowledgebase_doc_service_knowledgebase_doc_service_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DocMetaData); i {
+		file_proto_knowledgebase_doc_service_knowledgebase_doc_service_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AnalysisUrlDocResp); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3367,8 +6519,8 @@ func file_proto_knowledgebase_doc_service_knowledgebase_doc_service_proto_init()
 				return nil
 			}
 		}
-		file_proto_knowledgebase_doc_service_knowledgebase_doc_service_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UpdateDocStatusReq); i {
+		file_proto_knowledgebase_doc_service_knowledgebase_doc_service_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UrlInfo); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3379,8 +6531,8 @@ func file_proto_knowledgebase_doc_service_knowledgebase_doc_service_proto_init()
 				return nil
 			}
 		}
-		file_proto_knowledgebase_doc_service_knowledgebase_doc_service_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UpdateDocMetaDataReq); i {
+		file_proto_knowledgebase_doc_service_knowledgebase_doc_service_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DocSegmentLabelsReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3391,8 +6543,8 @@ func file_proto_knowledgebase_doc_service_knowledgebase_doc_service_proto_init()
 				return nil
 			}
 		}
-		file_proto_knowledgebase_doc_service_knowledgebase_doc_service_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*BatchUpdateDocMetaDataReq); i {
+		file_proto_knowledgebase_doc_service_knowledgebase_doc_service_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateDocSegmentReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3403,8 +6555,8 @@ func file_proto_knowledgebase_doc_service_knowledgebase_doc_service_proto_init()
 				return nil
 			}
 		}
-		file_proto_knowledgebase_doc_service_knowledgebase_doc_service_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MetaData); i {
+		file_proto_knowledgebase_doc_service_knowledgebase_doc_service_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BatchCreateDocSegmentReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3415,8 +6567,8 @@ func file_proto_knowledgebase_doc_service_knowledgebase_doc_service_proto_init()
 				return nil
 			}
 		}
-		file_proto_knowledgebase_doc_service_knowledgebase_doc_service_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*InitDocStatusReq); i {
+		file_proto_knowledgebase_doc_service_knowledgebase_doc_service_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExportDocSegmentReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3427,8 +6579,8 @@ func file_proto_knowledgebase_doc_service_knowledgebase_doc_service_proto_init()
 				return nil
 			}
 		}
-		file_proto_knowledgebase_doc_service_knowledgebase_doc_service_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DeleteDocReq); i {
+		file_proto_knowledgebase_doc_service_knowledgebase_doc_service_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExportDocSegmentResp); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3439,8 +6591,8 @@ func file_proto_knowledgebase_doc_service_knowledgebase_doc_service_proto_init()
 				return nil
 			}
 		}
-		file_proto_knowledgebase_doc_service_knowledgebase_doc_service_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DocFileInfo); i {
+		file_proto_knowledgebase_doc_service_knowledgebase_doc_service_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetDocSegmentImportReportReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3451,8 +6603,8 @@ func file_proto_knowledgebase_doc_service_knowledgebase_doc_service_proto_init()
 				return nil
 			}
 		}
-		file_proto_knowledgebase_doc_service_knowledgebase_doc_service_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DocSegment); i {
+		file_proto_knowledgebase_doc_service_knowledgebase_doc_service_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DocSegmentImportReport); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3463,8 +6615,8 @@ func file_proto_knowledgebase_doc_service_knowledgebase_doc_service_proto_init()
 				return nil
 			}
 		}
-		file_proto_knowledgebase_doc_service_knowledgebase_doc_service_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DocImportTipReq); i {
+		file_proto_knowledgebase_doc_service_knowledgebase_doc_service_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DocSegmentImportRowError); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3475,8 +6627,8 @@ func file_proto_knowledgebase_doc_service_knowledgebase_doc_service_proto_init()
 				return nil
 			}
 		}
-		file_proto_knowledgebase_doc_service_knowledgebase_doc_service_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DocImportTipResp); i {
+		file_proto_knowledgebase_doc_service_knowledgebase_doc_service_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteDocSegmentReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3487,8 +6639,8 @@ func file_proto_knowledgebase_doc_service_knowledgebase_doc_service_proto_init()
 				return nil
 			}
 		}
-		file_proto_knowledgebase_doc_service_knowledgebase_doc_service_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DocSegmentListResp); i {
+		file_proto_knowledgebase_doc_service_knowledgebase_doc_service_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateDocSegmentReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3499,8 +6651,8 @@ func file_proto_knowledgebase_doc_service_knowledgebase_doc_service_proto_init()
 				return nil
 			}
 		}
-		file_proto_knowledgebase_doc_service_knowledgebase_doc_service_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SegmentContent); i {
+		file_proto_knowledgebase_doc_service_knowledgebase_doc_service_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetDocChildSegmentListReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3511,8 +6663,8 @@ func file_proto_knowledgebase_doc_service_knowledgebase_doc_service_proto_init()
 				return nil
 			}
 		}
-		file_proto_knowledgebase_doc_service_knowledgebase_doc_service_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DocInfo); i {
+		file_proto_knowledgebase_doc_service_knowledgebase_doc_service_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetDocChildSegmentListResp); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3523,8 +6675,8 @@ func file_proto_knowledgebase_doc_service_knowledgebase_doc_service_proto_init()
 				return nil
 			}
 		}
-		file_proto_knowledgebase_doc_service_knowledgebase_doc_service_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DocSegmentListReq); i {
+		file_proto_knowledgebase_doc_service_knowledgebase_doc_service_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ChildSegmentInfo); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3535,8 +6687,8 @@ func file_proto_knowledgebase_doc_service_knowledgebase_doc_service_proto_init()
 				return nil
 			}
 		}
-		file_proto_knowledgebase_doc_service_knowledgebase_doc_service_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UpdateDocSegmentStatusReq); i {
+		file_proto_knowledgebase_doc_service_knowledgebase_doc_service_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateDocChildSegmentReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3547,8 +6699,8 @@ func file_proto_knowledgebase_doc_service_knowledgebase_doc_service_proto_init()
 				return nil
 			}
 		}
-		file_proto_knowledgebase_doc_service_knowledgebase_doc_service_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AnalysisUrlDocReq); i {
+		file_proto_knowledgebase_doc_service_knowledgebase_doc_service_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteDocChildSegmentReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3559,8 +6711,8 @@ func file_proto_knowledgebase_doc_service_knowledgebase_doc_service_proto_init()
 				return nil
 			}
 		}
-		file_proto_knowledgebase_doc_service_knowledgebase_doc_service_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AnalysisUrlDocResp); i {
+		file_proto_knowledgebase_doc_service_knowledgebase_doc_service_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateDocChildSegmentReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3571,8 +6723,8 @@ func file_proto_knowledgebase_doc_service_knowledgebase_doc_service_proto_init()
 				return nil
 			}
 		}
-		file_proto_knowledgebase_doc_service_knowledgebase_doc_service_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UrlInfo); i {
+		file_proto_knowledgebase_doc_service_knowledgebase_doc_service_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ChildChunk); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3583,8 +6735,8 @@ func file_proto_knowledgebase_doc_service_knowledgebase_doc_service_proto_init()
 				return nil
 			}
 		}
-		file_proto_knowledgebase_doc_service_knowledgebase_doc_service_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DocSegmentLabelsReq); i {
+		file_proto_knowledgebase_doc_service_knowledgebase_doc_service_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SaveDocSyncReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3595,8 +6747,8 @@ func file_proto_knowledgebase_doc_service_knowledgebase_doc_service_proto_init()
 				return nil
 			}
 		}
-		file_proto_knowledgebase_doc_service_knowledgebase_doc_service_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateDocSegmentReq); i {
+		file_proto_knowledgebase_doc_service_knowledgebase_doc_service_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SaveDocSyncResp); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3607,8 +6759,8 @@ func file_proto_knowledgebase_doc_service_knowledgebase_doc_service_proto_init()
 				return nil
 			}
 		}
-		file_proto_knowledgebase_doc_service_knowledgebase_doc_service_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*BatchCreateDocSegmentReq); i {
+		file_proto_knowledgebase_doc_service_knowledgebase_doc_service_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteDocSyncReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3619,8 +6771,8 @@ func file_proto_knowledgebase_doc_service_knowledgebase_doc_service_proto_init()
 				return nil
 			}
 		}
-		file_proto_knowledgebase_doc_service_knowledgebase_doc_service_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DeleteDocSegmentReq); i {
+		file_proto_knowledgebase_doc_service_knowledgebase_doc_service_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetDocSyncListReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3631,8 +6783,8 @@ func file_proto_knowledgebase_doc_service_knowledgebase_doc_service_proto_init()
 				return nil
 			}
 		}
-		file_proto_knowledgebase_doc_service_knowledgebase_doc_service_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UpdateDocSegmentReq); i {
+		file_proto_knowledgebase_doc_service_knowledgebase_doc_service_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetDocSyncListResp); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3643,8 +6795,8 @@ func file_proto_knowledgebase_doc_service_knowledgebase_doc_service_proto_init()
 				return nil
 			}
 		}
-		file_proto_knowledgebase_doc_service_knowledgebase_doc_service_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetDocChildSegmentListReq); i {
+		file_proto_knowledgebase_doc_service_knowledgebase_doc_service_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DocSyncInfo); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3655,8 +6807,8 @@ func file_proto_knowledgebase_doc_service_knowledgebase_doc_service_proto_init()
 				return nil
 			}
 		}
-		file_proto_knowledgebase_doc_service_knowledgebase_doc_service_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetDocChildSegmentListResp); i {
+		file_proto_knowledgebase_doc_service_knowledgebase_doc_service_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RunDocSyncReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3667,8 +6819,8 @@ func file_proto_knowledgebase_doc_service_knowledgebase_doc_service_proto_init()
 				return nil
 			}
 		}
-		file_proto_knowledgebase_doc_service_knowledgebase_doc_service_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ChildSegmentInfo); i {
+		file_proto_knowledgebase_doc_service_knowledgebase_doc_service_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetDocSyncHistoryListReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3679,8 +6831,8 @@ func file_proto_knowledgebase_doc_service_knowledgebase_doc_service_proto_init()
 				return nil
 			}
 		}
-		file_proto_knowledgebase_doc_service_knowledgebase_doc_service_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateDocChildSegmentReq); i {
+		file_proto_knowledgebase_doc_service_knowledgebase_doc_service_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetDocSyncHistoryListResp); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3691,8 +6843,8 @@ func file_proto_knowledgebase_doc_service_knowledgebase_doc_service_proto_init()
 				return nil
 			}
 		}
-		file_proto_knowledgebase_doc_service_knowledgebase_doc_service_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DeleteDocChildSegmentReq); i {
+		file_proto_knowledgebase_doc_service_knowledgebase_doc_service_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DocSyncHistoryInfo); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3703,8 +6855,8 @@ func file_proto_knowledgebase_doc_service_knowledgebase_doc_service_proto_init()
 				return nil
 			}
 		}
-		file_proto_knowledgebase_doc_service_knowledgebase_doc_service_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UpdateDocChildSegmentReq); i {
+		file_proto_knowledgebase_doc_service_knowledgebase_doc_service_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ConnectorConfig); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3715,8 +6867,188 @@ func file_proto_knowledgebase_doc_service_knowledgebase_doc_service_proto_init()
 				return nil
 			}
 		}
-		file_proto_knowledgebase_doc_service_knowledgebase_doc_service_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ChildChunk); i {
+		file_proto_knowledgebase_doc_service_knowledgebase_doc_service_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ConnectorCredential); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_knowledgebase_doc_service_knowledgebase_doc_service_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SaveConnectorReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_knowledgebase_doc_service_knowledgebase_doc_service_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SaveConnectorResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_knowledgebase_doc_service_knowledgebase_doc_service_proto_msgTypes[53].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteConnectorReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_knowledgebase_doc_service_knowledgebase_doc_service_proto_msgTypes[54].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetConnectorListReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_knowledgebase_doc_service_knowledgebase_doc_service_proto_msgTypes[55].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetConnectorListResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_knowledgebase_doc_service_knowledgebase_doc_service_proto_msgTypes[56].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ConnectorInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_knowledgebase_doc_service_knowledgebase_doc_service_proto_msgTypes[57].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RunConnectorReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_knowledgebase_doc_service_knowledgebase_doc_service_proto_msgTypes[58].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UploadDocVersionReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_knowledgebase_doc_service_knowledgebase_doc_service_proto_msgTypes[59].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetDocVersionListReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_knowledgebase_doc_service_knowledgebase_doc_service_proto_msgTypes[60].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetDocVersionListResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_knowledgebase_doc_service_knowledgebase_doc_service_proto_msgTypes[61].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DocVersionInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_knowledgebase_doc_service_knowledgebase_doc_service_proto_msgTypes[62].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DiffDocVersionReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_knowledgebase_doc_service_knowledgebase_doc_service_proto_msgTypes[63].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DiffDocVersionResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_knowledgebase_doc_service_knowledgebase_doc_service_proto_msgTypes[64].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DocSegmentDiff); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_knowledgebase_doc_service_knowledgebase_doc_service_proto_msgTypes[65].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RollbackDocVersionReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3734,7 +7066,7 @@ func file_proto_knowledgebase_doc_service_knowledgebase_doc_service_proto_init()
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_proto_knowledgebase_doc_service_knowledgebase_doc_service_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   34,
+			NumMessages:   66,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -20,26 +20,41 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	KnowledgeBaseDocService_GetDocList_FullMethodName              = "/knowledgebase_doc_service.KnowledgeBaseDocService/GetDocList"
-	KnowledgeBaseDocService_ImportDoc_FullMethodName               = "/knowledgebase_doc_service.KnowledgeBaseDocService/ImportDoc"
-	KnowledgeBaseDocService_UpdateDocStatus_FullMethodName         = "/knowledgebase_doc_service.KnowledgeBaseDocService/UpdateDocStatus"
-	KnowledgeBaseDocService_UpdateDocMetaData_FullMethodName       = "/knowledgebase_doc_service.KnowledgeBaseDocService/UpdateDocMetaData"
-	KnowledgeBaseDocService_BatchUpdateDocMetaData_FullMethodName  = "/knowledgebase_doc_service.KnowledgeBaseDocService/BatchUpdateDocMetaData"
-	KnowledgeBaseDocService_InitDocStatus_FullMethodName           = "/knowledgebase_doc_service.KnowledgeBaseDocService/InitDocStatus"
-	KnowledgeBaseDocService_DeleteDoc_FullMethodName               = "/knowledgebase_doc_service.KnowledgeBaseDocService/DeleteDoc"
-	KnowledgeBaseDocService_GetDocCategoryUploadTip_FullMethodName = "/knowledgebase_doc_service.KnowledgeBaseDocService/GetDocCategoryUploadTip"
-	KnowledgeBaseDocService_GetDocSegmentList_FullMethodName       = "/knowledgebase_doc_service.KnowledgeBaseDocService/GetDocSegmentList"
-	KnowledgeBaseDocService_UpdateDocSegmentStatus_FullMethodName  = "/knowledgebase_doc_service.KnowledgeBaseDocService/UpdateDocSegmentStatus"
-	KnowledgeBaseDocService_AnalysisDocUrl_FullMethodName          = "/knowledgebase_doc_service.KnowledgeBaseDocService/AnalysisDocUrl"
-	KnowledgeBaseDocService_UpdateDocSegmentLabels_FullMethodName  = "/knowledgebase_doc_service.KnowledgeBaseDocService/UpdateDocSegmentLabels"
-	KnowledgeBaseDocService_CreateDocSegment_FullMethodName        = "/knowledgebase_doc_service.KnowledgeBaseDocService/CreateDocSegment"
-	KnowledgeBaseDocService_BatchCreateDocSegment_FullMethodName   = "/knowledgebase_doc_service.KnowledgeBaseDocService/BatchCreateDocSegment"
-	KnowledgeBaseDocService_DeleteDocSegment_FullMethodName        = "/knowledgebase_doc_service.KnowledgeBaseDocService/DeleteDocSegment"
-	KnowledgeBaseDocService_UpdateDocSegment_FullMethodName        = "/knowledgebase_doc_service.KnowledgeBaseDocService/UpdateDocSegment"
-	KnowledgeBaseDocService_GetDocChildSegmentList_FullMethodName  = "/knowledgebase_doc_service.KnowledgeBaseDocService/GetDocChildSegmentList"
-	KnowledgeBaseDocService_CreateDocChildSegment_FullMethodName   = "/knowledgebase_doc_service.KnowledgeBaseDocService/CreateDocChildSegment"
-	KnowledgeBaseDocService_DeleteDocChildSegment_FullMethodName   = "/knowledgebase_doc_service.KnowledgeBaseDocService/DeleteDocChildSegment"
-	KnowledgeBaseDocService_UpdateDocChildSegment_FullMethodName   = "/knowledgebase_doc_service.KnowledgeBaseDocService/UpdateDocChildSegment"
+	KnowledgeBaseDocService_GetDocList_FullMethodName                = "/knowledgebase_doc_service.KnowledgeBaseDocService/GetDocList"
+	KnowledgeBaseDocService_ImportDoc_FullMethodName                 = "/knowledgebase_doc_service.KnowledgeBaseDocService/ImportDoc"
+	KnowledgeBaseDocService_UpdateDocStatus_FullMethodName           = "/knowledgebase_doc_service.KnowledgeBaseDocService/UpdateDocStatus"
+	KnowledgeBaseDocService_UpdateDocMetaData_FullMethodName         = "/knowledgebase_doc_service.KnowledgeBaseDocService/UpdateDocMetaData"
+	KnowledgeBaseDocService_BatchUpdateDocMetaData_FullMethodName    = "/knowledgebase_doc_service.KnowledgeBaseDocService/BatchUpdateDocMetaData"
+	KnowledgeBaseDocService_InitDocStatus_FullMethodName             = "/knowledgebase_doc_service.KnowledgeBaseDocService/InitDocStatus"
+	KnowledgeBaseDocService_DeleteDoc_FullMethodName                 = "/knowledgebase_doc_service.KnowledgeBaseDocService/DeleteDoc"
+	KnowledgeBaseDocService_GetDocCategoryUploadTip_FullMethodName   = "/knowledgebase_doc_service.KnowledgeBaseDocService/GetDocCategoryUploadTip"
+	KnowledgeBaseDocService_GetDocSegmentList_FullMethodName         = "/knowledgebase_doc_service.KnowledgeBaseDocService/GetDocSegmentList"
+	KnowledgeBaseDocService_UpdateDocSegmentStatus_FullMethodName    = "/knowledgebase_doc_service.KnowledgeBaseDocService/UpdateDocSegmentStatus"
+	KnowledgeBaseDocService_AnalysisDocUrl_FullMethodName            = "/knowledgebase_doc_service.KnowledgeBaseDocService/AnalysisDocUrl"
+	KnowledgeBaseDocService_UpdateDocSegmentLabels_FullMethodName    = "/knowledgebase_doc_service.KnowledgeBaseDocService/UpdateDocSegmentLabels"
+	KnowledgeBaseDocService_CreateDocSegment_FullMethodName          = "/knowledgebase_doc_service.KnowledgeBaseDocService/CreateDocSegment"
+	KnowledgeBaseDocService_BatchCreateDocSegment_FullMethodName     = "/knowledgebase_doc_service.KnowledgeBaseDocService/BatchCreateDocSegment"
+	KnowledgeBaseDocService_DeleteDocSegment_FullMethodName          = "/knowledgebase_doc_service.KnowledgeBaseDocService/DeleteDocSegment"
+	KnowledgeBaseDocService_UpdateDocSegment_FullMethodName          = "/knowledgebase_doc_service.KnowledgeBaseDocService/UpdateDocSegment"
+	KnowledgeBaseDocService_GetDocChildSegmentList_FullMethodName    = "/knowledgebase_doc_service.KnowledgeBaseDocService/GetDocChildSegmentList"
+	KnowledgeBaseDocService_CreateDocChildSegment_FullMethodName     = "/knowledgebase_doc_service.KnowledgeBaseDocService/CreateDocChildSegment"
+	KnowledgeBaseDocService_DeleteDocChildSegment_FullMethodName     = "/knowledgebase_doc_service.KnowledgeBaseDocService/DeleteDocChildSegment"
+	KnowledgeBaseDocService_UpdateDocChildSegment_FullMethodName     = "/knowledgebase_doc_service.KnowledgeBaseDocService/UpdateDocChildSegment"
+	KnowledgeBaseDocService_ExportDocSegment_FullMethodName          = "/knowledgebase_doc_service.KnowledgeBaseDocService/ExportDocSegment"
+	KnowledgeBaseDocService_GetDocSegmentImportReport_FullMethodName = "/knowledgebase_doc_service.KnowledgeBaseDocService/GetDocSegmentImportReport"
+	KnowledgeBaseDocService_SaveDocSync_FullMethodName               = "/knowledgebase_doc_service.KnowledgeBaseDocService/SaveDocSync"
+	KnowledgeBaseDocService_DeleteDocSync_FullMethodName             = "/knowledgebase_doc_service.KnowledgeBaseDocService/DeleteDocSync"
+	KnowledgeBaseDocService_GetDocSyncList_FullMethodName            = "/knowledgebase_doc_service.KnowledgeBaseDocService/GetDocSyncList"
+	KnowledgeBaseDocService_RunDocSync_FullMethodName                = "/knowledgebase_doc_service.KnowledgeBaseDocService/RunDocSync"
+	KnowledgeBaseDocService_GetDocSyncHistoryList_FullMethodName     = "/knowledgebase_doc_service.KnowledgeBaseDocService/GetDocSyncHistoryList"
+	KnowledgeBaseDocService_SaveConnector_FullMethodName             = "/knowledgebase_doc_service.KnowledgeBaseDocService/SaveConnector"
+	KnowledgeBaseDocService_DeleteConnector_FullMethodName           = "/knowledgebase_doc_service.KnowledgeBaseDocService/DeleteConnector"
+	KnowledgeBaseDocService_GetConnectorList_FullMethodName          = "/knowledgebase_doc_service.KnowledgeBaseDocService/GetConnectorList"
+	KnowledgeBaseDocService_RunConnector_FullMethodName              = "/knowledgebase_doc_service.KnowledgeBaseDocService/RunConnector"
+	KnowledgeBaseDocService_UploadDocVersion_FullMethodName          = "/knowledgebase_doc_service.KnowledgeBaseDocService/UploadDocVersion"
+	KnowledgeBaseDocService_GetDocVersionList_FullMethodName         = "/knowledgebase_doc_service.KnowledgeBaseDocService/GetDocVersionList"
+	KnowledgeBaseDocService_DiffDocVersion_FullMethodName            = "/knowledgebase_doc_service.KnowledgeBaseDocService/DiffDocVersion"
+	KnowledgeBaseDocService_RollbackDocVersion_FullMethodName        = "/knowledgebase_doc_service.KnowledgeBaseDocService/RollbackDocVersion"
 )
 
 // KnowledgeBaseDocServiceClient is the client API for KnowledgeBaseDocService service.
@@ -86,6 +101,36 @@ type KnowledgeBaseDocServiceClient interface {
 	DeleteDocChildSegment(ctx context.Context, in *DeleteDocChildSegmentReq, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	// 更新文档子分片
 	UpdateDocChildSegment(ctx context.Context, in *UpdateDocChildSegmentReq, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// 导出文档分段，支持单个文档或整个知识库
+	ExportDocSegment(ctx context.Context, in *ExportDocSegmentReq, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ExportDocSegmentResp], error)
+	// 查询文档分段最近一次批量导入结果
+	GetDocSegmentImportReport(ctx context.Context, in *GetDocSegmentImportReportReq, opts ...grpc.CallOption) (*DocSegmentImportReport, error)
+	// 设置url文档定时同步
+	SaveDocSync(ctx context.Context, in *SaveDocSyncReq, opts ...grpc.CallOption) (*SaveDocSyncResp, error)
+	// 删除url文档定时同步
+	DeleteDocSync(ctx context.Context, in *DeleteDocSyncReq, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// 获取url文档定时同步列表
+	GetDocSyncList(ctx context.Context, in *GetDocSyncListReq, opts ...grpc.CallOption) (*GetDocSyncListResp, error)
+	// 立即执行url文档同步
+	RunDocSync(ctx context.Context, in *RunDocSyncReq, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// 获取url文档同步记录
+	GetDocSyncHistoryList(ctx context.Context, in *GetDocSyncHistoryListReq, opts ...grpc.CallOption) (*GetDocSyncHistoryListResp, error)
+	// 创建或更新知识库连接器
+	SaveConnector(ctx context.Context, in *SaveConnectorReq, opts ...grpc.CallOption) (*SaveConnectorResp, error)
+	// 删除知识库连接器
+	DeleteConnector(ctx context.Context, in *DeleteConnectorReq, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// 查询知识库连接器列表
+	GetConnectorList(ctx context.Context, in *GetConnectorListReq, opts ...grpc.CallOption) (*GetConnectorListResp, error)
+	// 执行知识库连接器增量导入
+	RunConnector(ctx context.Context, in *RunConnectorReq, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// 上传文档新版本
+	UploadDocVersion(ctx context.Context, in *UploadDocVersionReq, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// 查询文档版本列表
+	GetDocVersionList(ctx context.Context, in *GetDocVersionListReq, opts ...grpc.CallOption) (*GetDocVersionListResp, error)
+	// 对比文档版本切片差异
+	DiffDocVersion(ctx context.Context, in *DiffDocVersionReq, opts ...grpc.CallOption) (*DiffDocVersionResp, error)
+	// 回滚文档到历史版本
+	RollbackDocVersion(ctx context.Context, in *RollbackDocVersionReq, opts ...grpc.CallOption) (*emptypb.Empty, error)
 }
 
 type knowledgeBaseDocServiceClient struct {
@@ -296,6 +341,165 @@ func (c *knowledgeBaseDocServiceClient) UpdateDocChildSegment(ctx context.Contex
 	return out, nil
 }
 
+func (c *knowledgeBaseDocServiceClient) ExportDocSegment(ctx context.Context, in *ExportDocSegmentReq, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ExportDocSegmentResp], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &KnowledgeBaseDocService_ServiceDesc.Streams[0], KnowledgeBaseDocService_ExportDocSegment_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[ExportDocSegmentReq, ExportDocSegmentResp]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type KnowledgeBaseDocService_ExportDocSegmentClient = grpc.ServerStreamingClient[ExportDocSegmentResp]
+
+func (c *knowledgeBaseDocServiceClient) GetDocSegmentImportReport(ctx context.Context, in *GetDocSegmentImportReportReq, opts ...grpc.CallOption) (*DocSegmentImportReport, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DocSegmentImportReport)
+	err := c.cc.Invoke(ctx, KnowledgeBaseDocService_GetDocSegmentImportReport_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *knowledgeBaseDocServiceClient) SaveDocSync(ctx context.Context, in *SaveDocSyncReq, opts ...grpc.CallOption) (*SaveDocSyncResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SaveDocSyncResp)
+	err := c.cc.Invoke(ctx, KnowledgeBaseDocService_SaveDocSync_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *knowledgeBaseDocServiceClient) DeleteDocSync(ctx context.Context, in *DeleteDocSyncReq, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, KnowledgeBaseDocService_DeleteDocSync_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *knowledgeBaseDocServiceClient) GetDocSyncList(ctx context.Context, in *GetDocSyncListReq, opts ...grpc.CallOption) (*GetDocSyncListResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetDocSyncListResp)
+	err := c.cc.Invoke(ctx, KnowledgeBaseDocService_GetDocSyncList_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *knowledgeBaseDocServiceClient) RunDocSync(ctx context.Context, in *RunDocSyncReq, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, KnowledgeBaseDocService_RunDocSync_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *knowledgeBaseDocServiceClient) GetDocSyncHistoryList(ctx context.Context, in *GetDocSyncHistoryListReq, opts ...grpc.CallOption) (*GetDocSyncHistoryListResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetDocSyncHistoryListResp)
+	err := c.cc.Invoke(ctx, KnowledgeBaseDocService_GetDocSyncHistoryList_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *knowledgeBaseDocServiceClient) SaveConnector(ctx context.Context, in *SaveConnectorReq, opts ...grpc.CallOption) (*SaveConnectorResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SaveConnectorResp)
+	err := c.cc.Invoke(ctx, KnowledgeBaseDocService_SaveConnector_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *knowledgeBaseDocServiceClient) DeleteConnector(ctx context.Context, in *DeleteConnectorReq, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, KnowledgeBaseDocService_DeleteConnector_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *knowledgeBaseDocServiceClient) GetConnectorList(ctx context.Context, in *GetConnectorListReq, opts ...grpc.CallOption) (*GetConnectorListResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetConnectorListResp)
+	err := c.cc.Invoke(ctx, KnowledgeBaseDocService_GetConnectorList_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *knowledgeBaseDocServiceClient) RunConnector(ctx context.Context, in *RunConnectorReq, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, KnowledgeBaseDocService_RunConnector_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *knowledgeBaseDocServiceClient) UploadDocVersion(ctx context.Context, in *UploadDocVersionReq, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, KnowledgeBaseDocService_UploadDocVersion_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *knowledgeBaseDocServiceClient) GetDocVersionList(ctx context.Context, in *GetDocVersionListReq, opts ...grpc.CallOption) (*GetDocVersionListResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetDocVersionListResp)
+	err := c.cc.Invoke(ctx, KnowledgeBaseDocService_GetDocVersionList_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *knowledgeBaseDocServiceClient) DiffDocVersion(ctx context.Context, in *DiffDocVersionReq, opts ...grpc.CallOption) (*DiffDocVersionResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DiffDocVersionResp)
+	err := c.cc.Invoke(ctx, KnowledgeBaseDocService_DiffDocVersion_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *knowledgeBaseDocServiceClient) RollbackDocVersion(ctx context.Context, in *RollbackDocVersionReq, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, KnowledgeBaseDocService_RollbackDocVersion_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // KnowledgeBaseDocServiceServer is the server API for KnowledgeBaseDocService service.
 // All implementations must embed UnimplementedKnowledgeBaseDocServiceServer
 // for forward compatibility.
@@ -340,6 +544,36 @@ type KnowledgeBaseDocServiceServer interface {
 	DeleteDocChildSegment(context.Context, *DeleteDocChildSegmentReq) (*emptypb.Empty, error)
 	// 更新文档子分片
 	UpdateDocChildSegment(context.Context, *UpdateDocChildSegmentReq) (*emptypb.Empty, error)
+	// 导出文档分段，支持单个文档或整个知识库
+	ExportDocSegment(*ExportDocSegmentReq, grpc.ServerStreamingServer[ExportDocSegmentResp]) error
+	// 查询文档分段最近一次批量导入结果
+	GetDocSegmentImportReport(context.Context, *GetDocSegmentImportReportReq) (*DocSegmentImportReport, error)
+	// 设置url文档定时同步
+	SaveDocSync(context.Context, *SaveDocSyncReq) (*SaveDocSyncResp, error)
+	// 删除url文档定时同步
+	DeleteDocSync(context.Context, *DeleteDocSyncReq) (*emptypb.Empty, error)
+	// 获取url文档定时同步列表
+	GetDocSyncList(context.Context, *GetDocSyncListReq) (*GetDocSyncListResp, error)
+	// 立即执行url文档同步
+	RunDocSync(context.Context, *RunDocSyncReq) (*emptypb.Empty, error)
+	// 获取url文档同步记录
+	GetDocSyncHistoryList(context.Context, *GetDocSyncHistoryListReq) (*GetDocSyncHistoryListResp, error)
+	// 创建或更新知识库连接器
+	SaveConnector(context.Context, *SaveConnectorReq) (*SaveConnectorResp, error)
+	// 删除知识库连接器
+	DeleteConnector(context.Context, *DeleteConnectorReq) (*emptypb.Empty, error)
+	// 查询知识库连接器列表
+	GetConnectorList(context.Context, *GetConnectorListReq) (*GetConnectorListResp, error)
+	// 执行知识库连接器增量导入
+	RunConnector(context.Context, *RunConnectorReq) (*emptypb.Empty, error)
+	// 上传文档新版本
+	UploadDocVersion(context.Context, *UploadDocVersionReq) (*emptypb.Empty, error)
+	// 查询文档版本列表
+	GetDocVersionList(context.Context, *GetDocVersionListReq) (*GetDocVersionListResp, error)
+	// 对比文档版本切片差异
+	DiffDocVersion(context.Context, *DiffDocVersionReq) (*DiffDocVersionResp, error)
+	// 回滚文档到历史版本
+	RollbackDocVersion(context.Context, *RollbackDocVersionReq) (*emptypb.Empty, error)
 	mustEmbedUnimplementedKnowledgeBaseDocServiceServer()
 }
 
@@ -410,6 +644,51 @@ func (UnimplementedKnowledgeBaseDocServiceServer) DeleteDocChildSegment(context.
 func (UnimplementedKnowledgeBaseDocServiceServer) UpdateDocChildSegment(context.Context, *UpdateDocChildSegmentReq) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateDocChildSegment not implemented")
 }
+func (UnimplementedKnowledgeBaseDocServiceServer) ExportDocSegment(*ExportDocSegmentReq, grpc.ServerStreamingServer[ExportDocSegmentResp]) error {
+	return status.Errorf(codes.Unimplemented, "method ExportDocSegment not implemented")
+}
+func (UnimplementedKnowledgeBaseDocServiceServer) GetDocSegmentImportReport(context.Context, *GetDocSegmentImportReportReq) (*DocSegmentImportReport, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDocSegmentImportReport not implemented")
+}
+func (UnimplementedKnowledgeBaseDocServiceServer) SaveDocSync(context.Context, *SaveDocSyncReq) (*SaveDocSyncResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SaveDocSync not implemented")
+}
+func (UnimplementedKnowledgeBaseDocServiceServer) DeleteDocSync(context.Context, *DeleteDocSyncReq) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteDocSync not implemented")
+}
+func (UnimplementedKnowledgeBaseDocServiceServer) GetDocSyncList(context.Context, *GetDocSyncListReq) (*GetDocSyncListResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDocSyncList not implemented")
+}
+func (UnimplementedKnowledgeBaseDocServiceServer) RunDocSync(context.Context, *RunDocSyncReq) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RunDocSync not implemented")
+}
+func (UnimplementedKnowledgeBaseDocServiceServer) GetDocSyncHistoryList(context.Context, *GetDocSyncHistoryListReq) (*GetDocSyncHistoryListResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDocSyncHistoryList not implemented")
+}
+func (UnimplementedKnowledgeBaseDocServiceServer) SaveConnector(context.Context, *SaveConnectorReq) (*SaveConnectorResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SaveConnector not implemented")
+}
+func (UnimplementedKnowledgeBaseDocServiceServer) DeleteConnector(context.Context, *DeleteConnectorReq) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteConnector not implemented")
+}
+func (UnimplementedKnowledgeBaseDocServiceServer) GetConnectorList(context.Context, *GetConnectorListReq) (*GetConnectorListResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetConnectorList not implemented")
+}
+func (UnimplementedKnowledgeBaseDocServiceServer) RunConnector(context.Context, *RunConnectorReq) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RunConnector not implemented")
+}
+func (UnimplementedKnowledgeBaseDocServiceServer) UploadDocVersion(context.Context, *UploadDocVersionReq) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UploadDocVersion not implemented")
+}
+func (UnimplementedKnowledgeBaseDocServiceServer) GetDocVersionList(context.Context, *GetDocVersionListReq) (*GetDocVersionListResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDocVersionList not implemented")
+}
+func (UnimplementedKnowledgeBaseDocServiceServer) DiffDocVersion(context.Context, *DiffDocVersionReq) (*DiffDocVersionResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DiffDocVersion not implemented")
+}
+func (UnimplementedKnowledgeBaseDocServiceServer) RollbackDocVersion(context.Context, *RollbackDocVersionReq) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RollbackDocVersion not implemented")
+}
 func (UnimplementedKnowledgeBaseDocServiceServer) mustEmbedUnimplementedKnowledgeBaseDocServiceServer() {
 }
 func (UnimplementedKnowledgeBaseDocServiceServer) testEmbeddedByValue() {}
@@ -792,6 +1071,269 @@ func _KnowledgeBaseDocService_UpdateDocChildSegment_Handler(srv interface{}, ctx
 	return interceptor(ctx, in, info, handler)
 }
 
+func _KnowledgeBaseDocService_ExportDocSegment_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ExportDocSegmentReq)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(KnowledgeBaseDocServiceServer).ExportDocSegment(m, &grpc.GenericServerStream[ExportDocSegmentReq, ExportDocSegmentResp]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type KnowledgeBaseDocService_ExportDocSegmentServer = grpc.ServerStreamingServer[ExportDocSegmentResp]
+
+func _KnowledgeBaseDocService_GetDocSegmentImportReport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDocSegmentImportReportReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KnowledgeBaseDocServiceServer).GetDocSegmentImportReport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: KnowledgeBaseDocService_GetDocSegmentImportReport_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KnowledgeBaseDocServiceServer).GetDocSegmentImportReport(ctx, req.(*GetDocSegmentImportReportReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _KnowledgeBaseDocService_SaveDocSync_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SaveDocSyncReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KnowledgeBaseDocServiceServer).SaveDocSync(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: KnowledgeBaseDocService_SaveDocSync_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KnowledgeBaseDocServiceServer).SaveDocSync(ctx, req.(*SaveDocSyncReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _KnowledgeBaseDocService_DeleteDocSync_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteDocSyncReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KnowledgeBaseDocServiceServer).DeleteDocSync(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: KnowledgeBaseDocService_DeleteDocSync_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KnowledgeBaseDocServiceServer).DeleteDocSync(ctx, req.(*DeleteDocSyncReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _KnowledgeBaseDocService_GetDocSyncList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDocSyncListReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KnowledgeBaseDocServiceServer).GetDocSyncList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: KnowledgeBaseDocService_GetDocSyncList_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KnowledgeBaseDocServiceServer).GetDocSyncList(ctx, req.(*GetDocSyncListReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _KnowledgeBaseDocService_RunDocSync_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RunDocSyncReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KnowledgeBaseDocServiceServer).RunDocSync(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: KnowledgeBaseDocService_RunDocSync_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KnowledgeBaseDocServiceServer).RunDocSync(ctx, req.(*RunDocSyncReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _KnowledgeBaseDocService_GetDocSyncHistoryList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDocSyncHistoryListReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KnowledgeBaseDocServiceServer).GetDocSyncHistoryList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: KnowledgeBaseDocService_GetDocSyncHistoryList_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KnowledgeBaseDocServiceServer).GetDocSyncHistoryList(ctx, req.(*GetDocSyncHistoryListReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _KnowledgeBaseDocService_SaveConnector_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SaveConnectorReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KnowledgeBaseDocServiceServer).SaveConnector(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: KnowledgeBaseDocService_SaveConnector_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KnowledgeBaseDocServiceServer).SaveConnector(ctx, req.(*SaveConnectorReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _KnowledgeBaseDocService_DeleteConnector_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteConnectorReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KnowledgeBaseDocServiceServer).DeleteConnector(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: KnowledgeBaseDocService_DeleteConnector_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KnowledgeBaseDocServiceServer).DeleteConnector(ctx, req.(*DeleteConnectorReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _KnowledgeBaseDocService_GetConnectorList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetConnectorListReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KnowledgeBaseDocServiceServer).GetConnectorList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: KnowledgeBaseDocService_GetConnectorList_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KnowledgeBaseDocServiceServer).GetConnectorList(ctx, req.(*GetConnectorListReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _KnowledgeBaseDocService_RunConnector_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RunConnectorReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KnowledgeBaseDocServiceServer).RunConnector(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: KnowledgeBaseDocService_RunConnector_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KnowledgeBaseDocServiceServer).RunConnector(ctx, req.(*RunConnectorReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _KnowledgeBaseDocService_UploadDocVersion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UploadDocVersionReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KnowledgeBaseDocServiceServer).UploadDocVersion(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: KnowledgeBaseDocService_UploadDocVersion_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KnowledgeBaseDocServiceServer).UploadDocVersion(ctx, req.(*UploadDocVersionReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _KnowledgeBaseDocService_GetDocVersionList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDocVersionListReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KnowledgeBaseDocServiceServer).GetDocVersionList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: KnowledgeBaseDocService_GetDocVersionList_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KnowledgeBaseDocServiceServer).GetDocVersionList(ctx, req.(*GetDocVersionListReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _KnowledgeBaseDocService_DiffDocVersion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DiffDocVersionReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KnowledgeBaseDocServiceServer).DiffDocVersion(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: KnowledgeBaseDocService_DiffDocVersion_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KnowledgeBaseDocServiceServer).DiffDocVersion(ctx, req.(*DiffDocVersionReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _KnowledgeBaseDocService_RollbackDocVersion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RollbackDocVersionReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KnowledgeBaseDocServiceServer).RollbackDocVersion(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: KnowledgeBaseDocService_RollbackDocVersion_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KnowledgeBaseDocServiceServer).RollbackDocVersion(ctx, req.(*RollbackDocVersionReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // KnowledgeBaseDocService_ServiceDesc is the grpc.ServiceDesc for KnowledgeBaseDocService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -879,7 +1421,69 @@ var KnowledgeBaseDocService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateDocChildSegment",
 			Handler:    _KnowledgeBaseDocService_UpdateDocChildSegment_Handler,
 		},
+		{
+			MethodName: "GetDocSegmentImportReport",
+			Handler:    _KnowledgeBaseDocService_GetDocSegmentImportReport_Handler,
+		},
+		{
+			MethodName: "SaveDocSync",
+			Handler:    _KnowledgeBaseDocService_SaveDocSync_Handler,
+		},
+		{
+			MethodName: "DeleteDocSync",
+			Handler:    _KnowledgeBaseDocService_DeleteDocSync_Handler,
+		},
+		{
+			MethodName: "GetDocSyncList",
+			Handler:    _KnowledgeBaseDocService_GetDocSyncList_Handler,
+		},
+		{
+			MethodName: "RunDocSync",
+			Handler:    _KnowledgeBaseDocService_RunDocSync_Handler,
+		},
+		{
+			MethodName: "GetDocSyncHistoryList",
+			Handler:    _KnowledgeBaseDocService_GetDocSyncHistoryList_Handler,
+		},
+		{
+			MethodName: "SaveConnector",
+			Handler:    _KnowledgeBaseDocService_SaveConnector_Handler,
+		},
+		{
+			MethodName: "DeleteConnector",
+			Handler:    _KnowledgeBaseDocService_DeleteConnector_Handler,
+		},
+		{
+			MethodName: "GetConnectorList",
+			Handler:    _KnowledgeBaseDocService_GetConnectorList_Handler,
+		},
+		{
+			MethodName: "RunConnector",
+			Handler:    _KnowledgeBaseDocService_RunConnector_Handler,
+		},
+		{
+			MethodName: "UploadDocVersion",
+			Handler:    _KnowledgeBaseDocService_UploadDocVersion_Handler,
+		},
+		{
+			MethodName: "GetDocVersionList",
+			Handler:    _KnowledgeBaseDocService_GetDocVersionList_Handler,
+		},
+		{
+			MethodName: "DiffDocVersion",
+			Handler:    _KnowledgeBaseDocService_DiffDocVersion_Handler,
+		},
+		{
+			MethodName: "RollbackDocVersion",
+			Handler:    _KnowledgeBaseDocService_RollbackDocVersion_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "ExportDocSegment",
+			Handler:       _KnowledgeBaseDocService_ExportDocSegment_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "proto/knowledgebase-doc-service/knowledgebase-doc-service.proto",
 }
@@ -31,6 +31,20 @@ const (
 	KnowledgeBaseService_GetKnowledgeMetaSelect_FullMethodName        = "/knowledgebase_service.KnowledgeBaseService/GetKnowledgeMetaSelect"
 	KnowledgeBaseService_GetKnowledgeMetaValueList_FullMethodName     = "/knowledgebase_service.KnowledgeBaseService/GetKnowledgeMetaValueList"
 	KnowledgeBaseService_UpdateKnowledgeMetaValue_FullMethodName      = "/knowledgebase_service.KnowledgeBaseService/UpdateKnowledgeMetaValue"
+	KnowledgeBaseService_GrantKnowledgePermission_FullMethodName      = "/knowledgebase_service.KnowledgeBaseService/GrantKnowledgePermission"
+	KnowledgeBaseService_RevokeKnowledgePermission_FullMethodName     = "/knowledgebase_service.KnowledgeBaseService/RevokeKnowledgePermission"
+	KnowledgeBaseService_GetKnowledgePermissionList_FullMethodName    = "/knowledgebase_service.KnowledgeBaseService/GetKnowledgePermissionList"
+	KnowledgeBaseService_ExportKnowledge_FullMethodName               = "/knowledgebase_service.KnowledgeBaseService/ExportKnowledge"
+	KnowledgeBaseService_ImportKnowledge_FullMethodName               = "/knowledgebase_service.KnowledgeBaseService/ImportKnowledge"
+	KnowledgeBaseService_GetKnowledgeBundleTask_FullMethodName        = "/knowledgebase_service.KnowledgeBaseService/GetKnowledgeBundleTask"
+	KnowledgeBaseService_CreateKnowledgeEvalDataset_FullMethodName    = "/knowledgebase_service.KnowledgeBaseService/CreateKnowledgeEvalDataset"
+	KnowledgeBaseService_DeleteKnowledgeEvalDataset_FullMethodName    = "/knowledgebase_service.KnowledgeBaseService/DeleteKnowledgeEvalDataset"
+	KnowledgeBaseService_GetKnowledgeEvalDatasetList_FullMethodName   = "/knowledgebase_service.KnowledgeBaseService/GetKnowledgeEvalDatasetList"
+	KnowledgeBaseService_GetKnowledgeEvalDataset_FullMethodName       = "/knowledgebase_service.KnowledgeBaseService/GetKnowledgeEvalDataset"
+	KnowledgeBaseService_CreateKnowledgeEvalRun_FullMethodName        = "/knowledgebase_service.KnowledgeBaseService/CreateKnowledgeEvalRun"
+	KnowledgeBaseService_GetKnowledgeEvalRunList_FullMethodName       = "/knowledgebase_service.KnowledgeBaseService/GetKnowledgeEvalRunList"
+	KnowledgeBaseService_GetKnowledgeEvalRun_FullMethodName           = "/knowledgebase_service.KnowledgeBaseService/GetKnowledgeEvalRun"
+	KnowledgeBaseService_CompareKnowledgeEvalRun_FullMethodName       = "/knowledgebase_service.KnowledgeBaseService/CompareKnowledgeEvalRun"
 )
 
 // KnowledgeBaseServiceClient is the client API for KnowledgeBaseService service.
@@ -59,6 +73,34 @@ type KnowledgeBaseServiceClient interface {
 	GetKnowledgeMetaValueList(ctx context.Context, in *KnowledgeMetaValueListReq, opts ...grpc.CallOption) (*KnowledgeMetaValueListResp, error)
 	// 更新知识库元数据值列表
 	UpdateKnowledgeMetaValue(ctx context.Context, in *UpdateKnowledgeMetaValueReq, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// 分享知识库（新增或修改分享对象的权限）
+	GrantKnowledgePermission(ctx context.Context, in *GrantKnowledgePermissionReq, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// 取消分享知识库
+	RevokeKnowledgePermission(ctx context.Context, in *RevokeKnowledgePermissionReq, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// 获取知识库分享列表
+	GetKnowledgePermissionList(ctx context.Context, in *KnowledgePermissionListReq, opts ...grpc.CallOption) (*KnowledgePermissionListResp, error)
+	// 导出知识库（异步任务）
+	ExportKnowledge(ctx context.Context, in *ExportKnowledgeReq, opts ...grpc.CallOption) (*KnowledgeBundleTaskResp, error)
+	// 导入知识库（异步任务）
+	ImportKnowledge(ctx context.Context, in *ImportKnowledgeReq, opts ...grpc.CallOption) (*KnowledgeBundleTaskResp, error)
+	// 获取知识库导入导出任务详情
+	GetKnowledgeBundleTask(ctx context.Context, in *KnowledgeBundleTaskReq, opts ...grpc.CallOption) (*KnowledgeBundleTaskInfo, error)
+	// 创建检索评测数据集
+	CreateKnowledgeEvalDataset(ctx context.Context, in *CreateKnowledgeEvalDatasetReq, opts ...grpc.CallOption) (*CreateKnowledgeEvalDatasetResp, error)
+	// 删除检索评测数据集及其评测记录
+	DeleteKnowledgeEvalDataset(ctx context.Context, in *KnowledgeEvalDatasetReq, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// 查询知识库的检索评测数据集列表
+	GetKnowledgeEvalDatasetList(ctx context.Context, in *KnowledgeEvalDatasetListReq, opts ...grpc.CallOption) (*KnowledgeEvalDatasetListResp, error)
+	// 查询检索评测数据集详情
+	GetKnowledgeEvalDataset(ctx context.Context, in *KnowledgeEvalDatasetReq, opts ...grpc.CallOption) (*KnowledgeEvalDatasetInfo, error)
+	// 按指定检索配置运行检索评测
+	CreateKnowledgeEvalRun(ctx context.Context, in *CreateKnowledgeEvalRunReq, opts ...grpc.CallOption) (*CreateKnowledgeEvalRunResp, error)
+	// 查询数据集的检索评测列表
+	GetKnowledgeEvalRunList(ctx context.Context, in *KnowledgeEvalRunListReq, opts ...grpc.CallOption) (*KnowledgeEvalRunListResp, error)
+	// 查询检索评测详情，包括每个问题的检索结果
+	GetKnowledgeEvalRun(ctx context.Context, in *KnowledgeEvalRunReq, opts ...grpc.CallOption) (*KnowledgeEvalRunDetail, error)
+	// 对比同一数据集的两次检索评测
+	CompareKnowledgeEvalRun(ctx context.Context, in *CompareKnowledgeEvalRunReq, opts ...grpc.CallOption) (*CompareKnowledgeEvalRunResp, error)
 }
 
 type knowledgeBaseServiceClient struct {
@@ -179,6 +221,146 @@ func (c *knowledgeBaseServiceClient) UpdateKnowledgeMetaValue(ctx context.Contex
 	return out, nil
 }
 
+func (c *knowledgeBaseServiceClient) GrantKnowledgePermission(ctx context.Context, in *GrantKnowledgePermissionReq, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, KnowledgeBaseService_GrantKnowledgePermission_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *knowledgeBaseServiceClient) RevokeKnowledgePermission(ctx context.Context, in *RevokeKnowledgePermissionReq, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, KnowledgeBaseService_RevokeKnowledgePermission_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *knowledgeBaseServiceClient) GetKnowledgePermissionList(ctx context.Context, in *KnowledgePermissionListReq, opts ...grpc.CallOption) (*KnowledgePermissionListResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(KnowledgePermissionListResp)
+	err := c.cc.Invoke(ctx, KnowledgeBaseService_GetKnowledgePermissionList_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *knowledgeBaseServiceClient) ExportKnowledge(ctx context.Context, in *ExportKnowledgeReq, opts ...grpc.CallOption) (*KnowledgeBundleTaskResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(KnowledgeBundleTaskResp)
+	err := c.cc.Invoke(ctx, KnowledgeBaseService_ExportKnowledge_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *knowledgeBaseServiceClient) ImportKnowledge(ctx context.Context, in *ImportKnowledgeReq, opts ...grpc.CallOption) (*KnowledgeBundleTaskResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(KnowledgeBundleTaskResp)
+	err := c.cc.Invoke(ctx, KnowledgeBaseService_ImportKnowledge_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *knowledgeBaseServiceClient) GetKnowledgeBundleTask(ctx context.Context, in *KnowledgeBundleTaskReq, opts ...grpc.CallOption) (*KnowledgeBundleTaskInfo, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(KnowledgeBundleTaskInfo)
+	err := c.cc.Invoke(ctx, KnowledgeBaseService_GetKnowledgeBundleTask_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *knowledgeBaseServiceClient) CreateKnowledgeEvalDataset(ctx context.Context, in *CreateKnowledgeEvalDatasetReq, opts ...grpc.CallOption) (*CreateKnowledgeEvalDatasetResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateKnowledgeEvalDatasetResp)
+	err := c.cc.Invoke(ctx, KnowledgeBaseService_CreateKnowledgeEvalDataset_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *knowledgeBaseServiceClient) DeleteKnowledgeEvalDataset(ctx context.Context, in *KnowledgeEvalDatasetReq, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, KnowledgeBaseService_DeleteKnowledgeEvalDataset_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *knowledgeBaseServiceClient) GetKnowledgeEvalDatasetList(ctx context.Context, in *KnowledgeEvalDatasetListReq, opts ...grpc.CallOption) (*KnowledgeEvalDatasetListResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(KnowledgeEvalDatasetListResp)
+	err := c.cc.Invoke(ctx, KnowledgeBaseService_GetKnowledgeEvalDatasetList_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *knowledgeBaseServiceClient) GetKnowledgeEvalDataset(ctx context.Context, in *KnowledgeEvalDatasetReq, opts ...grpc.CallOption) (*KnowledgeEvalDatasetInfo, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(KnowledgeEvalDatasetInfo)
+	err := c.cc.Invoke(ctx, KnowledgeBaseService_GetKnowledgeEvalDataset_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *knowledgeBaseServiceClient) CreateKnowledgeEvalRun(ctx context.Context, in *CreateKnowledgeEvalRunReq, opts ...grpc.CallOption) (*CreateKnowledgeEvalRunResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateKnowledgeEvalRunResp)
+	err := c.cc.Invoke(ctx, KnowledgeBaseService_CreateKnowledgeEvalRun_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *knowledgeBaseServiceClient) GetKnowledgeEvalRunList(ctx context.Context, in *KnowledgeEvalRunListReq, opts ...grpc.CallOption) (*KnowledgeEvalRunListResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(KnowledgeEvalRunListResp)
+	err := c.cc.Invoke(ctx, KnowledgeBaseService_GetKnowledgeEvalRunList_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *knowledgeBaseServiceClient) GetKnowledgeEvalRun(ctx context.Context, in *KnowledgeEvalRunReq, opts ...grpc.CallOption) (*KnowledgeEvalRunDetail, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(KnowledgeEvalRunDetail)
+	err := c.cc.Invoke(ctx, KnowledgeBaseService_GetKnowledgeEvalRun_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *knowledgeBaseServiceClient) CompareKnowledgeEvalRun(ctx context.Context, in *CompareKnowledgeEvalRunReq, opts ...grpc.CallOption) (*CompareKnowledgeEvalRunResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CompareKnowledgeEvalRunResp)
+	err := c.cc.Invoke(ctx, KnowledgeBaseService_CompareKnowledgeEvalRun_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // KnowledgeBaseServiceServer is the server API for KnowledgeBaseService service.
 // All implementations must embed UnimplementedKnowledgeBaseServiceServer
 // for forward compatibility.
@@ -205,6 +387,34 @@ type KnowledgeBaseServiceServer interface {
 	GetKnowledgeMetaValueList(context.Context, *KnowledgeMetaValueListReq) (*KnowledgeMetaValueListResp, error)
 	// 更新知识库元数据值列表
 	UpdateKnowledgeMetaValue(context.Context, *UpdateKnowledgeMetaValueReq) (*emptypb.Empty, error)
+	// 分享知识库（新增或修改分享对象的权限）
+	GrantKnowledgePermission(context.Context, *GrantKnowledgePermissionReq) (*emptypb.Empty, error)
+	// 取消分享知识库
+	RevokeKnowledgePermission(context.Context, *RevokeKnowledgePermissionReq) (*emptypb.Empty, error)
+	// 获取知识库分享列表
+	GetKnowledgePermissionList(context.Context, *KnowledgePermissionListReq) (*KnowledgePermissionListResp, error)
+	// 导出知识库（异步任务）
+	ExportKnowledge(context.Context, *ExportKnowledgeReq) (*KnowledgeBundleTaskResp, error)
+	// 导入知识库（异步任务）
+	ImportKnowledge(context.Context, *ImportKnowledgeReq) (*KnowledgeBundleTaskResp, error)
+	// 获取知识库导入导出任务详情
+	GetKnowledgeBundleTask(context.Context, *KnowledgeBundleTaskReq) (*KnowledgeBundleTaskInfo, error)
+	// 创建检索评测数据集
+	CreateKnowledgeEvalDataset(context.Context, *CreateKnowledgeEvalDatasetReq) (*CreateKnowledgeEvalDatasetResp, error)
+	// 删除检索评测数据集及其评测记录
+	DeleteKnowledgeEvalDataset(context.Context, *KnowledgeEvalDatasetReq) (*emptypb.Empty, error)
+	// 查询知识库的检索评测数据集列表
+	GetKnowledgeEvalDatasetList(context.Context, *KnowledgeEvalDatasetListReq) (*KnowledgeEvalDatasetListResp, error)
+	// 查询检索评测数据集详情
+	GetKnowledgeEvalDataset(context.Context, *KnowledgeEvalDatasetReq) (*KnowledgeEvalDatasetInfo, error)
+	// 按指定检索配置运行检索评测
+	CreateKnowledgeEvalRun(context.Context, *CreateKnowledgeEvalRunReq) (*CreateKnowledgeEvalRunResp, error)
+	// 查询数据集的检索评测列表
+	GetKnowledgeEvalRunList(context.Context, *KnowledgeEvalRunListReq) (*KnowledgeEvalRunListResp, error)
+	// 查询检索评测详情，包括每个问题的检索结果
+	GetKnowledgeEvalRun(context.Context, *KnowledgeEvalRunReq) (*KnowledgeEvalRunDetail, error)
+	// 对比同一数据集的两次检索评测
+	CompareKnowledgeEvalRun(context.Context, *CompareKnowledgeEvalRunReq) (*CompareKnowledgeEvalRunResp, error)
 	mustEmbedUnimplementedKnowledgeBaseServiceServer()
 }
 
@@ -248,6 +458,48 @@ func (UnimplementedKnowledgeBaseServiceServer) GetKnowledgeMetaValueList(context
 func (UnimplementedKnowledgeBaseServiceServer) UpdateKnowledgeMetaValue(context.Context, *UpdateKnowledgeMetaValueReq) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateKnowledgeMetaValue not implemented")
 }
+func (UnimplementedKnowledgeBaseServiceServer) GrantKnowledgePermission(context.Context, *GrantKnowledgePermissionReq) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GrantKnowledgePermission not implemented")
+}
+func (UnimplementedKnowledgeBaseServiceServer) RevokeKnowledgePermission(context.Context, *RevokeKnowledgePermissionReq) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeKnowledgePermission not implemented")
+}
+func (UnimplementedKnowledgeBaseServiceServer) GetKnowledgePermissionList(context.Context, *KnowledgePermissionListReq) (*KnowledgePermissionListResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetKnowledgePermissionList not implemented")
+}
+func (UnimplementedKnowledgeBaseServiceServer) ExportKnowledge(context.Context, *ExportKnowledgeReq) (*KnowledgeBundleTaskResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExportKnowledge not implemented")
+}
+func (UnimplementedKnowledgeBaseServiceServer) ImportKnowledge(context.Context, *ImportKnowledgeReq) (*KnowledgeBundleTaskResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ImportKnowledge not implemented")
+}
+func (UnimplementedKnowledgeBaseServiceServer) GetKnowledgeBundleTask(context.Context, *KnowledgeBundleTaskReq) (*KnowledgeBundleTaskInfo, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetKnowledgeBundleTask not implemented")
+}
+func (UnimplementedKnowledgeBaseServiceServer) CreateKnowledgeEvalDataset(context.Context, *CreateKnowledgeEvalDatasetReq) (*CreateKnowledgeEvalDatasetResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateKnowledgeEvalDataset not implemented")
+}
+func (UnimplementedKnowledgeBaseServiceServer) DeleteKnowledgeEvalDataset(context.Context, *KnowledgeEvalDatasetReq) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteKnowledgeEvalDataset not implemented")
+}
+func (UnimplementedKnowledgeBaseServiceServer) GetKnowledgeEvalDatasetList(context.Context, *KnowledgeEvalDatasetListReq) (*KnowledgeEvalDatasetListResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetKnowledgeEvalDatasetList not implemented")
+}
+func (UnimplementedKnowledgeBaseServiceServer) GetKnowledgeEvalDataset(context.Context, *KnowledgeEvalDatasetReq) (*KnowledgeEvalDatasetInfo, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetKnowledgeEvalDataset not implemented")
+}
+func (UnimplementedKnowledgeBaseServiceServer) CreateKnowledgeEvalRun(context.Context, *CreateKnowledgeEvalRunReq) (*CreateKnowledgeEvalRunResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateKnowledgeEvalRun not implemented")
+}
+func (UnimplementedKnowledgeBaseServiceServer) GetKnowledgeEvalRunList(context.Context, *KnowledgeEvalRunListReq) (*KnowledgeEvalRunListResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetKnowledgeEvalRunList not implemented")
+}
+func (UnimplementedKnowledgeBaseServiceServer) GetKnowledgeEvalRun(context.Context, *KnowledgeEvalRunReq) (*KnowledgeEvalRunDetail, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetKnowledgeEvalRun not implemented")
+}
+func (UnimplementedKnowledgeBaseServiceServer) CompareKnowledgeEvalRun(context.Context, *CompareKnowledgeEvalRunReq) (*CompareKnowledgeEvalRunResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CompareKnowledgeEvalRun not implemented")
+}
 func (UnimplementedKnowledgeBaseServiceServer) mustEmbedUnimplementedKnowledgeBaseServiceServer() {}
 func (UnimplementedKnowledgeBaseServiceServer) testEmbeddedByValue()                              {}
 
@@ -467,6 +719,258 @@ func _KnowledgeBaseService_UpdateKnowledgeMetaValue_Handler(srv interface{}, ctx
 	return interceptor(ctx, in, info, handler)
 }
 
+func _KnowledgeBaseService_GrantKnowledgePermission_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GrantKnowledgePermissionReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KnowledgeBaseServiceServer).GrantKnowledgePermission(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: KnowledgeBaseService_GrantKnowledgePermission_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KnowledgeBaseServiceServer).GrantKnowledgePermission(ctx, req.(*GrantKnowledgePermissionReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _KnowledgeBaseService_RevokeKnowledgePermission_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeKnowledgePermissionReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KnowledgeBaseServiceServer).RevokeKnowledgePermission(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: KnowledgeBaseService_RevokeKnowledgePermission_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KnowledgeBaseServiceServer).RevokeKnowledgePermission(ctx, req.(*RevokeKnowledgePermissionReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _KnowledgeBaseService_GetKnowledgePermissionList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(KnowledgePermissionListReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KnowledgeBaseServiceServer).GetKnowledgePermissionList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: KnowledgeBaseService_GetKnowledgePermissionList_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KnowledgeBaseServiceServer).GetKnowledgePermissionList(ctx, req.(*KnowledgePermissionListReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _KnowledgeBaseService_ExportKnowledge_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExportKnowledgeReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KnowledgeBaseServiceServer).ExportKnowledge(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: KnowledgeBaseService_ExportKnowledge_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KnowledgeBaseServiceServer).ExportKnowledge(ctx, req.(*ExportKnowledgeReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _KnowledgeBaseService_ImportKnowledge_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImportKnowledgeReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KnowledgeBaseServiceServer).ImportKnowledge(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: KnowledgeBaseService_ImportKnowledge_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KnowledgeBaseServiceServer).ImportKnowledge(ctx, req.(*ImportKnowledgeReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _KnowledgeBaseService_GetKnowledgeBundleTask_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(KnowledgeBundleTaskReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KnowledgeBaseServiceServer).GetKnowledgeBundleTask(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: KnowledgeBaseService_GetKnowledgeBundleTask_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KnowledgeBaseServiceServer).GetKnowledgeBundleTask(ctx, req.(*KnowledgeBundleTaskReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _KnowledgeBaseService_CreateKnowledgeEvalDataset_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateKnowledgeEvalDatasetReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KnowledgeBaseServiceServer).CreateKnowledgeEvalDataset(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: KnowledgeBaseService_CreateKnowledgeEvalDataset_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KnowledgeBaseServiceServer).CreateKnowledgeEvalDataset(ctx, req.(*CreateKnowledgeEvalDatasetReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _KnowledgeBaseService_DeleteKnowledgeEvalDataset_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(KnowledgeEvalDatasetReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KnowledgeBaseServiceServer).DeleteKnowledgeEvalDataset(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: KnowledgeBaseService_DeleteKnowledgeEvalDataset_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KnowledgeBaseServiceServer).DeleteKnowledgeEvalDataset(ctx, req.(*KnowledgeEvalDatasetReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _KnowledgeBaseService_GetKnowledgeEvalDatasetList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(KnowledgeEvalDatasetListReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KnowledgeBaseServiceServer).GetKnowledgeEvalDatasetList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: KnowledgeBaseService_GetKnowledgeEvalDatasetList_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KnowledgeBaseServiceServer).GetKnowledgeEvalDatasetList(ctx, req.(*KnowledgeEvalDatasetListReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _KnowledgeBaseService_GetKnowledgeEvalDataset_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(KnowledgeEvalDatasetReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KnowledgeBaseServiceServer).GetKnowledgeEvalDataset(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: KnowledgeBaseService_GetKnowledgeEvalDataset_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KnowledgeBaseServiceServer).GetKnowledgeEvalDataset(ctx, req.(*KnowledgeEvalDatasetReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _KnowledgeBaseService_CreateKnowledgeEvalRun_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateKnowledgeEvalRunReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KnowledgeBaseServiceServer).CreateKnowledgeEvalRun(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: KnowledgeBaseService_CreateKnowledgeEvalRun_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KnowledgeBaseServiceServer).CreateKnowledgeEvalRun(ctx, req.(*CreateKnowledgeEvalRunReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _KnowledgeBaseService_GetKnowledgeEvalRunList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(KnowledgeEvalRunListReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KnowledgeBaseServiceServer).GetKnowledgeEvalRunList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: KnowledgeBaseService_GetKnowledgeEvalRunList_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KnowledgeBaseServiceServer).GetKnowledgeEvalRunList(ctx, req.(*KnowledgeEvalRunListReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _KnowledgeBaseService_GetKnowledgeEvalRun_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(KnowledgeEvalRunReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KnowledgeBaseServiceServer).GetKnowledgeEvalRun(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: KnowledgeBaseService_GetKnowledgeEvalRun_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KnowledgeBaseServiceServer).GetKnowledgeEvalRun(ctx, req.(*KnowledgeEvalRunReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _KnowledgeBaseService_CompareKnowledgeEvalRun_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CompareKnowledgeEvalRunReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KnowledgeBaseServiceServer).CompareKnowledgeEvalRun(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: KnowledgeBaseService_CompareKnowledgeEvalRun_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KnowledgeBaseServiceServer).CompareKnowledgeEvalRun(ctx, req.(*CompareKnowledgeEvalRunReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // KnowledgeBaseService_ServiceDesc is the grpc.ServiceDesc for KnowledgeBaseService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -518,6 +1022,62 @@ var KnowledgeBaseService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateKnowledgeMetaValue",
 			Handler:    _KnowledgeBaseService_UpdateKnowledgeMetaValue_Handler,
 		},
+		{
+			MethodName: "GrantKnowledgePermission",
+			Handler:    _KnowledgeBaseService_GrantKnowledgePermission_Handler,
+		},
+		{
+			MethodName: "RevokeKnowledgePermission",
+			Handler:    _KnowledgeBaseService_RevokeKnowledgePermission_Handler,
+		},
+		{
+			MethodName: "GetKnowledgePermissionList",
+			Handler:    _KnowledgeBaseService_GetKnowledgePermissionList_Handler,
+		},
+		{
+			MethodName: "ExportKnowledge",
+			Handler:    _KnowledgeBaseService_ExportKnowledge_Handler,
+		},
+		{
+			MethodName: "ImportKnowledge",
+			Handler:    _KnowledgeBaseService_ImportKnowledge_Handler,
+		},
+		{
+			MethodName: "GetKnowledgeBundleTask",
+			Handler:    _KnowledgeBaseService_GetKnowledgeBundleTask_Handler,
+		},
+		{
+			MethodName: "CreateKnowledgeEvalDataset",
+			Handler:    _KnowledgeBaseService_CreateKnowledgeEvalDataset_Handler,
+		},
+		{
+			MethodName: "DeleteKnowledgeEvalDataset",
+			Handler:    _KnowledgeBaseService_DeleteKnowledgeEvalDataset_Handler,
+		},
+		{
+			MethodName: "GetKnowledgeEvalDatasetList",
+			Handler:    _KnowledgeBaseService_GetKnowledgeEvalDatasetList_Handler,
+		},
+		{
+			MethodName: "GetKnowledgeEvalDataset",
+			Handler:    _KnowledgeBaseService_GetKnowledgeEvalDataset_Handler,
+		},
+		{
+			MethodName: "CreateKnowledgeEvalRun",
+			Handler:    _KnowledgeBaseService_CreateKnowledgeEvalRun_Handler,
+		},
+		{
+			MethodName: "GetKnowledgeEvalRunList",
+			Handler:    _KnowledgeBaseService_GetKnowledgeEvalRunList_Handler,
+		},
+		{
+			MethodName: "GetKnowledgeEvalRun",
+			Handler:    _KnowledgeBaseService_GetKnowledgeEvalRun_Handler,
+		},
+		{
+			MethodName: "CompareKnowledgeEvalRun",
+			Handler:    _KnowledgeBaseService_CompareKnowledgeEvalRun_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "proto/knowledgebase-service/knowledgebase-service.proto",
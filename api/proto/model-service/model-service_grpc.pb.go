@@ -20,15 +20,21 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	ModelService_ImportModel_FullMethodName       = "/model_service.ModelService/ImportModel"
-	ModelService_UpdateModel_FullMethodName       = "/model_service.ModelService/UpdateModel"
-	ModelService_DeleteModel_FullMethodName       = "/model_service.ModelService/DeleteModel"
-	ModelService_GetModel_FullMethodName          = "/model_service.ModelService/GetModel"
-	ModelService_ListModels_FullMethodName        = "/model_service.ModelService/ListModels"
-	ModelService_ChangeModelStatus_FullMethodName = "/model_service.ModelService/ChangeModelStatus"
-	ModelService_GetModelById_FullMethodName      = "/model_service.ModelService/GetModelById"
-	ModelService_ListTypeModels_FullMethodName    = "/model_service.ModelService/ListTypeModels"
-	ModelService_GetModelByIds_FullMethodName     = "/model_service.ModelService/GetModelByIds"
+	ModelService_ImportModel_FullMethodName        = "/model_service.ModelService/ImportModel"
+	ModelService_UpdateModel_FullMethodName        = "/model_service.ModelService/UpdateModel"
+	ModelService_DeleteModel_FullMethodName        = "/model_service.ModelService/DeleteModel"
+	ModelService_GetModel_FullMethodName           = "/model_service.ModelService/GetModel"
+	ModelService_ListModels_FullMethodName         = "/model_service.ModelService/ListModels"
+	ModelService_ChangeModelStatus_FullMethodName  = "/model_service.ModelService/ChangeModelStatus"
+	ModelService_GetModelById_FullMethodName       = "/model_service.ModelService/GetModelById"
+	ModelService_ListTypeModels_FullMethodName     = "/model_service.ModelService/ListTypeModels"
+	ModelService_GetModelByIds_FullMethodName      = "/model_service.ModelService/GetModelByIds"
+	ModelService_RecordModelUsage_FullMethodName   = "/model_service.ModelService/RecordModelUsage"
+	ModelService_GetModelUsageStats_FullMethodName = "/model_service.ModelService/GetModelUsageStats"
+	ModelService_SetModelQuota_FullMethodName      = "/model_service.ModelService/SetModelQuota"
+	ModelService_DeleteModelQuota_FullMethodName   = "/model_service.ModelService/DeleteModelQuota"
+	ModelService_ListModelQuotas_FullMethodName    = "/model_service.ModelService/ListModelQuotas"
+	ModelService_CheckModelQuota_FullMethodName    = "/model_service.ModelService/CheckModelQuota"
 )
 
 // ModelServiceClient is the client API for ModelService service.
@@ -53,6 +59,20 @@ type ModelServiceClient interface {
 	ListTypeModels(ctx context.Context, in *ListTypeModelsReq, opts ...grpc.CallOption) (*ModelInfos, error)
 	// 根据模型ID列表查询
 	GetModelByIds(ctx context.Context, in *GetModelByIdsReq, opts ...grpc.CallOption) (*ModelInfos, error)
+	// --- usage ---
+	// 记录模型调用用量
+	RecordModelUsage(ctx context.Context, in *ModelUsage, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// 模型用量统计（按模型/应用/组织汇总）
+	GetModelUsageStats(ctx context.Context, in *GetModelUsageStatsReq, opts ...grpc.CallOption) (*ModelUsageStats, error)
+	// --- quota ---
+	// 设置组织/用户配额
+	SetModelQuota(ctx context.Context, in *ModelQuota, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// 删除组织/用户配额
+	DeleteModelQuota(ctx context.Context, in *DeleteModelQuotaReq, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// 配额列表（含当日、当月已用量）
+	ListModelQuotas(ctx context.Context, in *ListModelQuotasReq, opts ...grpc.CallOption) (*ModelQuotas, error)
+	// 校验组织/用户配额是否超限
+	CheckModelQuota(ctx context.Context, in *CheckModelQuotaReq, opts ...grpc.CallOption) (*CheckModelQuotaResp, error)
 }
 
 type modelServiceClient struct {
@@ -153,6 +173,66 @@ func (c *modelServiceClient) GetModelByIds(ctx context.Context, in *GetModelById
 	return out, nil
 }
 
+func (c *modelServiceClient) RecordModelUsage(ctx context.Context, in *ModelUsage, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, ModelService_RecordModelUsage_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *modelServiceClient) GetModelUsageStats(ctx context.Context, in *GetModelUsageStatsReq, opts ...grpc.CallOption) (*ModelUsageStats, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ModelUsageStats)
+	err := c.cc.Invoke(ctx, ModelService_GetModelUsageStats_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *modelServiceClient) SetModelQuota(ctx context.Context, in *ModelQuota, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, ModelService_SetModelQuota_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *modelServiceClient) DeleteModelQuota(ctx context.Context, in *DeleteModelQuotaReq, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, ModelService_DeleteModelQuota_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *modelServiceClient) ListModelQuotas(ctx context.Context, in *ListModelQuotasReq, opts ...grpc.CallOption) (*ModelQuotas, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ModelQuotas)
+	err := c.cc.Invoke(ctx, ModelService_ListModelQuotas_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *modelServiceClient) CheckModelQuota(ctx context.Context, in *CheckModelQuotaReq, opts ...grpc.CallOption) (*CheckModelQuotaResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CheckModelQuotaResp)
+	err := c.cc.Invoke(ctx, ModelService_CheckModelQuota_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ModelServiceServer is the server API for ModelService service.
 // All implementations must embed UnimplementedModelServiceServer
 // for forward compatibility.
@@ -175,6 +255,20 @@ type ModelServiceServer interface {
 	ListTypeModels(context.Context, *ListTypeModelsReq) (*ModelInfos, error)
 	// 根据模型ID列表查询
 	GetModelByIds(context.Context, *GetModelByIdsReq) (*ModelInfos, error)
+	// --- usage ---
+	// 记录模型调用用量
+	RecordModelUsage(context.Context, *ModelUsage) (*emptypb.Empty, error)
+	// 模型用量统计（按模型/应用/组织汇总）
+	GetModelUsageStats(context.Context, *GetModelUsageStatsReq) (*ModelUsageStats, error)
+	// --- quota ---
+	// 设置组织/用户配额
+	SetModelQuota(context.Context, *ModelQuota) (*emptypb.Empty, error)
+	// 删除组织/用户配额
+	DeleteModelQuota(context.Context, *DeleteModelQuotaReq) (*emptypb.Empty, error)
+	// 配额列表（含当日、当月已用量）
+	ListModelQuotas(context.Context, *ListModelQuotasReq) (*ModelQuotas, error)
+	// 校验组织/用户配额是否超限
+	CheckModelQuota(context.Context, *CheckModelQuotaReq) (*CheckModelQuotaResp, error)
 	mustEmbedUnimplementedModelServiceServer()
 }
 
@@ -212,6 +306,24 @@ func (UnimplementedModelServiceServer) ListTypeModels(context.Context, *ListType
 func (UnimplementedModelServiceServer) GetModelByIds(context.Context, *GetModelByIdsReq) (*ModelInfos, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetModelByIds not implemented")
 }
+func (UnimplementedModelServiceServer) RecordModelUsage(context.Context, *ModelUsage) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RecordModelUsage not implemented")
+}
+func (UnimplementedModelServiceServer) GetModelUsageStats(context.Context, *GetModelUsageStatsReq) (*ModelUsageStats, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetModelUsageStats not implemented")
+}
+func (UnimplementedModelServiceServer) SetModelQuota(context.Context, *ModelQuota) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetModelQuota not implemented")
+}
+func (UnimplementedModelServiceServer) DeleteModelQuota(context.Context, *DeleteModelQuotaReq) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteModelQuota not implemented")
+}
+func (UnimplementedModelServiceServer) ListModelQuotas(context.Context, *ListModelQuotasReq) (*ModelQuotas, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListModelQuotas not implemented")
+}
+func (UnimplementedModelServiceServer) CheckModelQuota(context.Context, *CheckModelQuotaReq) (*CheckModelQuotaResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CheckModelQuota not implemented")
+}
 func (UnimplementedModelServiceServer) mustEmbedUnimplementedModelServiceServer() {}
 func (UnimplementedModelServiceServer) testEmbeddedByValue()                      {}
 
@@ -395,6 +507,114 @@ func _ModelService_GetModelByIds_Handler(srv interface{}, ctx context.Context, d
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ModelService_RecordModelUsage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ModelUsage)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ModelServiceServer).RecordModelUsage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ModelService_RecordModelUsage_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ModelServiceServer).RecordModelUsage(ctx, req.(*ModelUsage))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ModelService_GetModelUsageStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetModelUsageStatsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ModelServiceServer).GetModelUsageStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ModelService_GetModelUsageStats_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ModelServiceServer).GetModelUsageStats(ctx, req.(*GetModelUsageStatsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ModelService_SetModelQuota_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ModelQuota)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ModelServiceServer).SetModelQuota(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ModelService_SetModelQuota_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ModelServiceServer).SetModelQuota(ctx, req.(*ModelQuota))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ModelService_DeleteModelQuota_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteModelQuotaReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ModelServiceServer).DeleteModelQuota(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ModelService_DeleteModelQuota_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ModelServiceServer).DeleteModelQuota(ctx, req.(*DeleteModelQuotaReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ModelService_ListModelQuotas_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListModelQuotasReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ModelServiceServer).ListModelQuotas(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ModelService_ListModelQuotas_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ModelServiceServer).ListModelQuotas(ctx, req.(*ListModelQuotasReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ModelService_CheckModelQuota_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CheckModelQuotaReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ModelServiceServer).CheckModelQuota(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ModelService_CheckModelQuota_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ModelServiceServer).CheckModelQuota(ctx, req.(*CheckModelQuotaReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // ModelService_ServiceDesc is the grpc.ServiceDesc for ModelService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -438,6 +658,30 @@ var ModelService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetModelByIds",
 			Handler:    _ModelService_GetModelByIds_Handler,
 		},
+		{
+			MethodName: "RecordModelUsage",
+			Handler:    _ModelService_RecordModelUsage_Handler,
+		},
+		{
+			MethodName: "GetModelUsageStats",
+			Handler:    _ModelService_GetModelUsageStats_Handler,
+		},
+		{
+			MethodName: "SetModelQuota",
+			Handler:    _ModelService_SetModelQuota_Handler,
+		},
+		{
+			MethodName: "DeleteModelQuota",
+			Handler:    _ModelService_DeleteModelQuota_Handler,
+		},
+		{
+			MethodName: "ListModelQuotas",
+			Handler:    _ModelService_ListModelQuotas_Handler,
+		},
+		{
+			MethodName: "CheckModelQuota",
+			Handler:    _ModelService_CheckModelQuota_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "proto/model-service/model-service.proto",
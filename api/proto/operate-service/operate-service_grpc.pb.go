@@ -24,6 +24,16 @@ const (
 	OperateService_CreateSystemCustomLogin_FullMethodName = "/operate_service.OperateService/CreateSystemCustomLogin"
 	OperateService_CreateSystemCustomHome_FullMethodName  = "/operate_service.OperateService/CreateSystemCustomHome"
 	OperateService_GetSystemCustom_FullMethodName         = "/operate_service.OperateService/GetSystemCustom"
+	OperateService_CreateAuditLog_FullMethodName          = "/operate_service.OperateService/CreateAuditLog"
+	OperateService_GetAuditLogList_FullMethodName         = "/operate_service.OperateService/GetAuditLogList"
+	OperateService_ExportAuditLog_FullMethodName          = "/operate_service.OperateService/ExportAuditLog"
+	OperateService_CreateWebhook_FullMethodName           = "/operate_service.OperateService/CreateWebhook"
+	OperateService_UpdateWebhook_FullMethodName           = "/operate_service.OperateService/UpdateWebhook"
+	OperateService_DeleteWebhook_FullMethodName           = "/operate_service.OperateService/DeleteWebhook"
+	OperateService_GetWebhookList_FullMethodName          = "/operate_service.OperateService/GetWebhookList"
+	OperateService_EmitWebhookEvent_FullMethodName        = "/operate_service.OperateService/EmitWebhookEvent"
+	OperateService_GetWebhookDeliveryList_FullMethodName  = "/operate_service.OperateService/GetWebhookDeliveryList"
+	OperateService_RetryWebhookDelivery_FullMethodName    = "/operate_service.OperateService/RetryWebhookDelivery"
 )
 
 // OperateServiceClient is the client API for OperateService service.
@@ -34,6 +44,18 @@ type OperateServiceClient interface {
 	CreateSystemCustomLogin(ctx context.Context, in *CreateSystemCustomLoginReq, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	CreateSystemCustomHome(ctx context.Context, in *CreateSystemCustomHomeReq, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	GetSystemCustom(ctx context.Context, in *GetSystemCustomReq, opts ...grpc.CallOption) (*SystemCustom, error)
+	// --- audit log ---
+	CreateAuditLog(ctx context.Context, in *CreateAuditLogReq, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	GetAuditLogList(ctx context.Context, in *GetAuditLogListReq, opts ...grpc.CallOption) (*AuditLogList, error)
+	ExportAuditLog(ctx context.Context, in *ExportAuditLogReq, opts ...grpc.CallOption) (*AuditLogList, error)
+	// --- webhook ---
+	CreateWebhook(ctx context.Context, in *CreateWebhookReq, opts ...grpc.CallOption) (*Webhook, error)
+	UpdateWebhook(ctx context.Context, in *UpdateWebhookReq, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	DeleteWebhook(ctx context.Context, in *DeleteWebhookReq, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	GetWebhookList(ctx context.Context, in *GetWebhookListReq, opts ...grpc.CallOption) (*WebhookList, error)
+	EmitWebhookEvent(ctx context.Context, in *EmitWebhookEventReq, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	GetWebhookDeliveryList(ctx context.Context, in *GetWebhookDeliveryListReq, opts ...grpc.CallOption) (*WebhookDeliveryList, error)
+	RetryWebhookDelivery(ctx context.Context, in *RetryWebhookDeliveryReq, opts ...grpc.CallOption) (*emptypb.Empty, error)
 }
 
 type operateServiceClient struct {
@@ -84,6 +106,106 @@ func (c *operateServiceClient) GetSystemCustom(ctx context.Context, in *GetSyste
 	return out, nil
 }
 
+func (c *operateServiceClient) CreateAuditLog(ctx context.Context, in *CreateAuditLogReq, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, OperateService_CreateAuditLog_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *operateServiceClient) GetAuditLogList(ctx context.Context, in *GetAuditLogListReq, opts ...grpc.CallOption) (*AuditLogList, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AuditLogList)
+	err := c.cc.Invoke(ctx, OperateService_GetAuditLogList_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *operateServiceClient) ExportAuditLog(ctx context.Context, in *ExportAuditLogReq, opts ...grpc.CallOption) (*AuditLogList, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AuditLogList)
+	err := c.cc.Invoke(ctx, OperateService_ExportAuditLog_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *operateServiceClient) CreateWebhook(ctx context.Context, in *CreateWebhookReq, opts ...grpc.CallOption) (*Webhook, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Webhook)
+	err := c.cc.Invoke(ctx, OperateService_CreateWebhook_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *operateServiceClient) UpdateWebhook(ctx context.Context, in *UpdateWebhookReq, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, OperateService_UpdateWebhook_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *operateServiceClient) DeleteWebhook(ctx context.Context, in *DeleteWebhookReq, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, OperateService_DeleteWebhook_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *operateServiceClient) GetWebhookList(ctx context.Context, in *GetWebhookListReq, opts ...grpc.CallOption) (*WebhookList, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(WebhookList)
+	err := c.cc.Invoke(ctx, OperateService_GetWebhookList_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *operateServiceClient) EmitWebhookEvent(ctx context.Context, in *EmitWebhookEventReq, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, OperateService_EmitWebhookEvent_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *operateServiceClient) GetWebhookDeliveryList(ctx context.Context, in *GetWebhookDeliveryListReq, opts ...grpc.CallOption) (*WebhookDeliveryList, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(WebhookDeliveryList)
+	err := c.cc.Invoke(ctx, OperateService_GetWebhookDeliveryList_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *operateServiceClient) RetryWebhookDelivery(ctx context.Context, in *RetryWebhookDeliveryReq, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, OperateService_RetryWebhookDelivery_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // OperateServiceServer is the server API for OperateService service.
 // All implementations must embed UnimplementedOperateServiceServer
 // for forward compatibility.
@@ -92,6 +214,18 @@ type OperateServiceServer interface {
 	CreateSystemCustomLogin(context.Context, *CreateSystemCustomLoginReq) (*emptypb.Empty, error)
 	CreateSystemCustomHome(context.Context, *CreateSystemCustomHomeReq) (*emptypb.Empty, error)
 	GetSystemCustom(context.Context, *GetSystemCustomReq) (*SystemCustom, error)
+	// --- audit log ---
+	CreateAuditLog(context.Context, *CreateAuditLogReq) (*emptypb.Empty, error)
+	GetAuditLogList(context.Context, *GetAuditLogListReq) (*AuditLogList, error)
+	ExportAuditLog(context.Context, *ExportAuditLogReq) (*AuditLogList, error)
+	// --- webhook ---
+	CreateWebhook(context.Context, *CreateWebhookReq) (*Webhook, error)
+	UpdateWebhook(context.Context, *UpdateWebhookReq) (*emptypb.Empty, error)
+	DeleteWebhook(context.Context, *DeleteWebhookReq) (*emptypb.Empty, error)
+	GetWebhookList(context.Context, *GetWebhookListReq) (*WebhookList, error)
+	EmitWebhookEvent(context.Context, *EmitWebhookEventReq) (*emptypb.Empty, error)
+	GetWebhookDeliveryList(context.Context, *GetWebhookDeliveryListReq) (*WebhookDeliveryList, error)
+	RetryWebhookDelivery(context.Context, *RetryWebhookDeliveryReq) (*emptypb.Empty, error)
 	mustEmbedUnimplementedOperateServiceServer()
 }
 
@@ -114,6 +248,36 @@ func (UnimplementedOperateServiceServer) CreateSystemCustomHome(context.Context,
 func (UnimplementedOperateServiceServer) GetSystemCustom(context.Context, *GetSystemCustomReq) (*SystemCustom, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetSystemCustom not implemented")
 }
+func (UnimplementedOperateServiceServer) CreateAuditLog(context.Context, *CreateAuditLogReq) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateAuditLog not implemented")
+}
+func (UnimplementedOperateServiceServer) GetAuditLogList(context.Context, *GetAuditLogListReq) (*AuditLogList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAuditLogList not implemented")
+}
+func (UnimplementedOperateServiceServer) ExportAuditLog(context.Context, *ExportAuditLogReq) (*AuditLogList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExportAuditLog not implemented")
+}
+func (UnimplementedOperateServiceServer) CreateWebhook(context.Context, *CreateWebhookReq) (*Webhook, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateWebhook not implemented")
+}
+func (UnimplementedOperateServiceServer) UpdateWebhook(context.Context, *UpdateWebhookReq) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateWebhook not implemented")
+}
+func (UnimplementedOperateServiceServer) DeleteWebhook(context.Context, *DeleteWebhookReq) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteWebhook not implemented")
+}
+func (UnimplementedOperateServiceServer) GetWebhookList(context.Context, *GetWebhookListReq) (*WebhookList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetWebhookList not implemented")
+}
+func (UnimplementedOperateServiceServer) EmitWebhookEvent(context.Context, *EmitWebhookEventReq) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EmitWebhookEvent not implemented")
+}
+func (UnimplementedOperateServiceServer) GetWebhookDeliveryList(context.Context, *GetWebhookDeliveryListReq) (*WebhookDeliveryList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetWebhookDeliveryList not implemented")
+}
+func (UnimplementedOperateServiceServer) RetryWebhookDelivery(context.Context, *RetryWebhookDeliveryReq) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RetryWebhookDelivery not implemented")
+}
 func (UnimplementedOperateServiceServer) mustEmbedUnimplementedOperateServiceServer() {}
 func (UnimplementedOperateServiceServer) testEmbeddedByValue()                        {}
 
@@ -207,6 +371,186 @@ func _OperateService_GetSystemCustom_Handler(srv interface{}, ctx context.Contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _OperateService_CreateAuditLog_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateAuditLogReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OperateServiceServer).CreateAuditLog(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OperateService_CreateAuditLog_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OperateServiceServer).CreateAuditLog(ctx, req.(*CreateAuditLogReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OperateService_GetAuditLogList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAuditLogListReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OperateServiceServer).GetAuditLogList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OperateService_GetAuditLogList_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OperateServiceServer).GetAuditLogList(ctx, req.(*GetAuditLogListReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OperateService_ExportAuditLog_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExportAuditLogReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OperateServiceServer).ExportAuditLog(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OperateService_ExportAuditLog_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OperateServiceServer).ExportAuditLog(ctx, req.(*ExportAuditLogReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OperateService_CreateWebhook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateWebhookReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OperateServiceServer).CreateWebhook(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OperateService_CreateWebhook_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OperateServiceServer).CreateWebhook(ctx, req.(*CreateWebhookReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OperateService_UpdateWebhook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateWebhookReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OperateServiceServer).UpdateWebhook(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OperateService_UpdateWebhook_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OperateServiceServer).UpdateWebhook(ctx, req.(*UpdateWebhookReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OperateService_DeleteWebhook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteWebhookReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OperateServiceServer).DeleteWebhook(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OperateService_DeleteWebhook_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OperateServiceServer).DeleteWebhook(ctx, req.(*DeleteWebhookReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OperateService_GetWebhookList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetWebhookListReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OperateServiceServer).GetWebhookList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OperateService_GetWebhookList_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OperateServiceServer).GetWebhookList(ctx, req.(*GetWebhookListReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OperateService_EmitWebhookEvent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EmitWebhookEventReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OperateServiceServer).EmitWebhookEvent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OperateService_EmitWebhookEvent_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OperateServiceServer).EmitWebhookEvent(ctx, req.(*EmitWebhookEventReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OperateService_GetWebhookDeliveryList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetWebhookDeliveryListReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OperateServiceServer).GetWebhookDeliveryList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OperateService_GetWebhookDeliveryList_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OperateServiceServer).GetWebhookDeliveryList(ctx, req.(*GetWebhookDeliveryListReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OperateService_RetryWebhookDelivery_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RetryWebhookDeliveryReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OperateServiceServer).RetryWebhookDelivery(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OperateService_RetryWebhookDelivery_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OperateServiceServer).RetryWebhookDelivery(ctx, req.(*RetryWebhookDeliveryReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // OperateService_ServiceDesc is the grpc.ServiceDesc for OperateService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -230,6 +574,46 @@ var OperateService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetSystemCustom",
 			Handler:    _OperateService_GetSystemCustom_Handler,
 		},
+		{
+			MethodName: "CreateAuditLog",
+			Handler:    _OperateService_CreateAuditLog_Handler,
+		},
+		{
+			MethodName: "GetAuditLogList",
+			Handler:    _OperateService_GetAuditLogList_Handler,
+		},
+		{
+			MethodName: "ExportAuditLog",
+			Handler:    _OperateService_ExportAuditLog_Handler,
+		},
+		{
+			MethodName: "CreateWebhook",
+			Handler:    _OperateService_CreateWebhook_Handler,
+		},
+		{
+			MethodName: "UpdateWebhook",
+			Handler:    _OperateService_UpdateWebhook_Handler,
+		},
+		{
+			MethodName: "DeleteWebhook",
+			Handler:    _OperateService_DeleteWebhook_Handler,
+		},
+		{
+			MethodName: "GetWebhookList",
+			Handler:    _OperateService_GetWebhookList_Handler,
+		},
+		{
+			MethodName: "EmitWebhookEvent",
+			Handler:    _OperateService_EmitWebhookEvent_Handler,
+		},
+		{
+			MethodName: "GetWebhookDeliveryList",
+			Handler:    _OperateService_GetWebhookDeliveryList_Handler,
+		},
+		{
+			MethodName: "RetryWebhookDelivery",
+			Handler:    _OperateService_RetryWebhookDelivery_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "proto/operate-service/operate-service.proto",
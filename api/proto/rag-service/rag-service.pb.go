@@ -1297,6 +1297,378 @@ func (x *AppBriefList) GetRagInfos() []*common.AppBrief {
 	return nil
 }
 
+type ChatRagFeedbackReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RagId    string    `protobuf:"bytes,1,opt,name=ragId,proto3" json:"ragId,omitempty"`
+	MsgId    string    `protobuf:"bytes,2,opt,name=msgId,proto3" json:"msgId,omitempty"`     // 问答返回的msg_id
+	Rating   int32     `protobuf:"varint,3,opt,name=rating,proto3" json:"rating,omitempty"`  // 1:点赞 -1:点踩 0:取消评价
+	Reasons  []string  `protobuf:"bytes,4,rep,name=reasons,proto3" json:"reasons,omitempty"` // 原因标签
+	Content  string    `protobuf:"bytes,5,opt,name=content,proto3" json:"content,omitempty"` // 评价内容
+	Identity *Identity `protobuf:"bytes,9,opt,name=identity,proto3" json:"identity,omitempty"`
+}
+
+func (x *ChatRagFeedbackReq) Reset() {
+	*x = ChatRagFeedbackReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_rag_service_rag_service_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ChatRagFeedbackReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChatRagFeedbackReq) ProtoMessage() {}
+
+func (x *ChatRagFeedbackReq) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_rag_service_rag_service_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChatRagFeedbackReq.ProtoReflect.Descriptor instead.
+func (*ChatRagFeedbackReq) Descriptor() ([]byte, []int) {
+	return file_proto_rag_service_rag_service_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *ChatRagFeedbackReq) GetRagId() string {
+	if x != nil {
+		return x.RagId
+	}
+	return ""
+}
+
+func (x *ChatRagFeedbackReq) GetMsgId() string {
+	if x != nil {
+		return x.MsgId
+	}
+	return ""
+}
+
+func (x *ChatRagFeedbackReq) GetRating() int32 {
+	if x != nil {
+		return x.Rating
+	}
+	return 0
+}
+
+func (x *ChatRagFeedbackReq) GetReasons() []string {
+	if x != nil {
+		return x.Reasons
+	}
+	return nil
+}
+
+func (x *ChatRagFeedbackReq) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+func (x *ChatRagFeedbackReq) GetIdentity() *Identity {
+	if x != nil {
+		return x.Identity
+	}
+	return nil
+}
+
+type ListRagFeedbackReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RagId     string    `protobuf:"bytes,1,opt,name=ragId,proto3" json:"ragId,omitempty"`          // 为空时查询组织内所有rag
+	Rating    int32     `protobuf:"varint,2,opt,name=rating,proto3" json:"rating,omitempty"`       // 0:全部
+	StartTime int64     `protobuf:"varint,3,opt,name=startTime,proto3" json:"startTime,omitempty"` // 评价时间范围（毫秒），0表示不限
+	EndTime   int64     `protobuf:"varint,4,opt,name=endTime,proto3" json:"endTime,omitempty"`
+	PageNo    int32     `protobuf:"varint,5,opt,name=pageNo,proto3" json:"pageNo,omitempty"`
+	PageSize  int32     `protobuf:"varint,6,opt,name=pageSize,proto3" json:"pageSize,omitempty"`
+	Identity  *Identity `protobuf:"bytes,7,opt,name=identity,proto3" json:"identity,omitempty"`
+}
+
+func (x *ListRagFeedbackReq) Reset() {
+	*x = ListRagFeedbackReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_rag_service_rag_service_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListRagFeedbackReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListRagFeedbackReq) ProtoMessage() {}
+
+func (x *ListRagFeedbackReq) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_rag_service_rag_service_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListRagFeedbackReq.ProtoReflect.Descriptor instead.
+func (*ListRagFeedbackReq) Descriptor() ([]byte, []int) {
+	return file_proto_rag_service_rag_service_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *ListRagFeedbackReq) GetRagId() string {
+	if x != nil {
+		return x.RagId
+	}
+	return ""
+}
+
+func (x *ListRagFeedbackReq) GetRating() int32 {
+	if x != nil {
+		return x.Rating
+	}
+	return 0
+}
+
+func (x *ListRagFeedbackReq) GetStartTime() int64 {
+	if x != nil {
+		return x.StartTime
+	}
+	return 0
+}
+
+func (x *ListRagFeedbackReq) GetEndTime() int64 {
+	if x != nil {
+		return x.EndTime
+	}
+	return 0
+}
+
+func (x *ListRagFeedbackReq) GetPageNo() int32 {
+	if x != nil {
+		return x.PageNo
+	}
+	return 0
+}
+
+func (x *ListRagFeedbackReq) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListRagFeedbackReq) GetIdentity() *Identity {
+	if x != nil {
+		return x.Identity
+	}
+	return nil
+}
+
+type RagFeedbackList struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Data  []*RagFeedbackInfo `protobuf:"bytes,1,rep,name=data,proto3" json:"data,omitempty"`
+	Total int64              `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+}
+
+func (x *RagFeedbackList) Reset() {
+	*x = RagFeedbackList{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_rag_service_rag_service_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RagFeedbackList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RagFeedbackList) ProtoMessage() {}
+
+func (x *RagFeedbackList) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_rag_service_rag_service_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RagFeedbackList.ProtoReflect.Descriptor instead.
+func (*RagFeedbackList) Descriptor() ([]byte, []int) {
+	return file_proto_rag_service_rag_service_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *RagFeedbackList) GetData() []*RagFeedbackInfo {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *RagFeedbackList) GetTotal() int64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+type RagFeedbackInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	FeedbackId string   `protobuf:"bytes,1,opt,name=feedbackId,proto3" json:"feedbackId,omitempty"`
+	RagId      string   `protobuf:"bytes,2,opt,name=ragId,proto3" json:"ragId,omitempty"`
+	MsgId      string   `protobuf:"bytes,3,opt,name=msgId,proto3" json:"msgId,omitempty"`
+	Rating     int32    `protobuf:"varint,4,opt,name=rating,proto3" json:"rating,omitempty"`
+	Reasons    []string `protobuf:"bytes,5,rep,name=reasons,proto3" json:"reasons,omitempty"`
+	Content    string   `protobuf:"bytes,6,opt,name=content,proto3" json:"content,omitempty"`
+	Question   string   `protobuf:"bytes,7,opt,name=question,proto3" json:"question,omitempty"`
+	Answer     string   `protobuf:"bytes,8,opt,name=answer,proto3" json:"answer,omitempty"`
+	SearchList string   `protobuf:"bytes,9,opt,name=searchList,proto3" json:"searchList,omitempty"`
+	UserId     string   `protobuf:"bytes,10,opt,name=userId,proto3" json:"userId,omitempty"`
+	CreatedAt  int64    `protobuf:"varint,11,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
+	UpdatedAt  int64    `protobuf:"varint,12,opt,name=updatedAt,proto3" json:"updatedAt,omitempty"`
+}
+
+func (x *RagFeedbackInfo) Reset() {
+	*x = RagFeedbackInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_rag_service_rag_service_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RagFeedbackInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RagFeedbackInfo) ProtoMessage() {}
+
+func (x *RagFeedbackInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_rag_service_rag_service_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RagFeedbackInfo.ProtoReflect.Descriptor instead.
+func (*RagFeedbackInfo) Descriptor() ([]byte, []int) {
+	return file_proto_rag_service_rag_service_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *RagFeedbackInfo) GetFeedbackId() string {
+	if x != nil {
+		return x.FeedbackId
+	}
+	return ""
+}
+
+func (x *RagFeedbackInfo) GetRagId() string {
+	if x != nil {
+		return x.RagId
+	}
+	return ""
+}
+
+func (x *RagFeedbackInfo) GetMsgId() string {
+	if x != nil {
+		return x.MsgId
+	}
+	return ""
+}
+
+func (x *RagFeedbackInfo) GetRating() int32 {
+	if x != nil {
+		return x.Rating
+	}
+	return 0
+}
+
+func (x *RagFeedbackInfo) GetReasons() []string {
+	if x != nil {
+		return x.Reasons
+	}
+	return nil
+}
+
+func (x *RagFeedbackInfo) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+func (x *RagFeedbackInfo) GetQuestion() string {
+	if x != nil {
+		return x.Question
+	}
+	return ""
+}
+
+func (x *RagFeedbackInfo) GetAnswer() string {
+	if x != nil {
+		return x.Answer
+	}
+	return ""
+}
+
+func (x *RagFeedbackInfo) GetSearchList() string {
+	if x != nil {
+		return x.SearchList
+	}
+	return ""
+}
+
+func (x *RagFeedbackInfo) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *RagFeedbackInfo) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+func (x *RagFeedbackInfo) GetUpdatedAt() int64 {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return 0
+}
+
 var File_proto_rag_service_rag_service_proto protoreflect.FileDescriptor
 
 var file_proto_rag_service_rag_service_proto_rawDesc = []byte{
@@ -1475,46 +1847,109 @@ var file_proto_rag_service_rag_service_proto_rawDesc = []byte{
 	0x42, 0x72, 0x69, 0x65, 0x66, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x2c, 0x0a, 0x08, 0x72, 0x61, 0x67,
 	0x49, 0x6e, 0x66, 0x6f, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x63, 0x6f,
 	0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x41, 0x70, 0x70, 0x42, 0x72, 0x69, 0x65, 0x66, 0x52, 0x08, 0x72,
-	0x61, 0x67, 0x49, 0x6e, 0x66, 0x6f, 0x73, 0x32, 0xb2, 0x04, 0x0a, 0x0a, 0x52, 0x61, 0x67, 0x53,
-	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x40, 0x0a, 0x07, 0x43, 0x68, 0x61, 0x74, 0x52, 0x61,
-	0x67, 0x12, 0x17, 0x2e, 0x72, 0x61, 0x67, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e,
-	0x43, 0x68, 0x61, 0x74, 0x52, 0x61, 0x67, 0x52, 0x65, 0x71, 0x1a, 0x18, 0x2e, 0x72, 0x61, 0x67,
-	0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x43, 0x68, 0x61, 0x74, 0x52, 0x61, 0x67,
-	0x52, 0x65, 0x73, 0x70, 0x22, 0x00, 0x30, 0x01, 0x12, 0x44, 0x0a, 0x09, 0x43, 0x72, 0x65, 0x61,
-	0x74, 0x65, 0x52, 0x61, 0x67, 0x12, 0x19, 0x2e, 0x72, 0x61, 0x67, 0x5f, 0x73, 0x65, 0x72, 0x76,
-	0x69, 0x63, 0x65, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x61, 0x67, 0x52, 0x65, 0x71,
-	0x1a, 0x1a, 0x2e, 0x72, 0x61, 0x67, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x43,
-	0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x61, 0x67, 0x52, 0x65, 0x73, 0x70, 0x22, 0x00, 0x12, 0x40,
-	0x0a, 0x09, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x61, 0x67, 0x12, 0x19, 0x2e, 0x72, 0x61,
-	0x67, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
-	0x52, 0x61, 0x67, 0x52, 0x65, 0x71, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00,
-	0x12, 0x4c, 0x0a, 0x0f, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x61, 0x67, 0x43, 0x6f, 0x6e,
-	0x66, 0x69, 0x67, 0x12, 0x1f, 0x2e, 0x72, 0x61, 0x67, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
-	0x65, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x61, 0x67, 0x43, 0x6f, 0x6e, 0x66, 0x69,
-	0x67, 0x52, 0x65, 0x71, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x40,
-	0x0a, 0x09, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x61, 0x67, 0x12, 0x19, 0x2e, 0x72, 0x61,
-	0x67, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x52, 0x61, 0x67, 0x44, 0x65, 0x6c,
-	0x65, 0x74, 0x65, 0x52, 0x65, 0x71, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00,
-	0x12, 0x41, 0x0a, 0x0c, 0x47, 0x65, 0x74, 0x52, 0x61, 0x67, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c,
-	0x12, 0x19, 0x2e, 0x72, 0x61, 0x67, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x52,
-	0x61, 0x67, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x52, 0x65, 0x71, 0x1a, 0x14, 0x2e, 0x72, 0x61,
-	0x67, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x52, 0x61, 0x67, 0x49, 0x6e, 0x66,
-	0x6f, 0x22, 0x00, 0x12, 0x3e, 0x0a, 0x07, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x61, 0x67, 0x12, 0x17,
+	0x61, 0x67, 0x49, 0x6e, 0x66, 0x6f, 0x73, 0x22, 0xbf, 0x01, 0x0a, 0x12, 0x43, 0x68, 0x61, 0x74,
+	0x52, 0x61, 0x67, 0x46, 0x65, 0x65, 0x64, 0x62, 0x61, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x12, 0x14,
+	0x0a, 0x05, 0x72, 0x61, 0x67, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x72,
+	0x61, 0x67, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x6d, 0x73, 0x67, 0x49, 0x64, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x6d, 0x73, 0x67, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x61,
+	0x74, 0x69, 0x6e, 0x67, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x72, 0x61, 0x74, 0x69,
+	0x6e, 0x67, 0x12, 0x18, 0x0a, 0x07, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x73, 0x18, 0x04, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x07, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x73, 0x12, 0x18, 0x0a, 0x07,
+	0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63,
+	0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x12, 0x31, 0x0a, 0x08, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69,
+	0x74, 0x79, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x72, 0x61, 0x67, 0x5f, 0x73,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x52,
+	0x08, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x22, 0xe1, 0x01, 0x0a, 0x12, 0x4c, 0x69,
+	0x73, 0x74, 0x52, 0x61, 0x67, 0x46, 0x65, 0x65, 0x64, 0x62, 0x61, 0x63, 0x6b, 0x52, 0x65, 0x71,
+	0x12, 0x14, 0x0a, 0x05, 0x72, 0x61, 0x67, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x72, 0x61, 0x67, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x61, 0x74, 0x69, 0x6e, 0x67,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x72, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x12, 0x1c,
+	0x0a, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07,
+	0x65, 0x6e, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x65,
+	0x6e, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x61, 0x67, 0x65, 0x4e, 0x6f,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x70, 0x61, 0x67, 0x65, 0x4e, 0x6f, 0x12, 0x1a,
+	0x0a, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x31, 0x0a, 0x08, 0x69, 0x64,
+	0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x72,
+	0x61, 0x67, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x49, 0x64, 0x65, 0x6e, 0x74,
+	0x69, 0x74, 0x79, 0x52, 0x08, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x22, 0x59, 0x0a,
+	0x0f, 0x52, 0x61, 0x67, 0x46, 0x65, 0x65, 0x64, 0x62, 0x61, 0x63, 0x6b, 0x4c, 0x69, 0x73, 0x74,
+	0x12, 0x30, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c,
 	0x2e, 0x72, 0x61, 0x67, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x52, 0x61, 0x67,
-	0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x1a, 0x18, 0x2e, 0x72, 0x61, 0x67, 0x5f, 0x73, 0x65,
-	0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x52, 0x61, 0x67, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73,
-	0x70, 0x22, 0x00, 0x12, 0x47, 0x0a, 0x0b, 0x47, 0x65, 0x74, 0x52, 0x61, 0x67, 0x42, 0x79, 0x49,
-	0x64, 0x73, 0x12, 0x1b, 0x2e, 0x72, 0x61, 0x67, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
-	0x2e, 0x47, 0x65, 0x74, 0x52, 0x61, 0x67, 0x42, 0x79, 0x49, 0x64, 0x73, 0x52, 0x65, 0x71, 0x1a,
-	0x19, 0x2e, 0x72, 0x61, 0x67, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x41, 0x70,
-	0x70, 0x42, 0x72, 0x69, 0x65, 0x66, 0x4c, 0x69, 0x73, 0x74, 0x22, 0x00, 0x42, 0x31, 0x5a, 0x2f,
-	0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x55, 0x6e, 0x69, 0x63, 0x6f,
-	0x6d, 0x41, 0x49, 0x2f, 0x77, 0x61, 0x6e, 0x77, 0x75, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x2f, 0x72, 0x61, 0x67, 0x2d, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x62,
-	0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x46, 0x65, 0x65, 0x64, 0x62, 0x61, 0x63, 0x6b, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x04, 0x64, 0x61,
+	0x74, 0x61, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x22, 0xd1, 0x02, 0x0a, 0x0f, 0x52, 0x61, 0x67,
+	0x46, 0x65, 0x65, 0x64, 0x62, 0x61, 0x63, 0x6b, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x1e, 0x0a, 0x0a,
+	0x66, 0x65, 0x65, 0x64, 0x62, 0x61, 0x63, 0x6b, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0a, 0x66, 0x65, 0x65, 0x64, 0x62, 0x61, 0x63, 0x6b, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05,
+	0x72, 0x61, 0x67, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x72, 0x61, 0x67,
+	0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x6d, 0x73, 0x67, 0x49, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x6d, 0x73, 0x67, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x61, 0x74, 0x69,
+	0x6e, 0x67, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x72, 0x61, 0x74, 0x69, 0x6e, 0x67,
+	0x12, 0x18, 0x0a, 0x07, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x07, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f,
+	0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x6e,
+	0x74, 0x65, 0x6e, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x71, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x71, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x16, 0x0a, 0x06, 0x61, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x61, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x12, 0x1e, 0x0a, 0x0a, 0x73, 0x65, 0x61, 0x72,
+	0x63, 0x68, 0x4c, 0x69, 0x73, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x73, 0x65,
+	0x61, 0x72, 0x63, 0x68, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72,
+	0x49, 0x64, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64,
+	0x12, 0x1c, 0x0a, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x0b, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x1c,
+	0x0a, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x0c, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x32, 0xd4, 0x05, 0x0a,
+	0x0a, 0x52, 0x61, 0x67, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x40, 0x0a, 0x07, 0x43,
+	0x68, 0x61, 0x74, 0x52, 0x61, 0x67, 0x12, 0x17, 0x2e, 0x72, 0x61, 0x67, 0x5f, 0x73, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x2e, 0x43, 0x68, 0x61, 0x74, 0x52, 0x61, 0x67, 0x52, 0x65, 0x71, 0x1a,
+	0x18, 0x2e, 0x72, 0x61, 0x67, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x43, 0x68,
+	0x61, 0x74, 0x52, 0x61, 0x67, 0x52, 0x65, 0x73, 0x70, 0x22, 0x00, 0x30, 0x01, 0x12, 0x44, 0x0a,
+	0x09, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x61, 0x67, 0x12, 0x19, 0x2e, 0x72, 0x61, 0x67,
+	0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52,
+	0x61, 0x67, 0x52, 0x65, 0x71, 0x1a, 0x1a, 0x2e, 0x72, 0x61, 0x67, 0x5f, 0x73, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x61, 0x67, 0x52, 0x65, 0x73,
+	0x70, 0x22, 0x00, 0x12, 0x40, 0x0a, 0x09, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x61, 0x67,
+	0x12, 0x19, 0x2e, 0x72, 0x61, 0x67, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x55,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x61, 0x67, 0x52, 0x65, 0x71, 0x1a, 0x16, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d,
+	0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x4c, 0x0a, 0x0f, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52,
+	0x61, 0x67, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x1f, 0x2e, 0x72, 0x61, 0x67, 0x5f, 0x73,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x61, 0x67,
+	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x71, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74,
+	0x79, 0x22, 0x00, 0x12, 0x40, 0x0a, 0x09, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x61, 0x67,
+	0x12, 0x19, 0x2e, 0x72, 0x61, 0x67, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x52,
+	0x61, 0x67, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x71, 0x1a, 0x16, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d,
+	0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x41, 0x0a, 0x0c, 0x47, 0x65, 0x74, 0x52, 0x61, 0x67, 0x44,
+	0x65, 0x74, 0x61, 0x69, 0x6c, 0x12, 0x19, 0x2e, 0x72, 0x61, 0x67, 0x5f, 0x73, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x2e, 0x52, 0x61, 0x67, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x52, 0x65, 0x71,
+	0x1a, 0x14, 0x2e, 0x72, 0x61, 0x67, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x52,
+	0x61, 0x67, 0x49, 0x6e, 0x66, 0x6f, 0x22, 0x00, 0x12, 0x3e, 0x0a, 0x07, 0x4c, 0x69, 0x73, 0x74,
+	0x52, 0x61, 0x67, 0x12, 0x17, 0x2e, 0x72, 0x61, 0x67, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x2e, 0x52, 0x61, 0x67, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x1a, 0x18, 0x2e, 0x72,
+	0x61, 0x67, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x52, 0x61, 0x67, 0x4c, 0x69,
+	0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x22, 0x00, 0x12, 0x47, 0x0a, 0x0b, 0x47, 0x65, 0x74, 0x52,
+	0x61, 0x67, 0x42, 0x79, 0x49, 0x64, 0x73, 0x12, 0x1b, 0x2e, 0x72, 0x61, 0x67, 0x5f, 0x73, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x61, 0x67, 0x42, 0x79, 0x49, 0x64,
+	0x73, 0x52, 0x65, 0x71, 0x1a, 0x19, 0x2e, 0x72, 0x61, 0x67, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x2e, 0x41, 0x70, 0x70, 0x42, 0x72, 0x69, 0x65, 0x66, 0x4c, 0x69, 0x73, 0x74, 0x22,
+	0x00, 0x12, 0x4c, 0x0a, 0x0f, 0x43, 0x68, 0x61, 0x74, 0x52, 0x61, 0x67, 0x46, 0x65, 0x65, 0x64,
+	0x62, 0x61, 0x63, 0x6b, 0x12, 0x1f, 0x2e, 0x72, 0x61, 0x67, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x2e, 0x43, 0x68, 0x61, 0x74, 0x52, 0x61, 0x67, 0x46, 0x65, 0x65, 0x64, 0x62, 0x61,
+	0x63, 0x6b, 0x52, 0x65, 0x71, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12,
+	0x52, 0x0a, 0x0f, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x61, 0x67, 0x46, 0x65, 0x65, 0x64, 0x62, 0x61,
+	0x63, 0x6b, 0x12, 0x1f, 0x2e, 0x72, 0x61, 0x67, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x2e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x61, 0x67, 0x46, 0x65, 0x65, 0x64, 0x62, 0x61, 0x63, 0x6b,
+	0x52, 0x65, 0x71, 0x1a, 0x1c, 0x2e, 0x72, 0x61, 0x67, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x2e, 0x52, 0x61, 0x67, 0x46, 0x65, 0x65, 0x64, 0x62, 0x61, 0x63, 0x6b, 0x4c, 0x69, 0x73,
+	0x74, 0x22, 0x00, 0x42, 0x31, 0x5a, 0x2f, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f,
+	0x6d, 0x2f, 0x55, 0x6e, 0x69, 0x63, 0x6f, 0x6d, 0x41, 0x49, 0x2f, 0x77, 0x61, 0x6e, 0x77, 0x75,
+	0x2f, 0x61, 0x70, 0x69, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x72, 0x61, 0x67, 0x2d, 0x73,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -1529,7 +1964,7 @@ func file_proto_rag_service_rag_service_proto_rawDescGZIP() []byte {
 	return file_proto_rag_service_rag_service_proto_rawDescData
 }
 
-var file_proto_rag_service_rag_service_proto_msgTypes = make([]protoimpl.MessageInfo, 21)
+var file_proto_rag_service_rag_service_proto_msgTypes = make([]protoimpl.MessageInfo, 25)
 var file_proto_rag_service_rag_service_proto_goTypes = []interface{}{
 	(*Identity)(nil),               // 0: rag_service.Identity
 	(*ChatRagReq)(nil),             // 1: rag_service.ChatRagReq
@@ -1552,24 +1987,28 @@ var file_proto_rag_service_rag_service_proto_goTypes = []interface{}{
 	(*RagListResp)(nil),            // 18: rag_service.RagListResp
 	(*GetRagByIdsReq)(nil),         // 19: rag_service.GetRagByIdsReq
 	(*AppBriefList)(nil),           // 20: rag_service.AppBriefList
-	(*common.AppBriefConfig)(nil),  // 21: common.AppBriefConfig
-	(*common.AppModelConfig)(nil),  // 22: common.AppModelConfig
-	(*common.AppBrief)(nil),        // 23: common.AppBrief
-	(*emptypb.Empty)(nil),          // 24: google.protobuf.Empty
+	(*ChatRagFeedbackReq)(nil),     // 21: rag_service.ChatRagFeedbackReq
+	(*ListRagFeedbackReq)(nil),     // 22: rag_service.ListRagFeedbackReq
+	(*RagFeedbackList)(nil),        // 23: rag_service.RagFeedbackList
+	(*RagFeedbackInfo)(nil),        // 24: rag_service.RagFeedbackInfo
+	(*common.AppBriefConfig)(nil),  // 25: common.AppBriefConfig
+	(*common.AppModelConfig)(nil),  // 26: common.AppModelConfig
+	(*common.AppBrief)(nil),        // 27: common.AppBrief
+	(*emptypb.Empty)(nil),          // 28: google.protobuf.Empty
 }
 var file_proto_rag_service_rag_service_proto_depIdxs = []int32{
 	0,  // 0: rag_service.ChatRagReq.identity:type_name -> rag_service.Identity
 	2,  // 1: rag_service.ChatRagReq.history:type_name -> rag_service.HistoryItem
-	21, // 2: rag_service.UpdateRagReq.appBrief:type_name -> common.AppBriefConfig
-	22, // 3: rag_service.UpdateRagConfigReq.modelConfig:type_name -> common.AppModelConfig
-	22, // 4: rag_service.UpdateRagConfigReq.rerankConfig:type_name -> common.AppModelConfig
+	25, // 2: rag_service.UpdateRagReq.appBrief:type_name -> common.AppBriefConfig
+	26, // 3: rag_service.UpdateRagConfigReq.modelConfig:type_name -> common.AppModelConfig
+	26, // 4: rag_service.UpdateRagConfigReq.rerankConfig:type_name -> common.AppModelConfig
 	11, // 5: rag_service.UpdateRagConfigReq.knowledgeBaseConfig:type_name -> rag_service.RagKnowledgeBaseConfig
 	16, // 6: rag_service.UpdateRagConfigReq.sensitiveConfig:type_name -> rag_service.RagSensitiveConfig
-	21, // 7: rag_service.CreateRagReq.appBrief:type_name -> common.AppBriefConfig
+	25, // 7: rag_service.CreateRagReq.appBrief:type_name -> common.AppBriefConfig
 	0,  // 8: rag_service.CreateRagReq.identity:type_name -> rag_service.Identity
-	21, // 9: rag_service.RagInfo.briefConfig:type_name -> common.AppBriefConfig
-	22, // 10: rag_service.RagInfo.modelConfig:type_name -> common.AppModelConfig
-	22, // 11: rag_service.RagInfo.rerankConfig:type_name -> common.AppModelConfig
+	25, // 9: rag_service.RagInfo.briefConfig:type_name -> common.AppBriefConfig
+	26, // 10: rag_service.RagInfo.modelConfig:type_name -> common.AppModelConfig
+	26, // 11: rag_service.RagInfo.rerankConfig:type_name -> common.AppModelConfig
 	11, // 12: rag_service.RagInfo.knowledgeBaseConfig:type_name -> rag_service.RagKnowledgeBaseConfig
 	16, // 13: rag_service.RagInfo.sensitiveConfig:type_name -> rag_service.RagSensitiveConfig
 	0,  // 14: rag_service.RagInfo.identity:type_name -> rag_service.Identity
@@ -1578,29 +2017,36 @@ var file_proto_rag_service_rag_service_proto_depIdxs = []int32{
 	13, // 17: rag_service.RagPerKnowledgeConfig.ragMetaFilter:type_name -> rag_service.RagMetaFilter
 	14, // 18: rag_service.RagMetaFilter.filterItems:type_name -> rag_service.RagMetaFilterItem
 	0,  // 19: rag_service.RagListReq.identity:type_name -> rag_service.Identity
-	23, // 20: rag_service.RagListResp.ragInfos:type_name -> common.AppBrief
-	23, // 21: rag_service.AppBriefList.ragInfos:type_name -> common.AppBrief
-	1,  // 22: rag_service.RagService.ChatRag:input_type -> rag_service.ChatRagReq
-	6,  // 23: rag_service.RagService.CreateRag:input_type -> rag_service.CreateRagReq
-	4,  // 24: rag_service.RagService.UpdateRag:input_type -> rag_service.UpdateRagReq
-	5,  // 25: rag_service.RagService.UpdateRagConfig:input_type -> rag_service.UpdateRagConfigReq
-	9,  // 26: rag_service.RagService.DeleteRag:input_type -> rag_service.RagDeleteReq
-	10, // 27: rag_service.RagService.GetRagDetail:input_type -> rag_service.RagDetailReq
-	17, // 28: rag_service.RagService.ListRag:input_type -> rag_service.RagListReq
-	19, // 29: rag_service.RagService.GetRagByIds:input_type -> rag_service.GetRagByIdsReq
-	3,  // 30: rag_service.RagService.ChatRag:output_type -> rag_service.ChatRagResp
-	7,  // 31: rag_service.RagService.CreateRag:output_type -> rag_service.CreateRagResp
-	24, // 32: rag_service.RagService.UpdateRag:output_type -> google.protobuf.Empty
-	24, // 33: rag_service.RagService.UpdateRagConfig:output_type -> google.protobuf.Empty
-	24, // 34: rag_service.RagService.DeleteRag:output_type -> google.protobuf.Empty
-	8,  // 35: rag_service.RagService.GetRagDetail:output_type -> rag_service.RagInfo
-	18, // 36: rag_service.RagService.ListRag:output_type -> rag_service.RagListResp
-	20, // 37: rag_service.RagService.GetRagByIds:output_type -> rag_service.AppBriefList
-	30, // [30:38] is the sub-list for method output_type
-	22, // [22:30] is the sub-list for method input_type
-	22, // [22:22] is the sub-list for extension type_name
-	22, // [22:22] is the sub-list for extension extendee
-	0,  // [0:22] is the sub-list for field type_name
+	27, // 20: rag_service.RagListResp.ragInfos:type_name -> common.AppBrief
+	27, // 21: rag_service.AppBriefList.ragInfos:type_name -> common.AppBrief
+	0,  // 22: rag_service.ChatRagFeedbackReq.identity:type_name -> rag_service.Identity
+	0,  // 23: rag_service.ListRagFeedbackReq.identity:type_name -> rag_service.Identity
+	24, // 24: rag_service.RagFeedbackList.data:type_name -> rag_service.RagFeedbackInfo
+	1,  // 25: rag_service.RagService.ChatRag:input_type -> rag_service.ChatRagReq
+	6,  // 26: rag_service.RagService.CreateRag:input_type -> rag_service.CreateRagReq
+	4,  // 27: rag_service.RagService.UpdateRag:input_type -> rag_service.UpdateRagReq
+	5,  // 28: rag_service.RagService.UpdateRagConfig:input_type -> rag_service.UpdateRagConfigReq
+	9,  // 29: rag_service.RagService.DeleteRag:input_type -> rag_service.RagDeleteReq
+	10, // 30: rag_service.RagService.GetRagDetail:input_type -> rag_service.RagDetailReq
+	17, // 31: rag_service.RagService.ListRag:input_type -> rag_service.RagListReq
+	19, // 32: rag_service.RagService.GetRagByIds:input_type -> rag_service.GetRagByIdsReq
+	21, // 33: rag_service.RagService.ChatRagFeedback:input_type -> rag_service.ChatRagFeedbackReq
+	22, // 34: rag_service.RagService.ListRagFeedback:input_type -> rag_service.ListRagFeedbackReq
+	3,  // 35: rag_service.RagService.ChatRag:output_type -> rag_service.ChatRagResp
+	7,  // 36: rag_service.RagService.CreateRag:output_type -> rag_service.CreateRagResp
+	28, // 37: rag_service.RagService.UpdateRag:output_type -> google.protobuf.Empty
+	28, // 38: rag_service.RagService.UpdateRagConfig:output_type -> google.protobuf.Empty
+	28, // 39: rag_service.RagService.DeleteRag:output_type -> google.protobuf.Empty
+	8,  // 40: rag_service.RagService.GetRagDetail:output_type -> rag_service.RagInfo
+	18, // 41: rag_service.RagService.ListRag:output_type -> rag_service.RagListResp
+	20, // 42: rag_service.RagService.GetRagByIds:output_type -> rag_service.AppBriefList
+	28, // 43: rag_service.RagService.ChatRagFeedback:output_type -> google.protobuf.Empty
+	23, // 44: rag_service.RagService.ListRagFeedback:output_type -> rag_service.RagFeedbackList
+	35, // [35:45] is the sub-list for method output_type
+	25, // [25:35] is the sub-list for method input_type
+	25, // [25:25] is the sub-list for extension type_name
+	25, // [25:25] is the sub-list for extension extendee
+	0,  // [0:25] is the sub-list for field type_name
 }
 
 func init() { file_proto_rag_service_rag_service_proto_init() }
@@ -1861,6 +2307,54 @@ func file_proto_rag_service_rag_service_proto_init() {
 				return nil
 			}
 		}
+		file_proto_rag_service_rag_service_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ChatRagFeedbackReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_rag_service_rag_service_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListRagFeedbackReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_rag_service_rag_service_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RagFeedbackList); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_rag_service_rag_service_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RagFeedbackInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -1868,7 +2362,7 @@ func file_proto_rag_service_rag_service_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_proto_rag_service_rag_service_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   21,
+			NumMessages:   25,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -28,6 +28,8 @@ const (
 	RagService_GetRagDetail_FullMethodName    = "/rag_service.RagService/GetRagDetail"
 	RagService_ListRag_FullMethodName         = "/rag_service.RagService/ListRag"
 	RagService_GetRagByIds_FullMethodName     = "/rag_service.RagService/GetRagByIds"
+	RagService_ChatRagFeedback_FullMethodName = "/rag_service.RagService/ChatRagFeedback"
+	RagService_ListRagFeedback_FullMethodName = "/rag_service.RagService/ListRagFeedback"
 )
 
 // RagServiceClient is the client API for RagService service.
@@ -42,7 +44,7 @@ type RagServiceClient interface {
 	UpdateRag(ctx context.Context, in *UpdateRagReq, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	// 更新 rag 配置信息
 	UpdateRagConfig(ctx context.Context, in *UpdateRagConfigReq, opts ...grpc.CallOption) (*emptypb.Empty, error)
-	// 删除 rag
+	//  删除 rag
 	DeleteRag(ctx context.Context, in *RagDeleteReq, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	// 获取 rag
 	GetRagDetail(ctx context.Context, in *RagDetailReq, opts ...grpc.CallOption) (*RagInfo, error)
@@ -50,6 +52,10 @@ type RagServiceClient interface {
 	ListRag(ctx context.Context, in *RagListReq, opts ...grpc.CallOption) (*RagListResp, error)
 	// 根据 ragIds 获取 rag 列表
 	GetRagByIds(ctx context.Context, in *GetRagByIdsReq, opts ...grpc.CallOption) (*AppBriefList, error)
+	// 评价 rag 回答
+	ChatRagFeedback(ctx context.Context, in *ChatRagFeedbackReq, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// 获取 rag 回答评价列表
+	ListRagFeedback(ctx context.Context, in *ListRagFeedbackReq, opts ...grpc.CallOption) (*RagFeedbackList, error)
 }
 
 type ragServiceClient struct {
@@ -149,6 +155,26 @@ func (c *ragServiceClient) GetRagByIds(ctx context.Context, in *GetRagByIdsReq,
 	return out, nil
 }
 
+func (c *ragServiceClient) ChatRagFeedback(ctx context.Context, in *ChatRagFeedbackReq, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, RagService_ChatRagFeedback_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ragServiceClient) ListRagFeedback(ctx context.Context, in *ListRagFeedbackReq, opts ...grpc.CallOption) (*RagFeedbackList, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RagFeedbackList)
+	err := c.cc.Invoke(ctx, RagService_ListRagFeedback_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // RagServiceServer is the server API for RagService service.
 // All implementations must embed UnimplementedRagServiceServer
 // for forward compatibility.
@@ -161,7 +187,7 @@ type RagServiceServer interface {
 	UpdateRag(context.Context, *UpdateRagReq) (*emptypb.Empty, error)
 	// 更新 rag 配置信息
 	UpdateRagConfig(context.Context, *UpdateRagConfigReq) (*emptypb.Empty, error)
-	// 删除 rag
+	//  删除 rag
 	DeleteRag(context.Context, *RagDeleteReq) (*emptypb.Empty, error)
 	// 获取 rag
 	GetRagDetail(context.Context, *RagDetailReq) (*RagInfo, error)
@@ -169,6 +195,10 @@ type RagServiceServer interface {
 	ListRag(context.Context, *RagListReq) (*RagListResp, error)
 	// 根据 ragIds 获取 rag 列表
 	GetRagByIds(context.Context, *GetRagByIdsReq) (*AppBriefList, error)
+	// 评价 rag 回答
+	ChatRagFeedback(context.Context, *ChatRagFeedbackReq) (*emptypb.Empty, error)
+	// 获取 rag 回答评价列表
+	ListRagFeedback(context.Context, *ListRagFeedbackReq) (*RagFeedbackList, error)
 	mustEmbedUnimplementedRagServiceServer()
 }
 
@@ -203,6 +233,12 @@ func (UnimplementedRagServiceServer) ListRag(context.Context, *RagListReq) (*Rag
 func (UnimplementedRagServiceServer) GetRagByIds(context.Context, *GetRagByIdsReq) (*AppBriefList, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetRagByIds not implemented")
 }
+func (UnimplementedRagServiceServer) ChatRagFeedback(context.Context, *ChatRagFeedbackReq) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ChatRagFeedback not implemented")
+}
+func (UnimplementedRagServiceServer) ListRagFeedback(context.Context, *ListRagFeedbackReq) (*RagFeedbackList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListRagFeedback not implemented")
+}
 func (UnimplementedRagServiceServer) mustEmbedUnimplementedRagServiceServer() {}
 func (UnimplementedRagServiceServer) testEmbeddedByValue()                    {}
 
@@ -361,6 +397,42 @@ func _RagService_GetRagByIds_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _RagService_ChatRagFeedback_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChatRagFeedbackReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RagServiceServer).ChatRagFeedback(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RagService_ChatRagFeedback_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RagServiceServer).ChatRagFeedback(ctx, req.(*ChatRagFeedbackReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RagService_ListRagFeedback_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListRagFeedbackReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RagServiceServer).ListRagFeedback(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RagService_ListRagFeedback_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RagServiceServer).ListRagFeedback(ctx, req.(*ListRagFeedbackReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // RagService_ServiceDesc is the grpc.ServiceDesc for RagService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -396,6 +468,14 @@ var RagService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetRagByIds",
 			Handler:    _RagService_GetRagByIds_Handler,
 		},
+		{
+			MethodName: "ChatRagFeedback",
+			Handler:    _RagService_ChatRagFeedback_Handler,
+		},
+		{
+			MethodName: "ListRagFeedback",
+			Handler:    _RagService_ListRagFeedback_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -1092,6 +1092,361 @@ func (x *SensitiveWordTableWithWords) GetSensitiveWords() []string {
 	return nil
 }
 
+type GetGuardrailPolicyReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AppId   string `protobuf:"bytes,1,opt,name=appId,proto3" json:"appId,omitempty"`
+	AppType string `protobuf:"bytes,2,opt,name=appType,proto3" json:"appType,omitempty"` // 应用类型：rag、agent
+}
+
+func (x *GetGuardrailPolicyReq) Reset() {
+	*x = GetGuardrailPolicyReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_safety_service_safety_service_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetGuardrailPolicyReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetGuardrailPolicyReq) ProtoMessage() {}
+
+func (x *GetGuardrailPolicyReq) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_safety_service_safety_service_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetGuardrailPolicyReq.ProtoReflect.Descriptor instead.
+func (*GetGuardrailPolicyReq) Descriptor() ([]byte, []int) {
+	return file_proto_safety_service_safety_service_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *GetGuardrailPolicyReq) GetAppId() string {
+	if x != nil {
+		return x.AppId
+	}
+	return ""
+}
+
+func (x *GetGuardrailPolicyReq) GetAppType() string {
+	if x != nil {
+		return x.AppType
+	}
+	return ""
+}
+
+type GuardrailPolicy struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AppId      string          `protobuf:"bytes,1,opt,name=appId,proto3" json:"appId,omitempty"`
+	AppType    string          `protobuf:"bytes,2,opt,name=appType,proto3" json:"appType,omitempty"`       // 应用类型：rag、agent
+	Enable     bool            `protobuf:"varint,3,opt,name=enable,proto3" json:"enable,omitempty"`        // 是否启用
+	Input      *GuardrailStage `protobuf:"bytes,4,opt,name=input,proto3" json:"input,omitempty"`           // 输入阶段（问题发送给模型前）
+	Output     *GuardrailStage `protobuf:"bytes,5,opt,name=output,proto3" json:"output,omitempty"`         // 输出阶段（流式回答）
+	BlockReply string          `protobuf:"bytes,6,opt,name=blockReply,proto3" json:"blockReply,omitempty"` // 拦截时的回复，为空时使用默认回复
+	OrgId      string          `protobuf:"bytes,7,opt,name=orgId,proto3" json:"orgId,omitempty"`
+	UserId     string          `protobuf:"bytes,8,opt,name=userId,proto3" json:"userId,omitempty"`
+	UpdatedAt  int64           `protobuf:"varint,9,opt,name=updatedAt,proto3" json:"updatedAt,omitempty"`
+}
+
+func (x *GuardrailPolicy) Reset() {
+	*x = GuardrailPolicy{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_safety_service_safety_service_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GuardrailPolicy) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GuardrailPolicy) ProtoMessage() {}
+
+func (x *GuardrailPolicy) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_safety_service_safety_service_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GuardrailPolicy.ProtoReflect.Descriptor instead.
+func (*GuardrailPolicy) Descriptor() ([]byte, []int) {
+	return file_proto_safety_service_safety_service_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *GuardrailPolicy) GetAppId() string {
+	if x != nil {
+		return x.AppId
+	}
+	return ""
+}
+
+func (x *GuardrailPolicy) GetAppType() string {
+	if x != nil {
+		return x.AppType
+	}
+	return ""
+}
+
+func (x *GuardrailPolicy) GetEnable() bool {
+	if x != nil {
+		return x.Enable
+	}
+	return false
+}
+
+func (x *GuardrailPolicy) GetInput() *GuardrailStage {
+	if x != nil {
+		return x.Input
+	}
+	return nil
+}
+
+func (x *GuardrailPolicy) GetOutput() *GuardrailStage {
+	if x != nil {
+		return x.Output
+	}
+	return nil
+}
+
+func (x *GuardrailPolicy) GetBlockReply() string {
+	if x != nil {
+		return x.BlockReply
+	}
+	return ""
+}
+
+func (x *GuardrailPolicy) GetOrgId() string {
+	if x != nil {
+		return x.OrgId
+	}
+	return ""
+}
+
+func (x *GuardrailPolicy) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *GuardrailPolicy) GetUpdatedAt() int64 {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return 0
+}
+
+type GuardrailStage struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Detectors  []*GuardrailDetector `protobuf:"bytes,1,rep,name=detectors,proto3" json:"detectors,omitempty"`   // 规则检测
+	Classifier *GuardrailClassifier `protobuf:"bytes,2,opt,name=classifier,proto3" json:"classifier,omitempty"` // 分类模型检测
+}
+
+func (x *GuardrailStage) Reset() {
+	*x = GuardrailStage{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_safety_service_safety_service_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GuardrailStage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GuardrailStage) ProtoMessage() {}
+
+func (x *GuardrailStage) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_safety_service_safety_service_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GuardrailStage.ProtoReflect.Descriptor instead.
+func (*GuardrailStage) Descriptor() ([]byte, []int) {
+	return file_proto_safety_service_safety_service_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *GuardrailStage) GetDetectors() []*GuardrailDetector {
+	if x != nil {
+		return x.Detectors
+	}
+	return nil
+}
+
+func (x *GuardrailStage) GetClassifier() *GuardrailClassifier {
+	if x != nil {
+		return x.Classifier
+	}
+	return nil
+}
+
+type GuardrailDetector struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Type    string `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`       // 检测器类型：phone、id_card、email、bank_card、regex
+	Name    string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`       // 规则名称，redact时作为自定义正则的占位符
+	Pattern string `protobuf:"bytes,3,opt,name=pattern,proto3" json:"pattern,omitempty"` // 正则表达式，仅regex类型
+	Action  string `protobuf:"bytes,4,opt,name=action,proto3" json:"action,omitempty"`   // 处理动作：mask、redact、block
+}
+
+func (x *GuardrailDetector) Reset() {
+	*x = GuardrailDetector{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_safety_service_safety_service_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GuardrailDetector) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GuardrailDetector) ProtoMessage() {}
+
+func (x *GuardrailDetector) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_safety_service_safety_service_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GuardrailDetector.ProtoReflect.Descriptor instead.
+func (*GuardrailDetector) Descriptor() ([]byte, []int) {
+	return file_proto_safety_service_safety_service_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *GuardrailDetector) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *GuardrailDetector) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *GuardrailDetector) GetPattern() string {
+	if x != nil {
+		return x.Pattern
+	}
+	return ""
+}
+
+func (x *GuardrailDetector) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
+type GuardrailClassifier struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Enable  bool   `protobuf:"varint,1,opt,name=enable,proto3" json:"enable,omitempty"`
+	ModelId string `protobuf:"bytes,2,opt,name=modelId,proto3" json:"modelId,omitempty"` // 分类模型（已导入的大模型）
+	Prompt  string `protobuf:"bytes,3,opt,name=prompt,proto3" json:"prompt,omitempty"`   // 分类提示词，为空时使用默认提示词
+}
+
+func (x *GuardrailClassifier) Reset() {
+	*x = GuardrailClassifier{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_safety_service_safety_service_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GuardrailClassifier) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GuardrailClassifier) ProtoMessage() {}
+
+func (x *GuardrailClassifier) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_safety_service_safety_service_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GuardrailClassifier.ProtoReflect.Descriptor instead.
+func (*GuardrailClassifier) Descriptor() ([]byte, []int) {
+	return file_proto_safety_service_safety_service_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *GuardrailClassifier) GetEnable() bool {
+	if x != nil {
+		return x.Enable
+	}
+	return false
+}
+
+func (x *GuardrailClassifier) GetModelId() string {
+	if x != nil {
+		return x.ModelId
+	}
+	return ""
+}
+
+func (x *GuardrailClassifier) GetPrompt() string {
+	if x != nil {
+		return x.Prompt
+	}
+	return ""
+}
+
 var File_proto_safety_service_safety_service_proto protoreflect.FileDescriptor
 
 var file_proto_safety_service_safety_service_proto_rawDesc = []byte{
@@ -1225,90 +1580,147 @@ var file_proto_safety_service_safety_service_proto_rawDesc = []byte{
 	0x6c, 0x65, 0x52, 0x05, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x26, 0x0a, 0x0e, 0x73, 0x65, 0x6e,
 	0x73, 0x69, 0x74, 0x69, 0x76, 0x65, 0x57, 0x6f, 0x72, 0x64, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28,
 	0x09, 0x52, 0x0e, 0x73, 0x65, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x76, 0x65, 0x57, 0x6f, 0x72, 0x64,
-	0x73, 0x32, 0xf2, 0x09, 0x0a, 0x0d, 0x53, 0x61, 0x66, 0x65, 0x74, 0x79, 0x53, 0x65, 0x72, 0x76,
-	0x69, 0x63, 0x65, 0x12, 0x77, 0x0a, 0x18, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x65, 0x6e,
-	0x73, 0x69, 0x74, 0x69, 0x76, 0x65, 0x57, 0x6f, 0x72, 0x64, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x12,
-	0x2b, 0x2e, 0x73, 0x61, 0x66, 0x65, 0x74, 0x79, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
-	0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x65, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x76, 0x65,
-	0x57, 0x6f, 0x72, 0x64, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x1a, 0x2c, 0x2e, 0x73,
-	0x61, 0x66, 0x65, 0x74, 0x79, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x43, 0x72,
-	0x65, 0x61, 0x74, 0x65, 0x53, 0x65, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x76, 0x65, 0x57, 0x6f, 0x72,
-	0x64, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x22, 0x00, 0x12, 0x61, 0x0a, 0x18,
-	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x65, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x76, 0x65, 0x57,
-	0x6f, 0x72, 0x64, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x2b, 0x2e, 0x73, 0x61, 0x66, 0x65, 0x74,
-	0x79, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
-	0x53, 0x65, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x76, 0x65, 0x57, 0x6f, 0x72, 0x64, 0x54, 0x61, 0x62,
-	0x6c, 0x65, 0x52, 0x65, 0x71, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12,
-	0x6b, 0x0a, 0x1d, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x65, 0x6e, 0x73, 0x69, 0x74, 0x69,
-	0x76, 0x65, 0x57, 0x6f, 0x72, 0x64, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79,
-	0x12, 0x30, 0x2e, 0x73, 0x61, 0x66, 0x65, 0x74, 0x79, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x73, 0x22, 0x47, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x47, 0x75, 0x61, 0x72, 0x64, 0x72, 0x61, 0x69,
+	0x6c, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x65, 0x71, 0x12, 0x14, 0x0a, 0x05, 0x61, 0x70,
+	0x70, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x61, 0x70, 0x70, 0x49, 0x64,
+	0x12, 0x18, 0x0a, 0x07, 0x61, 0x70, 0x70, 0x54, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x61, 0x70, 0x70, 0x54, 0x79, 0x70, 0x65, 0x22, 0xb3, 0x02, 0x0a, 0x0f, 0x47,
+	0x75, 0x61, 0x72, 0x64, 0x72, 0x61, 0x69, 0x6c, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x14,
+	0x0a, 0x05, 0x61, 0x70, 0x70, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x61,
+	0x70, 0x70, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x70, 0x70, 0x54, 0x79, 0x70, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x70, 0x70, 0x54, 0x79, 0x70, 0x65, 0x12, 0x16,
+	0x0a, 0x06, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06,
+	0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x34, 0x0a, 0x05, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x73, 0x61, 0x66, 0x65, 0x74, 0x79, 0x5f, 0x73,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x47, 0x75, 0x61, 0x72, 0x64, 0x72, 0x61, 0x69, 0x6c,
+	0x53, 0x74, 0x61, 0x67, 0x65, 0x52, 0x05, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x12, 0x36, 0x0a, 0x06,
+	0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x73,
+	0x61, 0x66, 0x65, 0x74, 0x79, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x47, 0x75,
+	0x61, 0x72, 0x64, 0x72, 0x61, 0x69, 0x6c, 0x53, 0x74, 0x61, 0x67, 0x65, 0x52, 0x06, 0x6f, 0x75,
+	0x74, 0x70, 0x75, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x70,
+	0x6c, 0x79, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x52,
+	0x65, 0x70, 0x6c, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x6f, 0x72, 0x67, 0x49, 0x64, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x6f, 0x72, 0x67, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73,
+	0x65, 0x72, 0x49, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72,
+	0x49, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x18,
+	0x09, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74,
+	0x22, 0x96, 0x01, 0x0a, 0x0e, 0x47, 0x75, 0x61, 0x72, 0x64, 0x72, 0x61, 0x69, 0x6c, 0x53, 0x74,
+	0x61, 0x67, 0x65, 0x12, 0x3f, 0x0a, 0x09, 0x64, 0x65, 0x74, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x73, 0x61, 0x66, 0x65, 0x74, 0x79, 0x5f,
+	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x47, 0x75, 0x61, 0x72, 0x64, 0x72, 0x61, 0x69,
+	0x6c, 0x44, 0x65, 0x74, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x52, 0x09, 0x64, 0x65, 0x74, 0x65, 0x63,
+	0x74, 0x6f, 0x72, 0x73, 0x12, 0x43, 0x0a, 0x0a, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x69, 0x66, 0x69,
+	0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x73, 0x61, 0x66, 0x65, 0x74,
+	0x79, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x47, 0x75, 0x61, 0x72, 0x64, 0x72,
+	0x61, 0x69, 0x6c, 0x43, 0x6c, 0x61, 0x73, 0x73, 0x69, 0x66, 0x69, 0x65, 0x72, 0x52, 0x0a, 0x63,
+	0x6c, 0x61, 0x73, 0x73, 0x69, 0x66, 0x69, 0x65, 0x72, 0x22, 0x6d, 0x0a, 0x11, 0x47, 0x75, 0x61,
+	0x72, 0x64, 0x72, 0x61, 0x69, 0x6c, 0x44, 0x65, 0x74, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x12, 0x12,
+	0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79,
+	0x70, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x61, 0x74, 0x74, 0x65, 0x72,
+	0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x70, 0x61, 0x74, 0x74, 0x65, 0x72, 0x6e,
+	0x12, 0x16, 0x0a, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x5f, 0x0a, 0x13, 0x47, 0x75, 0x61, 0x72,
+	0x64, 0x72, 0x61, 0x69, 0x6c, 0x43, 0x6c, 0x61, 0x73, 0x73, 0x69, 0x66, 0x69, 0x65, 0x72, 0x12,
+	0x16, 0x0a, 0x06, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x06, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x6f, 0x64, 0x65, 0x6c,
+	0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x49,
+	0x64, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x72, 0x6f, 0x6d, 0x70, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x70, 0x72, 0x6f, 0x6d, 0x70, 0x74, 0x32, 0xa4, 0x0b, 0x0a, 0x0d, 0x53, 0x61,
+	0x66, 0x65, 0x74, 0x79, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x77, 0x0a, 0x18, 0x43,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x65, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x76, 0x65, 0x57, 0x6f,
+	0x72, 0x64, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x2b, 0x2e, 0x73, 0x61, 0x66, 0x65, 0x74, 0x79,
+	0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53,
+	0x65, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x76, 0x65, 0x57, 0x6f, 0x72, 0x64, 0x54, 0x61, 0x62, 0x6c,
+	0x65, 0x52, 0x65, 0x71, 0x1a, 0x2c, 0x2e, 0x73, 0x61, 0x66, 0x65, 0x74, 0x79, 0x5f, 0x73, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x65, 0x6e, 0x73,
+	0x69, 0x74, 0x69, 0x76, 0x65, 0x57, 0x6f, 0x72, 0x64, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x52, 0x65,
+	0x73, 0x70, 0x22, 0x00, 0x12, 0x61, 0x0a, 0x18, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x65,
+	0x6e, 0x73, 0x69, 0x74, 0x69, 0x76, 0x65, 0x57, 0x6f, 0x72, 0x64, 0x54, 0x61, 0x62, 0x6c, 0x65,
+	0x12, 0x2b, 0x2e, 0x73, 0x61, 0x66, 0x65, 0x74, 0x79, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
 	0x65, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x65, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x76,
-	0x65, 0x57, 0x6f, 0x72, 0x64, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x52,
-	0x65, 0x71, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x61, 0x0a, 0x18,
-	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x65, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x76, 0x65, 0x57,
-	0x6f, 0x72, 0x64, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x2b, 0x2e, 0x73, 0x61, 0x66, 0x65, 0x74,
-	0x79, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
+	0x65, 0x57, 0x6f, 0x72, 0x64, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x1a, 0x16, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x6b, 0x0a, 0x1d, 0x55, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x53, 0x65, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x76, 0x65, 0x57, 0x6f, 0x72, 0x64, 0x54, 0x61,
+	0x62, 0x6c, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x30, 0x2e, 0x73, 0x61, 0x66, 0x65, 0x74,
+	0x79, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
 	0x53, 0x65, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x76, 0x65, 0x57, 0x6f, 0x72, 0x64, 0x54, 0x61, 0x62,
-	0x6c, 0x65, 0x52, 0x65, 0x71, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12,
-	0x70, 0x0a, 0x19, 0x47, 0x65, 0x74, 0x53, 0x65, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x76, 0x65, 0x57,
-	0x6f, 0x72, 0x64, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x2c, 0x2e, 0x73,
-	0x61, 0x66, 0x65, 0x74, 0x79, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x47, 0x65,
-	0x74, 0x53, 0x65, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x76, 0x65, 0x57, 0x6f, 0x72, 0x64, 0x54, 0x61,
-	0x62, 0x6c, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x1a, 0x23, 0x2e, 0x73, 0x61, 0x66,
-	0x65, 0x74, 0x79, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x53, 0x65, 0x6e, 0x73,
-	0x69, 0x74, 0x69, 0x76, 0x65, 0x57, 0x6f, 0x72, 0x64, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x22,
-	0x00, 0x12, 0x7a, 0x0a, 0x1e, 0x47, 0x65, 0x74, 0x53, 0x65, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x76,
-	0x65, 0x57, 0x6f, 0x72, 0x64, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x79,
-	0x49, 0x44, 0x73, 0x12, 0x31, 0x2e, 0x73, 0x61, 0x66, 0x65, 0x74, 0x79, 0x5f, 0x73, 0x65, 0x72,
-	0x76, 0x69, 0x63, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x65, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x76,
-	0x65, 0x57, 0x6f, 0x72, 0x64, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x79,
-	0x49, 0x44, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x23, 0x2e, 0x73, 0x61, 0x66, 0x65, 0x74, 0x79, 0x5f,
-	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x53, 0x65, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x76,
-	0x65, 0x57, 0x6f, 0x72, 0x64, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x22, 0x00, 0x12, 0x6f, 0x0a,
-	0x19, 0x47, 0x65, 0x74, 0x53, 0x65, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x76, 0x65, 0x57, 0x6f, 0x72,
-	0x64, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x42, 0x79, 0x49, 0x44, 0x12, 0x2c, 0x2e, 0x73, 0x61, 0x66,
-	0x65, 0x74, 0x79, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x53,
-	0x65, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x76, 0x65, 0x57, 0x6f, 0x72, 0x64, 0x54, 0x61, 0x62, 0x6c,
-	0x65, 0x42, 0x79, 0x49, 0x44, 0x52, 0x65, 0x71, 0x1a, 0x22, 0x2e, 0x73, 0x61, 0x66, 0x65, 0x74,
-	0x79, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x53, 0x65, 0x6e, 0x73, 0x69, 0x74,
-	0x69, 0x76, 0x65, 0x57, 0x6f, 0x72, 0x64, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x22, 0x00, 0x12, 0x63,
-	0x0a, 0x19, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x53, 0x65, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x76,
-	0x65, 0x56, 0x6f, 0x63, 0x61, 0x62, 0x75, 0x6c, 0x61, 0x72, 0x79, 0x12, 0x2c, 0x2e, 0x73, 0x61,
-	0x66, 0x65, 0x74, 0x79, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x55, 0x70, 0x6c,
-	0x6f, 0x61, 0x64, 0x53, 0x65, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x76, 0x65, 0x56, 0x6f, 0x63, 0x61,
-	0x62, 0x75, 0x6c, 0x61, 0x72, 0x79, 0x52, 0x65, 0x71, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
-	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74,
-	0x79, 0x22, 0x00, 0x12, 0x63, 0x0a, 0x19, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x65, 0x6e,
-	0x73, 0x69, 0x74, 0x69, 0x76, 0x65, 0x56, 0x6f, 0x63, 0x61, 0x62, 0x75, 0x6c, 0x61, 0x72, 0x79,
-	0x12, 0x2c, 0x2e, 0x73, 0x61, 0x66, 0x65, 0x74, 0x79, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x6c, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x52, 0x65, 0x71, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70,
+	0x74, 0x79, 0x22, 0x00, 0x12, 0x61, 0x0a, 0x18, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x65,
+	0x6e, 0x73, 0x69, 0x74, 0x69, 0x76, 0x65, 0x57, 0x6f, 0x72, 0x64, 0x54, 0x61, 0x62, 0x6c, 0x65,
+	0x12, 0x2b, 0x2e, 0x73, 0x61, 0x66, 0x65, 0x74, 0x79, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
 	0x65, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x65, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x76,
-	0x65, 0x56, 0x6f, 0x63, 0x61, 0x62, 0x75, 0x6c, 0x61, 0x72, 0x79, 0x52, 0x65, 0x71, 0x1a, 0x16,
-	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
-	0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x7a, 0x0a, 0x1a, 0x47, 0x65, 0x74, 0x53,
-	0x65, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x76, 0x65, 0x56, 0x6f, 0x63, 0x61, 0x62, 0x75, 0x6c, 0x61,
-	0x72, 0x79, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x2d, 0x2e, 0x73, 0x61, 0x66, 0x65, 0x74, 0x79, 0x5f,
-	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x65, 0x6e, 0x73, 0x69,
-	0x74, 0x69, 0x76, 0x65, 0x56, 0x6f, 0x63, 0x61, 0x62, 0x75, 0x6c, 0x61, 0x72, 0x79, 0x4c, 0x69,
-	0x73, 0x74, 0x52, 0x65, 0x71, 0x1a, 0x2b, 0x2e, 0x73, 0x61, 0x66, 0x65, 0x74, 0x79, 0x5f, 0x73,
-	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x53, 0x65, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x76, 0x65,
-	0x57, 0x6f, 0x72, 0x64, 0x56, 0x6f, 0x63, 0x61, 0x62, 0x75, 0x6c, 0x61, 0x72, 0x79, 0x52, 0x65,
-	0x73, 0x70, 0x22, 0x00, 0x12, 0x8f, 0x01, 0x0a, 0x27, 0x47, 0x65, 0x74, 0x53, 0x65, 0x6e, 0x73,
-	0x69, 0x74, 0x69, 0x76, 0x65, 0x57, 0x6f, 0x72, 0x64, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x4c, 0x69,
-	0x73, 0x74, 0x57, 0x69, 0x74, 0x68, 0x57, 0x6f, 0x72, 0x64, 0x73, 0x42, 0x79, 0x49, 0x44, 0x73,
-	0x12, 0x31, 0x2e, 0x73, 0x61, 0x66, 0x65, 0x74, 0x79, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
-	0x65, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x65, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x76, 0x65, 0x57, 0x6f,
-	0x72, 0x64, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x79, 0x49, 0x44, 0x73,
-	0x52, 0x65, 0x71, 0x1a, 0x2f, 0x2e, 0x73, 0x61, 0x66, 0x65, 0x74, 0x79, 0x5f, 0x73, 0x65, 0x72,
-	0x76, 0x69, 0x63, 0x65, 0x2e, 0x53, 0x65, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x76, 0x65, 0x57, 0x6f,
-	0x72, 0x64, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x57, 0x69, 0x74, 0x68, 0x57,
-	0x6f, 0x72, 0x64, 0x73, 0x22, 0x00, 0x42, 0x34, 0x5a, 0x32, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62,
-	0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x55, 0x6e, 0x69, 0x63, 0x6f, 0x6d, 0x41, 0x49, 0x2f, 0x77, 0x61,
-	0x6e, 0x77, 0x75, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x73, 0x61,
-	0x66, 0x65, 0x74, 0x79, 0x2d, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x62, 0x06, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x33,
+	0x65, 0x57, 0x6f, 0x72, 0x64, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x1a, 0x16, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x70, 0x0a, 0x19, 0x47, 0x65, 0x74, 0x53, 0x65,
+	0x6e, 0x73, 0x69, 0x74, 0x69, 0x76, 0x65, 0x57, 0x6f, 0x72, 0x64, 0x54, 0x61, 0x62, 0x6c, 0x65,
+	0x4c, 0x69, 0x73, 0x74, 0x12, 0x2c, 0x2e, 0x73, 0x61, 0x66, 0x65, 0x74, 0x79, 0x5f, 0x73, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x65, 0x6e, 0x73, 0x69, 0x74, 0x69,
+	0x76, 0x65, 0x57, 0x6f, 0x72, 0x64, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x52,
+	0x65, 0x71, 0x1a, 0x23, 0x2e, 0x73, 0x61, 0x66, 0x65, 0x74, 0x79, 0x5f, 0x73, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x2e, 0x53, 0x65, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x76, 0x65, 0x57, 0x6f, 0x72,
+	0x64, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x22, 0x00, 0x12, 0x7a, 0x0a, 0x1e, 0x47, 0x65, 0x74,
+	0x53, 0x65, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x76, 0x65, 0x57, 0x6f, 0x72, 0x64, 0x54, 0x61, 0x62,
+	0x6c, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x79, 0x49, 0x44, 0x73, 0x12, 0x31, 0x2e, 0x73, 0x61,
+	0x66, 0x65, 0x74, 0x79, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x47, 0x65, 0x74,
+	0x53, 0x65, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x76, 0x65, 0x57, 0x6f, 0x72, 0x64, 0x54, 0x61, 0x62,
+	0x6c, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x79, 0x49, 0x44, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x23,
+	0x2e, 0x73, 0x61, 0x66, 0x65, 0x74, 0x79, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e,
+	0x53, 0x65, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x76, 0x65, 0x57, 0x6f, 0x72, 0x64, 0x54, 0x61, 0x62,
+	0x6c, 0x65, 0x73, 0x22, 0x00, 0x12, 0x6f, 0x0a, 0x19, 0x47, 0x65, 0x74, 0x53, 0x65, 0x6e, 0x73,
+	0x69, 0x74, 0x69, 0x76, 0x65, 0x57, 0x6f, 0x72, 0x64, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x42, 0x79,
+	0x49, 0x44, 0x12, 0x2c, 0x2e, 0x73, 0x61, 0x66, 0x65, 0x74, 0x79, 0x5f, 0x73, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x65, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x76, 0x65,
+	0x57, 0x6f, 0x72, 0x64, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x42, 0x79, 0x49, 0x44, 0x52, 0x65, 0x71,
+	0x1a, 0x22, 0x2e, 0x73, 0x61, 0x66, 0x65, 0x74, 0x79, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x2e, 0x53, 0x65, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x76, 0x65, 0x57, 0x6f, 0x72, 0x64, 0x54,
+	0x61, 0x62, 0x6c, 0x65, 0x22, 0x00, 0x12, 0x63, 0x0a, 0x19, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64,
+	0x53, 0x65, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x76, 0x65, 0x56, 0x6f, 0x63, 0x61, 0x62, 0x75, 0x6c,
+	0x61, 0x72, 0x79, 0x12, 0x2c, 0x2e, 0x73, 0x61, 0x66, 0x65, 0x74, 0x79, 0x5f, 0x73, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x2e, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x53, 0x65, 0x6e, 0x73, 0x69,
+	0x74, 0x69, 0x76, 0x65, 0x56, 0x6f, 0x63, 0x61, 0x62, 0x75, 0x6c, 0x61, 0x72, 0x79, 0x52, 0x65,
+	0x71, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x63, 0x0a, 0x19, 0x44,
+	0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x65, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x76, 0x65, 0x56, 0x6f,
+	0x63, 0x61, 0x62, 0x75, 0x6c, 0x61, 0x72, 0x79, 0x12, 0x2c, 0x2e, 0x73, 0x61, 0x66, 0x65, 0x74,
+	0x79, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
+	0x53, 0x65, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x76, 0x65, 0x56, 0x6f, 0x63, 0x61, 0x62, 0x75, 0x6c,
+	0x61, 0x72, 0x79, 0x52, 0x65, 0x71, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00,
+	0x12, 0x7a, 0x0a, 0x1a, 0x47, 0x65, 0x74, 0x53, 0x65, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x76, 0x65,
+	0x56, 0x6f, 0x63, 0x61, 0x62, 0x75, 0x6c, 0x61, 0x72, 0x79, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x2d,
+	0x2e, 0x73, 0x61, 0x66, 0x65, 0x74, 0x79, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e,
+	0x47, 0x65, 0x74, 0x53, 0x65, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x76, 0x65, 0x56, 0x6f, 0x63, 0x61,
+	0x62, 0x75, 0x6c, 0x61, 0x72, 0x79, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x1a, 0x2b, 0x2e,
+	0x73, 0x61, 0x66, 0x65, 0x74, 0x79, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x53,
+	0x65, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x76, 0x65, 0x57, 0x6f, 0x72, 0x64, 0x56, 0x6f, 0x63, 0x61,
+	0x62, 0x75, 0x6c, 0x61, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x22, 0x00, 0x12, 0x8f, 0x01, 0x0a,
+	0x27, 0x47, 0x65, 0x74, 0x53, 0x65, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x76, 0x65, 0x57, 0x6f, 0x72,
+	0x64, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x57, 0x69, 0x74, 0x68, 0x57, 0x6f,
+	0x72, 0x64, 0x73, 0x42, 0x79, 0x49, 0x44, 0x73, 0x12, 0x31, 0x2e, 0x73, 0x61, 0x66, 0x65, 0x74,
+	0x79, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x65, 0x6e,
+	0x73, 0x69, 0x74, 0x69, 0x76, 0x65, 0x57, 0x6f, 0x72, 0x64, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x4c,
+	0x69, 0x73, 0x74, 0x42, 0x79, 0x49, 0x44, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x2f, 0x2e, 0x73, 0x61,
+	0x66, 0x65, 0x74, 0x79, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x53, 0x65, 0x6e,
+	0x73, 0x69, 0x74, 0x69, 0x76, 0x65, 0x57, 0x6f, 0x72, 0x64, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x4c,
+	0x69, 0x73, 0x74, 0x57, 0x69, 0x74, 0x68, 0x57, 0x6f, 0x72, 0x64, 0x73, 0x22, 0x00, 0x12, 0x50,
+	0x0a, 0x13, 0x53, 0x61, 0x76, 0x65, 0x47, 0x75, 0x61, 0x72, 0x64, 0x72, 0x61, 0x69, 0x6c, 0x50,
+	0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x1f, 0x2e, 0x73, 0x61, 0x66, 0x65, 0x74, 0x79, 0x5f, 0x73,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x47, 0x75, 0x61, 0x72, 0x64, 0x72, 0x61, 0x69, 0x6c,
+	0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00,
+	0x12, 0x5e, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x47, 0x75, 0x61, 0x72, 0x64, 0x72, 0x61, 0x69, 0x6c,
+	0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x25, 0x2e, 0x73, 0x61, 0x66, 0x65, 0x74, 0x79, 0x5f,
+	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x47, 0x75, 0x61, 0x72, 0x64,
+	0x72, 0x61, 0x69, 0x6c, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x65, 0x71, 0x1a, 0x1f, 0x2e,
+	0x73, 0x61, 0x66, 0x65, 0x74, 0x79, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x47,
+	0x75, 0x61, 0x72, 0x64, 0x72, 0x61, 0x69, 0x6c, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x22, 0x00,
+	0x42, 0x34, 0x5a, 0x32, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x55,
+	0x6e, 0x69, 0x63, 0x6f, 0x6d, 0x41, 0x49, 0x2f, 0x77, 0x61, 0x6e, 0x77, 0x75, 0x2f, 0x61, 0x70,
+	0x69, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x73, 0x61, 0x66, 0x65, 0x74, 0x79, 0x2d, 0x73,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -1323,7 +1735,7 @@ func file_proto_safety_service_safety_service_proto_rawDescGZIP() []byte {
 	return file_proto_safety_service_safety_service_proto_rawDescData
 }
 
-var file_proto_safety_service_safety_service_proto_msgTypes = make([]protoimpl.MessageInfo, 17)
+var file_proto_safety_service_safety_service_proto_msgTypes = make([]protoimpl.MessageInfo, 22)
 var file_proto_safety_service_safety_service_proto_goTypes = []interface{}{
 	(*CreateSensitiveWordTableReq)(nil),       // 0: safety_service.CreateSensitiveWordTableReq
 	(*UpdateSensitiveWordTableReq)(nil),       // 1: safety_service.UpdateSensitiveWordTableReq
@@ -1342,40 +1754,53 @@ var file_proto_safety_service_safety_service_proto_goTypes = []interface{}{
 	(*SensitiveWordVocabulary)(nil),           // 14: safety_service.SensitiveWordVocabulary
 	(*SensitiveWordTableListWithWords)(nil),   // 15: safety_service.SensitiveWordTableListWithWords
 	(*SensitiveWordTableWithWords)(nil),       // 16: safety_service.SensitiveWordTableWithWords
-	(*emptypb.Empty)(nil),                     // 17: google.protobuf.Empty
+	(*GetGuardrailPolicyReq)(nil),             // 17: safety_service.GetGuardrailPolicyReq
+	(*GuardrailPolicy)(nil),                   // 18: safety_service.GuardrailPolicy
+	(*GuardrailStage)(nil),                    // 19: safety_service.GuardrailStage
+	(*GuardrailDetector)(nil),                 // 20: safety_service.GuardrailDetector
+	(*GuardrailClassifier)(nil),               // 21: safety_service.GuardrailClassifier
+	(*emptypb.Empty)(nil),                     // 22: google.protobuf.Empty
 }
 var file_proto_safety_service_safety_service_proto_depIdxs = []int32{
 	12, // 0: safety_service.SensitiveWordTables.list:type_name -> safety_service.SensitiveWordTable
 	14, // 1: safety_service.SensitiveWordVocabularyResp.list:type_name -> safety_service.SensitiveWordVocabulary
 	16, // 2: safety_service.SensitiveWordTableListWithWords.details:type_name -> safety_service.SensitiveWordTableWithWords
 	12, // 3: safety_service.SensitiveWordTableWithWords.table:type_name -> safety_service.SensitiveWordTable
-	0,  // 4: safety_service.SafetyService.CreateSensitiveWordTable:input_type -> safety_service.CreateSensitiveWordTableReq
-	1,  // 5: safety_service.SafetyService.UpdateSensitiveWordTable:input_type -> safety_service.UpdateSensitiveWordTableReq
-	2,  // 6: safety_service.SafetyService.UpdateSensitiveWordTableReply:input_type -> safety_service.UpdateSensitiveWordTableReplyReq
-	3,  // 7: safety_service.SafetyService.DeleteSensitiveWordTable:input_type -> safety_service.DeleteSensitiveWordTableReq
-	4,  // 8: safety_service.SafetyService.GetSensitiveWordTableList:input_type -> safety_service.GetSensitiveWordTableListReq
-	8,  // 9: safety_service.SafetyService.GetSensitiveWordTableListByIDs:input_type -> safety_service.GetSensitiveWordTableListByIDsReq
-	9,  // 10: safety_service.SafetyService.GetSensitiveWordTableByID:input_type -> safety_service.GetSensitiveWordTableByIDReq
-	5,  // 11: safety_service.SafetyService.UploadSensitiveVocabulary:input_type -> safety_service.UploadSensitiveVocabularyReq
-	6,  // 12: safety_service.SafetyService.DeleteSensitiveVocabulary:input_type -> safety_service.DeleteSensitiveVocabularyReq
-	7,  // 13: safety_service.SafetyService.GetSensitiveVocabularyList:input_type -> safety_service.GetSensitiveVocabularyListReq
-	8,  // 14: safety_service.SafetyService.GetSensitiveWordTableListWithWordsByIDs:input_type -> safety_service.GetSensitiveWordTableListByIDsReq
-	10, // 15: safety_service.SafetyService.CreateSensitiveWordTable:output_type -> safety_service.CreateSensitiveWordTableResp
-	17, // 16: safety_service.SafetyService.UpdateSensitiveWordTable:output_type -> google.protobuf.Empty
-	17, // 17: safety_service.SafetyService.UpdateSensitiveWordTableReply:output_type -> google.protobuf.Empty
-	17, // 18: safety_service.SafetyService.DeleteSensitiveWordTable:output_type -> google.protobuf.Empty
-	11, // 19: safety_service.SafetyService.GetSensitiveWordTableList:output_type -> safety_service.SensitiveWordTables
-	11, // 20: safety_service.SafetyService.GetSensitiveWordTableListByIDs:output_type -> safety_service.SensitiveWordTables
-	12, // 21: safety_service.SafetyService.GetSensitiveWordTableByID:output_type -> safety_service.SensitiveWordTable
-	17, // 22: safety_service.SafetyService.UploadSensitiveVocabulary:output_type -> google.protobuf.Empty
-	17, // 23: safety_service.SafetyService.DeleteSensitiveVocabulary:output_type -> google.protobuf.Empty
-	13, // 24: safety_service.SafetyService.GetSensitiveVocabularyList:output_type -> safety_service.SensitiveWordVocabularyResp
-	15, // 25: safety_service.SafetyService.GetSensitiveWordTableListWithWordsByIDs:output_type -> safety_service.SensitiveWordTableListWithWords
-	15, // [15:26] is the sub-list for method output_type
-	4,  // [4:15] is the sub-list for method input_type
-	4,  // [4:4] is the sub-list for extension type_name
-	4,  // [4:4] is the sub-list for extension extendee
-	0,  // [0:4] is the sub-list for field type_name
+	19, // 4: safety_service.GuardrailPolicy.input:type_name -> safety_service.GuardrailStage
+	19, // 5: safety_service.GuardrailPolicy.output:type_name -> safety_service.GuardrailStage
+	20, // 6: safety_service.GuardrailStage.detectors:type_name -> safety_service.GuardrailDetector
+	21, // 7: safety_service.GuardrailStage.classifier:type_name -> safety_service.GuardrailClassifier
+	0,  // 8: safety_service.SafetyService.CreateSensitiveWordTable:input_type -> safety_service.CreateSensitiveWordTableReq
+	1,  // 9: safety_service.SafetyService.UpdateSensitiveWordTable:input_type -> safety_service.UpdateSensitiveWordTableReq
+	2,  // 10: safety_service.SafetyService.UpdateSensitiveWordTableReply:input_type -> safety_service.UpdateSensitiveWordTableReplyReq
+	3,  // 11: safety_service.SafetyService.DeleteSensitiveWordTable:input_type -> safety_service.DeleteSensitiveWordTableReq
+	4,  // 12: safety_service.SafetyService.GetSensitiveWordTableList:input_type -> safety_service.GetSensitiveWordTableListReq
+	8,  // 13: safety_service.SafetyService.GetSensitiveWordTableListByIDs:input_type -> safety_service.GetSensitiveWordTableListByIDsReq
+	9,  // 14: safety_service.SafetyService.GetSensitiveWordTableByID:input_type -> safety_service.GetSensitiveWordTableByIDReq
+	5,  // 15: safety_service.SafetyService.UploadSensitiveVocabulary:input_type -> safety_service.UploadSensitiveVocabularyReq
+	6,  // 16: safety_service.SafetyService.DeleteSensitiveVocabulary:input_type -> safety_service.DeleteSensitiveVocabularyReq
+	7,  // 17: safety_service.SafetyService.GetSensitiveVocabularyList:input_type -> safety_service.GetSensitiveVocabularyListReq
+	8,  // 18: safety_service.SafetyService.GetSensitiveWordTableListWithWordsByIDs:input_type -> safety_service.GetSensitiveWordTableListByIDsReq
+	18, // 19: safety_service.SafetyService.SaveGuardrailPolicy:input_type -> safety_service.GuardrailPolicy
+	17, // 20: safety_service.SafetyService.GetGuardrailPolicy:input_type -> safety_service.GetGuardrailPolicyReq
+	10, // 21: safety_service.SafetyService.CreateSensitiveWordTable:output_type -> safety_service.CreateSensitiveWordTableResp
+	22, // 22: safety_service.SafetyService.UpdateSensitiveWordTable:output_type -> google.protobuf.Empty
+	22, // 23: safety_service.SafetyService.UpdateSensitiveWordTableReply:output_type -> google.protobuf.Empty
+	22, // 24: safety_service.SafetyService.DeleteSensitiveWordTable:output_type -> google.protobuf.Empty
+	11, // 25: safety_service.SafetyService.GetSensitiveWordTableList:output_type -> safety_service.SensitiveWordTables
+	11, // 26: safety_service.SafetyService.GetSensitiveWordTableListByIDs:output_type -> safety_service.SensitiveWordTables
+	12, // 27: safety_service.SafetyService.GetSensitiveWordTableByID:output_type -> safety_service.SensitiveWordTable
+	22, // 28: safety_service.SafetyService.UploadSensitiveVocabulary:output_type -> google.protobuf.Empty
+	22, // 29: safety_service.SafetyService.DeleteSensitiveVocabulary:output_type -> google.protobuf.Empty
+	13, // 30: safety_service.SafetyService.GetSensitiveVocabularyList:output_type -> safety_service.SensitiveWordVocabularyResp
+	15, // 31: safety_service.SafetyService.GetSensitiveWordTableListWithWordsByIDs:output_type -> safety_service.SensitiveWordTableListWithWords
+	22, // 32: safety_service.SafetyService.SaveGuardrailPolicy:output_type -> google.protobuf.Empty
+	18, // 33: safety_service.SafetyService.GetGuardrailPolicy:output_type -> safety_service.GuardrailPolicy
+	21, // [21:34] is the sub-list for method output_type
+	8,  // [8:21] is the sub-list for method input_type
+	8,  // [8:8] is the sub-list for extension type_name
+	8,  // [8:8] is the sub-list for extension extendee
+	0,  // [0:8] is the sub-list for field type_name
 }
 
 func init() { file_proto_safety_service_safety_service_proto_init() }
@@ -1588,6 +2013,66 @@ func file_proto_safety_service_safety_service_proto_init() {
 				return nil
 			}
 		}
+		file_proto_safety_service_safety_service_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetGuardrailPolicyReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_safety_service_safety_service_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GuardrailPolicy); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_safety_service_safety_service_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GuardrailStage); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_safety_service_safety_service_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GuardrailDetector); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_safety_service_safety_service_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GuardrailClassifier); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -1595,7 +2080,7 @@ func file_proto_safety_service_safety_service_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_proto_safety_service_safety_service_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   17,
+			NumMessages:   22,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
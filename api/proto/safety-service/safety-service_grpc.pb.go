@@ -31,6 +31,8 @@ const (
 	SafetyService_DeleteSensitiveVocabulary_FullMethodName               = "/safety_service.SafetyService/DeleteSensitiveVocabulary"
 	SafetyService_GetSensitiveVocabularyList_FullMethodName              = "/safety_service.SafetyService/GetSensitiveVocabularyList"
 	SafetyService_GetSensitiveWordTableListWithWordsByIDs_FullMethodName = "/safety_service.SafetyService/GetSensitiveWordTableListWithWordsByIDs"
+	SafetyService_SaveGuardrailPolicy_FullMethodName                     = "/safety_service.SafetyService/SaveGuardrailPolicy"
+	SafetyService_GetGuardrailPolicy_FullMethodName                      = "/safety_service.SafetyService/GetGuardrailPolicy"
 )
 
 // SafetyServiceClient is the client API for SafetyService service.
@@ -62,6 +64,11 @@ type SafetyServiceClient interface {
 	GetSensitiveVocabularyList(ctx context.Context, in *GetSensitiveVocabularyListReq, opts ...grpc.CallOption) (*SensitiveWordVocabularyResp, error)
 	// 获取多个敏感词表详细数据(带敏感词)
 	GetSensitiveWordTableListWithWordsByIDs(ctx context.Context, in *GetSensitiveWordTableListByIDsReq, opts ...grpc.CallOption) (*SensitiveWordTableListWithWords, error)
+	// 护栏策略相关
+	// 保存应用护栏策略
+	SaveGuardrailPolicy(ctx context.Context, in *GuardrailPolicy, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// 获取应用护栏策略，未配置时返回未启用的空策略
+	GetGuardrailPolicy(ctx context.Context, in *GetGuardrailPolicyReq, opts ...grpc.CallOption) (*GuardrailPolicy, error)
 }
 
 type safetyServiceClient struct {
@@ -182,6 +189,26 @@ func (c *safetyServiceClient) GetSensitiveWordTableListWithWordsByIDs(ctx contex
 	return out, nil
 }
 
+func (c *safetyServiceClient) SaveGuardrailPolicy(ctx context.Context, in *GuardrailPolicy, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, SafetyService_SaveGuardrailPolicy_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *safetyServiceClient) GetGuardrailPolicy(ctx context.Context, in *GetGuardrailPolicyReq, opts ...grpc.CallOption) (*GuardrailPolicy, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GuardrailPolicy)
+	err := c.cc.Invoke(ctx, SafetyService_GetGuardrailPolicy_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // SafetyServiceServer is the server API for SafetyService service.
 // All implementations must embed UnimplementedSafetyServiceServer
 // for forward compatibility.
@@ -211,6 +238,11 @@ type SafetyServiceServer interface {
 	GetSensitiveVocabularyList(context.Context, *GetSensitiveVocabularyListReq) (*SensitiveWordVocabularyResp, error)
 	// 获取多个敏感词表详细数据(带敏感词)
 	GetSensitiveWordTableListWithWordsByIDs(context.Context, *GetSensitiveWordTableListByIDsReq) (*SensitiveWordTableListWithWords, error)
+	// 护栏策略相关
+	// 保存应用护栏策略
+	SaveGuardrailPolicy(context.Context, *GuardrailPolicy) (*emptypb.Empty, error)
+	// 获取应用护栏策略，未配置时返回未启用的空策略
+	GetGuardrailPolicy(context.Context, *GetGuardrailPolicyReq) (*GuardrailPolicy, error)
 	mustEmbedUnimplementedSafetyServiceServer()
 }
 
@@ -254,6 +286,12 @@ func (UnimplementedSafetyServiceServer) GetSensitiveVocabularyList(context.Conte
 func (UnimplementedSafetyServiceServer) GetSensitiveWordTableListWithWordsByIDs(context.Context, *GetSensitiveWordTableListByIDsReq) (*SensitiveWordTableListWithWords, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetSensitiveWordTableListWithWordsByIDs not implemented")
 }
+func (UnimplementedSafetyServiceServer) SaveGuardrailPolicy(context.Context, *GuardrailPolicy) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SaveGuardrailPolicy not implemented")
+}
+func (UnimplementedSafetyServiceServer) GetGuardrailPolicy(context.Context, *GetGuardrailPolicyReq) (*GuardrailPolicy, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetGuardrailPolicy not implemented")
+}
 func (UnimplementedSafetyServiceServer) mustEmbedUnimplementedSafetyServiceServer() {}
 func (UnimplementedSafetyServiceServer) testEmbeddedByValue()                       {}
 
@@ -473,6 +511,42 @@ func _SafetyService_GetSensitiveWordTableListWithWordsByIDs_Handler(srv interfac
 	return interceptor(ctx, in, info, handler)
 }
 
+func _SafetyService_SaveGuardrailPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GuardrailPolicy)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SafetyServiceServer).SaveGuardrailPolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SafetyService_SaveGuardrailPolicy_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SafetyServiceServer).SaveGuardrailPolicy(ctx, req.(*GuardrailPolicy))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SafetyService_GetGuardrailPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetGuardrailPolicyReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SafetyServiceServer).GetGuardrailPolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SafetyService_GetGuardrailPolicy_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SafetyServiceServer).GetGuardrailPolicy(ctx, req.(*GetGuardrailPolicyReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // SafetyService_ServiceDesc is the grpc.ServiceDesc for SafetyService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -524,6 +598,14 @@ var SafetyService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetSensitiveWordTableListWithWordsByIDs",
 			Handler:    _SafetyService_GetSensitiveWordTableListWithWordsByIDs_Handler,
 		},
+		{
+			MethodName: "SaveGuardrailPolicy",
+			Handler:    _SafetyService_SaveGuardrailPolicy_Handler,
+		},
+		{
+			MethodName: "GetGuardrailPolicy",
+			Handler:    _SafetyService_GetGuardrailPolicy_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "proto/safety-service/safety-service.proto",
@@ -15,6 +15,7 @@ import (
 	"github.com/UnicomAI/wanwu/pkg/db"
 	"github.com/UnicomAI/wanwu/pkg/log"
 	"github.com/UnicomAI/wanwu/pkg/minio"
+	"github.com/UnicomAI/wanwu/pkg/webhook"
 )
 
 var (
@@ -58,6 +59,10 @@ func main() {
 		log.Fatalf("init minio safety client err: %v", err)
 	}
 
+	if err := webhook.Init(config.Cfg().Operate.Host); err != nil {
+		log.Fatalf("init webhook emitter err: %v", err)
+	}
+
 	db, err := db.New(config.Cfg().DB)
 	if err != nil {
 		log.Fatalf("init db err: %v", err)
@@ -20,6 +20,7 @@ import (
 	"github.com/UnicomAI/wanwu/pkg/log"
 	"github.com/UnicomAI/wanwu/pkg/minio"
 	mp "github.com/UnicomAI/wanwu/pkg/model-provider"
+	"github.com/UnicomAI/wanwu/pkg/redis"
 	"github.com/UnicomAI/wanwu/pkg/util"
 )
 
@@ -86,6 +87,21 @@ func main() {
 		log.Fatalf("init minio err: %v", err)
 	}
 
+	// init redis: model cache，不可用时关闭模型缓存，不影响启动
+	if err := redis.InitModel(ctx, config.Cfg().Redis); err != nil {
+		log.Errorf("init redis model cache err, model cache disabled: %v", err)
+	}
+
+	// init redis: file upload session，不可用时分片上传不可用，不影响启动
+	if err := redis.InitFileUpload(ctx, config.Cfg().Redis); err != nil {
+		log.Errorf("init redis file upload err, chunked upload disabled: %v", err)
+	}
+
+	// init redis: rate limit，不可用时限流直接放行，不影响启动
+	if err := redis.InitRateLimit(ctx, config.Cfg().Redis); err != nil {
+		log.Warnf("init redis rate limit err, rate limit disabled: %v", err)
+	}
+
 	// init workflow http client
 	if err := http_client.InitWorkflow(); err != nil {
 		log.Fatalf("init http client err: %v", err)
@@ -104,6 +120,12 @@ func main() {
 		log.Fatalf("init assistant template err: %v", err)
 	}
 
+	// start file upload session clean
+	service.StartFileUploadClean(ctx)
+
+	// start audit log writer
+	service.StartAuditLogWriter()
+
 	// start http handler
 	handler.Start(ctx)
 
@@ -114,7 +136,12 @@ func main() {
 
 	// stop http handler
 	handler.Stop(ctx)
+	service.StopAuditLogWriter()
 	ahocorasick.Stop()
+	service.StopFileUploadClean()
+	redis.StopRateLimit()
+	redis.StopFileUpload()
+	redis.StopModel()
 }
 
 func versionPrint() {
@@ -10,6 +10,7 @@ import (
 
 	"github.com/UnicomAI/wanwu/internal/knowledge-service/pkg"
 	_ "github.com/UnicomAI/wanwu/internal/knowledge-service/pkg/minio"
+	_ "github.com/UnicomAI/wanwu/internal/knowledge-service/pkg/operate"
 	_ "github.com/UnicomAI/wanwu/internal/knowledge-service/pkg/tool-init"
 	_ "github.com/UnicomAI/wanwu/internal/knowledge-service/server/grpc"
 	_ "github.com/UnicomAI/wanwu/internal/knowledge-service/task"
@@ -558,6 +558,62 @@ const docTemplate = `{
         }
     },
     "definitions": {
+        "mp.CachePolicy": {
+            "type": "object",
+            "properties": {
+                "enable": {
+                    "description": "是否开启缓存（按模型ID+归一化请求精确匹配）",
+                    "type": "boolean"
+                },
+                "semanticEmbeddingModelId": {
+                    "description": "语义缓存使用的embedding模型ID",
+                    "type": "string"
+                },
+                "semanticEnable": {
+                    "description": "是否开启语义缓存，仅llm支持",
+                    "type": "boolean"
+                },
+                "semanticMaxEntries": {
+                    "description": "单个调用方在相同请求参数下语义缓存的最大条目数，0表示使用默认值50",
+                    "type": "integer"
+                },
+                "semanticThreshold": {
+                    "description": "语义缓存命中的余弦相似度阈值，(0, 1]，0表示使用默认值0.95",
+                    "type": "number"
+                },
+                "ttlSeconds": {
+                    "description": "缓存有效期（秒），0表示使用默认值3600",
+                    "type": "integer"
+                }
+            }
+        },
+        "mp.ProviderModelByAnthropic": {
+            "type": "object",
+            "properties": {
+                "llm": {
+                    "$ref": "#/definitions/mp_anthropic.LLM"
+                }
+            }
+        },
+        "mp.ProviderModelByGemini": {
+            "type": "object",
+            "properties": {
+                "embedding": {
+                    "$ref": "#/definitions/mp_gemini.Embedding"
+                },
+                "llm": {
+                    "$ref": "#/definitions/mp_gemini.LLM"
+                }
+            }
+        },
+        "mp.ProviderModelByHttp": {
+            "type": "object",
+            "properties": {
+                "pdf-parser": {
+                    "$ref": "#/definitions/mp_http.PdfParser"
+                }
+            }
+        },
         "mp.ProviderModelByHuoshan": {
             "type": "object",
             "properties": {
@@ -603,6 +659,9 @@ const docTemplate = `{
                 "llm": {
                     "$ref": "#/definitions/mp_openai_compatible.LLM"
                 },
+                "ocr": {
+                    "$ref": "#/definitions/mp_openai_compatible.Ocr"
+                },
                 "rerank": {
                     "$ref": "#/definitions/mp_openai_compatible.Rerank"
                 }
@@ -648,6 +707,15 @@ const docTemplate = `{
         "mp.ProviderModelConfig": {
             "type": "object",
             "properties": {
+                "providerAnthropic": {
+                    "$ref": "#/definitions/mp.ProviderModelByAnthropic"
+                },
+                "providerGemini": {
+                    "$ref": "#/definitions/mp.ProviderModelByGemini"
+                },
+                "providerHttp": {
+                    "$ref": "#/definitions/mp.ProviderModelByHttp"
+                },
                 "providerHuoshan": {
                     "$ref": "#/definitions/mp.ProviderModelByHuoshan"
                 },
@@ -668,6 +736,74 @@ const docTemplate = `{
                 }
             }
         },
+        "mp.RoutingPolicy": {
+            "type": "object",
+            "properties": {
+                "backoffMs": {
+                    "description": "重试退避基准时长（毫秒），每次重试翻倍",
+                    "type": "integer"
+                },
+                "fallbackModelIds": {
+                    "description": "备选模型ID，按顺序回退",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "maxRetries": {
+                    "description": "单个模型的最大重试次数（不含首次请求）",
+                    "type": "integer"
+                },
+                "retryableStatusCodes": {
+                    "description": "可重试的http状态码，为空时使用默认值[408,429,500,502,503,504]",
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    }
+                },
+                "timeoutSeconds": {
+                    "description": "单次请求超时（秒），流式请求为首包超时；0表示不超时",
+                    "type": "integer"
+                }
+            }
+        },
+        "mp_anthropic.LLM": {
+            "type": "object",
+            "properties": {
+                "apiKey": {
+                    "description": "ApiKey",
+                    "type": "string"
+                },
+                "contextSize": {
+                    "description": "上下文长度",
+                    "type": "integer"
+                },
+                "endpointUrl": {
+                    "description": "推理url，例如 https://api.anthropic.com/v1",
+                    "type": "string"
+                },
+                "functionCalling": {
+                    "description": "函数调用是否支持",
+                    "type": "string",
+                    "enum": [
+                        "noSupport",
+                        "toolCall"
+                    ]
+                },
+                "maxTokens": {
+                    "description": "模型回答最大tokens",
+                    "type": "integer"
+                },
+                "visionSupport": {
+                    "description": "视觉支持",
+                    "type": "string",
+                    "enum": [
+                        "noSupport",
+                        "support"
+                    ]
+                }
+            }
+        },
         "mp_common.Document": {
             "type": "object",
             "properties": {
@@ -1501,6 +1637,104 @@ const docTemplate = `{
                 }
             }
         },
+        "mp_gemini.Embedding": {
+            "type": "object",
+            "properties": {
+                "apiKey": {
+                    "description": "ApiKey",
+                    "type": "string"
+                },
+                "contextSize": {
+                    "description": "上下文长度",
+                    "type": "integer"
+                },
+                "endpointUrl": {
+                    "description": "推理url，例如 https://generativelanguage.googleapis.com/v1beta",
+                    "type": "string"
+                }
+            }
+        },
+        "mp_gemini.LLM": {
+            "type": "object",
+            "properties": {
+                "apiKey": {
+                    "description": "ApiKey",
+                    "type": "string"
+                },
+                "contextSize": {
+                    "description": "上下文长度",
+                    "type": "integer"
+                },
+                "endpointUrl": {
+                    "description": "推理url，例如 https://generativelanguage.googleapis.com/v1beta",
+                    "type": "string"
+                },
+                "functionCalling": {
+                    "description": "函数调用是否支持",
+                    "type": "string",
+                    "enum": [
+                        "noSupport",
+                        "toolCall"
+                    ]
+                },
+                "maxTokens": {
+                    "description": "模型回答最大tokens",
+                    "type": "integer"
+                },
+                "visionSupport": {
+                    "description": "视觉支持",
+                    "type": "string",
+                    "enum": [
+                        "noSupport",
+                        "support"
+                    ]
+                }
+            }
+        },
+        "mp_http.PdfParser": {
+            "type": "object",
+            "properties": {
+                "apiKey": {
+                    "description": "ApiKey，非空时以 Authorization: Bearer 传递",
+                    "type": "string"
+                },
+                "contentPath": {
+                    "description": "响应中解析结果的字段路径，以.分隔，*表示遍历数组，例如 data.pages.*.markdown；默认content",
+                    "type": "string"
+                },
+                "endpointUrl": {
+                    "description": "解析接口完整url",
+                    "type": "string"
+                },
+                "fileField": {
+                    "description": "请求中文件的表单字段名，默认file",
+                    "type": "string"
+                },
+                "fileNameField": {
+                    "description": "请求中文件名的表单字段名，默认file_name",
+                    "type": "string"
+                },
+                "formFields": {
+                    "description": "请求中附加的固定表单字段",
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "string"
+                    }
+                },
+                "messagePath": {
+                    "description": "响应中错误信息的字段路径",
+                    "type": "string"
+                },
+                "successPath": {
+                    "description": "响应中成功标识的字段路径，为空时仅以http状态码判断",
+                    "type": "string"
+                },
+                "successValue": {
+                    "description": "成功标识的值，例如 0",
+                    "type": "string"
+                }
+            }
+        },
         "mp_huoshan.Embedding": {
             "type": "object",
             "properties": {
@@ -1734,6 +1968,27 @@ const docTemplate = `{
                 }
             }
         },
+        "mp_openai_compatible.Ocr": {
+            "type": "object",
+            "properties": {
+                "apiKey": {
+                    "description": "ApiKey",
+                    "type": "string"
+                },
+                "endpointUrl": {
+                    "description": "推理url，多模态大模型的OpenAI兼容接口",
+                    "type": "string"
+                },
+                "maxTokens": {
+                    "description": "模型回答最大tokens",
+                    "type": "integer"
+                },
+                "prompt": {
+                    "description": "OCR提示词，为空时使用默认提示词",
+                    "type": "string"
+                }
+            }
+        },
         "mp_openai_compatible.Rerank": {
             "type": "object",
             "properties": {
@@ -2167,6 +2422,14 @@ const docTemplate = `{
                         }
                     ]
                 },
+                "cachePolicy": {
+                    "description": "模型响应缓存策略",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/mp.CachePolicy"
+                        }
+                    ]
+                },
                 "config": {},
                 "createdAt": {
                     "type": "string"
@@ -2220,6 +2483,14 @@ const docTemplate = `{
                     "description": "模型发布时间",
                     "type": "string"
                 },
+                "routingPolicy": {
+                    "description": "模型路由策略",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/mp.RoutingPolicy"
+                        }
+                    ]
+                },
                 "tags": {
                     "type": "array",
                     "items": {
@@ -55,6 +55,40 @@ const docTemplate = `{
                 }
             }
         },
+        "/agent/chat/feedback": {
+            "post": {
+                "description": "点赞/点踩智能体回答，未指定detail_id时评价对话最后一轮回答；rating为0时取消评价",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "openapi"
+                ],
+                "summary": "智能体回答评价OpenAPI",
+                "parameters": [
+                    {
+                        "description": "请求参数",
+                        "name": "data",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/request.OpenAPIAgentFeedbackRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    }
+                }
+            }
+        },
         "/agent/conversation": {
             "post": {
                 "description": "智能体创建对话OpenAPI",
@@ -101,6 +135,87 @@ const docTemplate = `{
                 }
             }
         },
+        "/chat/completions": {
+            "post": {
+                "description": "OpenAI兼容的对话接口，model为ApiKey所属的已发布智能体或文本问答应用id；\n智能体未指定conversation_id时新建对话，文本问答以之前的用户、助手消息作为历史；\n返回chat.completion或chat.completion.chunk，citations为引用的知识片段",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "openapi"
+                ],
+                "summary": "OpenAI兼容对话OpenAPI",
+                "parameters": [
+                    {
+                        "description": "请求参数",
+                        "name": "data",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/request.OpenAIChatCompletionRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/response.OpenAIChatCompletion"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.OpenAIError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/response.OpenAIError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/response.OpenAIError"
+                        }
+                    }
+                }
+            }
+        },
+        "/models": {
+            "get": {
+                "description": "OpenAI兼容的模型列表，返回ApiKey可访问的已发布智能体或文本问答应用",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "openapi"
+                ],
+                "summary": "OpenAI兼容模型列表OpenAPI",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/response.OpenAIModelList"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/response.OpenAIError"
+                        }
+                    }
+                }
+            }
+        },
         "/rag/chat": {
             "post": {
                 "description": "文本问答OpenAPI",
@@ -141,6 +256,40 @@ const docTemplate = `{
                 }
             }
         },
+        "/rag/chat/feedback": {
+            "post": {
+                "description": "按问答返回的msg_id点赞/点踩回答，rating为0时取消评价",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "openapi"
+                ],
+                "summary": "文本问答回答评价OpenAPI",
+                "parameters": [
+                    {
+                        "description": "请求参数",
+                        "name": "data",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/request.OpenAPIRagFeedbackRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    }
+                }
+            }
+        },
         "/workflow/file/upload": {
             "post": {
                 "description": "工作流OpenAPI文件上传",
@@ -204,6 +353,59 @@ const docTemplate = `{
         }
     },
     "definitions": {
+        "request.OpenAIChatCompletionRequest": {
+            "type": "object",
+            "required": [
+                "messages",
+                "model"
+            ],
+            "properties": {
+                "conversation_id": {
+                    "description": "扩展字段，智能体对话id，为空时新建对话",
+                    "type": "string"
+                },
+                "messages": {
+                    "type": "array",
+                    "minItems": 1,
+                    "items": {
+                        "$ref": "#/definitions/request.OpenAIChatMessage"
+                    }
+                },
+                "model": {
+                    "description": "应用id",
+                    "type": "string"
+                },
+                "stream": {
+                    "type": "boolean"
+                },
+                "stream_options": {
+                    "$ref": "#/definitions/request.OpenAIStreamOptions"
+                }
+            }
+        },
+        "request.OpenAIChatMessage": {
+            "type": "object",
+            "required": [
+                "role"
+            ],
+            "properties": {
+                "content": {
+                    "description": "字符串，或[{\"type\":\"text\",\"text\":\"...\"}]",
+                    "type": "string"
+                },
+                "role": {
+                    "type": "string"
+                }
+            }
+        },
+        "request.OpenAIStreamOptions": {
+            "type": "object",
+            "properties": {
+                "include_usage": {
+                    "type": "boolean"
+                }
+            }
+        },
         "request.OpenAPIAgentChatRequest": {
             "type": "object",
             "required": [
@@ -230,6 +432,34 @@ const docTemplate = `{
                 }
             }
         },
+        "request.OpenAPIAgentFeedbackRequest": {
+            "type": "object",
+            "required": [
+                "conversation_id"
+            ],
+            "properties": {
+                "content": {
+                    "type": "string"
+                },
+                "conversation_id": {
+                    "type": "string"
+                },
+                "detail_id": {
+                    "description": "为空时评价对话最后一轮回答",
+                    "type": "string"
+                },
+                "rating": {
+                    "description": "1:点赞 -1:点踩 0:取消评价",
+                    "type": "integer"
+                },
+                "reasons": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
         "request.OpenAPIRagChatRequest": {
             "type": "object",
             "required": [
@@ -244,6 +474,88 @@ const docTemplate = `{
                 }
             }
         },
+        "request.OpenAPIRagFeedbackRequest": {
+            "type": "object",
+            "required": [
+                "msg_id"
+            ],
+            "properties": {
+                "content": {
+                    "type": "string"
+                },
+                "msg_id": {
+                    "type": "string"
+                },
+                "rating": {
+                    "description": "1:点赞 -1:点踩 0:取消评价",
+                    "type": "integer"
+                },
+                "reasons": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "response.OpenAIChatChoice": {
+            "type": "object",
+            "properties": {
+                "delta": {
+                    "$ref": "#/definitions/response.OpenAIChatMessage"
+                },
+                "finish_reason": {
+                    "type": "string"
+                },
+                "index": {
+                    "type": "integer"
+                },
+                "message": {
+                    "$ref": "#/definitions/response.OpenAIChatMessage"
+                }
+            }
+        },
+        "response.OpenAIChatCompletion": {
+            "type": "object",
+            "properties": {
+                "choices": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/response.OpenAIChatChoice"
+                    }
+                },
+                "citations": {
+                    "description": "扩展字段，引用的知识片段",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/response.OpenAIChatSearch"
+                    }
+                },
+                "conversation_id": {
+                    "description": "扩展字段，智能体对话id",
+                    "type": "string"
+                },
+                "created": {
+                    "type": "integer"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "model": {
+                    "type": "string"
+                },
+                "msg_id": {
+                    "description": "扩展字段，文本问答消息id，用于回答评价",
+                    "type": "string"
+                },
+                "object": {
+                    "type": "string"
+                },
+                "usage": {
+                    "$ref": "#/definitions/response.OpenAIChatUsage"
+                }
+            }
+        },
         "response.OpenAIChatHistory": {
             "type": "object",
             "properties": {
@@ -255,6 +567,17 @@ const docTemplate = `{
                 }
             }
         },
+        "response.OpenAIChatMessage": {
+            "type": "object",
+            "properties": {
+                "content": {
+                    "type": "string"
+                },
+                "role": {
+                    "type": "string"
+                }
+            }
+        },
         "response.OpenAIChatSearch": {
             "type": "object",
             "properties": {
@@ -269,6 +592,82 @@ const docTemplate = `{
                 }
             }
         },
+        "response.OpenAIChatUsage": {
+            "type": "object",
+            "properties": {
+                "completion_tokens": {
+                    "type": "integer"
+                },
+                "prompt_tokens": {
+                    "type": "integer"
+                },
+                "total_tokens": {
+                    "type": "integer"
+                }
+            }
+        },
+        "response.OpenAIError": {
+            "type": "object",
+            "properties": {
+                "error": {
+                    "$ref": "#/definitions/response.OpenAIErrorDetail"
+                }
+            }
+        },
+        "response.OpenAIErrorDetail": {
+            "type": "object",
+            "properties": {
+                "code": {
+                    "type": "string"
+                },
+                "message": {
+                    "type": "string"
+                },
+                "type": {
+                    "type": "string"
+                }
+            }
+        },
+        "response.OpenAIModel": {
+            "type": "object",
+            "properties": {
+                "app_type": {
+                    "description": "扩展字段，应用类型：agent | rag",
+                    "type": "string"
+                },
+                "created": {
+                    "type": "integer"
+                },
+                "id": {
+                    "description": "应用id",
+                    "type": "string"
+                },
+                "name": {
+                    "description": "扩展字段，应用名称",
+                    "type": "string"
+                },
+                "object": {
+                    "type": "string"
+                },
+                "owned_by": {
+                    "type": "string"
+                }
+            }
+        },
+        "response.OpenAIModelList": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/response.OpenAIModel"
+                    }
+                },
+                "object": {
+                    "type": "string"
+                }
+            }
+        },
         "response.OpenAPIAgentChatFile": {
             "type": "object",
             "properties": {
@@ -265,6 +265,131 @@ const docTemplate = `{
                 }
             }
         },
+        "/agent/{suffix}/conversation/export": {
+            "get": {
+                "security": [
+                    {
+                        "JWT": []
+                    }
+                ],
+                "description": "导出单个对话或所有对话，格式为JSONL或Markdown",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/octet-stream"
+                ],
+                "tags": [
+                    "openurl"
+                ],
+                "summary": "导出智能体对话",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "临时唯一标识",
+                        "name": "X-Client-ID",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Url后缀",
+                        "name": "suffix",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "智能体对话id，为空时导出所有对话",
+                        "name": "conversationId",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "开始时间（毫秒）",
+                        "name": "startTime",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "结束时间（毫秒）",
+                        "name": "endTime",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "jsonl",
+                            "markdown"
+                        ],
+                        "type": "string",
+                        "description": "导出格式",
+                        "name": "format",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/agent/{suffix}/conversation/feedback": {
+            "post": {
+                "security": [
+                    {
+                        "JWT": []
+                    }
+                ],
+                "description": "点赞/点踩某轮回答并填写原因，rating为0时取消评价",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "openurl"
+                ],
+                "summary": "评价智能体回答",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "临时唯一标识",
+                        "name": "X-Client-ID",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Url后缀",
+                        "name": "suffix",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "回答评价参数",
+                        "name": "data",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/request.ConversationFeedbackRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    }
+                }
+            }
+        },
         "/agent/{suffix}/conversation/list": {
             "get": {
                 "security": [
@@ -394,6 +519,12 @@ const docTemplate = `{
         "mp.AppModelParams": {
             "type": "object",
             "properties": {
+                "providerAnthropic": {
+                    "$ref": "#/definitions/mp.AppModelParamsAnthropic"
+                },
+                "providerGemini": {
+                    "$ref": "#/definitions/mp.AppModelParamsGemini"
+                },
                 "providerHuoshan": {
                     "$ref": "#/definitions/mp.AppModelParamsHuoshan"
                 },
@@ -424,6 +555,32 @@ const docTemplate = `{
                 }
             }
         },
+        "mp.AppModelParamsAnthropic": {
+            "type": "object",
+            "properties": {
+                "llm": {
+                    "description": "大语言模型配置",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/mp_anthropic.LLMParams"
+                        }
+                    ]
+                }
+            }
+        },
+        "mp.AppModelParamsGemini": {
+            "type": "object",
+            "properties": {
+                "llm": {
+                    "description": "大语言模型配置",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/mp_gemini.LLMParams"
+                        }
+                    ]
+                }
+            }
+        },
         "mp.AppModelParamsHuoshan": {
             "type": "object",
             "properties": {
@@ -502,6 +659,80 @@ const docTemplate = `{
                 }
             }
         },
+        "mp_anthropic.LLMParams": {
+            "type": "object",
+            "properties": {
+                "maxTokens": {
+                    "description": "最大标记",
+                    "type": "integer"
+                },
+                "maxTokensEnable": {
+                    "description": "最大标记(开关)",
+                    "type": "boolean"
+                },
+                "temperature": {
+                    "description": "温度",
+                    "type": "number"
+                },
+                "temperatureEnable": {
+                    "description": "温度(开关)",
+                    "type": "boolean"
+                },
+                "topK": {
+                    "description": "Top K",
+                    "type": "integer"
+                },
+                "topKEnable": {
+                    "description": "Top K(开关)",
+                    "type": "boolean"
+                },
+                "topP": {
+                    "description": "Top P",
+                    "type": "number"
+                },
+                "topPEnable": {
+                    "description": "Top P(开关)",
+                    "type": "boolean"
+                }
+            }
+        },
+        "mp_gemini.LLMParams": {
+            "type": "object",
+            "properties": {
+                "maxTokens": {
+                    "description": "最大标记",
+                    "type": "integer"
+                },
+                "maxTokensEnable": {
+                    "description": "最大标记(开关)",
+                    "type": "boolean"
+                },
+                "temperature": {
+                    "description": "温度",
+                    "type": "number"
+                },
+                "temperatureEnable": {
+                    "description": "温度(开关)",
+                    "type": "boolean"
+                },
+                "topK": {
+                    "description": "Top K",
+                    "type": "integer"
+                },
+                "topKEnable": {
+                    "description": "Top K(开关)",
+                    "type": "boolean"
+                },
+                "topP": {
+                    "description": "Top P",
+                    "type": "number"
+                },
+                "topPEnable": {
+                    "description": "Top P(开关)",
+                    "type": "boolean"
+                }
+            }
+        },
         "mp_huoshan.LLMParams": {
             "type": "object",
             "properties": {
@@ -902,6 +1133,23 @@ const docTemplate = `{
                 }
             }
         },
+        "request.AssistantMemoryConfig": {
+            "type": "object",
+            "properties": {
+                "maxTokens": {
+                    "description": "历史对话的token预算，0表示不限制",
+                    "type": "integer"
+                },
+                "maxTurns": {
+                    "description": "保留最近的对话轮数，0表示不限制",
+                    "type": "integer"
+                },
+                "summaryEnable": {
+                    "description": "是否将窗口外的历史对话滚动总结为摘要（使用智能体的模型）",
+                    "type": "boolean"
+                }
+            }
+        },
         "request.Avatar": {
             "type": "object",
             "properties": {
@@ -915,6 +1163,36 @@ const docTemplate = `{
                 }
             }
         },
+        "request.ConversationFeedbackRequest": {
+            "type": "object",
+            "required": [
+                "conversationId",
+                "detailId"
+            ],
+            "properties": {
+                "content": {
+                    "description": "评价内容",
+                    "type": "string"
+                },
+                "conversationId": {
+                    "type": "string"
+                },
+                "detailId": {
+                    "type": "string"
+                },
+                "rating": {
+                    "description": "1:点赞 -1:点踩 0:取消评价",
+                    "type": "integer"
+                },
+                "reasons": {
+                    "description": "原因标签",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
         "request.ConversationIdRequest": {
             "type": "object",
             "required": [
@@ -1167,6 +1445,14 @@ const docTemplate = `{
                         "$ref": "#/definitions/response.MCPInfos"
                     }
                 },
+                "memoryConfig": {
+                    "description": "对话记忆配置",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/request.AssistantMemoryConfig"
+                        }
+                    ]
+                },
                 "modelConfig": {
                     "description": "模型",
                     "allOf": [
@@ -1263,12 +1549,20 @@ const docTemplate = `{
                 "id": {
                     "type": "string"
                 },
+                "parentId": {
+                    "description": "上一轮对话id，首轮为对话id",
+                    "type": "string"
+                },
                 "prompt": {
                     "type": "string"
                 },
                 "qa_type": {
                     "type": "integer"
                 },
+                "rating": {
+                    "description": "当前用户的评价 1:点赞 -1:点踩 0:未评价",
+                    "type": "integer"
+                },
                 "requestFileUrls": {
                     "type": "array",
                     "items": {
@@ -1279,6 +1573,14 @@ const docTemplate = `{
                     "type": "string"
                 },
                 "searchList": {},
+                "siblingCount": {
+                    "description": "同一轮对话的版本数（重新生成、编辑问题）",
+                    "type": "integer"
+                },
+                "siblingIndex": {
+                    "description": "当前版本的序号，从0开始",
+                    "type": "integer"
+                },
                 "sysPrompt": {
                     "type": "string"
                 },
@@ -54,9 +54,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/appspace/app/key": {
-            "post": {
-                "description": "生成ApiKey",
+        "/appspace/app/eval/dataset": {
+            "get": {
+                "security": [
+                    {
+                        "JWT": []
+                    }
+                ],
+                "description": "获取评测问题集详情",
                 "consumes": [
                     "application/json"
                 ],
@@ -66,16 +71,14 @@ const docTemplate = `{
                 "tags": [
                     "app"
                 ],
-                "summary": "生成ApiKey",
+                "summary": "获取评测问题集详情",
                 "parameters": [
                     {
-                        "description": "生成ApiKey参数",
-                        "name": "data",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/request.GenApiKeyRequest"
-                        }
+                        "type": "string",
+                        "description": "问题集id",
+                        "name": "datasetId",
+                        "in": "query",
+                        "required": true
                     }
                 ],
                 "responses": {
@@ -90,7 +93,7 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "$ref": "#/definitions/response.ApiResponse"
+                                            "$ref": "#/definitions/response.AppEvalDatasetInfo"
                                         }
                                     }
                                 }
@@ -99,8 +102,13 @@ const docTemplate = `{
                     }
                 }
             },
-            "delete": {
-                "description": "删除ApiKey",
+            "post": {
+                "security": [
+                    {
+                        "JWT": []
+                    }
+                ],
+                "description": "创建应用回答质量评测问题集，包含问题及参考答案",
                 "consumes": [
                     "application/json"
                 ],
@@ -110,15 +118,15 @@ const docTemplate = `{
                 "tags": [
                     "app"
                 ],
-                "summary": "删除ApiKey",
+                "summary": "创建评测问题集",
                 "parameters": [
                     {
-                        "description": "删除Apikey参数",
+                        "description": "问题集信息",
                         "name": "data",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/request.DelApiKeyRequest"
+                            "$ref": "#/definitions/request.AppEvalDatasetCreateRequest"
                         }
                     }
                 ],
@@ -126,15 +134,30 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/response.Response"
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/response.AppEvalDatasetInfo"
+                                        }
+                                    }
+                                }
+                            ]
                         }
                     }
                 }
-            }
-        },
-        "/appspace/app/key/list": {
-            "get": {
-                "description": "获取ApiKey",
+            },
+            "delete": {
+                "security": [
+                    {
+                        "JWT": []
+                    }
+                ],
+                "description": "删除评测问题集及其评测任务",
                 "consumes": [
                     "application/json"
                 ],
@@ -144,56 +167,36 @@ const docTemplate = `{
                 "tags": [
                     "app"
                 ],
-                "summary": "获取ApiKey",
+                "summary": "删除评测问题集",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "应用id",
-                        "name": "appId",
-                        "in": "query",
-                        "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "应用类型",
-                        "name": "appType",
-                        "in": "query",
-                        "required": true
+                        "description": "问题集id",
+                        "name": "data",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/request.AppEvalDatasetIdRequest"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "allOf": [
-                                {
-                                    "$ref": "#/definitions/response.Response"
-                                },
-                                {
-                                    "type": "object",
-                                    "properties": {
-                                        "data": {
-                                            "type": "array",
-                                            "items": {
-                                                "$ref": "#/definitions/response.ApiResponse"
-                                            }
-                                        }
-                                    }
-                                }
-                            ]
+                            "$ref": "#/definitions/response.Response"
                         }
                     }
                 }
             }
         },
-        "/appspace/app/list": {
+        "/appspace/app/eval/dataset/list": {
             "get": {
                 "security": [
                     {
                         "JWT": []
                     }
                 ],
-                "description": "获取智能体、工作流、文本问答等应用",
+                "description": "获取评测问题集列表",
                 "consumes": [
                     "application/json"
                 ],
@@ -203,26 +206,7 @@ const docTemplate = `{
                 "tags": [
                     "app"
                 ],
-                "summary": "获取应用列表",
-                "parameters": [
-                    {
-                        "type": "string",
-                        "description": "应用名(模糊查询)",
-                        "name": "name",
-                        "in": "query"
-                    },
-                    {
-                        "enum": [
-                            "agent",
-                            "workflow",
-                            "rag"
-                        ],
-                        "type": "string",
-                        "description": "应用类型 Enums(agent,workflow,rag)",
-                        "name": "appType",
-                        "in": "query"
-                    }
-                ],
+                "summary": "获取评测问题集列表",
                 "responses": {
                     "200": {
                         "description": "OK",
@@ -245,7 +229,7 @@ const docTemplate = `{
                                                         "list": {
                                                             "type": "array",
                                                             "items": {
-                                                                "$ref": "#/definitions/response.AppBriefInfo"
+                                                                "$ref": "#/definitions/response.AppEvalDatasetInfo"
                                                             }
                                                         }
                                                     }
@@ -260,14 +244,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/appspace/app/openurl": {
-            "put": {
+        "/appspace/app/eval/job": {
+            "get": {
                 "security": [
                     {
                         "JWT": []
                     }
                 ],
-                "description": "编辑应用Url",
+                "description": "获取评测任务详情及每个问题的回答和评分",
                 "consumes": [
                     "application/json"
                 ],
@@ -277,23 +261,33 @@ const docTemplate = `{
                 "tags": [
                     "app"
                 ],
-                "summary": "编辑应用Url",
+                "summary": "获取评测任务详情",
                 "parameters": [
                     {
-                        "description": "应用Url基本信息",
-                        "name": "data",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/request.AppUrlUpdateRequest"
-                        }
+                        "type": "string",
+                        "description": "评测任务id",
+                        "name": "jobId",
+                        "in": "query",
+                        "required": true
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/response.Response"
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/response.AppEvalJobDetail"
+                                        }
+                                    }
+                                }
+                            ]
                         }
                     }
                 }
@@ -304,44 +298,7 @@ const docTemplate = `{
                         "JWT": []
                     }
                 ],
-                "description": "创建应用Url",
-                "consumes": [
-                    "application/json"
-                ],
-                "produces": [
-                    "application/json"
-                ],
-                "tags": [
-                    "app"
-                ],
-                "summary": "创建应用Url",
-                "parameters": [
-                    {
-                        "description": "应用Url基本信息",
-                        "name": "data",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/request.AppUrlCreateRequest"
-                        }
-                    }
-                ],
-                "responses": {
-                    "200": {
-                        "description": "OK",
-                        "schema": {
-                            "$ref": "#/definitions/response.Response"
-                        }
-                    }
-                }
-            },
-            "delete": {
-                "security": [
-                    {
-                        "JWT": []
-                    }
-                ],
-                "description": "删除应用Url",
+                "description": "使用问题集回放应用对话，由评分模型对回答的忠实度、相关性、正确性打分，并按基线和最低分判断是否允许发布",
                 "consumes": [
                     "application/json"
                 ],
@@ -351,15 +308,15 @@ const docTemplate = `{
                 "tags": [
                     "app"
                 ],
-                "summary": "删除应用Url",
+                "summary": "创建评测任务",
                 "parameters": [
                     {
-                        "description": "应用UrlId",
+                        "description": "评测任务信息",
                         "name": "data",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/request.AppUrlIdRequest"
+                            "$ref": "#/definitions/request.AppEvalJobCreateRequest"
                         }
                     }
                 ],
@@ -367,20 +324,32 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/response.Response"
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/response.AppEvalJobInfo"
+                                        }
+                                    }
+                                }
+                            ]
                         }
                     }
                 }
             }
         },
-        "/appspace/app/openurl/list": {
+        "/appspace/app/eval/job/list": {
             "get": {
                 "security": [
                     {
                         "JWT": []
                     }
                 ],
-                "description": "获取应用Url列表",
+                "description": "获取评测任务列表，可按问题集或应用过滤",
                 "consumes": [
                     "application/json"
                 ],
@@ -390,21 +359,19 @@ const docTemplate = `{
                 "tags": [
                     "app"
                 ],
-                "summary": "获取应用Url列表",
+                "summary": "获取评测任务列表",
                 "parameters": [
                     {
                         "type": "string",
                         "description": "应用id",
                         "name": "appId",
-                        "in": "query",
-                        "required": true
+                        "in": "query"
                     },
                     {
                         "type": "string",
-                        "description": "应用类型",
-                        "name": "appType",
-                        "in": "query",
-                        "required": true
+                        "description": "问题集id",
+                        "name": "datasetId",
+                        "in": "query"
                     }
                 ],
                 "responses": {
@@ -429,7 +396,7 @@ const docTemplate = `{
                                                         "list": {
                                                             "type": "array",
                                                             "items": {
-                                                                "$ref": "#/definitions/response.AppUrlInfo"
+                                                                "$ref": "#/definitions/response.AppEvalJobInfo"
                                                             }
                                                         }
                                                     }
@@ -444,14 +411,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/appspace/app/openurl/status": {
-            "put": {
-                "security": [
-                    {
-                        "JWT": []
-                    }
-                ],
-                "description": "启用/停用应用Url",
+        "/appspace/app/key": {
+            "post": {
+                "description": "生成ApiKey",
                 "consumes": [
                     "application/json"
                 ],
@@ -461,15 +423,15 @@ const docTemplate = `{
                 "tags": [
                     "app"
                 ],
-                "summary": "启用/停用应用Url",
+                "summary": "生成ApiKey",
                 "parameters": [
                     {
-                        "description": "应用UrlId",
+                        "description": "生成ApiKey参数",
                         "name": "data",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/request.AppUrlStatusRequest"
+                            "$ref": "#/definitions/request.GenApiKeyRequest"
                         }
                     }
                 ],
@@ -477,20 +439,25 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/response.Response"
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/response.ApiResponse"
+                                        }
+                                    }
+                                }
+                            ]
                         }
                     }
                 }
-            }
-        },
-        "/appspace/app/publish": {
-            "post": {
-                "security": [
-                    {
-                        "JWT": []
-                    }
-                ],
-                "description": "发布应用",
+            },
+            "delete": {
+                "description": "删除ApiKey",
                 "consumes": [
                     "application/json"
                 ],
@@ -500,15 +467,15 @@ const docTemplate = `{
                 "tags": [
                     "app"
                 ],
-                "summary": "发布应用",
+                "summary": "删除ApiKey",
                 "parameters": [
                     {
-                        "description": "发布应用参数",
+                        "description": "删除Apikey参数",
                         "name": "data",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/request.PublishAppRequest"
+                            "$ref": "#/definitions/request.DelApiKeyRequest"
                         }
                     }
                 ],
@@ -520,14 +487,11 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "delete": {
-                "security": [
-                    {
-                        "JWT": []
-                    }
-                ],
-                "description": "取消发布应用",
+            }
+        },
+        "/appspace/app/key/list": {
+            "get": {
+                "description": "获取ApiKey",
                 "consumes": [
                     "application/json"
                 ],
@@ -537,36 +501,51 @@ const docTemplate = `{
                 "tags": [
                     "app"
                 ],
-                "summary": "取消发布应用",
+                "summary": "获取ApiKey",
                 "parameters": [
                     {
-                        "description": "取消发布应用参数",
-                        "name": "data",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/request.UnPublishAppRequest"
-                        }
+                        "type": "string",
+                        "description": "应用id",
+                        "name": "appId",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "应用类型",
+                        "name": "appType",
+                        "in": "query",
+                        "required": true
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/response.Response"
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/response.ApiResponse"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
                         }
                     }
                 }
             }
         },
-        "/appspace/app/url": {
-            "get": {
-                "security": [
-                    {
-                        "JWT": []
-                    }
-                ],
-                "description": "获取Api根地址",
+        "/appspace/app/key/rotate": {
+            "post": {
+                "description": "生成与原ApiKey配置相同的新ApiKey，原ApiKey在重叠期结束后失效",
                 "consumes": [
                     "application/json"
                 ],
@@ -576,21 +555,16 @@ const docTemplate = `{
                 "tags": [
                     "app"
                 ],
-                "summary": "获取Api根地址",
+                "summary": "轮换ApiKey",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "应用ID",
-                        "name": "appId",
-                        "in": "query",
-                        "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "应用类型",
-                        "name": "appType",
-                        "in": "query",
-                        "required": true
+                        "description": "轮换ApiKey参数",
+                        "name": "data",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/request.RotateApiKeyRequest"
+                        }
                     }
                 ],
                 "responses": {
@@ -605,7 +579,7 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "type": "string"
+                                            "$ref": "#/definitions/response.ApiResponse"
                                         }
                                     }
                                 }
@@ -615,13 +589,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/appspace/rag": {
+        "/appspace/app/list": {
             "get": {
                 "security": [
                     {
                         "JWT": []
                     }
                 ],
+                "description": "获取智能体、工作流、文本问答等应用",
                 "consumes": [
                     "application/json"
                 ],
@@ -629,15 +604,26 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "rag"
+                    "app"
                 ],
-                "summary": "获取RAG信息",
+                "summary": "获取应用列表",
                 "parameters": [
                     {
                         "type": "string",
-                        "name": "ragId",
-                        "in": "query",
-                        "required": true
+                        "description": "应用名(模糊查询)",
+                        "name": "name",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "agent",
+                            "workflow",
+                            "rag"
+                        ],
+                        "type": "string",
+                        "description": "应用类型 Enums(agent,workflow,rag)",
+                        "name": "appType",
+                        "in": "query"
                     }
                 ],
                 "responses": {
@@ -652,7 +638,22 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "$ref": "#/definitions/response.RagInfo"
+                                            "allOf": [
+                                                {
+                                                    "$ref": "#/definitions/response.ListResult"
+                                                },
+                                                {
+                                                    "type": "object",
+                                                    "properties": {
+                                                        "list": {
+                                                            "type": "array",
+                                                            "items": {
+                                                                "$ref": "#/definitions/response.AppBriefInfo"
+                                                            }
+                                                        }
+                                                    }
+                                                }
+                                            ]
                                         }
                                     }
                                 }
@@ -660,13 +661,16 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
+            }
+        },
+        "/appspace/app/openurl": {
             "put": {
                 "security": [
                     {
                         "JWT": []
                     }
                 ],
+                "description": "编辑应用Url",
                 "consumes": [
                     "application/json"
                 ],
@@ -674,17 +678,17 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "rag"
+                    "app"
                 ],
-                "summary": "更新RAG基本信息",
+                "summary": "编辑应用Url",
                 "parameters": [
                     {
-                        "description": "更新RAG基本信息的请求参数",
+                        "description": "应用Url基本信息",
                         "name": "data",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/request.RagBrief"
+                            "$ref": "#/definitions/request.AppUrlUpdateRequest"
                         }
                     }
                 ],
@@ -703,6 +707,7 @@ const docTemplate = `{
                         "JWT": []
                     }
                 ],
+                "description": "创建应用Url",
                 "consumes": [
                     "application/json"
                 ],
@@ -710,17 +715,17 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "rag"
+                    "app"
                 ],
-                "summary": "创建RAG",
+                "summary": "创建应用Url",
                 "parameters": [
                     {
-                        "description": "创建RAG的请求参数",
+                        "description": "应用Url基本信息",
                         "name": "data",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/request.AppBriefConfig"
+                            "$ref": "#/definitions/request.AppUrlCreateRequest"
                         }
                     }
                 ],
@@ -728,19 +733,7 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "allOf": [
-                                {
-                                    "$ref": "#/definitions/response.Response"
-                                },
-                                {
-                                    "type": "object",
-                                    "properties": {
-                                        "data": {
-                                            "$ref": "#/definitions/request.RagReq"
-                                        }
-                                    }
-                                }
-                            ]
+                            "$ref": "#/definitions/response.Response"
                         }
                     }
                 }
@@ -751,6 +744,7 @@ const docTemplate = `{
                         "JWT": []
                     }
                 ],
+                "description": "删除应用Url",
                 "consumes": [
                     "application/json"
                 ],
@@ -758,17 +752,17 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "rag"
+                    "app"
                 ],
-                "summary": "删除RAG",
+                "summary": "删除应用Url",
                 "parameters": [
                     {
-                        "description": "删除RAG的请求参数",
+                        "description": "应用UrlId",
                         "name": "data",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/request.RagReq"
+                            "$ref": "#/definitions/request.AppUrlIdRequest"
                         }
                     }
                 ],
@@ -782,13 +776,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/appspace/rag/config": {
-            "put": {
+        "/appspace/app/openurl/list": {
+            "get": {
                 "security": [
                     {
                         "JWT": []
                     }
                 ],
+                "description": "获取应用Url列表",
                 "consumes": [
                     "application/json"
                 ],
@@ -796,37 +791,70 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "rag"
+                    "app"
                 ],
-                "summary": "更新RAG配置信息",
+                "summary": "获取应用Url列表",
                 "parameters": [
                     {
-                        "description": "更新RAG配置信息的请求参数",
-                        "name": "data",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/request.RagConfig"
-                        }
+                        "type": "string",
+                        "description": "应用id",
+                        "name": "appId",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "应用类型",
+                        "name": "appType",
+                        "in": "query",
+                        "required": true
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/response.Response"
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "allOf": [
+                                                {
+                                                    "$ref": "#/definitions/response.ListResult"
+                                                },
+                                                {
+                                                    "type": "object",
+                                                    "properties": {
+                                                        "list": {
+                                                            "type": "array",
+                                                            "items": {
+                                                                "$ref": "#/definitions/response.AppUrlInfo"
+                                                            }
+                                                        }
+                                                    }
+                                                }
+                                            ]
+                                        }
+                                    }
+                                }
+                            ]
                         }
                     }
                 }
             }
         },
-        "/appspace/workflow": {
-            "post": {
+        "/appspace/app/openurl/status": {
+            "put": {
                 "security": [
                     {
                         "JWT": []
                     }
                 ],
+                "description": "启用/停用应用Url",
                 "consumes": [
                     "application/json"
                 ],
@@ -834,17 +862,17 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "workflow"
+                    "app"
                 ],
-                "summary": "创建Workflow",
+                "summary": "启用/停用应用Url",
                 "parameters": [
                     {
-                        "description": "创建Workflow的请求参数",
+                        "description": "应用UrlId",
                         "name": "data",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/request.AppBriefConfig"
+                            "$ref": "#/definitions/request.AppUrlStatusRequest"
                         }
                     }
                 ],
@@ -852,31 +880,20 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "allOf": [
-                                {
-                                    "$ref": "#/definitions/response.Response"
-                                },
-                                {
-                                    "type": "object",
-                                    "properties": {
-                                        "data": {
-                                            "$ref": "#/definitions/response.CozeWorkflowIDData"
-                                        }
-                                    }
-                                }
-                            ]
+                            "$ref": "#/definitions/response.Response"
                         }
                     }
                 }
             }
         },
-        "/appspace/workflow/copy": {
+        "/appspace/app/publish": {
             "post": {
                 "security": [
                     {
                         "JWT": []
                     }
                 ],
+                "description": "发布应用",
                 "consumes": [
                     "application/json"
                 ],
@@ -884,17 +901,17 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "workflow"
+                    "app"
                 ],
-                "summary": "拷贝Workflow",
+                "summary": "发布应用",
                 "parameters": [
                     {
-                        "description": "创建Workflow的请求参数",
+                        "description": "发布应用参数",
                         "name": "data",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/request.WorkflowIDReq"
+                            "$ref": "#/definitions/request.PublishAppRequest"
                         }
                     }
                 ],
@@ -902,49 +919,37 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "allOf": [
-                                {
-                                    "$ref": "#/definitions/response.Response"
-                                },
-                                {
-                                    "type": "object",
-                                    "properties": {
-                                        "data": {
-                                            "$ref": "#/definitions/response.CozeWorkflowIDData"
-                                        }
-                                    }
-                                }
-                            ]
+                            "$ref": "#/definitions/response.Response"
                         }
                     }
                 }
-            }
-        },
-        "/appspace/workflow/export": {
-            "get": {
+            },
+            "delete": {
                 "security": [
                     {
                         "JWT": []
                     }
                 ],
-                "description": "导出工作流的json文件",
+                "description": "取消发布应用",
                 "consumes": [
                     "application/json"
                 ],
                 "produces": [
-                    "application/octet-stream"
+                    "application/json"
                 ],
                 "tags": [
-                    "workflow"
+                    "app"
                 ],
-                "summary": "导出Workflow",
+                "summary": "取消发布应用",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "工作流ID",
-                        "name": "workflow_id",
-                        "in": "query",
-                        "required": true
+                        "description": "取消发布应用参数",
+                        "name": "data",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/request.UnPublishAppRequest"
+                        }
                     }
                 ],
                 "responses": {
@@ -957,72 +962,40 @@ const docTemplate = `{
                 }
             }
         },
-        "/appspace/workflow/import": {
-            "post": {
+        "/appspace/app/url": {
+            "get": {
                 "security": [
                     {
                         "JWT": []
                     }
                 ],
-                "description": "通过JSON文件导入工作流",
+                "description": "获取Api根地址",
                 "consumes": [
-                    "multipart/form-data"
+                    "application/json"
                 ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "workflow"
+                    "app"
                 ],
-                "summary": "导入Workflow",
+                "summary": "获取Api根地址",
                 "parameters": [
                     {
-                        "type": "file",
-                        "description": "工作流JSON文件",
-                        "name": "file",
-                        "in": "formData",
+                        "type": "string",
+                        "description": "应用ID",
+                        "name": "appId",
+                        "in": "query",
                         "required": true
-                    }
-                ],
-                "responses": {
-                    "200": {
-                        "description": "OK",
-                        "schema": {
-                            "allOf": [
-                                {
-                                    "$ref": "#/definitions/response.Response"
-                                },
-                                {
-                                    "type": "object",
-                                    "properties": {
-                                        "data": {
-                                            "$ref": "#/definitions/response.CozeWorkflowIDData"
-                                        }
-                                    }
-                                }
-                            ]
-                        }
-                    }
-                }
-            }
-        },
-        "/appspace/workflow/model/select/llm": {
-            "get": {
-                "security": [
+                    },
                     {
-                        "JWT": []
+                        "type": "string",
+                        "description": "应用类型",
+                        "name": "appType",
+                        "in": "query",
+                        "required": true
                     }
                 ],
-                "consumes": [
-                    "application/json"
-                ],
-                "produces": [
-                    "application/json"
-                ],
-                "tags": [
-                    "workflow"
-                ],
-                "summary": "llm模型列表（用于workflow）",
                 "responses": {
                     "200": {
                         "description": "OK",
@@ -1035,19 +1008,7 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "allOf": [
-                                                {
-                                                    "$ref": "#/definitions/response.ListResult"
-                                                },
-                                                {
-                                                    "type": "object",
-                                                    "properties": {
-                                                        "list": {
-                                                            "$ref": "#/definitions/response.CozeWorkflowModelInfo"
-                                                        }
-                                                    }
-                                                }
-                                            ]
+                                            "type": "string"
                                         }
                                     }
                                 }
@@ -1057,14 +1018,13 @@ const docTemplate = `{
                 }
             }
         },
-        "/assistant": {
+        "/appspace/rag": {
             "get": {
                 "security": [
                     {
                         "JWT": []
                     }
                 ],
-                "description": "查看智能体详情",
                 "consumes": [
                     "application/json"
                 ],
@@ -1072,14 +1032,13 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "agent"
+                    "rag"
                 ],
-                "summary": "查看智能体详情",
+                "summary": "获取RAG信息",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "智能体id",
-                        "name": "assistantId",
+                        "name": "ragId",
                         "in": "query",
                         "required": true
                     }
@@ -1096,7 +1055,7 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "$ref": "#/definitions/response.Assistant"
+                                            "$ref": "#/definitions/response.RagInfo"
                                         }
                                     }
                                 }
@@ -1111,7 +1070,6 @@ const docTemplate = `{
                         "JWT": []
                     }
                 ],
-                "description": "修改智能体基本信息，名称，头像，简介",
                 "consumes": [
                     "application/json"
                 ],
@@ -1119,17 +1077,17 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "agent"
+                    "rag"
                 ],
-                "summary": "修改智能体基本信息",
+                "summary": "更新RAG基本信息",
                 "parameters": [
                     {
-                        "description": "智能体基本信息参数",
+                        "description": "更新RAG基本信息的请求参数",
                         "name": "data",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/request.AssistantBrief"
+                            "$ref": "#/definitions/request.RagBrief"
                         }
                     }
                 ],
@@ -1148,7 +1106,6 @@ const docTemplate = `{
                         "JWT": []
                     }
                 ],
-                "description": "创建智能体，填写基本信息，创建完成为草稿状态",
                 "consumes": [
                     "application/json"
                 ],
@@ -1156,12 +1113,12 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "agent"
+                    "rag"
                 ],
-                "summary": "创建智能体",
+                "summary": "创建RAG",
                 "parameters": [
                     {
-                        "description": "智能体基本信息",
+                        "description": "创建RAG的请求参数",
                         "name": "data",
                         "in": "body",
                         "required": true,
@@ -1182,7 +1139,7 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "$ref": "#/definitions/response.AssistantCreateResp"
+                                            "$ref": "#/definitions/request.RagReq"
                                         }
                                     }
                                 }
@@ -1190,16 +1147,13 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        },
-        "/assistant/config": {
-            "put": {
+            },
+            "delete": {
                 "security": [
                     {
                         "JWT": []
                     }
                 ],
-                "description": "修改智能体配置信息，模型配置，知识库配置等等",
                 "consumes": [
                     "application/json"
                 ],
@@ -1207,17 +1161,17 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "agent"
+                    "rag"
                 ],
-                "summary": "修改智能体配置信息",
+                "summary": "删除RAG",
                 "parameters": [
                     {
-                        "description": "智能体配置信息参数",
+                        "description": "删除RAG的请求参数",
                         "name": "data",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/request.AssistantConfig"
+                            "$ref": "#/definitions/request.RagReq"
                         }
                     }
                 ],
@@ -1231,63 +1185,13 @@ const docTemplate = `{
                 }
             }
         },
-        "/assistant/conversation": {
-            "post": {
-                "security": [
-                    {
-                        "JWT": []
-                    }
-                ],
-                "description": "创建智能体对话",
-                "consumes": [
-                    "application/json"
-                ],
-                "produces": [
-                    "application/json"
-                ],
-                "tags": [
-                    "agent"
-                ],
-                "summary": "创建智能体对话",
-                "parameters": [
-                    {
-                        "description": "智能体对话创建参数",
-                        "name": "data",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/request.ConversationCreateRequest"
-                        }
-                    }
-                ],
-                "responses": {
-                    "200": {
-                        "description": "OK",
-                        "schema": {
-                            "allOf": [
-                                {
-                                    "$ref": "#/definitions/response.Response"
-                                },
-                                {
-                                    "type": "object",
-                                    "properties": {
-                                        "data": {
-                                            "$ref": "#/definitions/response.ConversationCreateResp"
-                                        }
-                                    }
-                                }
-                            ]
-                        }
-                    }
-                }
-            },
-            "delete": {
+        "/appspace/rag/config": {
+            "put": {
                 "security": [
                     {
                         "JWT": []
                     }
                 ],
-                "description": "删除智能体对话",
                 "consumes": [
                     "application/json"
                 ],
@@ -1295,17 +1199,17 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "agent"
+                    "rag"
                 ],
-                "summary": "删除智能体对话",
+                "summary": "更新RAG配置信息",
                 "parameters": [
                     {
-                        "description": "智能体对话的id",
+                        "description": "更新RAG配置信息的请求参数",
                         "name": "data",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/request.ConversationIdRequest"
+                            "$ref": "#/definitions/request.RagConfig"
                         }
                     }
                 ],
@@ -1319,14 +1223,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/assistant/conversation/detail": {
+        "/appspace/rag/feedback/list": {
             "get": {
                 "security": [
                     {
                         "JWT": []
                     }
                 ],
-                "description": "智能体对话详情历史列表",
+                "description": "查询RAG收到的回答评价，可按RAG、评价与时间范围过滤；组织管理员可查询组织内所有RAG，其他用户仅可查询自己创建的RAG",
                 "consumes": [
                     "application/json"
                 ],
@@ -1334,33 +1238,50 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "agent"
+                    "rag"
                 ],
-                "summary": "智能体对话详情历史列表",
+                "summary": "RAG回答评价列表",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "智能体对话id",
-                        "name": "conversationId",
-                        "in": "query",
-                        "required": true
+                        "description": "ragId，非组织管理员必填",
+                        "name": "ragId",
+                        "in": "query"
                     },
                     {
                         "type": "integer",
-                        "description": "页面编号，从1开始",
-                        "name": "pageNo",
-                        "in": "query",
-                        "required": true
+                        "description": "评价（1:点赞 -1:点踩 0:全部）",
+                        "name": "rating",
+                        "in": "query"
                     },
                     {
                         "type": "integer",
-                        "description": "单页数量，从1开始",
-                        "name": "pageSize",
-                        "in": "query",
-                        "required": true
-                    }
-                ],
-                "responses": {
+                        "description": "开始时间（毫秒）",
+                        "name": "startTime",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "结束时间（毫秒）",
+                        "name": "endTime",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "页码",
+                        "name": "pageNo",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "每页数量",
+                        "name": "pageSize",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
@@ -1382,7 +1303,7 @@ const docTemplate = `{
                                                         "list": {
                                                             "type": "array",
                                                             "items": {
-                                                                "$ref": "#/definitions/response.ConversationDetailInfo"
+                                                                "$ref": "#/definitions/response.RagFeedbackInfo"
                                                             }
                                                         }
                                                     }
@@ -1397,14 +1318,13 @@ const docTemplate = `{
                 }
             }
         },
-        "/assistant/conversation/list": {
-            "get": {
+        "/appspace/workflow": {
+            "post": {
                 "security": [
                     {
                         "JWT": []
                     }
                 ],
-                "description": "智能体对话列表",
                 "consumes": [
                     "application/json"
                 ],
@@ -1412,30 +1332,18 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "agent"
+                    "workflow"
                 ],
-                "summary": "智能体对话列表",
+                "summary": "创建Workflow",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "智能体id",
-                        "name": "assistantId",
-                        "in": "query",
-                        "required": true
-                    },
-                    {
-                        "type": "integer",
-                        "description": "页面编号，从1开始",
-                        "name": "pageNo",
-                        "in": "query",
-                        "required": true
-                    },
-                    {
-                        "type": "integer",
-                        "description": "单页数量，从1开始",
-                        "name": "pageSize",
-                        "in": "query",
-                        "required": true
+                        "description": "创建Workflow的请求参数",
+                        "name": "data",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/request.AppBriefConfig"
+                        }
                     }
                 ],
                 "responses": {
@@ -1450,22 +1358,7 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "allOf": [
-                                                {
-                                                    "$ref": "#/definitions/response.PageResult"
-                                                },
-                                                {
-                                                    "type": "object",
-                                                    "properties": {
-                                                        "list": {
-                                                            "type": "array",
-                                                            "items": {
-                                                                "$ref": "#/definitions/response.ConversationInfo"
-                                                            }
-                                                        }
-                                                    }
-                                                }
-                                            ]
+                                            "$ref": "#/definitions/response.CozeWorkflowIDData"
                                         }
                                     }
                                 }
@@ -1475,14 +1368,13 @@ const docTemplate = `{
                 }
             }
         },
-        "/assistant/stream": {
+        "/appspace/workflow/copy": {
             "post": {
                 "security": [
                     {
                         "JWT": []
                     }
                 ],
-                "description": "智能体流式问答",
                 "consumes": [
                     "application/json"
                 ],
@@ -1490,17 +1382,17 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "agent"
+                    "workflow"
                 ],
-                "summary": "智能体流式问答",
+                "summary": "拷贝Workflow",
                 "parameters": [
                     {
-                        "description": "智能体流式问答参数",
+                        "description": "创建Workflow的请求参数",
                         "name": "data",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/request.ConversionStreamRequest"
+                            "$ref": "#/definitions/request.WorkflowIDReq"
                         }
                     }
                 ],
@@ -1508,35 +1400,47 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/response.Response"
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/response.CozeWorkflowIDData"
+                                        }
+                                    }
+                                }
+                            ]
                         }
                     }
                 }
             }
         },
-        "/assistant/template": {
+        "/appspace/workflow/export": {
             "get": {
                 "security": [
                     {
                         "JWT": []
                     }
                 ],
-                "description": "获取智能体模板",
+                "description": "导出工作流的json文件",
                 "consumes": [
                     "application/json"
                 ],
                 "produces": [
-                    "application/json"
+                    "application/octet-stream"
                 ],
                 "tags": [
-                    "agent"
+                    "workflow"
                 ],
-                "summary": "获取智能体模板",
+                "summary": "导出Workflow",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "智能体模板ID",
-                        "name": "assistantTemplateId",
+                        "description": "工作流ID",
+                        "name": "workflow_id",
                         "in": "query",
                         "required": true
                     }
@@ -1545,49 +1449,37 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "allOf": [
-                                {
-                                    "$ref": "#/definitions/response.Response"
-                                },
-                                {
-                                    "type": "object",
-                                    "properties": {
-                                        "data": {
-                                            "$ref": "#/definitions/response.AssistantTemplateInfo"
-                                        }
-                                    }
-                                }
-                            ]
+                            "$ref": "#/definitions/response.Response"
                         }
                     }
                 }
-            },
+            }
+        },
+        "/appspace/workflow/import": {
             "post": {
                 "security": [
                     {
                         "JWT": []
                     }
                 ],
-                "description": "通过复制创建智能体",
+                "description": "通过JSON文件导入工作流",
                 "consumes": [
-                    "application/json"
+                    "multipart/form-data"
                 ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "agent"
+                    "workflow"
                 ],
-                "summary": "复制创建智能体",
+                "summary": "导入Workflow",
                 "parameters": [
                     {
-                        "description": "复制创建智能体所需信息",
-                        "name": "data",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/request.AssistantTemplateRequest"
-                        }
+                        "type": "file",
+                        "description": "工作流JSON文件",
+                        "name": "file",
+                        "in": "formData",
+                        "required": true
                     }
                 ],
                 "responses": {
@@ -1602,7 +1494,7 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "$ref": "#/definitions/response.AssistantCreateResp"
+                                            "$ref": "#/definitions/response.CozeWorkflowIDData"
                                         }
                                     }
                                 }
@@ -1612,14 +1504,13 @@ const docTemplate = `{
                 }
             }
         },
-        "/assistant/template/list": {
+        "/appspace/workflow/model/select/llm": {
             "get": {
                 "security": [
                     {
                         "JWT": []
                     }
                 ],
-                "description": "智能体模板列表",
                 "consumes": [
                     "application/json"
                 ],
@@ -1627,29 +1518,9 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "agent"
-                ],
-                "summary": "智能体模板列表",
-                "parameters": [
-                    {
-                        "enum": [
-                            "gov",
-                            "industry",
-                            "edu",
-                            "medical"
-                        ],
-                        "type": "string",
-                        "description": "智能体类型",
-                        "name": "category",
-                        "in": "query"
-                    },
-                    {
-                        "type": "string",
-                        "description": "智能体名称",
-                        "name": "name",
-                        "in": "query"
-                    }
+                    "workflow"
                 ],
+                "summary": "llm模型列表（用于workflow）",
                 "responses": {
                     "200": {
                         "description": "OK",
@@ -1670,10 +1541,7 @@ const docTemplate = `{
                                                     "type": "object",
                                                     "properties": {
                                                         "list": {
-                                                            "type": "array",
-                                                            "items": {
-                                                                "$ref": "#/definitions/response.AssistantTemplateInfo"
-                                                            }
+                                                            "$ref": "#/definitions/response.CozeWorkflowModelInfo"
                                                         }
                                                     }
                                                 }
@@ -1687,14 +1555,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/assistant/tool/custom": {
-            "post": {
+        "/assistant": {
+            "get": {
                 "security": [
                     {
                         "JWT": []
                     }
                 ],
-                "description": "为智能体绑定自定义工具",
+                "description": "查看智能体详情",
                 "consumes": [
                     "application/json"
                 ],
@@ -1704,34 +1572,44 @@ const docTemplate = `{
                 "tags": [
                     "agent"
                 ],
-                "summary": "添加自定义工具",
+                "summary": "查看智能体详情",
                 "parameters": [
                     {
-                        "description": "自定义工具新增参数",
-                        "name": "data",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/request.AssistantCustomToolAddRequest"
-                        }
+                        "type": "string",
+                        "description": "智能体id",
+                        "name": "assistantId",
+                        "in": "query",
+                        "required": true
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/response.Response"
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/response.Assistant"
+                                        }
+                                    }
+                                }
+                            ]
                         }
                     }
                 }
             },
-            "delete": {
+            "put": {
                 "security": [
                     {
                         "JWT": []
                     }
                 ],
-                "description": "为智能体解绑自定义工具",
+                "description": "修改智能体基本信息，名称，头像，简介",
                 "consumes": [
                     "application/json"
                 ],
@@ -1741,15 +1619,15 @@ const docTemplate = `{
                 "tags": [
                     "agent"
                 ],
-                "summary": "删除自定义工具",
+                "summary": "修改智能体基本信息",
                 "parameters": [
                     {
-                        "description": "智能体id与自定义工具id",
+                        "description": "智能体基本信息参数",
                         "name": "data",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/request.AssistantCustomToolDelRequest"
+                            "$ref": "#/definitions/request.AssistantBrief"
                         }
                     }
                 ],
@@ -1761,16 +1639,14 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        },
-        "/assistant/tool/custom/switch": {
-            "put": {
+            },
+            "post": {
                 "security": [
                     {
                         "JWT": []
                     }
                 ],
-                "description": "修改智能体绑定的自定义工具的启用状态",
+                "description": "创建智能体，填写基本信息，创建完成为草稿状态",
                 "consumes": [
                     "application/json"
                 ],
@@ -1780,15 +1656,15 @@ const docTemplate = `{
                 "tags": [
                     "agent"
                 ],
-                "summary": "启用/停用自定义工具",
+                "summary": "创建智能体",
                 "parameters": [
                     {
-                        "description": "智能体id与自定义工具id",
+                        "description": "智能体基本信息",
                         "name": "data",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/request.AssistantCustomToolEnableRequest"
+                            "$ref": "#/definitions/request.AppBriefConfig"
                         }
                     }
                 ],
@@ -1796,20 +1672,32 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/response.Response"
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/response.AssistantCreateResp"
+                                        }
+                                    }
+                                }
+                            ]
                         }
                     }
                 }
             }
         },
-        "/assistant/tool/mcp": {
-            "post": {
+        "/assistant/config": {
+            "put": {
                 "security": [
                     {
                         "JWT": []
                     }
                 ],
-                "description": "为智能体绑定已发布的mcp工具",
+                "description": "修改智能体配置信息，模型配置，知识库配置等等",
                 "consumes": [
                     "application/json"
                 ],
@@ -1819,15 +1707,15 @@ const docTemplate = `{
                 "tags": [
                     "agent"
                 ],
-                "summary": "添加mcp工具",
+                "summary": "修改智能体配置信息",
                 "parameters": [
                     {
-                        "description": "mcp新增参数",
+                        "description": "智能体配置信息参数",
                         "name": "data",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/request.AssistantMCPToolAddRequest"
+                            "$ref": "#/definitions/request.AssistantConfig"
                         }
                     }
                 ],
@@ -1839,14 +1727,16 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "delete": {
+            }
+        },
+        "/assistant/conversation": {
+            "post": {
                 "security": [
                     {
                         "JWT": []
                     }
                 ],
-                "description": "为智能体解绑mcp",
+                "description": "创建智能体对话",
                 "consumes": [
                     "application/json"
                 ],
@@ -1856,15 +1746,15 @@ const docTemplate = `{
                 "tags": [
                     "agent"
                 ],
-                "summary": "删除mcp",
+                "summary": "创建智能体对话",
                 "parameters": [
                     {
-                        "description": "mcp工具id，智能体id",
+                        "description": "智能体对话创建参数",
                         "name": "data",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/request.AssistantMCPToolDelRequest"
+                            "$ref": "#/definitions/request.ConversationCreateRequest"
                         }
                     }
                 ],
@@ -1872,20 +1762,30 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/response.Response"
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/response.ConversationCreateResp"
+                                        }
+                                    }
+                                }
+                            ]
                         }
                     }
                 }
-            }
-        },
-        "/assistant/tool/mcp/switch": {
-            "put": {
+            },
+            "delete": {
                 "security": [
                     {
                         "JWT": []
                     }
                 ],
-                "description": "修改智能体绑定的MCP的启用状态",
+                "description": "删除智能体对话",
                 "consumes": [
                     "application/json"
                 ],
@@ -1895,15 +1795,15 @@ const docTemplate = `{
                 "tags": [
                     "agent"
                 ],
-                "summary": "启用/停用 MCP",
+                "summary": "删除智能体对话",
                 "parameters": [
                     {
-                        "description": "mcp工具id、智能体id、enable",
+                        "description": "智能体对话的id",
                         "name": "data",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/request.AssistantMCPToolEnableRequest"
+                            "$ref": "#/definitions/request.ConversationIdRequest"
                         }
                     }
                 ],
@@ -1917,14 +1817,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/assistant/tool/workflow": {
-            "post": {
+        "/assistant/conversation/branch": {
+            "put": {
                 "security": [
                     {
                         "JWT": []
                     }
                 ],
-                "description": "为智能体绑定已发布的工作流",
+                "description": "将所选版本所在分支设为对话当前分支，后续问答与历史列表沿该分支进行",
                 "consumes": [
                     "application/json"
                 ],
@@ -1934,15 +1834,15 @@ const docTemplate = `{
                 "tags": [
                     "agent"
                 ],
-                "summary": "添加工作流",
+                "summary": "切换智能体对话分支",
                 "parameters": [
                     {
-                        "description": "工作流新增参数",
+                        "description": "对话id与所选版本的对话详情id",
                         "name": "data",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/request.AssistantWorkFlowAddRequest"
+                            "$ref": "#/definitions/request.ConversationDetailRequest"
                         }
                     }
                 ],
@@ -1954,14 +1854,16 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "delete": {
+            }
+        },
+        "/assistant/conversation/detail": {
+            "get": {
                 "security": [
                     {
                         "JWT": []
                     }
                 ],
-                "description": "为智能体解绑工作流",
+                "description": "智能体对话详情历史列表",
                 "consumes": [
                     "application/json"
                 ],
@@ -1971,17 +1873,176 @@ const docTemplate = `{
                 "tags": [
                     "agent"
                 ],
-                "summary": "删除工作流",
+                "summary": "智能体对话详情历史列表",
                 "parameters": [
                     {
-                        "description": "工作流id,智能体id",
-                        "name": "data",
-                        "in": "body",
-                        "required": true,
+                        "type": "string",
+                        "description": "智能体对话id",
+                        "name": "conversationId",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "页面编号，从1开始",
+                        "name": "pageNo",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "单页数量，从1开始",
+                        "name": "pageSize",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/request.AssistantWorkFlowDelRequest"
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "allOf": [
+                                                {
+                                                    "$ref": "#/definitions/response.PageResult"
+                                                },
+                                                {
+                                                    "type": "object",
+                                                    "properties": {
+                                                        "list": {
+                                                            "type": "array",
+                                                            "items": {
+                                                                "$ref": "#/definitions/response.ConversationDetailInfo"
+                                                            }
+                                                        }
+                                                    }
+                                                }
+                                            ]
+                                        }
+                                    }
+                                }
+                            ]
                         }
                     }
+                }
+            }
+        },
+        "/assistant/conversation/detail/siblings": {
+            "get": {
+                "security": [
+                    {
+                        "JWT": []
+                    }
+                ],
+                "description": "获取某轮对话的所有版本（重新生成的回答与编辑后的问题）",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "agent"
+                ],
+                "summary": "智能体对话版本列表",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "智能体对话id",
+                        "name": "conversationId",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "对话详情id",
+                        "name": "detailId",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/response.ConversationDetailSiblings"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    }
+                }
+            }
+        },
+        "/assistant/conversation/export": {
+            "get": {
+                "security": [
+                    {
+                        "JWT": []
+                    }
+                ],
+                "description": "导出单个对话，或智能体下指定时间范围内的所有对话，格式为JSONL或Markdown",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/octet-stream"
+                ],
+                "tags": [
+                    "agent"
+                ],
+                "summary": "导出智能体对话",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "智能体id，与conversationId二选一",
+                        "name": "assistantId",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "智能体对话id",
+                        "name": "conversationId",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "开始时间（毫秒）",
+                        "name": "startTime",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "结束时间（毫秒）",
+                        "name": "endTime",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "jsonl",
+                            "markdown"
+                        ],
+                        "type": "string",
+                        "description": "导出格式",
+                        "name": "format",
+                        "in": "query"
+                    }
                 ],
                 "responses": {
                     "200": {
@@ -1993,14 +2054,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/assistant/tool/workflow/switch": {
-            "put": {
+        "/assistant/conversation/feedback": {
+            "post": {
                 "security": [
                     {
                         "JWT": []
                     }
                 ],
-                "description": "修改智能体绑定的工作流的启用状态",
+                "description": "点赞/点踩某轮回答并填写原因，rating为0时取消评价",
                 "consumes": [
                     "application/json"
                 ],
@@ -2010,15 +2071,15 @@ const docTemplate = `{
                 "tags": [
                     "agent"
                 ],
-                "summary": "启用/停用工作流",
+                "summary": "评价智能体回答",
                 "parameters": [
                     {
-                        "description": "工作流id,智能体id,开关",
+                        "description": "回答评价参数",
                         "name": "data",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/request.AssistantWorkFlowToolEnableRequest"
+                            "$ref": "#/definitions/request.ConversationFeedbackRequest"
                         }
                     }
                 ],
@@ -2032,13 +2093,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/avatar": {
+        "/assistant/conversation/import": {
             "post": {
                 "security": [
                     {
                         "JWT": []
                     }
                 ],
+                "description": "导入对话导出的JSONL文件，文件中每个对话导入为智能体下的一个新对话",
                 "consumes": [
                     "multipart/form-data"
                 ],
@@ -2046,14 +2108,21 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "common"
+                    "agent"
                 ],
-                "summary": "上传自定义图标",
+                "summary": "导入智能体对话",
                 "parameters": [
+                    {
+                        "type": "string",
+                        "description": "智能体id",
+                        "name": "assistantId",
+                        "in": "formData",
+                        "required": true
+                    },
                     {
                         "type": "file",
-                        "description": "自定义图标（JPG/JPEG/PNG）",
-                        "name": "avatar",
+                        "description": "对话JSONL文件",
+                        "name": "file",
                         "in": "formData",
                         "required": true
                     }
@@ -2070,7 +2139,7 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "$ref": "#/definitions/request.Avatar"
+                                            "$ref": "#/definitions/response.ConversationImportResp"
                                         }
                                     }
                                 }
@@ -2080,8 +2149,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/base/captcha": {
+        "/assistant/conversation/list": {
             "get": {
+                "security": [
+                    {
+                        "JWT": []
+                    }
+                ],
+                "description": "智能体对话列表",
                 "consumes": [
                     "application/json"
                 ],
@@ -2089,15 +2164,30 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "guest"
+                    "agent"
                 ],
-                "summary": "获取验证码",
+                "summary": "智能体对话列表",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "语言",
-                        "name": "X-Language",
-                        "in": "header"
+                        "description": "智能体id",
+                        "name": "assistantId",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "页面编号，从1开始",
+                        "name": "pageNo",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "单页数量，从1开始",
+                        "name": "pageSize",
+                        "in": "query",
+                        "required": true
                     }
                 ],
                 "responses": {
@@ -2112,7 +2202,22 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "$ref": "#/definitions/response.Captcha"
+                                            "allOf": [
+                                                {
+                                                    "$ref": "#/definitions/response.PageResult"
+                                                },
+                                                {
+                                                    "type": "object",
+                                                    "properties": {
+                                                        "list": {
+                                                            "type": "array",
+                                                            "items": {
+                                                                "$ref": "#/definitions/response.ConversationInfo"
+                                                            }
+                                                        }
+                                                    }
+                                                }
+                                            ]
                                         }
                                     }
                                 }
@@ -2122,47 +2227,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/base/custom": {
+        "/assistant/feedback/list": {
             "get": {
-                "produces": [
-                    "application/json"
-                ],
-                "tags": [
-                    "guest"
-                ],
-                "summary": "自定义logo和title",
-                "parameters": [
+                "security": [
                     {
-                        "type": "string",
-                        "description": "语言",
-                        "name": "X-Language",
-                        "in": "header"
+                        "JWT": []
                     }
                 ],
-                "responses": {
-                    "200": {
-                        "description": "OK",
-                        "schema": {
-                            "allOf": [
-                                {
-                                    "$ref": "#/definitions/response.Response"
-                                },
-                                {
-                                    "type": "object",
-                                    "properties": {
-                                        "data": {
-                                            "$ref": "#/definitions/response.LogoCustomInfo"
-                                        }
-                                    }
-                                }
-                            ]
-                        }
-                    }
-                }
-            }
-        },
-        "/base/language/select": {
-            "get": {
+                "description": "查询智能体收到的回答评价，可按智能体、评价与时间范围过滤；组织管理员可查询组织内所有智能体，其他用户仅可查询自己创建的智能体",
                 "consumes": [
                     "application/json"
                 ],
@@ -2170,9 +2242,49 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "guest"
+                    "agent"
+                ],
+                "summary": "智能体回答评价列表",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "智能体id，非组织管理员必填",
+                        "name": "assistantId",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "评价（1:点赞 -1:点踩 0:全部）",
+                        "name": "rating",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "开始时间（毫秒）",
+                        "name": "startTime",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "结束时间（毫秒）",
+                        "name": "endTime",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "页码",
+                        "name": "pageNo",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "每页数量",
+                        "name": "pageSize",
+                        "in": "query",
+                        "required": true
+                    }
                 ],
-                "summary": "获取语言列表（用于下拉选择）",
                 "responses": {
                     "200": {
                         "description": "OK",
@@ -2185,7 +2297,22 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "$ref": "#/definitions/response.LanguageSelect"
+                                            "allOf": [
+                                                {
+                                                    "$ref": "#/definitions/response.PageResult"
+                                                },
+                                                {
+                                                    "type": "object",
+                                                    "properties": {
+                                                        "list": {
+                                                            "type": "array",
+                                                            "items": {
+                                                                "$ref": "#/definitions/response.ConversationFeedbackInfo"
+                                                            }
+                                                        }
+                                                    }
+                                                }
+                                            ]
                                         }
                                     }
                                 }
@@ -2195,8 +2322,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/base/login": {
+        "/assistant/stream": {
             "post": {
+                "security": [
+                    {
+                        "JWT": []
+                    }
+                ],
+                "description": "智能体流式问答",
                 "consumes": [
                     "application/json"
                 ],
@@ -2204,23 +2337,17 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "guest"
+                    "agent"
                 ],
-                "summary": "用户登录",
+                "summary": "智能体流式问答",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "语言",
-                        "name": "X-Language",
-                        "in": "header"
-                    },
-                    {
-                        "description": "用户名+密码",
+                        "description": "智能体流式问答参数",
                         "name": "data",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/request.Login"
+                            "$ref": "#/definitions/request.ConversionStreamRequest"
                         }
                     }
                 ],
@@ -2228,26 +2355,20 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "allOf": [
-                                {
-                                    "$ref": "#/definitions/response.Response"
-                                },
-                                {
-                                    "type": "object",
-                                    "properties": {
-                                        "data": {
-                                            "$ref": "#/definitions/response.Login"
-                                        }
-                                    }
-                                }
-                            ]
+                            "$ref": "#/definitions/response.Response"
                         }
                     }
                 }
             }
         },
-        "/base/login/email": {
+        "/assistant/stream/edit": {
             "post": {
+                "security": [
+                    {
+                        "JWT": []
+                    }
+                ],
+                "description": "编辑某轮问题并从该轮对话处分叉出新分支",
                 "consumes": [
                     "application/json"
                 ],
@@ -2255,17 +2376,17 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "guest"
+                    "agent"
                 ],
-                "summary": "用户邮箱双因子登录",
+                "summary": "智能体编辑问题并重新回答",
                 "parameters": [
                     {
-                        "description": "用户名+密码",
+                        "description": "智能体编辑问题参数",
                         "name": "data",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/request.Login"
+                            "$ref": "#/definitions/request.ConversationEditRequest"
                         }
                     }
                 ],
@@ -2273,26 +2394,20 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "allOf": [
-                                {
-                                    "$ref": "#/definitions/response.Response"
-                                },
-                                {
-                                    "type": "object",
-                                    "properties": {
-                                        "data": {
-                                            "$ref": "#/definitions/response.LoginByEmail"
-                                        }
-                                    }
-                                }
-                            ]
+                            "$ref": "#/definitions/response.Response"
                         }
                     }
                 }
             }
         },
-        "/base/password/email": {
+        "/assistant/stream/regenerate": {
             "post": {
+                "security": [
+                    {
+                        "JWT": []
+                    }
+                ],
+                "description": "使用原问题重新生成某轮回答，新回答作为该轮对话的新版本并切换为当前分支",
                 "consumes": [
                     "application/json"
                 ],
@@ -2300,17 +2415,17 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "guest"
+                    "agent"
                 ],
-                "summary": "邮箱重置密码",
+                "summary": "智能体重新生成回答",
                 "parameters": [
                     {
-                        "description": "重置密码信息",
+                        "description": "智能体重新生成回答参数",
                         "name": "data",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/request.ResetPasswordByEmail"
+                            "$ref": "#/definitions/request.ConversationRegenerateRequest"
                         }
                     }
                 ],
@@ -2324,8 +2439,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/base/password/email/code": {
-            "post": {
+        "/assistant/template": {
+            "get": {
+                "security": [
+                    {
+                        "JWT": []
+                    }
+                ],
+                "description": "获取智能体模板",
                 "consumes": [
                     "application/json"
                 ],
@@ -2333,32 +2454,46 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "guest"
+                    "agent"
                 ],
-                "summary": "重置密码邮箱验证码发送",
+                "summary": "获取智能体模板",
                 "parameters": [
                     {
-                        "description": "邮箱地址",
-                        "name": "data",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/request.ResetPasswordSendEmailCode"
-                        }
+                        "type": "string",
+                        "description": "智能体模板ID",
+                        "name": "assistantTemplateId",
+                        "in": "query",
+                        "required": true
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/response.Response"
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/response.AssistantTemplateInfo"
+                                        }
+                                    }
+                                }
+                            ]
                         }
                     }
                 }
-            }
-        },
-        "/base/register/email": {
+            },
             "post": {
+                "security": [
+                    {
+                        "JWT": []
+                    }
+                ],
+                "description": "通过复制创建智能体",
                 "consumes": [
                     "application/json"
                 ],
@@ -2366,17 +2501,17 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "guest"
+                    "agent"
                 ],
-                "summary": "用户邮箱注册",
+                "summary": "复制创建智能体",
                 "parameters": [
                     {
-                        "description": "邮箱注册信息",
+                        "description": "复制创建智能体所需信息",
                         "name": "data",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/request.RegisterByEmail"
+                            "$ref": "#/definitions/request.AssistantTemplateRequest"
                         }
                     }
                 ],
@@ -2384,14 +2519,32 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/response.Response"
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/response.AssistantCreateResp"
+                                        }
+                                    }
+                                }
+                            ]
                         }
                     }
                 }
             }
         },
-        "/base/register/email/code": {
-            "post": {
+        "/assistant/template/list": {
+            "get": {
+                "security": [
+                    {
+                        "JWT": []
+                    }
+                ],
+                "description": "智能体模板列表",
                 "consumes": [
                     "application/json"
                 ],
@@ -2399,38 +2552,74 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "guest"
+                    "agent"
                 ],
-                "summary": "邮箱注册验证码发送",
+                "summary": "智能体模板列表",
                 "parameters": [
                     {
-                        "description": "邮箱地址",
-                        "name": "data",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/request.RegisterSendEmailCode"
-                        }
+                        "enum": [
+                            "gov",
+                            "industry",
+                            "edu",
+                            "medical"
+                        ],
+                        "type": "string",
+                        "description": "智能体类型",
+                        "name": "category",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "智能体名称",
+                        "name": "name",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/response.Response"
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "allOf": [
+                                                {
+                                                    "$ref": "#/definitions/response.ListResult"
+                                                },
+                                                {
+                                                    "type": "object",
+                                                    "properties": {
+                                                        "list": {
+                                                            "type": "array",
+                                                            "items": {
+                                                                "$ref": "#/definitions/response.AssistantTemplateInfo"
+                                                            }
+                                                        }
+                                                    }
+                                                }
+                                            ]
+                                        }
+                                    }
+                                }
+                            ]
                         }
                     }
                 }
             }
         },
-        "/custom/home": {
+        "/assistant/tool/custom": {
             "post": {
                 "security": [
                     {
                         "JWT": []
                     }
                 ],
-                "description": "配置平台名称、平台图标、平台背景色",
+                "description": "为智能体绑定自定义工具",
                 "consumes": [
                     "application/json"
                 ],
@@ -2438,17 +2627,17 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "setting"
+                    "agent"
                 ],
-                "summary": "平台自定义配置",
+                "summary": "添加自定义工具",
                 "parameters": [
                     {
-                        "description": "平台配置请求参数",
+                        "description": "自定义工具新增参数",
                         "name": "data",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/request.CustomHomeConfig"
+                            "$ref": "#/definitions/request.AssistantCustomToolAddRequest"
                         }
                     }
                 ],
@@ -2460,16 +2649,14 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        },
-        "/custom/login": {
-            "post": {
+            },
+            "delete": {
                 "security": [
                     {
                         "JWT": []
                     }
                 ],
-                "description": "上传登录页背景图、登录页欢迎语、登录按钮颜色",
+                "description": "为智能体解绑自定义工具",
                 "consumes": [
                     "application/json"
                 ],
@@ -2477,17 +2664,17 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "setting"
+                    "agent"
                 ],
-                "summary": "登录页自定义配置",
+                "summary": "删除自定义工具",
                 "parameters": [
                     {
-                        "description": "登录页配置请求参数",
+                        "description": "智能体id与自定义工具id",
                         "name": "data",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/request.CustomLoginConfig"
+                            "$ref": "#/definitions/request.AssistantCustomToolDelRequest"
                         }
                     }
                 ],
@@ -2501,14 +2688,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/custom/tab": {
-            "post": {
+        "/assistant/tool/custom/switch": {
+            "put": {
                 "security": [
                     {
                         "JWT": []
                     }
                 ],
-                "description": "上传标签页图标、标签页标题",
+                "description": "修改智能体绑定的自定义工具的启用状态",
                 "consumes": [
                     "application/json"
                 ],
@@ -2516,17 +2703,17 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "setting"
+                    "agent"
                 ],
-                "summary": "标签页自定义配置",
+                "summary": "启用/停用自定义工具",
                 "parameters": [
                     {
-                        "description": "标签页配置请求参数",
+                        "description": "智能体id与自定义工具id",
                         "name": "data",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/request.CustomTabConfig"
+                            "$ref": "#/definitions/request.AssistantCustomToolEnableRequest"
                         }
                     }
                 ],
@@ -2540,13 +2727,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/doc_center/markdown": {
-            "get": {
+        "/assistant/tool/mcp": {
+            "post": {
                 "security": [
                     {
                         "JWT": []
                     }
                 ],
+                "description": "为智能体绑定已发布的mcp工具",
                 "consumes": [
                     "application/json"
                 ],
@@ -2554,47 +2742,36 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "common"
+                    "agent"
                 ],
-                "summary": "获取文档中心Markdown文件内容",
+                "summary": "添加mcp工具",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "目录path",
-                        "name": "path",
-                        "in": "query",
-                        "required": true
+                        "description": "mcp新增参数",
+                        "name": "data",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/request.AssistantMCPToolAddRequest"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "allOf": [
-                                {
-                                    "$ref": "#/definitions/response.Response"
-                                },
-                                {
-                                    "type": "object",
-                                    "properties": {
-                                        "data": {
-                                            "type": "string"
-                                        }
-                                    }
-                                }
-                            ]
+                            "$ref": "#/definitions/response.Response"
                         }
                     }
                 }
-            }
-        },
-        "/doc_center/menu": {
-            "get": {
+            },
+            "delete": {
                 "security": [
                     {
                         "JWT": []
                     }
                 ],
+                "description": "为智能体解绑mcp",
                 "consumes": [
                     "application/json"
                 ],
@@ -2602,41 +2779,38 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "common"
+                    "agent"
+                ],
+                "summary": "删除mcp",
+                "parameters": [
+                    {
+                        "description": "mcp工具id，智能体id",
+                        "name": "data",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/request.AssistantMCPToolDelRequest"
+                        }
+                    }
                 ],
-                "summary": "获取文档中心目录",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "allOf": [
-                                {
-                                    "$ref": "#/definitions/response.Response"
-                                },
-                                {
-                                    "type": "object",
-                                    "properties": {
-                                        "data": {
-                                            "type": "array",
-                                            "items": {
-                                                "$ref": "#/definitions/response.DocMenu"
-                                            }
-                                        }
-                                    }
-                                }
-                            ]
+                            "$ref": "#/definitions/response.Response"
                         }
                     }
                 }
             }
         },
-        "/doc_center/search": {
-            "get": {
+        "/assistant/tool/mcp/switch": {
+            "put": {
                 "security": [
                     {
                         "JWT": []
                     }
                 ],
+                "description": "修改智能体绑定的MCP的启用状态",
                 "consumes": [
                     "application/json"
                 ],
@@ -2644,46 +2818,38 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "common"
+                    "agent"
                 ],
-                "summary": "查找文档中心内容",
+                "summary": "启用/停用 MCP",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "搜索关键字",
-                        "name": "content",
-                        "in": "query",
-                        "required": true
+                        "description": "mcp工具id、智能体id、enable",
+                        "name": "data",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/request.AssistantMCPToolEnableRequest"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "allOf": [
-                                {
-                                    "$ref": "#/definitions/response.Response"
-                                },
-                                {
-                                    "type": "object",
-                                    "properties": {
-                                        "data": {
-                                            "type": "array",
-                                            "items": {
-                                                "$ref": "#/definitions/response.DocSearchResp"
-                                            }
-                                        }
-                                    }
-                                }
-                            ]
+                            "$ref": "#/definitions/response.Response"
                         }
                     }
                 }
             }
         },
-        "/exploration/app/favorite": {
+        "/assistant/tool/workflow": {
             "post": {
-                "description": "更改App收藏状态",
+                "security": [
+                    {
+                        "JWT": []
+                    }
+                ],
+                "description": "为智能体绑定已发布的工作流",
                 "consumes": [
                     "application/json"
                 ],
@@ -2691,17 +2857,17 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "exploration"
+                    "agent"
                 ],
-                "summary": "更改App收藏状态",
+                "summary": "添加工作流",
                 "parameters": [
                     {
-                        "description": "更改App收藏状态参数",
+                        "description": "工作流新增参数",
                         "name": "data",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/request.ChangeExplorationAppFavoriteRequest"
+                            "$ref": "#/definitions/request.AssistantWorkFlowAddRequest"
                         }
                     }
                 ],
@@ -2713,11 +2879,14 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        },
-        "/exploration/app/list": {
-            "get": {
-                "description": "获取应用广场应用",
+            },
+            "delete": {
+                "security": [
+                    {
+                        "JWT": []
+                    }
+                ],
+                "description": "为智能体解绑工作流",
                 "consumes": [
                     "application/json"
                 ],
@@ -2725,74 +2894,38 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "exploration"
+                    "agent"
                 ],
-                "summary": "获取应用广场应用",
+                "summary": "删除工作流",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "应用类型",
-                        "name": "appType",
-                        "in": "query"
-                    },
-                    {
-                        "type": "string",
-                        "description": "应用名称",
-                        "name": "name",
-                        "in": "query"
-                    },
-                    {
-                        "type": "string",
-                        "description": "搜索类型(all(全部),favorite(显示收藏的),private(显示私密发布的),history(历史应用))",
-                        "name": "searchType",
-                        "in": "query"
+                        "description": "工作流id,智能体id",
+                        "name": "data",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/request.AssistantWorkFlowDelRequest"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "allOf": [
-                                {
-                                    "$ref": "#/definitions/response.Response"
-                                },
-                                {
-                                    "type": "object",
-                                    "properties": {
-                                        "data": {
-                                            "allOf": [
-                                                {
-                                                    "$ref": "#/definitions/response.ListResult"
-                                                },
-                                                {
-                                                    "type": "object",
-                                                    "properties": {
-                                                        "list": {
-                                                            "type": "array",
-                                                            "items": {
-                                                                "$ref": "#/definitions/response.ExplorationAppInfo"
-                                                            }
-                                                        }
-                                                    }
-                                                }
-                                            ]
-                                        }
-                                    }
-                                }
-                            ]
+                            "$ref": "#/definitions/response.Response"
                         }
                     }
                 }
             }
         },
-        "/file/check": {
-            "get": {
+        "/assistant/tool/workflow/switch": {
+            "put": {
                 "security": [
                     {
                         "JWT": []
                     }
                 ],
-                "description": "校验分片文件",
+                "description": "修改智能体绑定的工作流的启用状态",
                 "consumes": [
                     "application/json"
                 ],
@@ -2800,17 +2933,17 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "common"
+                    "agent"
                 ],
-                "summary": "文件校验",
+                "summary": "启用/停用工作流",
                 "parameters": [
                     {
-                        "description": "文件校验参数",
+                        "description": "工作流id,智能体id,开关",
                         "name": "data",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/request.CheckFileReq"
+                            "$ref": "#/definitions/request.AssistantWorkFlowToolEnableRequest"
                         }
                     }
                 ],
@@ -2818,83 +2951,93 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "allOf": [
-                                {
-                                    "$ref": "#/definitions/response.Response"
-                                },
-                                {
-                                    "type": "object",
-                                    "properties": {
-                                        "data": {
-                                            "$ref": "#/definitions/response.CheckFileResp"
-                                        }
-                                    }
-                                }
-                            ]
+                            "$ref": "#/definitions/response.Response"
                         }
                     }
                 }
             }
         },
-        "/file/check/list": {
+        "/audit/log/export": {
             "get": {
                 "security": [
                     {
                         "JWT": []
                     }
                 ],
-                "description": "校验分片文件列表",
+                "description": "按查询条件导出审计日志为csv文件（仅组织管理员），单次最多导出10000条，超过时返回错误，需缩小时间范围",
                 "consumes": [
                     "application/json"
                 ],
                 "produces": [
-                    "application/json"
+                    "application/octet-stream"
                 ],
                 "tags": [
-                    "common"
+                    "permission"
                 ],
-                "summary": "文件列表校验",
+                "summary": "导出审计日志",
                 "parameters": [
                     {
-                        "description": "文件列表校验参数",
-                        "name": "data",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/request.CheckFileListReq"
-                        }
+                        "type": "string",
+                        "description": "路由权限标识，前缀匹配，如 permission、model",
+                        "name": "action",
+                        "in": "query"
+                    },
+                    {
+                        "minimum": 0,
+                        "type": "integer",
+                        "description": "结束时间（毫秒时间戳）",
+                        "name": "endTime",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            0,
+                            1,
+                            2
+                        ],
+                        "type": "integer",
+                        "description": "0.全部 1.成功 2.失败",
+                        "name": "result",
+                        "in": "query"
+                    },
+                    {
+                        "minimum": 0,
+                        "type": "integer",
+                        "description": "开始时间（毫秒时间戳）",
+                        "name": "startTime",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "操作对象ID",
+                        "name": "targetId",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "操作人ID",
+                        "name": "userId",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "allOf": [
-                                {
-                                    "$ref": "#/definitions/response.Response"
-                                },
-                                {
-                                    "type": "object",
-                                    "properties": {
-                                        "data": {
-                                            "$ref": "#/definitions/response.CheckFileListResp"
-                                        }
-                                    }
-                                }
-                            ]
+                            "$ref": "#/definitions/response.Response"
                         }
                     }
                 }
             }
         },
-        "/file/clean": {
-            "post": {
+        "/audit/log/list": {
+            "get": {
                 "security": [
                     {
                         "JWT": []
                     }
                 ],
-                "description": "清除已上传的分片文件",
+                "description": "获取X-Org-Id组织的审计日志列表（仅组织管理员）；在系统视角下获取全部组织的审计日志",
                 "consumes": [
                     "application/json"
                 ],
@@ -2902,18 +3045,63 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "common"
+                    "permission"
                 ],
-                "summary": "文件清除",
+                "summary": "获取审计日志列表",
                 "parameters": [
                     {
-                        "description": "文件清除参数",
-                        "name": "data",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/request.CleanFileReq"
-                        }
+                        "type": "string",
+                        "description": "路由权限标识，前缀匹配，如 permission、model",
+                        "name": "action",
+                        "in": "query"
+                    },
+                    {
+                        "minimum": 0,
+                        "type": "integer",
+                        "description": "结束时间（毫秒时间戳）",
+                        "name": "endTime",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "name": "pageNo",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "name": "pageSize",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "enum": [
+                            0,
+                            1,
+                            2
+                        ],
+                        "type": "integer",
+                        "description": "0.全部 1.成功 2.失败",
+                        "name": "result",
+                        "in": "query"
+                    },
+                    {
+                        "minimum": 0,
+                        "type": "integer",
+                        "description": "开始时间（毫秒时间戳）",
+                        "name": "startTime",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "操作对象ID",
+                        "name": "targetId",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "操作人ID",
+                        "name": "userId",
+                        "in": "query"
                     }
                 ],
                 "responses": {
@@ -2928,7 +3116,22 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "$ref": "#/definitions/response.CleanFileResp"
+                                            "allOf": [
+                                                {
+                                                    "$ref": "#/definitions/response.PageResult"
+                                                },
+                                                {
+                                                    "type": "object",
+                                                    "properties": {
+                                                        "list": {
+                                                            "type": "array",
+                                                            "items": {
+                                                                "$ref": "#/definitions/response.AuditLogInfo"
+                                                            }
+                                                        }
+                                                    }
+                                                }
+                                            ]
                                         }
                                     }
                                 }
@@ -2938,16 +3141,15 @@ const docTemplate = `{
                 }
             }
         },
-        "/file/delete": {
-            "delete": {
+        "/avatar": {
+            "post": {
                 "security": [
                     {
                         "JWT": []
                     }
                 ],
-                "description": "删除已上传的文件",
                 "consumes": [
-                    "application/json"
+                    "multipart/form-data"
                 ],
                 "produces": [
                     "application/json"
@@ -2955,16 +3157,14 @@ const docTemplate = `{
                 "tags": [
                     "common"
                 ],
-                "summary": "文件删除",
+                "summary": "上传自定义图标",
                 "parameters": [
                     {
-                        "description": "文件删除请求参数",
-                        "name": "data",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/request.DeleteFileReq"
-                        }
+                        "type": "file",
+                        "description": "自定义图标（JPG/JPEG/PNG）",
+                        "name": "avatar",
+                        "in": "formData",
+                        "required": true
                     }
                 ],
                 "responses": {
@@ -2979,7 +3179,7 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "$ref": "#/definitions/response.DeleteFileResp"
+                                            "$ref": "#/definitions/request.Avatar"
                                         }
                                     }
                                 }
@@ -2989,14 +3189,8 @@ const docTemplate = `{
                 }
             }
         },
-        "/file/merge": {
-            "post": {
-                "security": [
-                    {
-                        "JWT": []
-                    }
-                ],
-                "description": "合并分片文件，并上传minio",
+        "/base/captcha": {
+            "get": {
                 "consumes": [
                     "application/json"
                 ],
@@ -3004,18 +3198,15 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "common"
+                    "guest"
                 ],
-                "summary": "文件合并",
+                "summary": "获取验证码",
                 "parameters": [
                     {
-                        "description": "文件合并参数",
-                        "name": "data",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/request.MergeFileReq"
-                        }
+                        "type": "string",
+                        "description": "语言",
+                        "name": "X-Language",
+                        "in": "header"
                     }
                 ],
                 "responses": {
@@ -3030,7 +3221,7 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "$ref": "#/definitions/response.MergeFileResp"
+                                            "$ref": "#/definitions/response.Captcha"
                                         }
                                     }
                                 }
@@ -3040,52 +3231,21 @@ const docTemplate = `{
                 }
             }
         },
-        "/file/upload": {
-            "post": {
-                "security": [
-                    {
-                        "JWT": []
-                    }
-                ],
-                "description": "分片文件上传",
-                "consumes": [
-                    "multipart/form-data"
-                ],
+        "/base/custom": {
+            "get": {
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "common"
+                    "guest"
                 ],
-                "summary": "文件上传",
+                "summary": "自定义logo和title",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "原始文件名",
-                        "name": "fileName",
-                        "in": "formData",
-                        "required": true
-                    },
-                    {
-                        "type": "integer",
-                        "description": "分片文件序号",
-                        "name": "sequence",
-                        "in": "formData",
-                        "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "上传批次标识",
-                        "name": "chunkName",
-                        "in": "formData",
-                        "required": true
-                    },
-                    {
-                        "type": "file",
-                        "description": "文件",
-                        "name": "files",
-                        "in": "formData",
-                        "required": true
+                        "description": "语言",
+                        "name": "X-Language",
+                        "in": "header"
                     }
                 ],
                 "responses": {
@@ -3100,7 +3260,7 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "$ref": "#/definitions/response.UploadFileResp"
+                                            "$ref": "#/definitions/response.LogoCustomInfo"
                                         }
                                     }
                                 }
@@ -3110,14 +3270,8 @@ const docTemplate = `{
                 }
             }
         },
-        "/knowledge": {
-            "put": {
-                "security": [
-                    {
-                        "JWT": []
-                    }
-                ],
-                "description": "修改知识库（文档分类）",
+        "/base/language/select": {
+            "get": {
                 "consumes": [
                     "application/json"
                 ],
@@ -3125,36 +3279,33 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "knowledge"
-                ],
-                "summary": "修改知识库（文档分类）",
-                "parameters": [
-                    {
-                        "description": "修改知识库请求参数",
-                        "name": "data",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/request.UpdateKnowledgeReq"
-                        }
-                    }
+                    "guest"
                 ],
+                "summary": "获取语言列表（用于下拉选择）",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/response.Response"
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/response.LanguageSelect"
+                                        }
+                                    }
+                                }
+                            ]
                         }
                     }
                 }
-            },
+            }
+        },
+        "/base/login": {
             "post": {
-                "security": [
-                    {
-                        "JWT": []
-                    }
-                ],
-                "description": "创建知识库",
                 "consumes": [
                     "application/json"
                 ],
@@ -3162,17 +3313,23 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "knowledge"
+                    "guest"
                 ],
-                "summary": "创建知识库",
+                "summary": "用户登录",
                 "parameters": [
                     {
-                        "description": "创建知识库请求参数",
+                        "type": "string",
+                        "description": "语言",
+                        "name": "X-Language",
+                        "in": "header"
+                    },
+                    {
+                        "description": "用户名+密码",
                         "name": "data",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/request.CreateKnowledgeReq"
+                            "$ref": "#/definitions/request.Login"
                         }
                     }
                 ],
@@ -3188,10 +3345,7 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "type": "array",
-                                            "items": {
-                                                "$ref": "#/definitions/response.CreateKnowledgeResp"
-                                            }
+                                            "$ref": "#/definitions/response.Login"
                                         }
                                     }
                                 }
@@ -3199,14 +3353,10 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "delete": {
-                "security": [
-                    {
-                        "JWT": []
-                    }
-                ],
-                "description": "删除知识库",
+            }
+        },
+        "/base/login/email": {
+            "post": {
                 "consumes": [
                     "application/json"
                 ],
@@ -3214,17 +3364,17 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "knowledge"
+                    "guest"
                 ],
-                "summary": "删除知识库",
+                "summary": "用户邮箱双因子登录",
                 "parameters": [
                     {
-                        "description": "删除知识库请求参数",
+                        "description": "用户名+密码",
                         "name": "data",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/request.DeleteKnowledge"
+                            "$ref": "#/definitions/request.Login"
                         }
                     }
                 ],
@@ -3232,20 +3382,26 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/response.Response"
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/response.LoginByEmail"
+                                        }
+                                    }
+                                }
+                            ]
                         }
                     }
                 }
             }
         },
-        "/knowledge/doc": {
-            "delete": {
-                "security": [
-                    {
-                        "JWT": []
-                    }
-                ],
-                "description": "删除文档",
+        "/base/password/email": {
+            "post": {
                 "consumes": [
                     "application/json"
                 ],
@@ -3253,17 +3409,17 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "knowledge"
+                    "guest"
                 ],
-                "summary": "删除文档",
+                "summary": "邮箱重置密码",
                 "parameters": [
                     {
-                        "description": "删除文档请求参数",
+                        "description": "重置密码信息",
                         "name": "data",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/request.DeleteDocReq"
+                            "$ref": "#/definitions/request.ResetPasswordByEmail"
                         }
                     }
                 ],
@@ -3277,14 +3433,8 @@ const docTemplate = `{
                 }
             }
         },
-        "/knowledge/doc/import": {
+        "/base/password/email/code": {
             "post": {
-                "security": [
-                    {
-                        "JWT": []
-                    }
-                ],
-                "description": "上传文档",
                 "consumes": [
                     "application/json"
                 ],
@@ -3292,17 +3442,17 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "knowledge"
+                    "guest"
                 ],
-                "summary": "上传文档",
+                "summary": "重置密码邮箱验证码发送",
                 "parameters": [
                     {
-                        "description": "文档上传请求参数",
+                        "description": "邮箱地址",
                         "name": "data",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/request.DocImportReq"
+                            "$ref": "#/definitions/request.ResetPasswordSendEmailCode"
                         }
                     }
                 ],
@@ -3316,14 +3466,8 @@ const docTemplate = `{
                 }
             }
         },
-        "/knowledge/doc/import/tip": {
-            "get": {
-                "security": [
-                    {
-                        "JWT": []
-                    }
-                ],
-                "description": "获取知识库异步上传任务提示：有正在执行的异步上传任务/最近一次上传任务的失败信息",
+        "/base/register/email": {
+            "post": {
                 "consumes": [
                     "application/json"
                 ],
@@ -3331,17 +3475,17 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "knowledge"
+                    "guest"
                 ],
-                "summary": "获取知识库异步上传任务提示",
+                "summary": "用户邮箱注册",
                 "parameters": [
                     {
-                        "description": "获取知识库异步上传任务提示请求参数",
+                        "description": "邮箱注册信息",
                         "name": "data",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/request.QueryKnowledgeReq"
+                            "$ref": "#/definitions/request.RegisterByEmail"
                         }
                     }
                 ],
@@ -3355,14 +3499,8 @@ const docTemplate = `{
                 }
             }
         },
-        "/knowledge/doc/list": {
-            "get": {
-                "security": [
-                    {
-                        "JWT": []
-                    }
-                ],
-                "description": "获取知识库文档列表，不展示状态为无效（-1）的文档数据",
+        "/base/register/email/code": {
+            "post": {
                 "consumes": [
                     "application/json"
                 ],
@@ -3370,17 +3508,17 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "knowledge"
+                    "guest"
                 ],
-                "summary": "获取文档列表",
+                "summary": "邮箱注册验证码发送",
                 "parameters": [
                     {
-                        "description": "文档列表查询请求参数",
+                        "description": "邮箱地址",
                         "name": "data",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/request.DocListReq"
+                            "$ref": "#/definitions/request.RegisterSendEmailCode"
                         }
                     }
                 ],
@@ -3388,35 +3526,20 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "allOf": [
-                                {
-                                    "$ref": "#/definitions/response.PageResult"
-                                },
-                                {
-                                    "type": "object",
-                                    "properties": {
-                                        "list": {
-                                            "type": "array",
-                                            "items": {
-                                                "$ref": "#/definitions/response.ListDocResp"
-                                            }
-                                        }
-                                    }
-                                }
-                            ]
+                            "$ref": "#/definitions/response.Response"
                         }
                     }
                 }
             }
         },
-        "/knowledge/doc/meta": {
+        "/custom/home": {
             "post": {
                 "security": [
                     {
                         "JWT": []
                     }
                 ],
-                "description": "更新文档元数据",
+                "description": "配置平台名称、平台图标、平台背景色",
                 "consumes": [
                     "application/json"
                 ],
@@ -3424,17 +3547,17 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "knowledge"
+                    "setting"
                 ],
-                "summary": "更新文档元数据",
+                "summary": "平台自定义配置",
                 "parameters": [
                     {
-                        "description": "文档更新元数据请求参数",
+                        "description": "平台配置请求参数",
                         "name": "data",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/request.DocMetaDataReq"
+                            "$ref": "#/definitions/request.CustomHomeConfig"
                         }
                     }
                 ],
@@ -3448,14 +3571,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/knowledge/doc/meta/batch": {
+        "/custom/login": {
             "post": {
                 "security": [
                     {
                         "JWT": []
                     }
                 ],
-                "description": "批量更新文档元数据",
+                "description": "上传登录页背景图、登录页欢迎语、登录按钮颜色",
                 "consumes": [
                     "application/json"
                 ],
@@ -3463,17 +3586,17 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "knowledge"
+                    "setting"
                 ],
-                "summary": "批量更新文档元数据",
+                "summary": "登录页自定义配置",
                 "parameters": [
                     {
-                        "description": "批量文档更新元数据请求参数",
+                        "description": "登录页配置请求参数",
                         "name": "data",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/request.BatchDocMetaDataReq"
+                            "$ref": "#/definitions/request.CustomLoginConfig"
                         }
                     }
                 ],
@@ -3487,14 +3610,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/knowledge/doc/segment/batch/create": {
+        "/custom/tab": {
             "post": {
                 "security": [
                     {
                         "JWT": []
                     }
                 ],
-                "description": "批量新增文档切片",
+                "description": "上传标签页图标、标签页标题",
                 "consumes": [
                     "application/json"
                 ],
@@ -3502,17 +3625,17 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "knowledge"
+                    "setting"
                 ],
-                "summary": "批量新增文档切片",
+                "summary": "标签页自定义配置",
                 "parameters": [
                     {
-                        "description": "批量新增文档切片请求参数",
+                        "description": "标签页配置请求参数",
                         "name": "data",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/request.BatchCreateDocSegmentReq"
+                            "$ref": "#/definitions/request.CustomTabConfig"
                         }
                     }
                 ],
@@ -3526,14 +3649,13 @@ const docTemplate = `{
                 }
             }
         },
-        "/knowledge/doc/segment/child/create": {
-            "post": {
+        "/doc_center/markdown": {
+            "get": {
                 "security": [
                     {
                         "JWT": []
                     }
                 ],
-                "description": "新增文档子分片",
                 "consumes": [
                     "application/json"
                 ],
@@ -3541,38 +3663,47 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "knowledge"
+                    "common"
                 ],
-                "summary": "新增文档子分片",
+                "summary": "获取文档中心Markdown文件内容",
                 "parameters": [
                     {
-                        "description": "新增文档子切片请求参数",
-                        "name": "data",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/request.CreateDocChildSegmentReq"
-                        }
+                        "type": "string",
+                        "description": "目录path",
+                        "name": "path",
+                        "in": "query",
+                        "required": true
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/response.Response"
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "string"
+                                        }
+                                    }
+                                }
+                            ]
                         }
                     }
                 }
             }
         },
-        "/knowledge/doc/segment/child/delete": {
-            "delete": {
+        "/doc_center/menu": {
+            "get": {
                 "security": [
                     {
                         "JWT": []
                     }
                 ],
-                "description": "删除文档子切片",
                 "consumes": [
                     "application/json"
                 ],
@@ -3580,38 +3711,41 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "knowledge"
-                ],
-                "summary": "删除文档子切片",
-                "parameters": [
-                    {
-                        "description": "删除文档切片请求参数",
-                        "name": "data",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/request.DeleteDocChildSegmentReq"
-                        }
-                    }
+                    "common"
                 ],
+                "summary": "获取文档中心目录",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/response.Response"
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/response.DocMenu"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
                         }
                     }
                 }
             }
         },
-        "/knowledge/doc/segment/child/list": {
+        "/doc_center/search": {
             "get": {
                 "security": [
                     {
                         "JWT": []
                     }
                 ],
-                "description": "获取子分段列表",
                 "consumes": [
                     "application/json"
                 ],
@@ -3619,18 +3753,16 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "knowledge"
+                    "common"
                 ],
-                "summary": "获取子分段列表",
+                "summary": "查找文档中心内容",
                 "parameters": [
                     {
-                        "description": "获取子分段列表查询请求参数",
-                        "name": "data",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/request.DocChildListReq"
-                        }
+                        "type": "string",
+                        "description": "搜索关键字",
+                        "name": "content",
+                        "in": "query",
+                        "required": true
                     }
                 ],
                 "responses": {
@@ -3645,7 +3777,10 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "$ref": "#/definitions/response.DocChildSegmentResp"
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/response.DocSearchResp"
+                                            }
                                         }
                                     }
                                 }
@@ -3655,14 +3790,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/knowledge/doc/segment/child/update": {
+        "/exploration/app/favorite": {
             "post": {
-                "security": [
-                    {
-                        "JWT": []
-                    }
-                ],
-                "description": "更新文档子切片",
+                "description": "更改App收藏状态",
                 "consumes": [
                     "application/json"
                 ],
@@ -3670,17 +3800,17 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "knowledge"
+                    "exploration"
                 ],
-                "summary": "更新文档子切片",
+                "summary": "更改App收藏状态",
                 "parameters": [
                     {
-                        "description": "更新文档子切片请求参数",
+                        "description": "更改App收藏状态参数",
                         "name": "data",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/request.UpdateDocChildSegmentReq"
+                            "$ref": "#/definitions/request.ChangeExplorationAppFavoriteRequest"
                         }
                     }
                 ],
@@ -3694,14 +3824,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/knowledge/doc/segment/create": {
-            "post": {
-                "security": [
-                    {
-                        "JWT": []
-                    }
-                ],
-                "description": "新增文档切片",
+        "/exploration/app/list": {
+            "get": {
+                "description": "获取应用广场应用",
                 "consumes": [
                     "application/json"
                 ],
@@ -3709,38 +3834,74 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "knowledge"
+                    "exploration"
                 ],
-                "summary": "新增文档切片",
+                "summary": "获取应用广场应用",
                 "parameters": [
                     {
-                        "description": "新增文档切片请求参数",
-                        "name": "data",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/request.CreateDocSegmentReq"
-                        }
+                        "type": "string",
+                        "description": "应用类型",
+                        "name": "appType",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "应用名称",
+                        "name": "name",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "搜索类型(all(全部),favorite(显示收藏的),private(显示私密发布的),history(历史应用))",
+                        "name": "searchType",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/response.Response"
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "allOf": [
+                                                {
+                                                    "$ref": "#/definitions/response.ListResult"
+                                                },
+                                                {
+                                                    "type": "object",
+                                                    "properties": {
+                                                        "list": {
+                                                            "type": "array",
+                                                            "items": {
+                                                                "$ref": "#/definitions/response.ExplorationAppInfo"
+                                                            }
+                                                        }
+                                                    }
+                                                }
+                                            ]
+                                        }
+                                    }
+                                }
+                            ]
                         }
                     }
                 }
             }
         },
-        "/knowledge/doc/segment/delete": {
-            "delete": {
+        "/file/check": {
+            "get": {
                 "security": [
                     {
                         "JWT": []
                     }
                 ],
-                "description": "删除文档切片",
+                "description": "校验分片文件",
                 "consumes": [
                     "application/json"
                 ],
@@ -3748,17 +3909,17 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "knowledge"
+                    "common"
                 ],
-                "summary": "删除文档切片",
+                "summary": "文件校验",
                 "parameters": [
                     {
-                        "description": "删除文档切片请求参数",
+                        "description": "文件校验参数",
                         "name": "data",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/request.DeleteDocSegmentReq"
+                            "$ref": "#/definitions/request.CheckFileReq"
                         }
                     }
                 ],
@@ -3766,20 +3927,32 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/response.Response"
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/response.CheckFileResp"
+                                        }
+                                    }
+                                }
+                            ]
                         }
                     }
                 }
             }
         },
-        "/knowledge/doc/segment/labels": {
-            "post": {
+        "/file/check/list": {
+            "get": {
                 "security": [
                     {
                         "JWT": []
                     }
                 ],
-                "description": "更新文档切片标签",
+                "description": "校验分片文件列表",
                 "consumes": [
                     "application/json"
                 ],
@@ -3787,17 +3960,17 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "knowledge"
+                    "common"
                 ],
-                "summary": "更新文档切片标签",
+                "summary": "文件列表校验",
                 "parameters": [
                     {
-                        "description": "更新文档切片标签请求参数",
+                        "description": "文件列表校验参数",
                         "name": "data",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/request.DocSegmentLabelsReq"
+                            "$ref": "#/definitions/request.CheckFileListReq"
                         }
                     }
                 ],
@@ -3805,20 +3978,32 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/response.Response"
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/response.CheckFileListResp"
+                                        }
+                                    }
+                                }
+                            ]
                         }
                     }
                 }
             }
         },
-        "/knowledge/doc/segment/list": {
-            "get": {
-                "security": [
+        "/file/clean": {
+            "post": {
+                "security": [
                     {
                         "JWT": []
                     }
                 ],
-                "description": "获取文档切分结果",
+                "description": "清除已上传的分片文件",
                 "consumes": [
                     "application/json"
                 ],
@@ -3826,17 +4011,17 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "knowledge"
+                    "common"
                 ],
-                "summary": "获取文档切分结果",
+                "summary": "文件清除",
                 "parameters": [
                     {
-                        "description": "获取文档切分结果请求参数",
+                        "description": "文件清除参数",
                         "name": "data",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/request.DocSegmentListReq"
+                            "$ref": "#/definitions/request.CleanFileReq"
                         }
                     }
                 ],
@@ -3852,7 +4037,7 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "$ref": "#/definitions/response.DocSegmentResp"
+                                            "$ref": "#/definitions/response.CleanFileResp"
                                         }
                                     }
                                 }
@@ -3862,14 +4047,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/knowledge/doc/segment/status/update": {
-            "post": {
+        "/file/delete": {
+            "delete": {
                 "security": [
                     {
                         "JWT": []
                     }
                 ],
-                "description": "更新文档切片启用状态",
+                "description": "删除已上传的文件",
                 "consumes": [
                     "application/json"
                 ],
@@ -3877,17 +4062,17 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "knowledge"
+                    "common"
                 ],
-                "summary": "更新文档切片启用状态",
+                "summary": "文件删除",
                 "parameters": [
                     {
-                        "description": "更新文档切片启用状态请求参数",
+                        "description": "文件删除请求参数",
                         "name": "data",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/request.UpdateDocSegmentStatusReq"
+                            "$ref": "#/definitions/request.DeleteFileReq"
                         }
                     }
                 ],
@@ -3895,20 +4080,32 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/response.Response"
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/response.DeleteFileResp"
+                                        }
+                                    }
+                                }
+                            ]
                         }
                     }
                 }
             }
         },
-        "/knowledge/doc/segment/update": {
+        "/file/merge": {
             "post": {
                 "security": [
                     {
                         "JWT": []
                     }
                 ],
-                "description": "更新文档切片",
+                "description": "合并分片文件，并上传minio",
                 "consumes": [
                     "application/json"
                 ],
@@ -3916,17 +4113,17 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "knowledge"
+                    "common"
                 ],
-                "summary": "更新文档切片",
+                "summary": "文件合并",
                 "parameters": [
                     {
-                        "description": "更新文档切片请求参数",
+                        "description": "文件合并参数",
                         "name": "data",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/request.UpdateDocSegmentReq"
+                            "$ref": "#/definitions/request.MergeFileReq"
                         }
                     }
                 ],
@@ -3934,39 +4131,76 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/response.Response"
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/response.MergeFileResp"
+                                        }
+                                    }
+                                }
+                            ]
                         }
                     }
                 }
             }
         },
-        "/knowledge/doc/url/analysis": {
+        "/file/upload": {
             "post": {
                 "security": [
                     {
                         "JWT": []
                     }
                 ],
-                "description": "解析url",
+                "description": "分片文件上传",
                 "consumes": [
-                    "application/json"
+                    "multipart/form-data"
                 ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "knowledge"
+                    "common"
                 ],
-                "summary": "解析url",
+                "summary": "文件上传",
                 "parameters": [
                     {
-                        "description": "解析url请求参数",
-                        "name": "data",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/request.AnalysisUrlDocReq"
-                        }
+                        "type": "string",
+                        "description": "原始文件名",
+                        "name": "fileName",
+                        "in": "formData",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "分片文件序号",
+                        "name": "sequence",
+                        "in": "formData",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "上传批次标识",
+                        "name": "chunkName",
+                        "in": "formData",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "分片总数，除最后一片外每片不小于5MB",
+                        "name": "chunkTotal",
+                        "in": "formData"
+                    },
+                    {
+                        "type": "file",
+                        "description": "文件",
+                        "name": "files",
+                        "in": "formData",
+                        "required": true
                     }
                 ],
                 "responses": {
@@ -3981,7 +4215,7 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "$ref": "#/definitions/response.AnalysisDocUrlResp"
+                                            "$ref": "#/definitions/response.UploadFileResp"
                                         }
                                     }
                                 }
@@ -3991,14 +4225,51 @@ const docTemplate = `{
                 }
             }
         },
-        "/knowledge/hit": {
+        "/knowledge": {
+            "put": {
+                "security": [
+                    {
+                        "JWT": []
+                    }
+                ],
+                "description": "修改知识库（文档分类）",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "knowledge"
+                ],
+                "summary": "修改知识库（文档分类）",
+                "parameters": [
+                    {
+                        "description": "修改知识库请求参数",
+                        "name": "data",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/request.UpdateKnowledgeReq"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    }
+                }
+            },
             "post": {
                 "security": [
                     {
                         "JWT": []
                     }
                 ],
-                "description": "知识库命中测试",
+                "description": "创建知识库",
                 "consumes": [
                     "application/json"
                 ],
@@ -4008,15 +4279,15 @@ const docTemplate = `{
                 "tags": [
                     "knowledge"
                 ],
-                "summary": "知识库命中测试",
+                "summary": "创建知识库",
                 "parameters": [
                     {
-                        "description": "知识库命中测试请求参数",
+                        "description": "创建知识库请求参数",
                         "name": "data",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/request.KnowledgeHitReq"
+                            "$ref": "#/definitions/request.CreateKnowledgeReq"
                         }
                     }
                 ],
@@ -4032,7 +4303,10 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "$ref": "#/definitions/response.KnowledgeHitResp"
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/response.CreateKnowledgeResp"
+                                            }
                                         }
                                     }
                                 }
@@ -4040,16 +4314,14 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        },
-        "/knowledge/keywords": {
-            "get": {
+            },
+            "delete": {
                 "security": [
                     {
                         "JWT": []
                     }
                 ],
-                "description": "查询知识库关键词列表",
+                "description": "删除知识库",
                 "consumes": [
                     "application/json"
                 ],
@@ -4057,17 +4329,17 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "knowledge.keywords"
+                    "knowledge"
                 ],
-                "summary": "查询知识库关键词列表",
+                "summary": "删除知识库",
                 "parameters": [
                     {
-                        "description": "关键词列表查询请求参数",
+                        "description": "删除知识库请求参数",
                         "name": "data",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/request.ListKeywordsReq"
+                            "$ref": "#/definitions/request.DeleteKnowledge"
                         }
                     }
                 ],
@@ -4075,18 +4347,20 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/response.GetKnowledgeKeywordListResp"
+                            "$ref": "#/definitions/response.Response"
                         }
                     }
                 }
-            },
-            "put": {
+            }
+        },
+        "/knowledge/bundle/task": {
+            "get": {
                 "security": [
                     {
                         "JWT": []
                     }
                 ],
-                "description": "编辑知识库关键词",
+                "description": "查询知识库导入导出任务的状态和进度",
                 "consumes": [
                     "application/json"
                 ],
@@ -4094,36 +4368,48 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "knowledge.keywords"
+                    "knowledge"
                 ],
-                "summary": "编辑知识库关键词",
+                "summary": "查询知识库导入导出任务",
                 "parameters": [
                     {
-                        "description": "修改关键词请求参数",
-                        "name": "data",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/request.UpdateKeywordsReq"
-                        }
+                        "type": "string",
+                        "description": "任务id",
+                        "name": "taskId",
+                        "in": "query",
+                        "required": true
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/response.Response"
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/response.KnowledgeBundleTaskInfo"
+                                        }
+                                    }
+                                }
+                            ]
                         }
                     }
                 }
-            },
+            }
+        },
+        "/knowledge/connector": {
             "post": {
                 "security": [
                     {
                         "JWT": []
                     }
                 ],
-                "description": "新增知识库关键词",
+                "description": "创建或更新知识库连接器，支持S3兼容对象存储、git仓库、WebDAV与服务端挂载目录；凭证加密存储",
                 "consumes": [
                     "application/json"
                 ],
@@ -4131,17 +4417,17 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "knowledge.keywords"
+                    "knowledge"
                 ],
-                "summary": "新增知识库关键词",
+                "summary": "保存知识库连接器",
                 "parameters": [
                     {
-                        "description": "创建关键词请求参数",
+                        "description": "保存知识库连接器请求参数",
                         "name": "data",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/request.CreateKeywordsReq"
+                            "$ref": "#/definitions/request.ConnectorSaveReq"
                         }
                     }
                 ],
@@ -4149,7 +4435,19 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/response.Response"
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/response.ConnectorSaveResp"
+                                        }
+                                    }
+                                }
+                            ]
                         }
                     }
                 }
@@ -4160,7 +4458,7 @@ const docTemplate = `{
                         "JWT": []
                     }
                 ],
-                "description": "删除知识库关键词",
+                "description": "删除知识库连接器，已导入的文档保留",
                 "consumes": [
                     "application/json"
                 ],
@@ -4168,17 +4466,17 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "knowledge.keywords"
+                    "knowledge"
                 ],
-                "summary": "删除知识库关键词",
+                "summary": "删除知识库连接器",
                 "parameters": [
                     {
-                        "description": "删除知识库关键词请求参数",
+                        "description": "删除知识库连接器请求参数",
                         "name": "data",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/request.DeleteKeywordsReq"
+                            "$ref": "#/definitions/request.ConnectorIdReq"
                         }
                     }
                 ],
@@ -4192,14 +4490,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/knowledge/keywords/detail": {
+        "/knowledge/connector/list": {
             "get": {
                 "security": [
                     {
                         "JWT": []
                     }
                 ],
-                "description": "查询知识库关键词详情",
+                "description": "查询知识库连接器列表，凭证不返回",
                 "consumes": [
                     "application/json"
                 ],
@@ -4207,38 +4505,48 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "knowledge.keywords"
+                    "knowledge"
                 ],
-                "summary": "查询知识库关键词详情",
+                "summary": "查询知识库连接器列表",
                 "parameters": [
                     {
-                        "description": "关键词列表查询请求参数",
-                        "name": "data",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/request.GetKeywordsDetailReq"
-                        }
+                        "type": "string",
+                        "description": "知识库id",
+                        "name": "knowledgeId",
+                        "in": "query",
+                        "required": true
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/response.KeywordsInfo"
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/response.ConnectorListResp"
+                                        }
+                                    }
+                                }
+                            ]
                         }
                     }
                 }
             }
         },
-        "/knowledge/meta/select": {
-            "get": {
+        "/knowledge/connector/run": {
+            "post": {
                 "security": [
                     {
                         "JWT": []
                     }
                 ],
-                "description": "获取知识库元数据",
+                "description": "按增量游标导入上次执行后新增或修改的文件，创建文档导入任务",
                 "consumes": [
                     "application/json"
                 ],
@@ -4248,15 +4556,15 @@ const docTemplate = `{
                 "tags": [
                     "knowledge"
                 ],
-                "summary": "获取知识库元数据",
+                "summary": "执行知识库连接器",
                 "parameters": [
                     {
-                        "description": "获取知识库元数据请求参数",
+                        "description": "执行知识库连接器请求参数",
                         "name": "data",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/request.GetKnowledgeMetaSelectReq"
+                            "$ref": "#/definitions/request.ConnectorIdReq"
                         }
                     }
                 ],
@@ -4264,32 +4572,20 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "allOf": [
-                                {
-                                    "$ref": "#/definitions/response.Response"
-                                },
-                                {
-                                    "type": "object",
-                                    "properties": {
-                                        "data": {
-                                            "$ref": "#/definitions/response.GetKnowledgeMetaSelectResp"
-                                        }
-                                    }
-                                }
-                            ]
+                            "$ref": "#/definitions/response.Response"
                         }
                     }
                 }
             }
         },
-        "/knowledge/meta/value": {
-            "get": {
+        "/knowledge/doc": {
+            "delete": {
                 "security": [
                     {
                         "JWT": []
                     }
                 ],
-                "description": "获取文档元数据列表",
+                "description": "删除文档",
                 "consumes": [
                     "application/json"
                 ],
@@ -4299,15 +4595,15 @@ const docTemplate = `{
                 "tags": [
                     "knowledge"
                 ],
-                "summary": "获取文档元数据列表",
+                "summary": "删除文档",
                 "parameters": [
                     {
-                        "description": "文档元数据列表请求参数",
+                        "description": "删除文档请求参数",
                         "name": "data",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/request.KnowledgeMetaValueListReq"
+                            "$ref": "#/definitions/request.DeleteDocReq"
                         }
                     }
                 ],
@@ -4315,30 +4611,20 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "allOf": [
-                                {
-                                    "$ref": "#/definitions/response.Response"
-                                },
-                                {
-                                    "type": "object",
-                                    "properties": {
-                                        "data": {
-                                            "$ref": "#/definitions/response.KnowledgeMetaValueListResp"
-                                        }
-                                    }
-                                }
-                            ]
+                            "$ref": "#/definitions/response.Response"
                         }
                     }
                 }
-            },
+            }
+        },
+        "/knowledge/doc/import": {
             "post": {
                 "security": [
                     {
                         "JWT": []
                     }
                 ],
-                "description": "更新知识库元数据值",
+                "description": "上传文档",
                 "consumes": [
                     "application/json"
                 ],
@@ -4348,15 +4634,15 @@ const docTemplate = `{
                 "tags": [
                     "knowledge"
                 ],
-                "summary": "更新知识库元数据值",
+                "summary": "上传文档",
                 "parameters": [
                     {
-                        "description": "更新知识库元数据值请求参数",
+                        "description": "文档上传请求参数",
                         "name": "data",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/request.UpdateMetaValueReq"
+                            "$ref": "#/definitions/request.DocImportReq"
                         }
                     }
                 ],
@@ -4370,14 +4656,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/knowledge/select": {
-            "post": {
+        "/knowledge/doc/import/tip": {
+            "get": {
                 "security": [
                     {
                         "JWT": []
                     }
                 ],
-                "description": "查询知识库列表",
+                "description": "获取知识库异步上传任务提示：有正在执行的异步上传任务/最近一次上传任务的失败信息",
                 "consumes": [
                     "application/json"
                 ],
@@ -4387,15 +4673,15 @@ const docTemplate = `{
                 "tags": [
                     "knowledge"
                 ],
-                "summary": "查询知识库列表",
+                "summary": "获取知识库异步上传任务提示",
                 "parameters": [
                     {
-                        "description": "查询知识库列表",
+                        "description": "获取知识库异步上传任务提示请求参数",
                         "name": "data",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/request.KnowledgeSelectReq"
+                            "$ref": "#/definitions/request.QueryKnowledgeReq"
                         }
                     }
                 ],
@@ -4403,32 +4689,20 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "allOf": [
-                                {
-                                    "$ref": "#/definitions/response.Response"
-                                },
-                                {
-                                    "type": "object",
-                                    "properties": {
-                                        "data": {
-                                            "$ref": "#/definitions/response.KnowledgeListResp"
-                                        }
-                                    }
-                                }
-                            ]
+                            "$ref": "#/definitions/response.Response"
                         }
                     }
                 }
             }
         },
-        "/knowledge/splitter": {
+        "/knowledge/doc/list": {
             "get": {
                 "security": [
                     {
                         "JWT": []
                     }
                 ],
-                "description": "查询知识库分隔符列表",
+                "description": "获取知识库文档列表，不展示状态为无效（-1）的文档数据",
                 "consumes": [
                     "application/json"
                 ],
@@ -4436,16 +4710,17 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "knowledge.splitter"
+                    "knowledge"
                 ],
-                "summary": "查询知识库分隔符列表",
+                "summary": "获取文档列表",
                 "parameters": [
                     {
-                        "description": "查询知识库分隔符列表参数",
+                        "description": "文档列表查询请求参数",
                         "name": "data",
                         "in": "body",
+                        "required": true,
                         "schema": {
-                            "$ref": "#/definitions/request.GetKnowledgeSplitterReq"
+                            "$ref": "#/definitions/request.DocListReq"
                         }
                     }
                 ],
@@ -4455,13 +4730,16 @@ const docTemplate = `{
                         "schema": {
                             "allOf": [
                                 {
-                                    "$ref": "#/definitions/response.Response"
+                                    "$ref": "#/definitions/response.PageResult"
                                 },
                                 {
                                     "type": "object",
                                     "properties": {
-                                        "data": {
-                                            "$ref": "#/definitions/response.KnowledgeSplitterListResp"
+                                        "list": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/response.ListDocResp"
+                                            }
                                         }
                                     }
                                 }
@@ -4469,14 +4747,16 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "put": {
+            }
+        },
+        "/knowledge/doc/meta": {
+            "post": {
                 "security": [
                     {
                         "JWT": []
                     }
                 ],
-                "description": "修改知识库分隔符",
+                "description": "更新文档元数据",
                 "consumes": [
                     "application/json"
                 ],
@@ -4484,17 +4764,17 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "knowledge.splitter"
+                    "knowledge"
                 ],
-                "summary": "修改知识库分隔符",
+                "summary": "更新文档元数据",
                 "parameters": [
                     {
-                        "description": "修改知识库分隔符请求参数",
+                        "description": "文档更新元数据请求参数",
                         "name": "data",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/request.UpdateKnowledgeSplitterReq"
+                            "$ref": "#/definitions/request.DocMetaDataReq"
                         }
                     }
                 ],
@@ -4506,14 +4786,16 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
+            }
+        },
+        "/knowledge/doc/meta/batch": {
             "post": {
                 "security": [
                     {
                         "JWT": []
                     }
                 ],
-                "description": "创建知识库分隔符",
+                "description": "批量更新文档元数据",
                 "consumes": [
                     "application/json"
                 ],
@@ -4521,17 +4803,17 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "knowledge.splitter"
+                    "knowledge"
                 ],
-                "summary": "创建知识库分隔符",
+                "summary": "批量更新文档元数据",
                 "parameters": [
                     {
-                        "description": "创建知识库分隔符请求参数",
+                        "description": "批量文档更新元数据请求参数",
                         "name": "data",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/request.CreateKnowledgeSplitterReq"
+                            "$ref": "#/definitions/request.BatchDocMetaDataReq"
                         }
                     }
                 ],
@@ -4543,14 +4825,16 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "delete": {
+            }
+        },
+        "/knowledge/doc/segment/batch/create": {
+            "post": {
                 "security": [
                     {
                         "JWT": []
                     }
                 ],
-                "description": "删除知识库分隔符",
+                "description": "通过csv、jsonl或xlsx文件批量新增文档切片，upsert模式下按分段id更新已有切片",
                 "consumes": [
                     "application/json"
                 ],
@@ -4558,17 +4842,17 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "knowledge.splitter"
+                    "knowledge"
                 ],
-                "summary": "删除知识库分隔符",
+                "summary": "批量新增文档切片",
                 "parameters": [
                     {
-                        "description": "删除知识库分隔符请求参数",
+                        "description": "批量新增文档切片请求参数",
                         "name": "data",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/request.DeleteKnowledgeSplitterReq"
+                            "$ref": "#/definitions/request.BatchCreateDocSegmentReq"
                         }
                     }
                 ],
@@ -4582,14 +4866,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/knowledge/tag": {
-            "get": {
+        "/knowledge/doc/segment/child/create": {
+            "post": {
                 "security": [
                     {
                         "JWT": []
                     }
                 ],
-                "description": "查询知识库标签列表",
+                "description": "新增文档子分片",
                 "consumes": [
                     "application/json"
                 ],
@@ -4597,17 +4881,17 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "knowledge.tag"
+                    "knowledge"
                 ],
-                "summary": "查询知识库标签列表",
+                "summary": "新增文档子分片",
                 "parameters": [
                     {
-                        "description": "查询知识库请求参数",
+                        "description": "新增文档子切片请求参数",
                         "name": "data",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/request.KnowledgeTagSelectReq"
+                            "$ref": "#/definitions/request.CreateDocChildSegmentReq"
                         }
                     }
                 ],
@@ -4615,30 +4899,20 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "allOf": [
-                                {
-                                    "$ref": "#/definitions/response.Response"
-                                },
-                                {
-                                    "type": "object",
-                                    "properties": {
-                                        "data": {
-                                            "$ref": "#/definitions/response.KnowledgeTagListResp"
-                                        }
-                                    }
-                                }
-                            ]
+                            "$ref": "#/definitions/response.Response"
                         }
                     }
                 }
-            },
-            "put": {
+            }
+        },
+        "/knowledge/doc/segment/child/delete": {
+            "delete": {
                 "security": [
                     {
                         "JWT": []
                     }
                 ],
-                "description": "修改知识库标签",
+                "description": "删除文档子切片",
                 "consumes": [
                     "application/json"
                 ],
@@ -4646,17 +4920,17 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "knowledge.tag"
+                    "knowledge"
                 ],
-                "summary": "修改知识库标签",
+                "summary": "删除文档子切片",
                 "parameters": [
                     {
-                        "description": "修改知识库标签请求参数",
+                        "description": "删除文档切片请求参数",
                         "name": "data",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/request.UpdateKnowledgeTagReq"
+                            "$ref": "#/definitions/request.DeleteDocChildSegmentReq"
                         }
                     }
                 ],
@@ -4668,14 +4942,16 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "post": {
+            }
+        },
+        "/knowledge/doc/segment/child/list": {
+            "get": {
                 "security": [
                     {
                         "JWT": []
                     }
                 ],
-                "description": "创建知识库标签",
+                "description": "获取子分段列表",
                 "consumes": [
                     "application/json"
                 ],
@@ -4683,17 +4959,17 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "knowledge.tag"
+                    "knowledge"
                 ],
-                "summary": "创建知识库标签",
+                "summary": "获取子分段列表",
                 "parameters": [
                     {
-                        "description": "创建知识库标签请求参数",
+                        "description": "获取子分段列表查询请求参数",
                         "name": "data",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/request.CreateKnowledgeTagReq"
+                            "$ref": "#/definitions/request.DocChildListReq"
                         }
                     }
                 ],
@@ -4709,7 +4985,7 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "$ref": "#/definitions/response.CreateKnowledgeTagResp"
+                                            "$ref": "#/definitions/response.DocChildSegmentResp"
                                         }
                                     }
                                 }
@@ -4717,14 +4993,16 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "delete": {
+            }
+        },
+        "/knowledge/doc/segment/child/update": {
+            "post": {
                 "security": [
                     {
                         "JWT": []
                     }
                 ],
-                "description": "删除知识库标签",
+                "description": "更新文档子切片",
                 "consumes": [
                     "application/json"
                 ],
@@ -4732,17 +5010,17 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "knowledge.tag"
+                    "knowledge"
                 ],
-                "summary": "删除知识库标签",
+                "summary": "更新文档子切片",
                 "parameters": [
                     {
-                        "description": "删除知识库标签请求参数",
+                        "description": "更新文档子切片请求参数",
                         "name": "data",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/request.DeleteKnowledgeTagReq"
+                            "$ref": "#/definitions/request.UpdateDocChildSegmentReq"
                         }
                     }
                 ],
@@ -4756,14 +5034,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/knowledge/tag/bind": {
+        "/knowledge/doc/segment/create": {
             "post": {
                 "security": [
                     {
                         "JWT": []
                     }
                 ],
-                "description": "绑定知识库标签",
+                "description": "新增文档切片",
                 "consumes": [
                     "application/json"
                 ],
@@ -4771,17 +5049,17 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "knowledge.tag"
+                    "knowledge"
                 ],
-                "summary": "绑定知识库标签",
+                "summary": "新增文档切片",
                 "parameters": [
                     {
-                        "description": "绑定知识库标签请求参数",
+                        "description": "新增文档切片请求参数",
                         "name": "data",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/request.BindKnowledgeTagReq"
+                            "$ref": "#/definitions/request.CreateDocSegmentReq"
                         }
                     }
                 ],
@@ -4795,14 +5073,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/knowledge/tag/bind/count": {
-            "get": {
+        "/knowledge/doc/segment/delete": {
+            "delete": {
                 "security": [
                     {
                         "JWT": []
                     }
                 ],
-                "description": "查询标签绑定知识库数量",
+                "description": "删除文档切片",
                 "consumes": [
                     "application/json"
                 ],
@@ -4810,19 +5088,109 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "knowledge.tag"
+                    "knowledge"
                 ],
-                "summary": "查询标签绑定知识库数量",
+                "summary": "删除文档切片",
                 "parameters": [
                     {
-                        "description": "查询tag绑定数量参数请求参数",
+                        "description": "删除文档切片请求参数",
                         "name": "data",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/request.TagBindCountReq"
+                            "$ref": "#/definitions/request.DeleteDocSegmentReq"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/knowledge/doc/segment/export": {
+            "get": {
+                "security": [
+                    {
+                        "JWT": []
+                    }
+                ],
+                "description": "导出单个文档或知识库下全部处理完成文档的切片，包含内容、关键词、启用状态和子分段",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/octet-stream"
+                ],
+                "tags": [
+                    "knowledge"
+                ],
+                "summary": "导出文档切片",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "知识库id，与docId二选一",
+                        "name": "knowledgeId",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "文档id",
+                        "name": "docId",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "csv",
+                            "jsonl",
+                            "xlsx"
+                        ],
+                        "type": "string",
+                        "description": "导出格式",
+                        "name": "format",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
                         }
                     }
+                }
+            }
+        },
+        "/knowledge/doc/segment/import/report": {
+            "get": {
+                "security": [
+                    {
+                        "JWT": []
+                    }
+                ],
+                "description": "查询文档最近一次批量导入切片的结果及失败行",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "knowledge"
+                ],
+                "summary": "查询文档切片导入结果",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "文档id",
+                        "name": "docId",
+                        "in": "query",
+                        "required": true
+                    }
                 ],
                 "responses": {
                     "200": {
@@ -4836,7 +5204,7 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "$ref": "#/definitions/response.KnowledgeTagListResp"
+                                            "$ref": "#/definitions/response.DocSegmentImportReport"
                                         }
                                     }
                                 }
@@ -4846,9 +5214,53 @@ const docTemplate = `{
                 }
             }
         },
-        "/mcp": {
+        "/knowledge/doc/segment/labels": {
+            "post": {
+                "security": [
+                    {
+                        "JWT": []
+                    }
+                ],
+                "description": "更新文档切片标签",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "knowledge"
+                ],
+                "summary": "更新文档切片标签",
+                "parameters": [
+                    {
+                        "description": "更新文档切片标签请求参数",
+                        "name": "data",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/request.DocSegmentLabelsReq"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/knowledge/doc/segment/list": {
             "get": {
-                "description": "获取自定义MCP详情",
+                "security": [
+                    {
+                        "JWT": []
+                    }
+                ],
+                "description": "获取文档切分结果",
                 "consumes": [
                     "application/json"
                 ],
@@ -4856,16 +5268,18 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "tool"
+                    "knowledge"
                 ],
-                "summary": "获取自定义MCP详情",
+                "summary": "获取文档切分结果",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "mcpId",
-                        "name": "mcpId",
-                        "in": "query",
-                        "required": true
+                        "description": "获取文档切分结果请求参数",
+                        "name": "data",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/request.DocSegmentListReq"
+                        }
                     }
                 ],
                 "responses": {
@@ -4880,7 +5294,7 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "$ref": "#/definitions/response.MCPDetail"
+                                            "$ref": "#/definitions/response.DocSegmentResp"
                                         }
                                     }
                                 }
@@ -4888,9 +5302,16 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
+            }
+        },
+        "/knowledge/doc/segment/status/update": {
             "post": {
-                "description": "创建自定义MCP",
+                "security": [
+                    {
+                        "JWT": []
+                    }
+                ],
+                "description": "更新文档切片启用状态",
                 "consumes": [
                     "application/json"
                 ],
@@ -4898,17 +5319,17 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "tool"
+                    "knowledge"
                 ],
-                "summary": "创建自定义MCP",
+                "summary": "更新文档切片启用状态",
                 "parameters": [
                     {
-                        "description": "自定义MCP信息",
+                        "description": "更新文档切片启用状态请求参数",
                         "name": "data",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/request.MCPCreate"
+                            "$ref": "#/definitions/request.UpdateDocSegmentStatusReq"
                         }
                     }
                 ],
@@ -4920,9 +5341,16 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "delete": {
-                "description": "删除自定义MCP",
+            }
+        },
+        "/knowledge/doc/segment/update": {
+            "post": {
+                "security": [
+                    {
+                        "JWT": []
+                    }
+                ],
+                "description": "更新文档切片",
                 "consumes": [
                     "application/json"
                 ],
@@ -4930,17 +5358,17 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "tool"
+                    "knowledge"
                 ],
-                "summary": "删除自定义MCP",
+                "summary": "更新文档切片",
                 "parameters": [
                     {
-                        "description": "mcpId",
+                        "description": "更新文档切片请求参数",
                         "name": "data",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/request.MCPIDReq"
+                            "$ref": "#/definitions/request.UpdateDocSegmentReq"
                         }
                     }
                 ],
@@ -4954,9 +5382,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/mcp/list": {
-            "get": {
-                "description": "获取自定义MCP列表",
+        "/knowledge/doc/sync": {
+            "post": {
+                "security": [
+                    {
+                        "JWT": []
+                    }
+                ],
+                "description": "按url文档或url导入任务设置定时同步，cron表达式与同步间隔二选一，周期不小于10分钟；同步时内容变化的文档重新解析导入",
                 "consumes": [
                     "application/json"
                 ],
@@ -4964,15 +5397,18 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "tool"
+                    "knowledge"
                 ],
-                "summary": "获取自定义MCP列表",
+                "summary": "设置url文档定时同步",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "mcp名称",
-                        "name": "name",
-                        "in": "query"
+                        "description": "设置url文档定时同步请求参数",
+                        "name": "data",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/request.DocSyncSaveReq"
+                        }
                     }
                 ],
                 "responses": {
@@ -4987,22 +5423,7 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "allOf": [
-                                                {
-                                                    "$ref": "#/definitions/response.ListResult"
-                                                },
-                                                {
-                                                    "type": "object",
-                                                    "properties": {
-                                                        "list": {
-                                                            "type": "array",
-                                                            "items": {
-                                                                "$ref": "#/definitions/response.MCPInfo"
-                                                            }
-                                                        }
-                                                    }
-                                                }
-                                            ]
+                                            "$ref": "#/definitions/response.DocSyncSaveResp"
                                         }
                                     }
                                 }
@@ -5010,11 +5431,14 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        },
-        "/mcp/select": {
-            "get": {
-                "description": "获取自定义MCP列表（用于下拉选择）",
+            },
+            "delete": {
+                "security": [
+                    {
+                        "JWT": []
+                    }
+                ],
+                "description": "删除url文档定时同步及同步记录",
                 "consumes": [
                     "application/json"
                 ],
@@ -5022,101 +5446,38 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "mcp"
+                    "knowledge"
                 ],
-                "summary": "获取自定义MCP列表（用于下拉选择）",
+                "summary": "删除url文档定时同步",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "mcp名称",
-                        "name": "name",
-                        "in": "query"
-                    }
-                ],
-                "responses": {
-                    "200": {
-                        "description": "OK",
+                        "description": "删除url文档定时同步请求参数",
+                        "name": "data",
+                        "in": "body",
+                        "required": true,
                         "schema": {
-                            "allOf": [
-                                {
-                                    "$ref": "#/definitions/response.Response"
-                                },
-                                {
-                                    "type": "object",
-                                    "properties": {
-                                        "data": {
-                                            "allOf": [
-                                                {
-                                                    "$ref": "#/definitions/response.ListResult"
-                                                },
-                                                {
-                                                    "type": "object",
-                                                    "properties": {
-                                                        "list": {
-                                                            "type": "array",
-                                                            "items": {
-                                                                "$ref": "#/definitions/response.MCPSelect"
-                                                            }
-                                                        }
-                                                    }
-                                                }
-                                            ]
-                                        }
-                                    }
-                                }
-                            ]
+                            "$ref": "#/definitions/request.DocSyncIdReq"
                         }
                     }
-                }
-            }
-        },
-        "/mcp/square": {
-            "get": {
-                "description": "获取广场MCP详情",
-                "consumes": [
-                    "application/json"
-                ],
-                "produces": [
-                    "application/json"
-                ],
-                "tags": [
-                    "mcp.square"
-                ],
-                "summary": "获取广场MCP详情",
-                "parameters": [
-                    {
-                        "type": "string",
-                        "description": "mcpSquareId",
-                        "name": "mcpSquareId",
-                        "in": "query",
-                        "required": true
-                    }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "allOf": [
-                                {
-                                    "$ref": "#/definitions/response.Response"
-                                },
-                                {
-                                    "type": "object",
-                                    "properties": {
-                                        "data": {
-                                            "$ref": "#/definitions/response.MCPSquareDetail"
-                                        }
-                                    }
-                                }
-                            ]
+                            "$ref": "#/definitions/response.Response"
                         }
                     }
                 }
             }
         },
-        "/mcp/square/list": {
+        "/knowledge/doc/sync/history": {
             "get": {
-                "description": "获取广场MCP列表",
+                "security": [
+                    {
+                        "JWT": []
+                    }
+                ],
+                "description": "分页查询url文档同步记录，按同步开始时间倒序",
                 "consumes": [
                     "application/json"
                 ],
@@ -5124,27 +5485,36 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "mcp.square"
+                    "knowledge"
                 ],
-                "summary": "获取广场MCP列表",
+                "summary": "查询url文档同步记录",
                 "parameters": [
                     {
-                        "enum": [
-                            "all",
-                            "data",
-                            "create",
-                            "search"
-                        ],
                         "type": "string",
-                        "description": "mcp类型",
-                        "name": "category",
-                        "in": "query"
+                        "description": "同步配置id",
+                        "name": "syncId",
+                        "in": "query",
+                        "required": true
                     },
                     {
                         "type": "string",
-                        "description": "mcp名称",
-                        "name": "name",
+                        "description": "文档id",
+                        "name": "docId",
                         "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "页码",
+                        "name": "pageNo",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "每页数量",
+                        "name": "pageSize",
+                        "in": "query",
+                        "required": true
                     }
                 ],
                 "responses": {
@@ -5161,7 +5531,7 @@ const docTemplate = `{
                                         "data": {
                                             "allOf": [
                                                 {
-                                                    "$ref": "#/definitions/response.ListResult"
+                                                    "$ref": "#/definitions/response.PageResult"
                                                 },
                                                 {
                                                     "type": "object",
@@ -5169,7 +5539,7 @@ const docTemplate = `{
                                                         "list": {
                                                             "type": "array",
                                                             "items": {
-                                                                "$ref": "#/definitions/response.MCPSquareInfo"
+                                                                "$ref": "#/definitions/response.DocSyncHistoryInfo"
                                                             }
                                                         }
                                                     }
@@ -5184,9 +5554,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/mcp/square/recommend": {
+        "/knowledge/doc/sync/list": {
             "get": {
-                "description": "获取广场MCP推荐列表",
+                "security": [
+                    {
+                        "JWT": []
+                    }
+                ],
+                "description": "查询知识库的url文档定时同步列表",
                 "consumes": [
                     "application/json"
                 ],
@@ -5194,21 +5569,16 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "mcp.square"
+                    "knowledge"
                 ],
-                "summary": "获取广场MCP推荐列表",
+                "summary": "查询url文档同步列表",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "mcpId",
-                        "name": "mcpId",
-                        "in": "query"
-                    },
-                    {
-                        "type": "string",
-                        "description": "mcpSquareId",
-                        "name": "mcpSquareId",
-                        "in": "query"
+                        "description": "知识库id",
+                        "name": "knowledgeId",
+                        "in": "query",
+                        "required": true
                     }
                 ],
                 "responses": {
@@ -5223,22 +5593,7 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "allOf": [
-                                                {
-                                                    "$ref": "#/definitions/response.ListResult"
-                                                },
-                                                {
-                                                    "type": "object",
-                                                    "properties": {
-                                                        "list": {
-                                                            "type": "array",
-                                                            "items": {
-                                                                "$ref": "#/definitions/response.MCPSquareInfo"
-                                                            }
-                                                        }
-                                                    }
-                                                }
-                                            ]
+                                            "$ref": "#/definitions/response.DocSyncListResp"
                                         }
                                     }
                                 }
@@ -5248,9 +5603,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/mcp/tool/list": {
-            "get": {
-                "description": "获取MCP Tool列表",
+        "/knowledge/doc/sync/run": {
+            "post": {
+                "security": [
+                    {
+                        "JWT": []
+                    }
+                ],
+                "description": "立即执行一次url文档同步，不影响下次定时同步时间",
                 "consumes": [
                     "application/json"
                 ],
@@ -5258,52 +5618,38 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "mcp"
+                    "knowledge"
                 ],
-                "summary": "获取MCP Tool列表",
+                "summary": "立即执行url文档同步",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "mcpId(和serverUrl传一个)",
-                        "name": "mcpId",
-                        "in": "query"
-                    },
-                    {
-                        "type": "string",
-                        "description": "serverUrl,就是sseUrl(和mcpId传一个)",
-                        "name": "serverUrl",
-                        "in": "query"
+                        "description": "立即执行url文档同步请求参数",
+                        "name": "data",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/request.DocSyncIdReq"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "allOf": [
-                                {
-                                    "$ref": "#/definitions/response.Response"
-                                },
-                                {
-                                    "type": "object",
-                                    "properties": {
-                                        "data": {
-                                            "$ref": "#/definitions/response.MCPToolList"
-                                        }
-                                    }
-                                }
-                            ]
+                            "$ref": "#/definitions/response.Response"
                         }
                     }
                 }
             }
         },
-        "/model": {
-            "get": {
+        "/knowledge/doc/url/analysis": {
+            "post": {
                 "security": [
                     {
                         "JWT": []
                     }
                 ],
+                "description": "解析url",
                 "consumes": [
                     "application/json"
                 ],
@@ -5311,17 +5657,17 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "model"
+                    "knowledge"
                 ],
-                "summary": "‌查询单个模型",
+                "summary": "解析url",
                 "parameters": [
                     {
-                        "description": "模型ID",
+                        "description": "解析url请求参数",
                         "name": "data",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/request.GetModelRequest"
+                            "$ref": "#/definitions/request.AnalysisUrlDocReq"
                         }
                     }
                 ],
@@ -5337,7 +5683,7 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "$ref": "#/definitions/response.ModelInfo"
+                                            "$ref": "#/definitions/response.AnalysisDocUrlResp"
                                         }
                                     }
                                 }
@@ -5345,13 +5691,16 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "put": {
+            }
+        },
+        "/knowledge/doc/version": {
+            "post": {
                 "security": [
                     {
                         "JWT": []
                     }
                 ],
+                "description": "归档当前版本的文件和切片后，以新文件沿用原文档id重新解析导入，检索只命中生效版本；url文档不支持",
                 "consumes": [
                     "application/json"
                 ],
@@ -5359,17 +5708,17 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "model"
+                    "knowledge"
                 ],
-                "summary": "导入模型更新",
+                "summary": "上传文档新版本",
                 "parameters": [
                     {
-                        "description": "模型变更信息",
+                        "description": "上传文档新版本请求参数",
                         "name": "data",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/request.ImportOrUpdateModelRequest"
+                            "$ref": "#/definitions/request.DocVersionUploadReq"
                         }
                     }
                 ],
@@ -5381,14 +5730,16 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "post": {
+            }
+        },
+        "/knowledge/doc/version/diff": {
+            "get": {
                 "security": [
                     {
                         "JWT": []
                     }
                 ],
-                "description": "第三方模型的导入",
+                "description": "按切片序号对比两个版本的切片数量和内容，版本id为空表示当前生效版本",
                 "consumes": [
                     "application/json"
                 ],
@@ -5396,35 +5747,60 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "model"
+                    "knowledge"
                 ],
-                "summary": "模型导入",
+                "summary": "对比文档版本",
                 "parameters": [
                     {
-                        "description": "导入模型信息",
-                        "name": "data",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/request.ImportOrUpdateModelRequest"
-                        }
+                        "type": "string",
+                        "description": "文档id",
+                        "name": "docId",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "对比基准版本id",
+                        "name": "baseVersionId",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "对比目标版本id",
+                        "name": "targetVersionId",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/response.Response"
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/response.DocVersionDiffResp"
+                                        }
+                                    }
+                                }
+                            ]
                         }
                     }
                 }
-            },
-            "delete": {
-                "security": [
+            }
+        },
+        "/knowledge/doc/version/list": {
+            "get": {
+                "security": [
                     {
                         "JWT": []
                     }
                 ],
+                "description": "查询文档的当前生效版本和历史版本，上传新版本、编辑切片、回滚都会产生历史版本",
                 "consumes": [
                     "application/json"
                 ],
@@ -5432,17 +5808,66 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "model"
+                    "knowledge"
                 ],
-                "summary": "导入模型删除",
+                "summary": "查询文档版本列表",
                 "parameters": [
                     {
-                        "description": "模型删除key",
+                        "type": "string",
+                        "description": "文档id",
+                        "name": "docId",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/response.DocVersionListResp"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    }
+                }
+            }
+        },
+        "/knowledge/doc/version/rollback": {
+            "post": {
+                "security": [
+                    {
+                        "JWT": []
+                    }
+                ],
+                "description": "归档当前版本后重新解析历史版本文件，解析完成后恢复该版本的切片内容、启用状态和标签",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "knowledge"
+                ],
+                "summary": "回滚文档版本",
+                "parameters": [
+                    {
+                        "description": "回滚文档版本请求参数",
                         "name": "data",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/request.DeleteModelRequest"
+                            "$ref": "#/definitions/request.DocVersionRollbackReq"
                         }
                     }
                 ],
@@ -5456,13 +5881,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/model/list": {
+        "/knowledge/eval/dataset": {
             "get": {
                 "security": [
                     {
                         "JWT": []
                     }
                 ],
+                "description": "查询检索评测数据集详情，包括全部问题",
                 "consumes": [
                     "application/json"
                 ],
@@ -5470,38 +5896,16 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "model"
+                    "knowledge"
                 ],
-                "summary": "导入模型列表",
+                "summary": "查询检索评测数据集详情",
                 "parameters": [
-                    {
-                        "enum": [
-                            "llm",
-                            "embedding",
-                            "rerank"
-                        ],
-                        "type": "string",
-                        "description": "模型类型",
-                        "name": "modelType",
-                        "in": "query"
-                    },
-                    {
-                        "type": "string",
-                        "description": "模型供应商",
-                        "name": "provider",
-                        "in": "query"
-                    },
-                    {
-                        "type": "string",
-                        "description": "模型显示名称",
-                        "name": "displayName",
-                        "in": "query"
-                    },
                     {
                         "type": "string",
-                        "description": "启用状态（true: 启用）",
-                        "name": "isActive",
-                        "in": "query"
+                        "description": "数据集id",
+                        "name": "datasetId",
+                        "in": "query",
+                        "required": true
                     }
                 ],
                 "responses": {
@@ -5516,19 +5920,7 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "allOf": [
-                                                {
-                                                    "$ref": "#/definitions/response.ListResult"
-                                                },
-                                                {
-                                                    "type": "object",
-                                                    "properties": {
-                                                        "list": {
-                                                            "$ref": "#/definitions/response.ModelInfo"
-                                                        }
-                                                    }
-                                                }
-                                            ]
+                                            "$ref": "#/definitions/response.KnowledgeEvalDatasetInfo"
                                         }
                                     }
                                 }
@@ -5536,15 +5928,14 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        },
-        "/model/select/embedding": {
-            "get": {
+            },
+            "post": {
                 "security": [
                     {
                         "JWT": []
                     }
                 ],
+                "description": "创建检索评测数据集，每个问题指定期望命中的文档或分段，需要知识库编辑权限",
                 "consumes": [
                     "application/json"
                 ],
@@ -5552,9 +5943,20 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "model"
+                    "knowledge"
+                ],
+                "summary": "创建检索评测数据集",
+                "parameters": [
+                    {
+                        "description": "创建检索评测数据集请求参数",
+                        "name": "data",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/request.CreateKnowledgeEvalDatasetReq"
+                        }
+                    }
                 ],
-                "summary": "embedding模型列表",
                 "responses": {
                     "200": {
                         "description": "OK",
@@ -5567,19 +5969,7 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "allOf": [
-                                                {
-                                                    "$ref": "#/definitions/response.ListResult"
-                                                },
-                                                {
-                                                    "type": "object",
-                                                    "properties": {
-                                                        "list": {
-                                                            "$ref": "#/definitions/response.ModelInfo"
-                                                        }
-                                                    }
-                                                }
-                                            ]
+                                            "$ref": "#/definitions/response.CreateKnowledgeEvalDatasetResp"
                                         }
                                     }
                                 }
@@ -5587,15 +5977,14 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        },
-        "/model/select/gui": {
-            "get": {
+            },
+            "delete": {
                 "security": [
                     {
                         "JWT": []
                     }
                 ],
+                "description": "删除检索评测数据集及其全部评测记录",
                 "consumes": [
                     "application/json"
                 ],
@@ -5603,50 +5992,38 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "model"
+                    "knowledge"
+                ],
+                "summary": "删除检索评测数据集",
+                "parameters": [
+                    {
+                        "description": "删除检索评测数据集请求参数",
+                        "name": "data",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/request.KnowledgeEvalDatasetReq"
+                        }
+                    }
                 ],
-                "summary": "gui模型列表",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "allOf": [
-                                {
-                                    "$ref": "#/definitions/response.Response"
-                                },
-                                {
-                                    "type": "object",
-                                    "properties": {
-                                        "data": {
-                                            "allOf": [
-                                                {
-                                                    "$ref": "#/definitions/response.ListResult"
-                                                },
-                                                {
-                                                    "type": "object",
-                                                    "properties": {
-                                                        "list": {
-                                                            "$ref": "#/definitions/response.ModelInfo"
-                                                        }
-                                                    }
-                                                }
-                                            ]
-                                        }
-                                    }
-                                }
-                            ]
+                            "$ref": "#/definitions/response.Response"
                         }
                     }
                 }
             }
         },
-        "/model/select/llm": {
+        "/knowledge/eval/dataset/list": {
             "get": {
                 "security": [
                     {
                         "JWT": []
                     }
                 ],
+                "description": "查询知识库的检索评测数据集列表",
                 "consumes": [
                     "application/json"
                 ],
@@ -5654,9 +6031,18 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "model"
+                    "knowledge"
+                ],
+                "summary": "查询检索评测数据集列表",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "知识库id",
+                        "name": "knowledgeId",
+                        "in": "query",
+                        "required": true
+                    }
                 ],
-                "summary": "llm模型列表",
                 "responses": {
                     "200": {
                         "description": "OK",
@@ -5669,19 +6055,7 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "allOf": [
-                                                {
-                                                    "$ref": "#/definitions/response.ListResult"
-                                                },
-                                                {
-                                                    "type": "object",
-                                                    "properties": {
-                                                        "list": {
-                                                            "$ref": "#/definitions/response.ModelInfo"
-                                                        }
-                                                    }
-                                                }
-                                            ]
+                                            "$ref": "#/definitions/response.KnowledgeEvalDatasetListResp"
                                         }
                                     }
                                 }
@@ -5691,13 +6065,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/model/select/ocr": {
+        "/knowledge/eval/run": {
             "get": {
                 "security": [
                     {
                         "JWT": []
                     }
                 ],
+                "description": "查询检索评测详情，包括每个问题的检索结果和指标",
                 "consumes": [
                     "application/json"
                 ],
@@ -5705,9 +6080,18 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "model"
+                    "knowledge"
+                ],
+                "summary": "查询检索评测详情",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "评测id",
+                        "name": "runId",
+                        "in": "query",
+                        "required": true
+                    }
                 ],
-                "summary": "ocr模型列表",
                 "responses": {
                     "200": {
                         "description": "OK",
@@ -5720,19 +6104,7 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "allOf": [
-                                                {
-                                                    "$ref": "#/definitions/response.ListResult"
-                                                },
-                                                {
-                                                    "type": "object",
-                                                    "properties": {
-                                                        "list": {
-                                                            "$ref": "#/definitions/response.ModelInfo"
-                                                        }
-                                                    }
-                                                }
-                                            ]
+                                            "$ref": "#/definitions/response.KnowledgeEvalRunDetail"
                                         }
                                     }
                                 }
@@ -5740,15 +6112,14 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        },
-        "/model/select/pdf-parser": {
-            "get": {
+            },
+            "post": {
                 "security": [
                     {
                         "JWT": []
                     }
                 ],
+                "description": "按指定检索配置对数据集的全部问题执行检索，异步计算recall@k、MRR、nDCG@k和命中率，需要知识库编辑权限",
                 "consumes": [
                     "application/json"
                 ],
@@ -5756,9 +6127,20 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "model"
+                    "knowledge"
+                ],
+                "summary": "运行检索评测",
+                "parameters": [
+                    {
+                        "description": "运行检索评测请求参数",
+                        "name": "data",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/request.CreateKnowledgeEvalRunReq"
+                        }
+                    }
                 ],
-                "summary": "pdf文档解析模型列表",
                 "responses": {
                     "200": {
                         "description": "OK",
@@ -5771,19 +6153,7 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "allOf": [
-                                                {
-                                                    "$ref": "#/definitions/response.ListResult"
-                                                },
-                                                {
-                                                    "type": "object",
-                                                    "properties": {
-                                                        "list": {
-                                                            "$ref": "#/definitions/response.ModelInfo"
-                                                        }
-                                                    }
-                                                }
-                                            ]
+                                            "$ref": "#/definitions/response.CreateKnowledgeEvalRunResp"
                                         }
                                     }
                                 }
@@ -5793,13 +6163,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/model/select/rerank": {
+        "/knowledge/eval/run/compare": {
             "get": {
                 "security": [
                     {
                         "JWT": []
                     }
                 ],
+                "description": "对比同一数据集下两次已完成的检索评测，返回指标差值和每个问题的指标",
                 "consumes": [
                     "application/json"
                 ],
@@ -5807,10 +6178,26 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "model"
+                    "knowledge"
                 ],
-                "summary": "rerank模型列表",
-                "responses": {
+                "summary": "对比检索评测",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "基准评测id",
+                        "name": "baseRunId",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "对比评测id",
+                        "name": "targetRunId",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
@@ -5822,19 +6209,7 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "allOf": [
-                                                {
-                                                    "$ref": "#/definitions/response.ListResult"
-                                                },
-                                                {
-                                                    "type": "object",
-                                                    "properties": {
-                                                        "list": {
-                                                            "$ref": "#/definitions/response.ModelInfo"
-                                                        }
-                                                    }
-                                                }
-                                            ]
+                                            "$ref": "#/definitions/response.CompareKnowledgeEvalRunResp"
                                         }
                                     }
                                 }
@@ -5844,13 +6219,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/model/status": {
-            "put": {
+        "/knowledge/eval/run/list": {
+            "get": {
                 "security": [
                     {
                         "JWT": []
                     }
                 ],
+                "description": "查询数据集的检索评测列表，包括状态、进度和汇总指标",
                 "consumes": [
                     "application/json"
                 ],
@@ -5858,74 +6234,48 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "model"
+                    "knowledge"
                 ],
-                "summary": "模型启用/关闭",
+                "summary": "查询检索评测列表",
                 "parameters": [
                     {
-                        "description": "启用/关闭 的模型信息",
-                        "name": "data",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/request.ModelStatusRequest"
-                        }
+                        "type": "string",
+                        "description": "数据集id",
+                        "name": "datasetId",
+                        "in": "query",
+                        "required": true
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/response.Response"
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/response.KnowledgeEvalRunListResp"
+                                        }
+                                    }
+                                }
+                            ]
                         }
                     }
                 }
             }
         },
-        "/org": {
-            "put": {
-                "security": [
-                    {
-                        "JWT": []
-                    }
-                ],
-                "consumes": [
-                    "application/json"
-                ],
-                "produces": [
-                    "application/json"
-                ],
-                "tags": [
-                    "permission.org"
-                ],
-                "summary": "编辑下级组织",
-                "parameters": [
-                    {
-                        "description": "组织信息",
-                        "name": "data",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/request.OrgUpdate"
-                        }
-                    }
-                ],
-                "responses": {
-                    "200": {
-                        "description": "OK",
-                        "schema": {
-                            "$ref": "#/definitions/response.Response"
-                        }
-                    }
-                }
-            },
+        "/knowledge/export": {
             "post": {
                 "security": [
                     {
                         "JWT": []
                     }
                 ],
-                "description": "创建X-Org-Id组织的下级组织",
+                "description": "将知识库配置、文档、元数据、标签、关键词和切片编辑打包导出，需要管理权限；通过任务id查询进度",
                 "consumes": [
                     "application/json"
                 ],
@@ -5933,17 +6283,17 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "permission.org"
+                    "knowledge"
                 ],
-                "summary": "创建下级组织",
+                "summary": "导出知识库",
                 "parameters": [
                     {
-                        "description": "组织信息",
+                        "description": "导出知识库请求参数",
                         "name": "data",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/request.OrgCreate"
+                            "$ref": "#/definitions/request.KnowledgeExportReq"
                         }
                     }
                 ],
@@ -5959,7 +6309,7 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "$ref": "#/definitions/response.OrgID"
+                                            "$ref": "#/definitions/response.KnowledgeBundleTaskResp"
                                         }
                                     }
                                 }
@@ -5967,32 +6317,33 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "delete": {
+            }
+        },
+        "/knowledge/export/download": {
+            "get": {
                 "security": [
                     {
                         "JWT": []
                     }
                 ],
+                "description": "下载导出成功的知识库文件，导出文件保留1天",
                 "consumes": [
                     "application/json"
                 ],
                 "produces": [
-                    "application/json"
+                    "application/octet-stream"
                 ],
                 "tags": [
-                    "permission.org"
+                    "knowledge"
                 ],
-                "summary": "删除下级组织",
+                "summary": "下载知识库导出文件",
                 "parameters": [
                     {
-                        "description": "组织ID",
-                        "name": "data",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/request.OrgID"
-                        }
+                        "type": "string",
+                        "description": "导出任务id",
+                        "name": "taskId",
+                        "in": "query",
+                        "required": true
                     }
                 ],
                 "responses": {
@@ -6005,13 +6356,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/org/info": {
-            "get": {
+        "/knowledge/hit": {
+            "post": {
                 "security": [
                     {
                         "JWT": []
                     }
                 ],
+                "description": "知识库命中测试",
                 "consumes": [
                     "application/json"
                 ],
@@ -6019,16 +6371,18 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "permission.org"
+                    "knowledge"
                 ],
-                "summary": "获取组织信息",
+                "summary": "知识库命中测试",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "组织ID",
-                        "name": "orgId",
-                        "in": "query",
-                        "required": true
+                        "description": "知识库命中测试请求参数",
+                        "name": "data",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/request.KnowledgeHitReq"
+                        }
                     }
                 ],
                 "responses": {
@@ -6043,7 +6397,7 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "$ref": "#/definitions/response.OrgInfo"
+                                            "$ref": "#/definitions/response.KnowledgeHitResp"
                                         }
                                     }
                                 }
@@ -6053,14 +6407,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/org/list": {
-            "get": {
+        "/knowledge/import": {
+            "post": {
                 "security": [
                     {
                         "JWT": []
                     }
                 ],
-                "description": "获取X-Org-Id组织的下级组织列表",
+                "description": "上传知识库导出文件后导入，在当前用户下新建知识库，文档重新解析后恢复切片编辑；通过任务id查询进度",
                 "consumes": [
                     "application/json"
                 ],
@@ -6068,29 +6422,18 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "permission.org"
+                    "knowledge"
                 ],
-                "summary": "获取下级组织列表",
+                "summary": "导入知识库",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "组织名(模糊查询)",
-                        "name": "name",
-                        "in": "query"
-                    },
-                    {
-                        "type": "integer",
-                        "description": "页面编号，从1开始",
-                        "name": "pageNo",
-                        "in": "query",
-                        "required": true
-                    },
-                    {
-                        "type": "integer",
-                        "description": "单页数量，从1开始",
-                        "name": "pageSize",
-                        "in": "query",
-                        "required": true
+                        "description": "导入知识库请求参数",
+                        "name": "data",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/request.KnowledgeImportReq"
+                        }
                     }
                 ],
                 "responses": {
@@ -6105,22 +6448,7 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "allOf": [
-                                                {
-                                                    "$ref": "#/definitions/response.PageResult"
-                                                },
-                                                {
-                                                    "type": "object",
-                                                    "properties": {
-                                                        "list": {
-                                                            "type": "array",
-                                                            "items": {
-                                                                "$ref": "#/definitions/response.OrgInfo"
-                                                            }
-                                                        }
-                                                    }
-                                                }
-                                            ]
+                                            "$ref": "#/definitions/response.KnowledgeBundleTaskResp"
                                         }
                                     }
                                 }
@@ -6130,14 +6458,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/org/other/select": {
+        "/knowledge/keywords": {
             "get": {
                 "security": [
                     {
                         "JWT": []
                     }
                 ],
-                "description": "获取非X-Org-Id组织的用户列表",
+                "description": "查询知识库关键词列表",
                 "consumes": [
                     "application/json"
                 ],
@@ -6145,46 +6473,36 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "permission.user"
+                    "knowledge.keywords"
                 ],
-                "summary": "获取不在组织中用户列表（用于下拉选择）",
+                "summary": "查询知识库关键词列表",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "用户名(模糊查询)",
-                        "name": "name",
-                        "in": "query"
+                        "description": "关键词列表查询请求参数",
+                        "name": "data",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/request.ListKeywordsReq"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "allOf": [
-                                {
-                                    "$ref": "#/definitions/response.Response"
-                                },
-                                {
-                                    "type": "object",
-                                    "properties": {
-                                        "data": {
-                                            "$ref": "#/definitions/response.Select"
-                                        }
-                                    }
-                                }
-                            ]
+                            "$ref": "#/definitions/response.GetKnowledgeKeywordListResp"
                         }
                     }
                 }
-            }
-        },
-        "/org/select": {
-            "get": {
+            },
+            "put": {
                 "security": [
                     {
                         "JWT": []
                     }
                 ],
+                "description": "编辑知识库关键词",
                 "consumes": [
                     "application/json"
                 ],
@@ -6192,38 +6510,36 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "common"
+                    "knowledge.keywords"
+                ],
+                "summary": "编辑知识库关键词",
+                "parameters": [
+                    {
+                        "description": "修改关键词请求参数",
+                        "name": "data",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/request.UpdateKeywordsReq"
+                        }
+                    }
                 ],
-                "summary": "获取用户组织列表（用于下拉选择）",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "allOf": [
-                                {
-                                    "$ref": "#/definitions/response.Response"
-                                },
-                                {
-                                    "type": "object",
-                                    "properties": {
-                                        "data": {
-                                            "$ref": "#/definitions/response.Select"
-                                        }
-                                    }
-                                }
-                            ]
+                            "$ref": "#/definitions/response.Response"
                         }
                     }
                 }
-            }
-        },
-        "/org/status": {
-            "put": {
+            },
+            "post": {
                 "security": [
                     {
                         "JWT": []
                     }
                 ],
+                "description": "新增知识库关键词",
                 "consumes": [
                     "application/json"
                 ],
@@ -6231,17 +6547,17 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "permission.org"
+                    "knowledge.keywords"
                 ],
-                "summary": "修改下级组织状态",
+                "summary": "新增知识库关键词",
                 "parameters": [
                     {
-                        "description": "组织信息",
+                        "description": "创建关键词请求参数",
                         "name": "data",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/request.OrgStatus"
+                            "$ref": "#/definitions/request.CreateKeywordsReq"
                         }
                     }
                 ],
@@ -6253,16 +6569,14 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        },
-        "/org/user": {
-            "post": {
+            },
+            "delete": {
                 "security": [
                     {
                         "JWT": []
                     }
                 ],
-                "description": "增加X-Org-Id组织的用户",
+                "description": "删除知识库关键词",
                 "consumes": [
                     "application/json"
                 ],
@@ -6270,17 +6584,17 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "permission.user"
+                    "knowledge.keywords"
                 ],
-                "summary": "邀请用户加入组织",
+                "summary": "删除知识库关键词",
                 "parameters": [
                     {
-                        "description": "用户-角色",
+                        "description": "删除知识库关键词请求参数",
                         "name": "data",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/request.OrgUserAdd"
+                            "$ref": "#/definitions/request.DeleteKeywordsReq"
                         }
                     }
                 ],
@@ -6294,38 +6608,72 @@ const docTemplate = `{
                 }
             }
         },
-        "/proxy/file/upload": {
-            "post": {
+        "/knowledge/keywords/detail": {
+            "get": {
                 "security": [
                     {
                         "JWT": []
                     }
                 ],
-                "description": "代理文件上传",
+                "description": "查询知识库关键词详情",
                 "consumes": [
-                    "multipart/form-data"
+                    "application/json"
                 ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "common"
+                    "knowledge.keywords"
                 ],
-                "summary": "代理文件上传",
+                "summary": "查询知识库关键词详情",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "原始文件名",
-                        "name": "fileName",
-                        "in": "formData",
-                        "required": true
-                    },
+                        "description": "关键词列表查询请求参数",
+                        "name": "data",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/request.GetKeywordsDetailReq"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/response.KeywordsInfo"
+                        }
+                    }
+                }
+            }
+        },
+        "/knowledge/meta/select": {
+            "get": {
+                "security": [
                     {
-                        "type": "file",
-                        "description": "文件",
-                        "name": "file",
-                        "in": "formData",
-                        "required": true
+                        "JWT": []
+                    }
+                ],
+                "description": "获取知识库元数据",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "knowledge"
+                ],
+                "summary": "获取知识库元数据",
+                "parameters": [
+                    {
+                        "description": "获取知识库元数据请求参数",
+                        "name": "data",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/request.GetKnowledgeMetaSelectReq"
+                        }
                     }
                 ],
                 "responses": {
@@ -6340,7 +6688,7 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "$ref": "#/definitions/response.ProxyUploadFileResp"
+                                            "$ref": "#/definitions/response.GetKnowledgeMetaSelectResp"
                                         }
                                     }
                                 }
@@ -6350,13 +6698,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/rag/chat": {
+        "/knowledge/meta/value/list": {
             "post": {
                 "security": [
                     {
                         "JWT": []
                     }
                 ],
+                "description": "获取文档元数据列表",
                 "consumes": [
                     "application/json"
                 ],
@@ -6364,17 +6713,17 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "rag"
+                    "knowledge"
                 ],
-                "summary": "私域 RAG 问答",
+                "summary": "获取文档元数据列表",
                 "parameters": [
                     {
-                        "description": "RAG问答请求参数",
+                        "description": "文档元数据列表请求参数",
                         "name": "data",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/request.ChatRagRequest"
+                            "$ref": "#/definitions/request.KnowledgeMetaValueListReq"
                         }
                     }
                 ],
@@ -6382,20 +6731,32 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/response.Response"
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/response.KnowledgeMetaValueListResp"
+                                        }
+                                    }
+                                }
+                            ]
                         }
                     }
                 }
             }
         },
-        "/role": {
-            "put": {
+        "/knowledge/meta/value/update": {
+            "post": {
                 "security": [
                     {
                         "JWT": []
                     }
                 ],
-                "description": "编辑X-Org-Id组织的角色",
+                "description": "更新知识库元数据值",
                 "consumes": [
                     "application/json"
                 ],
@@ -6403,17 +6764,17 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "permission.role"
+                    "knowledge"
                 ],
-                "summary": "编辑角色",
+                "summary": "更新知识库元数据值",
                 "parameters": [
                     {
-                        "description": "角色信息",
+                        "description": "更新知识库元数据值请求参数",
                         "name": "data",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/request.RoleUpdate"
+                            "$ref": "#/definitions/request.UpdateMetaValueReq"
                         }
                     }
                 ],
@@ -6425,14 +6786,16 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
+            }
+        },
+        "/knowledge/permission": {
             "post": {
                 "security": [
                     {
                         "JWT": []
                     }
                 ],
-                "description": "创建X-Org-Id组织的角色",
+                "description": "将知识库分享给用户、角色或组织，权限为查看、编辑或管理；已分享的对象更新权限",
                 "consumes": [
                     "application/json"
                 ],
@@ -6440,17 +6803,17 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "permission.role"
+                    "knowledge.permission"
                 ],
-                "summary": "创建角色",
+                "summary": "分享知识库",
                 "parameters": [
                     {
-                        "description": "角色信息",
+                        "description": "分享知识库请求参数",
                         "name": "data",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/request.RoleCreate"
+                            "$ref": "#/definitions/request.KnowledgePermissionGrantReq"
                         }
                     }
                 ],
@@ -6458,19 +6821,7 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "allOf": [
-                                {
-                                    "$ref": "#/definitions/response.Response"
-                                },
-                                {
-                                    "type": "object",
-                                    "properties": {
-                                        "data": {
-                                            "$ref": "#/definitions/response.RoleID"
-                                        }
-                                    }
-                                }
-                            ]
+                            "$ref": "#/definitions/response.Response"
                         }
                     }
                 }
@@ -6481,7 +6832,7 @@ const docTemplate = `{
                         "JWT": []
                     }
                 ],
-                "description": "删除X-Org-Id组织的角色",
+                "description": "取消分享知识库",
                 "consumes": [
                     "application/json"
                 ],
@@ -6489,17 +6840,17 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "permission.role"
+                    "knowledge.permission"
                 ],
-                "summary": "删除角色",
+                "summary": "取消分享知识库",
                 "parameters": [
                     {
-                        "description": "角色ID",
+                        "description": "取消分享知识库请求参数",
                         "name": "data",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/request.RoleID"
+                            "$ref": "#/definitions/request.KnowledgePermissionRevokeReq"
                         }
                     }
                 ],
@@ -6513,14 +6864,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/role/info": {
+        "/knowledge/permission/list": {
             "get": {
                 "security": [
                     {
                         "JWT": []
                     }
                 ],
-                "description": "获取X-Org-Id组织的角色信息",
+                "description": "查询知识库分享列表",
                 "consumes": [
                     "application/json"
                 ],
@@ -6528,14 +6879,14 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "permission.role"
+                    "knowledge.permission"
                 ],
-                "summary": "获取角色信息",
+                "summary": "查询知识库分享列表",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "角色ID",
-                        "name": "roleId",
+                        "description": "知识库id",
+                        "name": "knowledgeId",
                         "in": "query",
                         "required": true
                     }
@@ -6552,7 +6903,7 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "$ref": "#/definitions/response.RoleInfo"
+                                            "$ref": "#/definitions/response.KnowledgePermissionListResp"
                                         }
                                     }
                                 }
@@ -6562,14 +6913,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/role/list": {
-            "get": {
+        "/knowledge/select": {
+            "post": {
                 "security": [
                     {
                         "JWT": []
                     }
                 ],
-                "description": "获取X-Org-Id组织的角色列表",
+                "description": "查询知识库列表",
                 "consumes": [
                     "application/json"
                 ],
@@ -6577,29 +6928,18 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "permission.role"
+                    "knowledge"
                 ],
-                "summary": "获取角色列表",
+                "summary": "查询知识库列表",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "角色名(模糊查询)",
-                        "name": "name",
-                        "in": "query"
-                    },
-                    {
-                        "type": "integer",
-                        "description": "页面编号，从1开始",
-                        "name": "pageNo",
-                        "in": "query",
-                        "required": true
-                    },
-                    {
-                        "type": "integer",
-                        "description": "单页数量，从1开始",
-                        "name": "pageSize",
-                        "in": "query",
-                        "required": true
+                        "description": "查询知识库列表",
+                        "name": "data",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/request.KnowledgeSelectReq"
+                        }
                     }
                 ],
                 "responses": {
@@ -6614,22 +6954,7 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "allOf": [
-                                                {
-                                                    "$ref": "#/definitions/response.PageResult"
-                                                },
-                                                {
-                                                    "type": "object",
-                                                    "properties": {
-                                                        "list": {
-                                                            "type": "array",
-                                                            "items": {
-                                                                "$ref": "#/definitions/response.RoleInfo"
-                                                            }
-                                                        }
-                                                    }
-                                                }
-                                            ]
+                                            "$ref": "#/definitions/response.KnowledgeListResp"
                                         }
                                     }
                                 }
@@ -6639,14 +6964,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/role/select": {
+        "/knowledge/splitter": {
             "get": {
                 "security": [
                     {
                         "JWT": []
                     }
                 ],
-                "description": "获取X-Org-Id组织的角色列表",
+                "description": "查询知识库分隔符列表",
                 "consumes": [
                     "application/json"
                 ],
@@ -6654,9 +6979,19 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "permission.user"
+                    "knowledge.splitter"
+                ],
+                "summary": "查询知识库分隔符列表",
+                "parameters": [
+                    {
+                        "description": "查询知识库分隔符列表参数",
+                        "name": "data",
+                        "in": "body",
+                        "schema": {
+                            "$ref": "#/definitions/request.GetKnowledgeSplitterReq"
+                        }
+                    }
                 ],
-                "summary": "获取组织角色列表（用于下拉选择）",
                 "responses": {
                     "200": {
                         "description": "OK",
@@ -6669,7 +7004,7 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "$ref": "#/definitions/response.Select"
+                                            "$ref": "#/definitions/response.KnowledgeSplitterListResp"
                                         }
                                     }
                                 }
@@ -6677,16 +7012,14 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        },
-        "/role/status": {
+            },
             "put": {
                 "security": [
                     {
                         "JWT": []
                     }
                 ],
-                "description": "修改X-Org-Id组织的角色状态",
+                "description": "修改知识库分隔符",
                 "consumes": [
                     "application/json"
                 ],
@@ -6694,17 +7027,17 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "permission.role"
+                    "knowledge.splitter"
                 ],
-                "summary": "修改角色状态",
+                "summary": "修改知识库分隔符",
                 "parameters": [
                     {
-                        "description": "角色信息",
+                        "description": "修改知识库分隔符请求参数",
                         "name": "data",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/request.RoleStatus"
+                            "$ref": "#/definitions/request.UpdateKnowledgeSplitterReq"
                         }
                     }
                 ],
@@ -6716,16 +7049,14 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        },
-        "/role/template": {
-            "get": {
+            },
+            "post": {
                 "security": [
                     {
                         "JWT": []
                     }
                 ],
-                "description": "获取当前用户在X-Org-Id组织的角色模板",
+                "description": "创建知识库分隔符",
                 "consumes": [
                     "application/json"
                 ],
@@ -6733,39 +7064,75 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "permission.role"
+                    "knowledge.splitter"
+                ],
+                "summary": "创建知识库分隔符",
+                "parameters": [
+                    {
+                        "description": "创建知识库分隔符请求参数",
+                        "name": "data",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/request.CreateKnowledgeSplitterReq"
+                        }
+                    }
                 ],
-                "summary": "获取角色模板（用于创建角色）",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "allOf": [
-                                {
-                                    "$ref": "#/definitions/response.Response"
-                                },
-                                {
-                                    "type": "object",
-                                    "properties": {
-                                        "data": {
-                                            "$ref": "#/definitions/response.RoleTemplate"
-                                        }
-                                    }
-                                }
-                            ]
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "JWT": []
+                    }
+                ],
+                "description": "删除知识库分隔符",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "knowledge.splitter"
+                ],
+                "summary": "删除知识库分隔符",
+                "parameters": [
+                    {
+                        "description": "删除知识库分隔符请求参数",
+                        "name": "data",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/request.DeleteKnowledgeSplitterReq"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
                         }
                     }
                 }
             }
         },
-        "/safe/sensitive/table": {
+        "/knowledge/tag": {
             "get": {
                 "security": [
                     {
                         "JWT": []
                     }
                 ],
-                "description": "获取敏感词表",
+                "description": "查询知识库标签列表",
                 "consumes": [
                     "application/json"
                 ],
@@ -6773,16 +7140,18 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "safety"
+                    "knowledge.tag"
                 ],
-                "summary": "获取敏感词表",
+                "summary": "查询知识库标签列表",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "敏感词表id",
-                        "name": "tableId",
-                        "in": "query",
-                        "required": true
+                        "description": "查询知识库请求参数",
+                        "name": "data",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/request.KnowledgeTagSelectReq"
+                        }
                     }
                 ],
                 "responses": {
@@ -6797,7 +7166,7 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "$ref": "#/definitions/response.SensitiveWordTableDetail"
+                                            "$ref": "#/definitions/response.KnowledgeTagListResp"
                                         }
                                     }
                                 }
@@ -6812,7 +7181,7 @@ const docTemplate = `{
                         "JWT": []
                     }
                 ],
-                "description": "编辑敏感词表",
+                "description": "修改知识库标签",
                 "consumes": [
                     "application/json"
                 ],
@@ -6820,17 +7189,17 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "safety"
+                    "knowledge.tag"
                 ],
-                "summary": "编辑敏感词表",
+                "summary": "修改知识库标签",
                 "parameters": [
                     {
-                        "description": "编辑敏感词表请求参数",
+                        "description": "修改知识库标签请求参数",
                         "name": "data",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/request.UpdateSensitiveWordTableReq"
+                            "$ref": "#/definitions/request.UpdateKnowledgeTagReq"
                         }
                     }
                 ],
@@ -6849,7 +7218,7 @@ const docTemplate = `{
                         "JWT": []
                     }
                 ],
-                "description": "创建敏感词表",
+                "description": "创建知识库标签",
                 "consumes": [
                     "application/json"
                 ],
@@ -6857,17 +7226,17 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "safety"
+                    "knowledge.tag"
                 ],
-                "summary": "创建敏感词表",
+                "summary": "创建知识库标签",
                 "parameters": [
                     {
-                        "description": "创建敏感词表请求参数",
+                        "description": "创建知识库标签请求参数",
                         "name": "data",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/request.CreateSensitiveWordTableReq"
+                            "$ref": "#/definitions/request.CreateKnowledgeTagReq"
                         }
                     }
                 ],
@@ -6883,7 +7252,7 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "$ref": "#/definitions/response.CreateSensitiveWordTableResp"
+                                            "$ref": "#/definitions/response.CreateKnowledgeTagResp"
                                         }
                                     }
                                 }
@@ -6898,7 +7267,7 @@ const docTemplate = `{
                         "JWT": []
                     }
                 ],
-                "description": "删除敏感词表",
+                "description": "删除知识库标签",
                 "consumes": [
                     "application/json"
                 ],
@@ -6906,17 +7275,17 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "safety"
+                    "knowledge.tag"
                 ],
-                "summary": "删除敏感词表",
+                "summary": "删除知识库标签",
                 "parameters": [
                     {
-                        "description": "删除敏感词表请求参数",
+                        "description": "删除知识库标签请求参数",
                         "name": "data",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/request.DeleteSensitiveWordTableReq"
+                            "$ref": "#/definitions/request.DeleteKnowledgeTagReq"
                         }
                     }
                 ],
@@ -6930,14 +7299,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/safe/sensitive/table/list": {
-            "get": {
+        "/knowledge/tag/bind": {
+            "post": {
                 "security": [
                     {
                         "JWT": []
                     }
                 ],
-                "description": "获取敏感词表列表",
+                "description": "绑定知识库标签",
                 "consumes": [
                     "application/json"
                 ],
@@ -6945,54 +7314,38 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "safety"
+                    "knowledge.tag"
+                ],
+                "summary": "绑定知识库标签",
+                "parameters": [
+                    {
+                        "description": "绑定知识库标签请求参数",
+                        "name": "data",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/request.BindKnowledgeTagReq"
+                        }
+                    }
                 ],
-                "summary": "获取敏感词表列表",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "allOf": [
-                                {
-                                    "$ref": "#/definitions/response.Response"
-                                },
-                                {
-                                    "type": "object",
-                                    "properties": {
-                                        "data": {
-                                            "allOf": [
-                                                {
-                                                    "$ref": "#/definitions/response.ListResult"
-                                                },
-                                                {
-                                                    "type": "object",
-                                                    "properties": {
-                                                        "list": {
-                                                            "type": "array",
-                                                            "items": {
-                                                                "$ref": "#/definitions/response.SensitiveWordTableDetail"
-                                                            }
-                                                        }
-                                                    }
-                                                }
-                                            ]
-                                        }
-                                    }
-                                }
-                            ]
+                            "$ref": "#/definitions/response.Response"
                         }
                     }
                 }
             }
         },
-        "/safe/sensitive/table/reply": {
-            "put": {
+        "/knowledge/tag/bind/count": {
+            "get": {
                 "security": [
                     {
                         "JWT": []
                     }
                 ],
-                "description": "编辑回复设置",
+                "description": "查询标签绑定知识库数量",
                 "consumes": [
                     "application/json"
                 ],
@@ -7000,17 +7353,17 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "safety"
+                    "knowledge.tag"
                 ],
-                "summary": "编辑回复设置",
+                "summary": "查询标签绑定知识库数量",
                 "parameters": [
                     {
-                        "description": "编辑回复设置请求参数",
+                        "description": "查询tag绑定数量参数请求参数",
                         "name": "data",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/request.UpdateSensitiveWordTableReplyReq"
+                            "$ref": "#/definitions/request.TagBindCountReq"
                         }
                     }
                 ],
@@ -7018,20 +7371,27 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/response.Response"
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/response.KnowledgeTagListResp"
+                                        }
+                                    }
+                                }
+                            ]
                         }
                     }
                 }
             }
         },
-        "/safe/sensitive/table/select": {
+        "/mcp": {
             "get": {
-                "security": [
-                    {
-                        "JWT": []
-                    }
-                ],
-                "description": "获取敏感词表列表（用于下拉选择）",
+                "description": "获取自定义MCP详情",
                 "consumes": [
                     "application/json"
                 ],
@@ -7039,9 +7399,18 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "safety"
+                    "tool"
+                ],
+                "summary": "获取自定义MCP详情",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "mcpId",
+                        "name": "mcpId",
+                        "in": "query",
+                        "required": true
+                    }
                 ],
-                "summary": "获取敏感词表列表（用于下拉选择）",
                 "responses": {
                     "200": {
                         "description": "OK",
@@ -7054,22 +7423,7 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "allOf": [
-                                                {
-                                                    "$ref": "#/definitions/response.ListResult"
-                                                },
-                                                {
-                                                    "type": "object",
-                                                    "properties": {
-                                                        "list": {
-                                                            "type": "array",
-                                                            "items": {
-                                                                "$ref": "#/definitions/response.SensitiveWordTableDetail"
-                                                            }
-                                                        }
-                                                    }
-                                                }
-                                            ]
+                                            "$ref": "#/definitions/response.MCPDetail"
                                         }
                                     }
                                 }
@@ -7077,16 +7431,9 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        },
-        "/safe/sensitive/word": {
+            },
             "post": {
-                "security": [
-                    {
-                        "JWT": []
-                    }
-                ],
-                "description": "上传敏感词",
+                "description": "创建自定义MCP",
                 "consumes": [
                     "application/json"
                 ],
@@ -7094,17 +7441,17 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "safety"
+                    "tool"
                 ],
-                "summary": "上传敏感词",
+                "summary": "创建自定义MCP",
                 "parameters": [
                     {
-                        "description": "上传敏感词参数",
+                        "description": "自定义MCP信息",
                         "name": "data",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/request.UploadSensitiveVocabularyReq"
+                            "$ref": "#/definitions/request.MCPCreate"
                         }
                     }
                 ],
@@ -7118,12 +7465,7 @@ const docTemplate = `{
                 }
             },
             "delete": {
-                "security": [
-                    {
-                        "JWT": []
-                    }
-                ],
-                "description": "删除敏感词",
+                "description": "删除自定义MCP",
                 "consumes": [
                     "application/json"
                 ],
@@ -7131,17 +7473,17 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "safety"
+                    "tool"
                 ],
-                "summary": "删除敏感词",
+                "summary": "删除自定义MCP",
                 "parameters": [
                     {
-                        "description": "删除敏感词参数",
+                        "description": "mcpId",
                         "name": "data",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/request.DeleteSensitiveVocabularyReq"
+                            "$ref": "#/definitions/request.MCPIDReq"
                         }
                     }
                 ],
@@ -7155,14 +7497,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/safe/sensitive/word/list": {
+        "/mcp/list": {
             "get": {
-                "security": [
-                    {
-                        "JWT": []
-                    }
-                ],
-                "description": "获取词表数据列表",
+                "description": "获取自定义MCP列表",
                 "consumes": [
                     "application/json"
                 ],
@@ -7170,30 +7507,15 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "safety"
+                    "tool"
                 ],
-                "summary": "获取词表数据列表",
+                "summary": "获取自定义MCP列表",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "敏感词表id",
-                        "name": "tableId",
-                        "in": "query",
-                        "required": true
-                    },
-                    {
-                        "type": "integer",
-                        "description": "页面编号，从1开始",
-                        "name": "pageNo",
-                        "in": "query",
-                        "required": true
-                    },
-                    {
-                        "type": "integer",
-                        "description": "单页数量，从1开始",
-                        "name": "pageSize",
-                        "in": "query",
-                        "required": true
+                        "description": "mcp名称",
+                        "name": "name",
+                        "in": "query"
                     }
                 ],
                 "responses": {
@@ -7218,7 +7540,7 @@ const docTemplate = `{
                                                         "list": {
                                                             "type": "array",
                                                             "items": {
-                                                                "$ref": "#/definitions/response.SensitiveWordVocabularyDetail"
+                                                                "$ref": "#/definitions/response.MCPInfo"
                                                             }
                                                         }
                                                     }
@@ -7233,9 +7555,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/tool/builtin": {
-            "post": {
-                "description": "修改内置工具",
+        "/mcp/select": {
+            "get": {
+                "description": "获取自定义MCP列表（用于下拉选择）",
                 "consumes": [
                     "application/json"
                 ],
@@ -7243,33 +7565,57 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "tool"
+                    "mcp"
                 ],
-                "summary": "修改内置工具",
+                "summary": "获取自定义MCP列表（用于下拉选择）",
                 "parameters": [
                     {
-                        "description": "内置工具信息",
-                        "name": "data",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/request.ToolSquareAPIKeyReq"
-                        }
+                        "type": "string",
+                        "description": "mcp名称",
+                        "name": "name",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/response.Response"
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "allOf": [
+                                                {
+                                                    "$ref": "#/definitions/response.ListResult"
+                                                },
+                                                {
+                                                    "type": "object",
+                                                    "properties": {
+                                                        "list": {
+                                                            "type": "array",
+                                                            "items": {
+                                                                "$ref": "#/definitions/response.MCPSelect"
+                                                            }
+                                                        }
+                                                    }
+                                                }
+                                            ]
+                                        }
+                                    }
+                                }
+                            ]
                         }
                     }
                 }
             }
         },
-        "/tool/custom": {
+        "/mcp/square": {
             "get": {
-                "description": "获取自定义工具详情",
+                "description": "获取广场MCP详情",
                 "consumes": [
                     "application/json"
                 ],
@@ -7277,14 +7623,14 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "tool"
+                    "mcp.square"
                 ],
-                "summary": "获取自定义工具详情",
+                "summary": "获取广场MCP详情",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "customToolId",
-                        "name": "customToolId",
+                        "description": "mcpSquareId",
+                        "name": "mcpSquareId",
                         "in": "query",
                         "required": true
                     }
@@ -7301,7 +7647,7 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "$ref": "#/definitions/response.CustomToolDetail"
+                                            "$ref": "#/definitions/response.MCPSquareDetail"
                                         }
                                     }
                                 }
@@ -7309,107 +7655,11 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "put": {
-                "description": "修改自定义工具",
-                "consumes": [
-                    "application/json"
-                ],
-                "produces": [
-                    "application/json"
-                ],
-                "tags": [
-                    "tool"
-                ],
-                "summary": "修改自定义工具",
-                "parameters": [
-                    {
-                        "description": "自定义工具信息",
-                        "name": "data",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/request.CustomToolUpdateReq"
-                        }
-                    }
-                ],
-                "responses": {
-                    "200": {
-                        "description": "OK",
-                        "schema": {
-                            "$ref": "#/definitions/response.Response"
-                        }
-                    }
-                }
-            },
-            "post": {
-                "description": "创建自定义工具",
-                "consumes": [
-                    "application/json"
-                ],
-                "produces": [
-                    "application/json"
-                ],
-                "tags": [
-                    "tool"
-                ],
-                "summary": "创建自定义工具",
-                "parameters": [
-                    {
-                        "description": "自定义工具信息",
-                        "name": "data",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/request.CustomToolCreate"
-                        }
-                    }
-                ],
-                "responses": {
-                    "200": {
-                        "description": "OK",
-                        "schema": {
-                            "$ref": "#/definitions/response.Response"
-                        }
-                    }
-                }
-            },
-            "delete": {
-                "description": "删除自定义工具",
-                "consumes": [
-                    "application/json"
-                ],
-                "produces": [
-                    "application/json"
-                ],
-                "tags": [
-                    "tool"
-                ],
-                "summary": "删除自定义工具",
-                "parameters": [
-                    {
-                        "description": "自定义工具ID",
-                        "name": "data",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/request.CustomToolIDReq"
-                        }
-                    }
-                ],
-                "responses": {
-                    "200": {
-                        "description": "OK",
-                        "schema": {
-                            "$ref": "#/definitions/response.Response"
-                        }
-                    }
-                }
             }
         },
-        "/tool/custom/list": {
+        "/mcp/square/list": {
             "get": {
-                "description": "获取自定义工具列表",
+                "description": "获取广场MCP列表",
                 "consumes": [
                     "application/json"
                 ],
@@ -7417,13 +7667,25 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "tool"
+                    "mcp.square"
                 ],
-                "summary": "获取自定义工具列表",
+                "summary": "获取广场MCP列表",
                 "parameters": [
                     {
+                        "enum": [
+                            "all",
+                            "data",
+                            "create",
+                            "search"
+                        ],
                         "type": "string",
-                        "description": "CustomTool名称",
+                        "description": "mcp类型",
+                        "name": "category",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "mcp名称",
                         "name": "name",
                         "in": "query"
                     }
@@ -7450,7 +7712,7 @@ const docTemplate = `{
                                                         "list": {
                                                             "type": "array",
                                                             "items": {
-                                                                "$ref": "#/definitions/response.CustomToolInfo"
+                                                                "$ref": "#/definitions/response.MCPSquareInfo"
                                                             }
                                                         }
                                                     }
@@ -7465,9 +7727,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/tool/custom/schema": {
-            "post": {
-                "description": "解析自定义工具的Schema转换为API相关数据",
+        "/mcp/square/recommend": {
+            "get": {
+                "description": "获取广场MCP推荐列表",
                 "consumes": [
                     "application/json"
                 ],
@@ -7475,75 +7737,20 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "tool"
+                    "mcp.square"
                 ],
-                "summary": "获取可用API列表（根据Schema）",
+                "summary": "获取广场MCP推荐列表",
                 "parameters": [
                     {
-                        "description": "Schema格式数据",
-                        "name": "data",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/request.CustomToolSchemaReq"
-                        }
-                    }
-                ],
-                "responses": {
-                    "200": {
-                        "description": "OK",
-                        "schema": {
-                            "allOf": [
-                                {
-                                    "$ref": "#/definitions/response.Response"
-                                },
-                                {
-                                    "type": "object",
-                                    "properties": {
-                                        "data": {
-                                            "allOf": [
-                                                {
-                                                    "$ref": "#/definitions/response.ListResult"
-                                                },
-                                                {
-                                                    "type": "object",
-                                                    "properties": {
-                                                        "list": {
-                                                            "type": "array",
-                                                            "items": {
-                                                                "$ref": "#/definitions/response.CustomToolActionInfo"
-                                                            }
-                                                        }
-                                                    }
-                                                }
-                                            ]
-                                        }
-                                    }
-                                }
-                            ]
-                        }
-                    }
-                }
-            }
-        },
-        "/tool/custom/select": {
-            "get": {
-                "description": "获取自定义工具列表（用于下拉选择）",
-                "consumes": [
-                    "application/json"
-                ],
-                "produces": [
-                    "application/json"
-                ],
-                "tags": [
-                    "tool"
-                ],
-                "summary": "获取自定义工具列表（用于下拉选择）",
-                "parameters": [
+                        "type": "string",
+                        "description": "mcpId",
+                        "name": "mcpId",
+                        "in": "query"
+                    },
                     {
                         "type": "string",
-                        "description": "CustomTool名称",
-                        "name": "name",
+                        "description": "mcpSquareId",
+                        "name": "mcpSquareId",
                         "in": "query"
                     }
                 ],
@@ -7569,7 +7776,7 @@ const docTemplate = `{
                                                         "list": {
                                                             "type": "array",
                                                             "items": {
-                                                                "$ref": "#/definitions/response.CustomToolSelect"
+                                                                "$ref": "#/definitions/response.MCPSquareInfo"
                                                             }
                                                         }
                                                     }
@@ -7584,9 +7791,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/tool/square": {
+        "/mcp/tool/list": {
             "get": {
-                "description": "获取内置工具详情",
+                "description": "获取MCP Tool列表",
                 "consumes": [
                     "application/json"
                 ],
@@ -7594,58 +7801,20 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "tool.square"
+                    "mcp"
                 ],
-                "summary": "获取内置工具详情",
+                "summary": "获取MCP Tool列表",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "toolSquareId",
-                        "name": "toolSquareId",
-                        "in": "query",
-                        "required": true
-                    }
-                ],
-                "responses": {
-                    "200": {
-                        "description": "OK",
-                        "schema": {
-                            "allOf": [
-                                {
-                                    "$ref": "#/definitions/response.Response"
-                                },
-                                {
-                                    "type": "object",
-                                    "properties": {
-                                        "data": {
-                                            "$ref": "#/definitions/response.ToolSquareDetail"
-                                        }
-                                    }
-                                }
-                            ]
-                        }
-                    }
-                }
-            }
-        },
-        "/tool/square/list": {
-            "get": {
-                "description": "获取内置工具列表",
-                "consumes": [
-                    "application/json"
-                ],
-                "produces": [
-                    "application/json"
-                ],
-                "tags": [
-                    "tool.square"
-                ],
-                "summary": "获取内置工具列表",
-                "parameters": [
+                        "description": "mcpId(和serverUrl传一个)",
+                        "name": "mcpId",
+                        "in": "query"
+                    },
                     {
                         "type": "string",
-                        "description": "tool名称",
-                        "name": "name",
+                        "description": "serverUrl,就是sseUrl(和mcpId传一个)",
+                        "name": "serverUrl",
                         "in": "query"
                     }
                 ],
@@ -7661,22 +7830,7 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "allOf": [
-                                                {
-                                                    "$ref": "#/definitions/response.ListResult"
-                                                },
-                                                {
-                                                    "type": "object",
-                                                    "properties": {
-                                                        "list": {
-                                                            "type": "array",
-                                                            "items": {
-                                                                "$ref": "#/definitions/response.ToolSquareInfo"
-                                                            }
-                                                        }
-                                                    }
-                                                }
-                                            ]
+                                            "$ref": "#/definitions/response.MCPToolList"
                                         }
                                     }
                                 }
@@ -7686,51 +7840,13 @@ const docTemplate = `{
                 }
             }
         },
-        "/user": {
-            "put": {
-                "security": [
-                    {
-                        "JWT": []
-                    }
-                ],
-                "description": "编辑X-Org-Id组织的用户；在系统视角下编辑用户，不能分配角色",
-                "consumes": [
-                    "application/json"
-                ],
-                "produces": [
-                    "application/json"
-                ],
-                "tags": [
-                    "permission.user"
-                ],
-                "summary": "编辑用户",
-                "parameters": [
-                    {
-                        "description": "用户信息",
-                        "name": "data",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/request.UserUpdate"
-                        }
-                    }
-                ],
-                "responses": {
-                    "200": {
-                        "description": "OK",
-                        "schema": {
-                            "$ref": "#/definitions/response.Response"
-                        }
-                    }
-                }
-            },
-            "post": {
+        "/model": {
+            "get": {
                 "security": [
                     {
                         "JWT": []
                     }
                 ],
-                "description": "创建用户，同时加入X-Org-Id组织；在系统视角下创建用户，不加入任何组织，也不能分配角色",
                 "consumes": [
                     "application/json"
                 ],
@@ -7738,17 +7854,17 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "permission.user"
+                    "model"
                 ],
-                "summary": "创建用户",
+                "summary": "‌查询单个模型",
                 "parameters": [
                     {
-                        "description": "用户信息",
+                        "description": "模型ID",
                         "name": "data",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/request.UserCreate"
+                            "$ref": "#/definitions/request.GetModelRequest"
                         }
                     }
                 ],
@@ -7764,7 +7880,7 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "$ref": "#/definitions/response.UserID"
+                                            "$ref": "#/definitions/response.ModelInfo"
                                         }
                                     }
                                 }
@@ -7773,13 +7889,12 @@ const docTemplate = `{
                     }
                 }
             },
-            "delete": {
+            "put": {
                 "security": [
                     {
                         "JWT": []
                     }
                 ],
-                "description": "从X-Org-Id组织将用户移除；在系统视角下为删除用户",
                 "consumes": [
                     "application/json"
                 ],
@@ -7787,17 +7902,17 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "permission.user"
+                    "model"
                 ],
-                "summary": "删除用户",
+                "summary": "导入模型更新",
                 "parameters": [
                     {
-                        "description": "用户ID",
+                        "description": "模型变更信息",
                         "name": "data",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/request.UserID"
+                            "$ref": "#/definitions/request.ImportOrUpdateModelRequest"
                         }
                     }
                 ],
@@ -7809,15 +7924,14 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        },
-        "/user/admin/password": {
-            "put": {
+            },
+            "post": {
                 "security": [
                     {
                         "JWT": []
                     }
                 ],
+                "description": "第三方模型的导入",
                 "consumes": [
                     "application/json"
                 ],
@@ -7825,17 +7939,17 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "permission.user"
+                    "model"
                 ],
-                "summary": "重置用户密码（by 管理员）",
+                "summary": "模型导入",
                 "parameters": [
                     {
-                        "description": "用户信息",
+                        "description": "导入模型信息",
                         "name": "data",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/request.UserPasswordByAdmin"
+                            "$ref": "#/definitions/request.ImportOrUpdateModelRequest"
                         }
                     }
                 ],
@@ -7847,16 +7961,13 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        },
-        "/user/avatar": {
-            "put": {
+            },
+            "delete": {
                 "security": [
                     {
                         "JWT": []
                     }
                 ],
-                "description": "更新用户的头像信息",
                 "consumes": [
                     "application/json"
                 ],
@@ -7864,17 +7975,17 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "common"
+                    "model"
                 ],
-                "summary": "编辑用户头像",
+                "summary": "导入模型删除",
                 "parameters": [
                     {
-                        "description": "用户头像信息",
+                        "description": "模型删除key",
                         "name": "data",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/request.UserAvatarUpdate"
+                            "$ref": "#/definitions/request.DeleteModelRequest"
                         }
                     }
                 ],
@@ -7888,13 +7999,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/user/info": {
+        "/model/cache/stats": {
             "get": {
                 "security": [
                     {
                         "JWT": []
                     }
                 ],
+                "description": "模型响应缓存的精确命中、语义命中与未命中次数",
                 "consumes": [
                     "application/json"
                 ],
@@ -7902,9 +8014,18 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "common"
+                    "model"
+                ],
+                "summary": "模型缓存命中统计",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "模型ID",
+                        "name": "modelId",
+                        "in": "query",
+                        "required": true
+                    }
                 ],
-                "summary": "获取用户信息",
                 "responses": {
                     "200": {
                         "description": "OK",
@@ -7917,7 +8038,7 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "$ref": "#/definitions/response.UserInfo"
+                                            "$ref": "#/definitions/response.ModelCacheStat"
                                         }
                                     }
                                 }
@@ -7927,14 +8048,13 @@ const docTemplate = `{
                 }
             }
         },
-        "/user/list": {
+        "/model/list": {
             "get": {
                 "security": [
                     {
                         "JWT": []
                     }
                 ],
-                "description": "获取X-Org-Id组织的用户列表；在系统视角下获取系统内全部用户列表",
                 "consumes": [
                     "application/json"
                 ],
@@ -7942,29 +8062,38 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "permission.user"
+                    "model"
                 ],
-                "summary": "获取用户列表",
+                "summary": "导入模型列表",
                 "parameters": [
                     {
+                        "enum": [
+                            "llm",
+                            "embedding",
+                            "rerank"
+                        ],
                         "type": "string",
-                        "description": "用户名(模糊查询)",
-                        "name": "name",
+                        "description": "模型类型",
+                        "name": "modelType",
                         "in": "query"
                     },
                     {
-                        "type": "integer",
-                        "description": "页面编号，从1开始",
-                        "name": "pageNo",
-                        "in": "query",
-                        "required": true
+                        "type": "string",
+                        "description": "模型供应商",
+                        "name": "provider",
+                        "in": "query"
                     },
                     {
-                        "type": "integer",
-                        "description": "单页数量，从1开始",
-                        "name": "pageSize",
-                        "in": "query",
-                        "required": true
+                        "type": "string",
+                        "description": "模型显示名称",
+                        "name": "displayName",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "启用状态（true: 启用）",
+                        "name": "isActive",
+                        "in": "query"
                     }
                 ],
                 "responses": {
@@ -7981,16 +8110,13 @@ const docTemplate = `{
                                         "data": {
                                             "allOf": [
                                                 {
-                                                    "$ref": "#/definitions/response.PageResult"
+                                                    "$ref": "#/definitions/response.ListResult"
                                                 },
                                                 {
                                                     "type": "object",
                                                     "properties": {
                                                         "list": {
-                                                            "type": "array",
-                                                            "items": {
-                                                                "$ref": "#/definitions/response.UserInfo"
-                                                            }
+                                                            "$ref": "#/definitions/response.ModelInfo"
                                                         }
                                                     }
                                                 }
@@ -8004,14 +8130,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/user/login": {
+        "/model/quota": {
             "put": {
                 "security": [
                     {
                         "JWT": []
                     }
                 ],
-                "description": "二阶段用户首次登录邮箱校验绑定与修改密码",
+                "description": "设置当前组织或组织内用户的每日、每月token配额（仅组织管理员）",
                 "consumes": [
                     "application/json"
                 ],
@@ -8019,23 +8145,17 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "common"
+                    "model"
                 ],
-                "summary": "用户首次登录邮箱校验绑定与修改密码",
+                "summary": "设置模型配额",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "语言",
-                        "name": "X-Language",
-                        "in": "header"
-                    },
-                    {
-                        "description": "密码和邮箱信息",
+                        "description": "配额信息",
                         "name": "data",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/request.ChangeUserPasswordByEmail"
+                            "$ref": "#/definitions/request.ModelQuotaRequest"
                         }
                     }
                 ],
@@ -8043,30 +8163,18 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "allOf": [
-                                {
-                                    "$ref": "#/definitions/response.Response"
-                                },
-                                {
-                                    "type": "object",
-                                    "properties": {
-                                        "data": {
-                                            "$ref": "#/definitions/response.Login"
-                                        }
-                                    }
-                                }
-                            ]
+                            "$ref": "#/definitions/response.Response"
                         }
                     }
                 }
             },
-            "post": {
+            "delete": {
                 "security": [
                     {
                         "JWT": []
                     }
                 ],
-                "description": "二阶段用户非首次登录邮箱校验与绑定",
+                "description": "删除当前组织或组织内用户的token配额（仅组织管理员）",
                 "consumes": [
                     "application/json"
                 ],
@@ -8074,26 +8182,48 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "common"
+                    "model"
                 ],
-                "summary": "非首次登录邮箱校验与绑定",
+                "summary": "删除模型配额",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "语言",
-                        "name": "X-Language",
-                        "in": "header"
-                    },
-                    {
-                        "description": "登录邮箱信息",
+                        "description": "配额信息",
                         "name": "data",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/request.LoginEmailCheck"
+                            "$ref": "#/definitions/request.DeleteModelQuotaRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
                         }
                     }
+                }
+            }
+        },
+        "/model/quota/list": {
+            "get": {
+                "security": [
+                    {
+                        "JWT": []
+                    }
+                ],
+                "description": "当前组织的组织配额、用户配额及当日、当月已用量（仅组织管理员）",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "model"
                 ],
+                "summary": "模型配额列表",
                 "responses": {
                     "200": {
                         "description": "OK",
@@ -8106,7 +8236,19 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "$ref": "#/definitions/response.Login"
+                                            "allOf": [
+                                                {
+                                                    "$ref": "#/definitions/response.ListResult"
+                                                },
+                                                {
+                                                    "type": "object",
+                                                    "properties": {
+                                                        "list": {
+                                                            "$ref": "#/definitions/response.ModelQuota"
+                                                        }
+                                                    }
+                                                }
+                                            ]
                                         }
                                     }
                                 }
@@ -8116,8 +8258,13 @@ const docTemplate = `{
                 }
             }
         },
-        "/user/login/email/code": {
-            "post": {
+        "/model/select/embedding": {
+            "get": {
+                "security": [
+                    {
+                        "JWT": []
+                    }
+                ],
                 "consumes": [
                     "application/json"
                 ],
@@ -8125,32 +8272,45 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "common"
-                ],
-                "summary": "登录邮箱验证码发送",
-                "parameters": [
-                    {
-                        "description": "邮箱地址",
-                        "name": "data",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/request.LoginSendEmailCode"
-                        }
-                    }
+                    "model"
                 ],
+                "summary": "embedding模型列表",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/response.Response"
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "allOf": [
+                                                {
+                                                    "$ref": "#/definitions/response.ListResult"
+                                                },
+                                                {
+                                                    "type": "object",
+                                                    "properties": {
+                                                        "list": {
+                                                            "$ref": "#/definitions/response.ModelInfo"
+                                                        }
+                                                    }
+                                                }
+                                            ]
+                                        }
+                                    }
+                                }
+                            ]
                         }
                     }
                 }
             }
         },
-        "/user/password": {
-            "put": {
+        "/model/select/gui": {
+            "get": {
                 "security": [
                     {
                         "JWT": []
@@ -8163,31 +8323,44 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "permission.user"
-                ],
-                "summary": "修改用户密码（by 个人）",
-                "parameters": [
-                    {
-                        "description": "用户信息",
-                        "name": "data",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/request.UserPassword"
-                        }
-                    }
+                    "model"
                 ],
+                "summary": "gui模型列表",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/response.Response"
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "allOf": [
+                                                {
+                                                    "$ref": "#/definitions/response.ListResult"
+                                                },
+                                                {
+                                                    "type": "object",
+                                                    "properties": {
+                                                        "list": {
+                                                            "$ref": "#/definitions/response.ModelInfo"
+                                                        }
+                                                    }
+                                                }
+                                            ]
+                                        }
+                                    }
+                                }
+                            ]
                         }
                     }
                 }
             }
         },
-        "/user/permission": {
+        "/model/select/llm": {
             "get": {
                 "security": [
                     {
@@ -8201,9 +8374,9 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "common"
+                    "model"
                 ],
-                "summary": "获取用户权限",
+                "summary": "llm模型列表",
                 "responses": {
                     "200": {
                         "description": "OK",
@@ -8216,7 +8389,19 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "$ref": "#/definitions/response.UserPermission"
+                                            "allOf": [
+                                                {
+                                                    "$ref": "#/definitions/response.ListResult"
+                                                },
+                                                {
+                                                    "type": "object",
+                                                    "properties": {
+                                                        "list": {
+                                                            "$ref": "#/definitions/response.ModelInfo"
+                                                        }
+                                                    }
+                                                }
+                                            ]
                                         }
                                     }
                                 }
@@ -8226,8 +8411,8 @@ const docTemplate = `{
                 }
             }
         },
-        "/user/status": {
-            "put": {
+        "/model/select/ocr": {
+            "get": {
                 "security": [
                     {
                         "JWT": []
@@ -8240,19 +8425,3071 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "permission.user"
-                ],
-                "summary": "修改用户状态",
-                "parameters": [
-                    {
-                        "description": "用户信息",
-                        "name": "data",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/request.UserStatus"
-                        }
-                    }
+                    "model"
+                ],
+                "summary": "ocr模型列表",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "allOf": [
+                                                {
+                                                    "$ref": "#/definitions/response.ListResult"
+                                                },
+                                                {
+                                                    "type": "object",
+                                                    "properties": {
+                                                        "list": {
+                                                            "$ref": "#/definitions/response.ModelInfo"
+                                                        }
+                                                    }
+                                                }
+                                            ]
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    }
+                }
+            }
+        },
+        "/model/select/pdf-parser": {
+            "get": {
+                "security": [
+                    {
+                        "JWT": []
+                    }
+                ],
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "model"
+                ],
+                "summary": "pdf文档解析模型列表",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "allOf": [
+                                                {
+                                                    "$ref": "#/definitions/response.ListResult"
+                                                },
+                                                {
+                                                    "type": "object",
+                                                    "properties": {
+                                                        "list": {
+                                                            "$ref": "#/definitions/response.ModelInfo"
+                                                        }
+                                                    }
+                                                }
+                                            ]
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    }
+                }
+            }
+        },
+        "/model/select/rerank": {
+            "get": {
+                "security": [
+                    {
+                        "JWT": []
+                    }
+                ],
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "model"
+                ],
+                "summary": "rerank模型列表",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "allOf": [
+                                                {
+                                                    "$ref": "#/definitions/response.ListResult"
+                                                },
+                                                {
+                                                    "type": "object",
+                                                    "properties": {
+                                                        "list": {
+                                                            "$ref": "#/definitions/response.ModelInfo"
+                                                        }
+                                                    }
+                                                }
+                                            ]
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    }
+                }
+            }
+        },
+        "/model/status": {
+            "put": {
+                "security": [
+                    {
+                        "JWT": []
+                    }
+                ],
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "model"
+                ],
+                "summary": "模型启用/关闭",
+                "parameters": [
+                    {
+                        "description": "启用/关闭 的模型信息",
+                        "name": "data",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/request.ModelStatusRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/model/usage/stats": {
+            "get": {
+                "security": [
+                    {
+                        "JWT": []
+                    }
+                ],
+                "description": "按模型、应用或组织汇总模型调用次数与token用量（仅组织管理员）",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "model"
+                ],
+                "summary": "模型用量统计",
+                "parameters": [
+                    {
+                        "enum": [
+                            "model",
+                            "app",
+                            "org"
+                        ],
+                        "type": "string",
+                        "description": "汇总维度",
+                        "name": "groupBy",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "组织ID（仅【系统】组织可指定）",
+                        "name": "orgId",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "用户ID",
+                        "name": "userId",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "模型ID",
+                        "name": "modelId",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "应用ID",
+                        "name": "appId",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "开始时间（毫秒）",
+                        "name": "startAt",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "结束时间（毫秒）",
+                        "name": "endAt",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/response.ModelUsageStat"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    }
+                }
+            }
+        },
+        "/org": {
+            "put": {
+                "security": [
+                    {
+                        "JWT": []
+                    }
+                ],
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "permission.org"
+                ],
+                "summary": "编辑下级组织",
+                "parameters": [
+                    {
+                        "description": "组织信息",
+                        "name": "data",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/request.OrgUpdate"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "JWT": []
+                    }
+                ],
+                "description": "创建X-Org-Id组织的下级组织",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "permission.org"
+                ],
+                "summary": "创建下级组织",
+                "parameters": [
+                    {
+                        "description": "组织信息",
+                        "name": "data",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/request.OrgCreate"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/response.OrgID"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "JWT": []
+                    }
+                ],
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "permission.org"
+                ],
+                "summary": "删除下级组织",
+                "parameters": [
+                    {
+                        "description": "组织ID",
+                        "name": "data",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/request.OrgID"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/org/info": {
+            "get": {
+                "security": [
+                    {
+                        "JWT": []
+                    }
+                ],
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "permission.org"
+                ],
+                "summary": "获取组织信息",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "组织ID",
+                        "name": "orgId",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/response.OrgInfo"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    }
+                }
+            }
+        },
+        "/org/list": {
+            "get": {
+                "security": [
+                    {
+                        "JWT": []
+                    }
+                ],
+                "description": "获取X-Org-Id组织的下级组织列表",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "permission.org"
+                ],
+                "summary": "获取下级组织列表",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "组织名(模糊查询)",
+                        "name": "name",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "页面编号，从1开始",
+                        "name": "pageNo",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "单页数量，从1开始",
+                        "name": "pageSize",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "allOf": [
+                                                {
+                                                    "$ref": "#/definitions/response.PageResult"
+                                                },
+                                                {
+                                                    "type": "object",
+                                                    "properties": {
+                                                        "list": {
+                                                            "type": "array",
+                                                            "items": {
+                                                                "$ref": "#/definitions/response.OrgInfo"
+                                                            }
+                                                        }
+                                                    }
+                                                }
+                                            ]
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    }
+                }
+            }
+        },
+        "/org/other/select": {
+            "get": {
+                "security": [
+                    {
+                        "JWT": []
+                    }
+                ],
+                "description": "获取非X-Org-Id组织的用户列表",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "permission.user"
+                ],
+                "summary": "获取不在组织中用户列表（用于下拉选择）",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "用户名(模糊查询)",
+                        "name": "name",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/response.Select"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    }
+                }
+            }
+        },
+        "/org/select": {
+            "get": {
+                "security": [
+                    {
+                        "JWT": []
+                    }
+                ],
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "common"
+                ],
+                "summary": "获取用户组织列表（用于下拉选择）",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/response.Select"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    }
+                }
+            }
+        },
+        "/org/status": {
+            "put": {
+                "security": [
+                    {
+                        "JWT": []
+                    }
+                ],
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "permission.org"
+                ],
+                "summary": "修改下级组织状态",
+                "parameters": [
+                    {
+                        "description": "组织信息",
+                        "name": "data",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/request.OrgStatus"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/org/user": {
+            "post": {
+                "security": [
+                    {
+                        "JWT": []
+                    }
+                ],
+                "description": "增加X-Org-Id组织的用户",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "permission.user"
+                ],
+                "summary": "邀请用户加入组织",
+                "parameters": [
+                    {
+                        "description": "用户-角色",
+                        "name": "data",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/request.OrgUserAdd"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/proxy/file/upload": {
+            "post": {
+                "security": [
+                    {
+                        "JWT": []
+                    }
+                ],
+                "description": "代理文件上传",
+                "consumes": [
+                    "multipart/form-data"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "common"
+                ],
+                "summary": "代理文件上传",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "原始文件名",
+                        "name": "fileName",
+                        "in": "formData",
+                        "required": true
+                    },
+                    {
+                        "type": "file",
+                        "description": "文件",
+                        "name": "file",
+                        "in": "formData",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/response.ProxyUploadFileResp"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    }
+                }
+            }
+        },
+        "/rag/chat": {
+            "post": {
+                "security": [
+                    {
+                        "JWT": []
+                    }
+                ],
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "rag"
+                ],
+                "summary": "私域 RAG 问答",
+                "parameters": [
+                    {
+                        "description": "RAG问答请求参数",
+                        "name": "data",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/request.ChatRagRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/rag/chat/feedback": {
+            "post": {
+                "security": [
+                    {
+                        "JWT": []
+                    }
+                ],
+                "description": "点赞/点踩某次问答并填写原因，同时保存问题、回答与知识片段快照；rating为0时取消评价",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "rag"
+                ],
+                "summary": "评价RAG回答",
+                "parameters": [
+                    {
+                        "description": "RAG回答评价参数",
+                        "name": "data",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/request.RagFeedbackRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/rate-limit/stats": {
+            "get": {
+                "security": [
+                    {
+                        "JWT": []
+                    }
+                ],
+                "description": "OpenAPI与应用Url按ApiKey、应用、匿名访问者的放行、拒绝计数及当前并发流数（仅系统管理员）",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "setting"
+                ],
+                "summary": "限流统计",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/response.RateLimitStats"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    }
+                }
+            }
+        },
+        "/role": {
+            "put": {
+                "security": [
+                    {
+                        "JWT": []
+                    }
+                ],
+                "description": "编辑X-Org-Id组织的角色",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "permission.role"
+                ],
+                "summary": "编辑角色",
+                "parameters": [
+                    {
+                        "description": "角色信息",
+                        "name": "data",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/request.RoleUpdate"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "JWT": []
+                    }
+                ],
+                "description": "创建X-Org-Id组织的角色",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "permission.role"
+                ],
+                "summary": "创建角色",
+                "parameters": [
+                    {
+                        "description": "角色信息",
+                        "name": "data",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/request.RoleCreate"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/response.RoleID"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "JWT": []
+                    }
+                ],
+                "description": "删除X-Org-Id组织的角色",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "permission.role"
+                ],
+                "summary": "删除角色",
+                "parameters": [
+                    {
+                        "description": "角色ID",
+                        "name": "data",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/request.RoleID"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/role/info": {
+            "get": {
+                "security": [
+                    {
+                        "JWT": []
+                    }
+                ],
+                "description": "获取X-Org-Id组织的角色信息",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "permission.role"
+                ],
+                "summary": "获取角色信息",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "角色ID",
+                        "name": "roleId",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/response.RoleInfo"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    }
+                }
+            }
+        },
+        "/role/list": {
+            "get": {
+                "security": [
+                    {
+                        "JWT": []
+                    }
+                ],
+                "description": "获取X-Org-Id组织的角色列表",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "permission.role"
+                ],
+                "summary": "获取角色列表",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "角色名(模糊查询)",
+                        "name": "name",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "页面编号，从1开始",
+                        "name": "pageNo",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "单页数量，从1开始",
+                        "name": "pageSize",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "allOf": [
+                                                {
+                                                    "$ref": "#/definitions/response.PageResult"
+                                                },
+                                                {
+                                                    "type": "object",
+                                                    "properties": {
+                                                        "list": {
+                                                            "type": "array",
+                                                            "items": {
+                                                                "$ref": "#/definitions/response.RoleInfo"
+                                                            }
+                                                        }
+                                                    }
+                                                }
+                                            ]
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    }
+                }
+            }
+        },
+        "/role/select": {
+            "get": {
+                "security": [
+                    {
+                        "JWT": []
+                    }
+                ],
+                "description": "获取X-Org-Id组织的角色列表",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "permission.user"
+                ],
+                "summary": "获取组织角色列表（用于下拉选择）",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/response.Select"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    }
+                }
+            }
+        },
+        "/role/status": {
+            "put": {
+                "security": [
+                    {
+                        "JWT": []
+                    }
+                ],
+                "description": "修改X-Org-Id组织的角色状态",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "permission.role"
+                ],
+                "summary": "修改角色状态",
+                "parameters": [
+                    {
+                        "description": "角色信息",
+                        "name": "data",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/request.RoleStatus"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/role/template": {
+            "get": {
+                "security": [
+                    {
+                        "JWT": []
+                    }
+                ],
+                "description": "获取当前用户在X-Org-Id组织的角色模板",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "permission.role"
+                ],
+                "summary": "获取角色模板（用于创建角色）",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/response.RoleTemplate"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    }
+                }
+            }
+        },
+        "/safe/guardrail/policy": {
+            "get": {
+                "security": [
+                    {
+                        "JWT": []
+                    }
+                ],
+                "description": "获取应用的输入、输出护栏策略，未配置时返回未启用的空策略",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "safety"
+                ],
+                "summary": "获取应用护栏策略",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "应用id",
+                        "name": "appId",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "enum": [
+                            "rag",
+                            "agent"
+                        ],
+                        "type": "string",
+                        "description": "应用类型：rag 或 agent",
+                        "name": "appType",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/response.GuardrailPolicy"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    }
+                }
+            },
+            "put": {
+                "security": [
+                    {
+                        "JWT": []
+                    }
+                ],
+                "description": "保存应用护栏策略：输入阶段在问题发送给模型前检测，输出阶段对流式回答检测；支持手机号、身份证号、邮箱、银行卡号及自定义正则检测（mask/redact/block），以及基于已导入大模型的分类检测",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "safety"
+                ],
+                "summary": "保存应用护栏策略",
+                "parameters": [
+                    {
+                        "description": "护栏策略",
+                        "name": "data",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/request.GuardrailPolicy"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/safe/sensitive/table": {
+            "get": {
+                "security": [
+                    {
+                        "JWT": []
+                    }
+                ],
+                "description": "获取敏感词表",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "safety"
+                ],
+                "summary": "获取敏感词表",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "敏感词表id",
+                        "name": "tableId",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/response.SensitiveWordTableDetail"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    }
+                }
+            },
+            "put": {
+                "security": [
+                    {
+                        "JWT": []
+                    }
+                ],
+                "description": "编辑敏感词表",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "safety"
+                ],
+                "summary": "编辑敏感词表",
+                "parameters": [
+                    {
+                        "description": "编辑敏感词表请求参数",
+                        "name": "data",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/request.UpdateSensitiveWordTableReq"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "JWT": []
+                    }
+                ],
+                "description": "创建敏感词表",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "safety"
+                ],
+                "summary": "创建敏感词表",
+                "parameters": [
+                    {
+                        "description": "创建敏感词表请求参数",
+                        "name": "data",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/request.CreateSensitiveWordTableReq"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/response.CreateSensitiveWordTableResp"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "JWT": []
+                    }
+                ],
+                "description": "删除敏感词表",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "safety"
+                ],
+                "summary": "删除敏感词表",
+                "parameters": [
+                    {
+                        "description": "删除敏感词表请求参数",
+                        "name": "data",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/request.DeleteSensitiveWordTableReq"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/safe/sensitive/table/list": {
+            "get": {
+                "security": [
+                    {
+                        "JWT": []
+                    }
+                ],
+                "description": "获取敏感词表列表",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "safety"
+                ],
+                "summary": "获取敏感词表列表",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "allOf": [
+                                                {
+                                                    "$ref": "#/definitions/response.ListResult"
+                                                },
+                                                {
+                                                    "type": "object",
+                                                    "properties": {
+                                                        "list": {
+                                                            "type": "array",
+                                                            "items": {
+                                                                "$ref": "#/definitions/response.SensitiveWordTableDetail"
+                                                            }
+                                                        }
+                                                    }
+                                                }
+                                            ]
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    }
+                }
+            }
+        },
+        "/safe/sensitive/table/reply": {
+            "put": {
+                "security": [
+                    {
+                        "JWT": []
+                    }
+                ],
+                "description": "编辑回复设置",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "safety"
+                ],
+                "summary": "编辑回复设置",
+                "parameters": [
+                    {
+                        "description": "编辑回复设置请求参数",
+                        "name": "data",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/request.UpdateSensitiveWordTableReplyReq"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/safe/sensitive/table/select": {
+            "get": {
+                "security": [
+                    {
+                        "JWT": []
+                    }
+                ],
+                "description": "获取敏感词表列表（用于下拉选择）",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "safety"
+                ],
+                "summary": "获取敏感词表列表（用于下拉选择）",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "allOf": [
+                                                {
+                                                    "$ref": "#/definitions/response.ListResult"
+                                                },
+                                                {
+                                                    "type": "object",
+                                                    "properties": {
+                                                        "list": {
+                                                            "type": "array",
+                                                            "items": {
+                                                                "$ref": "#/definitions/response.SensitiveWordTableDetail"
+                                                            }
+                                                        }
+                                                    }
+                                                }
+                                            ]
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    }
+                }
+            }
+        },
+        "/safe/sensitive/word": {
+            "post": {
+                "security": [
+                    {
+                        "JWT": []
+                    }
+                ],
+                "description": "上传敏感词",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "safety"
+                ],
+                "summary": "上传敏感词",
+                "parameters": [
+                    {
+                        "description": "上传敏感词参数",
+                        "name": "data",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/request.UploadSensitiveVocabularyReq"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "JWT": []
+                    }
+                ],
+                "description": "删除敏感词",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "safety"
+                ],
+                "summary": "删除敏感词",
+                "parameters": [
+                    {
+                        "description": "删除敏感词参数",
+                        "name": "data",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/request.DeleteSensitiveVocabularyReq"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/safe/sensitive/word/list": {
+            "get": {
+                "security": [
+                    {
+                        "JWT": []
+                    }
+                ],
+                "description": "获取词表数据列表",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "safety"
+                ],
+                "summary": "获取词表数据列表",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "敏感词表id",
+                        "name": "tableId",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "页面编号，从1开始",
+                        "name": "pageNo",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "单页数量，从1开始",
+                        "name": "pageSize",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "allOf": [
+                                                {
+                                                    "$ref": "#/definitions/response.ListResult"
+                                                },
+                                                {
+                                                    "type": "object",
+                                                    "properties": {
+                                                        "list": {
+                                                            "type": "array",
+                                                            "items": {
+                                                                "$ref": "#/definitions/response.SensitiveWordVocabularyDetail"
+                                                            }
+                                                        }
+                                                    }
+                                                }
+                                            ]
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    }
+                }
+            }
+        },
+        "/tool/builtin": {
+            "post": {
+                "description": "修改内置工具",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "tool"
+                ],
+                "summary": "修改内置工具",
+                "parameters": [
+                    {
+                        "description": "内置工具信息",
+                        "name": "data",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/request.ToolSquareAPIKeyReq"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/tool/custom": {
+            "get": {
+                "description": "获取自定义工具详情",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "tool"
+                ],
+                "summary": "获取自定义工具详情",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "customToolId",
+                        "name": "customToolId",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/response.CustomToolDetail"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    }
+                }
+            },
+            "put": {
+                "description": "修改自定义工具",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "tool"
+                ],
+                "summary": "修改自定义工具",
+                "parameters": [
+                    {
+                        "description": "自定义工具信息",
+                        "name": "data",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/request.CustomToolUpdateReq"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "创建自定义工具",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "tool"
+                ],
+                "summary": "创建自定义工具",
+                "parameters": [
+                    {
+                        "description": "自定义工具信息",
+                        "name": "data",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/request.CustomToolCreate"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "删除自定义工具",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "tool"
+                ],
+                "summary": "删除自定义工具",
+                "parameters": [
+                    {
+                        "description": "自定义工具ID",
+                        "name": "data",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/request.CustomToolIDReq"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/tool/custom/list": {
+            "get": {
+                "description": "获取自定义工具列表",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "tool"
+                ],
+                "summary": "获取自定义工具列表",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "CustomTool名称",
+                        "name": "name",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "allOf": [
+                                                {
+                                                    "$ref": "#/definitions/response.ListResult"
+                                                },
+                                                {
+                                                    "type": "object",
+                                                    "properties": {
+                                                        "list": {
+                                                            "type": "array",
+                                                            "items": {
+                                                                "$ref": "#/definitions/response.CustomToolInfo"
+                                                            }
+                                                        }
+                                                    }
+                                                }
+                                            ]
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    }
+                }
+            }
+        },
+        "/tool/custom/schema": {
+            "post": {
+                "description": "解析自定义工具的Schema转换为API相关数据",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "tool"
+                ],
+                "summary": "获取可用API列表（根据Schema）",
+                "parameters": [
+                    {
+                        "description": "Schema格式数据",
+                        "name": "data",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/request.CustomToolSchemaReq"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "allOf": [
+                                                {
+                                                    "$ref": "#/definitions/response.ListResult"
+                                                },
+                                                {
+                                                    "type": "object",
+                                                    "properties": {
+                                                        "list": {
+                                                            "type": "array",
+                                                            "items": {
+                                                                "$ref": "#/definitions/response.CustomToolActionInfo"
+                                                            }
+                                                        }
+                                                    }
+                                                }
+                                            ]
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    }
+                }
+            }
+        },
+        "/tool/custom/select": {
+            "get": {
+                "description": "获取自定义工具列表（用于下拉选择）",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "tool"
+                ],
+                "summary": "获取自定义工具列表（用于下拉选择）",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "CustomTool名称",
+                        "name": "name",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "allOf": [
+                                                {
+                                                    "$ref": "#/definitions/response.ListResult"
+                                                },
+                                                {
+                                                    "type": "object",
+                                                    "properties": {
+                                                        "list": {
+                                                            "type": "array",
+                                                            "items": {
+                                                                "$ref": "#/definitions/response.CustomToolSelect"
+                                                            }
+                                                        }
+                                                    }
+                                                }
+                                            ]
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    }
+                }
+            }
+        },
+        "/tool/square": {
+            "get": {
+                "description": "获取内置工具详情",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "tool.square"
+                ],
+                "summary": "获取内置工具详情",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "toolSquareId",
+                        "name": "toolSquareId",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/response.ToolSquareDetail"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    }
+                }
+            }
+        },
+        "/tool/square/list": {
+            "get": {
+                "description": "获取内置工具列表",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "tool.square"
+                ],
+                "summary": "获取内置工具列表",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "tool名称",
+                        "name": "name",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "allOf": [
+                                                {
+                                                    "$ref": "#/definitions/response.ListResult"
+                                                },
+                                                {
+                                                    "type": "object",
+                                                    "properties": {
+                                                        "list": {
+                                                            "type": "array",
+                                                            "items": {
+                                                                "$ref": "#/definitions/response.ToolSquareInfo"
+                                                            }
+                                                        }
+                                                    }
+                                                }
+                                            ]
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    }
+                }
+            }
+        },
+        "/user": {
+            "put": {
+                "security": [
+                    {
+                        "JWT": []
+                    }
+                ],
+                "description": "编辑X-Org-Id组织的用户；在系统视角下编辑用户，不能分配角色",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "permission.user"
+                ],
+                "summary": "编辑用户",
+                "parameters": [
+                    {
+                        "description": "用户信息",
+                        "name": "data",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/request.UserUpdate"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "JWT": []
+                    }
+                ],
+                "description": "创建用户，同时加入X-Org-Id组织；在系统视角下创建用户，不加入任何组织，也不能分配角色",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "permission.user"
+                ],
+                "summary": "创建用户",
+                "parameters": [
+                    {
+                        "description": "用户信息",
+                        "name": "data",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/request.UserCreate"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/response.UserID"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "JWT": []
+                    }
+                ],
+                "description": "从X-Org-Id组织将用户移除；在系统视角下为删除用户",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "permission.user"
+                ],
+                "summary": "删除用户",
+                "parameters": [
+                    {
+                        "description": "用户ID",
+                        "name": "data",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/request.UserID"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/user/admin/password": {
+            "put": {
+                "security": [
+                    {
+                        "JWT": []
+                    }
+                ],
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "permission.user"
+                ],
+                "summary": "重置用户密码（by 管理员）",
+                "parameters": [
+                    {
+                        "description": "用户信息",
+                        "name": "data",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/request.UserPasswordByAdmin"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/user/avatar": {
+            "put": {
+                "security": [
+                    {
+                        "JWT": []
+                    }
+                ],
+                "description": "更新用户的头像信息",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "common"
+                ],
+                "summary": "编辑用户头像",
+                "parameters": [
+                    {
+                        "description": "用户头像信息",
+                        "name": "data",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/request.UserAvatarUpdate"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/user/info": {
+            "get": {
+                "security": [
+                    {
+                        "JWT": []
+                    }
+                ],
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "common"
+                ],
+                "summary": "获取用户信息",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/response.UserInfo"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    }
+                }
+            }
+        },
+        "/user/list": {
+            "get": {
+                "security": [
+                    {
+                        "JWT": []
+                    }
+                ],
+                "description": "获取X-Org-Id组织的用户列表；在系统视角下获取系统内全部用户列表",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "permission.user"
+                ],
+                "summary": "获取用户列表",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "用户名(模糊查询)",
+                        "name": "name",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "页面编号，从1开始",
+                        "name": "pageNo",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "单页数量，从1开始",
+                        "name": "pageSize",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "allOf": [
+                                                {
+                                                    "$ref": "#/definitions/response.PageResult"
+                                                },
+                                                {
+                                                    "type": "object",
+                                                    "properties": {
+                                                        "list": {
+                                                            "type": "array",
+                                                            "items": {
+                                                                "$ref": "#/definitions/response.UserInfo"
+                                                            }
+                                                        }
+                                                    }
+                                                }
+                                            ]
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    }
+                }
+            }
+        },
+        "/user/login": {
+            "put": {
+                "security": [
+                    {
+                        "JWT": []
+                    }
+                ],
+                "description": "二阶段用户首次登录邮箱校验绑定与修改密码",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "common"
+                ],
+                "summary": "用户首次登录邮箱校验绑定与修改密码",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "语言",
+                        "name": "X-Language",
+                        "in": "header"
+                    },
+                    {
+                        "description": "密码和邮箱信息",
+                        "name": "data",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/request.ChangeUserPasswordByEmail"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/response.Login"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "JWT": []
+                    }
+                ],
+                "description": "二阶段用户非首次登录邮箱校验与绑定",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "common"
+                ],
+                "summary": "非首次登录邮箱校验与绑定",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "语言",
+                        "name": "X-Language",
+                        "in": "header"
+                    },
+                    {
+                        "description": "登录邮箱信息",
+                        "name": "data",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/request.LoginEmailCheck"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/response.Login"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    }
+                }
+            }
+        },
+        "/user/login/email/code": {
+            "post": {
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "common"
+                ],
+                "summary": "登录邮箱验证码发送",
+                "parameters": [
+                    {
+                        "description": "邮箱地址",
+                        "name": "data",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/request.LoginSendEmailCode"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/user/password": {
+            "put": {
+                "security": [
+                    {
+                        "JWT": []
+                    }
+                ],
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "permission.user"
+                ],
+                "summary": "修改用户密码（by 个人）",
+                "parameters": [
+                    {
+                        "description": "用户信息",
+                        "name": "data",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/request.UserPassword"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/user/permission": {
+            "get": {
+                "security": [
+                    {
+                        "JWT": []
+                    }
+                ],
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "common"
+                ],
+                "summary": "获取用户权限",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/response.UserPermission"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    }
+                }
+            }
+        },
+        "/user/status": {
+            "put": {
+                "security": [
+                    {
+                        "JWT": []
+                    }
+                ],
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "permission.user"
+                ],
+                "summary": "修改用户状态",
+                "parameters": [
+                    {
+                        "description": "用户信息",
+                        "name": "data",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/request.UserStatus"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/webhook": {
+            "put": {
+                "security": [
+                    {
+                        "JWT": []
+                    }
+                ],
+                "description": "编辑webhook（仅组织管理员），secret为空时不修改",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "permission"
+                ],
+                "summary": "编辑webhook",
+                "parameters": [
+                    {
+                        "description": "webhook信息",
+                        "name": "data",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/request.UpdateWebhookRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "JWT": []
+                    }
+                ],
+                "description": "注册组织的webhook接收地址（仅组织管理员）；事件以POST投递，请求头X-Wanwu-Signature为 sha256=hex(hmac_sha256(secret, \"{X-Wanwu-Timestamp}.{body}\"))，非2xx响应按指数退避重试",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "permission"
+                ],
+                "summary": "创建webhook",
+                "parameters": [
+                    {
+                        "description": "webhook信息",
+                        "name": "data",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/request.CreateWebhookRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/response.WebhookInfo"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "JWT": []
+                    }
+                ],
+                "description": "删除webhook及其投递记录（仅组织管理员）",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "permission"
+                ],
+                "summary": "删除webhook",
+                "parameters": [
+                    {
+                        "description": "webhook ID",
+                        "name": "data",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/request.WebhookIdRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/webhook/delivery/list": {
+            "get": {
+                "security": [
+                    {
+                        "JWT": []
+                    }
+                ],
+                "description": "获取组织的webhook投递记录（仅组织管理员），status=dead 为死信列表",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "permission"
+                ],
+                "summary": "获取webhook投递记录",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "name": "pageNo",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "name": "pageSize",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "enum": [
+                            "pending",
+                            "success",
+                            "dead"
+                        ],
+                        "type": "string",
+                        "description": "投递状态：pending 待投递，success 成功，dead 死信；为空时不过滤",
+                        "name": "status",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "webhook ID，为空时不过滤",
+                        "name": "webhookId",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "allOf": [
+                                                {
+                                                    "$ref": "#/definitions/response.PageResult"
+                                                },
+                                                {
+                                                    "type": "object",
+                                                    "properties": {
+                                                        "list": {
+                                                            "type": "array",
+                                                            "items": {
+                                                                "$ref": "#/definitions/response.WebhookDeliveryInfo"
+                                                            }
+                                                        }
+                                                    }
+                                                }
+                                            ]
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    }
+                }
+            }
+        },
+        "/webhook/delivery/retry": {
+            "post": {
+                "security": [
+                    {
+                        "JWT": []
+                    }
+                ],
+                "description": "将死信重新置为待投递并重置投递次数（仅组织管理员）",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "permission"
+                ],
+                "summary": "重试webhook投递",
+                "parameters": [
+                    {
+                        "description": "投递ID",
+                        "name": "data",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/request.WebhookDeliveryIdRequest"
+                        }
+                    }
                 ],
                 "responses": {
                     "200": {
@@ -8264,6 +11501,61 @@ const docTemplate = `{
                 }
             }
         },
+        "/webhook/list": {
+            "get": {
+                "security": [
+                    {
+                        "JWT": []
+                    }
+                ],
+                "description": "获取组织的webhook列表（仅组织管理员），签名密钥脱敏返回",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "permission"
+                ],
+                "summary": "获取webhook列表",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "allOf": [
+                                                {
+                                                    "$ref": "#/definitions/response.ListResult"
+                                                },
+                                                {
+                                                    "type": "object",
+                                                    "properties": {
+                                                        "list": {
+                                                            "type": "array",
+                                                            "items": {
+                                                                "$ref": "#/definitions/response.WebhookInfo"
+                                                            }
+                                                        }
+                                                    }
+                                                }
+                                            ]
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    }
+                }
+            }
+        },
         "/workflow/tool/action": {
             "get": {
                 "security": [
@@ -8468,6 +11760,12 @@ const docTemplate = `{
         "mp.AppModelParams": {
             "type": "object",
             "properties": {
+                "providerAnthropic": {
+                    "$ref": "#/definitions/mp.AppModelParamsAnthropic"
+                },
+                "providerGemini": {
+                    "$ref": "#/definitions/mp.AppModelParamsGemini"
+                },
                 "providerHuoshan": {
                     "$ref": "#/definitions/mp.AppModelParamsHuoshan"
                 },
@@ -8498,6 +11796,32 @@ const docTemplate = `{
                 }
             }
         },
+        "mp.AppModelParamsAnthropic": {
+            "type": "object",
+            "properties": {
+                "llm": {
+                    "description": "大语言模型配置",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/mp_anthropic.LLMParams"
+                        }
+                    ]
+                }
+            }
+        },
+        "mp.AppModelParamsGemini": {
+            "type": "object",
+            "properties": {
+                "llm": {
+                    "description": "大语言模型配置",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/mp_gemini.LLMParams"
+                        }
+                    ]
+                }
+            }
+        },
         "mp.AppModelParamsHuoshan": {
             "type": "object",
             "properties": {
@@ -8576,6 +11900,62 @@ const docTemplate = `{
                 }
             }
         },
+        "mp.CachePolicy": {
+            "type": "object",
+            "properties": {
+                "enable": {
+                    "description": "是否开启缓存（按模型ID+归一化请求精确匹配）",
+                    "type": "boolean"
+                },
+                "semanticEmbeddingModelId": {
+                    "description": "语义缓存使用的embedding模型ID",
+                    "type": "string"
+                },
+                "semanticEnable": {
+                    "description": "是否开启语义缓存，仅llm支持",
+                    "type": "boolean"
+                },
+                "semanticMaxEntries": {
+                    "description": "单个调用方在相同请求参数下语义缓存的最大条目数，0表示使用默认值50",
+                    "type": "integer"
+                },
+                "semanticThreshold": {
+                    "description": "语义缓存命中的余弦相似度阈值，(0, 1]，0表示使用默认值0.95",
+                    "type": "number"
+                },
+                "ttlSeconds": {
+                    "description": "缓存有效期（秒），0表示使用默认值3600",
+                    "type": "integer"
+                }
+            }
+        },
+        "mp.ProviderModelByAnthropic": {
+            "type": "object",
+            "properties": {
+                "llm": {
+                    "$ref": "#/definitions/mp_anthropic.LLM"
+                }
+            }
+        },
+        "mp.ProviderModelByGemini": {
+            "type": "object",
+            "properties": {
+                "embedding": {
+                    "$ref": "#/definitions/mp_gemini.Embedding"
+                },
+                "llm": {
+                    "$ref": "#/definitions/mp_gemini.LLM"
+                }
+            }
+        },
+        "mp.ProviderModelByHttp": {
+            "type": "object",
+            "properties": {
+                "pdf-parser": {
+                    "$ref": "#/definitions/mp_http.PdfParser"
+                }
+            }
+        },
         "mp.ProviderModelByHuoshan": {
             "type": "object",
             "properties": {
@@ -8587,109 +11967,361 @@ const docTemplate = `{
                 }
             }
         },
-        "mp.ProviderModelByInfini": {
+        "mp.ProviderModelByInfini": {
+            "type": "object",
+            "properties": {
+                "embedding": {
+                    "$ref": "#/definitions/mp_infini.Embedding"
+                },
+                "llm": {
+                    "$ref": "#/definitions/mp_infini.LLM"
+                },
+                "rerank": {
+                    "$ref": "#/definitions/mp_infini.Rerank"
+                }
+            }
+        },
+        "mp.ProviderModelByOllama": {
+            "type": "object",
+            "properties": {
+                "embedding": {
+                    "$ref": "#/definitions/mp_ollama.Embedding"
+                },
+                "llm": {
+                    "$ref": "#/definitions/mp_ollama.LLM"
+                }
+            }
+        },
+        "mp.ProviderModelByOpenAICompatible": {
+            "type": "object",
+            "properties": {
+                "embedding": {
+                    "$ref": "#/definitions/mp_openai_compatible.Embedding"
+                },
+                "llm": {
+                    "$ref": "#/definitions/mp_openai_compatible.LLM"
+                },
+                "ocr": {
+                    "$ref": "#/definitions/mp_openai_compatible.Ocr"
+                },
+                "rerank": {
+                    "$ref": "#/definitions/mp_openai_compatible.Rerank"
+                }
+            }
+        },
+        "mp.ProviderModelByQwen": {
+            "type": "object",
+            "properties": {
+                "embedding": {
+                    "$ref": "#/definitions/mp_qwen.Embedding"
+                },
+                "llm": {
+                    "$ref": "#/definitions/mp_qwen.LLM"
+                },
+                "rerank": {
+                    "$ref": "#/definitions/mp_qwen.Rerank"
+                }
+            }
+        },
+        "mp.ProviderModelByYuanjing": {
+            "type": "object",
+            "properties": {
+                "embedding": {
+                    "$ref": "#/definitions/mp_yuanjing.Embedding"
+                },
+                "gui": {
+                    "$ref": "#/definitions/mp_yuanjing.Gui"
+                },
+                "llm": {
+                    "$ref": "#/definitions/mp_yuanjing.LLM"
+                },
+                "ocr": {
+                    "$ref": "#/definitions/mp_yuanjing.Ocr"
+                },
+                "pdf-parser": {
+                    "$ref": "#/definitions/mp_yuanjing.PdfParser"
+                },
+                "rerank": {
+                    "$ref": "#/definitions/mp_yuanjing.Rerank"
+                }
+            }
+        },
+        "mp.ProviderModelConfig": {
+            "type": "object",
+            "properties": {
+                "providerAnthropic": {
+                    "$ref": "#/definitions/mp.ProviderModelByAnthropic"
+                },
+                "providerGemini": {
+                    "$ref": "#/definitions/mp.ProviderModelByGemini"
+                },
+                "providerHttp": {
+                    "$ref": "#/definitions/mp.ProviderModelByHttp"
+                },
+                "providerHuoshan": {
+                    "$ref": "#/definitions/mp.ProviderModelByHuoshan"
+                },
+                "providerModelByInfini": {
+                    "$ref": "#/definitions/mp.ProviderModelByInfini"
+                },
+                "providerOllama": {
+                    "$ref": "#/definitions/mp.ProviderModelByOllama"
+                },
+                "providerOpenAICompatible": {
+                    "$ref": "#/definitions/mp.ProviderModelByOpenAICompatible"
+                },
+                "providerQwen": {
+                    "$ref": "#/definitions/mp.ProviderModelByQwen"
+                },
+                "providerYuanJing": {
+                    "$ref": "#/definitions/mp.ProviderModelByYuanjing"
+                }
+            }
+        },
+        "mp.RoutingPolicy": {
+            "type": "object",
+            "properties": {
+                "backoffMs": {
+                    "description": "重试退避基准时长（毫秒），每次重试翻倍",
+                    "type": "integer"
+                },
+                "fallbackModelIds": {
+                    "description": "备选模型ID，按顺序回退",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "maxRetries": {
+                    "description": "单个模型的最大重试次数（不含首次请求）",
+                    "type": "integer"
+                },
+                "retryableStatusCodes": {
+                    "description": "可重试的http状态码，为空时使用默认值[408,429,500,502,503,504]",
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    }
+                },
+                "timeoutSeconds": {
+                    "description": "单次请求超时（秒），流式请求为首包超时；0表示不超时",
+                    "type": "integer"
+                }
+            }
+        },
+        "mp_anthropic.LLM": {
+            "type": "object",
+            "properties": {
+                "apiKey": {
+                    "description": "ApiKey",
+                    "type": "string"
+                },
+                "contextSize": {
+                    "description": "上下文长度",
+                    "type": "integer"
+                },
+                "endpointUrl": {
+                    "description": "推理url，例如 https://api.anthropic.com/v1",
+                    "type": "string"
+                },
+                "functionCalling": {
+                    "description": "函数调用是否支持",
+                    "type": "string",
+                    "enum": [
+                        "noSupport",
+                        "toolCall"
+                    ]
+                },
+                "maxTokens": {
+                    "description": "模型回答最大tokens",
+                    "type": "integer"
+                },
+                "visionSupport": {
+                    "description": "视觉支持",
+                    "type": "string",
+                    "enum": [
+                        "noSupport",
+                        "support"
+                    ]
+                }
+            }
+        },
+        "mp_anthropic.LLMParams": {
             "type": "object",
             "properties": {
-                "embedding": {
-                    "$ref": "#/definitions/mp_infini.Embedding"
+                "maxTokens": {
+                    "description": "最大标记",
+                    "type": "integer"
                 },
-                "llm": {
-                    "$ref": "#/definitions/mp_infini.LLM"
+                "maxTokensEnable": {
+                    "description": "最大标记(开关)",
+                    "type": "boolean"
                 },
-                "rerank": {
-                    "$ref": "#/definitions/mp_infini.Rerank"
+                "temperature": {
+                    "description": "温度",
+                    "type": "number"
+                },
+                "temperatureEnable": {
+                    "description": "温度(开关)",
+                    "type": "boolean"
+                },
+                "topK": {
+                    "description": "Top K",
+                    "type": "integer"
+                },
+                "topKEnable": {
+                    "description": "Top K(开关)",
+                    "type": "boolean"
+                },
+                "topP": {
+                    "description": "Top P",
+                    "type": "number"
+                },
+                "topPEnable": {
+                    "description": "Top P(开关)",
+                    "type": "boolean"
                 }
             }
         },
-        "mp.ProviderModelByOllama": {
+        "mp_common.Tag": {
             "type": "object",
             "properties": {
-                "embedding": {
-                    "$ref": "#/definitions/mp_ollama.Embedding"
-                },
-                "llm": {
-                    "$ref": "#/definitions/mp_ollama.LLM"
+                "text": {
+                    "type": "string"
                 }
             }
         },
-        "mp.ProviderModelByOpenAICompatible": {
+        "mp_gemini.Embedding": {
             "type": "object",
             "properties": {
-                "embedding": {
-                    "$ref": "#/definitions/mp_openai_compatible.Embedding"
+                "apiKey": {
+                    "description": "ApiKey",
+                    "type": "string"
                 },
-                "llm": {
-                    "$ref": "#/definitions/mp_openai_compatible.LLM"
+                "contextSize": {
+                    "description": "上下文长度",
+                    "type": "integer"
                 },
-                "rerank": {
-                    "$ref": "#/definitions/mp_openai_compatible.Rerank"
+                "endpointUrl": {
+                    "description": "推理url，例如 https://generativelanguage.googleapis.com/v1beta",
+                    "type": "string"
                 }
             }
         },
-        "mp.ProviderModelByQwen": {
+        "mp_gemini.LLM": {
             "type": "object",
             "properties": {
-                "embedding": {
-                    "$ref": "#/definitions/mp_qwen.Embedding"
+                "apiKey": {
+                    "description": "ApiKey",
+                    "type": "string"
                 },
-                "llm": {
-                    "$ref": "#/definitions/mp_qwen.LLM"
+                "contextSize": {
+                    "description": "上下文长度",
+                    "type": "integer"
                 },
-                "rerank": {
-                    "$ref": "#/definitions/mp_qwen.Rerank"
+                "endpointUrl": {
+                    "description": "推理url，例如 https://generativelanguage.googleapis.com/v1beta",
+                    "type": "string"
+                },
+                "functionCalling": {
+                    "description": "函数调用是否支持",
+                    "type": "string",
+                    "enum": [
+                        "noSupport",
+                        "toolCall"
+                    ]
+                },
+                "maxTokens": {
+                    "description": "模型回答最大tokens",
+                    "type": "integer"
+                },
+                "visionSupport": {
+                    "description": "视觉支持",
+                    "type": "string",
+                    "enum": [
+                        "noSupport",
+                        "support"
+                    ]
                 }
             }
         },
-        "mp.ProviderModelByYuanjing": {
+        "mp_gemini.LLMParams": {
             "type": "object",
             "properties": {
-                "embedding": {
-                    "$ref": "#/definitions/mp_yuanjing.Embedding"
+                "maxTokens": {
+                    "description": "最大标记",
+                    "type": "integer"
                 },
-                "gui": {
-                    "$ref": "#/definitions/mp_yuanjing.Gui"
+                "maxTokensEnable": {
+                    "description": "最大标记(开关)",
+                    "type": "boolean"
                 },
-                "llm": {
-                    "$ref": "#/definitions/mp_yuanjing.LLM"
+                "temperature": {
+                    "description": "温度",
+                    "type": "number"
                 },
-                "ocr": {
-                    "$ref": "#/definitions/mp_yuanjing.Ocr"
+                "temperatureEnable": {
+                    "description": "温度(开关)",
+                    "type": "boolean"
                 },
-                "pdf-parser": {
-                    "$ref": "#/definitions/mp_yuanjing.PdfParser"
+                "topK": {
+                    "description": "Top K",
+                    "type": "integer"
                 },
-                "rerank": {
-                    "$ref": "#/definitions/mp_yuanjing.Rerank"
+                "topKEnable": {
+                    "description": "Top K(开关)",
+                    "type": "boolean"
+                },
+                "topP": {
+                    "description": "Top P",
+                    "type": "number"
+                },
+                "topPEnable": {
+                    "description": "Top P(开关)",
+                    "type": "boolean"
                 }
             }
         },
-        "mp.ProviderModelConfig": {
+        "mp_http.PdfParser": {
             "type": "object",
             "properties": {
-                "providerHuoshan": {
-                    "$ref": "#/definitions/mp.ProviderModelByHuoshan"
+                "apiKey": {
+                    "description": "ApiKey，非空时以 Authorization: Bearer 传递",
+                    "type": "string"
                 },
-                "providerModelByInfini": {
-                    "$ref": "#/definitions/mp.ProviderModelByInfini"
+                "contentPath": {
+                    "description": "响应中解析结果的字段路径，以.分隔，*表示遍历数组，例如 data.pages.*.markdown；默认content",
+                    "type": "string"
                 },
-                "providerOllama": {
-                    "$ref": "#/definitions/mp.ProviderModelByOllama"
+                "endpointUrl": {
+                    "description": "解析接口完整url",
+                    "type": "string"
                 },
-                "providerOpenAICompatible": {
-                    "$ref": "#/definitions/mp.ProviderModelByOpenAICompatible"
+                "fileField": {
+                    "description": "请求中文件的表单字段名，默认file",
+                    "type": "string"
                 },
-                "providerQwen": {
-                    "$ref": "#/definitions/mp.ProviderModelByQwen"
+                "fileNameField": {
+                    "description": "请求中文件名的表单字段名，默认file_name",
+                    "type": "string"
                 },
-                "providerYuanJing": {
-                    "$ref": "#/definitions/mp.ProviderModelByYuanjing"
-                }
-            }
-        },
-        "mp_common.Tag": {
-            "type": "object",
-            "properties": {
-                "text": {
+                "formFields": {
+                    "description": "请求中附加的固定表单字段",
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "string"
+                    }
+                },
+                "messagePath": {
+                    "description": "响应中错误信息的字段路径",
+                    "type": "string"
+                },
+                "successPath": {
+                    "description": "响应中成功标识的字段路径，为空时仅以http状态码判断",
+                    "type": "string"
+                },
+                "successValue": {
+                    "description": "成功标识的值，例如 0",
                     "type": "string"
                 }
             }
@@ -9107,6 +12739,27 @@ const docTemplate = `{
                 }
             }
         },
+        "mp_openai_compatible.Ocr": {
+            "type": "object",
+            "properties": {
+                "apiKey": {
+                    "description": "ApiKey",
+                    "type": "string"
+                },
+                "endpointUrl": {
+                    "description": "推理url，多模态大模型的OpenAI兼容接口",
+                    "type": "string"
+                },
+                "maxTokens": {
+                    "description": "模型回答最大tokens",
+                    "type": "integer"
+                },
+                "prompt": {
+                    "description": "OCR提示词，为空时使用默认提示词",
+                    "type": "string"
+                }
+            }
+        },
         "mp_openai_compatible.Rerank": {
             "type": "object",
             "properties": {
@@ -9548,34 +13201,129 @@ const docTemplate = `{
                 "knowledgeId": {
                     "type": "string"
                 },
-                "urlList": {
-                    "type": "array",
-                    "items": {
-                        "type": "string"
-                    }
+                "urlList": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "request.AppBriefConfig": {
+            "type": "object",
+            "required": [
+                "name"
+            ],
+            "properties": {
+                "avatar": {
+                    "description": "图标",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/request.Avatar"
+                        }
+                    ]
+                },
+                "desc": {
+                    "description": "描述",
+                    "type": "string"
+                },
+                "name": {
+                    "description": "名称",
+                    "type": "string"
+                }
+            }
+        },
+        "request.AppEvalDatasetCreateRequest": {
+            "type": "object",
+            "required": [
+                "name",
+                "questions"
+            ],
+            "properties": {
+                "description": {
+                    "description": "问题集描述",
+                    "type": "string"
+                },
+                "name": {
+                    "description": "问题集名称",
+                    "type": "string"
+                },
+                "questions": {
+                    "type": "array",
+                    "minItems": 1,
+                    "items": {
+                        "$ref": "#/definitions/request.AppEvalQuestion"
+                    }
+                }
+            }
+        },
+        "request.AppEvalDatasetIdRequest": {
+            "type": "object",
+            "required": [
+                "datasetId"
+            ],
+            "properties": {
+                "datasetId": {
+                    "description": "问题集id",
+                    "type": "string"
+                }
+            }
+        },
+        "request.AppEvalJobCreateRequest": {
+            "type": "object",
+            "required": [
+                "appId",
+                "appType",
+                "datasetId",
+                "judgeModelId"
+            ],
+            "properties": {
+                "appId": {
+                    "description": "应用id",
+                    "type": "string"
+                },
+                "appType": {
+                    "description": "应用类型：rag 或 agent",
+                    "type": "string",
+                    "enum": [
+                        "rag",
+                        "agent"
+                    ]
+                },
+                "baselineJobId": {
+                    "description": "基线评测任务id，为空时不与基线对比",
+                    "type": "string"
+                },
+                "datasetId": {
+                    "description": "问题集id",
+                    "type": "string"
+                },
+                "judgeModelId": {
+                    "description": "评分模型id",
+                    "type": "string"
+                },
+                "minScore": {
+                    "description": "各项平均分的最低要求，为0时不校验",
+                    "type": "number",
+                    "maximum": 5,
+                    "minimum": 0
+                },
+                "tolerance": {
+                    "description": "相对基线允许下降的分值",
+                    "type": "number",
+                    "minimum": 0
                 }
             }
         },
-        "request.AppBriefConfig": {
+        "request.AppEvalQuestion": {
             "type": "object",
-            "required": [
-                "name"
-            ],
             "properties": {
-                "avatar": {
-                    "description": "图标",
-                    "allOf": [
-                        {
-                            "$ref": "#/definitions/request.Avatar"
-                        }
-                    ]
-                },
-                "desc": {
-                    "description": "描述",
+                "question": {
+                    "description": "问题",
                     "type": "string"
                 },
-                "name": {
-                    "description": "名称",
+                "referenceAnswer": {
+                    "description": "参考答案，用于评估回答正确性",
                     "type": "string"
                 }
             }
@@ -9882,6 +13630,14 @@ const docTemplate = `{
                         }
                     ]
                 },
+                "memoryConfig": {
+                    "description": "对话记忆",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/request.AssistantMemoryConfig"
+                        }
+                    ]
+                },
                 "modelConfig": {
                     "description": "模型",
                     "allOf": [
@@ -10023,6 +13779,23 @@ const docTemplate = `{
                 }
             }
         },
+        "request.AssistantMemoryConfig": {
+            "type": "object",
+            "properties": {
+                "maxTokens": {
+                    "description": "历史对话的token预算，0表示不限制",
+                    "type": "integer"
+                },
+                "maxTurns": {
+                    "description": "保留最近的对话轮数，0表示不限制",
+                    "type": "integer"
+                },
+                "summaryEnable": {
+                    "description": "是否将窗口外的历史对话滚动总结为摘要（使用智能体的模型）",
+                    "type": "boolean"
+                }
+            }
+        },
         "request.AssistantTemplateRequest": {
             "type": "object",
             "required": [
@@ -10107,7 +13880,11 @@ const docTemplate = `{
                     "type": "string"
                 },
                 "fileUploadId": {
-                    "description": "fileUploadId",
+                    "description": "fileUploadId，文件格式：csv、jsonl、xlsx",
+                    "type": "string"
+                },
+                "mode": {
+                    "description": "导入方式：create（默认，全部新增）、upsert（按分段id更新，分段id为空时新增）",
                     "type": "string"
                 }
             }
@@ -10163,143 +13940,370 @@ const docTemplate = `{
                     "description": "应用id",
                     "type": "string"
                 },
-                "appType": {
-                    "description": "应用类型",
+                "appType": {
+                    "description": "应用类型",
+                    "type": "string"
+                },
+                "isFavorite": {
+                    "description": "是否收藏",
+                    "type": "boolean"
+                }
+            }
+        },
+        "request.ChangeUserPasswordByEmail": {
+            "type": "object",
+            "required": [
+                "code",
+                "email",
+                "newPassword",
+                "oldPassword"
+            ],
+            "properties": {
+                "code": {
+                    "description": "邮箱验证码",
+                    "type": "string"
+                },
+                "email": {
+                    "description": "邮箱",
+                    "type": "string"
+                },
+                "newPassword": {
+                    "type": "string"
+                },
+                "oldPassword": {
+                    "type": "string"
+                }
+            }
+        },
+        "request.ChatRagRequest": {
+            "type": "object",
+            "required": [
+                "question",
+                "ragId"
+            ],
+            "properties": {
+                "history": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/request.History"
+                    }
+                },
+                "question": {
+                    "type": "string"
+                },
+                "ragId": {
+                    "type": "string"
+                }
+            }
+        },
+        "request.CheckFileListReq": {
+            "type": "object",
+            "required": [
+                "chunkName"
+            ],
+            "properties": {
+                "chunkName": {
+                    "description": "上传批次标识",
+                    "type": "string"
+                }
+            }
+        },
+        "request.CheckFileReq": {
+            "type": "object",
+            "required": [
+                "chunkName",
+                "fileName"
+            ],
+            "properties": {
+                "chunkName": {
+                    "description": "上传批次标识",
+                    "type": "string"
+                },
+                "fileName": {
+                    "description": "原始文件名",
+                    "type": "string"
+                },
+                "sequence": {
+                    "description": "分片文件序号",
+                    "type": "integer"
+                }
+            }
+        },
+        "request.ChildChunk": {
+            "type": "object",
+            "properties": {
+                "chunkNo": {
+                    "description": "子分段序列号",
+                    "type": "integer"
+                },
+                "content": {
+                    "description": "子分段内容",
+                    "type": "string"
+                }
+            }
+        },
+        "request.CleanFileReq": {
+            "type": "object",
+            "required": [
+                "chunkName"
+            ],
+            "properties": {
+                "chunkName": {
+                    "description": "上传批次标识",
+                    "type": "string"
+                }
+            }
+        },
+        "request.ConnectorConfig": {
+            "type": "object",
+            "properties": {
+                "branch": {
+                    "description": "git：分支，为空使用默认分支",
+                    "type": "string"
+                },
+                "bucket": {
+                    "description": "s3：桶名称",
+                    "type": "string"
+                },
+                "endpoint": {
+                    "description": "s3：服务地址（host:port）；webdav：服务地址（http(s)://host:port/path）",
+                    "type": "string"
+                },
+                "pathFilter": {
+                    "description": "路径过滤规则（glob，如 *.pdf、docs/**），为空导入全部支持的文件",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "repoUrl": {
+                    "description": "git：仓库地址，仅支持http(s)",
+                    "type": "string"
+                },
+                "rootPath": {
+                    "description": "s3：对象前缀；git、webdav：目录；local：服务端挂载目录",
+                    "type": "string"
+                },
+                "useSsl": {
+                    "description": "s3：是否使用https",
+                    "type": "boolean"
+                }
+            }
+        },
+        "request.ConnectorCredential": {
+            "type": "object",
+            "properties": {
+                "accessKey": {
+                    "description": "s3",
+                    "type": "string"
+                },
+                "password": {
+                    "description": "git（可填token）、webdav",
+                    "type": "string"
+                },
+                "secretKey": {
+                    "description": "s3",
+                    "type": "string"
+                },
+                "username": {
+                    "description": "git、webdav",
+                    "type": "string"
+                }
+            }
+        },
+        "request.ConnectorIdReq": {
+            "type": "object",
+            "required": [
+                "connectorId"
+            ],
+            "properties": {
+                "connectorId": {
+                    "description": "连接器id",
+                    "type": "string"
+                }
+            }
+        },
+        "request.ConnectorSaveReq": {
+            "type": "object",
+            "required": [
+                "config",
+                "connectorType",
+                "docAnalyzer",
+                "docSegment",
+                "knowledgeId",
+                "name"
+            ],
+            "properties": {
+                "config": {
+                    "description": "连接器配置",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/request.ConnectorConfig"
+                        }
+                    ]
+                },
+                "connectorId": {
+                    "description": "连接器id，为空时创建",
+                    "type": "string"
+                },
+                "connectorType": {
+                    "description": "连接器类型：s3、git、webdav、local",
+                    "type": "string"
+                },
+                "credential": {
+                    "description": "连接器凭证，加密存储；更新时为空则保留原凭证",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/request.ConnectorCredential"
+                        }
+                    ]
+                },
+                "docAnalyzer": {
+                    "description": "文档解析类型 text / ocr  / model",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "docPreprocess": {
+                    "description": "文本预处理规则 replaceSymbols / deleteLinks",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "docSegment": {
+                    "description": "文档分段配置",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/request.DocSegment"
+                        }
+                    ]
+                },
+                "knowledgeId": {
+                    "description": "知识库id",
+                    "type": "string"
+                },
+                "name": {
+                    "description": "连接器名称",
                     "type": "string"
                 },
-                "isFavorite": {
-                    "description": "是否收藏",
-                    "type": "boolean"
+                "parserModelId": {
+                    "description": "模型解析或ocr模型id",
+                    "type": "string"
                 }
             }
         },
-        "request.ChangeUserPasswordByEmail": {
+        "request.ConversationCreateRequest": {
             "type": "object",
             "required": [
-                "code",
-                "email",
-                "newPassword",
-                "oldPassword"
+                "assistantId",
+                "prompt"
             ],
             "properties": {
-                "code": {
-                    "description": "邮箱验证码",
-                    "type": "string"
-                },
-                "email": {
-                    "description": "邮箱",
-                    "type": "string"
-                },
-                "newPassword": {
+                "assistantId": {
                     "type": "string"
                 },
-                "oldPassword": {
+                "prompt": {
                     "type": "string"
                 }
             }
         },
-        "request.ChatRagRequest": {
+        "request.ConversationDetailRequest": {
             "type": "object",
             "required": [
-                "question",
-                "ragId"
+                "conversationId",
+                "detailId"
             ],
             "properties": {
-                "history": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/request.History"
-                    }
-                },
-                "question": {
+                "conversationId": {
                     "type": "string"
                 },
-                "ragId": {
+                "detailId": {
                     "type": "string"
                 }
             }
         },
-        "request.CheckFileListReq": {
+        "request.ConversationEditRequest": {
             "type": "object",
             "required": [
-                "chunkName"
+                "assistantId",
+                "conversationId",
+                "detailId",
+                "prompt"
             ],
             "properties": {
-                "chunkName": {
-                    "description": "上传批次标识",
+                "assistantId": {
+                    "type": "string"
+                },
+                "conversationId": {
+                    "type": "string"
+                },
+                "detailId": {
+                    "type": "string"
+                },
+                "prompt": {
                     "type": "string"
                 }
             }
         },
-        "request.CheckFileReq": {
+        "request.ConversationFeedbackRequest": {
             "type": "object",
             "required": [
-                "chunkName",
-                "fileName"
+                "conversationId",
+                "detailId"
             ],
             "properties": {
-                "chunkName": {
-                    "description": "上传批次标识",
+                "content": {
+                    "description": "评价内容",
                     "type": "string"
                 },
-                "fileName": {
-                    "description": "原始文件名",
+                "conversationId": {
                     "type": "string"
                 },
-                "sequence": {
-                    "description": "分片文件序号",
-                    "type": "integer"
-                }
-            }
-        },
-        "request.ChildChunk": {
-            "type": "object",
-            "properties": {
-                "chunkNo": {
-                    "description": "子分段序列号",
+                "detailId": {
+                    "type": "string"
+                },
+                "rating": {
+                    "description": "1:点赞 -1:点踩 0:取消评价",
                     "type": "integer"
                 },
-                "content": {
-                    "description": "子分段内容",
-                    "type": "string"
+                "reasons": {
+                    "description": "原因标签",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
                 }
             }
         },
-        "request.CleanFileReq": {
+        "request.ConversationIdRequest": {
             "type": "object",
             "required": [
-                "chunkName"
+                "conversationId"
             ],
             "properties": {
-                "chunkName": {
-                    "description": "上传批次标识",
+                "conversationId": {
                     "type": "string"
                 }
             }
         },
-        "request.ConversationCreateRequest": {
+        "request.ConversationRegenerateRequest": {
             "type": "object",
             "required": [
                 "assistantId",
-                "prompt"
+                "conversationId",
+                "detailId"
             ],
             "properties": {
                 "assistantId": {
                     "type": "string"
                 },
-                "prompt": {
-                    "type": "string"
-                }
-            }
-        },
-        "request.ConversationIdRequest": {
-            "type": "object",
-            "required": [
-                "conversationId"
-            ],
-            "properties": {
                 "conversationId": {
                     "type": "string"
+                },
+                "detailId": {
+                    "type": "string"
                 }
             }
         },
@@ -10413,6 +14417,52 @@ const docTemplate = `{
                 }
             }
         },
+        "request.CreateKnowledgeEvalDatasetReq": {
+            "type": "object",
+            "required": [
+                "knowledgeId",
+                "name",
+                "questionList"
+            ],
+            "properties": {
+                "description": {
+                    "type": "string"
+                },
+                "knowledgeId": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "questionList": {
+                    "type": "array",
+                    "minItems": 1,
+                    "items": {
+                        "$ref": "#/definitions/request.KnowledgeEvalQuestion"
+                    }
+                }
+            }
+        },
+        "request.CreateKnowledgeEvalRunReq": {
+            "type": "object",
+            "required": [
+                "datasetId",
+                "knowledgeMatchParams"
+            ],
+            "properties": {
+                "datasetId": {
+                    "type": "string"
+                },
+                "knowledgeMatchParams": {
+                    "description": "检索配置",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/request.KnowledgeMatchParams"
+                        }
+                    ]
+                }
+            }
+        },
         "request.CreateKnowledgeReq": {
             "type": "object",
             "required": [
@@ -10473,6 +14523,43 @@ const docTemplate = `{
                 }
             }
         },
+        "request.CreateWebhookRequest": {
+            "type": "object",
+            "required": [
+                "events",
+                "name",
+                "url"
+            ],
+            "properties": {
+                "enable": {
+                    "description": "是否启用",
+                    "type": "boolean"
+                },
+                "events": {
+                    "description": "订阅的事件，支持具体事件、* 及 knowledge.* 形式的前缀",
+                    "type": "array",
+                    "minItems": 1,
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "name": {
+                    "description": "名称",
+                    "type": "string",
+                    "maxLength": 64
+                },
+                "secret": {
+                    "description": "签名密钥；创建时为空则自动生成，编辑时为空表示不修改",
+                    "type": "string",
+                    "minLength": 16
+                },
+                "url": {
+                    "description": "接收地址，仅支持http/https及公网地址（或operate-service配置的白名单地址）",
+                    "type": "string",
+                    "maxLength": 1024
+                }
+            }
+        },
         "request.CustomHomeConfig": {
             "type": "object",
             "properties": {
@@ -10815,6 +14902,15 @@ const docTemplate = `{
                 }
             }
         },
+        "request.DeleteModelQuotaRequest": {
+            "type": "object",
+            "properties": {
+                "userId": {
+                    "description": "用户ID，为空表示组织配额",
+                    "type": "string"
+                }
+            }
+        },
         "request.DeleteModelRequest": {
             "type": "object",
             "required": [
@@ -11044,67 +15140,149 @@ const docTemplate = `{
                     "description": "分段方法 0：通用分段；1：父子分段",
                     "type": "string"
                 },
-                "segmentType": {
-                    "description": "分段方式，只有通用分段必填 0：自动分段；1：自定义分段",
-                    "type": "string"
+                "segmentType": {
+                    "description": "分段方式，只有通用分段必填 0：自动分段；1：自定义分段",
+                    "type": "string"
+                },
+                "splitter": {
+                    "description": "分隔符（只有自定义分段必填）",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "subMaxSplitter": {
+                    "description": "可分隔最大值（只有父子分段必填）",
+                    "type": "integer"
+                },
+                "subSplitter": {
+                    "description": "分隔符（只有父子分段必填）",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "request.DocSegmentLabelsReq": {
+            "type": "object",
+            "required": [
+                "contentId",
+                "docId",
+                "labels"
+            ],
+            "properties": {
+                "contentId": {
+                    "type": "string"
+                },
+                "docId": {
+                    "type": "string"
+                },
+                "labels": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "request.DocSegmentListReq": {
+            "type": "object",
+            "required": [
+                "docId",
+                "pageSize"
+            ],
+            "properties": {
+                "docId": {
+                    "type": "string"
+                },
+                "pageNo": {
+                    "type": "integer"
+                },
+                "pageSize": {
+                    "type": "integer"
+                }
+            }
+        },
+        "request.DocSyncIdReq": {
+            "type": "object",
+            "required": [
+                "syncId"
+            ],
+            "properties": {
+                "syncId": {
+                    "description": "同步配置id",
+                    "type": "string"
+                }
+            }
+        },
+        "request.DocSyncSaveReq": {
+            "type": "object",
+            "required": [
+                "knowledgeId"
+            ],
+            "properties": {
+                "cronExpr": {
+                    "description": "cron表达式（分 时 日 月 周），与intervalMinutes二选一",
+                    "type": "string"
+                },
+                "docId": {
+                    "description": "url文档id，与importTaskId二选一",
+                    "type": "string"
+                },
+                "enable": {
+                    "description": "是否启用",
+                    "type": "boolean"
                 },
-                "splitter": {
-                    "description": "分隔符（只有自定义分段必填）",
-                    "type": "array",
-                    "items": {
-                        "type": "string"
-                    }
+                "importTaskId": {
+                    "description": "url导入任务id，同步该任务导入的所有url文档，与docId二选一",
+                    "type": "string"
                 },
-                "subMaxSplitter": {
-                    "description": "可分隔最大值（只有父子分段必填）",
+                "intervalMinutes": {
+                    "description": "同步间隔（分钟），不小于10",
                     "type": "integer"
                 },
-                "subSplitter": {
-                    "description": "分隔符（只有父子分段必填）",
-                    "type": "array",
-                    "items": {
-                        "type": "string"
-                    }
+                "knowledgeId": {
+                    "description": "知识库id",
+                    "type": "string"
                 }
             }
         },
-        "request.DocSegmentLabelsReq": {
+        "request.DocVersionRollbackReq": {
             "type": "object",
             "required": [
-                "contentId",
                 "docId",
-                "labels"
+                "versionId"
             ],
             "properties": {
-                "contentId": {
-                    "type": "string"
-                },
                 "docId": {
+                    "description": "文档id",
                     "type": "string"
                 },
-                "labels": {
-                    "type": "array",
-                    "items": {
-                        "type": "string"
-                    }
+                "versionId": {
+                    "description": "回滚的历史版本id",
+                    "type": "string"
                 }
             }
         },
-        "request.DocSegmentListReq": {
+        "request.DocVersionUploadReq": {
             "type": "object",
             "required": [
                 "docId",
-                "pageSize"
+                "docInfo"
             ],
             "properties": {
                 "docId": {
+                    "description": "文档id",
                     "type": "string"
                 },
-                "pageNo": {
-                    "type": "integer"
-                },
-                "pageSize": {
-                    "type": "integer"
+                "docInfo": {
+                    "description": "新版本文件，docId为上传文件id，不支持压缩包",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/request.DocInfo"
+                        }
+                    ]
                 }
             }
         },
@@ -11126,6 +15304,20 @@ const docTemplate = `{
                 "appType"
             ],
             "properties": {
+                "allowedIps": {
+                    "description": "允许的客户端IP或CIDR；为空表示不限制",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "allowedOrigins": {
+                    "description": "允许的请求来源，如 https://example.com；为空表示不限制，配置后未携带Origin请求头的调用将被拒绝",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
                 "appId": {
                     "description": "应用id",
                     "type": "string"
@@ -11133,6 +15325,18 @@ const docTemplate = `{
                 "appType": {
                     "description": "应用类型",
                     "type": "string"
+                },
+                "expireDays": {
+                    "description": "有效天数，0表示永不过期",
+                    "type": "integer",
+                    "minimum": 0
+                },
+                "scopes": {
+                    "description": "授权范围：chat 对话问答/工作流运行，conversation 会话管理，feedback 回答评价；为空表示不限制",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
                 }
             }
         },
@@ -11174,6 +15378,109 @@ const docTemplate = `{
                 }
             }
         },
+        "request.GuardrailClassifier": {
+            "type": "object",
+            "properties": {
+                "enable": {
+                    "description": "是否启用",
+                    "type": "boolean"
+                },
+                "modelId": {
+                    "description": "分类模型id（已导入的大模型）",
+                    "type": "string"
+                },
+                "prompt": {
+                    "description": "分类提示词，为空时使用默认提示词",
+                    "type": "string"
+                }
+            }
+        },
+        "request.GuardrailDetector": {
+            "type": "object",
+            "properties": {
+                "action": {
+                    "description": "处理动作：mask 部分打码、redact 替换为占位符、block 拦截",
+                    "type": "string"
+                },
+                "name": {
+                    "description": "规则名称，redact时作为自定义正则的占位符",
+                    "type": "string"
+                },
+                "pattern": {
+                    "description": "正则表达式，仅regex类型",
+                    "type": "string"
+                },
+                "type": {
+                    "description": "检测器类型：phone 手机号、id_card 身份证号、email 邮箱、bank_card 银行卡号、regex 自定义正则",
+                    "type": "string"
+                }
+            }
+        },
+        "request.GuardrailPolicy": {
+            "type": "object",
+            "required": [
+                "appId",
+                "appType"
+            ],
+            "properties": {
+                "appId": {
+                    "description": "应用id",
+                    "type": "string"
+                },
+                "appType": {
+                    "description": "应用类型：rag 或 agent",
+                    "type": "string",
+                    "enum": [
+                        "rag",
+                        "agent"
+                    ]
+                },
+                "blockReply": {
+                    "description": "拦截时的回复，为空时使用默认回复",
+                    "type": "string"
+                },
+                "enable": {
+                    "description": "是否启用",
+                    "type": "boolean"
+                },
+                "input": {
+                    "description": "输入阶段，问题发送给模型前检测",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/request.GuardrailStage"
+                        }
+                    ]
+                },
+                "output": {
+                    "description": "输出阶段，对流式回答检测",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/request.GuardrailStage"
+                        }
+                    ]
+                }
+            }
+        },
+        "request.GuardrailStage": {
+            "type": "object",
+            "properties": {
+                "classifier": {
+                    "description": "分类模型检测",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/request.GuardrailClassifier"
+                        }
+                    ]
+                },
+                "detectors": {
+                    "description": "规则检测，按顺序执行",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/request.GuardrailDetector"
+                    }
+                }
+            }
+        },
         "request.History": {
             "type": "object",
             "properties": {
@@ -11205,6 +15512,14 @@ const docTemplate = `{
                         }
                     ]
                 },
+                "cachePolicy": {
+                    "description": "模型响应缓存策略，仅llm、embedding支持；语义缓存仅llm支持",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/mp.CachePolicy"
+                        }
+                    ]
+                },
                 "config": {},
                 "displayName": {
                     "description": "模型显示名称",
@@ -11249,6 +15564,85 @@ const docTemplate = `{
                 "publishDate": {
                     "description": "模型发布时间",
                     "type": "string"
+                },
+                "routingPolicy": {
+                    "description": "模型路由策略，仅llm支持",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/mp.RoutingPolicy"
+                        }
+                    ]
+                }
+            }
+        },
+        "request.KnowledgeEvalDatasetReq": {
+            "type": "object",
+            "required": [
+                "datasetId"
+            ],
+            "properties": {
+                "datasetId": {
+                    "type": "string"
+                }
+            }
+        },
+        "request.KnowledgeEvalQuestion": {
+            "type": "object",
+            "properties": {
+                "expectedContentIdList": {
+                    "description": "期望命中的分段id，不为空时按分段计算指标，否则按文档计算",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "expectedDocIdList": {
+                    "description": "期望命中的文档id",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "question": {
+                    "description": "评测问题",
+                    "type": "string"
+                },
+                "referenceAnswer": {
+                    "description": "参考答案，可选",
+                    "type": "string"
+                }
+            }
+        },
+        "request.KnowledgeExportReq": {
+            "type": "object",
+            "required": [
+                "knowledgeId"
+            ],
+            "properties": {
+                "knowledgeId": {
+                    "type": "string"
+                }
+            }
+        },
+        "request.KnowledgeGrantee": {
+            "type": "object",
+            "required": [
+                "granteeId",
+                "granteeType",
+                "permissionType"
+            ],
+            "properties": {
+                "granteeId": {
+                    "description": "分享对象id",
+                    "type": "string"
+                },
+                "granteeType": {
+                    "description": "分享对象类型：user（用户）、role（角色）、org（组织）",
+                    "type": "string"
+                },
+                "permissionType": {
+                    "description": "权限：1.查看 2.编辑 3.管理",
+                    "type": "integer"
                 }
             }
         },
@@ -11273,6 +15667,34 @@ const docTemplate = `{
                 }
             }
         },
+        "request.KnowledgeImportReq": {
+            "type": "object",
+            "required": [
+                "fileUploadId"
+            ],
+            "properties": {
+                "description": {
+                    "description": "知识库描述，为空时使用导出文件中的描述",
+                    "type": "string"
+                },
+                "embeddingModelInfo": {
+                    "description": "embedding模型，为空时使用导出文件中的模型",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/request.EmbeddingModel"
+                        }
+                    ]
+                },
+                "fileUploadId": {
+                    "description": "知识库导出文件上传id",
+                    "type": "string"
+                },
+                "name": {
+                    "description": "知识库名称，为空时使用导出文件中的名称",
+                    "type": "string"
+                }
+            }
+        },
         "request.KnowledgeMatchParams": {
             "type": "object",
             "required": [
@@ -11331,6 +15753,46 @@ const docTemplate = `{
                 }
             }
         },
+        "request.KnowledgePermissionGrantReq": {
+            "type": "object",
+            "required": [
+                "granteeList",
+                "knowledgeId"
+            ],
+            "properties": {
+                "granteeList": {
+                    "description": "分享对象列表",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/request.KnowledgeGrantee"
+                    }
+                },
+                "knowledgeId": {
+                    "type": "string"
+                }
+            }
+        },
+        "request.KnowledgePermissionRevokeReq": {
+            "type": "object",
+            "required": [
+                "granteeId",
+                "granteeType",
+                "knowledgeId"
+            ],
+            "properties": {
+                "granteeId": {
+                    "description": "分享对象id",
+                    "type": "string"
+                },
+                "granteeType": {
+                    "description": "分享对象类型：user（用户）、role（角色）、org（组织）",
+                    "type": "string"
+                },
+                "knowledgeId": {
+                    "type": "string"
+                }
+            }
+        },
         "request.KnowledgeSelectReq": {
             "type": "object",
             "properties": {
@@ -11539,6 +16001,23 @@ const docTemplate = `{
                 }
             }
         },
+        "request.ModelQuotaRequest": {
+            "type": "object",
+            "properties": {
+                "dailyTokens": {
+                    "description": "每日token上限，0表示不限制",
+                    "type": "integer"
+                },
+                "monthlyTokens": {
+                    "description": "每月token上限，0表示不限制",
+                    "type": "integer"
+                },
+                "userId": {
+                    "description": "用户ID，为空表示组织配额",
+                    "type": "string"
+                }
+            }
+        },
         "request.ModelStatusRequest": {
             "type": "object",
             "required": [
@@ -11752,6 +16231,37 @@ const docTemplate = `{
                 }
             }
         },
+        "request.RagFeedbackRequest": {
+            "type": "object",
+            "required": [
+                "msgId",
+                "ragId"
+            ],
+            "properties": {
+                "content": {
+                    "description": "评价内容",
+                    "type": "string"
+                },
+                "msgId": {
+                    "description": "问答返回的msg_id",
+                    "type": "string"
+                },
+                "ragId": {
+                    "type": "string"
+                },
+                "rating": {
+                    "description": "1:点赞 -1:点踩 0:取消评价",
+                    "type": "integer"
+                },
+                "reasons": {
+                    "description": "原因标签",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
         "request.RagReq": {
             "type": "object",
             "required": [
@@ -11913,6 +16423,24 @@ const docTemplate = `{
                 }
             }
         },
+        "request.RotateApiKeyRequest": {
+            "type": "object",
+            "required": [
+                "apiId"
+            ],
+            "properties": {
+                "apiId": {
+                    "description": "ApiID",
+                    "type": "string"
+                },
+                "overlapHours": {
+                    "description": "原ApiKey继续可用的小时数，0表示立即失效",
+                    "type": "integer",
+                    "maximum": 720,
+                    "minimum": 0
+                }
+            }
+        },
         "request.SensitiveTable": {
             "type": "object",
             "required": [
@@ -12121,7 +16649,6 @@ const docTemplate = `{
         "request.UpdateMetaValueReq": {
             "type": "object",
             "required": [
-                "applyToSelected",
                 "docIdList"
             ],
             "properties": {
@@ -12179,6 +16706,48 @@ const docTemplate = `{
                 }
             }
         },
+        "request.UpdateWebhookRequest": {
+            "type": "object",
+            "required": [
+                "events",
+                "name",
+                "url",
+                "webhookId"
+            ],
+            "properties": {
+                "enable": {
+                    "description": "是否启用",
+                    "type": "boolean"
+                },
+                "events": {
+                    "description": "订阅的事件，支持具体事件、* 及 knowledge.* 形式的前缀",
+                    "type": "array",
+                    "minItems": 1,
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "name": {
+                    "description": "名称",
+                    "type": "string",
+                    "maxLength": 64
+                },
+                "secret": {
+                    "description": "签名密钥；创建时为空则自动生成，编辑时为空表示不修改",
+                    "type": "string",
+                    "minLength": 16
+                },
+                "url": {
+                    "description": "接收地址，仅支持http/https及公网地址（或operate-service配置的白名单地址）",
+                    "type": "string",
+                    "maxLength": 1024
+                },
+                "webhookId": {
+                    "description": "webhook ID",
+                    "type": "string"
+                }
+            }
+        },
         "request.UploadSensitiveVocabularyReq": {
             "type": "object",
             "required": [
@@ -12365,93 +16934,327 @@ const docTemplate = `{
                         "type": "string"
                     }
                 },
-                "userId": {
-                    "description": "用户ID",
-                    "type": "string"
-                }
-            }
-        },
-        "request.WorkflowIDReq": {
-            "type": "object",
-            "required": [
-                "workflow_id"
-            ],
-            "properties": {
-                "workflow_id": {
+                "userId": {
+                    "description": "用户ID",
+                    "type": "string"
+                }
+            }
+        },
+        "request.WebhookDeliveryIdRequest": {
+            "type": "object",
+            "required": [
+                "deliveryId"
+            ],
+            "properties": {
+                "deliveryId": {
+                    "description": "投递ID",
+                    "type": "string"
+                }
+            }
+        },
+        "request.WebhookIdRequest": {
+            "type": "object",
+            "required": [
+                "webhookId"
+            ],
+            "properties": {
+                "webhookId": {
+                    "description": "webhook ID",
+                    "type": "string"
+                }
+            }
+        },
+        "request.WorkflowIDReq": {
+            "type": "object",
+            "required": [
+                "workflow_id"
+            ],
+            "properties": {
+                "workflow_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "response.AnalysisDocUrlResp": {
+            "type": "object",
+            "properties": {
+                "urlList": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/response.Url"
+                    }
+                }
+            }
+        },
+        "response.ApiResponse": {
+            "type": "object",
+            "properties": {
+                "allowedIps": {
+                    "description": "允许的客户端IP或CIDR，为空表示不限制",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "allowedOrigins": {
+                    "description": "允许的请求来源，为空表示不限制",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "apiId": {
+                    "description": "ApiID",
+                    "type": "string"
+                },
+                "apiKey": {
+                    "description": "生成的ApiKey，仅在生成或轮换时返回完整值，列表中为脱敏值",
+                    "type": "string"
+                },
+                "createdAt": {
+                    "description": "创建ApiKey的时间",
+                    "type": "string"
+                },
+                "expiresAt": {
+                    "description": "过期时间，为空表示永不过期",
+                    "type": "string"
+                },
+                "keyPrefix": {
+                    "description": "ApiKey可见前缀",
+                    "type": "string"
+                },
+                "lastUsedAt": {
+                    "description": "最近使用时间",
+                    "type": "string"
+                },
+                "scopes": {
+                    "description": "授权范围，为空表示不限制",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "response.AppBriefInfo": {
+            "type": "object",
+            "properties": {
+                "appId": {
+                    "description": "应用id",
+                    "type": "string"
+                },
+                "appType": {
+                    "description": "应用类型",
+                    "type": "string"
+                },
+                "avatar": {
+                    "description": "应用图标",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/request.Avatar"
+                        }
+                    ]
+                },
+                "createdAt": {
+                    "description": "应用创建时间",
+                    "type": "string"
+                },
+                "desc": {
+                    "description": "应用描述",
+                    "type": "string"
+                },
+                "name": {
+                    "description": "应用名称",
+                    "type": "string"
+                },
+                "publishType": {
+                    "description": "发布类型(public:公开发布,private:私密发布)",
+                    "type": "string"
+                },
+                "uniqueId": {
+                    "description": "随机unique id(每次动态生成)",
+                    "type": "string"
+                },
+                "updatedAt": {
+                    "description": "应用更新时间(用于历史记录排序)",
+                    "type": "string"
+                }
+            }
+        },
+        "response.AppEvalDatasetInfo": {
+            "type": "object",
+            "properties": {
+                "createdAt": {
+                    "type": "string"
+                },
+                "datasetId": {
+                    "type": "string"
+                },
+                "description": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "questionCount": {
+                    "type": "integer"
+                },
+                "questions": {
+                    "description": "仅详情返回",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/response.AppEvalQuestion"
+                    }
+                }
+            }
+        },
+        "response.AppEvalJobDetail": {
+            "type": "object",
+            "properties": {
+                "job": {
+                    "$ref": "#/definitions/response.AppEvalJobInfo"
+                },
+                "results": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/response.AppEvalResult"
+                    }
+                }
+            }
+        },
+        "response.AppEvalJobInfo": {
+            "type": "object",
+            "properties": {
+                "appId": {
+                    "type": "string"
+                },
+                "appType": {
+                    "type": "string"
+                },
+                "baselineJobId": {
+                    "type": "string"
+                },
+                "createdAt": {
+                    "type": "string"
+                },
+                "datasetId": {
+                    "type": "string"
+                },
+                "errorCount": {
+                    "description": "回答或评分失败的问题数",
+                    "type": "integer"
+                },
+                "errorMsg": {
+                    "type": "string"
+                },
+                "finishCount": {
+                    "description": "已评测问题数",
+                    "type": "integer"
+                },
+                "gateReason": {
+                    "description": "门禁未通过原因",
+                    "type": "string"
+                },
+                "gateStatus": {
+                    "description": "发布门禁：0.未设置 1.通过 2.未通过",
+                    "type": "integer"
+                },
+                "jobId": {
+                    "type": "string"
+                },
+                "judgeModelId": {
+                    "type": "string"
+                },
+                "minScore": {
+                    "type": "number"
+                },
+                "scores": {
+                    "description": "评分成功问题的平均分",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/response.AppEvalScores"
+                        }
+                    ]
+                },
+                "status": {
+                    "description": "0.评测中 1.成功 2.失败",
+                    "type": "integer"
+                },
+                "tolerance": {
+                    "type": "number"
+                },
+                "totalCount": {
+                    "description": "问题总数",
+                    "type": "integer"
+                },
+                "updatedAt": {
                     "type": "string"
                 }
             }
         },
-        "response.AnalysisDocUrlResp": {
-            "type": "object",
-            "properties": {
-                "urlList": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/response.Url"
-                    }
-                }
-            }
-        },
-        "response.ApiResponse": {
+        "response.AppEvalQuestion": {
             "type": "object",
             "properties": {
-                "apiId": {
-                    "description": "ApiID",
+                "question": {
                     "type": "string"
                 },
-                "apiKey": {
-                    "description": "生成的ApiKey",
+                "questionId": {
                     "type": "string"
                 },
-                "createdAt": {
-                    "description": "创建ApiKey的时间",
+                "referenceAnswer": {
                     "type": "string"
                 }
             }
         },
-        "response.AppBriefInfo": {
+        "response.AppEvalResult": {
             "type": "object",
             "properties": {
-                "appId": {
-                    "description": "应用id",
+                "answer": {
                     "type": "string"
                 },
-                "appType": {
-                    "description": "应用类型",
+                "errorMsg": {
+                    "description": "回答或评分失败原因",
                     "type": "string"
                 },
-                "avatar": {
-                    "description": "应用图标",
-                    "allOf": [
-                        {
-                            "$ref": "#/definitions/request.Avatar"
-                        }
-                    ]
+                "latencyMs": {
+                    "description": "回答耗时",
+                    "type": "integer"
                 },
-                "createdAt": {
-                    "description": "应用创建时间",
+                "question": {
                     "type": "string"
                 },
-                "desc": {
-                    "description": "应用描述",
+                "questionId": {
                     "type": "string"
                 },
-                "name": {
-                    "description": "应用名称",
+                "reason": {
+                    "description": "评分理由",
                     "type": "string"
                 },
-                "publishType": {
-                    "description": "发布类型(public:公开发布,private:私密发布)",
+                "referenceAnswer": {
                     "type": "string"
                 },
-                "uniqueId": {
-                    "description": "随机unique id(每次动态生成)",
-                    "type": "string"
+                "scores": {
+                    "$ref": "#/definitions/response.AppEvalScores"
                 },
-                "updatedAt": {
-                    "description": "应用更新时间(用于历史记录排序)",
-                    "type": "string"
+                "searchList": {
+                    "description": "回答引用的检索结果"
+                }
+            }
+        },
+        "response.AppEvalScores": {
+            "type": "object",
+            "properties": {
+                "correctness": {
+                    "description": "正确性：回答与参考答案是否一致，1-5分",
+                    "type": "number"
+                },
+                "faithfulness": {
+                    "description": "忠实度：回答是否基于检索内容，1-5分",
+                    "type": "number"
+                },
+                "relevance": {
+                    "description": "相关性：回答是否切题，1-5分",
+                    "type": "number"
                 }
             }
         },
@@ -12576,6 +17379,14 @@ const docTemplate = `{
                         "$ref": "#/definitions/response.MCPInfos"
                     }
                 },
+                "memoryConfig": {
+                    "description": "对话记忆配置",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/request.AssistantMemoryConfig"
+                        }
+                    ]
+                },
                 "modelConfig": {
                     "description": "模型",
                     "allOf": [
@@ -12715,6 +17526,62 @@ const docTemplate = `{
                 }
             }
         },
+        "response.AuditLogInfo": {
+            "type": "object",
+            "properties": {
+                "action": {
+                    "description": "路由权限标识",
+                    "type": "string"
+                },
+                "actionName": {
+                    "description": "操作描述",
+                    "type": "string"
+                },
+                "auditId": {
+                    "type": "string"
+                },
+                "clientIp": {
+                    "description": "客户端IP",
+                    "type": "string"
+                },
+                "code": {
+                    "type": "integer"
+                },
+                "createdAt": {
+                    "type": "string"
+                },
+                "errMsg": {
+                    "description": "失败原因",
+                    "type": "string"
+                },
+                "method": {
+                    "type": "string"
+                },
+                "orgId": {
+                    "type": "string"
+                },
+                "path": {
+                    "type": "string"
+                },
+                "request": {
+                    "description": "脱敏后的请求参数",
+                    "type": "string"
+                },
+                "success": {
+                    "type": "boolean"
+                },
+                "targetId": {
+                    "description": "操作对象ID",
+                    "type": "string"
+                },
+                "userId": {
+                    "type": "string"
+                },
+                "userName": {
+                    "type": "string"
+                }
+            }
+        },
         "response.Captcha": {
             "type": "object",
             "properties": {
@@ -12790,71 +17657,249 @@ const docTemplate = `{
                         "$ref": "#/definitions/response.ChildContent"
                     }
                 },
-                "childScore": {
+                "childScore": {
+                    "type": "array",
+                    "items": {
+                        "type": "number"
+                    }
+                },
+                "knowledgeName": {
+                    "type": "string"
+                },
+                "snippet": {
+                    "type": "string"
+                },
+                "title": {
+                    "type": "string"
+                }
+            }
+        },
+        "response.CleanFileResp": {
+            "type": "object",
+            "properties": {
+                "status": {
+                    "description": "0:清除失败，1：已完成",
+                    "type": "integer"
+                }
+            }
+        },
+        "response.CompareKnowledgeEvalRunResp": {
+            "type": "object",
+            "properties": {
+                "baseRun": {
+                    "$ref": "#/definitions/response.KnowledgeEvalRunInfo"
+                },
+                "delta": {
+                    "description": "对比评测减基准评测的指标差值",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/response.KnowledgeEvalMetrics"
+                        }
+                    ]
+                },
+                "questionList": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/response.KnowledgeEvalQuestionCompare"
+                    }
+                },
+                "targetRun": {
+                    "$ref": "#/definitions/response.KnowledgeEvalRunInfo"
+                }
+            }
+        },
+        "response.ConnectorInfo": {
+            "type": "object",
+            "properties": {
+                "config": {
+                    "description": "连接器配置",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/request.ConnectorConfig"
+                        }
+                    ]
+                },
+                "connectorId": {
+                    "description": "连接器id",
+                    "type": "string"
+                },
+                "connectorType": {
+                    "description": "连接器类型：s3、git、webdav、local",
+                    "type": "string"
+                },
+                "createAt": {
+                    "description": "创建时间",
+                    "type": "string"
+                },
+                "cursor": {
+                    "description": "增量游标：git为commit，其他为文件修改时间",
+                    "type": "string"
+                },
+                "errorMsg": {
+                    "description": "失败原因",
+                    "type": "string"
+                },
+                "hasCredential": {
+                    "description": "是否已设置凭证，凭证不返回",
+                    "type": "boolean"
+                },
+                "knowledgeId": {
+                    "description": "知识库id",
+                    "type": "string"
+                },
+                "lastImportTaskId": {
+                    "description": "最近一次创建的导入任务id",
+                    "type": "string"
+                },
+                "lastRunAt": {
+                    "description": "最近执行时间",
+                    "type": "string"
+                },
+                "name": {
+                    "description": "连接器名称",
+                    "type": "string"
+                },
+                "status": {
+                    "description": "0.未执行 1.执行中 2.执行成功 3.执行失败",
+                    "type": "integer"
+                }
+            }
+        },
+        "response.ConnectorListResp": {
+            "type": "object",
+            "properties": {
+                "list": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/response.ConnectorInfo"
+                    }
+                }
+            }
+        },
+        "response.ConnectorSaveResp": {
+            "type": "object",
+            "properties": {
+                "connectorId": {
+                    "description": "连接器id",
+                    "type": "string"
+                }
+            }
+        },
+        "response.ConversationCreateResp": {
+            "type": "object",
+            "properties": {
+                "conversationId": {
+                    "type": "string"
+                }
+            }
+        },
+        "response.ConversationDetailInfo": {
+            "type": "object",
+            "properties": {
+                "assistantId": {
+                    "type": "string"
+                },
+                "conversationId": {
+                    "type": "string"
+                },
+                "createdAt": {
+                    "type": "integer"
+                },
+                "createdBy": {
+                    "type": "string"
+                },
+                "fileName": {
+                    "type": "string"
+                },
+                "fileSize": {
+                    "type": "integer"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "parentId": {
+                    "description": "上一轮对话id，首轮为对话id",
+                    "type": "string"
+                },
+                "prompt": {
+                    "type": "string"
+                },
+                "qa_type": {
+                    "type": "integer"
+                },
+                "rating": {
+                    "description": "当前用户的评价 1:点赞 -1:点踩 0:未评价",
+                    "type": "integer"
+                },
+                "requestFileUrls": {
                     "type": "array",
                     "items": {
-                        "type": "number"
+                        "type": "string"
                     }
                 },
-                "knowledgeName": {
+                "response": {
                     "type": "string"
                 },
-                "snippet": {
-                    "type": "string"
+                "searchList": {},
+                "siblingCount": {
+                    "description": "同一轮对话的版本数（重新生成、编辑问题）",
+                    "type": "integer"
                 },
-                "title": {
+                "siblingIndex": {
+                    "description": "当前版本的序号，从0开始",
+                    "type": "integer"
+                },
+                "sysPrompt": {
                     "type": "string"
-                }
-            }
-        },
-        "response.CleanFileResp": {
-            "type": "object",
-            "properties": {
-                "status": {
-                    "description": "0:清除失败，1：已完成",
+                },
+                "updatedAt": {
                     "type": "integer"
                 }
             }
         },
-        "response.ConversationCreateResp": {
+        "response.ConversationDetailSiblings": {
             "type": "object",
             "properties": {
-                "conversationId": {
-                    "type": "string"
+                "activeIndex": {
+                    "description": "当前分支所在版本的序号",
+                    "type": "integer"
+                },
+                "list": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/response.ConversationDetailInfo"
+                    }
                 }
             }
         },
-        "response.ConversationDetailInfo": {
+        "response.ConversationFeedbackInfo": {
             "type": "object",
             "properties": {
                 "assistantId": {
                     "type": "string"
                 },
+                "content": {
+                    "type": "string"
+                },
                 "conversationId": {
                     "type": "string"
                 },
                 "createdAt": {
                     "type": "integer"
                 },
-                "createdBy": {
-                    "type": "string"
-                },
-                "fileName": {
+                "detailId": {
                     "type": "string"
                 },
-                "fileSize": {
-                    "type": "integer"
-                },
-                "id": {
+                "feedbackId": {
                     "type": "string"
                 },
                 "prompt": {
                     "type": "string"
                 },
-                "qa_type": {
+                "rating": {
                     "type": "integer"
                 },
-                "requestFileUrls": {
+                "reasons": {
                     "type": "array",
                     "items": {
                         "type": "string"
@@ -12863,12 +17908,25 @@ const docTemplate = `{
                 "response": {
                     "type": "string"
                 },
-                "searchList": {},
-                "sysPrompt": {
-                    "type": "string"
+                "searchList": {
+                    "description": "回答时检索到的知识片段"
                 },
                 "updatedAt": {
                     "type": "integer"
+                },
+                "userId": {
+                    "type": "string"
+                }
+            }
+        },
+        "response.ConversationImportResp": {
+            "type": "object",
+            "properties": {
+                "conversationIds": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
                 }
             }
         },
@@ -12913,6 +17971,14 @@ const docTemplate = `{
                         }
                     ]
                 },
+                "cachePolicy": {
+                    "description": "模型响应缓存策略",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/mp.CachePolicy"
+                        }
+                    ]
+                },
                 "config": {},
                 "createdAt": {
                     "type": "string"
@@ -12975,6 +18041,14 @@ const docTemplate = `{
                     "description": "模型发布时间",
                     "type": "string"
                 },
+                "routingPolicy": {
+                    "description": "模型路由策略",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/mp.RoutingPolicy"
+                        }
+                    ]
+                },
                 "tags": {
                     "type": "array",
                     "items": {
@@ -13009,6 +18083,22 @@ const docTemplate = `{
                 }
             }
         },
+        "response.CreateKnowledgeEvalDatasetResp": {
+            "type": "object",
+            "properties": {
+                "datasetId": {
+                    "type": "string"
+                }
+            }
+        },
+        "response.CreateKnowledgeEvalRunResp": {
+            "type": "object",
+            "properties": {
+                "runId": {
+                    "type": "string"
+                }
+            }
+        },
         "response.CreateKnowledgeResp": {
             "type": "object",
             "properties": {
@@ -13412,6 +18502,76 @@ const docTemplate = `{
                 }
             }
         },
+        "response.DocSegmentDiff": {
+            "type": "object",
+            "properties": {
+                "baseContent": {
+                    "description": "基准版本切片内容",
+                    "type": "string"
+                },
+                "changeType": {
+                    "description": "差异类型：added、deleted、modified",
+                    "type": "string"
+                },
+                "contentNum": {
+                    "description": "切片序号",
+                    "type": "integer"
+                },
+                "targetContent": {
+                    "description": "目标版本切片内容",
+                    "type": "string"
+                }
+            }
+        },
+        "response.DocSegmentImportReport": {
+            "type": "object",
+            "properties": {
+                "createdAt": {
+                    "type": "string"
+                },
+                "errorMsg": {
+                    "type": "string"
+                },
+                "importId": {
+                    "type": "string"
+                },
+                "rowErrorList": {
+                    "description": "失败行，最多记录1000行",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/response.DocSegmentImportRowError"
+                    }
+                },
+                "status": {
+                    "description": "0.待处理 1.导入中 2.成功 3.失败",
+                    "type": "integer"
+                },
+                "successCount": {
+                    "description": "成功行数",
+                    "type": "integer"
+                },
+                "totalCount": {
+                    "description": "文件总行数",
+                    "type": "integer"
+                }
+            }
+        },
+        "response.DocSegmentImportRowError": {
+            "type": "object",
+            "properties": {
+                "contentId": {
+                    "description": "分段id",
+                    "type": "string"
+                },
+                "errorMsg": {
+                    "type": "string"
+                },
+                "line": {
+                    "description": "行号，从1开始，不含表头",
+                    "type": "integer"
+                }
+            }
+        },
         "response.DocSegmentResp": {
             "type": "object",
             "properties": {
@@ -13434,36 +18594,223 @@ const docTemplate = `{
                     "description": "文档元数据",
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/response.DocMetaData"
+                        "$ref": "#/definitions/response.DocMetaData"
+                    }
+                },
+                "pageTotal": {
+                    "description": "总页数",
+                    "type": "integer"
+                },
+                "segmentImportStatus": {
+                    "description": "分段导入状态描述",
+                    "type": "string"
+                },
+                "segmentMethod": {
+                    "description": "分段方式 父子分段/通用分段",
+                    "type": "string"
+                },
+                "segmentTotalNum": {
+                    "description": "分段数量",
+                    "type": "integer"
+                },
+                "segmentType": {
+                    "description": "分段方式 0自动分段 1自定义分段",
+                    "type": "string"
+                },
+                "splitter": {
+                    "description": "分隔符（只有自定义分段必填）",
+                    "type": "string"
+                },
+                "uploadTime": {
+                    "description": "上传时间",
+                    "type": "string"
+                }
+            }
+        },
+        "response.DocSyncHistoryInfo": {
+            "type": "object",
+            "properties": {
+                "contentMd5": {
+                    "description": "本次获取的内容md5",
+                    "type": "string"
+                },
+                "docId": {
+                    "description": "文档id",
+                    "type": "string"
+                },
+                "docName": {
+                    "description": "文档名称",
+                    "type": "string"
+                },
+                "docUrl": {
+                    "description": "文档url",
+                    "type": "string"
+                },
+                "errorMsg": {
+                    "description": "失败原因",
+                    "type": "string"
+                },
+                "finishAt": {
+                    "description": "结束时间",
+                    "type": "string"
+                },
+                "startAt": {
+                    "description": "开始时间",
+                    "type": "string"
+                },
+                "status": {
+                    "description": "同步结果：1.内容未变化 2.内容已变化并重新导入 3.同步失败",
+                    "type": "integer"
+                },
+                "syncId": {
+                    "description": "同步配置id",
+                    "type": "string"
+                }
+            }
+        },
+        "response.DocSyncInfo": {
+            "type": "object",
+            "properties": {
+                "createAt": {
+                    "description": "创建时间",
+                    "type": "string"
+                },
+                "cronExpr": {
+                    "description": "cron表达式",
+                    "type": "string"
+                },
+                "docId": {
+                    "description": "url文档id",
+                    "type": "string"
+                },
+                "enable": {
+                    "description": "是否启用",
+                    "type": "boolean"
+                },
+                "importTaskId": {
+                    "description": "url导入任务id",
+                    "type": "string"
+                },
+                "intervalMinutes": {
+                    "description": "同步间隔（分钟）",
+                    "type": "integer"
+                },
+                "knowledgeId": {
+                    "description": "知识库id",
+                    "type": "string"
+                },
+                "lastSyncAt": {
+                    "description": "上次同步时间",
+                    "type": "string"
+                },
+                "nextSyncAt": {
+                    "description": "下次同步时间",
+                    "type": "string"
+                },
+                "syncId": {
+                    "description": "同步配置id",
+                    "type": "string"
+                }
+            }
+        },
+        "response.DocSyncListResp": {
+            "type": "object",
+            "properties": {
+                "list": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/response.DocSyncInfo"
+                    }
+                }
+            }
+        },
+        "response.DocSyncSaveResp": {
+            "type": "object",
+            "properties": {
+                "syncId": {
+                    "description": "同步配置id",
+                    "type": "string"
+                }
+            }
+        },
+        "response.DocVersionDiffResp": {
+            "type": "object",
+            "properties": {
+                "addedCount": {
+                    "description": "新增切片数量",
+                    "type": "integer"
+                },
+                "baseSegmentCount": {
+                    "description": "基准版本切片数量",
+                    "type": "integer"
+                },
+                "deletedCount": {
+                    "description": "删除切片数量",
+                    "type": "integer"
+                },
+                "list": {
+                    "description": "有差异的切片",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/response.DocSegmentDiff"
                     }
                 },
-                "pageTotal": {
-                    "description": "总页数",
+                "modifiedCount": {
+                    "description": "修改切片数量",
                     "type": "integer"
                 },
-                "segmentImportStatus": {
-                    "description": "分段导入状态描述",
+                "targetSegmentCount": {
+                    "description": "目标版本切片数量",
+                    "type": "integer"
+                }
+            }
+        },
+        "response.DocVersionInfo": {
+            "type": "object",
+            "properties": {
+                "active": {
+                    "description": "是否当前生效版本",
+                    "type": "boolean"
+                },
+                "createAt": {
+                    "description": "历史版本为归档时间，当前版本为文档更新时间",
                     "type": "string"
                 },
-                "segmentMethod": {
-                    "description": "分段方式 父子分段/通用分段",
+                "docName": {
+                    "description": "文档名称",
                     "type": "string"
                 },
-                "segmentTotalNum": {
-                    "description": "分段数量",
+                "docSize": {
+                    "description": "文档大小",
                     "type": "integer"
                 },
-                "segmentType": {
-                    "description": "分段方式 0自动分段 1自定义分段",
+                "docType": {
+                    "description": "文档类型",
                     "type": "string"
                 },
-                "splitter": {
-                    "description": "分隔符（只有自定义分段必填）",
-                    "type": "string"
+                "segmentCount": {
+                    "description": "切片数量",
+                    "type": "integer"
                 },
-                "uploadTime": {
-                    "description": "上传时间",
+                "versionId": {
+                    "description": "历史版本id，当前生效版本为空",
                     "type": "string"
+                },
+                "versionNo": {
+                    "description": "版本号",
+                    "type": "integer"
+                }
+            }
+        },
+        "response.DocVersionListResp": {
+            "type": "object",
+            "properties": {
+                "list": {
+                    "description": "按版本号倒序，第一条为当前生效版本",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/response.DocVersionInfo"
+                    }
                 }
             }
         },
@@ -13563,6 +18910,47 @@ const docTemplate = `{
                 }
             }
         },
+        "response.GuardrailPolicy": {
+            "type": "object",
+            "properties": {
+                "appId": {
+                    "description": "应用id",
+                    "type": "string"
+                },
+                "appType": {
+                    "description": "应用类型：rag 或 agent",
+                    "type": "string"
+                },
+                "blockReply": {
+                    "description": "拦截时的回复",
+                    "type": "string"
+                },
+                "enable": {
+                    "description": "是否启用",
+                    "type": "boolean"
+                },
+                "input": {
+                    "description": "输入阶段",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/request.GuardrailStage"
+                        }
+                    ]
+                },
+                "output": {
+                    "description": "输出阶段",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/request.GuardrailStage"
+                        }
+                    ]
+                },
+                "updatedAt": {
+                    "description": "更新时间，未配置时为空",
+                    "type": "string"
+                }
+            }
+        },
         "response.IDName": {
             "type": "object",
             "properties": {
@@ -13589,17 +18977,270 @@ const docTemplate = `{
                         "type": "string"
                     }
                 },
-                "knowledgeBaseNames": {
+                "knowledgeBaseNames": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "name": {
+                    "type": "string"
+                },
+                "updatedAt": {
+                    "type": "string"
+                }
+            }
+        },
+        "response.KnowledgeBundleTaskInfo": {
+            "type": "object",
+            "properties": {
+                "createdAt": {
+                    "type": "string"
+                },
+                "errorMsg": {
+                    "description": "失败原因",
+                    "type": "string"
+                },
+                "finishCount": {
+                    "description": "已处理文档数",
+                    "type": "integer"
+                },
+                "knowledgeId": {
+                    "description": "导出的知识库id或导入后新建的知识库id",
+                    "type": "string"
+                },
+                "knowledgeName": {
+                    "description": "知识库名称",
+                    "type": "string"
+                },
+                "status": {
+                    "description": "任务状态：0.待处理 1.处理中 2.成功 3.失败",
+                    "type": "integer"
+                },
+                "taskId": {
+                    "type": "string"
+                },
+                "taskType": {
+                    "description": "任务类型：1.导出 2.导入",
+                    "type": "integer"
+                },
+                "totalCount": {
+                    "description": "文档总数",
+                    "type": "integer"
+                },
+                "updatedAt": {
+                    "type": "string"
+                }
+            }
+        },
+        "response.KnowledgeBundleTaskResp": {
+            "type": "object",
+            "properties": {
+                "taskId": {
+                    "type": "string"
+                }
+            }
+        },
+        "response.KnowledgeEvalDatasetInfo": {
+            "type": "object",
+            "properties": {
+                "createdAt": {
+                    "type": "string"
+                },
+                "datasetId": {
+                    "type": "string"
+                },
+                "description": {
+                    "type": "string"
+                },
+                "knowledgeId": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "questionCount": {
+                    "description": "问题数",
+                    "type": "integer"
+                },
+                "questionList": {
+                    "description": "问题列表，仅详情返回",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/request.KnowledgeEvalQuestion"
+                    }
+                }
+            }
+        },
+        "response.KnowledgeEvalDatasetListResp": {
+            "type": "object",
+            "properties": {
+                "datasetList": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/response.KnowledgeEvalDatasetInfo"
+                    }
+                }
+            }
+        },
+        "response.KnowledgeEvalHit": {
+            "type": "object",
+            "properties": {
+                "contentId": {
+                    "type": "string"
+                },
+                "docId": {
+                    "description": "命中文档id，文档已删除时为空",
+                    "type": "string"
+                },
+                "relevant": {
+                    "description": "是否为期望命中的文档或分段",
+                    "type": "boolean"
+                },
+                "score": {
+                    "type": "number"
+                },
+                "snippet": {
+                    "type": "string"
+                },
+                "title": {
+                    "type": "string"
+                }
+            }
+        },
+        "response.KnowledgeEvalMetrics": {
+            "type": "object",
+            "properties": {
+                "hitRate": {
+                    "description": "命中率，单个问题命中为1",
+                    "type": "number"
+                },
+                "mrr": {
+                    "description": "平均倒数排名，单个问题为倒数排名",
+                    "type": "number"
+                },
+                "ndcg": {
+                    "description": "nDCG@k",
+                    "type": "number"
+                },
+                "recall": {
+                    "description": "recall@k",
+                    "type": "number"
+                }
+            }
+        },
+        "response.KnowledgeEvalQuestionCompare": {
+            "type": "object",
+            "properties": {
+                "baseMetrics": {
+                    "$ref": "#/definitions/response.KnowledgeEvalMetrics"
+                },
+                "question": {
+                    "type": "string"
+                },
+                "questionId": {
+                    "type": "string"
+                },
+                "targetMetrics": {
+                    "$ref": "#/definitions/response.KnowledgeEvalMetrics"
+                }
+            }
+        },
+        "response.KnowledgeEvalQuestionResult": {
+            "type": "object",
+            "properties": {
+                "errorMsg": {
+                    "description": "检索失败原因，失败时指标为0",
+                    "type": "string"
+                },
+                "hitList": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/response.KnowledgeEvalHit"
+                    }
+                },
+                "metrics": {
+                    "$ref": "#/definitions/response.KnowledgeEvalMetrics"
+                },
+                "question": {
+                    "type": "string"
+                },
+                "questionId": {
+                    "type": "string"
+                }
+            }
+        },
+        "response.KnowledgeEvalRunDetail": {
+            "type": "object",
+            "properties": {
+                "resultList": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/response.KnowledgeEvalQuestionResult"
+                    }
+                },
+                "run": {
+                    "$ref": "#/definitions/response.KnowledgeEvalRunInfo"
+                }
+            }
+        },
+        "response.KnowledgeEvalRunInfo": {
+            "type": "object",
+            "properties": {
+                "createdAt": {
+                    "type": "string"
+                },
+                "datasetId": {
+                    "type": "string"
+                },
+                "errorMsg": {
+                    "description": "失败原因",
+                    "type": "string"
+                },
+                "finishCount": {
+                    "description": "已评测问题数",
+                    "type": "integer"
+                },
+                "knowledgeId": {
+                    "type": "string"
+                },
+                "knowledgeMatchParams": {
+                    "description": "检索配置",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/request.KnowledgeMatchParams"
+                        }
+                    ]
+                },
+                "metrics": {
+                    "description": "各问题指标的平均值",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/response.KnowledgeEvalMetrics"
+                        }
+                    ]
+                },
+                "runId": {
+                    "type": "string"
+                },
+                "status": {
+                    "description": "评测状态：0.待处理 1.评测中 2.成功 3.失败",
+                    "type": "integer"
+                },
+                "totalCount": {
+                    "description": "问题总数",
+                    "type": "integer"
+                }
+            }
+        },
+        "response.KnowledgeEvalRunListResp": {
+            "type": "object",
+            "properties": {
+                "runList": {
                     "type": "array",
                     "items": {
-                        "type": "string"
+                        "$ref": "#/definitions/response.KnowledgeEvalRunInfo"
                     }
-                },
-                "name": {
-                    "type": "string"
-                },
-                "updatedAt": {
-                    "type": "string"
                 }
             }
         },
@@ -13663,6 +19304,14 @@ const docTemplate = `{
                 "name": {
                     "description": "知识库名称",
                     "type": "string"
+                },
+                "permissionType": {
+                    "description": "当前用户权限：1.查看 2.编辑 3.管理",
+                    "type": "integer"
+                },
+                "shared": {
+                    "description": "是否为他人分享的知识库",
+                    "type": "boolean"
                 }
             }
         },
@@ -13727,6 +19376,50 @@ const docTemplate = `{
                 }
             }
         },
+        "response.KnowledgePermissionInfo": {
+            "type": "object",
+            "properties": {
+                "createAt": {
+                    "description": "分享时间",
+                    "type": "string"
+                },
+                "granteeId": {
+                    "description": "分享对象id",
+                    "type": "string"
+                },
+                "granteeName": {
+                    "description": "分享对象名称",
+                    "type": "string"
+                },
+                "granteeType": {
+                    "description": "分享对象类型：user（用户）、role（角色）、org（组织）",
+                    "type": "string"
+                },
+                "permissionType": {
+                    "description": "权限：1.查看 2.编辑 3.管理",
+                    "type": "integer"
+                },
+                "sharerId": {
+                    "description": "分享人id",
+                    "type": "string"
+                },
+                "sharerName": {
+                    "description": "分享人名称",
+                    "type": "string"
+                }
+            }
+        },
+        "response.KnowledgePermissionListResp": {
+            "type": "object",
+            "properties": {
+                "list": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/response.KnowledgePermissionInfo"
+                    }
+                }
+            }
+        },
         "response.KnowledgeSplitter": {
             "type": "object",
             "properties": {
@@ -14354,6 +20047,26 @@ const docTemplate = `{
                 }
             }
         },
+        "response.ModelCacheStat": {
+            "type": "object",
+            "properties": {
+                "hit": {
+                    "description": "精确缓存命中次数",
+                    "type": "integer"
+                },
+                "miss": {
+                    "description": "未命中次数",
+                    "type": "integer"
+                },
+                "modelId": {
+                    "type": "string"
+                },
+                "semanticHit": {
+                    "description": "语义缓存命中次数",
+                    "type": "integer"
+                }
+            }
+        },
         "response.ModelInfo": {
             "type": "object",
             "required": [
@@ -14370,6 +20083,14 @@ const docTemplate = `{
                         }
                     ]
                 },
+                "cachePolicy": {
+                    "description": "模型响应缓存策略",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/mp.CachePolicy"
+                        }
+                    ]
+                },
                 "config": {},
                 "createdAt": {
                     "type": "string"
@@ -14423,6 +20144,14 @@ const docTemplate = `{
                     "description": "模型发布时间",
                     "type": "string"
                 },
+                "routingPolicy": {
+                    "description": "模型路由策略",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/mp.RoutingPolicy"
+                        }
+                    ]
+                },
                 "tags": {
                     "type": "array",
                     "items": {
@@ -14437,6 +20166,87 @@ const docTemplate = `{
                 }
             }
         },
+        "response.ModelQuota": {
+            "type": "object",
+            "properties": {
+                "dailyTokens": {
+                    "description": "每日token上限，0表示不限制",
+                    "type": "integer"
+                },
+                "dailyUsed": {
+                    "description": "当日已用token",
+                    "type": "integer"
+                },
+                "monthlyTokens": {
+                    "description": "每月token上限，0表示不限制",
+                    "type": "integer"
+                },
+                "monthlyUsed": {
+                    "description": "当月已用token",
+                    "type": "integer"
+                },
+                "orgId": {
+                    "type": "string"
+                },
+                "updatedAt": {
+                    "type": "string"
+                },
+                "userId": {
+                    "description": "为空表示组织配额",
+                    "type": "string"
+                }
+            }
+        },
+        "response.ModelUsageStat": {
+            "type": "object",
+            "properties": {
+                "appId": {
+                    "type": "string"
+                },
+                "appType": {
+                    "type": "string"
+                },
+                "avgLatencyMs": {
+                    "description": "平均耗时（毫秒）",
+                    "type": "integer"
+                },
+                "calls": {
+                    "description": "调用次数",
+                    "type": "integer"
+                },
+                "completionTokens": {
+                    "description": "输出token数",
+                    "type": "integer"
+                },
+                "failedCalls": {
+                    "description": "失败次数",
+                    "type": "integer"
+                },
+                "model": {
+                    "type": "string"
+                },
+                "modelId": {
+                    "type": "string"
+                },
+                "modelType": {
+                    "type": "string"
+                },
+                "orgId": {
+                    "type": "string"
+                },
+                "promptTokens": {
+                    "description": "输入token数",
+                    "type": "integer"
+                },
+                "provider": {
+                    "type": "string"
+                },
+                "totalTokens": {
+                    "description": "总token数",
+                    "type": "integer"
+                }
+            }
+        },
         "response.OrgID": {
             "type": "object",
             "properties": {
@@ -14525,6 +20335,50 @@ const docTemplate = `{
                 }
             }
         },
+        "response.RagFeedbackInfo": {
+            "type": "object",
+            "properties": {
+                "answer": {
+                    "type": "string"
+                },
+                "content": {
+                    "type": "string"
+                },
+                "createdAt": {
+                    "type": "integer"
+                },
+                "feedbackId": {
+                    "type": "string"
+                },
+                "msgId": {
+                    "type": "string"
+                },
+                "question": {
+                    "type": "string"
+                },
+                "ragId": {
+                    "type": "string"
+                },
+                "rating": {
+                    "type": "integer"
+                },
+                "reasons": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "searchList": {
+                    "description": "回答时检索到的知识片段"
+                },
+                "updatedAt": {
+                    "type": "integer"
+                },
+                "userId": {
+                    "type": "string"
+                }
+            }
+        },
         "response.RagInfo": {
             "type": "object",
             "required": [
@@ -14588,6 +20442,54 @@ const docTemplate = `{
                 }
             }
         },
+        "response.RateLimitStat": {
+            "type": "object",
+            "properties": {
+                "allowed": {
+                    "description": "放行请求数",
+                    "type": "integer"
+                },
+                "concurrencyRejected": {
+                    "description": "并发流超限被拒绝数",
+                    "type": "integer"
+                },
+                "dimension": {
+                    "description": "维度：api_key | app | client | ip",
+                    "type": "string"
+                },
+                "id": {
+                    "description": "ApiKey的apiId或应用的appId，维度汇总时为空",
+                    "type": "string"
+                },
+                "rejected": {
+                    "description": "请求速率超限被拒绝数",
+                    "type": "integer"
+                },
+                "streams": {
+                    "description": "当前并发流数，维度汇总时为0",
+                    "type": "integer"
+                }
+            }
+        },
+        "response.RateLimitStats": {
+            "type": "object",
+            "properties": {
+                "dimensions": {
+                    "description": "按维度汇总",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/response.RateLimitStat"
+                    }
+                },
+                "subjects": {
+                    "description": "按ApiKey、应用明细",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/response.RateLimitStat"
+                    }
+                }
+            }
+        },
         "response.Response": {
             "type": "object",
             "properties": {
@@ -15088,6 +20990,93 @@ const docTemplate = `{
                 }
             }
         },
+        "response.WebhookDeliveryInfo": {
+            "type": "object",
+            "properties": {
+                "attempts": {
+                    "description": "已投递次数",
+                    "type": "integer"
+                },
+                "createdAt": {
+                    "type": "string"
+                },
+                "deliveryId": {
+                    "type": "string"
+                },
+                "event": {
+                    "description": "事件类型",
+                    "type": "string"
+                },
+                "eventId": {
+                    "description": "事件ID，同一事件投递到多个webhook时相同",
+                    "type": "string"
+                },
+                "lastCode": {
+                    "description": "最近一次投递的响应码",
+                    "type": "integer"
+                },
+                "lastError": {
+                    "description": "最近一次投递的失败原因",
+                    "type": "string"
+                },
+                "nextRetryAt": {
+                    "description": "下次投递时间",
+                    "type": "string"
+                },
+                "payload": {
+                    "description": "投递内容",
+                    "type": "string"
+                },
+                "status": {
+                    "description": "投递状态：pending 待投递，success 成功，dead 死信",
+                    "type": "string"
+                },
+                "updatedAt": {
+                    "type": "string"
+                },
+                "webhookId": {
+                    "type": "string"
+                }
+            }
+        },
+        "response.WebhookInfo": {
+            "type": "object",
+            "properties": {
+                "createdAt": {
+                    "type": "string"
+                },
+                "enable": {
+                    "type": "boolean"
+                },
+                "events": {
+                    "description": "订阅的事件",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "name": {
+                    "type": "string"
+                },
+                "secret": {
+                    "description": "签名密钥，仅在创建时返回完整值，列表中为脱敏值",
+                    "type": "string"
+                },
+                "updatedAt": {
+                    "type": "string"
+                },
+                "url": {
+                    "type": "string"
+                },
+                "userId": {
+                    "description": "创建人ID",
+                    "type": "string"
+                },
+                "webhookId": {
+                    "type": "string"
+                }
+            }
+        },
         "response.WorkFlowInfos": {
             "type": "object",
             "properties": {
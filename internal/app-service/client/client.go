@@ -2,6 +2,7 @@ package client
 
 import (
 	"context"
+	"time"
 
 	err_code "github.com/UnicomAI/wanwu/api/proto/err-code"
 	"github.com/UnicomAI/wanwu/internal/app-service/client/model"
@@ -12,7 +13,8 @@ type IClient interface {
 	// --- api key ---
 	GetApiKeyList(ctx context.Context, userId, orgId, appId, appType string) ([]*model.ApiKey, *err_code.Status)
 	DelApiKey(ctx context.Context, apiId uint32) *err_code.Status
-	GenApiKey(ctx context.Context, userId, orgId, appId, appType, apiKey string) (*model.ApiKey, *err_code.Status)
+	GenApiKey(ctx context.Context, userId, orgId, appId, appType, apiKey string, opt orm.ApiKeyOption) (*model.ApiKey, *err_code.Status)
+	RotateApiKey(ctx context.Context, apiId uint32, userId, apiKey string, overlap time.Duration) (*model.ApiKey, *err_code.Status)
 	GetApiKeyByKey(ctx context.Context, apiKey string) (*model.ApiKey, *err_code.Status)
 
 	// --- explore ---
@@ -39,6 +41,8 @@ type IClient interface {
 	GetSensitiveWordTableListWithWordsByIDs(ctx context.Context, tableIds []string) ([]*orm.SensitiveWordTableWithWord, *err_code.Status)
 	GetSensitiveWordTableListByIDs(ctx context.Context, tableIds []string) ([]*model.SensitiveWordTable, *err_code.Status)
 	GetSensitiveWordTableByID(ctx context.Context, tableId uint32) (*model.SensitiveWordTable, *err_code.Status)
+	SaveGuardrailPolicy(ctx context.Context, policy *model.GuardrailPolicy) *err_code.Status
+	GetGuardrailPolicy(ctx context.Context, appId, appType string) (*model.GuardrailPolicy, *err_code.Status)
 
 	// --- web_url ---
 	CreateAppUrl(ctx context.Context, appUrl *model.AppUrl) *err_code.Status
@@ -47,4 +51,18 @@ type IClient interface {
 	GetAppUrlList(ctx context.Context, appID, appType string) ([]*model.AppUrl, *err_code.Status)
 	GetAppUrlInfoBySuffix(ctx context.Context, suffix string) (*model.AppUrl, *err_code.Status)
 	AppUrlStatusSwitch(ctx context.Context, urlID uint32, status bool) *err_code.Status
+
+	// --- eval ---
+	CreateAppEvalDataset(ctx context.Context, dataset *model.AppEvalDataset, questions []*model.AppEvalQuestion) *err_code.Status
+	DeleteAppEvalDataset(ctx context.Context, datasetId, userId, orgId string) *err_code.Status
+	GetAppEvalDatasetList(ctx context.Context, userId, orgId string) ([]*model.AppEvalDataset, *err_code.Status)
+	GetAppEvalDataset(ctx context.Context, datasetId, userId, orgId string) (*model.AppEvalDataset, []*model.AppEvalQuestion, *err_code.Status)
+	CreateAppEvalJob(ctx context.Context, job *model.AppEvalJob) *err_code.Status
+	ClaimAppEvalJob(ctx context.Context, runnerId string, lease time.Duration) (*model.AppEvalJob, []*model.AppEvalQuestion, *err_code.Status)
+	RenewAppEvalJob(ctx context.Context, jobId, runnerId string, lease time.Duration) *err_code.Status
+	SaveAppEvalResult(ctx context.Context, runnerId string, result *model.AppEvalResult) *err_code.Status
+	FinishAppEvalJob(ctx context.Context, jobId, runnerId, errorMsg string) (*model.AppEvalJob, *err_code.Status)
+	CheckAppEvalGate(ctx context.Context, appId, appType string) *err_code.Status
+	GetAppEvalJobList(ctx context.Context, userId, orgId, datasetId, appId string) ([]*model.AppEvalJob, *err_code.Status)
+	GetAppEvalJob(ctx context.Context, jobId, userId, orgId string) (*model.AppEvalJob, []*model.AppEvalResult, *err_code.Status)
 }
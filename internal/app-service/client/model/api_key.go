@@ -12,6 +12,21 @@ type ApiKey struct {
 	AppID string `gorm:"index:idx_api_key_app_id"`
 	// 应用类型
 	AppType string `gorm:"index:idx_api_key_app_type"`
-	// Api Key
+	// Api Key 明文，仅兼容历史数据，启动时迁移为哈希后清空
 	ApiKey string `gorm:"index:idx_api_key_api_key"`
+	// Api Key 可见前缀，用于展示和查找
+	KeyPrefix string `gorm:"index:idx_api_key_key_prefix"`
+	// Api Key 加盐哈希
+	KeyHash string
+	KeySalt string
+	// 授权范围，逗号分隔，为空表示不限制
+	Scopes string
+	// 允许的请求来源（Origin），逗号分隔，为空表示不限制
+	AllowedOrigins string `gorm:"type:text"`
+	// 允许的客户端IP或CIDR，逗号分隔，为空表示不限制
+	AllowedIPs string `gorm:"type:text"`
+	// 过期时间（毫秒），0表示永不过期
+	ExpiresAt int64
+	// 最近使用时间（毫秒）
+	LastUsedAt int64
 }
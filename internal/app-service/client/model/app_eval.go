@@ -0,0 +1,125 @@
+package model
+
+const (
+	AppEvalJobProcessing = 0 // 评测中
+	AppEvalJobSuccess    = 1 // 评测成功
+	AppEvalJobFail       = 2 // 评测失败
+
+	AppEvalGateNone = 0 // 未设置发布门禁
+	AppEvalGatePass = 1 // 门禁通过
+	AppEvalGateFail = 2 // 门禁未通过
+)
+
+// AppEvalDataset 应用回答质量评测问题集
+type AppEvalDataset struct {
+	ID        uint32 `gorm:"primary_key"`
+	CreatedAt int64  `gorm:"autoCreateTime:milli"`
+	UpdatedAt int64  `gorm:"autoUpdateTime:milli"`
+	// 问题集ID
+	DatasetID string `gorm:"index:idx_app_eval_dataset_dataset_id"`
+	// 名称
+	Name string
+	// 描述
+	Description string `gorm:"type:text"`
+	// 问题数
+	QuestionCount int
+	// 用户ID
+	UserID string `gorm:"index:idx_app_eval_dataset_user_id"`
+	// 组织ID
+	OrgID string `gorm:"index:idx_app_eval_dataset_org_id"`
+}
+
+// AppEvalQuestion 评测问题
+type AppEvalQuestion struct {
+	ID        uint32 `gorm:"primary_key"`
+	CreatedAt int64  `gorm:"autoCreateTime:milli"`
+	// 问题ID
+	QuestionID string
+	// 问题集ID
+	DatasetID string `gorm:"index:idx_app_eval_question_dataset_id"`
+	// 问题
+	Question string `gorm:"type:text"`
+	// 参考答案
+	ReferenceAnswer string `gorm:"type:text"`
+}
+
+// AppEvalJob 评测任务，按问题集回放应用对话并由评分模型打分
+type AppEvalJob struct {
+	ID        uint32 `gorm:"primary_key"`
+	CreatedAt int64  `gorm:"autoCreateTime:milli;index:idx_app_eval_job_created_at"`
+	UpdatedAt int64  `gorm:"autoUpdateTime:milli"`
+	// 任务ID
+	JobID string `gorm:"index:idx_app_eval_job_job_id"`
+	// 问题集ID
+	DatasetID string `gorm:"index:idx_app_eval_job_dataset_id"`
+	// 应用ID
+	AppID string `gorm:"index:idx_app_eval_job_app_id"`
+	// 应用类型
+	AppType string
+	// 评分模型ID
+	JudgeModelID string
+	// 基线评测任务ID
+	BaselineJobID string
+	// 相对基线允许下降的分值
+	Tolerance float64
+	// 各项平均分的最低要求
+	MinScore float64
+	// 任务状态
+	Status int
+	// 问题总数
+	TotalCount int
+	// 已评测问题数
+	FinishCount int
+	// 回答或评分失败的问题数
+	ErrorCount int
+	// 平均忠实度
+	Faithfulness float64
+	// 平均相关性
+	Relevance float64
+	// 平均正确性
+	Correctness float64
+	// 发布门禁状态
+	GateStatus int
+	// 门禁未通过原因
+	GateReason string `gorm:"type:text"`
+	// 失败原因
+	ErrorMsg string `gorm:"type:text"`
+	// 当前持有租约的执行者ID
+	RunnerID string
+	// 租约到期时间，过期后评测中的任务可被其他执行者领取继续执行
+	LeaseUntil int64 `gorm:"index:idx_app_eval_job_lease_until"`
+	// 用户ID
+	UserID string `gorm:"index:idx_app_eval_job_user_id"`
+	// 组织ID
+	OrgID string `gorm:"index:idx_app_eval_job_org_id"`
+}
+
+// AppEvalResult 单个问题的评测结果
+type AppEvalResult struct {
+	ID        uint32 `gorm:"primary_key"`
+	CreatedAt int64  `gorm:"autoCreateTime:milli"`
+	// 任务ID
+	JobID string `gorm:"index:idx_app_eval_result_job_id"`
+	// 问题ID
+	QuestionID string
+	// 问题
+	Question string `gorm:"type:text"`
+	// 参考答案
+	ReferenceAnswer string `gorm:"type:text"`
+	// 应用回答
+	Answer string `gorm:"type:longtext"`
+	// 回答引用的检索结果
+	SearchList string `gorm:"type:longtext"`
+	// 忠实度
+	Faithfulness float64
+	// 相关性
+	Relevance float64
+	// 正确性
+	Correctness float64
+	// 评分理由
+	Reason string `gorm:"type:text"`
+	// 失败原因
+	ErrorMsg string `gorm:"type:text"`
+	// 回答耗时
+	LatencyMs int64
+}
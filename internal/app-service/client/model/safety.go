@@ -22,3 +22,18 @@ type SensitiveWordVocabulary struct {
 	SensitiveType string `gorm:"index:idx_swv_sensitive_type"`
 	Content       string `gorm:"index:idx_swv_content"`
 }
+
+// GuardrailPolicy 应用护栏策略，输入、输出阶段配置以json存储
+type GuardrailPolicy struct {
+	ID         uint32 `gorm:"primary_key;autoIncrement"`
+	CreatedAt  int64  `gorm:"autoCreateTime:milli"`
+	UpdatedAt  int64  `gorm:"autoUpdateTime:milli"`
+	AppID      string `gorm:"index:idx_guardrail_policy_app"`
+	AppType    string `gorm:"index:idx_guardrail_policy_app"`
+	Enable     bool
+	Input      string `gorm:"type:text"`
+	Output     string `gorm:"type:text"`
+	BlockReply string `gorm:"type:text"`
+	UserID     string `gorm:"index:idx_guardrail_policy_user_id"`
+	OrgID      string `gorm:"index:idx_guardrail_policy_org_id"`
+}
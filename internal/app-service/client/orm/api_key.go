@@ -2,13 +2,37 @@ package orm
 
 import (
 	"context"
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/hex"
+	"errors"
+	"strings"
+	"sync"
+	"time"
 
 	errs "github.com/UnicomAI/wanwu/api/proto/err-code"
 	"github.com/UnicomAI/wanwu/internal/app-service/client/model"
 	"github.com/UnicomAI/wanwu/internal/app-service/client/orm/sqlopt"
+	"github.com/UnicomAI/wanwu/pkg/log"
 	"github.com/UnicomAI/wanwu/pkg/util"
+	"gorm.io/gorm"
 )
 
+const (
+	// apiKeyPrefixLen Api Key 可见前缀长度，如 ww-1a2b3c4d
+	apiKeyPrefixLen = 11
+	// apiKeyLastUsedInterval 最近使用时间的更新间隔，避免每次请求都写库
+	apiKeyLastUsedInterval = time.Minute
+)
+
+// ApiKeyOption Api Key 的授权范围、来源限制与有效期
+type ApiKeyOption struct {
+	Scopes         []string
+	AllowedOrigins []string
+	AllowedIPs     []string
+	ExpiresAt      int64 // 毫秒，0表示永不过期
+}
+
 func (c *Client) GetApiKeyList(ctx context.Context, userId, orgId, appId, appType string) ([]*model.ApiKey, *errs.Status) {
 	var apiKeys []*model.ApiKey
 	if err := sqlopt.SQLOptions(
@@ -30,13 +54,20 @@ func (c *Client) DelApiKey(ctx context.Context, apiId uint32) *errs.Status {
 	return nil
 }
 
-func (c *Client) GenApiKey(ctx context.Context, userId, orgId, appId, appType, apiKey string) (*model.ApiKey, *errs.Status) {
+// GenApiKey 保存Api Key，只存储可见前缀与加盐哈希，明文仅由调用方在创建时返回一次
+func (c *Client) GenApiKey(ctx context.Context, userId, orgId, appId, appType, apiKey string, opt ApiKeyOption) (*model.ApiKey, *errs.Status) {
 	newKey := &model.ApiKey{
-		OrgID:   orgId,
-		UserID:  userId,
-		AppID:   appId,
-		AppType: appType,
-		ApiKey:  apiKey,
+		OrgID:          orgId,
+		UserID:         userId,
+		AppID:          appId,
+		AppType:        appType,
+		Scopes:         strings.Join(opt.Scopes, ","),
+		AllowedOrigins: strings.Join(opt.AllowedOrigins, ","),
+		AllowedIPs:     strings.Join(opt.AllowedIPs, ","),
+		ExpiresAt:      opt.ExpiresAt,
+	}
+	if err := setApiKeyHash(newKey, apiKey); err != nil {
+		return nil, toErrStatus("app_api_keys_gen", err.Error())
 	}
 	if err := c.db.WithContext(ctx).Create(newKey).Error; err != nil {
 		return nil, toErrStatus("app_api_keys_gen", err.Error())
@@ -44,10 +75,158 @@ func (c *Client) GenApiKey(ctx context.Context, userId, orgId, appId, appType, a
 	return newKey, nil
 }
 
+// RotateApiKey 以原Api Key的配置生成新Key，原Key在overlap后失效（原Key更早过期时保持不变）
+func (c *Client) RotateApiKey(ctx context.Context, apiId uint32, userId, apiKey string, overlap time.Duration) (*model.ApiKey, *errs.Status) {
+	var newKey *model.ApiKey
+	err := c.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		oldKey := &model.ApiKey{}
+		if err := sqlopt.SQLOptions(
+			sqlopt.WithID(apiId),
+			sqlopt.WithUserID(userId),
+		).Apply(tx).First(oldKey).Error; err != nil {
+			return err
+		}
+		if isApiKeyExpired(oldKey) {
+			return errors.New("api key expired")
+		}
+		newKey = &model.ApiKey{
+			OrgID:          oldKey.OrgID,
+			UserID:         oldKey.UserID,
+			AppID:          oldKey.AppID,
+			AppType:        oldKey.AppType,
+			Scopes:         oldKey.Scopes,
+			AllowedOrigins: oldKey.AllowedOrigins,
+			AllowedIPs:     oldKey.AllowedIPs,
+			ExpiresAt:      oldKey.ExpiresAt,
+		}
+		if err := setApiKeyHash(newKey, apiKey); err != nil {
+			return err
+		}
+		if err := tx.Create(newKey).Error; err != nil {
+			return err
+		}
+		expiresAt := time.Now().Add(overlap).UnixMilli()
+		if oldKey.ExpiresAt > 0 && oldKey.ExpiresAt < expiresAt {
+			return nil
+		}
+		return tx.Model(oldKey).UpdateColumn("expires_at", expiresAt).Error
+	})
+	if err != nil {
+		return nil, toErrStatus("app_api_key_rotate", util.Int2Str(apiId), err.Error())
+	}
+	return newKey, nil
+}
+
+// GetApiKeyByKey 按可见前缀查找并校验哈希，过期的Key视为无效
 func (c *Client) GetApiKeyByKey(ctx context.Context, apiKey string) (*model.ApiKey, *errs.Status) {
-	ret := &model.ApiKey{}
-	if err := sqlopt.WithApiKey(apiKey).Apply(c.db).WithContext(ctx).First(ret).Error; err != nil {
-		return nil, toErrStatus("app_api_key_get_by_key", err.Error())
+	var candidates []*model.ApiKey
+	if err := sqlopt.WithKeyPrefix(apiKeyPrefix(apiKey)).Apply(c.db.WithContext(ctx)).Find(&candidates).Error; err != nil {
+		return nil, toErrStatus("app_api_keys_get_by_key", err.Error())
+	}
+	var ret *model.ApiKey
+	for _, candidate := range candidates {
+		if subtle.ConstantTimeCompare([]byte(hashApiKey(candidate.KeySalt, apiKey)), []byte(candidate.KeyHash)) == 1 {
+			ret = candidate
+			break
+		}
+	}
+	if ret == nil {
+		return nil, toErrStatus("app_api_keys_get_by_key", gorm.ErrRecordNotFound.Error())
+	}
+	if isApiKeyExpired(ret) {
+		return nil, toErrStatus("app_api_key_expired")
+	}
+	if now := time.Now().UnixMilli(); now-ret.LastUsedAt >= apiKeyLastUsedInterval.Milliseconds() {
+		c.apiKeyUsage.touch(ret.ID, now)
 	}
 	return ret, nil
 }
+
+// apiKeyUsage 暂存Api Key最近使用时间，由后台定时批量写库，不阻塞鉴权请求
+type apiKeyUsage struct {
+	mu   sync.Mutex
+	used map[uint32]int64
+}
+
+func newApiKeyUsage(db *gorm.DB) *apiKeyUsage {
+	u := &apiKeyUsage{used: make(map[uint32]int64)}
+	go func() {
+		defer util.PrintPanicStack()
+		ticker := time.NewTicker(apiKeyLastUsedInterval)
+		defer ticker.Stop()
+		for range ticker.C {
+			u.flush(db)
+		}
+	}()
+	return u
+}
+
+func (u *apiKeyUsage) touch(id uint32, now int64) {
+	u.mu.Lock()
+	defer u.mu.Unlock()
+	u.used[id] = now
+}
+
+func (u *apiKeyUsage) flush(db *gorm.DB) {
+	u.mu.Lock()
+	used := u.used
+	u.used = make(map[uint32]int64)
+	u.mu.Unlock()
+	for id, lastUsedAt := range used {
+		if err := db.Model(&model.ApiKey{}).Where("id = ?", id).UpdateColumn("last_used_at", lastUsedAt).Error; err != nil {
+			log.Warnf("update api key %v last used err: %v", id, err)
+		}
+	}
+}
+
+// migrateApiKeyHash 将历史明文存储的Api Key迁移为前缀+加盐哈希，并清空明文
+func migrateApiKeyHash(db *gorm.DB) error {
+	var apiKeys []*model.ApiKey
+	if err := db.Where("api_key != ?", "").Find(&apiKeys).Error; err != nil {
+		return err
+	}
+	for _, apiKey := range apiKeys {
+		if err := setApiKeyHash(apiKey, apiKey.ApiKey); err != nil {
+			return err
+		}
+		if err := db.Model(apiKey).UpdateColumns(map[string]interface{}{
+			"api_key":    "",
+			"key_prefix": apiKey.KeyPrefix,
+			"key_hash":   apiKey.KeyHash,
+			"key_salt":   apiKey.KeySalt,
+		}).Error; err != nil {
+			return err
+		}
+	}
+	if len(apiKeys) > 0 {
+		log.Infof("migrate %v api key to hash", len(apiKeys))
+	}
+	return nil
+}
+
+func setApiKeyHash(apiKey *model.ApiKey, key string) error {
+	salt := make([]byte, 16)
+	if _, err := rand.Read(salt); err != nil {
+		return err
+	}
+	apiKey.ApiKey = ""
+	apiKey.KeyPrefix = apiKeyPrefix(key)
+	apiKey.KeySalt = hex.EncodeToString(salt)
+	apiKey.KeyHash = hashApiKey(apiKey.KeySalt, key)
+	return nil
+}
+
+func hashApiKey(salt, key string) string {
+	return util.SHA256(salt + key)
+}
+
+func apiKeyPrefix(key string) string {
+	if len(key) <= apiKeyPrefixLen {
+		return key
+	}
+	return key[:apiKeyPrefixLen]
+}
+
+func isApiKeyExpired(apiKey *model.ApiKey) bool {
+	return apiKey.ExpiresAt > 0 && apiKey.ExpiresAt <= time.Now().UnixMilli()
+}
@@ -0,0 +1,368 @@
+package orm
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	errs "github.com/UnicomAI/wanwu/api/proto/err-code"
+	"github.com/UnicomAI/wanwu/internal/app-service/client/model"
+	"github.com/UnicomAI/wanwu/internal/app-service/client/orm/sqlopt"
+	"github.com/UnicomAI/wanwu/pkg/util"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+const (
+	// appEvalClaimBatch 单次领取时查询的候选任务数
+	appEvalClaimBatch = 10
+)
+
+var errAppEvalJobLease = errors.New("app eval job lease lost")
+
+func (c *Client) CreateAppEvalDataset(ctx context.Context, dataset *model.AppEvalDataset, questions []*model.AppEvalQuestion) *errs.Status {
+	dataset.DatasetID = util.GenUUID()
+	dataset.QuestionCount = len(questions)
+	for _, question := range questions {
+		question.QuestionID = util.GenUUID()
+		question.DatasetID = dataset.DatasetID
+	}
+	err := c.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(dataset).Error; err != nil {
+			return err
+		}
+		if len(questions) == 0 {
+			return nil
+		}
+		return tx.Create(questions).Error
+	})
+	if err != nil {
+		return toErrStatus("app_eval_dataset_create", dataset.Name, err.Error())
+	}
+	return nil
+}
+
+func (c *Client) DeleteAppEvalDataset(ctx context.Context, datasetId, userId, orgId string) *errs.Status {
+	dataset, status := c.getAppEvalDataset(ctx, datasetId, userId, orgId)
+	if status != nil {
+		return status
+	}
+	err := c.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		jobQuery := tx.Model(&model.AppEvalJob{}).Select("job_id").Where("dataset_id = ?", dataset.DatasetID)
+		if err := tx.Where("job_id IN (?)", jobQuery).Delete(&model.AppEvalResult{}).Error; err != nil {
+			return err
+		}
+		if err := sqlopt.WithDatasetID(dataset.DatasetID).Apply(tx).Delete(&model.AppEvalJob{}).Error; err != nil {
+			return err
+		}
+		if err := sqlopt.WithDatasetID(dataset.DatasetID).Apply(tx).Delete(&model.AppEvalQuestion{}).Error; err != nil {
+			return err
+		}
+		return sqlopt.WithID(dataset.ID).Apply(tx).Delete(&model.AppEvalDataset{}).Error
+	})
+	if err != nil {
+		return toErrStatus("app_eval_dataset_delete", dataset.Name, err.Error())
+	}
+	return nil
+}
+
+func (c *Client) GetAppEvalDatasetList(ctx context.Context, userId, orgId string) ([]*model.AppEvalDataset, *errs.Status) {
+	var datasets []*model.AppEvalDataset
+	if err := sqlopt.SQLOptions(
+		sqlopt.WithUserID(userId),
+		sqlopt.WithOrgID(orgId),
+	).Apply(c.db.WithContext(ctx)).Order("created_at desc").Find(&datasets).Error; err != nil {
+		return nil, toErrStatus("app_eval_dataset_list", err.Error())
+	}
+	return datasets, nil
+}
+
+func (c *Client) GetAppEvalDataset(ctx context.Context, datasetId, userId, orgId string) (*model.AppEvalDataset, []*model.AppEvalQuestion, *errs.Status) {
+	dataset, status := c.getAppEvalDataset(ctx, datasetId, userId, orgId)
+	if status != nil {
+		return nil, nil, status
+	}
+	var questions []*model.AppEvalQuestion
+	if err := sqlopt.WithDatasetID(dataset.DatasetID).Apply(c.db.WithContext(ctx)).Order("id").Find(&questions).Error; err != nil {
+		return nil, nil, toErrStatus("app_eval_dataset_get")
+	}
+	return dataset, questions, nil
+}
+
+// CreateAppEvalJob 创建评测任务；设置基线时基线须为同一问题集下已完成的评测任务
+func (c *Client) CreateAppEvalJob(ctx context.Context, job *model.AppEvalJob) *errs.Status {
+	dataset, status := c.getAppEvalDataset(ctx, job.DatasetID, job.UserID, job.OrgID)
+	if status != nil {
+		return status
+	}
+	if job.BaselineJobID != "" {
+		baseline, status := c.getAppEvalBaseline(ctx, job)
+		if status != nil || baseline.Status != model.AppEvalJobSuccess {
+			return toErrStatus("app_eval_job_baseline", job.BaselineJobID)
+		}
+	}
+	job.JobID = util.GenUUID()
+	job.Status = model.AppEvalJobProcessing
+	job.TotalCount = dataset.QuestionCount
+	if err := c.db.WithContext(ctx).Create(job).Error; err != nil {
+		return toErrStatus("app_eval_job_create", err.Error())
+	}
+	return nil
+}
+
+// ClaimAppEvalJob 领取一个评测中且租约已过期（新建或执行者已退出）的任务，返回任务及尚未保存评测结果的问题；没有可领取的任务时返回nil
+func (c *Client) ClaimAppEvalJob(ctx context.Context, runnerId string, lease time.Duration) (*model.AppEvalJob, []*model.AppEvalQuestion, *errs.Status) {
+	now := time.Now().UnixMilli()
+	var candidates []*model.AppEvalJob
+	if err := c.db.WithContext(ctx).Where("status = ? AND lease_until < ?", model.AppEvalJobProcessing, now).
+		Order("id").Limit(appEvalClaimBatch).Find(&candidates).Error; err != nil {
+		return nil, nil, toErrStatus("app_eval_job_claim", err.Error())
+	}
+	for _, job := range candidates {
+		// 以原租约到期时间做条件更新，多个执行者同时领取时只有一个成功
+		ret := sqlopt.SQLOptions(
+			sqlopt.WithID(job.ID),
+			sqlopt.WithStatus(model.AppEvalJobProcessing),
+		).Apply(c.db.WithContext(ctx)).Model(&model.AppEvalJob{}).Where("lease_until = ?", job.LeaseUntil).
+			UpdateColumns(map[string]interface{}{"runner_id": runnerId, "lease_until": now + lease.Milliseconds()})
+		if ret.Error != nil {
+			return nil, nil, toErrStatus("app_eval_job_claim", ret.Error.Error())
+		}
+		if ret.RowsAffected == 0 {
+			continue
+		}
+		job.RunnerID, job.LeaseUntil = runnerId, now+lease.Milliseconds()
+		var questions []*model.AppEvalQuestion
+		finished := c.db.WithContext(ctx).Model(&model.AppEvalResult{}).Select("question_id").Where("job_id = ?", job.JobID)
+		if err := sqlopt.WithDatasetID(job.DatasetID).Apply(c.db.WithContext(ctx)).
+			Where("question_id NOT IN (?)", finished).Order("id").Find(&questions).Error; err != nil {
+			return nil, nil, toErrStatus("app_eval_job_claim", err.Error())
+		}
+		return job, questions, nil
+	}
+	return nil, nil, nil
+}
+
+// RenewAppEvalJob 续约评测任务，任务已结束或已被其他执行者领取时返回错误
+func (c *Client) RenewAppEvalJob(ctx context.Context, jobId, runnerId string, lease time.Duration) *errs.Status {
+	ret := sqlopt.SQLOptions(
+		sqlopt.WithJobID(jobId),
+		sqlopt.WithRunnerID(runnerId),
+		sqlopt.WithStatus(model.AppEvalJobProcessing),
+	).Apply(c.db.WithContext(ctx)).Model(&model.AppEvalJob{}).UpdateColumn("lease_until", time.Now().UnixMilli()+lease.Milliseconds())
+	if ret.Error != nil {
+		return toErrStatus("app_eval_job_update", jobId, ret.Error.Error())
+	}
+	if ret.RowsAffected == 0 {
+		return toErrStatus("app_eval_job_lease", jobId)
+	}
+	return nil
+}
+
+// SaveAppEvalResult 保存单个问题的评测结果并更新任务进度；须为当前持有租约的执行者，已保存过的问题忽略
+func (c *Client) SaveAppEvalResult(ctx context.Context, runnerId string, result *model.AppEvalResult) *errs.Status {
+	updates := map[string]interface{}{
+		"finish_count": gorm.Expr("finish_count + 1"),
+	}
+	if result.ErrorMsg != "" {
+		updates["error_count"] = gorm.Expr("error_count + 1")
+	}
+	err := c.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		job := &model.AppEvalJob{}
+		if err := sqlopt.SQLOptions(
+			sqlopt.WithJobID(result.JobID),
+			sqlopt.WithRunnerID(runnerId),
+			sqlopt.WithStatus(model.AppEvalJobProcessing),
+		).Apply(tx).Clauses(clause.Locking{Strength: "UPDATE"}).First(job).Error; err != nil {
+			return errAppEvalJobLease
+		}
+		var count int64
+		if err := sqlopt.WithJobID(result.JobID).Apply(tx).Model(&model.AppEvalResult{}).
+			Where("question_id = ?", result.QuestionID).Count(&count).Error; err != nil {
+			return err
+		}
+		if count > 0 {
+			return nil
+		}
+		if err := tx.Create(result).Error; err != nil {
+			return err
+		}
+		return sqlopt.WithID(job.ID).Apply(tx).Model(&model.AppEvalJob{}).Updates(updates).Error
+	})
+	if errors.Is(err, errAppEvalJobLease) {
+		return toErrStatus("app_eval_job_lease", result.JobID)
+	}
+	if err != nil {
+		return toErrStatus("app_eval_result_save", result.QuestionID, err.Error())
+	}
+	return nil
+}
+
+// FinishAppEvalJob 结束评测任务：errorMsg不为空时任务失败，否则汇总评分成功问题的平均分并校验发布门禁；须为当前持有租约的执行者
+func (c *Client) FinishAppEvalJob(ctx context.Context, jobId, runnerId, errorMsg string) (*model.AppEvalJob, *errs.Status) {
+	job := &model.AppEvalJob{}
+	if err := sqlopt.SQLOptions(
+		sqlopt.WithJobID(jobId),
+		sqlopt.WithRunnerID(runnerId),
+		sqlopt.WithStatus(model.AppEvalJobProcessing),
+	).Apply(c.db.WithContext(ctx)).First(job).Error; err != nil {
+		return nil, toErrStatus("app_eval_job_lease", jobId)
+	}
+	updates := map[string]interface{}{}
+	if errorMsg != "" {
+		job.Status, job.ErrorMsg = model.AppEvalJobFail, errorMsg
+		updates["status"], updates["error_msg"] = job.Status, job.ErrorMsg
+	} else {
+		var avg struct {
+			Faithfulness float64
+			Relevance    float64
+			Correctness  float64
+		}
+		if err := sqlopt.WithJobID(jobId).Apply(c.db.WithContext(ctx)).Model(&model.AppEvalResult{}).
+			Where("error_msg = ?", "").
+			Select("COALESCE(AVG(faithfulness), 0) AS faithfulness, COALESCE(AVG(relevance), 0) AS relevance, COALESCE(AVG(correctness), 0) AS correctness").
+			Scan(&avg).Error; err != nil {
+			return nil, toErrStatus("app_eval_job_update", jobId, err.Error())
+		}
+		job.Status = model.AppEvalJobSuccess
+		job.Faithfulness, job.Relevance, job.Correctness = avg.Faithfulness, avg.Relevance, avg.Correctness
+		var baseline *model.AppEvalJob
+		if job.BaselineJobID != "" {
+			var status *errs.Status
+			if baseline, status = c.getAppEvalBaseline(ctx, job); status != nil {
+				return nil, status
+			}
+		}
+		job.GateStatus, job.GateReason = appEvalGate(job, baseline)
+		updates["status"] = job.Status
+		updates["faithfulness"], updates["relevance"], updates["correctness"] = job.Faithfulness, job.Relevance, job.Correctness
+		updates["gate_status"], updates["gate_reason"] = job.GateStatus, job.GateReason
+	}
+	if err := sqlopt.WithID(job.ID).Apply(c.db.WithContext(ctx)).Model(&model.AppEvalJob{}).Updates(updates).Error; err != nil {
+		return nil, toErrStatus("app_eval_job_update", jobId, err.Error())
+	}
+	return job, nil
+}
+
+// CheckAppEvalGate 发布门禁：应用最近一次设置了门禁且已完成的评测任务未通过时不允许发布；未设置门禁的应用不受限制
+func (c *Client) CheckAppEvalGate(ctx context.Context, appId, appType string) *errs.Status {
+	job := &model.AppEvalJob{}
+	err := sqlopt.SQLOptions(
+		sqlopt.WithAppID(appId),
+		sqlopt.WithAppType(appType),
+		sqlopt.WithStatus(model.AppEvalJobSuccess),
+	).Apply(c.db.WithContext(ctx)).Where("gate_status != ?", model.AppEvalGateNone).Order("id desc").First(job).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil
+	}
+	if err != nil {
+		return toErrStatus("app_eval_job_get")
+	}
+	if job.GateStatus == model.AppEvalGateFail {
+		return toErrStatus("app_eval_gate_fail", job.JobID, job.GateReason)
+	}
+	return nil
+}
+
+func (c *Client) GetAppEvalJobList(ctx context.Context, userId, orgId, datasetId, appId string) ([]*model.AppEvalJob, *errs.Status) {
+	var jobs []*model.AppEvalJob
+	if err := sqlopt.SQLOptions(
+		sqlopt.WithUserID(userId),
+		sqlopt.WithOrgID(orgId),
+		sqlopt.WithDatasetID(datasetId),
+		sqlopt.WithAppID(appId),
+	).Apply(c.db.WithContext(ctx)).Order("created_at desc").Find(&jobs).Error; err != nil {
+		return nil, toErrStatus("app_eval_job_list", err.Error())
+	}
+	return jobs, nil
+}
+
+func (c *Client) GetAppEvalJob(ctx context.Context, jobId, userId, orgId string) (*model.AppEvalJob, []*model.AppEvalResult, *errs.Status) {
+	job, status := c.getAppEvalJob(ctx, jobId, userId, orgId)
+	if status != nil {
+		return nil, nil, status
+	}
+	var results []*model.AppEvalResult
+	if err := sqlopt.WithJobID(job.JobID).Apply(c.db.WithContext(ctx)).Order("id").Find(&results).Error; err != nil {
+		return nil, nil, toErrStatus("app_eval_result_list", jobId, err.Error())
+	}
+	return job, results, nil
+}
+
+// --- internal ---
+
+func (c *Client) getAppEvalDataset(ctx context.Context, datasetId, userId, orgId string) (*model.AppEvalDataset, *errs.Status) {
+	dataset := &model.AppEvalDataset{}
+	if err := sqlopt.SQLOptions(
+		sqlopt.WithDatasetID(datasetId),
+		sqlopt.WithUserID(userId),
+		sqlopt.WithOrgID(orgId),
+	).Apply(c.db.WithContext(ctx)).First(dataset).Error; err != nil {
+		return nil, toErrStatus("app_eval_dataset_get")
+	}
+	return dataset, nil
+}
+
+func (c *Client) getAppEvalJob(ctx context.Context, jobId, userId, orgId string) (*model.AppEvalJob, *errs.Status) {
+	job := &model.AppEvalJob{}
+	if err := sqlopt.SQLOptions(
+		sqlopt.WithJobID(jobId),
+		sqlopt.WithUserID(userId),
+		sqlopt.WithOrgID(orgId),
+	).Apply(c.db.WithContext(ctx)).First(job).Error; err != nil {
+		return nil, toErrStatus("app_eval_job_get")
+	}
+	return job, nil
+}
+
+// getAppEvalBaseline 基线须为同一用户、组织下，同一应用、同一问题集的评测任务
+func (c *Client) getAppEvalBaseline(ctx context.Context, job *model.AppEvalJob) (*model.AppEvalJob, *errs.Status) {
+	baseline := &model.AppEvalJob{}
+	if err := sqlopt.SQLOptions(
+		sqlopt.WithJobID(job.BaselineJobID),
+		sqlopt.WithUserID(job.UserID),
+		sqlopt.WithOrgID(job.OrgID),
+		sqlopt.WithAppID(job.AppID),
+		sqlopt.WithDatasetID(job.DatasetID),
+	).Apply(c.db.WithContext(ctx)).First(baseline).Error; err != nil {
+		return nil, toErrStatus("app_eval_job_baseline", job.BaselineJobID)
+	}
+	return baseline, nil
+}
+
+// appEvalGate 校验发布门禁：存在失败问题、平均分低于最低要求或相对基线下降超过容忍值时不通过
+func appEvalGate(job, baseline *model.AppEvalJob) (int, string) {
+	if job.MinScore <= 0 && baseline == nil {
+		return model.AppEvalGateNone, ""
+	}
+	var reasons []string
+	if job.ErrorCount > 0 {
+		reasons = append(reasons, fmt.Sprintf("%d个问题回答或评分失败", job.ErrorCount))
+	}
+	scores := []struct {
+		name     string
+		score    float64
+		baseline float64
+	}{
+		{"faithfulness", job.Faithfulness, 0},
+		{"relevance", job.Relevance, 0},
+		{"correctness", job.Correctness, 0},
+	}
+	if baseline != nil {
+		scores[0].baseline, scores[1].baseline, scores[2].baseline = baseline.Faithfulness, baseline.Relevance, baseline.Correctness
+	}
+	for _, s := range scores {
+		if job.MinScore > 0 && s.score < job.MinScore {
+			reasons = append(reasons, fmt.Sprintf("%s %.2f 低于最低要求 %.2f", s.name, s.score, job.MinScore))
+		}
+		if baseline != nil && s.score < s.baseline-job.Tolerance {
+			reasons = append(reasons, fmt.Sprintf("%s %.2f 相对基线 %.2f 下降超过 %.2f", s.name, s.score, s.baseline, job.Tolerance))
+		}
+	}
+	if len(reasons) > 0 {
+		return model.AppEvalGateFail, strings.Join(reasons, "; ")
+	}
+	return model.AppEvalGatePass, ""
+}
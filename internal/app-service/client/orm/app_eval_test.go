@@ -0,0 +1,36 @@
+package orm
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/UnicomAI/wanwu/internal/app-service/client/model"
+)
+
+func TestAppEvalGate(t *testing.T) {
+	baseline := &model.AppEvalJob{Faithfulness: 4.5, Relevance: 4.5, Correctness: 4}
+	for _, c := range []struct {
+		name     string
+		job      *model.AppEvalJob
+		baseline *model.AppEvalJob
+		status   int
+		reasons  []string
+	}{
+		{"no gate", &model.AppEvalJob{Faithfulness: 1, Relevance: 1, Correctness: 1, ErrorCount: 2}, nil, model.AppEvalGateNone, nil},
+		{"min score pass", &model.AppEvalJob{MinScore: 4, Faithfulness: 4, Relevance: 4.2, Correctness: 5}, nil, model.AppEvalGatePass, nil},
+		{"min score fail", &model.AppEvalJob{MinScore: 4, Faithfulness: 3.9, Relevance: 4.2, Correctness: 5}, nil, model.AppEvalGateFail, []string{"faithfulness 3.90 低于最低要求 4.00"}},
+		{"error count", &model.AppEvalJob{MinScore: 1, Faithfulness: 5, Relevance: 5, Correctness: 5, ErrorCount: 1}, nil, model.AppEvalGateFail, []string{"1个问题回答或评分失败"}},
+		{"baseline within tolerance", &model.AppEvalJob{Tolerance: 0.5, Faithfulness: 4, Relevance: 4.6, Correctness: 3.5}, baseline, model.AppEvalGatePass, nil},
+		{"baseline regression", &model.AppEvalJob{Tolerance: 0.2, Faithfulness: 4.4, Relevance: 4.5, Correctness: 3.5}, baseline, model.AppEvalGateFail, []string{"correctness 3.50 相对基线 4.00 下降超过 0.20"}},
+		{"baseline and min score", &model.AppEvalJob{MinScore: 4, Faithfulness: 3, Relevance: 4.5, Correctness: 4}, baseline, model.AppEvalGateFail, []string{"faithfulness 3.00 低于最低要求 4.00", "faithfulness 3.00 相对基线 4.50 下降超过 0.00"}},
+	} {
+		status, reason := appEvalGate(c.job, c.baseline)
+		if status != c.status {
+			t.Errorf("%v: gate status %v, want %v (%v)", c.name, status, c.status, reason)
+			continue
+		}
+		if got := strings.Join(c.reasons, "; "); reason != got {
+			t.Errorf("%v: gate reason %q, want %q", c.name, reason, got)
+		}
+	}
+}
@@ -7,7 +7,8 @@ import (
 )
 
 type Client struct {
-	db *gorm.DB
+	db          *gorm.DB
+	apiKeyUsage *apiKeyUsage
 }
 
 func NewClient(db *gorm.DB) (*Client, error) {
@@ -19,12 +20,21 @@ func NewClient(db *gorm.DB) (*Client, error) {
 		model.AppFavorite{},
 		model.SensitiveWordTable{},
 		model.SensitiveWordVocabulary{},
+		model.GuardrailPolicy{},
 		model.AppUrl{},
+		model.AppEvalDataset{},
+		model.AppEvalQuestion{},
+		model.AppEvalJob{},
+		model.AppEvalResult{},
 	); err != nil {
 		return nil, err
 	}
+	if err := migrateApiKeyHash(db); err != nil {
+		return nil, err
+	}
 	return &Client{
-		db: db,
+		db:          db,
+		apiKeyUsage: newApiKeyUsage(db),
 	}, nil
 }
 
@@ -0,0 +1,56 @@
+package orm
+
+import (
+	"context"
+	"errors"
+
+	errs "github.com/UnicomAI/wanwu/api/proto/err-code"
+	"github.com/UnicomAI/wanwu/internal/app-service/client/model"
+	"github.com/UnicomAI/wanwu/internal/app-service/client/orm/sqlopt"
+	"gorm.io/gorm"
+)
+
+// SaveGuardrailPolicy 保存应用护栏策略，不存在时创建
+func (c *Client) SaveGuardrailPolicy(ctx context.Context, policy *model.GuardrailPolicy) *errs.Status {
+	err := c.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		existing := &model.GuardrailPolicy{}
+		err := sqlopt.SQLOptions(
+			sqlopt.WithAppID(policy.AppID),
+			sqlopt.WithAppType(policy.AppType),
+		).Apply(tx).First(existing).Error
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return tx.Create(policy).Error
+		}
+		if err != nil {
+			return err
+		}
+		return sqlopt.WithID(existing.ID).Apply(tx).Model(&model.GuardrailPolicy{}).Updates(map[string]interface{}{
+			"enable":      policy.Enable,
+			"input":       policy.Input,
+			"output":      policy.Output,
+			"block_reply": policy.BlockReply,
+			"user_id":     policy.UserID,
+			"org_id":      policy.OrgID,
+		}).Error
+	})
+	if err != nil {
+		return toErrStatus("app_safety_guardrail_policy_save", policy.AppID, err.Error())
+	}
+	return nil
+}
+
+// GetGuardrailPolicy 获取应用护栏策略，未配置时返回nil
+func (c *Client) GetGuardrailPolicy(ctx context.Context, appId, appType string) (*model.GuardrailPolicy, *errs.Status) {
+	policy := &model.GuardrailPolicy{}
+	err := sqlopt.SQLOptions(
+		sqlopt.WithAppID(appId),
+		sqlopt.WithAppType(appType),
+	).Apply(c.db.WithContext(ctx)).First(policy).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, toErrStatus("app_safety_guardrail_policy_get", appId, err.Error())
+	}
+	return policy, nil
+}
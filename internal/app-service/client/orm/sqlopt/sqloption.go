@@ -97,12 +97,9 @@ func WithIDs(Ids []string) SQLOption {
 	})
 }
 
-func WithApiKey(apiKey string) SQLOption {
+func WithKeyPrefix(keyPrefix string) SQLOption {
 	return funcSQLOption(func(db *gorm.DB) *gorm.DB {
-		if apiKey != "" {
-			return db.Where("api_key = ?", apiKey)
-		}
-		return db
+		return db.Where("key_prefix = ?", keyPrefix)
 	})
 }
 
@@ -219,3 +216,30 @@ func WithContents(contents []string) SQLOption {
 		return db.Where("content IN ?", contents)
 	})
 }
+
+func WithDatasetID(datasetID string) SQLOption {
+	return funcSQLOption(func(db *gorm.DB) *gorm.DB {
+		if datasetID != "" {
+			return db.Where("dataset_id = ?", datasetID)
+		}
+		return db
+	})
+}
+
+func WithJobID(jobID string) SQLOption {
+	return funcSQLOption(func(db *gorm.DB) *gorm.DB {
+		return db.Where("job_id = ?", jobID)
+	})
+}
+
+func WithStatus(status int) SQLOption {
+	return funcSQLOption(func(db *gorm.DB) *gorm.DB {
+		return db.Where("status = ?", status)
+	})
+}
+
+func WithRunnerID(runnerID string) SQLOption {
+	return funcSQLOption(func(db *gorm.DB) *gorm.DB {
+		return db.Where("runner_id = ?", runnerID)
+	})
+}
@@ -12,11 +12,12 @@ var (
 )
 
 type Config struct {
-	Server ServerConfig `json:"server" mapstructure:"server"`
-	Log    LogConfig    `json:"log" mapstructure:"log"`
-	DB     db.Config    `json:"db" mapstructure:"db"`
-	Redis  redis.Config `json:"redis" mapstructure:"redis"`
-	Minio  MinioConfig  `json:"minio" mapstructure:"minio"` // 新增 MinIO 配置
+	Server  ServerConfig  `json:"server" mapstructure:"server"`
+	Log     LogConfig     `json:"log" mapstructure:"log"`
+	DB      db.Config     `json:"db" mapstructure:"db"`
+	Redis   redis.Config  `json:"redis" mapstructure:"redis"`
+	Minio   MinioConfig   `json:"minio" mapstructure:"minio"` // 新增 MinIO 配置
+	Operate ServiceConfig `json:"operate" mapstructure:"operate"`
 }
 
 type ServerConfig struct {
@@ -30,6 +31,10 @@ type LogConfig struct {
 	Logs  []log.Config `json:"logs" mapstructure:"logs"`
 }
 
+type ServiceConfig struct {
+	Host string `json:"host" mapstructure:"host"`
+}
+
 type DBConfig struct {
 	Name string `json:"name" mapstructure:"name"`
 }
@@ -2,20 +2,43 @@ package app
 
 import (
 	"context"
+	"strings"
+	"time"
 
 	app_service "github.com/UnicomAI/wanwu/api/proto/app-service"
 	errs "github.com/UnicomAI/wanwu/api/proto/err-code"
 	"github.com/UnicomAI/wanwu/internal/app-service/client/model"
+	"github.com/UnicomAI/wanwu/internal/app-service/client/orm"
 	"github.com/UnicomAI/wanwu/pkg/util"
 	"google.golang.org/protobuf/types/known/emptypb"
 )
 
 func (s *Service) GenApiKey(ctx context.Context, req *app_service.GenApiKeyReq) (*app_service.ApiKeyInfo, error) {
-	apiKey, err := s.cli.GenApiKey(ctx, req.UserId, req.OrgId, req.AppId, req.AppType, util.GenApiUUID())
+	key := util.GenApiUUID()
+	apiKey, err := s.cli.GenApiKey(ctx, req.UserId, req.OrgId, req.AppId, req.AppType, key, orm.ApiKeyOption{
+		Scopes:         req.Scopes,
+		AllowedOrigins: req.AllowedOrigins,
+		AllowedIPs:     req.AllowedIps,
+		ExpiresAt:      req.ExpiresAt,
+	})
 	if err != nil {
 		return nil, errStatus(errs.Code_AppApikey, err)
 	}
-	return toProtoApiKey(apiKey), nil
+	ret := toProtoApiKey(apiKey)
+	ret.ApiKey = key
+	return ret, nil
+}
+
+// RotateApiKey 轮换Api Key，原Key在重叠期内仍可使用
+func (s *Service) RotateApiKey(ctx context.Context, req *app_service.RotateApiKeyReq) (*app_service.ApiKeyInfo, error) {
+	key := util.GenApiUUID()
+	apiKey, err := s.cli.RotateApiKey(ctx, util.MustU32(req.ApiId), req.UserId, key, time.Duration(req.OverlapSeconds)*time.Second)
+	if err != nil {
+		return nil, errStatus(errs.Code_AppApikey, err)
+	}
+	ret := toProtoApiKey(apiKey)
+	ret.ApiKey = key
+	return ret, nil
 }
 
 func (s *Service) GetApiKeyList(ctx context.Context, req *app_service.GetApiKeyListReq) (*app_service.ApiKeyInfoList, error) {
@@ -52,12 +75,24 @@ func (s *Service) GetApiKeyByKey(ctx context.Context, req *app_service.GetApiKey
 
 func toProtoApiKey(apiKey *model.ApiKey) *app_service.ApiKeyInfo {
 	return &app_service.ApiKeyInfo{
-		ApiId:     util.Int2Str(apiKey.ID),
-		ApiKey:    apiKey.ApiKey,
-		UserId:    apiKey.UserID,
-		OrgId:     apiKey.OrgID,
-		AppId:     apiKey.AppID,
-		AppType:   apiKey.AppType,
-		CreatedAt: apiKey.CreatedAt,
+		ApiId:          util.Int2Str(apiKey.ID),
+		UserId:         apiKey.UserID,
+		OrgId:          apiKey.OrgID,
+		AppId:          apiKey.AppID,
+		AppType:        apiKey.AppType,
+		CreatedAt:      apiKey.CreatedAt,
+		KeyPrefix:      apiKey.KeyPrefix,
+		Scopes:         splitApiKeyList(apiKey.Scopes),
+		AllowedOrigins: splitApiKeyList(apiKey.AllowedOrigins),
+		AllowedIps:     splitApiKeyList(apiKey.AllowedIPs),
+		ExpiresAt:      apiKey.ExpiresAt,
+		LastUsedAt:     apiKey.LastUsedAt,
+	}
+}
+
+func splitApiKeyList(s string) []string {
+	if s == "" {
+		return nil
 	}
+	return strings.Split(s, ",")
 }
@@ -7,6 +7,7 @@ import (
 	errs "github.com/UnicomAI/wanwu/api/proto/err-code"
 	"github.com/UnicomAI/wanwu/internal/app-service/client/model"
 	"github.com/UnicomAI/wanwu/internal/app-service/client/orm"
+	"github.com/UnicomAI/wanwu/pkg/webhook"
 	"google.golang.org/protobuf/types/known/emptypb"
 )
 
@@ -33,10 +34,19 @@ func (s *Service) ChangeExplorationAppFavorite(ctx context.Context, req *app_ser
 }
 
 func (s *Service) PublishApp(ctx context.Context, req *app_service.PublishAppReq) (*emptypb.Empty, error) {
+	if status := s.cli.CheckAppEvalGate(ctx, req.AppId, req.AppType); status != nil {
+		return nil, errStatus(errs.Code_AppEval, status)
+	}
 	err := s.cli.PublishApp(ctx, req.UserId, req.OrgId, req.AppId, req.AppType, req.PublishType)
 	if err != nil {
 		return nil, errStatus(errs.Code_AppExploration, err)
 	}
+	webhook.Emit(req.OrgId, webhook.EventAppPublished, map[string]string{
+		"appId":       req.AppId,
+		"appType":     req.AppType,
+		"publishType": req.PublishType,
+		"userId":      req.UserId,
+	})
 	return &emptypb.Empty{}, nil
 }
 
@@ -45,6 +55,11 @@ func (s *Service) UnPublishApp(ctx context.Context, req *app_service.UnPublishAp
 	if err != nil {
 		return nil, errStatus(errs.Code_AppExploration, err)
 	}
+	webhook.Emit(req.OrgId, webhook.EventAppUnpublished, map[string]string{
+		"appId":   req.AppId,
+		"appType": req.AppType,
+		"userId":  req.UserId,
+	})
 	return &emptypb.Empty{}, nil
 }
 
@@ -0,0 +1,229 @@
+package app
+
+import (
+	"context"
+	"time"
+
+	app_service "github.com/UnicomAI/wanwu/api/proto/app-service"
+	errs "github.com/UnicomAI/wanwu/api/proto/err-code"
+	"github.com/UnicomAI/wanwu/internal/app-service/client/model"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+// CreateAppEvalDataset 创建评测问题集
+func (s *Service) CreateAppEvalDataset(ctx context.Context, req *app_service.CreateAppEvalDatasetReq) (*app_service.AppEvalDatasetInfo, error) {
+	dataset := &model.AppEvalDataset{
+		Name:        req.Name,
+		Description: req.Description,
+		UserID:      req.UserId,
+		OrgID:       req.OrgId,
+	}
+	var questions []*model.AppEvalQuestion
+	for _, question := range req.Questions {
+		questions = append(questions, &model.AppEvalQuestion{
+			Question:        question.Question,
+			ReferenceAnswer: question.ReferenceAnswer,
+		})
+	}
+	if status := s.cli.CreateAppEvalDataset(ctx, dataset, questions); status != nil {
+		return nil, errStatus(errs.Code_AppEval, status)
+	}
+	return appEvalDataset2pb(dataset, questions), nil
+}
+
+// DeleteAppEvalDataset 删除评测问题集及其评测任务
+func (s *Service) DeleteAppEvalDataset(ctx context.Context, req *app_service.AppEvalDatasetReq) (*emptypb.Empty, error) {
+	if status := s.cli.DeleteAppEvalDataset(ctx, req.DatasetId, req.UserId, req.OrgId); status != nil {
+		return nil, errStatus(errs.Code_AppEval, status)
+	}
+	return &emptypb.Empty{}, nil
+}
+
+// GetAppEvalDatasetList 评测问题集列表
+func (s *Service) GetAppEvalDatasetList(ctx context.Context, req *app_service.GetAppEvalDatasetListReq) (*app_service.AppEvalDatasetList, error) {
+	datasets, status := s.cli.GetAppEvalDatasetList(ctx, req.UserId, req.OrgId)
+	if status != nil {
+		return nil, errStatus(errs.Code_AppEval, status)
+	}
+	ret := &app_service.AppEvalDatasetList{Total: int64(len(datasets))}
+	for _, dataset := range datasets {
+		ret.Datasets = append(ret.Datasets, appEvalDataset2pb(dataset, nil))
+	}
+	return ret, nil
+}
+
+// GetAppEvalDataset 评测问题集详情
+func (s *Service) GetAppEvalDataset(ctx context.Context, req *app_service.AppEvalDatasetReq) (*app_service.AppEvalDatasetInfo, error) {
+	dataset, questions, status := s.cli.GetAppEvalDataset(ctx, req.DatasetId, req.UserId, req.OrgId)
+	if status != nil {
+		return nil, errStatus(errs.Code_AppEval, status)
+	}
+	return appEvalDataset2pb(dataset, questions), nil
+}
+
+// CreateAppEvalJob 创建评测任务
+func (s *Service) CreateAppEvalJob(ctx context.Context, req *app_service.CreateAppEvalJobReq) (*app_service.AppEvalJobInfo, error) {
+	job := &model.AppEvalJob{
+		DatasetID:     req.DatasetId,
+		AppID:         req.AppId,
+		AppType:       req.AppType,
+		JudgeModelID:  req.JudgeModelId,
+		BaselineJobID: req.BaselineJobId,
+		Tolerance:     req.Tolerance,
+		MinScore:      req.MinScore,
+		UserID:        req.UserId,
+		OrgID:         req.OrgId,
+	}
+	if status := s.cli.CreateAppEvalJob(ctx, job); status != nil {
+		return nil, errStatus(errs.Code_AppEval, status)
+	}
+	return appEvalJob2pb(job), nil
+}
+
+// ClaimAppEvalJob 领取评测任务
+func (s *Service) ClaimAppEvalJob(ctx context.Context, req *app_service.ClaimAppEvalJobReq) (*app_service.ClaimAppEvalJobResp, error) {
+	job, questions, status := s.cli.ClaimAppEvalJob(ctx, req.RunnerId, time.Duration(req.LeaseSeconds)*time.Second)
+	if status != nil {
+		return nil, errStatus(errs.Code_AppEval, status)
+	}
+	if job == nil {
+		return &app_service.ClaimAppEvalJobResp{}, nil
+	}
+	ret := &app_service.ClaimAppEvalJobResp{Job: appEvalJob2pb(job)}
+	for _, question := range questions {
+		ret.Questions = append(ret.Questions, &app_service.AppEvalQuestion{
+			QuestionId:      question.QuestionID,
+			Question:        question.Question,
+			ReferenceAnswer: question.ReferenceAnswer,
+		})
+	}
+	return ret, nil
+}
+
+// RenewAppEvalJob 续约评测任务
+func (s *Service) RenewAppEvalJob(ctx context.Context, req *app_service.RenewAppEvalJobReq) (*emptypb.Empty, error) {
+	if status := s.cli.RenewAppEvalJob(ctx, req.JobId, req.RunnerId, time.Duration(req.LeaseSeconds)*time.Second); status != nil {
+		return nil, errStatus(errs.Code_AppEval, status)
+	}
+	return &emptypb.Empty{}, nil
+}
+
+// SaveAppEvalResult 保存单个问题的评测结果
+func (s *Service) SaveAppEvalResult(ctx context.Context, req *app_service.SaveAppEvalResultReq) (*emptypb.Empty, error) {
+	result := &model.AppEvalResult{
+		JobID:           req.JobId,
+		QuestionID:      req.Result.GetQuestionId(),
+		Question:        req.Result.GetQuestion(),
+		ReferenceAnswer: req.Result.GetReferenceAnswer(),
+		Answer:          req.Result.GetAnswer(),
+		SearchList:      req.Result.GetSearchList(),
+		Faithfulness:    req.Result.GetScores().GetFaithfulness(),
+		Relevance:       req.Result.GetScores().GetRelevance(),
+		Correctness:     req.Result.GetScores().GetCorrectness(),
+		Reason:          req.Result.GetReason(),
+		ErrorMsg:        req.Result.GetErrorMsg(),
+		LatencyMs:       req.Result.GetLatencyMs(),
+	}
+	if status := s.cli.SaveAppEvalResult(ctx, req.RunnerId, result); status != nil {
+		return nil, errStatus(errs.Code_AppEval, status)
+	}
+	return &emptypb.Empty{}, nil
+}
+
+// FinishAppEvalJob 结束评测任务
+func (s *Service) FinishAppEvalJob(ctx context.Context, req *app_service.FinishAppEvalJobReq) (*app_service.AppEvalJobInfo, error) {
+	job, status := s.cli.FinishAppEvalJob(ctx, req.JobId, req.RunnerId, req.ErrorMsg)
+	if status != nil {
+		return nil, errStatus(errs.Code_AppEval, status)
+	}
+	return appEvalJob2pb(job), nil
+}
+
+// GetAppEvalJobList 评测任务列表
+func (s *Service) GetAppEvalJobList(ctx context.Context, req *app_service.GetAppEvalJobListReq) (*app_service.AppEvalJobList, error) {
+	jobs, status := s.cli.GetAppEvalJobList(ctx, req.UserId, req.OrgId, req.DatasetId, req.AppId)
+	if status != nil {
+		return nil, errStatus(errs.Code_AppEval, status)
+	}
+	ret := &app_service.AppEvalJobList{Total: int64(len(jobs))}
+	for _, job := range jobs {
+		ret.Jobs = append(ret.Jobs, appEvalJob2pb(job))
+	}
+	return ret, nil
+}
+
+// GetAppEvalJob 评测任务详情
+func (s *Service) GetAppEvalJob(ctx context.Context, req *app_service.AppEvalJobReq) (*app_service.AppEvalJobDetail, error) {
+	job, results, status := s.cli.GetAppEvalJob(ctx, req.JobId, req.UserId, req.OrgId)
+	if status != nil {
+		return nil, errStatus(errs.Code_AppEval, status)
+	}
+	ret := &app_service.AppEvalJobDetail{Job: appEvalJob2pb(job)}
+	for _, result := range results {
+		ret.Results = append(ret.Results, &app_service.AppEvalResult{
+			QuestionId:      result.QuestionID,
+			Question:        result.Question,
+			ReferenceAnswer: result.ReferenceAnswer,
+			Answer:          result.Answer,
+			SearchList:      result.SearchList,
+			Scores: &app_service.AppEvalScores{
+				Faithfulness: result.Faithfulness,
+				Relevance:    result.Relevance,
+				Correctness:  result.Correctness,
+			},
+			Reason:    result.Reason,
+			ErrorMsg:  result.ErrorMsg,
+			LatencyMs: result.LatencyMs,
+		})
+	}
+	return ret, nil
+}
+
+func appEvalDataset2pb(dataset *model.AppEvalDataset, questions []*model.AppEvalQuestion) *app_service.AppEvalDatasetInfo {
+	ret := &app_service.AppEvalDatasetInfo{
+		DatasetId:     dataset.DatasetID,
+		Name:          dataset.Name,
+		Description:   dataset.Description,
+		QuestionCount: int32(dataset.QuestionCount),
+		CreatedAt:     dataset.CreatedAt,
+		UserId:        dataset.UserID,
+		OrgId:         dataset.OrgID,
+	}
+	for _, question := range questions {
+		ret.Questions = append(ret.Questions, &app_service.AppEvalQuestion{
+			QuestionId:      question.QuestionID,
+			Question:        question.Question,
+			ReferenceAnswer: question.ReferenceAnswer,
+		})
+	}
+	return ret
+}
+
+func appEvalJob2pb(job *model.AppEvalJob) *app_service.AppEvalJobInfo {
+	return &app_service.AppEvalJobInfo{
+		JobId:         job.JobID,
+		DatasetId:     job.DatasetID,
+		AppId:         job.AppID,
+		AppType:       job.AppType,
+		JudgeModelId:  job.JudgeModelID,
+		BaselineJobId: job.BaselineJobID,
+		Tolerance:     job.Tolerance,
+		MinScore:      job.MinScore,
+		Status:        int32(job.Status),
+		TotalCount:    int32(job.TotalCount),
+		FinishCount:   int32(job.FinishCount),
+		ErrorCount:    int32(job.ErrorCount),
+		Scores: &app_service.AppEvalScores{
+			Faithfulness: job.Faithfulness,
+			Relevance:    job.Relevance,
+			Correctness:  job.Correctness,
+		},
+		GateStatus: int32(job.GateStatus),
+		GateReason: job.GateReason,
+		ErrorMsg:   job.ErrorMsg,
+		CreatedAt:  job.CreatedAt,
+		UpdatedAt:  job.UpdatedAt,
+		UserId:     job.UserID,
+		OrgId:      job.OrgID,
+	}
+}
@@ -0,0 +1,131 @@
+package safety
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	errs "github.com/UnicomAI/wanwu/api/proto/err-code"
+	safety_service "github.com/UnicomAI/wanwu/api/proto/safety-service"
+	"github.com/UnicomAI/wanwu/internal/app-service/client/model"
+	grpc_util "github.com/UnicomAI/wanwu/pkg/grpc-util"
+	"github.com/UnicomAI/wanwu/pkg/guardrail"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+// guardrailStage 护栏阶段配置的存储格式
+type guardrailStage struct {
+	Detectors  []guardrail.Rule     `json:"detectors"`
+	Classifier *guardrailClassifier `json:"classifier,omitempty"`
+}
+
+type guardrailClassifier struct {
+	Enable  bool   `json:"enable"`
+	ModelId string `json:"modelId"`
+	Prompt  string `json:"prompt,omitempty"`
+}
+
+func (s *Service) SaveGuardrailPolicy(ctx context.Context, req *safety_service.GuardrailPolicy) (*emptypb.Empty, error) {
+	input, err := toGuardrailStage(req.Input)
+	if err != nil {
+		return nil, grpc_util.ErrorStatusWithKey(errs.Code_AppSafetyGuardrail, "app_safety_guardrail_policy_invalid", err.Error())
+	}
+	output, err := toGuardrailStage(req.Output)
+	if err != nil {
+		return nil, grpc_util.ErrorStatusWithKey(errs.Code_AppSafetyGuardrail, "app_safety_guardrail_policy_invalid", err.Error())
+	}
+	if status := s.cli.SaveGuardrailPolicy(ctx, &model.GuardrailPolicy{
+		AppID:      req.AppId,
+		AppType:    req.AppType,
+		Enable:     req.Enable,
+		Input:      input,
+		Output:     output,
+		BlockReply: req.BlockReply,
+		UserID:     req.UserId,
+		OrgID:      req.OrgId,
+	}); status != nil {
+		return nil, errStatus(errs.Code_AppSafetyGuardrail, status)
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func (s *Service) GetGuardrailPolicy(ctx context.Context, req *safety_service.GetGuardrailPolicyReq) (*safety_service.GuardrailPolicy, error) {
+	policy, status := s.cli.GetGuardrailPolicy(ctx, req.AppId, req.AppType)
+	if status != nil {
+		return nil, errStatus(errs.Code_AppSafetyGuardrail, status)
+	}
+	if policy == nil {
+		return &safety_service.GuardrailPolicy{
+			AppId:   req.AppId,
+			AppType: req.AppType,
+			Input:   &safety_service.GuardrailStage{},
+			Output:  &safety_service.GuardrailStage{},
+		}, nil
+	}
+	return &safety_service.GuardrailPolicy{
+		AppId:      policy.AppID,
+		AppType:    policy.AppType,
+		Enable:     policy.Enable,
+		Input:      toProtoGuardrailStage(policy.Input),
+		Output:     toProtoGuardrailStage(policy.Output),
+		BlockReply: policy.BlockReply,
+		OrgId:      policy.OrgID,
+		UserId:     policy.UserID,
+		UpdatedAt:  policy.UpdatedAt,
+	}, nil
+}
+
+// toGuardrailStage 校验规则（检测器类型、动作、正则）并转换为存储格式
+func toGuardrailStage(stage *safety_service.GuardrailStage) (string, error) {
+	ret := &guardrailStage{Detectors: []guardrail.Rule{}}
+	for _, detector := range stage.GetDetectors() {
+		ret.Detectors = append(ret.Detectors, guardrail.Rule{
+			Type:    detector.Type,
+			Name:    detector.Name,
+			Pattern: detector.Pattern,
+			Action:  detector.Action,
+		})
+	}
+	if _, err := guardrail.NewStage(ret.Detectors, nil); err != nil {
+		return "", err
+	}
+	if classifier := stage.GetClassifier(); classifier != nil {
+		if classifier.Enable && classifier.ModelId == "" {
+			return "", fmt.Errorf("classifier model empty")
+		}
+		ret.Classifier = &guardrailClassifier{
+			Enable:  classifier.Enable,
+			ModelId: classifier.ModelId,
+			Prompt:  classifier.Prompt,
+		}
+	}
+	b, err := json.Marshal(ret)
+	if err != nil {
+		return "", err
+	}
+	return string(b), nil
+}
+
+func toProtoGuardrailStage(config string) *safety_service.GuardrailStage {
+	ret := &safety_service.GuardrailStage{}
+	stage := &guardrailStage{}
+	if config == "" || json.Unmarshal([]byte(config), stage) != nil {
+		return ret
+	}
+	for _, rule := range stage.Detectors {
+		ret.Detectors = append(ret.Detectors, &safety_service.GuardrailDetector{
+			Type:    rule.Type,
+			Name:    rule.Name,
+			Pattern: rule.Pattern,
+			Action:  rule.Action,
+		})
+	}
+	if stage.Classifier != nil {
+		ret.Classifier = &safety_service.GuardrailClassifier{
+			Enable:  stage.Classifier.Enable,
+			ModelId: stage.Classifier.ModelId,
+			Prompt:  stage.Classifier.Prompt,
+		}
+	}
+	return ret
+}
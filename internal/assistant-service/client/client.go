@@ -44,8 +44,16 @@ type IClient interface {
 	//================Conversation================
 	CreateConversation(ctx context.Context, conversation *model.Conversation) *err_code.Status
 	UpdateConversation(ctx context.Context, conversation *model.Conversation) *err_code.Status
+	UpdateConversationSummary(ctx context.Context, conversationID uint32, summary string, summaryUntil int64) *err_code.Status
+	UpdateConversationActive(ctx context.Context, conversationID uint32, activeID string) *err_code.Status
 	DeleteConversation(ctx context.Context, conversationID uint32) *err_code.Status
 	GetConversation(ctx context.Context, conversationID uint32) (*model.Conversation, *err_code.Status)
 	GetConversationList(ctx context.Context, assistantID, userID, orgID string, offset, limit int32) ([]*model.Conversation, int64, *err_code.Status)
 	DeleteConversationByAssistantID(ctx context.Context, assistantID, userID, orgID string) *err_code.Status
+
+	//================ConversationFeedback================
+	SaveConversationFeedback(ctx context.Context, feedback *model.ConversationFeedback) *err_code.Status
+	DeleteConversationFeedback(ctx context.Context, detailId, userId string) *err_code.Status
+	GetConversationFeedbackRatings(ctx context.Context, userId string, detailIds []string) (map[string]int32, *err_code.Status)
+	GetConversationFeedbackList(ctx context.Context, orgId string, assistantId uint32, rating int32, startTime, endTime int64, offset, limit int32) ([]*model.ConversationFeedback, int64, *err_code.Status)
 }
@@ -1,11 +1,14 @@
 package model
 
 type Conversation struct {
-	ID          uint32 `gorm:"primarykey;column:id;comment:对话Id"`
-	AssistantId uint32 `gorm:"column:assistant_id;comment:'智能体id'"`
-	Title       string `gorm:"column:title;type:text;comment:'对话标题'"`
-	UserId      string `gorm:"column:user_id;index:idx_conversation_user_id;comment:用户id"`
-	OrgId       string `gorm:"column:org_id;index:idx_conversation_org_id;comment:组织id"`
-	CreatedAt   int64  `gorm:"autoCreateTime:milli;comment:创建时间"`
-	UpdatedAt   int64  `gorm:"autoUpdateTime:milli;comment:更新时间"`
+	ID           uint32 `gorm:"primarykey;column:id;comment:对话Id"`
+	AssistantId  uint32 `gorm:"column:assistant_id;comment:'智能体id'"`
+	Title        string `gorm:"column:title;type:text;comment:'对话标题'"`
+	Summary      string `gorm:"column:summary;type:longtext;comment:'窗口外历史对话的摘要'"`
+	SummaryUntil int64  `gorm:"column:summary_until;comment:'摘要已覆盖的最后一轮对话的创建时间'"`
+	ActiveId     string `gorm:"column:active_id;type:varchar(64);comment:'当前分支最后一轮对话id'"`
+	UserId       string `gorm:"column:user_id;index:idx_conversation_user_id;comment:用户id"`
+	OrgId        string `gorm:"column:org_id;index:idx_conversation_org_id;comment:组织id"`
+	CreatedAt    int64  `gorm:"autoCreateTime:milli;comment:创建时间"`
+	UpdatedAt    int64  `gorm:"autoUpdateTime:milli;comment:更新时间"`
 }
@@ -4,6 +4,7 @@ type ConversationDetails struct {
 	Id             string `json:"id"`
 	AssistantId    string `json:"assistantId"`
 	ConversationId string `json:"conversationId"`
+	ParentId       string `json:"parentId"` // 上一轮对话id，首轮为对话id；历史数据为空，按时间顺序串联
 	Prompt         string `json:"prompt"`
 	SysPrompt      string `json:"sysPrompt"`
 	Response       string `json:"response"`
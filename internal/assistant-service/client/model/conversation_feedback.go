@@ -0,0 +1,19 @@
+package model
+
+// ConversationFeedback 用户对智能体某轮回答的评价，同一用户对同一轮对话仅保留一条
+type ConversationFeedback struct {
+	ID             uint32 `gorm:"primarykey;column:id;comment:评价Id"`
+	AssistantId    uint32 `gorm:"column:assistant_id;index:idx_conversation_feedback_assistant_id;comment:智能体id"`
+	ConversationId uint32 `gorm:"column:conversation_id;comment:对话id"`
+	DetailId       string `gorm:"column:detail_id;type:varchar(64);uniqueIndex:idx_conversation_feedback_detail_user;comment:对话详情id"`
+	Rating         int32  `gorm:"column:rating;comment:评价，1点赞，-1点踩"`
+	Reasons        string `gorm:"column:reasons;type:text;comment:原因标签，json数组"`
+	Content        string `gorm:"column:content;type:text;comment:评价内容"`
+	Prompt         string `gorm:"column:prompt;type:longtext;comment:问题"`
+	Response       string `gorm:"column:response;type:longtext;comment:回答"`
+	SearchList     string `gorm:"column:search_list;type:longtext;comment:回答时检索到的知识片段快照"`
+	UserId         string `gorm:"column:user_id;type:varchar(64);uniqueIndex:idx_conversation_feedback_detail_user;comment:评价用户id"`
+	OrgId          string `gorm:"column:org_id;index:idx_conversation_feedback_org_id;comment:智能体所属组织id"`
+	CreatedAt      int64  `gorm:"autoCreateTime:milli;index:idx_conversation_feedback_created_at;comment:创建时间"`
+	UpdatedAt      int64  `gorm:"autoUpdateTime:milli;comment:更新时间"`
+}
@@ -40,6 +40,7 @@ func (c *Client) UpdateAssistant(ctx context.Context, assistant *model.Assistant
 			"rerank_config":        assistant.RerankConfig,
 			"online_search_config": assistant.OnlineSearchConfig,
 			"safety_config":        assistant.SafetyConfig,
+			"memory_config":        assistant.MemoryConfig,
 		}).Error; err != nil {
 			return toErrStatus("assistant_update", err.Error())
 		}
@@ -35,6 +35,31 @@ func (c *Client) UpdateConversation(ctx context.Context, conversation *model.Con
 	})
 }
 
+// UpdateConversationSummary 更新对话历史摘要及其覆盖的最后一轮对话的创建时间
+func (c *Client) UpdateConversationSummary(ctx context.Context, conversationID uint32, summary string, summaryUntil int64) *err_code.Status {
+	return c.transaction(ctx, func(tx *gorm.DB) *err_code.Status {
+		if err := sqlopt.WithID(conversationID).Apply(tx).Model(&model.Conversation{}).Updates(map[string]interface{}{
+			"summary":       summary,
+			"summary_until": summaryUntil,
+		}).Error; err != nil {
+			return toErrStatus("assistant_conversation_update", err.Error())
+		}
+		return nil
+	})
+}
+
+// UpdateConversationActive 更新对话当前分支最后一轮对话id
+func (c *Client) UpdateConversationActive(ctx context.Context, conversationID uint32, activeID string) *err_code.Status {
+	return c.transaction(ctx, func(tx *gorm.DB) *err_code.Status {
+		if err := sqlopt.WithID(conversationID).Apply(tx).Model(&model.Conversation{}).Updates(map[string]interface{}{
+			"active_id": activeID,
+		}).Error; err != nil {
+			return toErrStatus("assistant_conversation_update", err.Error())
+		}
+		return nil
+	})
+}
+
 func (c *Client) DeleteConversation(ctx context.Context, conversationID uint32) *err_code.Status {
 	return c.transaction(ctx, func(tx *gorm.DB) *err_code.Status {
 		if err := sqlopt.WithID(conversationID).Apply(tx).Delete(&model.Conversation{}).Error; err != nil {
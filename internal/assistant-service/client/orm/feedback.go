@@ -0,0 +1,95 @@
+package orm
+
+import (
+	"context"
+	"errors"
+
+	err_code "github.com/UnicomAI/wanwu/api/proto/err-code"
+	"github.com/UnicomAI/wanwu/internal/assistant-service/client/model"
+	"github.com/UnicomAI/wanwu/internal/assistant-service/client/orm/sqlopt"
+	"gorm.io/gorm"
+)
+
+// SaveConversationFeedback 保存评价，同一用户对同一轮对话已有评价时覆盖
+func (c *Client) SaveConversationFeedback(ctx context.Context, feedback *model.ConversationFeedback) *err_code.Status {
+	return c.transaction(ctx, func(tx *gorm.DB) *err_code.Status {
+		existing := &model.ConversationFeedback{}
+		err := sqlopt.SQLOptions(
+			sqlopt.WithDetailID(feedback.DetailId),
+			sqlopt.WithUserId(feedback.UserId),
+		).Apply(tx).First(existing).Error
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			if err := tx.Create(feedback).Error; err != nil {
+				return toErrStatus("assistant_feedback_submit", err.Error())
+			}
+			return nil
+		} else if err != nil {
+			return toErrStatus("assistant_feedback_submit", err.Error())
+		}
+		feedback.ID = existing.ID
+		if err := tx.Model(existing).Updates(map[string]interface{}{
+			"rating":      feedback.Rating,
+			"reasons":     feedback.Reasons,
+			"content":     feedback.Content,
+			"prompt":      feedback.Prompt,
+			"response":    feedback.Response,
+			"search_list": feedback.SearchList,
+		}).Error; err != nil {
+			return toErrStatus("assistant_feedback_submit", err.Error())
+		}
+		return nil
+	})
+}
+
+// DeleteConversationFeedback 取消评价
+func (c *Client) DeleteConversationFeedback(ctx context.Context, detailId, userId string) *err_code.Status {
+	return c.transaction(ctx, func(tx *gorm.DB) *err_code.Status {
+		if err := sqlopt.SQLOptions(
+			sqlopt.WithDetailID(detailId),
+			sqlopt.WithUserId(userId),
+		).Apply(tx).Delete(&model.ConversationFeedback{}).Error; err != nil {
+			return toErrStatus("assistant_feedback_submit", err.Error())
+		}
+		return nil
+	})
+}
+
+// GetConversationFeedbackRatings 获取用户对多轮对话的评价，key为对话详情id
+func (c *Client) GetConversationFeedbackRatings(ctx context.Context, userId string, detailIds []string) (map[string]int32, *err_code.Status) {
+	ret := make(map[string]int32)
+	if len(detailIds) == 0 {
+		return ret, nil
+	}
+	var feedbacks []*model.ConversationFeedback
+	if err := sqlopt.SQLOptions(
+		sqlopt.WithDetailIDs(detailIds),
+		sqlopt.WithUserId(userId),
+	).Apply(c.db.WithContext(ctx)).Select("detail_id", "rating").Find(&feedbacks).Error; err != nil {
+		return nil, toErrStatus("assistant_feedback_get_list", err.Error())
+	}
+	for _, feedback := range feedbacks {
+		ret[feedback.DetailId] = feedback.Rating
+	}
+	return ret, nil
+}
+
+// GetConversationFeedbackList 按智能体、评价与时间范围查询组织内的评价，按创建时间倒序
+func (c *Client) GetConversationFeedbackList(ctx context.Context, orgId string, assistantId uint32, rating int32, startTime, endTime int64, offset, limit int32) ([]*model.ConversationFeedback, int64, *err_code.Status) {
+	var feedbacks []*model.ConversationFeedback
+	var count int64
+	return feedbacks, count, c.transaction(ctx, func(tx *gorm.DB) *err_code.Status {
+		query := sqlopt.SQLOptions(
+			sqlopt.WithOrgID(orgId),
+			sqlopt.WithAssistantIDIfNotZero(assistantId),
+			sqlopt.WithRating(rating),
+			sqlopt.WithCreatedAtRange(startTime, endTime),
+		).Apply(tx.Model(&model.ConversationFeedback{}))
+		if err := query.Count(&count).Error; err != nil {
+			return toErrStatus("assistant_feedback_get_list", err.Error())
+		}
+		if err := query.Offset(int(offset)).Limit(int(limit)).Order("created_at DESC").Find(&feedbacks).Error; err != nil {
+			return toErrStatus("assistant_feedback_get_list", err.Error())
+		}
+		return nil
+	})
+}
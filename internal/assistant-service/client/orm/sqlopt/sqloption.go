@@ -97,3 +97,45 @@ func WithWorkflowID(workflowId string) SQLOption {
 		return db.Where("workflow_id = ?", workflowId)
 	})
 }
+
+func WithAssistantIDIfNotZero(assistantId uint32) SQLOption {
+	return funcSQLOption(func(db *gorm.DB) *gorm.DB {
+		if assistantId != 0 {
+			return db.Where("assistant_id = ?", assistantId)
+		}
+		return db
+	})
+}
+
+func WithDetailID(detailId string) SQLOption {
+	return funcSQLOption(func(db *gorm.DB) *gorm.DB {
+		return db.Where("detail_id = ?", detailId)
+	})
+}
+
+func WithDetailIDs(detailIds []string) SQLOption {
+	return funcSQLOption(func(db *gorm.DB) *gorm.DB {
+		return db.Where("detail_id IN ?", detailIds)
+	})
+}
+
+func WithRating(rating int32) SQLOption {
+	return funcSQLOption(func(db *gorm.DB) *gorm.DB {
+		if rating != 0 {
+			return db.Where("rating = ?", rating)
+		}
+		return db
+	})
+}
+
+func WithCreatedAtRange(startTime, endTime int64) SQLOption {
+	return funcSQLOption(func(db *gorm.DB) *gorm.DB {
+		if startTime > 0 {
+			db = db.Where("created_at >= ?", startTime)
+		}
+		if endTime > 0 {
+			db = db.Where("created_at <= ?", endTime)
+		}
+		return db
+	})
+}
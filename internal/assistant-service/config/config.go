@@ -23,6 +23,7 @@ type Config struct {
 	Knowledge Knowledge    `mapstructure:"knowledge" json:"knowledge" yaml:"knowledge"`
 	MCP       Mcp          `mapstructure:"mcp" json:"mcp"`
 	Workflow  Workflow     `mapstructure:"workflow" json:"workflow"`
+	Operate   Operate      `mapstructure:"operate" json:"operate"`
 }
 
 type Knowledge struct {
@@ -33,6 +34,10 @@ type Mcp struct {
 	Host string `mapstructure:"host" json:"host" yaml:"host"`
 }
 
+type Operate struct {
+	Host string `mapstructure:"host" json:"host" yaml:"host"`
+}
+
 type Workflow struct {
 	Endpoint      string `mapstructure:"endpoint" json:"endpoint" yaml:"endpoint"`
 	ListSchemaUri string `mapstructure:"list_schema_uri" json:"list_schema_uri" yaml:"list_schema_uri"`
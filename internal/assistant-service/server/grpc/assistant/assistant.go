@@ -9,7 +9,9 @@ import (
 	assistant_service "github.com/UnicomAI/wanwu/api/proto/assistant-service"
 	"github.com/UnicomAI/wanwu/api/proto/common"
 	errs "github.com/UnicomAI/wanwu/api/proto/err-code"
+	knowledgebase_service "github.com/UnicomAI/wanwu/api/proto/knowledgebase-service"
 	"github.com/UnicomAI/wanwu/internal/assistant-service/client/model"
+	grpc_util "github.com/UnicomAI/wanwu/pkg/grpc-util"
 	"github.com/UnicomAI/wanwu/pkg/log"
 	"github.com/UnicomAI/wanwu/pkg/util"
 	"google.golang.org/protobuf/types/known/emptypb"
@@ -169,6 +171,9 @@ func (s *Service) AssistantConfigUpdate(ctx context.Context, req *assistant_serv
 
 	// 处理knowledgeBaseConfig，转换成json字符串之后再更新
 	if req.KnowledgeBaseConfig != nil {
+		if err := checkKnowledgeOwner(ctx, existingAssistant, req.KnowledgeBaseConfig.KnowledgeBaseIds); err != nil {
+			return nil, err
+		}
 		knowledgeBaseConfigBytes, err := json.Marshal(req.KnowledgeBaseConfig)
 		if err != nil {
 			return nil, errStatus(errs.Code_AssistantErr, &errs.Status{
@@ -204,6 +209,18 @@ func (s *Service) AssistantConfigUpdate(ctx context.Context, req *assistant_serv
 		existingAssistant.SafetyConfig = string(safetyConfigBytes)
 	}
 
+	// 处理memoryConfig，转换成json字符串之后再更新
+	if req.MemoryConfig != nil {
+		memoryConfigBytes, err := json.Marshal(req.MemoryConfig)
+		if err != nil {
+			return nil, errStatus(errs.Code_AssistantErr, &errs.Status{
+				TextKey: "assistant_memoryConfig_marshal",
+				Args:    []string{err.Error()},
+			})
+		}
+		existingAssistant.MemoryConfig = string(memoryConfigBytes)
+	}
+
 	// 调用client方法更新智能体
 	if status := s.cli.UpdateAssistant(ctx, existingAssistant); status != nil {
 		return nil, errStatus(errs.Code_AssistantErr, status)
@@ -352,6 +369,18 @@ func (s *Service) GetAssistantInfo(ctx context.Context, req *assistant_service.G
 		}
 	}
 
+	// 处理assistant.MemoryConfig，转换成AssistantMemoryConfig
+	var memoryConfig *assistant_service.AssistantMemoryConfig
+	if assistant.MemoryConfig != "" {
+		memoryConfig = &assistant_service.AssistantMemoryConfig{}
+		if err := json.Unmarshal([]byte(assistant.MemoryConfig), memoryConfig); err != nil {
+			return nil, errStatus(errs.Code_AssistantErr, &errs.Status{
+				TextKey: "assistant_memoryConfig_unmarshal",
+				Args:    []string{err.Error()},
+			})
+		}
+	}
+
 	return &assistant_service.AssistantInfo{
 		AssistantId: strconv.FormatUint(uint64(assistant.ID), 10),
 		Identity: &assistant_service.Identity{
@@ -371,6 +400,7 @@ func (s *Service) GetAssistantInfo(ctx context.Context, req *assistant_service.G
 		RerankConfig:        rerankConfig,
 		OnlineSearchConfig:  onlineSearchConfig,
 		SafetyConfig:        safetyConfig,
+		MemoryConfig:        memoryConfig,
 		Scope:               int32(assistant.Scope),
 		WorkFlowInfos:       workFlowInfos,
 		McpInfos:            mcpInfos,
@@ -379,3 +409,24 @@ func (s *Service) GetAssistantInfo(ctx context.Context, req *assistant_service.G
 		UpdateTime:          assistant.UpdatedAt,
 	}, nil
 }
+
+// checkKnowledgeOwner 保存配置时校验知识库属于同一创建人，智能体对话只能使用一个知识库创建人的身份检索
+func checkKnowledgeOwner(ctx context.Context, assistant *model.Assistant, knowledgeIds []string) error {
+	if len(knowledgeIds) == 0 {
+		return nil
+	}
+	knowledgeInfoList, err := Knowledge.SelectKnowledgeDetailByIdList(ctx, &knowledgebase_service.KnowledgeDetailSelectListReq{
+		KnowledgeIds: knowledgeIds,
+		UserId:       assistant.UserId,
+		OrgId:        assistant.OrgId,
+	})
+	if err != nil {
+		return err
+	}
+	for _, v := range knowledgeInfoList.List {
+		if v.UserId != knowledgeInfoList.List[0].UserId {
+			return grpc_util.ErrorStatus(errs.Code_KnowledgeBaseMultiOwner)
+		}
+	}
+	return nil
+}
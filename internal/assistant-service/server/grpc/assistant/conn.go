@@ -7,6 +7,7 @@ import (
 	mcp_service "github.com/UnicomAI/wanwu/api/proto/mcp-service"
 	"github.com/UnicomAI/wanwu/internal/assistant-service/config"
 	"github.com/UnicomAI/wanwu/pkg/log"
+	"github.com/UnicomAI/wanwu/pkg/webhook"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials/insecure"
 )
@@ -38,6 +39,10 @@ func StartService() error {
 	MCP = mcp_service.NewMCPServiceClient(MCPConn)
 	log.Infof("MCP init success")
 	log.Infof("MCP: %s", config.Cfg().MCP.Host)
+
+	if err := webhook.Init(config.Cfg().Operate.Host); err != nil {
+		return fmt.Errorf("init webhook emitter err: %v", err)
+	}
 	return nil
 }
 
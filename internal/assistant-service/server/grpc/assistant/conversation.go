@@ -23,12 +23,15 @@ import (
 	"github.com/UnicomAI/wanwu/internal/assistant-service/client/model"
 	"github.com/UnicomAI/wanwu/internal/assistant-service/config"
 	"github.com/UnicomAI/wanwu/internal/assistant-service/pkg/util"
+	"github.com/UnicomAI/wanwu/pkg/constant"
 	"github.com/UnicomAI/wanwu/pkg/es"
 	grpc_util "github.com/UnicomAI/wanwu/pkg/grpc-util"
 	http_client "github.com/UnicomAI/wanwu/pkg/http-client"
 	"github.com/UnicomAI/wanwu/pkg/log"
 	mp "github.com/UnicomAI/wanwu/pkg/model-provider"
+	mp_common "github.com/UnicomAI/wanwu/pkg/model-provider/mp-common"
 	pkgUtil "github.com/UnicomAI/wanwu/pkg/util"
+	"github.com/UnicomAI/wanwu/pkg/webhook"
 
 	"github.com/google/uuid"
 	"google.golang.org/protobuf/types/known/emptypb"
@@ -110,60 +113,25 @@ func (s *Service) GetConversationList(ctx context.Context, req *assistant_servic
 	}, nil
 }
 
-// GetConversationDetailList 对话详情历史列表
+// GetConversationDetailList 对话详情历史列表，返回当前分支上的对话
 func (s *Service) GetConversationDetailList(ctx context.Context, req *assistant_service.GetConversationDetailListReq) (*assistant_service.GetConversationDetailListResp, error) {
-	// 计算分页参数
-	from := (req.PageNo - 1) * req.PageSize
-	size := int(req.PageSize)
-
-	// 组装查询条件
-	fieldConditions := map[string]interface{}{
-		"conversationId": req.ConversationId,
-		"userId":         req.Identity.UserId,
-		"orgId":          req.Identity.OrgId,
-	}
-
-	// 使用通配符查询所有对话详情索引
-	indexPattern := "conversation_detail_infos_*"
-
-	// 从ES查询数据
-	documents, total, err := es.Assistant().SearchByFields(ctx, indexPattern, fieldConditions, int(from), size)
+	conversation, tree, err := s.getConversationTree(ctx, req.ConversationId, req.Identity)
 	if err != nil {
-		log.Errorf("从ES查询对话详情失败，conversationId: %s, userId: %s, error: %v", req.ConversationId, req.Identity.UserId, err)
-		return nil, fmt.Errorf("查询对话详情失败: %v", err)
+		return nil, err
 	}
 
-	// 转换查询结果为响应格式
+	// 当前分支按创建时间倒序分页
+	var branch []*model.ConversationDetails
+	if leaf := tree.activeLeaf(conversation.ActiveId); leaf != nil {
+		branch = tree.path(leaf.Id)
+	}
+	total := int64(len(branch))
+	from := int((req.PageNo - 1) * req.PageSize)
 	var conversationDetails []*assistant_service.ConversionDetailInfo
-	for _, doc := range documents {
-		var detail model.ConversationDetails
-		if err := json.Unmarshal(doc, &detail); err != nil {
-			log.Warnf("解析ES文档失败: %v", err)
-			continue
-		}
-
-		// 替换fileUrl为minio对外下载url
-		downloadURL := os.Getenv("MINIO_DOWNLOAD_URL")
-		minioEndpoint := os.Getenv("MINIO_ENDPOINT")
-		detail.FileUrl = strings.Replace(detail.FileUrl, "http://"+minioEndpoint+"/", downloadURL, 1)
-
-		conversationDetails = append(conversationDetails, &assistant_service.ConversionDetailInfo{
-			Id:              detail.Id,
-			AssistantId:     detail.AssistantId,
-			ConversationId:  detail.ConversationId,
-			Prompt:          detail.Prompt,
-			SysPrompt:       detail.SysPrompt,
-			Response:        detail.Response,
-			SearchList:      detail.SearchList,
-			QaType:          detail.QaType,
-			CreatedBy:       detail.UserId, // 使用CreatedBy字段映射UserId
-			CreatedAt:       detail.CreatedAt,
-			UpdatedAt:       detail.UpdatedAt,
-			RequestFileUrls: []string{detail.FileUrl},
-			FileSize:        detail.FileSize,
-			FileName:        detail.FileName,
-		})
+	for i := len(branch) - 1 - from; i >= 0 && i > len(branch)-1-from-int(req.PageSize); i-- {
+		conversationDetails = append(conversationDetails, tree.toDetailInfo(branch[i]))
 	}
+	s.setFeedbackRatings(ctx, req.Identity.UserId, conversationDetails)
 
 	log.Infof("成功从ES查询对话详情，conversationId: %s, userId: %s, 总数: %d, 返回: %d",
 		req.ConversationId, req.Identity.UserId, total, len(conversationDetails))
@@ -176,6 +144,31 @@ func (s *Service) GetConversationDetailList(ctx context.Context, req *assistant_
 	}, nil
 }
 
+func toConversionDetailInfo(detail *model.ConversationDetails) *assistant_service.ConversionDetailInfo {
+	// 替换fileUrl为minio对外下载url
+	downloadURL := os.Getenv("MINIO_DOWNLOAD_URL")
+	minioEndpoint := os.Getenv("MINIO_ENDPOINT")
+	fileUrl := strings.Replace(detail.FileUrl, "http://"+minioEndpoint+"/", downloadURL, 1)
+
+	return &assistant_service.ConversionDetailInfo{
+		Id:              detail.Id,
+		AssistantId:     detail.AssistantId,
+		ConversationId:  detail.ConversationId,
+		ParentId:        detail.ParentId,
+		Prompt:          detail.Prompt,
+		SysPrompt:       detail.SysPrompt,
+		Response:        detail.Response,
+		SearchList:      detail.SearchList,
+		QaType:          detail.QaType,
+		CreatedBy:       detail.UserId, // 使用CreatedBy字段映射UserId
+		CreatedAt:       detail.CreatedAt,
+		UpdatedAt:       detail.UpdatedAt,
+		RequestFileUrls: []string{fileUrl},
+		FileSize:        detail.FileSize,
+		FileName:        detail.FileName,
+	}
+}
+
 // AssistantConversionStream 智能体流式对话
 func (s *Service) AssistantConversionStream(req *assistant_service.AssistantConversionStreamReq, stream assistant_service.AssistantService_AssistantConversionStreamServer) error {
 	ctx := stream.Context()
@@ -190,6 +183,13 @@ func (s *Service) AssistantConversionStream(req *assistant_service.AssistantConv
 	var streamStarted bool
 	var conversationSaved bool // 标记是否已经保存过对话
 
+	// 先确定上一轮对话，保证异常时保存的对话也挂在正确的分支上
+	var conversation *model.Conversation
+	var tree *conversationTree
+	if !req.Trial && req.ConversationId != "" {
+		conversation, tree = s.setParentId(ctx, req)
+	}
+
 	// 使用defer统一处理上下文取消的情况
 	defer func() {
 		// 只有在上下文被手动取消且还未保存过对话时，才保存"已被终止"消息
@@ -207,7 +207,7 @@ func (s *Service) AssistantConversionStream(req *assistant_service.AssistantConv
 				terminationMessage = fullResponse.String() + "\n本次回答已被终止"
 			}
 
-			saveConversation(ctx, req, terminationMessage, searchList)
+			s.saveConversation(ctx, req, terminationMessage, searchList)
 			log.Infof("因上下文取消保存终止消息，assistantId: %s, conversationId: %s", req.AssistantId, req.ConversationId)
 		}
 	}()
@@ -223,7 +223,7 @@ func (s *Service) AssistantConversionStream(req *assistant_service.AssistantConv
 	if status != nil {
 		log.Errorf("Assistant服务获取智能体信息失败，assistantId: %s, error: %v", req.AssistantId, status)
 		SSEError(stream, "智能体信息获取失败")
-		saveConversation(ctx, req, "智能体信息获取失败", "")
+		s.saveConversation(ctx, req, "智能体信息获取失败", "")
 		return errStatus(errs.Code_AssistantConversationErr, status)
 	}
 
@@ -235,7 +235,7 @@ func (s *Service) AssistantConversionStream(req *assistant_service.AssistantConv
 	if assistantConfig.SseUrl == "" {
 		log.Errorf("Assistant服务SSE URL配置为空，assistantId: %s", req.AssistantId)
 		SSEError(stream, "智能体SSE URL配置错误")
-		saveConversation(ctx, req, "智能体SSE URL配置错误", "")
+		s.saveConversation(ctx, req, "智能体SSE URL配置错误", "")
 		return grpc_util.ErrorStatusWithKey(errs.Code_AssistantConversationErr, "assistant_conversation", "SSE URL配置错误")
 	}
 
@@ -256,44 +256,46 @@ func (s *Service) AssistantConversionStream(req *assistant_service.AssistantConv
 	}
 
 	// 模型参数配置
-	modelConfig, err := s.setModelConfigParams(sseReq, assistant)
+	modelConfig, err := s.setModelConfigParams(sseReq, req, assistant)
 	if err != nil {
 		SSEError(stream, "智能体模型配置解析失败")
-		saveConversation(ctx, req, "智能体模型配置解析失败", "")
+		s.saveConversation(ctx, req, "智能体模型配置解析失败", "")
 		return grpc_util.ErrorStatusWithKey(errs.Code_AssistantConversationErr, "assistant_conversation", "模型配置解析失败")
 	}
 
-	// 知识库参数配置
-	if err := s.setKnowledgebaseParams(ctx, sseReq, req, assistant, modelConfig); err != nil {
+	// 知识库参数配置，xuid为知识库创建人userId
+	xuid, err := s.setKnowledgebaseParams(ctx, sseReq, req, assistant, modelConfig)
+	if err != nil {
 		SSEError(stream, "智能体知识库配置解析失败")
-		saveConversation(ctx, req, "智能体知识库配置解析失败", "")
+		s.saveConversation(ctx, req, "智能体知识库配置解析失败", "")
 		return grpc_util.ErrorStatusWithKey(errs.Code_AssistantConversationErr, "assistant_conversation", "知识库配置解析失败")
 	}
 
 	// plugin参数配置
 	if err := s.setCustomAndWorkflowParams(ctx, sseReq, req.AssistantId); err != nil {
 		SSEError(stream, "智能体plugin配置错误")
-		saveConversation(ctx, req, "智能体plugin配置错误", "")
+		s.saveConversation(ctx, req, "智能体plugin配置错误", "")
 		return grpc_util.ErrorStatusWithKey(errs.Code_AssistantConversationErr, "assistant_conversation", "plugin配置错误")
 	}
 
 	// 在线搜索参数配置
 	if err := s.setOnlineSearchParams(sseReq, assistant); err != nil {
 		SSEError(stream, "智能体在线搜索配置解析失败")
-		saveConversation(ctx, req, "智能体在线搜索配置解析失败", "")
+		s.saveConversation(ctx, req, "智能体在线搜索配置解析失败", "")
 		return grpc_util.ErrorStatusWithKey(errs.Code_AssistantConversationErr, "assistant_conversation", "在线搜索配置解析失败")
 	}
 
 	// MCP 信息参数配置
 	if err := s.setMCPParams(ctx, sseReq, assistant); err != nil {
 		SSEError(stream, "智能体MCP配置解析失败")
-		saveConversation(ctx, req, "智能体MCP配置解析失败", "")
+		s.saveConversation(ctx, req, "智能体MCP配置解析失败", "")
 		return grpc_util.ErrorStatusWithKey(errs.Code_AssistantConversationErr, "assistant_conversation", "MCP配置解析失败")
 	}
 
 	// 历史聊天记录配置
+	var memory *conversationMemory
 	if !req.Trial && req.ConversationId != "" {
-		s.setHistoryParams(ctx, sseReq, req)
+		memory = s.setHistoryParams(ctx, sseReq, req, conversation, tree, assistant, modelConfig)
 	}
 
 	// 底层智能体能力接口请求体
@@ -302,13 +304,13 @@ func (s *Service) AssistantConversionStream(req *assistant_service.AssistantConv
 	if err != nil {
 		log.Errorf("Assistant服务序列化请求体失败，assistantId: %s, error: %v", req.AssistantId, err)
 		SSEError(stream, "请求参数错误")
-		saveConversation(ctx, req, "请求参数错误", "")
+		s.saveConversation(ctx, req, "请求参数错误", "")
 		return grpc_util.ErrorStatusWithKey(errs.Code_AssistantConversationErr, "assistant_conversation", "请求参数错误")
 	}
 	if err := json.Unmarshal(reqBytes, &requestBody); err != nil {
 		log.Errorf("Assistant服务反序列化请求体到map失败，assistantId: %s, error: %v", req.AssistantId, err)
 		SSEError(stream, "请求参数错误")
-		saveConversation(ctx, req, "请求参数错误", "")
+		s.saveConversation(ctx, req, "请求参数错误", "")
 		return grpc_util.ErrorStatusWithKey(errs.Code_AssistantConversationErr, "assistant_conversation", "请求参数错误")
 	}
 
@@ -321,7 +323,7 @@ func (s *Service) AssistantConversionStream(req *assistant_service.AssistantConv
 	if err != nil {
 		log.Errorf("Assistant服务序列化最终请求体失败，assistantId: %s, error: %v", req.AssistantId, err)
 		SSEError(stream, "请求参数错误")
-		saveConversation(ctx, req, "请求参数错误", "")
+		s.saveConversation(ctx, req, "请求参数错误", "")
 		return grpc_util.ErrorStatusWithKey(errs.Code_AssistantConversationErr, "assistant_conversation", "请求参数错误")
 	}
 
@@ -329,9 +331,6 @@ func (s *Service) AssistantConversionStream(req *assistant_service.AssistantConv
 	startTime := time.Now()
 	id := uuid.New().String()
 
-	// xuid通过智能体传给RAG使用，要求xuid和知识库创建人userId一致，当前版本智能体创建人userId和知识库创建人userId一致。后面做了知识库分享之后这里可能需要改造。
-	xuid := assistant.UserId
-
 	log.Infof("Assistant服务开始调用HttpRequestLlmStream，uuid: %s, assistantId: %s, url: %s, userId: %s, timeout: %v, body: %s",
 		id, req.AssistantId, assistantConfig.SseUrl, reqUserId, timeout, string(requestBodyBytes))
 	sseResp, err := HttpRequestLlmStream(ctx, assistantConfig.SseUrl, reqUserId, xuid, bytes.NewReader(requestBodyBytes), timeout)
@@ -339,7 +338,7 @@ func (s *Service) AssistantConversionStream(req *assistant_service.AssistantConv
 		log.Errorf("Assistant服务调用智能体能力接口失败，assistantId: %s, uuid: %s, error: %v", req.AssistantId, id, err)
 		if ctx.Err() == nil { //非上下文被取消
 			SSEError(stream, "agent服务异常")
-			saveConversation(ctx, req, "agent服务异常", "")
+			s.saveConversation(ctx, req, "agent服务异常", "")
 		}
 		return grpc_util.ErrorStatusWithKey(errs.Code_AssistantConversationErr, "assistant_conversation", "agent服务异常")
 	}
@@ -350,7 +349,7 @@ func (s *Service) AssistantConversionStream(req *assistant_service.AssistantConv
 	if sseResp.StatusCode > http.StatusBadRequest {
 		log.Errorf("Assistant服务智能体能力接口返回错误状态码，assistantId: %s, statusCode: %d", req.AssistantId, sseResp.StatusCode)
 		SSEError(stream, "agent服务异常")
-		saveConversation(ctx, req, "agent服务异常", "")
+		s.saveConversation(ctx, req, "agent服务异常", "")
 		return grpc_util.ErrorStatusWithKey(errs.Code_AssistantConversationErr, "assistant_conversation", "agent服务异常")
 	}
 
@@ -371,8 +370,9 @@ func (s *Service) AssistantConversionStream(req *assistant_service.AssistantConv
 			if !req.Trial {
 				// 只有在上下文未被取消的情况下才保存并标记为已保存
 				if ctx.Err() == nil {
-					saveConversation(ctx, req, fullResponse.String(), searchList)
+					s.saveConversation(ctx, req, fullResponse.String(), searchList)
 					conversationSaved = true // 标记已保存
+					s.asyncConversationSummary(memory, req.Prompt, fullResponse.String())
 				}
 				// 如果上下文被取消，不设置conversationSaved，让defer函数处理终止消息
 			}
@@ -387,7 +387,7 @@ func (s *Service) AssistantConversionStream(req *assistant_service.AssistantConv
 				if hasReadFirstMessage && fullResponse.Len() > 0 {
 					errorMessage = fullResponse.String() + "\n" + errorMessage
 				}
-				saveConversation(ctx, req, errorMessage, searchList)
+				s.saveConversation(ctx, req, errorMessage, searchList)
 				conversationSaved = true // 标记已保存，避免defer中重复保存
 				log.Debugf("Assistant服务保存了中断消息，assistantId: %s, errorMessage: %s", req.AssistantId, errorMessage)
 			}
@@ -402,6 +402,24 @@ func (s *Service) AssistantConversionStream(req *assistant_service.AssistantConv
 			var streamData map[string]interface{}
 			if err := json.Unmarshal([]byte(jsonStrData), &streamData); err == nil {
 				log.Debugf("Assistant服务解析流式数据，assistantId: %s, streamData: %+v", req.AssistantId, streamData)
+				// 模型上游返回的OpenAI兼容error事件，记录到对话中并结束
+				if upstreamErr, ok := mp_common.ParseOpenAIError(jsonStrData); ok {
+					log.Errorf("Assistant服务收到模型错误事件，assistantId: %s, error: %+v", req.AssistantId, upstreamErr)
+					if err := stream.Send(&assistant_service.AssistantConversionStreamResp{
+						Content: jsonStrData,
+					}); err != nil {
+						log.Errorf("Assistant服务发送流式响应失败，assistantId: %s, error: %v", req.AssistantId, err)
+					}
+					if !req.Trial {
+						errorMessage := "本次回答出错：" + upstreamErr.Message
+						if hasReadFirstMessage && fullResponse.Len() > 0 {
+							errorMessage = fullResponse.String() + "\n" + errorMessage
+						}
+						s.saveConversation(ctx, req, errorMessage, searchList)
+						conversationSaved = true // 标记已保存，避免defer中重复保存
+					}
+					return grpc_util.ErrorStatusWithKey(errs.Code_AssistantConversationErr, "assistant_conversation", "本次回答出错")
+				}
 				code, ok := extractCodeFromStreamData(streamData)
 				if !ok {
 					log.Errorf("Assistant服务无法提取code字段，assistantId: %s, streamData: %+v", req.AssistantId, streamData)
@@ -455,7 +473,7 @@ func (s *Service) AssistantConversionStream(req *assistant_service.AssistantConv
 }
 
 // 设置模型配置参数
-func (s *Service) setModelConfigParams(sseReq *config.AgentSSERequest, assistant *model.Assistant) (*common.AppModelConfig, error) {
+func (s *Service) setModelConfigParams(sseReq *config.AgentSSERequest, req *assistant_service.AssistantConversionStreamReq, assistant *model.Assistant) (*common.AppModelConfig, error) {
 	if assistant.ModelConfig == "" {
 		log.Warnf("Assistant服务智能体模型配置为空，assistantId: %s", assistant.ID)
 		return nil, nil
@@ -470,7 +488,7 @@ func (s *Service) setModelConfigParams(sseReq *config.AgentSSERequest, assistant
 	log.Debugf("Assistant服务成功解析智能体模型配置，assistantId: %s, provider: %s, model: %s, modelId: %s, modelType: %s",
 		assistant.ID, modelConfig.Provider, modelConfig.Model, modelConfig.ModelId, modelConfig.ModelType)
 
-	modelEndpoint := mp.ToModelEndpoint(modelConfig.ModelId, modelConfig.Model)
+	modelEndpoint := mp.ToCallerModelEndpoint(modelConfig.ModelId, modelConfig.Model, modelCaller(req))
 	log.Debugf("Assistant服务生成模型端点，assistantId: %s, modelEndpoint: %+v", assistant.ID, modelEndpoint)
 	sseReq.Model = modelEndpoint["model"].(string)
 	sseReq.ModelUrl = modelEndpoint["model_url"].(string)
@@ -484,42 +502,61 @@ func (s *Service) setModelConfigParams(sseReq *config.AgentSSERequest, assistant
 	return modelConfig, nil
 }
 
-// 设置知识库参数
-func (s *Service) setKnowledgebaseParams(ctx context.Context, sseReq *config.AgentSSERequest, req *assistant_service.AssistantConversionStreamReq, assistant *model.Assistant, modelConfig *common.AppModelConfig) error {
+// 设置知识库参数，返回传给RAG的xuid：xuid须与知识库创建人userId一致，知识库可能由他人分享给智能体创建人，
+// 因此取知识库创建人userId，未配置知识库时为智能体创建人userId；保存配置时已拒绝不同创建人的知识库，
+// 历史配置中存在不同创建人时只使用第一个创建人的知识库，不中断对话
+func (s *Service) setKnowledgebaseParams(ctx context.Context, sseReq *config.AgentSSERequest, req *assistant_service.AssistantConversionStreamReq, assistant *model.Assistant, modelConfig *common.AppModelConfig) (string, error) {
+	xuid := assistant.UserId
 	knowledgebaseConfig := &RAGKnowledgeBaseConfig{}
 	if assistant.KnowledgebaseConfig == "" {
-		return nil
+		return xuid, nil
 	}
 
 	if err := json.Unmarshal([]byte(assistant.KnowledgebaseConfig), knowledgebaseConfig); err != nil {
 		log.Errorf("Assistant服务解析智能体知识库配置失败，assistantId: %s, error: %v, knowledgebaseConfigRaw: %s", req.AssistantId, err, assistant.KnowledgebaseConfig)
-		return err
+		return "", err
 	}
 	log.Debugf("Assistant服务解析知识库成功，knowledgebaseConfig: %+v", knowledgebaseConfig)
 
 	if len(knowledgebaseConfig.KnowledgeBaseIds) > 0 {
 		rerankEndpoint, err := buildRerank(req, knowledgebaseConfig, assistant)
 		if err != nil {
-			return err
+			return "", err
 		}
 		knowledgeInfoList, err := Knowledge.SelectKnowledgeDetailByIdList(ctx, &knowledgebase_service.KnowledgeDetailSelectListReq{
 			KnowledgeIds: knowledgebaseConfig.KnowledgeBaseIds,
+			UserId:       assistant.UserId,
+			OrgId:        assistant.OrgId,
 		})
 		if err != nil {
 			log.Errorf("Assistant服务获取知识库详情失败, err: %v", err)
-			return err
+			return "", err
 		}
 		log.Infof("knowledgeInfoList = %+v", knowledgeInfoList)
 
 		var knowNames []string
-		for _, v := range knowledgeInfoList.List {
+		knowIds := make(map[string]bool)
+		for i, v := range knowledgeInfoList.List {
+			if i == 0 {
+				xuid = v.UserId
+			} else if v.UserId != xuid {
+				log.Warnf("Assistant服务知识库创建人不一致，只使用创建人 %s 的知识库，assistantId: %s, 忽略知识库: %s", xuid, req.AssistantId, v.KnowledgeId)
+				continue
+			}
 			knowNames = append(knowNames, v.Name)
+			knowIds[v.KnowledgeId] = true
+		}
+		var appKnowledgeBaseList []*AppKnowledgeBaseInfo
+		for _, v := range knowledgebaseConfig.AppKnowledgeBaseList {
+			if knowIds[v.KnowledgeBaseId] {
+				appKnowledgeBaseList = append(appKnowledgeBaseList, v)
+			}
 		}
 
-		params, err := buildMetaDataFilterParams(knowledgebaseConfig.AppKnowledgeBaseList)
+		params, err := buildMetaDataFilterParams(appKnowledgeBaseList)
 		if err != nil {
 			log.Errorf("Assistant buildMetaDataFilterParams, err: %v", err)
-			return err
+			return "", err
 		}
 		sseReq.KnParams = &config.KnParams{
 			KnowledgeBase:        knowNames,
@@ -539,7 +576,7 @@ func (s *Service) setKnowledgebaseParams(ctx context.Context, sseReq *config.Age
 		}
 		sseReq.UseKnow = true
 	}
-	return nil
+	return xuid, nil
 }
 
 // 设置plugin参数：自定义工具和工作流
@@ -613,42 +650,6 @@ func (s *Service) setMCPParams(ctx context.Context, sseReq *config.AgentSSEReque
 	return nil
 }
 
-// 设置历史记录参数
-func (s *Service) setHistoryParams(ctx context.Context, sseReq *config.AgentSSERequest, req *assistant_service.AssistantConversionStreamReq) {
-	fieldConditions := map[string]interface{}{
-		"conversationId": req.ConversationId,
-		"userId":         req.Identity.UserId,
-		"orgId":          req.Identity.OrgId,
-	}
-	indexPattern := "conversation_detail_infos_*"
-
-	documents, _, err := es.Assistant().SearchByFields(ctx, indexPattern, fieldConditions, 0, 1000)
-	if err != nil {
-		log.Warnf("Assistant服务查询历史聊天记录失败，conversationId: %s, userId: %s, error: %v", req.ConversationId, req.Identity.UserId, err)
-		return
-	}
-
-	var historyList []config.AssistantConversionHistory
-	for _, doc := range documents {
-		var detail model.ConversationDetails
-		if err := json.Unmarshal(doc, &detail); err != nil {
-			log.Warnf("Assistant服务解析ES历史聊天记录失败: %v", err)
-			continue
-		}
-		history := config.AssistantConversionHistory{
-			Query:         detail.Prompt,
-			UploadFileUrl: detail.FileUrl,
-			Response:      detail.Response,
-		}
-		historyList = append(historyList, history)
-	}
-
-	if len(historyList) > 0 {
-		sseReq.History = historyList
-		log.Debugf("Assistant服务添加历史聊天记录到请求参数，conversationId: %s, 历史记录数: %d", req.ConversationId, len(historyList))
-	}
-}
-
 func buildRerank(req *assistant_service.AssistantConversionStreamReq, knowledgebaseConfig *RAGKnowledgeBaseConfig, assistant *model.Assistant) (map[string]interface{}, error) {
 	var rerankEndpoint map[string]interface{}
 	if knowledgebaseConfig.PriorityMatch != 1 {
@@ -663,30 +664,50 @@ func buildRerank(req *assistant_service.AssistantConversionStreamReq, knowledgeb
 				return nil, fmt.Errorf("智能体缺少rerank配置")
 			}
 		}
-		rerankEndpoint = mp.ToModelEndpoint(rerankConfig.ModelId, rerankConfig.Model)
+		rerankEndpoint = mp.ToCallerModelEndpoint(rerankConfig.ModelId, rerankConfig.Model, modelCaller(req))
 	}
 	return rerankEndpoint, nil
 }
 
-// 使用独立上下文保存对话的辅助函数
-func saveConversation(originalCtx context.Context, req *assistant_service.AssistantConversionStreamReq, response, searchList string) {
+// modelCaller 智能体经callback调用模型时的调用方，用量计入对话用户
+func modelCaller(req *assistant_service.AssistantConversionStreamReq) *mp.ModelCaller {
+	return &mp.ModelCaller{
+		OrgId:   req.Identity.OrgId,
+		UserId:  req.Identity.UserId,
+		AppId:   req.AssistantId,
+		AppType: constant.AppTypeAgent,
+	}
+}
+
+// 使用独立上下文保存对话的辅助函数，保存成功后将该轮对话设为对话的当前分支
+func (s *Service) saveConversation(originalCtx context.Context, req *assistant_service.AssistantConversionStreamReq, response, searchList string) {
+	ctx := originalCtx
 	// 如果原始上下文已取消，创建一个新的独立上下文
 	if originalCtx.Err() != nil {
-		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(context.Background(), 10*time.Second)
 		defer cancel()
-
-		if err := saveConversationDetailToES(ctx, req, response, searchList); err != nil {
-			log.Errorf("保存聊天记录到ES失败，assistantId: %s, conversationId: %s, error: %v",
-				req.AssistantId, req.ConversationId, err)
-		}
-		return
 	}
 
-	// 原始上下文未取消时，继续使用它
-	if err := saveConversationDetailToES(originalCtx, req, response, searchList); err != nil {
+	detailId, err := saveConversationDetailToES(ctx, req, response, searchList)
+	if err != nil {
 		log.Errorf("保存聊天记录到ES失败，assistantId: %s, conversationId: %s, error: %v",
 			req.AssistantId, req.ConversationId, err)
+		return
 	}
+	conversationID, err := pkgUtil.U32(req.ConversationId)
+	if err != nil {
+		return
+	}
+	if status := s.cli.UpdateConversationActive(ctx, conversationID, detailId); status != nil {
+		log.Errorf("更新对话当前分支失败，conversationId: %s, error: %v", req.ConversationId, status)
+	}
+	webhook.Emit(req.Identity.OrgId, webhook.EventConversationCompleted, map[string]string{
+		"assistantId":    req.AssistantId,
+		"conversationId": req.ConversationId,
+		"msgId":          detailId,
+		"userId":         req.Identity.UserId,
+	})
 }
 
 // buildRetrieveMethod 构造检索方式
@@ -957,7 +978,7 @@ func HttpRequestLlmStream(ctx context.Context, url, userId, xuid string, body io
 }
 
 // saveConversationDetailToES 保存聊天记录到ES
-func saveConversationDetailToES(ctx context.Context, req *assistant_service.AssistantConversionStreamReq, response, searchList string) error {
+func saveConversationDetailToES(ctx context.Context, req *assistant_service.AssistantConversionStreamReq, response, searchList string) (string, error) {
 	// 根据当前时间生成索引名称，格式为conversation_detail_infos_YYYYMM
 	now := time.Now()
 	indexName := fmt.Sprintf("conversation_detail_infos_%d%02d", now.Year(), now.Month())
@@ -968,6 +989,7 @@ func saveConversationDetailToES(ctx context.Context, req *assistant_service.Assi
 		Id:             uuid.New().String(),
 		AssistantId:    req.AssistantId,
 		ConversationId: req.ConversationId,
+		ParentId:       req.ParentId,
 		Prompt:         req.Prompt,
 		FileUrl:        req.FileInfo.FileUrl,
 		FileSize:       req.FileInfo.FileSize,
@@ -982,12 +1004,12 @@ func saveConversationDetailToES(ctx context.Context, req *assistant_service.Assi
 
 	// 写入ES
 	if err := es.Assistant().IndexDocument(ctx, indexName, conversationDetail); err != nil {
-		return fmt.Errorf("写入ES失败: %v", err)
+		return "", fmt.Errorf("写入ES失败: %v", err)
 	}
 
 	log.Infof("成功保存聊天记录到ES，索引: %s, assistantId: %s, conversationId: %s",
 		indexName, req.AssistantId, req.ConversationId)
-	return nil
+	return conversationDetail.Id, nil
 }
 
 // ConversationDeleteByAssistantId 根据智能体ID删除对话
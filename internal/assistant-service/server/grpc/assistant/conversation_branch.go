@@ -0,0 +1,266 @@
+package assistant
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+
+	assistant_service "github.com/UnicomAI/wanwu/api/proto/assistant-service"
+	errs "github.com/UnicomAI/wanwu/api/proto/err-code"
+	"github.com/UnicomAI/wanwu/internal/assistant-service/client/model"
+	"github.com/UnicomAI/wanwu/pkg/es"
+	grpc_util "github.com/UnicomAI/wanwu/pkg/grpc-util"
+	"github.com/UnicomAI/wanwu/pkg/log"
+	pkgUtil "github.com/UnicomAI/wanwu/pkg/util"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+const conversationDetailBatchSize = 500
+
+// AssistantConversionRegenerate 使用原问题重新生成某轮回答，新回答与原回答为同一轮对话的不同版本
+func (s *Service) AssistantConversionRegenerate(req *assistant_service.AssistantConversionRegenerateReq, stream assistant_service.AssistantService_AssistantConversionRegenerateServer) error {
+	_, tree, err := s.getConversationTree(stream.Context(), req.ConversationId, req.Identity)
+	if err != nil {
+		return err
+	}
+	detail, err := tree.get(req.DetailId)
+	if err != nil {
+		return err
+	}
+	return s.AssistantConversionStream(&assistant_service.AssistantConversionStreamReq{
+		AssistantId:    req.AssistantId,
+		ConversationId: req.ConversationId,
+		FileInfo: &assistant_service.ConversionStreamFile{
+			FileName: detail.FileName,
+			FileSize: detail.FileSize,
+			FileUrl:  detail.FileUrl,
+		},
+		Prompt:   detail.Prompt,
+		Identity: req.Identity,
+		ParentId: detail.ParentId,
+	}, stream)
+}
+
+// AssistantConversionEdit 编辑某轮问题并从该轮对话处分叉出新分支
+func (s *Service) AssistantConversionEdit(req *assistant_service.AssistantConversionEditReq, stream assistant_service.AssistantService_AssistantConversionEditServer) error {
+	_, tree, err := s.getConversationTree(stream.Context(), req.ConversationId, req.Identity)
+	if err != nil {
+		return err
+	}
+	detail, err := tree.get(req.DetailId)
+	if err != nil {
+		return err
+	}
+	return s.AssistantConversionStream(&assistant_service.AssistantConversionStreamReq{
+		AssistantId:    req.AssistantId,
+		ConversationId: req.ConversationId,
+		FileInfo: &assistant_service.ConversionStreamFile{
+			FileName: detail.FileName,
+			FileSize: detail.FileSize,
+			FileUrl:  detail.FileUrl,
+		},
+		Prompt:   req.Prompt,
+		Identity: req.Identity,
+		ParentId: detail.ParentId,
+	}, stream)
+}
+
+// GetConversationDetailSiblings 获取某轮对话的所有版本（重新生成的回答与编辑后的问题）
+func (s *Service) GetConversationDetailSiblings(ctx context.Context, req *assistant_service.GetConversationDetailSiblingsReq) (*assistant_service.GetConversationDetailSiblingsResp, error) {
+	conversation, tree, err := s.getConversationTree(ctx, req.ConversationId, req.Identity)
+	if err != nil {
+		return nil, err
+	}
+	detail, err := tree.get(req.DetailId)
+	if err != nil {
+		return nil, err
+	}
+	active := make(map[string]bool)
+	if leaf := tree.activeLeaf(conversation.ActiveId); leaf != nil {
+		for _, d := range tree.path(leaf.Id) {
+			active[d.Id] = true
+		}
+	}
+	resp := &assistant_service.GetConversationDetailSiblingsResp{}
+	for i, sibling := range tree.siblings(detail) {
+		if active[sibling.Id] {
+			resp.ActiveIndex = int32(i)
+		}
+		resp.Data = append(resp.Data, tree.toDetailInfo(sibling))
+	}
+	s.setFeedbackRatings(ctx, req.Identity.UserId, resp.Data)
+	return resp, nil
+}
+
+// ConversationBranchSelect 切换对话当前分支，当前分支延伸至所选对话下最新的一轮对话
+func (s *Service) ConversationBranchSelect(ctx context.Context, req *assistant_service.ConversationBranchSelectReq) (*emptypb.Empty, error) {
+	conversation, tree, err := s.getConversationTree(ctx, req.ConversationId, req.Identity)
+	if err != nil {
+		return nil, err
+	}
+	detail, err := tree.get(req.DetailId)
+	if err != nil {
+		return nil, err
+	}
+	if status := s.cli.UpdateConversationActive(ctx, conversation.ID, tree.leaf(detail.Id).Id); status != nil {
+		return nil, errStatus(errs.Code_AssistantConversationErr, status)
+	}
+	return &emptypb.Empty{}, nil
+}
+
+// setParentId 未指定上一轮对话时将本轮对话追加到当前分支末尾，返回对话及对话树；查询失败时返回nil
+func (s *Service) setParentId(ctx context.Context, req *assistant_service.AssistantConversionStreamReq) (*model.Conversation, *conversationTree) {
+	conversation, tree, err := s.getConversationTree(ctx, req.ConversationId, req.Identity)
+	if err != nil {
+		log.Warnf("Assistant服务查询历史聊天记录失败，conversationId: %s, userId: %s, error: %v", req.ConversationId, req.Identity.UserId, err)
+		return nil, nil
+	}
+	if req.ParentId == "" {
+		req.ParentId = tree.rootId
+		if leaf := tree.activeLeaf(conversation.ActiveId); leaf != nil {
+			req.ParentId = leaf.Id
+		}
+	}
+	return conversation, tree
+}
+
+// getConversationTree 获取对话及其所有对话详情组成的树
+func (s *Service) getConversationTree(ctx context.Context, conversationId string, identity *assistant_service.Identity) (*model.Conversation, *conversationTree, error) {
+	conversationID, err := pkgUtil.U32(conversationId)
+	if err != nil {
+		return nil, nil, err
+	}
+	conversation, status := s.cli.GetConversation(ctx, conversationID)
+	if status != nil {
+		return nil, nil, errStatus(errs.Code_AssistantConversationErr, status)
+	}
+	details, err := searchConversationDetails(ctx, conversationId, identity)
+	if err != nil {
+		return nil, nil, grpc_util.ErrorStatusWithKey(errs.Code_AssistantConversationErr, "assistant_conversation", err.Error())
+	}
+	return conversation, newConversationTree(conversationId, details), nil
+}
+
+// searchConversationDetails 从ES分批查询对话的所有对话详情（时间正序）
+func searchConversationDetails(ctx context.Context, conversationId string, identity *assistant_service.Identity) ([]*model.ConversationDetails, error) {
+	fieldConditions := map[string]interface{}{
+		"conversationId": conversationId,
+		"userId":         identity.UserId,
+		"orgId":          identity.OrgId,
+	}
+	indexPattern := "conversation_detail_infos_*"
+
+	var details []*model.ConversationDetails
+	err := es.Assistant().ScanByFields(ctx, indexPattern, fieldConditions, 0, 0, []string{"createdAt"}, conversationDetailBatchSize, func(documents []json.RawMessage) error {
+		for _, doc := range documents {
+			detail := &model.ConversationDetails{}
+			if err := json.Unmarshal(doc, detail); err != nil {
+				log.Warnf("解析ES文档失败: %v", err)
+				continue
+			}
+			details = append(details, detail)
+		}
+		return nil
+	})
+	if err != nil {
+		log.Errorf("从ES查询对话详情失败，conversationId: %s, userId: %s, error: %v", conversationId, identity.UserId, err)
+		return nil, fmt.Errorf("查询对话详情失败: %v", err)
+	}
+	return details, nil
+}
+
+// conversationTree 按上一轮对话id组织的对话详情树，首轮对话的父节点为对话id；
+// 同一父节点下的子节点为同一轮对话的多个版本，按创建时间正序
+type conversationTree struct {
+	rootId   string
+	details  map[string]*model.ConversationDetails
+	children map[string][]*model.ConversationDetails
+	latest   *model.ConversationDetails
+}
+
+func newConversationTree(conversationId string, details []*model.ConversationDetails) *conversationTree {
+	sorted := make([]*model.ConversationDetails, len(details))
+	copy(sorted, details)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return sorted[i].CreatedAt < sorted[j].CreatedAt
+	})
+	tree := &conversationTree{
+		rootId:   conversationId,
+		details:  make(map[string]*model.ConversationDetails),
+		children: make(map[string][]*model.ConversationDetails),
+	}
+	prevId := conversationId
+	for _, detail := range sorted {
+		// 历史数据没有分支，按时间顺序串联
+		if detail.ParentId == "" {
+			detail.ParentId = prevId
+		}
+		tree.details[detail.Id] = detail
+		tree.children[detail.ParentId] = append(tree.children[detail.ParentId], detail)
+		tree.latest = detail
+		prevId = detail.Id
+	}
+	return tree
+}
+
+func (t *conversationTree) get(id string) (*model.ConversationDetails, error) {
+	detail, ok := t.details[id]
+	if !ok {
+		return nil, grpc_util.ErrorStatusWithKey(errs.Code_AssistantConversationErr, "assistant_conversation", fmt.Sprintf("conversation detail %v not found", id))
+	}
+	return detail, nil
+}
+
+// activeLeaf 返回当前分支最后一轮对话；未记录当前分支时为最新的一轮对话，没有对话时返回nil
+func (t *conversationTree) activeLeaf(activeId string) *model.ConversationDetails {
+	if _, ok := t.details[activeId]; ok {
+		return t.leaf(activeId)
+	}
+	return t.latest
+}
+
+// leaf 从指定对话沿最新的版本向下，返回该分支最后一轮对话；id为对话id且没有对话时返回nil
+func (t *conversationTree) leaf(id string) *model.ConversationDetails {
+	detail := t.details[id]
+	for {
+		children := t.children[id]
+		if len(children) == 0 {
+			return detail
+		}
+		detail = children[len(children)-1]
+		id = detail.Id
+	}
+}
+
+// path 返回从首轮对话到指定对话的分支（时间正序）
+func (t *conversationTree) path(id string) []*model.ConversationDetails {
+	var ret []*model.ConversationDetails
+	for len(ret) < len(t.details) {
+		detail, ok := t.details[id]
+		if !ok {
+			break
+		}
+		ret = append(ret, detail)
+		id = detail.ParentId
+	}
+	for i, j := 0, len(ret)-1; i < j; i, j = i+1, j-1 {
+		ret[i], ret[j] = ret[j], ret[i]
+	}
+	return ret
+}
+
+func (t *conversationTree) siblings(detail *model.ConversationDetails) []*model.ConversationDetails {
+	return t.children[detail.ParentId]
+}
+
+func (t *conversationTree) toDetailInfo(detail *model.ConversationDetails) *assistant_service.ConversionDetailInfo {
+	info := toConversionDetailInfo(detail)
+	for i, sibling := range t.siblings(detail) {
+		if sibling.Id == detail.Id {
+			info.SiblingIndex = int32(i)
+		}
+	}
+	info.SiblingCount = int32(len(t.siblings(detail)))
+	return info
+}
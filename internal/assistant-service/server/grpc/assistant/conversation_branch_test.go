@@ -0,0 +1,57 @@
+package assistant
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/UnicomAI/wanwu/internal/assistant-service/client/model"
+)
+
+func branchIds(details []*model.ConversationDetails) string {
+	var ids []string
+	for _, detail := range details {
+		ids = append(ids, detail.Id)
+	}
+	return strings.Join(ids, ",")
+}
+
+func TestConversationTree(t *testing.T) {
+	// a、b为无分支的历史数据；c重新生成为c2，b编辑为b2后继续对话d
+	tree := newConversationTree("1", []*model.ConversationDetails{
+		{Id: "d", ParentId: "b2", CreatedAt: 6},
+		{Id: "b2", ParentId: "a", CreatedAt: 5},
+		{Id: "c2", ParentId: "b", CreatedAt: 4},
+		{Id: "c", ParentId: "b", CreatedAt: 3},
+		{Id: "b", CreatedAt: 2},
+		{Id: "a", CreatedAt: 1},
+	})
+
+	if ids := branchIds(tree.path(tree.activeLeaf("").Id)); ids != "a,b2,d" {
+		t.Fatalf("unexpected default branch %v", ids)
+	}
+	if ids := branchIds(tree.path(tree.activeLeaf("c").Id)); ids != "a,b,c" {
+		t.Fatalf("unexpected active branch %v", ids)
+	}
+	// 切换到b所在分支时延伸至最新版本c2
+	if leaf := tree.leaf("b"); leaf.Id != "c2" {
+		t.Fatalf("unexpected leaf %v", leaf.Id)
+	}
+	if ids := branchIds(tree.siblings(tree.details["b2"])); ids != "b,b2" {
+		t.Fatalf("unexpected siblings %v", ids)
+	}
+	if info := tree.toDetailInfo(tree.details["c2"]); info.SiblingCount != 2 || info.SiblingIndex != 1 || info.ParentId != "b" {
+		t.Fatalf("unexpected detail info %+v", info)
+	}
+	if ids := branchIds(tree.siblings(tree.details["a"])); ids != "a" || tree.leaf(tree.rootId).Id != "d" {
+		t.Fatalf("unexpected root siblings %v", ids)
+	}
+
+	// 摘要覆盖的对话不在当前分支窗口外的对话中时重新总结
+	dropped := tree.path("b2")
+	if summary, pending := pendingSummary(&model.Conversation{Summary: "s", SummaryUntil: 1}, dropped); summary != "s" || branchIds(pending) != "b2" {
+		t.Fatalf("unexpected pending summary %v %v", summary, branchIds(pending))
+	}
+	if summary, pending := pendingSummary(&model.Conversation{Summary: "s", SummaryUntil: 2}, dropped); summary != "" || branchIds(pending) != "a,b2" {
+		t.Fatalf("unexpected pending summary %v %v", summary, branchIds(pending))
+	}
+}
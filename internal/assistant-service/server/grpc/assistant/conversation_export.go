@@ -0,0 +1,207 @@
+package assistant
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"time"
+
+	assistant_service "github.com/UnicomAI/wanwu/api/proto/assistant-service"
+	errs "github.com/UnicomAI/wanwu/api/proto/err-code"
+	"github.com/UnicomAI/wanwu/internal/assistant-service/client/model"
+	"github.com/UnicomAI/wanwu/pkg/es"
+	grpc_util "github.com/UnicomAI/wanwu/pkg/grpc-util"
+	"github.com/UnicomAI/wanwu/pkg/log"
+	pkgUtil "github.com/UnicomAI/wanwu/pkg/util"
+	"github.com/google/uuid"
+)
+
+const conversationExportBatchSize = 200
+
+// ConversationExport 导出对话详情：跨按月划分的ES索引分批读取并流式返回，同一对话的对话详情按创建时间正序连续返回；
+// 已删除对话的对话详情不导出
+func (s *Service) ConversationExport(req *assistant_service.ConversationExportReq, stream assistant_service.AssistantService_ConversationExportServer) error {
+	ctx := stream.Context()
+	fieldConditions := map[string]interface{}{
+		"userId": req.Identity.UserId,
+		"orgId":  req.Identity.OrgId,
+	}
+	switch {
+	case req.ConversationId != "":
+		fieldConditions["conversationId"] = req.ConversationId
+	case req.AssistantId != "":
+		fieldConditions["assistantId"] = req.AssistantId
+	default:
+		return grpc_util.ErrorStatusWithKey(errs.Code_AssistantConversationErr, "assistant_conversation_export", "assistantId or conversationId empty")
+	}
+
+	// 对话id -> 对话，对话已删除时为nil
+	conversations := make(map[string]*model.Conversation)
+	err := es.Assistant().ScanByFields(ctx, "conversation_detail_infos_*", fieldConditions, req.StartTime, req.EndTime,
+		[]string{"conversationId", "createdAt"}, conversationExportBatchSize, func(documents []json.RawMessage) error {
+			var resp *assistant_service.ConversationExportResp
+			for _, doc := range documents {
+				detail := &model.ConversationDetails{}
+				if err := json.Unmarshal(doc, detail); err != nil {
+					log.Warnf("解析ES文档失败: %v", err)
+					continue
+				}
+				conversation, ok := conversations[detail.ConversationId]
+				if !ok {
+					conversation = s.getExportConversation(ctx, detail.ConversationId)
+					conversations[detail.ConversationId] = conversation
+				}
+				if conversation == nil {
+					continue
+				}
+				if resp == nil || resp.ConversationId != detail.ConversationId {
+					if resp != nil {
+						if err := stream.Send(resp); err != nil {
+							return err
+						}
+					}
+					resp = &assistant_service.ConversationExportResp{
+						ConversationId: detail.ConversationId,
+						Title:          conversation.Title,
+					}
+				}
+				resp.Data = append(resp.Data, toConversionDetailInfo(detail))
+			}
+			if resp != nil {
+				return stream.Send(resp)
+			}
+			return nil
+		})
+	if err != nil {
+		log.Errorf("Assistant服务导出对话失败，assistantId: %s, conversationId: %s, error: %v", req.AssistantId, req.ConversationId, err)
+		return grpc_util.ErrorStatusWithKey(errs.Code_AssistantConversationErr, "assistant_conversation_export", err.Error())
+	}
+	return nil
+}
+
+func (s *Service) getExportConversation(ctx context.Context, conversationId string) *model.Conversation {
+	conversationID, err := pkgUtil.U32(conversationId)
+	if err != nil {
+		return nil
+	}
+	conversation, status := s.cli.GetConversation(ctx, conversationID)
+	if status != nil {
+		log.Warnf("Assistant服务导出对话时获取对话失败，conversationId: %s, error: %v", conversationId, status)
+		return nil
+	}
+	return conversation
+}
+
+// ConversationImport 导入对话：每项创建一个新对话，对话详情重新生成id并按原创建时间写入对应月份的ES索引，保留对话分支
+func (s *Service) ConversationImport(ctx context.Context, req *assistant_service.ConversationImportReq) (*assistant_service.ConversationImportResp, error) {
+	assistantID, err := pkgUtil.U32(req.AssistantId)
+	if err != nil {
+		return nil, grpc_util.ErrorStatusWithKey(errs.Code_AssistantConversationErr, "assistant_conversation_import", err.Error())
+	}
+	if _, status := s.cli.GetAssistant(ctx, assistantID); status != nil {
+		return nil, errStatus(errs.Code_AssistantErr, status)
+	}
+
+	resp := &assistant_service.ConversationImportResp{}
+	for _, item := range req.Conversations {
+		if len(item.Data) == 0 {
+			continue
+		}
+		conversationId, err := s.importConversation(ctx, assistantID, item, req.Identity)
+		if err != nil {
+			return nil, err
+		}
+		resp.ConversationIds = append(resp.ConversationIds, conversationId)
+	}
+	return resp, nil
+}
+
+func (s *Service) importConversation(ctx context.Context, assistantID uint32, item *assistant_service.ConversationImportItem, identity *assistant_service.Identity) (string, error) {
+	details := make([]*assistant_service.ConversionDetailInfo, len(item.Data))
+	copy(details, item.Data)
+	sort.SliceStable(details, func(i, j int) bool {
+		return details[i].CreatedAt < details[j].CreatedAt
+	})
+	title := item.Title
+	if title == "" {
+		title = details[0].Prompt
+	}
+	conversation := &model.Conversation{
+		AssistantId: assistantID,
+		Title:       title,
+		UserId:      identity.UserId,
+		OrgId:       identity.OrgId,
+	}
+	if status := s.cli.CreateConversation(ctx, conversation); status != nil {
+		return "", errStatus(errs.Code_AssistantConversationErr, status)
+	}
+	conversationId := strconv.FormatUint(uint64(conversation.ID), 10)
+
+	// 原对话详情id -> 新对话详情id
+	ids := make(map[string]string)
+	indices := make(map[string][]interface{})
+	prevId := conversationId
+	nowMilli := time.Now().UnixMilli()
+	for _, info := range details {
+		detail := importConversationDetail(info, ids, prevId)
+		detail.AssistantId = strconv.FormatUint(uint64(assistantID), 10)
+		detail.ConversationId = conversationId
+		detail.UserId = identity.UserId
+		detail.OrgId = identity.OrgId
+		if detail.ParentId == "" {
+			detail.ParentId = conversationId
+		}
+		if detail.CreatedAt == 0 {
+			detail.CreatedAt = nowMilli
+		}
+		if detail.UpdatedAt == 0 {
+			detail.UpdatedAt = detail.CreatedAt
+		}
+		createdAt := time.UnixMilli(detail.CreatedAt)
+		indexName := fmt.Sprintf("conversation_detail_infos_%d%02d", createdAt.Year(), createdAt.Month())
+		indices[indexName] = append(indices[indexName], detail)
+		prevId = detail.Id
+	}
+	for indexName, documents := range indices {
+		if err := es.Assistant().BulkIndexDocuments(ctx, indexName, documents); err != nil {
+			log.Errorf("Assistant服务导入对话详情失败，conversationId: %s, index: %s, error: %v", conversationId, indexName, err)
+			return "", grpc_util.ErrorStatusWithKey(errs.Code_AssistantConversationErr, "assistant_conversation_import", err.Error())
+		}
+	}
+	if status := s.cli.UpdateConversationActive(ctx, conversation.ID, prevId); status != nil {
+		return "", errStatus(errs.Code_AssistantConversationErr, status)
+	}
+	log.Infof("Assistant服务导入对话成功，conversationId: %s, 对话详情数: %d", conversationId, len(details))
+	return conversationId, nil
+}
+
+// importConversationDetail 转换导入的对话详情并重新生成id；上一轮对话不在导入数据中时（首轮对话）parentId置空，
+// 没有上一轮对话id的历史数据按时间顺序串联到prevId
+func importConversationDetail(info *assistant_service.ConversionDetailInfo, ids map[string]string, prevId string) *model.ConversationDetails {
+	detail := &model.ConversationDetails{
+		Id:         uuid.New().String(),
+		Prompt:     info.Prompt,
+		SysPrompt:  info.SysPrompt,
+		Response:   info.Response,
+		SearchList: info.SearchList,
+		QaType:     info.QaType,
+		FileSize:   info.FileSize,
+		FileName:   info.FileName,
+		CreatedAt:  info.CreatedAt,
+		UpdatedAt:  info.UpdatedAt,
+	}
+	if len(info.RequestFileUrls) > 0 {
+		detail.FileUrl = info.RequestFileUrls[0]
+	}
+	if info.ParentId == "" {
+		detail.ParentId = prevId
+	} else {
+		detail.ParentId = ids[info.ParentId]
+	}
+	if info.Id != "" {
+		ids[info.Id] = detail.Id
+	}
+	return detail
+}
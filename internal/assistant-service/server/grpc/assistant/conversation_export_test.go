@@ -0,0 +1,22 @@
+package assistant
+
+import (
+	"testing"
+
+	assistant_service "github.com/UnicomAI/wanwu/api/proto/assistant-service"
+)
+
+func TestImportConversationDetail(t *testing.T) {
+	ids := make(map[string]string)
+	// a为首轮对话，b为a的下一轮，c为没有上一轮对话id的历史数据
+	a := importConversationDetail(&assistant_service.ConversionDetailInfo{Id: "a", ParentId: "old"}, ids, "new")
+	b := importConversationDetail(&assistant_service.ConversionDetailInfo{Id: "b", ParentId: "a"}, ids, a.Id)
+	c := importConversationDetail(&assistant_service.ConversionDetailInfo{Id: "c"}, ids, b.Id)
+
+	if a.Id == "a" || a.ParentId != "" {
+		t.Fatalf("unexpected first detail %+v", a)
+	}
+	if b.ParentId != a.Id || c.ParentId != b.Id {
+		t.Fatalf("unexpected parent %v %v", b.ParentId, c.ParentId)
+	}
+}
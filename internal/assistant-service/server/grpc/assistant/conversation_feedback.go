@@ -0,0 +1,126 @@
+package assistant
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+
+	assistant_service "github.com/UnicomAI/wanwu/api/proto/assistant-service"
+	errs "github.com/UnicomAI/wanwu/api/proto/err-code"
+	"github.com/UnicomAI/wanwu/internal/assistant-service/client/model"
+	grpc_util "github.com/UnicomAI/wanwu/pkg/grpc-util"
+	"github.com/UnicomAI/wanwu/pkg/log"
+	pkgUtil "github.com/UnicomAI/wanwu/pkg/util"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+// ConversationFeedbackSubmit 评价某轮回答，同时保存问题、回答与检索到的知识片段快照；rating为0时取消评价。
+// 未指定对话详情id时评价当前分支最后一轮回答（OpenAPI问答不返回对话详情id）
+func (s *Service) ConversationFeedbackSubmit(ctx context.Context, req *assistant_service.ConversationFeedbackSubmitReq) (*emptypb.Empty, error) {
+	if req.Rating < -1 || req.Rating > 1 {
+		return nil, grpc_util.ErrorStatusWithKey(errs.Code_AssistantFeedbackErr, "assistant_feedback_submit", fmt.Sprintf("invalid rating %v", req.Rating))
+	}
+	conversation, tree, err := s.getConversationTree(ctx, req.ConversationId, req.Identity)
+	if err != nil {
+		return nil, err
+	}
+	detailId := req.DetailId
+	if detailId == "" {
+		if leaf := tree.activeLeaf(conversation.ActiveId); leaf != nil {
+			detailId = leaf.Id
+		}
+	}
+	detail, err := tree.get(detailId)
+	if err != nil {
+		return nil, err
+	}
+	if req.Rating == 0 {
+		if status := s.cli.DeleteConversationFeedback(ctx, detail.Id, req.Identity.UserId); status != nil {
+			return nil, errStatus(errs.Code_AssistantFeedbackErr, status)
+		}
+		return &emptypb.Empty{}, nil
+	}
+
+	assistant, status := s.cli.GetAssistant(ctx, conversation.AssistantId)
+	if status != nil {
+		return nil, errStatus(errs.Code_AssistantErr, status)
+	}
+	reasons, err := json.Marshal(req.Reasons)
+	if err != nil {
+		return nil, grpc_util.ErrorStatusWithKey(errs.Code_AssistantFeedbackErr, "assistant_feedback_submit", err.Error())
+	}
+	if status := s.cli.SaveConversationFeedback(ctx, &model.ConversationFeedback{
+		AssistantId:    conversation.AssistantId,
+		ConversationId: conversation.ID,
+		DetailId:       detail.Id,
+		Rating:         req.Rating,
+		Reasons:        string(reasons),
+		Content:        req.Content,
+		Prompt:         detail.Prompt,
+		Response:       detail.Response,
+		SearchList:     detail.SearchList,
+		UserId:         req.Identity.UserId,
+		OrgId:          assistant.OrgId,
+	}); status != nil {
+		return nil, errStatus(errs.Code_AssistantFeedbackErr, status)
+	}
+	return &emptypb.Empty{}, nil
+}
+
+// GetConversationFeedbackList 回答评价列表，查询当前组织下智能体收到的评价
+func (s *Service) GetConversationFeedbackList(ctx context.Context, req *assistant_service.GetConversationFeedbackListReq) (*assistant_service.ConversationFeedbackList, error) {
+	var assistantID uint32
+	if req.AssistantId != "" {
+		var err error
+		if assistantID, err = pkgUtil.U32(req.AssistantId); err != nil {
+			return nil, grpc_util.ErrorStatusWithKey(errs.Code_AssistantFeedbackErr, "assistant_feedback_get_list", err.Error())
+		}
+	}
+	offset := (req.PageNo - 1) * req.PageSize
+	feedbacks, total, status := s.cli.GetConversationFeedbackList(ctx, req.Identity.OrgId, assistantID, req.Rating, req.StartTime, req.EndTime, offset, req.PageSize)
+	if status != nil {
+		return nil, errStatus(errs.Code_AssistantFeedbackErr, status)
+	}
+	ret := &assistant_service.ConversationFeedbackList{Total: total}
+	for _, feedback := range feedbacks {
+		var reasons []string
+		if feedback.Reasons != "" {
+			if err := json.Unmarshal([]byte(feedback.Reasons), &reasons); err != nil {
+				log.Warnf("Assistant服务解析评价原因失败，feedbackId: %d, error: %v", feedback.ID, err)
+			}
+		}
+		ret.Data = append(ret.Data, &assistant_service.ConversationFeedbackInfo{
+			FeedbackId:     strconv.FormatUint(uint64(feedback.ID), 10),
+			AssistantId:    strconv.FormatUint(uint64(feedback.AssistantId), 10),
+			ConversationId: strconv.FormatUint(uint64(feedback.ConversationId), 10),
+			DetailId:       feedback.DetailId,
+			Rating:         feedback.Rating,
+			Reasons:        reasons,
+			Content:        feedback.Content,
+			Prompt:         feedback.Prompt,
+			Response:       feedback.Response,
+			SearchList:     feedback.SearchList,
+			UserId:         feedback.UserId,
+			CreatedAt:      feedback.CreatedAt,
+			UpdatedAt:      feedback.UpdatedAt,
+		})
+	}
+	return ret, nil
+}
+
+// setFeedbackRatings 填充当前用户对各轮对话的评价
+func (s *Service) setFeedbackRatings(ctx context.Context, userId string, infos []*assistant_service.ConversionDetailInfo) {
+	var detailIds []string
+	for _, info := range infos {
+		detailIds = append(detailIds, info.Id)
+	}
+	ratings, status := s.cli.GetConversationFeedbackRatings(ctx, userId, detailIds)
+	if status != nil {
+		log.Warnf("Assistant服务获取回答评价失败，userId: %s, error: %v", userId, status)
+		return
+	}
+	for _, info := range infos {
+		info.Rating = ratings[info.Id]
+	}
+}
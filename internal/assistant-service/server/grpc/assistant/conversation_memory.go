@@ -0,0 +1,248 @@
+package assistant
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+	"unicode"
+
+	assistant_service "github.com/UnicomAI/wanwu/api/proto/assistant-service"
+	"github.com/UnicomAI/wanwu/api/proto/common"
+	"github.com/UnicomAI/wanwu/internal/assistant-service/client/model"
+	"github.com/UnicomAI/wanwu/internal/assistant-service/config"
+	"github.com/UnicomAI/wanwu/pkg/log"
+	mp "github.com/UnicomAI/wanwu/pkg/model-provider"
+	mp_common "github.com/UnicomAI/wanwu/pkg/model-provider/mp-common"
+	mp_openai_compatible "github.com/UnicomAI/wanwu/pkg/model-provider/mp-openai-compatible"
+	"github.com/UnicomAI/wanwu/pkg/util"
+)
+
+const (
+	memorySummaryTimeout  = 60 * time.Second
+	memorySummaryMaxInput = 8000 // 单次总结输入的最大token估算值，超出时截断最早的对话
+)
+
+const memorySummaryPrompt = `你是对话记忆助手。请将已有摘要与新增的对话合并为一份新的摘要，要求：
+1. 保留用户的身份、诉求、偏好、已确认的事实与结论、未解决的问题；
+2. 省略寒暄与重复内容，不要编造对话中没有的信息；
+3. 使用第三人称陈述，直接输出摘要正文，不超过500字。`
+
+// conversationMemory 本轮对话的记忆上下文，用于本轮对话保存后异步更新摘要
+type conversationMemory struct {
+	conversationID uint32
+	details        []*model.ConversationDetails
+	memoryConfig   *assistant_service.AssistantMemoryConfig
+	modelConfig    *common.AppModelConfig
+	caller         *mp.ModelCaller
+}
+
+// setHistoryParams 设置历史记录参数：历史对话为本轮对话的上一轮对话所在分支上按时间正序的对话；
+// 按智能体记忆配置保留最近的对话窗口，已存储且仍然有效的摘要追加到系统提示词中。
+// 开启摘要时返回本轮对话的记忆上下文，由调用方在本轮对话保存后异步更新摘要
+func (s *Service) setHistoryParams(ctx context.Context, sseReq *config.AgentSSERequest, req *assistant_service.AssistantConversionStreamReq, conversation *model.Conversation, tree *conversationTree, assistant *model.Assistant, modelConfig *common.AppModelConfig) *conversationMemory {
+	if tree == nil {
+		return nil
+	}
+	details := tree.path(req.ParentId)
+
+	memoryConfig, err := toMemoryConfig(assistant.MemoryConfig)
+	if err != nil {
+		log.Warnf("Assistant服务解析智能体记忆配置失败，assistantId: %d, error: %v", assistant.ID, err)
+	}
+	window, dropped := windowHistory(details, memoryConfig)
+
+	var historyList []config.AssistantConversionHistory
+	for _, detail := range window {
+		historyList = append(historyList, config.AssistantConversionHistory{
+			Query:         detail.Prompt,
+			UploadFileUrl: detail.FileUrl,
+			Response:      detail.Response,
+		})
+	}
+	if len(historyList) > 0 {
+		sseReq.History = historyList
+		log.Debugf("Assistant服务添加历史聊天记录到请求参数，conversationId: %s, 历史记录数: %d, 窗口外记录数: %d", req.ConversationId, len(historyList), len(dropped))
+	}
+
+	if memoryConfig == nil || !memoryConfig.SummaryEnable || conversation == nil {
+		return nil
+	}
+	if summary, _ := pendingSummary(conversation, dropped); summary != "" {
+		sseReq.SystemRole = strings.TrimSpace(sseReq.SystemRole + "\n\n以下是与用户此前对话的摘要，可作为回答的背景：\n" + summary)
+	}
+	return &conversationMemory{
+		conversationID: conversation.ID,
+		details:        details,
+		memoryConfig:   memoryConfig,
+		modelConfig:    modelConfig,
+		caller:         modelCaller(req),
+	}
+}
+
+// asyncConversationSummary 本轮对话保存后异步更新摘要，使下一轮对话窗口外的对话都已并入摘要；
+// 同一对话同时只有一个摘要任务，正在总结时跳过，未总结的对话由后续轮次继续并入
+func (s *Service) asyncConversationSummary(memory *conversationMemory, prompt, response string) {
+	if memory == nil {
+		return
+	}
+	// 以本轮对话作为最新一轮计算下一轮的窗口外对话；本轮对话尚未落库的创建时间无法作为摘要位置，不参与总结
+	current := &model.ConversationDetails{Prompt: prompt, Response: response}
+	_, dropped := windowHistory(append(append([]*model.ConversationDetails{}, memory.details...), current), memory.memoryConfig)
+	if len(dropped) > 0 && dropped[len(dropped)-1] == current {
+		dropped = dropped[:len(dropped)-1]
+	}
+	if len(dropped) == 0 {
+		return
+	}
+	if _, running := s.summaryLocks.LoadOrStore(memory.conversationID, struct{}{}); running {
+		log.Debugf("Assistant服务对话摘要正在生成，跳过本次总结，conversationId: %d", memory.conversationID)
+		return
+	}
+	go func() {
+		defer s.summaryLocks.Delete(memory.conversationID)
+		defer util.PrintPanicStack()
+		ctx := context.Background()
+		// 重新读取对话，基于最新的已有摘要合并，避免覆盖其他轮次已保存的摘要
+		conversation, status := s.cli.GetConversation(ctx, memory.conversationID)
+		if status != nil {
+			log.Warnf("Assistant服务获取对话失败，conversationId: %d, error: %v", memory.conversationID, status)
+			return
+		}
+		s.conversationSummary(ctx, conversation, dropped, memory.modelConfig, memory.caller)
+	}()
+}
+
+func toMemoryConfig(cfg string) (*assistant_service.AssistantMemoryConfig, error) {
+	if cfg == "" {
+		return nil, nil
+	}
+	ret := &assistant_service.AssistantMemoryConfig{}
+	if err := json.Unmarshal([]byte(cfg), ret); err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+// windowHistory 按最大轮数与token预算从最新的对话向前保留，返回窗口内与窗口外的对话（均为时间正序）
+func windowHistory(details []*model.ConversationDetails, cfg *assistant_service.AssistantMemoryConfig) (window, dropped []*model.ConversationDetails) {
+	if cfg == nil {
+		return details, nil
+	}
+	start := 0
+	if cfg.MaxTurns > 0 && len(details) > int(cfg.MaxTurns) {
+		start = len(details) - int(cfg.MaxTurns)
+	}
+	if cfg.MaxTokens > 0 {
+		var tokens int
+		for i := len(details) - 1; i >= start; i-- {
+			tokens += estimateTokens(details[i].Prompt) + estimateTokens(details[i].Response)
+			if tokens > int(cfg.MaxTokens) {
+				start = i + 1
+				break
+			}
+		}
+	}
+	return details[start:], details[:start]
+}
+
+// conversationSummary 存在未总结的窗口外对话时，使用智能体的模型将其并入已有摘要并保存。
+// 已有摘要覆盖的最后一轮对话不在窗口外对话中时（切换了分支），摘要已失效，重新总结
+func (s *Service) conversationSummary(ctx context.Context, conversation *model.Conversation, dropped []*model.ConversationDetails, modelConfig *common.AppModelConfig, caller *mp.ModelCaller) {
+	summary, pending := pendingSummary(conversation, dropped)
+	if len(pending) == 0 {
+		return
+	}
+	if modelConfig == nil || modelConfig.ModelId == "" {
+		return
+	}
+
+	newSummary, err := summarizeHistory(ctx, modelConfig, caller, summary, pending)
+	if err != nil {
+		log.Warnf("Assistant服务生成对话摘要失败，conversationId: %d, error: %v", conversation.ID, err)
+		return
+	}
+	summaryUntil := pending[len(pending)-1].CreatedAt
+	if status := s.cli.UpdateConversationSummary(ctx, conversation.ID, newSummary, summaryUntil); status != nil {
+		log.Warnf("Assistant服务保存对话摘要失败，conversationId: %d, error: %v", conversation.ID, status)
+		return
+	}
+	log.Infof("Assistant服务更新对话摘要，conversationId: %d, 新增总结轮数: %d", conversation.ID, len(pending))
+}
+
+// pendingSummary 返回仍然有效的已有摘要，以及窗口外尚未总结的对话
+func pendingSummary(conversation *model.Conversation, dropped []*model.ConversationDetails) (string, []*model.ConversationDetails) {
+	if conversation.SummaryUntil == 0 {
+		return "", dropped
+	}
+	for i, detail := range dropped {
+		if detail.CreatedAt == conversation.SummaryUntil {
+			return conversation.Summary, dropped[i+1:]
+		}
+	}
+	return "", dropped
+}
+
+// summarizeHistory 通过回调地址调用智能体的模型，将新增对话并入已有摘要
+func summarizeHistory(ctx context.Context, modelConfig *common.AppModelConfig, caller *mp.ModelCaller, summary string, pending []*model.ConversationDetails) (string, error) {
+	// 超出输入预算时保留最近的对话
+	var turns []string
+	var tokens int
+	for i := len(pending) - 1; i >= 0; i-- {
+		turn := fmt.Sprintf("用户：%s\n助手：%s", pending[i].Prompt, pending[i].Response)
+		tokens += estimateTokens(turn)
+		if tokens > memorySummaryMaxInput && len(turns) > 0 {
+			break
+		}
+		turns = append([]string{turn}, turns...)
+	}
+	content := "新增对话：\n" + strings.Join(turns, "\n\n")
+	if summary != "" {
+		content = "已有摘要：\n" + summary + "\n\n" + content
+	}
+
+	endpoint := mp.ToCallerModelEndpoint(modelConfig.ModelId, modelConfig.Model, caller)
+	modelUrl, _ := endpoint["model_url"].(string)
+	llm := &mp_openai_compatible.LLM{EndpointUrl: modelUrl}
+	stream := false
+	llmReq, err := llm.NewReq(&mp_common.LLMReq{
+		Model: modelConfig.Model,
+		Messages: []mp_common.OpenAIReqMsg{
+			{Role: mp_common.MsgRoleSystem, Content: memorySummaryPrompt},
+			{Role: mp_common.MsgRoleUser, Content: content},
+		},
+		Stream: &stream,
+	})
+	if err != nil {
+		return "", err
+	}
+	ctx, cancel := context.WithTimeout(ctx, memorySummaryTimeout)
+	defer cancel()
+	resp, _, err := llm.ChatCompletions(ctx, llmReq)
+	if err != nil {
+		return "", err
+	}
+	data, ok := resp.ConvertResp()
+	if !ok || len(data.Choices) == 0 || data.Choices[0].Message == nil {
+		return "", fmt.Errorf("invalid summary resp: %v", resp.String())
+	}
+	ret := strings.TrimSpace(data.Choices[0].Message.Content)
+	if ret == "" {
+		return "", fmt.Errorf("empty summary resp: %v", resp.String())
+	}
+	return ret, nil
+}
+
+// estimateTokens 粗略估算token数：中日韩字符按1个token，其余字符按4个字符1个token
+func estimateTokens(text string) int {
+	var cjk, others int
+	for _, r := range text {
+		if unicode.Is(unicode.Han, r) || unicode.Is(unicode.Hiragana, r) || unicode.Is(unicode.Katakana, r) || unicode.Is(unicode.Hangul, r) {
+			cjk++
+		} else {
+			others++
+		}
+	}
+	return cjk + (others+3)/4
+}
@@ -0,0 +1,45 @@
+package assistant
+
+import (
+	"testing"
+
+	assistant_service "github.com/UnicomAI/wanwu/api/proto/assistant-service"
+	"github.com/UnicomAI/wanwu/internal/assistant-service/client/model"
+)
+
+func TestWindowHistory(t *testing.T) {
+	var details []*model.ConversationDetails
+	for i, prompt := range []string{"你好", "介绍一下万悟平台", "如何创建智能体", "如何绑定知识库"} {
+		details = append(details, &model.ConversationDetails{Prompt: prompt, Response: "好的", CreatedAt: int64(i)})
+	}
+
+	for _, c := range []struct {
+		cfg     *assistant_service.AssistantMemoryConfig
+		window  int
+		dropped int
+	}{
+		{nil, 4, 0},
+		{&assistant_service.AssistantMemoryConfig{}, 4, 0},
+		{&assistant_service.AssistantMemoryConfig{MaxTurns: 3}, 3, 1},
+		// 最近两轮约 (7+2)+(7+2) 个token
+		{&assistant_service.AssistantMemoryConfig{MaxTurns: 3, MaxTokens: 18}, 2, 2},
+		{&assistant_service.AssistantMemoryConfig{MaxTokens: 1}, 0, 4},
+	} {
+		window, dropped := windowHistory(details, c.cfg)
+		if len(window) != c.window || len(dropped) != c.dropped {
+			t.Fatalf("cfg %v expect window %v dropped %v, got %v %v", c.cfg, c.window, c.dropped, len(window), len(dropped))
+		}
+		if len(window) > 0 && window[len(window)-1].CreatedAt != 3 {
+			t.Fatalf("cfg %v window should end with latest turn", c.cfg)
+		}
+	}
+}
+
+func TestEstimateTokens(t *testing.T) {
+	if n := estimateTokens("如何创建智能体"); n != 7 {
+		t.Fatalf("unexpected cjk tokens %v", n)
+	}
+	if n := estimateTokens("hello world!"); n != 3 {
+		t.Fatalf("unexpected ascii tokens %v", n)
+	}
+}
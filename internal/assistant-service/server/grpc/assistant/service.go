@@ -1,6 +1,8 @@
 package assistant
 
 import (
+	"sync"
+
 	assistant_service "github.com/UnicomAI/wanwu/api/proto/assistant-service"
 	errs "github.com/UnicomAI/wanwu/api/proto/err-code"
 	"github.com/UnicomAI/wanwu/internal/assistant-service/client"
@@ -9,7 +11,8 @@ import (
 
 type Service struct {
 	assistant_service.UnimplementedAssistantServiceServer
-	cli client.IClient
+	cli          client.IClient
+	summaryLocks sync.Map // 对话摘要异步生成的会话锁，key为对话ID
 }
 
 func NewService(cli client.IClient) *Service {
@@ -6,6 +6,7 @@ import (
 	"github.com/UnicomAI/wanwu/pkg/i18n"
 	"github.com/UnicomAI/wanwu/pkg/log"
 	"github.com/UnicomAI/wanwu/pkg/minio"
+	"github.com/UnicomAI/wanwu/pkg/redis"
 	"github.com/UnicomAI/wanwu/pkg/util"
 )
 
@@ -23,8 +24,10 @@ type Config struct {
 	CustomInfo        CustomInfoConfig        `json:"custom-info" mapstructure:"custom-info"`
 	DocCenter         DocCenterConfig         `json:"doc-center" mapstructure:"doc-center"`
 	DefaultIcon       DefaultIconConfig       `json:"default-icon" mapstructure:"default-icon"`
+	RateLimit         RateLimitConfig         `json:"rate-limit" mapstructure:"rate-limit"`
 	// middleware
 	Minio minio.Config `json:"minio" mapstructure:"minio"`
+	Redis redis.Config `json:"redis" mapstructure:"redis"`
 	// microservice
 	Iam       ServiceConfig      `json:"iam" mapstructure:"iam"`
 	Model     ModelConfig        `json:"model" mapstructure:"model"`
@@ -47,6 +50,8 @@ type ServerConfig struct {
 	ApiBaseUrl  string `json:"api_base_url" mapstructure:"api_base_url"`
 	AppOpenUrl  string `json:"app_open_base_url" mapstructure:"app_open_base_url"`
 	CallbackUrl string `json:"callback_url" mapstructure:"callback_url"`
+	// TrustedProxies 可信反向代理的IP或网段，仅来自这些地址的请求才采信X-Forwarded-For/X-Real-IP；为空时客户端IP取连接对端地址
+	TrustedProxies []string `json:"trusted_proxies" mapstructure:"trusted_proxies"`
 }
 
 type ModelConfig struct {
@@ -199,6 +204,56 @@ type DefaultIconConfig struct {
 	ToolIcon     string `json:"tool" mapstructure:"tool"`
 }
 
+// rate limit dimension
+const (
+	RateLimitDimensionApiKey = "api_key" // ApiKey
+	RateLimitDimensionApp    = "app"     // 应用
+	RateLimitDimensionClient = "client"  // 应用Url匿名访问者（X-Client-ID）
+	RateLimitDimensionIp     = "ip"      // 应用Url客户端IP，防止伪造X-Client-ID绕过访问者限制
+)
+
+type RateLimitConfig struct {
+	Enable        bool                    `json:"enable" mapstructure:"enable"`
+	StreamTimeout int                     `json:"stream_timeout" mapstructure:"stream_timeout"` // 并发流占用的最长时间（秒），超时未释放视为已结束
+	ApiKey        RateLimitRule           `json:"api_key" mapstructure:"api_key"`               // 每个ApiKey的限制
+	App           RateLimitRule           `json:"app" mapstructure:"app"`                       // 每个应用的限制（OpenAPI与应用Url共享）
+	Client        RateLimitRule           `json:"client" mapstructure:"client"`                 // 应用Url每个匿名访问者（X-Client-ID）的限制
+	Ip            RateLimitRule           `json:"ip" mapstructure:"ip"`                         // 应用Url每个客户端IP的限制，须远高于client，仅用于防滥用
+	Overrides     []RateLimitOverrideRule `json:"overrides" mapstructure:"overrides"`           // 指定ApiKey或应用的限制
+}
+
+type RateLimitRule struct {
+	Rate        float64 `json:"rate" mapstructure:"rate"`               // 每秒补充的令牌数，0表示不限制请求速率
+	Burst       int     `json:"burst" mapstructure:"burst"`             // 令牌桶容量，小于1时取1
+	Concurrency int     `json:"concurrency" mapstructure:"concurrency"` // 最大并发请求（流）数，0表示不限制
+}
+
+type RateLimitOverrideRule struct {
+	Dimension string        `json:"dimension" mapstructure:"dimension"` // api_key | app
+	Id        string        `json:"id" mapstructure:"id"`               // ApiKey的apiId或应用的appId
+	Rule      RateLimitRule `json:"rule" mapstructure:"rule"`
+}
+
+// Rule 获取维度dimension下id的限制，未单独配置时使用该维度的默认限制
+func (c *RateLimitConfig) Rule(dimension, id string) RateLimitRule {
+	for _, override := range c.Overrides {
+		if override.Dimension == dimension && override.Id == id {
+			return override.Rule
+		}
+	}
+	switch dimension {
+	case RateLimitDimensionApiKey:
+		return c.ApiKey
+	case RateLimitDimensionApp:
+		return c.App
+	case RateLimitDimensionClient:
+		return c.Client
+	case RateLimitDimensionIp:
+		return c.Ip
+	}
+	return RateLimitRule{}
+}
+
 func LoadConfig(in string) error {
 	_c = &Config{}
 	if err := util.LoadConfig(in, _c); err != nil {
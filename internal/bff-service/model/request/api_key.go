@@ -1,11 +1,35 @@
 package request
 
+import (
+	"fmt"
+	"net"
+	"net/url"
+	"strings"
+)
+
 type GenApiKeyRequest struct {
-	AppId   string `json:"appId" validate:"required"`   // 应用id
-	AppType string `json:"appType" validate:"required"` // 应用类型
+	AppId          string   `json:"appId" validate:"required"`                               // 应用id
+	AppType        string   `json:"appType" validate:"required"`                             // 应用类型
+	Scopes         []string `json:"scopes" validate:"dive,oneof=chat conversation feedback"` // 授权范围：chat 对话问答/工作流运行，conversation 会话管理，feedback 回答评价；为空表示不限制
+	AllowedOrigins []string `json:"allowedOrigins"`                                          // 允许的请求来源，如 https://example.com；为空表示不限制，配置后未携带Origin请求头的调用将被拒绝
+	AllowedIps     []string `json:"allowedIps"`                                              // 允许的客户端IP或CIDR；为空表示不限制
+	ExpireDays     int32    `json:"expireDays" validate:"gte=0"`                             // 有效天数，0表示永不过期
 }
 
 func (g GenApiKeyRequest) Check() error {
+	for _, origin := range g.AllowedOrigins {
+		if u, err := url.Parse(origin); err != nil || u.Scheme == "" || u.Host == "" || strings.TrimSuffix(origin, "/") != u.Scheme+"://"+u.Host {
+			return fmt.Errorf("invalid origin: %v", origin)
+		}
+	}
+	for _, ip := range g.AllowedIps {
+		if net.ParseIP(ip) != nil {
+			continue
+		}
+		if _, _, err := net.ParseCIDR(ip); err != nil {
+			return fmt.Errorf("invalid ip or cidr: %v", ip)
+		}
+	}
 	return nil
 }
 
@@ -17,6 +41,15 @@ func (d DelApiKeyRequest) Check() error {
 	return nil
 }
 
+type RotateApiKeyRequest struct {
+	ApiId        string `json:"apiId" validate:"required"`             // ApiID
+	OverlapHours int32  `json:"overlapHours" validate:"gte=0,lte=720"` // 原ApiKey继续可用的小时数，0表示立即失效
+}
+
+func (r RotateApiKeyRequest) Check() error {
+	return nil
+}
+
 type GetApiKeyListRequest struct {
 	AppId   string `form:"appId" json:"appId" validate:"required"`     // 应用id
 	AppType string `form:"appType" json:"appType" validate:"required"` // 应用类型
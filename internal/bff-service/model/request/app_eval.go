@@ -0,0 +1,58 @@
+package request
+
+import (
+	"errors"
+	"strings"
+)
+
+const appEvalMaxQuestions = 500
+
+type AppEvalQuestion struct {
+	Question        string `json:"question"`        // 问题
+	ReferenceAnswer string `json:"referenceAnswer"` // 参考答案，用于评估回答正确性
+}
+
+type AppEvalDatasetCreateRequest struct {
+	Name        string            `json:"name" validate:"required"` // 问题集名称
+	Description string            `json:"description"`              // 问题集描述
+	Questions   []AppEvalQuestion `json:"questions" validate:"required,min=1"`
+}
+
+func (req *AppEvalDatasetCreateRequest) Check() error {
+	if len(req.Questions) > appEvalMaxQuestions {
+		return errors.New("questions too many")
+	}
+	for _, question := range req.Questions {
+		if strings.TrimSpace(question.Question) == "" || strings.TrimSpace(question.ReferenceAnswer) == "" {
+			return errors.New("question and referenceAnswer required")
+		}
+	}
+	return nil
+}
+
+type AppEvalDatasetIdRequest struct {
+	DatasetId string `json:"datasetId" form:"datasetId" validate:"required"` // 问题集id
+	CommonCheck
+}
+
+type AppEvalJobCreateRequest struct {
+	DatasetId     string  `json:"datasetId" validate:"required"`               // 问题集id
+	AppId         string  `json:"appId" validate:"required"`                   // 应用id
+	AppType       string  `json:"appType" validate:"required,oneof=rag agent"` // 应用类型：rag 或 agent
+	JudgeModelId  string  `json:"judgeModelId" validate:"required"`            // 评分模型id
+	BaselineJobId string  `json:"baselineJobId"`                               // 基线评测任务id，为空时不与基线对比
+	Tolerance     float64 `json:"tolerance" validate:"gte=0"`                  // 相对基线允许下降的分值
+	MinScore      float64 `json:"minScore" validate:"gte=0,lte=5"`             // 各项平均分的最低要求，为0时不校验
+	CommonCheck
+}
+
+type AppEvalJobListRequest struct {
+	DatasetId string `json:"datasetId" form:"datasetId"` // 问题集id
+	AppId     string `json:"appId" form:"appId"`         // 应用id
+	CommonCheck
+}
+
+type AppEvalJobIdRequest struct {
+	JobId string `json:"jobId" form:"jobId" validate:"required"` // 评测任务id
+	CommonCheck
+}
@@ -19,9 +19,21 @@ type AssistantConfig struct {
 	SafetyConfig        AppSafetyConfig        `json:"safetyConfig"`        // 敏感词表配置
 	RerankConfig        AppModelConfig         `json:"rerankConfig"`        // Rerank模型
 	OnlineSearchConfig  OnlineSearchConfig     `json:"onlineSearchConfig"`  // 在线搜索
+	MemoryConfig        AssistantMemoryConfig  `json:"memoryConfig"`        // 对话记忆
 }
 
-func (a *AssistantConfig) Check() error { return nil }
+func (a *AssistantConfig) Check() error {
+	if a.MemoryConfig.MaxTurns < 0 || a.MemoryConfig.MaxTokens < 0 {
+		return fmt.Errorf("memoryConfig maxTurns and maxTokens should not be negative")
+	}
+	return nil
+}
+
+type AssistantMemoryConfig struct {
+	MaxTurns      int32 `json:"maxTurns"`      // 保留最近的对话轮数，0表示不限制
+	MaxTokens     int32 `json:"maxTokens"`     // 历史对话的token预算，0表示不限制
+	SummaryEnable bool  `json:"summaryEnable"` // 是否将窗口外的历史对话滚动总结为摘要（使用智能体的模型）
+}
 
 type AssistantPublish struct {
 	AssistantId string `json:"assistantId"  validate:"required"`
@@ -131,6 +143,102 @@ func (c *ConversionStreamRequest) Check() error {
 	return nil
 }
 
+type ConversationDetailRequest struct {
+	ConversationId string `json:"conversationId" form:"conversationId"  validate:"required"`
+	DetailId       string `json:"detailId" form:"detailId"  validate:"required"`
+}
+
+func (c *ConversationDetailRequest) Check() error { return nil }
+
+type ConversationRegenerateRequest struct {
+	AssistantId string `json:"assistantId"  validate:"required"`
+	ConversationDetailRequest
+}
+
+func (c *ConversationRegenerateRequest) Check() error { return nil }
+
+type ConversationEditRequest struct {
+	AssistantId string `json:"assistantId"  validate:"required"`
+	ConversationDetailRequest
+	Prompt string `json:"prompt"  validate:"required"`
+}
+
+func (c *ConversationEditRequest) Check() error { return nil }
+
+type ConversationFeedbackRequest struct {
+	ConversationId string   `json:"conversationId"  validate:"required"`
+	DetailId       string   `json:"detailId"  validate:"required"`
+	Rating         int32    `json:"rating"`  // 1:点赞 -1:点踩 0:取消评价
+	Reasons        []string `json:"reasons"` // 原因标签
+	Content        string   `json:"content"` // 评价内容
+}
+
+func (c *ConversationFeedbackRequest) Check() error {
+	return checkFeedbackRating(c.Rating)
+}
+
+type ConversationFeedbackListRequest struct {
+	AssistantId string `json:"assistantId" form:"assistantId"` // 为空时查询组织内所有智能体，仅组织管理员可用
+	FeedbackFilter
+	PageSearch
+}
+
+func (c *ConversationFeedbackListRequest) Check() error { return nil }
+
+type FeedbackFilter struct {
+	Rating    int32 `json:"rating" form:"rating"`       // 1:点赞 -1:点踩 0:全部
+	StartTime int64 `json:"startTime" form:"startTime"` // 评价时间范围（毫秒）
+	EndTime   int64 `json:"endTime" form:"endTime"`
+}
+
+func checkFeedbackRating(rating int32) error {
+	if rating < -1 || rating > 1 {
+		return fmt.Errorf("rating should be 1, -1 or 0")
+	}
+	return nil
+}
+
+type ConversationExportRequest struct {
+	AssistantId    string `json:"assistantId" form:"assistantId"`       // 导出智能体下的所有对话，与conversationId二选一
+	ConversationId string `json:"conversationId" form:"conversationId"` // 导出单个对话
+	ConversationExportOption
+}
+
+func (c *ConversationExportRequest) Check() error {
+	if c.AssistantId == "" && c.ConversationId == "" {
+		return fmt.Errorf("assistantId or conversationId is required")
+	}
+	return c.ConversationExportOption.Check()
+}
+
+type ConversationExportOption struct {
+	StartTime int64  `json:"startTime" form:"startTime"` // 对话时间范围（毫秒）
+	EndTime   int64  `json:"endTime" form:"endTime"`
+	Format    string `json:"format" form:"format"` // 导出格式 jsonl(默认)、markdown
+}
+
+func (c *ConversationExportOption) Check() error {
+	switch c.Format {
+	case "":
+		c.Format = ConversationExportFormatJSONL
+	case ConversationExportFormatJSONL, ConversationExportFormatMarkdown:
+	default:
+		return fmt.Errorf("invalid format %v", c.Format)
+	}
+	return nil
+}
+
+const (
+	ConversationExportFormatJSONL    = "jsonl"
+	ConversationExportFormatMarkdown = "markdown"
+)
+
+type ConversationImportRequest struct {
+	AssistantId string `form:"assistantId" validate:"required"`
+}
+
+func (c *ConversationImportRequest) Check() error { return nil }
+
 type ConversionStreamFile struct {
 	FileName string `json:"fileName" form:"fileName"`
 	FileSize int64  `json:"fileSize" form:"fileSize"`
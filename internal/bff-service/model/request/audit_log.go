@@ -0,0 +1,21 @@
+package request
+
+type AuditLogFilter struct {
+	UserId    string `json:"userId" form:"userId"`                        // 操作人ID
+	Action    string `json:"action" form:"action"`                        // 路由权限标识，前缀匹配，如 permission、model
+	TargetId  string `json:"targetId" form:"targetId"`                    // 操作对象ID
+	Result    int32  `json:"result" form:"result" validate:"oneof=0 1 2"` // 0.全部 1.成功 2.失败
+	StartTime int64  `json:"startTime" form:"startTime" validate:"gte=0"` // 开始时间（毫秒时间戳）
+	EndTime   int64  `json:"endTime" form:"endTime" validate:"gte=0"`     // 结束时间（毫秒时间戳）
+}
+
+type AuditLogListRequest struct {
+	AuditLogFilter
+	PageSearch
+	CommonCheck
+}
+
+type AuditLogExportRequest struct {
+	AuditLogFilter
+	CommonCheck
+}
@@ -7,9 +7,10 @@ type CheckFileReq struct {
 }
 
 type UploadFileReq struct {
-	FileName  string `json:"fileName" form:"fileName" validate:"required"`   //原始文件名
-	Sequence  int    `json:"sequence" form:"sequence" validate:"gt=0"`       //分片文件序号
-	ChunkName string `json:"chunkName" form:"chunkName" validate:"required"` //上传批次标识
+	FileName   string `json:"fileName" form:"fileName" validate:"required"`   //原始文件名
+	Sequence   int    `json:"sequence" form:"sequence" validate:"gt=0"`       //分片文件序号
+	ChunkName  string `json:"chunkName" form:"chunkName" validate:"required"` //上传批次标识
+	ChunkTotal int    `json:"chunkTotal" form:"chunkTotal"`                   //分片总数（可选），携带时上传即校验分片大小
 }
 
 type MergeFileReq struct {
@@ -0,0 +1,19 @@
+package request
+
+type KnowledgeExportReq struct {
+	KnowledgeId string `json:"knowledgeId" validate:"required"`
+	CommonCheck
+}
+
+type KnowledgeImportReq struct {
+	FileUploadId   string          `json:"fileUploadId" validate:"required"` // 知识库导出文件上传id
+	Name           string          `json:"name"`                             // 知识库名称，为空时使用导出文件中的名称
+	Description    string          `json:"description"`                      // 知识库描述，为空时使用导出文件中的描述
+	EmbeddingModel *EmbeddingModel `json:"embeddingModelInfo"`               // embedding模型，为空时使用导出文件中的模型
+	CommonCheck
+}
+
+type KnowledgeBundleTaskReq struct {
+	TaskId string `json:"taskId" form:"taskId" validate:"required"`
+	CommonCheck
+}
@@ -0,0 +1,67 @@
+package request
+
+import (
+	"errors"
+	"fmt"
+)
+
+const (
+	ConnectorTypeS3     = "s3"     // S3兼容对象存储
+	ConnectorTypeGit    = "git"    // git仓库
+	ConnectorTypeWebDAV = "webdav" // WebDAV
+	ConnectorTypeLocal  = "local"  // 服务端挂载目录
+)
+
+type ConnectorConfig struct {
+	Endpoint   string   `json:"endpoint"`   // s3：服务地址（host:port）；webdav：服务地址（http(s)://host:port/path）
+	Bucket     string   `json:"bucket"`     // s3：桶名称
+	UseSsl     bool     `json:"useSsl"`     // s3：是否使用https
+	RepoUrl    string   `json:"repoUrl"`    // git：仓库地址，仅支持http(s)
+	Branch     string   `json:"branch"`     // git：分支，为空使用默认分支
+	RootPath   string   `json:"rootPath"`   // s3：对象前缀；git、webdav：目录；local：服务端挂载目录
+	PathFilter []string `json:"pathFilter"` // 路径过滤规则（glob，如 *.pdf、docs/**），为空导入全部支持的文件
+}
+
+type ConnectorCredential struct {
+	AccessKey string `json:"accessKey"` // s3
+	SecretKey string `json:"secretKey"` // s3
+	Username  string `json:"username"`  // git、webdav
+	Password  string `json:"password"`  // git（可填token）、webdav
+}
+
+type ConnectorSaveReq struct {
+	KnowledgeId   string               `json:"knowledgeId" validate:"required"`   // 知识库id
+	ConnectorId   string               `json:"connectorId"`                       // 连接器id，为空时创建
+	Name          string               `json:"name" validate:"required"`          // 连接器名称
+	ConnectorType string               `json:"connectorType" validate:"required"` // 连接器类型：s3、git、webdav、local
+	Config        *ConnectorConfig     `json:"config" validate:"required"`        // 连接器配置
+	Credential    *ConnectorCredential `json:"credential"`                        // 连接器凭证，加密存储；更新时为空则保留原凭证
+	DocSegment    *DocSegment          `json:"docSegment" validate:"required"`    // 文档分段配置
+	DocAnalyzer   []string             `json:"docAnalyzer" validate:"required"`   // 文档解析类型 text / ocr  / model
+	ParserModelId string               `json:"parserModelId"`                     // 模型解析或ocr模型id
+	DocPreprocess []string             `json:"docPreprocess"`                     // 文本预处理规则 replaceSymbols / deleteLinks
+}
+
+func (c *ConnectorSaveReq) Check() error {
+	switch c.ConnectorType {
+	case ConnectorTypeS3, ConnectorTypeGit, ConnectorTypeWebDAV, ConnectorTypeLocal:
+	default:
+		return fmt.Errorf("connectorType错误 参数(%v)", c.ConnectorType)
+	}
+	for _, v := range c.DocAnalyzer {
+		if (v == DocAnalyzerOCR || v == DocAnalyzerPdfParser) && c.ParserModelId == "" {
+			return errors.New("parserModelId can not be empty")
+		}
+	}
+	return nil
+}
+
+type ConnectorIdReq struct {
+	ConnectorId string `json:"connectorId" validate:"required"` // 连接器id
+	CommonCheck
+}
+
+type ConnectorListReq struct {
+	KnowledgeId string `json:"knowledgeId" form:"knowledgeId" validate:"required"` // 知识库id
+	CommonCheck
+}
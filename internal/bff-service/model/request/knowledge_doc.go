@@ -108,9 +108,54 @@ type CreateDocSegmentReq struct {
 	CommonCheck
 }
 
+const (
+	DocSegmentImportModeCreate = "create" //全部新增为切片
+	DocSegmentImportModeUpsert = "upsert" //按分段id更新已有切片，分段id为空时新增
+
+	DocSegmentFileFormatCsv   = "csv"
+	DocSegmentFileFormatJsonl = "jsonl"
+	DocSegmentFileFormatXlsx  = "xlsx"
+)
+
 type BatchCreateDocSegmentReq struct {
 	DocId        string `json:"docId"  validate:"required"`        // 文档id
-	FileUploadId string `json:"fileUploadId"  validate:"required"` // fileUploadId
+	FileUploadId string `json:"fileUploadId"  validate:"required"` // fileUploadId，文件格式：csv、jsonl、xlsx
+	Mode         string `json:"mode"`                              // 导入方式：create（默认，全部新增）、upsert（按分段id更新，分段id为空时新增）
+}
+
+func (c *BatchCreateDocSegmentReq) Check() error {
+	switch c.Mode {
+	case "":
+		c.Mode = DocSegmentImportModeCreate
+	case DocSegmentImportModeCreate, DocSegmentImportModeUpsert:
+	default:
+		return errors.New("invalid mode " + c.Mode)
+	}
+	return nil
+}
+
+type DocSegmentExportReq struct {
+	KnowledgeId string `json:"knowledgeId" form:"knowledgeId"` // 知识库id，导出知识库下全部处理完成的文档
+	DocId       string `json:"docId" form:"docId"`             // 文档id，导出单个文档，优先于knowledgeId
+	Format      string `json:"format" form:"format"`           // 导出格式：csv（默认）、jsonl、xlsx
+}
+
+func (c *DocSegmentExportReq) Check() error {
+	if c.KnowledgeId == "" && c.DocId == "" {
+		return errors.New("knowledgeId and docId can not all empty")
+	}
+	switch c.Format {
+	case "":
+		c.Format = DocSegmentFileFormatCsv
+	case DocSegmentFileFormatCsv, DocSegmentFileFormatJsonl, DocSegmentFileFormatXlsx:
+	default:
+		return errors.New("invalid format " + c.Format)
+	}
+	return nil
+}
+
+type DocSegmentImportReportReq struct {
+	DocId string `json:"docId" form:"docId" validate:"required"` // 文档id
 	CommonCheck
 }
 
@@ -0,0 +1,39 @@
+package request
+
+import "errors"
+
+type DocSyncSaveReq struct {
+	KnowledgeId     string `json:"knowledgeId" validate:"required"` // 知识库id
+	DocId           string `json:"docId"`                           // url文档id，与importTaskId二选一
+	ImportTaskId    string `json:"importTaskId"`                    // url导入任务id，同步该任务导入的所有url文档，与docId二选一
+	CronExpr        string `json:"cronExpr"`                        // cron表达式（分 时 日 月 周），与intervalMinutes二选一
+	IntervalMinutes int    `json:"intervalMinutes"`                 // 同步间隔（分钟），不小于10
+	Enable          bool   `json:"enable"`                          // 是否启用
+}
+
+func (c *DocSyncSaveReq) Check() error {
+	if (c.DocId == "") == (c.ImportTaskId == "") {
+		return errors.New("docId与importTaskId必须且只能填写一个")
+	}
+	if (c.CronExpr == "") == (c.IntervalMinutes == 0) {
+		return errors.New("cronExpr与intervalMinutes必须且只能填写一个")
+	}
+	return nil
+}
+
+type DocSyncIdReq struct {
+	SyncId string `json:"syncId" validate:"required"` // 同步配置id
+	CommonCheck
+}
+
+type DocSyncListReq struct {
+	KnowledgeId string `json:"knowledgeId" form:"knowledgeId" validate:"required"` // 知识库id
+	CommonCheck
+}
+
+type DocSyncHistoryListReq struct {
+	SyncId string `json:"syncId" form:"syncId" validate:"required"` // 同步配置id
+	DocId  string `json:"docId" form:"docId"`                       // 文档id，为空查询全部文档
+	PageSearch
+	CommonCheck
+}
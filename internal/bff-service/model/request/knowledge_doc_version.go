@@ -0,0 +1,25 @@
+package request
+
+type DocVersionUploadReq struct {
+	DocId   string   `json:"docId" validate:"required"`   // 文档id
+	DocInfo *DocInfo `json:"docInfo" validate:"required"` // 新版本文件，docId为上传文件id，不支持压缩包
+	CommonCheck
+}
+
+type DocVersionListReq struct {
+	DocId string `json:"docId" form:"docId" validate:"required"` // 文档id
+	CommonCheck
+}
+
+type DocVersionDiffReq struct {
+	DocId           string `json:"docId" form:"docId" validate:"required"` // 文档id
+	BaseVersionId   string `json:"baseVersionId" form:"baseVersionId"`     // 对比基准版本id，为空表示当前生效版本
+	TargetVersionId string `json:"targetVersionId" form:"targetVersionId"` // 对比目标版本id，为空表示当前生效版本
+	CommonCheck
+}
+
+type DocVersionRollbackReq struct {
+	DocId     string `json:"docId" validate:"required"`     // 文档id
+	VersionId string `json:"versionId" validate:"required"` // 回滚的历史版本id
+	CommonCheck
+}
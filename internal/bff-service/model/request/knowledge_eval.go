@@ -0,0 +1,70 @@
+package request
+
+import (
+	"errors"
+	"strings"
+)
+
+type CreateKnowledgeEvalDatasetReq struct {
+	KnowledgeId  string                   `json:"knowledgeId" validate:"required"`
+	Name         string                   `json:"name" validate:"required"`
+	Description  string                   `json:"description"`
+	QuestionList []*KnowledgeEvalQuestion `json:"questionList" validate:"required,min=1"`
+}
+
+type KnowledgeEvalQuestion struct {
+	Question              string   `json:"question"`              // 评测问题
+	ExpectedDocIdList     []string `json:"expectedDocIdList"`     // 期望命中的文档id
+	ExpectedContentIdList []string `json:"expectedContentIdList"` // 期望命中的分段id，不为空时按分段计算指标，否则按文档计算
+	ReferenceAnswer       string   `json:"referenceAnswer"`       // 参考答案，可选
+}
+
+func (c *CreateKnowledgeEvalDatasetReq) Check() error {
+	for _, question := range c.QuestionList {
+		if question == nil || strings.TrimSpace(question.Question) == "" {
+			return errors.New("question不能为空")
+		}
+		if len(question.ExpectedDocIdList) == 0 && len(question.ExpectedContentIdList) == 0 {
+			return errors.New("expectedDocIdList和expectedContentIdList不能同时为空")
+		}
+	}
+	return nil
+}
+
+type KnowledgeEvalDatasetReq struct {
+	DatasetId string `json:"datasetId" form:"datasetId" validate:"required"`
+	CommonCheck
+}
+
+type KnowledgeEvalDatasetListReq struct {
+	KnowledgeId string `json:"knowledgeId" form:"knowledgeId" validate:"required"`
+	CommonCheck
+}
+
+type CreateKnowledgeEvalRunReq struct {
+	DatasetId            string                `json:"datasetId" validate:"required"`
+	KnowledgeMatchParams *KnowledgeMatchParams `json:"knowledgeMatchParams" validate:"required"` // 检索配置
+}
+
+func (c *CreateKnowledgeEvalRunReq) Check() error {
+	if c.KnowledgeMatchParams.TopK <= 0 {
+		return errors.New("topK必须大于0")
+	}
+	return nil
+}
+
+type KnowledgeEvalRunListReq struct {
+	DatasetId string `json:"datasetId" form:"datasetId" validate:"required"`
+	CommonCheck
+}
+
+type KnowledgeEvalRunReq struct {
+	RunId string `json:"runId" form:"runId" validate:"required"`
+	CommonCheck
+}
+
+type CompareKnowledgeEvalRunReq struct {
+	BaseRunId   string `json:"baseRunId" form:"baseRunId" validate:"required"`     // 基准评测id
+	TargetRunId string `json:"targetRunId" form:"targetRunId" validate:"required"` // 对比评测id
+	CommonCheck
+}
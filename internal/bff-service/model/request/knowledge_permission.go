@@ -0,0 +1,69 @@
+package request
+
+import (
+	"errors"
+	"fmt"
+)
+
+const (
+	KnowledgeGranteeUser = "user" // 分享给用户
+	KnowledgeGranteeRole = "role" // 分享给角色
+	KnowledgeGranteeOrg  = "org"  // 分享给组织
+
+	KnowledgePermissionViewer = 1 // 查看
+	KnowledgePermissionEditor = 2 // 编辑
+	KnowledgePermissionAdmin  = 3 // 管理
+)
+
+type KnowledgeGrantee struct {
+	GranteeType    string `json:"granteeType" validate:"required"`    // 分享对象类型：user（用户）、role（角色）、org（组织）
+	GranteeId      string `json:"granteeId" validate:"required"`      // 分享对象id
+	PermissionType int32  `json:"permissionType" validate:"required"` // 权限：1.查看 2.编辑 3.管理
+}
+
+type KnowledgePermissionGrantReq struct {
+	KnowledgeId string              `json:"knowledgeId" validate:"required"`
+	GranteeList []*KnowledgeGrantee `json:"granteeList" validate:"required"` // 分享对象列表
+}
+
+func (c *KnowledgePermissionGrantReq) Check() error {
+	if len(c.GranteeList) == 0 {
+		return errors.New("granteeList为空")
+	}
+	for _, grantee := range c.GranteeList {
+		if err := checkKnowledgeGrantee(grantee.GranteeType, grantee.GranteeId); err != nil {
+			return err
+		}
+		if grantee.PermissionType < KnowledgePermissionViewer || grantee.PermissionType > KnowledgePermissionAdmin {
+			return fmt.Errorf("permissionType错误 参数(%v)", grantee.PermissionType)
+		}
+	}
+	return nil
+}
+
+type KnowledgePermissionRevokeReq struct {
+	KnowledgeId string `json:"knowledgeId" validate:"required"`
+	GranteeType string `json:"granteeType" validate:"required"` // 分享对象类型：user（用户）、role（角色）、org（组织）
+	GranteeId   string `json:"granteeId" validate:"required"`   // 分享对象id
+}
+
+func (c *KnowledgePermissionRevokeReq) Check() error {
+	return checkKnowledgeGrantee(c.GranteeType, c.GranteeId)
+}
+
+type KnowledgePermissionListReq struct {
+	KnowledgeId string `json:"knowledgeId" form:"knowledgeId" validate:"required"`
+	CommonCheck
+}
+
+func checkKnowledgeGrantee(granteeType, granteeId string) error {
+	switch granteeType {
+	case KnowledgeGranteeUser, KnowledgeGranteeRole, KnowledgeGranteeOrg:
+	default:
+		return fmt.Errorf("granteeType错误 参数(%v)", granteeType)
+	}
+	if granteeId == "" {
+		return errors.New("granteeId为空")
+	}
+	return nil
+}
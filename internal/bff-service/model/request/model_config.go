@@ -11,23 +11,68 @@ type BaseModelRequest struct {
 	ModelId string `json:"modelId" form:"modelId" validate:"required"`
 }
 type ModelConfig struct {
-	ModelId     string                  `json:"modelId"`
-	Provider    string                  `json:"provider" validate:"required" enums:"OpenAI-API-compatible,YuanJing"` // 模型供应商
-	Model       string                  `json:"model" validate:"required"`                                           // 模型名称
-	ModelType   string                  `json:"modelType" validate:"required" enums:"llm,embedding,rerank"`          // 模型类型
-	DisplayName string                  `json:"displayName" validate:"required"`                                     // 模型显示名称
-	Avatar      Avatar                  `json:"avatar" `                                                             // 模型图标路径
-	PublishDate string                  `json:"publishDate"`                                                         // 模型发布时间
-	Config      interface{}             `json:"config"`
-	ModelDesc   string                  `json:"modelDesc"`          // 模型描述
-	Examples    *mp.ProviderModelConfig `json:"examples,omitempty"` // 仅用于swagger展示；模型对应供应商中的对应llm、embedding或rerank结构是config实际的参数
+	ModelId       string                  `json:"modelId"`
+	Provider      string                  `json:"provider" validate:"required" enums:"OpenAI-API-compatible,YuanJing"` // 模型供应商
+	Model         string                  `json:"model" validate:"required"`                                           // 模型名称
+	ModelType     string                  `json:"modelType" validate:"required" enums:"llm,embedding,rerank"`          // 模型类型
+	DisplayName   string                  `json:"displayName" validate:"required"`                                     // 模型显示名称
+	Avatar        Avatar                  `json:"avatar" `                                                             // 模型图标路径
+	PublishDate   string                  `json:"publishDate"`                                                         // 模型发布时间
+	Config        interface{}             `json:"config"`
+	ModelDesc     string                  `json:"modelDesc"`               // 模型描述
+	RoutingPolicy *mp.RoutingPolicy       `json:"routingPolicy,omitempty"` // 模型路由策略，仅llm支持
+	CachePolicy   *mp.CachePolicy         `json:"cachePolicy,omitempty"`   // 模型响应缓存策略，仅llm、embedding支持；语义缓存仅llm支持
+	Examples      *mp.ProviderModelConfig `json:"examples,omitempty"`      // 仅用于swagger展示；模型对应供应商中的对应llm、embedding或rerank结构是config实际的参数
 }
 
 func (cfg *ModelConfig) Check() error {
-	_, err := cfg.ConfigString()
+	if _, err := cfg.ConfigString(); err != nil {
+		return err
+	}
+	if _, err := cfg.RoutingPolicyString(); err != nil {
+		return err
+	}
+	_, err := cfg.CachePolicyString()
 	return err
 }
 
+func (cfg *ModelConfig) RoutingPolicyString() (string, error) {
+	if cfg.RoutingPolicy == nil {
+		return "", nil
+	}
+	if cfg.ModelType != mp.ModelTypeLLM {
+		return "", fmt.Errorf("routing policy only support model type %v", mp.ModelTypeLLM)
+	}
+	if err := cfg.RoutingPolicy.Check(); err != nil {
+		return "", err
+	}
+	b, err := json.Marshal(cfg.RoutingPolicy)
+	if err != nil {
+		return "", fmt.Errorf("marshal routing policy err: %v", err)
+	}
+	return string(b), nil
+}
+
+func (cfg *ModelConfig) CachePolicyString() (string, error) {
+	if cfg.CachePolicy == nil {
+		return "", nil
+	}
+	if cfg.ModelType != mp.ModelTypeLLM && cfg.ModelType != mp.ModelTypeEmbedding {
+		return "", fmt.Errorf("cache policy only support model type %v, %v", mp.ModelTypeLLM, mp.ModelTypeEmbedding)
+	}
+	if cfg.CachePolicy.SemanticEnable && cfg.ModelType != mp.ModelTypeLLM {
+		return "", fmt.Errorf("semantic cache only support model type %v", mp.ModelTypeLLM)
+	}
+	if err := cfg.CachePolicy.Check(); err != nil {
+		return "", err
+	}
+	b, err := json.Marshal(cfg.CachePolicy)
+	if err != nil {
+		return "", fmt.Errorf("marshal cache policy err: %v", err)
+	}
+	return string(b), nil
+}
+
 func (cfg *ModelConfig) ConfigString() (string, error) {
 	if cfg.Config == nil {
 		return "", nil
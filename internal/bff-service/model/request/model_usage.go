@@ -0,0 +1,46 @@
+package request
+
+import "fmt"
+
+type ModelUsageStatsRequest struct {
+	GroupBy string `json:"groupBy" form:"groupBy"` // 汇总维度（model: 按模型，app: 按应用，org: 按组织），默认model
+	OrgId   string `json:"orgId" form:"orgId"`     // 组织ID，仅【系统】组织可指定，为空时为当前组织
+	UserId  string `json:"userId" form:"userId"`   // 用户ID
+	ModelId string `json:"modelId" form:"modelId"` // 模型ID
+	AppId   string `json:"appId" form:"appId"`     // 应用ID
+	StartAt int64  `json:"startAt" form:"startAt"` // 开始时间（毫秒）
+	EndAt   int64  `json:"endAt" form:"endAt"`     // 结束时间（毫秒）
+}
+
+func (o *ModelUsageStatsRequest) Check() error {
+	switch o.GroupBy {
+	case "", "model", "app", "org":
+	default:
+		return fmt.Errorf("invalid groupBy %v", o.GroupBy)
+	}
+	if o.StartAt < 0 || o.EndAt < 0 || (o.EndAt > 0 && o.StartAt > o.EndAt) {
+		return fmt.Errorf("invalid time range")
+	}
+	return nil
+}
+
+type ModelQuotaRequest struct {
+	UserId        string `json:"userId"`        // 用户ID，为空表示组织配额
+	DailyTokens   int64  `json:"dailyTokens"`   // 每日token上限，0表示不限制
+	MonthlyTokens int64  `json:"monthlyTokens"` // 每月token上限，0表示不限制
+}
+
+func (o *ModelQuotaRequest) Check() error {
+	if o.DailyTokens < 0 || o.MonthlyTokens < 0 {
+		return fmt.Errorf("quota tokens should not be negative")
+	}
+	return nil
+}
+
+type DeleteModelQuotaRequest struct {
+	UserId string `json:"userId"` // 用户ID，为空表示组织配额
+}
+
+func (o *DeleteModelQuotaRequest) Check() error {
+	return nil
+}
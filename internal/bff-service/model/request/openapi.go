@@ -1,5 +1,11 @@
 package request
 
+import (
+	"encoding/json"
+	"errors"
+	"strings"
+)
+
 type OpenAPIAgentCreateConversationRequest struct {
 	Title string `json:"title"`
 }
@@ -26,3 +32,74 @@ type OpenAPIRagChatRequest struct {
 func (req *OpenAPIRagChatRequest) Check() error {
 	return nil
 }
+
+type OpenAPIAgentFeedbackRequest struct {
+	ConversationID string   `json:"conversation_id" validate:"required"`
+	DetailID       string   `json:"detail_id"` // 为空时评价对话最后一轮回答
+	Rating         int32    `json:"rating"`    // 1:点赞 -1:点踩 0:取消评价
+	Reasons        []string `json:"reasons"`
+	Content        string   `json:"content"`
+}
+
+func (req *OpenAPIAgentFeedbackRequest) Check() error {
+	return checkFeedbackRating(req.Rating)
+}
+
+type OpenAPIRagFeedbackRequest struct {
+	MsgID   string   `json:"msg_id" validate:"required"`
+	Rating  int32    `json:"rating"` // 1:点赞 -1:点踩 0:取消评价
+	Reasons []string `json:"reasons"`
+	Content string   `json:"content"`
+}
+
+func (req *OpenAPIRagFeedbackRequest) Check() error {
+	return checkFeedbackRating(req.Rating)
+}
+
+// OpenAIChatCompletionRequest OpenAI兼容的对话请求，只使用以下字段，其余字段忽略
+type OpenAIChatCompletionRequest struct {
+	Model          string               `json:"model" validate:"required"` // 应用id
+	Messages       []*OpenAIChatMessage `json:"messages" validate:"required,min=1,dive"`
+	Stream         bool                 `json:"stream"`
+	StreamOptions  *OpenAIStreamOptions `json:"stream_options"`
+	ConversationID string               `json:"conversation_id"` // 扩展字段，智能体对话id，为空时新建对话
+}
+
+type OpenAIChatMessage struct {
+	Role    string          `json:"role" validate:"required"`
+	Content json.RawMessage `json:"content" swaggertype:"string"` // 字符串，或[{"type":"text","text":"..."}]
+}
+
+type OpenAIStreamOptions struct {
+	IncludeUsage bool `json:"include_usage"`
+}
+
+func (req *OpenAIChatCompletionRequest) Check() error {
+	last := req.Messages[len(req.Messages)-1]
+	if last.Role != "user" || strings.TrimSpace(last.Text()) == "" {
+		return errors.New("the last message must be a non-empty user message")
+	}
+	return nil
+}
+
+// Text 消息文本，content为数组时拼接其中type为text的部分
+func (m *OpenAIChatMessage) Text() string {
+	var text string
+	if err := json.Unmarshal(m.Content, &text); err == nil {
+		return text
+	}
+	var parts []struct {
+		Type string `json:"type"`
+		Text string `json:"text"`
+	}
+	if err := json.Unmarshal(m.Content, &parts); err != nil {
+		return ""
+	}
+	var texts []string
+	for _, part := range parts {
+		if part.Type == "text" {
+			texts = append(texts, part.Text)
+		}
+	}
+	return strings.Join(texts, "\n")
+}
@@ -12,6 +12,15 @@ type UrlConversationIdRequest struct {
 
 func (c *UrlConversationIdRequest) Check() error { return nil }
 
+type UrlConversationExportRequest struct {
+	ConversationId string `json:"conversationId" form:"conversationId"` // 为空时导出所有对话
+	ConversationExportOption
+}
+
+func (c *UrlConversationExportRequest) Check() error {
+	return c.ConversationExportOption.Check()
+}
+
 type UrlConversionStreamRequest struct {
 	ConversationId string `json:"conversationId" form:"conversionId"`
 	Prompt         string `json:"prompt" form:"prompt"  validate:"required"`
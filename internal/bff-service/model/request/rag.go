@@ -19,6 +19,20 @@ type ChatRagRequest struct {
 	History  []*History `json:"history"`
 }
 
+type RagFeedbackRequest struct {
+	RagID   string   `json:"ragId" validate:"required"`
+	MsgID   string   `json:"msgId" validate:"required"` // 问答返回的msg_id
+	Rating  int32    `json:"rating"`                    // 1:点赞 -1:点踩 0:取消评价
+	Reasons []string `json:"reasons"`                   // 原因标签
+	Content string   `json:"content"`                   // 评价内容
+}
+
+type RagFeedbackListRequest struct {
+	RagID string `form:"ragId" json:"ragId"` // 为空时查询组织内所有rag，仅组织管理员可用
+	FeedbackFilter
+	PageSearch
+}
+
 type History struct {
 	Query       string `json:"query"`
 	Response    string `json:"response"`
@@ -47,6 +61,14 @@ func (c ChatRagRequest) Check() error {
 	return nil
 }
 
+func (r RagFeedbackRequest) Check() error {
+	return checkFeedbackRating(r.Rating)
+}
+
+func (r RagFeedbackListRequest) Check() error {
+	return nil
+}
+
 func (r RagReq) Check() error {
 	return nil
 }
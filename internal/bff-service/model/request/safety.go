@@ -1,5 +1,11 @@
 package request
 
+import (
+	"errors"
+
+	"github.com/UnicomAI/wanwu/pkg/guardrail"
+)
+
 type CreateSensitiveWordTableReq struct {
 	TableName string `json:"tableName" validate:"required"` // 敏感词表名
 	Remark    string `json:"remark"`                        // 备注
@@ -43,3 +49,62 @@ type UpdateSensitiveWordTableReplyReq struct {
 	Reply   string `json:"reply"`                       // 回复设置
 	CommonCheck
 }
+
+type GuardrailPolicyReq struct {
+	AppId   string `json:"appId" form:"appId" validate:"required"`                     // 应用id
+	AppType string `json:"appType" form:"appType" validate:"required,oneof=rag agent"` // 应用类型：rag 或 agent
+	CommonCheck
+}
+
+type GuardrailPolicy struct {
+	AppId      string         `json:"appId" validate:"required"`                   // 应用id
+	AppType    string         `json:"appType" validate:"required,oneof=rag agent"` // 应用类型：rag 或 agent
+	Enable     bool           `json:"enable"`                                      // 是否启用
+	Input      GuardrailStage `json:"input"`                                       // 输入阶段，问题发送给模型前检测
+	Output     GuardrailStage `json:"output"`                                      // 输出阶段，对流式回答检测
+	BlockReply string         `json:"blockReply"`                                  // 拦截时的回复，为空时使用默认回复
+}
+
+type GuardrailStage struct {
+	Detectors  []GuardrailDetector `json:"detectors"`  // 规则检测，按顺序执行
+	Classifier GuardrailClassifier `json:"classifier"` // 分类模型检测
+}
+
+type GuardrailDetector struct {
+	Type    string `json:"type"`    // 检测器类型：phone 手机号、id_card 身份证号、email 邮箱、bank_card 银行卡号、regex 自定义正则
+	Name    string `json:"name"`    // 规则名称，redact时作为自定义正则的占位符
+	Pattern string `json:"pattern"` // 正则表达式，仅regex类型
+	Action  string `json:"action"`  // 处理动作：mask 部分打码、redact 替换为占位符、block 拦截
+}
+
+type GuardrailClassifier struct {
+	Enable  bool   `json:"enable"`  // 是否启用
+	ModelId string `json:"modelId"` // 分类模型id（已导入的大模型）
+	Prompt  string `json:"prompt"`  // 分类提示词，为空时使用默认提示词
+}
+
+func (p *GuardrailPolicy) Check() error {
+	if err := p.Input.check(); err != nil {
+		return err
+	}
+	return p.Output.check()
+}
+
+func (s *GuardrailStage) check() error {
+	var rules []guardrail.Rule
+	for _, detector := range s.Detectors {
+		rules = append(rules, guardrail.Rule{
+			Type:    detector.Type,
+			Name:    detector.Name,
+			Pattern: detector.Pattern,
+			Action:  detector.Action,
+		})
+	}
+	if _, err := guardrail.NewStage(rules, nil); err != nil {
+		return err
+	}
+	if s.Classifier.Enable && s.Classifier.ModelId == "" {
+		return errors.New("classifier modelId required")
+	}
+	return nil
+}
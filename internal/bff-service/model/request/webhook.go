@@ -0,0 +1,54 @@
+package request
+
+import (
+	"fmt"
+
+	"github.com/UnicomAI/wanwu/pkg/webhook"
+)
+
+type WebhookRequest struct {
+	Name   string   `json:"name" validate:"required,max=64"`    // 名称
+	Url    string   `json:"url" validate:"required,max=1024"`   // 接收地址，仅支持http/https及公网地址（或operate-service配置的白名单地址）
+	Secret string   `json:"secret" validate:"omitempty,min=16"` // 签名密钥；创建时为空则自动生成，编辑时为空表示不修改
+	Events []string `json:"events" validate:"required,min=1"`   // 订阅的事件，支持具体事件、* 及 knowledge.* 形式的前缀
+	Enable bool     `json:"enable"`                             // 是否启用
+}
+
+func (w WebhookRequest) Check() error {
+	// 地址是否允许投递由operate-service按webhook白名单校验
+	if err := webhook.ValidURLFormat(w.Url); err != nil {
+		return err
+	}
+	for _, event := range w.Events {
+		if !webhook.ValidFilter(event) {
+			return fmt.Errorf("invalid event: %v", event)
+		}
+	}
+	return nil
+}
+
+type CreateWebhookRequest struct {
+	WebhookRequest
+}
+
+type UpdateWebhookRequest struct {
+	WebhookId string `json:"webhookId" validate:"required"` // webhook ID
+	WebhookRequest
+}
+
+type WebhookIdRequest struct {
+	WebhookId string `json:"webhookId" validate:"required"` // webhook ID
+	CommonCheck
+}
+
+type WebhookDeliveryListRequest struct {
+	WebhookId string `json:"webhookId" form:"webhookId"`                                           // webhook ID，为空时不过滤
+	Status    string `json:"status" form:"status" validate:"omitempty,oneof=pending success dead"` // 投递状态：pending 待投递，success 成功，dead 死信；为空时不过滤
+	PageSearch
+	CommonCheck
+}
+
+type WebhookDeliveryIdRequest struct {
+	DeliveryId string `json:"deliveryId" validate:"required"` // 投递ID
+	CommonCheck
+}
@@ -1,7 +1,13 @@
 package response
 
 type ApiResponse struct {
-	ApiID     string `json:"apiId" `    // ApiID
-	ApiKey    string `json:"apiKey"`    // 生成的ApiKey
-	CreatedAt string `json:"createdAt"` // 创建ApiKey的时间
+	ApiID          string   `json:"apiId" `         // ApiID
+	ApiKey         string   `json:"apiKey"`         // 生成的ApiKey，仅在生成或轮换时返回完整值，列表中为脱敏值
+	KeyPrefix      string   `json:"keyPrefix"`      // ApiKey可见前缀
+	Scopes         []string `json:"scopes"`         // 授权范围，为空表示不限制
+	AllowedOrigins []string `json:"allowedOrigins"` // 允许的请求来源，为空表示不限制
+	AllowedIps     []string `json:"allowedIps"`     // 允许的客户端IP或CIDR，为空表示不限制
+	ExpiresAt      string   `json:"expiresAt"`      // 过期时间，为空表示永不过期
+	LastUsedAt     string   `json:"lastUsedAt"`     // 最近使用时间
+	CreatedAt      string   `json:"createdAt"`      // 创建ApiKey的时间
 }
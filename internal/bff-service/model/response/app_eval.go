@@ -0,0 +1,60 @@
+package response
+
+type AppEvalQuestion struct {
+	QuestionId      string `json:"questionId"`
+	Question        string `json:"question"`
+	ReferenceAnswer string `json:"referenceAnswer"`
+}
+
+type AppEvalDatasetInfo struct {
+	DatasetId     string            `json:"datasetId"`
+	Name          string            `json:"name"`
+	Description   string            `json:"description"`
+	QuestionCount int32             `json:"questionCount"`
+	Questions     []AppEvalQuestion `json:"questions,omitempty"` // 仅详情返回
+	CreatedAt     string            `json:"createdAt"`
+}
+
+type AppEvalScores struct {
+	Faithfulness float64 `json:"faithfulness"` // 忠实度：回答是否基于检索内容，1-5分
+	Relevance    float64 `json:"relevance"`    // 相关性：回答是否切题，1-5分
+	Correctness  float64 `json:"correctness"`  // 正确性：回答与参考答案是否一致，1-5分
+}
+
+type AppEvalJobInfo struct {
+	JobId         string        `json:"jobId"`
+	DatasetId     string        `json:"datasetId"`
+	AppId         string        `json:"appId"`
+	AppType       string        `json:"appType"`
+	JudgeModelId  string        `json:"judgeModelId"`
+	BaselineJobId string        `json:"baselineJobId"`
+	Tolerance     float64       `json:"tolerance"`
+	MinScore      float64       `json:"minScore"`
+	Status        int32         `json:"status"`      // 0.评测中 1.成功 2.失败
+	TotalCount    int32         `json:"totalCount"`  // 问题总数
+	FinishCount   int32         `json:"finishCount"` // 已评测问题数
+	ErrorCount    int32         `json:"errorCount"`  // 回答或评分失败的问题数
+	Scores        AppEvalScores `json:"scores"`      // 评分成功问题的平均分
+	GateStatus    int32         `json:"gateStatus"`  // 发布门禁：0.未设置 1.通过 2.未通过
+	GateReason    string        `json:"gateReason"`  // 门禁未通过原因
+	ErrorMsg      string        `json:"errorMsg"`
+	CreatedAt     string        `json:"createdAt"`
+	UpdatedAt     string        `json:"updatedAt"`
+}
+
+type AppEvalResult struct {
+	QuestionId      string        `json:"questionId"`
+	Question        string        `json:"question"`
+	ReferenceAnswer string        `json:"referenceAnswer"`
+	Answer          string        `json:"answer"`
+	SearchList      interface{}   `json:"searchList"` // 回答引用的检索结果
+	Scores          AppEvalScores `json:"scores"`
+	Reason          string        `json:"reason"`    // 评分理由
+	ErrorMsg        string        `json:"errorMsg"`  // 回答或评分失败原因
+	LatencyMs       int64         `json:"latencyMs"` // 回答耗时
+}
+
+type AppEvalJobDetail struct {
+	Job     AppEvalJobInfo  `json:"job"`
+	Results []AppEvalResult `json:"results"`
+}
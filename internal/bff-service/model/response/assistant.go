@@ -15,6 +15,7 @@ type Assistant struct {
 	RerankConfig           request.AppModelConfig         `json:"rerankConfig"`        // Rerank模型
 	OnlineSearchConfig     request.OnlineSearchConfig     `json:"onlineSearchConfig"`  // 在线搜索配置
 	SafetyConfig           request.AppSafetyConfig        `json:"safetyConfig"`        // 敏感词表配置
+	MemoryConfig           request.AssistantMemoryConfig  `json:"memoryConfig"`        // 对话记忆配置
 	Scope                  int32                          `json:"scope"`               // 作用域
 	WorkFlowInfos          []*WorkFlowInfos               `json:"workFlowInfos"`       // 工作流信息
 	MCPInfos               []*MCPInfos                    `json:"mcpInfos"`            // MCP信息
@@ -64,6 +65,9 @@ type ConversationDetailInfo struct {
 	Id              string      `json:"id"`
 	AssistantId     string      `json:"assistantId"`
 	ConversationId  string      `json:"conversationId"`
+	ParentId        string      `json:"parentId"`     // 上一轮对话id，首轮为对话id
+	SiblingCount    int32       `json:"siblingCount"` // 同一轮对话的版本数（重新生成、编辑问题）
+	SiblingIndex    int32       `json:"siblingIndex"` // 当前版本的序号，从0开始
 	Prompt          string      `json:"prompt"`
 	SysPrompt       string      `json:"sysPrompt"`
 	Response        string      `json:"response"`
@@ -75,6 +79,48 @@ type ConversationDetailInfo struct {
 	RequestFileUrls []string    `json:"requestFileUrls"`
 	FileSize        int64       `json:"fileSize"`
 	FileName        string      `json:"fileName"`
+	Rating          int32       `json:"rating"` // 当前用户的评价 1:点赞 -1:点踩 0:未评价
+}
+
+type ConversationDetailSiblings struct {
+	List        []ConversationDetailInfo `json:"list"`
+	ActiveIndex int32                    `json:"activeIndex"` // 当前分支所在版本的序号
+}
+
+type ConversationFeedbackInfo struct {
+	FeedbackId     string      `json:"feedbackId"`
+	AssistantId    string      `json:"assistantId"`
+	ConversationId string      `json:"conversationId"`
+	DetailId       string      `json:"detailId"`
+	Rating         int32       `json:"rating"`
+	Reasons        []string    `json:"reasons"`
+	Content        string      `json:"content"`
+	Prompt         string      `json:"prompt"`
+	Response       string      `json:"response"`
+	SearchList     interface{} `json:"searchList"` // 回答时检索到的知识片段
+	UserId         string      `json:"userId"`
+	CreatedAt      int64       `json:"createdAt"`
+	UpdatedAt      int64       `json:"updatedAt"`
+}
+
+// ConversationExportRecord 对话导出与导入的JSONL记录，每行为一轮对话
+type ConversationExportRecord struct {
+	ConversationId string      `json:"conversationId"`
+	Title          string      `json:"title"`
+	Id             string      `json:"id"`
+	ParentId       string      `json:"parentId"` // 上一轮对话id，首轮为对话id
+	Prompt         string      `json:"prompt"`
+	Response       string      `json:"response"`
+	SearchList     interface{} `json:"searchList"`
+	FileName       string      `json:"fileName"`
+	FileSize       int64       `json:"fileSize"`
+	FileUrl        string      `json:"fileUrl"`
+	CreatedAt      int64       `json:"createdAt"`
+	UpdatedAt      int64       `json:"updatedAt"`
+}
+
+type ConversationImportResp struct {
+	ConversationIds []string `json:"conversationIds"`
 }
 
 type ConversationCreateResp struct {
@@ -0,0 +1,19 @@
+package response
+
+type AuditLogInfo struct {
+	AuditId    string `json:"auditId"`
+	OrgId      string `json:"orgId"`
+	UserId     string `json:"userId"`
+	UserName   string `json:"userName"`
+	Action     string `json:"action"`     // 路由权限标识
+	ActionName string `json:"actionName"` // 操作描述
+	Method     string `json:"method"`
+	Path       string `json:"path"`
+	TargetId   string `json:"targetId"` // 操作对象ID
+	Success    bool   `json:"success"`
+	Code       int64  `json:"code"`
+	ErrMsg     string `json:"errMsg"`   // 失败原因
+	ClientIp   string `json:"clientIp"` // 客户端IP
+	Request    string `json:"request"`  // 脱敏后的请求参数
+	CreatedAt  string `json:"createdAt"`
+}
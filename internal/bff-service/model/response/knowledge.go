@@ -26,6 +26,8 @@ type KnowledgeInfo struct {
 	EmbeddingModelInfo *EmbeddingModelInfo `json:"embeddingModelInfo"` //embedding模型信息
 	KnowledgeTagList   []*KnowledgeTag     `json:"knowledgeTagList"`   //知识库标签列表
 	CreateAt           string              `json:"createAt"`           //创建时间
+	PermissionType     int32               `json:"permissionType"`     //当前用户权限：1.查看 2.编辑 3.管理
+	Shared             bool                `json:"shared"`             //是否为他人分享的知识库
 }
 
 type KnowledgeMetaData struct {
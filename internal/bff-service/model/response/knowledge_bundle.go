@@ -0,0 +1,18 @@
+package response
+
+type KnowledgeBundleTaskResp struct {
+	TaskId string `json:"taskId"`
+}
+
+type KnowledgeBundleTaskInfo struct {
+	TaskId        string `json:"taskId"`
+	TaskType      int32  `json:"taskType"`      // 任务类型：1.导出 2.导入
+	KnowledgeId   string `json:"knowledgeId"`   // 导出的知识库id或导入后新建的知识库id
+	KnowledgeName string `json:"knowledgeName"` // 知识库名称
+	Status        int32  `json:"status"`        // 任务状态：0.待处理 1.处理中 2.成功 3.失败
+	TotalCount    int32  `json:"totalCount"`    // 文档总数
+	FinishCount   int32  `json:"finishCount"`   // 已处理文档数
+	ErrorMsg      string `json:"errorMsg"`      // 失败原因
+	CreatedAt     string `json:"createdAt"`
+	UpdatedAt     string `json:"updatedAt"`
+}
@@ -0,0 +1,26 @@
+package response
+
+import "github.com/UnicomAI/wanwu/internal/bff-service/model/request"
+
+type ConnectorSaveResp struct {
+	ConnectorId string `json:"connectorId"` // 连接器id
+}
+
+type ConnectorListResp struct {
+	List []*ConnectorInfo `json:"list"`
+}
+
+type ConnectorInfo struct {
+	ConnectorId      string                   `json:"connectorId"`      // 连接器id
+	KnowledgeId      string                   `json:"knowledgeId"`      // 知识库id
+	Name             string                   `json:"name"`             // 连接器名称
+	ConnectorType    string                   `json:"connectorType"`    // 连接器类型：s3、git、webdav、local
+	Config           *request.ConnectorConfig `json:"config"`           // 连接器配置
+	HasCredential    bool                     `json:"hasCredential"`    // 是否已设置凭证，凭证不返回
+	Status           int32                    `json:"status"`           // 0.未执行 1.执行中 2.执行成功 3.执行失败
+	ErrorMsg         string                   `json:"errorMsg"`         // 失败原因
+	Cursor           string                   `json:"cursor"`           // 增量游标：git为commit，其他为文件修改时间
+	LastImportTaskId string                   `json:"lastImportTaskId"` // 最近一次创建的导入任务id
+	LastRunAt        string                   `json:"lastRunAt"`        // 最近执行时间
+	CreateAt         string                   `json:"createAt"`         // 创建时间
+}
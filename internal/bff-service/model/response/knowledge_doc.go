@@ -71,3 +71,19 @@ type Url struct {
 type DocChildSegmentResp struct {
 	SegmentContentList []*ChildSegmentInfo `json:"contentList"` //内容
 }
+
+type DocSegmentImportReport struct {
+	ImportId     string                      `json:"importId"`
+	Status       int32                       `json:"status"`       // 0.待处理 1.导入中 2.成功 3.失败
+	TotalCount   int32                       `json:"totalCount"`   // 文件总行数
+	SuccessCount int32                       `json:"successCount"` // 成功行数
+	ErrorMsg     string                      `json:"errorMsg"`
+	RowErrorList []*DocSegmentImportRowError `json:"rowErrorList"` // 失败行，最多记录1000行
+	CreatedAt    string                      `json:"createdAt"`
+}
+
+type DocSegmentImportRowError struct {
+	Line      int32  `json:"line"`      // 行号，从1开始，不含表头
+	ContentId string `json:"contentId"` // 分段id
+	ErrorMsg  string `json:"errorMsg"`
+}
@@ -0,0 +1,34 @@
+package response
+
+type DocSyncSaveResp struct {
+	SyncId string `json:"syncId"` // 同步配置id
+}
+
+type DocSyncListResp struct {
+	List []*DocSyncInfo `json:"list"`
+}
+
+type DocSyncInfo struct {
+	SyncId          string `json:"syncId"`          // 同步配置id
+	KnowledgeId     string `json:"knowledgeId"`     // 知识库id
+	DocId           string `json:"docId"`           // url文档id
+	ImportTaskId    string `json:"importTaskId"`    // url导入任务id
+	CronExpr        string `json:"cronExpr"`        // cron表达式
+	IntervalMinutes int32  `json:"intervalMinutes"` // 同步间隔（分钟）
+	Enable          bool   `json:"enable"`          // 是否启用
+	LastSyncAt      string `json:"lastSyncAt"`      // 上次同步时间
+	NextSyncAt      string `json:"nextSyncAt"`      // 下次同步时间
+	CreateAt        string `json:"createAt"`        // 创建时间
+}
+
+type DocSyncHistoryInfo struct {
+	SyncId     string `json:"syncId"`     // 同步配置id
+	DocId      string `json:"docId"`      // 文档id
+	DocName    string `json:"docName"`    // 文档名称
+	DocUrl     string `json:"docUrl"`     // 文档url
+	Status     int32  `json:"status"`     // 同步结果：1.内容未变化 2.内容已变化并重新导入 3.同步失败
+	ContentMd5 string `json:"contentMd5"` // 本次获取的内容md5
+	ErrorMsg   string `json:"errorMsg"`   // 失败原因
+	StartAt    string `json:"startAt"`    // 开始时间
+	FinishAt   string `json:"finishAt"`   // 结束时间
+}
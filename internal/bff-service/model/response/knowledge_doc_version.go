@@ -0,0 +1,32 @@
+package response
+
+type DocVersionListResp struct {
+	List []*DocVersionInfo `json:"list"` // 按版本号倒序，第一条为当前生效版本
+}
+
+type DocVersionInfo struct {
+	VersionId    string `json:"versionId"`    // 历史版本id，当前生效版本为空
+	VersionNo    int32  `json:"versionNo"`    // 版本号
+	DocName      string `json:"docName"`      // 文档名称
+	DocType      string `json:"docType"`      // 文档类型
+	DocSize      int64  `json:"docSize"`      // 文档大小
+	SegmentCount int32  `json:"segmentCount"` // 切片数量
+	Active       bool   `json:"active"`       // 是否当前生效版本
+	CreateAt     string `json:"createAt"`     // 历史版本为归档时间，当前版本为文档更新时间
+}
+
+type DocVersionDiffResp struct {
+	BaseSegmentCount   int32             `json:"baseSegmentCount"`   // 基准版本切片数量
+	TargetSegmentCount int32             `json:"targetSegmentCount"` // 目标版本切片数量
+	AddedCount         int32             `json:"addedCount"`         // 新增切片数量
+	DeletedCount       int32             `json:"deletedCount"`       // 删除切片数量
+	ModifiedCount      int32             `json:"modifiedCount"`      // 修改切片数量
+	List               []*DocSegmentDiff `json:"list"`               // 有差异的切片
+}
+
+type DocSegmentDiff struct {
+	ContentNum    int32  `json:"contentNum"`    // 切片序号
+	ChangeType    string `json:"changeType"`    // 差异类型：added、deleted、modified
+	BaseContent   string `json:"baseContent"`   // 基准版本切片内容
+	TargetContent string `json:"targetContent"` // 目标版本切片内容
+}
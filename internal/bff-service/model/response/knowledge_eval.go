@@ -0,0 +1,85 @@
+package response
+
+import "github.com/UnicomAI/wanwu/internal/bff-service/model/request"
+
+type CreateKnowledgeEvalDatasetResp struct {
+	DatasetId string `json:"datasetId"`
+}
+
+type KnowledgeEvalDatasetListResp struct {
+	DatasetList []*KnowledgeEvalDatasetInfo `json:"datasetList"`
+}
+
+type KnowledgeEvalDatasetInfo struct {
+	DatasetId     string                           `json:"datasetId"`
+	KnowledgeId   string                           `json:"knowledgeId"`
+	Name          string                           `json:"name"`
+	Description   string                           `json:"description"`
+	QuestionCount int32                            `json:"questionCount"`          // 问题数
+	QuestionList  []*request.KnowledgeEvalQuestion `json:"questionList,omitempty"` // 问题列表，仅详情返回
+	CreatedAt     string                           `json:"createdAt"`
+}
+
+type CreateKnowledgeEvalRunResp struct {
+	RunId string `json:"runId"`
+}
+
+type KnowledgeEvalRunListResp struct {
+	RunList []*KnowledgeEvalRunInfo `json:"runList"`
+}
+
+type KnowledgeEvalMetrics struct {
+	Recall  float64 `json:"recall"`  // recall@k
+	Mrr     float64 `json:"mrr"`     // 平均倒数排名，单个问题为倒数排名
+	Ndcg    float64 `json:"ndcg"`    // nDCG@k
+	HitRate float64 `json:"hitRate"` // 命中率，单个问题命中为1
+}
+
+type KnowledgeEvalRunInfo struct {
+	RunId                string                        `json:"runId"`
+	DatasetId            string                        `json:"datasetId"`
+	KnowledgeId          string                        `json:"knowledgeId"`
+	KnowledgeMatchParams *request.KnowledgeMatchParams `json:"knowledgeMatchParams"` // 检索配置
+	Status               int32                         `json:"status"`               // 评测状态：0.待处理 1.评测中 2.成功 3.失败
+	TotalCount           int32                         `json:"totalCount"`           // 问题总数
+	FinishCount          int32                         `json:"finishCount"`          // 已评测问题数
+	Metrics              *KnowledgeEvalMetrics         `json:"metrics"`              // 各问题指标的平均值
+	ErrorMsg             string                        `json:"errorMsg"`             // 失败原因
+	CreatedAt            string                        `json:"createdAt"`
+}
+
+type KnowledgeEvalHit struct {
+	DocId     string  `json:"docId"` // 命中文档id，文档已删除时为空
+	ContentId string  `json:"contentId"`
+	Title     string  `json:"title"`
+	Snippet   string  `json:"snippet"`
+	Score     float64 `json:"score"`
+	Relevant  bool    `json:"relevant"` // 是否为期望命中的文档或分段
+}
+
+type KnowledgeEvalQuestionResult struct {
+	QuestionId string                `json:"questionId"`
+	Question   string                `json:"question"`
+	HitList    []*KnowledgeEvalHit   `json:"hitList"`
+	Metrics    *KnowledgeEvalMetrics `json:"metrics"`
+	ErrorMsg   string                `json:"errorMsg"` // 检索失败原因，失败时指标为0
+}
+
+type KnowledgeEvalRunDetail struct {
+	Run        *KnowledgeEvalRunInfo          `json:"run"`
+	ResultList []*KnowledgeEvalQuestionResult `json:"resultList"`
+}
+
+type KnowledgeEvalQuestionCompare struct {
+	QuestionId    string                `json:"questionId"`
+	Question      string                `json:"question"`
+	BaseMetrics   *KnowledgeEvalMetrics `json:"baseMetrics"`
+	TargetMetrics *KnowledgeEvalMetrics `json:"targetMetrics"`
+}
+
+type CompareKnowledgeEvalRunResp struct {
+	BaseRun      *KnowledgeEvalRunInfo           `json:"baseRun"`
+	TargetRun    *KnowledgeEvalRunInfo           `json:"targetRun"`
+	Delta        *KnowledgeEvalMetrics           `json:"delta"` // 对比评测减基准评测的指标差值
+	QuestionList []*KnowledgeEvalQuestionCompare `json:"questionList"`
+}
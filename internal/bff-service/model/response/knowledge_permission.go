@@ -0,0 +1,15 @@
+package response
+
+type KnowledgePermissionListResp struct {
+	List []*KnowledgePermissionInfo `json:"list"`
+}
+
+type KnowledgePermissionInfo struct {
+	GranteeType    string `json:"granteeType"`    // 分享对象类型：user（用户）、role（角色）、org（组织）
+	GranteeId      string `json:"granteeId"`      // 分享对象id
+	GranteeName    string `json:"granteeName"`    // 分享对象名称
+	PermissionType int32  `json:"permissionType"` // 权限：1.查看 2.编辑 3.管理
+	SharerId       string `json:"sharerId"`       // 分享人id
+	SharerName     string `json:"sharerName"`     // 分享人名称
+	CreateAt       string `json:"createAt"`       // 分享时间
+}
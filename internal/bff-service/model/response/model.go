@@ -7,20 +7,29 @@ import (
 )
 
 type ModelInfo struct {
-	ModelId     string                  `json:"modelId"`
-	Provider    string                  `json:"provider" validate:"required" enums:"OpenAI-API-compatible,YuanJing"` // 模型供应商
-	Model       string                  `json:"model" validate:"required"`                                           // 模型名称
-	ModelType   string                  `json:"modelType" validate:"required" enums:"llm,embedding,rerank"`
-	DisplayName string                  `json:"displayName"` // 模型显示名称
-	Avatar      request.Avatar          `json:"avatar" `     // 模型图标路径
-	PublishDate string                  `json:"publishDate"` // 模型发布时间
-	IsActive    bool                    `json:"isActive"`    // 启用状态（true: 启用，false: 禁用）
-	UserId      string                  `json:"userId"`
-	OrgId       string                  `json:"orgId"`
-	CreatedAt   string                  `json:"createdAt"`
-	UpdatedAt   string                  `json:"updatedAt"`
-	ModelDesc   string                  `json:"modelDesc"`
-	Tags        []mp_common.Tag         `json:"tags"`
-	Config      interface{}             `json:"config"`
-	Examples    *mp.ProviderModelConfig `json:"examples,omitempty"` // 仅用于swagger展示；模型对应供应商中的对应llm、embedding或rerank结构是config实际的参数
+	ModelId       string                  `json:"modelId"`
+	Provider      string                  `json:"provider" validate:"required" enums:"OpenAI-API-compatible,YuanJing"` // 模型供应商
+	Model         string                  `json:"model" validate:"required"`                                           // 模型名称
+	ModelType     string                  `json:"modelType" validate:"required" enums:"llm,embedding,rerank"`
+	DisplayName   string                  `json:"displayName"` // 模型显示名称
+	Avatar        request.Avatar          `json:"avatar" `     // 模型图标路径
+	PublishDate   string                  `json:"publishDate"` // 模型发布时间
+	IsActive      bool                    `json:"isActive"`    // 启用状态（true: 启用，false: 禁用）
+	UserId        string                  `json:"userId"`
+	OrgId         string                  `json:"orgId"`
+	CreatedAt     string                  `json:"createdAt"`
+	UpdatedAt     string                  `json:"updatedAt"`
+	ModelDesc     string                  `json:"modelDesc"`
+	Tags          []mp_common.Tag         `json:"tags"`
+	Config        interface{}             `json:"config"`
+	RoutingPolicy *mp.RoutingPolicy       `json:"routingPolicy,omitempty"` // 模型路由策略
+	CachePolicy   *mp.CachePolicy         `json:"cachePolicy,omitempty"`   // 模型响应缓存策略
+	Examples      *mp.ProviderModelConfig `json:"examples,omitempty"`      // 仅用于swagger展示；模型对应供应商中的对应llm、embedding或rerank结构是config实际的参数
+}
+
+type ModelCacheStat struct {
+	ModelId     string `json:"modelId"`
+	Hit         int64  `json:"hit"`         // 精确缓存命中次数
+	SemanticHit int64  `json:"semanticHit"` // 语义缓存命中次数
+	Miss        int64  `json:"miss"`        // 未命中次数
 }
@@ -0,0 +1,27 @@
+package response
+
+type ModelUsageStat struct {
+	ModelId          string `json:"modelId"`
+	Provider         string `json:"provider"`
+	ModelType        string `json:"modelType"`
+	Model            string `json:"model"`
+	OrgId            string `json:"orgId"`
+	AppId            string `json:"appId"`
+	AppType          string `json:"appType"`
+	Calls            int64  `json:"calls"`            // 调用次数
+	FailedCalls      int64  `json:"failedCalls"`      // 失败次数
+	PromptTokens     int64  `json:"promptTokens"`     // 输入token数
+	CompletionTokens int64  `json:"completionTokens"` // 输出token数
+	TotalTokens      int64  `json:"totalTokens"`      // 总token数
+	AvgLatencyMs     int64  `json:"avgLatencyMs"`     // 平均耗时（毫秒）
+}
+
+type ModelQuota struct {
+	OrgId         string `json:"orgId"`
+	UserId        string `json:"userId"`        // 为空表示组织配额
+	DailyTokens   int64  `json:"dailyTokens"`   // 每日token上限，0表示不限制
+	MonthlyTokens int64  `json:"monthlyTokens"` // 每月token上限，0表示不限制
+	DailyUsed     int64  `json:"dailyUsed"`     // 当日已用token
+	MonthlyUsed   int64  `json:"monthlyUsed"`   // 当月已用token
+	UpdatedAt     string `json:"updatedAt"`
+}
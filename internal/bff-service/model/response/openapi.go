@@ -49,3 +49,58 @@ type OpenAIChatHistory struct {
 	Query    string `json:"query"`
 	Response string `json:"response"`
 }
+
+// OpenAIChatCompletion OpenAI兼容的对话返回，object为chat.completion或chat.completion.chunk
+type OpenAIChatCompletion struct {
+	ID             string             `json:"id"`
+	Object         string             `json:"object"`
+	Created        int64              `json:"created"`
+	Model          string             `json:"model"`
+	Choices        []OpenAIChatChoice `json:"choices"`
+	Usage          *OpenAIChatUsage   `json:"usage,omitempty"`
+	Citations      []OpenAIChatSearch `json:"citations,omitempty"`       // 扩展字段，引用的知识片段
+	ConversationID string             `json:"conversation_id,omitempty"` // 扩展字段，智能体对话id
+	MsgID          string             `json:"msg_id,omitempty"`          // 扩展字段，文本问答消息id，用于回答评价
+}
+
+type OpenAIChatChoice struct {
+	Index        int                `json:"index"`
+	Message      *OpenAIChatMessage `json:"message,omitempty"`
+	Delta        *OpenAIChatMessage `json:"delta,omitempty"`
+	FinishReason *string            `json:"finish_reason"`
+}
+
+type OpenAIChatMessage struct {
+	Role    string `json:"role,omitempty"`
+	Content string `json:"content"`
+}
+
+type OpenAIChatUsage struct {
+	PromptTokens     int `json:"prompt_tokens"`
+	CompletionTokens int `json:"completion_tokens"`
+	TotalTokens      int `json:"total_tokens"`
+}
+
+type OpenAIModelList struct {
+	Object string        `json:"object"`
+	Data   []OpenAIModel `json:"data"`
+}
+
+type OpenAIModel struct {
+	ID      string `json:"id"` // 应用id
+	Object  string `json:"object"`
+	Created int64  `json:"created"`
+	OwnedBy string `json:"owned_by"`
+	Name    string `json:"name"`     // 扩展字段，应用名称
+	AppType string `json:"app_type"` // 扩展字段，应用类型：agent | rag
+}
+
+type OpenAIError struct {
+	Error OpenAIErrorDetail `json:"error"`
+}
+
+type OpenAIErrorDetail struct {
+	Message string `json:"message"`
+	Type    string `json:"type"`
+	Code    string `json:"code"`
+}
@@ -10,3 +10,18 @@ type RagInfo struct {
 	KnowledgeBaseConfig request.AppKnowledgebaseConfig `json:"knowledgeBaseConfig" validate:"required"` // 知识库
 	SafetyConfig        request.AppSafetyConfig        `json:"safetyConfig"`                            // 敏感词表配置
 }
+
+type RagFeedbackInfo struct {
+	FeedbackID string      `json:"feedbackId"`
+	RagID      string      `json:"ragId"`
+	MsgID      string      `json:"msgId"`
+	Rating     int32       `json:"rating"`
+	Reasons    []string    `json:"reasons"`
+	Content    string      `json:"content"`
+	Question   string      `json:"question"`
+	Answer     string      `json:"answer"`
+	SearchList interface{} `json:"searchList"` // 回答时检索到的知识片段
+	UserID     string      `json:"userId"`
+	CreatedAt  int64       `json:"createdAt"`
+	UpdatedAt  int64       `json:"updatedAt"`
+}
@@ -0,0 +1,15 @@
+package response
+
+type RateLimitStats struct {
+	Dimensions []*RateLimitStat `json:"dimensions"` // 按维度汇总
+	Subjects   []*RateLimitStat `json:"subjects"`   // 按ApiKey、应用明细
+}
+
+type RateLimitStat struct {
+	Dimension           string `json:"dimension"`           // 维度：api_key | app | client | ip
+	Id                  string `json:"id"`                  // ApiKey的apiId或应用的appId，维度汇总时为空
+	Allowed             int64  `json:"allowed"`             // 放行请求数
+	Rejected            int64  `json:"rejected"`            // 请求速率超限被拒绝数
+	ConcurrencyRejected int64  `json:"concurrencyRejected"` // 并发流超限被拒绝数
+	Streams             int64  `json:"streams"`             // 当前并发流数，维度汇总时为0
+}
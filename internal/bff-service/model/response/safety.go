@@ -1,5 +1,7 @@
 package response
 
+import "github.com/UnicomAI/wanwu/internal/bff-service/model/request"
+
 type CreateSensitiveWordTableResp struct {
 	TableId string `json:"tableId"` //敏感词表id
 }
@@ -17,3 +19,13 @@ type SensitiveWordVocabularyDetail struct {
 	Word          string `json:"word"`          // 敏感词
 	SensitiveType string `json:"sensitiveType"` // 敏感词类型
 }
+
+type GuardrailPolicy struct {
+	AppId      string                 `json:"appId"`      // 应用id
+	AppType    string                 `json:"appType"`    // 应用类型：rag 或 agent
+	Enable     bool                   `json:"enable"`     // 是否启用
+	Input      request.GuardrailStage `json:"input"`      // 输入阶段
+	Output     request.GuardrailStage `json:"output"`     // 输出阶段
+	BlockReply string                 `json:"blockReply"` // 拦截时的回复
+	UpdatedAt  string                 `json:"updatedAt"`  // 更新时间，未配置时为空
+}
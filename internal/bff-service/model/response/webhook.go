@@ -0,0 +1,28 @@
+package response
+
+type WebhookInfo struct {
+	WebhookId string   `json:"webhookId"`
+	Name      string   `json:"name"`
+	Url       string   `json:"url"`
+	Secret    string   `json:"secret"` // 签名密钥，仅在创建时返回完整值，列表中为脱敏值
+	Events    []string `json:"events"` // 订阅的事件
+	Enable    bool     `json:"enable"`
+	UserId    string   `json:"userId"` // 创建人ID
+	CreatedAt string   `json:"createdAt"`
+	UpdatedAt string   `json:"updatedAt"`
+}
+
+type WebhookDeliveryInfo struct {
+	DeliveryId  string `json:"deliveryId"`
+	WebhookId   string `json:"webhookId"`
+	EventId     string `json:"eventId"`     // 事件ID，同一事件投递到多个webhook时相同
+	Event       string `json:"event"`       // 事件类型
+	Payload     string `json:"payload"`     // 投递内容
+	Status      string `json:"status"`      // 投递状态：pending 待投递，success 成功，dead 死信
+	Attempts    int32  `json:"attempts"`    // 已投递次数
+	NextRetryAt string `json:"nextRetryAt"` // 下次投递时间
+	LastCode    int32  `json:"lastCode"`    // 最近一次投递的响应码
+	LastError   string `json:"lastError"`   // 最近一次投递的失败原因
+	CreatedAt   string `json:"createdAt"`
+	UpdatedAt   string `json:"updatedAt"`
+}
@@ -35,6 +35,10 @@ func Start(ctx context.Context) {
 	// router
 	gin.ForceConsoleColor()
 	r := gin.Default()
+	// 仅采信可信代理转发的客户端IP，避免伪造X-Forwarded-For绕过IP白名单、限流与审计
+	if err := r.SetTrustedProxies(config.Cfg().Server.TrustedProxies); err != nil {
+		log.Fatalf("set trusted proxies err: %v", err)
+	}
 	// v1
 	v1.Register(r.Group("/v1"))
 	// v2
@@ -2,6 +2,7 @@ package openapi
 
 import (
 	"encoding/json"
+	"errors"
 	"net/http"
 	"strings"
 
@@ -10,8 +11,10 @@ import (
 	"github.com/UnicomAI/wanwu/internal/bff-service/service"
 	gin_util "github.com/UnicomAI/wanwu/pkg/gin-util"
 	"github.com/UnicomAI/wanwu/pkg/log"
+	mp_common "github.com/UnicomAI/wanwu/pkg/model-provider/mp-common"
 	sse_util "github.com/UnicomAI/wanwu/pkg/sse-util"
 	"github.com/gin-gonic/gin"
+	"github.com/gin-gonic/gin/binding"
 )
 
 //	@title		AI Agent Productivity Platform - Open API
@@ -172,6 +175,56 @@ func ChatRag(ctx *gin.Context) {
 	ctx.JSON(status, resp)
 }
 
+// AgentFeedback
+//
+//	@Tags			openapi
+//	@Summary		智能体回答评价OpenAPI
+//	@Description	点赞/点踩智能体回答，未指定detail_id时评价对话最后一轮回答；rating为0时取消评价
+//	@Accept			json
+//	@Produce		json
+//	@Param			data	body		request.OpenAPIAgentFeedbackRequest	true	"请求参数"
+//	@Success		200		{object}	response.Response
+//	@Router			/agent/chat/feedback [post]
+func AgentFeedback(ctx *gin.Context) {
+	var req request.OpenAPIAgentFeedbackRequest
+	if !gin_util.Bind(ctx, &req) {
+		return
+	}
+	err := service.ConversationFeedbackSubmit(ctx, getUserID(ctx), getOrgID(ctx), request.ConversationFeedbackRequest{
+		ConversationId: req.ConversationID,
+		DetailId:       req.DetailID,
+		Rating:         req.Rating,
+		Reasons:        req.Reasons,
+		Content:        req.Content,
+	})
+	gin_util.Response(ctx, nil, err)
+}
+
+// RagFeedback
+//
+//	@Tags			openapi
+//	@Summary		文本问答回答评价OpenAPI
+//	@Description	按问答返回的msg_id点赞/点踩回答，rating为0时取消评价
+//	@Accept			json
+//	@Produce		json
+//	@Param			data	body		request.OpenAPIRagFeedbackRequest	true	"请求参数"
+//	@Success		200		{object}	response.Response
+//	@Router			/rag/chat/feedback [post]
+func RagFeedback(ctx *gin.Context) {
+	var req request.OpenAPIRagFeedbackRequest
+	if !gin_util.Bind(ctx, &req) {
+		return
+	}
+	err := service.RagFeedbackSubmit(ctx, getUserID(ctx), getOrgID(ctx), request.RagFeedbackRequest{
+		RagID:   getAppID(ctx),
+		MsgID:   req.MsgID,
+		Rating:  req.Rating,
+		Reasons: req.Reasons,
+		Content: req.Content,
+	})
+	gin_util.Response(ctx, nil, err)
+}
+
 // WorkflowRun
 //
 //	@Tags			openapi
@@ -222,8 +275,87 @@ func WorkflowFileUpload(ctx *gin.Context) {
 	ctx.String(http.StatusOK, resp)
 }
 
+// ChatCompletions
+//
+//	@Tags			openapi
+//	@Summary		OpenAI兼容对话OpenAPI
+//	@Description	OpenAI兼容的对话接口，model为ApiKey所属的已发布智能体或文本问答应用id；
+//	@Description	智能体未指定conversation_id时新建对话，文本问答以之前的用户、助手消息作为历史；
+//	@Description	返回chat.completion或chat.completion.chunk，citations为引用的知识片段
+//	@Accept			json
+//	@Produce		json
+//	@Param			data	body		request.OpenAIChatCompletionRequest	true	"请求参数"
+//	@Success		200		{object}	response.OpenAIChatCompletion
+//	@Failure		400		{object}	response.OpenAIError
+//	@Failure		404		{object}	response.OpenAIError
+//	@Failure		500		{object}	response.OpenAIError
+//	@Router			/chat/completions [post]
+func ChatCompletions(ctx *gin.Context) {
+	var req request.OpenAIChatCompletionRequest
+	if err := ctx.ShouldBindBodyWith(&req, binding.JSON); err != nil {
+		openAIError(ctx, http.StatusBadRequest, "invalid_request_error", "", err)
+		return
+	}
+	if err := req.Check(); err != nil {
+		openAIError(ctx, http.StatusBadRequest, "invalid_request_error", "", err)
+		return
+	}
+	appID := getAppID(ctx)
+	appType := ctx.GetString(gin_util.APP_TYPE)
+	if err := service.CheckOpenAIModel(ctx, appID, appType, req.Model); err != nil {
+		openAIError(ctx, http.StatusNotFound, "invalid_request_error", "model_not_found", err)
+		return
+	}
+	// 请求参数与模型已校验，此处的错误来自下游服务或模型调用
+	if err := service.OpenAIChatCompletions(ctx, getUserID(ctx), getOrgID(ctx), appID, appType, &req); err != nil {
+		httpStatus := http.StatusInternalServerError
+		var streamErr *mp_common.StreamError
+		if errors.As(err, &streamErr) {
+			httpStatus = streamErr.HttpStatus()
+		}
+		openAIError(ctx, httpStatus, openAIErrorType(httpStatus), "", err)
+	}
+}
+
+// ListModels
+//
+//	@Tags			openapi
+//	@Summary		OpenAI兼容模型列表OpenAPI
+//	@Description	OpenAI兼容的模型列表，返回ApiKey可访问的已发布智能体或文本问答应用
+//	@Accept			json
+//	@Produce		json
+//	@Success		200	{object}	response.OpenAIModelList
+//	@Failure		500	{object}	response.OpenAIError
+//	@Router			/models [get]
+func ListModels(ctx *gin.Context) {
+	resp, err := service.OpenAIListModels(ctx, getAppID(ctx), ctx.GetString(gin_util.APP_TYPE))
+	if err != nil {
+		openAIError(ctx, http.StatusInternalServerError, "server_error", "", err)
+		return
+	}
+	ctx.JSON(http.StatusOK, resp)
+}
+
 // --- internal ---
 
+// openAIError 以OpenAI的错误格式返回
+func openAIError(ctx *gin.Context, httpStatus int, errType, code string, err error) {
+	_, msg := gin_util.ErrCodeMsg(ctx, err)
+	resp := response.OpenAIError{Error: response.OpenAIErrorDetail{Message: msg, Type: errType, Code: code}}
+	b, _ := json.Marshal(resp)
+	ctx.Set(gin_util.STATUS, httpStatus)
+	ctx.Set(gin_util.RESULT, string(b))
+	ctx.JSON(httpStatus, resp)
+}
+
+// openAIErrorType 按http状态码返回OpenAI的错误类型
+func openAIErrorType(httpStatus int) string {
+	if httpStatus >= http.StatusInternalServerError {
+		return "server_error"
+	}
+	return "invalid_request_error"
+}
+
 // 获取当前用户ID
 func getUserID(ctx *gin.Context) string {
 	return ctx.GetString(gin_util.USER_ID)
@@ -134,3 +134,51 @@ func AssistantUrlConversionStream(ctx *gin.Context) {
 		gin_util.Response(ctx, nil, err)
 	}
 }
+
+// UrlConversationFeedback
+//
+//	@Tags			openurl
+//	@Summary		评价智能体回答
+//	@Description	点赞/点踩某轮回答并填写原因，rating为0时取消评价
+//	@Security		JWT
+//	@Accept			json
+//	@Produce		json
+//	@Param			X-Client-ID								header		string								true	"临时唯一标识"
+//	@Param			suffix									path		string								true	"Url后缀"
+//	@Param			data									body		request.ConversationFeedbackRequest	true	"回答评价参数"
+//	@Success		200										{object}	response.Response
+//	@Router			/agent/{suffix}/conversation/feedback 	[post]
+func UrlConversationFeedback(ctx *gin.Context) {
+	var req request.ConversationFeedbackRequest
+	if !gin_util.Bind(ctx, &req) {
+		return
+	}
+	err := service.UrlConversationFeedbackSubmit(ctx, req, ctx.GetHeader("X-Client-ID"), ctx.Param("suffix"))
+	gin_util.Response(ctx, nil, err)
+}
+
+// UrlConversationExport
+//
+//	@Tags			openurl
+//	@Summary		导出智能体对话
+//	@Description	导出单个对话或所有对话，格式为JSONL或Markdown
+//	@Security		JWT
+//	@Accept			json
+//	@Produce		application/octet-stream
+//	@Param			X-Client-ID							header		string	true	"临时唯一标识"
+//	@Param			suffix								path		string	true	"Url后缀"
+//	@Param			conversationId						query		string	false	"智能体对话id，为空时导出所有对话"
+//	@Param			startTime							query		int		false	"开始时间（毫秒）"
+//	@Param			endTime								query		int		false	"结束时间（毫秒）"
+//	@Param			format								query		string	false	"导出格式"	Enums(jsonl,markdown)
+//	@Success		200									{object}	response.Response
+//	@Router			/agent/{suffix}/conversation/export	[get]
+func UrlConversationExport(ctx *gin.Context) {
+	var req request.UrlConversationExportRequest
+	if !gin_util.BindQuery(ctx, &req) {
+		return
+	}
+	if err := service.UrlConversationExport(ctx, req, ctx.GetHeader("X-Client-ID"), ctx.Param("suffix")); err != nil {
+		gin_util.Response(ctx, nil, err)
+	}
+}
@@ -4,21 +4,28 @@ import (
 	"net/http"
 
 	"github.com/UnicomAI/wanwu/internal/bff-service/server/http/handler/callback"
+	"github.com/UnicomAI/wanwu/internal/bff-service/server/http/middleware"
 	mid "github.com/UnicomAI/wanwu/pkg/gin-util/mid-wrap"
 	"github.com/gin-gonic/gin"
 )
 
 func Register(openAPI *gin.RouterGroup) {
 	// callback
-	mid.Sub("callback").Reg(openAPI, "/model/:modelId", http.MethodGet, callback.GetModelById, "根据modelId获取模型")
-	mid.Sub("callback").Reg(openAPI, "/model/:modelId/chat/completions", http.MethodPost, callback.ModelChatCompletions, "Model Chat Completions")
-	mid.Sub("callback").Reg(openAPI, "/model/:modelId/embeddings", http.MethodPost, callback.ModelEmbeddings, "Model Embeddings")
-	mid.Sub("callback").Reg(openAPI, "/model/:modelId/rerank", http.MethodPost, callback.ModelRerank, "Model rerank")
-	mid.Sub("callback").Reg(openAPI, "/model/:modelId/ocr", http.MethodPost, callback.ModelOcr, "Model ocr")
-	mid.Sub("callback").Reg(openAPI, "/model/:modelId/gui", http.MethodPost, callback.ModelGui, "Model gui")
-	mid.Sub("callback").Reg(openAPI, "/model/:modelId/pdf-parser", http.MethodPost, callback.ModelPdfParser, "Model pdf文档解析")
+	registerModel(openAPI, "")
+	// callback（model_url中携带调用方信息，见mp.ToCallerModelEndpoint）
+	registerModel(openAPI, "/caller/:caller", middleware.ModelCaller)
 	// workflow
 	mid.Sub("callback").Reg(openAPI, "/workflow/list", http.MethodGet, callback.GetWorkflowList, "根据userId和spaceId获取Workflow")
 	mid.Sub("callback").Reg(openAPI, "/workflow/tool/square", http.MethodGet, callback.GetWorkflowSquareTool, "获取内置工具详情")
 	mid.Sub("callback").Reg(openAPI, "/workflow/tool/custom", http.MethodGet, callback.GetWorkflowCustomTool, "获取自定义工具详情")
 }
+
+func registerModel(openAPI *gin.RouterGroup, prefix string, handlers ...gin.HandlerFunc) {
+	mid.Sub("callback").Reg(openAPI, prefix+"/model/:modelId", http.MethodGet, callback.GetModelById, "根据modelId获取模型", handlers...)
+	mid.Sub("callback").Reg(openAPI, prefix+"/model/:modelId/chat/completions", http.MethodPost, callback.ModelChatCompletions, "Model Chat Completions", handlers...)
+	mid.Sub("callback").Reg(openAPI, prefix+"/model/:modelId/embeddings", http.MethodPost, callback.ModelEmbeddings, "Model Embeddings", handlers...)
+	mid.Sub("callback").Reg(openAPI, prefix+"/model/:modelId/rerank", http.MethodPost, callback.ModelRerank, "Model rerank", handlers...)
+	mid.Sub("callback").Reg(openAPI, prefix+"/model/:modelId/ocr", http.MethodPost, callback.ModelOcr, "Model ocr", handlers...)
+	mid.Sub("callback").Reg(openAPI, prefix+"/model/:modelId/gui", http.MethodPost, callback.ModelGui, "Model gui", handlers...)
+	mid.Sub("callback").Reg(openAPI, prefix+"/model/:modelId/pdf-parser", http.MethodPost, callback.ModelPdfParser, "Model pdf文档解析", handlers...)
+}
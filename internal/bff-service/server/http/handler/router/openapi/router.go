@@ -12,9 +12,13 @@ import (
 
 func Register(openAPI *gin.RouterGroup) {
 	// openapi
-	mid.Sub("openapi").Reg(openAPI, "/agent/conversation", http.MethodPost, openapi.CreateAgentConversation, "智能体创建对话OpenAPI", middleware.AuthOpenAPI(constant.AppTypeAgent))
-	mid.Sub("openapi").Reg(openAPI, "/agent/chat", http.MethodPost, openapi.ChatAgent, "智能体问答OpenAPI", middleware.AuthOpenAPI(constant.AppTypeAgent))
-	mid.Sub("openapi").Reg(openAPI, "/rag/chat", http.MethodPost, openapi.ChatRag, "文本问答OpenAPI", middleware.AuthOpenAPI(constant.AppTypeRag))
-	mid.Sub("openapi").Reg(openAPI, "/workflow/run", http.MethodPost, openapi.WorkflowRun, "工作流OpenAPI", middleware.AuthOpenAPI(constant.AppTypeWorkflow))
-	mid.Sub("openapi").Reg(openAPI, "/workflow/file/upload", http.MethodPost, openapi.WorkflowFileUpload, "工作流OpenAPI文件上传", middleware.AuthOpenAPI(constant.AppTypeWorkflow))
+	mid.Sub("openapi").Reg(openAPI, "/agent/conversation", http.MethodPost, openapi.CreateAgentConversation, "智能体创建对话OpenAPI", middleware.AuthOpenAPI(constant.AppTypeAgent, constant.ApiKeyScopeConversation))
+	mid.Sub("openapi").Reg(openAPI, "/agent/chat", http.MethodPost, openapi.ChatAgent, "智能体问答OpenAPI", middleware.AuthOpenAPI(constant.AppTypeAgent, constant.ApiKeyScopeChat), middleware.CheckModelQuota, middleware.RateLimitOpenAPI)
+	mid.Sub("openapi").Reg(openAPI, "/agent/chat/feedback", http.MethodPost, openapi.AgentFeedback, "智能体回答评价OpenAPI", middleware.AuthOpenAPI(constant.AppTypeAgent, constant.ApiKeyScopeFeedback))
+	mid.Sub("openapi").Reg(openAPI, "/rag/chat", http.MethodPost, openapi.ChatRag, "文本问答OpenAPI", middleware.AuthOpenAPI(constant.AppTypeRag, constant.ApiKeyScopeChat), middleware.CheckModelQuota, middleware.RateLimitOpenAPI)
+	mid.Sub("openapi").Reg(openAPI, "/rag/chat/feedback", http.MethodPost, openapi.RagFeedback, "文本问答回答评价OpenAPI", middleware.AuthOpenAPI(constant.AppTypeRag, constant.ApiKeyScopeFeedback))
+	mid.Sub("openapi").Reg(openAPI, "/workflow/run", http.MethodPost, openapi.WorkflowRun, "工作流OpenAPI", middleware.AuthOpenAPI(constant.AppTypeWorkflow, constant.ApiKeyScopeChat), middleware.CheckModelQuota, middleware.RateLimitOpenAPI)
+	mid.Sub("openapi").Reg(openAPI, "/chat/completions", http.MethodPost, openapi.ChatCompletions, "OpenAI兼容对话OpenAPI", middleware.AuthOpenAPI("", constant.ApiKeyScopeChat), middleware.CheckModelQuota, middleware.RateLimitOpenAPI)
+	mid.Sub("openapi").Reg(openAPI, "/models", http.MethodGet, openapi.ListModels, "OpenAI兼容模型列表OpenAPI", middleware.AuthOpenAPI("", constant.ApiKeyScopeChat), middleware.RateLimitOpenAPI)
+	mid.Sub("openapi").Reg(openAPI, "/workflow/file/upload", http.MethodPost, openapi.WorkflowFileUpload, "工作流OpenAPI文件上传", middleware.AuthOpenAPI(constant.AppTypeWorkflow, constant.ApiKeyScopeChat))
 }
@@ -4,6 +4,7 @@ import (
 	"net/http"
 
 	"github.com/UnicomAI/wanwu/internal/bff-service/server/http/handler/openurl"
+	"github.com/UnicomAI/wanwu/internal/bff-service/server/http/middleware"
 	mid "github.com/UnicomAI/wanwu/pkg/gin-util/mid-wrap"
 	"github.com/gin-gonic/gin"
 )
@@ -17,5 +18,7 @@ func Register(openUrl *gin.RouterGroup) {
 	mid.Sub("openurl").Reg(openUrl, "/agent/:suffix/conversation", http.MethodDelete, openurl.UrlConversationDelete, "删除智能体对话")
 	mid.Sub("openurl").Reg(openUrl, "/agent/:suffix/conversation/list", http.MethodGet, openurl.GetUrlConversationList, "获取智能体对话列表")
 	mid.Sub("openurl").Reg(openUrl, "/agent/:suffix/conversation/detail", http.MethodGet, openurl.GetUrlConversationDetailList, "智能体对话详情历史列表")
-	mid.Sub("openurl").Reg(openUrl, "/agent/:suffix/stream", http.MethodPost, openurl.AssistantUrlConversionStream, "智能体流式问答")
+	mid.Sub("openurl").Reg(openUrl, "/agent/:suffix/conversation/feedback", http.MethodPost, openurl.UrlConversationFeedback, "评价智能体回答")
+	mid.Sub("openurl").Reg(openUrl, "/agent/:suffix/conversation/export", http.MethodGet, openurl.UrlConversationExport, "导出智能体对话")
+	mid.Sub("openurl").Reg(openUrl, "/agent/:suffix/stream", http.MethodPost, openurl.AssistantUrlConversionStream, "智能体流式问答", middleware.RateLimitOpenUrl)
 }
@@ -36,6 +36,14 @@ func registerAssistant(apiV1 *gin.RouterGroup) {
 	mid.Sub("agent").Reg(apiV1, "/assistant/conversation", http.MethodDelete, v1.ConversationDelete, "删除智能体对话")
 	mid.Sub("agent").Reg(apiV1, "/assistant/conversation/list", http.MethodGet, v1.GetConversationList, "智能体对话列表")
 	mid.Sub("agent").Reg(apiV1, "/assistant/conversation/detail", http.MethodGet, v1.GetConversationDetailList, "智能体对话详情历史列表")
-
-	mid.Sub("agent").Reg(apiV1, "/assistant/stream", http.MethodPost, v1.AssistantConversionStream, "智能体流式问答", middleware.AppHistoryRecord("assistantId", constant.AppTypeAgent))
+	mid.Sub("agent").Reg(apiV1, "/assistant/conversation/detail/siblings", http.MethodGet, v1.GetConversationDetailSiblings, "智能体对话版本列表")
+	mid.Sub("agent").Reg(apiV1, "/assistant/conversation/branch", http.MethodPut, v1.ConversationBranchSelect, "切换智能体对话分支")
+	mid.Sub("agent").Reg(apiV1, "/assistant/conversation/feedback", http.MethodPost, v1.ConversationFeedbackSubmit, "评价智能体回答")
+	mid.Sub("agent").Reg(apiV1, "/assistant/feedback/list", http.MethodGet, v1.GetConversationFeedbackList, "智能体回答评价列表")
+	mid.Sub("agent").Reg(apiV1, "/assistant/conversation/export", http.MethodGet, v1.ConversationExport, "导出智能体对话", middleware.Audit("conversationId", "assistantId"))
+	mid.Sub("agent").Reg(apiV1, "/assistant/conversation/import", http.MethodPost, v1.ConversationImport, "导入智能体对话")
+
+	mid.Sub("agent").Reg(apiV1, "/assistant/stream", http.MethodPost, v1.AssistantConversionStream, "智能体流式问答", middleware.CheckModelQuota, middleware.AppHistoryRecord("assistantId", constant.AppTypeAgent))
+	mid.Sub("agent").Reg(apiV1, "/assistant/stream/regenerate", http.MethodPost, v1.AssistantConversionRegenerate, "智能体重新生成回答", middleware.CheckModelQuota)
+	mid.Sub("agent").Reg(apiV1, "/assistant/stream/edit", http.MethodPost, v1.AssistantConversionEdit, "智能体编辑问题并重新回答", middleware.CheckModelQuota)
 }
@@ -4,6 +4,7 @@ import (
 	"net/http"
 
 	v1 "github.com/UnicomAI/wanwu/internal/bff-service/server/http/handler/v1"
+	"github.com/UnicomAI/wanwu/internal/bff-service/server/http/middleware"
 	mid "github.com/UnicomAI/wanwu/pkg/gin-util/mid-wrap"
 	"github.com/gin-gonic/gin"
 )
@@ -12,7 +13,7 @@ func registerCommon(apiV1 *gin.RouterGroup) {
 	mid.Sub("common").Reg(apiV1, "/user/permission", http.MethodGet, v1.GetUserPermission, "获取用户权限")
 	mid.Sub("common").Reg(apiV1, "/user/info", http.MethodGet, v1.GetUserInfo, "获取用户信息")
 	mid.Sub("common").Reg(apiV1, "/org/select", http.MethodGet, v1.GetOrgSelect, "获取用户组织列表")
-	mid.Sub("common").Reg(apiV1, "/user/password", http.MethodPut, v1.ChangeUserPassword, "修改用户密码（by 个人）")
+	mid.Sub("common").Reg(apiV1, "/user/password", http.MethodPut, v1.ChangeUserPassword, "修改用户密码（by 个人）", middleware.Audit("userId"))
 	mid.Sub("common").Reg(apiV1, "/avatar", http.MethodPost, v1.UploadAvatar, "上传自定义图标")
 	mid.Sub("common").Reg(apiV1, "/user/avatar", http.MethodPut, v1.UpdateUserAvatar, "编辑用户头像")
 
@@ -47,13 +48,14 @@ func registerCommon(apiV1 *gin.RouterGroup) {
 	mid.Sub("common").Reg(apiV1, "/knowledge/select", http.MethodPost, v1.GetKnowledgeSelect, "查询用户知识库列表")
 
 	// rag/agent/workflow通用
-	mid.Sub("common").Reg(apiV1, "/appspace/app", http.MethodDelete, v1.DeleteAppSapceApp, "刪除应用")
+	mid.Sub("common").Reg(apiV1, "/appspace/app", http.MethodDelete, v1.DeleteAppSapceApp, "刪除应用", middleware.Audit("appId"))
 	mid.Sub("common").Reg(apiV1, "/appspace/app/list", http.MethodGet, v1.GetAppSpaceAppList, "获取应用列表")
-	mid.Sub("common").Reg(apiV1, "/appspace/app/publish", http.MethodPost, v1.PublishApp, "发布应用")
-	mid.Sub("common").Reg(apiV1, "/appspace/app/publish", http.MethodDelete, v1.UnPublishApp, "取消发布应用")
+	mid.Sub("common").Reg(apiV1, "/appspace/app/publish", http.MethodPost, v1.PublishApp, "发布应用", middleware.Audit("appId"))
+	mid.Sub("common").Reg(apiV1, "/appspace/app/publish", http.MethodDelete, v1.UnPublishApp, "取消发布应用", middleware.Audit("appId"))
 	mid.Sub("common").Reg(apiV1, "/appspace/app/url", http.MethodGet, v1.GetApiBaseUrl, "获取Api根地址")
-	mid.Sub("common").Reg(apiV1, "/appspace/app/key", http.MethodPost, v1.GenApiKey, "生成ApiKey")
-	mid.Sub("common").Reg(apiV1, "/appspace/app/key", http.MethodDelete, v1.DelApiKey, "删除ApiKey")
+	mid.Sub("common").Reg(apiV1, "/appspace/app/key", http.MethodPost, v1.GenApiKey, "生成ApiKey", middleware.Audit("appId"))
+	mid.Sub("common").Reg(apiV1, "/appspace/app/key", http.MethodDelete, v1.DelApiKey, "删除ApiKey", middleware.Audit("apiId"))
+	mid.Sub("common").Reg(apiV1, "/appspace/app/key/rotate", http.MethodPost, v1.RotateApiKey, "轮换ApiKey", middleware.Audit("apiId"))
 	mid.Sub("common").Reg(apiV1, "/appspace/app/key/list", http.MethodGet, v1.GetApiKeyList, "获取ApiKey列表")
 
 	// MCP通用
@@ -68,4 +70,13 @@ func registerCommon(apiV1 *gin.RouterGroup) {
 	mid.Sub("common").Reg(apiV1, "/appspace/app/openurl", http.MethodPut, v1.AppUrlUpdate, "编辑应用Url")
 	mid.Sub("common").Reg(apiV1, "/appspace/app/openurl/list", http.MethodGet, v1.GetAppUrlList, "获取应用Url列表")
 	mid.Sub("common").Reg(apiV1, "/appspace/app/openurl/status", http.MethodPut, v1.AppUrlStatusSwitch, "启用/停用应用Url")
+
+	// 应用回答质量评测
+	mid.Sub("common").Reg(apiV1, "/appspace/app/eval/dataset", http.MethodPost, v1.CreateAppEvalDataset, "创建评测问题集")
+	mid.Sub("common").Reg(apiV1, "/appspace/app/eval/dataset", http.MethodDelete, v1.DeleteAppEvalDataset, "删除评测问题集")
+	mid.Sub("common").Reg(apiV1, "/appspace/app/eval/dataset", http.MethodGet, v1.GetAppEvalDataset, "获取评测问题集详情")
+	mid.Sub("common").Reg(apiV1, "/appspace/app/eval/dataset/list", http.MethodGet, v1.GetAppEvalDatasetList, "获取评测问题集列表")
+	mid.Sub("common").Reg(apiV1, "/appspace/app/eval/job", http.MethodPost, v1.CreateAppEvalJob, "创建评测任务")
+	mid.Sub("common").Reg(apiV1, "/appspace/app/eval/job", http.MethodGet, v1.GetAppEvalJob, "获取评测任务详情")
+	mid.Sub("common").Reg(apiV1, "/appspace/app/eval/job/list", http.MethodGet, v1.GetAppEvalJobList, "获取评测任务列表")
 }
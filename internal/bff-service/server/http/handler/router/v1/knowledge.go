@@ -4,6 +4,7 @@ import (
 	"net/http"
 
 	v1 "github.com/UnicomAI/wanwu/internal/bff-service/server/http/handler/v1"
+	"github.com/UnicomAI/wanwu/internal/bff-service/server/http/middleware"
 	mid "github.com/UnicomAI/wanwu/pkg/gin-util/mid-wrap"
 	"github.com/gin-gonic/gin"
 )
@@ -12,19 +13,59 @@ func registerKnowledge(apiV1 *gin.RouterGroup) {
 	// 知识库增删改查
 	mid.Sub("knowledge").Reg(apiV1, "/knowledge", http.MethodPost, v1.CreateKnowledge, "创建知识库（文档分类）")
 	mid.Sub("knowledge").Reg(apiV1, "/knowledge", http.MethodPut, v1.UpdateKnowledge, "修改知识库（文档分类）")
-	mid.Sub("knowledge").Reg(apiV1, "/knowledge", http.MethodDelete, v1.DeleteKnowledge, "删除知识库（文档分类）")
+	mid.Sub("knowledge").Reg(apiV1, "/knowledge", http.MethodDelete, v1.DeleteKnowledge, "删除知识库（文档分类）", middleware.Audit("knowledgeId"))
+
+	// 知识库分享
+	mid.Sub("knowledge").Reg(apiV1, "/knowledge/permission", http.MethodPost, v1.GrantKnowledgePermission, "分享知识库")
+	mid.Sub("knowledge").Reg(apiV1, "/knowledge/permission", http.MethodDelete, v1.RevokeKnowledgePermission, "取消分享知识库")
+	mid.Sub("knowledge").Reg(apiV1, "/knowledge/permission/list", http.MethodGet, v1.GetKnowledgePermissionList, "查询知识库分享列表")
+
+	// 知识库导入导出
+	mid.Sub("knowledge").Reg(apiV1, "/knowledge/export", http.MethodPost, v1.ExportKnowledge, "导出知识库", middleware.Audit("knowledgeId"))
+	mid.Sub("knowledge").Reg(apiV1, "/knowledge/export/download", http.MethodGet, v1.DownloadKnowledgeExport, "下载知识库导出文件", middleware.Audit("taskId"))
+	mid.Sub("knowledge").Reg(apiV1, "/knowledge/import", http.MethodPost, v1.ImportKnowledge, "导入知识库")
+	mid.Sub("knowledge").Reg(apiV1, "/knowledge/bundle/task", http.MethodGet, v1.GetKnowledgeBundleTask, "查询知识库导入导出任务")
 
 	// 知识库命中测试
 	mid.Sub("knowledge").Reg(apiV1, "/knowledge/hit", http.MethodPost, v1.KnowledgeHit, "知识库命中测试")
 
+	// 知识库检索评测
+	mid.Sub("knowledge").Reg(apiV1, "/knowledge/eval/dataset", http.MethodPost, v1.CreateKnowledgeEvalDataset, "创建检索评测数据集")
+	mid.Sub("knowledge").Reg(apiV1, "/knowledge/eval/dataset", http.MethodDelete, v1.DeleteKnowledgeEvalDataset, "删除检索评测数据集")
+	mid.Sub("knowledge").Reg(apiV1, "/knowledge/eval/dataset", http.MethodGet, v1.GetKnowledgeEvalDataset, "查询检索评测数据集详情")
+	mid.Sub("knowledge").Reg(apiV1, "/knowledge/eval/dataset/list", http.MethodGet, v1.GetKnowledgeEvalDatasetList, "查询检索评测数据集列表")
+	mid.Sub("knowledge").Reg(apiV1, "/knowledge/eval/run", http.MethodPost, v1.CreateKnowledgeEvalRun, "运行检索评测")
+	mid.Sub("knowledge").Reg(apiV1, "/knowledge/eval/run", http.MethodGet, v1.GetKnowledgeEvalRun, "查询检索评测详情")
+	mid.Sub("knowledge").Reg(apiV1, "/knowledge/eval/run/list", http.MethodGet, v1.GetKnowledgeEvalRunList, "查询检索评测列表")
+	mid.Sub("knowledge").Reg(apiV1, "/knowledge/eval/run/compare", http.MethodGet, v1.CompareKnowledgeEvalRun, "对比检索评测")
+
 	// 知识库文档
 	mid.Sub("knowledge").Reg(apiV1, "/knowledge/doc/list", http.MethodGet, v1.GetDocList, "获取文档列表")
 	mid.Sub("knowledge").Reg(apiV1, "/knowledge/doc/import", http.MethodPost, v1.ImportDoc, "上传文档")
 	mid.Sub("knowledge").Reg(apiV1, "/knowledge/doc/import/tip", http.MethodGet, v1.GetDocImportTip, "获取知识库文档上传状态")
-	mid.Sub("knowledge").Reg(apiV1, "/knowledge/doc", http.MethodDelete, v1.DeleteDoc, "删除文档")
+	mid.Sub("knowledge").Reg(apiV1, "/knowledge/doc", http.MethodDelete, v1.DeleteDoc, "删除文档", middleware.Audit("docIdList"))
 	mid.Sub("knowledge").Reg(apiV1, "/knowledge/doc/meta", http.MethodPost, v1.UpdateDocMetaData, "更新文档元数据")
 	mid.Sub("knowledge").Reg(apiV1, "/knowledge/doc/meta/batch", http.MethodPost, v1.BatchUpdateDocMetaData, "批量更新文档元数据")
 
+	// url文档定时同步
+	mid.Sub("knowledge").Reg(apiV1, "/knowledge/doc/sync", http.MethodPost, v1.SaveDocSync, "设置url文档定时同步")
+	mid.Sub("knowledge").Reg(apiV1, "/knowledge/doc/sync", http.MethodDelete, v1.DeleteDocSync, "删除url文档定时同步")
+	mid.Sub("knowledge").Reg(apiV1, "/knowledge/doc/sync/run", http.MethodPost, v1.RunDocSync, "立即执行url文档同步")
+	mid.Sub("knowledge").Reg(apiV1, "/knowledge/doc/sync/list", http.MethodGet, v1.GetDocSyncList, "查询url文档同步列表")
+	mid.Sub("knowledge").Reg(apiV1, "/knowledge/doc/sync/history", http.MethodGet, v1.GetDocSyncHistoryList, "查询url文档同步记录")
+
+	// 文档版本
+	mid.Sub("knowledge").Reg(apiV1, "/knowledge/doc/version", http.MethodPost, v1.UploadDocVersion, "上传文档新版本")
+	mid.Sub("knowledge").Reg(apiV1, "/knowledge/doc/version/list", http.MethodGet, v1.GetDocVersionList, "查询文档版本列表")
+	mid.Sub("knowledge").Reg(apiV1, "/knowledge/doc/version/diff", http.MethodGet, v1.DiffDocVersion, "对比文档版本")
+	mid.Sub("knowledge").Reg(apiV1, "/knowledge/doc/version/rollback", http.MethodPost, v1.RollbackDocVersion, "回滚文档版本")
+
+	// 知识库连接器
+	mid.Sub("knowledge").Reg(apiV1, "/knowledge/connector", http.MethodPost, v1.SaveConnector, "保存知识库连接器")
+	mid.Sub("knowledge").Reg(apiV1, "/knowledge/connector", http.MethodDelete, v1.DeleteConnector, "删除知识库连接器")
+	mid.Sub("knowledge").Reg(apiV1, "/knowledge/connector/run", http.MethodPost, v1.RunConnector, "执行知识库连接器")
+	mid.Sub("knowledge").Reg(apiV1, "/knowledge/connector/list", http.MethodGet, v1.GetConnectorList, "查询知识库连接器列表")
+
 	// 知识库元数据
 	mid.Sub("knowledge").Reg(apiV1, "/knowledge/meta/select", http.MethodGet, v1.GetKnowledgeMetaKeySelect, "获取知识库元数据key列表")
 	mid.Sub("knowledge").Reg(apiV1, "/knowledge/meta/value/list", http.MethodPost, v1.GetKnowledgeMetaValueList, "获取知识库元数据值列表")
@@ -36,7 +77,9 @@ func registerKnowledge(apiV1 *gin.RouterGroup) {
 	mid.Sub("knowledge").Reg(apiV1, "/knowledge/doc/segment/labels", http.MethodPost, v1.UpdateDocSegmentLabels, "更新文档切片标签")
 	mid.Sub("knowledge").Reg(apiV1, "/knowledge/doc/segment/create", http.MethodPost, v1.CreateDocSegment, "新增文档切片")
 	mid.Sub("knowledge").Reg(apiV1, "/knowledge/doc/segment/batch/create", http.MethodPost, v1.BatchCreateDocSegment, "批量新增文档切片")
-	mid.Sub("knowledge").Reg(apiV1, "/knowledge/doc/segment/delete", http.MethodDelete, v1.DeleteDocSegment, "删除文档切片")
+	mid.Sub("knowledge").Reg(apiV1, "/knowledge/doc/segment/export", http.MethodGet, v1.ExportDocSegment, "导出文档切片", middleware.Audit("docId", "knowledgeId"))
+	mid.Sub("knowledge").Reg(apiV1, "/knowledge/doc/segment/import/report", http.MethodGet, v1.GetDocSegmentImportReport, "查询文档切片导入结果")
+	mid.Sub("knowledge").Reg(apiV1, "/knowledge/doc/segment/delete", http.MethodDelete, v1.DeleteDocSegment, "删除文档切片", middleware.Audit("contentId"))
 	mid.Sub("knowledge").Reg(apiV1, "/knowledge/doc/segment/update", http.MethodPost, v1.UpdateDocSegment, "更新文档切片")
 	mid.Sub("knowledge").Reg(apiV1, "/knowledge/doc/segment/child/list", http.MethodGet, v1.GetDocChildSegmentList, "获取子分段列表")
 	mid.Sub("knowledge").Reg(apiV1, "/knowledge/doc/segment/child/create", http.MethodPost, v1.CreateDocChildSegment, "创建子分段")
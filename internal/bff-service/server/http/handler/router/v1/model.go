@@ -4,15 +4,21 @@ import (
 	"net/http"
 
 	v1 "github.com/UnicomAI/wanwu/internal/bff-service/server/http/handler/v1"
+	"github.com/UnicomAI/wanwu/internal/bff-service/server/http/middleware"
 	mid "github.com/UnicomAI/wanwu/pkg/gin-util/mid-wrap"
 	"github.com/gin-gonic/gin"
 )
 
 func registerModel(apiV1 *gin.RouterGroup) {
-	mid.Sub("model").Reg(apiV1, "/model", http.MethodPost, v1.ImportModel, "模型导入")
-	mid.Sub("model").Reg(apiV1, "/model", http.MethodPut, v1.UpdateModel, "导入模型更新")
-	mid.Sub("model").Reg(apiV1, "/model", http.MethodDelete, v1.DeleteModel, "导入模型删除")
+	mid.Sub("model").Reg(apiV1, "/model", http.MethodPost, v1.ImportModel, "模型导入", middleware.Audit("modelId", "model"))
+	mid.Sub("model").Reg(apiV1, "/model", http.MethodPut, v1.UpdateModel, "导入模型更新", middleware.Audit("modelId"))
+	mid.Sub("model").Reg(apiV1, "/model", http.MethodDelete, v1.DeleteModel, "导入模型删除", middleware.Audit("modelId"))
 	mid.Sub("model").Reg(apiV1, "/model", http.MethodGet, v1.GetModel, "查询单个模型")
 	mid.Sub("model").Reg(apiV1, "/model/list", http.MethodGet, v1.ListModels, "导入模型列表展示")
-	mid.Sub("model").Reg(apiV1, "/model/status", http.MethodPut, v1.ChangeModelStatus, "模型启用/关闭")
+	mid.Sub("model").Reg(apiV1, "/model/status", http.MethodPut, v1.ChangeModelStatus, "模型启用/关闭", middleware.Audit("modelId"))
+	mid.Sub("model").Reg(apiV1, "/model/cache/stats", http.MethodGet, v1.GetModelCacheStat, "模型缓存命中统计")
+	mid.Sub("model").Reg(apiV1, "/model/usage/stats", http.MethodGet, v1.GetModelUsageStats, "模型用量统计")
+	mid.Sub("model").Reg(apiV1, "/model/quota/list", http.MethodGet, v1.ListModelQuotas, "模型配额列表")
+	mid.Sub("model").Reg(apiV1, "/model/quota", http.MethodPut, v1.SetModelQuota, "设置模型配额")
+	mid.Sub("model").Reg(apiV1, "/model/quota", http.MethodDelete, v1.DeleteModelQuota, "删除模型配额")
 }
@@ -4,34 +4,45 @@ import (
 	"net/http"
 
 	v1 "github.com/UnicomAI/wanwu/internal/bff-service/server/http/handler/v1"
+	"github.com/UnicomAI/wanwu/internal/bff-service/server/http/middleware"
 	mid "github.com/UnicomAI/wanwu/pkg/gin-util/mid-wrap"
 	"github.com/gin-gonic/gin"
 )
 
 func registerPermission(apiV1 *gin.RouterGroup) {
 	// permission.user
-	mid.Sub("permission.user").Reg(apiV1, "/user", http.MethodPost, v1.CreateUser, "创建用户")
-	mid.Sub("permission.user").Reg(apiV1, "/user", http.MethodPut, v1.ChangeUser, "编辑用户")
-	mid.Sub("permission.user").Reg(apiV1, "/user", http.MethodDelete, v1.DeleteUser, "删除用户")
+	mid.Sub("permission.user").Reg(apiV1, "/user", http.MethodPost, v1.CreateUser, "创建用户", middleware.Audit("userId"))
+	mid.Sub("permission.user").Reg(apiV1, "/user", http.MethodPut, v1.ChangeUser, "编辑用户", middleware.Audit("userId"))
+	mid.Sub("permission.user").Reg(apiV1, "/user", http.MethodDelete, v1.DeleteUser, "删除用户", middleware.Audit("userId"))
 	mid.Sub("permission.user").Reg(apiV1, "/user/list", http.MethodGet, v1.GetUserList, "获取用户列表")
-	mid.Sub("permission.user").Reg(apiV1, "/user/status", http.MethodPut, v1.ChangeUserStatus, "修改用户状态")
-	mid.Sub("permission.user").Reg(apiV1, "/user/admin/password", http.MethodPut, v1.AdminChangeUserPassword, "重置用户密码（by 管理员）")
+	mid.Sub("permission.user").Reg(apiV1, "/user/status", http.MethodPut, v1.ChangeUserStatus, "修改用户状态", middleware.Audit("userId"))
+	mid.Sub("permission.user").Reg(apiV1, "/user/admin/password", http.MethodPut, v1.AdminChangeUserPassword, "重置用户密码（by 管理员）", middleware.Audit("userId"))
 	mid.Sub("permission.user").Reg(apiV1, "/org/other/select", http.MethodGet, v1.GetOrgUserNotSelect, "获取不在组织中的用户列表（用于下拉选择）")
 	mid.Sub("permission.user").Reg(apiV1, "/role/select", http.MethodGet, v1.GetRoleSelect, "获取组织角色列表（用于下拉选择）")
-	mid.Sub("permission.user").Reg(apiV1, "/org/user", http.MethodPost, v1.AddOrgUser, "邀请用户加入组织")
+	mid.Sub("permission.user").Reg(apiV1, "/org/user", http.MethodPost, v1.AddOrgUser, "邀请用户加入组织", middleware.Audit("userId"))
 	// permission.org
-	mid.Sub("permission.org").Reg(apiV1, "/org", http.MethodPost, v1.CreateOrg, "创建下级组织")
-	mid.Sub("permission.org").Reg(apiV1, "/org", http.MethodPut, v1.ChangeOrg, "编辑下级组织")
-	mid.Sub("permission.org").Reg(apiV1, "/org", http.MethodDelete, v1.DeleteOrg, "删除下级组织")
+	mid.Sub("permission.org").Reg(apiV1, "/org", http.MethodPost, v1.CreateOrg, "创建下级组织", middleware.Audit("orgId"))
+	mid.Sub("permission.org").Reg(apiV1, "/org", http.MethodPut, v1.ChangeOrg, "编辑下级组织", middleware.Audit("orgId"))
+	mid.Sub("permission.org").Reg(apiV1, "/org", http.MethodDelete, v1.DeleteOrg, "删除下级组织", middleware.Audit("orgId"))
 	mid.Sub("permission.org").Reg(apiV1, "/org/info", http.MethodGet, v1.GetOrgInfo, "获取组织信息")
 	mid.Sub("permission.org").Reg(apiV1, "/org/list", http.MethodGet, v1.GetOrgList, "获取下级组织列表")
-	mid.Sub("permission.org").Reg(apiV1, "/org/status", http.MethodPut, v1.ChangeOrgStatus, "修改下级组织状态")
+	mid.Sub("permission.org").Reg(apiV1, "/org/status", http.MethodPut, v1.ChangeOrgStatus, "修改下级组织状态", middleware.Audit("orgId"))
 	// permission.role
 	mid.Sub("permission.role").Reg(apiV1, "/role/template", http.MethodGet, v1.GetRoleTemplate, "获取角色模板（用于创建角色）")
-	mid.Sub("permission.role").Reg(apiV1, "/role", http.MethodPost, v1.CreateRole, "创建角色")
-	mid.Sub("permission.role").Reg(apiV1, "/role", http.MethodPut, v1.ChangeRole, "编辑角色")
-	mid.Sub("permission.role").Reg(apiV1, "/role", http.MethodDelete, v1.DeleteRole, "删除角色")
+	mid.Sub("permission.role").Reg(apiV1, "/role", http.MethodPost, v1.CreateRole, "创建角色", middleware.Audit("roleId"))
+	mid.Sub("permission.role").Reg(apiV1, "/role", http.MethodPut, v1.ChangeRole, "编辑角色", middleware.Audit("roleId"))
+	mid.Sub("permission.role").Reg(apiV1, "/role", http.MethodDelete, v1.DeleteRole, "删除角色", middleware.Audit("roleId"))
 	mid.Sub("permission.role").Reg(apiV1, "/role/info", http.MethodGet, v1.GetRoleInfo, "获取角色信息")
 	mid.Sub("permission.role").Reg(apiV1, "/role/list", http.MethodGet, v1.GetRoleList, "获取角色列表")
-	mid.Sub("permission.role").Reg(apiV1, "/role/status", http.MethodPut, v1.ChangeRoleStatus, "修改角色状态")
+	mid.Sub("permission.role").Reg(apiV1, "/role/status", http.MethodPut, v1.ChangeRoleStatus, "修改角色状态", middleware.Audit("roleId"))
+	// permission 审计日志
+	mid.Sub("permission").Reg(apiV1, "/audit/log/list", http.MethodGet, v1.GetAuditLogList, "获取审计日志列表")
+	mid.Sub("permission").Reg(apiV1, "/audit/log/export", http.MethodGet, v1.ExportAuditLog, "导出审计日志")
+	// permission webhook
+	mid.Sub("permission").Reg(apiV1, "/webhook", http.MethodPost, v1.CreateWebhook, "创建webhook", middleware.Audit("webhookId"))
+	mid.Sub("permission").Reg(apiV1, "/webhook", http.MethodPut, v1.UpdateWebhook, "编辑webhook", middleware.Audit("webhookId"))
+	mid.Sub("permission").Reg(apiV1, "/webhook", http.MethodDelete, v1.DeleteWebhook, "删除webhook", middleware.Audit("webhookId"))
+	mid.Sub("permission").Reg(apiV1, "/webhook/list", http.MethodGet, v1.GetWebhookList, "获取webhook列表")
+	mid.Sub("permission").Reg(apiV1, "/webhook/delivery/list", http.MethodGet, v1.GetWebhookDeliveryList, "获取webhook投递记录")
+	mid.Sub("permission").Reg(apiV1, "/webhook/delivery/retry", http.MethodPost, v1.RetryWebhookDelivery, "重试webhook投递", middleware.Audit("deliveryId"))
 }
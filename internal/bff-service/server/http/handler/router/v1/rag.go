@@ -17,5 +17,7 @@ func registerRag(apiV1 *gin.RouterGroup) {
 	mid.Sub("rag").Reg(apiV1, "/appspace/rag", http.MethodDelete, v1.DeleteRag, "删除rag")
 	mid.Sub("rag").Reg(apiV1, "/appspace/rag", http.MethodGet, v1.GetRag, "获取rag详情")
 
-	mid.Sub("rag").Reg(apiV1, "/rag/chat", http.MethodPost, v1.ChatRag, "rag流式接口", middleware.AppHistoryRecord("ragId", constant.AppTypeRag))
+	mid.Sub("rag").Reg(apiV1, "/rag/chat", http.MethodPost, v1.ChatRag, "rag流式接口", middleware.CheckModelQuota, middleware.AppHistoryRecord("ragId", constant.AppTypeRag))
+	mid.Sub("rag").Reg(apiV1, "/rag/chat/feedback", http.MethodPost, v1.RagFeedbackSubmit, "评价rag回答")
+	mid.Sub("rag").Reg(apiV1, "/appspace/rag/feedback/list", http.MethodGet, v1.GetRagFeedbackList, "rag回答评价列表")
 }
@@ -4,6 +4,7 @@ import (
 	"net/http"
 
 	v1 "github.com/UnicomAI/wanwu/internal/bff-service/server/http/handler/v1"
+	"github.com/UnicomAI/wanwu/internal/bff-service/server/http/middleware"
 	mid "github.com/UnicomAI/wanwu/pkg/gin-util/mid-wrap"
 	"github.com/gin-gonic/gin"
 )
@@ -19,4 +20,6 @@ func registerSafety(apiV1 *gin.RouterGroup) {
 	mid.Sub("safety").Reg(apiV1, "/safe/sensitive/word/list", http.MethodGet, v1.GetSensitiveVocabularyList, "获取词表数据列表")
 	mid.Sub("safety").Reg(apiV1, "/safe/sensitive/word", http.MethodPost, v1.UploadSensitiveVocabulary, "上传敏感词")
 	mid.Sub("safety").Reg(apiV1, "/safe/sensitive/word", http.MethodDelete, v1.DeleteSensitiveVocabulary, "删除敏感词")
+	mid.Sub("safety").Reg(apiV1, "/safe/guardrail/policy", http.MethodGet, v1.GetGuardrailPolicy, "获取应用护栏策略")
+	mid.Sub("safety").Reg(apiV1, "/safe/guardrail/policy", http.MethodPut, v1.SaveGuardrailPolicy, "保存应用护栏策略", middleware.Audit("appId"))
 }
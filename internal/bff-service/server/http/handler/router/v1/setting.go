@@ -12,4 +12,5 @@ func registerSetting(apiV1 *gin.RouterGroup) {
 	mid.Sub("setting").Reg(apiV1, "/custom/tab", http.MethodPost, v1.UploadCustomTab, "标签页自定义配置")
 	mid.Sub("setting").Reg(apiV1, "/custom/login", http.MethodPost, v1.UploadCustomLogin, "登录页自定义配置")
 	mid.Sub("setting").Reg(apiV1, "/custom/home", http.MethodPost, v1.UploadCustomHome, "平台自定义配置")
+	mid.Sub("setting").Reg(apiV1, "/rate-limit/stats", http.MethodGet, v1.GetRateLimitStats, "限流统计")
 }
@@ -65,6 +65,25 @@ func DelApiKey(ctx *gin.Context) {
 	gin_util.Response(ctx, nil, err)
 }
 
+// RotateApiKey
+//
+//	@Tags			app
+//	@Summary		轮换ApiKey
+//	@Description	生成与原ApiKey配置相同的新ApiKey，原ApiKey在重叠期结束后失效
+//	@Accept			json
+//	@Produce		json
+//	@Param			data	body		request.RotateApiKeyRequest	true	"轮换ApiKey参数"
+//	@Success		200		{object}	response.Response{data=response.ApiResponse}
+//	@Router			/appspace/app/key/rotate [post]
+func RotateApiKey(ctx *gin.Context) {
+	var req request.RotateApiKeyRequest
+	if !gin_util.Bind(ctx, &req) {
+		return
+	}
+	resp, err := service.RotateApiKey(ctx, getUserID(ctx), req)
+	gin_util.Response(ctx, resp, err)
+}
+
 // GetApiKeyList
 //
 //	@Tags			app
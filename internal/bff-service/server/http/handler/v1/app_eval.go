@@ -0,0 +1,143 @@
+package v1
+
+import (
+	"github.com/UnicomAI/wanwu/internal/bff-service/model/request"
+	"github.com/UnicomAI/wanwu/internal/bff-service/service"
+	gin_util "github.com/UnicomAI/wanwu/pkg/gin-util"
+	"github.com/gin-gonic/gin"
+)
+
+// CreateAppEvalDataset
+//
+//	@Tags			app
+//	@Summary		创建评测问题集
+//	@Description	创建应用回答质量评测问题集，包含问题及参考答案
+//	@Security		JWT
+//	@Accept			json
+//	@Produce		json
+//	@Param			data						body		request.AppEvalDatasetCreateRequest	true	"问题集信息"
+//	@Success		200							{object}	response.Response{data=response.AppEvalDatasetInfo}
+//	@Router			/appspace/app/eval/dataset	[post]
+func CreateAppEvalDataset(ctx *gin.Context) {
+	var req request.AppEvalDatasetCreateRequest
+	if !gin_util.Bind(ctx, &req) {
+		return
+	}
+	resp, err := service.CreateAppEvalDataset(ctx, getUserID(ctx), getOrgID(ctx), req)
+	gin_util.Response(ctx, resp, err)
+}
+
+// DeleteAppEvalDataset
+//
+//	@Tags			app
+//	@Summary		删除评测问题集
+//	@Description	删除评测问题集及其评测任务
+//	@Security		JWT
+//	@Accept			json
+//	@Produce		json
+//	@Param			data						body		request.AppEvalDatasetIdRequest	true	"问题集id"
+//	@Success		200							{object}	response.Response
+//	@Router			/appspace/app/eval/dataset	[delete]
+func DeleteAppEvalDataset(ctx *gin.Context) {
+	var req request.AppEvalDatasetIdRequest
+	if !gin_util.Bind(ctx, &req) {
+		return
+	}
+	err := service.DeleteAppEvalDataset(ctx, getUserID(ctx), getOrgID(ctx), req)
+	gin_util.Response(ctx, nil, err)
+}
+
+// GetAppEvalDataset
+//
+//	@Tags			app
+//	@Summary		获取评测问题集详情
+//	@Description	获取评测问题集详情
+//	@Security		JWT
+//	@Accept			json
+//	@Produce		json
+//	@Param			data						query		request.AppEvalDatasetIdRequest	true	"问题集id"
+//	@Success		200							{object}	response.Response{data=response.AppEvalDatasetInfo}
+//	@Router			/appspace/app/eval/dataset	[get]
+func GetAppEvalDataset(ctx *gin.Context) {
+	var req request.AppEvalDatasetIdRequest
+	if !gin_util.BindQuery(ctx, &req) {
+		return
+	}
+	resp, err := service.GetAppEvalDataset(ctx, getUserID(ctx), getOrgID(ctx), req)
+	gin_util.Response(ctx, resp, err)
+}
+
+// GetAppEvalDatasetList
+//
+//	@Tags			app
+//	@Summary		获取评测问题集列表
+//	@Description	获取评测问题集列表
+//	@Security		JWT
+//	@Accept			json
+//	@Produce		json
+//	@Success		200								{object}	response.Response{data=response.ListResult{list=[]response.AppEvalDatasetInfo}}
+//	@Router			/appspace/app/eval/dataset/list	[get]
+func GetAppEvalDatasetList(ctx *gin.Context) {
+	resp, err := service.GetAppEvalDatasetList(ctx, getUserID(ctx), getOrgID(ctx))
+	gin_util.Response(ctx, resp, err)
+}
+
+// CreateAppEvalJob
+//
+//	@Tags			app
+//	@Summary		创建评测任务
+//	@Description	使用问题集回放应用对话，由评分模型对回答的忠实度、相关性、正确性打分，并按基线和最低分判断是否允许发布
+//	@Security		JWT
+//	@Accept			json
+//	@Produce		json
+//	@Param			data					body		request.AppEvalJobCreateRequest	true	"评测任务信息"
+//	@Success		200						{object}	response.Response{data=response.AppEvalJobInfo}
+//	@Router			/appspace/app/eval/job	[post]
+func CreateAppEvalJob(ctx *gin.Context) {
+	var req request.AppEvalJobCreateRequest
+	if !gin_util.Bind(ctx, &req) {
+		return
+	}
+	resp, err := service.CreateAppEvalJob(ctx, getUserID(ctx), getOrgID(ctx), req)
+	gin_util.Response(ctx, resp, err)
+}
+
+// GetAppEvalJob
+//
+//	@Tags			app
+//	@Summary		获取评测任务详情
+//	@Description	获取评测任务详情及每个问题的回答和评分
+//	@Security		JWT
+//	@Accept			json
+//	@Produce		json
+//	@Param			data					query		request.AppEvalJobIdRequest	true	"评测任务id"
+//	@Success		200						{object}	response.Response{data=response.AppEvalJobDetail}
+//	@Router			/appspace/app/eval/job	[get]
+func GetAppEvalJob(ctx *gin.Context) {
+	var req request.AppEvalJobIdRequest
+	if !gin_util.BindQuery(ctx, &req) {
+		return
+	}
+	resp, err := service.GetAppEvalJob(ctx, getUserID(ctx), getOrgID(ctx), req)
+	gin_util.Response(ctx, resp, err)
+}
+
+// GetAppEvalJobList
+//
+//	@Tags			app
+//	@Summary		获取评测任务列表
+//	@Description	获取评测任务列表，可按问题集或应用过滤
+//	@Security		JWT
+//	@Accept			json
+//	@Produce		json
+//	@Param			data						query		request.AppEvalJobListRequest	true	"过滤条件"
+//	@Success		200							{object}	response.Response{data=response.ListResult{list=[]response.AppEvalJobInfo}}
+//	@Router			/appspace/app/eval/job/list				[get]
+func GetAppEvalJobList(ctx *gin.Context) {
+	var req request.AppEvalJobListRequest
+	if !gin_util.BindQuery(ctx, &req) {
+		return
+	}
+	resp, err := service.GetAppEvalJobList(ctx, getUserID(ctx), getOrgID(ctx), req)
+	gin_util.Response(ctx, resp, err)
+}
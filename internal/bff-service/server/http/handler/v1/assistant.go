@@ -389,3 +389,185 @@ func AssistantConversionStream(ctx *gin.Context) {
 		gin_util.Response(ctx, nil, err)
 	}
 }
+
+// AssistantConversionRegenerate
+//
+//	@Tags			agent
+//	@Summary		智能体重新生成回答
+//	@Description	使用原问题重新生成某轮回答，新回答作为该轮对话的新版本并切换为当前分支
+//	@Security		JWT
+//	@Accept			json
+//	@Produce		json
+//	@Param			data	body		request.ConversationRegenerateRequest	true	"智能体重新生成回答参数"
+//	@Success		200		{object}	response.Response
+//	@Router			/assistant/stream/regenerate [post]
+func AssistantConversionRegenerate(ctx *gin.Context) {
+	userId, orgId := getUserID(ctx), getOrgID(ctx)
+	var req request.ConversationRegenerateRequest
+	if !gin_util.Bind(ctx, &req) {
+		return
+	}
+	if err := service.AssistantConversionRegenerate(ctx, userId, orgId, req); err != nil {
+		gin_util.Response(ctx, nil, err)
+	}
+}
+
+// AssistantConversionEdit
+//
+//	@Tags			agent
+//	@Summary		智能体编辑问题并重新回答
+//	@Description	编辑某轮问题并从该轮对话处分叉出新分支
+//	@Security		JWT
+//	@Accept			json
+//	@Produce		json
+//	@Param			data	body		request.ConversationEditRequest	true	"智能体编辑问题参数"
+//	@Success		200		{object}	response.Response
+//	@Router			/assistant/stream/edit [post]
+func AssistantConversionEdit(ctx *gin.Context) {
+	userId, orgId := getUserID(ctx), getOrgID(ctx)
+	var req request.ConversationEditRequest
+	if !gin_util.Bind(ctx, &req) {
+		return
+	}
+	if err := service.AssistantConversionEdit(ctx, userId, orgId, req); err != nil {
+		gin_util.Response(ctx, nil, err)
+	}
+}
+
+// GetConversationDetailSiblings
+//
+//	@Tags			agent
+//	@Summary		智能体对话版本列表
+//	@Description	获取某轮对话的所有版本（重新生成的回答与编辑后的问题）
+//	@Security		JWT
+//	@Accept			json
+//	@Produce		json
+//	@Param			conversationId	query		string	true	"智能体对话id"
+//	@Param			detailId		query		string	true	"对话详情id"
+//	@Success		200				{object}	response.Response{data=response.ConversationDetailSiblings}
+//	@Router			/assistant/conversation/detail/siblings [get]
+func GetConversationDetailSiblings(ctx *gin.Context) {
+	userId, orgId := getUserID(ctx), getOrgID(ctx)
+	var req request.ConversationDetailRequest
+	if !gin_util.BindQuery(ctx, &req) {
+		return
+	}
+	resp, err := service.GetConversationDetailSiblings(ctx, userId, orgId, req)
+	gin_util.Response(ctx, resp, err)
+}
+
+// ConversationBranchSelect
+//
+//	@Tags			agent
+//	@Summary		切换智能体对话分支
+//	@Description	将所选版本所在分支设为对话当前分支，后续问答与历史列表沿该分支进行
+//	@Security		JWT
+//	@Accept			json
+//	@Produce		json
+//	@Param			data	body		request.ConversationDetailRequest	true	"对话id与所选版本的对话详情id"
+//	@Success		200		{object}	response.Response
+//	@Router			/assistant/conversation/branch [put]
+func ConversationBranchSelect(ctx *gin.Context) {
+	userId, orgId := getUserID(ctx), getOrgID(ctx)
+	var req request.ConversationDetailRequest
+	if !gin_util.Bind(ctx, &req) {
+		return
+	}
+	err := service.ConversationBranchSelect(ctx, userId, orgId, req)
+	gin_util.Response(ctx, nil, err)
+}
+
+// ConversationFeedbackSubmit
+//
+//	@Tags			agent
+//	@Summary		评价智能体回答
+//	@Description	点赞/点踩某轮回答并填写原因，rating为0时取消评价
+//	@Security		JWT
+//	@Accept			json
+//	@Produce		json
+//	@Param			data	body		request.ConversationFeedbackRequest	true	"回答评价参数"
+//	@Success		200		{object}	response.Response
+//	@Router			/assistant/conversation/feedback [post]
+func ConversationFeedbackSubmit(ctx *gin.Context) {
+	userId, orgId := getUserID(ctx), getOrgID(ctx)
+	var req request.ConversationFeedbackRequest
+	if !gin_util.Bind(ctx, &req) {
+		return
+	}
+	err := service.ConversationFeedbackSubmit(ctx, userId, orgId, req)
+	gin_util.Response(ctx, nil, err)
+}
+
+// GetConversationFeedbackList
+//
+//	@Tags			agent
+//	@Summary		智能体回答评价列表
+//	@Description	查询智能体收到的回答评价，可按智能体、评价与时间范围过滤；组织管理员可查询组织内所有智能体，其他用户仅可查询自己创建的智能体
+//	@Security		JWT
+//	@Accept			json
+//	@Produce		json
+//	@Param			assistantId	query		string	false	"智能体id，非组织管理员必填"
+//	@Param			rating		query		int		false	"评价（1:点赞 -1:点踩 0:全部）"
+//	@Param			startTime	query		int		false	"开始时间（毫秒）"
+//	@Param			endTime		query		int		false	"结束时间（毫秒）"
+//	@Param			pageNo		query		int		true	"页码"
+//	@Param			pageSize	query		int		true	"每页数量"
+//	@Success		200			{object}	response.Response{data=response.PageResult{list=[]response.ConversationFeedbackInfo}}
+//	@Router			/assistant/feedback/list [get]
+func GetConversationFeedbackList(ctx *gin.Context) {
+	userId, orgId := getUserID(ctx), getOrgID(ctx)
+	var req request.ConversationFeedbackListRequest
+	if !gin_util.BindQuery(ctx, &req) {
+		return
+	}
+	resp, err := service.GetConversationFeedbackList(ctx, userId, orgId, isAdmin(ctx), req)
+	gin_util.Response(ctx, resp, err)
+}
+
+// ConversationExport
+//
+//	@Tags			agent
+//	@Summary		导出智能体对话
+//	@Description	导出单个对话，或智能体下指定时间范围内的所有对话，格式为JSONL或Markdown
+//	@Security		JWT
+//	@Accept			json
+//	@Produce		application/octet-stream
+//	@Param			assistantId		query		string	false	"智能体id，与conversationId二选一"
+//	@Param			conversationId	query		string	false	"智能体对话id"
+//	@Param			startTime		query		int		false	"开始时间（毫秒）"
+//	@Param			endTime			query		int		false	"结束时间（毫秒）"
+//	@Param			format			query		string	false	"导出格式"	Enums(jsonl,markdown)
+//	@Success		200				{object}	response.Response
+//	@Router			/assistant/conversation/export [get]
+func ConversationExport(ctx *gin.Context) {
+	userId, orgId := getUserID(ctx), getOrgID(ctx)
+	var req request.ConversationExportRequest
+	if !gin_util.BindQuery(ctx, &req) {
+		return
+	}
+	if err := service.ConversationExport(ctx, userId, orgId, req); err != nil {
+		gin_util.Response(ctx, nil, err)
+	}
+}
+
+// ConversationImport
+//
+//	@Tags			agent
+//	@Summary		导入智能体对话
+//	@Description	导入对话导出的JSONL文件，文件中每个对话导入为智能体下的一个新对话
+//	@Security		JWT
+//	@Accept			multipart/form-data
+//	@Produce		json
+//	@Param			assistantId	formData	string	true	"智能体id"
+//	@Param			file		formData	file	true	"对话JSONL文件"
+//	@Success		200			{object}	response.Response{data=response.ConversationImportResp}
+//	@Router			/assistant/conversation/import [post]
+func ConversationImport(ctx *gin.Context) {
+	userId, orgId := getUserID(ctx), getOrgID(ctx)
+	var req request.ConversationImportRequest
+	if !gin_util.BindForm(ctx, &req) {
+		return
+	}
+	resp, err := service.ConversationImport(ctx, userId, orgId, req)
+	gin_util.Response(ctx, resp, err)
+}
@@ -0,0 +1,67 @@
+package v1
+
+import (
+	err_code "github.com/UnicomAI/wanwu/api/proto/err-code"
+	"github.com/UnicomAI/wanwu/internal/bff-service/model/request"
+	"github.com/UnicomAI/wanwu/internal/bff-service/service"
+	gin_util "github.com/UnicomAI/wanwu/pkg/gin-util"
+	grpc_util "github.com/UnicomAI/wanwu/pkg/grpc-util"
+	"github.com/gin-gonic/gin"
+)
+
+// GetAuditLogList
+//
+//	@Tags			permission
+//	@Summary		获取审计日志列表
+//	@Description	获取X-Org-Id组织的审计日志列表（仅组织管理员）；在系统视角下获取全部组织的审计日志
+//	@Security		JWT
+//	@Accept			json
+//	@Produce		json
+//	@Param			data	query		request.AuditLogListRequest	true	"查询条件"
+//	@Success		200		{object}	response.Response{data=response.PageResult{list=[]response.AuditLogInfo}}
+//	@Router			/audit/log/list [get]
+func GetAuditLogList(ctx *gin.Context) {
+	var req request.AuditLogListRequest
+	if !gin_util.BindQuery(ctx, &req) {
+		return
+	}
+	if !isAdmin(ctx) {
+		gin_util.Response(ctx, nil, grpc_util.ErrorStatusWithKey(err_code.Code_BFFGeneral, "bff_audit_log_not_admin"))
+		return
+	}
+	resp, err := service.GetAuditLogList(ctx, auditLogOrgID(ctx), &req)
+	gin_util.Response(ctx, resp, err)
+}
+
+// ExportAuditLog
+//
+//	@Tags			permission
+//	@Summary		导出审计日志
+//	@Description	按查询条件导出审计日志为csv文件（仅组织管理员），单次最多导出10000条，超过时返回错误，需缩小时间范围
+//	@Security		JWT
+//	@Accept			json
+//	@Produce		application/octet-stream
+//	@Param			data	query		request.AuditLogExportRequest	true	"查询条件"
+//	@Success		200		{object}	response.Response
+//	@Router			/audit/log/export [get]
+func ExportAuditLog(ctx *gin.Context) {
+	var req request.AuditLogExportRequest
+	if !gin_util.BindQuery(ctx, &req) {
+		return
+	}
+	if !isAdmin(ctx) {
+		gin_util.Response(ctx, nil, grpc_util.ErrorStatusWithKey(err_code.Code_BFFGeneral, "bff_audit_log_not_admin"))
+		return
+	}
+	if err := service.ExportAuditLog(ctx, auditLogOrgID(ctx), &req); err != nil {
+		gin_util.Response(ctx, nil, err)
+	}
+}
+
+// auditLogOrgID 系统视角下不按组织过滤
+func auditLogOrgID(ctx *gin.Context) string {
+	if isSystem(ctx) {
+		return ""
+	}
+	return getOrgID(ctx)
+}
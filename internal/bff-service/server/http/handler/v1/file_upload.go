@@ -58,6 +58,7 @@ func CheckFileList(ctx *gin.Context) {
 //	@Param			fileName	formData	string	true	"原始文件名"
 //	@Param			sequence	formData	int		true	"分片文件序号"
 //	@Param			chunkName	formData	string	true	"上传批次标识"
+//	@Param			chunkTotal	formData	int		false	"分片总数，除最后一片外每片不小于5MB"
 //	@Param			files		formData	file	true	"文件"
 //	@Success		200			{object}	response.Response{data=response.UploadFileResp}
 //	@Router			/file/upload [post]
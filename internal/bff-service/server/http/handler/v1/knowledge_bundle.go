@@ -0,0 +1,93 @@
+package v1
+
+import (
+	"github.com/UnicomAI/wanwu/internal/bff-service/model/request"
+	"github.com/UnicomAI/wanwu/internal/bff-service/service"
+	gin_util "github.com/UnicomAI/wanwu/pkg/gin-util"
+	"github.com/gin-gonic/gin"
+)
+
+// ExportKnowledge
+//
+//	@Tags			knowledge
+//	@Summary		导出知识库
+//	@Description	将知识库配置、文档、元数据、标签、关键词和切片编辑打包导出，需要管理权限；通过任务id查询进度
+//	@Security		JWT
+//	@Accept			json
+//	@Produce		json
+//	@Param			data	body		request.KnowledgeExportReq	true	"导出知识库请求参数"
+//	@Success		200		{object}	response.Response{data=response.KnowledgeBundleTaskResp}
+//	@Router			/knowledge/export [post]
+func ExportKnowledge(ctx *gin.Context) {
+	userId, orgId := getUserID(ctx), getOrgID(ctx)
+	var req request.KnowledgeExportReq
+	if !gin_util.Bind(ctx, &req) {
+		return
+	}
+	resp, err := service.ExportKnowledge(ctx, userId, orgId, &req)
+	gin_util.Response(ctx, resp, err)
+}
+
+// ImportKnowledge
+//
+//	@Tags			knowledge
+//	@Summary		导入知识库
+//	@Description	上传知识库导出文件后导入，在当前用户下新建知识库，文档重新解析后恢复切片编辑；通过任务id查询进度
+//	@Security		JWT
+//	@Accept			json
+//	@Produce		json
+//	@Param			data	body		request.KnowledgeImportReq	true	"导入知识库请求参数"
+//	@Success		200		{object}	response.Response{data=response.KnowledgeBundleTaskResp}
+//	@Router			/knowledge/import [post]
+func ImportKnowledge(ctx *gin.Context) {
+	userId, orgId := getUserID(ctx), getOrgID(ctx)
+	var req request.KnowledgeImportReq
+	if !gin_util.Bind(ctx, &req) {
+		return
+	}
+	resp, err := service.ImportKnowledge(ctx, userId, orgId, &req)
+	gin_util.Response(ctx, resp, err)
+}
+
+// GetKnowledgeBundleTask
+//
+//	@Tags			knowledge
+//	@Summary		查询知识库导入导出任务
+//	@Description	查询知识库导入导出任务的状态和进度
+//	@Security		JWT
+//	@Accept			json
+//	@Produce		json
+//	@Param			taskId	query		string	true	"任务id"
+//	@Success		200		{object}	response.Response{data=response.KnowledgeBundleTaskInfo}
+//	@Router			/knowledge/bundle/task [get]
+func GetKnowledgeBundleTask(ctx *gin.Context) {
+	userId, orgId := getUserID(ctx), getOrgID(ctx)
+	var req request.KnowledgeBundleTaskReq
+	if !gin_util.BindQuery(ctx, &req) {
+		return
+	}
+	resp, err := service.GetKnowledgeBundleTask(ctx, userId, orgId, &req)
+	gin_util.Response(ctx, resp, err)
+}
+
+// DownloadKnowledgeExport
+//
+//	@Tags			knowledge
+//	@Summary		下载知识库导出文件
+//	@Description	下载导出成功的知识库文件，导出文件保留1天
+//	@Security		JWT
+//	@Accept			json
+//	@Produce		application/octet-stream
+//	@Param			taskId	query		string	true	"导出任务id"
+//	@Success		200		{object}	response.Response
+//	@Router			/knowledge/export/download [get]
+func DownloadKnowledgeExport(ctx *gin.Context) {
+	userId, orgId := getUserID(ctx), getOrgID(ctx)
+	var req request.KnowledgeBundleTaskReq
+	if !gin_util.BindQuery(ctx, &req) {
+		return
+	}
+	if err := service.DownloadKnowledgeExport(ctx, userId, orgId, &req); err != nil {
+		gin_util.Response(ctx, nil, err)
+	}
+}
@@ -0,0 +1,92 @@
+package v1
+
+import (
+	"github.com/UnicomAI/wanwu/internal/bff-service/model/request"
+	"github.com/UnicomAI/wanwu/internal/bff-service/service"
+	gin_util "github.com/UnicomAI/wanwu/pkg/gin-util"
+	"github.com/gin-gonic/gin"
+)
+
+// SaveConnector
+//
+//	@Tags			knowledge
+//	@Summary		保存知识库连接器
+//	@Description	创建或更新知识库连接器，支持S3兼容对象存储、git仓库、WebDAV与服务端挂载目录；凭证加密存储
+//	@Security		JWT
+//	@Accept			json
+//	@Produce		json
+//	@Param			data	body		request.ConnectorSaveReq	true	"保存知识库连接器请求参数"
+//	@Success		200		{object}	response.Response{data=response.ConnectorSaveResp}
+//	@Router			/knowledge/connector [post]
+func SaveConnector(ctx *gin.Context) {
+	userId, orgId := getUserID(ctx), getOrgID(ctx)
+	var req request.ConnectorSaveReq
+	if !gin_util.Bind(ctx, &req) {
+		return
+	}
+	resp, err := service.SaveConnector(ctx, userId, orgId, &req)
+	gin_util.Response(ctx, resp, err)
+}
+
+// DeleteConnector
+//
+//	@Tags			knowledge
+//	@Summary		删除知识库连接器
+//	@Description	删除知识库连接器，已导入的文档保留
+//	@Security		JWT
+//	@Accept			json
+//	@Produce		json
+//	@Param			data	body		request.ConnectorIdReq	true	"删除知识库连接器请求参数"
+//	@Success		200		{object}	response.Response
+//	@Router			/knowledge/connector [delete]
+func DeleteConnector(ctx *gin.Context) {
+	userId, orgId := getUserID(ctx), getOrgID(ctx)
+	var req request.ConnectorIdReq
+	if !gin_util.Bind(ctx, &req) {
+		return
+	}
+	err := service.DeleteConnector(ctx, userId, orgId, &req)
+	gin_util.Response(ctx, nil, err)
+}
+
+// RunConnector
+//
+//	@Tags			knowledge
+//	@Summary		执行知识库连接器
+//	@Description	按增量游标导入上次执行后新增或修改的文件，创建文档导入任务
+//	@Security		JWT
+//	@Accept			json
+//	@Produce		json
+//	@Param			data	body		request.ConnectorIdReq	true	"执行知识库连接器请求参数"
+//	@Success		200		{object}	response.Response
+//	@Router			/knowledge/connector/run [post]
+func RunConnector(ctx *gin.Context) {
+	userId, orgId := getUserID(ctx), getOrgID(ctx)
+	var req request.ConnectorIdReq
+	if !gin_util.Bind(ctx, &req) {
+		return
+	}
+	err := service.RunConnector(ctx, userId, orgId, &req)
+	gin_util.Response(ctx, nil, err)
+}
+
+// GetConnectorList
+//
+//	@Tags			knowledge
+//	@Summary		查询知识库连接器列表
+//	@Description	查询知识库连接器列表，凭证不返回
+//	@Security		JWT
+//	@Accept			json
+//	@Produce		json
+//	@Param			knowledgeId	query		string	true	"知识库id"
+//	@Success		200			{object}	response.Response{data=response.ConnectorListResp}
+//	@Router			/knowledge/connector/list [get]
+func GetConnectorList(ctx *gin.Context) {
+	userId, orgId := getUserID(ctx), getOrgID(ctx)
+	var req request.ConnectorListReq
+	if !gin_util.BindQuery(ctx, &req) {
+		return
+	}
+	resp, err := service.GetConnectorList(ctx, userId, orgId, &req)
+	gin_util.Response(ctx, resp, err)
+}
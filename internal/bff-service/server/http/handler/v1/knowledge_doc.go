@@ -242,7 +242,7 @@ func CreateDocSegment(ctx *gin.Context) {
 //
 //	@Tags			knowledge
 //	@Summary		批量新增文档切片
-//	@Description	批量新增文档切片
+//	@Description	通过csv、jsonl或xlsx文件批量新增文档切片，upsert模式下按分段id更新已有切片
 //	@Security		JWT
 //	@Accept			json
 //	@Produce		json
@@ -259,6 +259,51 @@ func BatchCreateDocSegment(ctx *gin.Context) {
 	gin_util.Response(ctx, nil, err)
 }
 
+// ExportDocSegment
+//
+//	@Tags			knowledge
+//	@Summary		导出文档切片
+//	@Description	导出单个文档或知识库下全部处理完成文档的切片，包含内容、关键词、启用状态和子分段
+//	@Security		JWT
+//	@Accept			json
+//	@Produce		application/octet-stream
+//	@Param			knowledgeId	query		string	false	"知识库id，与docId二选一"
+//	@Param			docId		query		string	false	"文档id"
+//	@Param			format		query		string	false	"导出格式"	Enums(csv,jsonl,xlsx)
+//	@Success		200			{object}	response.Response
+//	@Router			/knowledge/doc/segment/export [get]
+func ExportDocSegment(ctx *gin.Context) {
+	userId, orgId := getUserID(ctx), getOrgID(ctx)
+	var req request.DocSegmentExportReq
+	if !gin_util.BindQuery(ctx, &req) {
+		return
+	}
+	if err := service.ExportDocSegment(ctx, userId, orgId, &req); err != nil {
+		gin_util.Response(ctx, nil, err)
+	}
+}
+
+// GetDocSegmentImportReport
+//
+//	@Tags			knowledge
+//	@Summary		查询文档切片导入结果
+//	@Description	查询文档最近一次批量导入切片的结果及失败行
+//	@Security		JWT
+//	@Accept			json
+//	@Produce		json
+//	@Param			docId	query		string	true	"文档id"
+//	@Success		200		{object}	response.Response{data=response.DocSegmentImportReport}
+//	@Router			/knowledge/doc/segment/import/report [get]
+func GetDocSegmentImportReport(ctx *gin.Context) {
+	userId, orgId := getUserID(ctx), getOrgID(ctx)
+	var req request.DocSegmentImportReportReq
+	if !gin_util.BindQuery(ctx, &req) {
+		return
+	}
+	resp, err := service.GetDocSegmentImportReport(ctx, userId, orgId, &req)
+	gin_util.Response(ctx, resp, err)
+}
+
 // DeleteDocSegment
 //
 //	@Tags			knowledge
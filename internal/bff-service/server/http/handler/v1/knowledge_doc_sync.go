@@ -0,0 +1,116 @@
+package v1
+
+import (
+	"github.com/UnicomAI/wanwu/internal/bff-service/model/request"
+	"github.com/UnicomAI/wanwu/internal/bff-service/service"
+	gin_util "github.com/UnicomAI/wanwu/pkg/gin-util"
+	"github.com/gin-gonic/gin"
+)
+
+// SaveDocSync
+//
+//	@Tags			knowledge
+//	@Summary		设置url文档定时同步
+//	@Description	按url文档或url导入任务设置定时同步，cron表达式与同步间隔二选一，周期不小于10分钟；同步时内容变化的文档重新解析导入
+//	@Security		JWT
+//	@Accept			json
+//	@Produce		json
+//	@Param			data	body		request.DocSyncSaveReq	true	"设置url文档定时同步请求参数"
+//	@Success		200		{object}	response.Response{data=response.DocSyncSaveResp}
+//	@Router			/knowledge/doc/sync [post]
+func SaveDocSync(ctx *gin.Context) {
+	userId, orgId := getUserID(ctx), getOrgID(ctx)
+	var req request.DocSyncSaveReq
+	if !gin_util.Bind(ctx, &req) {
+		return
+	}
+	resp, err := service.SaveDocSync(ctx, userId, orgId, &req)
+	gin_util.Response(ctx, resp, err)
+}
+
+// DeleteDocSync
+//
+//	@Tags			knowledge
+//	@Summary		删除url文档定时同步
+//	@Description	删除url文档定时同步及同步记录
+//	@Security		JWT
+//	@Accept			json
+//	@Produce		json
+//	@Param			data	body		request.DocSyncIdReq	true	"删除url文档定时同步请求参数"
+//	@Success		200		{object}	response.Response
+//	@Router			/knowledge/doc/sync [delete]
+func DeleteDocSync(ctx *gin.Context) {
+	userId, orgId := getUserID(ctx), getOrgID(ctx)
+	var req request.DocSyncIdReq
+	if !gin_util.Bind(ctx, &req) {
+		return
+	}
+	err := service.DeleteDocSync(ctx, userId, orgId, &req)
+	gin_util.Response(ctx, nil, err)
+}
+
+// RunDocSync
+//
+//	@Tags			knowledge
+//	@Summary		立即执行url文档同步
+//	@Description	立即执行一次url文档同步，不影响下次定时同步时间
+//	@Security		JWT
+//	@Accept			json
+//	@Produce		json
+//	@Param			data	body		request.DocSyncIdReq	true	"立即执行url文档同步请求参数"
+//	@Success		200		{object}	response.Response
+//	@Router			/knowledge/doc/sync/run [post]
+func RunDocSync(ctx *gin.Context) {
+	userId, orgId := getUserID(ctx), getOrgID(ctx)
+	var req request.DocSyncIdReq
+	if !gin_util.Bind(ctx, &req) {
+		return
+	}
+	err := service.RunDocSync(ctx, userId, orgId, &req)
+	gin_util.Response(ctx, nil, err)
+}
+
+// GetDocSyncList
+//
+//	@Tags			knowledge
+//	@Summary		查询url文档同步列表
+//	@Description	查询知识库的url文档定时同步列表
+//	@Security		JWT
+//	@Accept			json
+//	@Produce		json
+//	@Param			knowledgeId	query		string	true	"知识库id"
+//	@Success		200			{object}	response.Response{data=response.DocSyncListResp}
+//	@Router			/knowledge/doc/sync/list [get]
+func GetDocSyncList(ctx *gin.Context) {
+	userId, orgId := getUserID(ctx), getOrgID(ctx)
+	var req request.DocSyncListReq
+	if !gin_util.BindQuery(ctx, &req) {
+		return
+	}
+	resp, err := service.GetDocSyncList(ctx, userId, orgId, &req)
+	gin_util.Response(ctx, resp, err)
+}
+
+// GetDocSyncHistoryList
+//
+//	@Tags			knowledge
+//	@Summary		查询url文档同步记录
+//	@Description	分页查询url文档同步记录，按同步开始时间倒序
+//	@Security		JWT
+//	@Accept			json
+//	@Produce		json
+//	@Param			syncId		query		string	true	"同步配置id"
+//	@Param			docId		query		string	false	"文档id"
+//	@Param			pageNo		query		int		true	"页码"
+//	@Param			pageSize	query		int		true	"每页数量"
+//	@Success		200			{object}	response.Response{data=response.PageResult{list=[]response.DocSyncHistoryInfo}}
+//	@Router			/knowledge/doc/sync/history [get]
+func GetDocSyncHistoryList(ctx *gin.Context) {
+	userId, orgId := getUserID(ctx), getOrgID(ctx)
+	var req request.DocSyncHistoryListReq
+	if !gin_util.BindQuery(ctx, &req) {
+		return
+	}
+	resp, err := service.GetDocSyncHistoryList(ctx, userId, orgId, &req)
+	gin_util.Response(ctx, resp, err)
+}
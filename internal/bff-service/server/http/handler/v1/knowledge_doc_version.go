@@ -0,0 +1,94 @@
+package v1
+
+import (
+	"github.com/UnicomAI/wanwu/internal/bff-service/model/request"
+	"github.com/UnicomAI/wanwu/internal/bff-service/service"
+	gin_util "github.com/UnicomAI/wanwu/pkg/gin-util"
+	"github.com/gin-gonic/gin"
+)
+
+// UploadDocVersion
+//
+//	@Tags			knowledge
+//	@Summary		上传文档新版本
+//	@Description	归档当前版本的文件和切片后，以新文件沿用原文档id重新解析导入，检索只命中生效版本；url文档不支持
+//	@Security		JWT
+//	@Accept			json
+//	@Produce		json
+//	@Param			data	body		request.DocVersionUploadReq	true	"上传文档新版本请求参数"
+//	@Success		200		{object}	response.Response
+//	@Router			/knowledge/doc/version [post]
+func UploadDocVersion(ctx *gin.Context) {
+	userId, orgId := getUserID(ctx), getOrgID(ctx)
+	var req request.DocVersionUploadReq
+	if !gin_util.Bind(ctx, &req) {
+		return
+	}
+	err := service.UploadDocVersion(ctx, userId, orgId, &req)
+	gin_util.Response(ctx, nil, err)
+}
+
+// GetDocVersionList
+//
+//	@Tags			knowledge
+//	@Summary		查询文档版本列表
+//	@Description	查询文档的当前生效版本和历史版本，上传新版本、编辑切片、回滚都会产生历史版本
+//	@Security		JWT
+//	@Accept			json
+//	@Produce		json
+//	@Param			docId	query		string	true	"文档id"
+//	@Success		200		{object}	response.Response{data=response.DocVersionListResp}
+//	@Router			/knowledge/doc/version/list [get]
+func GetDocVersionList(ctx *gin.Context) {
+	userId, orgId := getUserID(ctx), getOrgID(ctx)
+	var req request.DocVersionListReq
+	if !gin_util.BindQuery(ctx, &req) {
+		return
+	}
+	resp, err := service.GetDocVersionList(ctx, userId, orgId, &req)
+	gin_util.Response(ctx, resp, err)
+}
+
+// DiffDocVersion
+//
+//	@Tags			knowledge
+//	@Summary		对比文档版本
+//	@Description	按切片序号对比两个版本的切片数量和内容，版本id为空表示当前生效版本
+//	@Security		JWT
+//	@Accept			json
+//	@Produce		json
+//	@Param			docId			query		string	true	"文档id"
+//	@Param			baseVersionId	query		string	false	"对比基准版本id"
+//	@Param			targetVersionId	query		string	false	"对比目标版本id"
+//	@Success		200				{object}	response.Response{data=response.DocVersionDiffResp}
+//	@Router			/knowledge/doc/version/diff [get]
+func DiffDocVersion(ctx *gin.Context) {
+	userId, orgId := getUserID(ctx), getOrgID(ctx)
+	var req request.DocVersionDiffReq
+	if !gin_util.BindQuery(ctx, &req) {
+		return
+	}
+	resp, err := service.DiffDocVersion(ctx, userId, orgId, &req)
+	gin_util.Response(ctx, resp, err)
+}
+
+// RollbackDocVersion
+//
+//	@Tags			knowledge
+//	@Summary		回滚文档版本
+//	@Description	归档当前版本后重新解析历史版本文件，解析完成后恢复该版本的切片内容、启用状态和标签
+//	@Security		JWT
+//	@Accept			json
+//	@Produce		json
+//	@Param			data	body		request.DocVersionRollbackReq	true	"回滚文档版本请求参数"
+//	@Success		200		{object}	response.Response
+//	@Router			/knowledge/doc/version/rollback [post]
+func RollbackDocVersion(ctx *gin.Context) {
+	userId, orgId := getUserID(ctx), getOrgID(ctx)
+	var req request.DocVersionRollbackReq
+	if !gin_util.Bind(ctx, &req) {
+		return
+	}
+	err := service.RollbackDocVersion(ctx, userId, orgId, &req)
+	gin_util.Response(ctx, nil, err)
+}
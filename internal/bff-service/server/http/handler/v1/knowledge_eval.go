@@ -0,0 +1,177 @@
+package v1
+
+import (
+	"github.com/UnicomAI/wanwu/internal/bff-service/model/request"
+	"github.com/UnicomAI/wanwu/internal/bff-service/service"
+	gin_util "github.com/UnicomAI/wanwu/pkg/gin-util"
+	"github.com/gin-gonic/gin"
+)
+
+// CreateKnowledgeEvalDataset
+//
+//	@Tags			knowledge
+//	@Summary		创建检索评测数据集
+//	@Description	创建检索评测数据集，每个问题指定期望命中的文档或分段，需要知识库编辑权限
+//	@Security		JWT
+//	@Accept			json
+//	@Produce		json
+//	@Param			data	body		request.CreateKnowledgeEvalDatasetReq	true	"创建检索评测数据集请求参数"
+//	@Success		200		{object}	response.Response{data=response.CreateKnowledgeEvalDatasetResp}
+//	@Router			/knowledge/eval/dataset [post]
+func CreateKnowledgeEvalDataset(ctx *gin.Context) {
+	userId, orgId := getUserID(ctx), getOrgID(ctx)
+	var req request.CreateKnowledgeEvalDatasetReq
+	if !gin_util.Bind(ctx, &req) {
+		return
+	}
+	resp, err := service.CreateKnowledgeEvalDataset(ctx, userId, orgId, &req)
+	gin_util.Response(ctx, resp, err)
+}
+
+// DeleteKnowledgeEvalDataset
+//
+//	@Tags			knowledge
+//	@Summary		删除检索评测数据集
+//	@Description	删除检索评测数据集及其全部评测记录
+//	@Security		JWT
+//	@Accept			json
+//	@Produce		json
+//	@Param			data	body		request.KnowledgeEvalDatasetReq	true	"删除检索评测数据集请求参数"
+//	@Success		200		{object}	response.Response
+//	@Router			/knowledge/eval/dataset [delete]
+func DeleteKnowledgeEvalDataset(ctx *gin.Context) {
+	userId, orgId := getUserID(ctx), getOrgID(ctx)
+	var req request.KnowledgeEvalDatasetReq
+	if !gin_util.Bind(ctx, &req) {
+		return
+	}
+	err := service.DeleteKnowledgeEvalDataset(ctx, userId, orgId, &req)
+	gin_util.Response(ctx, nil, err)
+}
+
+// GetKnowledgeEvalDatasetList
+//
+//	@Tags			knowledge
+//	@Summary		查询检索评测数据集列表
+//	@Description	查询知识库的检索评测数据集列表
+//	@Security		JWT
+//	@Accept			json
+//	@Produce		json
+//	@Param			knowledgeId	query		string	true	"知识库id"
+//	@Success		200			{object}	response.Response{data=response.KnowledgeEvalDatasetListResp}
+//	@Router			/knowledge/eval/dataset/list [get]
+func GetKnowledgeEvalDatasetList(ctx *gin.Context) {
+	userId, orgId := getUserID(ctx), getOrgID(ctx)
+	var req request.KnowledgeEvalDatasetListReq
+	if !gin_util.BindQuery(ctx, &req) {
+		return
+	}
+	resp, err := service.GetKnowledgeEvalDatasetList(ctx, userId, orgId, &req)
+	gin_util.Response(ctx, resp, err)
+}
+
+// GetKnowledgeEvalDataset
+//
+//	@Tags			knowledge
+//	@Summary		查询检索评测数据集详情
+//	@Description	查询检索评测数据集详情，包括全部问题
+//	@Security		JWT
+//	@Accept			json
+//	@Produce		json
+//	@Param			datasetId	query		string	true	"数据集id"
+//	@Success		200			{object}	response.Response{data=response.KnowledgeEvalDatasetInfo}
+//	@Router			/knowledge/eval/dataset [get]
+func GetKnowledgeEvalDataset(ctx *gin.Context) {
+	userId, orgId := getUserID(ctx), getOrgID(ctx)
+	var req request.KnowledgeEvalDatasetReq
+	if !gin_util.BindQuery(ctx, &req) {
+		return
+	}
+	resp, err := service.GetKnowledgeEvalDataset(ctx, userId, orgId, &req)
+	gin_util.Response(ctx, resp, err)
+}
+
+// CreateKnowledgeEvalRun
+//
+//	@Tags			knowledge
+//	@Summary		运行检索评测
+//	@Description	按指定检索配置对数据集的全部问题执行检索，异步计算recall@k、MRR、nDCG@k和命中率，需要知识库编辑权限
+//	@Security		JWT
+//	@Accept			json
+//	@Produce		json
+//	@Param			data	body		request.CreateKnowledgeEvalRunReq	true	"运行检索评测请求参数"
+//	@Success		200		{object}	response.Response{data=response.CreateKnowledgeEvalRunResp}
+//	@Router			/knowledge/eval/run [post]
+func CreateKnowledgeEvalRun(ctx *gin.Context) {
+	userId, orgId := getUserID(ctx), getOrgID(ctx)
+	var req request.CreateKnowledgeEvalRunReq
+	if !gin_util.Bind(ctx, &req) {
+		return
+	}
+	resp, err := service.CreateKnowledgeEvalRun(ctx, userId, orgId, &req)
+	gin_util.Response(ctx, resp, err)
+}
+
+// GetKnowledgeEvalRunList
+//
+//	@Tags			knowledge
+//	@Summary		查询检索评测列表
+//	@Description	查询数据集的检索评测列表，包括状态、进度和汇总指标
+//	@Security		JWT
+//	@Accept			json
+//	@Produce		json
+//	@Param			datasetId	query		string	true	"数据集id"
+//	@Success		200			{object}	response.Response{data=response.KnowledgeEvalRunListResp}
+//	@Router			/knowledge/eval/run/list [get]
+func GetKnowledgeEvalRunList(ctx *gin.Context) {
+	userId, orgId := getUserID(ctx), getOrgID(ctx)
+	var req request.KnowledgeEvalRunListReq
+	if !gin_util.BindQuery(ctx, &req) {
+		return
+	}
+	resp, err := service.GetKnowledgeEvalRunList(ctx, userId, orgId, &req)
+	gin_util.Response(ctx, resp, err)
+}
+
+// GetKnowledgeEvalRun
+//
+//	@Tags			knowledge
+//	@Summary		查询检索评测详情
+//	@Description	查询检索评测详情，包括每个问题的检索结果和指标
+//	@Security		JWT
+//	@Accept			json
+//	@Produce		json
+//	@Param			runId	query		string	true	"评测id"
+//	@Success		200		{object}	response.Response{data=response.KnowledgeEvalRunDetail}
+//	@Router			/knowledge/eval/run [get]
+func GetKnowledgeEvalRun(ctx *gin.Context) {
+	userId, orgId := getUserID(ctx), getOrgID(ctx)
+	var req request.KnowledgeEvalRunReq
+	if !gin_util.BindQuery(ctx, &req) {
+		return
+	}
+	resp, err := service.GetKnowledgeEvalRun(ctx, userId, orgId, &req)
+	gin_util.Response(ctx, resp, err)
+}
+
+// CompareKnowledgeEvalRun
+//
+//	@Tags			knowledge
+//	@Summary		对比检索评测
+//	@Description	对比同一数据集下两次已完成的检索评测，返回指标差值和每个问题的指标
+//	@Security		JWT
+//	@Accept			json
+//	@Produce		json
+//	@Param			baseRunId	query		string	true	"基准评测id"
+//	@Param			targetRunId	query		string	true	"对比评测id"
+//	@Success		200			{object}	response.Response{data=response.CompareKnowledgeEvalRunResp}
+//	@Router			/knowledge/eval/run/compare [get]
+func CompareKnowledgeEvalRun(ctx *gin.Context) {
+	userId, orgId := getUserID(ctx), getOrgID(ctx)
+	var req request.CompareKnowledgeEvalRunReq
+	if !gin_util.BindQuery(ctx, &req) {
+		return
+	}
+	resp, err := service.CompareKnowledgeEvalRun(ctx, userId, orgId, &req)
+	gin_util.Response(ctx, resp, err)
+}
@@ -0,0 +1,71 @@
+package v1
+
+import (
+	"github.com/UnicomAI/wanwu/internal/bff-service/model/request"
+	"github.com/UnicomAI/wanwu/internal/bff-service/service"
+	gin_util "github.com/UnicomAI/wanwu/pkg/gin-util"
+	"github.com/gin-gonic/gin"
+)
+
+// GrantKnowledgePermission
+//
+//	@Tags			knowledge.permission
+//	@Summary		分享知识库
+//	@Description	将知识库分享给用户、角色或组织，权限为查看、编辑或管理；已分享的对象更新权限
+//	@Security		JWT
+//	@Accept			json
+//	@Produce		json
+//	@Param			data	body		request.KnowledgePermissionGrantReq	true	"分享知识库请求参数"
+//	@Success		200		{object}	response.Response
+//	@Router			/knowledge/permission [post]
+func GrantKnowledgePermission(ctx *gin.Context) {
+	userId, orgId := getUserID(ctx), getOrgID(ctx)
+	var req request.KnowledgePermissionGrantReq
+	if !gin_util.Bind(ctx, &req) {
+		return
+	}
+	err := service.GrantKnowledgePermission(ctx, userId, orgId, &req)
+	gin_util.Response(ctx, nil, err)
+}
+
+// RevokeKnowledgePermission
+//
+//	@Tags			knowledge.permission
+//	@Summary		取消分享知识库
+//	@Description	取消分享知识库
+//	@Security		JWT
+//	@Accept			json
+//	@Produce		json
+//	@Param			data	body		request.KnowledgePermissionRevokeReq	true	"取消分享知识库请求参数"
+//	@Success		200		{object}	response.Response
+//	@Router			/knowledge/permission [delete]
+func RevokeKnowledgePermission(ctx *gin.Context) {
+	userId, orgId := getUserID(ctx), getOrgID(ctx)
+	var req request.KnowledgePermissionRevokeReq
+	if !gin_util.Bind(ctx, &req) {
+		return
+	}
+	err := service.RevokeKnowledgePermission(ctx, userId, orgId, &req)
+	gin_util.Response(ctx, nil, err)
+}
+
+// GetKnowledgePermissionList
+//
+//	@Tags			knowledge.permission
+//	@Summary		查询知识库分享列表
+//	@Description	查询知识库分享列表
+//	@Security		JWT
+//	@Accept			json
+//	@Produce		json
+//	@Param			knowledgeId	query		string	true	"知识库id"
+//	@Success		200			{object}	response.Response{data=response.KnowledgePermissionListResp}
+//	@Router			/knowledge/permission/list [get]
+func GetKnowledgePermissionList(ctx *gin.Context) {
+	userId, orgId := getUserID(ctx), getOrgID(ctx)
+	var req request.KnowledgePermissionListReq
+	if !gin_util.BindQuery(ctx, &req) {
+		return
+	}
+	resp, err := service.GetKnowledgePermissionList(ctx, userId, orgId, &req)
+	gin_util.Response(ctx, resp, err)
+}
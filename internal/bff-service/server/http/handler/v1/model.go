@@ -131,6 +131,26 @@ func ChangeModelStatus(ctx *gin.Context) {
 	gin_util.Response(ctx, nil, err)
 }
 
+// GetModelCacheStat
+//
+//	@Tags			model
+//	@Summary		模型缓存命中统计
+//	@Description	模型响应缓存的精确命中、语义命中与未命中次数
+//	@Security		JWT
+//	@Accept			json
+//	@Produce		json
+//	@Param			modelId	query		string	true	"模型ID"
+//	@Success		200		{object}	response.Response{data=response.ModelCacheStat}
+//	@Router			/model/cache/stats [get]
+func GetModelCacheStat(ctx *gin.Context) {
+	var req request.GetModelRequest
+	if !gin_util.BindQuery(ctx, &req) {
+		return
+	}
+	resp, err := service.GetModelCacheStat(ctx, getUserID(ctx), getOrgID(ctx), &req)
+	gin_util.Response(ctx, resp, err)
+}
+
 // ListLlmModels
 //
 //	@Tags		model
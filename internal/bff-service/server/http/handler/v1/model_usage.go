@@ -0,0 +1,112 @@
+package v1
+
+import (
+	err_code "github.com/UnicomAI/wanwu/api/proto/err-code"
+	"github.com/UnicomAI/wanwu/internal/bff-service/model/request"
+	"github.com/UnicomAI/wanwu/internal/bff-service/service"
+	gin_util "github.com/UnicomAI/wanwu/pkg/gin-util"
+	grpc_util "github.com/UnicomAI/wanwu/pkg/grpc-util"
+	"github.com/gin-gonic/gin"
+)
+
+// GetModelUsageStats
+//
+//	@Tags			model
+//	@Summary		模型用量统计
+//	@Description	按模型、应用或组织汇总模型调用次数与token用量（仅组织管理员）
+//	@Security		JWT
+//	@Accept			json
+//	@Produce		json
+//	@Param			groupBy	query		string	false	"汇总维度"	Enums(model,app,org)
+//	@Param			orgId	query		string	false	"组织ID（仅【系统】组织可指定）"
+//	@Param			userId	query		string	false	"用户ID"
+//	@Param			modelId	query		string	false	"模型ID"
+//	@Param			appId	query		string	false	"应用ID"
+//	@Param			startAt	query		int		false	"开始时间（毫秒）"
+//	@Param			endAt	query		int		false	"结束时间（毫秒）"
+//	@Success		200		{object}	response.Response{data=[]response.ModelUsageStat}
+//	@Router			/model/usage/stats [get]
+func GetModelUsageStats(ctx *gin.Context) {
+	var req request.ModelUsageStatsRequest
+	if !gin_util.BindQuery(ctx, &req) {
+		return
+	}
+	if !isAdmin(ctx) {
+		gin_util.Response(ctx, nil, grpc_util.ErrorStatusWithKey(err_code.Code_BFFGeneral, "bff_model_quota_cannot_manage"))
+		return
+	}
+	// 【系统】组织可查看所有组织的用量
+	orgId := getOrgID(ctx)
+	if isSystem(ctx) {
+		orgId = req.OrgId
+	}
+	resp, err := service.GetModelUsageStats(ctx, orgId, &req)
+	gin_util.Response(ctx, resp, err)
+}
+
+// ListModelQuotas
+//
+//	@Tags			model
+//	@Summary		模型配额列表
+//	@Description	当前组织的组织配额、用户配额及当日、当月已用量（仅组织管理员）
+//	@Security		JWT
+//	@Accept			json
+//	@Produce		json
+//	@Success		200	{object}	response.Response{data=response.ListResult{list=response.ModelQuota}}
+//	@Router			/model/quota/list [get]
+func ListModelQuotas(ctx *gin.Context) {
+	if !isAdmin(ctx) {
+		gin_util.Response(ctx, nil, grpc_util.ErrorStatusWithKey(err_code.Code_BFFGeneral, "bff_model_quota_cannot_manage"))
+		return
+	}
+	resp, err := service.ListModelQuotas(ctx, getOrgID(ctx))
+	gin_util.Response(ctx, resp, err)
+}
+
+// SetModelQuota
+//
+//	@Tags			model
+//	@Summary		设置模型配额
+//	@Description	设置当前组织或组织内用户的每日、每月token配额（仅组织管理员）
+//	@Security		JWT
+//	@Accept			json
+//	@Produce		json
+//	@Param			data	body		request.ModelQuotaRequest	true	"配额信息"
+//	@Success		200		{object}	response.Response
+//	@Router			/model/quota [put]
+func SetModelQuota(ctx *gin.Context) {
+	var req request.ModelQuotaRequest
+	if !gin_util.Bind(ctx, &req) {
+		return
+	}
+	if !isAdmin(ctx) {
+		gin_util.Response(ctx, nil, grpc_util.ErrorStatusWithKey(err_code.Code_BFFGeneral, "bff_model_quota_cannot_manage"))
+		return
+	}
+	err := service.SetModelQuota(ctx, getOrgID(ctx), &req)
+	gin_util.Response(ctx, nil, err)
+}
+
+// DeleteModelQuota
+//
+//	@Tags			model
+//	@Summary		删除模型配额
+//	@Description	删除当前组织或组织内用户的token配额（仅组织管理员）
+//	@Security		JWT
+//	@Accept			json
+//	@Produce		json
+//	@Param			data	body		request.DeleteModelQuotaRequest	true	"配额信息"
+//	@Success		200		{object}	response.Response
+//	@Router			/model/quota [delete]
+func DeleteModelQuota(ctx *gin.Context) {
+	var req request.DeleteModelQuotaRequest
+	if !gin_util.Bind(ctx, &req) {
+		return
+	}
+	if !isAdmin(ctx) {
+		gin_util.Response(ctx, nil, grpc_util.ErrorStatusWithKey(err_code.Code_BFFGeneral, "bff_model_quota_cannot_manage"))
+		return
+	}
+	err := service.DeleteModelQuota(ctx, getOrgID(ctx), &req)
+	gin_util.Response(ctx, nil, err)
+}
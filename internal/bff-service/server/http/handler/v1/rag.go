@@ -29,6 +29,53 @@ func ChatRag(ctx *gin.Context) {
 	}
 }
 
+// RagFeedbackSubmit
+//
+//	@Tags			rag
+//	@Summary		评价RAG回答
+//	@Description	点赞/点踩某次问答并填写原因，同时保存问题、回答与知识片段快照；rating为0时取消评价
+//	@Security		JWT
+//	@Accept			json
+//	@Produce		json
+//	@Param			data	body		request.RagFeedbackRequest	true	"RAG回答评价参数"
+//	@Success		200		{object}	response.Response
+//	@Router			/rag/chat/feedback [post]
+func RagFeedbackSubmit(ctx *gin.Context) {
+	userId, orgId := getUserID(ctx), getOrgID(ctx)
+	var req request.RagFeedbackRequest
+	if !gin_util.Bind(ctx, &req) {
+		return
+	}
+	err := service.RagFeedbackSubmit(ctx, userId, orgId, req)
+	gin_util.Response(ctx, nil, err)
+}
+
+// GetRagFeedbackList
+//
+//	@Tags			rag
+//	@Summary		RAG回答评价列表
+//	@Description	查询RAG收到的回答评价，可按RAG、评价与时间范围过滤；组织管理员可查询组织内所有RAG，其他用户仅可查询自己创建的RAG
+//	@Security		JWT
+//	@Accept			json
+//	@Produce		json
+//	@Param			ragId		query		string	false	"ragId，非组织管理员必填"
+//	@Param			rating		query		int		false	"评价（1:点赞 -1:点踩 0:全部）"
+//	@Param			startTime	query		int		false	"开始时间（毫秒）"
+//	@Param			endTime		query		int		false	"结束时间（毫秒）"
+//	@Param			pageNo		query		int		true	"页码"
+//	@Param			pageSize	query		int		true	"每页数量"
+//	@Success		200			{object}	response.Response{data=response.PageResult{list=[]response.RagFeedbackInfo}}
+//	@Router			/appspace/rag/feedback/list [get]
+func GetRagFeedbackList(ctx *gin.Context) {
+	userId, orgId := getUserID(ctx), getOrgID(ctx)
+	var req request.RagFeedbackListRequest
+	if !gin_util.BindQuery(ctx, &req) {
+		return
+	}
+	resp, err := service.GetRagFeedbackList(ctx, userId, orgId, isAdmin(ctx), req)
+	gin_util.Response(ctx, resp, err)
+}
+
 // CreateRag
 //
 //	@Tags		rag
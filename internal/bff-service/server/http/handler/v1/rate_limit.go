@@ -0,0 +1,28 @@
+package v1
+
+import (
+	err_code "github.com/UnicomAI/wanwu/api/proto/err-code"
+	"github.com/UnicomAI/wanwu/internal/bff-service/service"
+	gin_util "github.com/UnicomAI/wanwu/pkg/gin-util"
+	grpc_util "github.com/UnicomAI/wanwu/pkg/grpc-util"
+	"github.com/gin-gonic/gin"
+)
+
+// GetRateLimitStats
+//
+//	@Tags			setting
+//	@Summary		限流统计
+//	@Description	OpenAPI与应用Url按ApiKey、应用、匿名访问者的放行、拒绝计数及当前并发流数（仅系统管理员）
+//	@Security		JWT
+//	@Accept			json
+//	@Produce		json
+//	@Success		200	{object}	response.Response{data=response.RateLimitStats}
+//	@Router			/rate-limit/stats [get]
+func GetRateLimitStats(ctx *gin.Context) {
+	if !isSystem(ctx) || !isAdmin(ctx) {
+		gin_util.Response(ctx, nil, grpc_util.ErrorStatusWithKey(err_code.Code_BFFGeneral, "bff_rate_limit_not_system"))
+		return
+	}
+	resp, err := service.GetRateLimitStats(ctx.Request.Context())
+	gin_util.Response(ctx, resp, err)
+}
@@ -198,3 +198,43 @@ func GetSensitiveWordTable(ctx *gin.Context) {
 	resp, err := service.GetSensitiveWordTableByID(ctx, &req)
 	gin_util.Response(ctx, resp, err)
 }
+
+// GetGuardrailPolicy
+//
+//	@Tags			safety
+//	@Summary		获取应用护栏策略
+//	@Description	获取应用的输入、输出护栏策略，未配置时返回未启用的空策略
+//	@Security		JWT
+//	@Accept			json
+//	@Produce		json
+//	@Param			data	query		request.GuardrailPolicyReq	true	"应用信息"
+//	@Success		200		{object}	response.Response{data=response.GuardrailPolicy}
+//	@Router			/safe/guardrail/policy [get]
+func GetGuardrailPolicy(ctx *gin.Context) {
+	var req request.GuardrailPolicyReq
+	if !gin_util.BindQuery(ctx, &req) {
+		return
+	}
+	resp, err := service.GetGuardrailPolicy(ctx, getUserID(ctx), getOrgID(ctx), &req)
+	gin_util.Response(ctx, resp, err)
+}
+
+// SaveGuardrailPolicy
+//
+//	@Tags			safety
+//	@Summary		保存应用护栏策略
+//	@Description	保存应用护栏策略：输入阶段在问题发送给模型前检测，输出阶段对流式回答检测；支持手机号、身份证号、邮箱、银行卡号及自定义正则检测（mask/redact/block），以及基于已导入大模型的分类检测
+//	@Security		JWT
+//	@Accept			json
+//	@Produce		json
+//	@Param			data	body		request.GuardrailPolicy	true	"护栏策略"
+//	@Success		200		{object}	response.Response
+//	@Router			/safe/guardrail/policy [put]
+func SaveGuardrailPolicy(ctx *gin.Context) {
+	var req request.GuardrailPolicy
+	if !gin_util.Bind(ctx, &req) {
+		return
+	}
+	err := service.SaveGuardrailPolicy(ctx, getUserID(ctx), getOrgID(ctx), &req)
+	gin_util.Response(ctx, nil, err)
+}
@@ -0,0 +1,149 @@
+package v1
+
+import (
+	err_code "github.com/UnicomAI/wanwu/api/proto/err-code"
+	"github.com/UnicomAI/wanwu/internal/bff-service/model/request"
+	"github.com/UnicomAI/wanwu/internal/bff-service/service"
+	gin_util "github.com/UnicomAI/wanwu/pkg/gin-util"
+	grpc_util "github.com/UnicomAI/wanwu/pkg/grpc-util"
+	"github.com/gin-gonic/gin"
+)
+
+// CreateWebhook
+//
+//	@Tags			permission
+//	@Summary		创建webhook
+//	@Description	注册组织的webhook接收地址（仅组织管理员）；事件以POST投递，请求头X-Wanwu-Signature为 sha256=hex(hmac_sha256(secret, "{X-Wanwu-Timestamp}.{body}"))，非2xx响应按指数退避重试
+//	@Security		JWT
+//	@Accept			json
+//	@Produce		json
+//	@Param			data	body		request.CreateWebhookRequest	true	"webhook信息"
+//	@Success		200		{object}	response.Response{data=response.WebhookInfo}
+//	@Router			/webhook [post]
+func CreateWebhook(ctx *gin.Context) {
+	var req request.CreateWebhookRequest
+	if !gin_util.Bind(ctx, &req) {
+		return
+	}
+	if !isAdmin(ctx) {
+		gin_util.Response(ctx, nil, grpc_util.ErrorStatusWithKey(err_code.Code_BFFGeneral, "bff_webhook_not_admin"))
+		return
+	}
+	resp, err := service.CreateWebhook(ctx, getUserID(ctx), getOrgID(ctx), &req)
+	gin_util.Response(ctx, resp, err)
+}
+
+// UpdateWebhook
+//
+//	@Tags			permission
+//	@Summary		编辑webhook
+//	@Description	编辑webhook（仅组织管理员），secret为空时不修改
+//	@Security		JWT
+//	@Accept			json
+//	@Produce		json
+//	@Param			data	body		request.UpdateWebhookRequest	true	"webhook信息"
+//	@Success		200		{object}	response.Response
+//	@Router			/webhook [put]
+func UpdateWebhook(ctx *gin.Context) {
+	var req request.UpdateWebhookRequest
+	if !gin_util.Bind(ctx, &req) {
+		return
+	}
+	if !isAdmin(ctx) {
+		gin_util.Response(ctx, nil, grpc_util.ErrorStatusWithKey(err_code.Code_BFFGeneral, "bff_webhook_not_admin"))
+		return
+	}
+	err := service.UpdateWebhook(ctx, getOrgID(ctx), &req)
+	gin_util.Response(ctx, nil, err)
+}
+
+// DeleteWebhook
+//
+//	@Tags			permission
+//	@Summary		删除webhook
+//	@Description	删除webhook及其投递记录（仅组织管理员）
+//	@Security		JWT
+//	@Accept			json
+//	@Produce		json
+//	@Param			data	body		request.WebhookIdRequest	true	"webhook ID"
+//	@Success		200		{object}	response.Response
+//	@Router			/webhook [delete]
+func DeleteWebhook(ctx *gin.Context) {
+	var req request.WebhookIdRequest
+	if !gin_util.Bind(ctx, &req) {
+		return
+	}
+	if !isAdmin(ctx) {
+		gin_util.Response(ctx, nil, grpc_util.ErrorStatusWithKey(err_code.Code_BFFGeneral, "bff_webhook_not_admin"))
+		return
+	}
+	err := service.DeleteWebhook(ctx, getOrgID(ctx), &req)
+	gin_util.Response(ctx, nil, err)
+}
+
+// GetWebhookList
+//
+//	@Tags			permission
+//	@Summary		获取webhook列表
+//	@Description	获取组织的webhook列表（仅组织管理员），签名密钥脱敏返回
+//	@Security		JWT
+//	@Accept			json
+//	@Produce		json
+//	@Success		200	{object}	response.Response{data=response.ListResult{list=[]response.WebhookInfo}}
+//	@Router			/webhook/list [get]
+func GetWebhookList(ctx *gin.Context) {
+	if !isAdmin(ctx) {
+		gin_util.Response(ctx, nil, grpc_util.ErrorStatusWithKey(err_code.Code_BFFGeneral, "bff_webhook_not_admin"))
+		return
+	}
+	resp, err := service.GetWebhookList(ctx, getOrgID(ctx))
+	gin_util.Response(ctx, resp, err)
+}
+
+// GetWebhookDeliveryList
+//
+//	@Tags			permission
+//	@Summary		获取webhook投递记录
+//	@Description	获取组织的webhook投递记录（仅组织管理员），status=dead 为死信列表
+//	@Security		JWT
+//	@Accept			json
+//	@Produce		json
+//	@Param			data	query		request.WebhookDeliveryListRequest	true	"查询条件"
+//	@Success		200		{object}	response.Response{data=response.PageResult{list=[]response.WebhookDeliveryInfo}}
+//	@Router			/webhook/delivery/list [get]
+func GetWebhookDeliveryList(ctx *gin.Context) {
+	var req request.WebhookDeliveryListRequest
+	if !gin_util.BindQuery(ctx, &req) {
+		return
+	}
+	if !isAdmin(ctx) {
+		gin_util.Response(ctx, nil, grpc_util.ErrorStatusWithKey(err_code.Code_BFFGeneral, "bff_webhook_not_admin"))
+		return
+	}
+	resp, err := service.GetWebhookDeliveryList(ctx, getOrgID(ctx), &req)
+	gin_util.Response(ctx, resp, err)
+}
+
+// RetryWebhookDelivery
+//
+//	@Tags			permission
+//	@Summary		重试webhook投递
+//	@Description	将死信重新置为待投递并重置投递次数（仅组织管理员）
+//	@Security		JWT
+//	@Accept			json
+//	@Produce		json
+//	@Param			data	body		request.WebhookDeliveryIdRequest	true	"投递ID"
+//	@Success		200		{object}	response.Response
+//	@Router			/webhook/delivery/retry [post]
+func RetryWebhookDelivery(ctx *gin.Context) {
+	var req request.WebhookDeliveryIdRequest
+	if !gin_util.Bind(ctx, &req) {
+		return
+	}
+	if !isAdmin(ctx) {
+		gin_util.Response(ctx, nil, grpc_util.ErrorStatusWithKey(err_code.Code_BFFGeneral, "bff_webhook_not_admin"))
+		return
+	}
+	err := service.RetryWebhookDelivery(ctx, getOrgID(ctx), &req)
+	gin_util.Response(ctx, nil, err)
+}
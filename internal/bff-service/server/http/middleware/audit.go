@@ -0,0 +1,164 @@
+package middleware
+
+import (
+	"encoding/json"
+	"strings"
+
+	operate_service "github.com/UnicomAI/wanwu/api/proto/operate-service"
+	"github.com/UnicomAI/wanwu/internal/bff-service/service"
+	gin_util "github.com/UnicomAI/wanwu/pkg/gin-util"
+	"github.com/UnicomAI/wanwu/pkg/gin-util/route"
+	"github.com/UnicomAI/wanwu/pkg/log"
+	"github.com/gin-gonic/gin"
+)
+
+const (
+	auditRequestMaxLen = 4096
+	auditRedacted      = "******"
+)
+
+var (
+	// auditSensitiveKeys 请求参数中需要脱敏的字段（小写，包含匹配）
+	auditSensitiveKeys = []string{"password", "secret", "apikey", "api_key", "accesskey", "access_key", "privatekey", "private_key", "credential", "authorization"}
+	// auditSensitiveSuffixes 请求参数中需要脱敏的字段后缀（小写），避免误伤 maxTokens 等字段
+	auditSensitiveSuffixes = []string{"token"}
+)
+
+// Audit 记录审计日志（异步写入），targetFields为操作对象ID字段，依次从请求body、query、返回data中查找
+func Audit(targetFields ...string) gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		var body string
+		if ctx.ContentType() == gin.MIMEJSON {
+			body, _ = requestBody(ctx)
+		}
+		ctx.Next()
+
+		userID, _ := getUserID(ctx)
+		action, _ := route.GetTags(ctx.FullPath(), ctx.Request.Method)
+		actionName, _ := route.GetDesc(ctx.FullPath(), ctx.Request.Method)
+		var resp struct {
+			Code int64           `json:"code"`
+			Data json.RawMessage `json:"data"`
+			Msg  string          `json:"msg"`
+		}
+		_ = json.Unmarshal([]byte(ctx.GetString(gin_util.RESULT)), &resp)
+		auditLog := &operate_service.AuditLog{
+			OrgId:      getOrgID(ctx),
+			UserId:     userID,
+			Action:     strings.Join(action, ","),
+			ActionName: actionName,
+			Method:     ctx.Request.Method,
+			Path:       ctx.FullPath(),
+			TargetId:   auditTargetID(ctx, body, string(resp.Data), targetFields),
+			Success:    resp.Code == 0 && ctx.Writer.Status() < 400,
+			Code:       resp.Code,
+			ClientIp:   ctx.ClientIP(), // 仅信任server.trusted_proxies中代理转发的X-Forwarded-For
+			Request:    auditRequest(ctx, body),
+		}
+		if !auditLog.Success {
+			auditLog.ErrMsg = resp.Msg
+		}
+		if err := service.CreateAuditLog(auditLog); err != nil {
+			log.Errorf("record audit log [%v]%v user %v org %v err: %v", auditLog.Method, auditLog.Path, auditLog.UserId, auditLog.OrgId, err)
+		}
+	}
+}
+
+// auditTargetID 依次从请求body、query、返回data中查找操作对象ID，数组以逗号拼接
+func auditTargetID(ctx *gin.Context, body, data string, targetFields []string) string {
+	if id := auditJSONField(body, targetFields); id != "" {
+		return id
+	}
+	for _, field := range targetFields {
+		if id := ctx.Query(field); id != "" {
+			return id
+		}
+	}
+	return auditJSONField(data, targetFields)
+}
+
+func auditJSONField(src string, fields []string) string {
+	kv := make(map[string]interface{})
+	if src == "" || json.Unmarshal([]byte(src), &kv) != nil {
+		return ""
+	}
+	for _, field := range fields {
+		if id := auditFieldString(kv[field]); id != "" {
+			return id
+		}
+	}
+	return ""
+}
+
+func auditFieldString(v interface{}) string {
+	switch v := v.(type) {
+	case string:
+		return v
+	case []interface{}:
+		var ids []string
+		for _, item := range v {
+			if id, ok := item.(string); ok && id != "" {
+				ids = append(ids, id)
+			}
+		}
+		return strings.Join(ids, ",")
+	}
+	return ""
+}
+
+// auditRequest 脱敏后的请求参数，body为空时使用query
+func auditRequest(ctx *gin.Context, body string) string {
+	var params interface{}
+	if body != "" {
+		if err := json.Unmarshal([]byte(body), &params); err != nil {
+			return ""
+		}
+	} else if query := ctx.Request.URL.Query(); len(query) > 0 {
+		kv := make(map[string]interface{})
+		for k, v := range query {
+			kv[k] = strings.Join(v, ",")
+		}
+		params = kv
+	} else {
+		return ""
+	}
+	b, _ := json.Marshal(redactAuditParams(params))
+	ret := string(b)
+	if len(ret) > auditRequestMaxLen {
+		ret = strings.ToValidUTF8(ret[:auditRequestMaxLen], "") + "..."
+	}
+	return ret
+}
+
+func redactAuditParams(params interface{}) interface{} {
+	switch params := params.(type) {
+	case map[string]interface{}:
+		for k, v := range params {
+			if isAuditSensitiveKey(k) {
+				params[k] = auditRedacted
+			} else {
+				params[k] = redactAuditParams(v)
+			}
+		}
+	case []interface{}:
+		for i, v := range params {
+			params[i] = redactAuditParams(v)
+		}
+	}
+	return params
+}
+
+func isAuditSensitiveKey(key string) bool {
+	key = strings.ToLower(key)
+	for _, sensitive := range auditSensitiveKeys {
+		if strings.Contains(key, sensitive) {
+			return true
+		}
+	}
+	for _, suffix := range auditSensitiveSuffixes {
+		if strings.HasSuffix(key, suffix) {
+			return true
+		}
+	}
+	return false
+}
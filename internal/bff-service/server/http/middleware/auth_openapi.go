@@ -2,9 +2,12 @@ package middleware
 
 import (
 	"fmt"
+	"net"
 	"net/http"
+	"slices"
 	"strings"
 
+	app_service "github.com/UnicomAI/wanwu/api/proto/app-service"
 	err_code "github.com/UnicomAI/wanwu/api/proto/err-code"
 	"github.com/UnicomAI/wanwu/internal/bff-service/service"
 	gin_util "github.com/UnicomAI/wanwu/pkg/gin-util"
@@ -12,7 +15,8 @@ import (
 	"google.golang.org/grpc/codes"
 )
 
-func AuthOpenAPI(appType string) func(*gin.Context) {
+// AuthOpenAPI 校验ApiKey，appType为空时不校验应用类型，scope为接口所需的授权范围
+func AuthOpenAPI(appType, scope string) func(*gin.Context) {
 	return func(ctx *gin.Context) {
 		token, err := getApiKey(ctx)
 		if err != nil {
@@ -26,18 +30,58 @@ func AuthOpenAPI(appType string) func(*gin.Context) {
 			ctx.Abort()
 			return
 		}
-		if apiKey.AppType != appType {
+		if appType != "" && apiKey.AppType != appType {
 			gin_util.ResponseDetail(ctx, http.StatusUnauthorized, codes.Code(err_code.Code_BFFAuth), nil, "invalid appType")
 			ctx.Abort()
 			return
 		}
+		if err := checkApiKeyAccess(ctx, apiKey, scope); err != nil {
+			gin_util.ResponseDetail(ctx, http.StatusForbidden, codes.Code(err_code.Code_BFFAuth), nil, err.Error())
+			ctx.Abort()
+			return
+		}
 		ctx.Set(gin_util.USER_ID, apiKey.UserId)
 		ctx.Set(gin_util.X_ORG_ID, apiKey.OrgId)
 		ctx.Set(gin_util.APP_ID, apiKey.AppId)
+		ctx.Set(gin_util.APP_TYPE, apiKey.AppType)
+		ctx.Set(gin_util.API_KEY_ID, apiKey.ApiId)
 	}
 
 }
 
+// checkApiKeyAccess 校验ApiKey的授权范围、请求来源及客户端IP，配置为空表示不限制；
+// 配置了来源白名单时，未携带Origin的请求（如服务端调用）同样拒绝，服务端调用应改用IP白名单限制
+func checkApiKeyAccess(ctx *gin.Context, apiKey *app_service.ApiKeyInfo, scope string) error {
+	if len(apiKey.Scopes) > 0 && !slices.Contains(apiKey.Scopes, scope) {
+		return fmt.Errorf("api key scope %v not allowed", scope)
+	}
+	if len(apiKey.AllowedOrigins) > 0 {
+		origin := ctx.Request.Header.Get("Origin")
+		if origin == "" {
+			return fmt.Errorf("origin required")
+		}
+		if !slices.ContainsFunc(apiKey.AllowedOrigins, func(allowed string) bool {
+			return strings.EqualFold(strings.TrimSuffix(allowed, "/"), origin)
+		}) {
+			return fmt.Errorf("origin %v not allowed", origin)
+		}
+	}
+	if len(apiKey.AllowedIps) > 0 {
+		// 仅在请求来自配置的可信代理时才采信转发头，见server.trusted_proxies
+		clientIP := net.ParseIP(ctx.ClientIP())
+		if clientIP == nil || !slices.ContainsFunc(apiKey.AllowedIps, func(allowed string) bool {
+			if _, ipNet, err := net.ParseCIDR(allowed); err == nil {
+				return ipNet.Contains(clientIP)
+			}
+			ip := net.ParseIP(allowed)
+			return ip != nil && ip.Equal(clientIP)
+		}) {
+			return fmt.Errorf("client ip %v not allowed", ctx.ClientIP())
+		}
+	}
+	return nil
+}
+
 func getApiKey(ctx *gin.Context) (string, error) {
 	authorization := ctx.Request.Header.Get("Authorization")
 	if authorization != "" {
@@ -0,0 +1,39 @@
+package middleware
+
+import (
+	"net/http"
+
+	err_code "github.com/UnicomAI/wanwu/api/proto/err-code"
+	"github.com/UnicomAI/wanwu/internal/bff-service/service"
+	gin_util "github.com/UnicomAI/wanwu/pkg/gin-util"
+	mp "github.com/UnicomAI/wanwu/pkg/model-provider"
+	"github.com/gin-gonic/gin"
+	"google.golang.org/grpc/codes"
+)
+
+// CheckModelQuota 对话入口校验当前组织、用户的token配额；OpenAPI须在AuthOpenAPI之后
+func CheckModelQuota(ctx *gin.Context) {
+	orgId := ctx.GetString(gin_util.X_ORG_ID)
+	if orgId == "" {
+		orgId = ctx.GetHeader(gin_util.X_ORG_ID)
+	}
+	if err := service.CheckModelQuota(ctx, orgId, ctx.GetString(gin_util.USER_ID)); err != nil {
+		service.ResponseModelQuotaErr(ctx, err)
+		ctx.Abort()
+		return
+	}
+}
+
+// ModelCaller 解析callback model_url中的调用方信息，写入header供模型用量统计与配额校验使用
+func ModelCaller(ctx *gin.Context) {
+	caller, err := mp.ParseModelCaller(ctx.Param("caller"))
+	if err != nil {
+		gin_util.ResponseDetail(ctx, http.StatusBadRequest, codes.Code(err_code.Code_BFFInvalidArg), nil, err.Error())
+		ctx.Abort()
+		return
+	}
+	ctx.Request.Header.Set(gin_util.X_ORG_ID, caller.OrgId)
+	ctx.Request.Header.Set(gin_util.X_USER_ID, caller.UserId)
+	ctx.Request.Header.Set(gin_util.X_APP_ID, caller.AppId)
+	ctx.Request.Header.Set(gin_util.X_APP_TYPE, caller.AppType)
+}
@@ -0,0 +1,50 @@
+package middleware
+
+import (
+	"math"
+	"net/http"
+	"strconv"
+
+	err_code "github.com/UnicomAI/wanwu/api/proto/err-code"
+	"github.com/UnicomAI/wanwu/internal/bff-service/config"
+	"github.com/UnicomAI/wanwu/internal/bff-service/service"
+	gin_util "github.com/UnicomAI/wanwu/pkg/gin-util"
+	"github.com/gin-gonic/gin"
+)
+
+// RateLimitOpenAPI OpenAPI按ApiKey、应用限流，须在AuthOpenAPI之后
+func RateLimitOpenAPI(ctx *gin.Context) {
+	rateLimit(ctx, []service.RateLimitSubject{
+		{Dimension: config.RateLimitDimensionApiKey, Id: ctx.GetString(gin_util.API_KEY_ID)},
+		{Dimension: config.RateLimitDimensionApp, Id: ctx.GetString(gin_util.APP_ID)},
+	})
+}
+
+// RateLimitOpenUrl 应用Url按应用、匿名访问者（X-Client-ID）限流；X-Client-ID由客户端生成，另按客户端IP以更高的限制兜底
+func RateLimitOpenUrl(ctx *gin.Context) {
+	// 未启用时无需查询应用Url所属的应用
+	if !config.Cfg().RateLimit.Enable {
+		return
+	}
+	rateLimit(ctx, []service.RateLimitSubject{
+		{Dimension: config.RateLimitDimensionApp, Id: service.GetAppUrlAppID(ctx, ctx.Param("suffix"))},
+		{Dimension: config.RateLimitDimensionClient, Id: ctx.GetHeader("X-Client-ID")},
+		{Dimension: config.RateLimitDimensionIp, Id: ctx.ClientIP()},
+	})
+}
+
+func rateLimit(ctx *gin.Context, subjects []service.RateLimitSubject) {
+	release, exceeded := service.RateLimitAcquire(ctx.Request.Context(), subjects)
+	if exceeded != nil {
+		ctx.Header("Retry-After", strconv.Itoa(max(1, int(math.Ceil(exceeded.RetryAfter.Seconds())))))
+		key := "bff_rate_limit_exceeded"
+		if exceeded.Concurrency {
+			key = "bff_rate_limit_concurrency"
+		}
+		gin_util.ResponseErrCodeKeyWithStatus(ctx, http.StatusTooManyRequests, err_code.Code_BFFGeneral, key, exceeded.Subject.Dimension)
+		ctx.Abort()
+		return
+	}
+	defer release()
+	ctx.Next()
+}
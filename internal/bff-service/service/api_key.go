@@ -2,6 +2,7 @@ package service
 
 import (
 	"net/url"
+	"time"
 
 	app_service "github.com/UnicomAI/wanwu/api/proto/app-service"
 	err_code "github.com/UnicomAI/wanwu/api/proto/err-code"
@@ -34,20 +35,37 @@ func GetApiKeyByKey(ctx *gin.Context, apiKey string) (*app_service.ApiKeyInfo, e
 }
 
 func GenApiKey(ctx *gin.Context, userId, orgId string, req request.GenApiKeyRequest) (*response.ApiResponse, error) {
+	var expiresAt int64
+	if req.ExpireDays > 0 {
+		expiresAt = time.Now().AddDate(0, 0, int(req.ExpireDays)).UnixMilli()
+	}
 	key, err := app.GenApiKey(ctx.Request.Context(), &app_service.GenApiKeyReq{
-		AppId:   req.AppId,
-		AppType: req.AppType,
-		UserId:  userId,
-		OrgId:   orgId,
+		AppId:          req.AppId,
+		AppType:        req.AppType,
+		UserId:         userId,
+		OrgId:          orgId,
+		Scopes:         req.Scopes,
+		AllowedOrigins: req.AllowedOrigins,
+		AllowedIps:     req.AllowedIps,
+		ExpiresAt:      expiresAt,
 	})
 	if err != nil {
 		return nil, err
 	}
-	return &response.ApiResponse{
-		ApiID:     key.ApiId,
-		ApiKey:    key.ApiKey,
-		CreatedAt: util.Time2Str(key.CreatedAt),
-	}, nil
+	return toApiResp(key), nil
+}
+
+// RotateApiKey 轮换ApiKey，生成配置相同的新Key，原Key在重叠期后失效
+func RotateApiKey(ctx *gin.Context, userId string, req request.RotateApiKeyRequest) (*response.ApiResponse, error) {
+	key, err := app.RotateApiKey(ctx.Request.Context(), &app_service.RotateApiKeyReq{
+		ApiId:          req.ApiId,
+		UserId:         userId,
+		OverlapSeconds: int64(time.Duration(req.OverlapHours) * time.Hour / time.Second),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return toApiResp(key), nil
 }
 
 func DelApiKey(ctx *gin.Context, req request.DelApiKeyRequest) error {
@@ -76,10 +94,25 @@ func GetApiKeyList(ctx *gin.Context, userId string, req request.GetApiKeyListReq
 	return apiRes, nil
 }
 
+// toApiResp 未返回明文时（列表）以前缀脱敏展示
 func toApiResp(apiKeyInfo *app_service.ApiKeyInfo) *response.ApiResponse {
-	return &response.ApiResponse{
-		ApiID:     apiKeyInfo.ApiId,
-		ApiKey:    apiKeyInfo.ApiKey,
-		CreatedAt: util.Time2Str(apiKeyInfo.CreatedAt),
+	ret := &response.ApiResponse{
+		ApiID:          apiKeyInfo.ApiId,
+		ApiKey:         apiKeyInfo.ApiKey,
+		KeyPrefix:      apiKeyInfo.KeyPrefix,
+		Scopes:         apiKeyInfo.Scopes,
+		AllowedOrigins: apiKeyInfo.AllowedOrigins,
+		AllowedIps:     apiKeyInfo.AllowedIps,
+		CreatedAt:      util.Time2Str(apiKeyInfo.CreatedAt),
+	}
+	if ret.ApiKey == "" {
+		ret.ApiKey = apiKeyInfo.KeyPrefix + "******"
+	}
+	if apiKeyInfo.ExpiresAt > 0 {
+		ret.ExpiresAt = util.Time2Str(apiKeyInfo.ExpiresAt)
+	}
+	if apiKeyInfo.LastUsedAt > 0 {
+		ret.LastUsedAt = util.Time2Str(apiKeyInfo.LastUsedAt)
 	}
+	return ret
 }
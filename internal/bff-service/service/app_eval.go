@@ -0,0 +1,209 @@
+package service
+
+import (
+	app_service "github.com/UnicomAI/wanwu/api/proto/app-service"
+	err_code "github.com/UnicomAI/wanwu/api/proto/err-code"
+	model_service "github.com/UnicomAI/wanwu/api/proto/model-service"
+	"github.com/UnicomAI/wanwu/internal/bff-service/model/request"
+	"github.com/UnicomAI/wanwu/internal/bff-service/model/response"
+	grpc_util "github.com/UnicomAI/wanwu/pkg/grpc-util"
+	mp "github.com/UnicomAI/wanwu/pkg/model-provider"
+	"github.com/UnicomAI/wanwu/pkg/util"
+	"github.com/gin-gonic/gin"
+)
+
+func CreateAppEvalDataset(ctx *gin.Context, userId, orgId string, req request.AppEvalDatasetCreateRequest) (*response.AppEvalDatasetInfo, error) {
+	var questions []*app_service.AppEvalQuestion
+	for _, question := range req.Questions {
+		questions = append(questions, &app_service.AppEvalQuestion{
+			Question:        question.Question,
+			ReferenceAnswer: question.ReferenceAnswer,
+		})
+	}
+	resp, err := app.CreateAppEvalDataset(ctx.Request.Context(), &app_service.CreateAppEvalDatasetReq{
+		Name:        req.Name,
+		Description: req.Description,
+		Questions:   questions,
+		UserId:      userId,
+		OrgId:       orgId,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return toAppEvalDatasetInfo(resp, false), nil
+}
+
+func DeleteAppEvalDataset(ctx *gin.Context, userId, orgId string, req request.AppEvalDatasetIdRequest) error {
+	_, err := app.DeleteAppEvalDataset(ctx.Request.Context(), &app_service.AppEvalDatasetReq{
+		DatasetId: req.DatasetId,
+		UserId:    userId,
+		OrgId:     orgId,
+	})
+	return err
+}
+
+func GetAppEvalDatasetList(ctx *gin.Context, userId, orgId string) (*response.ListResult, error) {
+	resp, err := app.GetAppEvalDatasetList(ctx.Request.Context(), &app_service.GetAppEvalDatasetListReq{
+		UserId: userId,
+		OrgId:  orgId,
+	})
+	if err != nil {
+		return nil, err
+	}
+	list := make([]*response.AppEvalDatasetInfo, 0, len(resp.Datasets))
+	for _, dataset := range resp.Datasets {
+		list = append(list, toAppEvalDatasetInfo(dataset, false))
+	}
+	return &response.ListResult{List: list, Total: resp.Total}, nil
+}
+
+func GetAppEvalDataset(ctx *gin.Context, userId, orgId string, req request.AppEvalDatasetIdRequest) (*response.AppEvalDatasetInfo, error) {
+	resp, err := app.GetAppEvalDataset(ctx.Request.Context(), &app_service.AppEvalDatasetReq{
+		DatasetId: req.DatasetId,
+		UserId:    userId,
+		OrgId:     orgId,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return toAppEvalDatasetInfo(resp, true), nil
+}
+
+// CreateAppEvalJob 创建评测任务，由评测任务执行者在后台按问题集逐个回放应用对话并由评分模型打分
+func CreateAppEvalJob(ctx *gin.Context, userId, orgId string, req request.AppEvalJobCreateRequest) (*response.AppEvalJobInfo, error) {
+	// 校验应用
+	if err := checkAppOwner(ctx, userId, orgId, req.AppId, req.AppType); err != nil {
+		return nil, err
+	}
+	// 评分模型
+	judgeInfo, err := model.GetModel(ctx.Request.Context(), &model_service.GetModelReq{
+		ModelId: req.JudgeModelId,
+		UserId:  userId,
+		OrgId:   orgId,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if !judgeInfo.IsActive || judgeInfo.ModelType != mp.ModelTypeLLM {
+		return nil, grpc_util.ErrorStatus(err_code.Code_BFFInvalidArg, "judge model must be an active llm")
+	}
+	if err := CheckModelQuota(ctx, orgId, userId); err != nil {
+		return nil, err
+	}
+	job, err := app.CreateAppEvalJob(ctx.Request.Context(), &app_service.CreateAppEvalJobReq{
+		DatasetId:     req.DatasetId,
+		AppId:         req.AppId,
+		AppType:       req.AppType,
+		JudgeModelId:  req.JudgeModelId,
+		BaselineJobId: req.BaselineJobId,
+		Tolerance:     req.Tolerance,
+		MinScore:      req.MinScore,
+		UserId:        userId,
+		OrgId:         orgId,
+	})
+	if err != nil {
+		return nil, err
+	}
+	notifyAppEvalRunner()
+	return toAppEvalJobInfo(job), nil
+}
+
+func GetAppEvalJobList(ctx *gin.Context, userId, orgId string, req request.AppEvalJobListRequest) (*response.ListResult, error) {
+	resp, err := app.GetAppEvalJobList(ctx.Request.Context(), &app_service.GetAppEvalJobListReq{
+		DatasetId: req.DatasetId,
+		AppId:     req.AppId,
+		UserId:    userId,
+		OrgId:     orgId,
+	})
+	if err != nil {
+		return nil, err
+	}
+	list := make([]*response.AppEvalJobInfo, 0, len(resp.Jobs))
+	for _, job := range resp.Jobs {
+		list = append(list, toAppEvalJobInfo(job))
+	}
+	return &response.ListResult{List: list, Total: resp.Total}, nil
+}
+
+func GetAppEvalJob(ctx *gin.Context, userId, orgId string, req request.AppEvalJobIdRequest) (*response.AppEvalJobDetail, error) {
+	resp, err := app.GetAppEvalJob(ctx.Request.Context(), &app_service.AppEvalJobReq{
+		JobId:  req.JobId,
+		UserId: userId,
+		OrgId:  orgId,
+	})
+	if err != nil {
+		return nil, err
+	}
+	ret := &response.AppEvalJobDetail{
+		Job:     *toAppEvalJobInfo(resp.Job),
+		Results: make([]response.AppEvalResult, 0, len(resp.Results)),
+	}
+	for _, result := range resp.Results {
+		ret.Results = append(ret.Results, response.AppEvalResult{
+			QuestionId:      result.QuestionId,
+			Question:        result.Question,
+			ReferenceAnswer: result.ReferenceAnswer,
+			Answer:          result.Answer,
+			SearchList:      unmarshalSearchList(result.SearchList),
+			Scores:          toAppEvalScores(result.Scores),
+			Reason:          result.Reason,
+			ErrorMsg:        result.ErrorMsg,
+			LatencyMs:       result.LatencyMs,
+		})
+	}
+	return ret, nil
+}
+
+// --- internal ---
+
+func toAppEvalDatasetInfo(dataset *app_service.AppEvalDatasetInfo, withQuestions bool) *response.AppEvalDatasetInfo {
+	ret := &response.AppEvalDatasetInfo{
+		DatasetId:     dataset.DatasetId,
+		Name:          dataset.Name,
+		Description:   dataset.Description,
+		QuestionCount: dataset.QuestionCount,
+		CreatedAt:     util.Time2Str(dataset.CreatedAt),
+	}
+	if withQuestions {
+		ret.Questions = make([]response.AppEvalQuestion, 0, len(dataset.Questions))
+		for _, question := range dataset.Questions {
+			ret.Questions = append(ret.Questions, response.AppEvalQuestion{
+				QuestionId:      question.QuestionId,
+				Question:        question.Question,
+				ReferenceAnswer: question.ReferenceAnswer,
+			})
+		}
+	}
+	return ret
+}
+
+func toAppEvalJobInfo(job *app_service.AppEvalJobInfo) *response.AppEvalJobInfo {
+	return &response.AppEvalJobInfo{
+		JobId:         job.JobId,
+		DatasetId:     job.DatasetId,
+		AppId:         job.AppId,
+		AppType:       job.AppType,
+		JudgeModelId:  job.JudgeModelId,
+		BaselineJobId: job.BaselineJobId,
+		Tolerance:     job.Tolerance,
+		MinScore:      job.MinScore,
+		Status:        job.Status,
+		TotalCount:    job.TotalCount,
+		FinishCount:   job.FinishCount,
+		ErrorCount:    job.ErrorCount,
+		Scores:        toAppEvalScores(job.Scores),
+		GateStatus:    job.GateStatus,
+		GateReason:    job.GateReason,
+		ErrorMsg:      job.ErrorMsg,
+		CreatedAt:     util.Time2Str(job.CreatedAt),
+		UpdatedAt:     util.Time2Str(job.UpdatedAt),
+	}
+}
+
+func toAppEvalScores(scores *app_service.AppEvalScores) response.AppEvalScores {
+	return response.AppEvalScores{
+		Faithfulness: scores.GetFaithfulness(),
+		Relevance:    scores.GetRelevance(),
+		Correctness:  scores.GetCorrectness(),
+	}
+}
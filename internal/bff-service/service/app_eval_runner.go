@@ -0,0 +1,410 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	app_service "github.com/UnicomAI/wanwu/api/proto/app-service"
+	assistant_service "github.com/UnicomAI/wanwu/api/proto/assistant-service"
+	err_code "github.com/UnicomAI/wanwu/api/proto/err-code"
+	model_service "github.com/UnicomAI/wanwu/api/proto/model-service"
+	rag_service "github.com/UnicomAI/wanwu/api/proto/rag-service"
+	"github.com/UnicomAI/wanwu/pkg/constant"
+	"github.com/UnicomAI/wanwu/pkg/log"
+	mp "github.com/UnicomAI/wanwu/pkg/model-provider"
+	"github.com/UnicomAI/wanwu/pkg/util"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	appEvalAnswerTimeout   = 10 * time.Minute
+	appEvalJudgeTimeout    = 2 * time.Minute
+	appEvalRPCTimeout      = 10 * time.Second
+	appEvalLease           = 2 * time.Minute  // 执行者租约时长，实例退出后任务最迟在租约过期后被重新领取
+	appEvalRenewInterval   = 30 * time.Second // 续约间隔
+	appEvalClaimInterval   = time.Minute      // 定时领取租约过期任务的间隔
+	appEvalMaxRunning      = 4                // 单个实例同时执行的任务数
+	appEvalMaxContextRunes = 6000             // 提供给评分模型的检索内容上限
+	appEvalMinScore        = 1
+	appEvalMaxScore        = 5
+)
+
+const appEvalJudgePrompt = `你是问答系统的评测员。请根据用户问题、检索内容、参考答案，对系统回答按1-5分（整数，5分最好）评分：
+1. faithfulness（忠实度）：回答是否完全基于检索内容，没有编造检索内容之外的事实；没有检索内容时，按回答是否存在明显编造评分。
+2. relevance（相关性）：回答是否直接回应了用户问题。
+3. correctness（正确性）：回答与参考答案在事实和结论上是否一致。
+只输出JSON，不要输出其他内容，格式：{"faithfulness":5,"relevance":5,"correctness":5,"reason":"简要评分理由"}`
+
+// appEvalJudge 评分模型
+type appEvalJudge struct {
+	llm   mp.ILLM
+	model string
+}
+
+type appEvalAnswer struct {
+	answer     string
+	searchList string
+}
+
+type appEvalJudgeResult struct {
+	Faithfulness float64 `json:"faithfulness"`
+	Relevance    float64 `json:"relevance"`
+	Correctness  float64 `json:"correctness"`
+	Reason       string  `json:"reason"`
+}
+
+// appEvalStreamLine 兼容rag（data.output、data.searchList）与智能体（response、search_list）的流式返回
+type appEvalStreamLine struct {
+	Code       int             `json:"code"`
+	Message    string          `json:"message"`
+	Response   string          `json:"response"`
+	SearchList json.RawMessage `json:"search_list"`
+	Data       json.RawMessage `json:"data"`
+}
+
+type appEvalRagStreamData struct {
+	Output     string          `json:"output"`
+	SearchList json.RawMessage `json:"searchList"`
+}
+
+// appEvalRunner 评测任务执行者。任务持久化在app-service中，执行者按租约领取：
+// 新建的任务由创建所在实例立即领取，实例退出后租约过期的任务由任一实例定时领取，从尚未保存结果的问题继续执行
+type appEvalRunner struct {
+	id      string
+	wake    chan struct{}
+	running chan struct{}
+}
+
+var _appEvalRunner = &appEvalRunner{
+	id:      util.GenUUID(),
+	wake:    make(chan struct{}, 1),
+	running: make(chan struct{}, appEvalMaxRunning),
+}
+
+// startAppEvalRunner 启动评测任务执行者
+func startAppEvalRunner() {
+	go func() {
+		defer util.PrintPanicStack()
+		ticker := time.NewTicker(appEvalClaimInterval)
+		defer ticker.Stop()
+		for {
+			_appEvalRunner.claim()
+			select {
+			case <-ticker.C:
+			case <-_appEvalRunner.wake:
+			}
+		}
+	}()
+}
+
+// notifyAppEvalRunner 通知执行者领取新建的任务
+func notifyAppEvalRunner() {
+	select {
+	case _appEvalRunner.wake <- struct{}{}:
+	default:
+	}
+}
+
+// claim 在并发上限内领取任务并执行
+func (r *appEvalRunner) claim() {
+	for {
+		select {
+		case r.running <- struct{}{}:
+		default:
+			return
+		}
+		ctx, cancel := context.WithTimeout(context.Background(), appEvalRPCTimeout)
+		resp, err := app.ClaimAppEvalJob(ctx, &app_service.ClaimAppEvalJobReq{
+			RunnerId:     r.id,
+			LeaseSeconds: int64(appEvalLease.Seconds()),
+		})
+		cancel()
+		if err != nil || resp.Job == nil {
+			<-r.running
+			if err != nil {
+				log.Errorf("[AppEval] runner %v claim err: %v", r.id, err)
+			}
+			return
+		}
+		go func() {
+			defer func() { <-r.running }()
+			r.run(resp.Job, resp.Questions)
+		}()
+	}
+}
+
+// run 逐个问题回放应用对话并评分，单个问题失败时记录失败原因后继续；租约丢失（已由其他实例执行）时直接退出
+func (r *appEvalRunner) run(job *app_service.AppEvalJobInfo, questions []*app_service.AppEvalQuestion) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	var errMsg string
+	defer util.PrintPanicStackWithCall(func(panicOccur bool, err error) {
+		if panicOccur {
+			errMsg = fmt.Sprintf("app eval job panic: %v", err)
+		}
+		if ctx.Err() != nil {
+			return
+		}
+		finishCtx, finishCancel := context.WithTimeout(context.Background(), appEvalRPCTimeout)
+		defer finishCancel()
+		if _, err := app.FinishAppEvalJob(finishCtx, &app_service.FinishAppEvalJobReq{JobId: job.JobId, ErrorMsg: errMsg, RunnerId: r.id}); err != nil {
+			log.Errorf("[AppEval] job %v finish err: %v", job.JobId, err)
+		}
+	})
+	go r.renew(ctx, cancel, job.JobId)
+
+	log.Infof("[AppEval] job %v %v %v start, questions: %v", job.JobId, job.AppType, job.AppId, len(questions))
+	judge, err := newAppEvalJudge(ctx, job)
+	if err != nil {
+		errMsg = fmt.Sprintf("judge model err: %v", err)
+		return
+	}
+	for _, question := range questions {
+		result := evalAppQuestion(ctx, job, question, judge)
+		if ctx.Err() != nil {
+			log.Warnf("[AppEval] job %v lease lost, stop", job.JobId)
+			return
+		}
+		saveCtx, saveCancel := context.WithTimeout(ctx, appEvalRPCTimeout)
+		_, err := app.SaveAppEvalResult(saveCtx, &app_service.SaveAppEvalResultReq{JobId: job.JobId, Result: result, RunnerId: r.id})
+		saveCancel()
+		if err != nil {
+			log.Errorf("[AppEval] job %v save question %v result err: %v", job.JobId, question.QuestionId, err)
+			errMsg = err.Error()
+			return
+		}
+	}
+	log.Infof("[AppEval] job %v stop", job.JobId)
+}
+
+// renew 定时续约，续约失败（任务已结束或已被其他实例领取）时取消执行
+func (r *appEvalRunner) renew(ctx context.Context, cancel context.CancelFunc, jobId string) {
+	defer util.PrintPanicStack()
+	ticker := time.NewTicker(appEvalRenewInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+		renewCtx, renewCancel := context.WithTimeout(ctx, appEvalRPCTimeout)
+		_, err := app.RenewAppEvalJob(renewCtx, &app_service.RenewAppEvalJobReq{
+			JobId:        jobId,
+			RunnerId:     r.id,
+			LeaseSeconds: int64(appEvalLease.Seconds()),
+		})
+		renewCancel()
+		if err != nil && ctx.Err() == nil {
+			log.Errorf("[AppEval] job %v renew err: %v", jobId, err)
+			// 仅在租约确已丢失时取消；app-service短暂不可用时等待下次续约，租约时长需覆盖数次续约间隔
+			if isAppEvalLeaseLost(err) {
+				cancel()
+				return
+			}
+		}
+	}
+}
+
+func isAppEvalLeaseLost(err error) bool {
+	for _, detail := range status.Convert(err).Details() {
+		if st, ok := detail.(*err_code.Status); ok && st.TextKey == "app_eval_job_lease" {
+			return true
+		}
+	}
+	return false
+}
+
+// newAppEvalJudge 按任务创建者的权限获取评分模型，用量计入任务创建者与被评测应用
+func newAppEvalJudge(ctx context.Context, job *app_service.AppEvalJobInfo) (*appEvalJudge, error) {
+	judgeInfo, err := model.GetModel(ctx, &model_service.GetModelReq{
+		ModelId: job.JudgeModelId,
+		UserId:  job.UserId,
+		OrgId:   job.OrgId,
+	})
+	if err != nil {
+		return nil, err
+	}
+	judge, err := newRoutingLLM(ctx, judgeInfo, func(info *model_service.ModelInfo) mp.UsageRecorder {
+		return newModelUsageRecorder(info, job.OrgId, job.UserId, job.AppId, job.AppType)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &appEvalJudge{
+		llm:   judge,
+		model: judgeInfo.Model,
+	}, nil
+}
+
+func evalAppQuestion(ctx context.Context, job *app_service.AppEvalJobInfo, question *app_service.AppEvalQuestion, judge *appEvalJudge) *app_service.AppEvalResult {
+	result := &app_service.AppEvalResult{
+		QuestionId:      question.QuestionId,
+		Question:        question.Question,
+		ReferenceAnswer: question.ReferenceAnswer,
+	}
+	start := time.Now()
+	answer, err := replayAppQuestion(ctx, job, question.Question)
+	result.LatencyMs = time.Since(start).Milliseconds()
+	if err != nil {
+		result.ErrorMsg = fmt.Sprintf("answer err: %v", err)
+		return result
+	}
+	result.Answer, result.SearchList = answer.answer, answer.searchList
+	scores, err := judge.score(ctx, question, answer)
+	if err != nil {
+		result.ErrorMsg = fmt.Sprintf("judge err: %v", err)
+		return result
+	}
+	result.Scores = &app_service.AppEvalScores{
+		Faithfulness: scores.Faithfulness,
+		Relevance:    scores.Relevance,
+		Correctness:  scores.Correctness,
+	}
+	result.Reason = scores.Reason
+	return result
+}
+
+// replayAppQuestion 调用应用流式对话接口获取完整回答；智能体以调试模式调用，不保存对话
+func replayAppQuestion(ctx context.Context, job *app_service.AppEvalJobInfo, question string) (*appEvalAnswer, error) {
+	ctx, cancel := context.WithTimeout(ctx, appEvalAnswerTimeout)
+	defer cancel()
+	var recv func() (string, error)
+	switch job.AppType {
+	case constant.AppTypeRag:
+		stream, err := rag.ChatRag(ctx, &rag_service.ChatRagReq{
+			RagId:    job.AppId,
+			Question: question,
+			Identity: &rag_service.Identity{UserId: job.UserId, OrgId: job.OrgId},
+		})
+		if err != nil {
+			return nil, err
+		}
+		recv = func() (string, error) {
+			resp, err := stream.Recv()
+			return resp.GetContent(), err
+		}
+	case constant.AppTypeAgent:
+		stream, err := assistant.AssistantConversionStream(ctx, &assistant_service.AssistantConversionStreamReq{
+			AssistantId: job.AppId,
+			FileInfo:    &assistant_service.ConversionStreamFile{},
+			Trial:       true,
+			Prompt:      question,
+			Identity:    &assistant_service.Identity{UserId: job.UserId, OrgId: job.OrgId},
+		})
+		if err != nil {
+			return nil, err
+		}
+		recv = func() (string, error) {
+			resp, err := stream.Recv()
+			return resp.GetContent(), err
+		}
+	default:
+		return nil, fmt.Errorf("unsupported app type %v", job.AppType)
+	}
+	answer := &appEvalAnswer{}
+	var sb strings.Builder
+	for {
+		content, err := recv()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		for _, line := range strings.Split(content, "\n") {
+			if err := parseAppEvalStreamLine(line, &sb, answer); err != nil {
+				return nil, err
+			}
+		}
+	}
+	answer.answer = strings.TrimSpace(sb.String())
+	if answer.answer == "" {
+		return nil, errors.New("empty answer")
+	}
+	return answer, nil
+}
+
+// parseAppEvalStreamLine 解析一行流式返回，拼接回答增量并保留最后一次非空的检索结果
+func parseAppEvalStreamLine(line string, sb *strings.Builder, answer *appEvalAnswer) error {
+	line = strings.TrimSpace(line)
+	if line == "" {
+		return nil
+	}
+	if strings.HasPrefix(line, "error:") {
+		return errors.New(strings.TrimSpace(strings.TrimPrefix(line, "error:")))
+	}
+	line = strings.TrimSpace(strings.TrimPrefix(line, "data:"))
+	var resp appEvalStreamLine
+	if err := json.Unmarshal([]byte(line), &resp); err != nil {
+		return nil
+	}
+	if resp.Code != 0 {
+		return fmt.Errorf("code %v: %v", resp.Code, resp.Message)
+	}
+	var data appEvalRagStreamData
+	_ = json.Unmarshal(resp.Data, &data)
+	sb.WriteString(data.Output)
+	sb.WriteString(resp.Response)
+	for _, searchList := range []json.RawMessage{data.SearchList, resp.SearchList} {
+		if s := strings.TrimSpace(string(searchList)); s != "" && s != "null" && s != "[]" {
+			answer.searchList = s
+		}
+	}
+	return nil
+}
+
+// score 调用评分模型对回答打分
+func (j *appEvalJudge) score(ctx context.Context, question *app_service.AppEvalQuestion, answer *appEvalAnswer) (*appEvalJudgeResult, error) {
+	content := fmt.Sprintf("用户问题：\n%s\n\n检索内容：\n%s\n\n参考答案：\n%s\n\n系统回答：\n%s",
+		question.Question, buildAppEvalContext(answer.searchList), question.ReferenceAnswer, answer.answer)
+	ctx, cancel := context.WithTimeout(ctx, appEvalJudgeTimeout)
+	defer cancel()
+	output, err := completeLLM(ctx, j.llm, j.model, appEvalJudgePrompt, content)
+	if err != nil {
+		return nil, err
+	}
+	return parseAppEvalJudgeResult(output)
+}
+
+// parseAppEvalJudgeResult 解析评分模型输出并校验分值范围
+func parseAppEvalJudgeResult(content string) (*appEvalJudgeResult, error) {
+	ret := &appEvalJudgeResult{}
+	if err := unmarshalLLMJSON(content, ret); err != nil {
+		return nil, err
+	}
+	for _, score := range []float64{ret.Faithfulness, ret.Relevance, ret.Correctness} {
+		if score < appEvalMinScore || score > appEvalMaxScore {
+			return nil, fmt.Errorf("judge score out of range: %v", content)
+		}
+	}
+	return ret, nil
+}
+
+// buildAppEvalContext 将检索结果整理为评分模型的检索内容
+func buildAppEvalContext(searchList string) string {
+	var items []map[string]interface{}
+	if searchList == "" || json.Unmarshal([]byte(searchList), &items) != nil || len(items) == 0 {
+		return "无"
+	}
+	var sb strings.Builder
+	var runes int
+	for i, item := range items {
+		title, _ := item["title"].(string)
+		snippet, _ := item["snippet"].(string)
+		if snippet == "" {
+			snippet, _ = item["content"].(string)
+		}
+		text := fmt.Sprintf("[%d] %s\n%s\n", i+1, title, snippet)
+		if runes += len([]rune(text)); runes > appEvalMaxContextRunes && sb.Len() > 0 {
+			break
+		}
+		sb.WriteString(text)
+	}
+	return sb.String()
+}
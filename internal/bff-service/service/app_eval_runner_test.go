@@ -0,0 +1,55 @@
+package service
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestParseAppEvalJudgeResult(t *testing.T) {
+	for _, c := range []struct {
+		content string
+		want    *appEvalJudgeResult
+	}{
+		{`{"faithfulness":5,"relevance":4,"correctness":3,"reason":"基本正确"}`, &appEvalJudgeResult{5, 4, 3, "基本正确"}},
+		{"<think>先分析{\"faithfulness\":1}</think>\n```json\n{\"faithfulness\":4,\"relevance\":5,\"correctness\":5,\"reason\":\"ok\"}\n```", &appEvalJudgeResult{4, 5, 5, "ok"}},
+		{`评分：{"faithfulness":2.5,"relevance":1,"correctness":1}`, &appEvalJudgeResult{2.5, 1, 1, ""}},
+		{`{"faithfulness":0,"relevance":4,"correctness":3}`, nil},
+		{`{"faithfulness":5,"relevance":6,"correctness":3}`, nil},
+		{`{"faithfulness":5,"relevance":4}`, nil},
+		{`无法评分`, nil},
+	} {
+		got, err := parseAppEvalJudgeResult(c.content)
+		if c.want == nil {
+			if err == nil {
+				t.Errorf("parse %q expect err, got %+v", c.content, got)
+			}
+			continue
+		}
+		if err != nil || *got != *c.want {
+			t.Errorf("parse %q = %+v err %v, want %+v", c.content, got, err, c.want)
+		}
+	}
+}
+
+func TestParseAppEvalStreamLine(t *testing.T) {
+	answer := &appEvalAnswer{}
+	var sb strings.Builder
+	for _, line := range []string{
+		`data: {"code":0,"data":{"output":"你好","searchList":[{"title":"a"}]}}`,
+		`data: {"code":0,"data":{"output":"，世界","searchList":[]}}`,
+		`{"response":"！","search_list":null}`,
+		`data: [DONE]`,
+	} {
+		if err := parseAppEvalStreamLine(line, &sb, answer); err != nil {
+			t.Fatalf("parse %q err: %v", line, err)
+		}
+	}
+	if sb.String() != "你好，世界！" || answer.searchList != `[{"title":"a"}]` {
+		t.Fatalf("answer %q search list %q", sb.String(), answer.searchList)
+	}
+	for _, line := range []string{`error: 模型调用失败`, `data: {"code":1,"message":"fail"}`} {
+		if err := parseAppEvalStreamLine(line, &sb, answer); err == nil {
+			t.Errorf("parse %q expect err", line)
+		}
+	}
+}
@@ -160,6 +160,7 @@ func UnPublishApp(ctx *gin.Context, userId, orgId string, req request.UnPublishA
 		AppId:   req.AppId,
 		AppType: req.AppType,
 		UserId:  userId,
+		OrgId:   orgId,
 	})
 	if err != nil {
 		return err
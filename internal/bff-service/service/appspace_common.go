@@ -3,17 +3,50 @@ package service
 import (
 	"fmt"
 
+	assistant_service "github.com/UnicomAI/wanwu/api/proto/assistant-service"
 	"github.com/UnicomAI/wanwu/api/proto/common"
 	err_code "github.com/UnicomAI/wanwu/api/proto/err-code"
+	rag_service "github.com/UnicomAI/wanwu/api/proto/rag-service"
 	"github.com/UnicomAI/wanwu/internal/bff-service/model/request"
 	"github.com/UnicomAI/wanwu/internal/bff-service/model/response"
 	bff_util "github.com/UnicomAI/wanwu/internal/bff-service/pkg/util"
+	"github.com/UnicomAI/wanwu/pkg/constant"
 	grpc_util "github.com/UnicomAI/wanwu/pkg/grpc-util"
 	mp "github.com/UnicomAI/wanwu/pkg/model-provider"
 	"github.com/UnicomAI/wanwu/pkg/util"
 	"github.com/gin-gonic/gin"
 )
 
+// --- app owner ---
+
+// checkAppOwner 校验应用存在且属于当前用户
+func checkAppOwner(ctx *gin.Context, userId, orgId, appId, appType string) error {
+	var identityUserId, identityOrgId string
+	switch appType {
+	case constant.AppTypeRag:
+		info, err := rag.GetRagDetail(ctx.Request.Context(), &rag_service.RagDetailReq{RagId: appId})
+		if err != nil {
+			return err
+		}
+		identityUserId, identityOrgId = info.GetIdentity().GetUserId(), info.GetIdentity().GetOrgId()
+	case constant.AppTypeAgent:
+		info, err := assistant.GetAssistantInfo(ctx.Request.Context(), &assistant_service.GetAssistantInfoReq{
+			AssistantId: appId,
+			Identity:    &assistant_service.Identity{UserId: userId, OrgId: orgId},
+		})
+		if err != nil {
+			return err
+		}
+		identityUserId, identityOrgId = info.GetIdentity().GetUserId(), info.GetIdentity().GetOrgId()
+	default:
+		return grpc_util.ErrorStatus(err_code.Code_BFFInvalidArg, "app type must be rag or agent")
+	}
+	if identityUserId != userId || identityOrgId != orgId {
+		return grpc_util.ErrorStatusWithKey(err_code.Code_BFFGeneral, "bff_app_not_owner")
+	}
+	return nil
+}
+
 // --- app breif ---
 
 func appBriefProto2Model(ctx *gin.Context, appBrief *common.AppBrief) response.AppBriefInfo {
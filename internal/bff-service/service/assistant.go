@@ -73,6 +73,11 @@ func AssistantConfigUpdate(ctx *gin.Context, userId, orgId string, req request.A
 			Enable:         req.SafetyConfig.Enable,
 			SensitiveTable: transSafetyConfig2Proto(req.SafetyConfig.Tables),
 		},
+		MemoryConfig: &assistant_service.AssistantMemoryConfig{
+			MaxTurns:      req.MemoryConfig.MaxTurns,
+			MaxTokens:     req.MemoryConfig.MaxTokens,
+			SummaryEnable: req.MemoryConfig.SummaryEnable,
+		},
 		Identity: &assistant_service.Identity{
 			UserId: userId,
 			OrgId:  orgId,
@@ -362,45 +367,80 @@ func GetConversationDetailList(ctx *gin.Context, userId, orgId string, req reque
 	// 转换resp.Data为自定义的ConversionDetailInfo结构体数组
 	var convertedList []response.ConversationDetailInfo
 	for _, item := range resp.Data {
-		convertedItem := response.ConversationDetailInfo{
-			Id:              item.Id,
-			AssistantId:     item.AssistantId,
-			ConversationId:  item.ConversationId,
-			Prompt:          item.Prompt,
-			SysPrompt:       item.SysPrompt,
-			Response:        item.Response,
-			QaType:          item.QaType,
-			CreatedBy:       item.CreatedBy,
-			CreatedAt:       item.CreatedAt,
-			UpdatedAt:       item.UpdatedAt,
-			RequestFileUrls: item.RequestFileUrls,
-			FileSize:        item.FileSize,
-			FileName:        item.FileName,
-		}
-
-		// 将SearchList从string转换为interface{}
-		if item.SearchList != "" {
-			var searchList interface{}
-			if err := json.Unmarshal([]byte(item.SearchList), &searchList); err != nil {
-				log.Warnf("解析SearchList失败，使用原始字符串，error: %v, searchList: %s", err, item.SearchList)
-				convertedItem.SearchList = item.SearchList
-			} else {
-				convertedItem.SearchList = searchList
-			}
-		} else {
-			convertedItem.SearchList = nil
-		}
+		convertedList = append(convertedList, toConversationDetailInfo(item))
+	}
+	// 对切片进行排序
+	sort.Slice(convertedList, func(i, j int) bool {
+		// CreatedAt值小的时间更早，排在前面
+		return convertedList[i].CreatedAt < convertedList[j].CreatedAt
+	})
 
-		convertedList = append(convertedList, convertedItem)
+	return response.PageResult{Total: resp.Total, List: convertedList, PageNo: req.PageNo, PageSize: req.PageSize}, nil
+}
 
-		// 对切片进行排序
-		sort.Slice(convertedList, func(i, j int) bool {
-			// CreatedAt值小的时间更早，排在前面
-			return convertedList[i].CreatedAt < convertedList[j].CreatedAt
-		})
+func GetConversationDetailSiblings(ctx *gin.Context, userId, orgId string, req request.ConversationDetailRequest) (*response.ConversationDetailSiblings, error) {
+	resp, err := assistant.GetConversationDetailSiblings(ctx.Request.Context(), &assistant_service.GetConversationDetailSiblingsReq{
+		ConversationId: req.ConversationId,
+		DetailId:       req.DetailId,
+		Identity: &assistant_service.Identity{
+			UserId: userId,
+			OrgId:  orgId,
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	ret := &response.ConversationDetailSiblings{ActiveIndex: resp.ActiveIndex}
+	for _, item := range resp.Data {
+		ret.List = append(ret.List, toConversationDetailInfo(item))
 	}
+	return ret, nil
+}
 
-	return response.PageResult{Total: resp.Total, List: convertedList, PageNo: req.PageNo, PageSize: req.PageSize}, nil
+func ConversationBranchSelect(ctx *gin.Context, userId, orgId string, req request.ConversationDetailRequest) error {
+	_, err := assistant.ConversationBranchSelect(ctx.Request.Context(), &assistant_service.ConversationBranchSelectReq{
+		ConversationId: req.ConversationId,
+		DetailId:       req.DetailId,
+		Identity: &assistant_service.Identity{
+			UserId: userId,
+			OrgId:  orgId,
+		},
+	})
+	return err
+}
+
+func toConversationDetailInfo(item *assistant_service.ConversionDetailInfo) response.ConversationDetailInfo {
+	convertedItem := response.ConversationDetailInfo{
+		Id:              item.Id,
+		AssistantId:     item.AssistantId,
+		ConversationId:  item.ConversationId,
+		ParentId:        item.ParentId,
+		SiblingCount:    item.SiblingCount,
+		SiblingIndex:    item.SiblingIndex,
+		Prompt:          item.Prompt,
+		SysPrompt:       item.SysPrompt,
+		Response:        item.Response,
+		QaType:          item.QaType,
+		CreatedBy:       item.CreatedBy,
+		CreatedAt:       item.CreatedAt,
+		UpdatedAt:       item.UpdatedAt,
+		RequestFileUrls: item.RequestFileUrls,
+		FileSize:        item.FileSize,
+		FileName:        item.FileName,
+		Rating:          item.Rating,
+	}
+
+	// 将SearchList从string转换为interface{}
+	if item.SearchList != "" {
+		var searchList interface{}
+		if err := json.Unmarshal([]byte(item.SearchList), &searchList); err != nil {
+			log.Warnf("解析SearchList失败，使用原始字符串，error: %v, searchList: %s", err, item.SearchList)
+			convertedItem.SearchList = item.SearchList
+		} else {
+			convertedItem.SearchList = searchList
+		}
+	}
+	return convertedItem
 }
 
 func transKnowledgebases2Proto(kbConfig request.AppKnowledgebaseConfig) *assistant_service.AssistantKnowledgeBaseConfig {
@@ -586,6 +626,11 @@ func transAssistantResp2Model(ctx *gin.Context, resp *assistant_service.Assistan
 	if err != nil {
 		return nil, err
 	}
+	memoryConfig := request.AssistantMemoryConfig{
+		MaxTurns:      resp.MemoryConfig.GetMaxTurns(),
+		MaxTokens:     resp.MemoryConfig.GetMaxTokens(),
+		SummaryEnable: resp.MemoryConfig.GetSummaryEnable(),
+	}
 	assistantModel := response.Assistant{
 		AssistantId:         resp.AssistantId,
 		AppBriefConfig:      appBriefConfigProto2Model(ctx, resp.AssistantBrief, constant.AppTypeAgent),
@@ -597,6 +642,7 @@ func transAssistantResp2Model(ctx *gin.Context, resp *assistant_service.Assistan
 		RerankConfig:        rerankConfig,
 		OnlineSearchConfig:  onlineSearchConfig,
 		SafetyConfig:        request.AppSafetyConfig{Enable: resp.SafetyConfig.GetEnable()},
+		MemoryConfig:        memoryConfig,
 		Scope:               resp.Scope,
 		WorkFlowInfos:       assistantWorkFlowInfos,
 		MCPInfos:            assistantMCPInfos,
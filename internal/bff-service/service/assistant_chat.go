@@ -25,15 +25,81 @@ func AssistantConversionStream(ctx *gin.Context, userId, orgId string, req reque
 		return err
 	}
 	// 2. 流式返回结果
-	_ = sse_util.NewSSEWriter(ctx, fmt.Sprintf("[Agent] %v conversation %v user %v org %v recv", req.AssistantId, req.ConversationId, userId, orgId), sse_util.DONE_MSG).
-		WriteStream(chatCh, nil, buildAgentChatRespLineProcessor(), nil)
+	writeAssistantConversationStream(ctx, req.AssistantId, req.ConversationId, userId, orgId, chatCh)
+	return nil
+}
+
+// AssistantConversionRegenerate 重新生成某轮回答
+func AssistantConversionRegenerate(ctx *gin.Context, userId, orgId string, req request.ConversationRegenerateRequest) error {
+	chatCh, err := callAssistantStream(ctx, req.AssistantId, req.ConversationId, "", userId, orgId, func(string) (assistant_service.AssistantService_AssistantConversionStreamClient, error) {
+		return assistant.AssistantConversionRegenerate(ctx.Request.Context(), &assistant_service.AssistantConversionRegenerateReq{
+			AssistantId:    req.AssistantId,
+			ConversationId: req.ConversationId,
+			DetailId:       req.DetailId,
+			Identity: &assistant_service.Identity{
+				UserId: userId,
+				OrgId:  orgId,
+			},
+		})
+	})
+	if err != nil {
+		return err
+	}
+	writeAssistantConversationStream(ctx, req.AssistantId, req.ConversationId, userId, orgId, chatCh)
 	return nil
 }
 
+// AssistantConversionEdit 编辑某轮问题并从该处分叉
+func AssistantConversionEdit(ctx *gin.Context, userId, orgId string, req request.ConversationEditRequest) error {
+	chatCh, err := callAssistantStream(ctx, req.AssistantId, req.ConversationId, req.Prompt, userId, orgId, func(prompt string) (assistant_service.AssistantService_AssistantConversionStreamClient, error) {
+		return assistant.AssistantConversionEdit(ctx.Request.Context(), &assistant_service.AssistantConversionEditReq{
+			AssistantId:    req.AssistantId,
+			ConversationId: req.ConversationId,
+			DetailId:       req.DetailId,
+			Prompt:         prompt,
+			Identity: &assistant_service.Identity{
+				UserId: userId,
+				OrgId:  orgId,
+			},
+		})
+	})
+	if err != nil {
+		return err
+	}
+	writeAssistantConversationStream(ctx, req.AssistantId, req.ConversationId, userId, orgId, chatCh)
+	return nil
+}
+
+func writeAssistantConversationStream(ctx *gin.Context, assistantId, conversationId, userId, orgId string, chatCh <-chan string) {
+	_ = sse_util.NewSSEWriter(ctx, fmt.Sprintf("[Agent] %v conversation %v user %v org %v recv", assistantId, conversationId, userId, orgId), sse_util.DONE_MSG).
+		WriteStream(chatCh, nil, buildAgentChatRespLineProcessor(), nil)
+}
+
 func CallAssistantConversationStream(ctx *gin.Context, userId, orgId string, req request.ConversionStreamRequest) (<-chan string, error) {
+	return callAssistantStream(ctx, req.AssistantId, req.ConversationId, req.Prompt, userId, orgId, func(prompt string) (assistant_service.AssistantService_AssistantConversionStreamClient, error) {
+		return assistant.AssistantConversionStream(ctx.Request.Context(), &assistant_service.AssistantConversionStreamReq{
+			AssistantId:    req.AssistantId,
+			ConversationId: req.ConversationId,
+			FileInfo: &assistant_service.ConversionStreamFile{
+				FileName: req.FileInfo.FileName,
+				FileSize: req.FileInfo.FileSize,
+				FileUrl:  req.FileInfo.FileUrl,
+			},
+			Trial:  req.Trial,
+			Prompt: prompt,
+			Identity: &assistant_service.Identity{
+				UserId: userId,
+				OrgId:  orgId,
+			},
+		})
+	})
+}
+
+// callAssistantStream 校验问题敏感词、执行护栏输入检测（prompt为空时跳过）后以处理后的问题调用智能体流式接口，并对回答做护栏检测与敏感词过滤
+func callAssistantStream(ctx *gin.Context, assistantId, conversationId, prompt, userId, orgId string, call func(prompt string) (assistant_service.AssistantService_AssistantConversionStreamClient, error)) (<-chan string, error) {
 	// 根据agentID获取敏感词配置
 	agentInfo, err := assistant.GetAssistantInfo(ctx, &assistant_service.GetAssistantInfoReq{
-		AssistantId: req.AssistantId,
+		AssistantId: assistantId,
 	})
 	if err != nil {
 		return nil, err
@@ -50,32 +116,29 @@ func CallAssistantConversationStream(ctx *gin.Context, userId, orgId string, req
 		if err != nil {
 			return nil, err
 		}
-		matchResults, err := ahocorasick.ContentMatch(req.Prompt, matchDicts, true)
-		if err != nil {
-			return nil, err
-		}
-		if len(matchResults) > 0 {
-			if matchResults[0].Reply != "" {
-				return nil, grpc_util.ErrorStatusWithKey(err_code.Code_BFFSensitiveWordCheck, "bff_sensitive_check_req", matchResults[0].Reply)
+		if prompt != "" {
+			matchResults, err := ahocorasick.ContentMatch(prompt, matchDicts, true)
+			if err != nil {
+				return nil, err
+			}
+			if len(matchResults) > 0 {
+				if matchResults[0].Reply != "" {
+					return nil, grpc_util.ErrorStatusWithKey(err_code.Code_BFFSensitiveWordCheck, "bff_sensitive_check_req", matchResults[0].Reply)
+				}
+				return nil, grpc_util.ErrorStatusWithKey(err_code.Code_BFFSensitiveWordCheck, "bff_sensitive_check_req_default_reply")
 			}
-			return nil, grpc_util.ErrorStatusWithKey(err_code.Code_BFFSensitiveWordCheck, "bff_sensitive_check_req_default_reply")
 		}
 	}
-	stream, err := assistant.AssistantConversionStream(ctx.Request.Context(), &assistant_service.AssistantConversionStreamReq{
-		AssistantId:    req.AssistantId,
-		ConversationId: req.ConversationId,
-		FileInfo: &assistant_service.ConversionStreamFile{
-			FileName: req.FileInfo.FileName,
-			FileSize: req.FileInfo.FileSize,
-			FileUrl:  req.FileInfo.FileUrl,
-		},
-		Trial:  req.Trial,
-		Prompt: req.Prompt,
-		Identity: &assistant_service.Identity{
-			UserId: userId,
-			OrgId:  orgId,
-		},
-	})
+	// 护栏策略
+	guard, err := buildGuardrail(ctx, assistantId, constant.AppTypeAgent)
+	if err != nil {
+		return nil, err
+	}
+	prompt, err = guard.checkInput(ctx, prompt)
+	if err != nil {
+		return nil, err
+	}
+	stream, err := call(prompt)
 	if err != nil {
 		return nil, err
 	}
@@ -84,25 +147,27 @@ func CallAssistantConversationStream(ctx *gin.Context, userId, orgId string, req
 	go func() {
 		defer util.PrintPanicStack()
 		defer close(rawCh)
-		log.Infof("[Agent] %v conversation %v user %v org %v start, query: %s", req.AssistantId, req.ConversationId, userId, orgId, req.Prompt)
+		log.Infof("[Agent] %v conversation %v user %v org %v start, query: %s", assistantId, conversationId, userId, orgId, prompt)
 		for {
 			s, err := stream.Recv()
 			if err == io.EOF {
-				log.Infof("[Agent] %v conversation %v user %v org %v stop", req.AssistantId, req.ConversationId, userId, orgId)
+				log.Infof("[Agent] %v conversation %v user %v org %v stop", assistantId, conversationId, userId, orgId)
 				break
 			}
 			if err != nil {
-				log.Errorf("[Agent] %v conversation %v user %v org %v recv err: %v", req.AssistantId, req.ConversationId, userId, orgId, err)
+				log.Errorf("[Agent] %v conversation %v user %v org %v recv err: %v", assistantId, conversationId, userId, orgId, err)
 				break
 			}
 			rawCh <- s.Content
 		}
 	}()
+	// 护栏输出检测
+	chatCh := guard.processOutput(ctx, rawCh, &agentSensitiveService{})
 	if !agentInfo.SafetyConfig.GetEnable() {
-		return rawCh, nil
+		return chatCh, nil
 	}
 	// 敏感词过滤
-	outputCh := ProcessSensitiveWords(ctx, rawCh, matchDicts, &agentSensitiveService{})
+	outputCh := ProcessSensitiveWords(ctx, chatCh, matchDicts, &agentSensitiveService{})
 	return outputCh, nil
 }
 
@@ -145,6 +210,11 @@ func (s *agentSensitiveService) parseContent(raw string) (id, content string) {
 	return resp.MsgID, resp.Response
 }
 
+// replaceContent implements ChatService.
+func (s *agentSensitiveService) replaceContent(raw, content string) (string, bool) {
+	return replaceStreamContent(raw, content, "response")
+}
+
 // buildSensitiveResp implements ChatService.
 func (s *agentSensitiveService) buildSensitiveResp(id string, content string) []string {
 	resp := map[string]interface{}{
@@ -0,0 +1,249 @@
+package service
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	assistant_service "github.com/UnicomAI/wanwu/api/proto/assistant-service"
+	err_code "github.com/UnicomAI/wanwu/api/proto/err-code"
+	"github.com/UnicomAI/wanwu/internal/bff-service/model/request"
+	"github.com/UnicomAI/wanwu/internal/bff-service/model/response"
+	grpc_util "github.com/UnicomAI/wanwu/pkg/grpc-util"
+	"github.com/UnicomAI/wanwu/pkg/log"
+	"github.com/gin-gonic/gin"
+)
+
+const conversationImportMaxLineSize = 16 * 1024 * 1024
+
+// ConversationExport 导出对话为JSONL或Markdown文件，边读取边写入响应
+func ConversationExport(ctx *gin.Context, userId, orgId string, req request.ConversationExportRequest) error {
+	return conversationExport(ctx, &assistant_service.ConversationExportReq{
+		AssistantId:    req.AssistantId,
+		ConversationId: req.ConversationId,
+		StartTime:      req.StartTime,
+		EndTime:        req.EndTime,
+		Identity: &assistant_service.Identity{
+			UserId: userId,
+			OrgId:  orgId,
+		},
+	}, req.Format)
+}
+
+// ConversationImport 导入JSONL文件，文件中每个对话导入为智能体下的一个新对话
+func ConversationImport(ctx *gin.Context, userId, orgId string, req request.ConversationImportRequest) (*response.ConversationImportResp, error) {
+	fileHeader, err := ctx.FormFile("file")
+	if err != nil {
+		return nil, grpc_util.ErrorStatusWithKey(err_code.Code_BFFGeneral, "bff_conversation_import_file", fmt.Sprintf("get file err: %v", err))
+	}
+	file, err := fileHeader.Open()
+	if err != nil {
+		return nil, grpc_util.ErrorStatusWithKey(err_code.Code_BFFGeneral, "bff_conversation_import_file", fmt.Sprintf("open file err: %v", err))
+	}
+	defer file.Close()
+	conversations, err := readConversationImportFile(file)
+	if err != nil {
+		return nil, grpc_util.ErrorStatusWithKey(err_code.Code_BFFGeneral, "bff_conversation_import_file", err.Error())
+	}
+	resp, err := assistant.ConversationImport(ctx.Request.Context(), &assistant_service.ConversationImportReq{
+		AssistantId:   req.AssistantId,
+		Conversations: conversations,
+		Identity: &assistant_service.Identity{
+			UserId: userId,
+			OrgId:  orgId,
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &response.ConversationImportResp{ConversationIds: resp.ConversationIds}, nil
+}
+
+// --- internal ---
+
+func conversationExport(ctx *gin.Context, req *assistant_service.ConversationExportReq, format string) error {
+	stream, err := assistant.ConversationExport(ctx.Request.Context(), req)
+	if err != nil {
+		return err
+	}
+	// 收到首条数据前出错时返回错误信息，之后出错只能中断下载
+	resp, err := stream.Recv()
+	if err != nil && err != io.EOF {
+		return err
+	}
+
+	fileName := fmt.Sprintf("conversation_export_%s", time.Now().Format("20060102150405"))
+	writer := newConversationExportWriter(format, ctx.Writer)
+	ctx.Header("Content-Disposition", "attachment; filename*=utf-8''"+url.QueryEscape(fileName+writer.ext()))
+	ctx.Header("Content-Type", "application/octet-stream")
+	ctx.Header("Access-Control-Expose-Headers", "Content-Disposition")
+	ctx.Status(http.StatusOK)
+	for resp != nil {
+		for _, detail := range resp.Data {
+			if err := writer.write(resp.Title, detail); err != nil {
+				log.Errorf("[Agent] export conversation %v write err: %v", resp.ConversationId, err)
+				return nil
+			}
+		}
+		ctx.Writer.Flush()
+		if resp, err = stream.Recv(); err != nil {
+			if err != io.EOF {
+				log.Errorf("[Agent] export assistant %v conversation %v recv err: %v", req.AssistantId, req.ConversationId, err)
+			}
+			break
+		}
+	}
+	return nil
+}
+
+type conversationExportWriter struct {
+	format         string
+	w              io.Writer
+	conversationId string
+	turn           int
+}
+
+func newConversationExportWriter(format string, w io.Writer) *conversationExportWriter {
+	return &conversationExportWriter{format: format, w: w}
+}
+
+func (w *conversationExportWriter) ext() string {
+	if w.format == request.ConversationExportFormatMarkdown {
+		return ".md"
+	}
+	return ".jsonl"
+}
+
+func (w *conversationExportWriter) write(title string, detail *assistant_service.ConversionDetailInfo) error {
+	var fileUrl string
+	if len(detail.RequestFileUrls) > 0 {
+		fileUrl = detail.RequestFileUrls[0]
+	}
+	if w.format == request.ConversationExportFormatMarkdown {
+		return w.writeMarkdown(title, fileUrl, detail)
+	}
+	b, err := json.Marshal(response.ConversationExportRecord{
+		ConversationId: detail.ConversationId,
+		Title:          title,
+		Id:             detail.Id,
+		ParentId:       detail.ParentId,
+		Prompt:         detail.Prompt,
+		Response:       detail.Response,
+		SearchList:     unmarshalSearchList(detail.SearchList),
+		FileName:       detail.FileName,
+		FileSize:       detail.FileSize,
+		FileUrl:        fileUrl,
+		CreatedAt:      detail.CreatedAt,
+		UpdatedAt:      detail.UpdatedAt,
+	})
+	if err != nil {
+		return err
+	}
+	_, err = w.w.Write(append(b, '\n'))
+	return err
+}
+
+func (w *conversationExportWriter) writeMarkdown(title, fileUrl string, detail *assistant_service.ConversionDetailInfo) error {
+	var sb strings.Builder
+	if detail.ConversationId != w.conversationId {
+		w.conversationId = detail.ConversationId
+		w.turn = 0
+		sb.WriteString(fmt.Sprintf("# %s\n\n", title))
+	}
+	w.turn++
+	sb.WriteString(fmt.Sprintf("## 第%d轮 %s\n\n", w.turn, time.UnixMilli(detail.CreatedAt).Format("2006-01-02 15:04:05")))
+	sb.WriteString(fmt.Sprintf("**用户：** %s\n\n", detail.Prompt))
+	if fileUrl != "" {
+		sb.WriteString(fmt.Sprintf("**附件：** [%s](%s)\n\n", detail.FileName, fileUrl))
+	}
+	sb.WriteString(fmt.Sprintf("**助手：**\n\n%s\n\n", detail.Response))
+	if searchList, ok := unmarshalSearchList(detail.SearchList).([]interface{}); ok && len(searchList) > 0 {
+		sb.WriteString("**引用：**\n\n")
+		for i, search := range searchList {
+			sb.WriteString(fmt.Sprintf("%d. %s\n", i+1, markdownSearchItem(search)))
+		}
+		sb.WriteString("\n")
+	}
+	_, err := io.WriteString(w.w, sb.String())
+	return err
+}
+
+// markdownSearchItem 知识片段显示为“标题（知识库）：片段”，字段缺失时原样输出
+func markdownSearchItem(search interface{}) string {
+	item, ok := search.(map[string]interface{})
+	if !ok {
+		b, _ := json.Marshal(search)
+		return string(b)
+	}
+	title, _ := item["title"].(string)
+	kbName, _ := item["kb_name"].(string)
+	snippet, _ := item["snippet"].(string)
+	if title == "" && snippet == "" {
+		b, _ := json.Marshal(search)
+		return string(b)
+	}
+	ret := title
+	if kbName != "" {
+		ret += "（" + kbName + "）"
+	}
+	if snippet != "" {
+		ret += "：" + strings.Join(strings.Fields(snippet), " ")
+	}
+	return ret
+}
+
+// readConversationImportFile 读取JSONL导入文件，按conversationId分组（保持首次出现的顺序）
+func readConversationImportFile(r io.Reader) ([]*assistant_service.ConversationImportItem, error) {
+	var items []*assistant_service.ConversationImportItem
+	groups := make(map[string]*assistant_service.ConversationImportItem)
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 0, 64*1024), conversationImportMaxLineSize)
+	var lineNo int
+	for scanner.Scan() {
+		lineNo++
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" {
+			continue
+		}
+		record := response.ConversationExportRecord{}
+		if err := json.Unmarshal([]byte(line), &record); err != nil {
+			return nil, fmt.Errorf("line %v unmarshal err: %v", lineNo, err)
+		}
+		if record.Prompt == "" && record.Response == "" {
+			return nil, fmt.Errorf("line %v prompt and response empty", lineNo)
+		}
+		item, ok := groups[record.ConversationId]
+		if !ok {
+			item = &assistant_service.ConversationImportItem{Title: record.Title}
+			groups[record.ConversationId] = item
+			items = append(items, item)
+		}
+		detail := &assistant_service.ConversionDetailInfo{
+			Id:         record.Id,
+			ParentId:   record.ParentId,
+			Prompt:     record.Prompt,
+			Response:   record.Response,
+			SearchList: marshalSearchList(record.SearchList),
+			FileName:   record.FileName,
+			FileSize:   record.FileSize,
+			CreatedAt:  record.CreatedAt,
+			UpdatedAt:  record.UpdatedAt,
+		}
+		if record.FileUrl != "" {
+			detail.RequestFileUrls = []string{record.FileUrl}
+		}
+		item.Data = append(item.Data, detail)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("read file err: %v", err)
+	}
+	if len(items) == 0 {
+		return nil, fmt.Errorf("file empty")
+	}
+	return items, nil
+}
@@ -0,0 +1,222 @@
+package service
+
+import (
+	"context"
+	"encoding/csv"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"sync"
+	"time"
+
+	err_code "github.com/UnicomAI/wanwu/api/proto/err-code"
+	iam_service "github.com/UnicomAI/wanwu/api/proto/iam-service"
+	operate_service "github.com/UnicomAI/wanwu/api/proto/operate-service"
+	"github.com/UnicomAI/wanwu/internal/bff-service/model/request"
+	"github.com/UnicomAI/wanwu/internal/bff-service/model/response"
+	grpc_util "github.com/UnicomAI/wanwu/pkg/grpc-util"
+	"github.com/UnicomAI/wanwu/pkg/log"
+	"github.com/UnicomAI/wanwu/pkg/util"
+	"github.com/gin-gonic/gin"
+)
+
+const (
+	auditLogQueueSize    = 4096
+	auditLogWriters      = 4
+	auditLogWriteTimeout = 5 * time.Second
+)
+
+var auditLogExportHeader = []string{"操作时间", "组织ID", "操作人ID", "操作人", "权限标识", "操作", "请求方法", "请求路径", "操作对象ID", "结果", "返回码", "失败原因", "客户端IP", "请求参数"}
+
+var _auditLogWriter = &auditLogWriter{}
+
+// auditLogWriter 审计日志异步写入，请求结束时写入队列，由后台协程写入operate-service
+type auditLogWriter struct {
+	mutex   sync.Mutex
+	queue   chan *operate_service.AuditLog
+	wg      sync.WaitGroup
+	stopped bool
+}
+
+// StartAuditLogWriter 启动审计日志异步写入
+func StartAuditLogWriter() {
+	w := _auditLogWriter
+	w.mutex.Lock()
+	defer w.mutex.Unlock()
+	if w.queue != nil {
+		return
+	}
+	w.queue = make(chan *operate_service.AuditLog, auditLogQueueSize)
+	for i := 0; i < auditLogWriters; i++ {
+		w.wg.Add(1)
+		go func() {
+			defer util.PrintPanicStack()
+			defer w.wg.Done()
+			for auditLog := range w.queue {
+				if err := w.write(auditLog); err != nil {
+					log.Errorf("record audit log [%v]%v user %v org %v err: %v", auditLog.Method, auditLog.Path, auditLog.UserId, auditLog.OrgId, err)
+				}
+			}
+		}()
+	}
+}
+
+// StopAuditLogWriter 停止接收审计日志，并等待队列中的审计日志写入完成
+func StopAuditLogWriter() {
+	w := _auditLogWriter
+	w.mutex.Lock()
+	if w.queue == nil || w.stopped {
+		w.mutex.Unlock()
+		return
+	}
+	w.stopped = true
+	close(w.queue)
+	w.mutex.Unlock()
+	w.wg.Wait()
+}
+
+// CreateAuditLog 审计日志写入队列，队列已满时同步写入，避免丢失审计日志；已停止时返回错误
+func CreateAuditLog(auditLog *operate_service.AuditLog) error {
+	w := _auditLogWriter
+	w.mutex.Lock()
+	if w.queue == nil || w.stopped {
+		w.mutex.Unlock()
+		return fmt.Errorf("audit log writer not running")
+	}
+	select {
+	case w.queue <- auditLog:
+		w.mutex.Unlock()
+		return nil
+	default:
+	}
+	w.mutex.Unlock()
+	log.Warnf("audit log queue full, write [%v]%v user %v org %v synchronously", auditLog.Method, auditLog.Path, auditLog.UserId, auditLog.OrgId)
+	return w.write(auditLog)
+}
+
+func (w *auditLogWriter) write(auditLog *operate_service.AuditLog) error {
+	ctx, cancel := context.WithTimeout(context.Background(), auditLogWriteTimeout)
+	defer cancel()
+	_, err := operate.CreateAuditLog(ctx, &operate_service.CreateAuditLogReq{AuditLog: auditLog})
+	return err
+}
+
+// GetAuditLogList orgId为空时查询全部组织（系统视角）
+func GetAuditLogList(ctx *gin.Context, orgId string, req *request.AuditLogListRequest) (*response.PageResult, error) {
+	if req.PageNo < 1 {
+		req.PageNo = 1
+	}
+	resp, err := operate.GetAuditLogList(ctx.Request.Context(), &operate_service.GetAuditLogListReq{
+		Filter:   toAuditLogFilter(orgId, req.AuditLogFilter),
+		PageNo:   int32(req.PageNo),
+		PageSize: int32(req.PageSize),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &response.PageResult{
+		List:     toAuditLogInfos(ctx, resp.AuditLogs),
+		Total:    resp.Total,
+		PageNo:   req.PageNo,
+		PageSize: req.PageSize,
+	}, nil
+}
+
+// ExportAuditLog 按条件导出审计日志为csv文件
+func ExportAuditLog(ctx *gin.Context, orgId string, req *request.AuditLogExportRequest) error {
+	resp, err := operate.ExportAuditLog(ctx.Request.Context(), &operate_service.ExportAuditLogReq{
+		Filter: toAuditLogFilter(orgId, req.AuditLogFilter),
+	})
+	if err != nil {
+		return err
+	}
+	// 超过单次导出上限时不截断导出，提示缩小时间范围
+	if resp.Total > int64(len(resp.AuditLogs)) {
+		return grpc_util.ErrorStatusWithKey(err_code.Code_BFFGeneral, "bff_audit_log_export_exceed", strconv.FormatInt(resp.Total, 10), strconv.Itoa(len(resp.AuditLogs)))
+	}
+
+	fileName := fmt.Sprintf("audit_log_%s.csv", time.Now().Format("20060102150405"))
+	ctx.Header("Content-Disposition", "attachment; filename*=utf-8''"+url.QueryEscape(fileName))
+	ctx.Header("Content-Type", "text/csv; charset=utf-8")
+	ctx.Header("Access-Control-Expose-Headers", "Content-Disposition")
+	ctx.Status(http.StatusOK)
+	// utf-8 bom，避免excel打开中文乱码
+	if _, err := ctx.Writer.Write([]byte("\xEF\xBB\xBF")); err != nil {
+		log.Errorf("export audit log org %v write err: %v", orgId, err)
+		return nil
+	}
+	writer := csv.NewWriter(ctx.Writer)
+	_ = writer.Write(auditLogExportHeader)
+	for _, auditLog := range toAuditLogInfos(ctx, resp.AuditLogs) {
+		result := "成功"
+		if !auditLog.Success {
+			result = "失败"
+		}
+		_ = writer.Write([]string{
+			auditLog.CreatedAt, auditLog.OrgId, auditLog.UserId, auditLog.UserName, auditLog.Action, auditLog.ActionName,
+			auditLog.Method, auditLog.Path, auditLog.TargetId, result, strconv.FormatInt(auditLog.Code, 10),
+			auditLog.ErrMsg, auditLog.ClientIp, auditLog.Request,
+		})
+	}
+	writer.Flush()
+	if err := writer.Error(); err != nil {
+		log.Errorf("export audit log org %v write err: %v", orgId, err)
+	}
+	return nil
+}
+
+// --- internal ---
+
+func toAuditLogFilter(orgId string, filter request.AuditLogFilter) *operate_service.AuditLogFilter {
+	return &operate_service.AuditLogFilter{
+		OrgId:     orgId,
+		UserId:    filter.UserId,
+		Action:    filter.Action,
+		TargetId:  filter.TargetId,
+		Result:    filter.Result,
+		StartTime: filter.StartTime,
+		EndTime:   filter.EndTime,
+	}
+}
+
+func toAuditLogInfos(ctx *gin.Context, auditLogs []*operate_service.AuditLog) []*response.AuditLogInfo {
+	userNames := make(map[string]string)
+	var userIds []string
+	for _, auditLog := range auditLogs {
+		if _, ok := userNames[auditLog.UserId]; !ok && auditLog.UserId != "" {
+			userNames[auditLog.UserId] = ""
+			userIds = append(userIds, auditLog.UserId)
+		}
+	}
+	if len(userIds) > 0 {
+		if users, err := iam.GetUserSelectByUserIDs(ctx.Request.Context(), &iam_service.GetUserSelectByUserIDsReq{UserIds: userIds}); err != nil {
+			log.Warnf("audit log get user select err: %v", err)
+		} else {
+			for _, user := range users.Selects {
+				userNames[user.Id] = user.Name
+			}
+		}
+	}
+	ret := make([]*response.AuditLogInfo, 0, len(auditLogs))
+	for _, auditLog := range auditLogs {
+		ret = append(ret, &response.AuditLogInfo{
+			AuditId:    auditLog.AuditId,
+			OrgId:      auditLog.OrgId,
+			UserId:     auditLog.UserId,
+			UserName:   userNames[auditLog.UserId],
+			Action:     auditLog.Action,
+			ActionName: auditLog.ActionName,
+			Method:     auditLog.Method,
+			Path:       auditLog.Path,
+			TargetId:   auditLog.TargetId,
+			Success:    auditLog.Success,
+			Code:       auditLog.Code,
+			ErrMsg:     auditLog.ErrMsg,
+			ClientIp:   auditLog.ClientIp,
+			Request:    auditLog.Request,
+			CreatedAt:  util.Time2Str(auditLog.CreatedAt),
+		})
+	}
+	return ret
+}
@@ -0,0 +1,173 @@
+package service
+
+import (
+	"encoding/json"
+
+	assistant_service "github.com/UnicomAI/wanwu/api/proto/assistant-service"
+	err_code "github.com/UnicomAI/wanwu/api/proto/err-code"
+	rag_service "github.com/UnicomAI/wanwu/api/proto/rag-service"
+	"github.com/UnicomAI/wanwu/internal/bff-service/model/request"
+	"github.com/UnicomAI/wanwu/internal/bff-service/model/response"
+	"github.com/UnicomAI/wanwu/pkg/constant"
+	grpc_util "github.com/UnicomAI/wanwu/pkg/grpc-util"
+	"github.com/UnicomAI/wanwu/pkg/log"
+	"github.com/gin-gonic/gin"
+)
+
+func ConversationFeedbackSubmit(ctx *gin.Context, userId, orgId string, req request.ConversationFeedbackRequest) error {
+	_, err := assistant.ConversationFeedbackSubmit(ctx.Request.Context(), &assistant_service.ConversationFeedbackSubmitReq{
+		ConversationId: req.ConversationId,
+		DetailId:       req.DetailId,
+		Rating:         req.Rating,
+		Reasons:        req.Reasons,
+		Content:        req.Content,
+		Identity: &assistant_service.Identity{
+			UserId: userId,
+			OrgId:  orgId,
+		},
+	})
+	return err
+}
+
+func GetConversationFeedbackList(ctx *gin.Context, userId, orgId string, admin bool, req request.ConversationFeedbackListRequest) (*response.PageResult, error) {
+	if err := checkFeedbackListAccess(ctx, userId, orgId, admin, req.AssistantId, constant.AppTypeAgent); err != nil {
+		return nil, err
+	}
+	resp, err := assistant.GetConversationFeedbackList(ctx.Request.Context(), &assistant_service.GetConversationFeedbackListReq{
+		AssistantId: req.AssistantId,
+		Rating:      req.Rating,
+		StartTime:   req.StartTime,
+		EndTime:     req.EndTime,
+		PageNo:      int32(req.PageNo),
+		PageSize:    int32(req.PageSize),
+		Identity: &assistant_service.Identity{
+			UserId: userId,
+			OrgId:  orgId,
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	list := make([]response.ConversationFeedbackInfo, 0, len(resp.Data))
+	for _, item := range resp.Data {
+		list = append(list, response.ConversationFeedbackInfo{
+			FeedbackId:     item.FeedbackId,
+			AssistantId:    item.AssistantId,
+			ConversationId: item.ConversationId,
+			DetailId:       item.DetailId,
+			Rating:         item.Rating,
+			Reasons:        item.Reasons,
+			Content:        item.Content,
+			Prompt:         item.Prompt,
+			Response:       item.Response,
+			SearchList:     unmarshalSearchList(item.SearchList),
+			UserId:         item.UserId,
+			CreatedAt:      item.CreatedAt,
+			UpdatedAt:      item.UpdatedAt,
+		})
+	}
+	return &response.PageResult{
+		List:     list,
+		Total:    resp.Total,
+		PageNo:   req.PageNo,
+		PageSize: req.PageSize,
+	}, nil
+}
+
+func RagFeedbackSubmit(ctx *gin.Context, userId, orgId string, req request.RagFeedbackRequest) error {
+	_, err := rag.ChatRagFeedback(ctx.Request.Context(), &rag_service.ChatRagFeedbackReq{
+		RagId:   req.RagID,
+		MsgId:   req.MsgID,
+		Rating:  req.Rating,
+		Reasons: req.Reasons,
+		Content: req.Content,
+		Identity: &rag_service.Identity{
+			UserId: userId,
+			OrgId:  orgId,
+		},
+	})
+	return err
+}
+
+func GetRagFeedbackList(ctx *gin.Context, userId, orgId string, admin bool, req request.RagFeedbackListRequest) (*response.PageResult, error) {
+	if err := checkFeedbackListAccess(ctx, userId, orgId, admin, req.RagID, constant.AppTypeRag); err != nil {
+		return nil, err
+	}
+	resp, err := rag.ListRagFeedback(ctx.Request.Context(), &rag_service.ListRagFeedbackReq{
+		RagId:     req.RagID,
+		Rating:    req.Rating,
+		StartTime: req.StartTime,
+		EndTime:   req.EndTime,
+		PageNo:    int32(req.PageNo),
+		PageSize:  int32(req.PageSize),
+		Identity: &rag_service.Identity{
+			UserId: userId,
+			OrgId:  orgId,
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	list := make([]response.RagFeedbackInfo, 0, len(resp.Data))
+	for _, item := range resp.Data {
+		list = append(list, response.RagFeedbackInfo{
+			FeedbackID: item.FeedbackId,
+			RagID:      item.RagId,
+			MsgID:      item.MsgId,
+			Rating:     item.Rating,
+			Reasons:    item.Reasons,
+			Content:    item.Content,
+			Question:   item.Question,
+			Answer:     item.Answer,
+			SearchList: unmarshalSearchList(item.SearchList),
+			UserID:     item.UserId,
+			CreatedAt:  item.CreatedAt,
+			UpdatedAt:  item.UpdatedAt,
+		})
+	}
+	return &response.PageResult{
+		List:     list,
+		Total:    resp.Total,
+		PageNo:   req.PageNo,
+		PageSize: req.PageSize,
+	}, nil
+}
+
+// --- internal ---
+
+// checkFeedbackListAccess 组织管理员可查询组织内所有应用收到的评价，其他用户须指定自己创建的应用
+func checkFeedbackListAccess(ctx *gin.Context, userId, orgId string, admin bool, appId, appType string) error {
+	if admin {
+		return nil
+	}
+	if appId == "" {
+		return grpc_util.ErrorStatusWithKey(err_code.Code_BFFGeneral, "bff_feedback_list_app_required")
+	}
+	return checkAppOwner(ctx, userId, orgId, appId, appType)
+}
+
+func marshalSearchList(searchList interface{}) string {
+	switch v := searchList.(type) {
+	case nil:
+		return ""
+	case string:
+		return v
+	}
+	b, err := json.Marshal(searchList)
+	if err != nil {
+		log.Warnf("marshal feedback searchList err: %v", err)
+		return ""
+	}
+	return string(b)
+}
+
+func unmarshalSearchList(searchList string) interface{} {
+	if searchList == "" {
+		return nil
+	}
+	var ret interface{}
+	if err := json.Unmarshal([]byte(searchList), &ret); err != nil {
+		return searchList
+	}
+	return ret
+}
@@ -3,10 +3,6 @@ package service
 import (
 	"encoding/json"
 	"fmt"
-	"os"
-	"sort"
-	"strconv"
-	"strings"
 	"time"
 
 	errs "github.com/UnicomAI/wanwu/api/proto/err-code"
@@ -17,24 +13,34 @@ import (
 	http_client "github.com/UnicomAI/wanwu/pkg/http-client"
 	"github.com/UnicomAI/wanwu/pkg/log"
 	"github.com/UnicomAI/wanwu/pkg/minio"
-	"github.com/UnicomAI/wanwu/pkg/util"
+	"github.com/UnicomAI/wanwu/pkg/redis"
 	"github.com/gin-gonic/gin"
 )
 
 const (
 	FileUploadCheckFileStatusFailed  = 0
 	FileUploadCheckFileStatusSuccess = 1
-	FileUploadTmpLocalDir            = "tmp"
+	// FileUploadMaxSequence minio分片上传的最大分片号
+	FileUploadMaxSequence = 10000
 )
 
 func CheckFile(ctx *gin.Context, r *request.CheckFileReq) (*response.CheckFileResp, error) {
-	exist, err := util.FileExist(BuildUploadFilePath(r.FileName, r.Sequence, r.ChunkName))
+	if err := checkFileUploadAvailable(); err != nil {
+		return nil, err
+	}
+	session, err := getFileUploadSession(ctx, r.ChunkName)
 	if err != nil {
 		return nil, grpc_util.ErrorStatusWithKey(errs.Code_BFFGeneral, "bff_file_upload_check", err.Error())
 	}
-	status := FileUploadCheckFileStatusSuccess
-	if !exist {
-		status = FileUploadCheckFileStatusFailed
+	status := FileUploadCheckFileStatusFailed
+	if session != nil {
+		exist, err := existFileUploadPart(ctx, session, r.Sequence)
+		if err != nil {
+			return nil, grpc_util.ErrorStatusWithKey(errs.Code_BFFGeneral, "bff_file_upload_check", err.Error())
+		}
+		if exist {
+			status = FileUploadCheckFileStatusSuccess
+		}
 	}
 	return &response.CheckFileResp{
 		Status: status,
@@ -42,12 +48,17 @@ func CheckFile(ctx *gin.Context, r *request.CheckFileReq) (*response.CheckFileRe
 }
 
 func CheckFileList(ctx *gin.Context, r *request.CheckFileListReq) (*response.CheckFileListResp, error) {
-	dirPath := BuildUploadFilePathDir(r.ChunkName)
-	list, err := util.DirFileList(dirPath, false, false)
+	if err := checkFileUploadAvailable(); err != nil {
+		return nil, err
+	}
+	session, err := getFileUploadSession(ctx, r.ChunkName)
 	if err != nil {
-		return nil, grpc_util.ErrorStatusWithKey(errs.Code_BFFGeneral, "bff_file_upload_file_not_exist", err.Error())
+		return nil, grpc_util.ErrorStatusWithKey(errs.Code_BFFGeneral, "bff_file_upload_check", err.Error())
+	}
+	if session == nil {
+		return nil, grpc_util.ErrorStatusWithKey(errs.Code_BFFGeneral, "bff_file_upload_file_not_exist", fmt.Sprintf("chunk (%v) not exist", r.ChunkName))
 	}
-	sequences, err := BuildUploadFileSeqList(list, false)
+	sequences, _, err := listFileUploadParts(ctx, session)
 	if err != nil {
 		return nil, grpc_util.ErrorStatusWithKey(errs.Code_BFFGeneral, "bff_file_upload_check", err.Error())
 	}
@@ -57,28 +68,18 @@ func CheckFileList(ctx *gin.Context, r *request.CheckFileListReq) (*response.Che
 }
 
 func UploadFile(ctx *gin.Context, r *request.UploadFileReq) (*response.UploadFileResp, error) {
-	var err error
-	defer func() {
-		if err != nil {
-			if err := clearChunkFile(r.FileName, r.Sequence, r.ChunkName); err != nil {
-				log.Errorf("upload file but clear chunk file err: %v", err)
-				return
-			}
-			return
-		}
-	}()
-	defer util.PrintPanicStack()
-
-	filePath := BuildUploadFilePath(r.FileName, r.Sequence, r.ChunkName)
-	exist, err := util.FileExist(filePath)
+	if err := checkFileUploadAvailable(); err != nil {
+		return nil, err
+	}
+	if r.Sequence < 1 || r.Sequence > FileUploadMaxSequence {
+		return nil, grpc_util.ErrorStatusWithKey(errs.Code_BFFGeneral, "bff_file_upload_save", fmt.Sprintf("sequence (%v) out of range [1, %v]", r.Sequence, FileUploadMaxSequence))
+	}
+	session, err := getOrCreateFileUploadSession(ctx, r.ChunkName, r.FileName)
 	if err != nil {
-		return nil, grpc_util.ErrorStatusWithKey(errs.Code_BFFGeneral, "bff_file_upload_file_not_exist", err.Error())
+		return nil, grpc_util.ErrorStatusWithKey(errs.Code_BFFGeneral, "bff_file_upload_save", err.Error())
 	}
-	if !exist {
-		err = saveFileInfo(ctx, filePath)
-		if err != nil {
-			return nil, grpc_util.ErrorStatusWithKey(errs.Code_BFFGeneral, "bff_file_upload_save", err.Error())
-		}
+	if err = saveFileInfo(ctx, session, r.Sequence, r.ChunkTotal); err != nil {
+		return nil, err
 	}
 	return &response.UploadFileResp{
 		Status: FileUploadCheckFileStatusSuccess,
@@ -86,77 +87,60 @@ func UploadFile(ctx *gin.Context, r *request.UploadFileReq) (*response.UploadFil
 }
 
 func MergeFile(ctx *gin.Context, r *request.MergeFileReq) (*response.MergeFileResp, error) {
-	var err error
-	var mergeFilePath = BuildMergeFilePath(r.FileName, r.ChunkName)
-	defer func() {
-		if err != nil {
-			if err := util.DeleteFile(mergeFilePath); err != nil {
-				log.Errorf("merge file but delete file err: %v", err)
-				return
-			}
-			return
-		}
-		if err := clearChunkDir(r.ChunkName); err != nil {
-			log.Errorf("merge file but clear chunk dir err: %v", err)
-			return
-		}
-	}()
-	defer util.PrintPanicStack()
-
-	dir := BuildUploadFilePathDir(r.ChunkName)
-	list, err := util.DirFileList(dir, false, true)
-	if err != nil || len(list) != r.ChunkTotal {
-		return nil, grpc_util.ErrorStatusWithKey(errs.Code_BFFGeneral, "bff_file_upload_check", err.Error())
+	if err := checkFileUploadAvailable(); err != nil {
+		return nil, err
 	}
-	sort.Slice(list, func(i, j int) bool {
-		return list[i] < list[j]
-	})
-	sequences, err := BuildUploadFileSeqList(list, true)
+	session, err := getFileUploadSession(ctx, r.ChunkName)
 	if err != nil {
 		return nil, grpc_util.ErrorStatusWithKey(errs.Code_BFFGeneral, "bff_file_upload_check", err.Error())
-	} else if len(sequences) != r.ChunkTotal {
-		return nil, grpc_util.ErrorStatusWithKey(errs.Code_BFFGeneral, "bff_file_upload_check", fmt.Sprintf("sequences num %v but total chunk %v", len(sequences), r.ChunkTotal))
 	}
-	for i := 1; i <= r.ChunkTotal; i++ {
-		if i != sequences[i-1] {
-			return nil, grpc_util.ErrorStatusWithKey(errs.Code_BFFGeneral, "bff_file_upload_check", "file upload not completed")
-		}
+	if session == nil {
+		return nil, grpc_util.ErrorStatusWithKey(errs.Code_BFFGeneral, "bff_file_upload_file_not_exist", fmt.Sprintf("chunk (%v) not exist", r.ChunkName))
 	}
-	file, err := util.MergeFile(list, mergeFilePath)
+	parts, err := minio.ListFileMultipartParts(ctx, session.ObjectName, session.UploadId)
 	if err != nil {
-		return nil, grpc_util.ErrorStatusWithKey(errs.Code_BFFGeneral, "bff_file_upload_merge", err.Error())
+		return nil, grpc_util.ErrorStatusWithKey(errs.Code_BFFGeneral, "bff_file_upload_check", err.Error())
 	}
-	open, err := os.Open(file.FilePath)
-	if err != nil {
-		return nil, grpc_util.ErrorStatusWithKey(errs.Code_BFFGeneral, "bff_file_upload_file_open", fmt.Sprintf("open file (%v) err: %v", file.FilePath, err))
+	if len(parts) != r.ChunkTotal {
+		return nil, grpc_util.ErrorStatusWithKey(errs.Code_BFFGeneral, "bff_file_upload_check", fmt.Sprintf("sequences num %v but total chunk %v", len(parts), r.ChunkTotal))
 	}
-	defer func() {
-		if err := open.Close(); err != nil {
-			log.Errorf("merge file but close file (%v) err: %v", file.FilePath, err)
-			return
+	var totalSize int64
+	for i, part := range parts {
+		if part.PartNumber != i+1 {
+			return nil, grpc_util.ErrorStatusWithKey(errs.Code_BFFGeneral, "bff_file_upload_check", "file upload not completed")
 		}
-	}()
-	defer util.PrintPanicStack()
-
-	if file.TotalByteCount != r.FileSize {
-		return nil, grpc_util.ErrorStatusWithKey(errs.Code_BFFGeneral, "bff_file_upload_file_merge", fmt.Sprintf("merge file total size (%v) but origin file size (%v)", file.TotalByteCount, r.FileSize))
+		if i < len(parts)-1 && part.Size < minio.MultipartMinPartSize {
+			return nil, grpc_util.ErrorStatusWithKey(errs.Code_BFFGeneral, "bff_file_upload_file_merge", fmt.Sprintf("chunk (%v) size (%v) less than %v", part.PartNumber, part.Size, minio.MultipartMinPartSize))
+		}
+		totalSize += part.Size
 	}
-	fileName, _, err := minio.UploadFileCommon(ctx, open, util.FileExt(file.FilePath), file.TotalByteCount, r.IsExpired)
-	if err != nil {
-		return nil, grpc_util.ErrorStatusWithKey(errs.Code_BFFGeneral, "bff_file_upload_file_merge", fmt.Sprintf("merge file but upload minio err: %v", err))
+	if totalSize != r.FileSize {
+		return nil, grpc_util.ErrorStatusWithKey(errs.Code_BFFGeneral, "bff_file_upload_file_merge", fmt.Sprintf("merge file total size (%v) but origin file size (%v)", totalSize, r.FileSize))
 	}
-	filePath, err := minio.GetUploadFileCommon(ctx, fileName, r.IsExpired)
+	if err = minio.CompleteFileMultipartUpload(ctx, session.FileName, session.ObjectName, session.UploadId, parts, r.IsExpired); err != nil {
+		return nil, grpc_util.ErrorStatusWithKey(errs.Code_BFFGeneral, "bff_file_upload_merge", fmt.Sprintf("merge file but complete minio multipart upload err: %v", err))
+	}
+	if err = deleteFileUploadSession(ctx, session, false); err != nil {
+		log.Errorf("merge file but delete upload session (%v) err: %v", r.ChunkName, err)
+	}
+	filePath, err := minio.GetUploadFileCommon(ctx, session.FileName, r.IsExpired)
 	if err != nil {
 		return nil, grpc_util.ErrorStatusWithKey(errs.Code_BFFGeneral, "bff_file_upload_file_merge", fmt.Sprintf("merge file but get minio file err: %v", err))
 	}
 	return &response.MergeFileResp{
-		FileName: fileName,
+		FileName: session.FileName,
 		FilePath: filePath,
 	}, nil
 }
 
 func CleanFile(ctx *gin.Context, r *request.CleanFileReq) (*response.CleanFileResp, error) {
-	err := clearChunkDir(r.ChunkName)
+	if err := checkFileUploadAvailable(); err != nil {
+		return nil, err
+	}
+	session, err := getFileUploadSession(ctx, r.ChunkName)
+	if err == nil && session != nil {
+		err = deleteFileUploadSession(ctx, session, true)
+	}
 	if err != nil {
 		return nil, grpc_util.ErrorStatusWithKey(errs.Code_BFFGeneral, "bff_file_upload_file_clear", err.Error())
 	}
@@ -181,118 +165,68 @@ func DeleteFile(ctx *gin.Context, r *request.DeleteFileReq) (*response.DeleteFil
 	}, nil
 }
 
-func saveFileInfo(ctx *gin.Context, filePath string) error {
+// saveFileInfo 校验分片大小后将请求中的分片写入minio multipart upload并记录到会话，相同序号重复上传时覆盖
+// checkFileUploadAvailable 分片上传会话依赖redis，redis不可用时拒绝分片上传请求，不影响其他接口
+func checkFileUploadAvailable() error {
+	if redis.FileUpload() == nil {
+		return grpc_util.ErrorStatusWithKey(errs.Code_BFFGeneral, "bff_file_upload_unavailable", "file upload redis not initialized")
+	}
+	return nil
+}
+
+func saveFileInfo(ctx *gin.Context, session *fileUploadSession, sequence, chunkTotal int) error {
 	form, err := ctx.MultipartForm()
 	if err != nil {
-		return fmt.Errorf("read file (%v) err: %v", filePath, err)
+		return grpc_util.ErrorStatusWithKey(errs.Code_BFFGeneral, "bff_file_upload_save", fmt.Sprintf("read chunk (%v) err: %v", sequence, err))
 	}
 	files := form.File["files"]
 	if len(files) == 0 {
-		return fmt.Errorf("file (%v) not exist", filePath)
+		return grpc_util.ErrorStatusWithKey(errs.Code_BFFGeneral, "bff_file_upload_save", fmt.Sprintf("chunk (%v) not exist", sequence))
 	}
 	fileInfo := files[0]
-	err = ctx.SaveUploadedFile(fileInfo, filePath)
+	sequences, sizes, err := listFileUploadParts(ctx, session)
 	if err != nil {
-		return fmt.Errorf("save file (%v) err: %v", filePath, err)
+		return grpc_util.ErrorStatusWithKey(errs.Code_BFFGeneral, "bff_file_upload_save", fmt.Sprintf("list chunk err: %v", err))
 	}
-	return nil
-}
-
-func clearChunkDir(chunkName string) error {
-	dir := BuildFilePathDir(chunkName)
-	exist, err := util.FileExist(dir)
-	if err != nil {
-		return fmt.Errorf("check dir (%v) err: %v", dir, err)
+	if err = checkFileUploadPartSize(sequence, fileInfo.Size, chunkTotal, sequences, sizes); err != nil {
+		return grpc_util.ErrorStatusWithKey(errs.Code_BFFGeneral, "bff_file_upload_chunk_size", err.Error())
 	}
-	if exist {
-		err = util.DeleteDirFile(dir)
-		if err != nil {
-			return fmt.Errorf("delete dir (%v) err: %v", dir, err)
-		}
-	}
-	return nil
-}
-
-func clearChunkFile(fileName string, sequence int, chunkName string) error {
-	filePath := BuildUploadFilePath(fileName, sequence, chunkName)
-	exist, err := util.FileExist(filePath)
+	file, err := fileInfo.Open()
 	if err != nil {
-		return fmt.Errorf("check file (%v) err: %v", filePath, err)
+		return grpc_util.ErrorStatusWithKey(errs.Code_BFFGeneral, "bff_file_upload_save", fmt.Sprintf("open chunk (%v) err: %v", sequence, err))
 	}
-	if exist {
-		err = util.DeleteFile(filePath)
-		if err != nil {
-			return fmt.Errorf("delete file (%v) err: %v", filePath, err)
+	defer func() {
+		if err := file.Close(); err != nil {
+			log.Errorf("save chunk (%v) but close file err: %v", sequence, err)
 		}
+	}()
+	if err = minio.PutFileMultipartPart(ctx, session.ObjectName, session.UploadId, sequence, file, fileInfo.Size); err != nil {
+		return grpc_util.ErrorStatusWithKey(errs.Code_BFFGeneral, "bff_file_upload_save", fmt.Sprintf("save chunk (%v) err: %v", sequence, err))
 	}
-	dirPath := BuildUploadFilePathDir(chunkName)
-	exist, err = util.FileExist(dirPath)
-	if err != nil {
-		return fmt.Errorf("check dir (%v) err: %v", dirPath, err)
-	}
-	if exist {
-		dir, err := os.ReadDir(dirPath)
-		if err != nil {
-			return fmt.Errorf("read dir (%v) err: %v", dirPath, err)
-		}
-		if len(dir) == 0 {
-			err = util.DeleteDirFile(dirPath)
-			if err != nil {
-				return fmt.Errorf("delete dir (%v) err: %v", dirPath, err)
-			}
-		}
+	if err = saveFileUploadPart(ctx, session, sequence, fileInfo.Size); err != nil {
+		return grpc_util.ErrorStatusWithKey(errs.Code_BFFGeneral, "bff_file_upload_save", fmt.Sprintf("record chunk (%v) err: %v", sequence, err))
 	}
 	return nil
 }
 
-func BuildFilePathDir(baseFileName string) string {
-	fileMd5 := util.MD5([]byte(baseFileName))
-	return fmt.Sprintf("%s/%s", FileUploadTmpLocalDir, fileMd5)
-}
-
-func BuildUploadFilePath(baseFileName string, sequence int, chunkName string) string {
-	fileName := fmt.Sprintf("%010d_%s", sequence, baseFileName)
-	return fmt.Sprintf("%s/upload/%s", BuildFilePathDir(chunkName), fileName)
-}
-
-func BuildUploadFilePathDir(chunkName string) string {
-	return fmt.Sprintf("%s/upload", BuildFilePathDir(chunkName))
-}
-
-func BuildMergeFilePath(baseFileName string, chunkName string) string {
-	mergeFileName := util.GenUUID() + util.FileExt(baseFileName)
-	return fmt.Sprintf("%s/merge/%s", BuildFilePathDir(chunkName), mergeFileName)
-}
-
-func BuildUploadFileSeqList(filePathList []string, fullPath bool) ([]int, error) {
-	var retList []int
-	for _, filePath := range filePathList {
-		seq, _, err := BuildChunkSequence(filePath, fullPath)
-		if err != nil {
-			return nil, err
+// checkFileUploadPartSize minio要求除最后一个分片外每个分片不小于MultipartMinPartSize，上传时提前校验，避免合并时才失败：
+// 请求携带分片总数时直接校验本分片；否则与已上传的分片对比，小于最小大小的分片之后不能再有其他分片
+func checkFileUploadPartSize(sequence int, size int64, chunkTotal int, sequences []int, sizes map[int]int64) error {
+	for _, uploaded := range sequences {
+		if uploaded == sequence {
+			continue
 		}
-		retList = append(retList, seq)
-	}
-	return retList, nil
-}
-
-func BuildChunkSequence(storeFile string, fullPath bool) (int, string, error) {
-	if fullPath {
-		lastIndex := strings.LastIndex(storeFile, "/")
-		if lastIndex < 0 {
-			return 0, "", fmt.Errorf("store file (%v) is not full path", storeFile)
+		if uploaded < sequence && sizes[uploaded] < minio.MultipartMinPartSize {
+			return fmt.Errorf("chunk (%v) size (%v) less than %v but is not the last chunk", uploaded, sizes[uploaded], minio.MultipartMinPartSize)
+		}
+		if uploaded > sequence && size < minio.MultipartMinPartSize {
+			return fmt.Errorf("chunk (%v) size (%v) less than %v but is not the last chunk", sequence, size, minio.MultipartMinPartSize)
 		}
-		storeFile = storeFile[lastIndex+1:]
-	}
-	splitIndex := strings.Index(storeFile, "_")
-	if splitIndex < 0 {
-		return 0, "", fmt.Errorf("store file (%v) is empty", storeFile)
 	}
-	sequence, err := strconv.Atoi(storeFile[0:splitIndex])
-	if err != nil {
-		return 0, "", fmt.Errorf("store file (%v) is invalid", storeFile)
+	if chunkTotal > 0 && sequence < chunkTotal && size < minio.MultipartMinPartSize {
+		return fmt.Errorf("chunk (%v) size (%v) less than %v but is not the last chunk", sequence, size, minio.MultipartMinPartSize)
 	}
-	return sequence, storeFile[splitIndex+1:], nil
+	return nil
 }
 
 func ProxyUploadFile(ctx *gin.Context, r *request.ProxyUploadFileReq) (*response.ProxyUploadFileResp, error) {
@@ -0,0 +1,231 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/UnicomAI/wanwu/pkg/log"
+	"github.com/UnicomAI/wanwu/pkg/minio"
+	"github.com/UnicomAI/wanwu/pkg/redis"
+	"github.com/UnicomAI/wanwu/pkg/util"
+	go_redis "github.com/redis/go-redis/v9"
+)
+
+const (
+	fileUploadSessionKeyPrefix = "file_upload:session:"
+	fileUploadSessionIndexKey  = "file_upload:sessions"
+	fileUploadPartsKeyPrefix   = "file_upload:parts:"
+	// fileUploadSessionExpire 分片上传会话有效期，超时未合并的会话会被清理
+	fileUploadSessionExpire = 24 * time.Hour
+	// fileUploadCleanInterval 清理过期分片上传会话的间隔
+	fileUploadCleanInterval = 10 * time.Minute
+)
+
+var _fileUploadCleanStop chan struct{}
+
+// fileUploadSession 分片上传会话，对应minio中的一次multipart upload
+type fileUploadSession struct {
+	ChunkName  string `json:"chunkName"`
+	FileName   string `json:"fileName"`
+	ObjectName string `json:"objectName"`
+	UploadId   string `json:"uploadId"`
+	CreatedAt  int64  `json:"createdAt"`
+}
+
+// StartFileUploadClean 定时清理过期的分片上传会话及minio中已上传的分片
+func StartFileUploadClean(ctx context.Context) {
+	if _fileUploadCleanStop != nil {
+		return
+	}
+	_fileUploadCleanStop = make(chan struct{})
+	stop := _fileUploadCleanStop
+	ticker := time.NewTicker(fileUploadCleanInterval)
+	go func() {
+		defer util.PrintPanicStack()
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ticker.C:
+				cleanExpiredFileUploadSession(ctx)
+			case <-stop:
+				return
+			}
+		}
+	}()
+}
+
+func StopFileUploadClean() {
+	if _fileUploadCleanStop != nil {
+		close(_fileUploadCleanStop)
+		_fileUploadCleanStop = nil
+	}
+}
+
+// getFileUploadSession 查询分片上传会话，不存在时返回nil
+func getFileUploadSession(ctx context.Context, chunkName string) (*fileUploadSession, error) {
+	value, err := redis.FileUpload().Get(ctx, buildFileUploadSessionKey(chunkName))
+	if err != nil {
+		return nil, err
+	}
+	if value == "" {
+		return nil, nil
+	}
+	session := &fileUploadSession{}
+	if err = json.Unmarshal([]byte(value), session); err != nil {
+		return nil, fmt.Errorf("unmarshal file upload session (%v) err: %v", chunkName, err)
+	}
+	return session, nil
+}
+
+// getOrCreateFileUploadSession 查询分片上传会话，不存在时在minio创建multipart upload；
+// 并发创建时只保留先写入redis的会话，其余multipart upload立即取消
+func getOrCreateFileUploadSession(ctx context.Context, chunkName, fileName string) (*fileUploadSession, error) {
+	session, err := getFileUploadSession(ctx, chunkName)
+	if err != nil || session != nil {
+		return session, err
+	}
+	storeFileName, objectName, uploadId, err := minio.NewFileMultipartUpload(ctx, util.FileExt(fileName))
+	if err != nil {
+		return nil, fmt.Errorf("create multipart upload err: %v", err)
+	}
+	session = &fileUploadSession{
+		ChunkName:  chunkName,
+		FileName:   storeFileName,
+		ObjectName: objectName,
+		UploadId:   uploadId,
+		CreatedAt:  time.Now().UnixMilli(),
+	}
+	value, err := json.Marshal(session)
+	if err != nil {
+		return nil, err
+	}
+	cli := redis.FileUpload().Cli()
+	ok, err := cli.SetNX(ctx, buildFileUploadSessionKey(chunkName), string(value), 2*fileUploadSessionExpire).Result()
+	if err == nil && ok {
+		err = cli.ZAdd(ctx, fileUploadSessionIndexKey, go_redis.Z{Score: float64(session.CreatedAt), Member: string(value)}).Err()
+		if err == nil {
+			return session, nil
+		}
+		_ = cli.Del(ctx, buildFileUploadSessionKey(chunkName)).Err()
+	}
+	if abortErr := minio.AbortFileMultipartUpload(ctx, objectName, uploadId); abortErr != nil {
+		log.Errorf("abort file upload session (%v) uploadId (%v) err: %v", chunkName, uploadId, abortErr)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("save file upload session (%v) err: %v", chunkName, err)
+	}
+	return getFileUploadSession(ctx, chunkName)
+}
+
+// saveFileUploadPart 记录已上传的分片序号及大小，避免每次校验都查询minio的分片列表
+func saveFileUploadPart(ctx context.Context, session *fileUploadSession, sequence int, size int64) error {
+	key := buildFileUploadPartsKey(session)
+	if err := redis.FileUpload().HSet(ctx, key, []redis.HashItem{{K: strconv.Itoa(sequence), V: strconv.FormatInt(size, 10)}}); err != nil {
+		return err
+	}
+	return redis.FileUpload().Expire(ctx, key, 2*fileUploadSessionExpire)
+}
+
+// listFileUploadParts 查询已上传的分片，返回升序的分片序号及各分片的大小
+func listFileUploadParts(ctx context.Context, session *fileUploadSession) ([]int, map[int]int64, error) {
+	items, err := redis.FileUpload().HGetAll(ctx, buildFileUploadPartsKey(session))
+	if err != nil {
+		return nil, nil, err
+	}
+	sequences := make([]int, 0, len(items))
+	sizes := make(map[int]int64, len(items))
+	for _, item := range items {
+		sequence, err := strconv.Atoi(item.K)
+		if err != nil {
+			continue
+		}
+		size, _ := strconv.ParseInt(item.V, 10, 64)
+		sequences = append(sequences, sequence)
+		sizes[sequence] = size
+	}
+	sort.Ints(sequences)
+	return sequences, sizes, nil
+}
+
+// existFileUploadPart 判断分片是否已上传
+func existFileUploadPart(ctx context.Context, session *fileUploadSession, sequence int) (bool, error) {
+	item, err := redis.FileUpload().HGet(ctx, buildFileUploadPartsKey(session), strconv.Itoa(sequence))
+	if err != nil {
+		return false, err
+	}
+	return item != nil, nil
+}
+
+// deleteFileUploadSession 删除分片上传会话，abort为true时同时取消minio中的multipart upload
+func deleteFileUploadSession(ctx context.Context, session *fileUploadSession, abort bool) error {
+	if abort {
+		if err := minio.AbortFileMultipartUpload(ctx, session.ObjectName, session.UploadId); err != nil && !minio.IsNoSuchUpload(err) {
+			return err
+		}
+	}
+	value, err := json.Marshal(session)
+	if err != nil {
+		return err
+	}
+	cli := redis.FileUpload().Cli()
+	if err = cli.ZRem(ctx, fileUploadSessionIndexKey, string(value)).Err(); err != nil {
+		return err
+	}
+	if err = redis.FileUpload().Del(ctx, buildFileUploadPartsKey(session)); err != nil {
+		return err
+	}
+	// 仅删除同一次multipart upload的会话，避免误删会话被清理后重新创建的会话
+	current, err := getFileUploadSession(ctx, session.ChunkName)
+	if err != nil || current == nil || current.UploadId != session.UploadId {
+		return err
+	}
+	return redis.FileUpload().Del(ctx, buildFileUploadSessionKey(session.ChunkName))
+}
+
+// cleanExpiredFileUploadSession 清理过期会话；多个bff实例并发清理时以ZRem成功的实例为准
+func cleanExpiredFileUploadSession(ctx context.Context) {
+	if redis.FileUpload() == nil {
+		return
+	}
+	cli := redis.FileUpload().Cli()
+	expireAt := time.Now().Add(-fileUploadSessionExpire).UnixMilli()
+	members, err := cli.ZRangeByScore(ctx, fileUploadSessionIndexKey, &go_redis.ZRangeBy{
+		Min: "-inf",
+		Max: strconv.FormatInt(expireAt, 10),
+	}).Result()
+	if err != nil {
+		log.Errorf("select expired file upload session err: %v", err)
+		return
+	}
+	for _, member := range members {
+		removed, err := cli.ZRem(ctx, fileUploadSessionIndexKey, member).Result()
+		if err != nil || removed == 0 {
+			continue
+		}
+		session := &fileUploadSession{}
+		if err = json.Unmarshal([]byte(member), session); err != nil {
+			log.Errorf("unmarshal expired file upload session (%v) err: %v", member, err)
+			continue
+		}
+		if err = deleteFileUploadSession(ctx, session, true); err != nil {
+			log.Errorf("clean expired file upload session (%v) uploadId (%v) err: %v", session.ChunkName, session.UploadId, err)
+			// 清理失败时放回索引，等待下次重试
+			_ = cli.ZAdd(ctx, fileUploadSessionIndexKey, go_redis.Z{Score: float64(session.CreatedAt), Member: member}).Err()
+			continue
+		}
+		log.Infof("clean expired file upload session (%v) uploadId (%v)", session.ChunkName, session.UploadId)
+	}
+}
+
+func buildFileUploadSessionKey(chunkName string) string {
+	return fileUploadSessionKeyPrefix + util.MD5([]byte(chunkName))
+}
+
+// buildFileUploadPartsKey 已上传分片按uploadId记录，会话重新创建后不会沿用旧的分片
+func buildFileUploadPartsKey(session *fileUploadSession) string {
+	return fileUploadPartsKeyPrefix + session.UploadId
+}
@@ -0,0 +1,38 @@
+package service
+
+import (
+	"testing"
+
+	"github.com/UnicomAI/wanwu/pkg/minio"
+)
+
+func TestCheckFileUploadPartSize(t *testing.T) {
+	const full, small = int64(minio.MultipartMinPartSize), int64(1024)
+	tests := []struct {
+		name       string
+		sequence   int
+		size       int64
+		chunkTotal int
+		sizes      map[int]int64
+		wantErr    bool
+	}{
+		{name: "first full chunk", sequence: 1, size: full},
+		{name: "small chunk may be the last", sequence: 1, size: small},
+		{name: "small chunk before total", sequence: 1, size: small, chunkTotal: 2, wantErr: true},
+		{name: "small last chunk with total", sequence: 2, size: small, chunkTotal: 2, sizes: map[int]int64{1: full}},
+		{name: "chunk after small chunk", sequence: 2, size: small, sizes: map[int]int64{1: small}, wantErr: true},
+		{name: "small chunk before uploaded chunk", sequence: 1, size: small, sizes: map[int]int64{2: full}, wantErr: true},
+		{name: "reupload small chunk", sequence: 1, size: small, sizes: map[int]int64{1: small}},
+		{name: "out of order chunks", sequence: 1, size: full, sizes: map[int]int64{2: small}},
+	}
+	for _, tt := range tests {
+		var sequences []int
+		for sequence := range tt.sizes {
+			sequences = append(sequences, sequence)
+		}
+		err := checkFileUploadPartSize(tt.sequence, tt.size, tt.chunkTotal, sequences, tt.sizes)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("%s: unexpected err %v", tt.name, err)
+		}
+	}
+}
@@ -0,0 +1,111 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/UnicomAI/wanwu/pkg/guardrail"
+	"github.com/gin-gonic/gin"
+)
+
+type fakeGuardrailClassifier struct {
+	mu      sync.Mutex
+	keyword string
+	err     error
+	texts   []string
+}
+
+func (c *fakeGuardrailClassifier) Classify(ctx context.Context, text string) (bool, string, error) {
+	c.mu.Lock()
+	c.texts = append(c.texts, text)
+	c.mu.Unlock()
+	if c.err != nil {
+		return false, "", c.err
+	}
+	return c.keyword != "" && strings.Contains(text, c.keyword), "unsafe", nil
+}
+
+func newGuardrailTestCtx() *gin.Context {
+	ctx, _ := gin.CreateTestContext(httptest.NewRecorder())
+	ctx.Request = httptest.NewRequest("GET", "/", nil)
+	return ctx
+}
+
+func agentChunk(content string) string {
+	b, _ := json.Marshal(map[string]interface{}{"msg_id": "1", "response": content, "finish": 0})
+	return string(b)
+}
+
+func runGuardrailOutput(t *testing.T, stage *guardrail.Stage, chunks []string) []string {
+	g := &guardrailPipeline{appId: "app", output: stage, blockReply: "blocked"}
+	rawCh := make(chan string, len(chunks))
+	for _, chunk := range chunks {
+		rawCh <- agentChunk(chunk)
+	}
+	close(rawCh)
+	var ret []string
+	for raw := range g.processOutput(newGuardrailTestCtx(), rawCh, &agentSensitiveService{}) {
+		_, content := (&agentSensitiveService{}).parseContent(raw)
+		ret = append(ret, content)
+	}
+	return ret
+}
+
+func TestGuardrailOutputRules(t *testing.T) {
+	stage, err := guardrail.NewStage([]guardrail.Rule{{Type: guardrail.DetectorPhone, Action: guardrail.ActionMask}}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	got := strings.Join(runGuardrailOutput(t, stage, []string{"电话138", "0013", "8000，请联系"}), "")
+	if got != "电话138****8000，请联系" {
+		t.Fatalf("output = %q", got)
+	}
+}
+
+func TestGuardrailOutputClassifier(t *testing.T) {
+	safe := strings.Repeat("安全内容", guardrailClassifyInterval/len("安全内容")+1)
+	for _, c := range []struct {
+		name       string
+		classifier *fakeGuardrailClassifier
+		chunks     []string
+		want       []string
+	}{
+		{"pass", &fakeGuardrailClassifier{keyword: "攻击"}, []string{safe, "结束"}, []string{safe, "结束"}},
+		// 首段通过后下发，命中的后续内容不下发
+		{"block later", &fakeGuardrailClassifier{keyword: "攻击"}, []string{safe, "如何攻击"}, []string{safe, "blocked"}},
+		// 未通过检测的内容不会先于拦截回复下发
+		{"block first", &fakeGuardrailClassifier{keyword: "攻击"}, []string{"如何攻击", "网站"}, []string{"blocked"}},
+		// 分类模型出错时放行
+		{"classify err", &fakeGuardrailClassifier{err: errors.New("timeout")}, []string{"你好", "世界"}, []string{"你好", "世界"}},
+	} {
+		stage, err := guardrail.NewStage(nil, c.classifier)
+		if err != nil {
+			t.Fatal(err)
+		}
+		got := runGuardrailOutput(t, stage, c.chunks)
+		if strings.Join(got, "|") != strings.Join(c.want, "|") {
+			t.Errorf("%v: output = %q, want %q", c.name, got, c.want)
+		}
+		if len(c.classifier.texts) == 0 {
+			t.Errorf("%v: classifier not called", c.name)
+		}
+	}
+}
+
+func TestUnmarshalLLMJSON(t *testing.T) {
+	ret := struct {
+		Unsafe bool   `json:"unsafe"`
+		Reason string `json:"reason"`
+	}{}
+	if err := unmarshalLLMJSON("<think>{\"unsafe\":false}</think>结果：{\"unsafe\":true,\"reason\":\"暴力\"}", &ret); err != nil || !ret.Unsafe || ret.Reason != "暴力" {
+		t.Fatalf("unmarshal = %+v err %v", ret, err)
+	}
+	if err := unmarshalLLMJSON("安全", &ret); err == nil {
+		t.Fatalf("expect err")
+	}
+}
@@ -19,7 +19,7 @@ func SelectKnowledgeList(ctx *gin.Context, userId, orgId string, req *request.Kn
 	if err != nil {
 		return nil, err
 	}
-	return buildKnowledgeInfoList(resp), nil
+	return buildKnowledgeInfoList(userId, resp), nil
 }
 
 // SelectKnowledgeInfoByName 根据知识库名称查询知识库信息
@@ -183,7 +183,7 @@ func buildKnowledgeListReq(r *request.KnowledgeHitReq) []*knowledgebase_service.
 }
 
 // buildKnowledgeInfoList 构造知识库列表结果
-func buildKnowledgeInfoList(knowledgeListResp *knowledgebase_service.KnowledgeSelectListResp) *response.KnowledgeListResp {
+func buildKnowledgeInfoList(userId string, knowledgeListResp *knowledgebase_service.KnowledgeSelectListResp) *response.KnowledgeListResp {
 	if knowledgeListResp == nil || len(knowledgeListResp.KnowledgeList) == 0 {
 		return &response.KnowledgeListResp{}
 	}
@@ -200,6 +200,8 @@ func buildKnowledgeInfoList(knowledgeListResp *knowledgebase_service.KnowledgeSe
 			},
 			KnowledgeTagList: buildTagList(knowledge.KnowledgeTagInfoList),
 			CreateAt:         knowledge.CreatedAt,
+			PermissionType:   knowledge.PermissionType,
+			Shared:           knowledge.UserId != userId,
 		})
 	}
 	return &response.KnowledgeListResp{KnowledgeList: list}
@@ -0,0 +1,121 @@
+package service
+
+import (
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	err_code "github.com/UnicomAI/wanwu/api/proto/err-code"
+	knowledgebase_service "github.com/UnicomAI/wanwu/api/proto/knowledgebase-service"
+	"github.com/UnicomAI/wanwu/internal/bff-service/model/request"
+	"github.com/UnicomAI/wanwu/internal/bff-service/model/response"
+	grpc_util "github.com/UnicomAI/wanwu/pkg/grpc-util"
+	"github.com/UnicomAI/wanwu/pkg/log"
+	"github.com/UnicomAI/wanwu/pkg/minio"
+	"github.com/gin-gonic/gin"
+)
+
+const (
+	knowledgeBundleExport  = 1 // 知识库导出任务
+	knowledgeBundleSuccess = 2 // 任务成功
+)
+
+// ExportKnowledge 导出知识库
+func ExportKnowledge(ctx *gin.Context, userId, orgId string, r *request.KnowledgeExportReq) (*response.KnowledgeBundleTaskResp, error) {
+	resp, err := knowledgeBase.ExportKnowledge(ctx.Request.Context(), &knowledgebase_service.ExportKnowledgeReq{
+		UserId:      userId,
+		OrgId:       orgId,
+		KnowledgeId: r.KnowledgeId,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &response.KnowledgeBundleTaskResp{TaskId: resp.TaskId}, nil
+}
+
+// ImportKnowledge 导入知识库
+func ImportKnowledge(ctx *gin.Context, userId, orgId string, r *request.KnowledgeImportReq) (*response.KnowledgeBundleTaskResp, error) {
+	filePath, err := minio.GetUploadFileWithExpire(ctx, r.FileUploadId)
+	if err != nil {
+		log.Errorf("GetUploadFileWithExpire error %v", err)
+		return nil, grpc_util.ErrorStatus(err_code.Code_KnowledgeBundleFileInvalid)
+	}
+	req := &knowledgebase_service.ImportKnowledgeReq{
+		UserId:      userId,
+		OrgId:       orgId,
+		FilePath:    filePath,
+		Name:        r.Name,
+		Description: r.Description,
+	}
+	if r.EmbeddingModel != nil {
+		req.EmbeddingModelInfo = &knowledgebase_service.EmbeddingModelInfo{ModelId: r.EmbeddingModel.ModelId}
+	}
+	resp, err := knowledgeBase.ImportKnowledge(ctx.Request.Context(), req)
+	if err != nil {
+		return nil, err
+	}
+	return &response.KnowledgeBundleTaskResp{TaskId: resp.TaskId}, nil
+}
+
+// GetKnowledgeBundleTask 查询知识库导入导出任务
+func GetKnowledgeBundleTask(ctx *gin.Context, userId, orgId string, r *request.KnowledgeBundleTaskReq) (*response.KnowledgeBundleTaskInfo, error) {
+	resp, err := getKnowledgeBundleTask(ctx, userId, orgId, r.TaskId)
+	if err != nil {
+		return nil, err
+	}
+	return &response.KnowledgeBundleTaskInfo{
+		TaskId:        resp.TaskId,
+		TaskType:      resp.TaskType,
+		KnowledgeId:   resp.KnowledgeId,
+		KnowledgeName: resp.KnowledgeName,
+		Status:        resp.Status,
+		TotalCount:    resp.TotalCount,
+		FinishCount:   resp.FinishCount,
+		ErrorMsg:      resp.ErrorMsg,
+		CreatedAt:     resp.CreatedAt,
+		UpdatedAt:     resp.UpdatedAt,
+	}, nil
+}
+
+// DownloadKnowledgeExport 下载知识库导出文件，导出文件保留1天
+func DownloadKnowledgeExport(ctx *gin.Context, userId, orgId string, r *request.KnowledgeBundleTaskReq) error {
+	resp, err := getKnowledgeBundleTask(ctx, userId, orgId, r.TaskId)
+	if err != nil {
+		return err
+	}
+	if resp.TaskType != knowledgeBundleExport || resp.Status != knowledgeBundleSuccess || resp.FileName == "" {
+		return grpc_util.ErrorStatus(err_code.Code_KnowledgeBundleFileInvalid)
+	}
+	object, err := minio.GetFileObjectWithExpire(ctx.Request.Context(), resp.FileName)
+	if err != nil {
+		log.Errorf("knowledge bundle task %v get export file err: %v", resp.TaskId, err)
+		return grpc_util.ErrorStatus(err_code.Code_KnowledgeBundleFileInvalid)
+	}
+	defer func() {
+		_ = object.Close()
+	}()
+	// 导出文件已过期清理时返回错误信息
+	info, err := object.Stat()
+	if err != nil {
+		log.Errorf("knowledge bundle task %v stat export file err: %v", resp.TaskId, err)
+		return grpc_util.ErrorStatus(err_code.Code_KnowledgeBundleFileInvalid)
+	}
+	ctx.Header("Content-Disposition", "attachment; filename*=utf-8''"+url.QueryEscape(resp.KnowledgeName+".zip"))
+	ctx.Header("Content-Type", "application/zip")
+	ctx.Header("Content-Length", strconv.FormatInt(info.Size, 10))
+	ctx.Header("Access-Control-Expose-Headers", "Content-Disposition")
+	ctx.Status(http.StatusOK)
+	if _, err = io.Copy(ctx.Writer, object); err != nil {
+		log.Errorf("knowledge bundle task %v download export file err: %v", resp.TaskId, err)
+	}
+	return nil
+}
+
+func getKnowledgeBundleTask(ctx *gin.Context, userId, orgId, taskId string) (*knowledgebase_service.KnowledgeBundleTaskInfo, error) {
+	return knowledgeBase.GetKnowledgeBundleTask(ctx.Request.Context(), &knowledgebase_service.KnowledgeBundleTaskReq{
+		UserId: userId,
+		OrgId:  orgId,
+		TaskId: taskId,
+	})
+}
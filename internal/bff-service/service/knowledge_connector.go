@@ -0,0 +1,116 @@
+package service
+
+import (
+	knowledgebase_doc_service "github.com/UnicomAI/wanwu/api/proto/knowledgebase-doc-service"
+	"github.com/UnicomAI/wanwu/internal/bff-service/model/request"
+	"github.com/UnicomAI/wanwu/internal/bff-service/model/response"
+	"github.com/gin-gonic/gin"
+)
+
+// SaveConnector 创建或更新知识库连接器
+func SaveConnector(ctx *gin.Context, userId, orgId string, r *request.ConnectorSaveReq) (*response.ConnectorSaveResp, error) {
+	segment := r.DocSegment
+	req := &knowledgebase_doc_service.SaveConnectorReq{
+		UserId:        userId,
+		OrgId:         orgId,
+		KnowledgeId:   r.KnowledgeId,
+		ConnectorId:   r.ConnectorId,
+		Name:          r.Name,
+		ConnectorType: r.ConnectorType,
+		Config: &knowledgebase_doc_service.ConnectorConfig{
+			Endpoint:   r.Config.Endpoint,
+			Bucket:     r.Config.Bucket,
+			UseSsl:     r.Config.UseSsl,
+			RepoUrl:    r.Config.RepoUrl,
+			Branch:     r.Config.Branch,
+			RootPath:   r.Config.RootPath,
+			PathFilter: r.Config.PathFilter,
+		},
+		DocSegment: &knowledgebase_doc_service.DocSegment{
+			SegmentType:    segment.SegmentType,
+			Splitter:       segment.Splitter,
+			MaxSplitter:    int32(segment.MaxSplitter),
+			Overlap:        segment.Overlap,
+			SegmentMethod:  segment.SegmentMethod,
+			SubMaxSplitter: int32(segment.SubMaxSplitter),
+			SubSplitter:    segment.SubSplitter,
+		},
+		DocAnalyzer:   r.DocAnalyzer,
+		OcrModelId:    r.ParserModelId,
+		DocPreprocess: r.DocPreprocess,
+	}
+	if r.Credential != nil {
+		req.Credential = &knowledgebase_doc_service.ConnectorCredential{
+			AccessKey: r.Credential.AccessKey,
+			SecretKey: r.Credential.SecretKey,
+			Username:  r.Credential.Username,
+			Password:  r.Credential.Password,
+		}
+	}
+	resp, err := knowledgeBaseDoc.SaveConnector(ctx.Request.Context(), req)
+	if err != nil {
+		return nil, err
+	}
+	return &response.ConnectorSaveResp{ConnectorId: resp.ConnectorId}, nil
+}
+
+// DeleteConnector 删除知识库连接器
+func DeleteConnector(ctx *gin.Context, userId, orgId string, r *request.ConnectorIdReq) error {
+	_, err := knowledgeBaseDoc.DeleteConnector(ctx.Request.Context(), &knowledgebase_doc_service.DeleteConnectorReq{
+		UserId:      userId,
+		OrgId:       orgId,
+		ConnectorId: r.ConnectorId,
+	})
+	return err
+}
+
+// RunConnector 执行知识库连接器增量导入
+func RunConnector(ctx *gin.Context, userId, orgId string, r *request.ConnectorIdReq) error {
+	_, err := knowledgeBaseDoc.RunConnector(ctx.Request.Context(), &knowledgebase_doc_service.RunConnectorReq{
+		UserId:      userId,
+		OrgId:       orgId,
+		ConnectorId: r.ConnectorId,
+	})
+	return err
+}
+
+// GetConnectorList 查询知识库连接器列表
+func GetConnectorList(ctx *gin.Context, userId, orgId string, r *request.ConnectorListReq) (*response.ConnectorListResp, error) {
+	resp, err := knowledgeBaseDoc.GetConnectorList(ctx.Request.Context(), &knowledgebase_doc_service.GetConnectorListReq{
+		UserId:      userId,
+		OrgId:       orgId,
+		KnowledgeId: r.KnowledgeId,
+	})
+	if err != nil {
+		return nil, err
+	}
+	list := make([]*response.ConnectorInfo, 0, len(resp.List))
+	for _, connector := range resp.List {
+		info := &response.ConnectorInfo{
+			ConnectorId:      connector.ConnectorId,
+			KnowledgeId:      connector.KnowledgeId,
+			Name:             connector.Name,
+			ConnectorType:    connector.ConnectorType,
+			HasCredential:    connector.HasCredential,
+			Status:           connector.Status,
+			ErrorMsg:         connector.ErrorMsg,
+			Cursor:           connector.Cursor,
+			LastImportTaskId: connector.LastImportTaskId,
+			LastRunAt:        connector.LastRunAt,
+			CreateAt:         connector.CreatedAt,
+		}
+		if c := connector.Config; c != nil {
+			info.Config = &request.ConnectorConfig{
+				Endpoint:   c.Endpoint,
+				Bucket:     c.Bucket,
+				UseSsl:     c.UseSsl,
+				RepoUrl:    c.RepoUrl,
+				Branch:     c.Branch,
+				RootPath:   c.RootPath,
+				PathFilter: c.PathFilter,
+			}
+		}
+		list = append(list, info)
+	}
+	return &response.ConnectorListResp{List: list}, nil
+}
@@ -333,8 +333,9 @@ func BatchCreateDocSegment(ctx *gin.Context, userId, orgId string, r *request.Ba
 		log.Errorf("GetUploadFileWithNotExpire error %v", err)
 		return grpc_util.ErrorStatus(errs.Code_KnowledgeDocImportUrlFailed)
 	}
-	ext := filepath.Ext(docUrl)
-	if ".csv" != ext {
+	switch strings.ToLower(filepath.Ext(docUrl)) {
+	case ".csv", ".jsonl", ".xlsx":
+	default:
 		return grpc_util.ErrorStatus(errs.Code_KnowledgeDocSegmentFileCSVTypeFail)
 	}
 	_, err = knowledgeBaseDoc.BatchCreateDocSegment(ctx.Request.Context(), &knowledgebase_doc_service.BatchCreateDocSegmentReq{
@@ -342,6 +343,7 @@ func BatchCreateDocSegment(ctx *gin.Context, userId, orgId string, r *request.Ba
 		OrgId:   orgId,
 		DocId:   r.DocId,
 		FileUrl: docUrl,
+		Mode:    r.Mode,
 	})
 	return err
 }
@@ -434,27 +436,36 @@ func buildMetaInfoList(req *request.DocImportReq) []*knowledgebase_doc_service.D
 func buildDocInfoList(ctx *gin.Context, req *request.DocImportReq) ([]*knowledgebase_doc_service.DocFileInfo, error) {
 	var docInfoList []*knowledgebase_doc_service.DocFileInfo
 	for _, info := range req.DocInfo {
-		var docUrl = info.DocUrl
-		var docType = info.DocType
-		if len(docUrl) == 0 {
-			var err error
-			docUrl, err = minio.GetUploadFileWithExpire(ctx, info.DocId)
-			if err != nil {
-				log.Errorf("GetUploadFileWithNotExpire error %v", err)
-				return nil, grpc_util.ErrorStatus(errs.Code_KnowledgeDocImportUrlFailed)
-			}
-			//特殊处理类型
-			if strings.HasSuffix(docUrl, ".tar.gz") {
-				docType = ".tar.gz"
-			}
+		docInfo, err := buildDocFileInfo(ctx, info)
+		if err != nil {
+			return nil, err
 		}
-		docInfoList = append(docInfoList, &knowledgebase_doc_service.DocFileInfo{
-			DocName: info.DocName,
-			DocId:   info.DocId,
-			DocUrl:  docUrl,
-			DocType: docType,
-			DocSize: info.DocSize,
-		})
+		docInfoList = append(docInfoList, docInfo)
 	}
 	return docInfoList, nil
 }
+
+// buildDocFileInfo 构造上传文档信息，未填写url时按上传文件id获取minio地址
+func buildDocFileInfo(ctx *gin.Context, info *request.DocInfo) (*knowledgebase_doc_service.DocFileInfo, error) {
+	var docUrl = info.DocUrl
+	var docType = info.DocType
+	if len(docUrl) == 0 {
+		var err error
+		docUrl, err = minio.GetUploadFileWithExpire(ctx, info.DocId)
+		if err != nil {
+			log.Errorf("GetUploadFileWithNotExpire error %v", err)
+			return nil, grpc_util.ErrorStatus(errs.Code_KnowledgeDocImportUrlFailed)
+		}
+		//特殊处理类型
+		if strings.HasSuffix(docUrl, ".tar.gz") {
+			docType = ".tar.gz"
+		}
+	}
+	return &knowledgebase_doc_service.DocFileInfo{
+		DocName: info.DocName,
+		DocId:   info.DocId,
+		DocUrl:  docUrl,
+		DocType: docType,
+		DocSize: info.DocSize,
+	}, nil
+}
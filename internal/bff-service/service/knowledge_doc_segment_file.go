@@ -0,0 +1,83 @@
+package service
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"time"
+
+	knowledgebase_doc_service "github.com/UnicomAI/wanwu/api/proto/knowledgebase-doc-service"
+	"github.com/UnicomAI/wanwu/internal/bff-service/model/request"
+	"github.com/UnicomAI/wanwu/internal/bff-service/model/response"
+	"github.com/UnicomAI/wanwu/pkg/log"
+	"github.com/gin-gonic/gin"
+)
+
+// ExportDocSegment 导出文档分段，流式写出文件内容
+func ExportDocSegment(ctx *gin.Context, userId, orgId string, r *request.DocSegmentExportReq) error {
+	stream, err := knowledgeBaseDoc.ExportDocSegment(ctx.Request.Context(), &knowledgebase_doc_service.ExportDocSegmentReq{
+		UserId:      userId,
+		OrgId:       orgId,
+		KnowledgeId: r.KnowledgeId,
+		DocId:       r.DocId,
+		Format:      r.Format,
+	})
+	if err != nil {
+		return err
+	}
+	// 收到首条数据前出错时返回错误信息，之后出错只能中断下载
+	resp, err := stream.Recv()
+	if err != nil && err != io.EOF {
+		return err
+	}
+
+	fileName := fmt.Sprintf("segment_export_%s.%s", time.Now().Format("20060102150405"), r.Format)
+	ctx.Header("Content-Disposition", "attachment; filename*=utf-8''"+url.QueryEscape(fileName))
+	ctx.Header("Content-Type", "application/octet-stream")
+	ctx.Header("Access-Control-Expose-Headers", "Content-Disposition")
+	ctx.Status(http.StatusOK)
+	for resp != nil {
+		if _, err := ctx.Writer.Write(resp.Data); err != nil {
+			log.Errorf("export doc %v knowledge %v segment write err: %v", r.DocId, r.KnowledgeId, err)
+			return nil
+		}
+		ctx.Writer.Flush()
+		if resp, err = stream.Recv(); err != nil {
+			if err != io.EOF {
+				log.Errorf("export doc %v knowledge %v segment recv err: %v", r.DocId, r.KnowledgeId, err)
+			}
+			break
+		}
+	}
+	return nil
+}
+
+// GetDocSegmentImportReport 查询文档分段最近一次批量导入结果
+func GetDocSegmentImportReport(ctx *gin.Context, userId, orgId string, r *request.DocSegmentImportReportReq) (*response.DocSegmentImportReport, error) {
+	resp, err := knowledgeBaseDoc.GetDocSegmentImportReport(ctx.Request.Context(), &knowledgebase_doc_service.GetDocSegmentImportReportReq{
+		UserId: userId,
+		OrgId:  orgId,
+		DocId:  r.DocId,
+	})
+	if err != nil {
+		return nil, err
+	}
+	report := &response.DocSegmentImportReport{
+		ImportId:     resp.ImportId,
+		Status:       resp.Status,
+		TotalCount:   resp.TotalCount,
+		SuccessCount: resp.SuccessCount,
+		ErrorMsg:     resp.ErrorMsg,
+		RowErrorList: make([]*response.DocSegmentImportRowError, 0, len(resp.RowErrorList)),
+		CreatedAt:    resp.CreatedAt,
+	}
+	for _, rowError := range resp.RowErrorList {
+		report.RowErrorList = append(report.RowErrorList, &response.DocSegmentImportRowError{
+			Line:      rowError.Line,
+			ContentId: rowError.ContentId,
+			ErrorMsg:  rowError.ErrorMsg,
+		})
+	}
+	return report, nil
+}
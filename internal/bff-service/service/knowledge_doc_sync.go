@@ -0,0 +1,109 @@
+package service
+
+import (
+	knowledgebase_doc_service "github.com/UnicomAI/wanwu/api/proto/knowledgebase-doc-service"
+	"github.com/UnicomAI/wanwu/internal/bff-service/model/request"
+	"github.com/UnicomAI/wanwu/internal/bff-service/model/response"
+	"github.com/gin-gonic/gin"
+)
+
+// SaveDocSync 设置url文档定时同步
+func SaveDocSync(ctx *gin.Context, userId, orgId string, r *request.DocSyncSaveReq) (*response.DocSyncSaveResp, error) {
+	resp, err := knowledgeBaseDoc.SaveDocSync(ctx.Request.Context(), &knowledgebase_doc_service.SaveDocSyncReq{
+		UserId:          userId,
+		OrgId:           orgId,
+		KnowledgeId:     r.KnowledgeId,
+		DocId:           r.DocId,
+		ImportTaskId:    r.ImportTaskId,
+		CronExpr:        r.CronExpr,
+		IntervalMinutes: int32(r.IntervalMinutes),
+		Enable:          r.Enable,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &response.DocSyncSaveResp{SyncId: resp.SyncId}, nil
+}
+
+// DeleteDocSync 删除url文档定时同步
+func DeleteDocSync(ctx *gin.Context, userId, orgId string, r *request.DocSyncIdReq) error {
+	_, err := knowledgeBaseDoc.DeleteDocSync(ctx.Request.Context(), &knowledgebase_doc_service.DeleteDocSyncReq{
+		UserId: userId,
+		OrgId:  orgId,
+		SyncId: r.SyncId,
+	})
+	return err
+}
+
+// RunDocSync 立即执行一次url文档同步
+func RunDocSync(ctx *gin.Context, userId, orgId string, r *request.DocSyncIdReq) error {
+	_, err := knowledgeBaseDoc.RunDocSync(ctx.Request.Context(), &knowledgebase_doc_service.RunDocSyncReq{
+		UserId: userId,
+		OrgId:  orgId,
+		SyncId: r.SyncId,
+	})
+	return err
+}
+
+// GetDocSyncList 查询知识库url文档同步列表
+func GetDocSyncList(ctx *gin.Context, userId, orgId string, r *request.DocSyncListReq) (*response.DocSyncListResp, error) {
+	resp, err := knowledgeBaseDoc.GetDocSyncList(ctx.Request.Context(), &knowledgebase_doc_service.GetDocSyncListReq{
+		UserId:      userId,
+		OrgId:       orgId,
+		KnowledgeId: r.KnowledgeId,
+	})
+	if err != nil {
+		return nil, err
+	}
+	list := make([]*response.DocSyncInfo, 0, len(resp.List))
+	for _, docSync := range resp.List {
+		list = append(list, &response.DocSyncInfo{
+			SyncId:          docSync.SyncId,
+			KnowledgeId:     docSync.KnowledgeId,
+			DocId:           docSync.DocId,
+			ImportTaskId:    docSync.ImportTaskId,
+			CronExpr:        docSync.CronExpr,
+			IntervalMinutes: docSync.IntervalMinutes,
+			Enable:          docSync.Enable,
+			LastSyncAt:      docSync.LastSyncAt,
+			NextSyncAt:      docSync.NextSyncAt,
+			CreateAt:        docSync.CreatedAt,
+		})
+	}
+	return &response.DocSyncListResp{List: list}, nil
+}
+
+// GetDocSyncHistoryList 分页查询url文档同步记录
+func GetDocSyncHistoryList(ctx *gin.Context, userId, orgId string, r *request.DocSyncHistoryListReq) (*response.PageResult, error) {
+	resp, err := knowledgeBaseDoc.GetDocSyncHistoryList(ctx.Request.Context(), &knowledgebase_doc_service.GetDocSyncHistoryListReq{
+		UserId:   userId,
+		OrgId:    orgId,
+		SyncId:   r.SyncId,
+		DocId:    r.DocId,
+		PageSize: int32(r.PageSize),
+		PageNum:  int32(r.PageNo),
+	})
+	if err != nil {
+		return nil, err
+	}
+	list := make([]*response.DocSyncHistoryInfo, 0, len(resp.List))
+	for _, history := range resp.List {
+		list = append(list, &response.DocSyncHistoryInfo{
+			SyncId:     history.SyncId,
+			DocId:      history.DocId,
+			DocName:    history.DocName,
+			DocUrl:     history.DocUrl,
+			Status:     history.Status,
+			ContentMd5: history.ContentMd5,
+			ErrorMsg:   history.ErrorMsg,
+			StartAt:    history.StartAt,
+			FinishAt:   history.FinishAt,
+		})
+	}
+	return &response.PageResult{
+		List:     list,
+		Total:    resp.Total,
+		PageNo:   int(resp.PageNum),
+		PageSize: int(resp.PageSize),
+	}, nil
+}
@@ -0,0 +1,91 @@
+package service
+
+import (
+	knowledgebase_doc_service "github.com/UnicomAI/wanwu/api/proto/knowledgebase-doc-service"
+	"github.com/UnicomAI/wanwu/internal/bff-service/model/request"
+	"github.com/UnicomAI/wanwu/internal/bff-service/model/response"
+	"github.com/gin-gonic/gin"
+)
+
+// UploadDocVersion 上传文档新版本
+func UploadDocVersion(ctx *gin.Context, userId, orgId string, r *request.DocVersionUploadReq) error {
+	docInfo, err := buildDocFileInfo(ctx, r.DocInfo)
+	if err != nil {
+		return err
+	}
+	_, err = knowledgeBaseDoc.UploadDocVersion(ctx.Request.Context(), &knowledgebase_doc_service.UploadDocVersionReq{
+		UserId:  userId,
+		OrgId:   orgId,
+		DocId:   r.DocId,
+		DocInfo: docInfo,
+	})
+	return err
+}
+
+// GetDocVersionList 查询文档版本列表
+func GetDocVersionList(ctx *gin.Context, userId, orgId string, r *request.DocVersionListReq) (*response.DocVersionListResp, error) {
+	resp, err := knowledgeBaseDoc.GetDocVersionList(ctx.Request.Context(), &knowledgebase_doc_service.GetDocVersionListReq{
+		UserId: userId,
+		OrgId:  orgId,
+		DocId:  r.DocId,
+	})
+	if err != nil {
+		return nil, err
+	}
+	list := make([]*response.DocVersionInfo, 0, len(resp.List))
+	for _, version := range resp.List {
+		list = append(list, &response.DocVersionInfo{
+			VersionId:    version.VersionId,
+			VersionNo:    version.VersionNo,
+			DocName:      version.DocName,
+			DocType:      version.DocType,
+			DocSize:      version.DocSize,
+			SegmentCount: version.SegmentCount,
+			Active:       version.Active,
+			CreateAt:     version.CreatedAt,
+		})
+	}
+	return &response.DocVersionListResp{List: list}, nil
+}
+
+// DiffDocVersion 对比文档版本切片差异
+func DiffDocVersion(ctx *gin.Context, userId, orgId string, r *request.DocVersionDiffReq) (*response.DocVersionDiffResp, error) {
+	resp, err := knowledgeBaseDoc.DiffDocVersion(ctx.Request.Context(), &knowledgebase_doc_service.DiffDocVersionReq{
+		UserId:          userId,
+		OrgId:           orgId,
+		DocId:           r.DocId,
+		BaseVersionId:   r.BaseVersionId,
+		TargetVersionId: r.TargetVersionId,
+	})
+	if err != nil {
+		return nil, err
+	}
+	list := make([]*response.DocSegmentDiff, 0, len(resp.List))
+	for _, diff := range resp.List {
+		list = append(list, &response.DocSegmentDiff{
+			ContentNum:    diff.ContentNum,
+			ChangeType:    diff.ChangeType,
+			BaseContent:   diff.BaseContent,
+			TargetContent: diff.TargetContent,
+		})
+	}
+	return &response.DocVersionDiffResp{
+		BaseSegmentCount:   resp.BaseSegmentCount,
+		TargetSegmentCount: resp.TargetSegmentCount,
+		AddedCount:         resp.AddedCount,
+		DeletedCount:       resp.DeletedCount,
+		ModifiedCount:      resp.ModifiedCount,
+		List:               list,
+	}, nil
+}
+
+// RollbackDocVersion 回滚文档到历史版本
+func RollbackDocVersion(ctx *gin.Context, userId, orgId string, r *request.DocVersionRollbackReq) error {
+	_, err := knowledgeBaseDoc.RollbackDocVersion(ctx.Request.Context(), &knowledgebase_doc_service.RollbackDocVersionReq{
+		UserId:    userId,
+		OrgId:     orgId,
+		DocId:     r.DocId,
+		VersionId: r.VersionId,
+	})
+	return err
+}
@@ -0,0 +1,244 @@
+package service
+
+import (
+	knowledgebase_service "github.com/UnicomAI/wanwu/api/proto/knowledgebase-service"
+	"github.com/UnicomAI/wanwu/internal/bff-service/model/request"
+	"github.com/UnicomAI/wanwu/internal/bff-service/model/response"
+	"github.com/gin-gonic/gin"
+)
+
+// CreateKnowledgeEvalDataset 创建检索评测数据集
+func CreateKnowledgeEvalDataset(ctx *gin.Context, userId, orgId string, r *request.CreateKnowledgeEvalDatasetReq) (*response.CreateKnowledgeEvalDatasetResp, error) {
+	var questionList []*knowledgebase_service.KnowledgeEvalQuestion
+	for _, question := range r.QuestionList {
+		questionList = append(questionList, &knowledgebase_service.KnowledgeEvalQuestion{
+			Question:              question.Question,
+			ExpectedDocIdList:     question.ExpectedDocIdList,
+			ExpectedContentIdList: question.ExpectedContentIdList,
+			ReferenceAnswer:       question.ReferenceAnswer,
+		})
+	}
+	resp, err := knowledgeBase.CreateKnowledgeEvalDataset(ctx.Request.Context(), &knowledgebase_service.CreateKnowledgeEvalDatasetReq{
+		UserId:       userId,
+		OrgId:        orgId,
+		KnowledgeId:  r.KnowledgeId,
+		Name:         r.Name,
+		Description:  r.Description,
+		QuestionList: questionList,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &response.CreateKnowledgeEvalDatasetResp{DatasetId: resp.DatasetId}, nil
+}
+
+// DeleteKnowledgeEvalDataset 删除检索评测数据集
+func DeleteKnowledgeEvalDataset(ctx *gin.Context, userId, orgId string, r *request.KnowledgeEvalDatasetReq) error {
+	_, err := knowledgeBase.DeleteKnowledgeEvalDataset(ctx.Request.Context(), &knowledgebase_service.KnowledgeEvalDatasetReq{
+		UserId:    userId,
+		OrgId:     orgId,
+		DatasetId: r.DatasetId,
+	})
+	return err
+}
+
+// GetKnowledgeEvalDatasetList 查询检索评测数据集列表
+func GetKnowledgeEvalDatasetList(ctx *gin.Context, userId, orgId string, r *request.KnowledgeEvalDatasetListReq) (*response.KnowledgeEvalDatasetListResp, error) {
+	resp, err := knowledgeBase.GetKnowledgeEvalDatasetList(ctx.Request.Context(), &knowledgebase_service.KnowledgeEvalDatasetListReq{
+		UserId:      userId,
+		OrgId:       orgId,
+		KnowledgeId: r.KnowledgeId,
+	})
+	if err != nil {
+		return nil, err
+	}
+	var datasetList = make([]*response.KnowledgeEvalDatasetInfo, 0)
+	for _, dataset := range resp.DatasetList {
+		datasetList = append(datasetList, buildKnowledgeEvalDatasetInfo(dataset))
+	}
+	return &response.KnowledgeEvalDatasetListResp{DatasetList: datasetList}, nil
+}
+
+// GetKnowledgeEvalDataset 查询检索评测数据集详情
+func GetKnowledgeEvalDataset(ctx *gin.Context, userId, orgId string, r *request.KnowledgeEvalDatasetReq) (*response.KnowledgeEvalDatasetInfo, error) {
+	resp, err := knowledgeBase.GetKnowledgeEvalDataset(ctx.Request.Context(), &knowledgebase_service.KnowledgeEvalDatasetReq{
+		UserId:    userId,
+		OrgId:     orgId,
+		DatasetId: r.DatasetId,
+	})
+	if err != nil {
+		return nil, err
+	}
+	info := buildKnowledgeEvalDatasetInfo(resp)
+	info.QuestionList = make([]*request.KnowledgeEvalQuestion, 0)
+	for _, question := range resp.QuestionList {
+		info.QuestionList = append(info.QuestionList, &request.KnowledgeEvalQuestion{
+			Question:              question.Question,
+			ExpectedDocIdList:     question.ExpectedDocIdList,
+			ExpectedContentIdList: question.ExpectedContentIdList,
+			ReferenceAnswer:       question.ReferenceAnswer,
+		})
+	}
+	return info, nil
+}
+
+// CreateKnowledgeEvalRun 运行检索评测
+func CreateKnowledgeEvalRun(ctx *gin.Context, userId, orgId string, r *request.CreateKnowledgeEvalRunReq) (*response.CreateKnowledgeEvalRunResp, error) {
+	matchParams := r.KnowledgeMatchParams
+	resp, err := knowledgeBase.CreateKnowledgeEvalRun(ctx.Request.Context(), &knowledgebase_service.CreateKnowledgeEvalRunReq{
+		UserId:    userId,
+		OrgId:     orgId,
+		DatasetId: r.DatasetId,
+		KnowledgeMatchParams: &knowledgebase_service.KnowledgeMatchParams{
+			MatchType:         matchParams.MatchType,
+			RerankModelId:     matchParams.RerankModelId,
+			PriorityMatch:     matchParams.PriorityMatch,
+			SemanticsPriority: matchParams.SemanticsPriority,
+			KeywordPriority:   matchParams.KeywordPriority,
+			TopK:              matchParams.TopK,
+			Score:             matchParams.Threshold,
+			TermWeight:        matchParams.TermWeight,
+			TermWeightEnable:  matchParams.TermWeightEnable,
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &response.CreateKnowledgeEvalRunResp{RunId: resp.RunId}, nil
+}
+
+// GetKnowledgeEvalRunList 查询检索评测列表
+func GetKnowledgeEvalRunList(ctx *gin.Context, userId, orgId string, r *request.KnowledgeEvalRunListReq) (*response.KnowledgeEvalRunListResp, error) {
+	resp, err := knowledgeBase.GetKnowledgeEvalRunList(ctx.Request.Context(), &knowledgebase_service.KnowledgeEvalRunListReq{
+		UserId:    userId,
+		OrgId:     orgId,
+		DatasetId: r.DatasetId,
+	})
+	if err != nil {
+		return nil, err
+	}
+	var runList = make([]*response.KnowledgeEvalRunInfo, 0)
+	for _, run := range resp.RunList {
+		runList = append(runList, buildKnowledgeEvalRunInfo(run))
+	}
+	return &response.KnowledgeEvalRunListResp{RunList: runList}, nil
+}
+
+// GetKnowledgeEvalRun 查询检索评测详情
+func GetKnowledgeEvalRun(ctx *gin.Context, userId, orgId string, r *request.KnowledgeEvalRunReq) (*response.KnowledgeEvalRunDetail, error) {
+	resp, err := knowledgeBase.GetKnowledgeEvalRun(ctx.Request.Context(), &knowledgebase_service.KnowledgeEvalRunReq{
+		UserId: userId,
+		OrgId:  orgId,
+		RunId:  r.RunId,
+	})
+	if err != nil {
+		return nil, err
+	}
+	detail := &response.KnowledgeEvalRunDetail{
+		Run:        buildKnowledgeEvalRunInfo(resp.Run),
+		ResultList: make([]*response.KnowledgeEvalQuestionResult, 0),
+	}
+	for _, result := range resp.ResultList {
+		var hitList = make([]*response.KnowledgeEvalHit, 0)
+		for _, hit := range result.HitList {
+			hitList = append(hitList, &response.KnowledgeEvalHit{
+				DocId:     hit.DocId,
+				ContentId: hit.ContentId,
+				Title:     hit.Title,
+				Snippet:   hit.Snippet,
+				Score:     hit.Score,
+				Relevant:  hit.Relevant,
+			})
+		}
+		detail.ResultList = append(detail.ResultList, &response.KnowledgeEvalQuestionResult{
+			QuestionId: result.QuestionId,
+			Question:   result.Question,
+			HitList:    hitList,
+			Metrics:    buildKnowledgeEvalMetrics(result.Metrics),
+			ErrorMsg:   result.ErrorMsg,
+		})
+	}
+	return detail, nil
+}
+
+// CompareKnowledgeEvalRun 对比同一数据集下的两次检索评测
+func CompareKnowledgeEvalRun(ctx *gin.Context, userId, orgId string, r *request.CompareKnowledgeEvalRunReq) (*response.CompareKnowledgeEvalRunResp, error) {
+	resp, err := knowledgeBase.CompareKnowledgeEvalRun(ctx.Request.Context(), &knowledgebase_service.CompareKnowledgeEvalRunReq{
+		UserId:      userId,
+		OrgId:       orgId,
+		BaseRunId:   r.BaseRunId,
+		TargetRunId: r.TargetRunId,
+	})
+	if err != nil {
+		return nil, err
+	}
+	ret := &response.CompareKnowledgeEvalRunResp{
+		BaseRun:      buildKnowledgeEvalRunInfo(resp.BaseRun),
+		TargetRun:    buildKnowledgeEvalRunInfo(resp.TargetRun),
+		Delta:        buildKnowledgeEvalMetrics(resp.Delta),
+		QuestionList: make([]*response.KnowledgeEvalQuestionCompare, 0),
+	}
+	for _, question := range resp.QuestionList {
+		ret.QuestionList = append(ret.QuestionList, &response.KnowledgeEvalQuestionCompare{
+			QuestionId:    question.QuestionId,
+			Question:      question.Question,
+			BaseMetrics:   buildKnowledgeEvalMetrics(question.BaseMetrics),
+			TargetMetrics: buildKnowledgeEvalMetrics(question.TargetMetrics),
+		})
+	}
+	return ret, nil
+}
+
+func buildKnowledgeEvalDatasetInfo(dataset *knowledgebase_service.KnowledgeEvalDatasetInfo) *response.KnowledgeEvalDatasetInfo {
+	return &response.KnowledgeEvalDatasetInfo{
+		DatasetId:     dataset.DatasetId,
+		KnowledgeId:   dataset.KnowledgeId,
+		Name:          dataset.Name,
+		Description:   dataset.Description,
+		QuestionCount: dataset.QuestionCount,
+		CreatedAt:     dataset.CreatedAt,
+	}
+}
+
+func buildKnowledgeEvalRunInfo(run *knowledgebase_service.KnowledgeEvalRunInfo) *response.KnowledgeEvalRunInfo {
+	if run == nil {
+		return nil
+	}
+	info := &response.KnowledgeEvalRunInfo{
+		RunId:       run.RunId,
+		DatasetId:   run.DatasetId,
+		KnowledgeId: run.KnowledgeId,
+		Status:      run.Status,
+		TotalCount:  run.TotalCount,
+		FinishCount: run.FinishCount,
+		Metrics:     buildKnowledgeEvalMetrics(run.Metrics),
+		ErrorMsg:    run.ErrorMsg,
+		CreatedAt:   run.CreatedAt,
+	}
+	if matchParams := run.KnowledgeMatchParams; matchParams != nil {
+		info.KnowledgeMatchParams = &request.KnowledgeMatchParams{
+			MatchType:         matchParams.MatchType,
+			RerankModelId:     matchParams.RerankModelId,
+			PriorityMatch:     matchParams.PriorityMatch,
+			SemanticsPriority: matchParams.SemanticsPriority,
+			KeywordPriority:   matchParams.KeywordPriority,
+			TopK:              matchParams.TopK,
+			Threshold:         matchParams.Score,
+			TermWeight:        matchParams.TermWeight,
+			TermWeightEnable:  matchParams.TermWeightEnable,
+		}
+	}
+	return info
+}
+
+func buildKnowledgeEvalMetrics(metrics *knowledgebase_service.KnowledgeEvalMetrics) *response.KnowledgeEvalMetrics {
+	if metrics == nil {
+		return &response.KnowledgeEvalMetrics{}
+	}
+	return &response.KnowledgeEvalMetrics{
+		Recall:  metrics.Recall,
+		Mrr:     metrics.Mrr,
+		Ndcg:    metrics.Ndcg,
+		HitRate: metrics.HitRate,
+	}
+}
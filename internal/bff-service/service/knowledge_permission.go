@@ -0,0 +1,112 @@
+package service
+
+import (
+	iam_service "github.com/UnicomAI/wanwu/api/proto/iam-service"
+	knowledgebase_service "github.com/UnicomAI/wanwu/api/proto/knowledgebase-service"
+	"github.com/UnicomAI/wanwu/internal/bff-service/model/request"
+	"github.com/UnicomAI/wanwu/internal/bff-service/model/response"
+	"github.com/UnicomAI/wanwu/pkg/log"
+	"github.com/gin-gonic/gin"
+)
+
+// GrantKnowledgePermission 分享知识库
+func GrantKnowledgePermission(ctx *gin.Context, userId, orgId string, r *request.KnowledgePermissionGrantReq) error {
+	var granteeList []*knowledgebase_service.KnowledgeGrantee
+	for _, grantee := range r.GranteeList {
+		granteeList = append(granteeList, &knowledgebase_service.KnowledgeGrantee{
+			GranteeType:    grantee.GranteeType,
+			GranteeId:      grantee.GranteeId,
+			PermissionType: grantee.PermissionType,
+		})
+	}
+	_, err := knowledgeBase.GrantKnowledgePermission(ctx.Request.Context(), &knowledgebase_service.GrantKnowledgePermissionReq{
+		UserId:      userId,
+		OrgId:       orgId,
+		KnowledgeId: r.KnowledgeId,
+		GranteeList: granteeList,
+	})
+	return err
+}
+
+// RevokeKnowledgePermission 取消分享知识库
+func RevokeKnowledgePermission(ctx *gin.Context, userId, orgId string, r *request.KnowledgePermissionRevokeReq) error {
+	_, err := knowledgeBase.RevokeKnowledgePermission(ctx.Request.Context(), &knowledgebase_service.RevokeKnowledgePermissionReq{
+		UserId:      userId,
+		OrgId:       orgId,
+		KnowledgeId: r.KnowledgeId,
+		GranteeType: r.GranteeType,
+		GranteeId:   r.GranteeId,
+	})
+	return err
+}
+
+// GetKnowledgePermissionList 查询知识库分享列表
+func GetKnowledgePermissionList(ctx *gin.Context, userId, orgId string, r *request.KnowledgePermissionListReq) (*response.KnowledgePermissionListResp, error) {
+	resp, err := knowledgeBase.GetKnowledgePermissionList(ctx.Request.Context(), &knowledgebase_service.KnowledgePermissionListReq{
+		UserId:      userId,
+		OrgId:       orgId,
+		KnowledgeId: r.KnowledgeId,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return buildKnowledgePermissionList(ctx, userId, orgId, resp.List), nil
+}
+
+// buildKnowledgePermissionList 构造知识库分享列表，补充分享对象与分享人名称；名称查询失败时仅返回id
+func buildKnowledgePermissionList(ctx *gin.Context, userId, orgId string, permissionList []*knowledgebase_service.KnowledgePermissionInfo) *response.KnowledgePermissionListResp {
+	names := knowledgeGranteeNames(ctx, userId, orgId, permissionList)
+	list := make([]*response.KnowledgePermissionInfo, 0, len(permissionList))
+	for _, permission := range permissionList {
+		list = append(list, &response.KnowledgePermissionInfo{
+			GranteeType:    permission.GranteeType,
+			GranteeId:      permission.GranteeId,
+			GranteeName:    names[permission.GranteeType][permission.GranteeId],
+			PermissionType: permission.PermissionType,
+			SharerId:       permission.UserId,
+			SharerName:     names[request.KnowledgeGranteeUser][permission.UserId],
+			CreateAt:       permission.CreatedAt,
+		})
+	}
+	return &response.KnowledgePermissionListResp{List: list}
+}
+
+// knowledgeGranteeNames 查询分享对象类型 -> id -> 名称
+func knowledgeGranteeNames(ctx *gin.Context, userId, orgId string, permissionList []*knowledgebase_service.KnowledgePermissionInfo) map[string]map[string]string {
+	names := map[string]map[string]string{
+		request.KnowledgeGranteeUser: {},
+		request.KnowledgeGranteeRole: {},
+		request.KnowledgeGranteeOrg:  {},
+	}
+	var userIds []string
+	for _, permission := range permissionList {
+		userIds = append(userIds, permission.UserId)
+		if permission.GranteeType == request.KnowledgeGranteeUser {
+			userIds = append(userIds, permission.GranteeId)
+		}
+	}
+	if len(userIds) > 0 {
+		if users, err := iam.GetUserSelectByUserIDs(ctx.Request.Context(), &iam_service.GetUserSelectByUserIDsReq{UserIds: userIds}); err != nil {
+			log.Warnf("knowledge permission get user select err: %v", err)
+		} else {
+			for _, user := range users.Selects {
+				names[request.KnowledgeGranteeUser][user.Id] = user.Name
+			}
+		}
+	}
+	if roles, err := iam.GetRoleSelect(ctx.Request.Context(), &iam_service.GetRoleSelectReq{OrgId: orgId}); err != nil {
+		log.Warnf("knowledge permission get role select err: %v", err)
+	} else {
+		for _, role := range roles.Roles {
+			names[request.KnowledgeGranteeRole][role.Id] = role.Name
+		}
+	}
+	if orgs, err := iam.GetOrgSelect(ctx.Request.Context(), &iam_service.GetOrgSelectReq{UserId: userId}); err != nil {
+		log.Warnf("knowledge permission get org select err: %v", err)
+	} else {
+		for _, org := range orgs.Selects {
+			names[request.KnowledgeGranteeOrg][org.Id] = org.Name
+		}
+	}
+	return names
+}
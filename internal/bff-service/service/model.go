@@ -18,6 +18,12 @@ func ImportModel(ctx *gin.Context, userId, orgId string, req *request.ImportOrUp
 	if err != nil {
 		return err
 	}
+	if err = validateRoutingPolicy(ctx, clientReq); err != nil {
+		return err
+	}
+	if err = validateCachePolicy(ctx, clientReq); err != nil {
+		return err
+	}
 	if err = ValidateModel(ctx, clientReq); err != nil {
 		return grpc_util.ErrorStatus(err_code.Code_BFFGeneral, fmt.Sprintf("An error occurred during model import validation: Invalid model: %v, err : %v", clientReq.Model, err))
 	}
@@ -36,6 +42,12 @@ func UpdateModel(ctx *gin.Context, userId, orgId string, req *request.ImportOrUp
 	if err != nil {
 		return err
 	}
+	if err = validateRoutingPolicy(ctx, clientReq); err != nil {
+		return err
+	}
+	if err = validateCachePolicy(ctx, clientReq); err != nil {
+		return err
+	}
 	if err = ValidateModel(ctx, clientReq); err != nil {
 		return grpc_util.ErrorStatus(err_code.Code_BFFGeneral, fmt.Sprintf("An error occurred during model update validation: Invalid model: %v, err : %v", clientReq.Model, err))
 	}
@@ -153,9 +165,75 @@ func parseImportAndUpdateClientReq(userId, orgId string, req *request.ImportOrUp
 		return nil, grpc_util.ErrorStatus(err_code.Code_BFFInvalidArg, err.Error())
 	}
 	clientReq.ProviderConfig = configStr
+	routingPolicyStr, err := req.RoutingPolicyString()
+	if err != nil {
+		return nil, grpc_util.ErrorStatus(err_code.Code_BFFInvalidArg, err.Error())
+	}
+	clientReq.RoutingPolicy = routingPolicyStr
+	cachePolicyStr, err := req.CachePolicyString()
+	if err != nil {
+		return nil, grpc_util.ErrorStatus(err_code.Code_BFFInvalidArg, err.Error())
+	}
+	clientReq.CachePolicy = cachePolicyStr
 	return clientReq, nil
 }
 
+// validateRoutingPolicy 校验路由策略中的备选模型均为已导入的llm，且不包含模型自身
+func validateRoutingPolicy(ctx *gin.Context, modelInfo *model_service.ModelInfo) error {
+	policy, err := mp.ToRoutingPolicy(modelInfo.RoutingPolicy)
+	if err != nil {
+		return grpc_util.ErrorStatus(err_code.Code_BFFInvalidArg, err.Error())
+	}
+	if policy == nil || len(policy.FallbackModelIds) == 0 {
+		return nil
+	}
+	for _, fallbackId := range policy.FallbackModelIds {
+		if modelInfo.ModelId != "" && fallbackId == modelInfo.ModelId {
+			return grpc_util.ErrorStatus(err_code.Code_BFFInvalidArg, "routing policy fallback model cannot be itself")
+		}
+	}
+	fallbacks, err := model.GetModelByIds(ctx.Request.Context(), &model_service.GetModelByIdsReq{ModelIds: policy.FallbackModelIds})
+	if err != nil {
+		return err
+	}
+	fallbackMap := make(map[string]*model_service.ModelInfo)
+	for _, fallback := range fallbacks.Models {
+		fallbackMap[fallback.ModelId] = fallback
+	}
+	for _, fallbackId := range policy.FallbackModelIds {
+		fallback, ok := fallbackMap[fallbackId]
+		if !ok || fallback.OrgId != modelInfo.OrgId {
+			return grpc_util.ErrorStatus(err_code.Code_BFFInvalidArg, fmt.Sprintf("routing policy fallback model %v not found", fallbackId))
+		}
+		if fallback.ModelType != mp.ModelTypeLLM {
+			return grpc_util.ErrorStatus(err_code.Code_BFFInvalidArg, fmt.Sprintf("routing policy fallback model %v is not llm", fallbackId))
+		}
+	}
+	return nil
+}
+
+// validateCachePolicy 校验语义缓存使用的embedding模型为已导入的embedding模型
+func validateCachePolicy(ctx *gin.Context, modelInfo *model_service.ModelInfo) error {
+	policy, err := mp.ToCachePolicy(modelInfo.CachePolicy)
+	if err != nil {
+		return grpc_util.ErrorStatus(err_code.Code_BFFInvalidArg, err.Error())
+	}
+	if policy == nil || !policy.SemanticEnable {
+		return nil
+	}
+	embedding, err := model.GetModelById(ctx.Request.Context(), &model_service.GetModelByIdReq{ModelId: policy.SemanticEmbeddingModelId})
+	if err != nil {
+		return err
+	}
+	if embedding.OrgId != modelInfo.OrgId {
+		return grpc_util.ErrorStatus(err_code.Code_BFFInvalidArg, fmt.Sprintf("cache policy semantic embedding model %v not found", policy.SemanticEmbeddingModelId))
+	}
+	if embedding.ModelType != mp.ModelTypeEmbedding {
+		return grpc_util.ErrorStatus(err_code.Code_BFFInvalidArg, fmt.Sprintf("cache policy semantic embedding model %v is not embedding", policy.SemanticEmbeddingModelId))
+	}
+	return nil
+}
+
 func toModelInfos(ctx *gin.Context, models []*model_service.ModelInfo) ([]*response.ModelInfo, error) {
 	var ret []*response.ModelInfo
 	for _, m := range models {
@@ -177,22 +255,32 @@ func toModelInfo(ctx *gin.Context, modelInfo *model_service.ModelInfo) (*respons
 	if err != nil {
 		return nil, grpc_util.ErrorStatus(err_code.Code_BFFGeneral, fmt.Sprintf("model %v get model tags err: %v", modelInfo.ModelId, err))
 	}
+	routingPolicy, err := mp.ToRoutingPolicy(modelInfo.RoutingPolicy)
+	if err != nil {
+		return nil, grpc_util.ErrorStatus(err_code.Code_BFFGeneral, fmt.Sprintf("model %v get routing policy err: %v", modelInfo.ModelId, err))
+	}
+	cachePolicy, err := mp.ToCachePolicy(modelInfo.CachePolicy)
+	if err != nil {
+		return nil, grpc_util.ErrorStatus(err_code.Code_BFFGeneral, fmt.Sprintf("model %v get cache policy err: %v", modelInfo.ModelId, err))
+	}
 	res := &response.ModelInfo{
-		ModelId:     modelInfo.ModelId,
-		Provider:    modelInfo.Provider,
-		Model:       modelInfo.Model,
-		ModelType:   modelInfo.ModelType,
-		DisplayName: modelInfo.DisplayName,
-		Avatar:      CacheAvatar(ctx, modelInfo.ModelIconPath, true),
-		PublishDate: modelInfo.PublishDate,
-		IsActive:    modelInfo.IsActive,
-		UserId:      modelInfo.UserId,
-		OrgId:       modelInfo.OrgId,
-		CreatedAt:   util.Time2Str(modelInfo.CreatedAt),
-		UpdatedAt:   util.Time2Str(modelInfo.UpdatedAt),
-		ModelDesc:   modelInfo.ModelDesc,
-		Config:      modelConfig,
-		Tags:        tags,
+		ModelId:       modelInfo.ModelId,
+		Provider:      modelInfo.Provider,
+		Model:         modelInfo.Model,
+		ModelType:     modelInfo.ModelType,
+		DisplayName:   modelInfo.DisplayName,
+		Avatar:        CacheAvatar(ctx, modelInfo.ModelIconPath, true),
+		PublishDate:   modelInfo.PublishDate,
+		IsActive:      modelInfo.IsActive,
+		UserId:        modelInfo.UserId,
+		OrgId:         modelInfo.OrgId,
+		CreatedAt:     util.Time2Str(modelInfo.CreatedAt),
+		UpdatedAt:     util.Time2Str(modelInfo.UpdatedAt),
+		ModelDesc:     modelInfo.ModelDesc,
+		Config:        modelConfig,
+		Tags:          tags,
+		RoutingPolicy: routingPolicy,
+		CachePolicy:   cachePolicy,
 	}
 	if res.DisplayName == "" {
 		res.DisplayName = res.Model
@@ -0,0 +1,81 @@
+package service
+
+import (
+	"fmt"
+
+	err_code "github.com/UnicomAI/wanwu/api/proto/err-code"
+	model_service "github.com/UnicomAI/wanwu/api/proto/model-service"
+	"github.com/UnicomAI/wanwu/internal/bff-service/model/request"
+	"github.com/UnicomAI/wanwu/internal/bff-service/model/response"
+	grpc_util "github.com/UnicomAI/wanwu/pkg/grpc-util"
+	"github.com/UnicomAI/wanwu/pkg/log"
+	mp "github.com/UnicomAI/wanwu/pkg/model-provider"
+	"github.com/UnicomAI/wanwu/pkg/redis"
+	"github.com/gin-gonic/gin"
+)
+
+func GetModelCacheStat(ctx *gin.Context, userId, orgId string, req *request.GetModelRequest) (*response.ModelCacheStat, error) {
+	// 校验模型归属
+	if _, err := model.GetModel(ctx.Request.Context(), &model_service.GetModelReq{
+		ModelId: req.ModelId,
+		UserId:  userId,
+		OrgId:   orgId,
+	}); err != nil {
+		return nil, err
+	}
+	ret := &response.ModelCacheStat{ModelId: req.ModelId}
+	if redis.Model() == nil {
+		return ret, nil
+	}
+	stat, err := mp.GetCacheStat(ctx.Request.Context(), redis.Model(), req.ModelId)
+	if err != nil {
+		return nil, grpc_util.ErrorStatus(err_code.Code_BFFGeneral, fmt.Sprintf("model %v get cache stat err: %v", req.ModelId, err))
+	}
+	ret.Hit, ret.SemanticHit, ret.Miss = stat.Hit, stat.SemanticHit, stat.Miss
+	return ret, nil
+}
+
+// toModelCache 根据模型的缓存策略与请求头返回调用方的模型缓存，未开启时返回nil；缓存状态通过响应头 X-Cache 返回
+func toModelCache(ctx *gin.Context, modelInfo *model_service.ModelInfo) (*mp.Cache, error) {
+	policy, err := mp.ToCachePolicy(modelInfo.CachePolicy)
+	if err != nil {
+		return nil, err
+	}
+	if policy == nil || !policy.Enable || redis.Model() == nil {
+		return nil, nil
+	}
+	// 缓存按调用方隔离，避免跨组织、跨用户返回他人的模型结果
+	orgId, userId, _, _ := modelUsageIdentity(ctx, modelInfo)
+	return mp.NewCache(redis.Model(), modelInfo.ModelId, orgId+":"+userId, policy, mp.ParseCacheControl(ctx.Request.Header), func(status string) {
+		ctx.Header(mp.CacheStatusHeader, status)
+	}), nil
+}
+
+// toCacheLLM 返回带缓存的ILLM；语义缓存的embedding模型不可用时仅使用精确缓存
+func toCacheLLM(ctx *gin.Context, modelInfo *model_service.ModelInfo, iLLM mp.ILLM) (mp.ILLM, error) {
+	cache, err := toModelCache(ctx, modelInfo)
+	if err != nil || cache == nil {
+		return iLLM, err
+	}
+	policy, _ := mp.ToCachePolicy(modelInfo.CachePolicy)
+	if !policy.SemanticEnable {
+		return mp.NewCacheLLM(iLLM, cache, nil), nil
+	}
+	embeddingInfo, err := model.GetModelById(ctx.Request.Context(), &model_service.GetModelByIdReq{ModelId: policy.SemanticEmbeddingModelId})
+	if err != nil || !embeddingInfo.IsActive || embeddingInfo.ModelType != mp.ModelTypeEmbedding {
+		log.Warnf("model %v semantic cache skip embedding model %v: not found, inactive or not embedding", modelInfo.ModelId, policy.SemanticEmbeddingModelId)
+		return mp.NewCacheLLM(iLLM, cache, nil), nil
+	}
+	embedding, err := mp.ToModelConfig(embeddingInfo.Provider, embeddingInfo.ModelType, embeddingInfo.ProviderConfig)
+	if err != nil {
+		log.Warnf("model %v semantic cache skip embedding model %v: %v", modelInfo.ModelId, embeddingInfo.ModelId, err)
+		return mp.NewCacheLLM(iLLM, cache, nil), nil
+	}
+	iEmbedding, ok := embedding.(mp.IEmbedding)
+	if !ok {
+		log.Warnf("model %v semantic cache skip embedding model %v: invalid provider", modelInfo.ModelId, embeddingInfo.ModelId)
+		return mp.NewCacheLLM(iLLM, cache, nil), nil
+	}
+	iEmbedding = mp.NewUsageEmbedding(iEmbedding, modelUsageRecorder(ctx, embeddingInfo))
+	return mp.NewCacheLLM(iLLM, cache, mp.NewEmbedder(iEmbedding, embeddingInfo.Model)), nil
+}
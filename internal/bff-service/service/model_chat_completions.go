@@ -1,9 +1,11 @@
 package service
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/http"
+	"strings"
 
 	err_code "github.com/UnicomAI/wanwu/api/proto/err-code"
 	model_service "github.com/UnicomAI/wanwu/api/proto/model-service"
@@ -22,6 +24,11 @@ func ModelChatCompletions(ctx *gin.Context, modelID string, req *mp_common.LLMRe
 		gin_util.Response(ctx, nil, err)
 		return
 	}
+	// 配额校验
+	if err := checkModelCallQuota(ctx, modelInfo); err != nil {
+		ResponseModelQuotaErr(ctx, err)
+		return
+	}
 	// 校验model字段
 	if req != nil {
 		if req.Model != modelInfo.Model {
@@ -31,15 +38,15 @@ func ModelChatCompletions(ctx *gin.Context, modelID string, req *mp_common.LLMRe
 	}
 
 	// llm config
-	llm, err := mp.ToModelConfig(modelInfo.Provider, modelInfo.ModelType, modelInfo.ProviderConfig)
+	iLLM, err := toRoutingLLM(ctx, modelInfo)
 	if err != nil {
 		gin_util.Response(ctx, nil, grpc_util.ErrorStatus(err_code.Code_BFFGeneral, fmt.Sprintf("model %v chat completions err: %v", modelInfo.ModelId, err)))
 		return
 	}
-
-	iLLM, ok := llm.(mp.ILLM)
-	if !ok {
-		gin_util.Response(ctx, nil, grpc_util.ErrorStatus(err_code.Code_BFFGeneral, fmt.Sprintf("model %v chat completions err: invalid provider", modelInfo.ModelId)))
+	// 命中缓存时不调用模型，不记录用量
+	iLLM, err = toCacheLLM(ctx, modelInfo, iLLM)
+	if err != nil {
+		gin_util.Response(ctx, nil, grpc_util.ErrorStatus(err_code.Code_BFFGeneral, fmt.Sprintf("model %v chat completions err: %v", modelInfo.ModelId, err)))
 		return
 	}
 
@@ -51,7 +58,18 @@ func ModelChatCompletions(ctx *gin.Context, modelID string, req *mp_common.LLMRe
 	}
 	resp, sseCh, err := iLLM.ChatCompletions(ctx.Request.Context(), llmReq)
 	if err != nil {
-		gin_util.Response(ctx, nil, grpc_util.ErrorStatus(err_code.Code_BFFGeneral, fmt.Sprintf("model %v chat completions err: %v", modelInfo.ModelId, err)))
+		log.Errorf("model %v chat completions err: %v", modelInfo.ModelId, err)
+		streamErr := mp_common.NewStreamError(modelInfo.Provider, err, false)
+		if llmReq.Stream() {
+			// 流式请求以OpenAI兼容的error事件返回，http状态码与上游一致
+			writeSSEHeader(ctx, streamErr.HttpStatus())
+			writeSSEError(ctx, modelInfo.ModelId, streamErr)
+			return
+		}
+		b, _ := json.Marshal(streamErr.OpenAIError())
+		ctx.Set(gin_util.STATUS, streamErr.HttpStatus())
+		ctx.Set(gin_util.RESULT, string(b))
+		ctx.JSON(streamErr.HttpStatus(), streamErr.OpenAIError())
 		return
 	}
 	// unary
@@ -68,15 +86,21 @@ func ModelChatCompletions(ctx *gin.Context, modelID string, req *mp_common.LLMRe
 	}
 	// stream
 	var answer string
-	ctx.Header("Cache-Control", "no-cache")
-	ctx.Header("Connection", "keep-alive")
-	ctx.Header("Content-Type", "text/event-stream; charset=utf-8")
+	writeSSEHeader(ctx, http.StatusOK)
 	var (
 		firstFlag = false // 思维链起始标识符，默认思维链未开始
 		endFlag   = false // 思维链结束标识符，默认思维链未结束
 	)
 	var data *mp_common.LLMResp
+	var ok bool
 	for sseResp := range sseCh {
+		// 上游终止错误事件
+		if streamErr, isErr := mp_common.ToStreamError(sseResp); isErr {
+			log.Errorf("model %v chat completions sse err: %v", modelInfo.ModelId, streamErr)
+			writeSSEError(ctx, modelInfo.ModelId, streamErr)
+			ctx.Set(gin_util.RESULT, answer+"\n"+streamErr.String())
+			return
+		}
 		data, ok = sseResp.ConvertResp()
 		dataStr := ""
 		if ok && data != nil {
@@ -108,6 +132,124 @@ func ModelChatCompletions(ctx *gin.Context, modelID string, req *mp_common.LLMRe
 		}
 		ctx.Writer.Flush()
 	}
-	ctx.Set(gin_util.STATUS, http.StatusOK)
 	ctx.Set(gin_util.RESULT, answer)
 }
+
+func writeSSEHeader(ctx *gin.Context, status int) {
+	ctx.Header("Cache-Control", "no-cache")
+	ctx.Header("Connection", "keep-alive")
+	ctx.Header("Content-Type", "text/event-stream; charset=utf-8")
+	ctx.Status(status)
+	ctx.Set(gin_util.STATUS, status)
+}
+
+// writeSSEError 输出OpenAI兼容的error事件，并以 data: [DONE] 结束流
+func writeSSEError(ctx *gin.Context, modelId string, streamErr *mp_common.StreamError) {
+	dataStr := fmt.Sprintf("%v\n\ndata: [DONE]\n\n", streamErr.String())
+	if _, err := ctx.Writer.Write([]byte(dataStr)); err != nil {
+		log.Errorf("model %v chat completions sse err: %v", modelId, err)
+	}
+	ctx.Writer.Flush()
+	ctx.Set(gin_util.RESULT, streamErr.String())
+}
+
+// toRoutingLLM 根据模型的路由策略，返回带重试、回退能力的ILLM；用量按实际调用的模型分别记录
+func toRoutingLLM(ctx *gin.Context, modelInfo *model_service.ModelInfo) (mp.ILLM, error) {
+	return newRoutingLLM(ctx.Request.Context(), modelInfo, func(info *model_service.ModelInfo) mp.UsageRecorder {
+		return modelUsageRecorder(ctx, info)
+	})
+}
+
+// newRoutingLLM 同toRoutingLLM，供后台任务等没有http请求的场景使用；recorder返回各路由目标模型的用量recorder
+func newRoutingLLM(ctx context.Context, modelInfo *model_service.ModelInfo, recorder func(*model_service.ModelInfo) mp.UsageRecorder) (mp.ILLM, error) {
+	iLLM, err := toLLM(modelInfo)
+	if err != nil {
+		return nil, err
+	}
+	iLLM = mp.NewUsageLLM(iLLM, recorder(modelInfo))
+	policy, err := mp.ToRoutingPolicy(modelInfo.RoutingPolicy)
+	if err != nil {
+		return nil, err
+	}
+	if policy == nil {
+		return iLLM, nil
+	}
+	targets := []mp.RoutingTarget{{ModelId: modelInfo.ModelId, Model: modelInfo.Model, LLM: iLLM}}
+	if len(policy.FallbackModelIds) > 0 {
+		fallbacks, err := model.GetModelByIds(ctx, &model_service.GetModelByIdsReq{ModelIds: policy.FallbackModelIds})
+		if err != nil {
+			return nil, err
+		}
+		// 按策略中的顺序回退
+		fallbackMap := make(map[string]*model_service.ModelInfo)
+		for _, fallback := range fallbacks.Models {
+			fallbackMap[fallback.ModelId] = fallback
+		}
+		for _, fallbackId := range policy.FallbackModelIds {
+			fallback, ok := fallbackMap[fallbackId]
+			if !ok || !fallback.IsActive || fallback.ModelType != mp.ModelTypeLLM {
+				log.Warnf("model %v routing policy skip fallback model %v: not found, inactive or not llm", modelInfo.ModelId, fallbackId)
+				continue
+			}
+			fallbackLLM, err := toLLM(fallback)
+			if err != nil {
+				log.Warnf("model %v routing policy skip fallback model %v: %v", modelInfo.ModelId, fallbackId, err)
+				continue
+			}
+			targets = append(targets, mp.RoutingTarget{ModelId: fallback.ModelId, Model: fallback.Model, LLM: mp.NewUsageLLM(fallbackLLM, recorder(fallback))})
+		}
+	}
+	return mp.NewRoutingLLM(policy, targets...), nil
+}
+
+// completeLLM 非流式调用模型，返回回答内容
+func completeLLM(ctx context.Context, llm mp.ILLM, model, system, user string) (string, error) {
+	stream := false
+	llmReq, err := llm.NewReq(&mp_common.LLMReq{
+		Model: model,
+		Messages: []mp_common.OpenAIReqMsg{
+			{Role: mp_common.MsgRoleSystem, Content: system},
+			{Role: mp_common.MsgRoleUser, Content: user},
+		},
+		Stream: &stream,
+	})
+	if err != nil {
+		return "", err
+	}
+	resp, _, err := llm.ChatCompletions(ctx, llmReq)
+	if err != nil {
+		return "", err
+	}
+	data, ok := resp.ConvertResp()
+	if !ok || len(data.Choices) == 0 || data.Choices[0].Message == nil {
+		return "", fmt.Errorf("invalid llm resp: %v", resp.String())
+	}
+	return data.Choices[0].Message.Content, nil
+}
+
+// unmarshalLLMJSON 解析模型输出的json，忽略思考内容和json外的文本
+func unmarshalLLMJSON(content string, v interface{}) error {
+	if idx := strings.LastIndex(content, "</think>"); idx >= 0 {
+		content = content[idx+len("</think>"):]
+	}
+	start, end := strings.Index(content, "{"), strings.LastIndex(content, "}")
+	if start < 0 || end <= start {
+		return fmt.Errorf("llm output not json: %v", content)
+	}
+	if err := json.Unmarshal([]byte(content[start:end+1]), v); err != nil {
+		return fmt.Errorf("llm output not json: %v", content)
+	}
+	return nil
+}
+
+func toLLM(modelInfo *model_service.ModelInfo) (mp.ILLM, error) {
+	llm, err := mp.ToModelConfig(modelInfo.Provider, modelInfo.ModelType, modelInfo.ProviderConfig)
+	if err != nil {
+		return nil, err
+	}
+	iLLM, ok := llm.(mp.ILLM)
+	if !ok {
+		return nil, fmt.Errorf("invalid provider")
+	}
+	return iLLM, nil
+}
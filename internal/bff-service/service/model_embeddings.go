@@ -20,6 +20,11 @@ func ModelEmbeddings(ctx *gin.Context, modelID string, req *mp_common.EmbeddingR
 		gin_util.Response(ctx, nil, err)
 		return
 	}
+	// 配额校验
+	if err := checkModelCallQuota(ctx, modelInfo); err != nil {
+		ResponseModelQuotaErr(ctx, err)
+		return
+	}
 
 	// 校验model字段
 	if req != nil {
@@ -40,6 +45,13 @@ func ModelEmbeddings(ctx *gin.Context, modelID string, req *mp_common.EmbeddingR
 		gin_util.Response(ctx, nil, grpc_util.ErrorStatus(err_code.Code_BFFGeneral, fmt.Sprintf("model %v embeddings err: invalid provider", modelInfo.ModelId)))
 		return
 	}
+	iEmbedding = mp.NewUsageEmbedding(iEmbedding, modelUsageRecorder(ctx, modelInfo))
+	cache, err := toModelCache(ctx, modelInfo)
+	if err != nil {
+		gin_util.Response(ctx, nil, grpc_util.ErrorStatus(err_code.Code_BFFGeneral, fmt.Sprintf("model %v embeddings err: %v", modelInfo.ModelId, err)))
+		return
+	}
+	iEmbedding = mp.NewCacheEmbedding(iEmbedding, cache)
 	// embeddings
 	embeddingReq, err := iEmbedding.NewReq(req)
 	if err != nil {
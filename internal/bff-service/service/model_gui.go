@@ -20,6 +20,11 @@ func ModelGui(ctx *gin.Context, modelID string, req *mp_common.GuiReq) {
 		gin_util.Response(ctx, nil, err)
 		return
 	}
+	// 配额校验
+	if err := checkModelCallQuota(ctx, modelInfo); err != nil {
+		ResponseModelQuotaErr(ctx, err)
+		return
+	}
 
 	// gui config
 	gui, err := mp.ToModelConfig(modelInfo.Provider, modelInfo.ModelType, modelInfo.ProviderConfig)
@@ -32,6 +37,7 @@ func ModelGui(ctx *gin.Context, modelID string, req *mp_common.GuiReq) {
 		gin_util.Response(ctx, nil, grpc_util.ErrorStatus(err_code.Code_BFFGeneral, fmt.Sprintf("model %v gui err: invalid provider", modelInfo.ModelId)))
 		return
 	}
+	iGui = mp.NewUsageGui(iGui, modelUsageRecorder(ctx, modelInfo))
 	// gui
 	guiReq, err := iGui.NewReq(req)
 	if err != nil {
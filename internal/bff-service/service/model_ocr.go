@@ -20,6 +20,11 @@ func ModelOcr(ctx *gin.Context, modelID string, req *mp_common.OcrReq) {
 		gin_util.Response(ctx, nil, err)
 		return
 	}
+	// 配额校验
+	if err := checkModelCallQuota(ctx, modelInfo); err != nil {
+		ResponseModelQuotaErr(ctx, err)
+		return
+	}
 	// ocr config
 	ocr, err := mp.ToModelConfig(modelInfo.Provider, modelInfo.ModelType, modelInfo.ProviderConfig)
 	if err != nil {
@@ -31,7 +36,10 @@ func ModelOcr(ctx *gin.Context, modelID string, req *mp_common.OcrReq) {
 		gin_util.Response(ctx, nil, grpc_util.ErrorStatus(err_code.Code_BFFGeneral, fmt.Sprintf("model %v ocr err: invalid provider", modelInfo.ModelId)))
 		return
 	}
+	iOcr = mp.NewUsageOcr(iOcr, modelUsageRecorder(ctx, modelInfo))
 
+	// 多模态大模型OCR需要模型名称
+	req.Model = modelInfo.Model
 	ocrReq, err := iOcr.NewReq(req)
 	if err != nil {
 		gin_util.Response(ctx, nil, grpc_util.ErrorStatus(err_code.Code_BFFGeneral, fmt.Sprintf("model %v ocr NewReq err: %v", modelInfo.ModelId, err)))
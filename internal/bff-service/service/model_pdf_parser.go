@@ -20,6 +20,11 @@ func ModelPdfParser(ctx *gin.Context, modelID string, req *mp_common.PdfParserRe
 		gin_util.Response(ctx, nil, err)
 		return
 	}
+	// 配额校验
+	if err := checkModelCallQuota(ctx, modelInfo); err != nil {
+		ResponseModelQuotaErr(ctx, err)
+		return
+	}
 	// pdfParser config
 	pdfParser, err := mp.ToModelConfig(modelInfo.Provider, modelInfo.ModelType, modelInfo.ProviderConfig)
 	if err != nil {
@@ -31,6 +36,7 @@ func ModelPdfParser(ctx *gin.Context, modelID string, req *mp_common.PdfParserRe
 		gin_util.Response(ctx, nil, grpc_util.ErrorStatus(err_code.Code_BFFGeneral, fmt.Sprintf("model %v pdfParser err: invalid provider", modelInfo.ModelId)))
 		return
 	}
+	iPdfParser = mp.NewUsagePdfParser(iPdfParser, modelUsageRecorder(ctx, modelInfo))
 
 	pdfParserReq, err := iPdfParser.NewReq(req)
 	if err != nil {
@@ -20,6 +20,11 @@ func ModelRerank(ctx *gin.Context, modelID string, req *mp_common.RerankReq) {
 		gin_util.Response(ctx, nil, err)
 		return
 	}
+	// 配额校验
+	if err := checkModelCallQuota(ctx, modelInfo); err != nil {
+		ResponseModelQuotaErr(ctx, err)
+		return
+	}
 
 	// 校验model字段
 	if req != nil {
@@ -40,6 +45,7 @@ func ModelRerank(ctx *gin.Context, modelID string, req *mp_common.RerankReq) {
 		gin_util.Response(ctx, nil, grpc_util.ErrorStatus(err_code.Code_BFFGeneral, fmt.Sprintf("model %v rerank err: invalid provider", modelInfo.ModelId)))
 		return
 	}
+	iRerank = mp.NewUsageRerank(iRerank, modelUsageRecorder(ctx, modelInfo))
 	// rerank
 	rerankReq, err := iRerank.NewReq(req)
 	if err != nil {
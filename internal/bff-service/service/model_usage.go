@@ -0,0 +1,194 @@
+package service
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	err_code "github.com/UnicomAI/wanwu/api/proto/err-code"
+	model_service "github.com/UnicomAI/wanwu/api/proto/model-service"
+	"github.com/UnicomAI/wanwu/internal/bff-service/model/request"
+	"github.com/UnicomAI/wanwu/internal/bff-service/model/response"
+	gin_util "github.com/UnicomAI/wanwu/pkg/gin-util"
+	grpc_util "github.com/UnicomAI/wanwu/pkg/grpc-util"
+	"github.com/UnicomAI/wanwu/pkg/log"
+	mp "github.com/UnicomAI/wanwu/pkg/model-provider"
+	"github.com/UnicomAI/wanwu/pkg/util"
+	"github.com/gin-gonic/gin"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// --- usage ---
+
+func GetModelUsageStats(ctx *gin.Context, orgId string, req *request.ModelUsageStatsRequest) ([]*response.ModelUsageStat, error) {
+	resp, err := model.GetModelUsageStats(ctx.Request.Context(), &model_service.GetModelUsageStatsReq{
+		GroupBy: req.GroupBy,
+		OrgId:   orgId,
+		UserId:  req.UserId,
+		ModelId: req.ModelId,
+		AppId:   req.AppId,
+		StartAt: req.StartAt,
+		EndAt:   req.EndAt,
+	})
+	if err != nil {
+		return nil, err
+	}
+	ret := make([]*response.ModelUsageStat, 0, len(resp.Stats))
+	for _, stat := range resp.Stats {
+		ret = append(ret, &response.ModelUsageStat{
+			ModelId:          stat.ModelId,
+			Provider:         stat.Provider,
+			ModelType:        stat.ModelType,
+			Model:            stat.Model,
+			OrgId:            stat.OrgId,
+			AppId:            stat.AppId,
+			AppType:          stat.AppType,
+			Calls:            stat.Calls,
+			FailedCalls:      stat.FailedCalls,
+			PromptTokens:     stat.PromptTokens,
+			CompletionTokens: stat.CompletionTokens,
+			TotalTokens:      stat.TotalTokens,
+			AvgLatencyMs:     stat.AvgLatencyMs,
+		})
+	}
+	return ret, nil
+}
+
+// --- quota ---
+
+func SetModelQuota(ctx *gin.Context, orgId string, req *request.ModelQuotaRequest) error {
+	_, err := model.SetModelQuota(ctx.Request.Context(), &model_service.ModelQuota{
+		OrgId:         orgId,
+		UserId:        req.UserId,
+		DailyTokens:   req.DailyTokens,
+		MonthlyTokens: req.MonthlyTokens,
+	})
+	return err
+}
+
+func DeleteModelQuota(ctx *gin.Context, orgId string, req *request.DeleteModelQuotaRequest) error {
+	_, err := model.DeleteModelQuota(ctx.Request.Context(), &model_service.DeleteModelQuotaReq{
+		OrgId:  orgId,
+		UserId: req.UserId,
+	})
+	return err
+}
+
+func ListModelQuotas(ctx *gin.Context, orgId string) (*response.ListResult, error) {
+	resp, err := model.ListModelQuotas(ctx.Request.Context(), &model_service.ListModelQuotasReq{
+		OrgId: orgId,
+	})
+	if err != nil {
+		return nil, err
+	}
+	list := make([]*response.ModelQuota, 0, len(resp.Quotas))
+	for _, quota := range resp.Quotas {
+		list = append(list, &response.ModelQuota{
+			OrgId:         quota.OrgId,
+			UserId:        quota.UserId,
+			DailyTokens:   quota.DailyTokens,
+			MonthlyTokens: quota.MonthlyTokens,
+			DailyUsed:     quota.DailyUsed,
+			MonthlyUsed:   quota.MonthlyUsed,
+			UpdatedAt:     util.Time2Str(quota.UpdatedAt),
+		})
+	}
+	return &response.ListResult{
+		List:  list,
+		Total: int64(len(list)),
+	}, nil
+}
+
+// CheckModelQuota 校验组织、用户的token配额，超限时返回错误
+func CheckModelQuota(ctx *gin.Context, orgId, userId string) error {
+	resp, err := model.CheckModelQuota(ctx.Request.Context(), &model_service.CheckModelQuotaReq{
+		OrgId:  orgId,
+		UserId: userId,
+	})
+	if err != nil {
+		return err
+	}
+	if resp.Exceeded {
+		return grpc_util.ErrorStatusWithKey(err_code.Code_BFFModelQuotaExceeded, "bff_model_quota_exceeded", resp.Reason)
+	}
+	return nil
+}
+
+// ResponseModelQuotaErr 返回配额校验错误；配额超限返回429，配额查询失败等其他错误返回500
+func ResponseModelQuotaErr(ctx *gin.Context, err error) {
+	if status.Code(err) == codes.Code(err_code.Code_BFFModelQuotaExceeded) {
+		gin_util.ResponseErrWithStatus(ctx, http.StatusTooManyRequests, err)
+		return
+	}
+	gin_util.ResponseErrWithStatus(ctx, http.StatusInternalServerError, err)
+}
+
+// --- internal ---
+
+// modelUsageIdentity 模型调用方的组织、用户、应用信息；
+// 系统内部调用（callback）通过header传递，未传递时计入模型所属的组织、用户
+func modelUsageIdentity(ctx *gin.Context, modelInfo *model_service.ModelInfo) (orgId, userId, appId, appType string) {
+	orgId = ctx.GetString(gin_util.X_ORG_ID)
+	if orgId == "" {
+		orgId = ctx.GetHeader(gin_util.X_ORG_ID)
+	}
+	userId = ctx.GetString(gin_util.USER_ID)
+	if userId == "" {
+		userId = ctx.GetHeader(gin_util.X_USER_ID)
+	}
+	if orgId == "" {
+		orgId, userId = modelInfo.OrgId, modelInfo.UserId
+	}
+	appId = ctx.GetString(gin_util.APP_ID)
+	if appId == "" {
+		appId = ctx.GetHeader(gin_util.X_APP_ID)
+	}
+	appType = ctx.GetString(gin_util.APP_TYPE)
+	if appType == "" {
+		appType = ctx.GetHeader(gin_util.X_APP_TYPE)
+	}
+	return
+}
+
+// checkModelCallQuota 模型调用前校验调用方配额
+func checkModelCallQuota(ctx *gin.Context, modelInfo *model_service.ModelInfo) error {
+	orgId, userId, _, _ := modelUsageIdentity(ctx, modelInfo)
+	return CheckModelQuota(ctx, orgId, userId)
+}
+
+// modelUsageRecorder 返回将模型调用用量写入model-service的recorder
+func modelUsageRecorder(ctx *gin.Context, modelInfo *model_service.ModelInfo) mp.UsageRecorder {
+	// gin.Context在请求结束后会被复用，提前取出调用方信息
+	orgId, userId, appId, appType := modelUsageIdentity(ctx, modelInfo)
+	return newModelUsageRecorder(modelInfo, orgId, userId, appId, appType)
+}
+
+// newModelUsageRecorder 返回按指定调用方记录模型用量的recorder
+func newModelUsageRecorder(modelInfo *model_service.ModelInfo, orgId, userId, appId, appType string) mp.UsageRecorder {
+	return func(usage *mp.Usage) {
+		go func() {
+			defer util.PrintPanicStack()
+			recordCtx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+			defer cancel()
+			if _, err := model.RecordModelUsage(recordCtx, &model_service.ModelUsage{
+				ModelId:          modelInfo.ModelId,
+				Provider:         modelInfo.Provider,
+				ModelType:        modelInfo.ModelType,
+				Model:            modelInfo.Model,
+				OrgId:            orgId,
+				UserId:           userId,
+				AppId:            appId,
+				AppType:          appType,
+				PromptTokens:     int64(usage.PromptTokens),
+				CompletionTokens: int64(usage.CompletionTokens),
+				TotalTokens:      int64(usage.TotalTokens),
+				LatencyMs:        usage.Latency.Milliseconds(),
+				Status:           usage.Status,
+				ErrMsg:           usage.ErrMsg,
+			}); err != nil {
+				log.Errorf("model %v record usage err: %v", modelInfo.ModelId, err)
+			}
+		}()
+	}
+}
@@ -259,6 +259,7 @@ func ValidateOcrModel(ctx *gin.Context, modelInfo *model_service.ModelInfo) erro
 	}
 	req := &mp_common.OcrReq{
 		Files: fileH,
+		Model: modelInfo.Model,
 	}
 	ocrReq, err := iOcr.NewReq(req)
 	if err != nil {
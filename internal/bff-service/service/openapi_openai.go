@@ -0,0 +1,341 @@
+package service
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	app_service "github.com/UnicomAI/wanwu/api/proto/app-service"
+	assistant_service "github.com/UnicomAI/wanwu/api/proto/assistant-service"
+	err_code "github.com/UnicomAI/wanwu/api/proto/err-code"
+	rag_service "github.com/UnicomAI/wanwu/api/proto/rag-service"
+	"github.com/UnicomAI/wanwu/internal/bff-service/model/request"
+	"github.com/UnicomAI/wanwu/internal/bff-service/model/response"
+	"github.com/UnicomAI/wanwu/pkg/constant"
+	gin_util "github.com/UnicomAI/wanwu/pkg/gin-util"
+	grpc_util "github.com/UnicomAI/wanwu/pkg/grpc-util"
+	"github.com/UnicomAI/wanwu/pkg/log"
+	sse_util "github.com/UnicomAI/wanwu/pkg/sse-util"
+	"github.com/UnicomAI/wanwu/pkg/util"
+	"github.com/gin-gonic/gin"
+)
+
+const (
+	openAIObjectCompletion      = "chat.completion"
+	openAIObjectCompletionChunk = "chat.completion.chunk"
+	openAIFinishReasonStop      = "stop"
+	openAIModelOwnedBy          = "wanwu"
+)
+
+// openAIChatDelta 一行智能体或文本问答流式返回中与OpenAI格式相关的内容
+type openAIChatDelta struct {
+	content   string
+	citations []response.OpenAIChatSearch
+	usage     *response.OpenAIChatUsage
+	msgId     string
+}
+
+// OpenAIListModels OpenAI兼容的模型列表，返回ApiKey可访问的已发布智能体或文本问答应用
+func OpenAIListModels(ctx *gin.Context, appId, appType string) (*response.OpenAIModelList, error) {
+	ret := &response.OpenAIModelList{
+		Object: "list",
+		Data:   []response.OpenAIModel{},
+	}
+	model, err := getOpenAIModel(ctx, appId, appType)
+	if err != nil {
+		return nil, err
+	}
+	if model != nil {
+		ret.Data = append(ret.Data, *model)
+	}
+	return ret, nil
+}
+
+// CheckOpenAIModel 校验model是否为ApiKey可访问的已发布智能体或文本问答应用
+func CheckOpenAIModel(ctx *gin.Context, appId, appType, model string) error {
+	if model == appId {
+		info, err := getOpenAIModel(ctx, appId, appType)
+		if err != nil {
+			return err
+		}
+		if info != nil {
+			return nil
+		}
+	}
+	return grpc_util.ErrorStatusWithKey(err_code.Code_BFFGeneral, "bff_openai_model_not_found", model)
+}
+
+// OpenAIChatCompletions OpenAI兼容的对话，智能体映射到智能体对话（未指定conversation_id时新建对话，只发送最后一条用户消息），
+// 文本问答映射到文本问答（之前的用户、助手消息作为历史）；system等其他角色的消息忽略，以应用自身配置为准
+func OpenAIChatCompletions(ctx *gin.Context, userId, orgId, appId, appType string, req *request.OpenAIChatCompletionRequest) error {
+	prompt := req.Messages[len(req.Messages)-1].Text()
+	completion := &response.OpenAIChatCompletion{
+		ID:      "chatcmpl-" + strings.ReplaceAll(util.GenUUID(), "-", ""),
+		Created: time.Now().Unix(),
+		Model:   appId,
+	}
+	var chatCh <-chan string
+	var err error
+	switch appType {
+	case constant.AppTypeAgent:
+		conversationId := req.ConversationID
+		if conversationId == "" {
+			conversation, err := ConversationCreate(ctx, userId, orgId, request.ConversationCreateRequest{
+				AssistantId: appId,
+				Prompt:      prompt,
+			})
+			if err != nil {
+				return err
+			}
+			conversationId = conversation.ConversationId
+		}
+		completion.ConversationID = conversationId
+		chatCh, err = CallAssistantConversationStream(ctx, userId, orgId, request.ConversionStreamRequest{
+			AssistantId:    appId,
+			ConversationId: conversationId,
+			Prompt:         prompt,
+		})
+	case constant.AppTypeRag:
+		chatCh, err = CallRagChatStream(ctx, userId, orgId, request.ChatRagRequest{
+			RagID:    appId,
+			Question: prompt,
+			History:  openAIRagHistory(req.Messages[:len(req.Messages)-1]),
+		})
+	default:
+		return grpc_util.ErrorStatusWithKey(err_code.Code_BFFGeneral, "bff_openai_model_not_found", req.Model)
+	}
+	if err != nil {
+		return err
+	}
+	label := fmt.Sprintf("[OpenAI] %v %v user %v org %v", appType, appId, userId, orgId)
+	if req.Stream {
+		writeOpenAIChatStream(ctx, label, appType, completion, chatCh, req.StreamOptions != nil && req.StreamOptions.IncludeUsage)
+		return nil
+	}
+
+	// 非流式
+	var sb strings.Builder
+	for line := range chatCh {
+		delta, err := parseOpenAIChatLine(appType, line)
+		if err != nil {
+			log.Errorf("%v recv err: %v", label, err)
+			return grpc_util.ErrorStatusWithKey(err_code.Code_BFFGeneral, "bff_openai_chat", err.Error())
+		}
+		if delta == nil {
+			continue
+		}
+		sb.WriteString(delta.content)
+		mergeOpenAIChatDelta(completion, delta)
+	}
+	finishReason := openAIFinishReasonStop
+	completion.Object = openAIObjectCompletion
+	completion.Choices = []response.OpenAIChatChoice{{
+		Message:      &response.OpenAIChatMessage{Role: "assistant", Content: sb.String()},
+		FinishReason: &finishReason,
+	}}
+	if completion.Usage == nil {
+		completion.Usage = &response.OpenAIChatUsage{}
+	}
+	b, _ := json.Marshal(completion)
+	ctx.Set(gin_util.STATUS, http.StatusOK)
+	ctx.Set(gin_util.RESULT, string(b))
+	ctx.JSON(http.StatusOK, completion)
+	return nil
+}
+
+// --- internal ---
+
+// getOpenAIModel 应用未发布或不是智能体、文本问答时返回nil
+func getOpenAIModel(ctx *gin.Context, appId, appType string) (*response.OpenAIModel, error) {
+	if appType != constant.AppTypeAgent && appType != constant.AppTypeRag {
+		return nil, nil
+	}
+	apps, err := app.GetAppListByIds(ctx.Request.Context(), &app_service.GetAppListByIdsReq{
+		AppIdsList: []string{appId},
+	})
+	if err != nil {
+		return nil, err
+	}
+	var published *app_service.AppInfo
+	for _, appInfo := range apps.Infos {
+		if appInfo.AppId == appId && appInfo.AppType == appType {
+			published = appInfo
+			break
+		}
+	}
+	if published == nil {
+		return nil, nil
+	}
+	ret := &response.OpenAIModel{
+		ID:      appId,
+		Object:  "model",
+		Created: published.CreatedAt / 1000,
+		OwnedBy: openAIModelOwnedBy,
+		AppType: appType,
+	}
+	switch appType {
+	case constant.AppTypeAgent:
+		info, err := assistant.GetAssistantInfo(ctx.Request.Context(), &assistant_service.GetAssistantInfoReq{
+			AssistantId: appId,
+		})
+		if err != nil {
+			return nil, err
+		}
+		ret.Name = info.AssistantBrief.GetName()
+	case constant.AppTypeRag:
+		info, err := rag.GetRagDetail(ctx.Request.Context(), &rag_service.RagDetailReq{
+			RagId: appId,
+		})
+		if err != nil {
+			return nil, err
+		}
+		ret.Name = info.BriefConfig.GetName()
+	}
+	return ret, nil
+}
+
+// openAIRagHistory 将用户消息与紧随其后的助手消息组成一轮历史
+func openAIRagHistory(messages []*request.OpenAIChatMessage) []*request.History {
+	var ret []*request.History
+	var query string
+	for _, message := range messages {
+		switch message.Role {
+		case "user":
+			query = message.Text()
+		case "assistant":
+			if query == "" {
+				continue
+			}
+			ret = append(ret, &request.History{
+				Query:       query,
+				Response:    message.Text(),
+				NeedHistory: true,
+			})
+			query = ""
+		}
+	}
+	return ret
+}
+
+// parseOpenAIChatLine 解析一行智能体或文本问答的流式返回，无内容的行返回nil
+func parseOpenAIChatLine(appType, line string) (*openAIChatDelta, error) {
+	line = strings.TrimSpace(line)
+	if line == "" || line == strings.TrimSpace(sse_util.DONE_MSG) {
+		return nil, nil
+	}
+	if strings.HasPrefix(line, "error:") {
+		return nil, errors.New(strings.TrimSpace(strings.TrimPrefix(line, "error:")))
+	}
+	line = strings.TrimSpace(strings.TrimPrefix(line, "data:"))
+	switch appType {
+	case constant.AppTypeAgent:
+		resp := &response.OpenAPIAgentChatResponse{}
+		if err := json.Unmarshal([]byte(line), resp); err != nil {
+			return nil, nil
+		}
+		if resp.Code != 0 {
+			return nil, errors.New(resp.Message)
+		}
+		delta := &openAIChatDelta{
+			content:   resp.Response,
+			citations: resp.SearchList,
+		}
+		if resp.Usage.TotalTokens > 0 {
+			delta.usage = &response.OpenAIChatUsage{
+				PromptTokens:     resp.Usage.PromptTokens,
+				CompletionTokens: resp.Usage.CompletionTokens,
+				TotalTokens:      resp.Usage.TotalTokens,
+			}
+		}
+		return delta, nil
+	case constant.AppTypeRag:
+		resp := &response.OpenAPIRagChatResponse{}
+		if err := json.Unmarshal([]byte(line), resp); err != nil {
+			return nil, nil
+		}
+		if resp.Code != 0 {
+			return nil, errors.New(resp.Message)
+		}
+		return &openAIChatDelta{
+			content:   resp.Data.Output,
+			citations: resp.Data.SearchList,
+			msgId:     resp.MsgID,
+		}, nil
+	}
+	return nil, nil
+}
+
+// mergeOpenAIChatDelta 保留最后一次非空的引用、用量及消息id
+func mergeOpenAIChatDelta(completion *response.OpenAIChatCompletion, delta *openAIChatDelta) {
+	if len(delta.citations) > 0 {
+		completion.Citations = delta.citations
+	}
+	if delta.usage != nil {
+		completion.Usage = delta.usage
+	}
+	if delta.msgId != "" {
+		completion.MsgID = delta.msgId
+	}
+}
+
+// writeOpenAIChatStream 以chat.completion.chunk格式流式返回：首帧为角色，之后为回答增量，
+// 结束帧带finish_reason与引用，include_usage时再返回用量帧，最后为[DONE]
+func writeOpenAIChatStream(ctx *gin.Context, label, appType string, completion *response.OpenAIChatCompletion, chatCh <-chan string, includeUsage bool) {
+	sw := sse_util.NewSSEWriter(ctx, label, sse_util.DONE_MSG)
+	write := func(chunk interface{}, done bool) error {
+		b, _ := json.Marshal(chunk)
+		return sw.WriteLine("data: "+string(b)+"\n\n", done, nil, nil)
+	}
+	newChunk := func(delta *response.OpenAIChatMessage, finishReason *string) *response.OpenAIChatCompletion {
+		return &response.OpenAIChatCompletion{
+			ID:             completion.ID,
+			Object:         openAIObjectCompletionChunk,
+			Created:        completion.Created,
+			Model:          completion.Model,
+			Choices:        []response.OpenAIChatChoice{{Delta: delta, FinishReason: finishReason}},
+			ConversationID: completion.ConversationID,
+		}
+	}
+	if err := write(newChunk(&response.OpenAIChatMessage{Role: "assistant"}, nil), false); err != nil {
+		return
+	}
+	for line := range chatCh {
+		delta, err := parseOpenAIChatLine(appType, line)
+		if err != nil {
+			log.Errorf("%v recv err: %v", label, err)
+			_ = write(response.OpenAIError{Error: response.OpenAIErrorDetail{Message: err.Error(), Type: "server_error"}}, false)
+			return
+		}
+		if delta == nil {
+			continue
+		}
+		mergeOpenAIChatDelta(completion, delta)
+		if delta.content == "" {
+			continue
+		}
+		if err := write(newChunk(&response.OpenAIChatMessage{Content: delta.content}, nil), false); err != nil {
+			return
+		}
+	}
+	finishReason := openAIFinishReasonStop
+	finish := newChunk(&response.OpenAIChatMessage{}, &finishReason)
+	finish.Citations = completion.Citations
+	finish.MsgID = completion.MsgID
+	if !includeUsage {
+		_ = write(finish, true)
+		return
+	}
+	if err := write(finish, false); err != nil {
+		return
+	}
+	usage := newChunk(nil, nil)
+	usage.Choices = []response.OpenAIChatChoice{}
+	usage.Usage = completion.Usage
+	if usage.Usage == nil {
+		usage.Usage = &response.OpenAIChatUsage{}
+	}
+	_ = write(usage, true)
+}
@@ -2,6 +2,7 @@ package service
 
 import (
 	"fmt"
+	"sync"
 	"time"
 
 	app_service "github.com/UnicomAI/wanwu/api/proto/app-service"
@@ -116,6 +117,10 @@ func AppUrlConversionStream(ctx *gin.Context, req request.UrlConversionStreamReq
 	if err != nil {
 		return err
 	}
+	// 匿名访问计入应用Url创建者的配额
+	if err := CheckModelQuota(ctx, appUrlInfo.OrgId, appUrlInfo.UserId); err != nil {
+		return err
+	}
 	// 1. CallAssistantConversationStream
 	chatCh, err := CallAssistantConversationStream(ctx, xCid, appUrlInfo.OrgId, request.ConversionStreamRequest{
 		AssistantId:    appUrlInfo.AppId,
@@ -133,6 +138,60 @@ func AppUrlConversionStream(ctx *gin.Context, req request.UrlConversionStreamReq
 	return nil
 }
 
+func UrlConversationFeedbackSubmit(ctx *gin.Context, req request.ConversationFeedbackRequest, xCid, suffix string) error {
+	appUrlInfo, err := getAppUrlInfoAndCheck(ctx, suffix)
+	if err != nil {
+		return err
+	}
+	return ConversationFeedbackSubmit(ctx, xCid, appUrlInfo.OrgId, req)
+}
+
+func UrlConversationExport(ctx *gin.Context, req request.UrlConversationExportRequest, xCid, suffix string) error {
+	appUrlInfo, err := getAppUrlInfoAndCheck(ctx, suffix)
+	if err != nil {
+		return err
+	}
+	return conversationExport(ctx, &assistant_service.ConversationExportReq{
+		AssistantId:    appUrlInfo.AppId,
+		ConversationId: req.ConversationId,
+		StartTime:      req.StartTime,
+		EndTime:        req.EndTime,
+		Identity: &assistant_service.Identity{
+			UserId: xCid,
+			OrgId:  appUrlInfo.OrgId,
+		},
+	}, req.Format)
+}
+
+// appUrlAppIDCacheTTL 应用Url所属应用的本地缓存时长，应用Url与应用的对应关系创建后不变
+const appUrlAppIDCacheTTL = 5 * time.Minute
+
+type appUrlAppIDCacheItem struct {
+	appId    string
+	expireAt time.Time
+}
+
+// appUrlAppIDCache 应用Url后缀 -> 所属应用，仅缓存查询成功的结果
+var appUrlAppIDCache sync.Map
+
+// GetAppUrlAppID 获取应用Url所属的应用id，Url不存在时返回空
+func GetAppUrlAppID(ctx *gin.Context, suffix string) string {
+	if v, ok := appUrlAppIDCache.Load(suffix); ok {
+		if item := v.(*appUrlAppIDCacheItem); time.Now().Before(item.expireAt) {
+			return item.appId
+		}
+		appUrlAppIDCache.Delete(suffix)
+	}
+	appUrlInfo, err := app.GetAppUrlInfoBySuffix(ctx, &app_service.GetAppUrlInfoBySuffixReq{
+		Suffix: suffix,
+	})
+	if err != nil {
+		return ""
+	}
+	appUrlAppIDCache.Store(suffix, &appUrlAppIDCacheItem{appId: appUrlInfo.AppId, expireAt: time.Now().Add(appUrlAppIDCacheTTL)})
+	return appUrlInfo.AppId
+}
+
 func getAppUrlInfoAndCheck(ctx *gin.Context, suffix string) (*app_service.AppUrlInfo, error) {
 	appUrlInfo, err := app.GetAppUrlInfoBySuffix(ctx, &app_service.GetAppUrlInfoBySuffixReq{
 		Suffix: suffix,
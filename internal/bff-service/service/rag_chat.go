@@ -58,6 +58,15 @@ func CallRagChatStream(ctx *gin.Context, userId, orgId string, req request.ChatR
 			return nil, grpc_util.ErrorStatusWithKey(err_code.Code_BFFSensitiveWordCheck, "bff_sensitive_check_req_default_reply")
 		}
 	}
+	// 护栏策略
+	guard, err := buildGuardrail(ctx, req.RagID, constant.AppTypeRag)
+	if err != nil {
+		return nil, err
+	}
+	question, err := guard.checkInput(ctx, req.Question)
+	if err != nil {
+		return nil, err
+	}
 	var ragHistory []*rag_service.HistoryItem
 	if len(req.History) > 0 {
 		for _, history := range req.History {
@@ -70,7 +79,7 @@ func CallRagChatStream(ctx *gin.Context, userId, orgId string, req request.ChatR
 	}
 	stream, err := rag.ChatRag(ctx, &rag_service.ChatRagReq{
 		RagId:    req.RagID,
-		Question: req.Question,
+		Question: question,
 		History:  ragHistory,
 		Identity: &rag_service.Identity{
 			UserId: userId,
@@ -94,7 +103,7 @@ func CallRagChatStream(ctx *gin.Context, userId, orgId string, req request.ChatR
 	go func() {
 		defer util.PrintPanicStack()
 		defer close(rawCh)
-		log.Infof("[RAG] %v user %v org %v start, query: %s", req.RagID, userId, orgId, req.Question)
+		log.Infof("[RAG] %v user %v org %v start, query: %s", req.RagID, userId, orgId, question)
 		rawCh <- firstResp.Content
 		for {
 			s, err := stream.Recv()
@@ -109,11 +118,13 @@ func CallRagChatStream(ctx *gin.Context, userId, orgId string, req request.ChatR
 			rawCh <- s.Content
 		}
 	}()
+	// 护栏输出检测
+	chatCh := guard.processOutput(ctx, rawCh, &ragSensitiveService{})
 	if !ragInfo.SensitiveConfig.GetEnable() {
-		return rawCh, nil
+		return chatCh, nil
 	}
 	// 敏感词过滤
-	retCh := ProcessSensitiveWords(ctx, rawCh, matchDicts, &ragSensitiveService{})
+	retCh := ProcessSensitiveWords(ctx, chatCh, matchDicts, &ragSensitiveService{})
 	return retCh, nil
 }
 
@@ -161,6 +172,10 @@ func (s *ragSensitiveService) parseContent(raw string) (id, content string) {
 	return resp.MsgID, resp.Data.Output
 }
 
+func (s *ragSensitiveService) replaceContent(raw, content string) (string, bool) {
+	return replaceStreamContent(raw, content, "data", "output")
+}
+
 func (s *ragSensitiveService) buildSensitiveResp(id string, content string) []string {
 	resp := map[string]interface{}{
 		"code":    0,
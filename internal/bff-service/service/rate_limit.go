@@ -0,0 +1,299 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/UnicomAI/wanwu/internal/bff-service/config"
+	"github.com/UnicomAI/wanwu/internal/bff-service/model/response"
+	"github.com/UnicomAI/wanwu/pkg/log"
+	"github.com/UnicomAI/wanwu/pkg/redis"
+	"github.com/UnicomAI/wanwu/pkg/util"
+	go_redis "github.com/redis/go-redis/v9"
+)
+
+const (
+	rateLimitBucketKeyPrefix = "rate_limit:bucket:"
+	rateLimitStreamKeyPrefix = "rate_limit:stream:"
+	rateLimitStatsKey        = "rate_limit:stats"
+	// rateLimitStreamTimeout 未配置stream_timeout时并发流占用的最长时间
+	rateLimitStreamTimeout = 10 * time.Minute
+
+	rateLimitStatAllowed     = "allowed"
+	rateLimitStatRejected    = "rejected"    // 请求速率超限
+	rateLimitStatConcurrency = "concurrency" // 并发流超限
+)
+
+// rateLimitBucketScript 令牌桶，KEYS为各维度的桶，ARGV依次为每个桶的rate、burst；
+// 所有桶均有令牌时才扣减，返回{被拒绝的KEYS下标（从1开始，0表示通过）, 需等待的毫秒数}
+var rateLimitBucketScript = go_redis.NewScript(`
+local t = redis.call('TIME')
+local now = tonumber(t[1]) * 1000 + math.floor(tonumber(t[2]) / 1000)
+local states = {}
+for i, key in ipairs(KEYS) do
+	local rate = tonumber(ARGV[i * 2 - 1])
+	local burst = tonumber(ARGV[i * 2])
+	local data = redis.call('HMGET', key, 'tokens', 'ts')
+	local tokens = tonumber(data[1])
+	local ts = tonumber(data[2])
+	if tokens == nil or ts == nil then
+		tokens = burst
+		ts = now
+	end
+	tokens = math.min(burst, tokens + math.max(0, now - ts) * rate / 1000)
+	if tokens < 1 then
+		return {i, math.ceil((1 - tokens) * 1000 / rate)}
+	end
+	states[i] = {tokens - 1, math.ceil(burst * 1000 / rate) + 1000}
+end
+for i, key in ipairs(KEYS) do
+	redis.call('HSET', key, 'tokens', tostring(states[i][1]), 'ts', now)
+	redis.call('PEXPIRE', key, states[i][2])
+end
+return {0, 0}
+`)
+
+// rateLimitStreamScript 并发流计数，KEYS为各维度的有序集合（member为请求id，score为占用截止时间），
+// ARGV为{请求id, 占用时长毫秒, 各KEYS的并发上限...}；所有维度均未超限时才占用，返回被拒绝的KEYS下标，0表示通过
+var rateLimitStreamScript = go_redis.NewScript(`
+local t = redis.call('TIME')
+local now = tonumber(t[1]) * 1000 + math.floor(tonumber(t[2]) / 1000)
+local lease = tonumber(ARGV[2])
+for i, key in ipairs(KEYS) do
+	redis.call('ZREMRANGEBYSCORE', key, '-inf', now)
+	if redis.call('ZCARD', key) >= tonumber(ARGV[i + 2]) then
+		return i
+	end
+end
+for i, key in ipairs(KEYS) do
+	redis.call('ZADD', key, now + lease, ARGV[1])
+	redis.call('PEXPIRE', key, lease)
+end
+return 0
+`)
+
+// RateLimitSubject 限流对象
+type RateLimitSubject struct {
+	Dimension string // config.RateLimitDimensionXXX
+	Id        string
+}
+
+// RateLimitExceeded 超出限制的维度及建议重试的等待时间
+type RateLimitExceeded struct {
+	Subject     RateLimitSubject
+	Concurrency bool // true表示并发流超限，否则为请求速率超限
+	RetryAfter  time.Duration
+}
+
+func (e *RateLimitExceeded) Error() string {
+	if e.Concurrency {
+		return fmt.Sprintf("too many concurrent requests for %v", e.Subject.Dimension)
+	}
+	return fmt.Sprintf("rate limit exceeded for %v, retry after %v", e.Subject.Dimension, e.RetryAfter)
+}
+
+// RateLimitAcquire 依次校验subjects的请求速率与并发流限制；
+// 超限时返回*RateLimitExceeded，通过时返回的release须在请求结束后调用以释放并发流；
+// 未启用限流或redis异常时直接放行
+func RateLimitAcquire(ctx context.Context, subjects []RateLimitSubject) (release func(), exceeded *RateLimitExceeded) {
+	release = func() {}
+	cfg := &config.Cfg().RateLimit
+	cli := redis.RateLimit()
+	if !cfg.Enable || cli == nil {
+		return release, nil
+	}
+	subjects = filterRateLimitSubjects(subjects)
+	if len(subjects) == 0 {
+		return release, nil
+	}
+
+	// 请求速率
+	var bucketSubjects []RateLimitSubject
+	var bucketKeys []string
+	var bucketArgs []interface{}
+	for _, subject := range subjects {
+		rule := cfg.Rule(subject.Dimension, subject.Id)
+		if rule.Rate <= 0 {
+			continue
+		}
+		bucketSubjects = append(bucketSubjects, subject)
+		bucketKeys = append(bucketKeys, rateLimitKey(rateLimitBucketKeyPrefix, subject))
+		bucketArgs = append(bucketArgs, rule.Rate, max(rule.Burst, 1))
+	}
+	if len(bucketKeys) > 0 {
+		ret, err := rateLimitBucketScript.Run(ctx, cli.Cli(), bucketKeys, bucketArgs...).Int64Slice()
+		if err != nil {
+			log.Errorf("rate limit bucket %v err: %v", bucketKeys, err)
+			return release, nil
+		}
+		if len(ret) == 2 && ret[0] > 0 && int(ret[0]) <= len(bucketSubjects) {
+			exceeded = &RateLimitExceeded{
+				Subject:    bucketSubjects[ret[0]-1],
+				RetryAfter: time.Duration(ret[1]) * time.Millisecond,
+			}
+			incrRateLimitStats(ctx, subjects, exceeded)
+			return release, exceeded
+		}
+	}
+
+	// 并发流
+	var streamSubjects []RateLimitSubject
+	var streamKeys []string
+	lease := rateLimitStreamTimeout
+	if cfg.StreamTimeout > 0 {
+		lease = time.Duration(cfg.StreamTimeout) * time.Second
+	}
+	member := util.GenUUID()
+	streamArgs := []interface{}{member, lease.Milliseconds()}
+	for _, subject := range subjects {
+		rule := cfg.Rule(subject.Dimension, subject.Id)
+		if rule.Concurrency <= 0 {
+			continue
+		}
+		streamSubjects = append(streamSubjects, subject)
+		streamKeys = append(streamKeys, rateLimitKey(rateLimitStreamKeyPrefix, subject))
+		streamArgs = append(streamArgs, rule.Concurrency)
+	}
+	if len(streamKeys) > 0 {
+		idx, err := rateLimitStreamScript.Run(ctx, cli.Cli(), streamKeys, streamArgs...).Int64()
+		if err != nil {
+			log.Errorf("rate limit stream %v err: %v", streamKeys, err)
+			return release, nil
+		}
+		if idx > 0 && int(idx) <= len(streamSubjects) {
+			exceeded = &RateLimitExceeded{
+				Subject:     streamSubjects[idx-1],
+				Concurrency: true,
+				RetryAfter:  time.Second,
+			}
+			incrRateLimitStats(ctx, subjects, exceeded)
+			return release, exceeded
+		}
+		release = func() {
+			// 请求的ctx可能已取消，使用独立的ctx释放
+			releaseCtx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+			defer cancel()
+			for _, key := range streamKeys {
+				if err := cli.Cli().ZRem(releaseCtx, key, member).Err(); err != nil {
+					log.Errorf("rate limit stream %v release err: %v", key, err)
+				}
+			}
+		}
+	}
+	incrRateLimitStats(ctx, subjects, nil)
+	return release, nil
+}
+
+// GetRateLimitStats 获取限流计数（自计数开始以来的累计值）及当前并发流数
+func GetRateLimitStats(ctx context.Context) (*response.RateLimitStats, error) {
+	ret := &response.RateLimitStats{
+		Dimensions: []*response.RateLimitStat{},
+		Subjects:   []*response.RateLimitStat{},
+	}
+	cli := redis.RateLimit()
+	if cli == nil {
+		return ret, nil
+	}
+	items, err := cli.HGetAll(ctx, rateLimitStatsKey)
+	if err != nil {
+		return nil, err
+	}
+	dimensions := make(map[string]*response.RateLimitStat)
+	subjects := make(map[string]*response.RateLimitStat)
+	for _, item := range items {
+		// field格式：{dimension}:{counter} 或 {dimension}:{id}:{counter}
+		parts := strings.Split(item.K, ":")
+		if len(parts) < 2 {
+			continue
+		}
+		count, _ := strconv.ParseInt(item.V, 10, 64)
+		var stat *response.RateLimitStat
+		if len(parts) == 2 {
+			stat = dimensions[parts[0]]
+			if stat == nil {
+				stat = &response.RateLimitStat{Dimension: parts[0]}
+				dimensions[parts[0]] = stat
+				ret.Dimensions = append(ret.Dimensions, stat)
+			}
+		} else {
+			id := strings.Join(parts[1:len(parts)-1], ":")
+			stat = subjects[parts[0]+":"+id]
+			if stat == nil {
+				stat = &response.RateLimitStat{Dimension: parts[0], Id: id}
+				subjects[parts[0]+":"+id] = stat
+				ret.Subjects = append(ret.Subjects, stat)
+			}
+		}
+		switch parts[len(parts)-1] {
+		case rateLimitStatAllowed:
+			stat.Allowed = count
+		case rateLimitStatRejected:
+			stat.Rejected = count
+		case rateLimitStatConcurrency:
+			stat.ConcurrencyRejected = count
+		}
+	}
+	now := time.Now().UnixMilli()
+	for _, stat := range ret.Subjects {
+		key := rateLimitKey(rateLimitStreamKeyPrefix, RateLimitSubject{Dimension: stat.Dimension, Id: stat.Id})
+		streams, err := cli.Cli().ZCount(ctx, key, strconv.FormatInt(now, 10), "+inf").Result()
+		if err != nil {
+			return nil, err
+		}
+		stat.Streams = streams
+	}
+	sort.Slice(ret.Dimensions, func(i, j int) bool { return ret.Dimensions[i].Dimension < ret.Dimensions[j].Dimension })
+	sort.Slice(ret.Subjects, func(i, j int) bool {
+		if ret.Subjects[i].Rejected+ret.Subjects[i].ConcurrencyRejected != ret.Subjects[j].Rejected+ret.Subjects[j].ConcurrencyRejected {
+			return ret.Subjects[i].Rejected+ret.Subjects[i].ConcurrencyRejected > ret.Subjects[j].Rejected+ret.Subjects[j].ConcurrencyRejected
+		}
+		return ret.Subjects[i].Allowed > ret.Subjects[j].Allowed
+	})
+	return ret, nil
+}
+
+// --- internal ---
+
+func filterRateLimitSubjects(subjects []RateLimitSubject) []RateLimitSubject {
+	var ret []RateLimitSubject
+	for _, subject := range subjects {
+		if subject.Id != "" {
+			ret = append(ret, subject)
+		}
+	}
+	return ret
+}
+
+func rateLimitKey(prefix string, subject RateLimitSubject) string {
+	return prefix + subject.Dimension + ":" + subject.Id
+}
+
+// incrRateLimitStats 记录各维度的计数；匿名访问者（X-Client-ID）与客户端IP数量不可控，只记录维度汇总
+func incrRateLimitStats(ctx context.Context, subjects []RateLimitSubject, exceeded *RateLimitExceeded) {
+	counter := rateLimitStatAllowed
+	if exceeded != nil {
+		counter = rateLimitStatRejected
+		if exceeded.Concurrency {
+			counter = rateLimitStatConcurrency
+		}
+	}
+	cli := redis.RateLimit()
+	pipe := cli.Cli().Pipeline()
+	for _, subject := range subjects {
+		// 超限时只计入实际超限的维度
+		if exceeded != nil && subject != exceeded.Subject {
+			continue
+		}
+		pipe.HIncrBy(ctx, rateLimitStatsKey, subject.Dimension+":"+counter, 1)
+		if subject.Dimension != config.RateLimitDimensionClient && subject.Dimension != config.RateLimitDimensionIp {
+			pipe.HIncrBy(ctx, rateLimitStatsKey, subject.Dimension+":"+subject.Id+":"+counter, 1)
+		}
+	}
+	if _, err := pipe.Exec(ctx); err != nil {
+		log.Errorf("rate limit stats incr err: %v", err)
+	}
+}
@@ -0,0 +1,118 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	go_redis "github.com/redis/go-redis/v9"
+)
+
+func newRateLimitTestRedis(t *testing.T) (*miniredis.Miniredis, *go_redis.Client) {
+	t.Helper()
+	m := miniredis.RunT(t)
+	m.SetTime(time.UnixMilli(1_700_000_000_000))
+	cli := go_redis.NewClient(&go_redis.Options{Addr: m.Addr()})
+	t.Cleanup(func() { _ = cli.Close() })
+	return m, cli
+}
+
+func runBucketScript(t *testing.T, cli *go_redis.Client, keys []string, args ...interface{}) []int64 {
+	t.Helper()
+	ret, err := rateLimitBucketScript.Run(context.Background(), cli, keys, args...).Int64Slice()
+	if err != nil {
+		t.Fatalf("bucket script: %v", err)
+	}
+	return ret
+}
+
+func runStreamScript(t *testing.T, cli *go_redis.Client, keys []string, args ...interface{}) int64 {
+	t.Helper()
+	ret, err := rateLimitStreamScript.Run(context.Background(), cli, keys, args...).Int64()
+	if err != nil {
+		t.Fatalf("stream script: %v", err)
+	}
+	return ret
+}
+
+func TestRateLimitBucketScript(t *testing.T) {
+	m, cli := newRateLimitTestRedis(t)
+	keys := []string{"bucket:a"}
+
+	// burst为2时前两次通过，第三次被拒绝并返回补充1个令牌所需的等待时间
+	for i := 0; i < 2; i++ {
+		if ret := runBucketScript(t, cli, keys, 1, 2); ret[0] != 0 {
+			t.Fatalf("request %d rejected: %v", i, ret)
+		}
+	}
+	ret := runBucketScript(t, cli, keys, 1, 2)
+	if ret[0] != 1 || ret[1] != 1000 {
+		t.Fatalf("expected rejection with 1000ms wait, got %v", ret)
+	}
+
+	// 按rate补充令牌
+	m.SetTime(time.UnixMilli(1_700_000_000_000 + 1000))
+	if ret := runBucketScript(t, cli, keys, 1, 2); ret[0] != 0 {
+		t.Fatalf("expected refill after 1s, got %v", ret)
+	}
+	if ret := runBucketScript(t, cli, keys, 1, 2); ret[0] != 1 {
+		t.Fatalf("expected rejection after refill consumed, got %v", ret)
+	}
+}
+
+func TestRateLimitBucketScriptMultiKey(t *testing.T) {
+	_, cli := newRateLimitTestRedis(t)
+	keys := []string{"bucket:api_key", "bucket:app"}
+
+	if ret := runBucketScript(t, cli, keys, 10, 10, 10, 1); ret[0] != 0 {
+		t.Fatalf("first request rejected: %v", ret)
+	}
+	// 第二个桶耗尽时返回其下标，且不扣减第一个桶
+	if ret := runBucketScript(t, cli, keys, 10, 10, 10, 1); ret[0] != 2 {
+		t.Fatalf("expected second key rejected, got %v", ret)
+	}
+	tokens, err := cli.HGet(context.Background(), "bucket:api_key", "tokens").Float64()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if tokens != 9 {
+		t.Fatalf("rejected request consumed first bucket, tokens %v", tokens)
+	}
+}
+
+func TestRateLimitStreamScript(t *testing.T) {
+	m, cli := newRateLimitTestRedis(t)
+	keys := []string{"stream:api_key", "stream:app"}
+	lease := int64(60_000)
+
+	if ret := runStreamScript(t, cli, keys, "r1", lease, 2, 1); ret != 0 {
+		t.Fatalf("first stream rejected: %v", ret)
+	}
+	// 第二个维度并发上限为1
+	if ret := runStreamScript(t, cli, keys, "r2", lease, 2, 1); ret != 2 {
+		t.Fatalf("expected second key rejected, got %v", ret)
+	}
+	// 被拒绝的请求不占用任何维度
+	members, err := m.ZMembers("stream:api_key")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(members) != 1 {
+		t.Fatalf("rejected stream occupied first key: %v", members)
+	}
+
+	// 释放后可再次占用
+	if err := cli.ZRem(context.Background(), "stream:app", "r1").Err(); err != nil {
+		t.Fatal(err)
+	}
+	if ret := runStreamScript(t, cli, keys, "r2", lease, 2, 1); ret != 0 {
+		t.Fatalf("expected stream after release, got %v", ret)
+	}
+
+	// 未释放的占用在租期结束后失效
+	m.SetTime(time.UnixMilli(1_700_000_000_000 + lease + 1))
+	if ret := runStreamScript(t, cli, []string{"stream:app"}, "r3", lease, 1); ret != 0 {
+		t.Fatalf("expected expired lease to be reclaimed, got %v", ret)
+	}
+}
@@ -24,6 +24,7 @@ type chatService interface {
 	serviceType() string
 	buildSensitiveResp(id, content string) []string
 	parseContent(raw string) (id, content string)
+	replaceContent(raw, content string) (string, bool)
 }
 
 // 构建敏感词字典
@@ -97,6 +97,8 @@ func Init() error {
 	assistant = assistant_service.NewAssistantServiceClient(assistantConn)
 	safety = safety_service.NewSafetyServiceClient(appConn)
 	operate = operate_service.NewOperateServiceClient(operateConn)
+	// 后台任务
+	startAppEvalRunner()
 	return nil
 }
 
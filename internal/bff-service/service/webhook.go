@@ -0,0 +1,144 @@
+package service
+
+import (
+	operate_service "github.com/UnicomAI/wanwu/api/proto/operate-service"
+	"github.com/UnicomAI/wanwu/internal/bff-service/model/request"
+	"github.com/UnicomAI/wanwu/internal/bff-service/model/response"
+	"github.com/UnicomAI/wanwu/pkg/util"
+	"github.com/gin-gonic/gin"
+)
+
+const (
+	webhookSecretVisibleLen = 6
+	webhookDeliveryPending  = "pending"
+)
+
+func CreateWebhook(ctx *gin.Context, userId, orgId string, req *request.CreateWebhookRequest) (*response.WebhookInfo, error) {
+	resp, err := operate.CreateWebhook(ctx.Request.Context(), &operate_service.CreateWebhookReq{
+		OrgId:  orgId,
+		UserId: userId,
+		Name:   req.Name,
+		Url:    req.Url,
+		Secret: req.Secret,
+		Events: req.Events,
+		Enable: req.Enable,
+	})
+	if err != nil {
+		return nil, err
+	}
+	// 创建时返回完整密钥，用于接收方校验签名
+	return toWebhookInfo(resp, false), nil
+}
+
+func UpdateWebhook(ctx *gin.Context, orgId string, req *request.UpdateWebhookRequest) error {
+	_, err := operate.UpdateWebhook(ctx.Request.Context(), &operate_service.UpdateWebhookReq{
+		WebhookId: req.WebhookId,
+		OrgId:     orgId,
+		Name:      req.Name,
+		Url:       req.Url,
+		Secret:    req.Secret,
+		Events:    req.Events,
+		Enable:    req.Enable,
+	})
+	return err
+}
+
+func DeleteWebhook(ctx *gin.Context, orgId string, req *request.WebhookIdRequest) error {
+	_, err := operate.DeleteWebhook(ctx.Request.Context(), &operate_service.DeleteWebhookReq{
+		WebhookId: req.WebhookId,
+		OrgId:     orgId,
+	})
+	return err
+}
+
+func GetWebhookList(ctx *gin.Context, orgId string) (*response.ListResult, error) {
+	resp, err := operate.GetWebhookList(ctx.Request.Context(), &operate_service.GetWebhookListReq{OrgId: orgId})
+	if err != nil {
+		return nil, err
+	}
+	list := make([]*response.WebhookInfo, 0, len(resp.Webhooks))
+	for _, hook := range resp.Webhooks {
+		list = append(list, toWebhookInfo(hook, true))
+	}
+	return &response.ListResult{
+		List:  list,
+		Total: resp.Total,
+	}, nil
+}
+
+// GetWebhookDeliveryList 获取投递记录，status为dead时即死信列表
+func GetWebhookDeliveryList(ctx *gin.Context, orgId string, req *request.WebhookDeliveryListRequest) (*response.PageResult, error) {
+	if req.PageNo < 1 {
+		req.PageNo = 1
+	}
+	resp, err := operate.GetWebhookDeliveryList(ctx.Request.Context(), &operate_service.GetWebhookDeliveryListReq{
+		OrgId:     orgId,
+		WebhookId: req.WebhookId,
+		Status:    req.Status,
+		PageNo:    int32(req.PageNo),
+		PageSize:  int32(req.PageSize),
+	})
+	if err != nil {
+		return nil, err
+	}
+	list := make([]*response.WebhookDeliveryInfo, 0, len(resp.Deliveries))
+	for _, delivery := range resp.Deliveries {
+		list = append(list, toWebhookDeliveryInfo(delivery))
+	}
+	return &response.PageResult{
+		List:     list,
+		Total:    resp.Total,
+		PageNo:   req.PageNo,
+		PageSize: req.PageSize,
+	}, nil
+}
+
+// RetryWebhookDelivery 重新投递死信
+func RetryWebhookDelivery(ctx *gin.Context, orgId string, req *request.WebhookDeliveryIdRequest) error {
+	_, err := operate.RetryWebhookDelivery(ctx.Request.Context(), &operate_service.RetryWebhookDeliveryReq{
+		DeliveryId: req.DeliveryId,
+		OrgId:      orgId,
+	})
+	return err
+}
+
+// --- internal ---
+
+func toWebhookInfo(hook *operate_service.Webhook, maskSecret bool) *response.WebhookInfo {
+	secret := hook.Secret
+	if maskSecret && len(secret) > webhookSecretVisibleLen {
+		secret = secret[:webhookSecretVisibleLen] + "******"
+	}
+	return &response.WebhookInfo{
+		WebhookId: hook.WebhookId,
+		Name:      hook.Name,
+		Url:       hook.Url,
+		Secret:    secret,
+		Events:    hook.Events,
+		Enable:    hook.Enable,
+		UserId:    hook.UserId,
+		CreatedAt: util.Time2Str(hook.CreatedAt),
+		UpdatedAt: util.Time2Str(hook.UpdatedAt),
+	}
+}
+
+func toWebhookDeliveryInfo(delivery *operate_service.WebhookDelivery) *response.WebhookDeliveryInfo {
+	var nextRetryAt string
+	if delivery.Status == webhookDeliveryPending {
+		nextRetryAt = util.Time2Str(delivery.NextRetryAt)
+	}
+	return &response.WebhookDeliveryInfo{
+		DeliveryId:  delivery.DeliveryId,
+		WebhookId:   delivery.WebhookId,
+		EventId:     delivery.EventId,
+		Event:       delivery.Event,
+		Payload:     delivery.Payload,
+		Status:      delivery.Status,
+		Attempts:    delivery.Attempts,
+		NextRetryAt: nextRetryAt,
+		LastCode:    delivery.LastCode,
+		LastError:   delivery.LastError,
+		CreatedAt:   util.Time2Str(delivery.CreatedAt),
+		UpdatedAt:   util.Time2Str(delivery.UpdatedAt),
+	}
+}
@@ -7,6 +7,13 @@ const (
 	DocSegmentImportFail      = 3 //文档分段导入失败
 )
 
+const (
+	DocSegmentImportModeCreate = "create" //全部新增为切片
+	DocSegmentImportModeUpsert = "upsert" //按分段id更新已有切片，分段id为空时新增
+
+	DocSegmentImportMaxRowError = 1000 //最多记录的行错误数量
+)
+
 type DocSegmentImportParams struct {
 	KnowledgeName   string   `json:"knowledgeName"`   // 知识库的名称
 	KnowledgeId     string   `json:"knowledgeId"`     // 知识库的唯一ID
@@ -16,6 +23,14 @@ type DocSegmentImportParams struct {
 	SegmentMethod   string   `json:"segmentMethod"`   ////分段方法 0：通用分段；1：父子分段,字符串为空则认为是通用分段
 	SubSplitter     []string `json:"subSplitter"`     // 分隔符（只有父子分段必填）
 	SubMaxSplitter  int      `json:"subMaxSplitter"`  // 可分隔最大值（只有父子分段必填）
+	Mode            string   `json:"mode"`            // 导入模式 create|upsert，为空则认为是create
+}
+
+// DocSegmentImportRowError 文档分段导入的行错误
+type DocSegmentImportRowError struct {
+	Line      int    `json:"line"`      // 数据行号，从1开始，不含表头
+	ContentId string `json:"contentId"` // 分段id
+	ErrorMsg  string `json:"errorMsg"`  // 错误信息
 }
 
 type ChildChunkConfig struct {
@@ -31,6 +46,7 @@ type DocSegmentImportTask struct {
 	SuccessCount int    `gorm:"column:success_count;type:bigint(20);default:0;comment:'成功数量'" json:"successCount"`
 	TotalCount   int    `gorm:"column:total_count;type:bigint(20);default:0;comment:'导入数量，当在导入过程中出现重启，则total为0'" json:"totalCount"`
 	ErrorMsg     string `gorm:"column:error_msg;type:longtext;not null;comment:'解析的错误信息'" json:"errorMsg"`
+	ErrorRows    string `gorm:"column:error_rows;type:longtext;comment:'行错误列表'" json:"errorRows"`
 	ImportParams string `gorm:"column:import_params;type:text;not null;comment:'导入信息'" json:"importParams"`
 	CreatedAt    int64  `gorm:"column:create_at;type:bigint(20);not null;autoCreateTime:milli" json:"createAt"` // Create Time
 	UpdatedAt    int64  `gorm:"column:update_at;type:bigint(20);not null;autoUpdateTime:milli" json:"updateAt"` // Update Time
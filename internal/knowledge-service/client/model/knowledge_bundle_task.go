@@ -0,0 +1,41 @@
+package model
+
+const (
+	KnowledgeBundleExport = 1 //知识库导出
+	KnowledgeBundleImport = 2 //知识库导入
+
+	KnowledgeBundleInit       = 0 //任务待处理
+	KnowledgeBundleProcessing = 1 //任务处理中
+	KnowledgeBundleSuccess    = 2 //任务成功
+	KnowledgeBundleFail       = 3 //任务失败
+)
+
+// KnowledgeBundleTask 知识库导入导出任务，用于在不同环境间迁移知识库
+type KnowledgeBundleTask struct {
+	Id            uint32 `gorm:"column:id;primary_key;type:bigint(20) auto_increment;not null;comment:'id';" json:"id"` // Primary Key
+	TaskId        string `gorm:"uniqueIndex:idx_unique_task_id;column:task_id;type:varchar(64)" json:"taskId"`          // Business Primary Key
+	TaskType      int    `gorm:"column:task_type;type:tinyint(1);not null;comment:'1-导出，2-导入'" json:"taskType"`
+	KnowledgeId   string `gorm:"column:knowledge_id;type:varchar(64);not null;default:'';comment:'导出的知识库id或导入后新建的知识库id'" json:"knowledgeId"`
+	KnowledgeName string `gorm:"column:knowledge_name;type:varchar(256);not null;default:''" json:"knowledgeName"`
+	Status        int    `gorm:"column:status;type:tinyint(1);not null;default:0;comment:'0-待处理，1-处理中，2-成功，3-失败'" json:"status"`
+	TotalCount    int    `gorm:"column:total_count;type:int(11);not null;default:0;comment:'文档总数'" json:"totalCount"`
+	FinishCount   int    `gorm:"column:finish_count;type:int(11);not null;default:0;comment:'已处理文档数'" json:"finishCount"`
+	FilePath      string `gorm:"column:file_path;type:text;not null;comment:'导出文件名称或导入文件地址'" json:"filePath"`
+	Params        string `gorm:"column:params;type:text;not null;comment:'导入参数'" json:"params"`
+	ErrorMsg      string `gorm:"column:error_msg;type:longtext;not null;comment:'失败原因'" json:"errorMsg"`
+	CreatedAt     int64  `gorm:"column:create_at;type:bigint(20);not null;" json:"createAt"` // Create Time
+	UpdatedAt     int64  `gorm:"column:update_at;type:bigint(20);not null;" json:"updateAt"` // Update Time
+	UserId        string `gorm:"column:user_id;type:varchar(64);not null;default:'';index:idx_user_id" json:"userId"`
+	OrgId         string `gorm:"column:org_id;type:varchar(64);not null;default:''" json:"orgId"`
+}
+
+func (KnowledgeBundleTask) TableName() string {
+	return "knowledge_bundle_task"
+}
+
+// KnowledgeBundleImportParams 知识库导入参数
+type KnowledgeBundleImportParams struct {
+	Name             string `json:"name"`
+	Description      string `json:"description"`
+	EmbeddingModelId string `json:"embeddingModelId"`
+}
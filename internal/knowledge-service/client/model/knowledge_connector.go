@@ -0,0 +1,60 @@
+package model
+
+const (
+	ConnectorTypeS3     = "s3"     //S3兼容对象存储
+	ConnectorTypeGit    = "git"    //git仓库
+	ConnectorTypeWebDAV = "webdav" //WebDAV
+	ConnectorTypeLocal  = "local"  //服务端挂载目录
+
+	ConnectorIdle    = 0 //未执行
+	ConnectorRunning = 1 //执行中
+	ConnectorSuccess = 2 //执行成功
+	ConnectorFail    = 3 //执行失败
+)
+
+// ConnectorParams 连接器配置（不含凭证）
+type ConnectorParams struct {
+	Endpoint   string   `json:"endpoint"`   //s3、webdav服务地址
+	Bucket     string   `json:"bucket"`     //s3桶名称
+	UseSsl     bool     `json:"useSsl"`     //s3是否使用https
+	RepoUrl    string   `json:"repoUrl"`    //git仓库地址
+	Branch     string   `json:"branch"`     //git分支
+	RootPath   string   `json:"rootPath"`   //s3对象前缀；git、webdav目录；local服务端挂载目录
+	PathFilter []string `json:"pathFilter"` //路径过滤规则（glob）
+}
+
+// ConnectorCredential 连接器凭证，加密后存储
+type ConnectorCredential struct {
+	AccessKey string `json:"accessKey"`
+	SecretKey string `json:"secretKey"`
+	Username  string `json:"username"`
+	Password  string `json:"password"`
+}
+
+// KnowledgeConnector 知识库连接器，从外部数据源增量导入文档
+type KnowledgeConnector struct {
+	Id               uint32 `gorm:"column:id;primary_key;type:bigint(20) auto_increment;not null;comment:'id';" json:"id"`       // Primary Key
+	ConnectorId      string `gorm:"uniqueIndex:idx_unique_connector_id;column:connector_id;type:varchar(64)" json:"connectorId"` // Business Primary Key
+	KnowledgeId      string `gorm:"column:knowledge_id;type:varchar(64);not null;default:'';index:idx_knowledge_id" json:"knowledgeId"`
+	Name             string `gorm:"column:name;type:varchar(256);not null;default:''" json:"name"`
+	ConnectorType    string `gorm:"column:connector_type;type:varchar(32);not null;default:'';comment:'s3、git、webdav、local'" json:"connectorType"`
+	Params           string `gorm:"column:params;type:text;not null;comment:'连接器配置'" json:"params"`
+	Credential       string `gorm:"column:credential;type:text;not null;comment:'加密后的连接器凭证'" json:"-"`
+	SegmentConfig    string `gorm:"column:segment_config;type:text;not null;comment:'分段配置信息'" json:"segmentConfig"`
+	DocAnalyzer      string `gorm:"column:doc_analyzer;type:text;not null;comment:'文档解析配置'" json:"docAnalyzer"`
+	OcrModelId       string `gorm:"column:ocr_model_id;type:varchar(64);not null;default:'';comment:'ocr模型id'" json:"ocrModelId"`
+	DocPreProcess    string `gorm:"column:doc_pre_process;type:text;not null;comment:'文档预处理规则'" json:"docPreProcess"`
+	Cursor           string `gorm:"column:sync_cursor;type:varchar(256);not null;default:'';comment:'增量游标'" json:"cursor"`
+	Status           int    `gorm:"column:status;type:tinyint(1);not null;default:0;comment:'0-未执行，1-执行中，2-执行成功，3-执行失败'" json:"status"`
+	ErrorMsg         string `gorm:"column:error_msg;type:text;not null;comment:'失败原因'" json:"errorMsg"`
+	LastImportTaskId string `gorm:"column:last_import_task_id;type:varchar(64);not null;default:'';comment:'最近一次创建的导入任务id'" json:"lastImportTaskId"`
+	LastRunAt        int64  `gorm:"column:last_run_at;type:bigint(20);not null;default:0" json:"lastRunAt"`
+	CreatedAt        int64  `gorm:"column:create_at;type:bigint(20);not null;" json:"createAt"` // Create Time
+	UpdatedAt        int64  `gorm:"column:update_at;type:bigint(20);not null;" json:"updateAt"` // Update Time
+	UserId           string `gorm:"column:user_id;type:varchar(64);not null;default:'';" json:"userId"`
+	OrgId            string `gorm:"column:org_id;type:varchar(64);not null;default:''" json:"orgId"`
+}
+
+func (KnowledgeConnector) TableName() string {
+	return "knowledge_connector"
+}
@@ -14,10 +14,13 @@ type KnowledgeDoc struct {
 	ImportTaskId string `gorm:"column:batch_id;type:varchar(64);not null;default:'';comment:'导入的任务id'" json:"importTaskId"`
 	KnowledgeId  string `gorm:"column:knowledge_id;index:idx_user_id_knowledge_id_name,priority:2;index:idx_user_id_knowledge_id_tag,priority:2;type:varchar(64);not null;default:''" json:"knowledgeId"`
 	FilePathMd5  string `gorm:"column:file_path_md5;type:varchar(64);not null;default:'';comment:'文件的md5值'" json:"filePathMd5"`
+	ContentMd5   string `gorm:"column:content_md5;type:varchar(64);not null;default:'';comment:'url文档导入或同步时获取的正文内容md5'" json:"contentMd5"`
 	FilePath     string `gorm:"column:file_path;type:text;not null" json:"filePath"`
 	Name         string `gorm:"column:name;index:idx_user_id_knowledge_id_name,priority:3;type:varchar(256);not null;default:''" json:"name"`
 	FileType     string `gorm:"column:file_type;type:varchar(20);not null;default:''" json:"fileType"`
 	FileSize     int64  `gorm:"column:file_size;type:bigint(20);COMMENT:'文件大小，单位byte'" json:"fileSize"`
+	Version      int    `gorm:"column:version;type:int(11);not null;default:1;comment:'当前生效版本号'" json:"version"`
+	RestoreId    string `gorm:"column:restore_version_id;type:varchar(64);not null;default:'';comment:'回滚后待恢复切片快照的版本id'" json:"restoreVersionId"`
 	Status       int    `gorm:"column:status;type:tinyint(1);not null;comment:'0-待处理， 1- 处理完成， 2-正在审核中(目前没有)，3-正在解析中，4-审核未通过（目前没有），5-解析失败';" json:"status"`
 	ErrorMsg     string `gorm:"column:error_msg;type:longtext;not null;comment:'解析的错误信息'" json:"errorMsg"`
 	CreatedAt    int64  `gorm:"column:create_at;type:bigint(20);not null;" json:"createAt"` // Create Time
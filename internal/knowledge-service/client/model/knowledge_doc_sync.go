@@ -0,0 +1,52 @@
+package model
+
+const (
+	UrlFileType = "url" //url文档的文件类型
+
+	DocSyncUnchanged = 1 //内容未变化
+	DocSyncChanged   = 2 //内容已变化，重新导入
+	DocSyncFail      = 3 //同步失败
+)
+
+// KnowledgeDocSync url文档定时同步配置，按文档（DocId）或按导入任务（ImportTaskId，同步该任务导入的所有url文档）配置
+type KnowledgeDocSync struct {
+	Id              uint32 `gorm:"column:id;primary_key;type:bigint(20) auto_increment;not null;comment:'id';" json:"id"` // Primary Key
+	SyncId          string `gorm:"uniqueIndex:idx_unique_sync_id;column:sync_id;type:varchar(64)" json:"syncId"`          // Business Primary Key
+	KnowledgeId     string `gorm:"column:knowledge_id;type:varchar(64);not null;default:'';index:idx_knowledge_id" json:"knowledgeId"`
+	DocId           string `gorm:"column:doc_id;type:varchar(64);not null;default:'';comment:'按文档同步的文档id'" json:"docId"`
+	ImportTaskId    string `gorm:"column:import_task_id;type:varchar(64);not null;default:'';comment:'按导入任务同步的导入任务id'" json:"importTaskId"`
+	CronExpr        string `gorm:"column:cron_expr;type:varchar(64);not null;default:'';comment:'cron表达式'" json:"cronExpr"`
+	IntervalMinutes int    `gorm:"column:interval_minutes;type:int(11);not null;default:0;comment:'同步间隔（分钟）'" json:"intervalMinutes"`
+	Enable          bool   `gorm:"column:enable;type:tinyint(1);not null;default:1;comment:'是否启用'" json:"enable"`
+	LastSyncAt      int64  `gorm:"column:last_sync_at;type:bigint(20);not null;default:0;comment:'上次同步时间'" json:"lastSyncAt"`
+	NextSyncAt      int64  `gorm:"column:next_sync_at;type:bigint(20);not null;default:0;index:idx_next_sync_at;comment:'下次同步时间'" json:"nextSyncAt"`
+	CreatedAt       int64  `gorm:"column:create_at;type:bigint(20);not null;" json:"createAt"` // Create Time
+	UpdatedAt       int64  `gorm:"column:update_at;type:bigint(20);not null;" json:"updateAt"` // Update Time
+	UserId          string `gorm:"column:user_id;type:varchar(64);not null;default:'';" json:"userId"`
+	OrgId           string `gorm:"column:org_id;type:varchar(64);not null;default:''" json:"orgId"`
+}
+
+func (KnowledgeDocSync) TableName() string {
+	return "knowledge_doc_sync"
+}
+
+// KnowledgeDocSyncHistory url文档同步记录，每次同步每个文档一条
+type KnowledgeDocSyncHistory struct {
+	Id          uint32 `gorm:"column:id;primary_key;type:bigint(20) auto_increment;not null;comment:'id';" json:"id"` // Primary Key
+	SyncId      string `gorm:"column:sync_id;type:varchar(64);not null;default:'';index:idx_sync_id_doc_id,priority:1" json:"syncId"`
+	DocId       string `gorm:"column:doc_id;type:varchar(64);not null;default:'';index:idx_sync_id_doc_id,priority:2" json:"docId"`
+	KnowledgeId string `gorm:"column:knowledge_id;type:varchar(64);not null;default:''" json:"knowledgeId"`
+	DocName     string `gorm:"column:doc_name;type:varchar(256);not null;default:''" json:"docName"`
+	DocUrl      string `gorm:"column:doc_url;type:text;not null" json:"docUrl"`
+	Status      int    `gorm:"column:status;type:tinyint(1);not null;comment:'1-内容未变化，2-内容已变化并重新导入，3-同步失败'" json:"status"`
+	ContentMd5  string `gorm:"column:content_md5;type:varchar(64);not null;default:'';comment:'本次获取的内容md5'" json:"contentMd5"`
+	ErrorMsg    string `gorm:"column:error_msg;type:text;not null;comment:'失败原因'" json:"errorMsg"`
+	StartAt     int64  `gorm:"column:start_at;type:bigint(20);not null;" json:"startAt"`
+	FinishAt    int64  `gorm:"column:finish_at;type:bigint(20);not null;" json:"finishAt"`
+	UserId      string `gorm:"column:user_id;type:varchar(64);not null;default:'';" json:"userId"`
+	OrgId       string `gorm:"column:org_id;type:varchar(64);not null;default:''" json:"orgId"`
+}
+
+func (KnowledgeDocSyncHistory) TableName() string {
+	return "knowledge_doc_sync_history"
+}
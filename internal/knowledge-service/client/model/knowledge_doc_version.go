@@ -0,0 +1,35 @@
+package model
+
+// KnowledgeDocVersion 文档历史版本，文档上传新版本、编辑切片或回滚前归档当前版本的文件和切片快照；
+// 连续编辑切片只在首次编辑前归档一次，合并时长内的后续编辑并入当前版本
+type KnowledgeDocVersion struct {
+	Id              uint32 `gorm:"column:id;primary_key;type:bigint(20) auto_increment;not null;comment:'id';" json:"id"` // Primary Key
+	VersionId       string `gorm:"uniqueIndex:idx_unique_version_id;column:version_id;type:varchar(64)" json:"versionId"` // Business Primary Key
+	DocId           string `gorm:"column:doc_id;type:varchar(64);not null;default:'';index:idx_doc_id_version_no,priority:1" json:"docId"`
+	VersionNo       int    `gorm:"column:version_no;type:int(11);not null;default:0;index:idx_doc_id_version_no,priority:2" json:"versionNo"`
+	KnowledgeId     string `gorm:"column:knowledge_id;type:varchar(64);not null;default:'';index:idx_knowledge_id" json:"knowledgeId"`
+	FilePath        string `gorm:"column:file_path;type:text;not null" json:"filePath"`
+	FilePathMd5     string `gorm:"column:file_path_md5;type:varchar(64);not null;default:''" json:"filePathMd5"`
+	Name            string `gorm:"column:name;type:varchar(256);not null;default:''" json:"name"`
+	FileType        string `gorm:"column:file_type;type:varchar(20);not null;default:''" json:"fileType"`
+	FileSize        int64  `gorm:"column:file_size;type:bigint(20);not null;default:0;comment:'文件大小，单位byte'" json:"fileSize"`
+	SegmentCount    int    `gorm:"column:segment_count;type:int(11);not null;default:0;comment:'切片数量'" json:"segmentCount"`
+	SegmentSnapshot string `gorm:"column:segment_snapshot;type:longtext;not null;comment:'切片快照json'" json:"segmentSnapshot"`
+	SegmentEdit     bool   `gorm:"column:segment_edit;type:tinyint(1);not null;default:0;comment:'是否为编辑切片前归档'" json:"segmentEdit"`
+	CreatedAt       int64  `gorm:"column:create_at;type:bigint(20);not null;" json:"createAt"` // 归档时间
+	UserId          string `gorm:"column:user_id;type:varchar(64);not null;default:''" json:"userId"`
+	OrgId           string `gorm:"column:org_id;type:varchar(64);not null;default:''" json:"orgId"`
+}
+
+func (KnowledgeDocVersion) TableName() string {
+	return "knowledge_doc_version"
+}
+
+// DocSegmentSnapshot 切片快照，记录父分段（通用分段）；Children为父子分段的子切片内容，仅知识库导出时记录
+type DocSegmentSnapshot struct {
+	ContentNum int      `json:"contentNum"`
+	Content    string   `json:"content"`
+	Status     bool     `json:"status"`
+	Labels     []string `json:"labels"`
+	Children   []string `json:"children,omitempty"`
+}
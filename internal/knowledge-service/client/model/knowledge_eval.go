@@ -0,0 +1,110 @@
+package model
+
+const (
+	KnowledgeEvalInit       = 0 //评测待处理
+	KnowledgeEvalProcessing = 1 //评测中
+	KnowledgeEvalSuccess    = 2 //评测成功
+	KnowledgeEvalFail       = 3 //评测失败
+)
+
+// KnowledgeEvalDataset 知识库检索评测数据集
+type KnowledgeEvalDataset struct {
+	Id            uint32 `gorm:"column:id;primary_key;type:bigint(20) auto_increment;not null;comment:'id';" json:"id"` // Primary Key
+	DatasetId     string `gorm:"uniqueIndex:idx_unique_dataset_id;column:dataset_id;type:varchar(64)" json:"datasetId"` // Business Primary Key
+	KnowledgeId   string `gorm:"column:knowledge_id;type:varchar(64);not null;default:'';index:idx_knowledge_id" json:"knowledgeId"`
+	Name          string `gorm:"column:name;type:varchar(256);not null;default:''" json:"name"`
+	Description   string `gorm:"column:description;type:text;not null;comment:'数据集描述'" json:"description"`
+	QuestionCount int    `gorm:"column:question_count;type:int(11);not null;default:0;comment:'问题数量'" json:"questionCount"`
+	CreatedAt     int64  `gorm:"column:create_at;type:bigint(20);not null;" json:"createAt"` // Create Time
+	UpdatedAt     int64  `gorm:"column:update_at;type:bigint(20);not null;" json:"updateAt"` // Update Time
+	UserId        string `gorm:"column:user_id;type:varchar(64);not null;default:'';" json:"userId"`
+	OrgId         string `gorm:"column:org_id;type:varchar(64);not null;default:''" json:"orgId"`
+}
+
+func (KnowledgeEvalDataset) TableName() string {
+	return "knowledge_eval_dataset"
+}
+
+// KnowledgeEvalQuestion 检索评测问题，期望分段不为空时按分段计算指标，否则按文档计算
+type KnowledgeEvalQuestion struct {
+	Id                 uint32 `gorm:"column:id;primary_key;type:bigint(20) auto_increment;not null;comment:'id';" json:"id"` // Primary Key
+	QuestionId         string `gorm:"uniqueIndex:idx_unique_question_id;column:question_id;type:varchar(64)" json:"questionId"`
+	DatasetId          string `gorm:"column:dataset_id;type:varchar(64);not null;default:'';index:idx_dataset_id" json:"datasetId"`
+	Question           string `gorm:"column:question;type:text;not null;comment:'问题'" json:"question"`
+	ExpectedDocIds     string `gorm:"column:expected_doc_ids;type:text;not null;comment:'期望命中的文档id列表'" json:"expectedDocIds"`
+	ExpectedContentIds string `gorm:"column:expected_content_ids;type:text;not null;comment:'期望命中的分段id列表'" json:"expectedContentIds"`
+	ReferenceAnswer    string `gorm:"column:reference_answer;type:text;not null;comment:'参考答案'" json:"referenceAnswer"`
+	CreatedAt          int64  `gorm:"column:create_at;type:bigint(20);not null;" json:"createAt"` // Create Time
+}
+
+func (KnowledgeEvalQuestion) TableName() string {
+	return "knowledge_eval_question"
+}
+
+// KnowledgeEvalRun 检索评测，按一套检索配置对数据集的全部问题做一次检索并计算指标
+type KnowledgeEvalRun struct {
+	Id          uint32  `gorm:"column:id;primary_key;type:bigint(20) auto_increment;not null;comment:'id';" json:"id"` // Primary Key
+	RunId       string  `gorm:"uniqueIndex:idx_unique_run_id;column:run_id;type:varchar(64)" json:"runId"`             // Business Primary Key
+	DatasetId   string  `gorm:"column:dataset_id;type:varchar(64);not null;default:'';index:idx_dataset_id" json:"datasetId"`
+	KnowledgeId string  `gorm:"column:knowledge_id;type:varchar(64);not null;default:'';index:idx_knowledge_id" json:"knowledgeId"`
+	MatchParams string  `gorm:"column:match_params;type:text;not null;comment:'检索配置'" json:"matchParams"`
+	HitParams   string  `gorm:"column:hit_params;type:text;not null;comment:'rag检索参数，不含问题'" json:"hitParams"`
+	TopK        int     `gorm:"column:top_k;type:int(11);not null;default:0" json:"topK"`
+	Status      int     `gorm:"column:status;type:tinyint(1);not null;default:0;comment:'0-待处理，1-评测中，2-成功，3-失败'" json:"status"`
+	TotalCount  int     `gorm:"column:total_count;type:int(11);not null;default:0;comment:'问题总数'" json:"totalCount"`
+	FinishCount int     `gorm:"column:finish_count;type:int(11);not null;default:0;comment:'已评测问题数'" json:"finishCount"`
+	Recall      float64 `gorm:"column:recall;type:double;not null;default:0" json:"recall"`
+	Mrr         float64 `gorm:"column:mrr;type:double;not null;default:0" json:"mrr"`
+	Ndcg        float64 `gorm:"column:ndcg;type:double;not null;default:0" json:"ndcg"`
+	HitRate     float64 `gorm:"column:hit_rate;type:double;not null;default:0" json:"hitRate"`
+	ErrorMsg    string  `gorm:"column:error_msg;type:longtext;not null;comment:'失败原因'" json:"errorMsg"`
+	CreatedAt   int64   `gorm:"column:create_at;type:bigint(20);not null;" json:"createAt"` // Create Time
+	UpdatedAt   int64   `gorm:"column:update_at;type:bigint(20);not null;" json:"updateAt"` // Update Time
+	UserId      string  `gorm:"column:user_id;type:varchar(64);not null;default:'';" json:"userId"`
+	OrgId       string  `gorm:"column:org_id;type:varchar(64);not null;default:''" json:"orgId"`
+}
+
+func (KnowledgeEvalRun) TableName() string {
+	return "knowledge_eval_run"
+}
+
+// KnowledgeEvalResult 检索评测中单个问题的检索结果及指标
+type KnowledgeEvalResult struct {
+	Id             uint32  `gorm:"column:id;primary_key;type:bigint(20) auto_increment;not null;comment:'id';" json:"id"` // Primary Key
+	RunId          string  `gorm:"column:run_id;type:varchar(64);not null;default:'';index:idx_run_id" json:"runId"`
+	QuestionId     string  `gorm:"column:question_id;type:varchar(64);not null;default:''" json:"questionId"`
+	HitList        string  `gorm:"column:hit_list;type:longtext;not null;comment:'检索结果列表'" json:"hitList"`
+	Recall         float64 `gorm:"column:recall;type:double;not null;default:0" json:"recall"`
+	ReciprocalRank float64 `gorm:"column:reciprocal_rank;type:double;not null;default:0" json:"reciprocalRank"`
+	Ndcg           float64 `gorm:"column:ndcg;type:double;not null;default:0" json:"ndcg"`
+	Hit            float64 `gorm:"column:hit;type:double;not null;default:0;comment:'是否命中，命中为1'" json:"hit"`
+	ErrorMsg       string  `gorm:"column:error_msg;type:text;not null;comment:'检索失败原因'" json:"errorMsg"`
+	CreatedAt      int64   `gorm:"column:create_at;type:bigint(20);not null;" json:"createAt"` // Create Time
+}
+
+func (KnowledgeEvalResult) TableName() string {
+	return "knowledge_eval_result"
+}
+
+// KnowledgeEvalMatchParams 检索评测的检索配置
+type KnowledgeEvalMatchParams struct {
+	MatchType         string  `json:"matchType"`
+	RerankModelId     string  `json:"rerankModelId"`
+	PriorityMatch     int32   `json:"priorityMatch"`
+	SemanticsPriority float32 `json:"semanticsPriority"`
+	KeywordPriority   float32 `json:"keywordPriority"`
+	TopK              int32   `json:"topK"`
+	Score             float32 `json:"score"`
+	TermWeight        float32 `json:"termWeight"`
+	TermWeightEnable  bool    `json:"termWeightEnable"`
+}
+
+// KnowledgeEvalHit 检索评测的单条检索结果
+type KnowledgeEvalHit struct {
+	DocId     string  `json:"docId"`
+	ContentId string  `json:"contentId"`
+	Title     string  `json:"title"`
+	Snippet   string  `json:"snippet"`
+	Score     float64 `json:"score"`
+	Relevant  bool    `json:"relevant"`
+}
@@ -0,0 +1,28 @@
+package model
+
+const (
+	KnowledgePermissionViewer = 1 // 查看：检索、命中测试，查看文档与分段
+	KnowledgePermissionEditor = 2 // 编辑：管理文档、分段与元数据
+	KnowledgePermissionAdmin  = 3 // 管理：修改、删除知识库，管理分享
+
+	KnowledgeGranteeUser = "user" // 分享给用户
+	KnowledgeGranteeRole = "role" // 分享给角色
+	KnowledgeGranteeOrg  = "org"  // 分享给组织
+)
+
+// KnowledgePermission 知识库分享记录，知识库创建人默认拥有管理权限，不记录在此表中
+type KnowledgePermission struct {
+	Id             uint32 `gorm:"column:id;primary_key;type:bigint(20) auto_increment;not null;comment:'id';" json:"id"` // Primary Key
+	KnowledgeId    string `gorm:"uniqueIndex:idx_unique_knowledge_grantee,priority:1;column:knowledge_id;type:varchar(64);not null;default:''" json:"knowledgeId"`
+	GranteeType    string `gorm:"uniqueIndex:idx_unique_knowledge_grantee,priority:2;index:idx_grantee,priority:1;column:grantee_type;type:varchar(16);not null;default:'';comment:'分享对象类型：user、role、org';" json:"granteeType"`
+	GranteeId      string `gorm:"uniqueIndex:idx_unique_knowledge_grantee,priority:3;index:idx_grantee,priority:2;column:grantee_id;type:varchar(64);not null;default:'';comment:'分享对象id';" json:"granteeId"`
+	PermissionType int    `gorm:"column:permission_type;type:tinyint(1);not null;default:1;comment:'权限：1.查看 2.编辑 3.管理';" json:"permissionType"`
+	CreatedAt      int64  `gorm:"column:create_at;type:bigint(20);not null;" json:"createAt"` // Create Time
+	UpdatedAt      int64  `gorm:"column:update_at;type:bigint(20);not null;" json:"updateAt"` // Update Time
+	UserId         string `gorm:"column:user_id;type:varchar(64);not null;default:'';comment:'分享人id';" json:"userId"`
+	OrgId          string `gorm:"column:org_id;type:varchar(64);not null;default:'';comment:'知识库所属组织id';" json:"orgId"`
+}
+
+func (KnowledgePermission) TableName() string {
+	return "knowledge_permission"
+}
@@ -7,7 +7,6 @@ import (
 	"github.com/UnicomAI/wanwu/internal/knowledge-service/client/orm/sqlopt"
 	async_task "github.com/UnicomAI/wanwu/internal/knowledge-service/pkg/async-task"
 	"github.com/UnicomAI/wanwu/internal/knowledge-service/pkg/db"
-	"github.com/UnicomAI/wanwu/internal/knowledge-service/service"
 	"github.com/UnicomAI/wanwu/pkg/log"
 	"gorm.io/gorm"
 )
@@ -55,28 +54,22 @@ func CreateDocSegmentImportTask(ctx context.Context, importTask *model.DocSegmen
 	})
 }
 
-// CreateOneDocSegment 创建一个分片
-func CreateOneDocSegment(ctx context.Context, importTask *model.DocSegmentImportTask, importParams *service.RagCreateDocSegmentParams) error {
-	return db.GetHandle(ctx).Transaction(func(tx *gorm.DB) error {
-		//1.创建知识库导入任务
-		err := tx.Model(&model.DocSegmentImportTask{}).Where("import_id = ?", importTask.ImportId).
-			Update("success_count", gorm.Expr("success_count + ?", 1)).Error
-		if err != nil {
-			return err
-		}
-		//2.通知rag创建分段
-		return service.RagCreateDocSegment(ctx, importParams)
-	})
+// IncrDocSegmentImportSuccessCount 导入成功数量加一
+func IncrDocSegmentImportSuccessCount(ctx context.Context, importId string) error {
+	return db.GetHandle(ctx).Model(&model.DocSegmentImportTask{}).
+		Where("import_id = ?", importId).
+		Update("success_count", gorm.Expr("success_count + ?", 1)).Error
 }
 
 // UpdateDocSegmentImportTaskStatus 更新导入任务状态
-func UpdateDocSegmentImportTaskStatus(ctx context.Context, taskId string, status int, errMsg string, totalCount int) error {
+func UpdateDocSegmentImportTaskStatus(ctx context.Context, taskId string, status int, errMsg string, totalCount int, errorRows string) error {
 	return db.GetHandle(ctx).Model(&model.DocSegmentImportTask{}).
 		Where("import_id = ?", taskId).
 		Updates(map[string]interface{}{
 			"status":      status,
 			"error_msg":   errMsg,
 			"total_count": totalCount,
+			"error_rows":  errorRows,
 		}).Error
 }
 
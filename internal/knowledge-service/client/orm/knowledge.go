@@ -14,26 +14,39 @@ import (
 	"gorm.io/gorm"
 )
 
-// SelectKnowledgeList 查询知识库列表
-func SelectKnowledgeList(ctx context.Context, userId, orgId, name string, tagIdList []string) ([]*model.KnowledgeBase, error) {
+// SelectKnowledgeList 查询知识库列表（本人创建及分享给本人的知识库），返回知识库列表与知识库id到当前用户权限的映射
+func SelectKnowledgeList(ctx context.Context, userId, orgId, name string, tagIdList []string) ([]*model.KnowledgeBase, map[string]int, error) {
 	var knowledgeIdList []string
 	var err error
 	if len(tagIdList) > 0 {
 		knowledgeIdList, err = SelectKnowledgeIdByTagId(ctx, tagIdList)
 		if err != nil {
-			return nil, err
+			return nil, nil, err
 		}
 	}
+	permissionMap, err := SelectKnowledgePermissionMap(ctx, userId, orgId, knowledgeIdList)
+	if err != nil {
+		return nil, nil, err
+	}
+	var sharedIdList []string
+	for knowledgeId := range permissionMap {
+		sharedIdList = append(sharedIdList, knowledgeId)
+	}
 	var knowledgeList []*model.KnowledgeBase
-	err = sqlopt.SQLOptions(sqlopt.WithKnowledgeIDList(knowledgeIdList), sqlopt.WithPermit(orgId, userId), sqlopt.LikeName(name), sqlopt.WithDelete(0)).
+	err = sqlopt.SQLOptions(sqlopt.WithKnowledgeIDList(knowledgeIdList), sqlopt.WithPermitOrKnowledgeIDs(orgId, userId, sharedIdList), sqlopt.LikeName(name), sqlopt.WithDelete(0)).
 		Apply(db.GetHandle(ctx), &model.KnowledgeBase{}).
 		Order("create_at desc").
 		Find(&knowledgeList).
 		Error
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
-	return knowledgeList, nil
+	for _, knowledge := range knowledgeList {
+		if isKnowledgeOwner(knowledge, userId, orgId) {
+			permissionMap[knowledge.KnowledgeId] = model.KnowledgePermissionAdmin
+		}
+	}
+	return knowledgeList, permissionMap, nil
 }
 
 // SelectKnowledgeById 查询知识库信息
@@ -0,0 +1,99 @@
+package orm
+
+import (
+	"context"
+	"time"
+
+	errs "github.com/UnicomAI/wanwu/api/proto/err-code"
+	"github.com/UnicomAI/wanwu/internal/knowledge-service/client/model"
+	"github.com/UnicomAI/wanwu/internal/knowledge-service/client/orm/sqlopt"
+	async_task "github.com/UnicomAI/wanwu/internal/knowledge-service/pkg/async-task"
+	"github.com/UnicomAI/wanwu/internal/knowledge-service/pkg/db"
+	"github.com/UnicomAI/wanwu/internal/knowledge-service/pkg/generator"
+	"github.com/UnicomAI/wanwu/internal/knowledge-service/pkg/util"
+	"github.com/UnicomAI/wanwu/pkg/log"
+	"gorm.io/gorm"
+)
+
+// CreateKnowledgeBundleTask 创建知识库导入导出任务并提交异步任务
+func CreateKnowledgeBundleTask(ctx context.Context, task *model.KnowledgeBundleTask) error {
+	now := time.Now().UnixMilli()
+	task.TaskId = generator.GetGenerator().NewID()
+	task.Status = model.KnowledgeBundleInit
+	task.CreatedAt, task.UpdatedAt = now, now
+	return db.GetHandle(ctx).Transaction(func(tx *gorm.DB) error {
+		err := tx.Create(task).Error
+		if err != nil {
+			return err
+		}
+		return async_task.SubmitTask(ctx, async_task.KnowledgeBundleTaskType, &async_task.KnowledgeBundleTaskParams{
+			TaskId: task.TaskId,
+		})
+	})
+}
+
+// SelectKnowledgeBundleTaskById 查询知识库导入导出任务，userId为空时不校验任务创建人
+func SelectKnowledgeBundleTaskById(ctx context.Context, taskId, userId, orgId string) (*model.KnowledgeBundleTask, error) {
+	var task model.KnowledgeBundleTask
+	err := sqlopt.SQLOptions(sqlopt.WithTaskID(taskId), sqlopt.WithPermit(orgId, userId)).
+		Apply(db.GetHandle(ctx), &model.KnowledgeBundleTask{}).
+		First(&task).Error
+	if err != nil {
+		log.Errorf("SelectKnowledgeBundleTaskById taskId %s err: %v", taskId, err)
+		return nil, util.ErrCode(errs.Code_KnowledgeBundleSelectFailed)
+	}
+	return &task, nil
+}
+
+// UpdateKnowledgeBundleTask 更新知识库导入导出任务的状态或进度
+func UpdateKnowledgeBundleTask(ctx context.Context, id uint32, updateParams map[string]interface{}) error {
+	updateParams["update_at"] = time.Now().UnixMilli()
+	return db.GetHandle(ctx).Model(&model.KnowledgeBundleTask{}).Where("id = ?", id).Updates(updateParams).Error
+}
+
+// CreateKnowledgeBundleDoc 创建知识库导入的文档：文档沿用导出时的导入配置，单独创建导入任务；
+// version 不为空时记录为0号版本，rag解析完成后恢复该版本的切片快照
+func CreateKnowledgeBundleDoc(ctx context.Context, knowledge *model.KnowledgeBase, doc *model.KnowledgeDoc, importTask *model.KnowledgeImportTask, version *model.KnowledgeDocVersion) error {
+	return db.GetHandle(ctx).Transaction(func(tx *gorm.DB) error {
+		//1.创建导入任务
+		err := createKnowledgeImportTask(tx, importTask)
+		if err != nil {
+			return err
+		}
+		//2.记录切片快照
+		if version != nil {
+			version.VersionId = generator.GetGenerator().NewID()
+			version.DocId = doc.DocId
+			version.KnowledgeId = doc.KnowledgeId
+			version.FilePath = doc.FilePath
+			version.FilePathMd5 = doc.FilePathMd5
+			version.Name = doc.Name
+			version.FileType = doc.FileType
+			version.FileSize = doc.FileSize
+			version.CreatedAt = time.Now().UnixMilli()
+			version.UserId = doc.UserId
+			version.OrgId = doc.OrgId
+			if err = tx.Create(version).Error; err != nil {
+				return err
+			}
+			doc.RestoreId = version.VersionId
+		}
+		//3.创建文档和元数据
+		if err = createKnowledgeDoc(tx, doc); err != nil {
+			return err
+		}
+		ragMetaList, err := buildAndCreateMetaData(tx, importTask, doc)
+		if err != nil {
+			return err
+		}
+		err = UpdateKnowledgeFileInfo(tx, knowledge.KnowledgeId, []*model.DocInfo{{DocSize: doc.FileSize}})
+		if err != nil {
+			return err
+		}
+		//4.rag文档导入
+		if doc.FileType == model.UrlFileType {
+			return ragImportUrlDoc(ctx, knowledge, doc, importTask, ragMetaList)
+		}
+		return ragImportFileDoc(ctx, knowledge, doc, importTask, ragMetaList)
+	})
+}
@@ -0,0 +1,143 @@
+package orm
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	errs "github.com/UnicomAI/wanwu/api/proto/err-code"
+	"github.com/UnicomAI/wanwu/internal/knowledge-service/client/model"
+	"github.com/UnicomAI/wanwu/internal/knowledge-service/client/orm/sqlopt"
+	async_task "github.com/UnicomAI/wanwu/internal/knowledge-service/pkg/async-task"
+	"github.com/UnicomAI/wanwu/internal/knowledge-service/pkg/db"
+	"github.com/UnicomAI/wanwu/internal/knowledge-service/pkg/generator"
+	"github.com/UnicomAI/wanwu/internal/knowledge-service/pkg/util"
+	"github.com/UnicomAI/wanwu/pkg/log"
+	"gorm.io/gorm"
+)
+
+// connectorRunningTimeout 执行中状态超过该时长视为服务异常中断，允许再次执行
+const connectorRunningTimeout int64 = 60 * 60 * 1000
+
+// CreateKnowledgeConnector 创建知识库连接器
+func CreateKnowledgeConnector(ctx context.Context, connector *model.KnowledgeConnector) error {
+	now := time.Now().UnixMilli()
+	connector.ConnectorId = generator.GetGenerator().NewID()
+	connector.CreatedAt, connector.UpdatedAt = now, now
+	return db.GetHandle(ctx).Create(connector).Error
+}
+
+// UpdateKnowledgeConnector 更新知识库连接器配置，凭证为空时保留原凭证；配置变化后游标不重置，如需全量导入请新建连接器
+func UpdateKnowledgeConnector(ctx context.Context, connector *model.KnowledgeConnector) error {
+	updates := map[string]interface{}{
+		"name":            connector.Name,
+		"params":          connector.Params,
+		"segment_config":  connector.SegmentConfig,
+		"doc_analyzer":    connector.DocAnalyzer,
+		"ocr_model_id":    connector.OcrModelId,
+		"doc_pre_process": connector.DocPreProcess,
+		"update_at":       time.Now().UnixMilli(),
+	}
+	if connector.Credential != "" {
+		updates["credential"] = connector.Credential
+	}
+	return db.GetHandle(ctx).Model(&model.KnowledgeConnector{}).Where("id = ?", connector.Id).Updates(updates).Error
+}
+
+// SelectKnowledgeConnectorById 查询知识库连接器
+func SelectKnowledgeConnectorById(ctx context.Context, connectorId string) (*model.KnowledgeConnector, error) {
+	var connector model.KnowledgeConnector
+	err := sqlopt.SQLOptions(sqlopt.WithConnectorID(connectorId)).
+		Apply(db.GetHandle(ctx), &model.KnowledgeConnector{}).
+		First(&connector).Error
+	if err != nil {
+		log.Errorf("SelectKnowledgeConnectorById connectorId %s err: %v", connectorId, err)
+		return nil, util.ErrCode(errs.Code_KnowledgeConnectorSelectFailed)
+	}
+	return &connector, nil
+}
+
+// SelectKnowledgeConnectorList 查询知识库连接器列表
+func SelectKnowledgeConnectorList(ctx context.Context, knowledgeId string) ([]*model.KnowledgeConnector, error) {
+	var list []*model.KnowledgeConnector
+	err := sqlopt.SQLOptions(sqlopt.WithKnowledgeID(knowledgeId)).
+		Apply(db.GetHandle(ctx), &model.KnowledgeConnector{}).
+		Order("create_at desc").
+		Find(&list).Error
+	if err != nil {
+		return nil, err
+	}
+	return list, nil
+}
+
+// DeleteKnowledgeConnector 删除知识库连接器，已导入的文档保留
+func DeleteKnowledgeConnector(ctx context.Context, connectorId string) error {
+	return db.GetHandle(ctx).Where("connector_id = ?", connectorId).Delete(&model.KnowledgeConnector{}).Error
+}
+
+// DeleteKnowledgeConnectorByKnowledgeId 删除知识库的所有连接器
+func DeleteKnowledgeConnectorByKnowledgeId(tx *gorm.DB, knowledgeId string) error {
+	return tx.Where("knowledge_id = ?", knowledgeId).Delete(&model.KnowledgeConnector{}).Error
+}
+
+// SubmitKnowledgeConnectorTask 将连接器置为执行中并提交增量导入任务，执行中的连接器不重复提交
+func SubmitKnowledgeConnectorTask(ctx context.Context, connector *model.KnowledgeConnector) error {
+	return db.GetHandle(ctx).Transaction(func(tx *gorm.DB) error {
+		now := time.Now().UnixMilli()
+		result := tx.Model(&model.KnowledgeConnector{}).
+			Where("id = ? AND (status <> ? OR update_at < ?)", connector.Id, model.ConnectorRunning, now-connectorRunningTimeout).
+			Updates(map[string]interface{}{
+				"status":    model.ConnectorRunning,
+				"error_msg": "",
+				"update_at": now,
+			})
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return errors.New("connector is running")
+		}
+		return async_task.SubmitTask(ctx, async_task.ConnectorImportTaskType, &async_task.ConnectorImportTaskParams{
+			ConnectorId: connector.ConnectorId,
+		})
+	})
+}
+
+// CreateKnowledgeConnectorImportTask 创建连接器导入任务并推进游标，游标与导入任务在同一事务中提交
+func CreateKnowledgeConnectorImportTask(ctx context.Context, connector *model.KnowledgeConnector, importTask *model.KnowledgeImportTask, cursor string) error {
+	return db.GetHandle(ctx).Transaction(func(tx *gorm.DB) error {
+		updates := map[string]interface{}{
+			"status":      model.ConnectorSuccess,
+			"error_msg":   "",
+			"sync_cursor": cursor,
+			"last_run_at": time.Now().UnixMilli(),
+			"update_at":   time.Now().UnixMilli(),
+		}
+		if importTask != nil {
+			if err := createKnowledgeImportTask(tx, importTask); err != nil {
+				return err
+			}
+			updates["last_import_task_id"] = importTask.ImportId
+		}
+		err := tx.Model(&model.KnowledgeConnector{}).Where("id = ?", connector.Id).Updates(updates).Error
+		if err != nil {
+			return err
+		}
+		if importTask == nil {
+			return nil
+		}
+		return async_task.SubmitTask(ctx, async_task.DocImportTaskType, &async_task.DocImportTaskParams{
+			TaskId: importTask.ImportId,
+		})
+	})
+}
+
+// UpdateKnowledgeConnectorFail 连接器执行失败，游标不推进
+func UpdateKnowledgeConnectorFail(ctx context.Context, connector *model.KnowledgeConnector, errMsg string) error {
+	return db.GetHandle(ctx).Model(&model.KnowledgeConnector{}).Where("id = ?", connector.Id).Updates(map[string]interface{}{
+		"status":      model.ConnectorFail,
+		"error_msg":   errMsg,
+		"last_run_at": time.Now().UnixMilli(),
+		"update_at":   time.Now().UnixMilli(),
+	}).Error
+}
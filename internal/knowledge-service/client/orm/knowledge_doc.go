@@ -6,6 +6,7 @@ import (
 	"errors"
 	"net/url"
 	"strconv"
+	"time"
 
 	errs "github.com/UnicomAI/wanwu/api/proto/err-code"
 	"github.com/UnicomAI/wanwu/internal/knowledge-service/client/model"
@@ -144,8 +145,29 @@ func CreateKnowledgeDoc(ctx context.Context, doc *model.KnowledgeDoc, importTask
 	if err != nil {
 		return err
 	}
+	return db.GetHandle(ctx).Transaction(func(tx *gorm.DB) error {
+		//1.插入数据
+		err = createKnowledgeDoc(tx, doc)
+		if err != nil {
+			return err
+		}
+		ragMetaList, err := buildAndCreateMetaData(tx, importTask, doc)
+		if err != nil {
+			log.Errorf("buildAndCreateMetaData error %s", err.Error())
+		}
+		//非初始话状态的不需要rag 导入，因为有可能直接失败了
+		if doc.Status != model.DocInit {
+			return nil
+		}
+		//2.rag文档导入
+		return ragImportFileDoc(ctx, knowledge, doc, importTask, ragMetaList)
+	})
+}
+
+// ragImportFileDoc rag文件文档导入
+func ragImportFileDoc(ctx context.Context, knowledge *model.KnowledgeBase, doc *model.KnowledgeDoc, importTask *model.KnowledgeImportTask, ragMetaList []*service.RagMetaDataParams) error {
 	var config = &model.SegmentConfig{}
-	err = json.Unmarshal([]byte(importTask.SegmentConfig), config)
+	err := json.Unmarshal([]byte(importTask.SegmentConfig), config)
 	if err != nil {
 		log.Errorf("SegmentConfig process error %s", err.Error())
 		return err
@@ -168,40 +190,24 @@ func CreateKnowledgeDoc(ctx context.Context, doc *model.KnowledgeDoc, importTask
 	}
 
 	_, objectName, _ := service.SplitFilePath(doc.FilePath)
-	return db.GetHandle(ctx).Transaction(func(tx *gorm.DB) error {
-		//1.插入数据
-		err = createKnowledgeDoc(tx, doc)
-		if err != nil {
-			return err
-		}
-		ragMetaList, err := buildAndCreateMetaData(tx, importTask, doc)
-		if err != nil {
-			log.Errorf("buildAndCreateMetaData error %s", err.Error())
-		}
-		//非初始话状态的不需要rag 导入，因为有可能直接失败了
-		if doc.Status != model.DocInit {
-			return nil
-		}
-		//2.rag文档导入
-		return service.RagImportDoc(ctx, &service.RagImportDocParams{
-			DocId:               doc.DocId,
-			KnowledgeName:       knowledge.Name,
-			CategoryId:          knowledge.KnowledgeId,
-			UserId:              doc.UserId,
-			Overlap:             config.Overlap,
-			SegmentSize:         config.MaxSplitter,
-			SegmentType:         service.RebuildSegmentType(config.SegmentType, config.SegmentMethod),
-			SplitType:           service.RebuildSplitType(config.SegmentMethod),
-			Separators:          config.Splitter,
-			ParserChoices:       analyzer.AnalyzerList,
-			ObjectName:          objectName,
-			OriginalName:        doc.Name,
-			IsEnhanced:          "false",
-			OcrModelId:          importTask.OcrModelId,
-			PreProcess:          preProcess.PreProcessList,
-			RagMetaDataParams:   ragMetaList,
-			RagChildChunkConfig: buildSubRagChunkConfig(config),
-		})
+	return service.RagImportDoc(ctx, &service.RagImportDocParams{
+		DocId:               doc.DocId,
+		KnowledgeName:       knowledge.Name,
+		CategoryId:          knowledge.KnowledgeId,
+		UserId:              doc.UserId,
+		Overlap:             config.Overlap,
+		SegmentSize:         config.MaxSplitter,
+		SegmentType:         service.RebuildSegmentType(config.SegmentType, config.SegmentMethod),
+		SplitType:           service.RebuildSplitType(config.SegmentMethod),
+		Separators:          config.Splitter,
+		ParserChoices:       analyzer.AnalyzerList,
+		ObjectName:          objectName,
+		OriginalName:        doc.Name,
+		IsEnhanced:          "false",
+		OcrModelId:          importTask.OcrModelId,
+		PreProcess:          preProcess.PreProcessList,
+		RagMetaDataParams:   ragMetaList,
+		RagChildChunkConfig: buildSubRagChunkConfig(config),
 	})
 }
 
@@ -299,24 +305,6 @@ func CreateKnowledgeUrlDoc(ctx context.Context, doc *model.KnowledgeDoc, importT
 	if err != nil {
 		return err
 	}
-	var config = &model.SegmentConfig{}
-	err = json.Unmarshal([]byte(importTask.SegmentConfig), config)
-	if err != nil {
-		log.Errorf("SegmentConfig process error %s", err.Error())
-		return err
-	}
-	var analyzer = &model.DocAnalyzer{}
-	err = json.Unmarshal([]byte(importTask.DocAnalyzer), analyzer)
-	if err != nil {
-		log.Errorf("DocAnalyzer process error %s", err.Error())
-		return err
-	}
-	var preProcess = &model.DocPreProcess{}
-	err = json.Unmarshal([]byte(importTask.DocPreProcess), preProcess)
-	if err != nil {
-		log.Errorf("DocPreprocess process error %s", err.Error())
-		return err
-	}
 	return db.GetHandle(ctx).Transaction(func(tx *gorm.DB) error {
 		//1.逻辑删除数据
 		err = createKnowledgeDoc(tx, doc)
@@ -331,46 +319,127 @@ func CreateKnowledgeUrlDoc(ctx context.Context, doc *model.KnowledgeDoc, importT
 		if doc.Status != model.DocInit {
 			return nil
 		}
-		//2.rag url文档导入
-		err = service.RagImportUrlDoc(ctx, &service.RagImportUrlDocParams{
-			TaskId:            doc.DocId,
-			FileName:          doc.Name,
-			Url:               url.QueryEscape(doc.FilePath),
-			UserId:            doc.UserId,
-			Overlap:           config.Overlap,
-			SegmentSize:       config.MaxSplitter,
-			SegmentType:       service.RebuildSegmentType(config.SegmentType, config.SegmentMethod),
-			SplitType:         service.RebuildSplitType(config.SegmentMethod),
-			Separators:        config.Splitter,
-			KnowledgeBaseName: knowledge.Name,
-			OcrModelId:        importTask.OcrModelId,
-			PreProcess:        preProcess.PreProcessList,
-			RagMetaDataParams: ragMetaList,
-		})
+		return ragImportUrlDoc(ctx, knowledge, doc, importTask, ragMetaList)
+	})
+}
+
+// ReimportKnowledgeUrlDoc url文档内容变化后重新导入：删除rag中的文档后按原导入配置与文档元数据重新导入，文档状态重置为待处理
+func ReimportKnowledgeUrlDoc(ctx context.Context, knowledge *model.KnowledgeBase, doc *model.KnowledgeDoc, contentMd5 string) error {
+	importTask, err := SelectKnowledgeImportTaskById(ctx, doc.ImportTaskId)
+	if err != nil {
+		return err
+	}
+	ragMetaList, err := buildDocRagMetaList(ctx, doc.DocId)
+	if err != nil {
+		return err
+	}
+	//1.删除rag中的文档
+	err = service.RagDeleteDoc(ctx, &service.RagDeleteDocParams{
+		UserId:        doc.UserId,
+		KnowledgeBase: knowledge.Name,
+		FileName:      service.RebuildFileName(doc.DocId, doc.FileType, doc.Name),
+	})
+	if err != nil {
+		return err
+	}
+	return db.GetHandle(ctx).Transaction(func(tx *gorm.DB) error {
+		//2.重置文档状态
+		err = tx.Model(&model.KnowledgeDoc{}).Where("id = ?", doc.Id).Updates(map[string]interface{}{
+			"status":      model.DocInit,
+			"error_msg":   "",
+			"content_md5": contentMd5,
+			"update_at":   time.Now().UnixMilli(),
+		}).Error
 		if err != nil {
 			return err
 		}
-		//3.rag 文档开始导入操作
-		var fileName = service.RebuildFileName(doc.DocId, doc.FileType, doc.Name)
-		return service.RagImportDoc(ctx, &service.RagImportDocParams{
-			DocId:               doc.DocId,
-			KnowledgeName:       knowledge.Name,
-			CategoryId:          knowledge.KnowledgeId,
-			UserId:              doc.UserId,
-			Overlap:             config.Overlap,
-			SegmentSize:         config.MaxSplitter,
-			SegmentType:         service.RebuildSegmentType(config.SegmentType, config.SegmentMethod),
-			SplitType:           service.RebuildSplitType(config.SegmentMethod),
-			Separators:          config.Splitter,
-			ParserChoices:       analyzer.AnalyzerList,
-			ObjectName:          fileName,
-			OriginalName:        fileName,
-			IsEnhanced:          "false",
-			OcrModelId:          importTask.OcrModelId,
-			PreProcess:          preProcess.PreProcessList,
-			RagMetaDataParams:   ragMetaList,
-			RagChildChunkConfig: buildSubRagChunkConfig(config),
+		//3.rag重新导入
+		return ragImportUrlDoc(ctx, knowledge, doc, importTask, ragMetaList)
+	})
+}
+
+// buildDocRagMetaList 查询文档已有元数据，构造rag导入参数
+func buildDocRagMetaList(ctx context.Context, docId string) ([]*service.RagMetaDataParams, error) {
+	metaList, err := SelectDocMetaList(ctx, "", "", docId)
+	if err != nil {
+		return nil, err
+	}
+	var ragMetaList []*service.RagMetaDataParams
+	for _, meta := range metaList {
+		ragValue, err := convertMetaValue(meta)
+		if err != nil {
+			return nil, err
+		}
+		ragMetaList = append(ragMetaList, &service.RagMetaDataParams{
+			MetaId:    meta.MetaId,
+			Key:       meta.Key,
+			Value:     ragValue,
+			ValueType: meta.ValueType,
+			Rule:      meta.Rule,
 		})
+	}
+	return ragMetaList, nil
+}
+
+// ragImportUrlDoc rag url文档导入
+func ragImportUrlDoc(ctx context.Context, knowledge *model.KnowledgeBase, doc *model.KnowledgeDoc, importTask *model.KnowledgeImportTask, ragMetaList []*service.RagMetaDataParams) error {
+	var config = &model.SegmentConfig{}
+	err := json.Unmarshal([]byte(importTask.SegmentConfig), config)
+	if err != nil {
+		log.Errorf("SegmentConfig process error %s", err.Error())
+		return err
+	}
+	var analyzer = &model.DocAnalyzer{}
+	err = json.Unmarshal([]byte(importTask.DocAnalyzer), analyzer)
+	if err != nil {
+		log.Errorf("DocAnalyzer process error %s", err.Error())
+		return err
+	}
+	var preProcess = &model.DocPreProcess{}
+	err = json.Unmarshal([]byte(importTask.DocPreProcess), preProcess)
+	if err != nil {
+		log.Errorf("DocPreprocess process error %s", err.Error())
+		return err
+	}
+	//1.rag url文档导入
+	err = service.RagImportUrlDoc(ctx, &service.RagImportUrlDocParams{
+		TaskId:            doc.DocId,
+		FileName:          doc.Name,
+		Url:               url.QueryEscape(doc.FilePath),
+		UserId:            doc.UserId,
+		Overlap:           config.Overlap,
+		SegmentSize:       config.MaxSplitter,
+		SegmentType:       service.RebuildSegmentType(config.SegmentType, config.SegmentMethod),
+		SplitType:         service.RebuildSplitType(config.SegmentMethod),
+		Separators:        config.Splitter,
+		KnowledgeBaseName: knowledge.Name,
+		OcrModelId:        importTask.OcrModelId,
+		PreProcess:        preProcess.PreProcessList,
+		RagMetaDataParams: ragMetaList,
+	})
+	if err != nil {
+		return err
+	}
+	//2.rag 文档开始导入操作
+	var fileName = service.RebuildFileName(doc.DocId, doc.FileType, doc.Name)
+	return service.RagImportDoc(ctx, &service.RagImportDocParams{
+		DocId:               doc.DocId,
+		KnowledgeName:       knowledge.Name,
+		CategoryId:          knowledge.KnowledgeId,
+		UserId:              doc.UserId,
+		Overlap:             config.Overlap,
+		SegmentSize:         config.MaxSplitter,
+		SegmentType:         service.RebuildSegmentType(config.SegmentType, config.SegmentMethod),
+		SplitType:           service.RebuildSplitType(config.SegmentMethod),
+		Separators:          config.Splitter,
+		ParserChoices:       analyzer.AnalyzerList,
+		ObjectName:          fileName,
+		OriginalName:        fileName,
+		IsEnhanced:          "false",
+		OcrModelId:          importTask.OcrModelId,
+		PreProcess:          preProcess.PreProcessList,
+		RagMetaDataParams:   ragMetaList,
+		RagChildChunkConfig: buildSubRagChunkConfig(config),
 	})
 }
 
@@ -0,0 +1,172 @@
+package orm
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	errs "github.com/UnicomAI/wanwu/api/proto/err-code"
+	"github.com/UnicomAI/wanwu/internal/knowledge-service/client/model"
+	"github.com/UnicomAI/wanwu/internal/knowledge-service/client/orm/sqlopt"
+	async_task "github.com/UnicomAI/wanwu/internal/knowledge-service/pkg/async-task"
+	"github.com/UnicomAI/wanwu/internal/knowledge-service/pkg/db"
+	"github.com/UnicomAI/wanwu/internal/knowledge-service/pkg/generator"
+	"github.com/UnicomAI/wanwu/internal/knowledge-service/pkg/util"
+	"github.com/UnicomAI/wanwu/pkg/log"
+	"gorm.io/gorm"
+)
+
+// SaveKnowledgeDocSync 设置url文档定时同步，同一文档或同一导入任务只保留一个同步配置
+func SaveKnowledgeDocSync(ctx context.Context, docSync *model.KnowledgeDocSync) error {
+	var existing model.KnowledgeDocSync
+	err := sqlopt.SQLOptions(sqlopt.WithKnowledgeID(docSync.KnowledgeId),
+		sqlopt.WithDocID(docSync.DocId),
+		sqlopt.WithImportTaskID(docSync.ImportTaskId)).
+		Apply(db.GetHandle(ctx), &model.KnowledgeDocSync{}).
+		First(&existing).Error
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		return err
+	}
+	now := time.Now().UnixMilli()
+	if err == nil {
+		docSync.Id, docSync.SyncId = existing.Id, existing.SyncId
+		return db.GetHandle(ctx).Model(&model.KnowledgeDocSync{}).Where("id = ?", existing.Id).Updates(map[string]interface{}{
+			"cron_expr":        docSync.CronExpr,
+			"interval_minutes": docSync.IntervalMinutes,
+			"enable":           docSync.Enable,
+			"next_sync_at":     docSync.NextSyncAt,
+			"update_at":        now,
+		}).Error
+	}
+	docSync.SyncId = generator.GetGenerator().NewID()
+	docSync.CreatedAt, docSync.UpdatedAt = now, now
+	return db.GetHandle(ctx).Create(docSync).Error
+}
+
+// SelectKnowledgeDocSyncById 查询url文档同步配置
+func SelectKnowledgeDocSyncById(ctx context.Context, syncId string) (*model.KnowledgeDocSync, error) {
+	var docSync model.KnowledgeDocSync
+	err := sqlopt.SQLOptions(sqlopt.WithSyncID(syncId)).
+		Apply(db.GetHandle(ctx), &model.KnowledgeDocSync{}).
+		First(&docSync).Error
+	if err != nil {
+		log.Errorf("SelectKnowledgeDocSyncById syncId %s err: %v", syncId, err)
+		return nil, util.ErrCode(errs.Code_KnowledgeDocSyncSelectFailed)
+	}
+	return &docSync, nil
+}
+
+// SelectKnowledgeDocSyncList 查询知识库的url文档同步配置列表
+func SelectKnowledgeDocSyncList(ctx context.Context, knowledgeId string) ([]*model.KnowledgeDocSync, error) {
+	var list []*model.KnowledgeDocSync
+	err := sqlopt.SQLOptions(sqlopt.WithKnowledgeID(knowledgeId)).
+		Apply(db.GetHandle(ctx), &model.KnowledgeDocSync{}).
+		Order("create_at desc").
+		Find(&list).Error
+	if err != nil {
+		return nil, err
+	}
+	return list, nil
+}
+
+// DeleteKnowledgeDocSync 删除url文档同步配置及同步记录
+func DeleteKnowledgeDocSync(ctx context.Context, syncId string) error {
+	return db.GetHandle(ctx).Transaction(func(tx *gorm.DB) error {
+		err := tx.Where("sync_id = ?", syncId).Delete(&model.KnowledgeDocSync{}).Error
+		if err != nil {
+			return err
+		}
+		return tx.Where("sync_id = ?", syncId).Delete(&model.KnowledgeDocSyncHistory{}).Error
+	})
+}
+
+// DeleteKnowledgeDocSyncByKnowledgeId 删除知识库的所有url文档同步配置及同步记录
+func DeleteKnowledgeDocSyncByKnowledgeId(tx *gorm.DB, knowledgeId string) error {
+	err := tx.Where("knowledge_id = ?", knowledgeId).Delete(&model.KnowledgeDocSync{}).Error
+	if err != nil {
+		return err
+	}
+	return tx.Where("knowledge_id = ?", knowledgeId).Delete(&model.KnowledgeDocSyncHistory{}).Error
+}
+
+// SelectDueKnowledgeDocSyncList 查询已到同步时间的同步配置
+func SelectDueKnowledgeDocSyncList(ctx context.Context, now int64, limit int) ([]*model.KnowledgeDocSync, error) {
+	var list []*model.KnowledgeDocSync
+	err := db.GetHandle(ctx).Model(&model.KnowledgeDocSync{}).
+		Where("enable = ? AND next_sync_at > 0 AND next_sync_at <= ?", true, now).
+		Order("next_sync_at").
+		Limit(limit).
+		Find(&list).Error
+	if err != nil {
+		return nil, err
+	}
+	return list, nil
+}
+
+// ClaimKnowledgeDocSync 以下次同步时间做乐观锁推进同步时间，多实例部署时只有一个实例能领取本次同步
+func ClaimKnowledgeDocSync(ctx context.Context, id uint32, nextSyncAt, newNextSyncAt int64) (bool, error) {
+	result := db.GetHandle(ctx).Model(&model.KnowledgeDocSync{}).
+		Where("id = ? AND next_sync_at = ?", id, nextSyncAt).
+		Update("next_sync_at", newNextSyncAt)
+	if result.Error != nil {
+		return false, result.Error
+	}
+	return result.RowsAffected == 1, nil
+}
+
+// SubmitKnowledgeDocSyncTask 提交url文档同步任务
+func SubmitKnowledgeDocSyncTask(ctx context.Context, syncId string) error {
+	return async_task.SubmitTask(ctx, async_task.DocSyncTaskType, &async_task.DocSyncTaskParams{
+		SyncId: syncId,
+	})
+}
+
+// SelectKnowledgeDocSyncDocList 查询同步配置对应的url文档，解析中的文档本次跳过
+func SelectKnowledgeDocSyncDocList(ctx context.Context, docSync *model.KnowledgeDocSync) ([]*model.KnowledgeDoc, error) {
+	var docList []*model.KnowledgeDoc
+	tx := sqlopt.SQLOptions(sqlopt.WithKnowledgeID(docSync.KnowledgeId),
+		sqlopt.WithDelete(0)).
+		Apply(db.GetHandle(ctx), &model.KnowledgeDoc{}).
+		Where("file_type = ?", model.UrlFileType).
+		Where("status NOT IN ?", append(util.BuildAnalyzingStatus(), model.DocInit))
+	if docSync.DocId != "" {
+		tx = tx.Where("doc_id = ?", docSync.DocId)
+	} else {
+		tx = tx.Where("batch_id = ?", docSync.ImportTaskId)
+	}
+	if err := tx.Find(&docList).Error; err != nil {
+		return nil, err
+	}
+	return docList, nil
+}
+
+// FinishKnowledgeDocSync 保存本次同步记录并更新上次同步时间
+func FinishKnowledgeDocSync(ctx context.Context, docSync *model.KnowledgeDocSync, historyList []*model.KnowledgeDocSyncHistory) error {
+	return db.GetHandle(ctx).Transaction(func(tx *gorm.DB) error {
+		if len(historyList) > 0 {
+			if err := tx.Create(historyList).Error; err != nil {
+				return err
+			}
+		}
+		return tx.Model(&model.KnowledgeDocSync{}).Where("id = ?", docSync.Id).Update("last_sync_at", time.Now().UnixMilli()).Error
+	})
+}
+
+// SelectKnowledgeDocSyncHistoryList 分页查询url文档同步记录
+func SelectKnowledgeDocSyncHistoryList(ctx context.Context, syncId, docId string, pageSize, pageNum int32) ([]*model.KnowledgeDocSyncHistory, int64, error) {
+	tx := sqlopt.SQLOptions(sqlopt.WithSyncID(syncId)).
+		Apply(db.GetHandle(ctx), &model.KnowledgeDocSyncHistory{})
+	if docId != "" {
+		tx = tx.Where("doc_id = ?", docId)
+	}
+	var total int64
+	if err := tx.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+	var list []*model.KnowledgeDocSyncHistory
+	err := tx.Order("start_at desc").Limit(int(pageSize)).Offset(int(pageSize * (pageNum - 1))).Find(&list).Error
+	if err != nil {
+		return nil, 0, err
+	}
+	return list, total, nil
+}
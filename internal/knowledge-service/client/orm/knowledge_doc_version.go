@@ -0,0 +1,171 @@
+package orm
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	errs "github.com/UnicomAI/wanwu/api/proto/err-code"
+	"github.com/UnicomAI/wanwu/internal/knowledge-service/client/model"
+	"github.com/UnicomAI/wanwu/internal/knowledge-service/client/orm/sqlopt"
+	"github.com/UnicomAI/wanwu/internal/knowledge-service/pkg/db"
+	"github.com/UnicomAI/wanwu/internal/knowledge-service/pkg/generator"
+	"github.com/UnicomAI/wanwu/internal/knowledge-service/pkg/util"
+	"github.com/UnicomAI/wanwu/internal/knowledge-service/service"
+	"github.com/UnicomAI/wanwu/pkg/log"
+	"gorm.io/gorm"
+)
+
+// SelectKnowledgeDocVersionList 查询文档历史版本列表，按版本号倒序
+func SelectKnowledgeDocVersionList(ctx context.Context, docId string) ([]*model.KnowledgeDocVersion, error) {
+	var list []*model.KnowledgeDocVersion
+	err := sqlopt.SQLOptions(sqlopt.WithDocID(docId)).
+		Apply(db.GetHandle(ctx), &model.KnowledgeDocVersion{}).
+		Order("version_no desc").
+		Find(&list).Error
+	if err != nil {
+		return nil, err
+	}
+	return list, nil
+}
+
+// SelectLatestKnowledgeDocVersion 查询文档最近归档的历史版本，没有历史版本时返回nil
+func SelectLatestKnowledgeDocVersion(ctx context.Context, docId string) (*model.KnowledgeDocVersion, error) {
+	var list []*model.KnowledgeDocVersion
+	err := sqlopt.SQLOptions(sqlopt.WithDocID(docId)).
+		Apply(db.GetHandle(ctx), &model.KnowledgeDocVersion{}).
+		Select("id", "version_id", "doc_id", "version_no", "segment_edit", "create_at").
+		Order("version_no desc").
+		Limit(1).
+		Find(&list).Error
+	if err != nil {
+		return nil, err
+	}
+	if len(list) == 0 {
+		return nil, nil
+	}
+	return list[0], nil
+}
+
+// SelectKnowledgeDocVersionById 查询文档历史版本
+func SelectKnowledgeDocVersionById(ctx context.Context, docId, versionId string) (*model.KnowledgeDocVersion, error) {
+	var version model.KnowledgeDocVersion
+	err := sqlopt.SQLOptions(sqlopt.WithDocID(docId), sqlopt.WithVersionID(versionId)).
+		Apply(db.GetHandle(ctx), &model.KnowledgeDocVersion{}).
+		First(&version).Error
+	if err != nil {
+		log.Errorf("SelectKnowledgeDocVersionById docId %s versionId %s err: %v", docId, versionId, err)
+		return nil, util.ErrCode(errs.Code_KnowledgeDocVersionSelectFailed)
+	}
+	return &version, nil
+}
+
+// ArchiveKnowledgeDocVersion 归档文档当前版本并将文档版本号加1，用于编辑切片前记录历史
+func ArchiveKnowledgeDocVersion(ctx context.Context, doc *model.KnowledgeDoc, version *model.KnowledgeDocVersion) error {
+	return db.GetHandle(ctx).Transaction(func(tx *gorm.DB) error {
+		return archiveKnowledgeDocVersion(tx, doc, version, map[string]interface{}{
+			"update_at": time.Now().UnixMilli(),
+		})
+	})
+}
+
+// ReimportKnowledgeDocVersion 归档文档当前版本，以新文件（上传的新版本或回滚的历史版本）沿用原文档id重新导入；
+// restoreId 不为空时，rag解析完成后恢复该历史版本的切片快照
+func ReimportKnowledgeDocVersion(ctx context.Context, knowledge *model.KnowledgeBase, doc *model.KnowledgeDoc, version *model.KnowledgeDocVersion, file *model.KnowledgeDoc, restoreId string) error {
+	importTask, err := SelectKnowledgeImportTaskById(ctx, doc.ImportTaskId)
+	if err != nil {
+		return err
+	}
+	ragMetaList, err := buildDocRagMetaList(ctx, doc.DocId)
+	if err != nil {
+		return err
+	}
+	//1.删除rag中的当前版本，保证检索只命中生效版本
+	err = service.RagDeleteDoc(ctx, &service.RagDeleteDocParams{
+		UserId:        doc.UserId,
+		KnowledgeBase: knowledge.Name,
+		FileName:      service.RebuildFileName(doc.DocId, doc.FileType, doc.Name),
+	})
+	if err != nil {
+		return err
+	}
+	return db.GetHandle(ctx).Transaction(func(tx *gorm.DB) error {
+		//2.归档当前版本，更新文档文件并重置状态
+		err = archiveKnowledgeDocVersion(tx, doc, version, map[string]interface{}{
+			"file_path":          file.FilePath,
+			"file_path_md5":      file.FilePathMd5,
+			"name":               file.Name,
+			"file_type":          file.FileType,
+			"file_size":          file.FileSize,
+			"status":             model.DocInit,
+			"error_msg":          "",
+			"restore_version_id": restoreId,
+			"update_at":          time.Now().UnixMilli(),
+		})
+		if err != nil {
+			return err
+		}
+		//3.rag重新导入
+		newDoc := *doc
+		newDoc.FilePath, newDoc.FilePathMd5 = file.FilePath, file.FilePathMd5
+		newDoc.Name, newDoc.FileType, newDoc.FileSize = file.Name, file.FileType, file.FileSize
+		if newDoc.FileType == model.UrlFileType {
+			return ragImportUrlDoc(ctx, knowledge, &newDoc, importTask, ragMetaList)
+		}
+		return ragImportFileDoc(ctx, knowledge, &newDoc, importTask, ragMetaList)
+	})
+}
+
+// FinishKnowledgeDocRestore 清除文档待恢复的历史版本，仅当待恢复版本未被再次修改时生效
+func FinishKnowledgeDocRestore(ctx context.Context, docId, restoreId string) error {
+	return db.GetHandle(ctx).Model(&model.KnowledgeDoc{}).
+		Where("doc_id = ? AND restore_version_id = ?", docId, restoreId).
+		Update("restore_version_id", "").Error
+}
+
+// SelectKnowledgeDocVersionByDocIdList 查询文档的所有历史版本
+func SelectKnowledgeDocVersionByDocIdList(ctx context.Context, docIdList []string) ([]*model.KnowledgeDocVersion, error) {
+	var list []*model.KnowledgeDocVersion
+	err := sqlopt.SQLOptions(sqlopt.WithDocIDs(docIdList)).
+		Apply(db.GetHandle(ctx), &model.KnowledgeDocVersion{}).
+		Select("id", "doc_id", "file_path", "file_type").
+		Find(&list).Error
+	if err != nil {
+		return nil, err
+	}
+	return list, nil
+}
+
+// DeleteKnowledgeDocVersionByDocIdList 删除文档的所有历史版本
+func DeleteKnowledgeDocVersionByDocIdList(tx *gorm.DB, docIdList []string) error {
+	return tx.Where("doc_id IN ?", docIdList).Delete(&model.KnowledgeDocVersion{}).Error
+}
+
+// archiveKnowledgeDocVersion 插入历史版本，并以版本号做乐观锁更新文档，避免并发修改同一版本
+func archiveKnowledgeDocVersion(tx *gorm.DB, doc *model.KnowledgeDoc, version *model.KnowledgeDocVersion, updateParams map[string]interface{}) error {
+	version.VersionId = generator.GetGenerator().NewID()
+	version.DocId = doc.DocId
+	version.VersionNo = doc.Version
+	version.KnowledgeId = doc.KnowledgeId
+	version.FilePath = doc.FilePath
+	version.FilePathMd5 = doc.FilePathMd5
+	version.Name = doc.Name
+	version.FileType = doc.FileType
+	version.FileSize = doc.FileSize
+	version.CreatedAt = time.Now().UnixMilli()
+	err := tx.Create(version).Error
+	if err != nil {
+		return err
+	}
+	updateParams["version"] = doc.Version + 1
+	result := tx.Model(&model.KnowledgeDoc{}).
+		Where("id = ? AND version = ?", doc.Id, doc.Version).
+		Updates(updateParams)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected != 1 {
+		return errors.New("doc version has been changed")
+	}
+	return nil
+}
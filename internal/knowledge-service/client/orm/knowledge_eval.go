@@ -0,0 +1,188 @@
+package orm
+
+import (
+	"context"
+	"time"
+
+	errs "github.com/UnicomAI/wanwu/api/proto/err-code"
+	"github.com/UnicomAI/wanwu/internal/knowledge-service/client/model"
+	"github.com/UnicomAI/wanwu/internal/knowledge-service/client/orm/sqlopt"
+	async_task "github.com/UnicomAI/wanwu/internal/knowledge-service/pkg/async-task"
+	"github.com/UnicomAI/wanwu/internal/knowledge-service/pkg/db"
+	"github.com/UnicomAI/wanwu/internal/knowledge-service/pkg/generator"
+	"github.com/UnicomAI/wanwu/internal/knowledge-service/pkg/util"
+	"github.com/UnicomAI/wanwu/pkg/log"
+	"gorm.io/gorm"
+)
+
+// CreateKnowledgeEvalDataset 创建检索评测数据集及问题
+func CreateKnowledgeEvalDataset(ctx context.Context, dataset *model.KnowledgeEvalDataset, questionList []*model.KnowledgeEvalQuestion) error {
+	now := time.Now().UnixMilli()
+	dataset.DatasetId = generator.GetGenerator().NewID()
+	dataset.QuestionCount = len(questionList)
+	dataset.CreatedAt, dataset.UpdatedAt = now, now
+	for _, question := range questionList {
+		question.QuestionId = generator.GetGenerator().NewID()
+		question.DatasetId = dataset.DatasetId
+		question.CreatedAt = now
+	}
+	return db.GetHandle(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(dataset).Error; err != nil {
+			return err
+		}
+		if len(questionList) == 0 {
+			return nil
+		}
+		return tx.Create(questionList).Error
+	})
+}
+
+// SelectKnowledgeEvalDatasetById 查询检索评测数据集
+func SelectKnowledgeEvalDatasetById(ctx context.Context, datasetId string) (*model.KnowledgeEvalDataset, error) {
+	var dataset model.KnowledgeEvalDataset
+	err := sqlopt.SQLOptions(sqlopt.WithDatasetID(datasetId)).
+		Apply(db.GetHandle(ctx), &model.KnowledgeEvalDataset{}).
+		First(&dataset).Error
+	if err != nil {
+		log.Errorf("SelectKnowledgeEvalDatasetById datasetId %s err: %v", datasetId, err)
+		return nil, util.ErrCode(errs.Code_KnowledgeEvalDatasetSelectFailed)
+	}
+	return &dataset, nil
+}
+
+// SelectKnowledgeEvalDatasetList 查询知识库的检索评测数据集列表
+func SelectKnowledgeEvalDatasetList(ctx context.Context, knowledgeId string) ([]*model.KnowledgeEvalDataset, error) {
+	var list []*model.KnowledgeEvalDataset
+	err := sqlopt.SQLOptions(sqlopt.WithKnowledgeID(knowledgeId)).
+		Apply(db.GetHandle(ctx), &model.KnowledgeEvalDataset{}).
+		Order("create_at desc").
+		Find(&list).Error
+	if err != nil {
+		log.Errorf("SelectKnowledgeEvalDatasetList knowledgeId %s err: %v", knowledgeId, err)
+		return nil, util.ErrCode(errs.Code_KnowledgeEvalDatasetSelectFailed)
+	}
+	return list, nil
+}
+
+// SelectKnowledgeEvalQuestionList 按创建顺序查询数据集的全部问题
+func SelectKnowledgeEvalQuestionList(ctx context.Context, datasetId string) ([]*model.KnowledgeEvalQuestion, error) {
+	var list []*model.KnowledgeEvalQuestion
+	err := sqlopt.SQLOptions(sqlopt.WithDatasetID(datasetId)).
+		Apply(db.GetHandle(ctx), &model.KnowledgeEvalQuestion{}).
+		Order("id asc").
+		Find(&list).Error
+	if err != nil {
+		log.Errorf("SelectKnowledgeEvalQuestionList datasetId %s err: %v", datasetId, err)
+		return nil, util.ErrCode(errs.Code_KnowledgeEvalDatasetSelectFailed)
+	}
+	return list, nil
+}
+
+// DeleteKnowledgeEvalDataset 删除检索评测数据集及其问题、评测记录
+func DeleteKnowledgeEvalDataset(ctx context.Context, datasetId string) error {
+	return db.GetHandle(ctx).Transaction(func(tx *gorm.DB) error {
+		return deleteKnowledgeEval(tx, "dataset_id = ?", datasetId)
+	})
+}
+
+// DeleteKnowledgeEvalByKnowledgeId 删除知识库的全部检索评测数据
+func DeleteKnowledgeEvalByKnowledgeId(tx *gorm.DB, knowledgeId string) error {
+	return deleteKnowledgeEval(tx, "knowledge_id = ?", knowledgeId)
+}
+
+func deleteKnowledgeEval(tx *gorm.DB, query string, value string) error {
+	var datasetIds []string
+	err := tx.Model(&model.KnowledgeEvalDataset{}).Where(query, value).Pluck("dataset_id", &datasetIds).Error
+	if err != nil || len(datasetIds) == 0 {
+		return err
+	}
+	runQuery := tx.Model(&model.KnowledgeEvalRun{}).Select("run_id").Where("dataset_id IN ?", datasetIds)
+	if err = tx.Where("run_id IN (?)", runQuery).Delete(&model.KnowledgeEvalResult{}).Error; err != nil {
+		return err
+	}
+	if err = tx.Where("dataset_id IN ?", datasetIds).Delete(&model.KnowledgeEvalRun{}).Error; err != nil {
+		return err
+	}
+	if err = tx.Where("dataset_id IN ?", datasetIds).Delete(&model.KnowledgeEvalQuestion{}).Error; err != nil {
+		return err
+	}
+	return tx.Where("dataset_id IN ?", datasetIds).Delete(&model.KnowledgeEvalDataset{}).Error
+}
+
+// CreateKnowledgeEvalRun 创建检索评测并提交异步任务
+func CreateKnowledgeEvalRun(ctx context.Context, run *model.KnowledgeEvalRun) error {
+	now := time.Now().UnixMilli()
+	run.RunId = generator.GetGenerator().NewID()
+	run.Status = model.KnowledgeEvalInit
+	run.CreatedAt, run.UpdatedAt = now, now
+	return db.GetHandle(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(run).Error; err != nil {
+			return err
+		}
+		return async_task.SubmitTask(ctx, async_task.KnowledgeEvalTaskType, &async_task.KnowledgeEvalTaskParams{
+			RunId: run.RunId,
+		})
+	})
+}
+
+// SelectKnowledgeEvalRunById 查询检索评测
+func SelectKnowledgeEvalRunById(ctx context.Context, runId string) (*model.KnowledgeEvalRun, error) {
+	var run model.KnowledgeEvalRun
+	err := sqlopt.SQLOptions(sqlopt.WithRunID(runId)).
+		Apply(db.GetHandle(ctx), &model.KnowledgeEvalRun{}).
+		First(&run).Error
+	if err != nil {
+		log.Errorf("SelectKnowledgeEvalRunById runId %s err: %v", runId, err)
+		return nil, util.ErrCode(errs.Code_KnowledgeEvalRunSelectFailed)
+	}
+	return &run, nil
+}
+
+// SelectKnowledgeEvalRunList 查询数据集的检索评测列表
+func SelectKnowledgeEvalRunList(ctx context.Context, datasetId string) ([]*model.KnowledgeEvalRun, error) {
+	var list []*model.KnowledgeEvalRun
+	err := sqlopt.SQLOptions(sqlopt.WithDatasetID(datasetId)).
+		Apply(db.GetHandle(ctx), &model.KnowledgeEvalRun{}).
+		Order("create_at desc").
+		Find(&list).Error
+	if err != nil {
+		log.Errorf("SelectKnowledgeEvalRunList datasetId %s err: %v", datasetId, err)
+		return nil, util.ErrCode(errs.Code_KnowledgeEvalRunSelectFailed)
+	}
+	return list, nil
+}
+
+// UpdateKnowledgeEvalRun 更新检索评测的状态、进度或指标
+func UpdateKnowledgeEvalRun(ctx context.Context, id uint32, updateParams map[string]interface{}) error {
+	updateParams["update_at"] = time.Now().UnixMilli()
+	return db.GetHandle(ctx).Model(&model.KnowledgeEvalRun{}).Where("id = ?", id).Updates(updateParams).Error
+}
+
+// CreateKnowledgeEvalResult 保存单个问题的检索结果并更新评测进度
+func CreateKnowledgeEvalResult(ctx context.Context, run *model.KnowledgeEvalRun, result *model.KnowledgeEvalResult, finishCount int) error {
+	result.RunId = run.RunId
+	result.CreatedAt = time.Now().UnixMilli()
+	return db.GetHandle(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(result).Error; err != nil {
+			return err
+		}
+		return tx.Model(&model.KnowledgeEvalRun{}).Where("id = ?", run.Id).Updates(map[string]interface{}{
+			"finish_count": finishCount,
+			"update_at":    time.Now().UnixMilli(),
+		}).Error
+	})
+}
+
+// SelectKnowledgeEvalResultList 查询检索评测的全部问题结果
+func SelectKnowledgeEvalResultList(ctx context.Context, runId string) ([]*model.KnowledgeEvalResult, error) {
+	var list []*model.KnowledgeEvalResult
+	err := sqlopt.SQLOptions(sqlopt.WithRunID(runId)).
+		Apply(db.GetHandle(ctx), &model.KnowledgeEvalResult{}).
+		Order("id asc").
+		Find(&list).Error
+	if err != nil {
+		log.Errorf("SelectKnowledgeEvalResultList runId %s err: %v", runId, err)
+		return nil, util.ErrCode(errs.Code_KnowledgeEvalRunSelectFailed)
+	}
+	return list, nil
+}
@@ -51,6 +51,33 @@ func GetKeywordsById(ctx context.Context, id uint32) (*model.KnowledgeKeywords,
 	return &keywords, nil
 }
 
+// SelectKeywordsByKnowledgeId 查询关联了知识库的关键词
+func SelectKeywordsByKnowledgeId(ctx context.Context, userId, orgId, knowledgeId string) ([]*model.KnowledgeKeywords, error) {
+	var keywordsList []*model.KnowledgeKeywords
+	err := sqlopt.SQLOptions(sqlopt.WithPermit(orgId, userId)).
+		Apply(db.GetHandle(ctx), &model.KnowledgeKeywords{}).
+		Where("knowledge_base_ids LIKE ?", "%\""+knowledgeId+"\"%").
+		Order("id asc").
+		Find(&keywordsList).Error
+	if err != nil {
+		return nil, err
+	}
+	var retList []*model.KnowledgeKeywords
+	for _, keywords := range keywordsList {
+		knowledgeIds, err := jsonToList(keywords.KnowledgeBaseIds)
+		if err != nil {
+			return nil, err
+		}
+		for _, id := range knowledgeIds {
+			if id == knowledgeId {
+				retList = append(retList, keywords)
+				break
+			}
+		}
+	}
+	return retList, nil
+}
+
 // CheckRepeatedKeywords 查询用户是否存在同名关键词设置
 func CheckRepeatedKeywords(ctx context.Context, req *knowledgebase_keywords_service.CreateKnowledgeKeywordsReq) error {
 	var keywordsList []*model.KnowledgeKeywords
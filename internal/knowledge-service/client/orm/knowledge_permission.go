@@ -0,0 +1,176 @@
+package orm
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	errs "github.com/UnicomAI/wanwu/api/proto/err-code"
+	"github.com/UnicomAI/wanwu/internal/knowledge-service/client/model"
+	"github.com/UnicomAI/wanwu/internal/knowledge-service/client/orm/sqlopt"
+	"github.com/UnicomAI/wanwu/internal/knowledge-service/pkg/db"
+	"github.com/UnicomAI/wanwu/internal/knowledge-service/pkg/iam"
+	"github.com/UnicomAI/wanwu/internal/knowledge-service/pkg/util"
+	"github.com/UnicomAI/wanwu/pkg/log"
+	"gorm.io/gorm"
+)
+
+// SelectKnowledgeWithPermission 查询知识库信息并返回当前用户的权限：创建人拥有管理权限，其他用户取分享给本人、所属角色或组织的最高权限；
+// userId、orgId均为空时为服务内部调用，与WithPermit一致不作限制
+func SelectKnowledgeWithPermission(ctx context.Context, knowledgeId, userId, orgId string, permissionType int) (*model.KnowledgeBase, int, error) {
+	knowledge, err := SelectKnowledgeById(ctx, knowledgeId, "", "")
+	if err != nil {
+		return nil, 0, err
+	}
+	current := model.KnowledgePermissionAdmin
+	if !isKnowledgeOwner(knowledge, userId, orgId) {
+		permissionMap, err := SelectKnowledgePermissionMap(ctx, userId, orgId, []string{knowledgeId})
+		if err != nil {
+			log.Errorf("SelectKnowledgePermissionMap userId %s knowledgeId %s err: %v", userId, knowledgeId, err)
+			return nil, 0, util.ErrCode(errs.Code_KnowledgeBaseAccessDenied)
+		}
+		current = permissionMap[knowledgeId]
+	}
+	if current < permissionType {
+		log.Errorf("knowledge %s permission denied, userId %s orgId %s permission %d required %d", knowledgeId, userId, orgId, current, permissionType)
+		return nil, 0, util.ErrCode(errs.Code_KnowledgeBaseAccessDenied)
+	}
+	return knowledge, current, nil
+}
+
+// SelectKnowledgeByIdListWithPermission 查询当前用户拥有指定权限的知识库，返回知识库列表与知识库id到权限的映射
+func SelectKnowledgeByIdListWithPermission(ctx context.Context, knowledgeIdList []string, userId, orgId string, permissionType int) ([]*model.KnowledgeBase, map[string]int, error) {
+	if len(knowledgeIdList) == 0 {
+		return nil, make(map[string]int), nil
+	}
+	knowledgeList, err := SelectKnowledgeByIdList(ctx, knowledgeIdList, "", "")
+	if err != nil {
+		return nil, nil, err
+	}
+	permissionMap, err := SelectKnowledgePermissionMap(ctx, userId, orgId, knowledgeIdList)
+	if err != nil {
+		log.Errorf("SelectKnowledgePermissionMap userId %s err: %v", userId, err)
+		return nil, nil, util.ErrCode(errs.Code_KnowledgeBaseAccessDenied)
+	}
+	var retList []*model.KnowledgeBase
+	for _, knowledge := range knowledgeList {
+		if isKnowledgeOwner(knowledge, userId, orgId) {
+			permissionMap[knowledge.KnowledgeId] = model.KnowledgePermissionAdmin
+		}
+		if permissionMap[knowledge.KnowledgeId] >= permissionType {
+			retList = append(retList, knowledge)
+		}
+	}
+	return retList, permissionMap, nil
+}
+
+// CheckKnowledgePermission 校验当前用户对知识库的权限，通过后将userId、orgId替换为知识库创建人；
+// 知识库下的文档、分段、元数据与RAG数据均归属于创建人，被分享的用户以创建人身份访问
+func CheckKnowledgePermission(ctx context.Context, knowledgeId string, userId, orgId *string, permissionType int) (*model.KnowledgeBase, error) {
+	knowledge, _, err := SelectKnowledgeWithPermission(ctx, knowledgeId, *userId, *orgId, permissionType)
+	if err != nil {
+		return nil, err
+	}
+	*userId, *orgId = knowledge.UserId, knowledge.OrgId
+	return knowledge, nil
+}
+
+// CheckDocPermission 校验当前用户对文档所属知识库的权限（文档须属于同一知识库），通过后将userId、orgId替换为知识库创建人
+func CheckDocPermission(ctx context.Context, docIdList []string, userId, orgId *string, permissionType int) error {
+	var knowledgeIdList []string
+	err := sqlopt.SQLOptions(sqlopt.WithDocIDs(docIdList)).
+		Apply(db.GetHandle(ctx), &model.KnowledgeDoc{}).
+		Distinct("knowledge_id").
+		Pluck("knowledge_id", &knowledgeIdList).Error
+	if err != nil || len(knowledgeIdList) != 1 {
+		log.Errorf("CheckDocPermission userId %s docIdList %v knowledgeIdList %v err: %v", *userId, docIdList, knowledgeIdList, err)
+		return util.ErrCode(errs.Code_KnowledgeBaseAccessDenied)
+	}
+	_, err = CheckKnowledgePermission(ctx, knowledgeIdList[0], userId, orgId, permissionType)
+	return err
+}
+
+// SelectKnowledgePermissionMap 查询分享给用户本人、所属角色或当前组织的知识库，返回知识库id到权限的映射（同一知识库取最高权限）；
+// knowledgeIdList为空时查询所有分享给用户的知识库
+func SelectKnowledgePermissionMap(ctx context.Context, userId, orgId string, knowledgeIdList []string) (map[string]int, error) {
+	permissionMap := make(map[string]int)
+	if userId == "" {
+		return permissionMap, nil
+	}
+	roleIdList, err := iam.GetUserRoleIdList(ctx, userId, orgId)
+	if err != nil {
+		// 角色查询失败时仅按用户与组织的分享判断
+		log.Warnf("GetUserRoleIdList userId %s orgId %s err: %v", userId, orgId, err)
+	}
+	var permissionList []*model.KnowledgePermission
+	err = sqlopt.SQLOptions(sqlopt.WithKnowledgeIDList(knowledgeIdList), sqlopt.WithGrantee(userId, orgId, roleIdList)).
+		Apply(db.GetHandle(ctx), &model.KnowledgePermission{}).
+		Find(&permissionList).Error
+	if err != nil {
+		return nil, err
+	}
+	for _, permission := range permissionList {
+		if permission.PermissionType > permissionMap[permission.KnowledgeId] {
+			permissionMap[permission.KnowledgeId] = permission.PermissionType
+		}
+	}
+	return permissionMap, nil
+}
+
+// SelectKnowledgePermissionList 查询知识库的分享列表
+func SelectKnowledgePermissionList(ctx context.Context, knowledgeId string) ([]*model.KnowledgePermission, error) {
+	var permissionList []*model.KnowledgePermission
+	err := sqlopt.SQLOptions(sqlopt.WithKnowledgeID(knowledgeId)).
+		Apply(db.GetHandle(ctx), &model.KnowledgePermission{}).
+		Order("create_at desc").
+		Find(&permissionList).Error
+	if err != nil {
+		return nil, err
+	}
+	return permissionList, nil
+}
+
+// SaveKnowledgePermission 分享知识库，已分享的对象更新权限
+func SaveKnowledgePermission(ctx context.Context, permissionList []*model.KnowledgePermission) error {
+	return db.GetHandle(ctx).Transaction(func(tx *gorm.DB) error {
+		for _, permission := range permissionList {
+			var existing model.KnowledgePermission
+			err := sqlopt.SQLOptions(sqlopt.WithKnowledgeID(permission.KnowledgeId), sqlopt.WithGranteeTypeAndID(permission.GranteeType, permission.GranteeId)).
+				Apply(tx, &model.KnowledgePermission{}).
+				First(&existing).Error
+			if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+				return err
+			}
+			if err == nil {
+				err = tx.Model(&model.KnowledgePermission{}).Where("id = ?", existing.Id).Updates(map[string]interface{}{
+					"permission_type": permission.PermissionType,
+					"user_id":         permission.UserId,
+					"update_at":       time.Now().UnixMilli(),
+				}).Error
+			} else {
+				err = tx.Create(permission).Error
+			}
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// DeleteKnowledgePermission 取消分享知识库
+func DeleteKnowledgePermission(ctx context.Context, knowledgeId, granteeType, granteeId string) error {
+	return sqlopt.SQLOptions(sqlopt.WithKnowledgeID(knowledgeId), sqlopt.WithGranteeTypeAndID(granteeType, granteeId)).
+		Apply(db.GetHandle(ctx), &model.KnowledgePermission{}).
+		Delete(&model.KnowledgePermission{}).Error
+}
+
+// DeleteKnowledgePermissionByKnowledgeId 删除知识库的所有分享
+func DeleteKnowledgePermissionByKnowledgeId(tx *gorm.DB, knowledgeId string) error {
+	return tx.Model(&model.KnowledgePermission{}).Where("knowledge_id = ?", knowledgeId).Delete(&model.KnowledgePermission{}).Error
+}
+
+// isKnowledgeOwner 是否为知识库创建人，与WithPermit一致，userId、orgId为空时不作限制
+func isKnowledgeOwner(knowledge *model.KnowledgeBase, userId, orgId string) bool {
+	return (orgId == "" || knowledge.OrgId == orgId) && (userId == "" || knowledge.UserId == userId)
+}
@@ -83,6 +83,19 @@ func SelectKnowledgeTagDetail(ctx context.Context, userId, orgId, tagId string)
 	return &knowledgeTag, nil
 }
 
+// SelectKnowledgeTagByName 按名称精确查询知识库标签，不存在时返回nil
+func SelectKnowledgeTagByName(ctx context.Context, userId, orgId, name string) (*model.KnowledgeTag, error) {
+	var knowledgeTagList []*model.KnowledgeTag
+	err := sqlopt.SQLOptions(sqlopt.WithPermit(orgId, userId), sqlopt.WithName(name)).
+		Apply(db.GetHandle(ctx), &model.KnowledgeTag{}).
+		Limit(1).
+		Find(&knowledgeTagList).Error
+	if err != nil || len(knowledgeTagList) == 0 {
+		return nil, err
+	}
+	return knowledgeTagList[0], nil
+}
+
 // CheckSameKnowledgeTagName 知识库标签是否存在同名
 func CheckSameKnowledgeTagName(ctx context.Context, userId, orgId, name string) error {
 	var count int64
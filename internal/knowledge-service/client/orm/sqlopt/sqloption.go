@@ -1,6 +1,7 @@
 package sqlopt
 
 import (
+	"github.com/UnicomAI/wanwu/internal/knowledge-service/client/model"
 	"gorm.io/gorm"
 )
 
@@ -96,6 +97,48 @@ func WithDocID(id string) SQLOption {
 	})
 }
 
+func WithSyncID(id string) SQLOption {
+	return funcSQLOption(func(db *gorm.DB) *gorm.DB {
+		return db.Where("sync_id = ?", id)
+	})
+}
+
+func WithConnectorID(id string) SQLOption {
+	return funcSQLOption(func(db *gorm.DB) *gorm.DB {
+		return db.Where("connector_id = ?", id)
+	})
+}
+
+func WithVersionID(id string) SQLOption {
+	return funcSQLOption(func(db *gorm.DB) *gorm.DB {
+		return db.Where("version_id = ?", id)
+	})
+}
+
+func WithTaskID(id string) SQLOption {
+	return funcSQLOption(func(db *gorm.DB) *gorm.DB {
+		return db.Where("task_id = ?", id)
+	})
+}
+
+func WithDatasetID(id string) SQLOption {
+	return funcSQLOption(func(db *gorm.DB) *gorm.DB {
+		return db.Where("dataset_id = ?", id)
+	})
+}
+
+func WithRunID(id string) SQLOption {
+	return funcSQLOption(func(db *gorm.DB) *gorm.DB {
+		return db.Where("run_id = ?", id)
+	})
+}
+
+func WithImportTaskID(id string) SQLOption {
+	return funcSQLOption(func(db *gorm.DB) *gorm.DB {
+		return db.Where("import_task_id = ?", id)
+	})
+}
+
 func WithKey(key string) SQLOption {
 	return funcSQLOption(func(db *gorm.DB) *gorm.DB {
 		return db.Where("`key` = ?", key)
@@ -151,6 +194,47 @@ func WithPermit(orgID, userID string) SQLOption {
 	})
 }
 
+// WithPermitOrKnowledgeIDs 权限查询条件：本人创建的知识库或分享给本人的知识库
+func WithPermitOrKnowledgeIDs(orgID, userID string, knowledgeIDs []string) SQLOption {
+	return funcSQLOption(func(db *gorm.DB) *gorm.DB {
+		if len(knowledgeIDs) == 0 {
+			return WithPermit(orgID, userID).Apply(db)
+		}
+		if len(orgID) == 0 && len(userID) == 0 {
+			return db
+		}
+		query, args := "(org_id = ? AND user_id = ?) OR knowledge_id IN ?", []interface{}{orgID, userID, knowledgeIDs}
+		if len(orgID) == 0 {
+			query, args = "user_id = ? OR knowledge_id IN ?", []interface{}{userID, knowledgeIDs}
+		} else if len(userID) == 0 {
+			query, args = "org_id = ? OR knowledge_id IN ?", []interface{}{orgID, knowledgeIDs}
+		}
+		return db.Where("("+query+")", args...)
+	})
+}
+
+// WithGrantee 分享对象查询条件：分享给用户本人、当前组织或用户所属角色
+func WithGrantee(userID, orgID string, roleIDs []string) SQLOption {
+	return funcSQLOption(func(db *gorm.DB) *gorm.DB {
+		query, args := "(grantee_type = ? AND grantee_id = ?)", []interface{}{model.KnowledgeGranteeUser, userID}
+		if len(orgID) > 0 {
+			query += " OR (grantee_type = ? AND grantee_id = ?)"
+			args = append(args, model.KnowledgeGranteeOrg, orgID)
+		}
+		if len(roleIDs) > 0 {
+			query += " OR (grantee_type = ? AND grantee_id IN ?)"
+			args = append(args, model.KnowledgeGranteeRole, roleIDs)
+		}
+		return db.Where("("+query+")", args...)
+	})
+}
+
+func WithGranteeTypeAndID(granteeType, granteeID string) SQLOption {
+	return funcSQLOption(func(db *gorm.DB) *gorm.DB {
+		return db.Where("grantee_type = ? AND grantee_id = ?", granteeType, granteeID)
+	})
+}
+
 func WithStatusList(status []int) SQLOption {
 	return funcSQLOption(func(db *gorm.DB) *gorm.DB {
 		if len(status) == 0 {
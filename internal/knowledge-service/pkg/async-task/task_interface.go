@@ -9,6 +9,10 @@ const (
 	DocDeleteTaskType        = 2 // 文档列表删除
 	DocImportTaskType        = 3 // 文档导入
 	DocSegmentImportTaskType = 4 // 文档分片导入
+	DocSyncTaskType          = 5 // url文档同步
+	ConnectorImportTaskType  = 6 // 连接器增量导入
+	KnowledgeBundleTaskType  = 7 // 知识库导入导出
+	KnowledgeEvalTaskType    = 8 // 知识库检索评测
 )
 
 type KnowledgeDeleteParams struct {
@@ -27,6 +31,22 @@ type DocSegmentImportTaskParams struct {
 	TaskId string `json:"taskId"`
 }
 
+type DocSyncTaskParams struct {
+	SyncId string `json:"syncId"`
+}
+
+type ConnectorImportTaskParams struct {
+	ConnectorId string `json:"connectorId"`
+}
+
+type KnowledgeBundleTaskParams struct {
+	TaskId string `json:"taskId"`
+}
+
+type KnowledgeEvalTaskParams struct {
+	RunId string `json:"runId"`
+}
+
 type BusinessTaskService interface {
 	BuildServiceType() uint32
 	//InitTask 初始化任务
@@ -64,7 +64,10 @@ type Config struct {
 	Kafka              *KafkaConfig        `mapstructure:"kafka" json:"kafka"`
 	UsageLimit         *UsageLimitConfig   `mapstructure:"usage-limit" json:"usageLimit"`
 	RagServer          *RagServerConfig    `mapstructure:"rag-server" json:"ragServer"`
+	Iam                *IamConfig          `mapstructure:"iam" json:"iam"`
+	Operate            *OperateConfig      `mapstructure:"operate" json:"operate"`
 	KnowledgeDocConfig *KnowledgeDocConfig `json:"knowledge-doc-config" mapstructure:"knowledge-doc-config"`
+	Connector          *ConnectorConfig    `json:"connector" mapstructure:"connector"`
 	SplitterList       []*Splitter         `mapstructure:"splitters" json:"splitters" yaml:"splitters"`
 }
 
@@ -121,10 +124,24 @@ type UsageLimitConfig struct {
 	UploadConcurrentLimit        int64  `mapstructure:"upload-concurrent-limit" json:"uploadConcurrentLimit"`
 }
 
+type IamConfig struct {
+	Host string `mapstructure:"host" json:"host"`
+}
+
+type OperateConfig struct {
+	Host string `mapstructure:"host" json:"host"`
+}
+
 type KnowledgeDocConfig struct {
 	DocLocalFilePath string `mapstructure:"doc-local-file-path" json:"doc-local-file-path"`
 }
 
+type ConnectorConfig struct {
+	SecretKey        string `mapstructure:"secret-key" json:"secret-key"`
+	LocalRootDirs    string `mapstructure:"local-root-dirs" json:"local-root-dirs"`
+	MaxObjectsPerRun int    `mapstructure:"max-objects-per-run" json:"max-objects-per-run"`
+}
+
 type RagServerConfig struct {
 	Endpoint                  string `mapstructure:"endpoint" json:"endpoint"`
 	UrlImportEndpoint         string `mapstructure:"url-import-endpoint" json:"url-import-endpoint"`
@@ -103,6 +103,16 @@ func registerTables(dbClient *gorm.DB) error {
 		model.KnowledgeSplitter{},
 		model.KnowledgeDocMeta{},
 		model.DocSegmentImportTask{},
+		model.KnowledgePermission{},
+		model.KnowledgeDocSync{},
+		model.KnowledgeDocSyncHistory{},
+		model.KnowledgeConnector{},
+		model.KnowledgeDocVersion{},
+		model.KnowledgeBundleTask{},
+		model.KnowledgeEvalDataset{},
+		model.KnowledgeEvalQuestion{},
+		model.KnowledgeEvalRun{},
+		model.KnowledgeEvalResult{},
 	)
 	if err != nil {
 		fmt.Printf("register knowledge tables failed: %v", err)
@@ -0,0 +1,79 @@
+package iam
+
+import (
+	"context"
+	"fmt"
+
+	iam_service "github.com/UnicomAI/wanwu/api/proto/iam-service"
+	"github.com/UnicomAI/wanwu/internal/knowledge-service/pkg"
+	"github.com/UnicomAI/wanwu/internal/knowledge-service/pkg/config"
+	"github.com/UnicomAI/wanwu/pkg/log"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+)
+
+const (
+	maxMsgSize            = 1024 * 1024 * 4 // 4M
+	headlessServiceSchema = "dns:///"
+)
+
+var iamClient = ClientIam{}
+
+type ClientIam struct {
+	cli iam_service.IAMServiceClient
+}
+
+func init() {
+	pkg.AddContainer(&iamClient)
+}
+
+func (c *ClientIam) LoadType() string {
+	return "iamClient"
+}
+
+func (c *ClientIam) Load() error {
+	iamConfig := config.GetConfig().Iam
+	if iamConfig == nil || iamConfig.Host == "" {
+		log.Warnf("iam host not configured, knowledge sharing by role disabled")
+		return nil
+	}
+	conn, err := grpc.NewClient(headlessServiceSchema+iamConfig.Host,
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithDefaultServiceConfig(`{"loadBalancingPolicy":"round_robin"}`),
+		grpc.WithDefaultCallOptions(
+			grpc.MaxCallRecvMsgSize(maxMsgSize),
+			grpc.MaxCallSendMsgSize(maxMsgSize)),
+	)
+	if err != nil {
+		return fmt.Errorf("init iam-service connection err: %v", err)
+	}
+	c.cli = iam_service.NewIAMServiceClient(conn)
+	return nil
+}
+
+func (c *ClientIam) StopPriority() int {
+	return pkg.DefaultPriority
+}
+
+func (c *ClientIam) Stop() error {
+	return nil
+}
+
+// GetUserRoleIdList 查询用户在组织内的角色id列表，未配置iam时返回空
+func GetUserRoleIdList(ctx context.Context, userId, orgId string) ([]string, error) {
+	if iamClient.cli == nil || userId == "" || orgId == "" {
+		return nil, nil
+	}
+	resp, err := iamClient.cli.GetUserPermission(ctx, &iam_service.GetUserPermissionReq{
+		UserId: userId,
+		OrgId:  orgId,
+	})
+	if err != nil {
+		return nil, err
+	}
+	var roleIdList []string
+	for _, role := range resp.Roles {
+		roleIdList = append(roleIdList, role.Id)
+	}
+	return roleIdList, nil
+}
@@ -41,7 +41,12 @@ func (c ClientMinio) Load() error {
 	if err != nil {
 		return err
 	}
-	return nil
+	//初始化文件上传bucket，用于读取上传的知识库导入文件及存放知识库导出文件
+	return minio.InitFileUpload(context.Background(), minio.Config{
+		Endpoint: minioConfig.EndPoint,
+		User:     minioConfig.User,
+		Password: minioConfig.Password,
+	})
 }
 
 func (c ClientMinio) StopPriority() int {
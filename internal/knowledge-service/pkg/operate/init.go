@@ -0,0 +1,41 @@
+package operate
+
+import (
+	"fmt"
+
+	"github.com/UnicomAI/wanwu/internal/knowledge-service/pkg"
+	"github.com/UnicomAI/wanwu/internal/knowledge-service/pkg/config"
+	"github.com/UnicomAI/wanwu/pkg/webhook"
+)
+
+var operateClient = ClientOperate{}
+
+// ClientOperate operate-service连接，用于发送webhook事件
+type ClientOperate struct{}
+
+func init() {
+	pkg.AddContainer(&operateClient)
+}
+
+func (c *ClientOperate) LoadType() string {
+	return "operateClient"
+}
+
+func (c *ClientOperate) Load() error {
+	var host string
+	if operateConfig := config.GetConfig().Operate; operateConfig != nil {
+		host = operateConfig.Host
+	}
+	if err := webhook.Init(host); err != nil {
+		return fmt.Errorf("init operate-service connection err: %v", err)
+	}
+	return nil
+}
+
+func (c *ClientOperate) StopPriority() int {
+	return pkg.DefaultPriority
+}
+
+func (c *ClientOperate) Stop() error {
+	return nil
+}
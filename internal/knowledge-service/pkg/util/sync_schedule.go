@@ -0,0 +1,37 @@
+package util
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/robfig/cron/v3"
+)
+
+const minSyncIntervalMinutes = 10
+
+// NextSyncTime 计算下次同步时间：cron表达式为标准5段格式（分 时 日 月 周），与同步间隔（分钟）二选一；
+// 同步间隔与cron周期均不能小于10分钟，避免频繁抓取与重建索引
+func NextSyncTime(cronExpr string, intervalMinutes int, from time.Time) (time.Time, error) {
+	if cronExpr != "" && intervalMinutes > 0 {
+		return time.Time{}, errors.New("cronExpr and intervalMinutes are mutually exclusive")
+	}
+	if cronExpr == "" {
+		if intervalMinutes < minSyncIntervalMinutes {
+			return time.Time{}, fmt.Errorf("intervalMinutes must be at least %d", minSyncIntervalMinutes)
+		}
+		return from.Add(time.Duration(intervalMinutes) * time.Minute), nil
+	}
+	schedule, err := cron.ParseStandard(cronExpr)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid cronExpr %q: %v", cronExpr, err)
+	}
+	next := schedule.Next(from)
+	if next.IsZero() {
+		return time.Time{}, fmt.Errorf("cronExpr %q never fires", cronExpr)
+	}
+	if schedule.Next(next).Sub(next) < minSyncIntervalMinutes*time.Minute {
+		return time.Time{}, fmt.Errorf("cronExpr %q fires more often than every %d minutes", cronExpr, minSyncIntervalMinutes)
+	}
+	return next, nil
+}
@@ -0,0 +1,34 @@
+package util
+
+import (
+	"testing"
+	"time"
+)
+
+func TestNextSyncTime(t *testing.T) {
+	from := time.Date(2025, 6, 2, 8, 30, 0, 0, time.Local) // 周一
+
+	next, err := NextSyncTime("", 60, from)
+	if err != nil || !next.Equal(from.Add(time.Hour)) {
+		t.Fatalf("interval next %v err %v", next, err)
+	}
+	next, err = NextSyncTime("0 9 * * 1", 0, from)
+	if err != nil || !next.Equal(time.Date(2025, 6, 2, 9, 0, 0, 0, time.Local)) {
+		t.Fatalf("cron next %v err %v", next, err)
+	}
+
+	for _, c := range []struct {
+		cronExpr        string
+		intervalMinutes int
+	}{
+		{"", 0},
+		{"", 5},
+		{"*/5 * * * *", 0},
+		{"0 9 * * 1", 60},
+		{"not a cron", 0},
+	} {
+		if _, err := NextSyncTime(c.cronExpr, c.intervalMinutes, from); err == nil {
+			t.Fatalf("expect error for %+v", c)
+		}
+	}
+}
@@ -5,6 +5,8 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"sort"
+	"strings"
 	"time"
 
 	errs "github.com/UnicomAI/wanwu/api/proto/err-code"
@@ -31,7 +33,7 @@ const (
 )
 
 func (s *Service) SelectKnowledgeList(ctx context.Context, req *knowledgebase_service.KnowledgeSelectReq) (*knowledgebase_service.KnowledgeSelectListResp, error) {
-	list, err := orm.SelectKnowledgeList(ctx, req.UserId, req.OrgId, req.Name, req.TagIdList)
+	list, permissionMap, err := orm.SelectKnowledgeList(ctx, req.UserId, req.OrgId, req.Name, req.TagIdList)
 	if err != nil {
 		log.Errorf(fmt.Sprintf("获取知识库列表失败(%v)  参数(%v)", err, req))
 		return nil, util.ErrCode(errs.Code_KnowledgeBaseSelectFailed)
@@ -45,16 +47,16 @@ func (s *Service) SelectKnowledgeList(ctx context.Context, req *knowledgebase_se
 		relation := orm.SelectKnowledgeTagListWithRelation(ctx, req.UserId, req.OrgId, "", knowledgeIdList)
 		tagMap = buildKnowledgeTagMap(relation)
 	}
-	return buildKnowledgeListResp(list, tagMap), nil
+	return buildKnowledgeListResp(list, tagMap, permissionMap), nil
 }
 
 func (s *Service) SelectKnowledgeDetailById(ctx context.Context, req *knowledgebase_service.KnowledgeDetailSelectReq) (*knowledgebase_service.KnowledgeInfo, error) {
-	knowledgeInfo, err := orm.SelectKnowledgeById(ctx, req.KnowledgeId, req.UserId, req.OrgId)
+	knowledgeInfo, permissionType, err := orm.SelectKnowledgeWithPermission(ctx, req.KnowledgeId, req.UserId, req.OrgId, model.KnowledgePermissionViewer)
 	if err != nil {
 		log.Errorf(fmt.Sprintf("获取知识库详情(%v)  参数(%v)", err, req))
 		return nil, err
 	}
-	return buildKnowledgeInfo(knowledgeInfo), nil
+	return buildKnowledgeInfo(knowledgeInfo, permissionType), nil
 }
 
 func (s *Service) SelectKnowledgeDetailByName(ctx context.Context, req *knowledgebase_service.KnowledgeDetailSelectReq) (*knowledgebase_service.KnowledgeInfo, error) {
@@ -63,16 +65,16 @@ func (s *Service) SelectKnowledgeDetailByName(ctx context.Context, req *knowledg
 		log.Errorf(fmt.Sprintf("根据名称获取知识库详情失败(%v)  参数(%v)", err, req))
 		return nil, err
 	}
-	return buildKnowledgeInfo(knowledgeInfo), nil
+	return buildKnowledgeInfo(knowledgeInfo, model.KnowledgePermissionAdmin), nil
 }
 
 func (s *Service) SelectKnowledgeDetailByIdList(ctx context.Context, req *knowledgebase_service.KnowledgeDetailSelectListReq) (*knowledgebase_service.KnowledgeDetailSelectListResp, error) {
-	knowledgeInfoList, err := orm.SelectKnowledgeByIdList(ctx, req.KnowledgeIds, req.UserId, req.OrgId)
+	knowledgeInfoList, permissionMap, err := orm.SelectKnowledgeByIdListWithPermission(ctx, req.KnowledgeIds, req.UserId, req.OrgId, model.KnowledgePermissionViewer)
 	if err != nil {
 		log.Errorf(fmt.Sprintf("根据id列表获取知识库详情列表失败(%v)  参数(%v)", err, req))
 		return nil, err
 	}
-	return buildKnowledgeInfoList(knowledgeInfoList), nil
+	return buildKnowledgeInfoList(knowledgeInfoList, permissionMap), nil
 }
 
 func (s *Service) CreateKnowledge(ctx context.Context, req *knowledgebase_service.CreateKnowledgeReq) (*knowledgebase_service.CreateKnowledgeResp, error) {
@@ -99,8 +101,8 @@ func (s *Service) CreateKnowledge(ctx context.Context, req *knowledgebase_servic
 }
 
 func (s *Service) UpdateKnowledge(ctx context.Context, req *knowledgebase_service.UpdateKnowledgeReq) (*emptypb.Empty, error) {
-	//1.查询知识库详情
-	knowledge, err := orm.SelectKnowledgeById(ctx, req.KnowledgeId, req.UserId, req.OrgId)
+	//1.查询知识库详情，重名校验在创建人的知识库中进行
+	knowledge, err := orm.CheckKnowledgePermission(ctx, req.KnowledgeId, &req.UserId, &req.OrgId, model.KnowledgePermissionAdmin)
 	if err != nil {
 		log.Errorf(fmt.Sprintf("没有操作该知识库的权限 参数(%v)", req))
 		return nil, err
@@ -122,7 +124,7 @@ func (s *Service) UpdateKnowledge(ctx context.Context, req *knowledgebase_servic
 // DeleteKnowledge 删除知识库
 func (s *Service) DeleteKnowledge(ctx context.Context, req *knowledgebase_service.DeleteKnowledgeReq) (*emptypb.Empty, error) {
 	//1.查询知识库详情
-	knowledge, err := orm.SelectKnowledgeById(ctx, req.KnowledgeId, req.UserId, req.OrgId)
+	knowledge, err := orm.CheckKnowledgePermission(ctx, req.KnowledgeId, &req.UserId, &req.OrgId, model.KnowledgePermissionAdmin)
 	if err != nil {
 		log.Errorf(fmt.Sprintf("没有操作该知识库的权限 参数(%v)", req))
 		return nil, err
@@ -151,30 +153,40 @@ func (s *Service) KnowledgeHit(ctx context.Context, req *knowledgebase_service.K
 	for _, k := range req.KnowledgeList {
 		knowledgeIdList = append(knowledgeIdList, k.KnowledgeId)
 	}
-	list, err := orm.SelectKnowledgeByIdList(ctx, knowledgeIdList, req.UserId, req.OrgId)
+	list, _, err := orm.SelectKnowledgeByIdListWithPermission(ctx, knowledgeIdList, req.UserId, req.OrgId, model.KnowledgePermissionViewer)
 	if err != nil {
 		return nil, err
 	}
-	knowledgeIDToName := make(map[string]string)
-	for _, k := range list {
-		if _, exists := knowledgeIDToName[k.KnowledgeId]; !exists {
-			knowledgeIDToName[k.KnowledgeId] = k.Name
-		}
-	}
-	// 2.RAG请求
-	ragHitParams, err := buildRagHitParams(req, list, knowledgeIDToName)
-	if err != nil {
-		return nil, util.ErrCode(errs.Code_KnowledgeBaseHitFailed)
+	if len(list) == 0 {
+		return nil, util.ErrCode(errs.Code_KnowledgeBaseAccessDenied)
 	}
-	hitResp, err := rag_service.RagKnowledgeHit(ctx, ragHitParams)
-	if err != nil {
-		log.Errorf("RagKnowledgeHit error %s", err)
-		return nil, util.ErrCode(errs.Code_KnowledgeBaseHitFailed)
+	// 2.RAG请求：RAG按知识库创建人区分知识库，按创建人分组后分别使用创建人身份检索，再合并重排
+	var hitDataList []*rag_service.KnowledgeHitData
+	for _, group := range groupKnowledgeByOwner(list) {
+		knowledgeIDToName := make(map[string]string)
+		for _, k := range group {
+			if _, exists := knowledgeIDToName[k.KnowledgeId]; !exists {
+				knowledgeIDToName[k.KnowledgeId] = k.Name
+			}
+		}
+		ragHitParams, err := buildRagHitParams(req, group[0].UserId, group, knowledgeIDToName)
+		if err != nil {
+			return nil, util.ErrCode(errs.Code_KnowledgeBaseHitFailed)
+		}
+		hitResp, err := rag_service.RagKnowledgeHit(ctx, ragHitParams)
+		if err != nil {
+			log.Errorf("RagKnowledgeHit error %s", err)
+			return nil, util.ErrCode(errs.Code_KnowledgeBaseHitFailed)
+		}
+		hitDataList = append(hitDataList, hitResp.Data)
 	}
-	return buildKnowledgeBaseHitResp(hitResp), nil
+	return buildKnowledgeBaseHitResp(mergeKnowledgeHitData(hitDataList, req.KnowledgeMatchParams.TopK)), nil
 }
 
 func (s *Service) GetKnowledgeMetaSelect(ctx context.Context, req *knowledgebase_service.SelectKnowledgeMetaReq) (*knowledgebase_service.SelectKnowledgeMetaResp, error) {
+	if _, err := orm.CheckKnowledgePermission(ctx, req.KnowledgeId, &req.UserId, &req.OrgId, model.KnowledgePermissionViewer); err != nil {
+		return nil, err
+	}
 	metaList, err := orm.SelectMetaByKnowledgeId(ctx, req.UserId, req.OrgId, req.KnowledgeId)
 	if err != nil {
 		log.Errorf("获取知识库元数据列表失败(%v)  参数(%v)", err, req)
@@ -184,6 +196,9 @@ func (s *Service) GetKnowledgeMetaSelect(ctx context.Context, req *knowledgebase
 }
 
 func (s *Service) GetKnowledgeMetaValueList(ctx context.Context, req *knowledgebase_service.KnowledgeMetaValueListReq) (*knowledgebase_service.KnowledgeMetaValueListResp, error) {
+	if err := orm.CheckDocPermission(ctx, req.DocIdList, &req.UserId, &req.OrgId, model.KnowledgePermissionViewer); err != nil {
+		return nil, err
+	}
 	metaList, err := orm.SelectMetaByDocIds(ctx, req.UserId, req.OrgId, req.DocIdList)
 	if err != nil {
 		return nil, util.ErrCode(errs.Code_KnowledgeMetaFetchFailed)
@@ -193,6 +208,9 @@ func (s *Service) GetKnowledgeMetaValueList(ctx context.Context, req *knowledgeb
 
 func (s *Service) UpdateKnowledgeMetaValue(ctx context.Context, req *knowledgebase_service.UpdateKnowledgeMetaValueReq) (*emptypb.Empty, error) {
 	//1.查询文档详情
+	if err := orm.CheckDocPermission(ctx, req.DocIdList, &req.UserId, &req.OrgId, model.KnowledgePermissionEditor); err != nil {
+		return nil, err
+	}
 	docList, err := orm.SelectDocByDocIdList(ctx, req.DocIdList, req.UserId, req.OrgId)
 	if err != nil {
 		log.Errorf("没有操作该知识库文档的权限 参数(%v)", req)
@@ -335,7 +353,7 @@ func handleDeleteMeta(req *knowledgebase_service.UpdateKnowledgeMetaValueReq, me
 	}
 }
 
-func buildRagHitParams(req *knowledgebase_service.KnowledgeHitReq, list []*model.KnowledgeBase, knowledgeIDToName map[string]string) (*rag_service.KnowledgeHitParams, error) {
+func buildRagHitParams(req *knowledgebase_service.KnowledgeHitReq, userId string, list []*model.KnowledgeBase, knowledgeIDToName map[string]string) (*rag_service.KnowledgeHitParams, error) {
 	matchParams := req.KnowledgeMatchParams
 	priorityMatch := matchParams.PriorityMatch
 	filterEnable, metaParams, err := buildRagHitMetaParams(req, knowledgeIDToName)
@@ -343,7 +361,7 @@ func buildRagHitParams(req *knowledgebase_service.KnowledgeHitReq, list []*model
 		return nil, err
 	}
 	ret := &rag_service.KnowledgeHitParams{
-		UserId:               req.UserId,
+		UserId:               userId,
 		Question:             req.Question,
 		KnowledgeBase:        buildKnowledgeNameList(list),
 		TopK:                 matchParams.TopK,
@@ -363,6 +381,10 @@ func buildRagHitMetaParams(req *knowledgebase_service.KnowledgeHitReq, knowledge
 	filterEnable := false // 标记是否有启用的元数据过滤
 	var metaFilterConditions []*rag_service.MetadataFilterItem
 	for _, k := range req.KnowledgeList {
+		// 只处理本次检索的知识库
+		if _, ok := knowledgeIDToName[k.KnowledgeId]; !ok {
+			continue
+		}
 		// 检查元数据过滤参数是否有效
 		filterParams := k.MetaDataFilterParams
 		if !isValidFilterParams(k.MetaDataFilterParams) {
@@ -484,13 +506,13 @@ func buildKnowledgeMetaSelectResp(metaList []*model.KnowledgeDocMeta) *knowledge
 }
 
 // buildKnowledgeListResp 构造知识库列表返回结果
-func buildKnowledgeListResp(knowledgeList []*model.KnowledgeBase, knowledgeTagMap map[string][]*orm.TagRelationDetail) *knowledgebase_service.KnowledgeSelectListResp {
+func buildKnowledgeListResp(knowledgeList []*model.KnowledgeBase, knowledgeTagMap map[string][]*orm.TagRelationDetail, permissionMap map[string]int) *knowledgebase_service.KnowledgeSelectListResp {
 	if len(knowledgeList) == 0 {
 		return &knowledgebase_service.KnowledgeSelectListResp{}
 	}
 	var retList []*knowledgebase_service.KnowledgeInfo
 	for _, knowledge := range knowledgeList {
-		knowledgeInfo := buildKnowledgeInfo(knowledge)
+		knowledgeInfo := buildKnowledgeInfo(knowledge, permissionMap[knowledge.KnowledgeId])
 		knowledgeInfo.KnowledgeTagInfoList = buildKnowledgeTagList(knowledge.KnowledgeId, knowledgeTagMap)
 		retList = append(retList, knowledgeInfo)
 	}
@@ -550,7 +572,7 @@ func checkRepeatedMetaKey(metaList []*model.KnowledgeDocMeta) []*model.Knowledge
 }
 
 // buildKnowledgeInfo 构造知识库信息
-func buildKnowledgeInfo(knowledge *model.KnowledgeBase) *knowledgebase_service.KnowledgeInfo {
+func buildKnowledgeInfo(knowledge *model.KnowledgeBase, permissionType int) *knowledgebase_service.KnowledgeInfo {
 	embeddingModelInfo := &knowledgebase_service.EmbeddingModelInfo{}
 	_ = json.Unmarshal([]byte(knowledge.EmbeddingModel), embeddingModelInfo)
 	return &knowledgebase_service.KnowledgeInfo{
@@ -560,14 +582,17 @@ func buildKnowledgeInfo(knowledge *model.KnowledgeBase) *knowledgebase_service.K
 		DocCount:           int32(knowledge.DocCount),
 		EmbeddingModelInfo: embeddingModelInfo,
 		CreatedAt:          pkg_util.Time2Str(knowledge.CreatedAt),
+		UserId:             knowledge.UserId,
+		OrgId:              knowledge.OrgId,
+		PermissionType:     int32(permissionType),
 	}
 }
 
 // buildKnowledgeInfoList 构造知识库信息列表
-func buildKnowledgeInfoList(knowledgeList []*model.KnowledgeBase) *knowledgebase_service.KnowledgeDetailSelectListResp {
+func buildKnowledgeInfoList(knowledgeList []*model.KnowledgeBase, permissionMap map[string]int) *knowledgebase_service.KnowledgeDetailSelectListResp {
 	var retList []*knowledgebase_service.KnowledgeInfo
 	for _, v := range knowledgeList {
-		info := buildKnowledgeInfo(v)
+		info := buildKnowledgeInfo(v, permissionMap[v.KnowledgeId])
 		retList = append(retList, info)
 	}
 	return &knowledgebase_service.KnowledgeDetailSelectListResp{
@@ -594,6 +619,78 @@ func buildKnowledgeBaseModel(req *knowledgebase_service.CreateKnowledgeReq) (*mo
 	}, nil
 }
 
+// groupKnowledgeByOwner 按创建人userId分组知识库，分组及组内知识库保持原有顺序
+func groupKnowledgeByOwner(knowledgeList []*model.KnowledgeBase) [][]*model.KnowledgeBase {
+	var groups [][]*model.KnowledgeBase
+	groupIndex := make(map[string]int)
+	for _, knowledge := range knowledgeList {
+		idx, ok := groupIndex[knowledge.UserId]
+		if !ok {
+			idx = len(groups)
+			groupIndex[knowledge.UserId] = idx
+			groups = append(groups, nil)
+		}
+		groups[idx] = append(groups[idx], knowledge)
+	}
+	return groups
+}
+
+// mergeKnowledgeHitData 合并各创建人分组的检索结果，按分数重排后取topK；
+// 同一检索配置下各组分数尺度一致（同一重排模型或同一权重），可直接比较
+func mergeKnowledgeHitData(dataList []*rag_service.KnowledgeHitData, topK int32) *rag_service.KnowledgeHitData {
+	if len(dataList) == 1 {
+		return dataList[0]
+	}
+	type scoredChunk struct {
+		chunk *rag_service.ChunkSearchList
+		score float64
+	}
+	var chunks []scoredChunk
+	var prompt, promptContext string
+	for _, data := range dataList {
+		if data == nil {
+			continue
+		}
+		for i, chunk := range data.SearchList {
+			var score float64
+			if i < len(data.Score) {
+				score = data.Score[i]
+			}
+			chunks = append(chunks, scoredChunk{chunk: chunk, score: score})
+		}
+		// prompt取第一个有结果的分组，其上下文替换为合并后的片段
+		if prompt == "" || (promptContext == "" && len(data.SearchList) > 0) {
+			prompt, promptContext = data.Prompt, joinChunkSnippet(data.SearchList)
+		}
+	}
+	sort.SliceStable(chunks, func(i, j int) bool { return chunks[i].score > chunks[j].score })
+	if topK > 0 && len(chunks) > int(topK) {
+		chunks = chunks[:topK]
+	}
+	ret := &rag_service.KnowledgeHitData{
+		SearchList: make([]*rag_service.ChunkSearchList, 0, len(chunks)),
+		Score:      make([]float64, 0, len(chunks)),
+	}
+	for _, c := range chunks {
+		ret.SearchList = append(ret.SearchList, c.chunk)
+		ret.Score = append(ret.Score, c.score)
+	}
+	// RAG以换行拼接片段作为prompt上下文
+	ret.Prompt = prompt
+	if promptContext != "" {
+		ret.Prompt = strings.Replace(prompt, promptContext, joinChunkSnippet(ret.SearchList), 1)
+	}
+	return ret
+}
+
+func joinChunkSnippet(searchList []*rag_service.ChunkSearchList) string {
+	snippets := make([]string, 0, len(searchList))
+	for _, chunk := range searchList {
+		snippets = append(snippets, chunk.Snippet)
+	}
+	return strings.Join(snippets, "\n")
+}
+
 // buildKnowledgeNameList 构造知识库名称
 func buildKnowledgeNameList(knowledgeList []*model.KnowledgeBase) []string {
 	if len(knowledgeList) == 0 {
@@ -607,8 +704,7 @@ func buildKnowledgeNameList(knowledgeList []*model.KnowledgeBase) []string {
 }
 
 // buildKnowledgeBaseHitResp 构造知识库命中返回
-func buildKnowledgeBaseHitResp(ragKnowledgeHitResp *rag_service.RagKnowledgeHitResp) *knowledgebase_service.KnowledgeHitResp {
-	knowledgeHitData := ragKnowledgeHitResp.Data
+func buildKnowledgeBaseHitResp(knowledgeHitData *rag_service.KnowledgeHitData) *knowledgebase_service.KnowledgeHitResp {
 	var searchList = make([]*knowledgebase_service.KnowledgeSearchInfo, 0)
 	list := knowledgeHitData.SearchList
 	if len(list) > 0 {
@@ -0,0 +1,100 @@
+package knowledge
+
+import (
+	"context"
+	"encoding/json"
+
+	errs "github.com/UnicomAI/wanwu/api/proto/err-code"
+	knowledgebase_service "github.com/UnicomAI/wanwu/api/proto/knowledgebase-service"
+	"github.com/UnicomAI/wanwu/internal/knowledge-service/client/model"
+	"github.com/UnicomAI/wanwu/internal/knowledge-service/client/orm"
+	"github.com/UnicomAI/wanwu/internal/knowledge-service/pkg/util"
+	"github.com/UnicomAI/wanwu/pkg/log"
+	pkg_util "github.com/UnicomAI/wanwu/pkg/util"
+)
+
+// ExportKnowledge 导出知识库，需要管理权限；任务记录在当前用户下
+func (s *Service) ExportKnowledge(ctx context.Context, req *knowledgebase_service.ExportKnowledgeReq) (*knowledgebase_service.KnowledgeBundleTaskResp, error) {
+	userId, orgId := req.UserId, req.OrgId
+	knowledge, err := orm.CheckKnowledgePermission(ctx, req.KnowledgeId, &req.UserId, &req.OrgId, model.KnowledgePermissionAdmin)
+	if err != nil {
+		log.Errorf("没有导出该知识库的权限 参数(%v)", req)
+		return nil, err
+	}
+	task := &model.KnowledgeBundleTask{
+		TaskType:      model.KnowledgeBundleExport,
+		KnowledgeId:   knowledge.KnowledgeId,
+		KnowledgeName: knowledge.Name,
+		UserId:        userId,
+		OrgId:         orgId,
+	}
+	if err = orm.CreateKnowledgeBundleTask(ctx, task); err != nil {
+		log.Errorf("知识库导出任务创建失败(%v) 参数(%v)", err, req)
+		return nil, util.ErrCode(errs.Code_KnowledgeBundleExportFailed)
+	}
+	return &knowledgebase_service.KnowledgeBundleTaskResp{TaskId: task.TaskId}, nil
+}
+
+// ImportKnowledge 从导出文件导入知识库，新建的知识库属于当前用户
+func (s *Service) ImportKnowledge(ctx context.Context, req *knowledgebase_service.ImportKnowledgeReq) (*knowledgebase_service.KnowledgeBundleTaskResp, error) {
+	if req.FilePath == "" {
+		return nil, util.ErrCode(errs.Code_KnowledgeBundleFileInvalid)
+	}
+	if req.Name != "" {
+		if err := orm.CheckSameKnowledgeName(ctx, req.UserId, req.OrgId, req.Name, ""); err != nil {
+			return nil, err
+		}
+	}
+	params := &model.KnowledgeBundleImportParams{
+		Name:        req.Name,
+		Description: req.Description,
+	}
+	if req.EmbeddingModelInfo != nil {
+		params.EmbeddingModelId = req.EmbeddingModelInfo.ModelId
+	}
+	paramsBytes, err := json.Marshal(params)
+	if err != nil {
+		return nil, util.ErrCode(errs.Code_KnowledgeBundleImportFailed)
+	}
+	task := &model.KnowledgeBundleTask{
+		TaskType:      model.KnowledgeBundleImport,
+		KnowledgeName: req.Name,
+		FilePath:      req.FilePath,
+		Params:        string(paramsBytes),
+		UserId:        req.UserId,
+		OrgId:         req.OrgId,
+	}
+	if err = orm.CreateKnowledgeBundleTask(ctx, task); err != nil {
+		log.Errorf("知识库导入任务创建失败(%v) 参数(%v)", err, req)
+		return nil, util.ErrCode(errs.Code_KnowledgeBundleImportFailed)
+	}
+	return &knowledgebase_service.KnowledgeBundleTaskResp{TaskId: task.TaskId}, nil
+}
+
+// GetKnowledgeBundleTask 查询知识库导入导出任务，仅任务创建人可查询
+func (s *Service) GetKnowledgeBundleTask(ctx context.Context, req *knowledgebase_service.KnowledgeBundleTaskReq) (*knowledgebase_service.KnowledgeBundleTaskInfo, error) {
+	task, err := orm.SelectKnowledgeBundleTaskById(ctx, req.TaskId, req.UserId, req.OrgId)
+	if err != nil {
+		return nil, err
+	}
+	return buildKnowledgeBundleTaskInfo(task), nil
+}
+
+func buildKnowledgeBundleTaskInfo(task *model.KnowledgeBundleTask) *knowledgebase_service.KnowledgeBundleTaskInfo {
+	info := &knowledgebase_service.KnowledgeBundleTaskInfo{
+		TaskId:        task.TaskId,
+		TaskType:      int32(task.TaskType),
+		KnowledgeId:   task.KnowledgeId,
+		KnowledgeName: task.KnowledgeName,
+		Status:        int32(task.Status),
+		TotalCount:    int32(task.TotalCount),
+		FinishCount:   int32(task.FinishCount),
+		ErrorMsg:      task.ErrorMsg,
+		CreatedAt:     pkg_util.Time2Str(task.CreatedAt),
+		UpdatedAt:     pkg_util.Time2Str(task.UpdatedAt),
+	}
+	if task.TaskType == model.KnowledgeBundleExport {
+		info.FileName = task.FilePath
+	}
+	return info
+}
@@ -0,0 +1,396 @@
+package knowledge
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+
+	errs "github.com/UnicomAI/wanwu/api/proto/err-code"
+	knowledgebase_service "github.com/UnicomAI/wanwu/api/proto/knowledgebase-service"
+	"github.com/UnicomAI/wanwu/internal/knowledge-service/client/model"
+	"github.com/UnicomAI/wanwu/internal/knowledge-service/client/orm"
+	"github.com/UnicomAI/wanwu/internal/knowledge-service/pkg/util"
+	"github.com/UnicomAI/wanwu/pkg/log"
+	pkg_util "github.com/UnicomAI/wanwu/pkg/util"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+// CreateKnowledgeEvalDataset 创建检索评测数据集，需要知识库编辑权限；每个问题至少指定一个期望命中的文档或分段
+func (s *Service) CreateKnowledgeEvalDataset(ctx context.Context, req *knowledgebase_service.CreateKnowledgeEvalDatasetReq) (*knowledgebase_service.CreateKnowledgeEvalDatasetResp, error) {
+	if strings.TrimSpace(req.Name) == "" || len(req.QuestionList) == 0 {
+		return nil, util.ErrCode(errs.Code_KnowledgeInvalidArguments)
+	}
+	knowledge, err := checkKnowledgeEvalPermission(ctx, req.KnowledgeId, req.UserId, req.OrgId, model.KnowledgePermissionEditor)
+	if err != nil {
+		return nil, err
+	}
+	var questionList []*model.KnowledgeEvalQuestion
+	for _, question := range req.QuestionList {
+		if strings.TrimSpace(question.Question) == "" || (len(question.ExpectedDocIdList) == 0 && len(question.ExpectedContentIdList) == 0) {
+			return nil, util.ErrCode(errs.Code_KnowledgeInvalidArguments)
+		}
+		expectedDocIds, err := json.Marshal(question.ExpectedDocIdList)
+		if err != nil {
+			return nil, util.ErrCode(errs.Code_KnowledgeEvalDatasetCreateFailed)
+		}
+		expectedContentIds, err := json.Marshal(question.ExpectedContentIdList)
+		if err != nil {
+			return nil, util.ErrCode(errs.Code_KnowledgeEvalDatasetCreateFailed)
+		}
+		questionList = append(questionList, &model.KnowledgeEvalQuestion{
+			Question:           question.Question,
+			ExpectedDocIds:     string(expectedDocIds),
+			ExpectedContentIds: string(expectedContentIds),
+			ReferenceAnswer:    question.ReferenceAnswer,
+		})
+	}
+	dataset := &model.KnowledgeEvalDataset{
+		KnowledgeId: knowledge.KnowledgeId,
+		Name:        req.Name,
+		Description: req.Description,
+		UserId:      req.UserId,
+		OrgId:       req.OrgId,
+	}
+	if err = orm.CreateKnowledgeEvalDataset(ctx, dataset, questionList); err != nil {
+		log.Errorf("检索评测数据集创建失败(%v) 参数(%v)", err, req)
+		return nil, util.ErrCode(errs.Code_KnowledgeEvalDatasetCreateFailed)
+	}
+	return &knowledgebase_service.CreateKnowledgeEvalDatasetResp{DatasetId: dataset.DatasetId}, nil
+}
+
+// DeleteKnowledgeEvalDataset 删除检索评测数据集及其评测记录，需要知识库编辑权限
+func (s *Service) DeleteKnowledgeEvalDataset(ctx context.Context, req *knowledgebase_service.KnowledgeEvalDatasetReq) (*emptypb.Empty, error) {
+	dataset, err := orm.SelectKnowledgeEvalDatasetById(ctx, req.DatasetId)
+	if err != nil {
+		return nil, err
+	}
+	if _, err = checkKnowledgeEvalPermission(ctx, dataset.KnowledgeId, req.UserId, req.OrgId, model.KnowledgePermissionEditor); err != nil {
+		return nil, err
+	}
+	if err = orm.DeleteKnowledgeEvalDataset(ctx, dataset.DatasetId); err != nil {
+		log.Errorf("检索评测数据集删除失败(%v) 参数(%v)", err, req)
+		return nil, util.ErrCode(errs.Code_KnowledgeEvalDatasetDeleteFailed)
+	}
+	return &emptypb.Empty{}, nil
+}
+
+// GetKnowledgeEvalDatasetList 查询知识库的检索评测数据集列表
+func (s *Service) GetKnowledgeEvalDatasetList(ctx context.Context, req *knowledgebase_service.KnowledgeEvalDatasetListReq) (*knowledgebase_service.KnowledgeEvalDatasetListResp, error) {
+	if _, err := checkKnowledgeEvalPermission(ctx, req.KnowledgeId, req.UserId, req.OrgId, model.KnowledgePermissionViewer); err != nil {
+		return nil, err
+	}
+	list, err := orm.SelectKnowledgeEvalDatasetList(ctx, req.KnowledgeId)
+	if err != nil {
+		return nil, err
+	}
+	resp := &knowledgebase_service.KnowledgeEvalDatasetListResp{}
+	for _, dataset := range list {
+		resp.DatasetList = append(resp.DatasetList, buildKnowledgeEvalDatasetInfo(dataset))
+	}
+	return resp, nil
+}
+
+// GetKnowledgeEvalDataset 查询检索评测数据集详情
+func (s *Service) GetKnowledgeEvalDataset(ctx context.Context, req *knowledgebase_service.KnowledgeEvalDatasetReq) (*knowledgebase_service.KnowledgeEvalDatasetInfo, error) {
+	dataset, err := orm.SelectKnowledgeEvalDatasetById(ctx, req.DatasetId)
+	if err != nil {
+		return nil, err
+	}
+	if _, err = checkKnowledgeEvalPermission(ctx, dataset.KnowledgeId, req.UserId, req.OrgId, model.KnowledgePermissionViewer); err != nil {
+		return nil, err
+	}
+	questionList, err := orm.SelectKnowledgeEvalQuestionList(ctx, dataset.DatasetId)
+	if err != nil {
+		return nil, err
+	}
+	info := buildKnowledgeEvalDatasetInfo(dataset)
+	for _, question := range questionList {
+		info.QuestionList = append(info.QuestionList, buildKnowledgeEvalQuestion(question))
+	}
+	return info, nil
+}
+
+// CreateKnowledgeEvalRun 按指定检索配置运行检索评测，需要知识库编辑权限；检索使用知识库创建人身份
+func (s *Service) CreateKnowledgeEvalRun(ctx context.Context, req *knowledgebase_service.CreateKnowledgeEvalRunReq) (*knowledgebase_service.CreateKnowledgeEvalRunResp, error) {
+	matchParams := req.KnowledgeMatchParams
+	if matchParams == nil || matchParams.TopK <= 0 || buildRetrieveMethod(matchParams.MatchType) == "" {
+		return nil, util.ErrCode(errs.Code_KnowledgeInvalidArguments)
+	}
+	dataset, err := orm.SelectKnowledgeEvalDatasetById(ctx, req.DatasetId)
+	if err != nil {
+		return nil, err
+	}
+	knowledge, err := checkKnowledgeEvalPermission(ctx, dataset.KnowledgeId, req.UserId, req.OrgId, model.KnowledgePermissionEditor)
+	if err != nil {
+		return nil, err
+	}
+	hitParams, err := buildRagHitParams(&knowledgebase_service.KnowledgeHitReq{
+		KnowledgeList:        []*knowledgebase_service.KnowledgeParams{{KnowledgeId: knowledge.KnowledgeId}},
+		KnowledgeMatchParams: matchParams,
+	}, knowledge.UserId, []*model.KnowledgeBase{knowledge}, map[string]string{knowledge.KnowledgeId: knowledge.Name})
+	if err != nil {
+		log.Errorf("检索评测参数构造失败(%v) 参数(%v)", err, req)
+		return nil, util.ErrCode(errs.Code_KnowledgeEvalRunCreateFailed)
+	}
+	hitParamsBytes, err := json.Marshal(hitParams)
+	if err != nil {
+		return nil, util.ErrCode(errs.Code_KnowledgeEvalRunCreateFailed)
+	}
+	matchParamsBytes, err := json.Marshal(&model.KnowledgeEvalMatchParams{
+		MatchType:         matchParams.MatchType,
+		RerankModelId:     matchParams.RerankModelId,
+		PriorityMatch:     matchParams.PriorityMatch,
+		SemanticsPriority: matchParams.SemanticsPriority,
+		KeywordPriority:   matchParams.KeywordPriority,
+		TopK:              matchParams.TopK,
+		Score:             matchParams.Score,
+		TermWeight:        matchParams.TermWeight,
+		TermWeightEnable:  matchParams.TermWeightEnable,
+	})
+	if err != nil {
+		return nil, util.ErrCode(errs.Code_KnowledgeEvalRunCreateFailed)
+	}
+	run := &model.KnowledgeEvalRun{
+		DatasetId:   dataset.DatasetId,
+		KnowledgeId: knowledge.KnowledgeId,
+		MatchParams: string(matchParamsBytes),
+		HitParams:   string(hitParamsBytes),
+		TopK:        int(matchParams.TopK),
+		TotalCount:  dataset.QuestionCount,
+		UserId:      req.UserId,
+		OrgId:       req.OrgId,
+	}
+	if err = orm.CreateKnowledgeEvalRun(ctx, run); err != nil {
+		log.Errorf("检索评测创建失败(%v) 参数(%v)", err, req)
+		return nil, util.ErrCode(errs.Code_KnowledgeEvalRunCreateFailed)
+	}
+	return &knowledgebase_service.CreateKnowledgeEvalRunResp{RunId: run.RunId}, nil
+}
+
+// GetKnowledgeEvalRunList 查询数据集的检索评测列表
+func (s *Service) GetKnowledgeEvalRunList(ctx context.Context, req *knowledgebase_service.KnowledgeEvalRunListReq) (*knowledgebase_service.KnowledgeEvalRunListResp, error) {
+	dataset, err := orm.SelectKnowledgeEvalDatasetById(ctx, req.DatasetId)
+	if err != nil {
+		return nil, err
+	}
+	if _, err = checkKnowledgeEvalPermission(ctx, dataset.KnowledgeId, req.UserId, req.OrgId, model.KnowledgePermissionViewer); err != nil {
+		return nil, err
+	}
+	list, err := orm.SelectKnowledgeEvalRunList(ctx, dataset.DatasetId)
+	if err != nil {
+		return nil, err
+	}
+	resp := &knowledgebase_service.KnowledgeEvalRunListResp{}
+	for _, run := range list {
+		resp.RunList = append(resp.RunList, buildKnowledgeEvalRunInfo(run))
+	}
+	return resp, nil
+}
+
+// GetKnowledgeEvalRun 查询检索评测详情，包括每个问题的检索结果
+func (s *Service) GetKnowledgeEvalRun(ctx context.Context, req *knowledgebase_service.KnowledgeEvalRunReq) (*knowledgebase_service.KnowledgeEvalRunDetail, error) {
+	run, err := orm.SelectKnowledgeEvalRunById(ctx, req.RunId)
+	if err != nil {
+		return nil, err
+	}
+	if _, err = checkKnowledgeEvalPermission(ctx, run.KnowledgeId, req.UserId, req.OrgId, model.KnowledgePermissionViewer); err != nil {
+		return nil, err
+	}
+	questionMap, err := selectKnowledgeEvalQuestionMap(ctx, run.DatasetId)
+	if err != nil {
+		return nil, err
+	}
+	resultList, err := orm.SelectKnowledgeEvalResultList(ctx, run.RunId)
+	if err != nil {
+		return nil, err
+	}
+	detail := &knowledgebase_service.KnowledgeEvalRunDetail{Run: buildKnowledgeEvalRunInfo(run)}
+	for _, result := range resultList {
+		detail.ResultList = append(detail.ResultList, buildKnowledgeEvalQuestionResult(result, questionMap[result.QuestionId]))
+	}
+	return detail, nil
+}
+
+// CompareKnowledgeEvalRun 对比同一数据集下两次已完成的检索评测，按问题列出两次评测的指标
+func (s *Service) CompareKnowledgeEvalRun(ctx context.Context, req *knowledgebase_service.CompareKnowledgeEvalRunReq) (*knowledgebase_service.CompareKnowledgeEvalRunResp, error) {
+	baseRun, err := orm.SelectKnowledgeEvalRunById(ctx, req.BaseRunId)
+	if err != nil {
+		return nil, err
+	}
+	targetRun, err := orm.SelectKnowledgeEvalRunById(ctx, req.TargetRunId)
+	if err != nil {
+		return nil, err
+	}
+	if baseRun.DatasetId != targetRun.DatasetId || baseRun.Status != model.KnowledgeEvalSuccess || targetRun.Status != model.KnowledgeEvalSuccess {
+		return nil, util.ErrCode(errs.Code_KnowledgeEvalRunCompareFailed)
+	}
+	if _, err = checkKnowledgeEvalPermission(ctx, baseRun.KnowledgeId, req.UserId, req.OrgId, model.KnowledgePermissionViewer); err != nil {
+		return nil, err
+	}
+	questionList, err := orm.SelectKnowledgeEvalQuestionList(ctx, baseRun.DatasetId)
+	if err != nil {
+		return nil, err
+	}
+	baseResultMap, err := selectKnowledgeEvalResultMap(ctx, baseRun.RunId)
+	if err != nil {
+		return nil, err
+	}
+	targetResultMap, err := selectKnowledgeEvalResultMap(ctx, targetRun.RunId)
+	if err != nil {
+		return nil, err
+	}
+	resp := &knowledgebase_service.CompareKnowledgeEvalRunResp{
+		BaseRun:   buildKnowledgeEvalRunInfo(baseRun),
+		TargetRun: buildKnowledgeEvalRunInfo(targetRun),
+		Delta: &knowledgebase_service.KnowledgeEvalMetrics{
+			Recall:  targetRun.Recall - baseRun.Recall,
+			Mrr:     targetRun.Mrr - baseRun.Mrr,
+			Ndcg:    targetRun.Ndcg - baseRun.Ndcg,
+			HitRate: targetRun.HitRate - baseRun.HitRate,
+		},
+	}
+	for _, question := range questionList {
+		resp.QuestionList = append(resp.QuestionList, &knowledgebase_service.KnowledgeEvalQuestionCompare{
+			QuestionId:    question.QuestionId,
+			Question:      question.Question,
+			BaseMetrics:   buildKnowledgeEvalResultMetrics(baseResultMap[question.QuestionId]),
+			TargetMetrics: buildKnowledgeEvalResultMetrics(targetResultMap[question.QuestionId]),
+		})
+	}
+	return resp, nil
+}
+
+// checkKnowledgeEvalPermission 校验知识库权限，不修改请求中的用户信息
+func checkKnowledgeEvalPermission(ctx context.Context, knowledgeId, userId, orgId string, permissionType int) (*model.KnowledgeBase, error) {
+	knowledge, err := orm.CheckKnowledgePermission(ctx, knowledgeId, &userId, &orgId, permissionType)
+	if err != nil {
+		log.Errorf("没有操作该知识库检索评测的权限 knowledgeId(%v) userId(%v)", knowledgeId, userId)
+		return nil, err
+	}
+	return knowledge, nil
+}
+
+func selectKnowledgeEvalQuestionMap(ctx context.Context, datasetId string) (map[string]*model.KnowledgeEvalQuestion, error) {
+	questionList, err := orm.SelectKnowledgeEvalQuestionList(ctx, datasetId)
+	if err != nil {
+		return nil, err
+	}
+	questionMap := make(map[string]*model.KnowledgeEvalQuestion, len(questionList))
+	for _, question := range questionList {
+		questionMap[question.QuestionId] = question
+	}
+	return questionMap, nil
+}
+
+func selectKnowledgeEvalResultMap(ctx context.Context, runId string) (map[string]*model.KnowledgeEvalResult, error) {
+	resultList, err := orm.SelectKnowledgeEvalResultList(ctx, runId)
+	if err != nil {
+		return nil, err
+	}
+	resultMap := make(map[string]*model.KnowledgeEvalResult, len(resultList))
+	for _, result := range resultList {
+		resultMap[result.QuestionId] = result
+	}
+	return resultMap, nil
+}
+
+func buildKnowledgeEvalDatasetInfo(dataset *model.KnowledgeEvalDataset) *knowledgebase_service.KnowledgeEvalDatasetInfo {
+	return &knowledgebase_service.KnowledgeEvalDatasetInfo{
+		DatasetId:     dataset.DatasetId,
+		KnowledgeId:   dataset.KnowledgeId,
+		Name:          dataset.Name,
+		Description:   dataset.Description,
+		QuestionCount: int32(dataset.QuestionCount),
+		CreatedAt:     pkg_util.Time2Str(dataset.CreatedAt),
+	}
+}
+
+func buildKnowledgeEvalQuestion(question *model.KnowledgeEvalQuestion) *knowledgebase_service.KnowledgeEvalQuestion {
+	ret := &knowledgebase_service.KnowledgeEvalQuestion{
+		QuestionId:      question.QuestionId,
+		Question:        question.Question,
+		ReferenceAnswer: question.ReferenceAnswer,
+	}
+	if err := json.Unmarshal([]byte(question.ExpectedDocIds), &ret.ExpectedDocIdList); err != nil {
+		log.Errorf("question %s expected doc ids unmarshal err: %v", question.QuestionId, err)
+	}
+	if err := json.Unmarshal([]byte(question.ExpectedContentIds), &ret.ExpectedContentIdList); err != nil {
+		log.Errorf("question %s expected content ids unmarshal err: %v", question.QuestionId, err)
+	}
+	return ret
+}
+
+func buildKnowledgeEvalRunInfo(run *model.KnowledgeEvalRun) *knowledgebase_service.KnowledgeEvalRunInfo {
+	info := &knowledgebase_service.KnowledgeEvalRunInfo{
+		RunId:       run.RunId,
+		DatasetId:   run.DatasetId,
+		KnowledgeId: run.KnowledgeId,
+		Status:      int32(run.Status),
+		TotalCount:  int32(run.TotalCount),
+		FinishCount: int32(run.FinishCount),
+		Metrics: &knowledgebase_service.KnowledgeEvalMetrics{
+			Recall:  run.Recall,
+			Mrr:     run.Mrr,
+			Ndcg:    run.Ndcg,
+			HitRate: run.HitRate,
+		},
+		ErrorMsg:  run.ErrorMsg,
+		CreatedAt: pkg_util.Time2Str(run.CreatedAt),
+	}
+	var matchParams = &model.KnowledgeEvalMatchParams{}
+	if err := json.Unmarshal([]byte(run.MatchParams), matchParams); err != nil {
+		log.Errorf("run %s match params unmarshal err: %v", run.RunId, err)
+		return info
+	}
+	info.KnowledgeMatchParams = &knowledgebase_service.KnowledgeMatchParams{
+		MatchType:         matchParams.MatchType,
+		RerankModelId:     matchParams.RerankModelId,
+		PriorityMatch:     matchParams.PriorityMatch,
+		SemanticsPriority: matchParams.SemanticsPriority,
+		KeywordPriority:   matchParams.KeywordPriority,
+		TopK:              matchParams.TopK,
+		Score:             matchParams.Score,
+		TermWeight:        matchParams.TermWeight,
+		TermWeightEnable:  matchParams.TermWeightEnable,
+	}
+	return info
+}
+
+func buildKnowledgeEvalResultMetrics(result *model.KnowledgeEvalResult) *knowledgebase_service.KnowledgeEvalMetrics {
+	if result == nil {
+		return &knowledgebase_service.KnowledgeEvalMetrics{}
+	}
+	return &knowledgebase_service.KnowledgeEvalMetrics{
+		Recall:  result.Recall,
+		Mrr:     result.ReciprocalRank,
+		Ndcg:    result.Ndcg,
+		HitRate: result.Hit,
+	}
+}
+
+func buildKnowledgeEvalQuestionResult(result *model.KnowledgeEvalResult, question *model.KnowledgeEvalQuestion) *knowledgebase_service.KnowledgeEvalQuestionResult {
+	ret := &knowledgebase_service.KnowledgeEvalQuestionResult{
+		QuestionId: result.QuestionId,
+		Metrics:    buildKnowledgeEvalResultMetrics(result),
+		ErrorMsg:   result.ErrorMsg,
+	}
+	if question != nil {
+		ret.Question = question.Question
+	}
+	var hitList []*model.KnowledgeEvalHit
+	if err := json.Unmarshal([]byte(result.HitList), &hitList); err != nil {
+		log.Errorf("run %s question %s hit list unmarshal err: %v", result.RunId, result.QuestionId, err)
+		return ret
+	}
+	for _, hit := range hitList {
+		ret.HitList = append(ret.HitList, &knowledgebase_service.KnowledgeEvalHit{
+			DocId:     hit.DocId,
+			ContentId: hit.ContentId,
+			Title:     hit.Title,
+			Snippet:   hit.Snippet,
+			Score:     hit.Score,
+			Relevant:  hit.Relevant,
+		})
+	}
+	return ret
+}
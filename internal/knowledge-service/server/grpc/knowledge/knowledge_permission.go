@@ -0,0 +1,130 @@
+package knowledge
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	errs "github.com/UnicomAI/wanwu/api/proto/err-code"
+	knowledgebase_service "github.com/UnicomAI/wanwu/api/proto/knowledgebase-service"
+	"github.com/UnicomAI/wanwu/internal/knowledge-service/client/model"
+	"github.com/UnicomAI/wanwu/internal/knowledge-service/client/orm"
+	"github.com/UnicomAI/wanwu/internal/knowledge-service/pkg/util"
+	"github.com/UnicomAI/wanwu/pkg/log"
+	pkg_util "github.com/UnicomAI/wanwu/pkg/util"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+// GrantKnowledgePermission 分享知识库，需要管理权限；已分享的对象更新权限
+func (s *Service) GrantKnowledgePermission(ctx context.Context, req *knowledgebase_service.GrantKnowledgePermissionReq) (*emptypb.Empty, error) {
+	//1.校验权限，分享人记录为当前用户
+	sharer := req.UserId
+	knowledge, err := orm.CheckKnowledgePermission(ctx, req.KnowledgeId, &req.UserId, &req.OrgId, model.KnowledgePermissionAdmin)
+	if err != nil {
+		log.Errorf("没有分享该知识库的权限 参数(%v)", req)
+		return nil, err
+	}
+	//2.构造分享记录
+	permissionList, err := buildKnowledgePermissionList(knowledge, sharer, req.GranteeList)
+	if err != nil {
+		log.Errorf("知识库分享参数错误(%v) 参数(%v)", err, req)
+		return nil, util.ErrCode(errs.Code_KnowledgePermissionGrantFailed)
+	}
+	if len(permissionList) == 0 {
+		return &emptypb.Empty{}, nil
+	}
+	//3.保存分享记录
+	if err = orm.SaveKnowledgePermission(ctx, permissionList); err != nil {
+		log.Errorf("知识库分享失败(%v) 参数(%v)", err, req)
+		return nil, util.ErrCode(errs.Code_KnowledgePermissionGrantFailed)
+	}
+	return &emptypb.Empty{}, nil
+}
+
+// RevokeKnowledgePermission 取消分享知识库，需要管理权限
+func (s *Service) RevokeKnowledgePermission(ctx context.Context, req *knowledgebase_service.RevokeKnowledgePermissionReq) (*emptypb.Empty, error) {
+	knowledge, err := orm.CheckKnowledgePermission(ctx, req.KnowledgeId, &req.UserId, &req.OrgId, model.KnowledgePermissionAdmin)
+	if err != nil {
+		log.Errorf("没有取消分享该知识库的权限 参数(%v)", req)
+		return nil, err
+	}
+	if err = orm.DeleteKnowledgePermission(ctx, knowledge.KnowledgeId, req.GranteeType, req.GranteeId); err != nil {
+		log.Errorf("知识库取消分享失败(%v) 参数(%v)", err, req)
+		return nil, util.ErrCode(errs.Code_KnowledgePermissionRevokeFailed)
+	}
+	return &emptypb.Empty{}, nil
+}
+
+// GetKnowledgePermissionList 查询知识库分享列表，需要管理权限
+func (s *Service) GetKnowledgePermissionList(ctx context.Context, req *knowledgebase_service.KnowledgePermissionListReq) (*knowledgebase_service.KnowledgePermissionListResp, error) {
+	knowledge, err := orm.CheckKnowledgePermission(ctx, req.KnowledgeId, &req.UserId, &req.OrgId, model.KnowledgePermissionAdmin)
+	if err != nil {
+		log.Errorf("没有查看该知识库分享的权限 参数(%v)", req)
+		return nil, err
+	}
+	permissionList, err := orm.SelectKnowledgePermissionList(ctx, knowledge.KnowledgeId)
+	if err != nil {
+		log.Errorf("查询知识库分享列表失败(%v) 参数(%v)", err, req)
+		return nil, util.ErrCode(errs.Code_KnowledgePermissionSelectFailed)
+	}
+	return buildKnowledgePermissionListResp(permissionList), nil
+}
+
+// buildKnowledgePermissionList 构造分享记录，跳过知识库创建人本人
+func buildKnowledgePermissionList(knowledge *model.KnowledgeBase, sharer string, granteeList []*knowledgebase_service.KnowledgeGrantee) ([]*model.KnowledgePermission, error) {
+	var retList []*model.KnowledgePermission
+	now := time.Now().UnixMilli()
+	for _, grantee := range granteeList {
+		if err := validateKnowledgeGrantee(grantee); err != nil {
+			return nil, err
+		}
+		if grantee.GranteeType == model.KnowledgeGranteeUser && grantee.GranteeId == knowledge.UserId {
+			continue
+		}
+		retList = append(retList, &model.KnowledgePermission{
+			KnowledgeId:    knowledge.KnowledgeId,
+			GranteeType:    grantee.GranteeType,
+			GranteeId:      grantee.GranteeId,
+			PermissionType: int(grantee.PermissionType),
+			CreatedAt:      now,
+			UpdatedAt:      now,
+			UserId:         sharer,
+			OrgId:          knowledge.OrgId,
+		})
+	}
+	return retList, nil
+}
+
+func validateKnowledgeGrantee(grantee *knowledgebase_service.KnowledgeGrantee) error {
+	switch grantee.GranteeType {
+	case model.KnowledgeGranteeUser, model.KnowledgeGranteeRole, model.KnowledgeGranteeOrg:
+	default:
+		return errInvalidGrantee(grantee)
+	}
+	if grantee.GranteeId == "" {
+		return errInvalidGrantee(grantee)
+	}
+	if grantee.PermissionType < model.KnowledgePermissionViewer || grantee.PermissionType > model.KnowledgePermissionAdmin {
+		return errInvalidGrantee(grantee)
+	}
+	return nil
+}
+
+func errInvalidGrantee(grantee *knowledgebase_service.KnowledgeGrantee) error {
+	return fmt.Errorf("invalid grantee type %s id %s permission %d", grantee.GranteeType, grantee.GranteeId, grantee.PermissionType)
+}
+
+// buildKnowledgePermissionListResp 构造知识库分享列表
+func buildKnowledgePermissionListResp(permissionList []*model.KnowledgePermission) *knowledgebase_service.KnowledgePermissionListResp {
+	var retList []*knowledgebase_service.KnowledgePermissionInfo
+	for _, permission := range permissionList {
+		retList = append(retList, &knowledgebase_service.KnowledgePermissionInfo{
+			GranteeType:    permission.GranteeType,
+			GranteeId:      permission.GranteeId,
+			PermissionType: int32(permission.PermissionType),
+			UserId:         permission.UserId,
+			CreatedAt:      pkg_util.Time2Str(permission.CreatedAt),
+		})
+	}
+	return &knowledgebase_service.KnowledgePermissionListResp{List: retList}
+}
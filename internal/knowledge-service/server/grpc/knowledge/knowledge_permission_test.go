@@ -0,0 +1,37 @@
+package knowledge
+
+import (
+	"testing"
+
+	knowledgebase_service "github.com/UnicomAI/wanwu/api/proto/knowledgebase-service"
+	"github.com/UnicomAI/wanwu/internal/knowledge-service/client/model"
+)
+
+func TestBuildKnowledgePermissionList(t *testing.T) {
+	knowledge := &model.KnowledgeBase{KnowledgeId: "k1", UserId: "owner", OrgId: "org1"}
+	list, err := buildKnowledgePermissionList(knowledge, "admin", []*knowledgebase_service.KnowledgeGrantee{
+		{GranteeType: model.KnowledgeGranteeUser, GranteeId: "owner", PermissionType: model.KnowledgePermissionViewer},
+		{GranteeType: model.KnowledgeGranteeRole, GranteeId: "r1", PermissionType: model.KnowledgePermissionEditor},
+		{GranteeType: model.KnowledgeGranteeOrg, GranteeId: "org1", PermissionType: model.KnowledgePermissionViewer},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	// 创建人本人不记录分享
+	if len(list) != 2 {
+		t.Fatalf("expect 2 permissions, got %v", len(list))
+	}
+	if list[0].GranteeId != "r1" || list[0].UserId != "admin" || list[0].OrgId != "org1" {
+		t.Fatalf("unexpected permission %+v", list[0])
+	}
+
+	for _, grantee := range []*knowledgebase_service.KnowledgeGrantee{
+		{GranteeType: "group", GranteeId: "g1", PermissionType: model.KnowledgePermissionViewer},
+		{GranteeType: model.KnowledgeGranteeUser, GranteeId: "", PermissionType: model.KnowledgePermissionViewer},
+		{GranteeType: model.KnowledgeGranteeUser, GranteeId: "u1", PermissionType: 4},
+	} {
+		if _, err := buildKnowledgePermissionList(knowledge, "admin", []*knowledgebase_service.KnowledgeGrantee{grantee}); err == nil {
+			t.Fatalf("expect invalid grantee %+v", grantee)
+		}
+	}
+}
@@ -0,0 +1,54 @@
+package knowledge
+
+import (
+	"testing"
+
+	"github.com/UnicomAI/wanwu/internal/knowledge-service/client/model"
+	rag_service "github.com/UnicomAI/wanwu/internal/knowledge-service/service"
+)
+
+func TestGroupKnowledgeByOwner(t *testing.T) {
+	groups := groupKnowledgeByOwner([]*model.KnowledgeBase{
+		{KnowledgeId: "k1", UserId: "u1"},
+		{KnowledgeId: "k2", UserId: "u2"},
+		{KnowledgeId: "k3", UserId: "u1"},
+	})
+	if len(groups) != 2 || len(groups[0]) != 2 || len(groups[1]) != 1 {
+		t.Fatalf("unexpected groups %v", groups)
+	}
+	if groups[0][0].KnowledgeId != "k1" || groups[0][1].KnowledgeId != "k3" || groups[1][0].KnowledgeId != "k2" {
+		t.Fatalf("group order not kept: %v", groups)
+	}
+}
+
+func TestMergeKnowledgeHitData(t *testing.T) {
+	u1 := &rag_service.KnowledgeHitData{
+		Prompt:     "参考:a1\na2 问题:q",
+		SearchList: []*rag_service.ChunkSearchList{{Snippet: "a1"}, {Snippet: "a2"}},
+		Score:      []float64{0.9, 0.5},
+	}
+	u2 := &rag_service.KnowledgeHitData{
+		Prompt:     "参考:b1 问题:q",
+		SearchList: []*rag_service.ChunkSearchList{{Snippet: "b1"}},
+		Score:      []float64{0.7},
+	}
+	data := mergeKnowledgeHitData([]*rag_service.KnowledgeHitData{u1, u2}, 2)
+	// 按分数重排后取topK
+	if len(data.SearchList) != 2 || data.SearchList[0].Snippet != "a1" || data.SearchList[1].Snippet != "b1" {
+		t.Fatalf("unexpected search list %v", data.SearchList)
+	}
+	if data.Score[0] != 0.9 || data.Score[1] != 0.7 {
+		t.Fatalf("unexpected score %v", data.Score)
+	}
+	// prompt上下文替换为合并后的片段
+	if data.Prompt != "参考:a1\nb1 问题:q" {
+		t.Fatalf("unexpected prompt %q", data.Prompt)
+	}
+
+	// 首个分组无结果时prompt取有结果的分组
+	empty := &rag_service.KnowledgeHitData{Prompt: "q"}
+	data = mergeKnowledgeHitData([]*rag_service.KnowledgeHitData{empty, u2}, 5)
+	if len(data.SearchList) != 1 || data.Prompt != "参考:b1 问题:q" {
+		t.Fatalf("unexpected merge with empty group %v %q", data.SearchList, data.Prompt)
+	}
+}
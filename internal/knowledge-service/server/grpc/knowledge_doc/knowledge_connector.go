@@ -0,0 +1,207 @@
+package knowledge_doc
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+
+	errs "github.com/UnicomAI/wanwu/api/proto/err-code"
+	knowledgebase_doc_service "github.com/UnicomAI/wanwu/api/proto/knowledgebase-doc-service"
+	"github.com/UnicomAI/wanwu/internal/knowledge-service/client/model"
+	"github.com/UnicomAI/wanwu/internal/knowledge-service/client/orm"
+	"github.com/UnicomAI/wanwu/internal/knowledge-service/pkg/util"
+	"github.com/UnicomAI/wanwu/internal/knowledge-service/task/connector"
+	"github.com/UnicomAI/wanwu/pkg/log"
+	util2 "github.com/UnicomAI/wanwu/pkg/util"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+// SaveConnector 创建或更新知识库连接器，需要编辑权限；导入的文档归属知识库创建人
+func (s *Service) SaveConnector(ctx context.Context, req *knowledgebase_doc_service.SaveConnectorReq) (*knowledgebase_doc_service.SaveConnectorResp, error) {
+	if !connector.Enabled() {
+		log.Errorf("保存知识库连接器失败(%v) 知识库(%s)", connector.ErrConnectorDisabled, req.KnowledgeId)
+		return nil, util.ErrCode(errs.Code_KnowledgeConnectorDisabled)
+	}
+	//1.校验权限
+	if _, err := orm.CheckKnowledgePermission(ctx, req.KnowledgeId, &req.UserId, &req.OrgId, model.KnowledgePermissionEditor); err != nil {
+		log.Errorf("没有设置知识库连接器的权限 参数(%v)", req.KnowledgeId)
+		return nil, err
+	}
+	//2.构造连接器，凭证加密
+	knowledgeConnector, err := buildKnowledgeConnector(req)
+	if err != nil {
+		log.Errorf("知识库连接器参数错误(%v) 知识库(%s)", err, req.KnowledgeId)
+		return nil, util.ErrCode(errs.Code_KnowledgeConnectorParamsInvalid)
+	}
+	//3.保存
+	if req.ConnectorId == "" {
+		err = orm.CreateKnowledgeConnector(ctx, knowledgeConnector)
+	} else {
+		var existing *model.KnowledgeConnector
+		if existing, err = orm.SelectKnowledgeConnectorById(ctx, req.ConnectorId); err != nil {
+			return nil, err
+		}
+		if existing.KnowledgeId != req.KnowledgeId || existing.ConnectorType != req.ConnectorType {
+			log.Errorf("知识库连接器 %s 不属于知识库 %s 或类型不可修改", req.ConnectorId, req.KnowledgeId)
+			return nil, util.ErrCode(errs.Code_KnowledgeConnectorParamsInvalid)
+		}
+		knowledgeConnector.Id, knowledgeConnector.ConnectorId = existing.Id, existing.ConnectorId
+		err = orm.UpdateKnowledgeConnector(ctx, knowledgeConnector)
+	}
+	if err != nil {
+		log.Errorf("保存知识库连接器失败(%v) 知识库(%s)", err, req.KnowledgeId)
+		return nil, util.ErrCode(errs.Code_KnowledgeConnectorSaveFailed)
+	}
+	return &knowledgebase_doc_service.SaveConnectorResp{ConnectorId: knowledgeConnector.ConnectorId}, nil
+}
+
+// DeleteConnector 删除知识库连接器，需要编辑权限
+func (s *Service) DeleteConnector(ctx context.Context, req *knowledgebase_doc_service.DeleteConnectorReq) (*emptypb.Empty, error) {
+	knowledgeConnector, err := checkConnectorPermission(ctx, req.ConnectorId, &req.UserId, &req.OrgId, model.KnowledgePermissionEditor)
+	if err != nil {
+		return nil, err
+	}
+	if err = orm.DeleteKnowledgeConnector(ctx, knowledgeConnector.ConnectorId); err != nil {
+		log.Errorf("删除知识库连接器失败(%v) 参数(%v)", err, req)
+		return nil, util.ErrCode(errs.Code_KnowledgeConnectorDeleteFailed)
+	}
+	return &emptypb.Empty{}, nil
+}
+
+// GetConnectorList 查询知识库连接器列表，需要查看权限；凭证不返回
+func (s *Service) GetConnectorList(ctx context.Context, req *knowledgebase_doc_service.GetConnectorListReq) (*knowledgebase_doc_service.GetConnectorListResp, error) {
+	if _, err := orm.CheckKnowledgePermission(ctx, req.KnowledgeId, &req.UserId, &req.OrgId, model.KnowledgePermissionViewer); err != nil {
+		log.Errorf("没有查看知识库连接器的权限 参数(%v)", req)
+		return nil, err
+	}
+	list, err := orm.SelectKnowledgeConnectorList(ctx, req.KnowledgeId)
+	if err != nil {
+		log.Errorf("查询知识库连接器列表失败(%v) 参数(%v)", err, req)
+		return nil, util.ErrCode(errs.Code_KnowledgeConnectorSelectFailed)
+	}
+	return buildConnectorListResp(list), nil
+}
+
+// RunConnector 执行知识库连接器增量导入，需要编辑权限
+func (s *Service) RunConnector(ctx context.Context, req *knowledgebase_doc_service.RunConnectorReq) (*emptypb.Empty, error) {
+	if !connector.Enabled() {
+		log.Errorf("执行知识库连接器失败(%v) 参数(%v)", connector.ErrConnectorDisabled, req)
+		return nil, util.ErrCode(errs.Code_KnowledgeConnectorDisabled)
+	}
+	knowledgeConnector, err := checkConnectorPermission(ctx, req.ConnectorId, &req.UserId, &req.OrgId, model.KnowledgePermissionEditor)
+	if err != nil {
+		return nil, err
+	}
+	if err = orm.SubmitKnowledgeConnectorTask(ctx, knowledgeConnector); err != nil {
+		log.Errorf("执行知识库连接器失败(%v) 参数(%v)", err, req)
+		return nil, util.ErrCode(errs.Code_KnowledgeConnectorRunFailed)
+	}
+	return &emptypb.Empty{}, nil
+}
+
+// checkConnectorPermission 查询连接器并校验所属知识库权限
+func checkConnectorPermission(ctx context.Context, connectorId string, userId, orgId *string, permissionType int) (*model.KnowledgeConnector, error) {
+	knowledgeConnector, err := orm.SelectKnowledgeConnectorById(ctx, connectorId)
+	if err != nil {
+		return nil, err
+	}
+	if _, err = orm.CheckKnowledgePermission(ctx, knowledgeConnector.KnowledgeId, userId, orgId, permissionType); err != nil {
+		log.Errorf("没有操作知识库连接器 %s 的权限", connectorId)
+		return nil, err
+	}
+	return knowledgeConnector, nil
+}
+
+func buildKnowledgeConnector(req *knowledgebase_doc_service.SaveConnectorReq) (*model.KnowledgeConnector, error) {
+	if req.Name == "" || req.Config == nil || req.DocSegment == nil {
+		return nil, errors.New("name, config and docSegment are required")
+	}
+	params := &model.ConnectorParams{
+		Endpoint:   req.Config.Endpoint,
+		Bucket:     req.Config.Bucket,
+		UseSsl:     req.Config.UseSsl,
+		RepoUrl:    req.Config.RepoUrl,
+		Branch:     req.Config.Branch,
+		RootPath:   req.Config.RootPath,
+		PathFilter: req.Config.PathFilter,
+	}
+	if err := connector.CheckConnectorParams(req.ConnectorType, params); err != nil {
+		return nil, err
+	}
+	paramsBytes, err := json.Marshal(params)
+	if err != nil {
+		return nil, err
+	}
+	var credential string
+	if c := req.Credential; c != nil && (c.AccessKey != "" || c.SecretKey != "" || c.Username != "" || c.Password != "") {
+		credential, err = connector.EncryptCredential(&model.ConnectorCredential{
+			AccessKey: c.AccessKey,
+			SecretKey: c.SecretKey,
+			Username:  c.Username,
+			Password:  c.Password,
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+	//导入配置与文档上传一致
+	importTask, err := buildImportTask(&knowledgebase_doc_service.ImportDocReq{
+		UserId:        req.UserId,
+		OrgId:         req.OrgId,
+		KnowledgeId:   req.KnowledgeId,
+		DocImportType: model.FileImportType,
+		DocSegment:    req.DocSegment,
+		DocAnalyzer:   req.DocAnalyzer,
+		OcrModelId:    req.OcrModelId,
+		DocPreprocess: req.DocPreprocess,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &model.KnowledgeConnector{
+		KnowledgeId:   req.KnowledgeId,
+		Name:          req.Name,
+		ConnectorType: req.ConnectorType,
+		Params:        string(paramsBytes),
+		Credential:    credential,
+		SegmentConfig: importTask.SegmentConfig,
+		DocAnalyzer:   importTask.DocAnalyzer,
+		OcrModelId:    importTask.OcrModelId,
+		DocPreProcess: importTask.DocPreProcess,
+		UserId:        req.UserId,
+		OrgId:         req.OrgId,
+	}, nil
+}
+
+func buildConnectorListResp(list []*model.KnowledgeConnector) *knowledgebase_doc_service.GetConnectorListResp {
+	var retList []*knowledgebase_doc_service.ConnectorInfo
+	for _, knowledgeConnector := range list {
+		var params = &model.ConnectorParams{}
+		if err := json.Unmarshal([]byte(knowledgeConnector.Params), params); err != nil {
+			log.Errorf("connector %s params unmarshal err: %v", knowledgeConnector.ConnectorId, err)
+		}
+		retList = append(retList, &knowledgebase_doc_service.ConnectorInfo{
+			ConnectorId:   knowledgeConnector.ConnectorId,
+			KnowledgeId:   knowledgeConnector.KnowledgeId,
+			Name:          knowledgeConnector.Name,
+			ConnectorType: knowledgeConnector.ConnectorType,
+			Config: &knowledgebase_doc_service.ConnectorConfig{
+				Endpoint:   params.Endpoint,
+				Bucket:     params.Bucket,
+				UseSsl:     params.UseSsl,
+				RepoUrl:    params.RepoUrl,
+				Branch:     params.Branch,
+				RootPath:   params.RootPath,
+				PathFilter: params.PathFilter,
+			},
+			HasCredential:    knowledgeConnector.Credential != "",
+			Status:           int32(knowledgeConnector.Status),
+			ErrorMsg:         knowledgeConnector.ErrorMsg,
+			Cursor:           knowledgeConnector.Cursor,
+			LastImportTaskId: knowledgeConnector.LastImportTaskId,
+			LastRunAt:        timeStr(knowledgeConnector.LastRunAt),
+			CreatedAt:        util2.Time2Str(knowledgeConnector.CreatedAt),
+		})
+	}
+	return &knowledgebase_doc_service.GetConnectorListResp{List: retList}
+}
@@ -14,6 +14,7 @@ import (
 	"github.com/UnicomAI/wanwu/internal/knowledge-service/service"
 	"github.com/UnicomAI/wanwu/pkg/log"
 	util2 "github.com/UnicomAI/wanwu/pkg/util"
+	"github.com/UnicomAI/wanwu/pkg/webhook"
 	"github.com/samber/lo"
 	"google.golang.org/protobuf/types/known/emptypb"
 	"strconv"
@@ -39,6 +40,9 @@ const (
 )
 
 func (s *Service) GetDocList(ctx context.Context, req *knowledgebase_doc_service.GetDocListReq) (*knowledgebase_doc_service.GetDocListResp, error) {
+	if _, err := orm.CheckKnowledgePermission(ctx, req.KnowledgeId, &req.UserId, &req.OrgId, model.KnowledgePermissionViewer); err != nil {
+		return nil, err
+	}
 	list, total, err := orm.GetDocList(ctx, req.UserId, req.OrgId, req.KnowledgeId,
 		req.DocName, req.DocTag, util.BuildDocReqStatusList(int(req.Status)), req.PageSize, req.PageNum)
 	if err != nil {
@@ -58,6 +62,9 @@ func (s *Service) GetDocList(ctx context.Context, req *knowledgebase_doc_service
 }
 
 func (s *Service) ImportDoc(ctx context.Context, req *knowledgebase_doc_service.ImportDocReq) (*emptypb.Empty, error) {
+	if _, err := orm.CheckKnowledgePermission(ctx, req.KnowledgeId, &req.UserId, &req.OrgId, model.KnowledgePermissionEditor); err != nil {
+		return nil, err
+	}
 	task, err := buildImportTask(req)
 	if err != nil {
 		return nil, err
@@ -77,9 +84,38 @@ func (s *Service) UpdateDocStatus(ctx context.Context, req *knowledgebase_doc_se
 		log.Errorf("docId: %v update doc fail %v", req.DocId, err)
 		return nil, util.ErrCode(errs.Code_KnowledgeDocUpdateStatusFailed)
 	}
+	//回滚的文档解析结束后异步恢复历史版本切片，并发送解析结果事件
+	if status := util.BuildDocRespStatus(int(req.Status)); status == model.DocSuccess || status == model.DocFail {
+		go func() {
+			defer util2.PrintPanicStack()
+			restoreDocVersionSegment(context.Background(), req.DocId, status == model.DocSuccess)
+			emitDocParseEvent(context.Background(), req.DocId, status == model.DocSuccess)
+		}()
+	}
 	return &emptypb.Empty{}, nil
 }
 
+// emitDocParseEvent 发送文档解析结果的webhook事件
+func emitDocParseEvent(ctx context.Context, docId string, success bool) {
+	docList, err := orm.SelectDocByDocIdList(ctx, []string{docId}, "", "")
+	if err != nil {
+		return
+	}
+	doc := docList[0]
+	event := webhook.EventKnowledgeDocParsed
+	if !success {
+		event = webhook.EventKnowledgeDocParseFailed
+	}
+	webhook.Emit(doc.OrgId, event, map[string]string{
+		"docId":       doc.DocId,
+		"docName":     doc.Name,
+		"knowledgeId": doc.KnowledgeId,
+		"importId":    doc.ImportTaskId,
+		"userId":      doc.UserId,
+		"errorMsg":    doc.ErrorMsg,
+	})
+}
+
 func (s *Service) UpdateDocMetaData(ctx context.Context, req *knowledgebase_doc_service.UpdateDocMetaDataReq) (*emptypb.Empty, error) {
 	if len(req.MetaDataList) == 0 {
 		return &emptypb.Empty{}, nil
@@ -114,7 +150,7 @@ func buildMetaDocMap(metaList []*model.KnowledgeDocMeta) map[string][]*model.Kno
 // updateKnowledgeMetaData 更新知识库元数据
 func updateKnowledgeMetaData(ctx context.Context, req *knowledgebase_doc_service.UpdateDocMetaDataReq) (*emptypb.Empty, error) {
 	// 1.查询知识库信息
-	knowledge, err := orm.SelectKnowledgeById(ctx, req.KnowledgeId, req.UserId, req.OrgId)
+	knowledge, err := orm.CheckKnowledgePermission(ctx, req.KnowledgeId, &req.UserId, &req.OrgId, model.KnowledgePermissionEditor)
 	if err != nil {
 		log.Errorf("没有操作该知识库的权限 参数(%v)", req)
 		return nil, err
@@ -191,6 +227,9 @@ func buildImportTaskIdList(docList []*model.KnowledgeDoc) []string {
 // updateDocMetaData 更新文档元数据
 func updateDocMetaData(ctx context.Context, req *knowledgebase_doc_service.UpdateDocMetaDataReq) (*emptypb.Empty, error) {
 	//1.查询文档详情
+	if err := orm.CheckDocPermission(ctx, []string{req.DocId}, &req.UserId, &req.OrgId, model.KnowledgePermissionEditor); err != nil {
+		return nil, err
+	}
 	docList, err := orm.SelectDocByDocIdList(ctx, []string{req.DocId}, req.UserId, req.OrgId)
 	if err != nil {
 		log.Errorf("没有操作该知识库文档的权限 参数(%v)", req)
@@ -332,6 +371,9 @@ func (s *Service) InitDocStatus(ctx context.Context, req *knowledgebase_doc_serv
 
 func (s *Service) DeleteDoc(ctx context.Context, req *knowledgebase_doc_service.DeleteDocReq) (*emptypb.Empty, error) {
 	//1.查询文档详情
+	if err := orm.CheckDocPermission(ctx, req.Ids, &req.UserId, &req.OrgId, model.KnowledgePermissionEditor); err != nil {
+		return nil, err
+	}
 	docList, err := orm.SelectDocByDocIdList(ctx, req.Ids, req.UserId, req.OrgId)
 	if err != nil {
 		log.Errorf("没有操作该知识库的权限 参数(%v)", req)
@@ -357,7 +399,7 @@ func (s *Service) DeleteDoc(ctx context.Context, req *knowledgebase_doc_service.
 
 func (s *Service) GetDocCategoryUploadTip(ctx context.Context, req *knowledgebase_doc_service.DocImportTipReq) (*knowledgebase_doc_service.DocImportTipResp, error) {
 	//1.查询知识库详情
-	knowledge, err := orm.SelectKnowledgeById(ctx, req.KnowledgeId, req.UserId, req.OrgId)
+	knowledge, err := orm.CheckKnowledgePermission(ctx, req.KnowledgeId, &req.UserId, &req.OrgId, model.KnowledgePermissionViewer)
 	if err != nil {
 		return nil, err
 	}
@@ -400,6 +442,9 @@ func (s *Service) GetDocCategoryUploadTip(ctx context.Context, req *knowledgebas
 
 func (s *Service) GetDocSegmentList(ctx context.Context, req *knowledgebase_doc_service.DocSegmentListReq) (*knowledgebase_doc_service.DocSegmentListResp, error) {
 	//1.查询文档详情
+	if err := orm.CheckDocPermission(ctx, []string{req.DocId}, &req.UserId, &req.OrgId, model.KnowledgePermissionViewer); err != nil {
+		return nil, err
+	}
 	docList, err := orm.SelectDocByDocIdList(ctx, []string{req.DocId}, req.UserId, req.OrgId)
 	if err != nil {
 		log.Errorf("没有操作该知识库的权限 参数(%v)", req)
@@ -442,6 +487,9 @@ func (s *Service) GetDocSegmentList(ctx context.Context, req *knowledgebase_doc_
 
 func (s *Service) GetDocChildSegmentList(ctx context.Context, req *knowledgebase_doc_service.GetDocChildSegmentListReq) (*knowledgebase_doc_service.GetDocChildSegmentListResp, error) {
 	//1.查询文档详情
+	if err := orm.CheckDocPermission(ctx, []string{req.DocId}, &req.UserId, &req.OrgId, model.KnowledgePermissionViewer); err != nil {
+		return nil, err
+	}
 	docList, err := orm.SelectDocByDocIdList(ctx, []string{req.DocId}, req.UserId, req.OrgId)
 	if err != nil {
 		log.Errorf("没有操作该知识库的权限 参数(%v)", req)
@@ -487,7 +535,7 @@ func (s *Service) AnalysisDocUrl(ctx context.Context, req *knowledgebase_doc_ser
 // BatchUpdateDocMetaData 批量更新文档元数据
 func (s *Service) BatchUpdateDocMetaData(ctx context.Context, req *knowledgebase_doc_service.BatchUpdateDocMetaDataReq) (*emptypb.Empty, error) {
 	//1.查询知识库信息
-	knowledge, err := orm.SelectKnowledgeById(ctx, req.KnowledgeId, req.UserId, req.OrgId)
+	knowledge, err := orm.CheckKnowledgePermission(ctx, req.KnowledgeId, &req.UserId, &req.OrgId, model.KnowledgePermissionEditor)
 	if err != nil {
 		log.Errorf("没有操作该知识库的权限 参数(%v)", req)
 		return nil, err
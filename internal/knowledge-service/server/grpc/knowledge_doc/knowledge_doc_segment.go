@@ -10,6 +10,7 @@ import (
 	"github.com/UnicomAI/wanwu/internal/knowledge-service/pkg/generator"
 	"github.com/UnicomAI/wanwu/internal/knowledge-service/pkg/util"
 	"github.com/UnicomAI/wanwu/internal/knowledge-service/service"
+	doc_segment_file "github.com/UnicomAI/wanwu/internal/knowledge-service/task/doc-segment-file"
 	"github.com/UnicomAI/wanwu/pkg/log"
 	"google.golang.org/protobuf/types/known/emptypb"
 	"strings"
@@ -19,6 +20,9 @@ import (
 
 func (s *Service) CreateDocSegment(ctx context.Context, req *knowledgebase_doc_service.CreateDocSegmentReq) (*emptypb.Empty, error) {
 	//1.查询文档详情
+	if err := orm.CheckDocPermission(ctx, []string{req.DocId}, &req.UserId, &req.OrgId, model.KnowledgePermissionEditor); err != nil {
+		return nil, err
+	}
 	docList, err := orm.SelectDocByDocIdList(ctx, []string{req.DocId}, req.UserId, req.OrgId)
 	if err != nil {
 		log.Errorf("没有操作该知识库文档的权限 参数(%v)", req)
@@ -61,7 +65,12 @@ func (s *Service) CreateDocSegment(ctx context.Context, req *knowledgebase_doc_s
 		log.Errorf("内容长度超出最大分段长度 错误(%v) 参数(%v)", err1, req)
 		return nil, err1
 	}
-	//8.发送rag请求
+	//8.归档当前版本
+	if err = archiveDocSegmentVersion(ctx, knowledge, doc); err != nil {
+		log.Errorf("docId %v archive doc version fail %v", req.DocId, err)
+		return nil, util.ErrCode(errs.Code_KnowledgeDocSegmentCreateFailed)
+	}
+	//9.发送rag请求
 	var labels = req.Labels
 	if len(labels) == 0 {
 		labels = make([]string, 0)
@@ -89,7 +98,21 @@ func (s *Service) CreateDocSegment(ctx context.Context, req *knowledgebase_doc_s
 }
 
 func (s *Service) BatchCreateDocSegment(ctx context.Context, req *knowledgebase_doc_service.BatchCreateDocSegmentReq) (*emptypb.Empty, error) {
+	//0.校验文件格式和导入方式
+	if _, err := doc_segment_file.ParseFormat(req.FileUrl); err != nil {
+		return nil, util.ErrCode(errs.Code_KnowledgeDocSegmentFileCSVTypeFail)
+	}
+	if req.Mode == "" {
+		req.Mode = model.DocSegmentImportModeCreate
+	}
+	if req.Mode != model.DocSegmentImportModeCreate && req.Mode != model.DocSegmentImportModeUpsert {
+		log.Errorf("文档分段导入方式(%s)不支持 参数(%v)", req.Mode, req)
+		return nil, util.ErrCode(errs.Code_KnowledgeDocSegmentCreateFailed)
+	}
 	//1.查询文档详情
+	if err := orm.CheckDocPermission(ctx, []string{req.DocId}, &req.UserId, &req.OrgId, model.KnowledgePermissionEditor); err != nil {
+		return nil, err
+	}
 	docList, err := orm.SelectDocByDocIdList(ctx, []string{req.DocId}, req.UserId, req.OrgId)
 	if err != nil {
 		log.Errorf("没有操作该知识库文档的权限 参数(%v)", req)
@@ -122,6 +145,11 @@ func (s *Service) BatchCreateDocSegment(ctx context.Context, req *knowledgebase_
 		return nil, err
 	}
 
+	//6.归档当前版本
+	if err = archiveDocSegmentVersion(ctx, knowledge, doc); err != nil {
+		log.Errorf("docId %v archive doc version fail %v", req.DocId, err)
+		return nil, util.ErrCode(errs.Code_KnowledgeDocSegmentCreateFailed)
+	}
 	task, err := buildDocSegmentImportTask(knowledge, fileName, doc.DocId, segmentConfig, req)
 	if err != nil {
 		log.Errorf("docId %v create doc segment import task params fail %v", req.DocId, err)
@@ -138,6 +166,9 @@ func (s *Service) BatchCreateDocSegment(ctx context.Context, req *knowledgebase_
 
 func (s *Service) UpdateDocSegment(ctx context.Context, req *knowledgebase_doc_service.UpdateDocSegmentReq) (*emptypb.Empty, error) {
 	//1.查询文档详情
+	if err := orm.CheckDocPermission(ctx, []string{req.DocId}, &req.UserId, &req.OrgId, model.KnowledgePermissionEditor); err != nil {
+		return nil, err
+	}
 	docList, err := orm.SelectDocByDocIdList(ctx, []string{req.DocId}, req.UserId, req.OrgId)
 	if err != nil {
 		log.Errorf("没有操作该知识库文档的权限 参数(%v)", req)
@@ -179,7 +210,12 @@ func (s *Service) UpdateDocSegment(ctx context.Context, req *knowledgebase_doc_s
 		log.Errorf("内容长度超出最大分段长度 错误(%v) 参数(%v)", err1, req)
 		return nil, err1
 	}
-	//8.发送rag请求
+	//8.归档当前版本
+	if err = archiveDocSegmentVersion(ctx, knowledge, doc); err != nil {
+		log.Errorf("docId %v archive doc version fail %v", req.DocId, err)
+		return nil, util.ErrCode(errs.Code_KnowledgeDocSegmentUpdateFailed)
+	}
+	//9.发送rag请求
 	err = service.RagUpdateDocSegment(ctx, &service.RagUpdateDocSegmentParams{
 		UserId:          req.UserId,
 		KnowledgeBase:   knowledge.Name,
@@ -203,6 +239,9 @@ func (s *Service) UpdateDocSegment(ctx context.Context, req *knowledgebase_doc_s
 
 func (s *Service) DeleteDocSegment(ctx context.Context, req *knowledgebase_doc_service.DeleteDocSegmentReq) (*emptypb.Empty, error) {
 	//1.查询文档详情
+	if err := orm.CheckDocPermission(ctx, []string{req.DocId}, &req.UserId, &req.OrgId, model.KnowledgePermissionEditor); err != nil {
+		return nil, err
+	}
 	docList, err := orm.SelectDocByDocIdList(ctx, []string{req.DocId}, req.UserId, req.OrgId)
 	if err != nil {
 		log.Errorf("没有操作该知识库文档的权限 参数(%v)", req)
@@ -222,7 +261,12 @@ func (s *Service) DeleteDocSegment(ctx context.Context, req *knowledgebase_doc_s
 	}
 	//4.获取文档名称
 	fileName := service.RebuildFileName(doc.DocId, doc.FileType, doc.Name)
-	//5.发送rag请求
+	//5.归档当前版本
+	if err = archiveDocSegmentVersion(ctx, knowledge, doc); err != nil {
+		log.Errorf("docId %v archive doc version fail %v", req.DocId, err)
+		return nil, util.ErrCode(errs.Code_KnowledgeDocSegmentDeleteFailed)
+	}
+	//6.发送rag请求
 	err = service.RagDeleteDocSegment(ctx, &service.RagDeleteDocSegmentParams{
 		UserId:        req.UserId,
 		KnowledgeBase: knowledge.Name,
@@ -239,6 +283,9 @@ func (s *Service) DeleteDocSegment(ctx context.Context, req *knowledgebase_doc_s
 
 func (s *Service) UpdateDocSegmentStatus(ctx context.Context, req *knowledgebase_doc_service.UpdateDocSegmentStatusReq) (*emptypb.Empty, error) {
 	//1.查询文档详情
+	if err := orm.CheckDocPermission(ctx, []string{req.DocId}, &req.UserId, &req.OrgId, model.KnowledgePermissionEditor); err != nil {
+		return nil, err
+	}
 	docList, err := orm.SelectDocByDocIdList(ctx, []string{req.DocId}, req.UserId, req.OrgId)
 	if err != nil {
 		log.Errorf("没有操作该知识库的权限 参数(%v)", req)
@@ -263,6 +310,9 @@ func (s *Service) UpdateDocSegmentStatus(ctx context.Context, req *knowledgebase
 
 func (s *Service) UpdateDocSegmentLabels(ctx context.Context, req *knowledgebase_doc_service.DocSegmentLabelsReq) (*emptypb.Empty, error) {
 	//1.查询文档详情
+	if err := orm.CheckDocPermission(ctx, []string{req.DocId}, &req.UserId, &req.OrgId, model.KnowledgePermissionEditor); err != nil {
+		return nil, err
+	}
 	docList, err := orm.SelectDocByDocIdList(ctx, []string{req.DocId}, req.UserId, req.OrgId)
 	if err != nil {
 		log.Errorf("没有操作该知识库文档的权限 参数(%v)", req)
@@ -303,6 +353,9 @@ func (s *Service) UpdateDocSegmentLabels(ctx context.Context, req *knowledgebase
 
 func (s *Service) CreateDocChildSegment(ctx context.Context, req *knowledgebase_doc_service.CreateDocChildSegmentReq) (*emptypb.Empty, error) {
 	//1.查询文档详情
+	if err := orm.CheckDocPermission(ctx, []string{req.DocId}, &req.UserId, &req.OrgId, model.KnowledgePermissionEditor); err != nil {
+		return nil, err
+	}
 	docList, err := orm.SelectDocByDocIdList(ctx, []string{req.DocId}, req.UserId, req.OrgId)
 	if err != nil {
 		log.Errorf("没有操作该知识库文档的权限 参数(%v)", req)
@@ -355,6 +408,9 @@ func (s *Service) CreateDocChildSegment(ctx context.Context, req *knowledgebase_
 
 func (s *Service) UpdateDocChildSegment(ctx context.Context, req *knowledgebase_doc_service.UpdateDocChildSegmentReq) (*emptypb.Empty, error) {
 	//1.查询文档详情
+	if err := orm.CheckDocPermission(ctx, []string{req.DocId}, &req.UserId, &req.OrgId, model.KnowledgePermissionEditor); err != nil {
+		return nil, err
+	}
 	docList, err := orm.SelectDocByDocIdList(ctx, []string{req.DocId}, req.UserId, req.OrgId)
 	if err != nil {
 		log.Errorf("没有操作该知识库文档的权限 参数(%v)", req)
@@ -413,6 +469,9 @@ func (s *Service) UpdateDocChildSegment(ctx context.Context, req *knowledgebase_
 
 func (s *Service) DeleteDocChildSegment(ctx context.Context, req *knowledgebase_doc_service.DeleteDocChildSegmentReq) (*emptypb.Empty, error) {
 	//1.查询文档详情
+	if err := orm.CheckDocPermission(ctx, []string{req.DocId}, &req.UserId, &req.OrgId, model.KnowledgePermissionEditor); err != nil {
+		return nil, err
+	}
 	docList, err := orm.SelectDocByDocIdList(ctx, []string{req.DocId}, req.UserId, req.OrgId)
 	if err != nil {
 		log.Errorf("没有操作该知识库文档的权限 参数(%v)", req)
@@ -471,6 +530,7 @@ func buildDocSegmentImportTask(knowledge *model.KnowledgeBase, fileName, docId s
 		SegmentMethod:   segmentConfig.SegmentMethod,
 		SubMaxSplitter:  segmentConfig.SubMaxSplitter,
 		SubSplitter:     segmentConfig.SubSplitter,
+		Mode:            req.Mode,
 	}
 	marshal, err := json.Marshal(params)
 	if err != nil {
@@ -0,0 +1,190 @@
+package knowledge_doc
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+
+	errs "github.com/UnicomAI/wanwu/api/proto/err-code"
+	knowledgebase_doc_service "github.com/UnicomAI/wanwu/api/proto/knowledgebase-doc-service"
+	"github.com/UnicomAI/wanwu/internal/knowledge-service/client/model"
+	"github.com/UnicomAI/wanwu/internal/knowledge-service/client/orm"
+	"github.com/UnicomAI/wanwu/internal/knowledge-service/pkg/util"
+	"github.com/UnicomAI/wanwu/internal/knowledge-service/service"
+	doc_segment_file "github.com/UnicomAI/wanwu/internal/knowledge-service/task/doc-segment-file"
+	"github.com/UnicomAI/wanwu/pkg/log"
+	pkg_util "github.com/UnicomAI/wanwu/pkg/util"
+)
+
+// docSegmentExportChunkSize 导出文件每次发送的数据大小
+const docSegmentExportChunkSize = 64 * 1024
+
+// ExportDocSegment 导出文档分段，指定docId时导出单个文档，否则导出知识库下全部处理完成的文档；
+// 开始发送数据后出错只能中断导出
+func (s *Service) ExportDocSegment(req *knowledgebase_doc_service.ExportDocSegmentReq, stream knowledgebase_doc_service.KnowledgeBaseDocService_ExportDocSegmentServer) error {
+	ctx := stream.Context()
+	if err := doc_segment_file.CheckFormat(req.Format); err != nil {
+		return util.ErrCode(errs.Code_KnowledgeDocSegmentExportFailed)
+	}
+	//1.查询知识库及文档
+	knowledge, docList, err := selectExportDocList(ctx, req)
+	if err != nil {
+		return err
+	}
+	//2.逐个文档写入导出文件
+	buf := bufio.NewWriterSize(&docSegmentExportSender{stream: stream}, docSegmentExportChunkSize)
+	writer, err := doc_segment_file.NewWriter(req.Format, buf)
+	if err != nil {
+		log.Errorf("create doc segment export writer err: %v", err)
+		return util.ErrCode(errs.Code_KnowledgeDocSegmentExportFailed)
+	}
+	for _, doc := range docList {
+		if err = exportDocSegment(ctx, writer, knowledge, doc); err != nil {
+			log.Errorf("docId %v export doc segment fail %v", doc.DocId, err)
+			return util.ErrCode(errs.Code_KnowledgeDocSegmentExportFailed)
+		}
+	}
+	if err = writer.Close(); err == nil {
+		err = buf.Flush()
+	}
+	if err != nil {
+		log.Errorf("knowledgeId %v export doc segment flush fail %v", knowledge.KnowledgeId, err)
+		return util.ErrCode(errs.Code_KnowledgeDocSegmentExportFailed)
+	}
+	return nil
+}
+
+// GetDocSegmentImportReport 查询文档分段最近一次批量导入结果
+func (s *Service) GetDocSegmentImportReport(ctx context.Context, req *knowledgebase_doc_service.GetDocSegmentImportReportReq) (*knowledgebase_doc_service.DocSegmentImportReport, error) {
+	if err := orm.CheckDocPermission(ctx, []string{req.DocId}, &req.UserId, &req.OrgId, model.KnowledgePermissionViewer); err != nil {
+		return nil, err
+	}
+	importTask, err := orm.SelectSegmentLatestImportTaskByDocID(ctx, req.DocId)
+	if err != nil {
+		log.Errorf("docId %v select doc segment import task fail %v", req.DocId, err)
+		return nil, util.ErrCode(errs.Code_KnowledgeDocSegmentImportSelectFailed)
+	}
+	return buildDocSegmentImportReport(importTask), nil
+}
+
+// docSegmentExportSender 将导出文件内容按块发送
+type docSegmentExportSender struct {
+	stream knowledgebase_doc_service.KnowledgeBaseDocService_ExportDocSegmentServer
+}
+
+func (d *docSegmentExportSender) Write(p []byte) (int, error) {
+	data := make([]byte, len(p))
+	copy(data, p)
+	if err := d.stream.Send(&knowledgebase_doc_service.ExportDocSegmentResp{Data: data}); err != nil {
+		return 0, err
+	}
+	return len(p), nil
+}
+
+// selectExportDocList 校验查看权限，查询导出的知识库和文档
+func selectExportDocList(ctx context.Context, req *knowledgebase_doc_service.ExportDocSegmentReq) (*model.KnowledgeBase, []*model.KnowledgeDoc, error) {
+	if req.DocId != "" {
+		doc, err := checkDocVersionDoc(ctx, req.DocId, &req.UserId, &req.OrgId, model.KnowledgePermissionViewer)
+		if err != nil {
+			return nil, nil, err
+		}
+		if util.BuildDocRespStatus(doc.Status) != model.DocSuccess {
+			log.Errorf("非处理完成文档无法导出切片 状态(%d) 参数(%v)", doc.Status, req)
+			return nil, nil, util.ErrCode(errs.Code_KnowledgeDocSegmentExportFailed)
+		}
+		knowledge, err := orm.SelectKnowledgeById(ctx, doc.KnowledgeId, req.UserId, req.OrgId)
+		if err != nil {
+			log.Errorf("没有操作该知识库的权限 参数(%v)", req)
+			return nil, nil, err
+		}
+		return knowledge, []*model.KnowledgeDoc{doc}, nil
+	}
+	knowledge, err := orm.CheckKnowledgePermission(ctx, req.KnowledgeId, &req.UserId, &req.OrgId, model.KnowledgePermissionViewer)
+	if err != nil {
+		log.Errorf("没有导出该知识库分段的权限 参数(%v)", req)
+		return nil, nil, err
+	}
+	allDocList, err := orm.GetDocListByKnowledgeId(ctx, "", "", knowledge.KnowledgeId)
+	if err != nil {
+		log.Errorf("knowledgeId %v select doc list fail %v", knowledge.KnowledgeId, err)
+		return nil, nil, util.ErrCode(errs.Code_KnowledgeDocSegmentExportFailed)
+	}
+	var docList []*model.KnowledgeDoc
+	for _, doc := range allDocList {
+		if util.BuildDocRespStatus(doc.Status) == model.DocSuccess {
+			docList = append(docList, doc)
+		}
+	}
+	return knowledge, docList, nil
+}
+
+// exportDocSegment 写入文档全部切片，父子分段同时写入子切片内容
+func exportDocSegment(ctx context.Context, writer doc_segment_file.Writer, knowledge *model.KnowledgeBase, doc *model.KnowledgeDoc) error {
+	segmentConfig, err := selectDocSegmentConfig(ctx, doc)
+	if err != nil {
+		return err
+	}
+	fileName := service.RebuildFileName(doc.DocId, doc.FileType, doc.Name)
+	segmentList, err := service.RagGetAllDocSegmentList(ctx, doc.UserId, knowledge.Name, fileName)
+	if err != nil {
+		return err
+	}
+	for _, segment := range segmentList {
+		status := segment.Status
+		row := &doc_segment_file.Row{
+			DocId:      doc.DocId,
+			DocName:    doc.Name,
+			ContentId:  segment.ContentId,
+			ContentNum: segment.MetaData.ChunkCurrentNum,
+			Content:    segment.Content,
+			Labels:     segment.Labels,
+			Status:     &status,
+		}
+		if segmentConfig.SegmentMethod == model.ParentSegmentMethod {
+			childList, err := service.RagGetAllDocChildSegmentList(ctx, &service.RagGetDocChildSegmentParams{
+				UserId:            doc.UserId,
+				KnowledgeBaseName: knowledge.Name,
+				KnowledgeId:       knowledge.KnowledgeId,
+				FileName:          fileName,
+				ChunkId:           segment.ContentId,
+			})
+			if err != nil {
+				return err
+			}
+			for _, child := range childList {
+				row.Children = append(row.Children, child.Content)
+			}
+		}
+		if err = writer.Write(row); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func buildDocSegmentImportReport(importTask *model.DocSegmentImportTask) *knowledgebase_doc_service.DocSegmentImportReport {
+	report := &knowledgebase_doc_service.DocSegmentImportReport{
+		ImportId:     importTask.ImportId,
+		Status:       int32(importTask.Status),
+		TotalCount:   int32(importTask.TotalCount),
+		SuccessCount: int32(importTask.SuccessCount),
+		ErrorMsg:     importTask.ErrorMsg,
+		CreatedAt:    pkg_util.Time2Str(importTask.CreatedAt),
+	}
+	if importTask.ErrorRows == "" {
+		return report
+	}
+	var rowErrors []*model.DocSegmentImportRowError
+	if err := json.Unmarshal([]byte(importTask.ErrorRows), &rowErrors); err != nil {
+		log.Errorf("importId %v unmarshal error rows fail %v", importTask.ImportId, err)
+		return report
+	}
+	for _, rowError := range rowErrors {
+		report.RowErrorList = append(report.RowErrorList, &knowledgebase_doc_service.DocSegmentImportRowError{
+			Line:      int32(rowError.Line),
+			ContentId: rowError.ContentId,
+			ErrorMsg:  rowError.ErrorMsg,
+		})
+	}
+	return report
+}
@@ -0,0 +1,195 @@
+package knowledge_doc
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	errs "github.com/UnicomAI/wanwu/api/proto/err-code"
+	knowledgebase_doc_service "github.com/UnicomAI/wanwu/api/proto/knowledgebase-doc-service"
+	"github.com/UnicomAI/wanwu/internal/knowledge-service/client/model"
+	"github.com/UnicomAI/wanwu/internal/knowledge-service/client/orm"
+	"github.com/UnicomAI/wanwu/internal/knowledge-service/pkg/util"
+	"github.com/UnicomAI/wanwu/pkg/log"
+	util2 "github.com/UnicomAI/wanwu/pkg/util"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+// SaveDocSync 设置url文档定时同步，需要编辑权限
+func (s *Service) SaveDocSync(ctx context.Context, req *knowledgebase_doc_service.SaveDocSyncReq) (*knowledgebase_doc_service.SaveDocSyncResp, error) {
+	//1.校验权限
+	if _, err := orm.CheckKnowledgePermission(ctx, req.KnowledgeId, &req.UserId, &req.OrgId, model.KnowledgePermissionEditor); err != nil {
+		log.Errorf("没有设置url文档同步的权限 参数(%v)", req)
+		return nil, err
+	}
+	//2.校验同步对象与同步周期
+	if err := checkDocSyncTarget(ctx, req); err != nil {
+		log.Errorf("url文档同步参数错误(%v) 参数(%v)", err, req)
+		return nil, util.ErrCode(errs.Code_KnowledgeDocSyncParamsInvalid)
+	}
+	next, err := util.NextSyncTime(req.CronExpr, int(req.IntervalMinutes), time.Now())
+	if err != nil {
+		log.Errorf("url文档同步周期错误(%v) 参数(%v)", err, req)
+		return nil, util.ErrCode(errs.Code_KnowledgeDocSyncParamsInvalid)
+	}
+	//3.保存同步配置
+	docSync := &model.KnowledgeDocSync{
+		KnowledgeId:     req.KnowledgeId,
+		DocId:           req.DocId,
+		ImportTaskId:    req.ImportTaskId,
+		CronExpr:        req.CronExpr,
+		IntervalMinutes: int(req.IntervalMinutes),
+		Enable:          req.Enable,
+		NextSyncAt:      next.UnixMilli(),
+		UserId:          req.UserId,
+		OrgId:           req.OrgId,
+	}
+	if err = orm.SaveKnowledgeDocSync(ctx, docSync); err != nil {
+		log.Errorf("保存url文档同步失败(%v) 参数(%v)", err, req)
+		return nil, util.ErrCode(errs.Code_KnowledgeDocSyncSaveFailed)
+	}
+	return &knowledgebase_doc_service.SaveDocSyncResp{SyncId: docSync.SyncId}, nil
+}
+
+// DeleteDocSync 删除url文档定时同步，需要编辑权限
+func (s *Service) DeleteDocSync(ctx context.Context, req *knowledgebase_doc_service.DeleteDocSyncReq) (*emptypb.Empty, error) {
+	docSync, err := checkDocSyncPermission(ctx, req.SyncId, &req.UserId, &req.OrgId, model.KnowledgePermissionEditor)
+	if err != nil {
+		return nil, err
+	}
+	if err = orm.DeleteKnowledgeDocSync(ctx, docSync.SyncId); err != nil {
+		log.Errorf("删除url文档同步失败(%v) 参数(%v)", err, req)
+		return nil, util.ErrCode(errs.Code_KnowledgeDocSyncDeleteFailed)
+	}
+	return &emptypb.Empty{}, nil
+}
+
+// GetDocSyncList 查询知识库的url文档同步列表，需要查看权限
+func (s *Service) GetDocSyncList(ctx context.Context, req *knowledgebase_doc_service.GetDocSyncListReq) (*knowledgebase_doc_service.GetDocSyncListResp, error) {
+	if _, err := orm.CheckKnowledgePermission(ctx, req.KnowledgeId, &req.UserId, &req.OrgId, model.KnowledgePermissionViewer); err != nil {
+		log.Errorf("没有查看url文档同步的权限 参数(%v)", req)
+		return nil, err
+	}
+	list, err := orm.SelectKnowledgeDocSyncList(ctx, req.KnowledgeId)
+	if err != nil {
+		log.Errorf("查询url文档同步列表失败(%v) 参数(%v)", err, req)
+		return nil, util.ErrCode(errs.Code_KnowledgeDocSyncSelectFailed)
+	}
+	return buildDocSyncListResp(list), nil
+}
+
+// RunDocSync 立即执行一次url文档同步，需要编辑权限
+func (s *Service) RunDocSync(ctx context.Context, req *knowledgebase_doc_service.RunDocSyncReq) (*emptypb.Empty, error) {
+	docSync, err := checkDocSyncPermission(ctx, req.SyncId, &req.UserId, &req.OrgId, model.KnowledgePermissionEditor)
+	if err != nil {
+		return nil, err
+	}
+	if err = orm.SubmitKnowledgeDocSyncTask(ctx, docSync.SyncId); err != nil {
+		log.Errorf("执行url文档同步失败(%v) 参数(%v)", err, req)
+		return nil, util.ErrCode(errs.Code_KnowledgeDocSyncRunFailed)
+	}
+	return &emptypb.Empty{}, nil
+}
+
+// GetDocSyncHistoryList 分页查询url文档同步记录，需要查看权限
+func (s *Service) GetDocSyncHistoryList(ctx context.Context, req *knowledgebase_doc_service.GetDocSyncHistoryListReq) (*knowledgebase_doc_service.GetDocSyncHistoryListResp, error) {
+	docSync, err := checkDocSyncPermission(ctx, req.SyncId, &req.UserId, &req.OrgId, model.KnowledgePermissionViewer)
+	if err != nil {
+		return nil, err
+	}
+	list, total, err := orm.SelectKnowledgeDocSyncHistoryList(ctx, docSync.SyncId, req.DocId, req.PageSize, req.PageNum)
+	if err != nil {
+		log.Errorf("查询url文档同步记录失败(%v) 参数(%v)", err, req)
+		return nil, util.ErrCode(errs.Code_KnowledgeDocSyncSelectFailed)
+	}
+	return buildDocSyncHistoryListResp(list, total, req.PageNum, req.PageSize), nil
+}
+
+// checkDocSyncPermission 查询同步配置并校验所属知识库权限
+func checkDocSyncPermission(ctx context.Context, syncId string, userId, orgId *string, permissionType int) (*model.KnowledgeDocSync, error) {
+	docSync, err := orm.SelectKnowledgeDocSyncById(ctx, syncId)
+	if err != nil {
+		return nil, err
+	}
+	if _, err = orm.CheckKnowledgePermission(ctx, docSync.KnowledgeId, userId, orgId, permissionType); err != nil {
+		log.Errorf("没有操作url文档同步 %s 的权限", syncId)
+		return nil, err
+	}
+	return docSync, nil
+}
+
+// checkDocSyncTarget 校验同步对象：文档须为该知识库的url文档，导入任务须为该知识库的url导入任务
+func checkDocSyncTarget(ctx context.Context, req *knowledgebase_doc_service.SaveDocSyncReq) error {
+	if (req.DocId == "") == (req.ImportTaskId == "") {
+		return errors.New("docId and importTaskId are mutually exclusive and one is required")
+	}
+	if req.DocId != "" {
+		docList, err := orm.SelectDocByDocIdList(ctx, []string{req.DocId}, "", "")
+		if err != nil {
+			return err
+		}
+		if docList[0].KnowledgeId != req.KnowledgeId || docList[0].FileType != model.UrlFileType {
+			return errors.New("doc is not a url doc of the knowledge")
+		}
+		return nil
+	}
+	importTask, err := orm.SelectKnowledgeImportTaskById(ctx, req.ImportTaskId)
+	if err != nil {
+		return err
+	}
+	if importTask.KnowledgeId != req.KnowledgeId ||
+		(importTask.ImportType != model.UrlImportType && importTask.ImportType != model.UrlFileImportType) {
+		return errors.New("import task is not a url import task of the knowledge")
+	}
+	return nil
+}
+
+func buildDocSyncListResp(list []*model.KnowledgeDocSync) *knowledgebase_doc_service.GetDocSyncListResp {
+	var retList []*knowledgebase_doc_service.DocSyncInfo
+	for _, docSync := range list {
+		retList = append(retList, &knowledgebase_doc_service.DocSyncInfo{
+			SyncId:          docSync.SyncId,
+			KnowledgeId:     docSync.KnowledgeId,
+			DocId:           docSync.DocId,
+			ImportTaskId:    docSync.ImportTaskId,
+			CronExpr:        docSync.CronExpr,
+			IntervalMinutes: int32(docSync.IntervalMinutes),
+			Enable:          docSync.Enable,
+			LastSyncAt:      timeStr(docSync.LastSyncAt),
+			NextSyncAt:      timeStr(docSync.NextSyncAt),
+			CreatedAt:       util2.Time2Str(docSync.CreatedAt),
+		})
+	}
+	return &knowledgebase_doc_service.GetDocSyncListResp{List: retList}
+}
+
+func buildDocSyncHistoryListResp(list []*model.KnowledgeDocSyncHistory, total int64, pageNum, pageSize int32) *knowledgebase_doc_service.GetDocSyncHistoryListResp {
+	var retList []*knowledgebase_doc_service.DocSyncHistoryInfo
+	for _, history := range list {
+		retList = append(retList, &knowledgebase_doc_service.DocSyncHistoryInfo{
+			SyncId:     history.SyncId,
+			DocId:      history.DocId,
+			DocName:    history.DocName,
+			DocUrl:     history.DocUrl,
+			Status:     int32(history.Status),
+			ContentMd5: history.ContentMd5,
+			ErrorMsg:   history.ErrorMsg,
+			StartAt:    util2.Time2Str(history.StartAt),
+			FinishAt:   util2.Time2Str(history.FinishAt),
+		})
+	}
+	return &knowledgebase_doc_service.GetDocSyncHistoryListResp{
+		List:     retList,
+		Total:    total,
+		PageNum:  pageNum,
+		PageSize: pageSize,
+	}
+}
+
+// timeStr 未同步过的时间返回空
+func timeStr(millis int64) string {
+	if millis == 0 {
+		return ""
+	}
+	return util2.Time2Str(millis)
+}
@@ -0,0 +1,551 @@
+package knowledge_doc
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"time"
+
+	errs "github.com/UnicomAI/wanwu/api/proto/err-code"
+	knowledgebase_doc_service "github.com/UnicomAI/wanwu/api/proto/knowledgebase-doc-service"
+	"github.com/UnicomAI/wanwu/internal/knowledge-service/client/model"
+	"github.com/UnicomAI/wanwu/internal/knowledge-service/client/orm"
+	"github.com/UnicomAI/wanwu/internal/knowledge-service/pkg/util"
+	"github.com/UnicomAI/wanwu/internal/knowledge-service/service"
+	import_service "github.com/UnicomAI/wanwu/internal/knowledge-service/task/import-service"
+	"github.com/UnicomAI/wanwu/pkg/log"
+	util2 "github.com/UnicomAI/wanwu/pkg/util"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+// docSegmentVersionMergeWindow 连续编辑切片的版本合并时长，自首次编辑归档起时长内的编辑并入同一版本
+const docSegmentVersionMergeWindow = 10 * time.Minute
+
+const (
+	DocSegmentAdded    = "added"
+	DocSegmentDeleted  = "deleted"
+	DocSegmentModified = "modified"
+)
+
+// UploadDocVersion 上传文档新版本，归档当前版本后沿用原文档id重新导入，需要编辑权限
+func (s *Service) UploadDocVersion(ctx context.Context, req *knowledgebase_doc_service.UploadDocVersionReq) (*emptypb.Empty, error) {
+	//1.查询文档详情
+	doc, err := checkDocVersionDoc(ctx, req.DocId, &req.UserId, &req.OrgId, model.KnowledgePermissionEditor)
+	if err != nil {
+		return nil, err
+	}
+	if util.BuildDocRespStatus(doc.Status) != model.DocSuccess && util.BuildDocRespStatus(doc.Status) != model.DocFail {
+		log.Errorf("处理中的文档无法上传新版本 状态(%d) 参数(%v)", doc.Status, req)
+		return nil, util.ErrCode(errs.Code_KnowledgeDocVersionStatusInvalid)
+	}
+	//2.校验新版本文件，url文档通过同步更新
+	if req.DocInfo == nil || doc.FileType == model.UrlFileType {
+		log.Errorf("文档不支持上传新版本 参数(%v)", req)
+		return nil, util.ErrCode(errs.Code_KnowledgeDocVersionUploadFailed)
+	}
+	file := &model.DocInfo{
+		DocName: req.DocInfo.DocName,
+		DocUrl:  req.DocInfo.DocUrl,
+		DocType: req.DocInfo.DocType,
+		DocSize: req.DocInfo.DocSize,
+	}
+	if err = import_service.CheckDocVersionFile(ctx, doc, file); err != nil {
+		log.Errorf("文档新版本校验失败(%v) 参数(%v)", err, req)
+		return nil, util.ErrCode(errs.Code_KnowledgeDocVersionUploadFailed)
+	}
+	//3.查询知识库详情
+	knowledge, err := orm.SelectKnowledgeById(ctx, doc.KnowledgeId, req.UserId, req.OrgId)
+	if err != nil {
+		log.Errorf("查询知识库详情失败 参数(%v)", req)
+		return nil, err
+	}
+	//4.快照当前版本切片
+	version, err := buildDocVersion(ctx, knowledge, doc)
+	if err != nil {
+		log.Errorf("docId %v snapshot doc version fail %v", req.DocId, err)
+		return nil, util.ErrCode(errs.Code_KnowledgeDocVersionUploadFailed)
+	}
+	//5.保存新版本文件并重新导入
+	filePath, _, _, err := service.CopyFile(ctx, file.DocUrl, "")
+	if err != nil {
+		log.Errorf("docId %v copy doc version file fail %v", req.DocId, err)
+		return nil, util.ErrCode(errs.Code_KnowledgeDocVersionUploadFailed)
+	}
+	err = orm.ReimportKnowledgeDocVersion(ctx, knowledge, doc, version, &model.KnowledgeDoc{
+		FilePath:    filePath,
+		FilePathMd5: util.MD5(filePath),
+		Name:        file.DocName,
+		FileType:    file.DocType,
+		FileSize:    file.DocSize,
+	}, "")
+	if err != nil {
+		log.Errorf("docId %v reimport doc version fail %v", req.DocId, err)
+		return nil, util.ErrCode(errs.Code_KnowledgeDocVersionUploadFailed)
+	}
+	return &emptypb.Empty{}, nil
+}
+
+// GetDocVersionList 查询文档版本列表，需要查看权限
+func (s *Service) GetDocVersionList(ctx context.Context, req *knowledgebase_doc_service.GetDocVersionListReq) (*knowledgebase_doc_service.GetDocVersionListResp, error) {
+	doc, err := checkDocVersionDoc(ctx, req.DocId, &req.UserId, &req.OrgId, model.KnowledgePermissionViewer)
+	if err != nil {
+		return nil, err
+	}
+	knowledge, err := orm.SelectKnowledgeById(ctx, doc.KnowledgeId, req.UserId, req.OrgId)
+	if err != nil {
+		log.Errorf("查询知识库详情失败 参数(%v)", req)
+		return nil, err
+	}
+	versionList, err := orm.SelectKnowledgeDocVersionList(ctx, doc.DocId)
+	if err != nil {
+		log.Errorf("查询文档版本列表失败(%v) 参数(%v)", err, req)
+		return nil, util.ErrCode(errs.Code_KnowledgeDocVersionSelectFailed)
+	}
+	//当前版本切片数查询失败不影响列表展示
+	segmentCount, err := countDocSegment(ctx, knowledge, doc)
+	if err != nil {
+		log.Errorf("docId %v count doc segment fail %v", req.DocId, err)
+	}
+	return buildDocVersionListResp(doc, segmentCount, versionList), nil
+}
+
+// DiffDocVersion 按切片序号对比两个文档版本的切片差异，需要查看权限
+func (s *Service) DiffDocVersion(ctx context.Context, req *knowledgebase_doc_service.DiffDocVersionReq) (*knowledgebase_doc_service.DiffDocVersionResp, error) {
+	doc, err := checkDocVersionDoc(ctx, req.DocId, &req.UserId, &req.OrgId, model.KnowledgePermissionViewer)
+	if err != nil {
+		return nil, err
+	}
+	knowledge, err := orm.SelectKnowledgeById(ctx, doc.KnowledgeId, req.UserId, req.OrgId)
+	if err != nil {
+		log.Errorf("查询知识库详情失败 参数(%v)", req)
+		return nil, err
+	}
+	baseList, err := selectDocVersionSegment(ctx, knowledge, doc, req.BaseVersionId)
+	if err != nil {
+		log.Errorf("docId %v select base version %v segment fail %v", req.DocId, req.BaseVersionId, err)
+		return nil, util.ErrCode(errs.Code_KnowledgeDocVersionDiffFailed)
+	}
+	targetList, err := selectDocVersionSegment(ctx, knowledge, doc, req.TargetVersionId)
+	if err != nil {
+		log.Errorf("docId %v select target version %v segment fail %v", req.DocId, req.TargetVersionId, err)
+		return nil, util.ErrCode(errs.Code_KnowledgeDocVersionDiffFailed)
+	}
+	return diffDocSegment(baseList, targetList), nil
+}
+
+// RollbackDocVersion 回滚文档到历史版本，归档当前版本后重新导入历史版本文件，解析完成后恢复历史版本的切片快照，需要编辑权限
+func (s *Service) RollbackDocVersion(ctx context.Context, req *knowledgebase_doc_service.RollbackDocVersionReq) (*emptypb.Empty, error) {
+	//1.查询文档详情
+	doc, err := checkDocVersionDoc(ctx, req.DocId, &req.UserId, &req.OrgId, model.KnowledgePermissionEditor)
+	if err != nil {
+		return nil, err
+	}
+	if util.BuildDocRespStatus(doc.Status) != model.DocSuccess && util.BuildDocRespStatus(doc.Status) != model.DocFail {
+		log.Errorf("处理中的文档无法回滚 状态(%d) 参数(%v)", doc.Status, req)
+		return nil, util.ErrCode(errs.Code_KnowledgeDocVersionStatusInvalid)
+	}
+	//2.查询历史版本，改名时校验重名
+	target, err := orm.SelectKnowledgeDocVersionById(ctx, doc.DocId, req.VersionId)
+	if err != nil {
+		return nil, err
+	}
+	if target.Name != doc.Name {
+		if err = orm.CheckKnowledgeDocSameName(ctx, doc.UserId, doc.KnowledgeId, target.Name, ""); err != nil {
+			log.Errorf("docId %v rollback version %v same name %v", req.DocId, req.VersionId, err)
+			return nil, util.ErrCode(errs.Code_KnowledgeDocVersionRollbackFailed)
+		}
+	}
+	//3.查询知识库详情
+	knowledge, err := orm.SelectKnowledgeById(ctx, doc.KnowledgeId, req.UserId, req.OrgId)
+	if err != nil {
+		log.Errorf("查询知识库详情失败 参数(%v)", req)
+		return nil, err
+	}
+	//4.快照当前版本切片
+	version, err := buildDocVersion(ctx, knowledge, doc)
+	if err != nil {
+		log.Errorf("docId %v snapshot doc version fail %v", req.DocId, err)
+		return nil, util.ErrCode(errs.Code_KnowledgeDocVersionRollbackFailed)
+	}
+	//5.重新导入历史版本文件
+	err = orm.ReimportKnowledgeDocVersion(ctx, knowledge, doc, version, &model.KnowledgeDoc{
+		FilePath:    target.FilePath,
+		FilePathMd5: target.FilePathMd5,
+		Name:        target.Name,
+		FileType:    target.FileType,
+		FileSize:    target.FileSize,
+	}, target.VersionId)
+	if err != nil {
+		log.Errorf("docId %v rollback doc version %v fail %v", req.DocId, req.VersionId, err)
+		return nil, util.ErrCode(errs.Code_KnowledgeDocVersionRollbackFailed)
+	}
+	return &emptypb.Empty{}, nil
+}
+
+// archiveDocSegmentVersion 编辑切片前归档当前版本；合并时长内的连续编辑不重复快照全部切片
+func archiveDocSegmentVersion(ctx context.Context, knowledge *model.KnowledgeBase, doc *model.KnowledgeDoc) error {
+	latest, err := orm.SelectLatestKnowledgeDocVersion(ctx, doc.DocId)
+	if err != nil {
+		return err
+	}
+	if mergeDocSegmentVersion(latest, doc, time.Now()) {
+		return nil
+	}
+	version, err := buildDocVersion(ctx, knowledge, doc)
+	if err != nil {
+		return err
+	}
+	version.SegmentEdit = true
+	return orm.ArchiveKnowledgeDocVersion(ctx, doc, version)
+}
+
+// mergeDocSegmentVersion 最近的历史版本为编辑切片前归档、之后没有其他归档且仍在合并时长内时，本次编辑并入当前版本
+func mergeDocSegmentVersion(latest *model.KnowledgeDocVersion, doc *model.KnowledgeDoc, now time.Time) bool {
+	if latest == nil || !latest.SegmentEdit || latest.VersionNo != doc.Version-1 {
+		return false
+	}
+	return now.Sub(time.UnixMilli(latest.CreatedAt)) < docSegmentVersionMergeWindow
+}
+
+// restoreDocVersionSegment rag解析结束后，解析成功的回滚文档或知识库导入的文档将切片恢复为版本快照
+func restoreDocVersionSegment(ctx context.Context, docId string, success bool) {
+	docList, err := orm.SelectDocByDocIdList(ctx, []string{docId}, "", "")
+	if err != nil || docList[0].RestoreId == "" {
+		return
+	}
+	doc := docList[0]
+	if success {
+		err = restoreDocSegment(ctx, doc)
+		if err != nil {
+			log.Errorf("docId %v restore version %v segment fail %v", doc.DocId, doc.RestoreId, err)
+		}
+	}
+	//解析或恢复失败时保留rag解析结果，不再重试
+	if err = orm.FinishKnowledgeDocRestore(ctx, doc.DocId, doc.RestoreId); err != nil {
+		log.Errorf("docId %v finish restore version %v fail %v", doc.DocId, doc.RestoreId, err)
+	}
+}
+
+// restoreDocSegment 按切片序号对比快照与当前切片：修改内容不同的切片，删除多余切片，补充缺少的切片，最后同步启用状态、标签和子切片
+func restoreDocSegment(ctx context.Context, doc *model.KnowledgeDoc) error {
+	version, err := orm.SelectKnowledgeDocVersionById(ctx, doc.DocId, doc.RestoreId)
+	if err != nil {
+		return err
+	}
+	snapshotList, err := unmarshalDocSegmentSnapshot(version.SegmentSnapshot)
+	if err != nil {
+		return err
+	}
+	knowledge, err := orm.SelectKnowledgeById(ctx, doc.KnowledgeId, "", "")
+	if err != nil {
+		return err
+	}
+	segmentConfig, err := selectDocSegmentConfig(ctx, doc)
+	if err != nil {
+		return err
+	}
+	fileName := service.RebuildFileName(doc.DocId, doc.FileType, doc.Name)
+	currentList, err := selectDocSegmentList(ctx, knowledge, doc)
+	if err != nil {
+		return err
+	}
+	//1.修改内容不同的切片
+	for i := 0; i < len(currentList) && i < len(snapshotList); i++ {
+		if currentList[i].Content == snapshotList[i].Content {
+			continue
+		}
+		err = service.RagUpdateDocSegment(ctx, &service.RagUpdateDocSegmentParams{
+			UserId:          doc.UserId,
+			KnowledgeBase:   knowledge.Name,
+			KnowledgeId:     knowledge.KnowledgeId,
+			FileName:        fileName,
+			MaxSentenceSize: segmentConfig.MaxSplitter,
+			Chunk: &service.UpdateChunkItem{
+				ChunkId: currentList[i].ContentId,
+				Content: snapshotList[i].Content,
+				Labels:  buildSnapshotLabels(snapshotList[i].Labels),
+			},
+			SplitType:        service.RebuildSplitType(segmentConfig.SegmentMethod),
+			ChildChunkConfig: service.RebuildChildChunkConfig(segmentConfig.SegmentMethod, segmentConfig.SubMaxSplitter, segmentConfig.SubSplitter),
+		})
+		if err != nil {
+			return err
+		}
+	}
+	//2.删除多余切片
+	if len(currentList) > len(snapshotList) {
+		var chunkIds []string
+		for _, segment := range currentList[len(snapshotList):] {
+			chunkIds = append(chunkIds, segment.ContentId)
+		}
+		err = service.RagDeleteDocSegment(ctx, &service.RagDeleteDocSegmentParams{
+			UserId:        doc.UserId,
+			KnowledgeBase: knowledge.Name,
+			KnowledgeId:   knowledge.KnowledgeId,
+			FileName:      fileName,
+			ChunkIds:      chunkIds,
+		})
+		if err != nil {
+			return err
+		}
+	}
+	//3.补充缺少的切片，新增切片追加在末尾
+	if len(snapshotList) > len(currentList) {
+		var chunks []*service.NewChunkItem
+		for _, snapshot := range snapshotList[len(currentList):] {
+			chunks = append(chunks, &service.NewChunkItem{
+				Content: snapshot.Content,
+				Labels:  buildSnapshotLabels(snapshot.Labels),
+			})
+		}
+		err = service.RagCreateDocSegment(ctx, &service.RagCreateDocSegmentParams{
+			UserId:           doc.UserId,
+			KnowledgeBase:    knowledge.Name,
+			KnowledgeId:      knowledge.KnowledgeId,
+			FileName:         fileName,
+			MaxSentenceSize:  segmentConfig.MaxSplitter,
+			Chunks:           chunks,
+			SplitType:        service.RebuildSplitType(segmentConfig.SegmentMethod),
+			ChildChunkConfig: service.RebuildChildChunkConfig(segmentConfig.SegmentMethod, segmentConfig.SubMaxSplitter, segmentConfig.SubSplitter),
+		})
+		if err != nil {
+			return err
+		}
+	}
+	//4.同步启用状态和标签
+	currentList, err = selectDocSegmentList(ctx, knowledge, doc)
+	if err != nil {
+		return err
+	}
+	for i := 0; i < len(currentList) && i < len(snapshotList); i++ {
+		current, snapshot := currentList[i], snapshotList[i]
+		if current.Status != snapshot.Status {
+			err = service.RagDocUpdateDocSegmentStatus(ctx, &service.DocSegmentStatusUpdateParams{
+				UserId:        doc.UserId,
+				KnowledgeName: knowledge.Name,
+				FileName:      fileName,
+				ContentId:     current.ContentId,
+				Status:        snapshot.Status,
+			})
+			if err != nil {
+				return err
+			}
+		}
+		if !equalLabels(current.Labels, snapshot.Labels) {
+			err = service.RagDocSegmentLabels(ctx, &service.RagDocSegmentLabelsParams{
+				UserId:        doc.UserId,
+				KnowledgeBase: knowledge.Name,
+				KnowledgeId:   knowledge.KnowledgeId,
+				FileName:      fileName,
+				ContentId:     current.ContentId,
+				Labels:        buildSnapshotLabels(snapshot.Labels),
+			})
+			if err != nil {
+				return err
+			}
+		}
+		//5.父子分段同步子切片，快照未记录子切片时保留rag切分结果
+		if snapshot.Children != nil {
+			if err = restoreDocChildSegment(ctx, knowledge, doc, fileName, current, snapshot.Children); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// restoreDocChildSegment 按子切片序号对比快照与当前子切片并同步
+func restoreDocChildSegment(ctx context.Context, knowledge *model.KnowledgeBase, doc *model.KnowledgeDoc, fileName string, parent service.FileSplitContent, children []string) error {
+	return service.RagSyncDocChildSegment(ctx, doc.UserId, knowledge.Name, knowledge.KnowledgeId, fileName, parent, children)
+}
+
+// checkDocVersionDoc 校验文档权限并查询文档详情，userId、orgId替换为文档所属用户
+func checkDocVersionDoc(ctx context.Context, docId string, userId, orgId *string, permissionType int) (*model.KnowledgeDoc, error) {
+	if err := orm.CheckDocPermission(ctx, []string{docId}, userId, orgId, permissionType); err != nil {
+		return nil, err
+	}
+	docList, err := orm.SelectDocByDocIdList(ctx, []string{docId}, *userId, *orgId)
+	if err != nil {
+		log.Errorf("没有操作该知识库文档的权限 docId(%v)", docId)
+		return nil, err
+	}
+	return docList[0], nil
+}
+
+// buildDocVersion 构造当前版本的历史版本记录，非处理完成文档没有切片
+func buildDocVersion(ctx context.Context, knowledge *model.KnowledgeBase, doc *model.KnowledgeDoc) (*model.KnowledgeDocVersion, error) {
+	var snapshotList = make([]*model.DocSegmentSnapshot, 0)
+	if util.BuildDocRespStatus(doc.Status) == model.DocSuccess {
+		segmentList, err := selectDocSegmentList(ctx, knowledge, doc)
+		if err != nil {
+			return nil, err
+		}
+		snapshotList = buildDocSegmentSnapshot(segmentList)
+	}
+	snapshot, err := json.Marshal(snapshotList)
+	if err != nil {
+		return nil, err
+	}
+	return &model.KnowledgeDocVersion{
+		SegmentCount:    len(snapshotList),
+		SegmentSnapshot: string(snapshot),
+		UserId:          doc.UserId,
+		OrgId:           doc.OrgId,
+	}, nil
+}
+
+// selectDocVersionSegment 查询版本切片，versionId为空时查询当前生效版本
+func selectDocVersionSegment(ctx context.Context, knowledge *model.KnowledgeBase, doc *model.KnowledgeDoc, versionId string) ([]*model.DocSegmentSnapshot, error) {
+	if versionId == "" {
+		if util.BuildDocRespStatus(doc.Status) != model.DocSuccess {
+			return nil, nil
+		}
+		segmentList, err := selectDocSegmentList(ctx, knowledge, doc)
+		if err != nil {
+			return nil, err
+		}
+		return buildDocSegmentSnapshot(segmentList), nil
+	}
+	version, err := orm.SelectKnowledgeDocVersionById(ctx, doc.DocId, versionId)
+	if err != nil {
+		return nil, err
+	}
+	return unmarshalDocSegmentSnapshot(version.SegmentSnapshot)
+}
+
+// selectDocSegmentList 分页查询rag中文档的全部切片
+func selectDocSegmentList(ctx context.Context, knowledge *model.KnowledgeBase, doc *model.KnowledgeDoc) ([]service.FileSplitContent, error) {
+	return service.RagGetAllDocSegmentList(ctx, doc.UserId, knowledge.Name, service.RebuildFileName(doc.DocId, doc.FileType, doc.Name))
+}
+
+// countDocSegment 查询当前生效版本的切片数量
+func countDocSegment(ctx context.Context, knowledge *model.KnowledgeBase, doc *model.KnowledgeDoc) (int, error) {
+	if util.BuildDocRespStatus(doc.Status) != model.DocSuccess {
+		return 0, nil
+	}
+	resp, err := service.RagGetDocSegmentList(ctx, &service.RagGetDocSegmentParams{
+		UserId:            doc.UserId,
+		KnowledgeBaseName: knowledge.Name,
+		FileName:          service.RebuildFileName(doc.DocId, doc.FileType, doc.Name),
+		PageSize:          1,
+	})
+	if errors.Is(err, service.ErrDocSegmentEmpty) {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, err
+	}
+	return resp.ChunkTotalNum, nil
+}
+
+// selectDocSegmentConfig 查询文档导入时的分段配置
+func selectDocSegmentConfig(ctx context.Context, doc *model.KnowledgeDoc) (*model.SegmentConfig, error) {
+	importTask, err := orm.SelectKnowledgeImportTaskById(ctx, doc.ImportTaskId)
+	if err != nil {
+		return nil, err
+	}
+	var segmentConfig = &model.SegmentConfig{}
+	err = json.Unmarshal([]byte(importTask.SegmentConfig), segmentConfig)
+	if err != nil {
+		return nil, err
+	}
+	return segmentConfig, nil
+}
+
+func buildDocSegmentSnapshot(segmentList []service.FileSplitContent) []*model.DocSegmentSnapshot {
+	var retList = make([]*model.DocSegmentSnapshot, 0, len(segmentList))
+	for _, segment := range segmentList {
+		retList = append(retList, &model.DocSegmentSnapshot{
+			ContentNum: segment.MetaData.ChunkCurrentNum,
+			Content:    segment.Content,
+			Status:     segment.Status,
+			Labels:     segment.Labels,
+		})
+	}
+	return retList
+}
+
+func unmarshalDocSegmentSnapshot(snapshot string) ([]*model.DocSegmentSnapshot, error) {
+	var retList []*model.DocSegmentSnapshot
+	if snapshot == "" {
+		return retList, nil
+	}
+	err := json.Unmarshal([]byte(snapshot), &retList)
+	if err != nil {
+		return nil, err
+	}
+	return retList, nil
+}
+
+func buildSnapshotLabels(labels []string) []string {
+	if len(labels) == 0 {
+		return make([]string, 0)
+	}
+	return labels
+}
+
+func equalLabels(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// diffDocSegment 按切片位置对比两个版本的切片
+func diffDocSegment(baseList, targetList []*model.DocSegmentSnapshot) *knowledgebase_doc_service.DiffDocVersionResp {
+	resp := &knowledgebase_doc_service.DiffDocVersionResp{
+		BaseSegmentCount:   int32(len(baseList)),
+		TargetSegmentCount: int32(len(targetList)),
+	}
+	for i := 0; i < len(baseList) || i < len(targetList); i++ {
+		var diff = &knowledgebase_doc_service.DocSegmentDiff{ContentNum: int32(i + 1)}
+		switch {
+		case i >= len(baseList):
+			diff.ChangeType = DocSegmentAdded
+			diff.TargetContent = targetList[i].Content
+			resp.AddedCount++
+		case i >= len(targetList):
+			diff.ChangeType = DocSegmentDeleted
+			diff.BaseContent = baseList[i].Content
+			resp.DeletedCount++
+		case baseList[i].Content != targetList[i].Content:
+			diff.ChangeType = DocSegmentModified
+			diff.BaseContent = baseList[i].Content
+			diff.TargetContent = targetList[i].Content
+			resp.ModifiedCount++
+		default:
+			continue
+		}
+		resp.List = append(resp.List, diff)
+	}
+	return resp
+}
+
+func buildDocVersionListResp(doc *model.KnowledgeDoc, segmentCount int, versionList []*model.KnowledgeDocVersion) *knowledgebase_doc_service.GetDocVersionListResp {
+	var retList = []*knowledgebase_doc_service.DocVersionInfo{{
+		VersionNo:    int32(doc.Version),
+		DocName:      doc.Name,
+		DocType:      doc.FileType,
+		DocSize:      doc.FileSize,
+		SegmentCount: int32(segmentCount),
+		Active:       true,
+		CreatedAt:    util2.Time2Str(doc.UpdatedAt),
+	}}
+	for _, version := range versionList {
+		retList = append(retList, &knowledgebase_doc_service.DocVersionInfo{
+			VersionId:    version.VersionId,
+			VersionNo:    int32(version.VersionNo),
+			DocName:      version.Name,
+			DocType:      version.FileType,
+			DocSize:      version.FileSize,
+			SegmentCount: int32(version.SegmentCount),
+			CreatedAt:    util2.Time2Str(version.CreatedAt),
+		})
+	}
+	return &knowledgebase_doc_service.GetDocVersionListResp{List: retList}
+}
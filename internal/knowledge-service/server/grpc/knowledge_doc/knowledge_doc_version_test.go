@@ -0,0 +1,64 @@
+package knowledge_doc
+
+import (
+	"testing"
+	"time"
+
+	"github.com/UnicomAI/wanwu/internal/knowledge-service/client/model"
+)
+
+func TestDiffDocSegment(t *testing.T) {
+	baseList := []*model.DocSegmentSnapshot{
+		{ContentNum: 1, Content: "a"},
+		{ContentNum: 2, Content: "b"},
+		{ContentNum: 3, Content: "c"},
+	}
+	targetList := []*model.DocSegmentSnapshot{
+		{ContentNum: 1, Content: "a"},
+		{ContentNum: 2, Content: "b2"},
+	}
+	resp := diffDocSegment(baseList, targetList)
+	if resp.BaseSegmentCount != 3 || resp.TargetSegmentCount != 2 {
+		t.Fatalf("unexpected segment count %+v", resp)
+	}
+	if resp.ModifiedCount != 1 || resp.DeletedCount != 1 || resp.AddedCount != 0 || len(resp.List) != 2 {
+		t.Fatalf("unexpected diff %+v", resp)
+	}
+	if resp.List[0].ContentNum != 2 || resp.List[0].ChangeType != DocSegmentModified || resp.List[0].TargetContent != "b2" {
+		t.Fatalf("unexpected modified segment %+v", resp.List[0])
+	}
+	if resp.List[1].ContentNum != 3 || resp.List[1].ChangeType != DocSegmentDeleted || resp.List[1].BaseContent != "c" {
+		t.Fatalf("unexpected deleted segment %+v", resp.List[1])
+	}
+
+	// 反向对比为新增
+	resp = diffDocSegment(targetList, baseList)
+	if resp.AddedCount != 1 || resp.List[1].ChangeType != DocSegmentAdded || resp.List[1].TargetContent != "c" {
+		t.Fatalf("unexpected reverse diff %+v", resp)
+	}
+}
+
+func TestMergeDocSegmentVersion(t *testing.T) {
+	now := time.UnixMilli(1_700_000_000_000)
+	doc := &model.KnowledgeDoc{Version: 3}
+	latest := &model.KnowledgeDocVersion{VersionNo: 2, SegmentEdit: true, CreatedAt: now.Add(-time.Minute).UnixMilli()}
+	if !mergeDocSegmentVersion(latest, doc, now) {
+		t.Fatal("expected edit within window to merge")
+	}
+	if mergeDocSegmentVersion(nil, doc, now) {
+		t.Fatal("expected first edit to archive")
+	}
+	if mergeDocSegmentVersion(latest, doc, now.Add(docSegmentVersionMergeWindow)) {
+		t.Fatal("expected edit after window to archive")
+	}
+	// 上传新版本或回滚归档的版本不合并
+	upload := *latest
+	upload.SegmentEdit = false
+	if mergeDocSegmentVersion(&upload, doc, now) {
+		t.Fatal("expected edit after upload to archive")
+	}
+	// 之后有其他归档时不合并
+	if mergeDocSegmentVersion(latest, &model.KnowledgeDoc{Version: 4}, now) {
+		t.Fatal("expected edit after another archive to archive")
+	}
+}
@@ -13,6 +13,16 @@ import (
 	"time"
 )
 
+// docSegmentPageSize 分页查询文档全部切片时的每页数量
+const docSegmentPageSize = 100
+
+var (
+	// ErrDocSegmentEmpty 文档切片为空
+	ErrDocSegmentEmpty = errors.New("doc segment response is empty")
+	// ErrDocChildSegmentEmpty 文档子切片为空
+	ErrDocChildSegmentEmpty = errors.New("doc child segment response is empty")
+)
+
 type RagCreateDocSegmentParams struct {
 	UserId           string            `json:"userId"`             // 发起请求的用户ID
 	KnowledgeBase    string            `json:"knowledgeBase"`      // 知识库的名称
@@ -325,7 +335,7 @@ func RagGetDocSegmentList(ctx context.Context, ragGetDocSegmentParams *RagGetDoc
 		return nil, errors.New(resp.Message)
 	}
 	if resp.Data == nil || len(resp.Data.List) == 0 {
-		return nil, errors.New("doc segment response is empty")
+		return nil, ErrDocSegmentEmpty
 	}
 	// 排序
 	slices.SortFunc(resp.Data.List, func(a, b FileSplitContent) int {
@@ -334,6 +344,106 @@ func RagGetDocSegmentList(ctx context.Context, ragGetDocSegmentParams *RagGetDoc
 	return resp.Data, nil
 }
 
+// RagGetAllDocSegmentList 分页查询文档的全部切片，切片为空时返回空列表
+func RagGetAllDocSegmentList(ctx context.Context, userId, knowledgeName, fileName string) ([]FileSplitContent, error) {
+	var retList []FileSplitContent
+	for {
+		resp, err := RagGetDocSegmentList(ctx, &RagGetDocSegmentParams{
+			UserId:            userId,
+			KnowledgeBaseName: knowledgeName,
+			FileName:          fileName,
+			PageSize:          docSegmentPageSize,
+			SearchAfter:       int32(len(retList)),
+		})
+		if errors.Is(err, ErrDocSegmentEmpty) {
+			return retList, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		retList = append(retList, resp.List...)
+		if len(resp.List) < docSegmentPageSize || len(retList) >= resp.ChunkTotalNum {
+			return retList, nil
+		}
+	}
+}
+
+// RagGetAllDocChildSegmentList 查询父切片的全部子切片，子切片为空时返回空列表
+func RagGetAllDocChildSegmentList(ctx context.Context, ragGetDocChildSegmentParams *RagGetDocChildSegmentParams) ([]ChildFileSplitContent, error) {
+	resp, err := RagGetDocChildSegmentList(ctx, ragGetDocChildSegmentParams)
+	if errors.Is(err, ErrDocChildSegmentEmpty) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return resp.ChildContentList, nil
+}
+
+// RagSyncDocChildSegment 按子切片序号对比目标内容与当前子切片：修改内容不同的子切片，删除多余子切片，补充缺少的子切片
+func RagSyncDocChildSegment(ctx context.Context, userId, knowledgeName, knowledgeId, fileName string, parent FileSplitContent, children []string) error {
+	currentList, err := RagGetAllDocChildSegmentList(ctx, &RagGetDocChildSegmentParams{
+		UserId:            userId,
+		KnowledgeBaseName: knowledgeName,
+		KnowledgeId:       knowledgeId,
+		FileName:          fileName,
+		ChunkId:           parent.ContentId,
+	})
+	if err != nil {
+		return err
+	}
+	parentNo := int32(parent.MetaData.ChunkCurrentNum)
+	for i := 0; i < len(currentList) && i < len(children); i++ {
+		if currentList[i].Content == children[i] {
+			continue
+		}
+		err = RagUpdateDocChildSegment(ctx, &RagUpdateDocChildSegmentParams{
+			UserId:          userId,
+			KnowledgeBase:   knowledgeName,
+			KnowledgeId:     knowledgeId,
+			FileName:        fileName,
+			ChunkId:         parent.ContentId,
+			ChunkCurrentNum: parentNo,
+			ChildChunk: &ChildChunk{
+				ChildContent: children[i],
+				ChildChunkNo: int32(currentList[i].MetaData.ChildChunkCurrentNum),
+			},
+		})
+		if err != nil {
+			return err
+		}
+	}
+	if len(currentList) > len(children) {
+		var childNos []int32
+		for _, child := range currentList[len(children):] {
+			childNos = append(childNos, int32(child.MetaData.ChildChunkCurrentNum))
+		}
+		err = RagDeleteDocChildSegment(ctx, &RagDeleteDocChildSegmentParams{
+			UserId:                userId,
+			KnowledgeBase:         knowledgeName,
+			KnowledgeId:           knowledgeId,
+			FileName:              fileName,
+			ChunkId:               parent.ContentId,
+			ChunkCurrentNum:       parentNo,
+			ChildChunkCurrentNums: childNos,
+		})
+		if err != nil {
+			return err
+		}
+	}
+	if len(children) > len(currentList) {
+		return RagCreateDocChildSegment(ctx, &RagCreateDocChildSegmentParams{
+			UserId:        userId,
+			KnowledgeBase: knowledgeName,
+			KnowledgeId:   knowledgeId,
+			FileName:      fileName,
+			ChunkId:       parent.ContentId,
+			ChildContents: children[len(currentList):],
+		})
+	}
+	return nil
+}
+
 // RagCreateDocChildSegment 新增文档子切片
 func RagCreateDocChildSegment(ctx context.Context, ragCreateDocChildSegmentParams *RagCreateDocChildSegmentParams) error {
 	ragServer := config.GetConfig().RagServer
@@ -447,7 +557,7 @@ func RagGetDocChildSegmentList(ctx context.Context, ragGetDocChildSegmentParams
 		return nil, errors.New(resp.Message)
 	}
 	if resp.Data == nil || len(resp.Data.ChildContentList) == 0 {
-		return nil, errors.New("doc child segment response is empty")
+		return nil, ErrDocChildSegmentEmpty
 	}
 	// 按排序
 	slices.SortFunc(resp.Data.ChildContentList, func(a, b ChildFileSplitContent) int {
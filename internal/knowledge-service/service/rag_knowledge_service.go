@@ -100,6 +100,7 @@ type ChunkSearchList struct {
 	Title            string          `json:"title"`
 	Snippet          string          `json:"snippet"`
 	KbName           string          `json:"kb_name"`
+	ContentId        string          `json:"content_id"`
 	MetaData         interface{}     `json:"meta_data"`
 	ChildContentList []*ChildContent `json:"child_content_list"`
 	ChildScore       []float64       `json:"child_score"`
@@ -0,0 +1,101 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"crypto/md5"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/UnicomAI/wanwu/internal/knowledge-service/pkg/config"
+	local_http "github.com/UnicomAI/wanwu/internal/knowledge-service/pkg/http"
+	"golang.org/x/net/html"
+)
+
+const (
+	urlContentFetchTimeout   = 60 * time.Second
+	urlContentSizeLimitBytes = 200 * 1024 * 1024 // 未配置文件大小限制时的默认上限
+)
+
+// FetchUrlContentMd5 获取url内容并对提取后的正文文本计算md5，用于url文档同步时判断内容是否变化；非2xx响应、内容超过大小限制视为获取失败
+func FetchUrlContentMd5(ctx context.Context, url string) (string, error) {
+	ctx, cancel := context.WithTimeout(ctx, urlContentFetchTimeout)
+	defer cancel()
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return "", err
+	}
+	resp, err := local_http.GetClient().Client.Do(req)
+	if err != nil {
+		return "", err
+	}
+	defer func() { _ = resp.Body.Close() }()
+	if resp.StatusCode < http.StatusOK || resp.StatusCode >= http.StatusMultipleChoices {
+		return "", fmt.Errorf("fetch url %s status %d", url, resp.StatusCode)
+	}
+	limit := urlContentSizeLimit()
+	body, err := io.ReadAll(io.LimitReader(resp.Body, limit+1))
+	if err != nil {
+		return "", err
+	}
+	if int64(len(body)) > limit {
+		return "", fmt.Errorf("fetch url %s content size exceeds limit %d", url, limit)
+	}
+	text := string(body)
+	if strings.Contains(resp.Header.Get("Content-Type"), "html") {
+		text = extractHtmlText(body)
+	}
+	sum := md5.Sum([]byte(strings.Join(strings.Fields(text), " ")))
+	return hex.EncodeToString(sum[:]), nil
+}
+
+// urlContentSizeLimit url内容大小上限，与url导入的html文件大小限制一致，未配置时取最大文件大小限制
+func urlContentSizeLimit() int64 {
+	if limitConfig := config.GetConfig().UsageLimit; limitConfig != nil {
+		for _, limit := range []int64{limitConfig.HtmlSizeLimit, limitConfig.MaxFileSize} {
+			if limit > 0 {
+				return limit
+			}
+		}
+	}
+	return urlContentSizeLimitBytes
+}
+
+// extractHtmlText 提取html正文文本，忽略脚本、样式等不影响解析结果的内容，避免动态token等引起误判
+func extractHtmlText(body []byte) string {
+	var sb strings.Builder
+	var skip int
+	tokenizer := html.NewTokenizer(bytes.NewReader(body))
+	for {
+		switch tokenizer.Next() {
+		case html.ErrorToken:
+			return sb.String()
+		case html.StartTagToken:
+			if isIgnoredHtmlTag(tokenizer) {
+				skip++
+			}
+		case html.EndTagToken:
+			if isIgnoredHtmlTag(tokenizer) && skip > 0 {
+				skip--
+			}
+		case html.TextToken:
+			if skip == 0 {
+				sb.Write(tokenizer.Text())
+				sb.WriteByte(' ')
+			}
+		}
+	}
+}
+
+func isIgnoredHtmlTag(tokenizer *html.Tokenizer) bool {
+	name, _ := tokenizer.TagName()
+	switch string(name) {
+	case "script", "style", "noscript", "template":
+		return true
+	}
+	return false
+}
@@ -0,0 +1,64 @@
+package connector
+
+import (
+	"context"
+	"io"
+	"time"
+
+	"github.com/UnicomAI/wanwu/internal/knowledge-service/client/model"
+)
+
+// RemoteObject 远端文件
+type RemoteObject struct {
+	Path   string // 相对于根目录的路径，以/分隔
+	Name   string // 文件名
+	Size   int64  // 文件大小，未知为-1
+	Cursor string // 该文件对应的增量游标，用于单次导入数量截断
+	Locate string // 数据源内部定位，如对象key、资源地址；为空时使用Path
+}
+
+// Source 一次执行中打开的数据源
+type Source interface {
+	// List 列出游标之后新增或修改的文件，返回新游标
+	List(ctx context.Context, cursor string) ([]*RemoteObject, string, error)
+	// Open 读取文件内容
+	Open(ctx context.Context, object *RemoteObject) (io.ReadCloser, error)
+	// Close 释放数据源，如删除临时目录
+	Close() error
+}
+
+// Connector 连接器，按类型注册
+type Connector interface {
+	ConnectorType() string
+	CheckParams(params *model.ConnectorParams) error
+	NewSource(ctx context.Context, params *model.ConnectorParams, credential *model.ConnectorCredential) (Source, error)
+}
+
+// timeCursorLayout 以文件修改时间作为游标的数据源使用，定长UTC格式，字符串顺序与时间顺序一致
+const timeCursorLayout = "2006-01-02T15:04:05.000000000Z"
+
+func parseTimeCursor(cursor string) time.Time {
+	if cursor == "" {
+		return time.Time{}
+	}
+	t, err := time.Parse(timeCursorLayout, cursor)
+	if err != nil {
+		return time.Time{}
+	}
+	return t
+}
+
+func formatTimeCursor(t time.Time) string {
+	if t.IsZero() {
+		return ""
+	}
+	return t.UTC().Format(timeCursorLayout)
+}
+
+// maxTimeCursor 返回较新的游标
+func maxTimeCursor(cursor string, t time.Time) string {
+	if t.After(parseTimeCursor(cursor)) {
+		return formatTimeCursor(t)
+	}
+	return cursor
+}
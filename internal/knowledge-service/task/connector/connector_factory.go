@@ -0,0 +1,289 @@
+package connector
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/UnicomAI/wanwu/internal/knowledge-service/client/model"
+	"github.com/UnicomAI/wanwu/internal/knowledge-service/client/orm"
+	"github.com/UnicomAI/wanwu/internal/knowledge-service/pkg/config"
+	"github.com/UnicomAI/wanwu/internal/knowledge-service/pkg/generator"
+	"github.com/UnicomAI/wanwu/internal/knowledge-service/service"
+	"github.com/UnicomAI/wanwu/pkg/log"
+	"github.com/UnicomAI/wanwu/pkg/util"
+)
+
+var connectorMap = make(map[string]Connector)
+
+// ErrConnectorDisabled 未配置连接器密钥时连接器不可用，不影响知识库其他功能
+var ErrConnectorDisabled = errors.New("connector secret key not configured, please set env CONNECTOR_SECRET_KEY")
+
+// Enabled 是否已配置连接器密钥，未配置时不允许创建与执行连接器
+func Enabled() bool {
+	return config.GetConfig().Connector != nil && config.GetConfig().Connector.SecretKey != ""
+}
+
+func AddConnector(connector Connector) {
+	connectorMap[connector.ConnectorType()] = connector
+}
+
+// CheckConnectorParams 校验连接器类型与配置
+func CheckConnectorParams(connectorType string, params *model.ConnectorParams) error {
+	connector, ok := connectorMap[connectorType]
+	if !ok {
+		return fmt.Errorf("connector type %s not support", connectorType)
+	}
+	for _, filter := range params.PathFilter {
+		if _, err := path.Match(filter, ""); err != nil {
+			return fmt.Errorf("invalid path filter %s", filter)
+		}
+	}
+	return connector.CheckParams(params)
+}
+
+// DoConnectorImport 执行连接器增量导入：枚举游标之后新增或修改的远端文件，上传minio后创建文件导入任务，并推进游标
+func DoConnectorImport(ctx context.Context, connector *model.KnowledgeConnector) (err error) {
+	defer util.PrintPanicStackWithCall(func(panicOccur bool, err2 error) {
+		if panicOccur {
+			log.Errorf("do connector import panic: %v", err2)
+			err = errors.New("连接器导入异常")
+		}
+		if err != nil {
+			if err1 := orm.UpdateKnowledgeConnectorFail(ctx, connector, err.Error()); err1 != nil {
+				log.Errorf("update connector %s fail status err: %v", connector.ConnectorId, err1)
+			}
+		}
+	})
+	//1.解析配置与凭证
+	if !Enabled() {
+		return ErrConnectorDisabled
+	}
+	impl, ok := connectorMap[connector.ConnectorType]
+	if !ok {
+		return fmt.Errorf("connector type %s not support", connector.ConnectorType)
+	}
+	var params = &model.ConnectorParams{}
+	if err = json.Unmarshal([]byte(connector.Params), params); err != nil {
+		return err
+	}
+	credential, err := DecryptCredential(connector.Credential)
+	if err != nil {
+		return err
+	}
+	//2.枚举远端文件
+	source, err := impl.NewSource(ctx, params, credential)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err1 := source.Close(); err1 != nil {
+			log.Errorf("connector %s close source err: %v", connector.ConnectorId, err1)
+		}
+	}()
+	objectList, cursor, err := source.List(ctx, connector.Cursor)
+	if err != nil {
+		return err
+	}
+	objectList, cursor = limitObjectList(filterObjectList(objectList, params.PathFilter), cursor)
+	//3.上传minio
+	var docList []*model.DocInfo
+	for _, object := range objectList {
+		docInfo, err := uploadObject(ctx, source, object)
+		if err != nil {
+			return fmt.Errorf("upload %s err: %v", object.Path, err)
+		}
+		docList = append(docList, docInfo)
+	}
+	//4.创建导入任务并推进游标
+	var importTask *model.KnowledgeImportTask
+	if len(docList) > 0 {
+		if importTask, err = buildImportTask(connector, docList); err != nil {
+			return err
+		}
+	}
+	log.Infof("connector %s import %d docs, cursor %s -> %s", connector.ConnectorId, len(docList), connector.Cursor, cursor)
+	return orm.CreateKnowledgeConnectorImportTask(ctx, connector, importTask, cursor)
+}
+
+// EncryptCredential 加密连接器凭证
+func EncryptCredential(credential *model.ConnectorCredential) (string, error) {
+	key, err := credentialKey()
+	if err != nil {
+		return "", err
+	}
+	plain, err := json.Marshal(credential)
+	if err != nil {
+		return "", err
+	}
+	cipherText, err := util.EncryptAESGCM(plain, key)
+	if err != nil {
+		return "", err
+	}
+	return base64.StdEncoding.EncodeToString(cipherText), nil
+}
+
+// DecryptCredential 解密连接器凭证，未设置凭证时返回空凭证
+func DecryptCredential(credential string) (*model.ConnectorCredential, error) {
+	var ret = &model.ConnectorCredential{}
+	if credential == "" {
+		return ret, nil
+	}
+	key, err := credentialKey()
+	if err != nil {
+		return nil, err
+	}
+	cipherText, err := base64.StdEncoding.DecodeString(credential)
+	if err != nil {
+		return nil, err
+	}
+	plain, err := util.DecryptAESGCM(cipherText, key)
+	if err != nil {
+		return nil, err
+	}
+	if err = json.Unmarshal(plain, ret); err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+// credentialKey 由配置的密钥派生32字节AES密钥
+func credentialKey() ([]byte, error) {
+	if !Enabled() {
+		return nil, ErrConnectorDisabled
+	}
+	key, err := hex.DecodeString(util.SHA256(config.GetConfig().Connector.SecretKey))
+	if err != nil {
+		return nil, err
+	}
+	return key, nil
+}
+
+// filterObjectList 按路径过滤规则与支持的文件类型过滤
+func filterObjectList(objectList []*RemoteObject, pathFilter []string) []*RemoteObject {
+	fileTypeMap := buildFileTypeMap()
+	var retList []*RemoteObject
+	for _, object := range objectList {
+		if !fileTypeMap[docType(object.Name)] || !MatchPathFilter(object.Path, pathFilter) {
+			continue
+		}
+		retList = append(retList, object)
+	}
+	return retList
+}
+
+// limitObjectList 单次导入数量限制：按游标排序截取，同一游标的对象不拆分，游标推进到最后一个导入对象
+func limitObjectList(objectList []*RemoteObject, cursor string) ([]*RemoteObject, string) {
+	limit := 0
+	if config.GetConfig().Connector != nil {
+		limit = config.GetConfig().Connector.MaxObjectsPerRun
+	}
+	if limit <= 0 || len(objectList) <= limit {
+		return objectList, cursor
+	}
+	sort.SliceStable(objectList, func(i, j int) bool {
+		return objectList[i].Cursor < objectList[j].Cursor
+	})
+	end := limit
+	for end < len(objectList) && objectList[end].Cursor == objectList[limit-1].Cursor {
+		end++
+	}
+	if end == len(objectList) {
+		return objectList, cursor
+	}
+	return objectList[:end], objectList[end-1].Cursor
+}
+
+// MatchPathFilter 路径是否匹配过滤规则：规则为glob，匹配完整相对路径或文件名；以/**结尾匹配目录下所有文件；规则为空匹配全部
+func MatchPathFilter(objectPath string, pathFilter []string) bool {
+	if len(pathFilter) == 0 {
+		return true
+	}
+	for _, filter := range pathFilter {
+		if strings.HasSuffix(filter, "/**") && strings.HasPrefix(objectPath, strings.TrimSuffix(filter, "**")) {
+			return true
+		}
+		if ok, _ := path.Match(filter, objectPath); ok {
+			return true
+		}
+		if ok, _ := path.Match(filter, path.Base(objectPath)); ok {
+			return true
+		}
+	}
+	return false
+}
+
+func uploadObject(ctx context.Context, source Source, object *RemoteObject) (*model.DocInfo, error) {
+	reader, err := source.Open(ctx, object)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		_ = reader.Close()
+	}()
+	fileType := docType(object.Name)
+	fileName := generator.GetGenerator().NewID() + fileType
+	minioFilePath, size, err := service.UploadFile(ctx, config.GetConfig().Minio.KnowledgeDir, fileName, reader, object.Size)
+	if err != nil {
+		return nil, err
+	}
+	return &model.DocInfo{
+		DocName: object.Name,
+		DocUrl:  minioFilePath,
+		DocType: fileType,
+		DocSize: size,
+	}, nil
+}
+
+func buildImportTask(connector *model.KnowledgeConnector, docList []*model.DocInfo) (*model.KnowledgeImportTask, error) {
+	docImportInfo, err := json.Marshal(&model.DocImportInfo{
+		DocInfoList: docList,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &model.KnowledgeImportTask{
+		ImportId:      generator.GetGenerator().NewID(),
+		KnowledgeId:   connector.KnowledgeId,
+		ImportType:    model.FileImportType,
+		SegmentConfig: connector.SegmentConfig,
+		DocAnalyzer:   connector.DocAnalyzer,
+		CreatedAt:     time.Now().UnixMilli(),
+		UpdatedAt:     time.Now().UnixMilli(),
+		DocInfo:       string(docImportInfo),
+		OcrModelId:    connector.OcrModelId,
+		DocPreProcess: connector.DocPreProcess,
+		UserId:        connector.UserId,
+		OrgId:         connector.OrgId,
+	}, nil
+}
+
+func buildFileTypeMap() map[string]bool {
+	var fileTypeMap = make(map[string]bool)
+	usageLimit := config.GetConfig().UsageLimit
+	if usageLimit == nil {
+		return fileTypeMap
+	}
+	for _, fileType := range strings.Split(usageLimit.FileTypes+";"+usageLimit.CompressedFileType, ";") {
+		if fileType != "" {
+			fileTypeMap[fileType] = true
+		}
+	}
+	return fileTypeMap
+}
+
+// docType 文件类型，.tar.gz 作为整体
+func docType(name string) string {
+	if strings.HasSuffix(name, ".tar.gz") {
+		return ".tar.gz"
+	}
+	return filepath.Ext(name)
+}
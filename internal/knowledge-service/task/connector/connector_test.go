@@ -0,0 +1,139 @@
+package connector
+
+import (
+	"context"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/UnicomAI/wanwu/internal/knowledge-service/client/model"
+	"github.com/UnicomAI/wanwu/internal/knowledge-service/pkg/config"
+)
+
+func TestMatchPathFilter(t *testing.T) {
+	for _, c := range []struct {
+		path   string
+		filter []string
+		match  bool
+	}{
+		{"a/b/c.pdf", nil, true},
+		{"a/b/c.pdf", []string{"*.pdf"}, true},
+		{"a/b/c.pdf", []string{"*.docx"}, false},
+		{"a/b/c.pdf", []string{"a/**"}, true},
+		{"a/b/c.pdf", []string{"b/**"}, false},
+		{"a/b/c.pdf", []string{"a/*/c.pdf"}, true},
+	} {
+		if got := MatchPathFilter(c.path, c.filter); got != c.match {
+			t.Errorf("MatchPathFilter(%s, %v) = %v, want %v", c.path, c.filter, got, c.match)
+		}
+	}
+}
+
+func TestLimitObjectList(t *testing.T) {
+	config.GetConfig().Connector = &config.ConnectorConfig{MaxObjectsPerRun: 2}
+	defer func() { config.GetConfig().Connector = nil }()
+
+	objectList := []*RemoteObject{{Path: "c", Cursor: "3"}, {Path: "a", Cursor: "1"}, {Path: "b1", Cursor: "2"}, {Path: "b2", Cursor: "2"}}
+	list, cursor := limitObjectList(objectList, "3")
+	if len(list) != 3 || cursor != "2" {
+		t.Fatalf("limitObjectList got %d objects cursor %s, want 3 objects cursor 2", len(list), cursor)
+	}
+}
+
+func TestParseWebdavMultiStatus(t *testing.T) {
+	body := `<?xml version="1.0" encoding="utf-8"?>
+<d:multistatus xmlns:d="DAV:">
+  <d:response><d:href>/dav/docs/</d:href><d:propstat><d:prop><d:resourcetype><d:collection/></d:resourcetype></d:prop><d:status>HTTP/1.1 200 OK</d:status></d:propstat></d:response>
+  <d:response><d:href>/dav/docs/%E6%89%8B%E5%86%8C.pdf</d:href><d:propstat><d:prop><d:resourcetype/><d:getcontentlength>1024</d:getcontentlength><d:getlastmodified>Mon, 12 Jan 2026 09:25:56 GMT</d:getlastmodified></d:prop><d:status>HTTP/1.1 200 OK</d:status></d:propstat></d:response>
+</d:multistatus>`
+	entryList, err := parseWebdavMultiStatus(strings.NewReader(body))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(entryList) != 2 || !entryList[0].IsDir {
+		t.Fatalf("unexpected entries %+v", entryList)
+	}
+	file := entryList[1]
+	if file.IsDir || file.Path != "/dav/docs/手册.pdf" || file.Size != 1024 || file.ModTime.Year() != 2026 {
+		t.Fatalf("unexpected file entry %+v", file)
+	}
+}
+
+func TestLocalDirSource(t *testing.T) {
+	root := t.TempDir()
+	config.GetConfig().Connector = &config.ConnectorConfig{LocalRootDirs: root}
+	defer func() { config.GetConfig().Connector = nil }()
+
+	if _, err := resolveLocalRootDir(filepath.Join(root, "..")); err == nil {
+		t.Fatal("expect dir outside local-root-dirs rejected")
+	}
+	writeFile(t, filepath.Join(root, "a", "old.txt"), time.Now().Add(-time.Hour))
+	writeFile(t, filepath.Join(root, "new.txt"), time.Now())
+
+	source, err := (&LocalDirConnector{}).NewSource(context.Background(), &model.ConnectorParams{RootPath: root}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	list, cursor, err := source.List(context.Background(), "")
+	if err != nil || len(list) != 2 {
+		t.Fatalf("first list got %d objects err %v", len(list), err)
+	}
+	list, _, err = source.List(context.Background(), formatTimeCursor(time.Now().Add(-time.Minute)))
+	if err != nil || len(list) != 1 || list[0].Path != "new.txt" {
+		t.Fatalf("incremental list got %+v err %v", list, err)
+	}
+	list, _, err = source.List(context.Background(), cursor)
+	if err != nil || len(list) != 0 {
+		t.Fatalf("list after cursor got %d objects err %v", len(list), err)
+	}
+}
+
+func TestGitSource(t *testing.T) {
+	if _, err := exec.LookPath("git"); err != nil {
+		t.Skip("git not installed")
+	}
+	repo := t.TempDir()
+	s := &gitSource{dir: repo, rootPath: "docs"}
+	ctx := context.Background()
+	mustGit := func(args ...string) {
+		if _, err := s.git(ctx, repo, args...); err != nil {
+			t.Fatal(err)
+		}
+	}
+	mustGit("init", "--quiet")
+	mustGit("config", "user.email", "test@example.com")
+	mustGit("config", "user.name", "test")
+	writeFile(t, filepath.Join(repo, "docs", "a.md"), time.Now())
+	writeFile(t, filepath.Join(repo, "README.md"), time.Now())
+	mustGit("add", "-A")
+	mustGit("commit", "--quiet", "-m", "init")
+
+	list, cursor, err := s.List(ctx, "")
+	if err != nil || len(list) != 1 || list[0].Path != "a.md" {
+		t.Fatalf("first list got %+v err %v", list, err)
+	}
+	writeFile(t, filepath.Join(repo, "docs", "b.md"), time.Now())
+	mustGit("add", "-A")
+	mustGit("commit", "--quiet", "-m", "add b")
+
+	list, _, err = s.List(ctx, cursor)
+	if err != nil || len(list) != 1 || list[0].Path != "b.md" {
+		t.Fatalf("incremental list got %+v err %v", list, err)
+	}
+}
+
+func writeFile(t *testing.T, filePath string, modTime time.Time) {
+	t.Helper()
+	if err := os.MkdirAll(filepath.Dir(filePath), 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filePath, []byte(filePath), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.Chtimes(filePath, modTime, modTime); err != nil {
+		t.Fatal(err)
+	}
+}
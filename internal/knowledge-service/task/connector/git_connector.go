@@ -0,0 +1,166 @@
+package connector
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/url"
+	"os"
+	"os/exec"
+	"path"
+	"path/filepath"
+	"strings"
+
+	"github.com/UnicomAI/wanwu/internal/knowledge-service/client/model"
+	"github.com/UnicomAI/wanwu/internal/knowledge-service/pkg/config"
+)
+
+// GitConnector git仓库，使用git命令克隆到临时目录，rootPath为仓库内目录，游标为上次导入的commit
+type GitConnector struct{}
+
+func init() {
+	AddConnector(&GitConnector{})
+}
+
+func (c *GitConnector) ConnectorType() string {
+	return model.ConnectorTypeGit
+}
+
+func (c *GitConnector) CheckParams(params *model.ConnectorParams) error {
+	repoUrl, err := url.Parse(params.RepoUrl)
+	if err != nil {
+		return err
+	}
+	//仅支持http(s)，避免通过file://等协议读取服务端本地仓库
+	if repoUrl.Scheme != "http" && repoUrl.Scheme != "https" {
+		return errors.New("repoUrl scheme must be http or https")
+	}
+	if repoUrl.User != nil {
+		return errors.New("credential should not be in repoUrl")
+	}
+	if strings.HasPrefix(params.Branch, "-") {
+		return errors.New("invalid branch")
+	}
+	return nil
+}
+
+func (c *GitConnector) NewSource(ctx context.Context, params *model.ConnectorParams, credential *model.ConnectorCredential) (Source, error) {
+	if err := c.CheckParams(params); err != nil {
+		return nil, err
+	}
+	repoUrl, _ := url.Parse(params.RepoUrl)
+	if credential.Password != "" {
+		username := credential.Username
+		if username == "" {
+			username = "oauth2"
+		}
+		repoUrl.User = url.UserPassword(username, credential.Password)
+	}
+	baseDir := "static/temp"
+	if docConfig := config.GetConfig().KnowledgeDocConfig; docConfig != nil && docConfig.DocLocalFilePath != "" {
+		baseDir = docConfig.DocLocalFilePath
+	}
+	if err := os.MkdirAll(baseDir, 0755); err != nil {
+		return nil, err
+	}
+	dir, err := os.MkdirTemp(baseDir, "connector-git-")
+	if err != nil {
+		return nil, err
+	}
+	source := &gitSource{dir: dir, rootPath: strings.Trim(params.RootPath, "/"), secret: credential.Password}
+	args := []string{"clone", "--single-branch", "--quiet"}
+	if params.Branch != "" {
+		args = append(args, "--branch", params.Branch)
+	}
+	args = append(args, "--", repoUrl.String(), dir)
+	if _, err = source.git(ctx, "", args...); err != nil {
+		_ = source.Close()
+		return nil, err
+	}
+	return source, nil
+}
+
+type gitSource struct {
+	dir      string
+	rootPath string
+	secret   string
+}
+
+func (s *gitSource) List(ctx context.Context, cursor string) ([]*RemoteObject, string, error) {
+	head, err := s.git(ctx, s.dir, "rev-parse", "HEAD")
+	if err != nil {
+		return nil, "", err
+	}
+	head = strings.TrimSpace(head)
+	if head == cursor {
+		return nil, cursor, nil
+	}
+	var output string
+	if s.commitExists(ctx, cursor) {
+		output, err = s.git(ctx, s.dir, "diff", "--name-only", "-z", "--no-renames", "--diff-filter=AM", cursor, head)
+	} else {
+		//首次导入或游标对应的commit已不存在（强制推送）时全量导入
+		output, err = s.git(ctx, s.dir, "ls-files", "-z")
+	}
+	if err != nil {
+		return nil, "", err
+	}
+	var objectList []*RemoteObject
+	for _, filePath := range strings.Split(output, "\x00") {
+		if filePath == "" {
+			continue
+		}
+		if s.rootPath != "" && !strings.HasPrefix(filePath, s.rootPath+"/") {
+			continue
+		}
+		info, err := os.Lstat(filepath.Join(s.dir, filepath.FromSlash(filePath)))
+		if err != nil || !info.Mode().IsRegular() {
+			continue
+		}
+		objectList = append(objectList, &RemoteObject{
+			Path:   strings.TrimPrefix(strings.TrimPrefix(filePath, s.rootPath), "/"),
+			Name:   path.Base(filePath),
+			Size:   info.Size(),
+			Cursor: head,
+			Locate: filePath,
+		})
+	}
+	return objectList, head, nil
+}
+
+func (s *gitSource) Open(ctx context.Context, object *RemoteObject) (io.ReadCloser, error) {
+	return os.Open(filepath.Join(s.dir, filepath.FromSlash(object.Locate)))
+}
+
+func (s *gitSource) Close() error {
+	return os.RemoveAll(s.dir)
+}
+
+func (s *gitSource) commitExists(ctx context.Context, commit string) bool {
+	if commit == "" {
+		return false
+	}
+	_, err := s.git(ctx, s.dir, "cat-file", "-e", commit+"^{commit}")
+	return err == nil
+}
+
+// git 执行git命令，错误信息中去除凭证
+func (s *gitSource) git(ctx context.Context, dir string, args ...string) (string, error) {
+	cmd := exec.CommandContext(ctx, "git", args...)
+	cmd.Dir = dir
+	cmd.Env = append(os.Environ(), "GIT_TERMINAL_PROMPT=0")
+	var stdout, stderr bytes.Buffer
+	cmd.Stdout, cmd.Stderr = &stdout, &stderr
+	if err := cmd.Run(); err != nil {
+		msg := strings.TrimSpace(stderr.String())
+		if s.secret != "" {
+			msg = strings.ReplaceAll(msg, s.secret, "***")
+			//url中的凭证为转义后的形式
+			msg = strings.ReplaceAll(msg, strings.TrimPrefix(url.UserPassword("x", s.secret).String(), "x:"), "***")
+		}
+		return "", fmt.Errorf("git %s: %v %s", args[0], err, msg)
+	}
+	return stdout.String(), nil
+}
@@ -0,0 +1,114 @@
+package connector
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/UnicomAI/wanwu/internal/knowledge-service/client/model"
+	"github.com/UnicomAI/wanwu/internal/knowledge-service/pkg/config"
+)
+
+// LocalDirConnector 服务端挂载目录，目录须位于配置的 local-root-dirs 之下，游标为文件修改时间
+type LocalDirConnector struct{}
+
+func init() {
+	AddConnector(&LocalDirConnector{})
+}
+
+func (c *LocalDirConnector) ConnectorType() string {
+	return model.ConnectorTypeLocal
+}
+
+func (c *LocalDirConnector) CheckParams(params *model.ConnectorParams) error {
+	_, err := resolveLocalRootDir(params.RootPath)
+	return err
+}
+
+func (c *LocalDirConnector) NewSource(ctx context.Context, params *model.ConnectorParams, credential *model.ConnectorCredential) (Source, error) {
+	rootDir, err := resolveLocalRootDir(params.RootPath)
+	if err != nil {
+		return nil, err
+	}
+	return &localDirSource{rootDir: rootDir}, nil
+}
+
+type localDirSource struct {
+	rootDir string
+}
+
+func (s *localDirSource) List(ctx context.Context, cursor string) ([]*RemoteObject, string, error) {
+	var objectList []*RemoteObject
+	since, newCursor := parseTimeCursor(cursor), cursor
+	err := filepath.Walk(s.rootDir, func(filePath string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		if !info.Mode().IsRegular() || !info.ModTime().After(since) {
+			return nil
+		}
+		relPath, err := filepath.Rel(s.rootDir, filePath)
+		if err != nil {
+			return err
+		}
+		objectList = append(objectList, &RemoteObject{
+			Path:   filepath.ToSlash(relPath),
+			Name:   info.Name(),
+			Size:   info.Size(),
+			Cursor: formatTimeCursor(info.ModTime()),
+		})
+		newCursor = maxTimeCursor(newCursor, info.ModTime())
+		return nil
+	})
+	if err != nil {
+		return nil, "", err
+	}
+	return objectList, newCursor, nil
+}
+
+func (s *localDirSource) Open(ctx context.Context, object *RemoteObject) (io.ReadCloser, error) {
+	return os.Open(filepath.Join(s.rootDir, filepath.FromSlash(object.Path)))
+}
+
+func (s *localDirSource) Close() error {
+	return nil
+}
+
+// resolveLocalRootDir 校验目录位于允许的挂载目录之下，避免读取服务端任意目录
+func resolveLocalRootDir(rootPath string) (string, error) {
+	if rootPath == "" {
+		return "", errors.New("rootPath is empty")
+	}
+	dir, err := filepath.Abs(rootPath)
+	if err != nil {
+		return "", err
+	}
+	if dir, err = filepath.EvalSymlinks(dir); err != nil {
+		return "", err
+	}
+	if config.GetConfig().Connector != nil {
+		for _, allowDir := range strings.Split(config.GetConfig().Connector.LocalRootDirs, ";") {
+			if allowDir == "" {
+				continue
+			}
+			allow, err := filepath.Abs(allowDir)
+			if err != nil {
+				continue
+			}
+			if allow, err = filepath.EvalSymlinks(allow); err != nil {
+				continue
+			}
+			if dir == allow || strings.HasPrefix(dir, allow+string(filepath.Separator)) {
+				return dir, nil
+			}
+		}
+	}
+	return "", fmt.Errorf("rootPath %s is not under local-root-dirs", rootPath)
+}
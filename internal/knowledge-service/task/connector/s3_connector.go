@@ -0,0 +1,81 @@
+package connector
+
+import (
+	"context"
+	"errors"
+	"io"
+	"path"
+	"strings"
+
+	"github.com/UnicomAI/wanwu/internal/knowledge-service/client/model"
+	"github.com/minio/minio-go/v7"
+	"github.com/minio/minio-go/v7/pkg/credentials"
+)
+
+// S3Connector S3兼容对象存储，rootPath为对象前缀，游标为对象修改时间
+type S3Connector struct{}
+
+func init() {
+	AddConnector(&S3Connector{})
+}
+
+func (c *S3Connector) ConnectorType() string {
+	return model.ConnectorTypeS3
+}
+
+func (c *S3Connector) CheckParams(params *model.ConnectorParams) error {
+	if params.Endpoint == "" || params.Bucket == "" {
+		return errors.New("endpoint and bucket are required")
+	}
+	if strings.Contains(params.Endpoint, "://") {
+		return errors.New("endpoint should be host:port without scheme, use useSsl for https")
+	}
+	return nil
+}
+
+func (c *S3Connector) NewSource(ctx context.Context, params *model.ConnectorParams, credential *model.ConnectorCredential) (Source, error) {
+	client, err := minio.New(params.Endpoint, &minio.Options{
+		Creds:  credentials.NewStaticV4(credential.AccessKey, credential.SecretKey, ""),
+		Secure: params.UseSsl,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &s3Source{client: client, bucket: params.Bucket, prefix: params.RootPath}, nil
+}
+
+type s3Source struct {
+	client *minio.Client
+	bucket string
+	prefix string
+}
+
+func (s *s3Source) List(ctx context.Context, cursor string) ([]*RemoteObject, string, error) {
+	var objectList []*RemoteObject
+	since, newCursor := parseTimeCursor(cursor), cursor
+	for object := range s.client.ListObjects(ctx, s.bucket, minio.ListObjectsOptions{Prefix: s.prefix, Recursive: true}) {
+		if object.Err != nil {
+			return nil, "", object.Err
+		}
+		if strings.HasSuffix(object.Key, "/") || !object.LastModified.After(since) {
+			continue
+		}
+		objectList = append(objectList, &RemoteObject{
+			Path:   strings.TrimPrefix(strings.TrimPrefix(object.Key, s.prefix), "/"),
+			Name:   path.Base(object.Key),
+			Size:   object.Size,
+			Cursor: formatTimeCursor(object.LastModified),
+			Locate: object.Key,
+		})
+		newCursor = maxTimeCursor(newCursor, object.LastModified)
+	}
+	return objectList, newCursor, nil
+}
+
+func (s *s3Source) Open(ctx context.Context, object *RemoteObject) (io.ReadCloser, error) {
+	return s.client.GetObject(ctx, s.bucket, object.Locate, minio.GetObjectOptions{})
+}
+
+func (s *s3Source) Close() error {
+	return nil
+}
@@ -0,0 +1,218 @@
+package connector
+
+import (
+	"context"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"path"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/UnicomAI/wanwu/internal/knowledge-service/client/model"
+)
+
+const (
+	webdavRequestTimeout = 60 * time.Second
+	webdavMaxDirCount    = 10000 //单次遍历的最大目录数
+)
+
+const webdavPropfindBody = `<?xml version="1.0" encoding="utf-8"?>
+<d:propfind xmlns:d="DAV:"><d:prop><d:resourcetype/><d:getcontentlength/><d:getlastmodified/></d:prop></d:propfind>`
+
+// WebDAVConnector WebDAV目录，逐级PROPFIND（Depth: 1）遍历，游标为文件修改时间
+type WebDAVConnector struct{}
+
+func init() {
+	AddConnector(&WebDAVConnector{})
+}
+
+func (c *WebDAVConnector) ConnectorType() string {
+	return model.ConnectorTypeWebDAV
+}
+
+func (c *WebDAVConnector) CheckParams(params *model.ConnectorParams) error {
+	endpoint, err := url.Parse(params.Endpoint)
+	if err != nil {
+		return err
+	}
+	if endpoint.Scheme != "http" && endpoint.Scheme != "https" {
+		return errors.New("endpoint scheme must be http or https")
+	}
+	if endpoint.User != nil {
+		return errors.New("credential should not be in endpoint")
+	}
+	return nil
+}
+
+func (c *WebDAVConnector) NewSource(ctx context.Context, params *model.ConnectorParams, credential *model.ConnectorCredential) (Source, error) {
+	endpoint, err := url.Parse(params.Endpoint)
+	if err != nil {
+		return nil, err
+	}
+	root := *endpoint
+	root.Path = strings.TrimSuffix(path.Join("/", endpoint.Path, params.RootPath), "/") + "/"
+	return &webdavSource{
+		root:       &root,
+		credential: credential,
+		client:     &http.Client{Timeout: webdavRequestTimeout},
+	}, nil
+}
+
+type webdavSource struct {
+	root       *url.URL
+	credential *model.ConnectorCredential
+	client     *http.Client
+}
+
+type webdavMultiStatus struct {
+	Responses []webdavResponse `xml:"DAV: response"`
+}
+
+type webdavResponse struct {
+	Href     string           `xml:"DAV: href"`
+	PropStat []webdavPropStat `xml:"DAV: propstat"`
+}
+
+type webdavPropStat struct {
+	Status string     `xml:"DAV: status"`
+	Prop   webdavProp `xml:"DAV: prop"`
+}
+
+type webdavProp struct {
+	ResourceType  webdavResourceType `xml:"DAV: resourcetype"`
+	ContentLength string             `xml:"DAV: getcontentlength"`
+	LastModified  string             `xml:"DAV: getlastmodified"`
+}
+
+type webdavResourceType struct {
+	Collection *struct{} `xml:"DAV: collection"`
+}
+
+// webdavEntry PROPFIND解析后的资源
+type webdavEntry struct {
+	Path    string // 已解码的绝对路径
+	IsDir   bool
+	Size    int64
+	ModTime time.Time
+}
+
+func (s *webdavSource) List(ctx context.Context, cursor string) ([]*RemoteObject, string, error) {
+	var objectList []*RemoteObject
+	since, newCursor := parseTimeCursor(cursor), cursor
+	dirs := []string{s.root.Path}
+	for visited := 0; len(dirs) > 0; visited++ {
+		if visited >= webdavMaxDirCount {
+			return nil, "", fmt.Errorf("webdav directory count exceeds %d", webdavMaxDirCount)
+		}
+		dir := dirs[0]
+		dirs = dirs[1:]
+		entryList, err := s.propfind(ctx, dir)
+		if err != nil {
+			return nil, "", err
+		}
+		for _, entry := range entryList {
+			if strings.TrimSuffix(entry.Path, "/") == strings.TrimSuffix(dir, "/") {
+				continue
+			}
+			if entry.IsDir {
+				dirs = append(dirs, strings.TrimSuffix(entry.Path, "/")+"/")
+				continue
+			}
+			if !entry.ModTime.After(since) {
+				continue
+			}
+			objectList = append(objectList, &RemoteObject{
+				Path:   strings.TrimPrefix(entry.Path, s.root.Path),
+				Name:   path.Base(entry.Path),
+				Size:   entry.Size,
+				Cursor: formatTimeCursor(entry.ModTime),
+				Locate: entry.Path,
+			})
+			newCursor = maxTimeCursor(newCursor, entry.ModTime)
+		}
+	}
+	return objectList, newCursor, nil
+}
+
+func (s *webdavSource) Open(ctx context.Context, object *RemoteObject) (io.ReadCloser, error) {
+	resp, err := s.do(ctx, http.MethodGet, object.Locate, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode != http.StatusOK {
+		_ = resp.Body.Close()
+		return nil, fmt.Errorf("webdav get %s status %d", object.Locate, resp.StatusCode)
+	}
+	return resp.Body, nil
+}
+
+func (s *webdavSource) Close() error {
+	return nil
+}
+
+func (s *webdavSource) propfind(ctx context.Context, dir string) ([]*webdavEntry, error) {
+	resp, err := s.do(ctx, "PROPFIND", dir, strings.NewReader(webdavPropfindBody), map[string]string{
+		"Depth":        "1",
+		"Content-Type": "application/xml; charset=utf-8",
+	})
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = resp.Body.Close() }()
+	if resp.StatusCode != http.StatusMultiStatus {
+		return nil, fmt.Errorf("webdav propfind %s status %d", dir, resp.StatusCode)
+	}
+	return parseWebdavMultiStatus(resp.Body)
+}
+
+func (s *webdavSource) do(ctx context.Context, method, filePath string, body io.Reader, header map[string]string) (*http.Response, error) {
+	target := *s.root
+	target.Path = filePath
+	req, err := http.NewRequestWithContext(ctx, method, target.String(), body)
+	if err != nil {
+		return nil, err
+	}
+	for k, v := range header {
+		req.Header.Set(k, v)
+	}
+	if s.credential.Username != "" || s.credential.Password != "" {
+		req.SetBasicAuth(s.credential.Username, s.credential.Password)
+	}
+	return s.client.Do(req)
+}
+
+// parseWebdavMultiStatus 解析PROPFIND响应，href统一为解码后的路径
+func parseWebdavMultiStatus(reader io.Reader) ([]*webdavEntry, error) {
+	var multiStatus webdavMultiStatus
+	if err := xml.NewDecoder(reader).Decode(&multiStatus); err != nil {
+		return nil, err
+	}
+	var entryList []*webdavEntry
+	for _, resp := range multiStatus.Responses {
+		href, err := url.Parse(resp.Href)
+		if err != nil {
+			return nil, err
+		}
+		entry := &webdavEntry{Path: href.Path, Size: -1}
+		for _, propStat := range resp.PropStat {
+			if propStat.Status != "" && !strings.Contains(propStat.Status, " 200 ") {
+				continue
+			}
+			prop := propStat.Prop
+			entry.IsDir = entry.IsDir || prop.ResourceType.Collection != nil
+			if size, err := strconv.ParseInt(strings.TrimSpace(prop.ContentLength), 10, 64); err == nil {
+				entry.Size = size
+			}
+			if modTime, err := http.ParseTime(strings.TrimSpace(prop.LastModified)); err == nil {
+				entry.ModTime = modTime
+			}
+		}
+		entryList = append(entryList, entry)
+	}
+	return entryList, nil
+}
@@ -0,0 +1,125 @@
+package task
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"sync"
+
+	"github.com/UnicomAI/wanwu/internal/knowledge-service/client/orm"
+	async_task_pkg "github.com/UnicomAI/wanwu/internal/knowledge-service/pkg/async-task"
+	"github.com/UnicomAI/wanwu/internal/knowledge-service/task/connector"
+	"github.com/UnicomAI/wanwu/pkg/log"
+	"github.com/UnicomAI/wanwu/pkg/util"
+	async "github.com/gromitlee/go-async"
+	"github.com/gromitlee/go-async/pkg/async/async_task"
+)
+
+var connectorImportTask = &ConnectorImportTask{Del: true}
+
+type ConnectorImportTask struct {
+	Wg  sync.WaitGroup
+	Del bool // 是否需要自动清理
+}
+
+func init() {
+	async_task_pkg.AddContainer(connectorImportTask)
+}
+
+func (t *ConnectorImportTask) BuildServiceType() uint32 {
+	return async_task_pkg.ConnectorImportTaskType
+}
+
+func (t *ConnectorImportTask) InitTask() error {
+	if err := async.RegisterTask(t.BuildServiceType(), func() async_task.ITask {
+		return connectorImportTask
+	}); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (t *ConnectorImportTask) SubmitTask(ctx context.Context, params interface{}) (err error) {
+	if params == nil {
+		return errors.New("参数不能为空")
+	}
+	paramStr, err := json.Marshal(params)
+	if err != nil {
+		return err
+	}
+	var taskId uint32
+	taskId, err = async.CreateTask(ctx, "", "ConnectorImportTask", t.BuildServiceType(), string(paramStr), true)
+	log.Infof("connector import task %d ", taskId)
+	return err
+}
+
+func (t *ConnectorImportTask) Running(ctx context.Context, taskCtx string, stop <-chan struct{}) <-chan async_task.IReport {
+	reportCh := make(chan async_task.IReport)
+	t.Wg.Add(1)
+	go func() {
+		defer util.PrintPanicStack()
+		defer t.Wg.Wait()
+		defer t.Wg.Done()
+		defer close(reportCh)
+
+		r := &report{phase: async_task.RunPhaseNormal, del: t.Del, ctx: taskCtx}
+		defer func() {
+			reportCh <- r.clone()
+		}()
+
+		//执行连接器增量导入
+		systemStop, err := t.runStep(ctx, taskCtx, stop)
+		if systemStop {
+			log.Infof("system stop")
+			return
+		}
+		if err != nil {
+			log.Errorf("executeConnectorImportTask err: %s", err)
+			r.phase = async_task.RunPhaseFailed
+			return
+		} else {
+			r.phase = async_task.RunPhaseFinished
+			return
+		}
+	}()
+
+	return reportCh
+}
+
+func (t *ConnectorImportTask) Deleting(ctx context.Context, taskCtx string, stop <-chan struct{}) <-chan async_task.IReport {
+	return CommonDeleting(ctx, taskCtx, stop, &t.Wg)
+}
+
+func (t *ConnectorImportTask) runStep(ctx context.Context, taskCtx string, stop <-chan struct{}) (bool, error) {
+	ret := make(chan Result, 1)
+	go func() {
+		defer util.PrintPanicStack()
+		defer close(ret)
+		ret <- importConnector(ctx, taskCtx)
+	}()
+	for {
+		select {
+		case <-ctx.Done():
+			return false, nil
+		case <-stop:
+			return true, nil
+		case result := <-ret:
+			return false, result.Error
+		}
+	}
+}
+
+func importConnector(ctx context.Context, taskCtx string) Result {
+	var params = &async_task_pkg.ConnectorImportTaskParams{}
+	err := json.Unmarshal([]byte(taskCtx), params)
+	if err != nil {
+		log.Errorf("unmarshal json err: %s", err)
+		return Result{Error: err}
+	}
+	knowledgeConnector, err := orm.SelectKnowledgeConnectorById(ctx, params.ConnectorId)
+	if err != nil {
+		//连接器已删除
+		return Result{}
+	}
+	return Result{Error: connector.DoConnectorImport(ctx, knowledgeConnector)}
+}
@@ -0,0 +1,140 @@
+package doc_segment_file
+
+import (
+	"encoding/json"
+	"fmt"
+	"path/filepath"
+	"strconv"
+	"strings"
+)
+
+// 文档分段文件格式
+const (
+	FormatCsv   = "csv"
+	FormatJsonl = "jsonl"
+	FormatXlsx  = "xlsx"
+)
+
+// csv和xlsx的表头，jsonl使用Row的json字段名
+const (
+	columnDocId      = "文档id"
+	columnDocName    = "文档名称"
+	columnContentId  = "分段id"
+	columnContentNum = "分段序号"
+	columnContent    = "分段内容"
+	columnLabels     = "关键词"
+	columnStatus     = "启用状态"
+	columnChildren   = "子分段"
+)
+
+var header = []string{columnDocId, columnDocName, columnContentId, columnContentNum, columnContent, columnLabels, columnStatus, columnChildren}
+
+// Row 文档分段文件的一行，导入时除分段内容外均可为空
+type Row struct {
+	DocId      string   `json:"docId"`
+	DocName    string   `json:"docName"`
+	ContentId  string   `json:"contentId"`
+	ContentNum int      `json:"contentNum"`
+	Content    string   `json:"content"`
+	Labels     []string `json:"labels"`
+	Status     *bool    `json:"status,omitempty"`   // 为空时不修改启用状态
+	Children   []string `json:"children,omitempty"` // 父子分段的子分段内容，为空时不修改子分段
+}
+
+// ParseFormat 根据文件名后缀识别文件格式
+func ParseFormat(fileName string) (string, error) {
+	format := strings.ToLower(strings.TrimPrefix(filepath.Ext(fileName), "."))
+	if err := CheckFormat(format); err != nil {
+		return "", err
+	}
+	return format, nil
+}
+
+// CheckFormat 校验文件格式
+func CheckFormat(format string) error {
+	switch format {
+	case FormatCsv, FormatJsonl, FormatXlsx:
+		return nil
+	default:
+		return fmt.Errorf("doc segment file format %q not support", format)
+	}
+}
+
+// toRecord 转换为csv和xlsx的一行，顺序与表头一致
+func (r *Row) toRecord() ([]string, error) {
+	var status, children string
+	if r.Status != nil {
+		status = strconv.FormatBool(*r.Status)
+	}
+	if len(r.Children) > 0 {
+		data, err := json.Marshal(r.Children)
+		if err != nil {
+			return nil, err
+		}
+		children = string(data)
+	}
+	return []string{r.DocId, r.DocName, r.ContentId, strconv.Itoa(r.ContentNum), r.Content,
+		strings.Join(r.Labels, ","), status, children}, nil
+}
+
+// columnIndex 根据表头确定各列位置；表头中没有分段内容列时，按旧模板第一列为分段内容、第二列为关键词
+func columnIndex(headerRecord []string) map[string]int {
+	index := make(map[string]int)
+	for i, name := range headerRecord {
+		index[strings.TrimSpace(strings.TrimPrefix(name, utf8Bom))] = i
+	}
+	if _, ok := index[columnContent]; ok {
+		return index
+	}
+	return map[string]int{columnContent: 0, columnLabels: 1}
+}
+
+// parseRecord 按表头位置解析csv和xlsx的一行
+func parseRecord(index map[string]int, record []string) (*Row, error) {
+	column := func(name string) string {
+		i, ok := index[name]
+		if !ok || i >= len(record) {
+			return ""
+		}
+		return strings.TrimSpace(record[i])
+	}
+	row := &Row{
+		DocId:     column(columnDocId),
+		DocName:   column(columnDocName),
+		ContentId: column(columnContentId),
+		Labels:    splitLabels(column(columnLabels)),
+	}
+	if i, ok := index[columnContent]; ok && i < len(record) {
+		row.Content = record[i]
+	}
+	if contentNum := column(columnContentNum); contentNum != "" {
+		num, err := strconv.Atoi(contentNum)
+		if err != nil {
+			return nil, fmt.Errorf("%s %q invalid", columnContentNum, contentNum)
+		}
+		row.ContentNum = num
+	}
+	if status := column(columnStatus); status != "" {
+		value, err := strconv.ParseBool(status)
+		if err != nil {
+			return nil, fmt.Errorf("%s %q invalid", columnStatus, status)
+		}
+		row.Status = &value
+	}
+	if children := column(columnChildren); children != "" {
+		if err := json.Unmarshal([]byte(children), &row.Children); err != nil {
+			return nil, fmt.Errorf("%s invalid: %v", columnChildren, err)
+		}
+	}
+	return row, nil
+}
+
+func splitLabels(labels string) []string {
+	var retList []string
+	for _, label := range strings.Split(labels, ",") {
+		if label = strings.TrimSpace(label); label != "" {
+			retList = append(retList, label)
+		}
+	}
+	return retList
+}
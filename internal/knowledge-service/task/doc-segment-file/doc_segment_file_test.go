@@ -0,0 +1,96 @@
+package doc_segment_file
+
+import (
+	"bytes"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestWriteRead(t *testing.T) {
+	status := false
+	rowList := []*Row{
+		{DocId: "d1", DocName: "a.txt", ContentId: "c1", ContentNum: 1, Content: "# hello, \"world\"\nline2", Labels: []string{"a", "b"}, Status: &status, Children: []string{"hello", "world"}},
+		{DocId: "d1", DocName: "a.txt", ContentNum: 2, Content: "second"},
+	}
+	for _, format := range []string{FormatCsv, FormatJsonl, FormatXlsx} {
+		buf := &bytes.Buffer{}
+		writer, err := NewWriter(format, buf)
+		if err != nil {
+			t.Fatal(err)
+		}
+		for _, row := range rowList {
+			if err = writer.Write(row); err != nil {
+				t.Fatal(err)
+			}
+		}
+		if err = writer.Close(); err != nil {
+			t.Fatal(err)
+		}
+		var gotList []*Row
+		err = Read(format, buf, func(line int, row *Row, rowErr error) error {
+			if rowErr != nil {
+				t.Fatalf("%s line %d err: %v", format, line, rowErr)
+			}
+			if line != len(gotList)+1 {
+				t.Fatalf("%s line = %d, want %d", format, line, len(gotList)+1)
+			}
+			gotList = append(gotList, row)
+			return nil
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !reflect.DeepEqual(gotList, rowList) {
+			t.Errorf("%s read = %+v, want %+v", format, gotList, rowList)
+		}
+	}
+}
+
+func TestReadCsvTemplate(t *testing.T) {
+	data := "分段内容,关键词\r\n2024年新款,\"手机,支持\"\r\nbad\r\n"
+	var gotList []*Row
+	err := Read(FormatCsv, strings.NewReader(data), func(line int, row *Row, rowErr error) error {
+		if rowErr != nil {
+			t.Fatalf("line %d err: %v", line, rowErr)
+		}
+		gotList = append(gotList, row)
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []*Row{{Content: "2024年新款", Labels: []string{"手机", "支持"}}, {Content: "bad"}}
+	if !reflect.DeepEqual(gotList, want) {
+		t.Errorf("read = %+v, want %+v", gotList, want)
+	}
+}
+
+func TestReadRowError(t *testing.T) {
+	data := "{\"content\":\"a\"}\n\nnot json\n{\"content\":\"b\",\"status\":true}\n"
+	var errLines []int
+	var count int
+	err := Read(FormatJsonl, strings.NewReader(data), func(line int, row *Row, rowErr error) error {
+		if rowErr != nil {
+			errLines = append(errLines, line)
+			return nil
+		}
+		count++
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 2 || !reflect.DeepEqual(errLines, []int{2}) {
+		t.Errorf("count = %d, errLines = %v", count, errLines)
+	}
+}
+
+func TestParseFormat(t *testing.T) {
+	for name, want := range map[string]string{"a.CSV": FormatCsv, "b.jsonl": FormatJsonl, "c.xlsx": FormatXlsx, "d.xls": ""} {
+		got, err := ParseFormat(name)
+		if got != want || (want == "") != (err != nil) {
+			t.Errorf("ParseFormat(%q) = %q, %v", name, got, err)
+		}
+	}
+}
@@ -0,0 +1,121 @@
+package doc_segment_file
+
+import (
+	"bufio"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"io"
+	"strings"
+
+	"github.com/xuri/excelize/v2"
+)
+
+// jsonlMaxLineSize jsonl单行最大长度
+const jsonlMaxLineSize = 16 * 1024 * 1024
+
+// RowHandler 处理读取到的一行，line为数据行号（从1开始，不含表头），
+// 该行解析失败时row为空、rowErr为解析错误；返回错误时停止读取
+type RowHandler func(line int, row *Row, rowErr error) error
+
+// Read 逐行读取指定格式的文档分段文件，csv和xlsx第一行为表头
+func Read(format string, r io.Reader, handler RowHandler) error {
+	if err := CheckFormat(format); err != nil {
+		return err
+	}
+	switch format {
+	case FormatCsv:
+		return readCsv(r, handler)
+	case FormatXlsx:
+		return readXlsx(r, handler)
+	default:
+		return readJsonl(r, handler)
+	}
+}
+
+func readCsv(r io.Reader, handler RowHandler) error {
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = -1 // 允许可变字段数量
+	headerRecord, err := reader.Read()
+	if err == io.EOF {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	index := columnIndex(headerRecord)
+	for line := 1; ; line++ {
+		record, err := reader.Read()
+		if err == io.EOF {
+			return nil
+		}
+		var row *Row
+		if err == nil {
+			row, err = parseRecord(index, record)
+		}
+		if err = handler(line, row, err); err != nil {
+			return err
+		}
+	}
+}
+
+func readXlsx(r io.Reader, handler RowHandler) error {
+	file, err := excelize.OpenReader(r)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = file.Close()
+	}()
+	sheetList := file.GetSheetList()
+	if len(sheetList) == 0 {
+		return errors.New("xlsx sheet is empty")
+	}
+	rows, err := file.Rows(sheetList[0])
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = rows.Close()
+	}()
+	var index map[string]int
+	for line := 0; rows.Next(); line++ {
+		record, err := rows.Columns()
+		if index == nil {
+			if err != nil {
+				return err
+			}
+			index = columnIndex(record)
+			continue
+		}
+		var row *Row
+		if err == nil {
+			row, err = parseRecord(index, record)
+		}
+		if err = handler(line, row, err); err != nil {
+			return err
+		}
+	}
+	return rows.Error()
+}
+
+func readJsonl(r io.Reader, handler RowHandler) error {
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 0, 64*1024), jsonlMaxLineSize)
+	for line := 0; scanner.Scan(); {
+		text := strings.TrimSpace(strings.TrimPrefix(scanner.Text(), utf8Bom))
+		if text == "" {
+			continue
+		}
+		line++
+		var row = &Row{}
+		err := json.Unmarshal([]byte(text), row)
+		if err != nil {
+			row = nil
+		}
+		if err = handler(line, row, err); err != nil {
+			return err
+		}
+	}
+	return scanner.Err()
+}
@@ -0,0 +1,129 @@
+package doc_segment_file
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"io"
+
+	"github.com/xuri/excelize/v2"
+)
+
+// utf8Bom csv文件头，便于excel按utf-8打开
+const utf8Bom = "\ufeff"
+
+const xlsxSheetName = "Sheet1"
+
+// Writer 文档分段文件写入，Close后文件内容才完整写出
+type Writer interface {
+	Write(row *Row) error
+	Close() error
+}
+
+// NewWriter 创建指定格式的文档分段文件写入
+func NewWriter(format string, w io.Writer) (Writer, error) {
+	if err := CheckFormat(format); err != nil {
+		return nil, err
+	}
+	switch format {
+	case FormatCsv:
+		return newCsvWriter(w)
+	case FormatXlsx:
+		return newXlsxWriter(w)
+	default:
+		return &jsonlWriter{encoder: json.NewEncoder(w)}, nil
+	}
+}
+
+type csvWriter struct {
+	writer *csv.Writer
+}
+
+func newCsvWriter(w io.Writer) (*csvWriter, error) {
+	if _, err := io.WriteString(w, utf8Bom); err != nil {
+		return nil, err
+	}
+	writer := csv.NewWriter(w)
+	if err := writer.Write(header); err != nil {
+		return nil, err
+	}
+	return &csvWriter{writer: writer}, nil
+}
+
+func (c *csvWriter) Write(row *Row) error {
+	record, err := row.toRecord()
+	if err != nil {
+		return err
+	}
+	return c.writer.Write(record)
+}
+
+func (c *csvWriter) Close() error {
+	c.writer.Flush()
+	return c.writer.Error()
+}
+
+type jsonlWriter struct {
+	encoder *json.Encoder
+}
+
+func (j *jsonlWriter) Write(row *Row) error {
+	return j.encoder.Encode(row)
+}
+
+func (j *jsonlWriter) Close() error {
+	return nil
+}
+
+type xlsxWriter struct {
+	w      io.Writer
+	file   *excelize.File
+	stream *excelize.StreamWriter
+	rowNum int
+}
+
+func newXlsxWriter(w io.Writer) (*xlsxWriter, error) {
+	file := excelize.NewFile()
+	stream, err := file.NewStreamWriter(xlsxSheetName)
+	if err != nil {
+		_ = file.Close()
+		return nil, err
+	}
+	x := &xlsxWriter{w: w, file: file, stream: stream}
+	if err = x.writeRecord(header); err != nil {
+		_ = file.Close()
+		return nil, err
+	}
+	return x, nil
+}
+
+func (x *xlsxWriter) Write(row *Row) error {
+	record, err := row.toRecord()
+	if err != nil {
+		return err
+	}
+	return x.writeRecord(record)
+}
+
+func (x *xlsxWriter) writeRecord(record []string) error {
+	x.rowNum++
+	cell, err := excelize.CoordinatesToCellName(1, x.rowNum)
+	if err != nil {
+		return err
+	}
+	values := make([]interface{}, 0, len(record))
+	for _, value := range record {
+		values = append(values, value)
+	}
+	return x.stream.SetRow(cell, values)
+}
+
+func (x *xlsxWriter) Close() error {
+	defer func() {
+		_ = x.file.Close()
+	}()
+	if err := x.stream.Flush(); err != nil {
+		return err
+	}
+	_, err := x.file.WriteTo(x.w)
+	return err
+}
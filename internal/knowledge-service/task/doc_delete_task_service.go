@@ -168,9 +168,42 @@ func BatchDeleteAllDoc(ctx context.Context, tx *gorm.DB, knowledge *model.Knowle
 		//只打印，不阻塞
 		log.Errorf("DeleteMetaDataByDocIdList error %v", err)
 	}
+	//5.删除历史版本
+	err = batchDocVersionDelete(ctx, tx, docList)
+	if err != nil {
+		//只打印，不阻塞
+		log.Errorf("batchDocVersionDelete error %v", err)
+	}
 	return nil
 }
 
+// batchDocVersionDelete 删除文档历史版本及其minio文件，跳过与当前版本共用的文件
+func batchDocVersionDelete(ctx context.Context, tx *gorm.DB, docList []*model.KnowledgeDoc) error {
+	docIdList := buildDocIdList(docList)
+	versionList, err := orm.SelectKnowledgeDocVersionByDocIdList(ctx, docIdList)
+	if err != nil {
+		return err
+	}
+	if len(versionList) == 0 {
+		return nil
+	}
+	var deletedPath = make(map[string]bool)
+	for _, doc := range docList {
+		deletedPath[doc.FilePath] = true
+	}
+	for _, version := range versionList {
+		if version.FileType == model.UrlFileType || deletedPath[version.FilePath] {
+			continue
+		}
+		deletedPath[version.FilePath] = true
+		err = service.DeleteFile(ctx, version.FilePath)
+		if err != nil {
+			log.Errorf("batchDocVersionDelete file %s error %v", version.FilePath, err)
+		}
+	}
+	return orm.DeleteKnowledgeDocVersionByDocIdList(tx, docIdList)
+}
+
 // batchRagDelete 批量rag删除
 func batchRagDelete(ctx context.Context, knowledge *model.KnowledgeBase, docList []*model.KnowledgeDoc) error {
 	for _, doc := range docList {
@@ -2,11 +2,10 @@ package task
 
 import (
 	"context"
-	"encoding/csv"
 	"encoding/json"
 	"errors"
 	"fmt"
-	"io"
+	"slices"
 	"strings"
 	"sync"
 	"unicode/utf8"
@@ -15,6 +14,7 @@ import (
 	"github.com/UnicomAI/wanwu/internal/knowledge-service/client/orm"
 	async_task_pkg "github.com/UnicomAI/wanwu/internal/knowledge-service/pkg/async-task"
 	"github.com/UnicomAI/wanwu/internal/knowledge-service/service"
+	doc_segment_file "github.com/UnicomAI/wanwu/internal/knowledge-service/task/doc-segment-file"
 	"github.com/UnicomAI/wanwu/pkg/log"
 	"github.com/UnicomAI/wanwu/pkg/util"
 	async "github.com/gromitlee/go-async"
@@ -140,7 +140,7 @@ func importDocSegment(ctx context.Context, taskCtx string) Result {
 		return Result{Error: err}
 	}
 	//更新状态处理中
-	err = orm.UpdateDocSegmentImportTaskStatus(ctx, docSegmentImportTaskParams.TaskId, model.DocSegmentImportImporting, "", 0)
+	err = orm.UpdateDocSegmentImportTaskStatus(ctx, docSegmentImportTaskParams.TaskId, model.DocSegmentImportImporting, "", 0, "")
 	if err != nil {
 		log.Errorf("UpdateDocSegmentImportTaskStatus err: %s", err)
 		return Result{Error: err}
@@ -155,8 +155,10 @@ func importDocSegment(ctx context.Context, taskCtx string) Result {
 	return Result{Error: err}
 }
 
-// doDocSegmentImport 执行文件导入
+// doDocSegmentImport 执行文件导入，单行处理失败记录行错误后继续处理下一行
 func doDocSegmentImport(ctx context.Context, importTaskParams *model.DocSegmentImportParams, importTask *model.DocSegmentImportTask) (lineCount int, err error) {
+	var successCount int
+	var rowErrors []*model.DocSegmentImportRowError
 	defer util.PrintPanicStackWithCall(func(panicOccur bool, err2 error) {
 		if panicOccur {
 			log.Errorf("do doc import task panic: %v", err2)
@@ -167,105 +169,208 @@ func doDocSegmentImport(ctx context.Context, importTaskParams *model.DocSegmentI
 		if err != nil {
 			status = model.DocSegmentImportFail
 			errMsg = err.Error()
-		}
-		if lineCount == 0 {
+		} else if successCount == 0 {
 			status = model.DocSegmentImportFail
 			errMsg = "文件所有行全部处理失败"
 		}
+		var errorRows string
+		if len(rowErrors) > 0 {
+			data, err1 := json.Marshal(rowErrors)
+			if err1 != nil {
+				log.Errorf("marshal doc segment import row errors err: %v", err1)
+			}
+			errorRows = string(data)
+		}
 		//更新状态和数量
-		err = orm.UpdateDocSegmentImportTaskStatus(ctx, importTask.ImportId, status, errMsg, lineCount)
+		err = orm.UpdateDocSegmentImportTaskStatus(ctx, importTask.ImportId, status, errMsg, lineCount, errorRows)
 	})
-	lineCount, err = processCsvFileLine(ctx, importTaskParams.FileUrl, buildLineProcessor(importTask, importTaskParams))
-	return
-}
-
-// csv 文件行处理器
-func buildLineProcessor(importTask *model.DocSegmentImportTask, importParams *model.DocSegmentImportParams) func(ctx context.Context, strings []string) error {
-	return func(ctx context.Context, lineData []string) error {
-		if utf8.RuneCountInString(lineData[0]) > importParams.MaxSentenceSize {
-			return errors.New("line exceeds max sentence")
-		}
-
-		var chunks []*service.NewChunkItem
-		chunks = append(chunks, &service.NewChunkItem{
-			Content: lineData[0],
-			Labels:  strings.Split(lineData[1], ","),
-		})
-
-		return orm.CreateOneDocSegment(ctx, importTask, &service.RagCreateDocSegmentParams{
-			UserId:           importTask.UserId,
-			KnowledgeBase:    importParams.KnowledgeName,
-			KnowledgeId:      importParams.KnowledgeId,
-			FileName:         importParams.FileName,
-			MaxSentenceSize:  importParams.MaxSentenceSize,
-			Chunks:           chunks,
-			SplitType:        service.RebuildSplitType(importParams.SegmentMethod),
-			ChildChunkConfig: service.RebuildChildChunkConfig(importParams.SegmentMethod, importParams.SubMaxSplitter, importParams.SubSplitter),
-		})
+	format, err := doc_segment_file.ParseFormat(importTaskParams.FileUrl)
+	if err != nil {
+		return 0, err
+	}
+	processor, err := newDocSegmentRowProcessor(ctx, importTask, importTaskParams)
+	if err != nil {
+		return 0, err
 	}
-}
-
-func processCsvFileLine(ctx context.Context, csvUrl string,
-	lineProcessor func(context.Context, []string) error) (int, error) {
-
 	//下载url，循环调用rag
-	object, err := service.DownloadFileObject(ctx, csvUrl)
+	object, err := service.DownloadFileObject(ctx, importTaskParams.FileUrl)
 	if err != nil {
 		log.Errorf("download file err: %s", err)
 		return 0, err
 	}
-
 	defer func() {
 		err2 := object.Close()
 		if err2 != nil {
 			log.Errorf("close file err: %s", err2)
 		}
 	}()
+	err = doc_segment_file.Read(format, object, func(line int, row *doc_segment_file.Row, rowErr error) error {
+		lineCount = line
+		if rowErr == nil {
+			rowErr = processor.process(ctx, row)
+		}
+		if rowErr != nil {
+			log.Errorf("process doc segment file line %d err: %s", line, rowErr)
+			if len(rowErrors) < model.DocSegmentImportMaxRowError {
+				rowError := &model.DocSegmentImportRowError{Line: line, ErrorMsg: rowErr.Error()}
+				if row != nil {
+					rowError.ContentId = row.ContentId
+				}
+				rowErrors = append(rowErrors, rowError)
+			}
+			return nil
+		}
+		successCount++
+		return orm.IncrDocSegmentImportSuccessCount(ctx, importTask.ImportId)
+	})
+	return
+}
+
+// docSegmentRowProcessor 文档分段文件行处理器
+type docSegmentRowProcessor struct {
+	importTask   *model.DocSegmentImportTask
+	importParams *model.DocSegmentImportParams
+	segmentMap   map[string]service.FileSplitContent // upsert模式下按分段id索引的已有切片
+}
 
-	// 创建CSV读取器
-	reader := csv.NewReader(object)
+func newDocSegmentRowProcessor(ctx context.Context, importTask *model.DocSegmentImportTask, importParams *model.DocSegmentImportParams) (*docSegmentRowProcessor, error) {
+	processor := &docSegmentRowProcessor{importTask: importTask, importParams: importParams}
+	if importParams.Mode != model.DocSegmentImportModeUpsert {
+		return processor, nil
+	}
+	segmentList, err := service.RagGetAllDocSegmentList(ctx, importTask.UserId, importParams.KnowledgeName, importParams.FileName)
+	if err != nil {
+		return nil, err
+	}
+	processor.segmentMap = make(map[string]service.FileSplitContent, len(segmentList))
+	for _, segment := range segmentList {
+		processor.segmentMap[segment.ContentId] = segment
+	}
+	return processor, nil
+}
 
-	// 根据需要配置CSV读取器
-	reader.Comma = ','          // 设置分隔符，默认为逗号
-	reader.Comment = '#'        // 设置注释字符
-	reader.FieldsPerRecord = -1 // 允许可变字段数量
+// process 处理一行：upsert模式下分段id存在时更新已有切片，否则新增切片
+func (p *docSegmentRowProcessor) process(ctx context.Context, row *doc_segment_file.Row) error {
+	if row.DocId != "" && row.DocId != p.importTask.DocId {
+		return fmt.Errorf("文档id %s 与导入文档不一致", row.DocId)
+	}
+	if strings.TrimSpace(row.Content) == "" {
+		return errors.New("分段内容为空")
+	}
+	if utf8.RuneCountInString(row.Content) > p.importParams.MaxSentenceSize {
+		return errors.New("分段内容超过最大分段长度")
+	}
+	if p.importParams.Mode != model.DocSegmentImportModeUpsert || row.ContentId == "" {
+		return p.createSegment(ctx, row)
+	}
+	segment, ok := p.segmentMap[row.ContentId]
+	if !ok {
+		return fmt.Errorf("分段id %s 不存在", row.ContentId)
+	}
+	return p.updateSegment(ctx, row, segment)
+}
 
-	// 读取并跳过表头行
-	_, err = reader.Read()
+// createSegment 新增切片，需要设置启用状态或子分段时查询新增的切片（追加在末尾）后同步
+func (p *docSegmentRowProcessor) createSegment(ctx context.Context, row *doc_segment_file.Row) error {
+	params := p.importParams
+	err := service.RagCreateDocSegment(ctx, &service.RagCreateDocSegmentParams{
+		UserId:          p.importTask.UserId,
+		KnowledgeBase:   params.KnowledgeName,
+		KnowledgeId:     params.KnowledgeId,
+		FileName:        params.FileName,
+		MaxSentenceSize: params.MaxSentenceSize,
+		Chunks: []*service.NewChunkItem{{
+			Content: row.Content,
+			Labels:  buildRowLabels(row.Labels),
+		}},
+		SplitType:        service.RebuildSplitType(params.SegmentMethod),
+		ChildChunkConfig: service.RebuildChildChunkConfig(params.SegmentMethod, params.SubMaxSplitter, params.SubSplitter),
+	})
 	if err != nil {
-		if err == io.EOF {
-			log.Errorf("csv file is empty")
-			return 0, nil
-		}
-		log.Errorf("read header line err: %v", err)
+		return err
+	}
+	if (row.Status == nil || *row.Status) && !p.syncChildren(row) {
+		return nil
+	}
+	segmentList, err := service.RagGetAllDocSegmentList(ctx, p.importTask.UserId, params.KnowledgeName, params.FileName)
+	if err != nil {
+		return err
+	}
+	if len(segmentList) == 0 {
+		return errors.New("新增的分段不存在")
 	}
+	segment := segmentList[len(segmentList)-1]
+	return p.syncSegment(ctx, row, segment)
+}
 
-	var lineCount = 0
-	// 逐行读取CSV内容
-	for {
-		record, err := reader.Read()
-		if err == io.EOF {
-			break
-		}
+// updateSegment 更新已有切片，内容、关键词、启用状态和子分段均只在不一致时更新
+func (p *docSegmentRowProcessor) updateSegment(ctx context.Context, row *doc_segment_file.Row, segment service.FileSplitContent) error {
+	params := p.importParams
+	if row.Content != segment.Content {
+		err := service.RagUpdateDocSegment(ctx, &service.RagUpdateDocSegmentParams{
+			UserId:          p.importTask.UserId,
+			KnowledgeBase:   params.KnowledgeName,
+			KnowledgeId:     params.KnowledgeId,
+			FileName:        params.FileName,
+			MaxSentenceSize: params.MaxSentenceSize,
+			Chunk: &service.UpdateChunkItem{
+				ChunkId: segment.ContentId,
+				Content: row.Content,
+				Labels:  buildRowLabels(row.Labels),
+			},
+			SplitType:        service.RebuildSplitType(params.SegmentMethod),
+			ChildChunkConfig: service.RebuildChildChunkConfig(params.SegmentMethod, params.SubMaxSplitter, params.SubSplitter),
+		})
 		if err != nil {
-			// 可以选择记录错误并继续，或者直接返回错误
-			log.Errorf("解析CSV行时出错: %v, lineCount %d", err, lineCount)
-			continue
+			return err
 		}
-		lineCount++
-		if len(record) < 2 {
-			err = fmt.Errorf("line data not ok lineCount %d", lineCount)
-			// 可以选择记录错误并继续，或者直接返回错误
-			log.Errorf("解析CSV行时出错: %v", err)
-			continue
+		segment.Labels = row.Labels
+	}
+	if !slices.Equal(segment.Labels, row.Labels) {
+		err := service.RagDocSegmentLabels(ctx, &service.RagDocSegmentLabelsParams{
+			UserId:        p.importTask.UserId,
+			KnowledgeBase: params.KnowledgeName,
+			KnowledgeId:   params.KnowledgeId,
+			FileName:      params.FileName,
+			ContentId:     segment.ContentId,
+			Labels:        buildRowLabels(row.Labels),
+		})
+		if err != nil {
+			return err
 		}
+	}
+	return p.syncSegment(ctx, row, segment)
+}
 
-		err = lineProcessor(ctx, record)
+// syncSegment 同步启用状态和子分段
+func (p *docSegmentRowProcessor) syncSegment(ctx context.Context, row *doc_segment_file.Row, segment service.FileSplitContent) error {
+	params := p.importParams
+	if row.Status != nil && *row.Status != segment.Status {
+		err := service.RagDocUpdateDocSegmentStatus(ctx, &service.DocSegmentStatusUpdateParams{
+			UserId:        p.importTask.UserId,
+			KnowledgeName: params.KnowledgeName,
+			FileName:      params.FileName,
+			ContentId:     segment.ContentId,
+			Status:        *row.Status,
+		})
 		if err != nil {
-			log.Errorf("process csv line lineCount %d err: %s", lineCount, err)
-			continue
+			return err
 		}
 	}
+	if !p.syncChildren(row) {
+		return nil
+	}
+	return service.RagSyncDocChildSegment(ctx, p.importTask.UserId, params.KnowledgeName, params.KnowledgeId, params.FileName, segment, row.Children)
+}
 
-	return lineCount, nil
+// syncChildren 是否需要同步子分段，非父子分段文档忽略子分段
+func (p *docSegmentRowProcessor) syncChildren(row *doc_segment_file.Row) bool {
+	return len(row.Children) > 0 && p.importParams.SegmentMethod == model.ParentSegmentMethod
+}
+
+func buildRowLabels(labels []string) []string {
+	if len(labels) == 0 {
+		return make([]string, 0)
+	}
+	return labels
 }
@@ -0,0 +1,221 @@
+package task
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/UnicomAI/wanwu/internal/knowledge-service/client/model"
+	"github.com/UnicomAI/wanwu/internal/knowledge-service/client/orm"
+	async_task_pkg "github.com/UnicomAI/wanwu/internal/knowledge-service/pkg/async-task"
+	pkg_util "github.com/UnicomAI/wanwu/internal/knowledge-service/pkg/util"
+	"github.com/UnicomAI/wanwu/internal/knowledge-service/service"
+	"github.com/UnicomAI/wanwu/pkg/log"
+	"github.com/UnicomAI/wanwu/pkg/util"
+	async "github.com/gromitlee/go-async"
+	"github.com/gromitlee/go-async/pkg/async/async_task"
+)
+
+const (
+	docSyncScheduleInterval = time.Minute //定时同步扫描间隔
+	docSyncScheduleBatch    = 100         //每次扫描最多领取的同步配置数
+)
+
+var docSyncTask = &DocSyncTask{Del: true}
+
+type DocSyncTask struct {
+	Wg  sync.WaitGroup
+	Del bool // 是否需要自动清理
+}
+
+func init() {
+	async_task_pkg.AddContainer(docSyncTask)
+}
+
+func (t *DocSyncTask) BuildServiceType() uint32 {
+	return async_task_pkg.DocSyncTaskType
+}
+
+func (t *DocSyncTask) InitTask() error {
+	if err := async.RegisterTask(t.BuildServiceType(), func() async_task.ITask {
+		return docSyncTask
+	}); err != nil {
+		return err
+	}
+	go runDocSyncSchedule()
+	return nil
+}
+
+func (t *DocSyncTask) SubmitTask(ctx context.Context, params interface{}) (err error) {
+	if params == nil {
+		return errors.New("参数不能为空")
+	}
+	paramStr, err := json.Marshal(params)
+	if err != nil {
+		return err
+	}
+	var taskId uint32
+	taskId, err = async.CreateTask(ctx, "", "DocSyncTask", t.BuildServiceType(), string(paramStr), true)
+	log.Infof("doc sync task %d ", taskId)
+	return err
+}
+
+func (t *DocSyncTask) Running(ctx context.Context, taskCtx string, stop <-chan struct{}) <-chan async_task.IReport {
+	reportCh := make(chan async_task.IReport)
+	t.Wg.Add(1)
+	go func() {
+		defer util.PrintPanicStack()
+		defer t.Wg.Wait()
+		defer t.Wg.Done()
+		defer close(reportCh)
+
+		r := &report{phase: async_task.RunPhaseNormal, del: t.Del, ctx: taskCtx}
+		defer func() {
+			reportCh <- r.clone()
+		}()
+
+		//执行url文档同步
+		systemStop, err := t.runStep(ctx, taskCtx, stop)
+		if systemStop {
+			log.Infof("system stop")
+			return
+		}
+		if err != nil {
+			log.Errorf("executeDocSyncTask err: %s", err)
+			r.phase = async_task.RunPhaseFailed
+			return
+		} else {
+			r.phase = async_task.RunPhaseFinished
+			return
+		}
+	}()
+
+	return reportCh
+}
+
+func (t *DocSyncTask) Deleting(ctx context.Context, taskCtx string, stop <-chan struct{}) <-chan async_task.IReport {
+	return CommonDeleting(ctx, taskCtx, stop, &t.Wg)
+}
+
+func (t *DocSyncTask) runStep(ctx context.Context, taskCtx string, stop <-chan struct{}) (bool, error) {
+	ret := make(chan Result, 1)
+	go func() {
+		defer util.PrintPanicStack()
+		defer close(ret)
+		ret <- syncDoc(ctx, taskCtx)
+	}()
+	for {
+		select {
+		case <-ctx.Done():
+			return false, nil
+		case <-stop:
+			return true, nil
+		case result := <-ret:
+			return false, result.Error
+		}
+	}
+}
+
+// runDocSyncSchedule 定时扫描到期的同步配置，推进下次同步时间后提交同步任务
+func runDocSyncSchedule() {
+	defer util.PrintPanicStack()
+	ticker := time.NewTicker(docSyncScheduleInterval)
+	defer ticker.Stop()
+	for range ticker.C {
+		scheduleDueDocSync(context.Background())
+	}
+}
+
+func scheduleDueDocSync(ctx context.Context) {
+	now := time.Now()
+	dueList, err := orm.SelectDueKnowledgeDocSyncList(ctx, now.UnixMilli(), docSyncScheduleBatch)
+	if err != nil {
+		log.Errorf("select due doc sync list err: %v", err)
+		return
+	}
+	for _, docSync := range dueList {
+		next, err := pkg_util.NextSyncTime(docSync.CronExpr, docSync.IntervalMinutes, now)
+		if err != nil {
+			log.Errorf("doc sync %s next sync time err: %v", docSync.SyncId, err)
+			continue
+		}
+		claimed, err := orm.ClaimKnowledgeDocSync(ctx, docSync.Id, docSync.NextSyncAt, next.UnixMilli())
+		if err != nil {
+			log.Errorf("claim doc sync %s err: %v", docSync.SyncId, err)
+			continue
+		}
+		if !claimed {
+			//其他实例已领取
+			continue
+		}
+		if err = orm.SubmitKnowledgeDocSyncTask(ctx, docSync.SyncId); err != nil {
+			log.Errorf("submit doc sync task %s err: %v", docSync.SyncId, err)
+		}
+	}
+}
+
+func syncDoc(ctx context.Context, taskCtx string) Result {
+	var params = &async_task_pkg.DocSyncTaskParams{}
+	err := json.Unmarshal([]byte(taskCtx), params)
+	if err != nil {
+		log.Errorf("unmarshal json err: %s", err)
+		return Result{Error: err}
+	}
+	docSync, err := orm.SelectKnowledgeDocSyncById(ctx, params.SyncId)
+	if err != nil {
+		//同步配置已删除
+		return Result{}
+	}
+	knowledge, err := orm.SelectKnowledgeById(ctx, docSync.KnowledgeId, "", "")
+	if err != nil {
+		return Result{Error: err}
+	}
+	docList, err := orm.SelectKnowledgeDocSyncDocList(ctx, docSync)
+	if err != nil {
+		log.Errorf("select doc sync %s doc list err: %v", docSync.SyncId, err)
+		return Result{Error: err}
+	}
+	var historyList []*model.KnowledgeDocSyncHistory
+	for _, doc := range docList {
+		historyList = append(historyList, syncUrlDoc(ctx, knowledge, docSync, doc))
+	}
+	err = orm.FinishKnowledgeDocSync(ctx, docSync, historyList)
+	return Result{Error: err}
+}
+
+// syncUrlDoc 同步单个url文档：内容md5未变化不处理，变化则重新导入；首次同步只记录内容md5作为基线
+func syncUrlDoc(ctx context.Context, knowledge *model.KnowledgeBase, docSync *model.KnowledgeDocSync, doc *model.KnowledgeDoc) *model.KnowledgeDocSyncHistory {
+	history := &model.KnowledgeDocSyncHistory{
+		SyncId:      docSync.SyncId,
+		DocId:       doc.DocId,
+		KnowledgeId: doc.KnowledgeId,
+		DocName:     doc.Name,
+		DocUrl:      doc.FilePath,
+		Status:      model.DocSyncUnchanged,
+		StartAt:     time.Now().UnixMilli(),
+		UserId:      docSync.UserId,
+		OrgId:       docSync.OrgId,
+	}
+	defer func() {
+		history.FinishAt = time.Now().UnixMilli()
+	}()
+	contentMd5, err := service.FetchUrlContentMd5(ctx, doc.FilePath)
+	if err != nil {
+		log.Errorf("doc sync %s fetch url %s err: %v", docSync.SyncId, doc.FilePath, err)
+		history.Status, history.ErrorMsg = model.DocSyncFail, err.Error()
+		return history
+	}
+	history.ContentMd5 = contentMd5
+	//与导入时记录的内容md5比对；未记录md5的历史文档无法确认内容未变化，按变化重新导入
+	if doc.ContentMd5 == contentMd5 {
+		return history
+	}
+	history.Status = model.DocSyncChanged
+	if err = orm.ReimportKnowledgeUrlDoc(ctx, knowledge, doc, contentMd5); err != nil {
+		log.Errorf("doc sync %s doc %s err: %v", docSync.SyncId, doc.DocId, err)
+		history.Status, history.ErrorMsg = model.DocSyncFail, err.Error()
+	}
+	return history
+}
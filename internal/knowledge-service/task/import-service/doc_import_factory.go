@@ -11,6 +11,7 @@ import (
 	"github.com/UnicomAI/wanwu/internal/knowledge-service/pkg/db"
 	"github.com/UnicomAI/wanwu/pkg/log"
 	"github.com/UnicomAI/wanwu/pkg/util"
+	"github.com/UnicomAI/wanwu/pkg/webhook"
 	"gorm.io/gorm"
 )
 
@@ -44,6 +45,9 @@ func DoDocImport(ctx context.Context, task *model.KnowledgeImportTask) (resultLi
 			}
 			return nil
 		})
+		if err == nil {
+			emitImportEvent(task, status, errMsg, resultList)
+		}
 	})
 
 	//1.获取服务service
@@ -86,3 +90,22 @@ func DoDocImport(ctx context.Context, task *model.KnowledgeImportTask) (resultLi
 	resultList, err = docImportService.ImportDoc(ctx, task, docCheckList)
 	return
 }
+
+// emitImportEvent 发送导入任务结果的webhook事件，文档解析结果另由文档状态更新时发送
+func emitImportEvent(task *model.KnowledgeImportTask, status int, errMsg string, resultList []*model.DocInfo) {
+	event := webhook.EventKnowledgeImportFinished
+	if status == model.KnowledgeImportError {
+		event = webhook.EventKnowledgeImportFailed
+	}
+	docIdList := make([]string, 0, len(resultList))
+	for _, doc := range resultList {
+		docIdList = append(docIdList, doc.DocId)
+	}
+	webhook.Emit(task.OrgId, event, map[string]interface{}{
+		"importId":    task.ImportId,
+		"knowledgeId": task.KnowledgeId,
+		"userId":      task.UserId,
+		"docIdList":   docIdList,
+		"errorMsg":    errMsg,
+	})
+}
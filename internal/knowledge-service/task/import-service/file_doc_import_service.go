@@ -108,6 +108,21 @@ func checkOneFile(ctx context.Context, importTask *model.KnowledgeImportTask, do
 	return true, ""
 }
 
+// CheckDocVersionFile 校验文档新版本文件，不支持压缩包，改名时校验重名
+func CheckDocVersionFile(ctx context.Context, doc *model.KnowledgeDoc, file *model.DocInfo) error {
+	isCompressed, _ := checkCompressedFile(file)
+	if isCompressed || !buildFileTypeMap()[file.DocType] {
+		return fmt.Errorf("文件%s格式%s不支持", file.DocName, file.DocType)
+	}
+	if err := checkSingleFileSize(file); err != nil {
+		return err
+	}
+	if file.DocName == doc.Name {
+		return nil
+	}
+	return orm.CheckKnowledgeDocSameName(ctx, doc.UserId, doc.KnowledgeId, file.DocName, "")
+}
+
 // 校验单个文件大小限制
 func checkSingleFileSize(doc *model.DocInfo) error {
 	limitConfig := config.GetConfig().UsageLimit
@@ -9,6 +9,7 @@ import (
 	"github.com/UnicomAI/wanwu/internal/knowledge-service/client/orm"
 	"github.com/UnicomAI/wanwu/internal/knowledge-service/pkg/generator"
 	"github.com/UnicomAI/wanwu/internal/knowledge-service/pkg/util"
+	"github.com/UnicomAI/wanwu/internal/knowledge-service/service"
 	"github.com/UnicomAI/wanwu/pkg/log"
 )
 
@@ -54,7 +55,14 @@ func (f UrlDocImportService) ImportDoc(ctx context.Context, importTask *model.Kn
 	var result = false
 	var retList []*model.DocInfo
 	for _, docInfo := range docList {
-		err := orm.CreateKnowledgeUrlDoc(ctx, buildKnowledgeUrlDoc(importTask, docInfo), importTask)
+		doc := buildKnowledgeUrlDoc(importTask, docInfo)
+		//记录导入时的内容md5，作为url文档同步的比对基准
+		contentMd5, err := service.FetchUrlContentMd5(ctx, doc.FilePath)
+		if err != nil {
+			log.Errorf("fetch url %s content md5 fail %v", doc.FilePath, err)
+		}
+		doc.ContentMd5 = contentMd5
+		err = orm.CreateKnowledgeUrlDoc(ctx, doc, importTask)
 		if err != nil {
 			log.Errorf("import doc fail %v", err)
 			continue
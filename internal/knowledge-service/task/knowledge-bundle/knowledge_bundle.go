@@ -0,0 +1,176 @@
+package knowledge_bundle
+
+import (
+	"archive/zip"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"path"
+
+	"github.com/UnicomAI/wanwu/internal/knowledge-service/client/model"
+	"github.com/UnicomAI/wanwu/internal/knowledge-service/client/orm"
+	"github.com/UnicomAI/wanwu/pkg/log"
+	"github.com/UnicomAI/wanwu/pkg/util"
+)
+
+// 知识库导出文件结构：
+//
+//	manifest.json                知识库配置、标签、关键词及文档列表
+//	docs/<docId>/file            文档原文件，url文档没有
+//	docs/<docId>/segments.json   文档切片快照，仅处理完成的文档有
+const (
+	manifestVersion  = 1
+	manifestFileName = "manifest.json"
+	docDir           = "docs"
+	docFileName      = "file"
+	docSegmentName   = "segments.json"
+)
+
+// Manifest 知识库导出清单
+type Manifest struct {
+	Version      int          `json:"version"`
+	Knowledge    *Knowledge   `json:"knowledge"`
+	TagList      []string     `json:"tagList"`
+	KeywordsList []*Keywords  `json:"keywordsList"`
+	DocList      []*ExportDoc `json:"docList"`
+}
+
+type Knowledge struct {
+	Name           string `json:"name"`
+	Description    string `json:"description"`
+	EmbeddingModel string `json:"embeddingModel"`
+}
+
+type Keywords struct {
+	Name  string `json:"name"`
+	Alias string `json:"alias"`
+}
+
+// ExportDoc 导出的文档，导入配置沿用文档原导入任务的配置
+type ExportDoc struct {
+	DocId         string     `json:"docId"`
+	Name          string     `json:"name"`
+	FileType      string     `json:"fileType"`
+	FileSize      int64      `json:"fileSize"`
+	Url           string     `json:"url"` // url文档的地址
+	SegmentConfig string     `json:"segmentConfig"`
+	DocAnalyzer   string     `json:"docAnalyzer"`
+	OcrModelId    string     `json:"ocrModelId"`
+	DocPreProcess string     `json:"docPreProcess"`
+	MetaList      []*DocMeta `json:"metaList"`
+	HasSegment    bool       `json:"hasSegment"` // 是否导出了切片快照
+}
+
+type DocMeta struct {
+	Key       string `json:"key"`
+	Value     string `json:"value"`
+	ValueType string `json:"valueType"`
+	Rule      string `json:"rule"`
+}
+
+func docFilePath(docId string) string {
+	return path.Join(docDir, docId, docFileName)
+}
+
+func docSegmentPath(docId string) string {
+	return path.Join(docDir, docId, docSegmentName)
+}
+
+func writeJson(writer *zip.Writer, name string, v interface{}) error {
+	w, err := writer.Create(name)
+	if err != nil {
+		return err
+	}
+	return json.NewEncoder(w).Encode(v)
+}
+
+func readJson(reader *zip.Reader, name string, v interface{}) error {
+	file, err := reader.Open(name)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = file.Close()
+	}()
+	return json.NewDecoder(file).Decode(v)
+}
+
+// readManifest 读取并校验导出清单
+func readManifest(reader *zip.Reader) (*Manifest, error) {
+	var manifest = &Manifest{}
+	if err := readJson(reader, manifestFileName, manifest); err != nil {
+		return nil, fmt.Errorf("read manifest err: %v", err)
+	}
+	if manifest.Version != manifestVersion {
+		return nil, fmt.Errorf("manifest version %d not support", manifest.Version)
+	}
+	if manifest.Knowledge == nil {
+		return nil, errors.New("manifest knowledge is empty")
+	}
+	for _, doc := range manifest.DocList {
+		if doc.DocId == "" || path.Base(doc.DocId) != doc.DocId {
+			return nil, fmt.Errorf("manifest doc id %q invalid", doc.DocId)
+		}
+	}
+	return manifest, nil
+}
+
+// readSegment 读取文档切片快照
+func readSegment(reader *zip.Reader, docId string) ([]*model.DocSegmentSnapshot, error) {
+	var snapshotList []*model.DocSegmentSnapshot
+	if err := readJson(reader, docSegmentPath(docId), &snapshotList); err != nil {
+		return nil, err
+	}
+	return snapshotList, nil
+}
+
+// openDocFile 打开文档原文件
+func openDocFile(reader *zip.Reader, docId string) (io.ReadCloser, int64, error) {
+	file, err := reader.Open(docFilePath(docId))
+	if err != nil {
+		return nil, 0, err
+	}
+	info, err := file.Stat()
+	if err != nil {
+		_ = file.Close()
+		return nil, 0, err
+	}
+	return file, info.Size(), nil
+}
+
+func unmarshalSegmentConfig(segmentConfig string) (*model.SegmentConfig, error) {
+	var config = &model.SegmentConfig{}
+	if err := json.Unmarshal([]byte(segmentConfig), config); err != nil {
+		return nil, err
+	}
+	return config, nil
+}
+
+// DoKnowledgeBundle 执行知识库导入导出任务，失败时记录失败原因
+func DoKnowledgeBundle(ctx context.Context, task *model.KnowledgeBundleTask) (err error) {
+	defer util.PrintPanicStackWithCall(func(panicOccur bool, err2 error) {
+		if panicOccur {
+			log.Errorf("do knowledge bundle panic: %v", err2)
+			err = errors.New("知识库导入导出异常")
+		}
+		if err != nil {
+			err1 := orm.UpdateKnowledgeBundleTask(ctx, task.Id, map[string]interface{}{
+				"status":    model.KnowledgeBundleFail,
+				"error_msg": err.Error(),
+			})
+			if err1 != nil {
+				log.Errorf("update knowledge bundle task %s fail status err: %v", task.TaskId, err1)
+			}
+		}
+	})
+	switch task.TaskType {
+	case model.KnowledgeBundleExport:
+		return doKnowledgeExport(ctx, task)
+	case model.KnowledgeBundleImport:
+		return doKnowledgeImport(ctx, task)
+	default:
+		return fmt.Errorf("knowledge bundle task type %d not support", task.TaskType)
+	}
+}
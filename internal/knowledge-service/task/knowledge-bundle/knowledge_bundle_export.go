@@ -0,0 +1,238 @@
+package knowledge_bundle
+
+import (
+	"archive/zip"
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+
+	"github.com/UnicomAI/wanwu/internal/knowledge-service/client/model"
+	"github.com/UnicomAI/wanwu/internal/knowledge-service/client/orm"
+	"github.com/UnicomAI/wanwu/internal/knowledge-service/pkg/config"
+	"github.com/UnicomAI/wanwu/internal/knowledge-service/pkg/util"
+	"github.com/UnicomAI/wanwu/internal/knowledge-service/service"
+	"github.com/UnicomAI/wanwu/pkg/log"
+	"github.com/UnicomAI/wanwu/pkg/minio"
+)
+
+// doKnowledgeExport 导出知识库：打包文档原文件、元数据、切片快照、标签和关键词，上传到文件上传bucket的过期目录
+func doKnowledgeExport(ctx context.Context, task *model.KnowledgeBundleTask) error {
+	//1.查询知识库及文档
+	knowledge, err := orm.SelectKnowledgeById(ctx, task.KnowledgeId, "", "")
+	if err != nil {
+		return err
+	}
+	docList, err := orm.GetDocListByKnowledgeId(ctx, "", "", knowledge.KnowledgeId)
+	if err != nil {
+		return err
+	}
+	err = orm.UpdateKnowledgeBundleTask(ctx, task.Id, map[string]interface{}{
+		"status":      model.KnowledgeBundleProcessing,
+		"total_count": len(docList),
+	})
+	if err != nil {
+		return err
+	}
+	//2.创建本地压缩文件
+	localFilePath := util.BuildFilePath(config.GetConfig().KnowledgeDocConfig.DocLocalFilePath, ".zip")
+	if err = os.MkdirAll(filepath.Dir(localFilePath), 0755); err != nil {
+		return err
+	}
+	localFile, err := os.Create(localFilePath)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = localFile.Close()
+		if err1 := os.Remove(localFilePath); err1 != nil {
+			log.Errorf("knowledge export local file delete %v", err1)
+		}
+	}()
+	writer := zip.NewWriter(localFile)
+	manifest, err := buildManifest(ctx, knowledge)
+	if err != nil {
+		return err
+	}
+	//3.逐个导出文档
+	for i, doc := range docList {
+		exportDoc, err := exportKnowledgeDoc(ctx, writer, knowledge, doc)
+		if err != nil {
+			return fmt.Errorf("export doc %s err: %v", doc.Name, err)
+		}
+		manifest.DocList = append(manifest.DocList, exportDoc)
+		err = orm.UpdateKnowledgeBundleTask(ctx, task.Id, map[string]interface{}{
+			"finish_count": i + 1,
+		})
+		if err != nil {
+			return err
+		}
+	}
+	if err = writeJson(writer, manifestFileName, manifest); err != nil {
+		return err
+	}
+	if err = writer.Close(); err != nil {
+		return err
+	}
+	//4.上传压缩文件
+	fileInfo, err := localFile.Stat()
+	if err != nil {
+		return err
+	}
+	if _, err = localFile.Seek(0, io.SeekStart); err != nil {
+		return err
+	}
+	fileName, _, err := minio.UploadFileCommonWithExpire(ctx, localFile, ".zip", fileInfo.Size())
+	if err != nil {
+		return err
+	}
+	return orm.UpdateKnowledgeBundleTask(ctx, task.Id, map[string]interface{}{
+		"status":    model.KnowledgeBundleSuccess,
+		"file_path": fileName,
+	})
+}
+
+// buildManifest 构造知识库配置、标签和关键词
+func buildManifest(ctx context.Context, knowledge *model.KnowledgeBase) (*Manifest, error) {
+	manifest := &Manifest{
+		Version: manifestVersion,
+		Knowledge: &Knowledge{
+			Name:           knowledge.Name,
+			Description:    knowledge.Description,
+			EmbeddingModel: knowledge.EmbeddingModel,
+		},
+		TagList:      make([]string, 0),
+		KeywordsList: make([]*Keywords, 0),
+		DocList:      make([]*ExportDoc, 0),
+	}
+	relationList, err := orm.SelectKnowledgeTagRelationList(ctx, "", "", []string{knowledge.KnowledgeId})
+	if err != nil {
+		return nil, err
+	}
+	for _, relation := range relationList {
+		tag, err := orm.SelectKnowledgeTagDetail(ctx, "", "", relation.TagId)
+		if err != nil {
+			log.Errorf("knowledge %s export tag %s err: %v", knowledge.KnowledgeId, relation.TagId, err)
+			continue
+		}
+		manifest.TagList = append(manifest.TagList, tag.Name)
+	}
+	keywordsList, err := orm.SelectKeywordsByKnowledgeId(ctx, knowledge.UserId, knowledge.OrgId, knowledge.KnowledgeId)
+	if err != nil {
+		return nil, err
+	}
+	for _, keywords := range keywordsList {
+		manifest.KeywordsList = append(manifest.KeywordsList, &Keywords{
+			Name:  keywords.Name,
+			Alias: keywords.Alias,
+		})
+	}
+	return manifest, nil
+}
+
+// exportKnowledgeDoc 导出文档原文件和切片快照，处理完成的文档才有切片
+func exportKnowledgeDoc(ctx context.Context, writer *zip.Writer, knowledge *model.KnowledgeBase, doc *model.KnowledgeDoc) (*ExportDoc, error) {
+	importTask, err := orm.SelectKnowledgeImportTaskById(ctx, doc.ImportTaskId)
+	if err != nil {
+		return nil, err
+	}
+	metaList, err := orm.SelectDocMetaList(ctx, "", "", doc.DocId)
+	if err != nil {
+		return nil, err
+	}
+	exportDoc := &ExportDoc{
+		DocId:         doc.DocId,
+		Name:          doc.Name,
+		FileType:      doc.FileType,
+		FileSize:      doc.FileSize,
+		SegmentConfig: importTask.SegmentConfig,
+		DocAnalyzer:   importTask.DocAnalyzer,
+		OcrModelId:    importTask.OcrModelId,
+		DocPreProcess: importTask.DocPreProcess,
+		MetaList:      make([]*DocMeta, 0, len(metaList)),
+	}
+	for _, meta := range metaList {
+		exportDoc.MetaList = append(exportDoc.MetaList, &DocMeta{
+			Key:       meta.Key,
+			Value:     meta.Value,
+			ValueType: meta.ValueType,
+			Rule:      meta.Rule,
+		})
+	}
+	//1.文档原文件
+	if doc.FileType == model.UrlFileType {
+		exportDoc.Url = doc.FilePath
+	} else if err = writeDocFile(ctx, writer, doc); err != nil {
+		return nil, err
+	}
+	//2.切片快照
+	if util.BuildDocRespStatus(doc.Status) != model.DocSuccess {
+		return exportDoc, nil
+	}
+	snapshotList, err := buildDocSegmentSnapshot(ctx, knowledge, doc, importTask)
+	if err != nil {
+		return nil, err
+	}
+	if err = writeJson(writer, docSegmentPath(doc.DocId), snapshotList); err != nil {
+		return nil, err
+	}
+	exportDoc.HasSegment = true
+	return exportDoc, nil
+}
+
+func writeDocFile(ctx context.Context, writer *zip.Writer, doc *model.KnowledgeDoc) error {
+	object, err := service.DownloadFileObject(ctx, doc.FilePath)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = object.Close()
+	}()
+	w, err := writer.Create(docFilePath(doc.DocId))
+	if err != nil {
+		return err
+	}
+	_, err = io.Copy(w, object)
+	return err
+}
+
+// buildDocSegmentSnapshot 查询文档全部切片，父子分段同时记录子切片内容
+func buildDocSegmentSnapshot(ctx context.Context, knowledge *model.KnowledgeBase, doc *model.KnowledgeDoc, importTask *model.KnowledgeImportTask) ([]*model.DocSegmentSnapshot, error) {
+	fileName := service.RebuildFileName(doc.DocId, doc.FileType, doc.Name)
+	segmentList, err := service.RagGetAllDocSegmentList(ctx, doc.UserId, knowledge.Name, fileName)
+	if err != nil {
+		return nil, err
+	}
+	segmentConfig, err := unmarshalSegmentConfig(importTask.SegmentConfig)
+	if err != nil {
+		return nil, err
+	}
+	var retList = make([]*model.DocSegmentSnapshot, 0, len(segmentList))
+	for _, segment := range segmentList {
+		snapshot := &model.DocSegmentSnapshot{
+			ContentNum: segment.MetaData.ChunkCurrentNum,
+			Content:    segment.Content,
+			Status:     segment.Status,
+			Labels:     segment.Labels,
+		}
+		if segmentConfig.SegmentMethod == model.ParentSegmentMethod {
+			childList, err := service.RagGetAllDocChildSegmentList(ctx, &service.RagGetDocChildSegmentParams{
+				UserId:            doc.UserId,
+				KnowledgeBaseName: knowledge.Name,
+				KnowledgeId:       knowledge.KnowledgeId,
+				FileName:          fileName,
+				ChunkId:           segment.ContentId,
+			})
+			if err != nil {
+				return nil, err
+			}
+			snapshot.Children = make([]string, 0, len(childList))
+			for _, child := range childList {
+				snapshot.Children = append(snapshot.Children, child.Content)
+			}
+		}
+		retList = append(retList, snapshot)
+	}
+	return retList, nil
+}
@@ -0,0 +1,286 @@
+package knowledge_bundle
+
+import (
+	"archive/zip"
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	knowledgebase_service "github.com/UnicomAI/wanwu/api/proto/knowledgebase-service"
+	"github.com/UnicomAI/wanwu/internal/knowledge-service/client/model"
+	"github.com/UnicomAI/wanwu/internal/knowledge-service/client/orm"
+	"github.com/UnicomAI/wanwu/internal/knowledge-service/pkg/config"
+	"github.com/UnicomAI/wanwu/internal/knowledge-service/pkg/generator"
+	"github.com/UnicomAI/wanwu/internal/knowledge-service/pkg/util"
+	"github.com/UnicomAI/wanwu/internal/knowledge-service/service"
+	"github.com/UnicomAI/wanwu/pkg/log"
+)
+
+// doKnowledgeImport 导入知识库：在任务创建人下新建知识库，恢复标签、关键词和文档，
+// 文档按导出时的配置重新解析，rag解析完成后恢复切片快照
+func doKnowledgeImport(ctx context.Context, task *model.KnowledgeBundleTask) error {
+	var params = &model.KnowledgeBundleImportParams{}
+	if err := json.Unmarshal([]byte(task.Params), params); err != nil {
+		return err
+	}
+	//1.下载并读取导出文件
+	localFilePath := util.BuildFilePath(config.GetConfig().KnowledgeDocConfig.DocLocalFilePath, ".zip")
+	if err := service.DownloadFileToLocal(ctx, task.FilePath, localFilePath); err != nil {
+		return err
+	}
+	defer func() {
+		if err1 := os.Remove(localFilePath); err1 != nil {
+			log.Errorf("knowledge import local file delete %v", err1)
+		}
+	}()
+	readCloser, err := zip.OpenReader(localFilePath)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = readCloser.Close()
+	}()
+	reader := &readCloser.Reader
+	manifest, err := readManifest(reader)
+	if err != nil {
+		return err
+	}
+	err = orm.UpdateKnowledgeBundleTask(ctx, task.Id, map[string]interface{}{
+		"status":      model.KnowledgeBundleProcessing,
+		"total_count": len(manifest.DocList),
+	})
+	if err != nil {
+		return err
+	}
+	//2.创建知识库
+	knowledge, err := createKnowledge(ctx, task, params, manifest)
+	if err != nil {
+		return err
+	}
+	err = orm.UpdateKnowledgeBundleTask(ctx, task.Id, map[string]interface{}{
+		"knowledge_id":   knowledge.KnowledgeId,
+		"knowledge_name": knowledge.Name,
+	})
+	if err != nil {
+		return err
+	}
+	//3.恢复标签和关键词
+	if err = bindKnowledgeTag(ctx, knowledge, manifest.TagList); err != nil {
+		return err
+	}
+	for _, keywords := range manifest.KeywordsList {
+		err = orm.CreateKeywords(ctx, &model.KnowledgeKeywords{
+			Name:             keywords.Name,
+			Alias:            keywords.Alias,
+			KnowledgeBaseIds: fmt.Sprintf("[%q]", knowledge.KnowledgeId),
+			UserId:           knowledge.UserId,
+			OrgId:            knowledge.OrgId,
+		})
+		if err != nil {
+			return fmt.Errorf("create keywords %s err: %v", keywords.Name, err)
+		}
+	}
+	//4.逐个导入文档，单个文档失败不影响其他文档
+	var failList []string
+	for i, exportDoc := range manifest.DocList {
+		if err = importKnowledgeDoc(ctx, reader, knowledge, exportDoc); err != nil {
+			log.Errorf("knowledge %s import doc %s err: %v", knowledge.KnowledgeId, exportDoc.Name, err)
+			failList = append(failList, exportDoc.Name)
+		}
+		err = orm.UpdateKnowledgeBundleTask(ctx, task.Id, map[string]interface{}{
+			"finish_count": i + 1,
+		})
+		if err != nil {
+			return err
+		}
+	}
+	if len(failList) > 0 {
+		return fmt.Errorf("import doc fail: %s", strings.Join(failList, ","))
+	}
+	return orm.UpdateKnowledgeBundleTask(ctx, task.Id, map[string]interface{}{
+		"status": model.KnowledgeBundleSuccess,
+	})
+}
+
+// createKnowledge 创建知识库，未指定的名称、描述和embedding模型沿用导出文件中的配置
+func createKnowledge(ctx context.Context, task *model.KnowledgeBundleTask, params *model.KnowledgeBundleImportParams, manifest *Manifest) (*model.KnowledgeBase, error) {
+	name, description := params.Name, params.Description
+	if name == "" {
+		name = manifest.Knowledge.Name
+	}
+	if description == "" {
+		description = manifest.Knowledge.Description
+	}
+	if err := orm.CheckSameKnowledgeName(ctx, task.UserId, task.OrgId, name, ""); err != nil {
+		return nil, fmt.Errorf("knowledge name %s duplicate", name)
+	}
+	var embeddingModelInfo = &knowledgebase_service.EmbeddingModelInfo{ModelId: params.EmbeddingModelId}
+	if embeddingModelInfo.ModelId == "" {
+		if err := json.Unmarshal([]byte(manifest.Knowledge.EmbeddingModel), embeddingModelInfo); err != nil {
+			return nil, fmt.Errorf("manifest embedding model invalid: %v", err)
+		}
+	}
+	embeddingModel, err := json.Marshal(embeddingModelInfo)
+	if err != nil {
+		return nil, err
+	}
+	knowledge := &model.KnowledgeBase{
+		KnowledgeId:    generator.GetGenerator().NewID(),
+		Name:           name,
+		Description:    description,
+		EmbeddingModel: string(embeddingModel),
+		CreatedAt:      time.Now().UnixMilli(),
+		UpdatedAt:      time.Now().UnixMilli(),
+		UserId:         task.UserId,
+		OrgId:          task.OrgId,
+	}
+	if err = orm.CreateKnowledge(ctx, knowledge, embeddingModelInfo.ModelId); err != nil {
+		return nil, err
+	}
+	return knowledge, nil
+}
+
+// bindKnowledgeTag 按名称复用已有标签，不存在时新建
+func bindKnowledgeTag(ctx context.Context, knowledge *model.KnowledgeBase, tagList []string) error {
+	if len(tagList) == 0 {
+		return nil
+	}
+	var relationList []*model.KnowledgeTagRelation
+	for _, name := range tagList {
+		tag, err := orm.SelectKnowledgeTagByName(ctx, knowledge.UserId, knowledge.OrgId, name)
+		if err != nil {
+			return err
+		}
+		if tag == nil {
+			tag = &model.KnowledgeTag{
+				TagId:     generator.GetGenerator().NewID(),
+				Name:      name,
+				CreatedAt: time.Now().UnixMilli(),
+				UpdatedAt: time.Now().UnixMilli(),
+				UserId:    knowledge.UserId,
+				OrgId:     knowledge.OrgId,
+			}
+			if err = orm.CreateKnowledgeTag(ctx, tag); err != nil {
+				return err
+			}
+		}
+		relationList = append(relationList, &model.KnowledgeTagRelation{
+			TagId:       tag.TagId,
+			KnowledgeId: knowledge.KnowledgeId,
+			CreatedAt:   time.Now().UnixMilli(),
+			UpdatedAt:   time.Now().UnixMilli(),
+			UserId:      knowledge.UserId,
+			OrgId:       knowledge.OrgId,
+		})
+	}
+	return orm.BindKnowledgeTag(ctx, relationList, knowledge.KnowledgeId)
+}
+
+// importKnowledgeDoc 上传文档原文件并创建文档，有切片快照时记录为待恢复版本
+func importKnowledgeDoc(ctx context.Context, reader *zip.Reader, knowledge *model.KnowledgeBase, exportDoc *ExportDoc) error {
+	//1.上传原文件
+	filePath, fileSize := exportDoc.Url, exportDoc.FileSize
+	if exportDoc.FileType != model.UrlFileType {
+		file, size, err := openDocFile(reader, exportDoc.DocId)
+		if err != nil {
+			return err
+		}
+		fileName := generator.GetGenerator().NewID() + exportDoc.FileType
+		filePath, fileSize, err = service.UploadFile(ctx, config.GetConfig().Minio.KnowledgeDir, fileName, file, size)
+		_ = file.Close()
+		if err != nil {
+			return err
+		}
+	}
+	//2.构造文档及导入任务
+	importTask, err := buildImportTask(knowledge, exportDoc, filePath, fileSize)
+	if err != nil {
+		return err
+	}
+	doc := &model.KnowledgeDoc{
+		DocId:        generator.GetGenerator().NewID(),
+		ImportTaskId: importTask.ImportId,
+		KnowledgeId:  knowledge.KnowledgeId,
+		FilePath:     filePath,
+		FilePathMd5:  util.MD5(filePath),
+		Name:         exportDoc.Name,
+		FileType:     exportDoc.FileType,
+		FileSize:     fileSize,
+		Status:       model.DocInit,
+		CreatedAt:    time.Now().UnixMilli(),
+		UpdatedAt:    time.Now().UnixMilli(),
+		UserId:       knowledge.UserId,
+		OrgId:        knowledge.OrgId,
+	}
+	//3.切片快照
+	var version *model.KnowledgeDocVersion
+	if exportDoc.HasSegment {
+		snapshotList, err := readSegment(reader, exportDoc.DocId)
+		if err != nil {
+			return err
+		}
+		snapshot, err := json.Marshal(snapshotList)
+		if err != nil {
+			return err
+		}
+		version = &model.KnowledgeDocVersion{
+			SegmentCount:    len(snapshotList),
+			SegmentSnapshot: string(snapshot),
+		}
+	}
+	return orm.CreateKnowledgeBundleDoc(ctx, knowledge, doc, importTask, version)
+}
+
+func buildImportTask(knowledge *model.KnowledgeBase, exportDoc *ExportDoc, filePath string, fileSize int64) (*model.KnowledgeImportTask, error) {
+	importType := model.FileImportType
+	if exportDoc.FileType == model.UrlFileType {
+		importType = model.UrlImportType
+	}
+	docImportInfo, err := json.Marshal(&model.DocImportInfo{
+		DocInfoList: []*model.DocInfo{{
+			DocName: exportDoc.Name,
+			DocUrl:  filePath,
+			DocType: exportDoc.FileType,
+			DocSize: fileSize,
+		}},
+	})
+	if err != nil {
+		return nil, err
+	}
+	var metaList []*model.KnowledgeDocMeta
+	for _, meta := range exportDoc.MetaList {
+		metaList = append(metaList, &model.KnowledgeDocMeta{
+			Key:       meta.Key,
+			Value:     meta.Value,
+			ValueType: meta.ValueType,
+			Rule:      meta.Rule,
+		})
+	}
+	var metaData string
+	if len(metaList) > 0 {
+		data, err := json.Marshal(&model.DocImportMetaData{DocMetaDataList: metaList})
+		if err != nil {
+			return nil, err
+		}
+		metaData = string(data)
+	}
+	return &model.KnowledgeImportTask{
+		ImportId:      generator.GetGenerator().NewID(),
+		KnowledgeId:   knowledge.KnowledgeId,
+		ImportType:    importType,
+		Status:        model.KnowledgeImportFinish,
+		DocInfo:       string(docImportInfo),
+		SegmentConfig: exportDoc.SegmentConfig,
+		DocAnalyzer:   exportDoc.DocAnalyzer,
+		OcrModelId:    exportDoc.OcrModelId,
+		DocPreProcess: exportDoc.DocPreProcess,
+		MetaData:      metaData,
+		CreatedAt:     time.Now().UnixMilli(),
+		UpdatedAt:     time.Now().UnixMilli(),
+		UserId:        knowledge.UserId,
+		OrgId:         knowledge.OrgId,
+	}, nil
+}
@@ -0,0 +1,88 @@
+package knowledge_bundle
+
+import (
+	"archive/zip"
+	"bytes"
+	"io"
+	"testing"
+
+	"github.com/UnicomAI/wanwu/internal/knowledge-service/client/model"
+)
+
+func buildBundle(t *testing.T, manifest *Manifest, files map[string]string, segments map[string][]*model.DocSegmentSnapshot) *zip.Reader {
+	buf := &bytes.Buffer{}
+	writer := zip.NewWriter(buf)
+	for docId, content := range files {
+		w, err := writer.Create(docFilePath(docId))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err = w.Write([]byte(content)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	for docId, snapshotList := range segments {
+		if err := writeJson(writer, docSegmentPath(docId), snapshotList); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := writeJson(writer, manifestFileName, manifest); err != nil {
+		t.Fatal(err)
+	}
+	if err := writer.Close(); err != nil {
+		t.Fatal(err)
+	}
+	reader, err := zip.NewReader(bytes.NewReader(buf.Bytes()), int64(buf.Len()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return reader
+}
+
+func TestReadBundle(t *testing.T) {
+	manifest := &Manifest{
+		Version:   manifestVersion,
+		Knowledge: &Knowledge{Name: "kb", EmbeddingModel: `{"modelId":"1"}`},
+		TagList:   []string{"tag"},
+		DocList:   []*ExportDoc{{DocId: "d1", Name: "a.txt", FileType: ".txt", HasSegment: true}},
+	}
+	reader := buildBundle(t, manifest, map[string]string{"d1": "hello"}, map[string][]*model.DocSegmentSnapshot{
+		"d1": {{ContentNum: 1, Content: "hello", Status: true, Children: []string{"he", "llo"}}},
+	})
+
+	got, err := readManifest(reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got.Knowledge.Name != "kb" || len(got.DocList) != 1 || got.TagList[0] != "tag" {
+		t.Fatalf("readManifest = %+v", got)
+	}
+	file, size, err := openDocFile(reader, "d1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	content, _ := io.ReadAll(file)
+	_ = file.Close()
+	if string(content) != "hello" || size != 5 {
+		t.Fatalf("openDocFile = %q, %d", content, size)
+	}
+	snapshotList, err := readSegment(reader, "d1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(snapshotList) != 1 || len(snapshotList[0].Children) != 2 {
+		t.Fatalf("readSegment = %+v", snapshotList)
+	}
+}
+
+func TestReadManifestInvalid(t *testing.T) {
+	for _, manifest := range []*Manifest{
+		{Version: manifestVersion + 1, Knowledge: &Knowledge{}},
+		{Version: manifestVersion},
+		{Version: manifestVersion, Knowledge: &Knowledge{}, DocList: []*ExportDoc{{DocId: "../d1"}}},
+	} {
+		if _, err := readManifest(buildBundle(t, manifest, nil, nil)); err == nil {
+			t.Errorf("readManifest(%+v) want error", manifest)
+		}
+	}
+}
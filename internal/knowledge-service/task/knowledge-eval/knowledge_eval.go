@@ -0,0 +1,160 @@
+package knowledge_eval
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+
+	"github.com/UnicomAI/wanwu/internal/knowledge-service/client/model"
+	"github.com/UnicomAI/wanwu/internal/knowledge-service/client/orm"
+	"github.com/UnicomAI/wanwu/internal/knowledge-service/service"
+	"github.com/UnicomAI/wanwu/pkg/log"
+	"github.com/UnicomAI/wanwu/pkg/util"
+)
+
+// DoKnowledgeEval 执行检索评测：逐个问题检索并计算指标，单个问题检索失败时该问题指标记为0，失败时记录失败原因
+func DoKnowledgeEval(ctx context.Context, run *model.KnowledgeEvalRun) (err error) {
+	defer util.PrintPanicStackWithCall(func(panicOccur bool, err2 error) {
+		if panicOccur {
+			log.Errorf("do knowledge eval panic: %v", err2)
+			err = errors.New("检索评测异常")
+		}
+		if err != nil {
+			err1 := orm.UpdateKnowledgeEvalRun(ctx, run.Id, map[string]interface{}{
+				"status":    model.KnowledgeEvalFail,
+				"error_msg": err.Error(),
+			})
+			if err1 != nil {
+				log.Errorf("update knowledge eval run %s fail status err: %v", run.RunId, err1)
+			}
+		}
+	})
+	//1.查询知识库、问题及检索参数
+	knowledge, err := orm.SelectKnowledgeById(ctx, run.KnowledgeId, "", "")
+	if err != nil {
+		return err
+	}
+	questionList, err := orm.SelectKnowledgeEvalQuestionList(ctx, run.DatasetId)
+	if err != nil {
+		return err
+	}
+	fileNameMap, err := buildDocFileNameMap(ctx, knowledge)
+	if err != nil {
+		return err
+	}
+	var hitParams = &service.KnowledgeHitParams{}
+	if err = json.Unmarshal([]byte(run.HitParams), hitParams); err != nil {
+		return err
+	}
+	err = orm.UpdateKnowledgeEvalRun(ctx, run.Id, map[string]interface{}{
+		"status":      model.KnowledgeEvalProcessing,
+		"total_count": len(questionList),
+	})
+	if err != nil {
+		return err
+	}
+	//2.逐个问题检索并计算指标
+	var metricsList []Metrics
+	for i, question := range questionList {
+		result, metrics := evalQuestion(ctx, *hitParams, question, run.TopK, fileNameMap)
+		if err = orm.CreateKnowledgeEvalResult(ctx, run, result, i+1); err != nil {
+			return err
+		}
+		metricsList = append(metricsList, metrics)
+	}
+	//3.汇总指标
+	metrics := average(metricsList)
+	return orm.UpdateKnowledgeEvalRun(ctx, run.Id, map[string]interface{}{
+		"status":   model.KnowledgeEvalSuccess,
+		"recall":   metrics.Recall,
+		"mrr":      metrics.Mrr,
+		"ndcg":     metrics.Ndcg,
+		"hit_rate": metrics.HitRate,
+	})
+}
+
+// evalQuestion 检索单个问题并计算指标，期望分段不为空时按分段id判断相关性，否则按文档id判断
+func evalQuestion(ctx context.Context, hitParams service.KnowledgeHitParams, question *model.KnowledgeEvalQuestion, topK int, fileNameMap map[string]string) (*model.KnowledgeEvalResult, Metrics) {
+	result := &model.KnowledgeEvalResult{QuestionId: question.QuestionId, HitList: "[]"}
+	expectedDocIds, err := unmarshalIdList(question.ExpectedDocIds)
+	if err != nil {
+		result.ErrorMsg = err.Error()
+		return result, Metrics{}
+	}
+	expectedContentIds, err := unmarshalIdList(question.ExpectedContentIds)
+	if err != nil {
+		result.ErrorMsg = err.Error()
+		return result, Metrics{}
+	}
+	hitParams.Question = question.Question
+	resp, err := service.RagKnowledgeHit(ctx, &hitParams)
+	if err != nil {
+		log.Errorf("knowledge eval question %s hit err: %v", question.QuestionId, err)
+		result.ErrorMsg = err.Error()
+		return result, Metrics{}
+	}
+	var hitList = make([]*model.KnowledgeEvalHit, 0)
+	var hitKeys []string
+	if resp.Data != nil {
+		for i, search := range resp.Data.SearchList {
+			hit := &model.KnowledgeEvalHit{
+				DocId:     fileNameMap[search.Title],
+				ContentId: search.ContentId,
+				Title:     search.Title,
+				Snippet:   search.Snippet,
+			}
+			if i < len(resp.Data.Score) {
+				hit.Score = resp.Data.Score[i]
+			}
+			hitList = append(hitList, hit)
+			if len(expectedContentIds) > 0 {
+				hitKeys = append(hitKeys, hit.ContentId)
+			} else {
+				hitKeys = append(hitKeys, hit.DocId)
+			}
+		}
+	}
+	expected := expectedContentIds
+	if len(expected) == 0 {
+		expected = expectedDocIds
+	}
+	metrics, relevantList := evaluate(hitKeys, expected, topK)
+	for i, relevant := range relevantList {
+		hitList[i].Relevant = relevant
+	}
+	data, err := json.Marshal(hitList)
+	if err != nil {
+		result.ErrorMsg = err.Error()
+		return result, Metrics{}
+	}
+	result.HitList = string(data)
+	result.Recall = metrics.Recall
+	result.ReciprocalRank = metrics.Mrr
+	result.Ndcg = metrics.Ndcg
+	result.Hit = metrics.HitRate
+	return result, metrics
+}
+
+// buildDocFileNameMap 构造rag文件名到文档id的映射，检索结果的标题为rag文件名
+func buildDocFileNameMap(ctx context.Context, knowledge *model.KnowledgeBase) (map[string]string, error) {
+	docList, err := orm.GetDocListByKnowledgeId(ctx, "", "", knowledge.KnowledgeId)
+	if err != nil {
+		return nil, err
+	}
+	fileNameMap := make(map[string]string, len(docList))
+	for _, doc := range docList {
+		fileNameMap[service.RebuildFileName(doc.DocId, doc.FileType, doc.Name)] = doc.DocId
+	}
+	return fileNameMap, nil
+}
+
+func unmarshalIdList(idList string) ([]string, error) {
+	if idList == "" {
+		return nil, nil
+	}
+	var retList []string
+	if err := json.Unmarshal([]byte(idList), &retList); err != nil {
+		return nil, err
+	}
+	return retList, nil
+}
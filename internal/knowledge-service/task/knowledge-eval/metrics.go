@@ -0,0 +1,76 @@
+package knowledge_eval
+
+import (
+	"math"
+)
+
+// Metrics 检索评测指标，单个问题的Mrr为倒数排名、HitRate为是否命中
+type Metrics struct {
+	Recall  float64
+	Mrr     float64
+	Ndcg    float64
+	HitRate float64
+}
+
+// evaluate 按二元相关性计算前k条检索结果的指标：
+// hitKeys 为检索结果依次对应的文档或分段id，expected 为期望命中的id；
+// 同一id重复出现时只有第一次计为相关，避免同一文档的多个分段重复计分
+func evaluate(hitKeys []string, expected []string, k int) (Metrics, []bool) {
+	relevantList := make([]bool, len(hitKeys))
+	expectedSet := make(map[string]bool, len(expected))
+	for _, key := range expected {
+		expectedSet[key] = true
+	}
+	if len(expectedSet) == 0 || k <= 0 {
+		return Metrics{}, relevantList
+	}
+	var metrics Metrics
+	var found int
+	var dcg float64
+	for i, key := range hitKeys {
+		if i >= k {
+			break
+		}
+		if !expectedSet[key] {
+			continue
+		}
+		relevantList[i] = true
+		delete(expectedSet, key)
+		found++
+		dcg += 1 / math.Log2(float64(i+2))
+		if metrics.Mrr == 0 {
+			metrics.Mrr = 1 / float64(i+1)
+		}
+	}
+	expectedCount := found + len(expectedSet)
+	var idcg float64
+	for i := 0; i < expectedCount && i < k; i++ {
+		idcg += 1 / math.Log2(float64(i+2))
+	}
+	metrics.Recall = float64(found) / float64(expectedCount)
+	metrics.Ndcg = dcg / idcg
+	if found > 0 {
+		metrics.HitRate = 1
+	}
+	return metrics, relevantList
+}
+
+// average 计算各问题指标的平均值
+func average(metricsList []Metrics) Metrics {
+	var ret Metrics
+	if len(metricsList) == 0 {
+		return ret
+	}
+	for _, metrics := range metricsList {
+		ret.Recall += metrics.Recall
+		ret.Mrr += metrics.Mrr
+		ret.Ndcg += metrics.Ndcg
+		ret.HitRate += metrics.HitRate
+	}
+	count := float64(len(metricsList))
+	ret.Recall /= count
+	ret.Mrr /= count
+	ret.Ndcg /= count
+	ret.HitRate /= count
+	return ret
+}
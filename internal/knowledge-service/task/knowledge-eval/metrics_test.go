@@ -0,0 +1,72 @@
+package knowledge_eval
+
+import (
+	"math"
+	"testing"
+)
+
+func TestEvaluate(t *testing.T) {
+	cases := []struct {
+		name     string
+		hitKeys  []string
+		expected []string
+		k        int
+		want     Metrics
+		relevant []bool
+	}{
+		{
+			name:     "first hit",
+			hitKeys:  []string{"a", "b", "c"},
+			expected: []string{"a"},
+			k:        3,
+			want:     Metrics{Recall: 1, Mrr: 1, Ndcg: 1, HitRate: 1},
+			relevant: []bool{true, false, false},
+		},
+		{
+			name:     "second hit with duplicate doc",
+			hitKeys:  []string{"x", "b", "b", "a"},
+			expected: []string{"a", "b"},
+			k:        3,
+			want:     Metrics{Recall: 0.5, Mrr: 0.5, Ndcg: (1 / math.Log2(3)) / (1 + 1/math.Log2(3)), HitRate: 1},
+			relevant: []bool{false, true, false, false},
+		},
+		{
+			name:     "miss",
+			hitKeys:  []string{"x", "y"},
+			expected: []string{"a"},
+			k:        2,
+			want:     Metrics{},
+			relevant: []bool{false, false},
+		},
+		{
+			name:     "no expected",
+			hitKeys:  []string{"a"},
+			k:        1,
+			want:     Metrics{},
+			relevant: []bool{false},
+		},
+	}
+	for _, c := range cases {
+		got, relevant := evaluate(c.hitKeys, c.expected, c.k)
+		if math.Abs(got.Recall-c.want.Recall) > 1e-9 || math.Abs(got.Mrr-c.want.Mrr) > 1e-9 ||
+			math.Abs(got.Ndcg-c.want.Ndcg) > 1e-9 || got.HitRate != c.want.HitRate {
+			t.Errorf("%s: evaluate = %+v, want %+v", c.name, got, c.want)
+		}
+		for i := range relevant {
+			if relevant[i] != c.relevant[i] {
+				t.Errorf("%s: relevant = %v, want %v", c.name, relevant, c.relevant)
+				break
+			}
+		}
+	}
+}
+
+func TestAverage(t *testing.T) {
+	got := average([]Metrics{{Recall: 1, Mrr: 1, Ndcg: 1, HitRate: 1}, {}})
+	if got != (Metrics{Recall: 0.5, Mrr: 0.5, Ndcg: 0.5, HitRate: 0.5}) {
+		t.Errorf("average = %+v", got)
+	}
+	if average(nil) != (Metrics{}) {
+		t.Errorf("average(nil) not empty")
+	}
+}
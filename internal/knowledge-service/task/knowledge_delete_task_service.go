@@ -155,6 +155,22 @@ func deleteKnowledgeByKnowledgeId(ctx context.Context, taskCtx string) Result {
 		if err != nil {
 			return err
 		}
+		err = orm.DeleteKnowledgePermissionByKnowledgeId(tx, knowledge.KnowledgeId)
+		if err != nil {
+			return err
+		}
+		err = orm.DeleteKnowledgeDocSyncByKnowledgeId(tx, knowledge.KnowledgeId)
+		if err != nil {
+			return err
+		}
+		err = orm.DeleteKnowledgeConnectorByKnowledgeId(tx, knowledge.KnowledgeId)
+		if err != nil {
+			return err
+		}
+		err = orm.DeleteKnowledgeEvalByKnowledgeId(tx, knowledge.KnowledgeId)
+		if err != nil {
+			return err
+		}
 		return nil
 	})
 	return Result{Error: err}
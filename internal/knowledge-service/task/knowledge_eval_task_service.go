@@ -0,0 +1,129 @@
+package task
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"sync"
+
+	"github.com/UnicomAI/wanwu/internal/knowledge-service/client/model"
+	"github.com/UnicomAI/wanwu/internal/knowledge-service/client/orm"
+	async_task_pkg "github.com/UnicomAI/wanwu/internal/knowledge-service/pkg/async-task"
+	knowledge_eval "github.com/UnicomAI/wanwu/internal/knowledge-service/task/knowledge-eval"
+	"github.com/UnicomAI/wanwu/pkg/log"
+	"github.com/UnicomAI/wanwu/pkg/util"
+	async "github.com/gromitlee/go-async"
+	"github.com/gromitlee/go-async/pkg/async/async_task"
+)
+
+var knowledgeEvalTask = &KnowledgeEvalTask{Del: true}
+
+type KnowledgeEvalTask struct {
+	Wg  sync.WaitGroup
+	Del bool // 是否需要自动清理
+}
+
+func init() {
+	async_task_pkg.AddContainer(knowledgeEvalTask)
+}
+
+func (t *KnowledgeEvalTask) BuildServiceType() uint32 {
+	return async_task_pkg.KnowledgeEvalTaskType
+}
+
+func (t *KnowledgeEvalTask) InitTask() error {
+	if err := async.RegisterTask(t.BuildServiceType(), func() async_task.ITask {
+		return knowledgeEvalTask
+	}); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (t *KnowledgeEvalTask) SubmitTask(ctx context.Context, params interface{}) (err error) {
+	if params == nil {
+		return errors.New("参数不能为空")
+	}
+	paramStr, err := json.Marshal(params)
+	if err != nil {
+		return err
+	}
+	var taskId uint32
+	taskId, err = async.CreateTask(ctx, "", "KnowledgeEvalTask", t.BuildServiceType(), string(paramStr), true)
+	log.Infof("knowledge eval task %d ", taskId)
+	return err
+}
+
+func (t *KnowledgeEvalTask) Running(ctx context.Context, taskCtx string, stop <-chan struct{}) <-chan async_task.IReport {
+	reportCh := make(chan async_task.IReport)
+	t.Wg.Add(1)
+	go func() {
+		defer util.PrintPanicStack()
+		defer t.Wg.Wait()
+		defer t.Wg.Done()
+		defer close(reportCh)
+
+		r := &report{phase: async_task.RunPhaseNormal, del: t.Del, ctx: taskCtx}
+		defer func() {
+			reportCh <- r.clone()
+		}()
+
+		//执行检索评测
+		systemStop, err := t.runStep(ctx, taskCtx, stop)
+		if systemStop {
+			log.Infof("system stop")
+			return
+		}
+		if err != nil {
+			log.Errorf("executeKnowledgeEvalTask err: %s", err)
+			r.phase = async_task.RunPhaseFailed
+			return
+		} else {
+			r.phase = async_task.RunPhaseFinished
+			return
+		}
+	}()
+
+	return reportCh
+}
+
+func (t *KnowledgeEvalTask) Deleting(ctx context.Context, taskCtx string, stop <-chan struct{}) <-chan async_task.IReport {
+	return CommonDeleting(ctx, taskCtx, stop, &t.Wg)
+}
+
+func (t *KnowledgeEvalTask) runStep(ctx context.Context, taskCtx string, stop <-chan struct{}) (bool, error) {
+	ret := make(chan Result, 1)
+	go func() {
+		defer util.PrintPanicStack()
+		defer close(ret)
+		ret <- executeKnowledgeEval(ctx, taskCtx)
+	}()
+	for {
+		select {
+		case <-ctx.Done():
+			return false, nil
+		case <-stop:
+			return true, nil
+		case result := <-ret:
+			return false, result.Error
+		}
+	}
+}
+
+func executeKnowledgeEval(ctx context.Context, taskCtx string) Result {
+	var params = &async_task_pkg.KnowledgeEvalTaskParams{}
+	err := json.Unmarshal([]byte(taskCtx), params)
+	if err != nil {
+		log.Errorf("unmarshal json err: %s", err)
+		return Result{Error: err}
+	}
+	run, err := orm.SelectKnowledgeEvalRunById(ctx, params.RunId)
+	if err != nil {
+		return Result{Error: err}
+	}
+	if run.Status != model.KnowledgeEvalInit {
+		//任务已执行，服务重启后不再重复执行
+		return Result{}
+	}
+	return Result{Error: knowledge_eval.DoKnowledgeEval(ctx, run)}
+}
@@ -18,4 +18,13 @@ type IClient interface {
 
 	GetModelById(ctx context.Context, modelId uint32) (*model.ModelImported, *errs.Status)
 	GetModelByIds(ctx context.Context, modelIds []uint32) ([]*model.ModelImported, *errs.Status)
+
+	RecordModelUsage(ctx context.Context, usage *model.ModelUsage) *errs.Status
+	GetModelUsageStats(ctx context.Context, groupBy, orgId, userId, modelId, appId string, startAt, endAt int64) ([]*model.ModelUsageStat, *errs.Status)
+	GetModelTokensUsed(ctx context.Context, orgId, userId string, startAt int64) (int64, *errs.Status)
+
+	SetModelQuota(ctx context.Context, quota *model.ModelQuota) *errs.Status
+	DeleteModelQuota(ctx context.Context, orgId, userId string) *errs.Status
+	ListModelQuotas(ctx context.Context, orgId string) ([]*model.ModelQuota, *errs.Status)
+	GetModelQuota(ctx context.Context, orgId, userId string) (*model.ModelQuota, *errs.Status)
 }
@@ -11,5 +11,7 @@ type ModelImported struct {
 	ProviderConfig string `gorm:"column:provider_config;type:longtext;comment:某供应商下的模型配置"`
 	ModelDesc      string `gorm:"column:model_desc;type:longtext;comment:模型描述"`
 	PublishDate    string `gorm:"column:publish_date;type:varchar(100);comment:模型发布时间"`
+	RoutingPolicy  string `gorm:"column:routing_policy;type:longtext;comment:模型路由策略"`
+	CachePolicy    string `gorm:"column:cache_policy;type:longtext;comment:模型响应缓存策略"`
 	PublicModel
 }
@@ -0,0 +1,9 @@
+package model
+
+// ModelQuota 组织/用户的模型token配额，UserID为空表示组织配额
+type ModelQuota struct {
+	ID            uint32 `gorm:"primary_key;auto_increment;not null;"`
+	DailyTokens   int64  `gorm:"column:daily_tokens;type:bigint;comment:每日token上限，0表示不限制"`
+	MonthlyTokens int64  `gorm:"column:monthly_tokens;type:bigint;comment:每月token上限，0表示不限制"`
+	PublicModel
+}
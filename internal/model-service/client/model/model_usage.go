@@ -0,0 +1,41 @@
+package model
+
+const (
+	UsageStatusSuccess = "success"
+	UsageStatusFailed  = "failed"
+)
+
+// ModelUsage 模型调用用量流水
+type ModelUsage struct {
+	ID               uint32 `gorm:"primary_key;auto_increment;not null;"`
+	ModelID          string `gorm:"column:model_id;index:idx_model_usage_model_id;type:varchar(100);comment:模型ID"`
+	Provider         string `gorm:"column:provider;type:varchar(100);comment:模型供应商"`
+	ModelType        string `gorm:"column:model_type;type:varchar(100);comment:模型类型"`
+	Model            string `gorm:"column:model;type:varchar(100);comment:模型名称"`
+	AppID            string `gorm:"column:app_id;index:idx_model_usage_app_id;type:varchar(100);comment:调用方应用ID"`
+	AppType          string `gorm:"column:app_type;type:varchar(100);comment:调用方应用类型"`
+	PromptTokens     int64  `gorm:"column:prompt_tokens;type:bigint;comment:输入token数"`
+	CompletionTokens int64  `gorm:"column:completion_tokens;type:bigint;comment:输出token数"`
+	TotalTokens      int64  `gorm:"column:total_tokens;type:bigint;comment:总token数"`
+	LatencyMs        int64  `gorm:"column:latency_ms;type:bigint;comment:调用耗时（毫秒）"`
+	Status           string `gorm:"column:status;type:varchar(20);comment:调用状态"`
+	ErrMsg           string `gorm:"column:err_msg;type:text;comment:错误信息"`
+	PublicModel
+}
+
+// ModelUsageStat 模型用量汇总，非数据库表
+type ModelUsageStat struct {
+	ModelID          string `gorm:"column:model_id"`
+	Provider         string `gorm:"column:provider"`
+	ModelType        string `gorm:"column:model_type"`
+	Model            string `gorm:"column:model"`
+	OrgID            string `gorm:"column:org_id"`
+	AppID            string `gorm:"column:app_id"`
+	AppType          string `gorm:"column:app_type"`
+	Calls            int64  `gorm:"column:calls"`
+	FailedCalls      int64  `gorm:"column:failed_calls"`
+	PromptTokens     int64  `gorm:"column:prompt_tokens"`
+	CompletionTokens int64  `gorm:"column:completion_tokens"`
+	TotalTokens      int64  `gorm:"column:total_tokens"`
+	AvgLatencyMs     int64  `gorm:"column:avg_latency_ms"`
+}
@@ -2,6 +2,7 @@ package orm
 
 import (
 	"context"
+	"errors"
 
 	err_code "github.com/UnicomAI/wanwu/api/proto/err-code"
 	"github.com/UnicomAI/wanwu/internal/model-service/client/model"
@@ -17,6 +18,8 @@ func NewClient(ctx context.Context, db *gorm.DB) (*Client, error) {
 	// auto migrate
 	if err := db.AutoMigrate(
 		model.ModelImported{},
+		model.ModelUsage{},
+		model.ModelQuota{},
 	); err != nil {
 		return nil, err
 	}
@@ -25,6 +28,17 @@ func NewClient(ctx context.Context, db *gorm.DB) (*Client, error) {
 	}, nil
 }
 
+func (c *Client) transaction(ctx context.Context, fc func(tx *gorm.DB) *err_code.Status) *err_code.Status {
+	var status *err_code.Status
+	_ = c.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if status = fc(tx); status != nil {
+			return errors.New(status.String())
+		}
+		return nil
+	})
+	return status
+}
+
 func toErrStatus(key string, args ...string) *err_code.Status {
 	return &err_code.Status{
 		TextKey: key,
@@ -88,6 +88,8 @@ func (c *Client) UpdateModel(ctx context.Context, tab *model_client.ModelImporte
 		"model_icon_path": tab.ModelIconPath,
 		"publish_date":    tab.PublishDate,
 		"provider_config": tab.ProviderConfig,
+		"routing_policy":  tab.RoutingPolicy,
+		"cache_policy":    tab.CachePolicy,
 	}).Error; err != nil {
 		return toErrStatus("model_update_err", err.Error())
 	}
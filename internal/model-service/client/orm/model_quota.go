@@ -0,0 +1,71 @@
+package orm
+
+import (
+	"context"
+	"errors"
+
+	errs "github.com/UnicomAI/wanwu/api/proto/err-code"
+	model_client "github.com/UnicomAI/wanwu/internal/model-service/client/model"
+	"github.com/UnicomAI/wanwu/internal/model-service/client/orm/sqlopt"
+	"gorm.io/gorm"
+)
+
+func (c *Client) SetModelQuota(ctx context.Context, quota *model_client.ModelQuota) *errs.Status {
+	return c.transaction(ctx, func(tx *gorm.DB) *errs.Status {
+		var existing model_client.ModelQuota
+		err := sqlopt.SQLOptions(
+			sqlopt.WithOrgID(quota.OrgID),
+			sqlopt.WithQuotaUserID(quota.UserID),
+			sqlopt.WithUpdateLock(),
+		).Apply(tx).First(&existing).Error
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			if err := tx.Create(quota).Error; err != nil {
+				return toErrStatus("model_quota_set_err", err.Error())
+			}
+			return nil
+		} else if err != nil {
+			return toErrStatus("model_quota_set_err", err.Error())
+		}
+		if err := tx.Model(&existing).Updates(map[string]interface{}{
+			"daily_tokens":   quota.DailyTokens,
+			"monthly_tokens": quota.MonthlyTokens,
+		}).Error; err != nil {
+			return toErrStatus("model_quota_set_err", err.Error())
+		}
+		return nil
+	})
+}
+
+func (c *Client) DeleteModelQuota(ctx context.Context, orgId, userId string) *errs.Status {
+	if err := sqlopt.SQLOptions(
+		sqlopt.WithOrgID(orgId),
+		sqlopt.WithQuotaUserID(userId),
+	).Apply(c.db.WithContext(ctx)).Delete(&model_client.ModelQuota{}).Error; err != nil {
+		return toErrStatus("model_quota_delete_err", err.Error())
+	}
+	return nil
+}
+
+func (c *Client) ListModelQuotas(ctx context.Context, orgId string) ([]*model_client.ModelQuota, *errs.Status) {
+	var quotas []*model_client.ModelQuota
+	if err := sqlopt.WithOrgID(orgId).Apply(c.db.WithContext(ctx)).
+		Order("user_id ASC").Find(&quotas).Error; err != nil {
+		return nil, toErrStatus("model_quota_list_err", err.Error())
+	}
+	return quotas, nil
+}
+
+// GetModelQuota 获取组织（userId为空）或用户配额，未配置时返回nil
+func (c *Client) GetModelQuota(ctx context.Context, orgId, userId string) (*model_client.ModelQuota, *errs.Status) {
+	var quotas []*model_client.ModelQuota
+	if err := sqlopt.SQLOptions(
+		sqlopt.WithOrgID(orgId),
+		sqlopt.WithQuotaUserID(userId),
+	).Apply(c.db.WithContext(ctx)).Limit(1).Find(&quotas).Error; err != nil {
+		return nil, toErrStatus("model_quota_get_err", err.Error())
+	}
+	if len(quotas) == 0 {
+		return nil, nil
+	}
+	return quotas[0], nil
+}
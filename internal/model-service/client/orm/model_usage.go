@@ -0,0 +1,72 @@
+package orm
+
+import (
+	"context"
+	"strings"
+
+	errs "github.com/UnicomAI/wanwu/api/proto/err-code"
+	model_client "github.com/UnicomAI/wanwu/internal/model-service/client/model"
+	"github.com/UnicomAI/wanwu/internal/model-service/client/orm/sqlopt"
+)
+
+const (
+	UsageGroupByModel = "model"
+	UsageGroupByApp   = "app"
+	UsageGroupByOrg   = "org"
+)
+
+func (c *Client) RecordModelUsage(ctx context.Context, usage *model_client.ModelUsage) *errs.Status {
+	if err := c.db.WithContext(ctx).Create(usage).Error; err != nil {
+		return toErrStatus("model_usage_record_err", err.Error())
+	}
+	return nil
+}
+
+func (c *Client) GetModelUsageStats(ctx context.Context, groupBy, orgId, userId, modelId, appId string, startAt, endAt int64) ([]*model_client.ModelUsageStat, *errs.Status) {
+	var groupColumns []string
+	switch groupBy {
+	case UsageGroupByModel, "":
+		groupColumns = []string{"model_id", "provider", "model_type", "model"}
+	case UsageGroupByApp:
+		groupColumns = []string{"app_id", "app_type"}
+	case UsageGroupByOrg:
+		groupColumns = []string{"org_id"}
+	default:
+		return nil, toErrStatus("model_usage_stats_err", "invalid groupBy "+groupBy)
+	}
+	selects := append(groupColumns,
+		"COUNT(*) AS calls",
+		"SUM(CASE WHEN status = '"+model_client.UsageStatusFailed+"' THEN 1 ELSE 0 END) AS failed_calls",
+		"SUM(prompt_tokens) AS prompt_tokens",
+		"SUM(completion_tokens) AS completion_tokens",
+		"SUM(total_tokens) AS total_tokens",
+		"AVG(latency_ms) AS avg_latency_ms",
+	)
+	var stats []*model_client.ModelUsageStat
+	if err := sqlopt.SQLOptions(
+		sqlopt.WithOrgID(orgId),
+		sqlopt.WithUserID(userId),
+		sqlopt.WithModelID(modelId),
+		sqlopt.WithAppID(appId),
+		sqlopt.WithCreatedAtRange(startAt, endAt),
+	).Apply(c.db.WithContext(ctx)).Model(&model_client.ModelUsage{}).
+		Select(selects).Group(strings.Join(groupColumns, ", ")).Order("total_tokens DESC").
+		Scan(&stats).Error; err != nil {
+		return nil, toErrStatus("model_usage_stats_err", err.Error())
+	}
+	return stats, nil
+}
+
+// GetModelTokensUsed 组织（userId为空）或用户自startAt起的token用量
+func (c *Client) GetModelTokensUsed(ctx context.Context, orgId, userId string, startAt int64) (int64, *errs.Status) {
+	var used int64
+	if err := sqlopt.SQLOptions(
+		sqlopt.WithOrgID(orgId),
+		sqlopt.WithUserID(userId),
+		sqlopt.WithCreatedAtRange(startAt, 0),
+	).Apply(c.db.WithContext(ctx)).Model(&model_client.ModelUsage{}).
+		Select("COALESCE(SUM(total_tokens), 0)").Scan(&used).Error; err != nil {
+		return 0, toErrStatus("model_usage_stats_err", err.Error())
+	}
+	return used, nil
+}
@@ -122,6 +122,44 @@ func LikeDisplayNameOrModel(displayName string) SQLOption {
 	})
 }
 
+// WithQuotaUserID 精确匹配user_id，userID为空时匹配组织配额
+func WithQuotaUserID(userID string) SQLOption {
+	return funcSQLOption(func(db *gorm.DB) *gorm.DB {
+		return db.Where("user_id = ?", userID)
+	})
+}
+
+func WithModelID(modelID string) SQLOption {
+	return funcSQLOption(func(db *gorm.DB) *gorm.DB {
+		if modelID != "" {
+			return db.Where("model_id = ?", modelID)
+		}
+		return db
+	})
+}
+
+func WithAppID(appID string) SQLOption {
+	return funcSQLOption(func(db *gorm.DB) *gorm.DB {
+		if appID != "" {
+			return db.Where("app_id = ?", appID)
+		}
+		return db
+	})
+}
+
+// WithCreatedAtRange created_at在[startAt, endAt)之间，为0时不限制
+func WithCreatedAtRange(startAt, endAt int64) SQLOption {
+	return funcSQLOption(func(db *gorm.DB) *gorm.DB {
+		if startAt > 0 {
+			db = db.Where("created_at >= ?", startAt)
+		}
+		if endAt > 0 {
+			db = db.Where("created_at < ?", endAt)
+		}
+		return db
+	})
+}
+
 func WithUpdateLock() SQLOption {
 	return funcSQLOption(func(db *gorm.DB) *gorm.DB {
 		return db.Clauses(clause.Locking{
@@ -20,6 +20,8 @@ func (s *Service) ImportModel(ctx context.Context, req *model_service.ModelInfo)
 		IsActive:       req.IsActive,
 		PublishDate:    req.PublishDate,
 		ProviderConfig: req.ProviderConfig,
+		RoutingPolicy:  req.RoutingPolicy,
+		CachePolicy:    req.CachePolicy,
 		PublicModel: model.PublicModel{
 			OrgID:  req.OrgId,
 			UserID: req.UserId,
@@ -41,6 +43,8 @@ func (s *Service) UpdateModel(ctx context.Context, req *model_service.ModelInfo)
 		ModelIconPath:  req.ModelIconPath,
 		PublishDate:    req.PublishDate,
 		ProviderConfig: req.ProviderConfig,
+		RoutingPolicy:  req.RoutingPolicy,
+		CachePolicy:    req.CachePolicy,
 		PublicModel: model.PublicModel{
 			OrgID:  req.OrgId,
 			UserID: req.UserId,
@@ -102,6 +106,8 @@ func toModelInfo(modelInfo *model.ModelImported) *model_service.ModelInfo {
 		CreatedAt:      modelInfo.CreatedAt,
 		UpdatedAt:      modelInfo.UpdatedAt,
 		ModelDesc:      modelInfo.ModelDesc,
+		RoutingPolicy:  modelInfo.RoutingPolicy,
+		CachePolicy:    modelInfo.CachePolicy,
 	}
 }
 
@@ -0,0 +1,115 @@
+package model
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	errs "github.com/UnicomAI/wanwu/api/proto/err-code"
+	model_service "github.com/UnicomAI/wanwu/api/proto/model-service"
+	"github.com/UnicomAI/wanwu/internal/model-service/client/model"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+func (s *Service) SetModelQuota(ctx context.Context, req *model_service.ModelQuota) (*emptypb.Empty, error) {
+	if err := s.cli.SetModelQuota(ctx, &model.ModelQuota{
+		DailyTokens:   req.DailyTokens,
+		MonthlyTokens: req.MonthlyTokens,
+		PublicModel: model.PublicModel{
+			OrgID:  req.OrgId,
+			UserID: req.UserId,
+		},
+	}); err != nil {
+		return nil, errStatus(errs.Code_ModelQuota, err)
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func (s *Service) DeleteModelQuota(ctx context.Context, req *model_service.DeleteModelQuotaReq) (*emptypb.Empty, error) {
+	if err := s.cli.DeleteModelQuota(ctx, req.OrgId, req.UserId); err != nil {
+		return nil, errStatus(errs.Code_ModelQuota, err)
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func (s *Service) ListModelQuotas(ctx context.Context, req *model_service.ListModelQuotasReq) (*model_service.ModelQuotas, error) {
+	quotas, err := s.cli.ListModelQuotas(ctx, req.OrgId)
+	if err != nil {
+		return nil, errStatus(errs.Code_ModelQuota, err)
+	}
+	ret := &model_service.ModelQuotas{}
+	for _, quota := range quotas {
+		dailyUsed, monthlyUsed, err := s.quotaUsed(ctx, quota.OrgID, quota.UserID)
+		if err != nil {
+			return nil, errStatus(errs.Code_ModelQuota, err)
+		}
+		ret.Quotas = append(ret.Quotas, &model_service.ModelQuota{
+			OrgId:         quota.OrgID,
+			UserId:        quota.UserID,
+			DailyTokens:   quota.DailyTokens,
+			MonthlyTokens: quota.MonthlyTokens,
+			DailyUsed:     dailyUsed,
+			MonthlyUsed:   monthlyUsed,
+			CreatedAt:     quota.CreatedAt,
+			UpdatedAt:     quota.UpdatedAt,
+		})
+	}
+	return ret, nil
+}
+
+// CheckModelQuota 依次校验组织配额、用户配额
+func (s *Service) CheckModelQuota(ctx context.Context, req *model_service.CheckModelQuotaReq) (*model_service.CheckModelQuotaResp, error) {
+	if req.OrgId == "" {
+		return &model_service.CheckModelQuotaResp{}, nil
+	}
+	scopes := []string{""}
+	if req.UserId != "" {
+		scopes = append(scopes, req.UserId)
+	}
+	for _, userId := range scopes {
+		quota, err := s.cli.GetModelQuota(ctx, req.OrgId, userId)
+		if err != nil {
+			return nil, errStatus(errs.Code_ModelQuota, err)
+		}
+		if quota == nil || (quota.DailyTokens <= 0 && quota.MonthlyTokens <= 0) {
+			continue
+		}
+		dailyUsed, monthlyUsed, err := s.quotaUsed(ctx, req.OrgId, userId)
+		if err != nil {
+			return nil, errStatus(errs.Code_ModelQuota, err)
+		}
+		scope := "org"
+		if userId != "" {
+			scope = "user"
+		}
+		if quota.DailyTokens > 0 && dailyUsed >= quota.DailyTokens {
+			return &model_service.CheckModelQuotaResp{
+				Exceeded: true,
+				Reason:   fmt.Sprintf("%v daily token quota exceeded (%v/%v)", scope, dailyUsed, quota.DailyTokens),
+			}, nil
+		}
+		if quota.MonthlyTokens > 0 && monthlyUsed >= quota.MonthlyTokens {
+			return &model_service.CheckModelQuotaResp{
+				Exceeded: true,
+				Reason:   fmt.Sprintf("%v monthly token quota exceeded (%v/%v)", scope, monthlyUsed, quota.MonthlyTokens),
+			}, nil
+		}
+	}
+	return &model_service.CheckModelQuotaResp{}, nil
+}
+
+// quotaUsed 组织（userId为空）或用户当日、当月的token用量
+func (s *Service) quotaUsed(ctx context.Context, orgId, userId string) (int64, int64, *errs.Status) {
+	now := time.Now()
+	dayStart := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, now.Location())
+	monthStart := time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, now.Location())
+	dailyUsed, err := s.cli.GetModelTokensUsed(ctx, orgId, userId, dayStart.UnixMilli())
+	if err != nil {
+		return 0, 0, err
+	}
+	monthlyUsed, err := s.cli.GetModelTokensUsed(ctx, orgId, userId, monthStart.UnixMilli())
+	if err != nil {
+		return 0, 0, err
+	}
+	return dailyUsed, monthlyUsed, nil
+}
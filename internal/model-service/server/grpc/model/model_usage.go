@@ -0,0 +1,60 @@
+package model
+
+import (
+	"context"
+
+	errs "github.com/UnicomAI/wanwu/api/proto/err-code"
+	model_service "github.com/UnicomAI/wanwu/api/proto/model-service"
+	"github.com/UnicomAI/wanwu/internal/model-service/client/model"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+func (s *Service) RecordModelUsage(ctx context.Context, req *model_service.ModelUsage) (*emptypb.Empty, error) {
+	if err := s.cli.RecordModelUsage(ctx, &model.ModelUsage{
+		ModelID:          req.ModelId,
+		Provider:         req.Provider,
+		ModelType:        req.ModelType,
+		Model:            req.Model,
+		AppID:            req.AppId,
+		AppType:          req.AppType,
+		PromptTokens:     req.PromptTokens,
+		CompletionTokens: req.CompletionTokens,
+		TotalTokens:      req.TotalTokens,
+		LatencyMs:        req.LatencyMs,
+		Status:           req.Status,
+		ErrMsg:           req.ErrMsg,
+		PublicModel: model.PublicModel{
+			OrgID:  req.OrgId,
+			UserID: req.UserId,
+		},
+	}); err != nil {
+		return nil, errStatus(errs.Code_ModelUsage, err)
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func (s *Service) GetModelUsageStats(ctx context.Context, req *model_service.GetModelUsageStatsReq) (*model_service.ModelUsageStats, error) {
+	stats, err := s.cli.GetModelUsageStats(ctx, req.GroupBy, req.OrgId, req.UserId, req.ModelId, req.AppId, req.StartAt, req.EndAt)
+	if err != nil {
+		return nil, errStatus(errs.Code_ModelUsage, err)
+	}
+	ret := &model_service.ModelUsageStats{}
+	for _, stat := range stats {
+		ret.Stats = append(ret.Stats, &model_service.ModelUsageStat{
+			ModelId:          stat.ModelID,
+			Provider:         stat.Provider,
+			ModelType:        stat.ModelType,
+			Model:            stat.Model,
+			OrgId:            stat.OrgID,
+			AppId:            stat.AppID,
+			AppType:          stat.AppType,
+			Calls:            stat.Calls,
+			FailedCalls:      stat.FailedCalls,
+			PromptTokens:     stat.PromptTokens,
+			CompletionTokens: stat.CompletionTokens,
+			TotalTokens:      stat.TotalTokens,
+			AvgLatencyMs:     stat.AvgLatencyMs,
+		})
+	}
+	return ret, nil
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 
 	err_code "github.com/UnicomAI/wanwu/api/proto/err-code"
+	"github.com/UnicomAI/wanwu/internal/operate-service/client/model"
 	"github.com/UnicomAI/wanwu/internal/operate-service/client/orm"
 )
 
@@ -11,4 +12,22 @@ type IClient interface {
 	// 系统自定义配置
 	CreateSystemCustom(ctx context.Context, userID, orgID string, key orm.SystemCustomKey, mode orm.SystemCustomMode, custom orm.SystemCustom) *err_code.Status
 	GetSystemCustom(ctx context.Context, mode orm.SystemCustomMode) (*orm.SystemCustom, *err_code.Status)
+
+	// 审计日志
+	CreateAuditLog(ctx context.Context, auditLog *model.AuditLog) *err_code.Status
+	GetAuditLogList(ctx context.Context, filter orm.AuditLogFilter, offset, limit int32) ([]*model.AuditLog, int64, *err_code.Status)
+	ExportAuditLog(ctx context.Context, filter orm.AuditLogFilter) ([]*model.AuditLog, int64, *err_code.Status)
+	DeleteAuditLogBefore(ctx context.Context, before int64) (int64, *err_code.Status)
+
+	// webhook
+	CreateWebhook(ctx context.Context, webhook *model.Webhook) *err_code.Status
+	UpdateWebhook(ctx context.Context, webhook *model.Webhook) *err_code.Status
+	DeleteWebhook(ctx context.Context, orgID, webhookID string) *err_code.Status
+	GetWebhook(ctx context.Context, orgID, webhookID string) (*model.Webhook, *err_code.Status)
+	GetWebhookList(ctx context.Context, orgID string) ([]*model.Webhook, int64, *err_code.Status)
+	CreateWebhookDeliveries(ctx context.Context, deliveries []*model.WebhookDelivery) *err_code.Status
+	GetWebhookDeliveryList(ctx context.Context, orgID, webhookID, status string, offset, limit int32) ([]*model.WebhookDelivery, int64, *err_code.Status)
+	RetryWebhookDelivery(ctx context.Context, orgID, deliveryID string, now int64) *err_code.Status
+	ClaimWebhookDeliveries(ctx context.Context, now, lease int64, limit int) ([]*model.WebhookDelivery, *err_code.Status)
+	UpdateWebhookDeliveryResult(ctx context.Context, delivery *model.WebhookDelivery) *err_code.Status
 }
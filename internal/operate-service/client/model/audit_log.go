@@ -0,0 +1,25 @@
+package model
+
+type AuditLog struct {
+	ID        uint32 `gorm:"primary_key"`
+	AuditID   string `gorm:"uniqueIndex:idx_unique_audit_id;type:varchar(64)"`
+	CreatedAt int64  `gorm:"autoCreateTime:milli;index:idx_audit_log_created_at"`
+	// 组织ID
+	OrgID string `gorm:"index:idx_audit_log_org_id"`
+	// 操作人ID
+	UserID string `gorm:"index:idx_audit_log_user_id"`
+	// 路由权限标识（mid-wrap tag），多个以逗号分隔
+	Action string `gorm:"index:idx_audit_log_action"`
+	// 操作描述
+	ActionName string
+	Method     string
+	Path       string
+	// 操作对象ID
+	TargetID string `gorm:"index:idx_audit_log_target_id"`
+	Success  bool
+	Code     int64
+	ErrMsg   string `gorm:"type:text"`
+	ClientIP string
+	// 脱敏后的请求参数
+	Request string `gorm:"type:longtext"`
+}
@@ -0,0 +1,44 @@
+package model
+
+type Webhook struct {
+	ID        uint32 `gorm:"primary_key"`
+	WebhookID string `gorm:"uniqueIndex:idx_unique_webhook_id;type:varchar(64)"`
+	CreatedAt int64  `gorm:"autoCreateTime:milli"`
+	UpdatedAt int64  `gorm:"autoUpdateTime:milli"`
+	// 组织ID
+	OrgID string `gorm:"index:idx_webhook_org_id"`
+	// 创建人ID
+	UserID string
+	Name   string
+	// 接收地址
+	URL string `gorm:"type:varchar(1024)"`
+	// 签名密钥
+	Secret string
+	// 订阅的事件，多个以逗号分隔
+	Events string `gorm:"type:text"`
+	Enable bool
+}
+
+type WebhookDelivery struct {
+	ID         uint32 `gorm:"primary_key"`
+	DeliveryID string `gorm:"uniqueIndex:idx_unique_webhook_delivery_id;type:varchar(64)"`
+	CreatedAt  int64  `gorm:"autoCreateTime:milli;index:idx_webhook_delivery_created_at"`
+	UpdatedAt  int64  `gorm:"autoUpdateTime:milli"`
+	WebhookID  string `gorm:"index:idx_webhook_delivery_webhook_id"`
+	// 组织ID
+	OrgID string `gorm:"index:idx_webhook_delivery_org_id"`
+	// 事件ID，同一事件投递到多个webhook时相同
+	EventID string
+	Event   string
+	// 投递内容
+	Payload string `gorm:"type:longtext"`
+	// 投递状态 pending | success | dead
+	Status string `gorm:"index:idx_webhook_delivery_status_next_retry_at,priority:1"`
+	// 已投递次数
+	Attempts int32
+	// 下次投递时间（毫秒），投递中时为租约到期时间
+	NextRetryAt int64 `gorm:"index:idx_webhook_delivery_status_next_retry_at,priority:2"`
+	// 最近一次投递的响应码
+	LastCode  int32
+	LastError string `gorm:"type:text"`
+}
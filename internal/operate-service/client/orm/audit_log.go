@@ -0,0 +1,74 @@
+package orm
+
+import (
+	"context"
+
+	err_code "github.com/UnicomAI/wanwu/api/proto/err-code"
+	"github.com/UnicomAI/wanwu/internal/operate-service/client/model"
+	"github.com/UnicomAI/wanwu/internal/operate-service/client/orm/sqlopt"
+	"github.com/UnicomAI/wanwu/pkg/util"
+)
+
+// AuditLogExportLimit 单次导出审计日志的最大条数
+const AuditLogExportLimit = 10000
+
+// AuditLogFilter 审计日志查询条件，OrgID为空时不按组织过滤（系统视角）
+type AuditLogFilter struct {
+	OrgID     string
+	UserID    string
+	Action    string // 路由权限标识前缀
+	TargetID  string
+	Success   *bool // nil表示不过滤
+	StartTime int64
+	EndTime   int64
+}
+
+func (c *Client) CreateAuditLog(ctx context.Context, auditLog *model.AuditLog) *err_code.Status {
+	auditLog.AuditID = util.GenUUID()
+	if err := c.db.WithContext(ctx).Create(auditLog).Error; err != nil {
+		return toErrStatus("ope_audit_log_create", auditLog.Action, err.Error())
+	}
+	return nil
+}
+
+func (c *Client) GetAuditLogList(ctx context.Context, filter AuditLogFilter, offset, limit int32) ([]*model.AuditLog, int64, *err_code.Status) {
+	var count int64
+	var auditLogs []*model.AuditLog
+	db := auditLogFilterOptions(filter).Apply(c.db.WithContext(ctx)).Model(&model.AuditLog{})
+	if err := db.Count(&count).Error; err != nil {
+		return nil, 0, toErrStatus("ope_audit_log_list", err.Error())
+	}
+	if err := db.Order("created_at DESC").Offset(int(offset)).Limit(int(limit)).Find(&auditLogs).Error; err != nil {
+		return nil, 0, toErrStatus("ope_audit_log_list", err.Error())
+	}
+	return auditLogs, count, nil
+}
+
+// ExportAuditLog 按条件导出审计日志，最多返回 AuditLogExportLimit 条，total为满足条件的总数
+func (c *Client) ExportAuditLog(ctx context.Context, filter AuditLogFilter) ([]*model.AuditLog, int64, *err_code.Status) {
+	auditLogs, count, status := c.GetAuditLogList(ctx, filter, 0, AuditLogExportLimit)
+	if status != nil {
+		return nil, 0, toErrStatus("ope_audit_log_export", status.Args...)
+	}
+	return auditLogs, count, nil
+}
+
+// DeleteAuditLogBefore 删除指定时间（毫秒）之前的审计日志，返回删除条数
+func (c *Client) DeleteAuditLogBefore(ctx context.Context, before int64) (int64, *err_code.Status) {
+	ret := c.db.WithContext(ctx).Where("created_at < ?", before).Delete(&model.AuditLog{})
+	if ret.Error != nil {
+		return 0, toErrStatus("ope_audit_log_delete", ret.Error.Error())
+	}
+	return ret.RowsAffected, nil
+}
+
+func auditLogFilterOptions(filter AuditLogFilter) sqlopt.SQLOption {
+	return sqlopt.SQLOptions(
+		sqlopt.WithOrgID(filter.OrgID),
+		sqlopt.WithUserID(filter.UserID),
+		sqlopt.WithActionPrefix(filter.Action),
+		sqlopt.WithTargetID(filter.TargetID),
+		sqlopt.WithSuccess(filter.Success),
+		sqlopt.WithCreatedAtRange(filter.StartTime, filter.EndTime),
+	)
+}
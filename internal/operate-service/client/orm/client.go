@@ -1,6 +1,9 @@
 package orm
 
 import (
+	"context"
+	"errors"
+
 	err_code "github.com/UnicomAI/wanwu/api/proto/err-code"
 	"github.com/UnicomAI/wanwu/internal/operate-service/client/model"
 	"gorm.io/gorm"
@@ -27,6 +30,9 @@ func NewClient(db *gorm.DB) (*Client, error) {
 	// auto migrate
 	if err := db.AutoMigrate(
 		model.SystemCustom{},
+		model.AuditLog{},
+		model.Webhook{},
+		model.WebhookDelivery{},
 	); err != nil {
 		return nil, err
 	}
@@ -35,6 +41,17 @@ func NewClient(db *gorm.DB) (*Client, error) {
 	}, nil
 }
 
+func (c *Client) transaction(ctx context.Context, fc func(tx *gorm.DB) *err_code.Status) *err_code.Status {
+	var status *err_code.Status
+	_ = c.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if status = fc(tx); status != nil {
+			return errors.New(status.String())
+		}
+		return nil
+	})
+	return status
+}
+
 func toErrStatus(key string, args ...string) *err_code.Status {
 	return &err_code.Status{
 		TextKey: key,
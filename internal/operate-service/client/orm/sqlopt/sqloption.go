@@ -56,3 +56,67 @@ func WithKey(key string) SQLOption {
 		return db
 	})
 }
+
+// WithActionPrefix 按路由权限标识前缀过滤，如 permission 匹配 permission.user
+func WithActionPrefix(action string) SQLOption {
+	return funcSQLOption(func(db *gorm.DB) *gorm.DB {
+		if action != "" {
+			return db.Where("action LIKE ?", action+"%")
+		}
+		return db
+	})
+}
+
+func WithTargetID(targetID string) SQLOption {
+	return funcSQLOption(func(db *gorm.DB) *gorm.DB {
+		if targetID != "" {
+			return db.Where("target_id = ?", targetID)
+		}
+		return db
+	})
+}
+
+func WithSuccess(success *bool) SQLOption {
+	return funcSQLOption(func(db *gorm.DB) *gorm.DB {
+		if success != nil {
+			return db.Where("success = ?", *success)
+		}
+		return db
+	})
+}
+
+func WithCreatedAtRange(startTime, endTime int64) SQLOption {
+	return funcSQLOption(func(db *gorm.DB) *gorm.DB {
+		if startTime > 0 {
+			db = db.Where("created_at >= ?", startTime)
+		}
+		if endTime > 0 {
+			db = db.Where("created_at <= ?", endTime)
+		}
+		return db
+	})
+}
+
+func WithWebhookID(webhookID string) SQLOption {
+	return funcSQLOption(func(db *gorm.DB) *gorm.DB {
+		if webhookID != "" {
+			return db.Where("webhook_id = ?", webhookID)
+		}
+		return db
+	})
+}
+
+func WithDeliveryID(deliveryID string) SQLOption {
+	return funcSQLOption(func(db *gorm.DB) *gorm.DB {
+		return db.Where("delivery_id = ?", deliveryID)
+	})
+}
+
+func WithStatus(status string) SQLOption {
+	return funcSQLOption(func(db *gorm.DB) *gorm.DB {
+		if status != "" {
+			return db.Where("status = ?", status)
+		}
+		return db
+	})
+}
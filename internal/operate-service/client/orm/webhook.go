@@ -0,0 +1,194 @@
+package orm
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	err_code "github.com/UnicomAI/wanwu/api/proto/err-code"
+	"github.com/UnicomAI/wanwu/internal/operate-service/client/model"
+	"github.com/UnicomAI/wanwu/internal/operate-service/client/orm/sqlopt"
+	"github.com/UnicomAI/wanwu/pkg/util"
+	"gorm.io/gorm"
+)
+
+// webhook delivery status
+const (
+	WebhookDeliveryPending = "pending" // 待投递（含重试中）
+	WebhookDeliverySuccess = "success" // 投递成功
+	WebhookDeliveryDead    = "dead"    // 超过最大重试次数，进入死信
+)
+
+func (c *Client) CreateWebhook(ctx context.Context, webhook *model.Webhook) *err_code.Status {
+	webhook.WebhookID = util.GenUUID()
+	if err := c.db.WithContext(ctx).Create(webhook).Error; err != nil {
+		return toErrStatus("ope_webhook_create", err.Error())
+	}
+	return nil
+}
+
+// UpdateWebhook 更新webhook，Secret为空时不修改
+func (c *Client) UpdateWebhook(ctx context.Context, webhook *model.Webhook) *err_code.Status {
+	return c.transaction(ctx, func(tx *gorm.DB) *err_code.Status {
+		if _, status := getWebhook(tx, webhook.OrgID, webhook.WebhookID); status != nil {
+			return status
+		}
+		updates := map[string]interface{}{
+			"name":   webhook.Name,
+			"url":    webhook.URL,
+			"events": webhook.Events,
+			"enable": webhook.Enable,
+		}
+		if webhook.Secret != "" {
+			updates["secret"] = webhook.Secret
+		}
+		if err := sqlopt.SQLOptions(
+			sqlopt.WithOrgID(webhook.OrgID),
+			sqlopt.WithWebhookID(webhook.WebhookID),
+		).Apply(tx).Model(&model.Webhook{}).Updates(updates).Error; err != nil {
+			return toErrStatus("ope_webhook_update", err.Error())
+		}
+		return nil
+	})
+}
+
+// DeleteWebhook 删除webhook及其投递记录
+func (c *Client) DeleteWebhook(ctx context.Context, orgID, webhookID string) *err_code.Status {
+	return c.transaction(ctx, func(tx *gorm.DB) *err_code.Status {
+		if _, status := getWebhook(tx, orgID, webhookID); status != nil {
+			return status
+		}
+		if err := sqlopt.WithWebhookID(webhookID).Apply(tx).Delete(&model.WebhookDelivery{}).Error; err != nil {
+			return toErrStatus("ope_webhook_delete", err.Error())
+		}
+		if err := sqlopt.WithWebhookID(webhookID).Apply(tx).Delete(&model.Webhook{}).Error; err != nil {
+			return toErrStatus("ope_webhook_delete", err.Error())
+		}
+		return nil
+	})
+}
+
+// GetWebhook orgID为空时不按组织过滤
+func (c *Client) GetWebhook(ctx context.Context, orgID, webhookID string) (*model.Webhook, *err_code.Status) {
+	return getWebhook(c.db.WithContext(ctx), orgID, webhookID)
+}
+
+func (c *Client) GetWebhookList(ctx context.Context, orgID string) ([]*model.Webhook, int64, *err_code.Status) {
+	var webhooks []*model.Webhook
+	if err := sqlopt.WithOrgID(orgID).Apply(c.db.WithContext(ctx)).Order("id DESC").Find(&webhooks).Error; err != nil {
+		return nil, 0, toErrStatus("ope_webhook_list", err.Error())
+	}
+	return webhooks, int64(len(webhooks)), nil
+}
+
+func (c *Client) CreateWebhookDeliveries(ctx context.Context, deliveries []*model.WebhookDelivery) *err_code.Status {
+	if len(deliveries) == 0 {
+		return nil
+	}
+	for _, delivery := range deliveries {
+		delivery.DeliveryID = util.GenUUID()
+		delivery.Status = WebhookDeliveryPending
+	}
+	if err := c.db.WithContext(ctx).Create(deliveries).Error; err != nil {
+		return toErrStatus("ope_webhook_delivery_create", deliveries[0].Event, err.Error())
+	}
+	return nil
+}
+
+func (c *Client) GetWebhookDeliveryList(ctx context.Context, orgID, webhookID, status string, offset, limit int32) ([]*model.WebhookDelivery, int64, *err_code.Status) {
+	var count int64
+	var deliveries []*model.WebhookDelivery
+	db := sqlopt.SQLOptions(
+		sqlopt.WithOrgID(orgID),
+		sqlopt.WithWebhookID(webhookID),
+		sqlopt.WithStatus(status),
+	).Apply(c.db.WithContext(ctx)).Model(&model.WebhookDelivery{})
+	if err := db.Count(&count).Error; err != nil {
+		return nil, 0, toErrStatus("ope_webhook_delivery_list", err.Error())
+	}
+	if err := db.Order("id DESC").Offset(int(offset)).Limit(int(limit)).Find(&deliveries).Error; err != nil {
+		return nil, 0, toErrStatus("ope_webhook_delivery_list", err.Error())
+	}
+	return deliveries, count, nil
+}
+
+// RetryWebhookDelivery 将死信重新置为待投递，并重置投递次数
+func (c *Client) RetryWebhookDelivery(ctx context.Context, orgID, deliveryID string, now int64) *err_code.Status {
+	ret := sqlopt.SQLOptions(
+		sqlopt.WithOrgID(orgID),
+		sqlopt.WithDeliveryID(deliveryID),
+		sqlopt.WithStatus(WebhookDeliveryDead),
+	).Apply(c.db.WithContext(ctx)).Model(&model.WebhookDelivery{}).Updates(map[string]interface{}{
+		"status":        WebhookDeliveryPending,
+		"attempts":      0,
+		"next_retry_at": now,
+	})
+	if ret.Error != nil {
+		return toErrStatus("ope_webhook_delivery_retry", ret.Error.Error())
+	}
+	if ret.RowsAffected == 0 {
+		return toErrStatus("ope_webhook_delivery_not_dead", deliveryID)
+	}
+	return nil
+}
+
+// ClaimWebhookDeliveries 获取到期的待投递记录，并将其下次投递时间推迟lease毫秒作为租约，避免多个实例重复投递
+func (c *Client) ClaimWebhookDeliveries(ctx context.Context, now, lease int64, limit int) ([]*model.WebhookDelivery, *err_code.Status) {
+	var deliveries []*model.WebhookDelivery
+	if err := sqlopt.WithStatus(WebhookDeliveryPending).Apply(c.db.WithContext(ctx)).
+		Where("next_retry_at <= ?", now).Order("next_retry_at").Limit(limit).Find(&deliveries).Error; err != nil {
+		return nil, toErrStatus("ope_webhook_delivery_claim", err.Error())
+	}
+	var ret []*model.WebhookDelivery
+	for _, delivery := range deliveries {
+		res := c.db.WithContext(ctx).Model(&model.WebhookDelivery{}).
+			Where("id = ? AND status = ? AND next_retry_at = ?", delivery.ID, WebhookDeliveryPending, delivery.NextRetryAt).
+			Update("next_retry_at", now+lease)
+		if res.Error != nil {
+			return ret, toErrStatus("ope_webhook_delivery_claim", res.Error.Error())
+		}
+		if res.RowsAffected == 1 {
+			delivery.NextRetryAt = now + lease
+			ret = append(ret, delivery)
+		}
+	}
+	return ret, nil
+}
+
+// UpdateWebhookDeliveryResult 记录一次投递的结果
+func (c *Client) UpdateWebhookDeliveryResult(ctx context.Context, delivery *model.WebhookDelivery) *err_code.Status {
+	if err := c.db.WithContext(ctx).Model(&model.WebhookDelivery{}).Where("id = ?", delivery.ID).Updates(map[string]interface{}{
+		"status":        delivery.Status,
+		"attempts":      delivery.Attempts,
+		"next_retry_at": delivery.NextRetryAt,
+		"last_code":     delivery.LastCode,
+		"last_error":    delivery.LastError,
+	}).Error; err != nil {
+		return toErrStatus("ope_webhook_delivery_update", delivery.DeliveryID, err.Error())
+	}
+	return nil
+}
+
+// WebhookEvents webhook订阅的事件列表
+func WebhookEvents(webhook *model.Webhook) []string {
+	if webhook.Events == "" {
+		return nil
+	}
+	return strings.Split(webhook.Events, ",")
+}
+
+// --- internal ---
+
+func getWebhook(db *gorm.DB, orgID, webhookID string) (*model.Webhook, *err_code.Status) {
+	webhook := &model.Webhook{}
+	if err := sqlopt.SQLOptions(
+		sqlopt.WithOrgID(orgID),
+		sqlopt.WithWebhookID(webhookID),
+	).Apply(db).First(webhook).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, toErrStatus("ope_webhook_not_found", webhookID)
+		}
+		return nil, toErrStatus("ope_webhook_get", err.Error())
+	}
+	return webhook, nil
+}
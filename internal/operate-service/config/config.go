@@ -12,10 +12,12 @@ var (
 )
 
 type Config struct {
-	Server ServerConfig `json:"server" mapstructure:"server"`
-	Log    LogConfig    `json:"log" mapstructure:"log"`
-	DB     db.Config    `json:"db" mapstructure:"db"`
-	Redis  redis.Config `json:"redis" mapstructure:"redis"`
+	Server  ServerConfig  `json:"server" mapstructure:"server"`
+	Log     LogConfig     `json:"log" mapstructure:"log"`
+	DB      db.Config     `json:"db" mapstructure:"db"`
+	Redis   redis.Config  `json:"redis" mapstructure:"redis"`
+	Audit   AuditConfig   `json:"audit" mapstructure:"audit"`
+	Webhook WebhookConfig `json:"webhook" mapstructure:"webhook"`
 }
 
 type ServerConfig struct {
@@ -29,6 +31,18 @@ type LogConfig struct {
 	Logs  []log.Config `json:"logs" mapstructure:"logs"`
 }
 
+type AuditConfig struct {
+	RetentionDays int `json:"retention_days" mapstructure:"retention_days"` // 审计日志保留天数，小于等于0时不清理
+}
+
+type WebhookConfig struct {
+	Interval    int      `json:"interval" mapstructure:"interval"`         // 扫描待投递记录的间隔（秒）
+	Timeout     int      `json:"timeout" mapstructure:"timeout"`           // 单次投递超时（秒）
+	MaxAttempts int      `json:"max_attempts" mapstructure:"max_attempts"` // 最大投递次数，超过后进入死信
+	BatchSize   int      `json:"batch_size" mapstructure:"batch_size"`     // 每次扫描的最大投递条数
+	Allowlist   []string `json:"allowlist" mapstructure:"allowlist"`       // 允许投递的内网CIDR、IP或主机名，默认只允许公网地址
+}
+
 type DBConfig struct {
 	Name string `json:"name" mapstructure:"name"`
 }
@@ -0,0 +1,145 @@
+package operate
+
+import (
+	"context"
+	"time"
+
+	errs "github.com/UnicomAI/wanwu/api/proto/err-code"
+	operate_service "github.com/UnicomAI/wanwu/api/proto/operate-service"
+	"github.com/UnicomAI/wanwu/internal/operate-service/client/model"
+	"github.com/UnicomAI/wanwu/internal/operate-service/client/orm"
+	"github.com/UnicomAI/wanwu/pkg/log"
+	"github.com/UnicomAI/wanwu/pkg/util"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+const (
+	auditLogCleanInterval = time.Hour
+	auditLogCleanTimeout  = 10 * time.Minute
+
+	auditLogResultSuccess = 1
+	auditLogResultFail    = 2
+)
+
+func (s *Service) CreateAuditLog(ctx context.Context, req *operate_service.CreateAuditLogReq) (*emptypb.Empty, error) {
+	auditLog := req.GetAuditLog()
+	if err := s.cli.CreateAuditLog(ctx, &model.AuditLog{
+		OrgID:      auditLog.GetOrgId(),
+		UserID:     auditLog.GetUserId(),
+		Action:     auditLog.GetAction(),
+		ActionName: auditLog.GetActionName(),
+		Method:     auditLog.GetMethod(),
+		Path:       auditLog.GetPath(),
+		TargetID:   auditLog.GetTargetId(),
+		Success:    auditLog.GetSuccess(),
+		Code:       auditLog.GetCode(),
+		ErrMsg:     auditLog.GetErrMsg(),
+		ClientIP:   auditLog.GetClientIp(),
+		Request:    auditLog.GetRequest(),
+	}); err != nil {
+		return nil, errStatus(errs.Code_OperateAudit, err)
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func (s *Service) GetAuditLogList(ctx context.Context, req *operate_service.GetAuditLogListReq) (*operate_service.AuditLogList, error) {
+	auditLogs, total, err := s.cli.GetAuditLogList(ctx, toAuditLogFilter(req.Filter), (req.PageNo-1)*req.PageSize, req.PageSize)
+	if err != nil {
+		return nil, errStatus(errs.Code_OperateAudit, err)
+	}
+	return toProtoAuditLogList(auditLogs, total), nil
+}
+
+func (s *Service) ExportAuditLog(ctx context.Context, req *operate_service.ExportAuditLogReq) (*operate_service.AuditLogList, error) {
+	auditLogs, total, err := s.cli.ExportAuditLog(ctx, toAuditLogFilter(req.Filter))
+	if err != nil {
+		return nil, errStatus(errs.Code_OperateAudit, err)
+	}
+	return toProtoAuditLogList(auditLogs, total), nil
+}
+
+// StartAuditLogClean 定时清理超过保留天数的审计日志
+func (s *Service) StartAuditLogClean(retentionDays int) {
+	if retentionDays <= 0 || s.auditCleanStop != nil {
+		return
+	}
+	s.auditCleanStop = make(chan struct{})
+	stop := s.auditCleanStop
+	go func() {
+		defer util.PrintPanicStack()
+		ticker := time.NewTicker(auditLogCleanInterval)
+		defer ticker.Stop()
+		for {
+			s.cleanAuditLog(retentionDays)
+			select {
+			case <-ticker.C:
+			case <-stop:
+				return
+			}
+		}
+	}()
+}
+
+func (s *Service) StopAuditLogClean() {
+	if s.auditCleanStop != nil {
+		close(s.auditCleanStop)
+		s.auditCleanStop = nil
+	}
+}
+
+func (s *Service) cleanAuditLog(retentionDays int) {
+	ctx, cancel := context.WithTimeout(context.Background(), auditLogCleanTimeout)
+	defer cancel()
+	before := time.Now().AddDate(0, 0, -retentionDays).UnixMilli()
+	count, err := s.cli.DeleteAuditLogBefore(ctx, before)
+	if err != nil {
+		log.Errorf("clean audit log before %v err: %v", before, err.Args)
+		return
+	}
+	if count > 0 {
+		log.Infof("clean audit log before %v count: %v", before, count)
+	}
+}
+
+func toAuditLogFilter(filter *operate_service.AuditLogFilter) orm.AuditLogFilter {
+	ret := orm.AuditLogFilter{
+		OrgID:     filter.GetOrgId(),
+		UserID:    filter.GetUserId(),
+		Action:    filter.GetAction(),
+		TargetID:  filter.GetTargetId(),
+		StartTime: filter.GetStartTime(),
+		EndTime:   filter.GetEndTime(),
+	}
+	switch filter.GetResult() {
+	case auditLogResultSuccess:
+		success := true
+		ret.Success = &success
+	case auditLogResultFail:
+		success := false
+		ret.Success = &success
+	}
+	return ret
+}
+
+func toProtoAuditLogList(auditLogs []*model.AuditLog, total int64) *operate_service.AuditLogList {
+	ret := &operate_service.AuditLogList{Total: total}
+	for _, auditLog := range auditLogs {
+		ret.AuditLogs = append(ret.AuditLogs, &operate_service.AuditLog{
+			AuditId:    auditLog.AuditID,
+			OrgId:      auditLog.OrgID,
+			UserId:     auditLog.UserID,
+			Action:     auditLog.Action,
+			ActionName: auditLog.ActionName,
+			Method:     auditLog.Method,
+			Path:       auditLog.Path,
+			TargetId:   auditLog.TargetID,
+			Success:    auditLog.Success,
+			Code:       auditLog.Code,
+			ErrMsg:     auditLog.ErrMsg,
+			ClientIp:   auditLog.ClientIP,
+			Request:    auditLog.Request,
+			CreatedAt:  auditLog.CreatedAt,
+		})
+	}
+	return ret
+}
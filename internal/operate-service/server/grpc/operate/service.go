@@ -5,16 +5,22 @@ import (
 	operate_service "github.com/UnicomAI/wanwu/api/proto/operate-service"
 	"github.com/UnicomAI/wanwu/internal/operate-service/client"
 	grpc_util "github.com/UnicomAI/wanwu/pkg/grpc-util"
+	"github.com/UnicomAI/wanwu/pkg/webhook"
 )
 
 type Service struct {
 	operate_service.UnimplementedOperateServiceServer
 	cli client.IClient
+
+	auditCleanStop chan struct{}
+	webhookStop    chan struct{}
+	webhookAllow   *webhook.Allowlist
 }
 
-func NewService(cli client.IClient) *Service {
+func NewService(cli client.IClient, webhookAllow *webhook.Allowlist) *Service {
 	return &Service{
-		cli: cli,
+		cli:          cli,
+		webhookAllow: webhookAllow,
 	}
 }
 
@@ -0,0 +1,332 @@
+package operate
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	errs "github.com/UnicomAI/wanwu/api/proto/err-code"
+	operate_service "github.com/UnicomAI/wanwu/api/proto/operate-service"
+	"github.com/UnicomAI/wanwu/internal/operate-service/client/model"
+	"github.com/UnicomAI/wanwu/internal/operate-service/client/orm"
+	"github.com/UnicomAI/wanwu/internal/operate-service/config"
+	"github.com/UnicomAI/wanwu/pkg/log"
+	"github.com/UnicomAI/wanwu/pkg/util"
+	"github.com/UnicomAI/wanwu/pkg/webhook"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+const (
+	webhookSecretPrefix = "whsec_"
+
+	webhookDefaultInterval    = 5 * time.Second
+	webhookDefaultTimeout     = 10 * time.Second
+	webhookDefaultMaxAttempts = 8
+	webhookDefaultBatchSize   = 50
+
+	// webhookLastErrorLen 记录失败原因的最大长度
+	webhookLastErrorLen = 1024
+)
+
+func (s *Service) CreateWebhook(ctx context.Context, req *operate_service.CreateWebhookReq) (*operate_service.Webhook, error) {
+	if err := checkWebhook(req.Url, req.Events, s.webhookAllow); err != nil {
+		return nil, errStatus(errs.Code_OperateWebhook, err)
+	}
+	secret := req.Secret
+	if secret == "" {
+		secret = genWebhookSecret()
+	}
+	hook := &model.Webhook{
+		OrgID:  req.OrgId,
+		UserID: req.UserId,
+		Name:   req.Name,
+		URL:    req.Url,
+		Secret: secret,
+		Events: strings.Join(req.Events, ","),
+		Enable: req.Enable,
+	}
+	if err := s.cli.CreateWebhook(ctx, hook); err != nil {
+		return nil, errStatus(errs.Code_OperateWebhook, err)
+	}
+	return toProtoWebhook(hook), nil
+}
+
+func (s *Service) UpdateWebhook(ctx context.Context, req *operate_service.UpdateWebhookReq) (*emptypb.Empty, error) {
+	if err := checkWebhook(req.Url, req.Events, s.webhookAllow); err != nil {
+		return nil, errStatus(errs.Code_OperateWebhook, err)
+	}
+	if err := s.cli.UpdateWebhook(ctx, &model.Webhook{
+		WebhookID: req.WebhookId,
+		OrgID:     req.OrgId,
+		Name:      req.Name,
+		URL:       req.Url,
+		Secret:    req.Secret,
+		Events:    strings.Join(req.Events, ","),
+		Enable:    req.Enable,
+	}); err != nil {
+		return nil, errStatus(errs.Code_OperateWebhook, err)
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func (s *Service) DeleteWebhook(ctx context.Context, req *operate_service.DeleteWebhookReq) (*emptypb.Empty, error) {
+	if err := s.cli.DeleteWebhook(ctx, req.OrgId, req.WebhookId); err != nil {
+		return nil, errStatus(errs.Code_OperateWebhook, err)
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func (s *Service) GetWebhookList(ctx context.Context, req *operate_service.GetWebhookListReq) (*operate_service.WebhookList, error) {
+	hooks, total, err := s.cli.GetWebhookList(ctx, req.OrgId)
+	if err != nil {
+		return nil, errStatus(errs.Code_OperateWebhook, err)
+	}
+	ret := &operate_service.WebhookList{Total: total}
+	for _, hook := range hooks {
+		ret.Webhooks = append(ret.Webhooks, toProtoWebhook(hook))
+	}
+	return ret, nil
+}
+
+// EmitWebhookEvent 为组织内订阅了该事件的webhook创建待投递记录，由投递任务异步投递
+func (s *Service) EmitWebhookEvent(ctx context.Context, req *operate_service.EmitWebhookEventReq) (*emptypb.Empty, error) {
+	hooks, _, err := s.cli.GetWebhookList(ctx, req.OrgId)
+	if err != nil {
+		return nil, errStatus(errs.Code_OperateWebhook, err)
+	}
+	now := time.Now().UnixMilli()
+	eventId := util.GenUUID()
+	data := json.RawMessage(req.Data)
+	if len(data) == 0 {
+		data = json.RawMessage("{}")
+	}
+	payload, marshalErr := json.Marshal(&webhook.Payload{
+		Id:        eventId,
+		Event:     req.Event,
+		OrgId:     req.OrgId,
+		CreatedAt: now,
+		Data:      data,
+	})
+	if marshalErr != nil {
+		return nil, errStatus(errs.Code_OperateWebhook, &errs.Status{
+			TextKey: "ope_webhook_event_invalid",
+			Args:    []string{req.Event, marshalErr.Error()},
+		})
+	}
+	var deliveries []*model.WebhookDelivery
+	for _, hook := range hooks {
+		if !hook.Enable || !webhook.Match(orm.WebhookEvents(hook), req.Event) {
+			continue
+		}
+		deliveries = append(deliveries, &model.WebhookDelivery{
+			WebhookID:   hook.WebhookID,
+			OrgID:       req.OrgId,
+			EventID:     eventId,
+			Event:       req.Event,
+			Payload:     string(payload),
+			NextRetryAt: now,
+		})
+	}
+	if err := s.cli.CreateWebhookDeliveries(ctx, deliveries); err != nil {
+		return nil, errStatus(errs.Code_OperateWebhook, err)
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func (s *Service) GetWebhookDeliveryList(ctx context.Context, req *operate_service.GetWebhookDeliveryListReq) (*operate_service.WebhookDeliveryList, error) {
+	deliveries, total, err := s.cli.GetWebhookDeliveryList(ctx, req.OrgId, req.WebhookId, req.Status, (req.PageNo-1)*req.PageSize, req.PageSize)
+	if err != nil {
+		return nil, errStatus(errs.Code_OperateWebhook, err)
+	}
+	ret := &operate_service.WebhookDeliveryList{Total: total}
+	for _, delivery := range deliveries {
+		ret.Deliveries = append(ret.Deliveries, toProtoWebhookDelivery(delivery))
+	}
+	return ret, nil
+}
+
+func (s *Service) RetryWebhookDelivery(ctx context.Context, req *operate_service.RetryWebhookDeliveryReq) (*emptypb.Empty, error) {
+	if err := s.cli.RetryWebhookDelivery(ctx, req.OrgId, req.DeliveryId, time.Now().UnixMilli()); err != nil {
+		return nil, errStatus(errs.Code_OperateWebhook, err)
+	}
+	return &emptypb.Empty{}, nil
+}
+
+// StartWebhookDelivery 定时投递到期的webhook事件，失败后按指数退避重试，超过最大次数后进入死信
+func (s *Service) StartWebhookDelivery(cfg config.WebhookConfig) {
+	if s.webhookStop != nil {
+		return
+	}
+	interval, timeout := webhookDefaultInterval, webhookDefaultTimeout
+	if cfg.Interval > 0 {
+		interval = time.Duration(cfg.Interval) * time.Second
+	}
+	if cfg.Timeout > 0 {
+		timeout = time.Duration(cfg.Timeout) * time.Second
+	}
+	maxAttempts, batchSize := webhookDefaultMaxAttempts, webhookDefaultBatchSize
+	if cfg.MaxAttempts > 0 {
+		maxAttempts = cfg.MaxAttempts
+	}
+	if cfg.BatchSize > 0 {
+		batchSize = cfg.BatchSize
+	}
+	httpCli := webhook.NewClient(timeout, s.webhookAllow)
+
+	s.webhookStop = make(chan struct{})
+	stop := s.webhookStop
+	go func() {
+		defer util.PrintPanicStack()
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ticker.C:
+				s.deliverWebhooks(httpCli, timeout, maxAttempts, batchSize)
+			case <-stop:
+				return
+			}
+		}
+	}()
+}
+
+func (s *Service) StopWebhookDelivery() {
+	if s.webhookStop != nil {
+		close(s.webhookStop)
+		s.webhookStop = nil
+	}
+}
+
+func (s *Service) deliverWebhooks(httpCli *http.Client, timeout time.Duration, maxAttempts, batchSize int) {
+	ctx := context.Background()
+	// 同一批次并发投递，租约需覆盖单次投递超时
+	lease := 2 * timeout
+	deliveries, err := s.cli.ClaimWebhookDeliveries(ctx, time.Now().UnixMilli(), lease.Milliseconds(), batchSize)
+	if err != nil {
+		log.Errorf("claim webhook deliveries err: %v", err.Args)
+	}
+	if len(deliveries) == 0 {
+		return
+	}
+
+	hooks := make(map[string]*model.Webhook)
+	for _, delivery := range deliveries {
+		if _, ok := hooks[delivery.WebhookID]; ok {
+			continue
+		}
+		hook, err := s.cli.GetWebhook(ctx, "", delivery.WebhookID)
+		if err != nil && err.TextKey != "ope_webhook_not_found" {
+			// 查询异常时不投递，租约到期后重新获取
+			log.Errorf("webhook %v delivery get webhook err: %v", delivery.WebhookID, err.Args)
+			continue
+		}
+		hooks[delivery.WebhookID] = hook
+	}
+
+	var wg sync.WaitGroup
+	for _, delivery := range deliveries {
+		if _, ok := hooks[delivery.WebhookID]; !ok {
+			continue
+		}
+		wg.Add(1)
+		go func(delivery *model.WebhookDelivery) {
+			defer wg.Done()
+			defer util.PrintPanicStack()
+			s.deliverWebhook(ctx, httpCli, hooks[delivery.WebhookID], delivery, timeout, maxAttempts)
+		}(delivery)
+	}
+	wg.Wait()
+}
+
+func (s *Service) deliverWebhook(ctx context.Context, httpCli *http.Client, hook *model.Webhook, delivery *model.WebhookDelivery, timeout time.Duration, maxAttempts int) {
+	delivery.Attempts++
+	switch {
+	case hook == nil:
+		delivery.LastCode, delivery.LastError = 0, "webhook not found"
+	case !hook.Enable:
+		delivery.LastCode, delivery.LastError = 0, "webhook disabled"
+	default:
+		deliverCtx, cancel := context.WithTimeout(ctx, timeout)
+		code, err := webhook.Deliver(deliverCtx, httpCli, hook.URL, hook.Secret, delivery.Event, delivery.DeliveryID, []byte(delivery.Payload))
+		cancel()
+		delivery.LastCode, delivery.LastError = int32(code), ""
+		if err == nil {
+			delivery.Status = orm.WebhookDeliverySuccess
+			break
+		}
+		delivery.LastError = err.Error()
+		if len(delivery.LastError) > webhookLastErrorLen {
+			delivery.LastError = delivery.LastError[:webhookLastErrorLen]
+		}
+	}
+	if delivery.Status != orm.WebhookDeliverySuccess {
+		if hook == nil || !hook.Enable || int(delivery.Attempts) >= maxAttempts {
+			delivery.Status = orm.WebhookDeliveryDead
+			log.Warnf("webhook %v delivery %v event %v dead after %v attempts: %v", delivery.WebhookID, delivery.DeliveryID, delivery.Event, delivery.Attempts, delivery.LastError)
+		} else {
+			delivery.NextRetryAt = time.Now().Add(webhook.Backoff(int(delivery.Attempts))).UnixMilli()
+		}
+	}
+	if err := s.cli.UpdateWebhookDeliveryResult(ctx, delivery); err != nil {
+		log.Errorf("webhook %v delivery %v update result err: %v", delivery.WebhookID, delivery.DeliveryID, err.Args)
+	}
+}
+
+func checkWebhook(url string, events []string, allow *webhook.Allowlist) *errs.Status {
+	if err := webhook.ValidURL(url, allow); err != nil {
+		return &errs.Status{TextKey: "ope_webhook_url_invalid", Args: []string{url, err.Error()}}
+	}
+	if len(events) == 0 {
+		return &errs.Status{TextKey: "ope_webhook_event_empty"}
+	}
+	for _, event := range events {
+		if !webhook.ValidFilter(event) {
+			return &errs.Status{TextKey: "ope_webhook_event_invalid", Args: []string{event, "unknown event"}}
+		}
+	}
+	return nil
+}
+
+func genWebhookSecret() string {
+	b := make([]byte, 24)
+	_, _ = rand.Read(b)
+	return webhookSecretPrefix + hex.EncodeToString(b)
+}
+
+func toProtoWebhook(hook *model.Webhook) *operate_service.Webhook {
+	return &operate_service.Webhook{
+		WebhookId: hook.WebhookID,
+		OrgId:     hook.OrgID,
+		UserId:    hook.UserID,
+		Name:      hook.Name,
+		Url:       hook.URL,
+		Secret:    hook.Secret,
+		Events:    orm.WebhookEvents(hook),
+		Enable:    hook.Enable,
+		CreatedAt: hook.CreatedAt,
+		UpdatedAt: hook.UpdatedAt,
+	}
+}
+
+func toProtoWebhookDelivery(delivery *model.WebhookDelivery) *operate_service.WebhookDelivery {
+	return &operate_service.WebhookDelivery{
+		DeliveryId:  delivery.DeliveryID,
+		WebhookId:   delivery.WebhookID,
+		OrgId:       delivery.OrgID,
+		EventId:     delivery.EventID,
+		Event:       delivery.Event,
+		Payload:     delivery.Payload,
+		Status:      delivery.Status,
+		Attempts:    delivery.Attempts,
+		NextRetryAt: delivery.NextRetryAt,
+		LastCode:    delivery.LastCode,
+		LastError:   delivery.LastError,
+		CreatedAt:   delivery.CreatedAt,
+		UpdatedAt:   delivery.UpdatedAt,
+	}
+}
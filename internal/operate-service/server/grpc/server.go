@@ -14,6 +14,7 @@ import (
 	"github.com/UnicomAI/wanwu/internal/operate-service/config"
 	"github.com/UnicomAI/wanwu/internal/operate-service/server/grpc/operate"
 	"github.com/UnicomAI/wanwu/pkg/log"
+	"github.com/UnicomAI/wanwu/pkg/webhook"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/health"
@@ -29,8 +30,12 @@ type Server struct {
 }
 
 func NewServer(cfg *config.Config, cli client.IClient) (*Server, error) {
+	webhookAllow, err := webhook.NewAllowlist(cfg.Webhook.Allowlist)
+	if err != nil {
+		return nil, err
+	}
 	s := &Server{
-		operate: operate.NewService(cli),
+		operate: operate.NewService(cli, webhookAllow),
 		cfg:     cfg,
 	}
 	return s, nil
@@ -77,6 +82,11 @@ func (s *Server) Start(ctx context.Context) error {
 	}()
 
 	log.Infof("start grpc server at: %s", s.cfg.Server.GrpcEndpoint)
+
+	// audit log clean
+	s.operate.StartAuditLogClean(s.cfg.Audit.RetentionDays)
+	// webhook delivery
+	s.operate.StartWebhookDelivery(s.cfg.Webhook)
 	return nil
 }
 
@@ -85,6 +95,9 @@ func (s *Server) Stop(ctx context.Context) {
 		return
 	}
 
+	s.operate.StopAuditLogClean()
+	s.operate.StopWebhookDelivery()
+
 	log.Infof("closing grpc server...")
 	stopped := make(chan struct{})
 	go func() {
@@ -17,4 +17,9 @@ type IClient interface {
 	UpdateRag(ctx context.Context, rag *model.RagInfo) *err_code.Status
 	UpdateRagConfig(ctx context.Context, rag *model.RagInfo) *err_code.Status
 	FetchRagFirst(ctx context.Context, ragId string) (*model.RagInfo, *err_code.Status)
+	SaveRagFeedback(ctx context.Context, feedback *model.RagFeedback) *err_code.Status
+	DeleteRagFeedback(ctx context.Context, msgID, userID string) *err_code.Status
+	ListRagFeedback(ctx context.Context, req *rag_service.ListRagFeedbackReq) (*rag_service.RagFeedbackList, *err_code.Status)
+	SaveRagChatSnapshot(ctx context.Context, snapshot *model.RagChatSnapshot) *err_code.Status
+	GetRagChatSnapshot(ctx context.Context, msgID, userID string) (*model.RagChatSnapshot, *err_code.Status)
 }
@@ -0,0 +1,17 @@
+package model
+
+// RagChatSnapshot rag问答结果快照，问答结束时由服务端按msg_id保存，评价时引用
+type RagChatSnapshot struct {
+	ID         int64  `json:"id" gorm:"primaryKey;type:bigint(20) auto_increment;not null;"`
+	MsgID      string `json:"msgId" gorm:"uniqueIndex:idx_rag_chat_snapshot_msg_id;column:msg_id;type:varchar(255);comment:问答返回的msg_id"`
+	RagID      string `json:"ragId" gorm:"column:rag_id;type:varchar(255);comment:ragId"`
+	Question   string `json:"question" gorm:"column:question;type:longtext;comment:问题"`
+	Answer     string `json:"answer" gorm:"column:answer;type:longtext;comment:回答"`
+	SearchList string `json:"searchList" gorm:"column:search_list;type:longtext;comment:回答时检索到的知识片段"`
+	// UserID、OrgID 为提问用户
+	PublicModel
+}
+
+func (r RagChatSnapshot) TableName() string {
+	return "rag_chat_snapshot"
+}
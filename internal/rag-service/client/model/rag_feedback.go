@@ -0,0 +1,20 @@
+package model
+
+// RagFeedback 用户对rag某次回答的评价，同一用户对同一条回答仅保留一条
+type RagFeedback struct {
+	ID         int64  `json:"id" gorm:"primaryKey;type:bigint(20) auto_increment;not null;"`
+	RagID      string `json:"ragId" gorm:"index:idx_rag_feedback_rag_id;column:rag_id;type:varchar(255);comment:ragId"`
+	MsgID      string `json:"msgId" gorm:"index:idx_rag_feedback_msg_id;column:msg_id;type:varchar(255);comment:问答返回的msg_id"`
+	Rating     int32  `json:"rating" gorm:"column:rating;type:tinyint(2);comment:评价，1点赞，-1点踩"`
+	Reasons    string `json:"reasons" gorm:"column:reasons;type:text;comment:原因标签，json数组"`
+	Content    string `json:"content" gorm:"column:content;type:text;comment:评价内容"`
+	Question   string `json:"question" gorm:"column:question;type:longtext;comment:问题"`
+	Answer     string `json:"answer" gorm:"column:answer;type:longtext;comment:回答"`
+	SearchList string `json:"searchList" gorm:"column:search_list;type:longtext;comment:回答时检索到的知识片段快照"`
+	// UserID 为评价用户，OrgID 为rag所属组织
+	PublicModel
+}
+
+func (r RagFeedback) TableName() string {
+	return "rag_feedback"
+}
@@ -19,6 +19,8 @@ func NewClient(ctx context.Context, db *gorm.DB) (*Client, error) {
 	// auto migrate
 	if err := db.AutoMigrate(
 		model.RagInfo{},
+		model.RagFeedback{},
+		model.RagChatSnapshot{},
 	); err != nil {
 		return nil, err
 	}
@@ -0,0 +1,38 @@
+package orm
+
+import (
+	"context"
+	"errors"
+
+	err_code "github.com/UnicomAI/wanwu/api/proto/err-code"
+	"github.com/UnicomAI/wanwu/internal/rag-service/client/model"
+	"github.com/UnicomAI/wanwu/internal/rag-service/client/orm/sqlopt"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// SaveRagChatSnapshot 保存问答结果快照，msg_id已存在时忽略
+func (c *Client) SaveRagChatSnapshot(ctx context.Context, snapshot *model.RagChatSnapshot) *err_code.Status {
+	if err := c.db.WithContext(ctx).Clauses(clause.OnConflict{DoNothing: true}).Create(snapshot).Error; err != nil {
+		return toErrStatus("rag_chat_snapshot_err", err.Error())
+	}
+	return nil
+}
+
+// GetRagChatSnapshot 查询用户自己的问答结果快照
+func (c *Client) GetRagChatSnapshot(ctx context.Context, msgID, userID string) (*model.RagChatSnapshot, *err_code.Status) {
+	if msgID == "" || userID == "" {
+		return nil, toErrStatus("rag_chat_snapshot_not_found", msgID)
+	}
+	snapshot := &model.RagChatSnapshot{}
+	err := sqlopt.SQLOptions(
+		sqlopt.WithMsgID(msgID),
+		sqlopt.WithUserID(userID),
+	).Apply(c.db.WithContext(ctx)).First(snapshot).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, toErrStatus("rag_chat_snapshot_not_found", msgID)
+	} else if err != nil {
+		return nil, toErrStatus("rag_chat_snapshot_err", err.Error())
+	}
+	return snapshot, nil
+}
@@ -0,0 +1,103 @@
+package orm
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"strconv"
+
+	err_code "github.com/UnicomAI/wanwu/api/proto/err-code"
+	rag_service "github.com/UnicomAI/wanwu/api/proto/rag-service"
+	"github.com/UnicomAI/wanwu/internal/rag-service/client/model"
+	"github.com/UnicomAI/wanwu/internal/rag-service/client/orm/sqlopt"
+	"github.com/UnicomAI/wanwu/pkg/log"
+	"gorm.io/gorm"
+)
+
+// SaveRagFeedback 保存评价，同一用户对同一条回答已有评价时覆盖
+func (c *Client) SaveRagFeedback(ctx context.Context, feedback *model.RagFeedback) *err_code.Status {
+	return c.transaction(ctx, func(tx *gorm.DB) *err_code.Status {
+		existing := &model.RagFeedback{}
+		err := sqlopt.SQLOptions(
+			sqlopt.WithMsgID(feedback.MsgID),
+			sqlopt.WithUserID(feedback.UserID),
+		).Apply(tx).First(existing).Error
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			if err := tx.Create(feedback).Error; err != nil {
+				return toErrStatus("rag_feedback_err", err.Error())
+			}
+			return nil
+		} else if err != nil {
+			return toErrStatus("rag_feedback_err", err.Error())
+		}
+		if err := tx.Model(existing).Updates(map[string]interface{}{
+			"rating":      feedback.Rating,
+			"reasons":     feedback.Reasons,
+			"content":     feedback.Content,
+			"question":    feedback.Question,
+			"answer":      feedback.Answer,
+			"search_list": feedback.SearchList,
+		}).Error; err != nil {
+			return toErrStatus("rag_feedback_err", err.Error())
+		}
+		return nil
+	})
+}
+
+// DeleteRagFeedback 取消评价
+func (c *Client) DeleteRagFeedback(ctx context.Context, msgID, userID string) *err_code.Status {
+	if msgID == "" || userID == "" {
+		return toErrStatus("rag_feedback_err", "msgId or userId empty")
+	}
+	if err := sqlopt.SQLOptions(
+		sqlopt.WithMsgID(msgID),
+		sqlopt.WithUserID(userID),
+	).Apply(c.db.WithContext(ctx)).Delete(&model.RagFeedback{}).Error; err != nil {
+		return toErrStatus("rag_feedback_err", err.Error())
+	}
+	return nil
+}
+
+// ListRagFeedback 按rag、评价与时间范围查询组织内的评价，按创建时间倒序
+func (c *Client) ListRagFeedback(ctx context.Context, req *rag_service.ListRagFeedbackReq) (*rag_service.RagFeedbackList, *err_code.Status) {
+	var feedbacks []*model.RagFeedback
+	var count int64
+	query := sqlopt.SQLOptions(
+		sqlopt.WithOrgID(req.Identity.OrgId),
+		sqlopt.WithRagID(req.RagId),
+		sqlopt.WithRating(req.Rating),
+		sqlopt.WithCreatedAtRange(req.StartTime, req.EndTime),
+	).Apply(c.db.WithContext(ctx).Model(&model.RagFeedback{}))
+	if err := query.Count(&count).Error; err != nil {
+		return nil, toErrStatus("rag_feedback_list_err", err.Error())
+	}
+	offset := (req.PageNo - 1) * req.PageSize
+	if err := query.Offset(int(offset)).Limit(int(req.PageSize)).Order("created_at DESC").Find(&feedbacks).Error; err != nil {
+		return nil, toErrStatus("rag_feedback_list_err", err.Error())
+	}
+
+	ret := &rag_service.RagFeedbackList{Total: count}
+	for _, feedback := range feedbacks {
+		var reasons []string
+		if feedback.Reasons != "" {
+			if err := json.Unmarshal([]byte(feedback.Reasons), &reasons); err != nil {
+				log.Warnf("rag feedback %v unmarshal reasons err: %v", feedback.ID, err)
+			}
+		}
+		ret.Data = append(ret.Data, &rag_service.RagFeedbackInfo{
+			FeedbackId: strconv.FormatInt(feedback.ID, 10),
+			RagId:      feedback.RagID,
+			MsgId:      feedback.MsgID,
+			Rating:     feedback.Rating,
+			Reasons:    reasons,
+			Content:    feedback.Content,
+			Question:   feedback.Question,
+			Answer:     feedback.Answer,
+			SearchList: feedback.SearchList,
+			UserId:     feedback.UserID,
+			CreatedAt:  feedback.CreatedAt,
+			UpdatedAt:  feedback.UpdatedAt,
+		})
+	}
+	return ret, nil
+}
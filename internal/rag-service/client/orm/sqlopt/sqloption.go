@@ -71,3 +71,30 @@ func InRagIds(ragIds []string) SQLOption {
 		return db
 	})
 }
+
+func WithMsgID(msgID string) SQLOption {
+	return funcSQLOption(func(db *gorm.DB) *gorm.DB {
+		return db.Where("msg_id = ?", msgID)
+	})
+}
+
+func WithRating(rating int32) SQLOption {
+	return funcSQLOption(func(db *gorm.DB) *gorm.DB {
+		if rating != 0 {
+			return db.Where("rating = ?", rating)
+		}
+		return db
+	})
+}
+
+func WithCreatedAtRange(startTime, endTime int64) SQLOption {
+	return funcSQLOption(func(db *gorm.DB) *gorm.DB {
+		if startTime > 0 {
+			db = db.Where("created_at >= ?", startTime)
+		}
+		if endTime > 0 {
+			db = db.Where("created_at <= ?", endTime)
+		}
+		return db
+	})
+}
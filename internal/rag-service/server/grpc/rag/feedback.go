@@ -0,0 +1,129 @@
+package rag
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	errs "github.com/UnicomAI/wanwu/api/proto/err-code"
+	rag_service "github.com/UnicomAI/wanwu/api/proto/rag-service"
+	"github.com/UnicomAI/wanwu/internal/rag-service/client/model"
+	grpc_util "github.com/UnicomAI/wanwu/pkg/grpc-util"
+	"github.com/UnicomAI/wanwu/pkg/log"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+// ChatRagFeedback 评价rag某次回答；问题、回答与检索到的知识片段取自问答结束时服务端保存的快照。rating为0时取消评价
+func (s *Service) ChatRagFeedback(ctx context.Context, in *rag_service.ChatRagFeedbackReq) (*emptypb.Empty, error) {
+	if in.Rating < -1 || in.Rating > 1 {
+		return nil, grpc_util.ErrorStatusWithKey(errs.Code_RagFeedbackErr, "rag_feedback_err", fmt.Sprintf("invalid rating %v", in.Rating))
+	}
+	if in.Rating == 0 {
+		if err := s.cli.DeleteRagFeedback(ctx, in.MsgId, in.Identity.UserId); err != nil {
+			return nil, errStatus(errs.Code_RagFeedbackErr, err)
+		}
+		return &emptypb.Empty{}, nil
+	}
+	rag, err := s.cli.FetchRagFirst(ctx, in.RagId)
+	if err != nil {
+		return nil, errStatus(errs.Code_RagGetErr, err)
+	}
+	// 只能评价自己在该rag下的回答
+	snapshot, err := s.cli.GetRagChatSnapshot(ctx, in.MsgId, in.Identity.UserId)
+	if err != nil {
+		return nil, errStatus(errs.Code_RagFeedbackErr, err)
+	}
+	if snapshot.RagID != in.RagId {
+		return nil, grpc_util.ErrorStatusWithKey(errs.Code_RagFeedbackErr, "rag_chat_snapshot_not_found", in.MsgId)
+	}
+	reasons, errM := json.Marshal(in.Reasons)
+	if errM != nil {
+		return nil, grpc_util.ErrorStatusWithKey(errs.Code_RagFeedbackErr, "rag_feedback_err", errM.Error())
+	}
+	if err := s.cli.SaveRagFeedback(ctx, &model.RagFeedback{
+		RagID:      in.RagId,
+		MsgID:      in.MsgId,
+		Rating:     in.Rating,
+		Reasons:    string(reasons),
+		Content:    in.Content,
+		Question:   snapshot.Question,
+		Answer:     snapshot.Answer,
+		SearchList: snapshot.SearchList,
+		PublicModel: model.PublicModel{
+			OrgID:  rag.OrgID,
+			UserID: in.Identity.UserId,
+		},
+	}); err != nil {
+		return nil, errStatus(errs.Code_RagFeedbackErr, err)
+	}
+	return &emptypb.Empty{}, nil
+}
+
+// ListRagFeedback 回答评价列表，查询当前组织下rag收到的评价
+func (s *Service) ListRagFeedback(ctx context.Context, in *rag_service.ListRagFeedbackReq) (*rag_service.RagFeedbackList, error) {
+	list, err := s.cli.ListRagFeedback(ctx, in)
+	if err != nil {
+		return nil, errStatus(errs.Code_RagFeedbackErr, err)
+	}
+	return list, nil
+}
+
+// --- internal ---
+
+// ragChatRecorder 从rag流式结果中拼接回答，并记录最后一次返回的知识片段
+type ragChatRecorder struct {
+	msgId      string
+	answer     strings.Builder
+	searchList string
+}
+
+func (r *ragChatRecorder) add(line string) {
+	raw := strings.TrimSpace(strings.TrimPrefix(line, "data:"))
+	if raw == "" {
+		return
+	}
+	resp := struct {
+		MsgID string `json:"msg_id"`
+		Data  struct {
+			Output     string          `json:"output"`
+			SearchList json.RawMessage `json:"searchList"`
+		} `json:"data"`
+	}{}
+	if err := json.Unmarshal([]byte(raw), &resp); err != nil {
+		return
+	}
+	if resp.MsgID != "" {
+		r.msgId = resp.MsgID
+	}
+	r.answer.WriteString(resp.Data.Output)
+	if len(resp.Data.SearchList) > 0 && string(resp.Data.SearchList) != "null" {
+		r.searchList = string(resp.Data.SearchList)
+	}
+}
+
+// saveRagChatSnapshot 问答结束时保存回答快照，供评价引用
+func (s *Service) saveRagChatSnapshot(ctx context.Context, req *rag_service.ChatRagReq, recorder *ragChatRecorder) {
+	if recorder.msgId == "" || req.Identity.GetUserId() == "" {
+		return
+	}
+	if ctx.Err() != nil {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(context.Background(), 10*time.Second)
+		defer cancel()
+	}
+	if err := s.cli.SaveRagChatSnapshot(ctx, &model.RagChatSnapshot{
+		MsgID:      recorder.msgId,
+		RagID:      req.RagId,
+		Question:   req.Question,
+		Answer:     recorder.answer.String(),
+		SearchList: recorder.searchList,
+		PublicModel: model.PublicModel{
+			OrgID:  req.Identity.GetOrgId(),
+			UserID: req.Identity.GetUserId(),
+		},
+	}); err != nil {
+		log.Errorf("rag %v save chat snapshot %v err: %v", req.RagId, recorder.msgId, err)
+	}
+}
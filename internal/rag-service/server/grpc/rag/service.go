@@ -11,8 +11,10 @@ import (
 	"github.com/UnicomAI/wanwu/internal/rag-service/client/model"
 	"github.com/UnicomAI/wanwu/internal/rag-service/pkg/generator"
 	"github.com/UnicomAI/wanwu/internal/rag-service/service"
+	"github.com/UnicomAI/wanwu/pkg/constant"
 	grpc_util "github.com/UnicomAI/wanwu/pkg/grpc-util"
 	"github.com/UnicomAI/wanwu/pkg/log"
+	mp "github.com/UnicomAI/wanwu/pkg/model-provider"
 	"google.golang.org/grpc"
 	"google.golang.org/protobuf/types/known/emptypb"
 )
@@ -62,17 +64,31 @@ func (s *Service) ChatRag(req *rag_service.ChatRagReq, stream grpc.ServerStreami
 		return grpc_util.ErrorStatusWithKey(errs.Code_RagChatErr, "rag_chat_err", "check knowledgeInfoList err: knowledgeInfoList is nil")
 	}
 
+	// RAG按知识库创建人区分知识库，知识库可能由他人分享给rag创建人，须使用知识库创建人userId
+	knowledgeUserId, ownerKnowledgeList := knowledgeOwner(rag.UserID, knowledgeInfoList.List)
+	if len(ownerKnowledgeList) < len(knowledgeInfoList.List) {
+		log.Warnf("rag %s knowledge base owners differ, only use knowledge of owner %s", req.RagId, knowledgeUserId)
+		knowledgeInfoList.List = ownerKnowledgeList
+	}
+
 	//  请求rag
 	buildParams, errk := service.BuildChatConsultParams(req, rag, knowledgeInfoList)
 	if errk != nil {
 		log.Errorf("errk = %s", errk.Error())
 		return grpc_util.ErrorStatusWithKey(errs.Code_RagChatErr, "rag_chat_err", errk.Error())
 	}
-	chatChan, errg := service.RagStreamChat(ctx, rag.UserID, buildParams)
+	chatChan, errg := service.RagStreamChat(ctx, knowledgeUserId, &mp.ModelCaller{
+		OrgId:   req.Identity.GetOrgId(),
+		UserId:  req.Identity.GetUserId(),
+		AppId:   req.RagId,
+		AppType: constant.AppTypeRag,
+	}, buildParams)
 	if errg != nil {
 		return grpc_util.ErrorStatusWithKey(errs.Code_RagChatErr, "rag_chat_err", errg.Error())
 	}
+	recorder := &ragChatRecorder{}
 	for text := range chatChan {
+		recorder.add(text)
 		resp := &rag_service.ChatRagResp{
 			Content: text,
 		}
@@ -80,6 +96,7 @@ func (s *Service) ChatRag(req *rag_service.ChatRagReq, stream grpc.ServerStreami
 			return grpc_util.ErrorStatusWithKey(errs.Code_RagChatErr, "rag_chat_err", err.Error())
 		}
 	}
+	s.saveRagChatSnapshot(ctx, req, recorder)
 	return nil
 }
 
@@ -133,6 +150,9 @@ func (s *Service) UpdateRagConfig(ctx context.Context, in *rag_service.UpdateRag
 		knowledgeIdList = append(knowledgeIdList, perKbConfig.KnowledgeId)
 	}
 	if len(knowledgeIdList) > 0 {
+		if err := s.checkKnowledgeOwner(ctx, in.RagId, knowledgeIdList); err != nil {
+			return nil, err
+		}
 		knowledgeIdBytes, err := json.Marshal(knowledgeIdList)
 		if err != nil {
 			return nil, grpc_util.ErrorStatusWithKey(errs.Code_RagChatErr, "rag_update_err", "marshal err:", err.Error())
@@ -222,3 +242,39 @@ func (s *Service) GetRagByIds(ctx context.Context, in *rag_service.GetRagByIdsRe
 	}
 	return ragList, nil
 }
+
+// checkKnowledgeOwner 保存配置时校验知识库属于同一创建人，RAG问答只能使用一个知识库创建人的身份检索
+func (s *Service) checkKnowledgeOwner(ctx context.Context, ragId string, knowledgeIds []string) error {
+	rag, err := s.cli.FetchRagFirst(ctx, ragId)
+	if err != nil {
+		return errStatus(errs.Code_RagUpdateErr, err)
+	}
+	knowledgeInfoList, errk := Knowledge.SelectKnowledgeDetailByIdList(ctx, &knowledgebase_service.KnowledgeDetailSelectListReq{
+		UserId:       rag.UserID,
+		OrgId:        rag.OrgID,
+		KnowledgeIds: knowledgeIds,
+	})
+	if errk != nil {
+		return errk
+	}
+	if _, ownerKnowledgeList := knowledgeOwner(rag.UserID, knowledgeInfoList.List); len(ownerKnowledgeList) < len(knowledgeInfoList.List) {
+		return grpc_util.ErrorStatus(errs.Code_KnowledgeBaseMultiOwner)
+	}
+	return nil
+}
+
+// knowledgeOwner 返回第一个知识库的创建人userId及该创建人的知识库，未使用知识库时返回defaultUserId；
+// 保存配置时已拒绝不同创建人的知识库，历史配置中存在不同创建人时只使用第一个创建人的知识库，不中断问答
+func knowledgeOwner(defaultUserId string, knowledgeList []*knowledgebase_service.KnowledgeInfo) (string, []*knowledgebase_service.KnowledgeInfo) {
+	if len(knowledgeList) == 0 {
+		return defaultUserId, knowledgeList
+	}
+	userId := knowledgeList[0].UserId
+	var ret []*knowledgebase_service.KnowledgeInfo
+	for _, knowledge := range knowledgeList {
+		if knowledge.UserId == userId {
+			ret = append(ret, knowledge)
+		}
+	}
+	return userId, ret
+}
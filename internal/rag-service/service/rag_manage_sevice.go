@@ -16,6 +16,7 @@ import (
 	"github.com/UnicomAI/wanwu/internal/rag-service/config"
 	http_client "github.com/UnicomAI/wanwu/internal/rag-service/pkg/http-client"
 	"github.com/UnicomAI/wanwu/pkg/log"
+	mp "github.com/UnicomAI/wanwu/pkg/model-provider"
 )
 
 const (
@@ -95,8 +96,8 @@ type ModelConfig struct {
 	PresencePenaltyEnable  bool    `json:"presencePenaltyEnable"`
 }
 
-func RagStreamChat(ctx context.Context, userId string, req *RagChatParams) (<-chan string, error) {
-	params, err := buildHttpParams(userId, req)
+func RagStreamChat(ctx context.Context, userId string, caller *mp.ModelCaller, req *RagChatParams) (<-chan string, error) {
+	params, err := buildHttpParams(userId, caller, req)
 	if err != nil {
 		log.Errorf("build http params fail", err.Error())
 		return nil, err
@@ -154,16 +155,19 @@ func RagStreamChat(ctx context.Context, userId string, req *RagChatParams) (<-ch
 	return ret, nil
 }
 
-func buildHttpParams(userId string, req *RagChatParams) (*http_client.HttpRequestParams, error) {
+// buildHttpParams X-uid为知识库创建人userId；调用方信息header由RAG透传给模型callback，用于用量统计与配额校验
+func buildHttpParams(userId string, caller *mp.ModelCaller, req *RagChatParams) (*http_client.HttpRequestParams, error) {
 	url := fmt.Sprintf("%s%s", config.Cfg().RagServer.ChatEndpoint, config.Cfg().RagServer.ChatUrl)
 	body, err := json.Marshal(req)
 	if err != nil {
 		return nil, err
 	}
+	headers := caller.Headers()
+	headers["X-uid"] = userId
 	return &http_client.HttpRequestParams{
 		Url:        url,
 		Body:       body,
-		Headers:    map[string]string{"X-uid": userId},
+		Headers:    headers,
 		Timeout:    time.Minute * 10,
 		MonitorKey: "rag_search_service",
 		LogLevel:   http_client.LogAll,
@@ -270,6 +274,10 @@ func buildRagMetaParams(rag *model.RagInfo, knowledgeIDToName map[string]string)
 	filterEnable := false // 标记是否有启用的元数据过滤
 	var metaFilterConditions []*MetadataFilterItem
 	for _, k := range perKbConfig {
+		// 只处理本次问答使用的知识库
+		if _, ok := knowledgeIDToName[k.KnowledgeId]; !ok {
+			continue
+		}
 		// 检查元数据过滤参数是否有效
 		filterParams := k.RagMetaFilter
 		if !isValidFilterParams(k.RagMetaFilter) {
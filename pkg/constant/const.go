@@ -18,3 +18,10 @@ const (
 	ToolTypeBuiltIn = "builtin" // 内置工具
 	ToolTypeCustom  = "custom"  // 自定义工具
 )
+
+// api key scope
+const (
+	ApiKeyScopeChat         = "chat"         // 对话问答、工作流运行
+	ApiKeyScopeConversation = "conversation" // 会话管理
+	ApiKeyScopeFeedback     = "feedback"     // 回答评价
+)
@@ -68,6 +68,10 @@ func InitESIndexTemplate(ctx context.Context) error {
 						"type": "keyword",
 						"index": true
 					},
+					"parentId": {
+						"type": "keyword",
+						"index": true
+					},
 					"prompt": {
 						"type": "text",
 						"fields": {
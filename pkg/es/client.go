@@ -223,6 +223,133 @@ func (c *client) SearchByFields(ctx context.Context, index string, fieldConditio
 	return documents, int64(totalValue), nil
 }
 
+// 批量写入数据到指定索引
+func (c *client) BulkIndexDocuments(ctx context.Context, index string, documents []interface{}) error {
+	if len(documents) == 0 {
+		return nil
+	}
+	var body strings.Builder
+	for _, document := range documents {
+		docJSON, err := json.Marshal(document)
+		if err != nil {
+			return fmt.Errorf("序列化文档失败: %v", err)
+		}
+		body.WriteString(`{"index":{}}` + "\n")
+		body.Write(docJSON)
+		body.WriteString("\n")
+	}
+
+	res, err := c.cli.Bulk(
+		strings.NewReader(body.String()),
+		c.cli.Bulk.WithContext(ctx),
+		c.cli.Bulk.WithIndex(index),
+		c.cli.Bulk.WithRefresh("true"),
+	)
+	if err != nil {
+		return fmt.Errorf("批量写入ES失败: %v", err)
+	}
+	defer res.Body.Close()
+
+	if res.IsError() {
+		return fmt.Errorf("ES批量写入响应错误: %s", res.String())
+	}
+	var result struct {
+		Errors bool `json:"errors"`
+	}
+	if err := json.NewDecoder(res.Body).Decode(&result); err != nil {
+		return fmt.Errorf("解析批量写入结果失败: %v", err)
+	}
+	if result.Errors {
+		return fmt.Errorf("ES批量写入部分文档失败，索引: %s", index)
+	}
+
+	log.Infof("成功批量写入ES，索引: %s, 数量: %d", index, len(documents))
+	return nil
+}
+
+// 根据指定字段条件与createdAt时间范围（毫秒，0表示不限）分批查询所有数据，按sortFields及id正序排列，每批数据回调handle
+func (c *client) ScanByFields(ctx context.Context, index string, fieldConditions map[string]interface{}, startTime, endTime int64, sortFields []string, batchSize int, handle func(documents []json.RawMessage) error) error {
+	must := buildMustQuery(fieldConditions)
+	if startTime > 0 || endTime > 0 {
+		createdAt := map[string]interface{}{}
+		if startTime > 0 {
+			createdAt["gte"] = startTime
+		}
+		if endTime > 0 {
+			createdAt["lte"] = endTime
+		}
+		must = append(must, map[string]interface{}{
+			"range": map[string]interface{}{"createdAt": createdAt},
+		})
+	}
+	var sort []map[string]interface{}
+	for _, field := range append(sortFields, "id") {
+		sort = append(sort, map[string]interface{}{field: map[string]interface{}{"order": "asc"}})
+	}
+
+	var searchAfter []interface{}
+	for {
+		query := map[string]interface{}{
+			"query": map[string]interface{}{
+				"bool": map[string]interface{}{
+					"must": must,
+				},
+			},
+			"size": batchSize,
+			"sort": sort,
+		}
+		if searchAfter != nil {
+			query["search_after"] = searchAfter
+		}
+		queryJSON, err := json.Marshal(query)
+		if err != nil {
+			return fmt.Errorf("序列化查询失败: %v", err)
+		}
+
+		res, err := c.cli.Search(
+			c.cli.Search.WithContext(ctx),
+			c.cli.Search.WithIndex(index),
+			c.cli.Search.WithBody(strings.NewReader(string(queryJSON))),
+		)
+		if err != nil {
+			return fmt.Errorf("ES查询失败: %v", err)
+		}
+		var result struct {
+			Hits struct {
+				Hits []struct {
+					Source json.RawMessage `json:"_source"`
+					Sort   []interface{}   `json:"sort"`
+				} `json:"hits"`
+			} `json:"hits"`
+		}
+		if res.IsError() {
+			res.Body.Close()
+			return fmt.Errorf("ES查询响应错误: %s", res.String())
+		}
+		err = json.NewDecoder(res.Body).Decode(&result)
+		res.Body.Close()
+		if err != nil {
+			return fmt.Errorf("解析查询结果失败: %v", err)
+		}
+
+		hits := result.Hits.Hits
+		if len(hits) == 0 {
+			return nil
+		}
+		documents := make([]json.RawMessage, 0, len(hits))
+		for _, hit := range hits {
+			documents = append(documents, hit.Source)
+		}
+		if err := handle(documents); err != nil {
+			return err
+		}
+		if len(hits) < batchSize {
+			return nil
+		}
+		searchAfter = hits[len(hits)-1].Sort
+	}
+}
+
 // 创建索引模板
 func (c *client) CreateIndexTemplate(ctx context.Context, templateName string, templateBody string) error {
 	res, err := c.cli.Indices.PutIndexTemplate(
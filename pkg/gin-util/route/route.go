@@ -123,6 +123,18 @@ func GetTags(absPath, method string) ([]string, bool) {
 	return ret, true
 }
 
+func GetDesc(absPath, method string) (string, bool) {
+	v, loaded := paths.Load(pathKey(absPath, method))
+	if !loaded {
+		return "", false
+	}
+	p, ok := v.(*_path)
+	if !ok {
+		return "", false
+	}
+	return p.desc, true
+}
+
 type _path struct {
 	absPath  string
 	method   string
@@ -24,6 +24,9 @@ const (
 	// http header
 	X_LANGUAGE = "X-Language" // 当前语言
 	X_ORG_ID   = "X-Org-Id"   // 当前组织
+	X_USER_ID  = "X-User-Id"  // 当前用户（系统内部调用时传递）
+	X_APP_ID   = "X-App-Id"   // 当前应用（系统内部调用时传递）
+	X_APP_TYPE = "X-App-Type" // 当前应用类型（系统内部调用时传递）
 
 	// gin.Context
 	USER_ID   = "USER_ID"   // 当前用户
@@ -31,8 +34,9 @@ const (
 	IS_SYSTEM = "IS_SYSTEM" // 当前组织X_ORG_ID是否是【系统】
 
 	// openapi相关
-	APP_ID   = "APP_ID"
-	APP_TYPE = "APP_TYPE"
+	APP_ID     = "APP_ID"
+	APP_TYPE   = "APP_TYPE"
+	API_KEY_ID = "API_KEY_ID"
 
 	ANSWER = "ANSWER"
 )
@@ -149,19 +153,23 @@ func ResponseErr(ctx *gin.Context, err error) {
 
 // ResponseDetail 返回httpStatus与err信息，err有i18n
 func ResponseErrWithStatus(ctx *gin.Context, httpStatus int, err error) {
+	code, msg := ErrCodeMsg(ctx, err)
+	ResponseDetail(ctx, httpStatus, code, nil, msg)
+}
+
+// ErrCodeMsg 获取err的code与i18n错误信息
+func ErrCodeMsg(ctx *gin.Context, err error) (codes.Code, string) {
 	st, ok := status.FromError(err)
 	if !ok {
-		ResponseDetail(ctx, httpStatus, codes.Code(err_code.Code_BFFGeneral), nil, fmt.Sprintf("[i18n] %v", err))
-		return
+		return codes.Code(err_code.Code_BFFGeneral), fmt.Sprintf("[i18n] %v", err)
 	}
 	for _, detail := range st.Details() {
 		switch detail := detail.(type) {
 		case *err_code.Status:
-			ResponseDetail(ctx, httpStatus, st.Code(), nil, I18nCodeOrKey(ctx, err_code.Code(st.Code()), detail.TextKey, detail.Args...))
-			return
+			return st.Code(), I18nCodeOrKey(ctx, err_code.Code(st.Code()), detail.TextKey, detail.Args...)
 		}
 	}
-	ResponseDetail(ctx, httpStatus, st.Code(), nil, fmt.Sprintf("[i18n] %v", st.Message()))
+	return st.Code(), fmt.Sprintf("[i18n] %v", st.Message())
 }
 
 // ResponseErrCodeKey 返回400/code与错误信息，code/key有i18n
@@ -0,0 +1,228 @@
+package guardrail
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"unicode/utf8"
+)
+
+// detector type
+const (
+	DetectorPhone    = "phone"     // 手机号
+	DetectorIDCard   = "id_card"   // 身份证号
+	DetectorEmail    = "email"     // 邮箱
+	DetectorBankCard = "bank_card" // 银行卡号
+	DetectorRegex    = "regex"     // 自定义正则
+)
+
+// Detector 规则检测器
+type Detector interface {
+	// Find 返回文本中命中的字节区间[start, end)
+	Find(text string) [][]int
+	// Mask 对命中内容部分打码
+	Mask(match string) string
+	// Label redact时的占位符
+	Label() string
+}
+
+// Factory 根据规则构造检测器
+type Factory func(rule Rule) (Detector, error)
+
+var factories = map[string]Factory{
+	DetectorPhone: func(Rule) (Detector, error) {
+		return &patternDetector{re: phoneRe, label: "PHONE", valid: digitBoundary, mask: maskPhone}, nil
+	},
+	DetectorIDCard: func(Rule) (Detector, error) {
+		return &patternDetector{re: idCardRe, label: "ID_CARD", valid: validIDCard, mask: maskIDCard}, nil
+	},
+	DetectorEmail: func(Rule) (Detector, error) {
+		return &patternDetector{re: emailRe, label: "EMAIL", mask: maskEmail}, nil
+	},
+	DetectorBankCard: func(Rule) (Detector, error) {
+		return &patternDetector{re: bankCardRe, label: "BANK_CARD", valid: validBankCard, mask: maskBankCard}, nil
+	},
+	DetectorRegex: func(rule Rule) (Detector, error) {
+		if rule.Pattern == "" {
+			return nil, fmt.Errorf("regex rule %v pattern empty", rule.Name)
+		}
+		re, err := regexp.Compile(rule.Pattern)
+		if err != nil {
+			return nil, fmt.Errorf("regex rule %v pattern %v invalid: %v", rule.Name, rule.Pattern, err)
+		}
+		label := rule.Name
+		if label == "" {
+			label = "REDACTED"
+		}
+		return &patternDetector{re: re, label: label, mask: maskAll}, nil
+	},
+}
+
+var (
+	phoneRe    = regexp.MustCompile(`(?:\+?86[- ]?)?1[3-9]\d{9}`)
+	idCardRe   = regexp.MustCompile(`[1-9]\d{16}[\dXx]`)
+	emailRe    = regexp.MustCompile(`[A-Za-z0-9._%+\-]+@[A-Za-z0-9.\-]+\.[A-Za-z]{2,}`)
+	bankCardRe = regexp.MustCompile(`\d{4}(?:[ -]\d{4}){3}(?:[ -]\d{1,3})?|\d{15,19}`)
+)
+
+// Register 注册自定义检测器类型，需在init阶段调用
+func Register(typ string, factory Factory) {
+	factories[typ] = factory
+}
+
+// ValidDetector 校验检测器类型
+func ValidDetector(typ string) bool {
+	_, ok := factories[typ]
+	return ok
+}
+
+// NewDetector 根据规则构造检测器
+func NewDetector(rule Rule) (Detector, error) {
+	factory, ok := factories[rule.Type]
+	if !ok {
+		return nil, fmt.Errorf("detector type %v not support", rule.Type)
+	}
+	return factory(rule)
+}
+
+// patternDetector 正则匹配后再做边界、校验位等校验
+type patternDetector struct {
+	re    *regexp.Regexp
+	label string
+	valid func(text string, start, end int) bool
+	mask  func(match string) string
+}
+
+func (d *patternDetector) Find(text string) [][]int {
+	locs := d.re.FindAllStringIndex(text, -1)
+	if d.valid == nil {
+		return locs
+	}
+	var ret [][]int
+	for _, loc := range locs {
+		if d.valid(text, loc[0], loc[1]) {
+			ret = append(ret, loc)
+		}
+	}
+	return ret
+}
+
+func (d *patternDetector) Mask(match string) string {
+	return d.mask(match)
+}
+
+func (d *patternDetector) Label() string {
+	return d.label
+}
+
+// --- validate ---
+
+func isDigit(b byte) bool {
+	return b >= '0' && b <= '9'
+}
+
+// digitBoundary 命中内容前后不能紧接数字，避免从更长的数字串中截取
+func digitBoundary(text string, start, end int) bool {
+	return (start == 0 || !isDigit(text[start-1])) && (end == len(text) || !isDigit(text[end]))
+}
+
+// validIDCard 18位身份证号校验位（GB 11643）
+func validIDCard(text string, start, end int) bool {
+	if !digitBoundary(text, start, end) {
+		return false
+	}
+	weights := []int{7, 9, 10, 5, 8, 4, 2, 1, 6, 3, 7, 9, 10, 5, 8, 4, 2}
+	sum := 0
+	for i, w := range weights {
+		sum += int(text[start+i]-'0') * w
+	}
+	return strings.EqualFold(string("10X98765432"[sum%11]), text[end-1:end])
+}
+
+// validBankCard 银行卡号Luhn校验
+func validBankCard(text string, start, end int) bool {
+	if !digitBoundary(text, start, end) {
+		return false
+	}
+	var digits []int
+	for i := start; i < end; i++ {
+		if isDigit(text[i]) {
+			digits = append(digits, int(text[i]-'0'))
+		}
+	}
+	if len(digits) < 15 || len(digits) > 19 {
+		return false
+	}
+	sum := 0
+	for i := len(digits) - 1; i >= 0; i-- {
+		d := digits[i]
+		if (len(digits)-1-i)%2 == 1 {
+			d *= 2
+			if d > 9 {
+				d -= 9
+			}
+		}
+		sum += d
+	}
+	return sum%10 == 0
+}
+
+// --- mask ---
+
+// maskDigits 保留前head位与后tail位数字，其余数字替换为*，分隔符不变
+func maskDigits(s string, head, tail int) string {
+	n := 0
+	for i := 0; i < len(s); i++ {
+		if isDigit(s[i]) {
+			n++
+		}
+	}
+	b := []byte(s)
+	idx := 0
+	for i := range b {
+		if !isDigit(b[i]) {
+			continue
+		}
+		if idx >= head && idx < n-tail {
+			b[i] = '*'
+		}
+		idx++
+	}
+	return string(b)
+}
+
+// maskPhone 138****8000
+func maskPhone(s string) string {
+	n := 0
+	for i := 0; i < len(s); i++ {
+		if isDigit(s[i]) {
+			n++
+		}
+	}
+	// 国家码之后的11位号码保留前3后4
+	return maskDigits(s, n-8, 4)
+}
+
+// maskIDCard 110***********123X
+func maskIDCard(s string) string {
+	return s[:3] + strings.Repeat("*", len(s)-7) + s[len(s)-4:]
+}
+
+// maskEmail a***@example.com
+func maskEmail(s string) string {
+	at := strings.LastIndex(s, "@")
+	if at <= 1 {
+		return "*" + s[at:]
+	}
+	return s[:1] + "***" + s[at:]
+}
+
+// maskBankCard **** **** **** 1234
+func maskBankCard(s string) string {
+	return maskDigits(s, 0, 4)
+}
+
+// maskAll 全部替换为*
+func maskAll(s string) string {
+	return strings.Repeat("*", utf8.RuneCountInString(s))
+}
@@ -0,0 +1,194 @@
+package guardrail
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// action
+const (
+	ActionMask   = "mask"   // 部分打码，保留首尾便于核对
+	ActionRedact = "redact" // 整体替换为占位符
+	ActionBlock  = "block"  // 拦截本轮对话
+)
+
+// TypeClassifier 分类模型命中时Hit的类型
+const TypeClassifier = "classifier"
+
+// Rule 检测规则
+type Rule struct {
+	Type    string `json:"type"`              // 检测器类型，见 DetectorPhone 等
+	Name    string `json:"name,omitempty"`    // 规则名称，redact时作为自定义正则的占位符
+	Pattern string `json:"pattern,omitempty"` // 正则表达式，仅regex类型
+	Action  string `json:"action"`            // 命中后的处理动作
+}
+
+// Classifier 基于模型的内容分类，unsafe为true时拦截
+type Classifier interface {
+	Classify(ctx context.Context, text string) (unsafe bool, reason string, err error)
+}
+
+// Hit 命中的规则
+type Hit struct {
+	Type   string `json:"type"`
+	Name   string `json:"name,omitempty"`
+	Action string `json:"action"`
+}
+
+// Result 检测结果
+type Result struct {
+	Text    string // 处理后的文本，拦截时为原文
+	Blocked bool   // 是否拦截
+	Hits    []Hit  // 命中的规则
+}
+
+// Stage 一个检测阶段（输入或输出），依次执行规则检测与分类模型
+type Stage struct {
+	rules      []Rule
+	detectors  []Detector
+	classifier Classifier
+	hasRegex   bool
+}
+
+type span struct {
+	start, end int
+	rule       int
+}
+
+// ValidAction 校验处理动作
+func ValidAction(action string) bool {
+	return action == ActionMask || action == ActionRedact || action == ActionBlock
+}
+
+// NewStage 构造检测阶段，classifier为空时只执行规则检测
+func NewStage(rules []Rule, classifier Classifier) (*Stage, error) {
+	s := &Stage{classifier: classifier}
+	for _, rule := range rules {
+		if !ValidAction(rule.Action) {
+			return nil, fmt.Errorf("rule %v invalid action %v", rule.Type, rule.Action)
+		}
+		detector, err := NewDetector(rule)
+		if err != nil {
+			return nil, err
+		}
+		s.rules = append(s.rules, rule)
+		s.detectors = append(s.detectors, detector)
+		if rule.Type == DetectorRegex {
+			s.hasRegex = true
+		}
+	}
+	return s, nil
+}
+
+// Empty 没有任何规则与分类模型
+func (s *Stage) Empty() bool {
+	return s == nil || (len(s.detectors) == 0 && s.classifier == nil)
+}
+
+// HasClassifier 是否配置了分类模型
+func (s *Stage) HasClassifier() bool {
+	return s != nil && s.classifier != nil
+}
+
+// Check 执行规则检测，未拦截时再调用分类模型；分类模型出错时返回规则检测结果及错误，由调用方决定是否放行
+func (s *Stage) Check(ctx context.Context, text string) (*Result, error) {
+	ret := s.Apply(text)
+	if ret.Blocked || !s.HasClassifier() {
+		return ret, nil
+	}
+	blocked, err := s.Classify(ctx, ret.Text, ret)
+	if err != nil {
+		return ret, err
+	}
+	if blocked {
+		ret.Text = text
+	}
+	return ret, nil
+}
+
+// Classify 调用分类模型，命中时将结果标记为拦截
+func (s *Stage) Classify(ctx context.Context, text string, ret *Result) (bool, error) {
+	if !s.HasClassifier() || strings.TrimSpace(text) == "" {
+		return false, nil
+	}
+	unsafe, reason, err := s.classifier.Classify(ctx, text)
+	if err != nil {
+		return false, err
+	}
+	if unsafe {
+		ret.Blocked = true
+		ret.Hits = append(ret.Hits, Hit{Type: TypeClassifier, Name: reason, Action: ActionBlock})
+	}
+	return unsafe, nil
+}
+
+// Apply 只执行规则检测
+func (s *Stage) Apply(text string) *Result {
+	if s == nil {
+		return &Result{Text: text}
+	}
+	spans := s.find(text)
+	return s.render(text, spans)
+}
+
+// find 查找所有命中区间，按起点升序并去除重叠（起点相同时优先保留更长的，再按规则顺序）
+func (s *Stage) find(text string) []span {
+	var spans []span
+	for i, detector := range s.detectors {
+		for _, loc := range detector.Find(text) {
+			spans = append(spans, span{start: loc[0], end: loc[1], rule: i})
+		}
+	}
+	sort.SliceStable(spans, func(i, j int) bool {
+		if spans[i].start != spans[j].start {
+			return spans[i].start < spans[j].start
+		}
+		if spans[i].end != spans[j].end {
+			return spans[i].end > spans[j].end
+		}
+		return spans[i].rule < spans[j].rule
+	})
+	var ret []span
+	end := 0
+	for _, sp := range spans {
+		if sp.start < end {
+			continue
+		}
+		ret = append(ret, sp)
+		end = sp.end
+	}
+	return ret
+}
+
+// render 按命中区间处理文本，存在block动作时拦截
+func (s *Stage) render(text string, spans []span) *Result {
+	ret := &Result{Text: text}
+	if len(spans) == 0 {
+		return ret
+	}
+	var sb strings.Builder
+	last := 0
+	for _, sp := range spans {
+		rule, detector := s.rules[sp.rule], s.detectors[sp.rule]
+		ret.Hits = append(ret.Hits, Hit{Type: rule.Type, Name: rule.Name, Action: rule.Action})
+		sb.WriteString(text[last:sp.start])
+		match := text[sp.start:sp.end]
+		switch rule.Action {
+		case ActionMask:
+			sb.WriteString(detector.Mask(match))
+		case ActionRedact:
+			sb.WriteString("[" + detector.Label() + "]")
+		case ActionBlock:
+			ret.Blocked = true
+		}
+		last = sp.end
+	}
+	if ret.Blocked {
+		return ret
+	}
+	sb.WriteString(text[last:])
+	ret.Text = sb.String()
+	return ret
+}
@@ -0,0 +1,182 @@
+package guardrail
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+)
+
+func newStage(t *testing.T, classifier Classifier, rules ...Rule) *Stage {
+	stage, err := NewStage(rules, classifier)
+	if err != nil {
+		t.Fatalf("new stage err: %v", err)
+	}
+	return stage
+}
+
+func TestApply(t *testing.T) {
+	for _, c := range []struct {
+		rule    Rule
+		text    string
+		want    string
+		blocked bool
+	}{
+		{Rule{Type: DetectorPhone, Action: ActionMask}, "电话13800138000，谢谢", "电话138****8000，谢谢", false},
+		{Rule{Type: DetectorPhone, Action: ActionMask}, "电话+86 13800138000", "电话+86 138****8000", false},
+		{Rule{Type: DetectorPhone, Action: ActionMask}, "订单号138001380001", "订单号138001380001", false},
+		{Rule{Type: DetectorPhone, Action: ActionRedact}, "call 13800138000", "call [PHONE]", false},
+		{Rule{Type: DetectorIDCard, Action: ActionMask}, "身份证11010519491231002X。", "身份证110***********002X。", false},
+		{Rule{Type: DetectorIDCard, Action: ActionMask}, "身份证110105194912310021。", "身份证110105194912310021。", false},
+		{Rule{Type: DetectorEmail, Action: ActionMask}, "mail: alice.w@example.com", "mail: a***@example.com", false},
+		{Rule{Type: DetectorEmail, Action: ActionRedact}, "mail: alice@example.com!", "mail: [EMAIL]!", false},
+		{Rule{Type: DetectorBankCard, Action: ActionMask}, "卡号4111111111111111", "卡号************1111", false},
+		{Rule{Type: DetectorBankCard, Action: ActionMask}, "卡号4111 1111 1111 1111", "卡号**** **** **** 1111", false},
+		{Rule{Type: DetectorBankCard, Action: ActionMask}, "卡号4111111111111112", "卡号4111111111111112", false},
+		{Rule{Type: DetectorBankCard, Action: ActionBlock}, "卡号4111111111111111", "卡号4111111111111111", true},
+		{Rule{Type: DetectorRegex, Name: "项目代号", Pattern: `PRJ-\d{4}`, Action: ActionRedact}, "项目PRJ-2024上线", "项目[项目代号]上线", false},
+		{Rule{Type: DetectorRegex, Pattern: `机密`, Action: ActionMask}, "这是机密文件", "这是**文件", false},
+	} {
+		ret := newStage(t, nil, c.rule).Apply(c.text)
+		if ret.Text != c.want || ret.Blocked != c.blocked {
+			t.Errorf("%v %v apply %q = %q blocked %v, want %q blocked %v", c.rule.Type, c.rule.Action, c.text, ret.Text, ret.Blocked, c.want, c.blocked)
+		}
+	}
+}
+
+func TestApplyOverlap(t *testing.T) {
+	stage := newStage(t, nil,
+		Rule{Type: DetectorPhone, Action: ActionMask},
+		Rule{Type: DetectorEmail, Action: ActionRedact},
+		Rule{Type: DetectorRegex, Name: "NUM", Pattern: `\d+`, Action: ActionRedact},
+	)
+	ret := stage.Apply("13800138000@example.com 与 13800138000")
+	if want := "[EMAIL] 与 138****8000"; ret.Text != want {
+		t.Fatalf("apply = %q, want %q", ret.Text, want)
+	}
+	if len(ret.Hits) != 2 || ret.Hits[0].Type != DetectorEmail || ret.Hits[1].Type != DetectorPhone {
+		t.Fatalf("unexpected hits %+v", ret.Hits)
+	}
+}
+
+func TestNewStage(t *testing.T) {
+	for _, rule := range []Rule{
+		{Type: "unknown", Action: ActionMask},
+		{Type: DetectorPhone, Action: "drop"},
+		{Type: DetectorRegex, Action: ActionMask},
+		{Type: DetectorRegex, Pattern: `(`, Action: ActionMask},
+	} {
+		if _, err := NewStage([]Rule{rule}, nil); err == nil {
+			t.Errorf("rule %+v expect err", rule)
+		}
+	}
+}
+
+type mockClassifier struct {
+	keyword string
+	err     error
+	texts   []string
+}
+
+func (m *mockClassifier) Classify(ctx context.Context, text string) (bool, string, error) {
+	m.texts = append(m.texts, text)
+	if m.err != nil {
+		return false, "", m.err
+	}
+	if strings.Contains(text, m.keyword) {
+		return true, "unsafe " + m.keyword, nil
+	}
+	return false, "", nil
+}
+
+func TestCheck(t *testing.T) {
+	classifier := &mockClassifier{keyword: "攻击"}
+	stage := newStage(t, classifier, Rule{Type: DetectorPhone, Action: ActionMask})
+
+	ret, err := stage.Check(context.Background(), "我的电话13800138000")
+	if err != nil || ret.Blocked || ret.Text != "我的电话138****8000" {
+		t.Fatalf("check = %+v err %v", ret, err)
+	}
+	// 分类模型收到的是脱敏后的文本
+	if classifier.texts[0] != "我的电话138****8000" {
+		t.Fatalf("classifier text %q", classifier.texts[0])
+	}
+
+	ret, err = stage.Check(context.Background(), "如何攻击网站")
+	if err != nil || !ret.Blocked || ret.Hits[0].Type != TypeClassifier {
+		t.Fatalf("check = %+v err %v", ret, err)
+	}
+
+	classifier.err = errors.New("model unavailable")
+	ret, err = stage.Check(context.Background(), "你好")
+	if err == nil || ret.Blocked || ret.Text != "你好" {
+		t.Fatalf("check = %+v err %v", ret, err)
+	}
+}
+
+func streamAll(stage *Stage, chunks []string) (string, bool) {
+	st := stage.NewStream()
+	var sb strings.Builder
+	for _, chunk := range chunks {
+		ret := st.Write(chunk)
+		if ret.Blocked {
+			return sb.String(), true
+		}
+		sb.WriteString(ret.Text)
+	}
+	ret := st.Flush()
+	sb.WriteString(ret.Text)
+	return sb.String(), ret.Blocked
+}
+
+func TestStream(t *testing.T) {
+	stage := newStage(t, nil,
+		Rule{Type: DetectorPhone, Action: ActionMask},
+		Rule{Type: DetectorEmail, Action: ActionRedact},
+		Rule{Type: DetectorBankCard, Action: ActionMask},
+	)
+	for _, c := range []struct {
+		chunks []string
+		want   string
+	}{
+		{[]string{"联系电话", "138", "0013", "8000", "，邮箱", "bob@exa", "mple.com"}, "联系电话138****8000，邮箱[EMAIL]"},
+		{[]string{"卡号4111 11", "11 1111 1", "111。谢谢"}, "卡号**** **** **** 1111。谢谢"},
+		{[]string{"hello ", "world"}, "hello world"},
+	} {
+		got, blocked := streamAll(stage, c.chunks)
+		if got != c.want || blocked {
+			t.Errorf("stream %q = %q blocked %v, want %q", c.chunks, got, blocked, c.want)
+		}
+	}
+
+	regexStage := newStage(t, nil, Rule{Type: DetectorRegex, Name: "代号", Pattern: `内部项目[A-Z]+`, Action: ActionRedact})
+	if got, _ := streamAll(regexStage, []string{"这是内部", "项目", "ABC的介绍"}); got != "这是[代号]的介绍" {
+		t.Errorf("regex stream = %q", got)
+	}
+
+	blockStage := newStage(t, nil, Rule{Type: DetectorIDCard, Action: ActionBlock})
+	got, blocked := streamAll(blockStage, []string{"身份证号是1101051949", "1231002X，", "请查收"})
+	if !blocked || strings.Contains(got, "1101051949") {
+		t.Errorf("block stream = %q blocked %v", got, blocked)
+	}
+}
+
+func TestHoldCut(t *testing.T) {
+	long := strings.Repeat("a", maxHoldBytes+10)
+	for _, c := range []struct {
+		text string
+		hold int
+		want int
+	}{
+		{"你好，", 0, len("你好，")},
+		{"电话138", 0, len("电话")},
+		{"卡号6222 0202", 0, len("卡号")},
+		{"hello world", 0, len("hello ")},
+		{"你好", 1, len("你")},
+		{long, 0, 10},
+	} {
+		if got := holdCut(c.text, c.hold); got != c.want {
+			t.Errorf("holdCut(%q, %v) = %v, want %v", c.text, c.hold, got, c.want)
+		}
+	}
+}
@@ -0,0 +1,94 @@
+package guardrail
+
+import (
+	"unicode/utf8"
+)
+
+const (
+	// maxHoldBytes 流式检测最多缓存的字节数
+	maxHoldBytes = 256
+	// regexHoldRunes 存在自定义正则时至少缓存的字符数
+	regexHoldRunes = 32
+)
+
+// Stream 流式规则检测；末尾可能与后续内容构成命中的片段会先缓存，保证跨分片的敏感信息也能被处理
+type Stream struct {
+	stage   *Stage
+	pending string
+}
+
+// NewStream 构造流式检测
+func (s *Stage) NewStream() *Stream {
+	return &Stream{stage: s}
+}
+
+// Write 追加一段内容，返回可以下发的处理结果（可能为空）
+func (st *Stream) Write(chunk string) *Result {
+	text := st.pending + chunk
+	if st.stage == nil {
+		st.pending = ""
+		return &Result{Text: text}
+	}
+	hold := 0
+	if st.stage.hasRegex {
+		hold = regexHoldRunes
+	}
+	cut := holdCut(text, hold)
+	spans := st.stage.find(text)
+	var ready []span
+	for _, sp := range spans {
+		if sp.end <= cut {
+			ready = append(ready, sp)
+			continue
+		}
+		// 跨越下发位置的命中留到下次处理
+		if sp.start < cut {
+			cut = sp.start
+		}
+		break
+	}
+	st.pending = text[cut:]
+	return st.stage.render(text[:cut], ready)
+}
+
+// Flush 处理剩余的缓存内容
+func (st *Stream) Flush() *Result {
+	text := st.pending
+	st.pending = ""
+	return st.stage.Apply(text)
+}
+
+// holdCut 计算可以下发的位置：末尾由数字、字母、邮箱字符组成的片段可能还未结束，需要缓存；hold为至少缓存的字符数
+func holdCut(text string, hold int) int {
+	cut := len(text)
+	for cut > 0 {
+		r, size := utf8.DecodeLastRuneInString(text[:cut])
+		if isTokenRune(r) {
+			cut -= size
+			continue
+		}
+		// 银行卡号等数字分组之间的空格
+		if r == ' ' && cut-size > 0 && isDigit(text[cut-size-1]) {
+			cut -= size
+			continue
+		}
+		break
+	}
+	for i, n := len(text), 0; i > 0 && n < hold; n++ {
+		_, size := utf8.DecodeLastRuneInString(text[:i])
+		i -= size
+		cut = min(cut, i)
+	}
+	if len(text)-cut > maxHoldBytes {
+		cut = len(text) - maxHoldBytes
+		for cut < len(text) && !utf8.RuneStart(text[cut]) {
+			cut++
+		}
+	}
+	return cut
+}
+
+func isTokenRune(r rune) bool {
+	return (r >= '0' && r <= '9') || (r >= 'a' && r <= 'z') || (r >= 'A' && r <= 'Z') ||
+		r == '.' || r == '_' || r == '%' || r == '+' || r == '-' || r == '@'
+}
@@ -0,0 +1,79 @@
+package minio
+
+import (
+	"context"
+	"io"
+
+	"github.com/UnicomAI/wanwu/pkg/util"
+	"github.com/minio/minio-go/v7"
+)
+
+// MultipartMinPartSize minio分片上传除最后一片外每片的最小大小
+const MultipartMinPartSize = 5 * 1024 * 1024
+
+// NewFileMultipartUpload 在过期目录创建分片上传，返回文件名、对象名和uploadId
+func NewFileMultipartUpload(ctx context.Context, fileType string) (string, string, string, error) {
+	fileName := util.GenUUID() + fileType
+	objectName := buildObjectName(DirFileExpire, fileName)
+	uploadId, err := fileUploadCore().NewMultipartUpload(ctx, BucketFileUpload, objectName, minio.PutObjectOptions{})
+	if err != nil {
+		return "", "", "", err
+	}
+	return fileName, objectName, uploadId, nil
+}
+
+// PutFileMultipartPart 上传分片，相同分片号重复上传时覆盖
+func PutFileMultipartPart(ctx context.Context, objectName, uploadId string, partNumber int, reader io.Reader, size int64) error {
+	_, err := fileUploadCore().PutObjectPart(ctx, BucketFileUpload, objectName, uploadId, partNumber, reader, size, minio.PutObjectPartOptions{})
+	return err
+}
+
+// ListFileMultipartParts 查询已上传的分片，按分片号升序
+func ListFileMultipartParts(ctx context.Context, objectName, uploadId string) ([]minio.ObjectPart, error) {
+	var parts []minio.ObjectPart
+	marker := 0
+	for {
+		result, err := fileUploadCore().ListObjectParts(ctx, BucketFileUpload, objectName, uploadId, marker, 1000)
+		if err != nil {
+			return nil, err
+		}
+		parts = append(parts, result.ObjectParts...)
+		if !result.IsTruncated {
+			return parts, nil
+		}
+		marker = result.NextPartNumberMarker
+	}
+}
+
+// CompleteFileMultipartUpload 合并分片；isExpired为true时文件移动到不过期目录
+func CompleteFileMultipartUpload(ctx context.Context, fileName, objectName, uploadId string, parts []minio.ObjectPart, isExpired bool) error {
+	completeParts := make([]minio.CompletePart, 0, len(parts))
+	for _, part := range parts {
+		completeParts = append(completeParts, minio.CompletePart{PartNumber: part.PartNumber, ETag: part.ETag})
+	}
+	_, err := fileUploadCore().CompleteMultipartUpload(ctx, BucketFileUpload, objectName, uploadId, completeParts, minio.PutObjectOptions{})
+	if err != nil || !isExpired {
+		return err
+	}
+	_, err = _minioFileUpload.cli.ComposeObject(ctx,
+		minio.CopyDestOptions{Bucket: BucketFileUpload, Object: buildObjectName(DirFileNotExpire, fileName)},
+		minio.CopySrcOptions{Bucket: BucketFileUpload, Object: objectName})
+	if err != nil {
+		return err
+	}
+	return _minioFileUpload.cli.RemoveObject(ctx, BucketFileUpload, objectName, minio.RemoveObjectOptions{})
+}
+
+// AbortFileMultipartUpload 取消分片上传并清理已上传的分片
+func AbortFileMultipartUpload(ctx context.Context, objectName, uploadId string) error {
+	return fileUploadCore().AbortMultipartUpload(ctx, BucketFileUpload, objectName, uploadId)
+}
+
+// IsNoSuchUpload 分片上传不存在（已合并或已取消）
+func IsNoSuchUpload(err error) bool {
+	return minio.ToErrorResponse(err).Code == "NoSuchUpload"
+}
+
+func fileUploadCore() *minio.Core {
+	return &minio.Core{Client: _minioFileUpload.cli}
+}
@@ -99,6 +99,11 @@ func GetUploadFileWithExpire(ctx context.Context, fileName string) (string, erro
 	return buildFilePath(BucketFileUpload, object.Key), nil
 }
 
+// GetFileObjectWithExpire 读取过期目录中的文件
+func GetFileObjectWithExpire(ctx context.Context, fileName string) (*minio.Object, error) {
+	return _minioFileUpload.cli.GetObject(ctx, BucketFileUpload, buildObjectName(DirFileExpire, fileName), minio.GetObjectOptions{})
+}
+
 func GetUploadFileWithNotExpire(ctx context.Context, fileName string) (string, error) {
 	objectName := buildObjectName(DirFileNotExpire, fileName)
 	object, err := _minioFileUpload.cli.StatObject(ctx, BucketFileUpload, objectName, minio.StatObjectOptions{})
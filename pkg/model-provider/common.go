@@ -18,6 +18,9 @@ const (
 	ProviderOllama           = "Ollama"
 	ProviderQwen             = "Qwen"
 	ProviderInfini           = "Infini"
+	ProviderAnthropic        = "Anthropic"
+	ProviderGemini           = "Gemini"
+	ProviderHttp             = "HTTP" // 通用HTTP接口，通过字段映射适配
 )
 
 var (
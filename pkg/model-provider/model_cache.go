@@ -0,0 +1,593 @@
+package mp
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"math"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/UnicomAI/wanwu/pkg/log"
+	mp_common "github.com/UnicomAI/wanwu/pkg/model-provider/mp-common"
+	"github.com/UnicomAI/wanwu/pkg/redis"
+	"github.com/UnicomAI/wanwu/pkg/util"
+)
+
+const (
+	defaultCacheTTLSeconds        = 3600
+	defaultCacheSemanticThreshold = 0.95
+	defaultCacheSemanticEntries   = 50
+	maxCacheSemanticEntries       = 200
+
+	// CacheBypassHeader 请求头，值为true时本次请求不读取也不写入缓存
+	CacheBypassHeader = "X-Cache-Bypass"
+	// CacheStatusHeader 响应头，返回本次请求的缓存状态
+	CacheStatusHeader = "X-Cache"
+
+	CacheStatusHit         = "HIT"
+	CacheStatusSemanticHit = "SEMANTIC_HIT"
+	CacheStatusMiss        = "MISS"
+	CacheStatusBypass      = "BYPASS"
+)
+
+// CachePolicy 模型响应缓存策略：相同请求在有效期内直接返回缓存结果；llm可开启语义缓存，按embedding相似度命中；
+// 缓存按调用方（组织+用户）隔离，不同调用方之间不共享缓存结果
+type CachePolicy struct {
+	Enable                   bool    `json:"enable"`                   // 是否开启缓存（按模型ID+归一化请求精确匹配）
+	TTLSeconds               int     `json:"ttlSeconds"`               // 缓存有效期（秒），0表示使用默认值3600
+	SemanticEnable           bool    `json:"semanticEnable"`           // 是否开启语义缓存，仅llm支持
+	SemanticEmbeddingModelId string  `json:"semanticEmbeddingModelId"` // 语义缓存使用的embedding模型ID
+	SemanticThreshold        float64 `json:"semanticThreshold"`        // 语义缓存命中的余弦相似度阈值，(0, 1]，0表示使用默认值0.95
+	SemanticMaxEntries       int     `json:"semanticMaxEntries"`       // 单个调用方在相同请求参数下语义缓存的最大条目数，0表示使用默认值50
+}
+
+func (p *CachePolicy) Check() error {
+	if p.TTLSeconds < 0 {
+		return fmt.Errorf("cache policy ttlSeconds should not be negative")
+	}
+	if !p.SemanticEnable {
+		return nil
+	}
+	if !p.Enable {
+		return fmt.Errorf("cache policy semanticEnable requires enable")
+	}
+	if p.SemanticEmbeddingModelId == "" {
+		return fmt.Errorf("cache policy semanticEmbeddingModelId should not be empty")
+	}
+	if p.SemanticThreshold < 0 || p.SemanticThreshold > 1 {
+		return fmt.Errorf("cache policy semanticThreshold should be in [0, 1]")
+	}
+	if p.SemanticMaxEntries < 0 || p.SemanticMaxEntries > maxCacheSemanticEntries {
+		return fmt.Errorf("cache policy semanticMaxEntries should be in [0, %v]", maxCacheSemanticEntries)
+	}
+	return nil
+}
+
+// ToCachePolicy 解析模型缓存策略，cfg为空时返回nil
+func ToCachePolicy(cfg string) (*CachePolicy, error) {
+	if cfg == "" {
+		return nil, nil
+	}
+	ret := &CachePolicy{}
+	if err := json.Unmarshal([]byte(cfg), ret); err != nil {
+		return nil, fmt.Errorf("unmarshal cache policy err: %v", err)
+	}
+	if err := ret.Check(); err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+func (p *CachePolicy) ttl() time.Duration {
+	if p.TTLSeconds == 0 {
+		return defaultCacheTTLSeconds * time.Second
+	}
+	return time.Duration(p.TTLSeconds) * time.Second
+}
+
+func (p *CachePolicy) semanticThreshold() float64 {
+	if p.SemanticThreshold == 0 {
+		return defaultCacheSemanticThreshold
+	}
+	return p.SemanticThreshold
+}
+
+func (p *CachePolicy) semanticMaxEntries() int {
+	if p.SemanticMaxEntries == 0 {
+		return defaultCacheSemanticEntries
+	}
+	return p.SemanticMaxEntries
+}
+
+// CacheControl 单次请求的缓存控制
+type CacheControl struct {
+	NoCache bool // 不读取缓存，响应仍写入缓存
+	NoStore bool // 不读取也不写入缓存
+}
+
+// ParseCacheControl 解析请求头：Cache-Control: no-cache 不读取缓存；Cache-Control: no-store 或 X-Cache-Bypass: true 不读取也不写入缓存
+func ParseCacheControl(header http.Header) CacheControl {
+	var ret CacheControl
+	for _, directive := range strings.Split(header.Get("Cache-Control"), ",") {
+		switch strings.ToLower(strings.TrimSpace(directive)) {
+		case "no-cache":
+			ret.NoCache = true
+		case "no-store":
+			ret.NoCache, ret.NoStore = true, true
+		}
+	}
+	if bypass := strings.ToLower(header.Get(CacheBypassHeader)); bypass == "true" || bypass == "1" {
+		ret.NoCache, ret.NoStore = true, true
+	}
+	return ret
+}
+
+// CacheStore 缓存存储，由pkg/redis的客户端实现
+type CacheStore interface {
+	Get(ctx context.Context, key string) (string, error)
+	Set(ctx context.Context, key, value string, expire time.Duration) error
+	Expire(ctx context.Context, key string, expire time.Duration) error
+	HSet(ctx context.Context, key string, items []redis.HashItem) error
+	HGetAll(ctx context.Context, key string) ([]redis.HashItem, error)
+	HIncrBy(ctx context.Context, key, field string, incr int64) error
+}
+
+// CacheRecorder 缓存查询结束后的回调，status为CacheStatusXXX
+type CacheRecorder func(status string)
+
+// CacheStat 模型缓存命中统计
+type CacheStat struct {
+	Hit         int64 `json:"hit"`         // 精确缓存命中次数
+	SemanticHit int64 `json:"semanticHit"` // 语义缓存命中次数
+	Miss        int64 `json:"miss"`        // 未命中次数
+}
+
+// GetCacheStat 查询模型缓存命中统计
+func GetCacheStat(ctx context.Context, store CacheStore, modelId string) (*CacheStat, error) {
+	items, err := store.HGetAll(ctx, cacheStatKey(modelId))
+	if err != nil {
+		return nil, err
+	}
+	ret := &CacheStat{}
+	for _, item := range items {
+		switch item.K {
+		case CacheStatusHit:
+			ret.Hit = util.MustI64(item.V)
+		case CacheStatusSemanticHit:
+			ret.SemanticHit = util.MustI64(item.V)
+		case CacheStatusMiss:
+			ret.Miss = util.MustI64(item.V)
+		}
+	}
+	return ret, nil
+}
+
+// Cache 单个模型在单个调用方下的响应缓存
+type Cache struct {
+	store    CacheStore
+	modelId  string
+	scope    string
+	policy   *CachePolicy
+	control  CacheControl
+	recorder CacheRecorder
+}
+
+// NewCache policy未开启时返回nil；scope为调用方标识，缓存按scope隔离；recorder可为nil
+func NewCache(store CacheStore, modelId, scope string, policy *CachePolicy, control CacheControl, recorder CacheRecorder) *Cache {
+	if store == nil || policy == nil || !policy.Enable {
+		return nil
+	}
+	return &Cache{store: store, modelId: modelId, scope: scope, policy: policy, control: control, recorder: recorder}
+}
+
+func (c *Cache) get(ctx context.Context, key string) string {
+	value, err := c.store.Get(ctx, key)
+	if err != nil {
+		log.Warnf("model %v cache get err: %v", c.modelId, err)
+		return ""
+	}
+	return value
+}
+
+func (c *Cache) set(ctx context.Context, key, value string) {
+	if err := c.store.Set(ctx, key, value, c.policy.ttl()); err != nil {
+		log.Warnf("model %v cache set err: %v", c.modelId, err)
+	}
+}
+
+func (c *Cache) record(ctx context.Context, status string) {
+	if status != CacheStatusBypass {
+		if err := c.store.HIncrBy(ctx, cacheStatKey(c.modelId), status, 1); err != nil {
+			log.Warnf("model %v cache stat err: %v", c.modelId, err)
+		}
+	}
+	if c.recorder != nil {
+		c.recorder(status)
+	}
+}
+
+// missStatus 未读取缓存时的状态
+func (c *Cache) missStatus() string {
+	if c.control.NoCache {
+		return CacheStatusBypass
+	}
+	return CacheStatusMiss
+}
+
+func (c *Cache) key(kind, hash string) string {
+	return fmt.Sprintf("model_cache:%v:%v:%v:%v", c.modelId, c.scope, kind, hash)
+}
+
+func cacheStatKey(modelId string) string {
+	return fmt.Sprintf("model_cache:%v:stat", modelId)
+}
+
+// hashRequest 归一化请求并计算hash：忽略流式相关字段，json序列化时map按key排序
+func hashRequest(data map[string]interface{}, ignores ...string) string {
+	normalized := make(map[string]interface{}, len(data))
+	for k, v := range data {
+		normalized[k] = v
+	}
+	for _, k := range append(ignores, "stream", "stream_options") {
+		delete(normalized, k)
+	}
+	b, _ := json.Marshal(normalized)
+	sum := sha256.Sum256(b)
+	return hex.EncodeToString(sum[:])
+}
+
+// --- embedding ---
+
+// NewCacheEmbedding 返回带精确缓存的IEmbedding；cache为nil时返回embedding本身
+func NewCacheEmbedding(embedding IEmbedding, cache *Cache) IEmbedding {
+	if cache == nil {
+		return embedding
+	}
+	return &cacheEmbedding{IEmbedding: embedding, cache: cache}
+}
+
+type cacheEmbedding struct {
+	IEmbedding
+	cache *Cache
+}
+
+func (c *cacheEmbedding) Embeddings(ctx context.Context, req mp_common.IEmbeddingReq, headers ...mp_common.Header) (mp_common.IEmbeddingResp, error) {
+	key := c.cache.key("embedding", hashRequest(req.Data()))
+	if !c.cache.control.NoCache {
+		if value := c.cache.get(ctx, key); value != "" {
+			c.cache.record(ctx, CacheStatusHit)
+			return mp_common.NewEmbeddingResp(value), nil
+		}
+	}
+	c.cache.record(ctx, c.cache.missStatus())
+	resp, err := c.IEmbedding.Embeddings(ctx, req, headers...)
+	if err != nil {
+		return nil, err
+	}
+	if _, ok := resp.ConvertResp(); ok && !c.cache.control.NoStore {
+		c.cache.set(ctx, key, resp.String())
+	}
+	return resp, nil
+}
+
+// --- llm ---
+
+// Embedder 计算文本向量，用于语义缓存
+type Embedder func(ctx context.Context, text string) ([]float64, error)
+
+// NewEmbedder 使用embedding模型计算文本向量
+func NewEmbedder(embedding IEmbedding, model string) Embedder {
+	return func(ctx context.Context, text string) ([]float64, error) {
+		req, err := embedding.NewReq(&mp_common.EmbeddingReq{Model: model, Input: []string{text}})
+		if err != nil {
+			return nil, err
+		}
+		resp, err := embedding.Embeddings(ctx, req)
+		if err != nil {
+			return nil, err
+		}
+		data, ok := resp.ConvertResp()
+		if !ok || len(data.Data) == 0 {
+			return nil, fmt.Errorf("invalid embedding resp: %v", resp.String())
+		}
+		return data.Data[0].Embedding, nil
+	}
+}
+
+// NewCacheLLM 返回带缓存的ILLM：非流式与流式共用缓存，流式命中时以SSE数据包回放缓存结果；
+// embedder非nil且策略开启语义缓存时，精确缓存未命中再按语义相似度查询；cache为nil时返回llm本身
+func NewCacheLLM(llm ILLM, cache *Cache, embedder Embedder) ILLM {
+	if cache == nil {
+		return llm
+	}
+	if !cache.policy.SemanticEnable {
+		embedder = nil
+	}
+	return &cacheLLM{ILLM: llm, cache: cache, embedder: embedder}
+}
+
+type cacheLLM struct {
+	ILLM
+	cache    *Cache
+	embedder Embedder
+}
+
+// semanticEntry 语义缓存条目，以精确缓存的hash为field存储在语义缓存分桶中
+type semanticEntry struct {
+	Vector []float64 `json:"vector"`
+}
+
+func (c *cacheLLM) ChatCompletions(ctx context.Context, req mp_common.ILLMReq, headers ...mp_common.Header) (mp_common.ILLMResp, <-chan mp_common.ILLMResp, error) {
+	hash := hashRequest(req.Data())
+	key := c.cache.key("llm", hash)
+	semantic := c.newSemantic(ctx, req)
+	if !c.cache.control.NoCache {
+		if value := c.cache.get(ctx, key); value != "" {
+			c.cache.record(ctx, CacheStatusHit)
+			return replayLLMResp(req.Stream(), value)
+		}
+		if value := semantic.lookup(ctx); value != "" {
+			c.cache.record(ctx, CacheStatusSemanticHit)
+			return replayLLMResp(req.Stream(), value)
+		}
+	}
+	c.cache.record(ctx, c.cache.missStatus())
+	resp, sseCh, err := c.ILLM.ChatCompletions(ctx, req, headers...)
+	if err != nil || c.cache.control.NoStore {
+		return resp, sseCh, err
+	}
+	// unary
+	if sseCh == nil {
+		if data, ok := resp.ConvertResp(); ok && len(data.Choices) > 0 {
+			c.cache.set(ctx, key, resp.String())
+			semantic.add(ctx, hash)
+		}
+		return resp, nil, nil
+	}
+	// stream，流正常结束后将拼接的结果写入缓存
+	ret := make(chan mp_common.ILLMResp, 1024)
+	go func() {
+		defer util.PrintPanicStack()
+		defer close(ret)
+		acc := &streamAccumulator{}
+		for sseResp := range sseCh {
+			acc.add(sseResp)
+			ret <- sseResp
+		}
+		if value, ok := acc.result(); ok && ctx.Err() == nil {
+			c.cache.set(ctx, key, value)
+			semantic.add(ctx, hash)
+		}
+	}()
+	return nil, ret, nil
+}
+
+// replayLLMResp 返回缓存结果，流式请求转换为SSE数据包
+func replayLLMResp(stream bool, value string) (mp_common.ILLMResp, <-chan mp_common.ILLMResp, error) {
+	if !stream {
+		return mp_common.NewLLMResp(false, value), nil, nil
+	}
+	data, ok := mp_common.NewLLMResp(false, value).ConvertResp()
+	if !ok {
+		return nil, nil, fmt.Errorf("invalid cached llm resp: %v", value)
+	}
+	var chunks []*mp_common.LLMResp
+	var finish []mp_common.OpenAIRespChoice
+	for _, choice := range data.Choices {
+		delta := &mp_common.OpenAIMsg{Role: mp_common.MsgRoleAssistant}
+		if choice.Message != nil {
+			delta.Content = choice.Message.Content
+			delta.ReasoningContent = choice.Message.ReasoningContent
+			for i, toolCall := range choice.Message.ToolCalls {
+				toolCall.Index = &i
+				delta.ToolCalls = append(delta.ToolCalls, toolCall)
+			}
+		}
+		chunks = append(chunks, &mp_common.LLMResp{
+			ID:      data.ID,
+			Object:  "chat.completion.chunk",
+			Created: data.Created,
+			Model:   data.Model,
+			Choices: []mp_common.OpenAIRespChoice{{Index: choice.Index, Delta: delta}},
+		})
+		finish = append(finish, mp_common.OpenAIRespChoice{Index: choice.Index, Delta: &mp_common.OpenAIMsg{}, FinishReason: choice.FinishReason})
+	}
+	chunks = append(chunks, &mp_common.LLMResp{
+		ID:      data.ID,
+		Object:  "chat.completion.chunk",
+		Created: data.Created,
+		Model:   data.Model,
+		Choices: finish,
+		Usage:   data.Usage,
+	})
+	ret := make(chan mp_common.ILLMResp, len(chunks)*2+2)
+	for _, chunk := range chunks {
+		ret <- mp_common.NewLLMResp(true, mp_common.NewLLMStreamData(chunk))
+		ret <- mp_common.NewLLMResp(true, "")
+	}
+	ret <- mp_common.NewLLMResp(true, "data: [DONE]")
+	ret <- mp_common.NewLLMResp(true, "")
+	close(ret)
+	return nil, ret, nil
+}
+
+// streamAccumulator 拼接流式结果；多选项、工具调用或错误结束的流不缓存
+type streamAccumulator struct {
+	resp      *mp_common.LLMResp
+	content   strings.Builder
+	reasoning strings.Builder
+	finish    string
+	invalid   bool
+}
+
+func (a *streamAccumulator) add(sseResp mp_common.ILLMResp) {
+	if a.invalid {
+		return
+	}
+	if _, ok := mp_common.ToStreamError(sseResp); ok {
+		a.invalid = true
+		return
+	}
+	data, ok := sseResp.ConvertResp()
+	if !ok {
+		return
+	}
+	if a.resp == nil {
+		a.resp = &mp_common.LLMResp{ID: data.ID, Object: "chat.completion", Created: data.Created, Model: data.Model}
+	}
+	if data.Usage.TotalTokens > 0 {
+		a.resp.Usage = data.Usage
+	}
+	for _, choice := range data.Choices {
+		if choice.Index != 0 || (choice.Delta != nil && (len(choice.Delta.ToolCalls) > 0 || choice.Delta.FunctionCall != nil)) {
+			a.invalid = true
+			return
+		}
+		if choice.Delta != nil {
+			a.content.WriteString(choice.Delta.Content)
+			if choice.Delta.ReasoningContent != nil {
+				a.reasoning.WriteString(*choice.Delta.ReasoningContent)
+			}
+		}
+		if choice.FinishReason != "" {
+			a.finish = choice.FinishReason
+		}
+	}
+}
+
+func (a *streamAccumulator) result() (string, bool) {
+	if a.invalid || a.resp == nil || a.finish == "" {
+		return "", false
+	}
+	msg := &mp_common.OpenAIMsg{Role: mp_common.MsgRoleAssistant, Content: a.content.String()}
+	if a.reasoning.Len() > 0 {
+		reasoning := a.reasoning.String()
+		msg.ReasoningContent = &reasoning
+	}
+	a.resp.Choices = []mp_common.OpenAIRespChoice{{Index: 0, Message: msg, FinishReason: a.finish}}
+	b, err := json.Marshal(a.resp)
+	if err != nil {
+		return "", false
+	}
+	return string(b), true
+}
+
+// --- semantic ---
+
+// cacheSemantic 单次请求的语义缓存
+type cacheSemantic struct {
+	cache    *Cache
+	embedder Embedder
+	text     string
+	params   string
+	vector   []float64
+	entries  []redis.HashItem
+	loaded   bool
+}
+
+func (c *cacheLLM) newSemantic(ctx context.Context, req mp_common.ILLMReq) *cacheSemantic {
+	if c.embedder == nil {
+		return nil
+	}
+	openAIReq, ok := req.OpenAIReq()
+	if !ok {
+		return nil
+	}
+	var lines []string
+	for _, msg := range openAIReq.Messages {
+		if text := msg.Text(); text != "" {
+			lines = append(lines, string(msg.Role)+": "+text)
+		}
+	}
+	if len(lines) == 0 {
+		return nil
+	}
+	return &cacheSemantic{
+		cache:    c.cache,
+		embedder: c.embedder,
+		text:     strings.Join(lines, "\n"),
+		params:   hashRequest(req.Data(), "messages"),
+	}
+}
+
+// key 语义缓存分桶：按调用方与除messages外的请求参数分桶，参数一致时才比较相似度，单个分桶条目数有上限
+func (s *cacheSemantic) key() string {
+	return s.cache.key("semantic", s.params)
+}
+
+// load 计算请求向量并读取所在分桶的语义缓存条目，仅执行一次
+func (s *cacheSemantic) load(ctx context.Context) bool {
+	if s.loaded {
+		return s.vector != nil
+	}
+	s.loaded = true
+	vector, err := s.embedder(ctx, s.text)
+	if err != nil {
+		log.Warnf("model %v semantic cache embedding err: %v", s.cache.modelId, err)
+		return false
+	}
+	entries, err := s.cache.store.HGetAll(ctx, s.key())
+	if err != nil {
+		log.Warnf("model %v semantic cache get entries err: %v", s.cache.modelId, err)
+		return false
+	}
+	s.vector, s.entries = vector, entries
+	return true
+}
+
+// lookup 返回相似度最高且不低于阈值的缓存结果
+func (s *cacheSemantic) lookup(ctx context.Context) string {
+	if s == nil || !s.load(ctx) {
+		return ""
+	}
+	var best string
+	bestScore := s.cache.policy.semanticThreshold()
+	for _, item := range s.entries {
+		var entry semanticEntry
+		if err := json.Unmarshal([]byte(item.V), &entry); err != nil {
+			continue
+		}
+		if score := cosineSimilarity(s.vector, entry.Vector); score >= bestScore {
+			best, bestScore = item.K, score
+		}
+	}
+	if best == "" {
+		return ""
+	}
+	return s.cache.get(ctx, s.cache.key("llm", best))
+}
+
+// add 记录语义缓存条目，分桶条目数达到上限时不再写入，待分桶过期后重新累积
+func (s *cacheSemantic) add(ctx context.Context, hash string) {
+	if s == nil || !s.load(ctx) || len(s.entries) >= s.cache.policy.semanticMaxEntries() {
+		return
+	}
+	b, _ := json.Marshal(&semanticEntry{Vector: s.vector})
+	if err := s.cache.store.HSet(ctx, s.key(), []redis.HashItem{{K: hash, V: string(b)}}); err != nil {
+		log.Warnf("model %v semantic cache set err: %v", s.cache.modelId, err)
+		return
+	}
+	if err := s.cache.store.Expire(ctx, s.key(), s.cache.policy.ttl()); err != nil {
+		log.Warnf("model %v semantic cache expire err: %v", s.cache.modelId, err)
+	}
+}
+
+func cosineSimilarity(a, b []float64) float64 {
+	if len(a) == 0 || len(a) != len(b) {
+		return 0
+	}
+	var dot, normA, normB float64
+	for i := range a {
+		dot += a[i] * b[i]
+		normA += a[i] * a[i]
+		normB += b[i] * b[i]
+	}
+	if normA == 0 || normB == 0 {
+		return 0
+	}
+	return dot / (math.Sqrt(normA) * math.Sqrt(normB))
+}
@@ -0,0 +1,187 @@
+package mp
+
+import (
+	"context"
+	"net/http"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	mp_common "github.com/UnicomAI/wanwu/pkg/model-provider/mp-common"
+	"github.com/UnicomAI/wanwu/pkg/redis"
+	"github.com/UnicomAI/wanwu/pkg/util"
+)
+
+func TestMain(m *testing.M) {
+	if err := util.InitValidator(); err != nil {
+		panic(err)
+	}
+	os.Exit(m.Run())
+}
+
+// memoryStore 内存实现的CacheStore，忽略过期时间
+type memoryStore struct {
+	kvs    map[string]string
+	hashes map[string]map[string]string
+}
+
+func newMemoryStore() *memoryStore {
+	return &memoryStore{kvs: make(map[string]string), hashes: make(map[string]map[string]string)}
+}
+
+func (s *memoryStore) Get(ctx context.Context, key string) (string, error) {
+	return s.kvs[key], nil
+}
+
+func (s *memoryStore) Set(ctx context.Context, key, value string, expire time.Duration) error {
+	s.kvs[key] = value
+	return nil
+}
+
+func (s *memoryStore) Expire(ctx context.Context, key string, expire time.Duration) error {
+	return nil
+}
+
+func (s *memoryStore) HSet(ctx context.Context, key string, items []redis.HashItem) error {
+	if s.hashes[key] == nil {
+		s.hashes[key] = make(map[string]string)
+	}
+	for _, item := range items {
+		s.hashes[key][item.K] = item.V
+	}
+	return nil
+}
+
+func (s *memoryStore) HGetAll(ctx context.Context, key string) ([]redis.HashItem, error) {
+	var ret []redis.HashItem
+	for k, v := range s.hashes[key] {
+		ret = append(ret, redis.HashItem{K: k, V: v})
+	}
+	return ret, nil
+}
+
+func (s *memoryStore) HIncrBy(ctx context.Context, key, field string, incr int64) error {
+	return s.HSet(ctx, key, []redis.HashItem{{K: field, V: util.Int2Str(util.MustI64(s.hashes[key][field]) + incr)}})
+}
+
+// countLLM 返回固定结果并记录调用次数
+type countLLM struct {
+	calls int
+}
+
+func (l *countLLM) Tags() []mp_common.Tag { return nil }
+
+func (l *countLLM) NewReq(req *mp_common.LLMReq) (mp_common.ILLMReq, error) {
+	data, err := req.Data()
+	if err != nil {
+		return nil, err
+	}
+	return mp_common.NewLLMReq(data), nil
+}
+
+func (l *countLLM) ChatCompletions(ctx context.Context, req mp_common.ILLMReq, headers ...mp_common.Header) (mp_common.ILLMResp, <-chan mp_common.ILLMResp, error) {
+	l.calls++
+	return mp_common.NewLLMResp(false, `{"id":"chatcmpl-1","object":"chat.completion","created":1,"model":"qwen","choices":[{"index":0,"message":{"role":"assistant","content":"北京"},"finish_reason":"stop"}],"usage":{"prompt_tokens":5,"completion_tokens":1,"total_tokens":6}}`), nil, nil
+}
+
+func newChatReq(t *testing.T, llm ILLM, question string, stream bool) mp_common.ILLMReq {
+	req, err := llm.NewReq(&mp_common.LLMReq{
+		Model:    "qwen",
+		Messages: []mp_common.OpenAIReqMsg{{Role: mp_common.MsgRoleUser, Content: question}},
+		Stream:   &stream,
+	})
+	if err != nil {
+		t.Fatalf("new req err: %v", err)
+	}
+	return req
+}
+
+func TestCacheLLM(t *testing.T) {
+	store := newMemoryStore()
+	upstream := &countLLM{}
+	var statuses []string
+	newLLM := func(header http.Header) ILLM {
+		cache := NewCache(store, "1", "org:user", &CachePolicy{Enable: true}, ParseCacheControl(header), func(status string) {
+			statuses = append(statuses, status)
+		})
+		return NewCacheLLM(upstream, cache, nil)
+	}
+
+	// 未命中写入缓存，流式请求命中并回放
+	llm := newLLM(http.Header{})
+	if _, _, err := llm.ChatCompletions(context.Background(), newChatReq(t, llm, "中国的首都是？", false)); err != nil {
+		t.Fatalf("chat completions err: %v", err)
+	}
+	_, sseCh, err := llm.ChatCompletions(context.Background(), newChatReq(t, llm, "中国的首都是？", true))
+	if err != nil || sseCh == nil {
+		t.Fatalf("chat completions stream err: %v", err)
+	}
+	var answer string
+	var lines []string
+	for sseResp := range sseCh {
+		lines = append(lines, sseResp.String())
+		if data, ok := sseResp.ConvertResp(); ok && data.Choices[0].Delta != nil {
+			answer += data.Choices[0].Delta.Content
+		}
+	}
+	if answer != "北京" || lines[len(lines)-2] != "data: [DONE]" || upstream.calls != 1 {
+		t.Fatalf("unexpected replay %v, calls %v", strings.Join(lines, "\n"), upstream.calls)
+	}
+
+	// 绕过缓存
+	llm = newLLM(http.Header{CacheBypassHeader: []string{"true"}})
+	if _, _, err := llm.ChatCompletions(context.Background(), newChatReq(t, llm, "中国的首都是？", false)); err != nil || upstream.calls != 2 {
+		t.Fatalf("expect bypass cache, calls %v err %v", upstream.calls, err)
+	}
+
+	// 其他调用方不命中
+	other := NewCacheLLM(upstream, NewCache(store, "1", "org:other", &CachePolicy{Enable: true}, CacheControl{}, nil), nil)
+	if _, _, err := other.ChatCompletions(context.Background(), newChatReq(t, other, "中国的首都是？", false)); err != nil || upstream.calls != 3 {
+		t.Fatalf("expect cache isolated by scope, calls %v err %v", upstream.calls, err)
+	}
+
+	stat, _ := GetCacheStat(context.Background(), store, "1")
+	if strings.Join(statuses, ",") != "MISS,HIT,BYPASS" || stat.Hit != 1 || stat.Miss != 2 {
+		t.Fatalf("unexpected statuses %v stat %+v", statuses, stat)
+	}
+}
+
+func TestCacheLLMSemantic(t *testing.T) {
+	store := newMemoryStore()
+	upstream := &countLLM{}
+	vectors := map[string][]float64{
+		"user: 中国的首都是？":   {1, 0, 0},
+		"user: 中国的首都是哪里？": {0.99, 0.1, 0},
+		"user: 法国的首都是？":   {0, 1, 0},
+	}
+	embedder := func(ctx context.Context, text string) ([]float64, error) {
+		return vectors[text], nil
+	}
+	policy := &CachePolicy{Enable: true, SemanticEnable: true, SemanticEmbeddingModelId: "2", SemanticThreshold: 0.9, SemanticMaxEntries: 1}
+	var status string
+	llm := NewCacheLLM(upstream, NewCache(store, "1", "org:user", policy, CacheControl{}, func(s string) { status = s }), embedder)
+
+	for _, c := range []struct {
+		question string
+		status   string
+		calls    int
+	}{
+		{"中国的首都是？", CacheStatusMiss, 1},
+		{"中国的首都是哪里？", CacheStatusSemanticHit, 1},
+		{"法国的首都是？", CacheStatusMiss, 2},
+	} {
+		if _, _, err := llm.ChatCompletions(context.Background(), newChatReq(t, llm, c.question, false)); err != nil {
+			t.Fatalf("chat completions err: %v", err)
+		}
+		if status != c.status || upstream.calls != c.calls {
+			t.Fatalf("question %v expect status %v calls %v, got %v %v", c.question, c.status, c.calls, status, upstream.calls)
+		}
+	}
+	// 分桶条目数不超过上限
+	for key, entries := range store.hashes {
+		if strings.Contains(key, ":semantic:") && len(entries) != 1 {
+			t.Fatalf("semantic bucket %v entries %v", key, len(entries))
+		}
+	}
+}
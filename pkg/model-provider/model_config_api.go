@@ -5,7 +5,10 @@ import (
 	"encoding/json"
 	"fmt"
 
+	mp_anthropic "github.com/UnicomAI/wanwu/pkg/model-provider/mp-anthropic"
 	mp_common "github.com/UnicomAI/wanwu/pkg/model-provider/mp-common"
+	mp_gemini "github.com/UnicomAI/wanwu/pkg/model-provider/mp-gemini"
+	mp_http "github.com/UnicomAI/wanwu/pkg/model-provider/mp-http"
 	mp_huoshan "github.com/UnicomAI/wanwu/pkg/model-provider/mp-huoshan"
 	mp_infini "github.com/UnicomAI/wanwu/pkg/model-provider/mp-infini"
 	mp_ollama "github.com/UnicomAI/wanwu/pkg/model-provider/mp-ollama"
@@ -78,6 +81,12 @@ func ToModelTags(provider, modelType, cfg string) ([]mp_common.Tag, error) {
 				return nil, fmt.Errorf("unmarshal model config err: %v", err)
 			}
 			tags = embedding.Tags()
+		case ModelTypeOcr:
+			ocr := &mp_openai_compatible.Ocr{}
+			if err := json.Unmarshal([]byte(cfg), ocr); err != nil {
+				return nil, fmt.Errorf("unmarshal model config err: %v", err)
+			}
+			tags = ocr.Tags()
 		default:
 			return nil, fmt.Errorf("ToModelTags:invalid provider %v model type %v", provider, modelType)
 		}
@@ -202,6 +211,45 @@ func ToModelTags(provider, modelType, cfg string) ([]mp_common.Tag, error) {
 		default:
 			return nil, fmt.Errorf("ToModelTags:invalid provider %v model type %v", provider, modelType)
 		}
+	case ProviderAnthropic:
+		switch modelType {
+		case ModelTypeLLM:
+			llm := &mp_anthropic.LLM{}
+			if err := json.Unmarshal([]byte(cfg), llm); err != nil {
+				return nil, fmt.Errorf("unmarshal model config err: %v", err)
+			}
+			tags = llm.Tags()
+		default:
+			return nil, fmt.Errorf("ToModelTags:invalid provider %v model type %v", provider, modelType)
+		}
+	case ProviderGemini:
+		switch modelType {
+		case ModelTypeLLM:
+			llm := &mp_gemini.LLM{}
+			if err := json.Unmarshal([]byte(cfg), llm); err != nil {
+				return nil, fmt.Errorf("unmarshal model config err: %v", err)
+			}
+			tags = llm.Tags()
+		case ModelTypeEmbedding:
+			embedding := &mp_gemini.Embedding{}
+			if err := json.Unmarshal([]byte(cfg), embedding); err != nil {
+				return nil, fmt.Errorf("unmarshal model config err: %v", err)
+			}
+			tags = embedding.Tags()
+		default:
+			return nil, fmt.Errorf("ToModelTags:invalid provider %v model type %v", provider, modelType)
+		}
+	case ProviderHttp:
+		switch modelType {
+		case ModelTypePdfParser:
+			pdfParser := &mp_http.PdfParser{}
+			if err := json.Unmarshal([]byte(cfg), pdfParser); err != nil {
+				return nil, fmt.Errorf("unmarshal model config err: %v", err)
+			}
+			tags = pdfParser.Tags()
+		default:
+			return nil, fmt.Errorf("ToModelTags:invalid provider %v model type %v", provider, modelType)
+		}
 	default:
 		return nil, fmt.Errorf("ToModelTags:invalid provider: %v", provider)
 	}
@@ -223,6 +271,8 @@ func ToModelConfig(provider, modelType, cfg string) (interface{}, error) {
 			ret = &mp_openai_compatible.Rerank{}
 		case ModelTypeEmbedding:
 			ret = &mp_openai_compatible.Embedding{}
+		case ModelTypeOcr:
+			ret = &mp_openai_compatible.Ocr{}
 		default:
 			return nil, fmt.Errorf("ToModelConfig:invalid provider %v model type %v", provider, modelType)
 		}
@@ -283,6 +333,29 @@ func ToModelConfig(provider, modelType, cfg string) (interface{}, error) {
 		default:
 			return nil, fmt.Errorf("ToModelConfig:invalid provider %v model type %v", provider, modelType)
 		}
+	case ProviderAnthropic:
+		switch modelType {
+		case ModelTypeLLM:
+			ret = &mp_anthropic.LLM{}
+		default:
+			return nil, fmt.Errorf("ToModelConfig:invalid provider %v model type %v", provider, modelType)
+		}
+	case ProviderGemini:
+		switch modelType {
+		case ModelTypeLLM:
+			ret = &mp_gemini.LLM{}
+		case ModelTypeEmbedding:
+			ret = &mp_gemini.Embedding{}
+		default:
+			return nil, fmt.Errorf("ToModelConfig:invalid provider %v model type %v", provider, modelType)
+		}
+	case ProviderHttp:
+		switch modelType {
+		case ModelTypePdfParser:
+			ret = &mp_http.PdfParser{}
+		default:
+			return nil, fmt.Errorf("ToModelConfig:invalid provider %v model type %v", provider, modelType)
+		}
 	default:
 		return nil, fmt.Errorf("ToModelConfig:invalid provider: %v", modelType)
 	}
@@ -300,12 +373,16 @@ type ProviderModelConfig struct {
 	ProviderQwen             ProviderModelByQwen             `json:"providerQwen"`
 	ProviderOllama           ProviderModelByOllama           `json:"providerOllama"`
 	ProviderInfini           ProviderModelByInfini           `json:"providerModelByInfini"`
+	ProviderAnthropic        ProviderModelByAnthropic        `json:"providerAnthropic"`
+	ProviderGemini           ProviderModelByGemini           `json:"providerGemini"`
+	ProviderHttp             ProviderModelByHttp             `json:"providerHttp"`
 }
 
 type ProviderModelByOpenAICompatible struct {
 	Llm       mp_openai_compatible.LLM       `json:"llm"`
 	Rerank    mp_openai_compatible.Rerank    `json:"rerank"`
 	Embedding mp_openai_compatible.Embedding `json:"embedding"`
+	Ocr       mp_openai_compatible.Ocr       `json:"ocr"`
 }
 
 type ProviderModelByYuanjing struct {
@@ -338,3 +415,16 @@ type ProviderModelByInfini struct {
 	Rerank    mp_infini.Rerank    `json:"rerank"`
 	Embedding mp_infini.Embedding `json:"embedding"`
 }
+
+type ProviderModelByAnthropic struct {
+	Llm mp_anthropic.LLM `json:"llm"`
+}
+
+type ProviderModelByGemini struct {
+	Llm       mp_gemini.LLM       `json:"llm"`
+	Embedding mp_gemini.Embedding `json:"embedding"`
+}
+
+type ProviderModelByHttp struct {
+	PdfParser mp_http.PdfParser `json:"pdf-parser"`
+}
@@ -1,10 +1,13 @@
 package mp
 
 import (
+	"encoding/base64"
 	"encoding/json"
 	"fmt"
 	"net/url"
 
+	mp_anthropic "github.com/UnicomAI/wanwu/pkg/model-provider/mp-anthropic"
+	mp_gemini "github.com/UnicomAI/wanwu/pkg/model-provider/mp-gemini"
 	mp_huoshan "github.com/UnicomAI/wanwu/pkg/model-provider/mp-huoshan"
 	mp_infini "github.com/UnicomAI/wanwu/pkg/model-provider/mp-infini"
 	mp_ollama "github.com/UnicomAI/wanwu/pkg/model-provider/mp-ollama"
@@ -29,6 +32,49 @@ func ToModelEndpoint(modelId, model string) map[string]interface{} {
 	return ret
 }
 
+// ModelCaller 模型调用方，经callback调用模型时用于用量统计与配额校验
+type ModelCaller struct {
+	OrgId   string `json:"orgId"`
+	UserId  string `json:"userId"`
+	AppId   string `json:"appId"`
+	AppType string `json:"appType"`
+}
+
+// Headers 调用方信息header（与bff的X-Org-Id、X-User-Id、X-App-Id、X-App-Type一致），供自行拼接callback地址的下游透传
+func (c *ModelCaller) Headers() map[string]string {
+	return map[string]string{
+		"X-Org-Id":   c.OrgId,
+		"X-User-Id":  c.UserId,
+		"X-App-Id":   c.AppId,
+		"X-App-Type": c.AppType,
+	}
+}
+
+// ToCallerModelEndpoint 同ToModelEndpoint，model_url中编码调用方信息；
+// 下游（如智能体）将model_url作为OpenAI base_url直接调用、无法透传header时使用
+func ToCallerModelEndpoint(modelId, model string, caller *ModelCaller) map[string]interface{} {
+	ret := ToModelEndpoint(modelId, model)
+	if len(ret) > 0 && caller != nil {
+		b, _ := json.Marshal(caller)
+		modelUrl, _ := url.JoinPath(_callbackUrl, "/callback/v1/caller", base64.RawURLEncoding.EncodeToString(b), "model", modelId)
+		ret["model_url"] = modelUrl
+	}
+	return ret
+}
+
+// ParseModelCaller 解析ToCallerModelEndpoint编码在model_url中的调用方信息
+func ParseModelCaller(caller string) (*ModelCaller, error) {
+	b, err := base64.RawURLEncoding.DecodeString(caller)
+	if err != nil {
+		return nil, fmt.Errorf("invalid model caller: %v", err)
+	}
+	ret := &ModelCaller{}
+	if err := json.Unmarshal(b, ret); err != nil {
+		return nil, fmt.Errorf("invalid model caller: %v", err)
+	}
+	return ret, nil
+}
+
 // ToModelParams 返回ILLMParams、IEmbeddingParams或IRerankParams，与对应实际传给模型的参数
 func ToModelParams(provider, modelType, cfg string) (interface{}, map[string]interface{}, error) {
 	params := make(map[string]interface{})
@@ -119,6 +165,29 @@ func ToModelParams(provider, modelType, cfg string) (interface{}, map[string]int
 		default:
 			return nil, nil, fmt.Errorf("invalid model type: %v", modelType)
 		}
+	case ProviderAnthropic:
+		switch modelType {
+		case ModelTypeLLM:
+			llm := &mp_anthropic.LLMParams{}
+			if err = json.Unmarshal([]byte(cfg), llm); err == nil {
+				ret = llm
+				params = llm.GetParams()
+			}
+		default:
+			return nil, nil, fmt.Errorf("invalid model type: %v", modelType)
+		}
+	case ProviderGemini:
+		switch modelType {
+		case ModelTypeLLM:
+			llm := &mp_gemini.LLMParams{}
+			if err = json.Unmarshal([]byte(cfg), llm); err == nil {
+				ret = llm
+				params = llm.GetParams()
+			}
+		case ModelTypeEmbedding:
+		default:
+			return nil, nil, fmt.Errorf("invalid model type: %v", modelType)
+		}
 	default:
 		return nil, nil, fmt.Errorf("invalid provider: %v", modelType)
 	}
@@ -135,6 +204,8 @@ type AppModelParams struct {
 	ProviderQwen             AppModelParamsQwen             `json:"providerQwen"`
 	ProviderOllama           AppModelParamsOllama           `json:"providerOllama"`
 	ProviderInfini           AppModelParamsInfini           `json:"providerModelByInfini"`
+	ProviderAnthropic        AppModelParamsAnthropic        `json:"providerAnthropic"`
+	ProviderGemini           AppModelParamsGemini           `json:"providerGemini"`
 }
 
 type AppModelParamsOpenAICompatible struct {
@@ -160,3 +231,11 @@ type AppModelParamsOllama struct {
 type AppModelParamsInfini struct {
 	LLM mp_infini.LLMParams `json:"llm"` // 大语言模型配置
 }
+
+type AppModelParamsAnthropic struct {
+	LLM mp_anthropic.LLMParams `json:"llm"` // 大语言模型配置
+}
+
+type AppModelParamsGemini struct {
+	LLM mp_gemini.LLMParams `json:"llm"` // 大语言模型配置
+}
@@ -0,0 +1,29 @@
+package mp
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestToCallerModelEndpoint(t *testing.T) {
+	callbackUrl := _callbackUrl
+	_callbackUrl = "http://bff-service:6668"
+	defer func() { _callbackUrl = callbackUrl }()
+
+	caller := &ModelCaller{OrgId: "org", UserId: "user", AppId: "app", AppType: "agent"}
+	modelUrl, _ := ToCallerModelEndpoint("1", "qwen", caller)["model_url"].(string)
+	prefix, suffix, ok := strings.Cut(modelUrl, "/callback/v1/caller/")
+	if !ok || !strings.HasSuffix(suffix, "/model/1") || prefix != _callbackUrl {
+		t.Fatalf("model url %v", modelUrl)
+	}
+	ret, err := ParseModelCaller(strings.TrimSuffix(suffix, "/model/1"))
+	if err != nil || *ret != *caller {
+		t.Fatalf("parse caller %+v err %v", ret, err)
+	}
+	if _, err := ParseModelCaller("not-base64!"); err == nil {
+		t.Fatalf("expect err")
+	}
+	if len(ToCallerModelEndpoint("", "qwen", caller)) != 0 {
+		t.Fatalf("expect empty endpoint")
+	}
+}
@@ -0,0 +1,217 @@
+package mp
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/UnicomAI/wanwu/pkg/log"
+	mp_common "github.com/UnicomAI/wanwu/pkg/model-provider/mp-common"
+	"github.com/UnicomAI/wanwu/pkg/util"
+)
+
+var defaultRetryableStatusCodes = []int{408, 429, 500, 502, 503, 504}
+
+// RoutingPolicy 模型路由策略：当前模型失败时按策略重试，重试耗尽后依次回退到备选模型
+type RoutingPolicy struct {
+	FallbackModelIds     []string `json:"fallbackModelIds"`     // 备选模型ID，按顺序回退
+	MaxRetries           int      `json:"maxRetries"`           // 单个模型的最大重试次数（不含首次请求）
+	BackoffMs            int      `json:"backoffMs"`            // 重试退避基准时长（毫秒），每次重试翻倍
+	TimeoutSeconds       int      `json:"timeoutSeconds"`       // 单次请求超时（秒），流式请求为首包超时；0表示不超时
+	RetryableStatusCodes []int    `json:"retryableStatusCodes"` // 可重试的http状态码，为空时使用默认值[408,429,500,502,503,504]
+}
+
+func (p *RoutingPolicy) Check() error {
+	if p.MaxRetries < 0 || p.MaxRetries > 10 {
+		return fmt.Errorf("routing policy maxRetries should be in [0, 10]")
+	}
+	if p.BackoffMs < 0 {
+		return fmt.Errorf("routing policy backoffMs should not be negative")
+	}
+	if p.TimeoutSeconds < 0 {
+		return fmt.Errorf("routing policy timeoutSeconds should not be negative")
+	}
+	for _, code := range p.RetryableStatusCodes {
+		if code < 100 || code > 599 {
+			return fmt.Errorf("routing policy retryableStatusCodes invalid http status %v", code)
+		}
+	}
+	return nil
+}
+
+// ToRoutingPolicy 解析模型路由策略，cfg为空时返回nil
+func ToRoutingPolicy(cfg string) (*RoutingPolicy, error) {
+	if cfg == "" {
+		return nil, nil
+	}
+	ret := &RoutingPolicy{}
+	if err := json.Unmarshal([]byte(cfg), ret); err != nil {
+		return nil, fmt.Errorf("unmarshal routing policy err: %v", err)
+	}
+	if err := ret.Check(); err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+func (p *RoutingPolicy) retryable(ctx context.Context, err error) bool {
+	// 调用方取消，不再重试
+	if ctx.Err() != nil {
+		return false
+	}
+	var httpErr *mp_common.HttpError
+	if errors.As(err, &httpErr) {
+		codes := p.RetryableStatusCodes
+		if len(codes) == 0 {
+			codes = defaultRetryableStatusCodes
+		}
+		return slices.Contains(codes, httpErr.StatusCode)
+	}
+	// 连接失败、超时等网络错误
+	return true
+}
+
+func (p *RoutingPolicy) backoff(retry int) time.Duration {
+	return time.Duration(p.BackoffMs) * time.Millisecond << retry
+}
+
+// RoutingTarget 路由目标模型
+type RoutingTarget struct {
+	ModelId string
+	Model   string
+	LLM     ILLM
+}
+
+// NewRoutingLLM 返回按policy对targets依次重试、回退的ILLM；targets[0]为主模型
+func NewRoutingLLM(policy *RoutingPolicy, targets ...RoutingTarget) ILLM {
+	if policy == nil {
+		policy = &RoutingPolicy{}
+	}
+	return &routingLLM{policy: policy, targets: targets}
+}
+
+// routingLLM implementation of ILLM
+type routingLLM struct {
+	policy  *RoutingPolicy
+	targets []RoutingTarget
+}
+
+// routingLLMReq implementation of ILLMReq，按路由目标分别生成请求
+type routingLLMReq struct {
+	mp_common.ILLMReq // 主模型请求
+	reqs              []mp_common.ILLMReq
+}
+
+func (r *routingLLM) Tags() []mp_common.Tag {
+	if len(r.targets) == 0 {
+		return nil
+	}
+	return r.targets[0].LLM.Tags()
+}
+
+func (r *routingLLM) NewReq(req *mp_common.LLMReq) (mp_common.ILLMReq, error) {
+	if len(r.targets) == 0 {
+		return nil, fmt.Errorf("routing llm without target")
+	}
+	ret := &routingLLMReq{}
+	for i, target := range r.targets {
+		targetReq := *req
+		if i > 0 {
+			targetReq.Model = target.Model
+		}
+		llmReq, err := target.LLM.NewReq(&targetReq)
+		if err != nil {
+			// 主模型请求错误直接返回，备选模型请求错误则跳过该模型
+			if i == 0 {
+				return nil, err
+			}
+			log.Warnf("routing llm skip fallback model %v NewReq err: %v", target.ModelId, err)
+			llmReq = nil
+		}
+		ret.reqs = append(ret.reqs, llmReq)
+	}
+	ret.ILLMReq = ret.reqs[0]
+	return ret, nil
+}
+
+func (r *routingLLM) ChatCompletions(ctx context.Context, req mp_common.ILLMReq, headers ...mp_common.Header) (mp_common.ILLMResp, <-chan mp_common.ILLMResp, error) {
+	routingReq, ok := req.(*routingLLMReq)
+	if !ok {
+		return nil, nil, fmt.Errorf("routing llm invalid req type %T", req)
+	}
+	var lastErr error
+	for i, target := range r.targets {
+		if i >= len(routingReq.reqs) || routingReq.reqs[i] == nil {
+			continue
+		}
+		if i > 0 {
+			log.Warnf("routing llm fallback from model %v to model %v, last err: %v", r.targets[i-1].ModelId, target.ModelId, lastErr)
+		}
+		for retry := 0; retry <= r.policy.MaxRetries; retry++ {
+			if retry > 0 {
+				log.Warnf("routing llm model %v retry %v/%v, last err: %v", target.ModelId, retry, r.policy.MaxRetries, lastErr)
+				select {
+				case <-ctx.Done():
+					return nil, nil, ctx.Err()
+				case <-time.After(r.policy.backoff(retry - 1)):
+				}
+			}
+			resp, sseCh, err := r.chatCompletions(ctx, target.LLM, routingReq.reqs[i], headers...)
+			if err == nil {
+				return resp, sseCh, nil
+			}
+			lastErr = err
+			if !r.policy.retryable(ctx, err) {
+				return nil, nil, err
+			}
+		}
+	}
+	if lastErr == nil {
+		lastErr = fmt.Errorf("routing llm without available target")
+	}
+	return nil, nil, lastErr
+}
+
+// chatCompletions 单次请求；超时对非流式为整个请求，对流式为建立连接并收到响应头
+func (r *routingLLM) chatCompletions(ctx context.Context, llm ILLM, req mp_common.ILLMReq, headers ...mp_common.Header) (mp_common.ILLMResp, <-chan mp_common.ILLMResp, error) {
+	if r.policy.TimeoutSeconds <= 0 {
+		return llm.ChatCompletions(ctx, req, headers...)
+	}
+	timeout := time.Duration(r.policy.TimeoutSeconds) * time.Second
+	if !req.Stream() {
+		attemptCtx, cancel := context.WithTimeout(ctx, timeout)
+		defer cancel()
+		return llm.ChatCompletions(attemptCtx, req, headers...)
+	}
+	attemptCtx, cancel := context.WithCancel(ctx)
+	timer := time.AfterFunc(timeout, cancel)
+	_, sseCh, err := llm.ChatCompletions(attemptCtx, req, headers...)
+	if !timer.Stop() && err == nil {
+		// 超时与连接建立同时发生，按超时处理
+		err = context.DeadlineExceeded
+	}
+	if err != nil {
+		cancel()
+		if sseCh != nil {
+			go func() {
+				for range sseCh {
+				}
+			}()
+		}
+		return nil, nil, err
+	}
+	// 流结束后释放attemptCtx
+	ret := make(chan mp_common.ILLMResp, 1024)
+	go func() {
+		defer util.PrintPanicStack()
+		defer close(ret)
+		defer cancel()
+		for sseResp := range sseCh {
+			ret <- sseResp
+		}
+	}()
+	return nil, ret, nil
+}
@@ -0,0 +1,210 @@
+package mp
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"slices"
+	"sync"
+	"testing"
+	"time"
+
+	mp_common "github.com/UnicomAI/wanwu/pkg/model-provider/mp-common"
+)
+
+// routingCall 路由测试中各模型的调用记录
+type routingCall struct {
+	mu     sync.Mutex
+	models []string
+}
+
+func (c *routingCall) add(model string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.models = append(c.models, model)
+}
+
+func (c *routingCall) list() []string {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return slices.Clone(c.models)
+}
+
+// routingLLMFake 按顺序返回errs中的错误，用尽后成功；chunks不为空时以流式返回chunks，streamErr不为nil时在chunks之后返回
+type routingLLMFake struct {
+	name      string
+	calls     *routingCall
+	errs      []error
+	chunks    []string
+	streamErr *mp_common.StreamError
+}
+
+func (l *routingLLMFake) Tags() []mp_common.Tag { return nil }
+
+func (l *routingLLMFake) NewReq(req *mp_common.LLMReq) (mp_common.ILLMReq, error) {
+	data, err := req.Data()
+	if err != nil {
+		return nil, err
+	}
+	return mp_common.NewLLMReq(data), nil
+}
+
+func (l *routingLLMFake) ChatCompletions(ctx context.Context, req mp_common.ILLMReq, headers ...mp_common.Header) (mp_common.ILLMResp, <-chan mp_common.ILLMResp, error) {
+	l.calls.add(l.name + ":" + req.Data()["model"].(string))
+	if len(l.errs) > 0 {
+		err := l.errs[0]
+		l.errs = l.errs[1:]
+		return nil, nil, err
+	}
+	if !req.Stream() {
+		return mp_common.NewLLMResp(false, `{"id":"chatcmpl-1","object":"chat.completion","created":1,"model":"qwen","choices":[{"index":0,"message":{"role":"assistant","content":"`+l.name+`"},"finish_reason":"stop"}]}`), nil, nil
+	}
+	ch := make(chan mp_common.ILLMResp, len(l.chunks)+1)
+	for _, chunk := range l.chunks {
+		ch <- mp_common.NewLLMResp(true, chunk)
+	}
+	if l.streamErr != nil {
+		ch <- l.streamErr
+	}
+	close(ch)
+	return nil, ch, nil
+}
+
+func routingHttpErr(statusCode int) error {
+	return &mp_common.HttpError{Provider: "fake", StatusCode: statusCode, Body: http.StatusText(statusCode)}
+}
+
+func newRoutingTestLLM(policy *RoutingPolicy, llms ...*routingLLMFake) ILLM {
+	var targets []RoutingTarget
+	for _, llm := range llms {
+		targets = append(targets, RoutingTarget{ModelId: llm.name, Model: llm.name + "-model", LLM: llm})
+	}
+	return NewRoutingLLM(policy, targets...)
+}
+
+func TestRoutingLLMRetryable(t *testing.T) {
+	calls := &routingCall{}
+	primary := &routingLLMFake{name: "a", calls: calls, errs: []error{routingHttpErr(http.StatusServiceUnavailable), errors.New("connection reset")}}
+	fallback := &routingLLMFake{name: "b", calls: calls}
+	llm := newRoutingTestLLM(&RoutingPolicy{FallbackModelIds: []string{"b"}, MaxRetries: 2, BackoffMs: 1}, primary, fallback)
+
+	resp, _, err := llm.ChatCompletions(context.Background(), newChatReq(t, llm, "hi", false))
+	if err != nil {
+		t.Fatalf("chat err: %v", err)
+	}
+	if data, ok := resp.ConvertResp(); !ok || data.Choices[0].Message.Content != "a" {
+		t.Fatalf("unexpected resp %v", resp.String())
+	}
+	// 503与网络错误均重试，主模型第三次成功，不回退
+	if got := calls.list(); !slices.Equal(got, []string{"a:qwen", "a:qwen", "a:qwen"}) {
+		t.Fatalf("unexpected calls %v", got)
+	}
+}
+
+func TestRoutingLLMNonRetryable(t *testing.T) {
+	calls := &routingCall{}
+	primary := &routingLLMFake{name: "a", calls: calls, errs: []error{routingHttpErr(http.StatusBadRequest)}}
+	fallback := &routingLLMFake{name: "b", calls: calls}
+	llm := newRoutingTestLLM(&RoutingPolicy{FallbackModelIds: []string{"b"}, MaxRetries: 2, BackoffMs: 1}, primary, fallback)
+
+	_, _, err := llm.ChatCompletions(context.Background(), newChatReq(t, llm, "hi", false))
+	var httpErr *mp_common.HttpError
+	if !errors.As(err, &httpErr) || httpErr.StatusCode != http.StatusBadRequest {
+		t.Fatalf("expected 400 err, got %v", err)
+	}
+	// 不可重试的错误直接返回，既不重试也不回退
+	if got := calls.list(); !slices.Equal(got, []string{"a:qwen"}) {
+		t.Fatalf("unexpected calls %v", got)
+	}
+}
+
+func TestRoutingLLMFallbackOrder(t *testing.T) {
+	calls := &routingCall{}
+	unavailable := func() []error {
+		return []error{routingHttpErr(http.StatusTooManyRequests), routingHttpErr(http.StatusBadGateway)}
+	}
+	a := &routingLLMFake{name: "a", calls: calls, errs: unavailable()}
+	b := &routingLLMFake{name: "b", calls: calls, errs: unavailable()}
+	c := &routingLLMFake{name: "c", calls: calls}
+	llm := newRoutingTestLLM(&RoutingPolicy{FallbackModelIds: []string{"b", "c"}, MaxRetries: 1, BackoffMs: 1}, a, b, c)
+
+	resp, _, err := llm.ChatCompletions(context.Background(), newChatReq(t, llm, "hi", false))
+	if err != nil {
+		t.Fatalf("chat err: %v", err)
+	}
+	if data, ok := resp.ConvertResp(); !ok || data.Choices[0].Message.Content != "c" {
+		t.Fatalf("unexpected resp %v", resp.String())
+	}
+	// 每个模型重试耗尽后按顺序回退，备选模型使用各自的model
+	want := []string{"a:qwen", "a:qwen", "b:b-model", "b:b-model", "c:c-model"}
+	if got := calls.list(); !slices.Equal(got, want) {
+		t.Fatalf("unexpected calls %v, want %v", got, want)
+	}
+}
+
+func TestRoutingLLMFallbackExhausted(t *testing.T) {
+	calls := &routingCall{}
+	a := &routingLLMFake{name: "a", calls: calls, errs: []error{routingHttpErr(http.StatusServiceUnavailable)}}
+	b := &routingLLMFake{name: "b", calls: calls, errs: []error{routingHttpErr(http.StatusGatewayTimeout)}}
+	llm := newRoutingTestLLM(&RoutingPolicy{FallbackModelIds: []string{"b"}, BackoffMs: 1}, a, b)
+
+	_, _, err := llm.ChatCompletions(context.Background(), newChatReq(t, llm, "hi", false))
+	var httpErr *mp_common.HttpError
+	if !errors.As(err, &httpErr) || httpErr.StatusCode != http.StatusGatewayTimeout {
+		t.Fatalf("expected last model err, got %v", err)
+	}
+}
+
+func TestRoutingLLMStreamErrorAfterFirstChunk(t *testing.T) {
+	calls := &routingCall{}
+	primary := &routingLLMFake{
+		name:      "a",
+		calls:     calls,
+		chunks:    []string{`data: {"id":"chatcmpl-1","object":"chat.completion.chunk","created":1,"model":"qwen","choices":[{"index":0,"delta":{"content":"部分"}}]}`},
+		streamErr: &mp_common.StreamError{Type: mp_common.StreamErrorTypeInterrupted, Provider: "fake", Body: "connection reset", Partial: true},
+	}
+	fallback := &routingLLMFake{name: "b", calls: calls}
+	llm := newRoutingTestLLM(&RoutingPolicy{FallbackModelIds: []string{"b"}, MaxRetries: 2, BackoffMs: 1, TimeoutSeconds: 10}, primary, fallback)
+
+	_, sseCh, err := llm.ChatCompletions(context.Background(), newChatReq(t, llm, "hi", true))
+	if err != nil {
+		t.Fatalf("chat err: %v", err)
+	}
+	var chunks int
+	var streamErr *mp_common.StreamError
+	for resp := range sseCh {
+		if e, ok := mp_common.ToStreamError(resp); ok {
+			streamErr = e
+			continue
+		}
+		chunks++
+	}
+	if chunks != 1 || streamErr == nil || !streamErr.Partial {
+		t.Fatalf("expected 1 chunk then partial stream err, got %v chunks, err %v", chunks, streamErr)
+	}
+	// 已输出内容后的流式错误透传给调用方，不重试也不回退
+	if got := calls.list(); !slices.Equal(got, []string{"a:qwen"}) {
+		t.Fatalf("unexpected calls %v", got)
+	}
+}
+
+func TestRoutingLLMCancelDuringBackoff(t *testing.T) {
+	calls := &routingCall{}
+	primary := &routingLLMFake{name: "a", calls: calls, errs: []error{routingHttpErr(http.StatusServiceUnavailable)}}
+	fallback := &routingLLMFake{name: "b", calls: calls}
+	llm := newRoutingTestLLM(&RoutingPolicy{FallbackModelIds: []string{"b"}, MaxRetries: 1, BackoffMs: 60_000}, primary, fallback)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	time.AfterFunc(50*time.Millisecond, cancel)
+	start := time.Now()
+	_, _, err := llm.ChatCompletions(ctx, newChatReq(t, llm, "hi", false))
+	if !errors.Is(err, context.Canceled) {
+		t.Fatalf("expected context canceled, got %v", err)
+	}
+	if elapsed := time.Since(start); elapsed > 5*time.Second {
+		t.Fatalf("backoff not interrupted by cancel, elapsed %v", elapsed)
+	}
+	if got := calls.list(); !slices.Equal(got, []string{"a:qwen"}) {
+		t.Fatalf("unexpected calls %v", got)
+	}
+}
@@ -0,0 +1,218 @@
+package mp
+
+import (
+	"context"
+	"time"
+
+	mp_common "github.com/UnicomAI/wanwu/pkg/model-provider/mp-common"
+	"github.com/UnicomAI/wanwu/pkg/util"
+	"github.com/gin-gonic/gin"
+)
+
+const (
+	UsageStatusSuccess = "success"
+	UsageStatusFailed  = "failed"
+)
+
+// Usage 单次模型调用的用量
+type Usage struct {
+	PromptTokens     int
+	CompletionTokens int
+	TotalTokens      int
+	Latency          time.Duration // 调用耗时，流式为整个流的耗时
+	Status           string        // UsageStatusSuccess 或 UsageStatusFailed
+	ErrMsg           string
+}
+
+// UsageRecorder 模型调用结束后的用量回调；流式调用在流结束后回调
+type UsageRecorder func(usage *Usage)
+
+func newUsage(start time.Time, u mp_common.Usage, err error) *Usage {
+	ret := &Usage{
+		PromptTokens:     u.PromptTokens,
+		CompletionTokens: u.CompletionTokens,
+		TotalTokens:      u.TotalTokens,
+		Latency:          time.Since(start),
+		Status:           UsageStatusSuccess,
+	}
+	if ret.TotalTokens == 0 {
+		ret.TotalTokens = ret.PromptTokens + ret.CompletionTokens
+	}
+	if err != nil {
+		ret.Status = UsageStatusFailed
+		ret.ErrMsg = err.Error()
+	}
+	return ret
+}
+
+// --- llm ---
+
+// NewUsageLLM 返回调用结束后通过recorder记录用量的ILLM
+func NewUsageLLM(llm ILLM, recorder UsageRecorder) ILLM {
+	return &usageLLM{ILLM: llm, recorder: recorder}
+}
+
+type usageLLM struct {
+	ILLM
+	recorder UsageRecorder
+}
+
+func (u *usageLLM) ChatCompletions(ctx context.Context, req mp_common.ILLMReq, headers ...mp_common.Header) (mp_common.ILLMResp, <-chan mp_common.ILLMResp, error) {
+	start := time.Now()
+	resp, sseCh, err := u.ILLM.ChatCompletions(ctx, req, headers...)
+	if err != nil {
+		u.recorder(newUsage(start, mp_common.Usage{}, err))
+		return nil, nil, err
+	}
+	// unary
+	if sseCh == nil {
+		var usage mp_common.Usage
+		if data, ok := resp.ConvertResp(); ok {
+			usage = toUsage(data.Usage)
+		}
+		u.recorder(newUsage(start, usage, nil))
+		return resp, nil, nil
+	}
+	// stream，usage通常在最后一个数据包中返回
+	ret := make(chan mp_common.ILLMResp, 1024)
+	go func() {
+		defer util.PrintPanicStack()
+		defer close(ret)
+		var usage mp_common.Usage
+		var streamErr error
+		for sseResp := range sseCh {
+			if e, ok := mp_common.ToStreamError(sseResp); ok {
+				streamErr = e
+			} else if data, ok := sseResp.ConvertResp(); ok && data.Usage.TotalTokens > 0 {
+				usage = toUsage(data.Usage)
+			}
+			ret <- sseResp
+		}
+		if streamErr == nil && ctx.Err() != nil {
+			streamErr = ctx.Err()
+		}
+		u.recorder(newUsage(start, usage, streamErr))
+	}()
+	return nil, ret, nil
+}
+
+func toUsage(u mp_common.OpenAIRespUsage) mp_common.Usage {
+	return mp_common.Usage{
+		PromptTokens:     u.PromptTokens,
+		CompletionTokens: u.CompletionTokens,
+		TotalTokens:      u.TotalTokens,
+	}
+}
+
+// --- embedding ---
+
+// NewUsageEmbedding 返回调用结束后通过recorder记录用量的IEmbedding
+func NewUsageEmbedding(embedding IEmbedding, recorder UsageRecorder) IEmbedding {
+	return &usageEmbedding{IEmbedding: embedding, recorder: recorder}
+}
+
+type usageEmbedding struct {
+	IEmbedding
+	recorder UsageRecorder
+}
+
+func (u *usageEmbedding) Embeddings(ctx context.Context, req mp_common.IEmbeddingReq, headers ...mp_common.Header) (mp_common.IEmbeddingResp, error) {
+	start := time.Now()
+	resp, err := u.IEmbedding.Embeddings(ctx, req, headers...)
+	var usage mp_common.Usage
+	if err == nil {
+		if data, ok := resp.ConvertResp(); ok {
+			usage = data.Usage
+		}
+	}
+	u.recorder(newUsage(start, usage, err))
+	return resp, err
+}
+
+// --- rerank ---
+
+// NewUsageRerank 返回调用结束后通过recorder记录用量的IRerank
+func NewUsageRerank(rerank IRerank, recorder UsageRecorder) IRerank {
+	return &usageRerank{IRerank: rerank, recorder: recorder}
+}
+
+type usageRerank struct {
+	IRerank
+	recorder UsageRecorder
+}
+
+func (u *usageRerank) Rerank(ctx context.Context, req mp_common.IRerankReq, headers ...mp_common.Header) (mp_common.IRerankResp, error) {
+	start := time.Now()
+	resp, err := u.IRerank.Rerank(ctx, req, headers...)
+	var usage mp_common.Usage
+	if err == nil {
+		if data, ok := resp.ConvertResp(); ok {
+			usage = data.Usage
+		}
+	}
+	u.recorder(newUsage(start, usage, err))
+	return resp, err
+}
+
+// --- gui ---
+
+// NewUsageGui 返回调用结束后通过recorder记录用量的IGui
+func NewUsageGui(gui IGui, recorder UsageRecorder) IGui {
+	return &usageGui{IGui: gui, recorder: recorder}
+}
+
+type usageGui struct {
+	IGui
+	recorder UsageRecorder
+}
+
+func (u *usageGui) Gui(ctx context.Context, req mp_common.IGuiReq, headers ...mp_common.Header) (mp_common.IGuiResp, error) {
+	start := time.Now()
+	resp, err := u.IGui.Gui(ctx, req, headers...)
+	var usage mp_common.Usage
+	if err == nil {
+		if data, ok := resp.ConvertResp(); ok {
+			usage = data.Usage
+		}
+	}
+	u.recorder(newUsage(start, usage, err))
+	return resp, err
+}
+
+// --- ocr ---
+
+// NewUsageOcr 返回调用结束后通过recorder记录调用次数与耗时的IOcr（无token用量）
+func NewUsageOcr(ocr IOcr, recorder UsageRecorder) IOcr {
+	return &usageOcr{IOcr: ocr, recorder: recorder}
+}
+
+type usageOcr struct {
+	IOcr
+	recorder UsageRecorder
+}
+
+func (u *usageOcr) Ocr(ctx *gin.Context, req mp_common.IOcrReq, headers ...mp_common.Header) (mp_common.IOcrResp, error) {
+	start := time.Now()
+	resp, err := u.IOcr.Ocr(ctx, req, headers...)
+	u.recorder(newUsage(start, mp_common.Usage{}, err))
+	return resp, err
+}
+
+// --- pdf parser ---
+
+// NewUsagePdfParser 返回调用结束后通过recorder记录调用次数与耗时的IPdfParser（无token用量）
+func NewUsagePdfParser(pdfParser IPdfParser, recorder UsageRecorder) IPdfParser {
+	return &usagePdfParser{IPdfParser: pdfParser, recorder: recorder}
+}
+
+type usagePdfParser struct {
+	IPdfParser
+	recorder UsageRecorder
+}
+
+func (u *usagePdfParser) PdfParser(ctx *gin.Context, req mp_common.IPdfParserReq, headers ...mp_common.Header) (mp_common.IPdfParserResp, error) {
+	start := time.Now()
+	resp, err := u.IPdfParser.PdfParser(ctx, req, headers...)
+	u.recorder(newUsage(start, mp_common.Usage{}, err))
+	return resp, err
+}
@@ -0,0 +1,430 @@
+package mp_anthropic
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/UnicomAI/wanwu/pkg/log"
+	mp_common "github.com/UnicomAI/wanwu/pkg/model-provider/mp-common"
+)
+
+// --- messages api ---
+
+type messagesResp struct {
+	ID         string          `json:"id"`
+	Model      string          `json:"model"`
+	Content    []contentBlock  `json:"content"`
+	StopReason string          `json:"stop_reason"`
+	Usage      *messagesUsage  `json:"usage"`
+	Error      json.RawMessage `json:"error"`
+}
+
+type contentBlock struct {
+	Type     string          `json:"type"` // "text" | "thinking" | "redacted_thinking" | "tool_use"
+	Text     string          `json:"text"`
+	Thinking string          `json:"thinking"`
+	ID       string          `json:"id"`
+	Name     string          `json:"name"`
+	Input    json.RawMessage `json:"input"`
+}
+
+type messagesUsage struct {
+	InputTokens              int `json:"input_tokens"`
+	OutputTokens             int `json:"output_tokens"`
+	CacheCreationInputTokens int `json:"cache_creation_input_tokens"`
+	CacheReadInputTokens     int `json:"cache_read_input_tokens"`
+}
+
+func (u *messagesUsage) promptTokens() int {
+	if u == nil {
+		return 0
+	}
+	return u.InputTokens + u.CacheCreationInputTokens + u.CacheReadInputTokens
+}
+
+type streamEvent struct {
+	Type         string         `json:"type"` // "message_start" | "content_block_start" | "content_block_delta" | "content_block_stop" | "message_delta" | "message_stop" | "ping"
+	Index        int            `json:"index"`
+	Message      *messagesResp  `json:"message"`
+	ContentBlock *contentBlock  `json:"content_block"`
+	Delta        *streamDelta   `json:"delta"`
+	Usage        *messagesUsage `json:"usage"`
+}
+
+type streamDelta struct {
+	Type        string `json:"type"` // "text_delta" | "thinking_delta" | "signature_delta" | "input_json_delta"
+	Text        string `json:"text"`
+	Thinking    string `json:"thinking"`
+	PartialJson string `json:"partial_json"`
+	StopReason  string `json:"stop_reason"`
+}
+
+// --- request ---
+
+// toMessagesReq 将OpenAI格式的请求转换为Messages API请求
+func toMessagesReq(req *mp_common.LLMReq, cfgMaxTokens *int) (map[string]interface{}, error) {
+	var system []string
+	var messages []map[string]interface{}
+	// Messages API 要求user、assistant交替出现，相邻同角色的消息合并
+	appendBlocks := func(role string, blocks []map[string]interface{}) {
+		if len(blocks) == 0 {
+			return
+		}
+		if n := len(messages); n > 0 && messages[n-1]["role"] == role {
+			messages[n-1]["content"] = append(messages[n-1]["content"].([]map[string]interface{}), blocks...)
+			return
+		}
+		messages = append(messages, map[string]interface{}{"role": role, "content": blocks})
+	}
+	for _, msg := range req.Messages {
+		switch msg.Role {
+		case mp_common.MsgRoleSystem:
+			if text := msg.Text(); text != "" {
+				system = append(system, text)
+			}
+		case mp_common.MsgRoleUser:
+			appendBlocks("user", toContentBlocks(msg.Parts()))
+		case mp_common.MsgRoleAssistant:
+			// 历史思维链缺少签名，不回传
+			blocks := toContentBlocks(msg.Parts())
+			for _, toolCall := range msg.ToolCalls {
+				input := make(map[string]interface{})
+				if toolCall.Function.Arguments != "" {
+					if err := json.Unmarshal([]byte(toolCall.Function.Arguments), &input); err != nil {
+						return nil, fmt.Errorf("tool call %v arguments unmarshal err: %v", toolCall.Function.Name, err)
+					}
+				}
+				blocks = append(blocks, map[string]interface{}{
+					"type":  "tool_use",
+					"id":    toolCall.ID,
+					"name":  toolCall.Function.Name,
+					"input": input,
+				})
+			}
+			appendBlocks("assistant", blocks)
+		case mp_common.MsgRoleFunction:
+			if msg.ToolCallId == nil {
+				return nil, fmt.Errorf("tool message without tool_call_id")
+			}
+			appendBlocks("user", []map[string]interface{}{{
+				"type":        "tool_result",
+				"tool_use_id": *msg.ToolCallId,
+				"content":     msg.Text(),
+			}})
+		default:
+			return nil, fmt.Errorf("unsupported message role: %v", msg.Role)
+		}
+	}
+
+	maxTokens := defaultMaxTokens
+	if req.MaxCompletionTokens != nil {
+		maxTokens = *req.MaxCompletionTokens
+	} else if req.MaxTokens != nil {
+		maxTokens = *req.MaxTokens
+	} else if cfgMaxTokens != nil && *cfgMaxTokens > 0 {
+		maxTokens = *cfgMaxTokens
+	}
+
+	ret := map[string]interface{}{
+		"model":    req.Model,
+		"messages": messages,
+	}
+	if len(system) > 0 {
+		ret["system"] = strings.Join(system, "\n")
+	}
+	if req.Stream != nil && *req.Stream {
+		ret["stream"] = true
+	}
+	if req.Stop != nil && *req.Stop != "" {
+		ret["stop_sequences"] = []string{*req.Stop}
+	}
+	if req.Temperature != nil {
+		ret["temperature"] = *req.Temperature
+	}
+	if req.TopP != nil {
+		ret["top_p"] = *req.TopP
+	}
+	if req.TopK != nil {
+		ret["top_k"] = *req.TopK
+	}
+	if (req.Thinking != nil && req.Thinking.Type == "enabled") || (req.EnableThinking != nil && *req.EnableThinking) {
+		budget := defaultThinkingBudget
+		if req.ThinkingBudget != nil && *req.ThinkingBudget > budget {
+			budget = *req.ThinkingBudget
+		}
+		// budget_tokens 必须小于 max_tokens
+		if maxTokens <= budget {
+			maxTokens = budget + defaultMaxTokens
+		}
+		ret["thinking"] = map[string]interface{}{
+			"type":          "enabled",
+			"budget_tokens": budget,
+		}
+		// 开启思考时不支持调整temperature、top_k
+		delete(ret, "temperature")
+		delete(ret, "top_k")
+	}
+	ret["max_tokens"] = maxTokens
+
+	if len(req.Tools) > 0 {
+		tools := make([]map[string]interface{}, 0, len(req.Tools))
+		for _, tool := range req.Tools {
+			if tool.Function == nil {
+				continue
+			}
+			tools = append(tools, map[string]interface{}{
+				"name":         tool.Function.Name,
+				"description":  tool.Function.Description,
+				"input_schema": toInputSchema(tool.Function.Parameters),
+			})
+		}
+		ret["tools"] = tools
+		if toolChoice := toToolChoice(req.ToolChoice, req.ParallelToolCalls); toolChoice != nil {
+			ret["tool_choice"] = toolChoice
+		}
+	}
+	return ret, nil
+}
+
+func toContentBlocks(parts []mp_common.OpenAIReqMsgPart) []map[string]interface{} {
+	var ret []map[string]interface{}
+	for _, part := range parts {
+		switch part.Type {
+		case "text":
+			// Messages API 不接受空文本块
+			if part.Text == "" {
+				continue
+			}
+			ret = append(ret, map[string]interface{}{"type": "text", "text": part.Text})
+		case "image_url":
+			source := map[string]interface{}{"type": "url", "url": part.ImageUrl}
+			if mimeType, data, ok := mp_common.ParseDataUrl(part.ImageUrl); ok {
+				source = map[string]interface{}{"type": "base64", "media_type": mimeType, "data": data}
+			}
+			ret = append(ret, map[string]interface{}{"type": "image", "source": source})
+		}
+	}
+	return ret
+}
+
+func toInputSchema(params *mp_common.OpenAIFunctionParameters) map[string]interface{} {
+	ret := map[string]interface{}{
+		"type":       "object",
+		"properties": map[string]interface{}{},
+	}
+	if params == nil {
+		return ret
+	}
+	if len(params.Properties) > 0 {
+		ret["properties"] = params.Properties
+	}
+	if len(params.Required) > 0 {
+		ret["required"] = params.Required
+	}
+	return ret
+}
+
+// toToolChoice 转换tool_choice："auto" | "none" | "required" | {"type":"function","function":{"name":"xx"}}
+func toToolChoice(toolChoice interface{}, parallelToolCalls *bool) map[string]interface{} {
+	ret := map[string]interface{}{"type": "auto"}
+	switch v := toolChoice.(type) {
+	case string:
+		switch v {
+		case "none":
+			ret["type"] = "none"
+		case "required":
+			ret["type"] = "any"
+		}
+	case map[string]interface{}:
+		if function, ok := v["function"].(map[string]interface{}); ok {
+			if name, _ := function["name"].(string); name != "" {
+				ret = map[string]interface{}{"type": "tool", "name": name}
+			}
+		}
+	}
+	if parallelToolCalls != nil && !*parallelToolCalls && ret["type"] != "none" {
+		ret["disable_parallel_tool_use"] = true
+	}
+	return ret
+}
+
+// --- response ---
+
+// toLLMResp 将Messages API的非流式响应转换为OpenAI格式
+func toLLMResp(stream bool, raw string) mp_common.ILLMResp {
+	resp := &messagesResp{}
+	if err := json.Unmarshal([]byte(raw), resp); err != nil || len(resp.Error) > 0 {
+		log.Errorf("anthropic resp (%v) convert to openai resp err: %v", raw, err)
+		return mp_common.NewLLMResp(stream, raw)
+	}
+	msg := &mp_common.OpenAIMsg{Role: mp_common.MsgRoleAssistant}
+	var reasoning string
+	for _, block := range resp.Content {
+		switch block.Type {
+		case "text":
+			msg.Content += block.Text
+		case "thinking":
+			reasoning += block.Thinking
+		case "tool_use":
+			msg.ToolCalls = append(msg.ToolCalls, &mp_common.ToolCall{
+				ID:   block.ID,
+				Type: mp_common.ToolTypeFunction,
+				Function: mp_common.FunctionCall{
+					Name:      block.Name,
+					Arguments: string(block.Input),
+				},
+			})
+		}
+	}
+	if reasoning != "" {
+		msg.ReasoningContent = &reasoning
+	}
+	ret := &mp_common.LLMResp{
+		ID:      resp.ID,
+		Object:  "chat.completion",
+		Created: int(time.Now().Unix()),
+		Model:   resp.Model,
+		Choices: []mp_common.OpenAIRespChoice{{
+			Message:      msg,
+			FinishReason: toFinishReason(resp.StopReason),
+		}},
+	}
+	if resp.Usage != nil {
+		ret.Usage = mp_common.OpenAIRespUsage{
+			PromptTokens:     resp.Usage.promptTokens(),
+			CompletionTokens: resp.Usage.OutputTokens,
+			TotalTokens:      resp.Usage.promptTokens() + resp.Usage.OutputTokens,
+		}
+	}
+	b, _ := json.Marshal(ret)
+	return mp_common.NewLLMResp(stream, string(b))
+}
+
+func toFinishReason(stopReason string) string {
+	switch stopReason {
+	case "":
+		return ""
+	case "max_tokens":
+		return "length"
+	case "tool_use":
+		return "tool_calls"
+	case "refusal":
+		return "content_filter"
+	default: // end_turn、stop_sequence、pause_turn
+		return "stop"
+	}
+}
+
+// --- stream ---
+
+// streamConverter 将Messages API的SSE事件转换为OpenAI格式的流式数据包，每个请求一个实例
+type streamConverter struct {
+	id           string
+	model        string
+	created      int
+	promptTokens int
+	toolIndex    map[int]int // content block index -> tool call index
+}
+
+func newStreamConverter() *streamConverter {
+	return &streamConverter{
+		created:   int(time.Now().Unix()),
+		toolIndex: make(map[int]int),
+	}
+}
+
+func (c *streamConverter) convert(line string) []string {
+	// event: 行与空行不输出，事件类型以data中的type为准
+	if !strings.HasPrefix(line, "data:") {
+		return nil
+	}
+	raw := strings.TrimSpace(strings.TrimPrefix(line, "data:"))
+	event := &streamEvent{}
+	if err := json.Unmarshal([]byte(raw), event); err != nil {
+		log.Errorf("anthropic stream event (%v) unmarshal err: %v", raw, err)
+		return nil
+	}
+	switch event.Type {
+	case "message_start":
+		if event.Message != nil {
+			c.id = event.Message.ID
+			c.model = event.Message.Model
+			c.promptTokens = event.Message.Usage.promptTokens()
+		}
+		return []string{c.chunk(&mp_common.OpenAIMsg{}, "", nil)}
+	case "content_block_start":
+		if event.ContentBlock == nil || event.ContentBlock.Type != "tool_use" {
+			return nil
+		}
+		index := len(c.toolIndex)
+		c.toolIndex[event.Index] = index
+		return []string{c.chunk(&mp_common.OpenAIMsg{
+			ToolCalls: []*mp_common.ToolCall{{
+				ID:       event.ContentBlock.ID,
+				Type:     mp_common.ToolTypeFunction,
+				Function: mp_common.FunctionCall{Name: event.ContentBlock.Name},
+				Index:    &index,
+			}},
+		}, "", nil)}
+	case "content_block_delta":
+		if event.Delta == nil {
+			return nil
+		}
+		switch event.Delta.Type {
+		case "text_delta":
+			return []string{c.chunk(&mp_common.OpenAIMsg{Content: event.Delta.Text}, "", nil)}
+		case "thinking_delta":
+			thinking := event.Delta.Thinking
+			return []string{c.chunk(&mp_common.OpenAIMsg{ReasoningContent: &thinking}, "", nil)}
+		case "input_json_delta":
+			index, ok := c.toolIndex[event.Index]
+			if !ok {
+				return nil
+			}
+			return []string{c.chunk(&mp_common.OpenAIMsg{
+				ToolCalls: []*mp_common.ToolCall{{
+					Type:     mp_common.ToolTypeFunction,
+					Function: mp_common.FunctionCall{Arguments: event.Delta.PartialJson},
+					Index:    &index,
+				}},
+			}, "", nil)}
+		}
+	case "message_delta":
+		var stopReason string
+		if event.Delta != nil {
+			stopReason = event.Delta.StopReason
+		}
+		usage := &mp_common.OpenAIRespUsage{PromptTokens: c.promptTokens}
+		if event.Usage != nil {
+			if prompt := event.Usage.promptTokens(); prompt > usage.PromptTokens {
+				usage.PromptTokens = prompt
+			}
+			usage.CompletionTokens = event.Usage.OutputTokens
+		}
+		usage.TotalTokens = usage.PromptTokens + usage.CompletionTokens
+		return []string{c.chunk(&mp_common.OpenAIMsg{}, toFinishReason(stopReason), usage)}
+	case "message_stop":
+		return []string{"data: [DONE]"}
+	}
+	return nil
+}
+
+func (c *streamConverter) chunk(delta *mp_common.OpenAIMsg, finishReason string, usage *mp_common.OpenAIRespUsage) string {
+	delta.Role = mp_common.MsgRoleAssistant
+	ret := &mp_common.LLMResp{
+		ID:      c.id,
+		Object:  "chat.completion.chunk",
+		Created: c.created,
+		Model:   c.model,
+		Choices: []mp_common.OpenAIRespChoice{{
+			Delta:        delta,
+			FinishReason: finishReason,
+		}},
+	}
+	if usage != nil {
+		ret.Usage = *usage
+	}
+	return mp_common.NewLLMStreamData(ret)
+}
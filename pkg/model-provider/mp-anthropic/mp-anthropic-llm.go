@@ -0,0 +1,81 @@
+package mp_anthropic
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+
+	mp_common "github.com/UnicomAI/wanwu/pkg/model-provider/mp-common"
+)
+
+const (
+	anthropicVersion = "2023-06-01"
+
+	defaultMaxTokens      = 4096 // Messages API 必须指定max_tokens
+	defaultThinkingBudget = 1024 // budget_tokens 最小值
+)
+
+type LLM struct {
+	ApiKey          string `json:"apiKey"`                                              // ApiKey
+	EndpointUrl     string `json:"endpointUrl"`                                         // 推理url，例如 https://api.anthropic.com/v1
+	FunctionCalling string `json:"functionCalling" validate:"oneof=noSupport toolCall"` // 函数调用是否支持
+	VisionSupport   string `json:"visionSupport" validate:"oneof=noSupport support"`    // 视觉支持
+	MaxTokens       *int   `json:"maxTokens"`                                           // 模型回答最大tokens
+	ContextSize     *int   `json:"contextSize"`                                         // 上下文长度
+}
+
+func (cfg *LLM) Tags() []mp_common.Tag {
+	tags := []mp_common.Tag{
+		{
+			Text: mp_common.TagChat,
+		},
+	}
+	if cfg.VisionSupport == "support" {
+		tags = append(tags, mp_common.Tag{
+			Text: mp_common.TagVisionSupport,
+		})
+	}
+	tags = append(tags, mp_common.GetTagsByFunctionCall(cfg.FunctionCalling)...)
+	tags = append(tags, mp_common.GetTagsByContentSize(cfg.ContextSize)...)
+	return tags
+}
+
+func (cfg *LLM) NewReq(req *mp_common.LLMReq) (mp_common.ILLMReq, error) {
+	if req.MaxTokens != nil && cfg.ContextSize != nil && *req.MaxTokens > *cfg.ContextSize {
+		return nil, fmt.Errorf("max_tokens too large (max allowed: %d)", *cfg.ContextSize)
+	}
+	m, err := req.Data()
+	if err != nil {
+		return nil, err
+	}
+	return mp_common.NewLLMReq(m), nil
+}
+
+// ChatCompletions 将OpenAI格式的请求转换为Messages API请求，并将响应转换回OpenAI格式
+func (cfg *LLM) ChatCompletions(ctx context.Context, req mp_common.ILLMReq, headers ...mp_common.Header) (mp_common.ILLMResp, <-chan mp_common.ILLMResp, error) {
+	openAIReq, ok := req.OpenAIReq()
+	if !ok {
+		return nil, nil, fmt.Errorf("anthropic chat completions invalid request")
+	}
+	messagesReq, err := toMessagesReq(openAIReq, cfg.MaxTokens)
+	if err != nil {
+		return nil, nil, err
+	}
+	headers = append(headers,
+		mp_common.Header{Key: "x-api-key", Value: cfg.ApiKey},
+		mp_common.Header{Key: "anthropic-version", Value: anthropicVersion},
+	)
+	if !req.Stream() {
+		return mp_common.ChatCompletions(ctx, "anthropic", "", cfg.messagesUrl(), mp_common.NewLLMReq(messagesReq), toLLMResp, headers...)
+	}
+	_, sseCh, err := mp_common.ChatCompletions(ctx, "anthropic", "", cfg.messagesUrl(), mp_common.NewLLMReq(messagesReq), mp_common.NewLLMResp, headers...)
+	if err != nil {
+		return nil, nil, err
+	}
+	return nil, mp_common.ConvertLLMStream(sseCh, newStreamConverter().convert, nil), nil
+}
+
+func (cfg *LLM) messagesUrl() string {
+	ret, _ := url.JoinPath(cfg.EndpointUrl, "/messages")
+	return ret
+}
@@ -0,0 +1,197 @@
+package mp_anthropic
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"testing"
+
+	mp_common "github.com/UnicomAI/wanwu/pkg/model-provider/mp-common"
+	"github.com/UnicomAI/wanwu/pkg/util"
+)
+
+func TestMain(m *testing.M) {
+	if err := util.InitValidator(); err != nil {
+		panic(err)
+	}
+	os.Exit(m.Run())
+}
+
+// newFixtureServer 返回testdata中录制的响应，并记录收到的请求体
+func newFixtureServer(t *testing.T, fixture string, body *map[string]interface{}) *httptest.Server {
+	b, err := os.ReadFile("testdata/" + fixture)
+	if err != nil {
+		t.Fatalf("read fixture err: %v", err)
+	}
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/v1/messages" || r.Header.Get("x-api-key") != "sk-ant" || r.Header.Get("anthropic-version") != anthropicVersion {
+			t.Errorf("unexpected request %v %v", r.URL.Path, r.Header)
+		}
+		if r.Header.Get("Authorization") != "" {
+			t.Errorf("unexpected authorization header")
+		}
+		if err := json.NewDecoder(r.Body).Decode(body); err != nil {
+			t.Errorf("decode request body err: %v", err)
+		}
+		if strings.HasSuffix(fixture, ".sse") {
+			w.Header().Set("Content-Type", "text/event-stream")
+		}
+		_, _ = w.Write(b)
+	}))
+}
+
+func newReq(t *testing.T, llm *LLM, stream bool) mp_common.ILLMReq {
+	enableThinking := true
+	req := &mp_common.LLMReq{
+		Model:  "claude-sonnet-4-20250514",
+		Stream: &stream,
+		Messages: []mp_common.OpenAIReqMsg{
+			{Role: mp_common.MsgRoleSystem, Content: "你是一个助手"},
+			{Role: mp_common.MsgRoleUser, Content: "几点了"},
+			{Role: mp_common.MsgRoleAssistant, Content: "", ToolCalls: []*mp_common.ToolCall{{
+				ID:       "toolu_0",
+				Type:     mp_common.ToolTypeFunction,
+				Function: mp_common.FunctionCall{Name: "get_current_time", Arguments: "{}"},
+			}}},
+			{Role: mp_common.MsgRoleFunction, Content: "08:00", ToolCallId: strPtr("toolu_0")},
+			{Role: mp_common.MsgRoleUser, Content: []map[string]interface{}{
+				{"type": "text", "text": "上海呢"},
+				{"type": "image_url", "image_url": map[string]string{"url": "data:image/png;base64,iVBORw0KGgo="}},
+			}},
+		},
+		Tools: []mp_common.OpenAITool{{
+			Type: mp_common.ToolTypeFunction,
+			Function: &mp_common.OpenAIFunction{
+				Name:        "get_current_time",
+				Description: "当你想知道现在的时间时非常有用。",
+				Parameters: &mp_common.OpenAIFunctionParameters{
+					Type: "object",
+					Properties: map[string]mp_common.OpenAIFunctionParametersProperty{
+						"timezone": {Type: "string", Description: "时区"},
+					},
+				},
+			},
+		}},
+		ToolChoice:     "required",
+		EnableThinking: &enableThinking,
+	}
+	ret, err := llm.NewReq(req)
+	if err != nil {
+		t.Fatalf("new req err: %v", err)
+	}
+	return ret
+}
+
+func TestChatCompletionsUnary(t *testing.T) {
+	var body map[string]interface{}
+	srv := newFixtureServer(t, "messages_tool_use.json", &body)
+	defer srv.Close()
+
+	llm := &LLM{ApiKey: "sk-ant", EndpointUrl: srv.URL + "/v1"}
+	resp, sseCh, err := llm.ChatCompletions(context.Background(), newReq(t, llm, false))
+	if err != nil || sseCh != nil {
+		t.Fatalf("chat completions err: %v", err)
+	}
+
+	// request
+	if body["system"] != "你是一个助手" || body["max_tokens"] != float64(defaultMaxTokens) || body["stream"] != nil {
+		t.Fatalf("unexpected request %v", body)
+	}
+	if thinking, _ := body["thinking"].(map[string]interface{}); thinking["budget_tokens"] != float64(defaultThinkingBudget) {
+		t.Fatalf("unexpected thinking %v", body["thinking"])
+	}
+	if toolChoice, _ := body["tool_choice"].(map[string]interface{}); toolChoice["type"] != "any" {
+		t.Fatalf("unexpected tool choice %v", body["tool_choice"])
+	}
+	messages, _ := body["messages"].([]interface{})
+	if len(messages) != 3 {
+		t.Fatalf("expect user/assistant/user messages, got %v", messages)
+	}
+	// tool结果与后续user消息合并
+	last := messages[2].(map[string]interface{})["content"].([]interface{})
+	if len(last) != 3 || last[0].(map[string]interface{})["type"] != "tool_result" || last[2].(map[string]interface{})["type"] != "image" {
+		t.Fatalf("unexpected last message %v", last)
+	}
+
+	// response
+	data, ok := resp.ConvertResp()
+	if !ok {
+		t.Fatalf("convert resp failed: %v", resp.String())
+	}
+	msg := data.Choices[0].Message
+	if msg.Content != "我来查询一下当前时间。" || msg.ReasoningContent == nil || data.Choices[0].FinishReason != "tool_calls" {
+		t.Fatalf("unexpected message %+v", msg)
+	}
+	if len(msg.ToolCalls) != 1 || msg.ToolCalls[0].ID != "toolu_01A09q90qw90lq917835lq9" || msg.ToolCalls[0].Function.Arguments != `{"timezone": "Asia/Shanghai"}` {
+		t.Fatalf("unexpected tool calls %+v", msg.ToolCalls)
+	}
+	if data.Usage.PromptTokens != 412 || data.Usage.CompletionTokens != 96 || data.Usage.TotalTokens != 508 {
+		t.Fatalf("unexpected usage %+v", data.Usage)
+	}
+}
+
+func TestChatCompletionsStream(t *testing.T) {
+	var body map[string]interface{}
+	srv := newFixtureServer(t, "messages_stream.sse", &body)
+	defer srv.Close()
+
+	llm := &LLM{ApiKey: "sk-ant", EndpointUrl: srv.URL + "/v1"}
+	_, sseCh, err := llm.ChatCompletions(context.Background(), newReq(t, llm, true))
+	if err != nil {
+		t.Fatalf("chat completions err: %v", err)
+	}
+	if body["stream"] != true {
+		t.Fatalf("unexpected request %v", body)
+	}
+
+	var content, reasoning, arguments, finishReason, last string
+	var toolCallId string
+	var usage mp_common.OpenAIRespUsage
+	for sseResp := range sseCh {
+		last = sseResp.String()
+		if !strings.HasPrefix(last, "data:") {
+			t.Fatalf("unexpected sse line %q", last)
+		}
+		data, ok := sseResp.ConvertResp()
+		if !ok {
+			continue
+		}
+		delta := data.Choices[0].Delta
+		content += delta.Content
+		if delta.ReasoningContent != nil {
+			reasoning += *delta.ReasoningContent
+		}
+		for _, toolCall := range delta.ToolCalls {
+			if *toolCall.Index != 0 {
+				t.Fatalf("unexpected tool call index %v", *toolCall.Index)
+			}
+			toolCallId += toolCall.ID
+			arguments += toolCall.Function.Arguments
+		}
+		if data.Choices[0].FinishReason != "" {
+			finishReason = data.Choices[0].FinishReason
+		}
+		if data.Usage.TotalTokens > 0 {
+			usage = data.Usage
+		}
+	}
+	if content != "好的，我来查询。" || reasoning != "需要查询当前时间。" {
+		t.Fatalf("unexpected content %q reasoning %q", content, reasoning)
+	}
+	if toolCallId != "toolu_01T1x1fJ34qAmk2tNTrN7Up6" || arguments != `{"timezone": "Asia/Shanghai"}` || finishReason != "tool_calls" {
+		t.Fatalf("unexpected tool call %v %v %v", toolCallId, arguments, finishReason)
+	}
+	if usage.PromptTokens != 472 || usage.CompletionTokens != 89 {
+		t.Fatalf("unexpected usage %+v", usage)
+	}
+	if last != "data: [DONE]" {
+		t.Fatalf("expect [DONE] as last event, got %v", last)
+	}
+}
+
+func strPtr(s string) *string {
+	return &s
+}
@@ -0,0 +1,171 @@
+package mp_common
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/go-resty/resty/v2"
+)
+
+// HttpError 模型接口返回非2xx状态码时的错误
+type HttpError struct {
+	Provider   string `json:"provider"`
+	Url        string `json:"url"`
+	StatusCode int    `json:"statusCode"`
+	Body       string `json:"body"`
+}
+
+func (e *HttpError) Error() string {
+	return fmt.Sprintf("request %v %v http status %v msg: %v", e.Url, e.Provider, e.StatusCode, e.Body)
+}
+
+// newHttpError 读取并关闭resp的body，构造HttpError
+func newHttpError(provider, url string, resp *resty.Response) *HttpError {
+	ret := &HttpError{
+		Provider:   provider,
+		Url:        url,
+		StatusCode: resp.StatusCode(),
+	}
+	if resp.RawResponse != nil && resp.RawResponse.Body != nil {
+		defer func() { _ = resp.RawResponse.Body.Close() }()
+		b, _ := io.ReadAll(resp.RawResponse.Body)
+		ret.Body = string(b)
+	}
+	return ret
+}
+
+// --- stream error ---
+
+const (
+	StreamErrorTypeUpstream    = "upstream_error"     // 上游返回非2xx状态码或错误事件
+	StreamErrorTypeInterrupted = "stream_interrupted" // 上游连接失败或流读取中断
+)
+
+// StreamError 流式请求的终止错误事件，作为channel中最后一个ILLMResp
+type StreamError struct {
+	Type       string `json:"type"`
+	Provider   string `json:"provider"`
+	StatusCode int    `json:"status_code"` // 上游http状态码，连接失败时为0
+	Body       string `json:"body"`        // 上游返回内容或错误信息
+	Partial    bool   `json:"partial"`     // 出错前是否已输出部分内容
+}
+
+// OpenAIError OpenAI兼容的错误响应，流式时以 data: {"error":{...}} 输出
+type OpenAIError struct {
+	Error *OpenAIErrorDetail `json:"error"`
+}
+
+type OpenAIErrorDetail struct {
+	Message    string      `json:"message"`
+	Type       string      `json:"type"`
+	Code       interface{} `json:"code,omitempty"`
+	Provider   string      `json:"provider,omitempty"`
+	StatusCode int         `json:"status_code,omitempty"`
+	Partial    bool        `json:"partial"`
+}
+
+// NewStreamError 根据err构造StreamError，err为HttpError时保留上游状态码与返回内容
+func NewStreamError(provider string, err error, partial bool) *StreamError {
+	var httpErr *HttpError
+	if errors.As(err, &httpErr) {
+		return &StreamError{
+			Type:       StreamErrorTypeUpstream,
+			Provider:   httpErr.Provider,
+			StatusCode: httpErr.StatusCode,
+			Body:       httpErr.Body,
+			Partial:    partial,
+		}
+	}
+	var streamErr *StreamError
+	if errors.As(err, &streamErr) {
+		ret := *streamErr
+		ret.Partial = ret.Partial || partial
+		return &ret
+	}
+	return &StreamError{
+		Type:     StreamErrorTypeInterrupted,
+		Provider: provider,
+		Body:     err.Error(),
+		Partial:  partial,
+	}
+}
+
+// ToStreamError 判断流式响应是否为终止错误事件
+func ToStreamError(resp ILLMResp) (*StreamError, bool) {
+	ret, ok := resp.(*StreamError)
+	return ret, ok
+}
+
+// ParseOpenAIError 解析OpenAI兼容的错误内容，raw可带 data: 前缀
+func ParseOpenAIError(raw string) (*OpenAIErrorDetail, bool) {
+	raw = strings.TrimSpace(strings.TrimPrefix(strings.TrimSpace(raw), "data:"))
+	if !strings.HasPrefix(raw, "{") {
+		return nil, false
+	}
+	ret := &OpenAIError{}
+	if err := json.Unmarshal([]byte(raw), ret); err != nil || ret.Error == nil {
+		// 部分模型error字段为字符串
+		var strErr struct {
+			Error string `json:"error"`
+		}
+		if err := json.Unmarshal([]byte(raw), &strErr); err != nil || strErr.Error == "" {
+			return nil, false
+		}
+		return &OpenAIErrorDetail{Message: strErr.Error}, true
+	}
+	return ret.Error, true
+}
+
+func (e *StreamError) Error() string {
+	return fmt.Sprintf("%v %v stream err, http status %v, partial %v, msg: %v", e.Provider, e.Type, e.StatusCode, e.Partial, e.Body)
+}
+
+// HttpStatus 返回给调用方的http状态码，上游无状态码时为502
+func (e *StreamError) HttpStatus() int {
+	if e.StatusCode >= 400 {
+		return e.StatusCode
+	}
+	return http.StatusBadGateway
+}
+
+// OpenAIError 转换为OpenAI兼容的错误，上游返回内容为OpenAI错误格式时沿用其message与code
+func (e *StreamError) OpenAIError() *OpenAIError {
+	detail := &OpenAIErrorDetail{
+		Message:    e.Body,
+		Type:       e.Type,
+		Provider:   e.Provider,
+		StatusCode: e.StatusCode,
+		Partial:    e.Partial,
+	}
+	if upstream, ok := ParseOpenAIError(e.Body); ok {
+		detail.Message = upstream.Message
+		detail.Code = upstream.Code
+	}
+	if detail.Message == "" {
+		detail.Message = http.StatusText(e.HttpStatus())
+	}
+	return &OpenAIError{Error: detail}
+}
+
+// String 返回SSE错误事件 data: {"error":{...}}
+func (e *StreamError) String() string {
+	b, _ := json.Marshal(e.OpenAIError())
+	return "data: " + string(b)
+}
+
+func (e *StreamError) Data() (map[string]interface{}, bool) {
+	b, _ := json.Marshal(e.OpenAIError())
+	ret := make(map[string]interface{})
+	if err := json.Unmarshal(b, &ret); err != nil {
+		return nil, false
+	}
+	return ret, true
+}
+
+func (e *StreamError) ConvertResp() (*LLMResp, bool) {
+	return nil, false
+}
@@ -138,6 +138,79 @@ type OpenAIReqMsg struct {
 	ToolCalls        []*ToolCall   `json:"tool_calls,omitempty"`
 }
 
+// OpenAIReqMsgPart 消息内容中的文本或图片
+type OpenAIReqMsgPart struct {
+	Type     string // "text" | "image_url"
+	Text     string
+	ImageUrl string
+}
+
+// Parts 将字符串或数组形式的content统一为OpenAIReqMsgPart列表
+func (msg *OpenAIReqMsg) Parts() []OpenAIReqMsgPart {
+	switch content := msg.Content.(type) {
+	case nil:
+		return nil
+	case string:
+		if content == "" {
+			return nil
+		}
+		return []OpenAIReqMsgPart{{Type: "text", Text: content}}
+	case []interface{}:
+		var ret []OpenAIReqMsgPart
+		for _, item := range content {
+			part, ok := item.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			switch part["type"] {
+			case "text":
+				text, _ := part["text"].(string)
+				ret = append(ret, OpenAIReqMsgPart{Type: "text", Text: text})
+			case "image_url":
+				var imageUrl string
+				switch v := part["image_url"].(type) {
+				case string:
+					imageUrl = v
+				case map[string]interface{}:
+					imageUrl, _ = v["url"].(string)
+				}
+				ret = append(ret, OpenAIReqMsgPart{Type: "image_url", ImageUrl: imageUrl})
+			}
+		}
+		return ret
+	default:
+		b, _ := json.Marshal(content)
+		var items []interface{}
+		if err := json.Unmarshal(b, &items); err != nil {
+			return []OpenAIReqMsgPart{{Type: "text", Text: string(b)}}
+		}
+		return (&OpenAIReqMsg{Content: items}).Parts()
+	}
+}
+
+// Text 返回消息中全部文本内容
+func (msg *OpenAIReqMsg) Text() string {
+	var texts []string
+	for _, part := range msg.Parts() {
+		if part.Type == "text" {
+			texts = append(texts, part.Text)
+		}
+	}
+	return strings.Join(texts, "\n")
+}
+
+// ParseDataUrl 解析 data:<mimeType>;base64,<data> 格式的url
+func ParseDataUrl(url string) (mimeType, data string, ok bool) {
+	if !strings.HasPrefix(url, "data:") {
+		return "", "", false
+	}
+	meta, data, found := strings.Cut(strings.TrimPrefix(url, "data:"), ",")
+	if !found || !strings.HasSuffix(meta, ";base64") {
+		return "", "", false
+	}
+	return strings.TrimSuffix(meta, ";base64"), data, true
+}
+
 type ExtraBody struct {
 	ApiOption string `json:"api_option"` // 选择指定功能。 1）math：拍照答题；2）ocr：多模态OCR；3）general：通用场景。   默认会根据prompt进行意图判断
 }
@@ -408,10 +481,11 @@ func chatCompletionsUnary(ctx context.Context, provider, apiKey, url string, req
 	}
 	resp, err := request.Post(url)
 	if err != nil {
-		return nil, fmt.Errorf("request %v %v chat completions unary err: %v", url, provider, err)
+		return nil, fmt.Errorf("request %v %v chat completions unary err: %w", url, provider, err)
 	} else if resp.StatusCode() >= 300 {
-		return nil, fmt.Errorf("request %v %v chat completions unary http status %v msg: %v", url, provider, resp.StatusCode(), resp.String())
+		return nil, newHttpError(provider, url, resp)
 	}
+	defer func() { _ = resp.RawResponse.Body.Close() }()
 	b, err := io.ReadAll(resp.RawResponse.Body)
 	if err != nil {
 		return nil, fmt.Errorf("request %v %v chat completions unary read response body err: %v", url, provider, err)
@@ -431,33 +505,89 @@ func chatCompletionsStream(ctx context.Context, provider, apiKey, url string, re
 		})
 	}
 
+	// 同步建立连接，以便调用方感知连接失败或非2xx状态码
+	request := resty.New().
+		SetTLSClientConfig(&tls.Config{InsecureSkipVerify: true}). // 关闭证书校验
+		R().
+		SetContext(ctx).
+		SetHeader("Content-Type", "application/json").
+		SetHeader("Accept", "application/json").
+		SetBody(req.Data()).
+		SetDoNotParseResponse(true)
+	for _, header := range headers {
+		request.SetHeader(header.Key, header.Value)
+	}
+	resp, err := request.Post(url)
+	if err != nil {
+		return nil, fmt.Errorf("request %v %v chat completions stream err: %w", url, provider, err)
+	} else if resp.StatusCode() >= 300 {
+		return nil, newHttpError(provider, url, resp)
+	}
+
 	ret := make(chan ILLMResp, 1024)
 	go func() {
 		defer util.PrintPanicStack()
 		defer close(ret)
-		request := resty.New().
-			SetTLSClientConfig(&tls.Config{InsecureSkipVerify: true}). // 关闭证书校验
-			R().
-			SetContext(ctx).
-			SetHeader("Content-Type", "application/json").
-			SetHeader("Accept", "application/json").
-			SetBody(req.Data()).
-			SetDoNotParseResponse(true)
-		for _, header := range headers {
-			request.SetHeader(header.Key, header.Value)
-		}
-		resp, err := request.Post(url)
-		if err != nil {
-			log.Errorf("request %v %v chat completions stream err: %v", url, provider, err)
-			return
-		} else if resp.StatusCode() >= 300 {
-			log.Errorf("request %v %v chat completions stream http status %v msg: %v", url, provider, resp.StatusCode(), resp.String())
-			return
-		}
+		defer func() { _ = resp.RawResponse.Body.Close() }()
+		var partial bool // 是否已输出部分内容
 		scan := bufio.NewScanner(resp.RawResponse.Body)
 		for scan.Scan() {
-			ret <- respConverter(true, scan.Text())
+			line := scan.Text()
+			// 上游在流中返回错误事件
+			if strings.HasPrefix(line, "data:") {
+				if _, ok := ParseOpenAIError(line); ok {
+					log.Errorf("request %v %v chat completions stream upstream err: %v", url, provider, line)
+					ret <- &StreamError{
+						Type:       StreamErrorTypeUpstream,
+						Provider:   provider,
+						StatusCode: resp.StatusCode(),
+						Body:       strings.TrimSpace(strings.TrimPrefix(line, "data:")),
+						Partial:    partial,
+					}
+					return
+				}
+				partial = partial || line != "data: [DONE]"
+			}
+			ret <- respConverter(true, line)
+		}
+		if err := scan.Err(); err != nil {
+			log.Errorf("request %v %v chat completions stream read err: %v", url, provider, err)
+			// 调用方取消时不再返回错误事件
+			if ctx.Err() == nil {
+				ret <- NewStreamError(provider, fmt.Errorf("request %v %v chat completions stream read err: %v", url, provider, err), partial)
+			}
 		}
 	}()
 	return ret, nil
 }
+
+// ConvertLLMStream 将非OpenAI格式的上游流转换为OpenAI兼容的流
+// convert 将上游一行转换为零或多行OpenAI格式的SSE；end 在上游正常结束时调用，可为nil；StreamError原样透传
+func ConvertLLMStream(sseCh <-chan ILLMResp, convert func(line string) []string, end func() []string) <-chan ILLMResp {
+	ret := make(chan ILLMResp, 1024)
+	go func() {
+		defer util.PrintPanicStack()
+		defer close(ret)
+		for sseResp := range sseCh {
+			if _, ok := ToStreamError(sseResp); ok {
+				ret <- sseResp
+				return
+			}
+			for _, line := range convert(sseResp.String()) {
+				ret <- NewLLMResp(true, line)
+			}
+		}
+		if end != nil {
+			for _, line := range end() {
+				ret <- NewLLMResp(true, line)
+			}
+		}
+	}()
+	return ret
+}
+
+// NewLLMStreamData 将OpenAI格式的流式数据包序列化为 data: {...}
+func NewLLMStreamData(chunk *LLMResp) string {
+	b, _ := json.Marshal(chunk)
+	return "data: " + string(b)
+}
@@ -0,0 +1,83 @@
+package mp_common
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func newStreamReq() ILLMReq {
+	return NewLLMReq(map[string]interface{}{"model": "fake", "stream": true})
+}
+
+func TestChatCompletionsStreamHttpError(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusTooManyRequests)
+		_, _ = w.Write([]byte(`{"error":{"message":"rate limited","type":"rate_limit","code":"429"}}`))
+	}))
+	defer srv.Close()
+
+	_, _, err := ChatCompletions(context.Background(), "fake", "", srv.URL, newStreamReq(), NewLLMResp)
+	var httpErr *HttpError
+	if !errors.As(err, &httpErr) {
+		t.Fatalf("expect HttpError, got %v", err)
+	}
+	streamErr := NewStreamError("fake", err, false)
+	if streamErr.HttpStatus() != http.StatusTooManyRequests || streamErr.Partial {
+		t.Fatalf("unexpected stream error %+v", streamErr)
+	}
+	if detail := streamErr.OpenAIError().Error; detail.Message != "rate limited" || detail.Type != StreamErrorTypeUpstream {
+		t.Fatalf("unexpected openai error %+v", detail)
+	}
+}
+
+func TestChatCompletionsStreamUpstreamErrorEvent(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/event-stream")
+		_, _ = fmt.Fprintln(w, `data: {"id":"1","choices":[{"index":0,"delta":{"content":"hi"}}]}`)
+		_, _ = fmt.Fprintln(w, `data: {"error":{"message":"content filtered","type":"invalid_request_error"}}`)
+	}))
+	defer srv.Close()
+
+	streamErr := lastStreamError(t, srv.URL)
+	if !streamErr.Partial || streamErr.StatusCode != http.StatusOK {
+		t.Fatalf("unexpected stream error %+v", streamErr)
+	}
+	if detail, ok := ParseOpenAIError(streamErr.String()); !ok || detail.Message != "content filtered" || !detail.Partial {
+		t.Fatalf("unexpected sse error frame %v", streamErr.String())
+	}
+}
+
+func TestChatCompletionsStreamInterrupted(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/event-stream")
+		_, _ = fmt.Fprintln(w, `data: {"id":"1","choices":[{"index":0,"delta":{"content":"hi"}}]}`)
+		w.(http.Flusher).Flush()
+		panic(http.ErrAbortHandler)
+	}))
+	defer srv.Close()
+
+	streamErr := lastStreamError(t, srv.URL)
+	if streamErr.Type != StreamErrorTypeInterrupted || !streamErr.Partial || streamErr.HttpStatus() != http.StatusBadGateway {
+		t.Fatalf("unexpected stream error %+v", streamErr)
+	}
+}
+
+func lastStreamError(t *testing.T, url string) *StreamError {
+	_, sseCh, err := ChatCompletions(context.Background(), "fake", "", url, newStreamReq(), NewLLMResp)
+	if err != nil {
+		t.Fatalf("chat completions err: %v", err)
+	}
+	var last ILLMResp
+	for resp := range sseCh {
+		last = resp
+	}
+	streamErr, ok := ToStreamError(last)
+	if !ok {
+		t.Fatalf("expect stream error as last event, got %v", last)
+	}
+	return streamErr
+}
@@ -1,11 +1,17 @@
 package mp_common
 
 import (
+	"context"
 	"crypto/tls"
+	"encoding/base64"
 	"encoding/json"
 	"fmt"
 	"io"
 	"mime/multipart"
+	"net/http"
+	"strings"
+	"time"
+	"unicode/utf8"
 
 	"github.com/UnicomAI/wanwu/pkg/log"
 	"github.com/UnicomAI/wanwu/pkg/util"
@@ -17,6 +23,7 @@ import (
 
 type OcrReq struct {
 	Files *multipart.FileHeader `form:"file" json:"file" validate:"required"`
+	Model string                `form:"-" json:"-"` // 模型名称，由调用方根据模型信息填充，多模态大模型OCR时使用
 }
 
 func (req *OcrReq) Check() error {
@@ -155,3 +162,86 @@ func Ocr(ctx *gin.Context, provider, apiKey, url string, req *OcrReq, headers ..
 	}
 	return b, nil
 }
+
+// --- ocr by llm ---
+
+const DefaultOcrPrompt = "识别文件中的全部文字，按原有阅读顺序输出纯文本，表格以markdown格式输出，不要添加任何解释。"
+
+// OcrLLM 用于OCR的多模态大模型
+type OcrLLM interface {
+	NewReq(req *LLMReq) (ILLMReq, error)
+	ChatCompletions(ctx context.Context, req ILLMReq, headers ...Header) (ILLMResp, <-chan ILLMResp, error)
+}
+
+// OcrByLLM 将图片以image_url、PDF以file消息发送给多模态大模型识别文字，返回OcrResp格式的结果
+func OcrByLLM(ctx context.Context, provider string, llm OcrLLM, prompt string, maxTokens *int, req *OcrReq, headers ...Header) ([]byte, error) {
+	start := time.Now()
+	file, err := req.Files.Open()
+	if err != nil {
+		return nil, fmt.Errorf("%v ocr by llm err: %v", provider, err)
+	}
+	defer file.Close()
+	b, err := io.ReadAll(file)
+	if err != nil {
+		return nil, fmt.Errorf("%v ocr by llm read file err: %v", provider, err)
+	}
+	mimeType := req.Files.Header.Get("Content-Type")
+	if !strings.HasPrefix(mimeType, "image/") && mimeType != "application/pdf" {
+		mimeType = http.DetectContentType(b)
+	}
+	dataUrl := "data:" + mimeType + ";base64," + base64.StdEncoding.EncodeToString(b)
+	var filePart map[string]interface{}
+	switch {
+	case strings.HasPrefix(mimeType, "image/"):
+		filePart = map[string]interface{}{
+			"type":      "image_url",
+			"image_url": map[string]interface{}{"url": dataUrl},
+		}
+	case mimeType == "application/pdf":
+		filePart = map[string]interface{}{
+			"type": "file",
+			"file": map[string]interface{}{"filename": req.Files.Filename, "file_data": dataUrl},
+		}
+	default:
+		return nil, fmt.Errorf("%v ocr by llm unsupported file type: %v", provider, mimeType)
+	}
+	if prompt == "" {
+		prompt = DefaultOcrPrompt
+	}
+
+	stream := false
+	llmReq, err := llm.NewReq(&LLMReq{
+		Model: req.Model,
+		Messages: []OpenAIReqMsg{{
+			Role:    MsgRoleUser,
+			Content: []interface{}{filePart, map[string]interface{}{"type": "text", "text": prompt}},
+		}},
+		Stream:    &stream,
+		MaxTokens: maxTokens,
+	})
+	if err != nil {
+		return nil, err
+	}
+	resp, _, err := llm.ChatCompletions(ctx, llmReq, headers...)
+	if err != nil {
+		return nil, err
+	}
+	data, ok := resp.ConvertResp()
+	if !ok || len(data.Choices) == 0 || data.Choices[0].Message == nil {
+		return nil, fmt.Errorf("%v ocr by llm invalid resp: %v", provider, resp.String())
+	}
+	text := data.Choices[0].Message.Content
+	return json.Marshal(&OcrResp{
+		Code:      0,
+		Message:   "success",
+		TimeStamp: time.Now().Format(time.DateTime),
+		Id:        data.ID,
+		TimeCost:  time.Since(start).Seconds(),
+		OcrData: []OcrData{{
+			PageNum: []int{1},
+			Type:    "text",
+			Text:    text,
+			Length:  utf8.RuneCountInString(text),
+		}},
+	})
+}
@@ -0,0 +1,90 @@
+package mp_gemini
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	url_p "net/url"
+	"strings"
+
+	mp_common "github.com/UnicomAI/wanwu/pkg/model-provider/mp-common"
+)
+
+type Embedding struct {
+	ApiKey      string `json:"apiKey"`      // ApiKey
+	EndpointUrl string `json:"endpointUrl"` // 推理url，例如 https://generativelanguage.googleapis.com/v1beta
+	ContextSize *int   `json:"contextSize"` // 上下文长度
+}
+
+func (cfg *Embedding) Tags() []mp_common.Tag {
+	tags := []mp_common.Tag{
+		{
+			Text: mp_common.TagEmbedding,
+		},
+	}
+	tags = append(tags, mp_common.GetTagsByContentSize(cfg.ContextSize)...)
+	return tags
+}
+
+func (cfg *Embedding) NewReq(req *mp_common.EmbeddingReq) (mp_common.IEmbeddingReq, error) {
+	m, err := req.Data()
+	if err != nil {
+		return nil, err
+	}
+	return mp_common.NewEmbeddingReq(m), nil
+}
+
+// Embeddings 通过batchEmbedContents计算向量，并将响应转换为OpenAI格式
+func (cfg *Embedding) Embeddings(ctx context.Context, req mp_common.IEmbeddingReq, headers ...mp_common.Header) (mp_common.IEmbeddingResp, error) {
+	b, err := json.Marshal(req.Data())
+	if err != nil {
+		return nil, err
+	}
+	embeddingReq := &mp_common.EmbeddingReq{}
+	if err = json.Unmarshal(b, embeddingReq); err != nil {
+		return nil, fmt.Errorf("gemini embeddings invalid request: %v", err)
+	}
+	model := "models/" + strings.TrimPrefix(embeddingReq.Model, "models/")
+	requests := make([]map[string]interface{}, 0, len(embeddingReq.Input))
+	for _, input := range embeddingReq.Input {
+		requests = append(requests, map[string]interface{}{
+			"model":   model,
+			"content": map[string]interface{}{"parts": []map[string]interface{}{{"text": input}}},
+		})
+	}
+	headers = append(headers, mp_common.Header{Key: "x-goog-api-key", Value: cfg.ApiKey})
+	b, err = mp_common.Embeddings(ctx, "gemini", "", cfg.embeddingsUrl(model), map[string]interface{}{"requests": requests}, headers...)
+	if err != nil {
+		return nil, err
+	}
+	resp := &batchEmbedContentsResp{}
+	if err = json.Unmarshal(b, resp); err != nil {
+		return nil, fmt.Errorf("gemini embeddings resp (%v) unmarshal err: %v", string(b), err)
+	}
+	object := "list"
+	ret := &mp_common.EmbeddingResp{
+		Model:  embeddingReq.Model,
+		Object: &object,
+		Data:   make([]mp_common.EmbeddingData, 0, len(resp.Embeddings)),
+	}
+	for i, embedding := range resp.Embeddings {
+		ret.Data = append(ret.Data, mp_common.EmbeddingData{
+			Object:    "embedding",
+			Embedding: embedding.Values,
+			Index:     i,
+		})
+	}
+	b, _ = json.Marshal(ret)
+	return mp_common.NewEmbeddingResp(string(b)), nil
+}
+
+func (cfg *Embedding) embeddingsUrl(model string) string {
+	ret, _ := url_p.JoinPath(cfg.EndpointUrl, model+":batchEmbedContents")
+	return ret
+}
+
+type batchEmbedContentsResp struct {
+	Embeddings []struct {
+		Values []float64 `json:"values"`
+	} `json:"embeddings"`
+}
@@ -0,0 +1,438 @@
+package mp_gemini
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/UnicomAI/wanwu/pkg/log"
+	mp_common "github.com/UnicomAI/wanwu/pkg/model-provider/mp-common"
+	"github.com/go-resty/resty/v2"
+)
+
+const maxInlineImageSize = 20 << 20 // generateContent 内联数据上限20MB
+
+// --- generateContent api ---
+
+type generateContentResp struct {
+	ResponseId    string         `json:"responseId"`
+	ModelVersion  string         `json:"modelVersion"`
+	Candidates    []candidate    `json:"candidates"`
+	UsageMetadata *usageMetadata `json:"usageMetadata"`
+}
+
+type candidate struct {
+	Index        int      `json:"index"`
+	Content      *content `json:"content"`
+	FinishReason string   `json:"finishReason"`
+}
+
+type content struct {
+	Role  string `json:"role"`
+	Parts []part `json:"parts"`
+}
+
+type part struct {
+	Text         string        `json:"text"`
+	Thought      bool          `json:"thought"` // 思考内容
+	FunctionCall *functionCall `json:"functionCall"`
+}
+
+type functionCall struct {
+	ID   string          `json:"id"`
+	Name string          `json:"name"`
+	Args json.RawMessage `json:"args"`
+}
+
+type usageMetadata struct {
+	PromptTokenCount     int `json:"promptTokenCount"`
+	CandidatesTokenCount int `json:"candidatesTokenCount"`
+	ThoughtsTokenCount   int `json:"thoughtsTokenCount"`
+	TotalTokenCount      int `json:"totalTokenCount"`
+}
+
+func (u *usageMetadata) toUsage() mp_common.OpenAIRespUsage {
+	ret := mp_common.OpenAIRespUsage{
+		PromptTokens:     u.PromptTokenCount,
+		CompletionTokens: u.CandidatesTokenCount + u.ThoughtsTokenCount,
+		TotalTokens:      u.TotalTokenCount,
+	}
+	if ret.TotalTokens == 0 {
+		ret.TotalTokens = ret.PromptTokens + ret.CompletionTokens
+	}
+	return ret
+}
+
+// --- request ---
+
+// toGenerateContentReq 将OpenAI格式的请求转换为generateContent请求
+func toGenerateContentReq(ctx context.Context, req *mp_common.LLMReq, cfgMaxTokens *int) (map[string]interface{}, error) {
+	var system []map[string]interface{}
+	var contents []map[string]interface{}
+	// 相邻同角色的消息合并，并行工具调用的结果需在同一轮中返回
+	appendParts := func(role string, parts []map[string]interface{}) {
+		if len(parts) == 0 {
+			return
+		}
+		if n := len(contents); n > 0 && contents[n-1]["role"] == role {
+			contents[n-1]["parts"] = append(contents[n-1]["parts"].([]map[string]interface{}), parts...)
+			return
+		}
+		contents = append(contents, map[string]interface{}{"role": role, "parts": parts})
+	}
+	toolNames := make(map[string]string) // tool call id -> function name
+	for _, msg := range req.Messages {
+		switch msg.Role {
+		case mp_common.MsgRoleSystem:
+			if text := msg.Text(); text != "" {
+				system = append(system, map[string]interface{}{"text": text})
+			}
+		case mp_common.MsgRoleUser:
+			parts, err := toParts(ctx, msg.Parts())
+			if err != nil {
+				return nil, err
+			}
+			appendParts("user", parts)
+		case mp_common.MsgRoleAssistant:
+			parts, err := toParts(ctx, msg.Parts())
+			if err != nil {
+				return nil, err
+			}
+			for _, toolCall := range msg.ToolCalls {
+				args := make(map[string]interface{})
+				if toolCall.Function.Arguments != "" {
+					if err := json.Unmarshal([]byte(toolCall.Function.Arguments), &args); err != nil {
+						return nil, fmt.Errorf("tool call %v arguments unmarshal err: %v", toolCall.Function.Name, err)
+					}
+				}
+				toolNames[toolCall.ID] = toolCall.Function.Name
+				parts = append(parts, map[string]interface{}{
+					"functionCall": map[string]interface{}{
+						"name": toolCall.Function.Name,
+						"args": args,
+					},
+				})
+			}
+			appendParts("model", parts)
+		case mp_common.MsgRoleFunction:
+			var name string
+			if msg.ToolCallId != nil {
+				name = toolNames[*msg.ToolCallId]
+			}
+			if name == "" && msg.Name != nil {
+				name = *msg.Name
+			}
+			if name == "" {
+				return nil, fmt.Errorf("tool message without matched tool call")
+			}
+			appendParts("user", []map[string]interface{}{{
+				"functionResponse": map[string]interface{}{
+					"name":     name,
+					"response": map[string]interface{}{"content": msg.Text()},
+				},
+			}})
+		default:
+			return nil, fmt.Errorf("unsupported message role: %v", msg.Role)
+		}
+	}
+
+	ret := map[string]interface{}{
+		"contents": contents,
+	}
+	if len(system) > 0 {
+		ret["systemInstruction"] = map[string]interface{}{"parts": system}
+	}
+	if genCfg := toGenerationConfig(req, cfgMaxTokens); len(genCfg) > 0 {
+		ret["generationConfig"] = genCfg
+	}
+	if len(req.Tools) > 0 {
+		var declarations []map[string]interface{}
+		for _, tool := range req.Tools {
+			if tool.Function == nil {
+				continue
+			}
+			declaration := map[string]interface{}{
+				"name":        tool.Function.Name,
+				"description": tool.Function.Description,
+			}
+			// OBJECT类型的properties不能为空，无参数时不传parameters
+			if params := tool.Function.Parameters; params != nil && len(params.Properties) > 0 {
+				schema := map[string]interface{}{
+					"type":       "object",
+					"properties": params.Properties,
+				}
+				if len(params.Required) > 0 {
+					schema["required"] = params.Required
+				}
+				declaration["parameters"] = schema
+			}
+			declarations = append(declarations, declaration)
+		}
+		ret["tools"] = []map[string]interface{}{{"functionDeclarations": declarations}}
+		if toolConfig := toToolConfig(req.ToolChoice); toolConfig != nil {
+			ret["toolConfig"] = toolConfig
+		}
+	}
+	return ret, nil
+}
+
+func toGenerationConfig(req *mp_common.LLMReq, cfgMaxTokens *int) map[string]interface{} {
+	ret := make(map[string]interface{})
+	if req.MaxCompletionTokens != nil {
+		ret["maxOutputTokens"] = *req.MaxCompletionTokens
+	} else if req.MaxTokens != nil {
+		ret["maxOutputTokens"] = *req.MaxTokens
+	} else if cfgMaxTokens != nil && *cfgMaxTokens > 0 {
+		ret["maxOutputTokens"] = *cfgMaxTokens
+	}
+	if req.Temperature != nil {
+		ret["temperature"] = *req.Temperature
+	}
+	if req.TopP != nil {
+		ret["topP"] = *req.TopP
+	}
+	if req.TopK != nil {
+		ret["topK"] = *req.TopK
+	}
+	if req.PresencePenalty != nil {
+		ret["presencePenalty"] = *req.PresencePenalty
+	}
+	if req.FrequencyPenalty != nil {
+		ret["frequencyPenalty"] = *req.FrequencyPenalty
+	}
+	if req.Seed != nil {
+		ret["seed"] = *req.Seed
+	}
+	if req.N != nil {
+		ret["candidateCount"] = *req.N
+	}
+	if req.Stop != nil && *req.Stop != "" {
+		ret["stopSequences"] = []string{*req.Stop}
+	}
+	if req.ResponseFormat != nil && strings.HasPrefix(req.ResponseFormat.Type, "json") {
+		ret["responseMimeType"] = "application/json"
+	}
+	switch {
+	case (req.Thinking != nil && req.Thinking.Type == "enabled") || (req.EnableThinking != nil && *req.EnableThinking):
+		thinkingCfg := map[string]interface{}{"includeThoughts": true}
+		if req.ThinkingBudget != nil {
+			thinkingCfg["thinkingBudget"] = *req.ThinkingBudget
+		}
+		ret["thinkingConfig"] = thinkingCfg
+	case (req.Thinking != nil && req.Thinking.Type == "disabled") || (req.EnableThinking != nil && !*req.EnableThinking):
+		ret["thinkingConfig"] = map[string]interface{}{"thinkingBudget": 0}
+	}
+	return ret
+}
+
+func toParts(ctx context.Context, msgParts []mp_common.OpenAIReqMsgPart) ([]map[string]interface{}, error) {
+	var ret []map[string]interface{}
+	for _, msgPart := range msgParts {
+		switch msgPart.Type {
+		case "text":
+			if msgPart.Text == "" {
+				continue
+			}
+			ret = append(ret, map[string]interface{}{"text": msgPart.Text})
+		case "image_url":
+			mimeType, data, ok := mp_common.ParseDataUrl(msgPart.ImageUrl)
+			if !ok {
+				var err error
+				if mimeType, data, err = fetchImage(ctx, msgPart.ImageUrl); err != nil {
+					return nil, err
+				}
+			}
+			ret = append(ret, map[string]interface{}{
+				"inlineData": map[string]interface{}{"mimeType": mimeType, "data": data},
+			})
+		}
+	}
+	return ret, nil
+}
+
+// fetchImage 下载图片并转为base64，generateContent 不支持任意http图片地址
+func fetchImage(ctx context.Context, imageUrl string) (mimeType, data string, err error) {
+	resp, err := resty.New().
+		SetTLSClientConfig(&tls.Config{InsecureSkipVerify: true}). // 关闭证书校验
+		SetTimeout(30 * time.Second).
+		R().
+		SetContext(ctx).
+		Get(imageUrl)
+	if err != nil {
+		return "", "", fmt.Errorf("fetch image %v err: %v", imageUrl, err)
+	} else if resp.StatusCode() >= 300 {
+		return "", "", fmt.Errorf("fetch image %v http status %v", imageUrl, resp.StatusCode())
+	} else if len(resp.Body()) > maxInlineImageSize {
+		return "", "", fmt.Errorf("fetch image %v size %v exceeds limit", imageUrl, len(resp.Body()))
+	}
+	mimeType = strings.TrimSpace(strings.Split(resp.Header().Get("Content-Type"), ";")[0])
+	if !strings.HasPrefix(mimeType, "image/") {
+		mimeType = http.DetectContentType(resp.Body())
+	}
+	return mimeType, base64.StdEncoding.EncodeToString(resp.Body()), nil
+}
+
+// toToolConfig 转换tool_choice："auto" | "none" | "required" | {"type":"function","function":{"name":"xx"}}
+func toToolConfig(toolChoice interface{}) map[string]interface{} {
+	functionCallingConfig := map[string]interface{}{}
+	switch v := toolChoice.(type) {
+	case string:
+		switch v {
+		case "auto":
+			functionCallingConfig["mode"] = "AUTO"
+		case "none":
+			functionCallingConfig["mode"] = "NONE"
+		case "required":
+			functionCallingConfig["mode"] = "ANY"
+		}
+	case map[string]interface{}:
+		if function, ok := v["function"].(map[string]interface{}); ok {
+			if name, _ := function["name"].(string); name != "" {
+				functionCallingConfig["mode"] = "ANY"
+				functionCallingConfig["allowedFunctionNames"] = []string{name}
+			}
+		}
+	}
+	if len(functionCallingConfig) == 0 {
+		return nil
+	}
+	return map[string]interface{}{"functionCallingConfig": functionCallingConfig}
+}
+
+// --- response ---
+
+// toLLMResp 将generateContent的非流式响应转换为OpenAI格式
+func toLLMResp(stream bool, raw, model string) mp_common.ILLMResp {
+	resp := &generateContentResp{}
+	if err := json.Unmarshal([]byte(raw), resp); err != nil {
+		log.Errorf("gemini resp (%v) convert to openai resp err: %v", raw, err)
+		return mp_common.NewLLMResp(stream, raw)
+	}
+	ret := newStreamConverter(model).toLLMResp(resp, false)
+	b, _ := json.Marshal(ret)
+	return mp_common.NewLLMResp(stream, string(b))
+}
+
+func toFinishReason(finishReason string, toolCall bool) string {
+	switch finishReason {
+	case "":
+		return ""
+	case "STOP":
+		if toolCall {
+			return "tool_calls"
+		}
+		return "stop"
+	case "MAX_TOKENS":
+		return "length"
+	case "SAFETY", "RECITATION", "BLOCKLIST", "PROHIBITED_CONTENT", "SPII", "IMAGE_SAFETY":
+		return "content_filter"
+	default:
+		return "stop"
+	}
+}
+
+// --- stream ---
+
+// streamConverter 将generateContent的响应转换为OpenAI格式，流式时每个请求一个实例，
+// 工具调用在单个数据包中完整返回，按出现顺序分配index
+type streamConverter struct {
+	model     string
+	created   int
+	toolIndex int
+}
+
+func newStreamConverter(model string) *streamConverter {
+	return &streamConverter{
+		model:   model,
+		created: int(time.Now().Unix()),
+	}
+}
+
+func (c *streamConverter) convert(line string) []string {
+	if !strings.HasPrefix(line, "data:") {
+		return nil
+	}
+	raw := strings.TrimSpace(strings.TrimPrefix(line, "data:"))
+	resp := &generateContentResp{}
+	if err := json.Unmarshal([]byte(raw), resp); err != nil {
+		log.Errorf("gemini stream resp (%v) unmarshal err: %v", raw, err)
+		return nil
+	}
+	return []string{mp_common.NewLLMStreamData(c.toLLMResp(resp, true))}
+}
+
+func (c *streamConverter) toLLMResp(resp *generateContentResp, stream bool) *mp_common.LLMResp {
+	ret := &mp_common.LLMResp{
+		ID:      resp.ResponseId,
+		Object:  "chat.completion",
+		Created: c.created,
+		Model:   c.model,
+		Choices: []mp_common.OpenAIRespChoice{},
+	}
+	if stream {
+		ret.Object = "chat.completion.chunk"
+	}
+	if resp.ModelVersion != "" {
+		ret.Model = resp.ModelVersion
+	}
+	if resp.UsageMetadata != nil {
+		ret.Usage = resp.UsageMetadata.toUsage()
+	}
+	for _, cand := range resp.Candidates {
+		msg := &mp_common.OpenAIMsg{Role: mp_common.MsgRoleAssistant}
+		var reasoning string
+		if cand.Content != nil {
+			for _, p := range cand.Content.Parts {
+				switch {
+				case p.FunctionCall != nil:
+					args := &bytes.Buffer{}
+					if err := json.Compact(args, p.FunctionCall.Args); err != nil || args.Len() == 0 {
+						args.Reset()
+						args.WriteString("{}")
+					}
+					toolCall := &mp_common.ToolCall{
+						ID:   p.FunctionCall.ID,
+						Type: mp_common.ToolTypeFunction,
+						Function: mp_common.FunctionCall{
+							Name:      p.FunctionCall.Name,
+							Arguments: args.String(),
+						},
+					}
+					if toolCall.ID == "" {
+						toolCall.ID = fmt.Sprintf("call_%v_%d", resp.ResponseId, c.toolIndex)
+					}
+					if stream {
+						index := c.toolIndex
+						toolCall.Index = &index
+					}
+					c.toolIndex++
+					msg.ToolCalls = append(msg.ToolCalls, toolCall)
+				case p.Thought:
+					reasoning += p.Text
+				default:
+					msg.Content += p.Text
+				}
+			}
+		}
+		if reasoning != "" {
+			msg.ReasoningContent = &reasoning
+		}
+		choice := mp_common.OpenAIRespChoice{
+			Index:        cand.Index,
+			FinishReason: toFinishReason(cand.FinishReason, len(msg.ToolCalls) > 0 || (stream && c.toolIndex > 0)),
+		}
+		if stream {
+			choice.Delta = msg
+		} else {
+			choice.Message = msg
+		}
+		ret.Choices = append(ret.Choices, choice)
+	}
+	return ret
+}
@@ -0,0 +1,30 @@
+package mp_gemini
+
+type LLMParams struct {
+	Temperature       float32 `json:"temperature"`       // 温度
+	TemperatureEnable bool    `json:"temperatureEnable"` // 温度(开关)
+	TopP              float32 `json:"topP"`              // Top P
+	TopPEnable        bool    `json:"topPEnable"`        // Top P(开关)
+	TopK              int32   `json:"topK"`              // Top K
+	TopKEnable        bool    `json:"topKEnable"`        // Top K(开关)
+	MaxTokens         int32   `json:"maxTokens"`         // 最大标记
+	MaxTokensEnable   bool    `json:"maxTokensEnable"`   // 最大标记(开关)
+}
+
+func (cfg *LLMParams) GetParams() map[string]interface{} {
+	ret := make(map[string]interface{})
+
+	if cfg.TemperatureEnable {
+		ret["temperature"] = cfg.Temperature
+	}
+	if cfg.TopPEnable {
+		ret["top_p"] = cfg.TopP
+	}
+	if cfg.TopKEnable {
+		ret["top_k"] = cfg.TopK
+	}
+	if cfg.MaxTokensEnable {
+		ret["max_tokens"] = cfg.MaxTokens
+	}
+	return ret
+}
@@ -0,0 +1,102 @@
+package mp_gemini
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strings"
+
+	mp_common "github.com/UnicomAI/wanwu/pkg/model-provider/mp-common"
+)
+
+type LLM struct {
+	ApiKey          string `json:"apiKey"`                                              // ApiKey
+	EndpointUrl     string `json:"endpointUrl"`                                         // 推理url，例如 https://generativelanguage.googleapis.com/v1beta
+	FunctionCalling string `json:"functionCalling" validate:"oneof=noSupport toolCall"` // 函数调用是否支持
+	VisionSupport   string `json:"visionSupport" validate:"oneof=noSupport support"`    // 视觉支持
+	MaxTokens       *int   `json:"maxTokens"`                                           // 模型回答最大tokens
+	ContextSize     *int   `json:"contextSize"`                                         // 上下文长度
+}
+
+func (cfg *LLM) Tags() []mp_common.Tag {
+	tags := []mp_common.Tag{
+		{
+			Text: mp_common.TagChat,
+		},
+	}
+	if cfg.VisionSupport == "support" {
+		tags = append(tags, mp_common.Tag{
+			Text: mp_common.TagVisionSupport,
+		})
+	}
+	tags = append(tags, mp_common.GetTagsByFunctionCall(cfg.FunctionCalling)...)
+	tags = append(tags, mp_common.GetTagsByContentSize(cfg.ContextSize)...)
+	return tags
+}
+
+func (cfg *LLM) NewReq(req *mp_common.LLMReq) (mp_common.ILLMReq, error) {
+	if req.MaxTokens != nil && cfg.ContextSize != nil && *req.MaxTokens > *cfg.ContextSize {
+		return nil, fmt.Errorf("max_tokens too large (max allowed: %d)", *cfg.ContextSize)
+	}
+	m, err := req.Data()
+	if err != nil {
+		return nil, err
+	}
+	return mp_common.NewLLMReq(m), nil
+}
+
+// ChatCompletions 将OpenAI格式的请求转换为generateContent请求，并将响应转换回OpenAI格式
+func (cfg *LLM) ChatCompletions(ctx context.Context, req mp_common.ILLMReq, headers ...mp_common.Header) (mp_common.ILLMResp, <-chan mp_common.ILLMResp, error) {
+	openAIReq, ok := req.OpenAIReq()
+	if !ok {
+		return nil, nil, fmt.Errorf("gemini chat completions invalid request")
+	}
+	body, err := toGenerateContentReq(ctx, openAIReq, cfg.MaxTokens)
+	if err != nil {
+		return nil, nil, err
+	}
+	headers = append(headers, mp_common.Header{Key: "x-goog-api-key", Value: cfg.ApiKey})
+	if !req.Stream() {
+		return mp_common.ChatCompletions(ctx, "gemini", "", cfg.generateContentUrl(openAIReq.Model, false), &generateContentReq{data: body}, func(stream bool, raw string) mp_common.ILLMResp {
+			return toLLMResp(stream, raw, openAIReq.Model)
+		}, headers...)
+	}
+	_, sseCh, err := mp_common.ChatCompletions(ctx, "gemini", "", cfg.generateContentUrl(openAIReq.Model, true), &generateContentReq{data: body, stream: true}, mp_common.NewLLMResp, headers...)
+	if err != nil {
+		return nil, nil, err
+	}
+	// generateContent 流式没有结束事件，上游正常结束后补充 [DONE]
+	return nil, mp_common.ConvertLLMStream(sseCh, newStreamConverter(openAIReq.Model).convert, func() []string {
+		return []string{"data: [DONE]"}
+	}), nil
+}
+
+func (cfg *LLM) generateContentUrl(model string, stream bool) string {
+	method := ":generateContent"
+	if stream {
+		method = ":streamGenerateContent"
+	}
+	ret, _ := url.JoinPath(cfg.EndpointUrl, "/models", strings.TrimPrefix(model, "models/")+method)
+	if stream {
+		ret += "?alt=sse"
+	}
+	return ret
+}
+
+// generateContentReq implementation of ILLMReq，请求体中不包含stream字段
+type generateContentReq struct {
+	stream bool
+	data   map[string]interface{}
+}
+
+func (req *generateContentReq) Stream() bool {
+	return req.stream
+}
+
+func (req *generateContentReq) Data() map[string]interface{} {
+	return req.data
+}
+
+func (req *generateContentReq) OpenAIReq() (*mp_common.LLMReq, bool) {
+	return nil, false
+}
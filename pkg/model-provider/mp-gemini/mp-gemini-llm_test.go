@@ -0,0 +1,228 @@
+package mp_gemini
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"testing"
+
+	mp_common "github.com/UnicomAI/wanwu/pkg/model-provider/mp-common"
+	"github.com/UnicomAI/wanwu/pkg/util"
+)
+
+var pngHeader = []byte{0x89, 'P', 'N', 'G', '\r', '\n', 0x1a, '\n'}
+
+func TestMain(m *testing.M) {
+	if err := util.InitValidator(); err != nil {
+		panic(err)
+	}
+	os.Exit(m.Run())
+}
+
+// newFixtureServer 返回testdata中录制的响应，并记录收到的请求体；/image.png 用于图片下载
+func newFixtureServer(t *testing.T, path, fixture string, body *map[string]interface{}) *httptest.Server {
+	b, err := os.ReadFile("testdata/" + fixture)
+	if err != nil {
+		t.Fatalf("read fixture err: %v", err)
+	}
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/image.png" {
+			w.Header().Set("Content-Type", "image/png")
+			_, _ = w.Write(pngHeader)
+			return
+		}
+		if r.URL.EscapedPath() != path || r.Header.Get("x-goog-api-key") != "gm-key" || r.Header.Get("Authorization") != "" {
+			t.Errorf("unexpected request %v %v", r.URL.EscapedPath(), r.Header)
+		}
+		if strings.HasSuffix(fixture, ".sse") && r.URL.Query().Get("alt") != "sse" {
+			t.Errorf("unexpected stream query %v", r.URL.RawQuery)
+		}
+		if err := json.NewDecoder(r.Body).Decode(body); err != nil {
+			t.Errorf("decode request body err: %v", err)
+		}
+		_, _ = w.Write(b)
+	}))
+}
+
+func newReq(t *testing.T, llm *LLM, imageUrl string, stream bool) mp_common.ILLMReq {
+	enableThinking := true
+	req := &mp_common.LLMReq{
+		Model:  "gemini-2.5-flash",
+		Stream: &stream,
+		Messages: []mp_common.OpenAIReqMsg{
+			{Role: mp_common.MsgRoleSystem, Content: "你是一个助手"},
+			{Role: mp_common.MsgRoleUser, Content: "几点了"},
+			{Role: mp_common.MsgRoleAssistant, Content: "", ToolCalls: []*mp_common.ToolCall{{
+				ID:       "call_0",
+				Type:     mp_common.ToolTypeFunction,
+				Function: mp_common.FunctionCall{Name: "get_current_time", Arguments: "{}"},
+			}}},
+			{Role: mp_common.MsgRoleFunction, Content: "08:00", ToolCallId: strPtr("call_0")},
+			{Role: mp_common.MsgRoleUser, Content: []map[string]interface{}{
+				{"type": "text", "text": "上海呢"},
+				{"type": "image_url", "image_url": map[string]string{"url": imageUrl}},
+			}},
+		},
+		Tools: []mp_common.OpenAITool{{
+			Type: mp_common.ToolTypeFunction,
+			Function: &mp_common.OpenAIFunction{
+				Name:        "get_current_time",
+				Description: "当你想知道现在的时间时非常有用。",
+				Parameters: &mp_common.OpenAIFunctionParameters{
+					Type:       "object",
+					Properties: map[string]mp_common.OpenAIFunctionParametersProperty{},
+				},
+			},
+		}},
+		ToolChoice:     "required",
+		EnableThinking: &enableThinking,
+	}
+	ret, err := llm.NewReq(req)
+	if err != nil {
+		t.Fatalf("new req err: %v", err)
+	}
+	return ret
+}
+
+func TestChatCompletionsUnary(t *testing.T) {
+	var body map[string]interface{}
+	srv := newFixtureServer(t, "/v1beta/models/gemini-2.5-flash:generateContent", "generate_content_function_call.json", &body)
+	defer srv.Close()
+
+	llm := &LLM{ApiKey: "gm-key", EndpointUrl: srv.URL + "/v1beta"}
+	resp, sseCh, err := llm.ChatCompletions(context.Background(), newReq(t, llm, srv.URL+"/image.png", false))
+	if err != nil || sseCh != nil {
+		t.Fatalf("chat completions err: %v", err)
+	}
+
+	// request
+	if body["systemInstruction"] == nil || body["stream"] != nil {
+		t.Fatalf("unexpected request %v", body)
+	}
+	genCfg, _ := body["generationConfig"].(map[string]interface{})
+	if thinkingCfg, _ := genCfg["thinkingConfig"].(map[string]interface{}); thinkingCfg["includeThoughts"] != true {
+		t.Fatalf("unexpected generation config %v", genCfg)
+	}
+	if toolConfig, _ := body["toolConfig"].(map[string]interface{}); toolConfig["functionCallingConfig"].(map[string]interface{})["mode"] != "ANY" {
+		t.Fatalf("unexpected tool config %v", body["toolConfig"])
+	}
+	// 无参数的工具不传parameters
+	declaration := body["tools"].([]interface{})[0].(map[string]interface{})["functionDeclarations"].([]interface{})[0].(map[string]interface{})
+	if _, ok := declaration["parameters"]; ok {
+		t.Fatalf("unexpected function declaration %v", declaration)
+	}
+	contents, _ := body["contents"].([]interface{})
+	if len(contents) != 3 || contents[1].(map[string]interface{})["role"] != "model" {
+		t.Fatalf("expect user/model/user contents, got %v", contents)
+	}
+	// 工具结果与后续user消息合并，图片下载后内联
+	parts := contents[2].(map[string]interface{})["parts"].([]interface{})
+	if len(parts) != 3 || parts[0].(map[string]interface{})["functionResponse"].(map[string]interface{})["name"] != "get_current_time" {
+		t.Fatalf("unexpected last content %v", parts)
+	}
+	if inlineData := parts[2].(map[string]interface{})["inlineData"].(map[string]interface{}); inlineData["mimeType"] != "image/png" || inlineData["data"] != base64.StdEncoding.EncodeToString(pngHeader) {
+		t.Fatalf("unexpected inline data %v", inlineData)
+	}
+
+	// response
+	data, ok := resp.ConvertResp()
+	if !ok {
+		t.Fatalf("convert resp failed: %v", resp.String())
+	}
+	msg := data.Choices[0].Message
+	if msg.Content != "我来查询一下当前时间。" || msg.ReasoningContent == nil || data.Choices[0].FinishReason != "tool_calls" {
+		t.Fatalf("unexpected message %+v", msg)
+	}
+	if len(msg.ToolCalls) != 1 || msg.ToolCalls[0].ID == "" || msg.ToolCalls[0].Function.Arguments != `{"timezone":"Asia/Shanghai"}` {
+		t.Fatalf("unexpected tool calls %+v", msg.ToolCalls)
+	}
+	if data.Usage.PromptTokens != 58 || data.Usage.CompletionTokens != 69 || data.Usage.TotalTokens != 127 {
+		t.Fatalf("unexpected usage %+v", data.Usage)
+	}
+}
+
+func TestChatCompletionsStream(t *testing.T) {
+	var body map[string]interface{}
+	srv := newFixtureServer(t, "/v1beta/models/gemini-2.5-flash:streamGenerateContent", "stream_generate_content.sse", &body)
+	defer srv.Close()
+
+	llm := &LLM{ApiKey: "gm-key", EndpointUrl: srv.URL + "/v1beta"}
+	_, sseCh, err := llm.ChatCompletions(context.Background(), newReq(t, llm, "data:image/png;base64,iVBORw0KGgo=", true))
+	if err != nil {
+		t.Fatalf("chat completions err: %v", err)
+	}
+	if body["stream"] != nil {
+		t.Fatalf("unexpected request %v", body)
+	}
+
+	var content, reasoning, arguments, finishReason, last string
+	var usage mp_common.OpenAIRespUsage
+	for sseResp := range sseCh {
+		last = sseResp.String()
+		if !strings.HasPrefix(last, "data:") {
+			t.Fatalf("unexpected sse line %q", last)
+		}
+		data, ok := sseResp.ConvertResp()
+		if !ok {
+			continue
+		}
+		delta := data.Choices[0].Delta
+		content += delta.Content
+		if delta.ReasoningContent != nil {
+			reasoning += *delta.ReasoningContent
+		}
+		for _, toolCall := range delta.ToolCalls {
+			if *toolCall.Index != 0 || toolCall.ID == "" {
+				t.Fatalf("unexpected tool call %+v", toolCall)
+			}
+			arguments += toolCall.Function.Arguments
+		}
+		if data.Choices[0].FinishReason != "" {
+			finishReason = data.Choices[0].FinishReason
+		}
+		usage = data.Usage
+	}
+	if content != "好的，我来查询。" || reasoning != "需要查询当前时间。" {
+		t.Fatalf("unexpected content %q reasoning %q", content, reasoning)
+	}
+	if arguments != `{"timezone":"Asia/Shanghai"}` || finishReason != "tool_calls" {
+		t.Fatalf("unexpected tool call %v %v", arguments, finishReason)
+	}
+	if usage.PromptTokens != 58 || usage.CompletionTokens != 29 || usage.TotalTokens != 87 {
+		t.Fatalf("unexpected usage %+v", usage)
+	}
+	if last != "data: [DONE]" {
+		t.Fatalf("expect [DONE] as last event, got %v", last)
+	}
+}
+
+func TestEmbeddings(t *testing.T) {
+	var body map[string]interface{}
+	srv := newFixtureServer(t, "/v1beta/models/text-embedding-004:batchEmbedContents", "batch_embed_contents.json", &body)
+	defer srv.Close()
+
+	embedding := &Embedding{ApiKey: "gm-key", EndpointUrl: srv.URL + "/v1beta"}
+	req, err := embedding.NewReq(&mp_common.EmbeddingReq{Model: "text-embedding-004", Input: []string{"你好", "世界"}})
+	if err != nil {
+		t.Fatalf("new req err: %v", err)
+	}
+	resp, err := embedding.Embeddings(context.Background(), req)
+	if err != nil {
+		t.Fatalf("embeddings err: %v", err)
+	}
+	if requests, _ := body["requests"].([]interface{}); len(requests) != 2 || requests[0].(map[string]interface{})["model"] != "models/text-embedding-004" {
+		t.Fatalf("unexpected request %v", body)
+	}
+	data, ok := resp.ConvertResp()
+	if !ok || len(data.Data) != 2 || data.Data[1].Index != 1 || len(data.Data[1].Embedding) != 4 {
+		t.Fatalf("unexpected embeddings resp %v", resp.String())
+	}
+}
+
+func strPtr(s string) *string {
+	return &s
+}
@@ -0,0 +1,192 @@
+package mp_http
+
+import (
+	"bytes"
+	"crypto/tls"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+
+	mp_common "github.com/UnicomAI/wanwu/pkg/model-provider/mp-common"
+	"github.com/gin-gonic/gin"
+	"github.com/go-resty/resty/v2"
+)
+
+// PdfParser 通用HTTP文档解析接口，通过表单字段与响应字段映射适配
+type PdfParser struct {
+	ApiKey        string            `json:"apiKey"`        // ApiKey，非空时以 Authorization: Bearer 传递
+	EndpointUrl   string            `json:"endpointUrl"`   // 解析接口完整url
+	FileField     string            `json:"fileField"`     // 请求中文件的表单字段名，默认file
+	FileNameField string            `json:"fileNameField"` // 请求中文件名的表单字段名，默认file_name
+	FormFields    map[string]string `json:"formFields"`    // 请求中附加的固定表单字段
+	ContentPath   string            `json:"contentPath"`   // 响应中解析结果的字段路径，以.分隔，*表示遍历数组，例如 data.pages.*.markdown；默认content
+	SuccessPath   string            `json:"successPath"`   // 响应中成功标识的字段路径，为空时仅以http状态码判断
+	SuccessValue  string            `json:"successValue"`  // 成功标识的值，例如 0
+	MessagePath   string            `json:"messagePath"`   // 响应中错误信息的字段路径
+}
+
+func (cfg *PdfParser) Tags() []mp_common.Tag {
+	tags := []mp_common.Tag{
+		{
+			Text: mp_common.TagPdfParser,
+		},
+	}
+	return tags
+}
+
+func (cfg *PdfParser) NewReq(req *mp_common.PdfParserReq) (mp_common.IPdfParserReq, error) {
+	return mp_common.NewPdfParserReq(req), nil
+}
+
+func (cfg *PdfParser) PdfParser(ctx *gin.Context, req mp_common.IPdfParserReq, headers ...mp_common.Header) (mp_common.IPdfParserResp, error) {
+	b, err := cfg.request(ctx, req.Data(), headers...)
+	if err != nil {
+		return nil, err
+	}
+	content, err := cfg.parseContent(b)
+	if err != nil {
+		return nil, err
+	}
+	ret, _ := json.Marshal(&mp_common.PdfParserResp{
+		Code:    "200",
+		Content: content,
+		Message: "success",
+		Status:  "success",
+	})
+	return mp_common.NewPdfParserResp(string(ret)), nil
+}
+
+func (cfg *PdfParser) request(ctx *gin.Context, req *mp_common.PdfParserReq, headers ...mp_common.Header) ([]byte, error) {
+	if cfg.ApiKey != "" {
+		headers = append(headers, mp_common.Header{
+			Key:   "Authorization",
+			Value: "Bearer " + cfg.ApiKey,
+		})
+	}
+	file, err := req.Files.Open()
+	if err != nil {
+		return nil, fmt.Errorf("request %v http pdfParser err: %v", cfg.EndpointUrl, err)
+	}
+	defer file.Close()
+
+	fields := make(map[string]string)
+	for k, v := range cfg.FormFields {
+		fields[k] = v
+	}
+	fields[valueOrDefault(cfg.FileNameField, "file_name")] = req.FileName
+	request := resty.New().
+		SetTLSClientConfig(&tls.Config{InsecureSkipVerify: true}). // 关闭证书校验
+		SetTimeout(0).                                             // 关闭请求超时
+		R().
+		SetContext(ctx).
+		SetHeader("Accept", "application/json").
+		SetFileReader(valueOrDefault(cfg.FileField, "file"), req.Files.Filename, file).
+		SetMultipartFormData(fields).
+		SetDoNotParseResponse(true)
+	for _, header := range headers {
+		request.SetHeader(header.Key, header.Value)
+	}
+	resp, err := request.Post(cfg.EndpointUrl)
+	if err != nil {
+		return nil, fmt.Errorf("request %v http pdfParser err: %v", cfg.EndpointUrl, err)
+	}
+	defer func() { _ = resp.RawResponse.Body.Close() }()
+	b, err := io.ReadAll(resp.RawResponse.Body)
+	if err != nil {
+		return nil, fmt.Errorf("request %v http pdfParser read response body err: %v", cfg.EndpointUrl, err)
+	}
+	if resp.StatusCode() >= 300 {
+		return nil, fmt.Errorf("request %v http pdfParser http status %v msg: %v", cfg.EndpointUrl, resp.StatusCode(), string(b))
+	}
+	return b, nil
+}
+
+// parseContent 按字段映射从响应中取出解析结果
+func (cfg *PdfParser) parseContent(b []byte) (string, error) {
+	var data interface{}
+	decoder := json.NewDecoder(bytes.NewReader(b))
+	decoder.UseNumber()
+	if err := decoder.Decode(&data); err != nil {
+		return "", fmt.Errorf("http pdfParser resp (%v) unmarshal err: %v", string(b), err)
+	}
+	if cfg.SuccessPath != "" {
+		values := lookupPath(data, cfg.SuccessPath)
+		if len(values) == 0 || toString(values[0]) != cfg.SuccessValue {
+			var msg string
+			if cfg.MessagePath != "" {
+				msg = joinValues(lookupPath(data, cfg.MessagePath))
+			}
+			return "", fmt.Errorf("http pdfParser failed msg: %v, resp: %v", msg, string(b))
+		}
+	}
+	content := joinValues(lookupPath(data, valueOrDefault(cfg.ContentPath, "content")))
+	if content == "" {
+		return "", fmt.Errorf("http pdfParser resp without content: %v", string(b))
+	}
+	return content, nil
+}
+
+// lookupPath 按以.分隔的路径取值，数字表示数组下标，*表示遍历数组
+func lookupPath(data interface{}, path string) []interface{} {
+	values := []interface{}{data}
+	for _, key := range strings.Split(path, ".") {
+		var next []interface{}
+		for _, value := range values {
+			switch v := value.(type) {
+			case map[string]interface{}:
+				if item, ok := v[key]; ok {
+					next = append(next, item)
+				}
+			case []interface{}:
+				if key == "*" {
+					next = append(next, v...)
+				} else if index, err := json.Number(key).Int64(); err == nil && index >= 0 && int(index) < len(v) {
+					next = append(next, v[index])
+				}
+			}
+		}
+		values = next
+	}
+	return values
+}
+
+// joinValues 多个取值以空行拼接，数组取值展开拼接
+func joinValues(values []interface{}) string {
+	var texts []string
+	for _, value := range values {
+		if items, ok := value.([]interface{}); ok {
+			if text := joinValues(items); text != "" {
+				texts = append(texts, text)
+			}
+			continue
+		}
+		if text := toString(value); text != "" {
+			texts = append(texts, text)
+		}
+	}
+	return strings.Join(texts, "\n\n")
+}
+
+func toString(value interface{}) string {
+	switch v := value.(type) {
+	case nil:
+		return ""
+	case string:
+		return v
+	case json.Number:
+		return v.String()
+	case bool:
+		return fmt.Sprint(v)
+	default:
+		b, _ := json.Marshal(v)
+		return string(b)
+	}
+}
+
+func valueOrDefault(value, defaultValue string) string {
+	if value == "" {
+		return defaultValue
+	}
+	return value
+}
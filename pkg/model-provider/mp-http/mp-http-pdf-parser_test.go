@@ -0,0 +1,99 @@
+package mp_http
+
+import (
+	"bytes"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+
+	mp_common "github.com/UnicomAI/wanwu/pkg/model-provider/mp-common"
+	"github.com/UnicomAI/wanwu/pkg/util"
+	"github.com/gin-gonic/gin"
+)
+
+func TestMain(m *testing.M) {
+	if err := util.InitValidator(); err != nil {
+		panic(err)
+	}
+	os.Exit(m.Run())
+}
+
+// newFixtureServer 返回testdata中录制的响应，并校验表单字段映射
+func newFixtureServer(t *testing.T, fixture string) *httptest.Server {
+	b, err := os.ReadFile("testdata/" + fixture)
+	if err != nil {
+		t.Fatalf("read fixture err: %v", err)
+	}
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer sk-parser" {
+			t.Errorf("unexpected headers %v", r.Header)
+		}
+		if _, _, err := r.FormFile("document"); err != nil {
+			t.Errorf("form file err: %v", err)
+		}
+		if r.FormValue("name") != "test.pdf" || r.FormValue("output_format") != "markdown" {
+			t.Errorf("unexpected form %v", r.MultipartForm.Value)
+		}
+		_, _ = w.Write(b)
+	}))
+}
+
+func newPdfParser(url string) *PdfParser {
+	return &PdfParser{
+		ApiKey:        "sk-parser",
+		EndpointUrl:   url,
+		FileField:     "document",
+		FileNameField: "name",
+		FormFields:    map[string]string{"output_format": "markdown"},
+		ContentPath:   "data.pages.*.markdown",
+		SuccessPath:   "code",
+		SuccessValue:  "0",
+		MessagePath:   "msg",
+	}
+}
+
+func TestPdfParser(t *testing.T) {
+	srv := newFixtureServer(t, "pdf_parser_pages.json")
+	defer srv.Close()
+
+	resp, err := parse(t, newPdfParser(srv.URL))
+	if err != nil {
+		t.Fatalf("pdf parser err: %v", err)
+	}
+	data, ok := resp.ConvertResp()
+	if !ok || data.Code != "200" || data.Content != "# 第一章 总则\n\n第一条 为规范管理，制定本办法。" {
+		t.Fatalf("unexpected pdf parser resp %v", resp.String())
+	}
+}
+
+func TestPdfParserFailed(t *testing.T) {
+	srv := newFixtureServer(t, "pdf_parser_failed.json")
+	defer srv.Close()
+
+	if _, err := parse(t, newPdfParser(srv.URL)); err == nil {
+		t.Fatalf("expect err for failed resp")
+	}
+}
+
+func parse(t *testing.T, pdfParser *PdfParser) (mp_common.IPdfParserResp, error) {
+	buf := &bytes.Buffer{}
+	writer := multipart.NewWriter(buf)
+	part, _ := writer.CreateFormFile("file", "test.pdf")
+	_, _ = part.Write([]byte("%PDF-1.4"))
+	_ = writer.Close()
+	r := httptest.NewRequest(http.MethodPost, "/", buf)
+	r.Header.Set("Content-Type", writer.FormDataContentType())
+	_, fileHeader, err := r.FormFile("file")
+	if err != nil {
+		t.Fatalf("form file err: %v", err)
+	}
+
+	req, err := pdfParser.NewReq(&mp_common.PdfParserReq{Files: fileHeader, FileName: "test.pdf"})
+	if err != nil {
+		t.Fatalf("new req err: %v", err)
+	}
+	ctx, _ := gin.CreateTestContext(httptest.NewRecorder())
+	return pdfParser.PdfParser(ctx, req)
+}
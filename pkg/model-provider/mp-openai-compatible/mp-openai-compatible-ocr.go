@@ -0,0 +1,41 @@
+package mp_openai_compatible
+
+import (
+	"fmt"
+
+	mp_common "github.com/UnicomAI/wanwu/pkg/model-provider/mp-common"
+	"github.com/gin-gonic/gin"
+)
+
+// Ocr 以OpenAI兼容接口的多模态大模型进行OCR
+type Ocr struct {
+	ApiKey      string `json:"apiKey"`      // ApiKey
+	EndpointUrl string `json:"endpointUrl"` // 推理url，多模态大模型的OpenAI兼容接口
+	Prompt      string `json:"prompt"`      // OCR提示词，为空时使用默认提示词
+	MaxTokens   *int   `json:"maxTokens"`   // 模型回答最大tokens
+}
+
+func (cfg *Ocr) Tags() []mp_common.Tag {
+	tags := []mp_common.Tag{
+		{
+			Text: mp_common.TagOcr,
+		},
+	}
+	return tags
+}
+
+func (cfg *Ocr) NewReq(req *mp_common.OcrReq) (mp_common.IOcrReq, error) {
+	if req.Model == "" {
+		return nil, fmt.Errorf("ocr by llm without model")
+	}
+	return mp_common.NewOcrReq(req), nil
+}
+
+func (cfg *Ocr) Ocr(ctx *gin.Context, req mp_common.IOcrReq, headers ...mp_common.Header) (mp_common.IOcrResp, error) {
+	llm := &LLM{ApiKey: cfg.ApiKey, EndpointUrl: cfg.EndpointUrl}
+	b, err := mp_common.OcrByLLM(ctx, "openai compatible", llm, cfg.Prompt, cfg.MaxTokens, req.Data(), headers...)
+	if err != nil {
+		return nil, err
+	}
+	return mp_common.NewOcrResp(string(b)), nil
+}
@@ -0,0 +1,94 @@
+package mp_openai_compatible
+
+import (
+	"bytes"
+	"encoding/json"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"testing"
+
+	mp_common "github.com/UnicomAI/wanwu/pkg/model-provider/mp-common"
+	"github.com/UnicomAI/wanwu/pkg/util"
+	"github.com/gin-gonic/gin"
+)
+
+var pngHeader = []byte{0x89, 'P', 'N', 'G', '\r', '\n', 0x1a, '\n', 0, 0, 0, 0x0d, 'I', 'H', 'D', 'R'}
+
+func TestMain(m *testing.M) {
+	if err := util.InitValidator(); err != nil {
+		panic(err)
+	}
+	os.Exit(m.Run())
+}
+
+func TestOcr(t *testing.T) {
+	b, err := os.ReadFile("testdata/chat_completions_ocr.json")
+	if err != nil {
+		t.Fatalf("read fixture err: %v", err)
+	}
+	var body mp_common.LLMReq
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/v1/chat/completions" || r.Header.Get("Authorization") != "Bearer sk-ocr" {
+			t.Errorf("unexpected request %v %v", r.URL.Path, r.Header)
+		}
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			t.Errorf("decode request body err: %v", err)
+		}
+		_, _ = w.Write(b)
+	}))
+	defer srv.Close()
+
+	ocr := &Ocr{ApiKey: "sk-ocr", EndpointUrl: srv.URL + "/v1"}
+	req, err := ocr.NewReq(&mp_common.OcrReq{Files: newFileHeader(t, "invoice.png", pngHeader), Model: "qwen2.5-vl-72b-instruct"})
+	if err != nil {
+		t.Fatalf("new req err: %v", err)
+	}
+	ctx, _ := gin.CreateTestContext(httptest.NewRecorder())
+	resp, err := ocr.Ocr(ctx, req)
+	if err != nil {
+		t.Fatalf("ocr err: %v", err)
+	}
+
+	// request
+	parts := body.Messages[0].Parts()
+	if body.Model != "qwen2.5-vl-72b-instruct" || len(parts) != 2 || parts[1].Text != mp_common.DefaultOcrPrompt {
+		t.Fatalf("unexpected request %+v", body)
+	}
+	if !strings.HasPrefix(parts[0].ImageUrl, "data:image/png;base64,") {
+		t.Fatalf("unexpected image url %v", parts[0].ImageUrl)
+	}
+
+	// response
+	data, ok := resp.ConvertResp()
+	if !ok || data.Code != 0 || len(data.OcrData) != 1 || data.OcrData[0].Text != "发票号码：12345678\n开票日期：2025年10月18日" {
+		t.Fatalf("unexpected ocr resp %v", resp.String())
+	}
+}
+
+func TestOcrWithoutModel(t *testing.T) {
+	ocr := &Ocr{EndpointUrl: "http://127.0.0.1"}
+	if _, err := ocr.NewReq(&mp_common.OcrReq{Files: newFileHeader(t, "invoice.png", pngHeader)}); err == nil {
+		t.Fatalf("expect err without model")
+	}
+}
+
+func newFileHeader(t *testing.T, filename string, content []byte) *multipart.FileHeader {
+	buf := &bytes.Buffer{}
+	writer := multipart.NewWriter(buf)
+	part, err := writer.CreateFormFile("file", filename)
+	if err != nil {
+		t.Fatalf("create form file err: %v", err)
+	}
+	_, _ = part.Write(content)
+	_ = writer.Close()
+	r := httptest.NewRequest(http.MethodPost, "/", buf)
+	r.Header.Set("Content-Type", writer.FormDataContentType())
+	_, fileHeader, err := r.FormFile("file")
+	if err != nil {
+		t.Fatalf("form file err: %v", err)
+	}
+	return fileHeader
+}
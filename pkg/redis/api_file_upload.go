@@ -0,0 +1,37 @@
+package redis
+
+import (
+	"context"
+	"fmt"
+)
+
+const (
+	_dbFileUpload = 7
+)
+
+var (
+	_redisFileUpload *client
+)
+
+func InitFileUpload(ctx context.Context, cfg Config) error {
+	if _redisFileUpload != nil {
+		return fmt.Errorf("redis file upload client already init")
+	}
+	c, err := newClient(ctx, cfg, _dbFileUpload)
+	if err != nil {
+		return err
+	}
+	_redisFileUpload = c
+	return nil
+}
+
+func StopFileUpload() {
+	if _redisFileUpload != nil {
+		_redisFileUpload.Stop()
+		_redisFileUpload = nil
+	}
+}
+
+func FileUpload() *client {
+	return _redisFileUpload
+}
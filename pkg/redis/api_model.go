@@ -0,0 +1,37 @@
+package redis
+
+import (
+	"context"
+	"fmt"
+)
+
+const (
+	_dbModel = 6
+)
+
+var (
+	_redisModel *client
+)
+
+func InitModel(ctx context.Context, cfg Config) error {
+	if _redisModel != nil {
+		return fmt.Errorf("redis model client already init")
+	}
+	c, err := newClient(ctx, cfg, _dbModel)
+	if err != nil {
+		return err
+	}
+	_redisModel = c
+	return nil
+}
+
+func StopModel() {
+	if _redisModel != nil {
+		_redisModel.Stop()
+		_redisModel = nil
+	}
+}
+
+func Model() *client {
+	return _redisModel
+}
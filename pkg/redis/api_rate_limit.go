@@ -0,0 +1,37 @@
+package redis
+
+import (
+	"context"
+	"fmt"
+)
+
+const (
+	_dbRateLimit = 8
+)
+
+var (
+	_redisRateLimit *client
+)
+
+func InitRateLimit(ctx context.Context, cfg Config) error {
+	if _redisRateLimit != nil {
+		return fmt.Errorf("redis rate limit client already init")
+	}
+	c, err := newClient(ctx, cfg, _dbRateLimit)
+	if err != nil {
+		return err
+	}
+	_redisRateLimit = c
+	return nil
+}
+
+func StopRateLimit() {
+	if _redisRateLimit != nil {
+		_redisRateLimit.Stop()
+		_redisRateLimit = nil
+	}
+}
+
+func RateLimit() *client {
+	return _redisRateLimit
+}
@@ -94,6 +94,24 @@ func (c *client) Expire(ctx context.Context, key string, expire time.Duration) e
 	return c.cli.Expire(ctx, key, expire).Err()
 }
 
+// --- String ---
+
+// Get key不存在时返回空字符串
+func (c *client) Get(ctx context.Context, key string) (string, error) {
+	value, err := c.cli.Get(ctx, key).Result()
+	if err != nil {
+		if errors.Is(err, redis.Nil) {
+			return "", nil
+		}
+		return "", err
+	}
+	return value, nil
+}
+
+func (c *client) Set(ctx context.Context, key, value string, expire time.Duration) error {
+	return c.cli.Set(ctx, key, value, expire).Err()
+}
+
 // --- Hash ---
 
 type HashItem struct {
@@ -123,6 +141,10 @@ func (c *client) HGet(ctx context.Context, key, field string) (*HashItem, error)
 	return &HashItem{K: field, V: value}, nil
 }
 
+func (c *client) HIncrBy(ctx context.Context, key, field string, incr int64) error {
+	return c.cli.HIncrBy(ctx, key, field, incr).Err()
+}
+
 func (c *client) HGetAll(ctx context.Context, key string) ([]HashItem, error) {
 	kvs, err := c.cli.HGetAll(ctx, key).Result()
 	if err != nil {
@@ -4,6 +4,7 @@ import (
 	"crypto/aes"
 	"crypto/cipher"
 	"crypto/md5"
+	"crypto/rand"
 	"crypto/sha256"
 	"encoding/hex"
 	"errors"
@@ -41,3 +42,36 @@ func unPadding(src []byte) ([]byte, error) {
 	}
 	return src[:length-unPadNum], nil
 }
+
+// EncryptAESGCM AES-GCM加密，key长度16/24/32字节，返回 nonce+密文
+func EncryptAESGCM(plain, key []byte) ([]byte, error) {
+	gcm, err := newGCM(key)
+	if err != nil {
+		return nil, err
+	}
+	nonce := make([]byte, gcm.NonceSize())
+	if _, err = rand.Read(nonce); err != nil {
+		return nil, err
+	}
+	return gcm.Seal(nonce, nonce, plain, nil), nil
+}
+
+// DecryptAESGCM AES-GCM解密，src为 EncryptAESGCM 的输出
+func DecryptAESGCM(src, key []byte) ([]byte, error) {
+	gcm, err := newGCM(key)
+	if err != nil {
+		return nil, err
+	}
+	if len(src) < gcm.NonceSize() {
+		return nil, errors.New("解密失败")
+	}
+	return gcm.Open(nil, src[:gcm.NonceSize()], src[gcm.NonceSize():], nil)
+}
+
+func newGCM(key []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
@@ -0,0 +1,172 @@
+package webhook
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"net/url"
+	"strings"
+	"syscall"
+	"time"
+)
+
+var (
+	errForbiddenAddress = errors.New("webhook address not allowed")
+
+	// nonPublicNets IsPrivate/IsLoopback等之外不可投递的网段
+	nonPublicNets = []*net.IPNet{
+		mustCIDR("0.0.0.0/8"),     // 本网络
+		mustCIDR("100.64.0.0/10"), // 运营商级NAT
+		mustCIDR("192.0.0.0/24"),  // IETF协议分配
+		mustCIDR("198.18.0.0/15"), // 基准测试
+		mustCIDR("240.0.0.0/4"),   // 保留
+	}
+)
+
+func mustCIDR(cidr string) *net.IPNet {
+	_, ipNet, err := net.ParseCIDR(cidr)
+	if err != nil {
+		panic(err)
+	}
+	return ipNet
+}
+
+// PublicIP 是否为可投递的公网地址，拒绝回环、内网、链路本地（含云厂商元数据169.254.169.254）、未指定及组播地址
+func PublicIP(ip net.IP) bool {
+	if ip.IsLoopback() || ip.IsPrivate() || ip.IsLinkLocalUnicast() || ip.IsLinkLocalMulticast() ||
+		ip.IsInterfaceLocalMulticast() || ip.IsMulticast() || ip.IsUnspecified() {
+		return false
+	}
+	for _, ipNet := range nonPublicNets {
+		if ipNet.Contains(ip) {
+			return false
+		}
+	}
+	return true
+}
+
+// Allowlist 允许投递的非公网地址，用于投递到内网服务；为nil时只允许公网地址
+type Allowlist struct {
+	nets  []*net.IPNet
+	hosts map[string]bool
+}
+
+// NewAllowlist 由配置构造白名单，每项为CIDR网段、IP或主机名；主机名须与接收地址的host完全一致（不区分大小写）
+func NewAllowlist(entries []string) (*Allowlist, error) {
+	a := &Allowlist{hosts: make(map[string]bool)}
+	for _, entry := range entries {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+		if strings.Contains(entry, "/") {
+			_, ipNet, err := net.ParseCIDR(entry)
+			if err != nil {
+				return nil, fmt.Errorf("invalid webhook allowlist cidr %v: %v", entry, err)
+			}
+			a.nets = append(a.nets, ipNet)
+			continue
+		}
+		if ip := net.ParseIP(entry); ip != nil {
+			a.nets = append(a.nets, &net.IPNet{IP: ip, Mask: net.CIDRMask(len(ip)*8, len(ip)*8)})
+			continue
+		}
+		a.hosts[strings.ToLower(entry)] = true
+	}
+	return a, nil
+}
+
+// allowIP ip是否在白名单网段内
+func (a *Allowlist) allowIP(ip net.IP) bool {
+	if a == nil {
+		return false
+	}
+	for _, ipNet := range a.nets {
+		if ipNet.Contains(ip) {
+			return true
+		}
+	}
+	return false
+}
+
+// allowHost 主机名是否在白名单内
+func (a *Allowlist) allowHost(host string) bool {
+	return a != nil && a.hosts[strings.ToLower(host)]
+}
+
+// allowedHostKey 标记本次连接的主机名在白名单内，由dialer的ControlContext读取
+type allowedHostKey struct{}
+
+// ValidURL 校验接收地址：仅支持http/https，host为IP时需为公网地址或在白名单内；域名在投递连接时按解析结果校验
+func ValidURL(rawUrl string, allow *Allowlist) error {
+	u, err := parseURL(rawUrl)
+	if err != nil {
+		return err
+	}
+	if ip := net.ParseIP(u.Hostname()); ip != nil && !allow.allowIP(ip) && !PublicIP(ip) {
+		return fmt.Errorf("url %v: %w", rawUrl, errForbiddenAddress)
+	}
+	return nil
+}
+
+// ValidURLFormat 只校验接收地址格式，不校验地址是否允许投递，用于不持有白名单的调用方预校验
+func ValidURLFormat(rawUrl string) error {
+	_, err := parseURL(rawUrl)
+	return err
+}
+
+func parseURL(rawUrl string) (*url.URL, error) {
+	u, err := url.Parse(rawUrl)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Hostname() == "" || u.User != nil {
+		return nil, fmt.Errorf("invalid url: %v", rawUrl)
+	}
+	return u, nil
+}
+
+// NewClient 投递用http client：在DNS解析后、建立连接前校验目标IP（防止DNS rebinding），先按白名单放行，再要求公网地址；
+// 不使用环境代理，不跟随重定向
+func NewClient(timeout time.Duration, allow *Allowlist) *http.Client {
+	dialer := &net.Dialer{
+		Timeout: timeout,
+		ControlContext: func(ctx context.Context, network, address string, _ syscall.RawConn) error {
+			host, _, err := net.SplitHostPort(address)
+			if err != nil {
+				return err
+			}
+			if allowed, _ := ctx.Value(allowedHostKey{}).(bool); allowed {
+				return nil
+			}
+			ip := net.ParseIP(host)
+			if ip != nil && allow.allowIP(ip) {
+				return nil
+			}
+			if ip == nil || !PublicIP(ip) {
+				return fmt.Errorf("%v: %w", host, errForbiddenAddress)
+			}
+			return nil
+		},
+	}
+	// 解析前的主机名只在DialContext中可见，白名单主机名通过ctx传给ControlContext
+	dialContext := func(ctx context.Context, network, address string) (net.Conn, error) {
+		if host, _, err := net.SplitHostPort(address); err == nil && allow.allowHost(host) {
+			ctx = context.WithValue(ctx, allowedHostKey{}, true)
+		}
+		return dialer.DialContext(ctx, network, address)
+	}
+	return &http.Client{
+		Timeout: timeout,
+		Transport: &http.Transport{
+			Proxy:                 nil,
+			DialContext:           dialContext,
+			TLSHandshakeTimeout:   timeout,
+			ResponseHeaderTimeout: timeout,
+			MaxIdleConnsPerHost:   4,
+			IdleConnTimeout:       90 * time.Second,
+		},
+		CheckRedirect: func(*http.Request, []*http.Request) error {
+			return http.ErrUseLastResponse
+		},
+	}
+}
@@ -0,0 +1,66 @@
+package webhook
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	operate_service "github.com/UnicomAI/wanwu/api/proto/operate-service"
+	"github.com/UnicomAI/wanwu/pkg/log"
+	"github.com/UnicomAI/wanwu/pkg/util"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+)
+
+const (
+	maxMsgSize            = 1024 * 1024 * 4 // 4M
+	headlessServiceSchema = "dns:///"
+
+	emitTimeout = 10 * time.Second
+)
+
+var operate operate_service.OperateServiceClient
+
+// Init 初始化operate-service连接，host为空时不发送事件
+func Init(host string) error {
+	if host == "" {
+		log.Warnf("operate host not configured, webhook event disabled")
+		return nil
+	}
+	conn, err := grpc.NewClient(headlessServiceSchema+host,
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithDefaultServiceConfig(`{"loadBalancingPolicy":"round_robin"}`),
+		grpc.WithDefaultCallOptions(
+			grpc.MaxCallRecvMsgSize(maxMsgSize),
+			grpc.MaxCallSendMsgSize(maxMsgSize)),
+	)
+	if err != nil {
+		return err
+	}
+	operate = operate_service.NewOperateServiceClient(conn)
+	return nil
+}
+
+// Emit 异步发送事件，由operate-service投递到组织内订阅了该事件的webhook；发送失败只记录日志，不影响业务流程
+func Emit(orgId, event string, data interface{}) {
+	if operate == nil || orgId == "" {
+		return
+	}
+	b, err := json.Marshal(data)
+	if err != nil {
+		log.Errorf("webhook emit org %v event %v marshal err: %v", orgId, event, err)
+		return
+	}
+	go func() {
+		defer util.PrintPanicStack()
+		ctx, cancel := context.WithTimeout(context.Background(), emitTimeout)
+		defer cancel()
+		if _, err := operate.EmitWebhookEvent(ctx, &operate_service.EmitWebhookEventReq{
+			OrgId: orgId,
+			Event: event,
+			Data:  string(b),
+		}); err != nil {
+			log.Errorf("webhook emit org %v event %v err: %v", orgId, event, err)
+		}
+	}()
+}
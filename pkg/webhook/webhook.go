@@ -0,0 +1,150 @@
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// event type
+const (
+	EventKnowledgeDocParsed      = "knowledge.doc.parsed"       // 知识库文档解析成功
+	EventKnowledgeDocParseFailed = "knowledge.doc.parse_failed" // 知识库文档解析失败
+	EventKnowledgeImportFinished = "knowledge.import.finished"  // 知识库导入任务完成
+	EventKnowledgeImportFailed   = "knowledge.import.failed"    // 知识库导入任务失败
+	EventAppPublished            = "app.published"              // 应用发布
+	EventAppUnpublished          = "app.unpublished"            // 应用取消发布
+	EventConversationCompleted   = "conversation.completed"     // 智能体对话完成
+)
+
+// request header
+const (
+	HeaderEvent     = "X-Wanwu-Event"     // 事件类型
+	HeaderDelivery  = "X-Wanwu-Delivery"  // 投递ID，重试时不变，可用于去重
+	HeaderTimestamp = "X-Wanwu-Timestamp" // 签名时间戳（秒）
+	HeaderSignature = "X-Wanwu-Signature" // sha256=hex(hmac_sha256(secret, "{timestamp}.{body}"))
+)
+
+const (
+	signaturePrefix = "sha256="
+
+	backoffBase = 10 * time.Second
+	backoffMax  = time.Hour
+
+	// respDrainLimit 丢弃接收方响应内容的最大字节数，便于复用连接
+	respDrainLimit = 4096
+)
+
+// Events 全部事件类型
+var Events = []string{
+	EventKnowledgeDocParsed,
+	EventKnowledgeDocParseFailed,
+	EventKnowledgeImportFinished,
+	EventKnowledgeImportFailed,
+	EventAppPublished,
+	EventAppUnpublished,
+	EventConversationCompleted,
+}
+
+// Payload 投递给接收方的请求体
+type Payload struct {
+	Id        string          `json:"id"`        // 事件ID
+	Event     string          `json:"event"`     // 事件类型
+	OrgId     string          `json:"orgId"`     // 组织ID
+	CreatedAt int64           `json:"createdAt"` // 事件产生时间（毫秒）
+	Data      json.RawMessage `json:"data"`      // 事件内容
+}
+
+// ValidFilter 校验事件过滤条件，支持具体事件、"*" 及 "knowledge.*" 形式的前缀
+func ValidFilter(filter string) bool {
+	if filter == "*" {
+		return true
+	}
+	if prefix, ok := strings.CutSuffix(filter, ".*"); ok {
+		for _, event := range Events {
+			if strings.HasPrefix(event, prefix+".") {
+				return true
+			}
+		}
+		return false
+	}
+	for _, event := range Events {
+		if event == filter {
+			return true
+		}
+	}
+	return false
+}
+
+// Match 判断event是否满足任一过滤条件，filters为空时不匹配任何事件
+func Match(filters []string, event string) bool {
+	for _, filter := range filters {
+		if filter == "*" || filter == event {
+			return true
+		}
+		if prefix, ok := strings.CutSuffix(filter, "*"); ok && strings.HasPrefix(event, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// Sign 计算签名，timestamp为秒级时间戳
+func Sign(secret string, timestamp int64, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(strconv.FormatInt(timestamp, 10)))
+	mac.Write([]byte("."))
+	mac.Write(body)
+	return signaturePrefix + hex.EncodeToString(mac.Sum(nil))
+}
+
+// Verify 校验签名，供接收方参考
+func Verify(secret string, timestamp int64, body []byte, signature string) bool {
+	return hmac.Equal([]byte(Sign(secret, timestamp, body)), []byte(signature))
+}
+
+// Backoff 第attempt次（从1开始）投递失败后到下次重试的等待时间，按指数增长，最长1小时
+func Backoff(attempt int) time.Duration {
+	if attempt < 1 {
+		attempt = 1
+	}
+	if attempt > 16 {
+		return backoffMax
+	}
+	return min(backoffBase<<(attempt-1), backoffMax)
+}
+
+// Deliver 签名并投递一次事件，返回接收方的响应码；非2xx（含重定向）响应视为失败。
+// 错误中只包含响应码，不包含响应内容，避免通过投递记录读取接收方（如内网服务）的返回；cli应使用 NewClient 构造
+func Deliver(ctx context.Context, cli *http.Client, url, secret, event, deliveryId string, body []byte) (int, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(body))
+	if err != nil {
+		return 0, err
+	}
+	timestamp := time.Now().Unix()
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("User-Agent", "Wanwu-Webhook")
+	req.Header.Set(HeaderEvent, event)
+	req.Header.Set(HeaderDelivery, deliveryId)
+	req.Header.Set(HeaderTimestamp, strconv.FormatInt(timestamp, 10))
+	req.Header.Set(HeaderSignature, Sign(secret, timestamp, body))
+	resp, err := cli.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	defer func() { _ = resp.Body.Close() }()
+	_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, respDrainLimit))
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return resp.StatusCode, fmt.Errorf("webhook response status %v", resp.StatusCode)
+	}
+	return resp.StatusCode, nil
+}
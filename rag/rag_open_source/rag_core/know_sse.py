@@ -135,6 +135,10 @@ async def search(request: Request):
             api_key = llm_config.api_key
 
         headers = {"Content-Type": "application/json", "Authorization": f"Bearer {api_key}"}
+        # 透传调用方信息，供模型callback统计用量、校验配额
+        for caller_header in ("X-Org-Id", "X-User-Id", "X-App-Id", "X-App-Type"):
+            if request.headers.get(caller_header):
+                headers[caller_header] = request.headers.get(caller_header)
         messages = []
         for item in history:
             messages.append({"role": "user", "content": item["query"]})
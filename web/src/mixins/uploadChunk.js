@@ -11,7 +11,7 @@ export default {
             isExpire:false,//合并接口是否添加isExpired参数，用来判断minio存储文件是否过期
             // maxSizeBytes: 20 * 1024 * 1024,//可切片大小
             maxSizeBytes:0,//可切片大小
-            chunkSize: 4 * 1024 * 1024,//切片大小1MB
+            chunkSize: 5 * 1024 * 1024,//切片大小5MB，minio分片上传除最后一片外每片不小于5MB
             file: null,//当前文件
             totalChunks: 0,//所有切片数
             uploadedChunks: 0,